@@ -49,6 +49,8 @@ type (
 		QueryField *FieldConfig `json:"QueryField,omitempty"`
 		// MutationInputs defines the input types for the mutation.
 		MutationInputs []MutationConfig `json:"MutationInputs,omitempty"`
+		// MutationPayload enables generating payload types for the mutation inputs.
+		MutationPayload *MutationPayloadConfig `json:"MutationPayload,omitempty"`
 	}
 
 	// Directive to apply on the field/type.
@@ -75,6 +77,13 @@ type (
 	MutationConfig struct {
 		IsCreate bool `json:"IsCreate,omitempty"`
 	}
+
+	// MutationPayloadConfig hold config for the mutation payloads.
+	MutationPayloadConfig struct {
+		// InvalidateConnections holds the keys of the connections that are
+		// invalidated by all mutations of the type (e.g. Query.todos).
+		InvalidateConnections []string `json:"InvalidateConnections,omitempty"`
+	}
 )
 
 const (
@@ -388,6 +397,25 @@ func Mutations(inputs ...MutationOption) Annotation {
 	return Annotation{MutationInputs: a}
 }
 
+// MutationPayloads returns an annotation for generating the Create<T>Payload and Update<T>Payload
+// types of the mutation inputs defined by the Mutations annotation. Besides the mutated node, a payload
+// holds the IDs of the nodes affected by the mutation and the keys of the connections it invalidated,
+// allowing clients using normalized caches to update their store without refetching whole lists.
+//
+// The given connection keys (e.g. "Query.todos") are invalidated by all mutations. Keys of the lists
+// holding the other side of the edges changed by the mutation (e.g. "Todo.children" when the parent
+// of a todo is changed) are added automatically.
+//
+//	func (Todo) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
+//			entgql.MutationPayloads("Query.todos"),
+//		}
+//	}
+func MutationPayloads(invalidate ...string) Annotation {
+	return Annotation{MutationPayload: &MutationPayloadConfig{InvalidateConnections: invalidate}}
+}
+
 // Merge implements the schema.Merger interface.
 func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	var ant Annotation
@@ -425,6 +453,12 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	if len(ant.MutationInputs) > 0 {
 		a.MutationInputs = append(a.MutationInputs, ant.MutationInputs...)
 	}
+	if ant.MutationPayload != nil {
+		if a.MutationPayload == nil {
+			a.MutationPayload = &MutationPayloadConfig{}
+		}
+		a.MutationPayload.InvalidateConnections = append(a.MutationPayload.InvalidateConnections, ant.MutationPayload.InvalidateConnections...)
+	}
 	if ant.RelayConnection {
 		a.RelayConnection = true
 	}
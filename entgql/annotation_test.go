@@ -36,6 +36,13 @@ func TestAnnotation(t *testing.T) {
 	annotation = entgql.MapsTo(names...)
 	require.True(t, annotation.Unbind)
 	require.ElementsMatch(t, names, annotation.Mapping)
+
+	annotation = entgql.MutationPayloads()
+	require.NotNil(t, annotation.MutationPayload)
+	require.Empty(t, annotation.MutationPayload.InvalidateConnections)
+	annotation = annotation.Merge(entgql.MutationPayloads("Query.todos")).(entgql.Annotation)
+	annotation = annotation.Merge(entgql.MutationPayloads("User.todos")).(entgql.Annotation)
+	require.Equal(t, []string{"Query.todos", "User.todos"}, annotation.MutationPayload.InvalidateConnections)
 }
 
 func TestAnnotationDecode(t *testing.T) {
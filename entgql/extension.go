@@ -19,6 +19,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"entgo.io/contrib/genmanifest"
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
 	"github.com/99designs/gqlgen/api"
@@ -34,6 +35,7 @@ type (
 		outputWriter func(*ast.Schema) error
 		hooks        []gen.Hook
 		templates    []*gen.Template
+		manifest     bool
 	}
 
 	// ExtensionOption allows for managing the Extension configuration
@@ -67,6 +69,16 @@ func WithOutputWriter(w func(*ast.Schema) error) ExtensionOption {
 	}
 }
 
+// WithManifest stamps the generated GraphQL schema with the version of entgql and the hash
+// of the ent schema it was generated from, and records it in the manifest of the ent target
+// directory. See the genmanifest package for detecting stale generated code.
+func WithManifest() ExtensionOption {
+	return func(ex *Extension) error {
+		ex.manifest = true
+		return nil
+	}
+}
+
 // WithSchemaHook allows users to provide a list of hooks
 // to run after the GQL schema generation.
 func WithSchemaHook(hooks ...SchemaHook) ExtensionOption {
@@ -246,7 +258,7 @@ func (e *Extension) genSchemaHook() gen.Hook {
 			}
 
 			if !(e.genSchema || e.genWhereInput || e.genMutations) {
-				return nil
+				return e.recordManifest(g)
 			}
 			schema, err := e.BuildSchema(g)
 			if err != nil {
@@ -254,15 +266,38 @@ func (e *Extension) genSchemaHook() gen.Hook {
 			}
 			if e.outputWriter == nil {
 				if e.path == "" {
-					return nil
+					return e.recordManifest(g)
+				}
+				content := printSchema(schema)
+				if e.manifest {
+					hash, err := genmanifest.SchemaHash(g)
+					if err != nil {
+						return err
+					}
+					content = "# " + genmanifest.Header("entgql", genmanifest.Version(), hash) + "\n\n" + content
+				}
+				if err := os.WriteFile(e.path, []byte(content), 0644); err != nil {
+					return err
 				}
-				return os.WriteFile(e.path, []byte(printSchema(schema)), 0644)
+				return e.recordManifest(g, e.path)
 			}
-			return e.outputWriter(schema)
+			if err := e.outputWriter(schema); err != nil {
+				return err
+			}
+			return e.recordManifest(g)
 		})
 	}
 }
 
+// recordManifest records the given generated files
+// in the manifest, if it is enabled.
+func (e *Extension) recordManifest(g *gen.Graph, files ...string) error {
+	if !e.manifest {
+		return nil
+	}
+	return genmanifest.Record(g, "entgql", files...)
+}
+
 // hasTemplate reports if the template exists
 // in the template list and returns its index.
 func (e *Extension) hasTemplate(tem *gen.Template) (int, bool) {
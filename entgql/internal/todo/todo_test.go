@@ -1109,6 +1109,46 @@ func (s *todoTestSuite) TestMutationFieldCollection() {
 	s.Require().Equal(strconv.Itoa(idOffset+1), rsp.CreateTodo.Parent.Text)
 }
 
+func (s *todoTestSuite) TestMutationPayload() {
+	var rsp struct {
+		UpdateTodo struct {
+			Todo struct {
+				ID     string
+				Parent struct {
+					ID string
+				}
+			}
+			AffectedIDs            []string
+			InvalidatedConnections []string
+		}
+	}
+	err := s.Post(`mutation ($id: ID!, $parentID: ID!, $childID: ID!) {
+		updateTodo(id: $id, input: { parentID: $parentID, removeChildIDs: [$childID] }) {
+			todo {
+				id
+				parent {
+					id
+				}
+			}
+			affectedIDs
+			invalidatedConnections
+		}
+	}`, &rsp,
+		client.Var("id", strconv.Itoa(idOffset+3)),
+		client.Var("parentID", strconv.Itoa(idOffset+2)),
+		client.Var("childID", strconv.Itoa(idOffset+5)),
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(strconv.Itoa(idOffset+3), rsp.UpdateTodo.Todo.ID)
+	s.Require().Equal(strconv.Itoa(idOffset+2), rsp.UpdateTodo.Todo.Parent.ID)
+	s.Require().Equal([]string{
+		strconv.Itoa(idOffset + 3),
+		strconv.Itoa(idOffset + 2),
+		strconv.Itoa(idOffset + 5),
+	}, rsp.UpdateTodo.AffectedIDs)
+	s.Require().Equal([]string{"Query.todos", "Todo.children"}, rsp.UpdateTodo.InvalidatedConnections)
+}
+
 func (s *todoTestSuite) TestQueryJSONFields() {
 	var (
 		ctx = context.Background()
@@ -1874,3 +1914,69 @@ func TestMutation_CreateCategory(t *testing.T) {
 	require.Equal(t, "c1.t1", n.Edges[0].Node.Text)
 	require.Equal(t, "c1.t2", n.Edges[1].Node.Text)
 }
+
+func TestSQLTracer(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	require.NoError(t, err)
+	ec := enttest.NewClient(t,
+		enttest.WithOptions(ent.Driver(entgql.TraceDriver(drv))),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	srv := handler.NewDefaultServer(gen.NewSchema(ec))
+	srv.Use(entgql.SQLTracer{})
+	gqlc := client.New(srv)
+
+	group := ec.Group.Create().SetName("group").SaveX(ctx)
+	for i := 0; i < 3; i++ {
+		ec.User.Create().SetName(fmt.Sprintf("user-%d", i)).AddGroups(group).SaveX(ctx)
+	}
+	const query = `query {
+		users {
+			edges {
+				node {
+					name
+					groups {
+						totalCount
+					}
+				}
+			}
+		}
+	}`
+
+	rsp, err := gqlc.RawPost(query)
+	require.NoError(t, err)
+	require.Empty(t, rsp.Errors)
+	require.NotContains(t, rsp.Extensions, entgql.SQLTraceExtension, "operations are traced only if requested")
+
+	rsp, err = gqlc.RawPost(query, client.AddHeader(entgql.SQLTraceHeader, "1"))
+	require.NoError(t, err)
+	require.Empty(t, rsp.Errors)
+	require.Contains(t, rsp.Extensions, entgql.SQLTraceExtension)
+	b, err := json.Marshal(rsp.Extensions[entgql.SQLTraceExtension])
+	require.NoError(t, err)
+	var trace entgql.SQLTraceSummary
+	require.NoError(t, json.Unmarshal(b, &trace))
+	require.NotEmpty(t, trace.Statements)
+	var count int
+	for _, s := range trace.Statements {
+		count += s.Count
+		require.NotContains(t, s.Query, "user-", "statements are recorded without their arguments")
+		require.NotContains(t, s.Query, "\n")
+		require.Zero(t, s.Errors)
+	}
+	require.Equal(t, trace.Count, count)
+	require.Contains(t, trace.Statements[0].Query, "FROM `users`")
+	_, err = time.ParseDuration(trace.Duration)
+	require.NoError(t, err)
+
+	rsp, err = gqlc.RawPost(query, client.AddHeader("X-Debug", "1"))
+	require.NoError(t, err)
+	require.NotContains(t, rsp.Extensions, entgql.SQLTraceExtension)
+
+	srv = handler.NewDefaultServer(gen.NewSchema(ec))
+	srv.Use(entgql.SQLTracer{Header: "X-Debug"})
+	rsp, err = client.New(srv).RawPost(query, client.AddHeader("X-Debug", "1"))
+	require.NoError(t, err)
+	require.Contains(t, rsp.Extensions, entgql.SQLTraceExtension)
+}
@@ -0,0 +1,112 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"entgo.io/contrib/entgql/internal/todopulid/ent/schema/pulid"
+)
+
+// CreateTodoPayload represents the payload of mutations creating todos.
+type CreateTodoPayload struct {
+	// Todo is the created node.
+	Todo *Todo `json:"todo"`
+	// AffectedIDs holds the IDs of the nodes affected by the mutation.
+	AffectedIDs []pulid.ID `json:"affectedIDs"`
+	// InvalidatedConnections holds the keys of the connections invalidated by the mutation.
+	InvalidatedConnections []string `json:"invalidatedConnections"`
+}
+
+// NewCreateTodoPayload returns the CreateTodoPayload of the mutation m that created the given node.
+func NewCreateTodoPayload(node *Todo, m *TodoMutation) *CreateTodoPayload {
+	p := &CreateTodoPayload{
+		Todo:        node,
+		AffectedIDs: []pulid.ID{node.ID},
+	}
+	p.InvalidatedConnections = appendConnections(p.InvalidatedConnections, "Query.todos")
+	if id, exists := m.ParentID(); exists {
+		p.AffectedIDs = append(p.AffectedIDs, id)
+		p.InvalidatedConnections = appendConnections(p.InvalidatedConnections, "Todo.children")
+	}
+	for _, id := range m.ChildrenIDs() {
+		p.AffectedIDs = append(p.AffectedIDs, id)
+	}
+	if id, exists := m.CategoryID(); exists {
+		p.AffectedIDs = append(p.AffectedIDs, id)
+		p.InvalidatedConnections = appendConnections(p.InvalidatedConnections, "Category.todos")
+	}
+	return p
+}
+
+// UpdateTodoPayload represents the payload of mutations updating todos.
+type UpdateTodoPayload struct {
+	// Todo is the updated node.
+	Todo *Todo `json:"todo"`
+	// AffectedIDs holds the IDs of the nodes affected by the mutation.
+	AffectedIDs []pulid.ID `json:"affectedIDs"`
+	// InvalidatedConnections holds the keys of the connections invalidated by the mutation.
+	InvalidatedConnections []string `json:"invalidatedConnections"`
+}
+
+// NewUpdateTodoPayload returns the UpdateTodoPayload of the mutation m that updated the given node.
+func NewUpdateTodoPayload(node *Todo, m *TodoMutation) *UpdateTodoPayload {
+	p := &UpdateTodoPayload{
+		Todo:        node,
+		AffectedIDs: []pulid.ID{node.ID},
+	}
+	p.InvalidatedConnections = appendConnections(p.InvalidatedConnections, "Query.todos")
+	if id, exists := m.ParentID(); exists {
+		p.AffectedIDs = append(p.AffectedIDs, id)
+		p.InvalidatedConnections = appendConnections(p.InvalidatedConnections, "Todo.children")
+	}
+	if m.ParentCleared() {
+		p.InvalidatedConnections = appendConnections(p.InvalidatedConnections, "Todo.children")
+	}
+	for _, id := range m.ChildrenIDs() {
+		p.AffectedIDs = append(p.AffectedIDs, id)
+	}
+	for _, id := range m.RemovedChildrenIDs() {
+		p.AffectedIDs = append(p.AffectedIDs, id)
+	}
+	if len(m.ChildrenIDs()) > 0 || len(m.RemovedChildrenIDs()) > 0 {
+		p.InvalidatedConnections = appendConnections(p.InvalidatedConnections, "Todo.children")
+	}
+	if m.ChildrenCleared() {
+		p.InvalidatedConnections = appendConnections(p.InvalidatedConnections, "Todo.children")
+	}
+	if id, exists := m.CategoryID(); exists {
+		p.AffectedIDs = append(p.AffectedIDs, id)
+		p.InvalidatedConnections = appendConnections(p.InvalidatedConnections, "Category.todos")
+	}
+	if m.CategoryCleared() {
+		p.InvalidatedConnections = appendConnections(p.InvalidatedConnections, "Category.todos")
+	}
+	return p
+}
+
+// appendConnections appends the given connection keys to keys, if they were not added before.
+func appendConnections(keys []string, add ...string) []string {
+	for _, k := range add {
+		var exists bool
+		for i := 0; i < len(keys) && !exists; i++ {
+			exists = keys[i] == k
+		}
+		if !exists {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
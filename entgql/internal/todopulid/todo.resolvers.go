@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"entgo.io/contrib/entgql/internal/todopulid/ent"
+	"entgo.io/contrib/entgql/internal/todopulid/ent/schema/pulid"
 	"entgo.io/contrib/entgql/internal/todopulid/ent/todo"
 )
 
@@ -37,6 +38,17 @@ func (r *mutationResolver) CreateTodo(ctx context.Context, input ent.CreateTodoI
 		Save(ctx)
 }
 
+func (r *mutationResolver) UpdateTodo(ctx context.Context, id pulid.ID, input ent.UpdateTodoInput) (*ent.UpdateTodoPayload, error) {
+	update := ent.FromContext(ctx).Todo.
+		UpdateOneID(id).
+		SetInput(input)
+	node, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ent.NewUpdateTodoPayload(node, update.Mutation()), nil
+}
+
 func (r *mutationResolver) ClearTodos(ctx context.Context) (int, error) {
 	client := ent.FromContext(ctx)
 	return client.Todo.
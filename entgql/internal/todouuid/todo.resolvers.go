@@ -24,6 +24,7 @@ import (
 
 	"entgo.io/contrib/entgql/internal/todouuid/ent"
 	"entgo.io/contrib/entgql/internal/todouuid/ent/todo"
+	"github.com/google/uuid"
 )
 
 func (r *mutationResolver) CreateCategory(ctx context.Context, input ent.CreateCategoryInput) (*ent.Category, error) {
@@ -37,6 +38,17 @@ func (r *mutationResolver) CreateTodo(ctx context.Context, input ent.CreateTodoI
 		Save(ctx)
 }
 
+func (r *mutationResolver) UpdateTodo(ctx context.Context, id uuid.UUID, input ent.UpdateTodoInput) (*ent.UpdateTodoPayload, error) {
+	update := ent.FromContext(ctx).Todo.
+		UpdateOneID(id).
+		SetInput(input)
+	node, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ent.NewUpdateTodoPayload(node, update.Mutation()), nil
+}
+
 func (r *mutationResolver) ClearTodos(ctx context.Context) (int, error) {
 	client := ent.FromContext(ctx)
 	return client.Todo.
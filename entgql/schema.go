@@ -247,6 +247,12 @@ func (e *schemaGenerator) buildTypes(g *gen.Graph, s *ast.Schema) error {
 			if len(defs) > 0 {
 				s.AddTypes(defs...)
 			}
+			if defs, err = e.buildMutationPayloads(node, ant, gqlType); err != nil {
+				return err
+			}
+			if len(defs) > 0 {
+				s.AddTypes(defs...)
+			}
 		}
 	}
 
@@ -628,6 +634,52 @@ func (e *schemaGenerator) buildMutationInputs(t *gen.Type, ant *Annotation, gqlT
 	return defs, nil
 }
 
+// buildMutationPayloads returns the Create<T>Payload and Update<T>Payload types of the given schema type.
+func (e *schemaGenerator) buildMutationPayloads(t *gen.Type, ant *Annotation, gqlType string) ([]*ast.Definition, error) {
+	if ant.MutationPayload == nil || ant.Skip.Is(SkipType) {
+		return nil, nil
+	}
+	var defs []*ast.Definition
+	for _, i := range ant.MutationInputs {
+		if (i.IsCreate && ant.Skip.Is(SkipMutationCreateInput)) ||
+			(!i.IsCreate && ant.Skip.Is(SkipMutationUpdateInput)) {
+			continue
+		}
+		desc := MutationDescriptor{Type: t, IsCreate: i.IsCreate}
+		name, err := desc.Payload()
+		if err != nil {
+			return nil, err
+		}
+		def := &ast.Definition{
+			Name: name,
+			Kind: ast.Object,
+			Fields: ast.FieldList{
+				{
+					Name: camel(gqlType),
+					Type: namedType(gqlType, false),
+				},
+				{
+					Name:        "affectedIDs",
+					Type:        listNamedType("ID", false),
+					Description: "The IDs of the nodes affected by the mutation.",
+				},
+				{
+					Name:        "invalidatedConnections",
+					Type:        listNamedType("String", false),
+					Description: "The keys of the connections invalidated by the mutation.",
+				},
+			},
+		}
+		if i.IsCreate {
+			def.Description = fmt.Sprintf("%s is returned by mutations creating %s objects.\nPayload was generated by ent.", name, gqlType)
+		} else {
+			def.Description = fmt.Sprintf("%s is returned by mutations updating %s objects.\nPayload was generated by ent.", name, gqlType)
+		}
+		defs = append(defs, def)
+	}
+	return defs, nil
+}
+
 func (e *schemaGenerator) fieldDefinitions(gqlType string, f *gen.Field, ant *Annotation) ([]*ast.FieldDefinition, error) {
 	ft, err := e.typeFromField(gqlType, f, ant)
 	if err != nil {
@@ -100,6 +100,17 @@ input CreateTodoInput {
   secretID: ID
 }
 """
+CreateTodoPayload is returned by mutations creating Todo objects.
+Payload was generated by ent.
+"""
+type CreateTodoPayload {
+  todo: Todo!
+  """The IDs of the nodes affected by the mutation."""
+  affectedIDs: [ID!]!
+  """The keys of the connections invalidated by the mutation."""
+  invalidatedConnections: [String!]!
+}
+"""
 CreateUserInput is used for create User object.
 Input was generated by ent.
 """
@@ -207,6 +218,17 @@ input UpdateTodoInput {
   secretID: ID
 }
 """
+UpdateTodoPayload is returned by mutations updating Todo objects.
+Payload was generated by ent.
+"""
+type UpdateTodoPayload {
+  todo: Todo!
+  """The IDs of the nodes affected by the mutation."""
+  affectedIDs: [ID!]!
+  """The keys of the connections invalidated by the mutation."""
+  invalidatedConnections: [String!]!
+}
+"""
 UpdateUserInput is used for update User object.
 Input was generated by ent.
 """
@@ -450,6 +472,17 @@ input CreateTodoInput {
   secretID: ID
 }
 """
+CreateTodoPayload is returned by mutations creating Todo objects.
+Payload was generated by ent.
+"""
+type CreateTodoPayload {
+  todo: Todo!
+  """The IDs of the nodes affected by the mutation."""
+  affectedIDs: [ID!]!
+  """The keys of the connections invalidated by the mutation."""
+  invalidatedConnections: [String!]!
+}
+"""
 CreateUserInput is used for create User object.
 Input was generated by ent.
 """
@@ -829,6 +862,17 @@ input UpdateTodoInput {
   secretID: ID
 }
 """
+UpdateTodoPayload is returned by mutations updating Todo objects.
+Payload was generated by ent.
+"""
+type UpdateTodoPayload {
+  todo: Todo!
+  """The IDs of the nodes affected by the mutation."""
+  affectedIDs: [ID!]!
+  """The keys of the connections invalidated by the mutation."""
+  invalidatedConnections: [String!]!
+}
+"""
 UpdateUserInput is used for update User object.
 Input was generated by ent.
 """
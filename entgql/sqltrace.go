@@ -0,0 +1,296 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	// SQLTraceHeader is the default request header enabling the SQL tracing of an operation.
+	SQLTraceHeader = "X-Ent-Debug"
+	// SQLTraceExtension is the key of the SQL trace in the response extensions.
+	SQLTraceExtension = "entSQLTrace"
+)
+
+// SQLTracer is a debug extension recording the SQL statements executed by ent during GraphQL operations,
+// and attaching their summary to the response extensions. Operations are traced only if their request
+// carries the tracing header, and the statements are recorded only if the ent client was opened with a
+// driver wrapped by TraceDriver. For example:
+//
+//	client := ent.NewClient(ent.Driver(entgql.TraceDriver(drv)))
+//	srv := handler.NewDefaultServer(NewSchema(client))
+//	srv.Use(entgql.SQLTracer{})
+//
+// The summary holds the statements without their arguments, grouped by their text, which makes repeated
+// statements (e.g. N+1 queries) stand out. Like any debug facility, it should not be exposed in production.
+type SQLTracer struct {
+	// Header is the request header enabling the tracing. Defaults to SQLTraceHeader.
+	Header string
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationParameterMutator
+	graphql.ResponseInterceptor
+} = SQLTracer{}
+
+// ExtensionName returns the extension name.
+func (SQLTracer) ExtensionName() string {
+	return "EntGQLSQLTracer"
+}
+
+// Validate is called when adding an extension to the server, it allows validation against the servers schema.
+func (SQLTracer) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationParameters enables the tracing of operations whose request carries the tracing header.
+func (t SQLTracer) MutateOperationParameters(ctx context.Context, params *graphql.RawParams) *gqlerror.Error {
+	header := t.Header
+	if header == "" {
+		header = SQLTraceHeader
+	}
+	if params.Headers.Get(header) != "" {
+		graphql.GetOperationContext(ctx).Stats.SetExtension(SQLTraceExtension, &sqlTrace{})
+	}
+	return nil
+}
+
+// InterceptResponse records the statements executed while resolving traced operations,
+// and attaches their summary to the response extensions.
+func (SQLTracer) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+	tr, ok := graphql.GetOperationContext(ctx).Stats.GetExtension(SQLTraceExtension).(*sqlTrace)
+	if !ok {
+		return next(ctx)
+	}
+	rsp := next(context.WithValue(ctx, sqlTraceKey{}, tr))
+	if rsp == nil {
+		return nil
+	}
+	if rsp.Extensions == nil {
+		rsp.Extensions = make(map[string]interface{})
+	}
+	rsp.Extensions[SQLTraceExtension] = tr.summary()
+	return rsp
+}
+
+type (
+	// SQLTraceSummary is the summary of the statements executed during a traced operation.
+	SQLTraceSummary struct {
+		// Count is the number of executed statements.
+		Count int `json:"count"`
+		// Duration is the total execution time of the statements.
+		Duration string `json:"duration"`
+		// Statements holds the executed statements, grouped by their text,
+		// in the order of their first execution.
+		Statements []*SQLStatementSummary `json:"statements"`
+	}
+
+	// SQLStatementSummary is the summary of the executions of a statement.
+	SQLStatementSummary struct {
+		// Query is the text of the statement, without its arguments.
+		Query string `json:"query"`
+		// Count is the number of times the statement was executed.
+		Count int `json:"count"`
+		// Duration is the total execution time of the statement.
+		Duration string `json:"duration"`
+		// Errors is the number of executions that failed.
+		Errors int `json:"errors,omitempty"`
+	}
+
+	sqlTraceKey struct{}
+
+	// sqlTrace records the statements executed during a traced operation.
+	sqlTrace struct {
+		mu         sync.Mutex
+		statements []*sqlStatement
+		index      map[string]*sqlStatement
+	}
+
+	sqlStatement struct {
+		query    string
+		count    int
+		errors   int
+		duration time.Duration
+	}
+)
+
+func (t *sqlTrace) record(query string, d time.Duration, err error) {
+	query = strings.Join(strings.Fields(query), " ")
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.index == nil {
+		t.index = make(map[string]*sqlStatement)
+	}
+	s, ok := t.index[query]
+	if !ok {
+		s = &sqlStatement{query: query}
+		t.index[query] = s
+		t.statements = append(t.statements, s)
+	}
+	s.count++
+	s.duration += d
+	if err != nil {
+		s.errors++
+	}
+}
+
+func (t *sqlTrace) summary() *SQLTraceSummary {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var total time.Duration
+	sum := &SQLTraceSummary{Statements: make([]*SQLStatementSummary, 0, len(t.statements))}
+	for _, s := range t.statements {
+		sum.Count += s.count
+		total += s.duration
+		sum.Statements = append(sum.Statements, &SQLStatementSummary{
+			Query:    s.query,
+			Count:    s.count,
+			Duration: s.duration.String(),
+			Errors:   s.errors,
+		})
+	}
+	sum.Duration = total.String()
+	return sum
+}
+
+// traceStatement records the statement in the trace of the operation, if it is traced.
+// It is deferred with the start time of the statement.
+func traceStatement(ctx context.Context, query string, start time.Time, err *error) {
+	if tr, ok := ctx.Value(sqlTraceKey{}).(*sqlTrace); ok {
+		tr.record(query, time.Since(start), *err)
+	}
+}
+
+// TraceDriver wraps the given driver to record the statements it executes
+// during the operations traced by the SQLTracer extension.
+func TraceDriver(drv dialect.Driver) dialect.Driver {
+	return &traceDriver{drv}
+}
+
+type (
+	traceDriver struct{ dialect.Driver }
+	traceTx     struct{ dialect.Tx }
+)
+
+// Exec records the statement and calls the underlying driver Exec method.
+func (d *traceDriver) Exec(ctx context.Context, query string, args, v any) (err error) {
+	defer traceStatement(ctx, query, time.Now(), &err)
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+// ExecContext records the statement and calls the underlying driver ExecContext method if it is supported.
+func (d *traceDriver) ExecContext(ctx context.Context, query string, args ...any) (_ sql.Result, err error) {
+	drv, ok := d.Driver.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, errors.New("entgql: Driver.ExecContext is not supported")
+	}
+	defer traceStatement(ctx, query, time.Now(), &err)
+	return drv.ExecContext(ctx, query, args...)
+}
+
+// Query records the statement and calls the underlying driver Query method.
+func (d *traceDriver) Query(ctx context.Context, query string, args, v any) (err error) {
+	defer traceStatement(ctx, query, time.Now(), &err)
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+// QueryContext records the statement and calls the underlying driver QueryContext method if it is supported.
+func (d *traceDriver) QueryContext(ctx context.Context, query string, args ...any) (_ *sql.Rows, err error) {
+	drv, ok := d.Driver.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, errors.New("entgql: Driver.QueryContext is not supported")
+	}
+	defer traceStatement(ctx, query, time.Now(), &err)
+	return drv.QueryContext(ctx, query, args...)
+}
+
+// Tx calls the underlying driver Tx method, and records the statements executed by the transaction.
+func (d *traceDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &traceTx{tx}, nil
+}
+
+// BeginTx calls the underlying driver BeginTx method if it is supported,
+// and records the statements executed by the transaction.
+func (d *traceDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, errors.New("entgql: Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &traceTx{tx}, nil
+}
+
+// Exec records the statement and calls the underlying transaction Exec method.
+func (t *traceTx) Exec(ctx context.Context, query string, args, v any) (err error) {
+	defer traceStatement(ctx, query, time.Now(), &err)
+	return t.Tx.Exec(ctx, query, args, v)
+}
+
+// ExecContext records the statement and calls the underlying transaction ExecContext method if it is supported.
+func (t *traceTx) ExecContext(ctx context.Context, query string, args ...any) (_ sql.Result, err error) {
+	tx, ok := t.Tx.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, errors.New("entgql: Tx.ExecContext is not supported")
+	}
+	defer traceStatement(ctx, query, time.Now(), &err)
+	return tx.ExecContext(ctx, query, args...)
+}
+
+// Query records the statement and calls the underlying transaction Query method.
+func (t *traceTx) Query(ctx context.Context, query string, args, v any) (err error) {
+	defer traceStatement(ctx, query, time.Now(), &err)
+	return t.Tx.Query(ctx, query, args, v)
+}
+
+// QueryContext records the statement and calls the underlying transaction QueryContext method if it is supported.
+func (t *traceTx) QueryContext(ctx context.Context, query string, args ...any) (_ *sql.Rows, err error) {
+	tx, ok := t.Tx.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, errors.New("entgql: Tx.QueryContext is not supported")
+	}
+	defer traceStatement(ctx, query, time.Now(), &err)
+	return tx.QueryContext(ctx, query, args...)
+}
@@ -62,6 +62,10 @@ var (
 	// MutationInputTemplate adds a template for generating Create<T>Input and Update<T>Input for each schema type.
 	MutationInputTemplate = parseT("template/mutation_input.tmpl").SkipIf(skipMutationTemplate)
 
+	// MutationPayloadTemplate adds a template for generating Create<T>Payload and Update<T>Payload for
+	// each schema type annotated with MutationPayloads.
+	MutationPayloadTemplate = parseT("template/mutation_payload.tmpl").SkipIf(skipMutationPayloadTemplate)
+
 	// AllTemplates holds all templates for extending ent to support GraphQL.
 	AllTemplates = []*gen.Template{
 		CollectionTemplate,
@@ -71,6 +75,7 @@ var (
 		TransactionTemplate,
 		EdgeTemplate,
 		MutationInputTemplate,
+		MutationPayloadTemplate,
 	}
 
 	// TemplateFuncs contains the extra template functions used by entgql.
@@ -285,6 +290,102 @@ func (m *MutationDescriptor) skip(immutable bool, skip SkipMode) bool {
 	return immutable || skip.Is(SkipMutationUpdateInput)
 }
 
+// Payload returns the payload's name.
+func (m *MutationDescriptor) Payload() (string, error) {
+	gqlType, _, err := gqlTypeFromNode(m.Type)
+	if err != nil {
+		return "", err
+	}
+	if m.IsCreate {
+		return fmt.Sprintf("Create%sPayload", gqlType), nil
+	}
+	return fmt.Sprintf("Update%sPayload", gqlType), nil
+}
+
+// HasPayload reports if a payload type should be generated for the mutation.
+func (m *MutationDescriptor) HasPayload() (bool, error) {
+	ant, err := annotation(m.Type.Annotations)
+	if err != nil {
+		return false, err
+	}
+	return ant.MutationPayload != nil && !ant.Skip.Is(SkipType), nil
+}
+
+// PayloadConnections returns the keys of the connections invalidated by all mutations of the type.
+func (m *MutationDescriptor) PayloadConnections() ([]string, error) {
+	ant, err := annotation(m.Type.Annotations)
+	if err != nil || ant.MutationPayload == nil {
+		return nil, err
+	}
+	return ant.MutationPayload.InvalidateConnections, nil
+}
+
+// PayloadEdgeDescriptor holds the information about
+// an edge whose changes are reported in the payload.
+type PayloadEdgeDescriptor struct {
+	*gen.Edge
+	// Connections holds the keys of the connections
+	// invalidated when the edge is changed.
+	Connections []string
+}
+
+// PayloadEdges returns the list of edges whose changes are reported in the payload type.
+func (m *MutationDescriptor) PayloadEdges() ([]*PayloadEdgeDescriptor, error) {
+	edges := make([]*PayloadEdgeDescriptor, 0, len(m.Type.Edges))
+	for _, e := range m.Type.Edges {
+		if e.Type.IsEdgeSchema() || e.Type.HasCompositeID() {
+			continue
+		}
+		_, ant, err := gqlTypeFromNode(e.Type)
+		if err != nil {
+			return nil, err
+		}
+		if ant.Skip.Is(SkipType) {
+			continue
+		}
+		d := &PayloadEdgeDescriptor{Edge: e}
+		// Adding or removing items from the edge invalidates its own list.
+		if !m.IsCreate && !e.Unique {
+			keys, err := edgeConnections(m.Type, e)
+			if err != nil {
+				return nil, err
+			}
+			d.Connections = append(d.Connections, keys...)
+		}
+		// Changing the edge invalidates the list holding the other side of the edge.
+		if e.Ref != nil && !e.Ref.Unique {
+			keys, err := edgeConnections(e.Type, e.Ref)
+			if err != nil {
+				return nil, err
+			}
+			d.Connections = append(d.Connections, keys...)
+		}
+		edges = append(edges, d)
+	}
+	return edges, nil
+}
+
+// edgeConnections returns the keys of the GraphQL fields of the given edge of t.
+func edgeConnections(t *gen.Type, e *gen.Edge) ([]string, error) {
+	gqlType, _, err := gqlTypeFromNode(t)
+	if err != nil {
+		return nil, err
+	}
+	ant, err := annotation(e.Annotations)
+	if err != nil || ant.Skip.Is(SkipType) {
+		return nil, err
+	}
+	names := []string{camel(e.Name)}
+	if len(ant.Mapping) > 0 {
+		names = ant.Mapping
+	}
+	keys := make([]string, len(names))
+	for i, name := range names {
+		keys[i] = gqlType + "." + name
+	}
+	return keys, nil
+}
+
 // mutationInputs returns the list of input types for the mutation.
 func mutationInputs(nodes []*gen.Type) ([]*MutationDescriptor, error) {
 	filteredNodes := make([]*MutationDescriptor, 0, len(nodes))
@@ -648,3 +749,16 @@ func skipMutationTemplate(g *gen.Graph) bool {
 	}
 	return true
 }
+
+func skipMutationPayloadTemplate(g *gen.Graph) bool {
+	nodes, err := mutationInputs(g.Nodes)
+	if err != nil {
+		return true
+	}
+	for _, n := range nodes {
+		if ok, err := n.HasPayload(); err == nil && ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,220 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entoas
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ogen-go/ogen"
+)
+
+// CurlExamplesFileName is the name of the companion document holding the curl examples.
+// It is written next to the openapi.json file unless a writer is configured.
+const CurlExamplesFileName = "openapi.curl.md"
+
+// curlExamples renders a markdown document holding a curl example for every operation of the given spec.
+//
+// The examples are built out of the spec: path and required query or header parameters are filled with
+// their example values, and request bodies consist of the required properties and the ones having an
+// example. Example values are defined on fields using the Example annotation. Missing ones are replaced
+// by placeholders matching the schema type.
+func curlExamples(spec *ogen.Spec, baseURL string) ([]byte, error) {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	ps := make([]string, 0, len(spec.Paths))
+	for p := range spec.Paths {
+		ps = append(ps, p)
+	}
+	sort.Strings(ps)
+	var b bytes.Buffer
+	if spec.Info.Title != "" {
+		fmt.Fprintf(&b, "# %s\n", spec.Info.Title)
+	}
+	for _, p := range ps {
+		pi := spec.Paths[p]
+		for _, mo := range []struct {
+			method string
+			op     *ogen.Operation
+		}{
+			{"GET", pi.Get},
+			{"POST", pi.Post},
+			{"PUT", pi.Put},
+			{"PATCH", pi.Patch},
+			{"DELETE", pi.Delete},
+		} {
+			if mo.op == nil {
+				continue
+			}
+			params := append(append([]*ogen.Parameter(nil), pi.Parameters...), mo.op.Parameters...)
+			cmd, err := curlCommand(spec, baseURL, mo.method, p, params, mo.op.RequestBody)
+			if err != nil {
+				return nil, fmt.Errorf("curl example for %s %s: %w", mo.method, p, err)
+			}
+			title := mo.op.OperationID
+			if title == "" {
+				title = mo.method + " " + p
+			}
+			fmt.Fprintf(&b, "\n## %s\n\n", title)
+			if mo.op.Summary != "" {
+				fmt.Fprintf(&b, "%s\n\n", mo.op.Summary)
+			}
+			fmt.Fprintf(&b, "```shell\n%s\n```\n", cmd)
+		}
+	}
+	return b.Bytes(), nil
+}
+
+// curlCommand renders the curl command for a single operation.
+func curlCommand(spec *ogen.Spec, baseURL, method, path string, params []*ogen.Parameter, body *ogen.RequestBody) (string, error) {
+	var (
+		query   []string
+		headers []string
+	)
+	for _, p := range params {
+		v, err := exampleValue(spec, &p.Schema)
+		if err != nil {
+			return "", err
+		}
+		switch p.In {
+		case "path":
+			path = strings.ReplaceAll(path, "{"+p.Name+"}", paramValue(v))
+		case "query":
+			if p.Required {
+				query = append(query, p.Name+"="+paramValue(v))
+			}
+		case "header":
+			if p.Required {
+				headers = append(headers, p.Name+": "+paramValue(v))
+			}
+		}
+	}
+	u := baseURL + path
+	if len(query) > 0 {
+		u += "?" + strings.Join(query, "&")
+	}
+	lines := []string{fmt.Sprintf("curl -X %s %s", method, shellQuote(u))}
+	for _, h := range headers {
+		lines = append(lines, "-H "+shellQuote(h))
+	}
+	if body != nil {
+		if m, ok := body.Content["application/json"]; ok {
+			v, err := exampleValue(spec, &m.Schema)
+			if err != nil {
+				return "", err
+			}
+			var d bytes.Buffer
+			if err := json.Indent(&d, v, "  ", "  "); err != nil {
+				return "", err
+			}
+			lines = append(lines, "-H 'Content-Type: application/json'", "-d "+shellQuote(d.String()))
+		}
+	}
+	return strings.Join(lines, " \\\n  "), nil
+}
+
+// exampleValue returns the JSON encoded example value for the given schema.
+func exampleValue(spec *ogen.Spec, s *ogen.Schema) (json.RawMessage, error) {
+	switch {
+	case s.Example != nil:
+		return s.Example, nil
+	case s.Default != nil:
+		return s.Default, nil
+	case len(s.Enum) > 0:
+		return s.Enum[0], nil
+	case s.Ref != "":
+		n := strings.TrimPrefix(s.Ref, "#/components/schemas/")
+		rs, ok := spec.Components.Schemas[n]
+		if !ok {
+			return nil, fmt.Errorf("schema %q not found", s.Ref)
+		}
+		return exampleValue(spec, rs)
+	}
+	switch s.Type {
+	case "array":
+		if s.Items == nil {
+			return json.RawMessage("[]"), nil
+		}
+		v, err := exampleValue(spec, s.Items)
+		if err != nil {
+			return nil, err
+		}
+		return json.RawMessage("[" + string(v) + "]"), nil
+	case "object", "":
+		// Keep the order of the properties and only render required ones and the ones having an example.
+		var b bytes.Buffer
+		b.WriteByte('{')
+		for _, p := range s.Properties {
+			if p.Schema.Example == nil && !isRequired(s, p.Name) {
+				continue
+			}
+			v, err := exampleValue(spec, p.Schema)
+			if err != nil {
+				return nil, err
+			}
+			k, err := json.Marshal(p.Name)
+			if err != nil {
+				return nil, err
+			}
+			if b.Len() > 1 {
+				b.WriteByte(',')
+			}
+			b.Write(k)
+			b.WriteByte(':')
+			b.Write(v)
+		}
+		b.WriteByte('}')
+		return b.Bytes(), nil
+	case "integer", "number":
+		return json.RawMessage("1"), nil
+	case "boolean":
+		return json.RawMessage("true"), nil
+	}
+	switch s.Format {
+	case "date-time":
+		return json.RawMessage(`"2006-01-02T15:04:05Z"`), nil
+	case "uuid":
+		return json.RawMessage(`"00000000-0000-0000-0000-000000000000"`), nil
+	case "byte":
+		return json.RawMessage(`""`), nil
+	}
+	return json.RawMessage(`"string"`), nil
+}
+
+// isRequired reports whether the property with the given name is required by the schema.
+func isRequired(s *ogen.Schema, n string) bool {
+	for _, r := range s.Required {
+		if r == n {
+			return true
+		}
+	}
+	return false
+}
+
+// paramValue renders a JSON encoded example value as used in paths, query strings or headers.
+func paramValue(v json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(v, &s); err == nil {
+		return s
+	}
+	return string(v)
+}
+
+// shellQuote wraps the given string in single quotes for its use in a shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
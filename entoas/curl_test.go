@@ -0,0 +1,65 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entoas
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurlExamples(t *testing.T) {
+	t.Parallel()
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	g, err := entc.LoadGraph(filepath.Join(wd, "internal", "simple", "schema"), &gen.Config{
+		Annotations: gen.Annotations{(&Config{}).Name(): &Config{DefaultPolicy: PolicyExpose, Examples: true}},
+	})
+	require.NoError(t, err)
+	spec := ogen.NewSpec().SetInfo(ogen.NewInfo().SetTitle("Pets API"))
+	require.NoError(t, generate(g, spec))
+	b, err := curlExamples(spec, "http://localhost:8080/")
+	require.NoError(t, err)
+	doc := string(b)
+	require.Contains(t, doc, "# Pets API\n")
+	// Request bodies hold the example values and placeholders for the required properties without one.
+	require.Contains(t, doc, "## createPet\n\nCreate a new Pet\n\n```shell\n"+
+		"curl -X POST 'http://localhost:8080/pets' \\\n"+
+		"  -H 'Content-Type: application/json' \\\n"+
+		"  -d '{\n    \"name\": \"Kuro\",\n    \"age\": 1\n  }'\n```\n")
+	require.Contains(t, doc, "## createCategory\n\nCreate a new Category\n\n```shell\n"+
+		"curl -X POST 'http://localhost:8080/categories' \\\n"+
+		"  -H 'Content-Type: application/json' \\\n"+
+		"  -d '{\n    \"name\": \"string\"\n  }'\n```\n")
+	// Path parameters are filled in.
+	require.Contains(t, doc, "curl -X DELETE 'http://localhost:8080/pets/1'\n")
+	require.Contains(t, doc, "curl -X GET 'http://localhost:8080/pets/1/owner'\n")
+	// The spec itself documents the example values, unless the examples are disabled.
+	require.JSONEq(t, `"Kuro"`, string(spec.Components.Schemas["Pet"].Properties[1].Schema.Example))
+	g.Annotations[(&Config{}).Name()] = &Config{DefaultPolicy: PolicyExpose}
+	spec = ogen.NewSpec()
+	require.NoError(t, generate(g, spec))
+	require.Nil(t, spec.Components.Schemas["Pet"].Properties[1].Schema.Example)
+}
+
+func TestShellQuote(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, `'{"name":"Kuro'\''s"}'`, shellQuote(`{"name":"Kuro's"}`))
+}
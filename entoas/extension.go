@@ -21,6 +21,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"entgo.io/contrib/genmanifest"
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
 	"github.com/ogen-go/ogen"
@@ -43,6 +44,21 @@ type (
 		// By enabling she SimpleModels configuration the generator simply adds the defined schemas with all fields and edges.
 		// Serialization groups have no effects in this mode.
 		SimpleModels bool
+		// Whether or whether not to document a "fields" query parameter on read and list operations.
+		//
+		// The parameter accepts a comma separated list of field names and allows clients to request sparse
+		// fieldsets. Handler generators can use SelectableFields and EdgeSelectableFields to map the requested
+		// names onto a Select() call.
+		FieldSelection bool
+		// Whether or whether not to document an "Idempotency-Key" header on create operations.
+		//
+		// A request carrying a key creates at most one entity. Retrying it returns the entity created with the key,
+		// whatever the payload of the retry.
+		IdempotencyKey bool
+		// Whether or whether not to attach the values of the Example annotations to the schemas of the fields.
+		//
+		// It is enabled by CurlExamples and CurlExamplesWriteTo, whose requests are filled with these values.
+		Examples bool
 	}
 	// Extension implements entc.Extension interface for providing OpenAPI Specification generation.
 	Extension struct {
@@ -51,6 +67,13 @@ type (
 		mutations []MutateFunc
 		out       io.Writer
 		spec      *ogen.Spec
+		curl      *curlConfig
+		manifest  bool
+	}
+	// curlConfig configures the generation of the curl examples document.
+	curlConfig struct {
+		baseURL string
+		out     io.Writer
 	}
 	// ExtensionOption allows managing Extension configuration using functional arguments.
 	ExtensionOption func(*Extension) error
@@ -108,6 +131,25 @@ func SimpleModels() ExtensionOption {
 	}
 }
 
+// FieldSelection adds the "fields" query parameter to the read and list operations, allowing clients to
+// select the fields rendered in the response from a comma separated list.
+func FieldSelection() ExtensionOption {
+	return func(ex *Extension) error {
+		ex.config.FieldSelection = true
+		return nil
+	}
+}
+
+// IdempotencyKey documents the optional Idempotency-Key header of the create operations. A retry carrying the key
+// of a created entity returns it rather than creating another one, as the services generated by entproto do when
+// the header is forwarded to them as the x-idempotency-key gRPC metadata.
+func IdempotencyKey() ExtensionOption {
+	return func(ex *Extension) error {
+		ex.config.IdempotencyKey = true
+		return nil
+	}
+}
+
 // WriteTo writes the current specs content to the given io.Writer.
 func WriteTo(out io.Writer) ExtensionOption {
 	return func(ex *Extension) error {
@@ -116,6 +158,44 @@ func WriteTo(out io.Writer) ExtensionOption {
 	}
 }
 
+// CurlExamples enables the generation of a companion document holding a curl example for every operation
+// of the generated spec, sent to the given base URL. The document is written to CurlExamplesFileName next
+// to the spec, unless CurlExamplesWriteTo is used.
+//
+// Request bodies and parameters are filled with the values given by the Example annotation.
+func CurlExamples(baseURL string) ExtensionOption {
+	return func(ex *Extension) error {
+		if ex.curl == nil {
+			ex.curl = &curlConfig{}
+		}
+		ex.curl.baseURL = baseURL
+		ex.config.Examples = true
+		return nil
+	}
+}
+
+// CurlExamplesWriteTo writes the curl examples document to the given io.Writer. It enables the generation
+// of the document if CurlExamples was not given.
+func CurlExamplesWriteTo(out io.Writer) ExtensionOption {
+	return func(ex *Extension) error {
+		if ex.curl == nil {
+			ex.curl = &curlConfig{}
+		}
+		ex.curl.out = out
+		ex.config.Examples = true
+		return nil
+	}
+}
+
+// Manifest records the generated spec and curl examples files in the manifest of the ent target directory.
+// See the genmanifest package for detecting stale generated code.
+func Manifest() ExtensionOption {
+	return func(ex *Extension) error {
+		ex.manifest = true
+		return nil
+	}
+}
+
 // Spec allows to configure a pointer to an existing ogen.Spec where the code generator writes the final result to.
 // Any configured Mutations are run before the spec is written.
 func Spec(spec *ogen.Spec) ExtensionOption {
@@ -162,12 +242,38 @@ func (ex *Extension) generate(next gen.Generator) gen.Generator {
 		if err != nil {
 			return err
 		}
+		// Files written to the target directory.
+		var files []string
 		// If a writer is given write the dumped spec into it.
 		if ex.out != nil {
 			_, err = ex.out.Write(b)
+		} else {
+			files = append(files, filepath.Join(g.Target, "openapi.json"))
+			err = os.WriteFile(files[len(files)-1], b, 0644)
+		}
+		if err != nil {
 			return err
 		}
-		return os.WriteFile(filepath.Join(g.Target, "openapi.json"), b, 0644)
+		if ex.curl != nil {
+			// Render the curl examples for the final spec.
+			b, err = curlExamples(spec, ex.curl.baseURL)
+			if err != nil {
+				return err
+			}
+			if ex.curl.out != nil {
+				_, err = ex.curl.out.Write(b)
+			} else {
+				files = append(files, filepath.Join(g.Target, CurlExamplesFileName))
+				err = os.WriteFile(files[len(files)-1], b, 0644)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if ex.manifest {
+			return genmanifest.Record(g, "entoas", files...)
+		}
+		return nil
 	})
 }
 
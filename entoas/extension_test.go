@@ -40,4 +40,8 @@ func TestExtension(t *testing.T) {
 	require.Equal(t, ex.config.DefaultPolicy, PolicyExpose)
 	require.Len(t, ex.mutations, 1)
 	require.Equal(t, ex.out, os.Stdout)
+	require.Nil(t, ex.curl)
+	ex, err = NewExtension(CurlExamples("http://localhost:8080"), CurlExamplesWriteTo(os.Stdout))
+	require.NoError(t, err)
+	require.Equal(t, &curlConfig{baseURL: "http://localhost:8080", out: os.Stdout}, ex.curl)
 }
@@ -53,10 +53,14 @@ func generate(g *gen.Graph, spec *ogen.Spec) error {
 
 // schemas adds schemas for every node to the spec.
 func schemas(g *gen.Graph, spec *ogen.Spec) error {
+	cfg, err := GetConfig(g.Config)
+	if err != nil {
+		return err
+	}
 	// Loop over every defined node and add it to the spec.
 	for _, n := range g.Nodes {
 		s := ogen.NewSchema()
-		if err := addSchemaFields(s, append([]*gen.Field{n.ID}, n.Fields...)); err != nil {
+		if err := addSchemaFields(s, append([]*gen.Field{n.ID}, n.Fields...), cfg.Examples); err != nil {
 			return err
 		}
 		spec.AddSchema(n.Name, s)
@@ -80,10 +84,6 @@ func schemas(g *gen.Graph, spec *ogen.Spec) error {
 		}
 	}
 	// If the SimpleModels feature is enabled to not generate a schema per response.
-	cfg, err := GetConfig(g.Config)
-	if err != nil {
-		return err
-	}
 	if !cfg.SimpleModels {
 		// Add all the views for the paths to the schemas.
 		vs, err := Views(g)
@@ -92,7 +92,7 @@ func schemas(g *gen.Graph, spec *ogen.Spec) error {
 		}
 		for n, v := range vs {
 			s := ogen.NewSchema()
-			if err := addSchemaFields(s, v.Fields); err != nil {
+			if err := addSchemaFields(s, v.Fields, cfg.Examples); err != nil {
 				return err
 			}
 			spec.AddSchema(n, s)
@@ -123,8 +123,8 @@ func schemas(g *gen.Graph, spec *ogen.Spec) error {
 	return nil
 }
 
-// addSchemaFields adds the given gen.Field slice to the ogen.Schema.
-func addSchemaFields(s *ogen.Schema, fs []*gen.Field) error {
+// addSchemaFields adds the given gen.Field slice to the ogen.Schema, along with their examples if requested.
+func addSchemaFields(s *ogen.Schema, fs []*gen.Field, examples bool) error {
 	for _, f := range fs {
 		ant, err := FieldAnnotation(f)
 		if err != nil {
@@ -133,7 +133,7 @@ func addSchemaFields(s *ogen.Schema, fs []*gen.Field) error {
 		if ant.Skip {
 			continue
 		}
-		p, err := property(f)
+		p, err := property(f, examples)
 		if err != nil {
 			return err
 		}
@@ -288,6 +288,13 @@ func createOp(spec *ogen.Spec, n *gen.Type) (*ogen.Operation, error) {
 			spec.RefResponse(strconv.Itoa(http.StatusConflict)),
 			spec.RefResponse(strconv.Itoa(http.StatusInternalServerError)),
 		)
+	cfg, err := GetConfig(n.Config)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.IdempotencyKey {
+		op.AddParameters(idempotencyKeyParam())
+	}
 	return op, nil
 }
 
@@ -297,6 +304,10 @@ func readOp(spec *ogen.Spec, n *gen.Type) (*ogen.Operation, error) {
 	if err != nil {
 		return nil, err
 	}
+	fs, err := fieldsParam(n, n.Annotations, OpRead)
+	if err != nil {
+		return nil, err
+	}
 	vn, err := ViewName(n, OpRead)
 	if err != nil {
 		return nil, err
@@ -307,6 +318,7 @@ func readOp(spec *ogen.Spec, n *gen.Type) (*ogen.Operation, error) {
 		AddTags(n.Name).
 		SetOperationID(string(OpRead)+n.Name).
 		AddParameters(id).
+		AddParameters(fs...).
 		AddResponse(
 			strconv.Itoa(http.StatusOK),
 			ogen.NewResponse().
@@ -331,6 +343,10 @@ func readEdgeOp(spec *ogen.Spec, n *gen.Type, e *gen.Edge) (*ogen.Operation, err
 	if err != nil {
 		return nil, err
 	}
+	fs, err := fieldsParam(e.Type, e.Annotations, OpRead)
+	if err != nil {
+		return nil, err
+	}
 	vn, err := EdgeViewName(n, e, OpRead)
 	if err != nil {
 		return nil, err
@@ -341,6 +357,7 @@ func readEdgeOp(spec *ogen.Spec, n *gen.Type, e *gen.Edge) (*ogen.Operation, err
 		AddTags(n.Name).
 		SetOperationID(string(OpRead)+n.Name+strcase.UpperCamelCase(e.Name)).
 		AddParameters(id).
+		AddParameters(fs...).
 		AddResponse(
 			strconv.Itoa(http.StatusOK),
 			ogen.NewResponse().
@@ -424,6 +441,10 @@ func listOp(spec *ogen.Spec, n *gen.Type) (*ogen.Operation, error) {
 	if err != nil {
 		return nil, err
 	}
+	fs, err := fieldsParam(n, n.Annotations, OpList)
+	if err != nil {
+		return nil, err
+	}
 	op := ogen.NewOperation().
 		SetSummary(fmt.Sprintf("List %s", rules.Pluralize(n.Name))).
 		SetDescription(fmt.Sprintf("List %s.", rules.Pluralize(n.Name))).
@@ -441,6 +462,7 @@ func listOp(spec *ogen.Spec, n *gen.Type) (*ogen.Operation, error) {
 				SetDescription("item count to render per page").
 				SetSchema(ogen.Int()),
 		).
+		AddParameters(fs...).
 		AddResponse(
 			strconv.Itoa(http.StatusOK),
 			ogen.NewResponse().
@@ -469,6 +491,10 @@ func listEdgeOp(spec *ogen.Spec, n *gen.Type, e *gen.Edge) (*ogen.Operation, err
 	if err != nil {
 		return nil, err
 	}
+	fs, err := fieldsParam(e.Type, e.Annotations, OpList)
+	if err != nil {
+		return nil, err
+	}
 	op := ogen.NewOperation().
 		SetSummary(fmt.Sprintf("List attached %s", rules.Pluralize(strcase.UpperCamelCase(e.Name)))).
 		SetDescription(fmt.Sprintf("List attached %s.", rules.Pluralize(strcase.UpperCamelCase(e.Name)))).
@@ -487,6 +513,7 @@ func listEdgeOp(spec *ogen.Spec, n *gen.Type, e *gen.Edge) (*ogen.Operation, err
 				SetDescription("item count to render per page").
 				SetSchema(ogen.Int()),
 		).
+		AddParameters(fs...).
 		AddResponse(
 			strconv.Itoa(http.StatusOK),
 			ogen.NewResponse().
@@ -502,12 +529,30 @@ func listEdgeOp(spec *ogen.Spec, n *gen.Type, e *gen.Edge) (*ogen.Operation, err
 	return op, nil
 }
 
-// property creates an ogen.Property out of an ent schema field.
-func property(f *gen.Field) (*ogen.Property, error) {
+// property creates an ogen.Property out of an ent schema field. If examples is set, the user defined example
+// value of the field is attached to its schema.
+func property(f *gen.Field, examples bool) (*ogen.Property, error) {
 	s, err := OgenSchema(f)
 	if err != nil {
 		return nil, err
 	}
+	if !examples {
+		return ogen.NewProperty().SetName(f.Name).SetSchema(s), nil
+	}
+	// Attach the user defined example value to a copy of the schema.
+	ant, err := FieldAnnotation(f)
+	if err != nil {
+		return nil, err
+	}
+	if ant.Example != nil {
+		ex, err := json.Marshal(ant.Example)
+		if err != nil {
+			return nil, err
+		}
+		c := *s
+		c.Example = ex
+		s = &c
+	}
 	return ogen.NewProperty().SetName(f.Name).SetSchema(s), nil
 }
 
@@ -679,6 +724,10 @@ func reqBody(n *gen.Type, op Operation) (*ogen.RequestBody, error) {
 	default:
 		return nil, fmt.Errorf("requestBody: unsupported operation %q", op)
 	}
+	cfg, err := GetConfig(n.Config)
+	if err != nil {
+		return nil, err
+	}
 	c := ogen.NewSchema()
 	for _, f := range n.Fields {
 		a, err := FieldAnnotation(f)
@@ -689,7 +738,7 @@ func reqBody(n *gen.Type, op Operation) (*ogen.RequestBody, error) {
 			continue
 		}
 		if op == OpCreate || !f.Immutable {
-			p, err := property(f)
+			p, err := property(f, cfg.Examples)
 			if err != nil {
 				return nil, err
 			}
@@ -710,21 +759,6 @@ func reqBody(n *gen.Type, op Operation) (*ogen.RequestBody, error) {
 	return req, nil
 }
 
-// // exampleValue returns the user defined example value for the ent schema field.
-// func exampleValue(f *gen.Field) (interface{}, error) {
-// 	a, err := FieldAnnotation(f)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-// 	if a != nil && a.Example != nil {
-// 		return a.Example, err
-// 	}
-// 	if f.IsEnum() {
-// 		return f.EnumValues()[0], nil
-// 	}
-// 	return nil, nil
-// }
-
 // contains checks if a string slice contains the given value.
 func contains(xs []Operation, s Operation) bool {
 	for _, x := range xs {
@@ -735,6 +769,46 @@ func contains(xs []Operation, s Operation) bool {
 	return false
 }
 
+// fieldsParam creates the "fields" query parameter for the given type and operation if field selection is enabled.
+func fieldsParam(n *gen.Type, a gen.Annotations, op Operation) ([]*ogen.Parameter, error) {
+	cfg, err := GetConfig(n.Config)
+	if err != nil {
+		return nil, err
+	}
+	if !cfg.FieldSelection {
+		return nil, nil
+	}
+	fs, err := selectableFields(n, a, op)
+	if err != nil {
+		return nil, err
+	}
+	vs := make([]json.RawMessage, len(fs))
+	for i, f := range fs {
+		vs[i], err = json.Marshal(f.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	p := ogen.NewParameter().
+		InQuery().
+		SetName("fields").
+		SetDescription("comma separated list of fields to render").
+		SetStyle("form").
+		SetExplode(false).
+		SetSchema(ogen.String().AsEnum(nil, vs...).AsArray())
+	return []*ogen.Parameter{p}, nil
+}
+
+// idempotencyKeyParam creates the optional Idempotency-Key header parameter.
+func idempotencyKeyParam() *ogen.Parameter {
+	return ogen.NewParameter().
+		InHeader().
+		SetName("Idempotency-Key").
+		SetDescription("unique key to safely retry the request, " +
+			"a retry with the key of a created entity returns it rather than creating another one").
+		SetSchema(ogen.String())
+}
+
 // pathParam creates a new Parameter in path for the ID of gen.Type.
 func pathParam(n *gen.Type) (*ogen.Parameter, error) {
 	t, err := OgenSchema(n.ID)
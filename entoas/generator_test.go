@@ -20,9 +20,12 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/entc/load"
 	entfield "entgo.io/ent/schema/field"
@@ -141,3 +144,65 @@ func (l Link) Value() (driver.Value, error) {
 	}
 	return l.String(), nil
 }
+
+func TestFieldSelection(t *testing.T) {
+	t.Parallel()
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	g, err := entc.LoadGraph(filepath.Join(wd, "internal", "simple", "schema"), &gen.Config{
+		Annotations: gen.Annotations{(&Config{}).Name(): &Config{DefaultPolicy: PolicyExpose, FieldSelection: true}},
+	})
+	require.NoError(t, err)
+	var p *gen.Type
+	for _, n := range g.Nodes {
+		if n.Name == "Pet" {
+			p = n
+			break
+		}
+	}
+	fs, err := SelectableFields(p, OpRead)
+	require.NoError(t, err)
+	// The ID is not part of the "pet" serialization group.
+	require.Equal(t, p.Fields, fs)
+	// Render the spec and check the parameter is documented on read and list operations only.
+	spec := ogen.NewSpec()
+	require.NoError(t, generate(g, spec))
+	param := func(op *ogen.Operation) *ogen.Parameter {
+		for _, p := range op.Parameters {
+			if p.Name == "fields" {
+				return p
+			}
+		}
+		return nil
+	}
+	pi := spec.Paths["/pets/{id}"]
+	fp := param(pi.Get)
+	require.NotNil(t, fp)
+	require.Equal(t, "query", fp.In)
+	require.Equal(t, "form", fp.Style)
+	require.False(t, *fp.Explode)
+	require.Len(t, fp.Schema.Items.Enum, 3)
+	require.NotNil(t, param(spec.Paths["/pets"].Get))
+	require.NotNil(t, param(spec.Paths["/pets/{id}/owner"].Get))
+	require.Nil(t, param(pi.Patch))
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	t.Parallel()
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	g, err := entc.LoadGraph(filepath.Join(wd, "internal", "simple", "schema"), &gen.Config{
+		Annotations: gen.Annotations{(&Config{}).Name(): &Config{DefaultPolicy: PolicyExpose, IdempotencyKey: true}},
+	})
+	require.NoError(t, err)
+	spec := ogen.NewSpec()
+	require.NoError(t, generate(g, spec))
+	require.NotContains(t, spec.Components.Responses, "422")
+	op := spec.Paths["/pets"].Post
+	require.Len(t, op.Parameters, 1)
+	require.Equal(t, "Idempotency-Key", op.Parameters[0].Name)
+	require.Equal(t, "header", op.Parameters[0].In)
+	require.False(t, op.Parameters[0].Required)
+	require.NotContains(t, op.Responses, "422")
+	require.Empty(t, spec.Paths["/pets/{id}"].Patch.Parameters[1:])
+}
@@ -29,6 +29,8 @@ import (
 func main() {
 	ex, err := entoas.NewExtension(
 		entoas.SimpleModels(),
+		entoas.CurlExamples("http://localhost:8080"),
+		entoas.Manifest(),
 		entoas.Mutations(func(_ *gen.Graph, spec *ogen.Spec) error {
 			spec.Info.SetTitle("My Simple API").
 				SetDescription("API to demonstrate **simple model** generation.").
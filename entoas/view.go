@@ -146,6 +146,41 @@ func view(n *gen.Type, gs serialization.Groups) (*View, error) {
 	return v, nil
 }
 
+// SelectableFields returns the fields of the given gen.Type that can be requested with the "fields" query parameter
+// on the given operation. The value to pass in the query parameter is the fields name.
+func SelectableFields(n *gen.Type, op Operation) ([]*gen.Field, error) {
+	return selectableFields(n, n.Annotations, op)
+}
+
+// EdgeSelectableFields returns the fields of the type the given gen.Edge points to that can be requested with the
+// "fields" query parameter on the given 2nd level operation.
+func EdgeSelectableFields(e *gen.Edge, op Operation) ([]*gen.Field, error) {
+	return selectableFields(e.Type, e.Annotations, op)
+}
+
+// selectableFields returns the fields rendered on the view of the given type and operation.
+func selectableFields(n *gen.Type, a gen.Annotations, op Operation) ([]*gen.Field, error) {
+	gs, err := GroupsForOperation(a, op)
+	if err != nil {
+		return nil, err
+	}
+	v, err := view(n, gs)
+	if err != nil {
+		return nil, err
+	}
+	var fs []*gen.Field
+	for _, f := range v.Fields {
+		ant, err := FieldAnnotation(f)
+		if err != nil {
+			return nil, err
+		}
+		if !ant.Skip {
+			fs = append(fs, f)
+		}
+	}
+	return fs, nil
+}
+
 // serializeField checks if a gen.Field is to be serialized for the requested groups.
 func serializeField(f *gen.Field, g serialization.Groups) (bool, error) {
 	// If the field is sensitive, don't serialize it.
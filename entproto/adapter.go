@@ -19,14 +19,20 @@ import (
 	"fmt"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 
+	"entgo.io/contrib/genmanifest"
 	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/load"
 	"entgo.io/ent/schema/field"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/builder"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/descriptorpb"
 	_ "google.golang.org/protobuf/types/known/emptypb"
+	_ "google.golang.org/protobuf/types/known/fieldmaskpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	_ "google.golang.org/protobuf/types/known/wrapperspb" // needed to load wkt to global proto registry
 )
@@ -43,6 +49,7 @@ var (
 		// TODO: handle more Well-Known proto types
 		"google.protobuf.Timestamp":   "google/protobuf/timestamp.proto",
 		"google.protobuf.Empty":       "google/protobuf/empty.proto",
+		"google.protobuf.FieldMask":   "google/protobuf/field_mask.proto",
 		"google.protobuf.Int32Value":  "google/protobuf/wrappers.proto",
 		"google.protobuf.Int64Value":  "google/protobuf/wrappers.proto",
 		"google.protobuf.UInt32Value": "google/protobuf/wrappers.proto",
@@ -53,15 +60,15 @@ var (
 		"google.protobuf.BoolValue":   "google/protobuf/wrappers.proto",
 		"google.protobuf.BytesValue":  "google/protobuf/wrappers.proto",
 	}
+	// optionsPaths holds the paths of the files defining the options that may be set on service methods.
+	optionsPaths = []string{"google/api/client.proto", openAPIOptionsPath}
 )
 
 // LoadAdapter takes a *gen.Graph and parses it into protobuf file descriptors
 func LoadAdapter(graph *gen.Graph) (*Adapter, error) {
-	a := &Adapter{
-		graph:            graph,
-		descriptors:      make(map[string]*desc.FileDescriptor),
-		schemaProtoFiles: make(map[string]string),
-		errors:           make(map[string]error),
+	a, err := newAdapter(graph)
+	if err != nil {
+		return nil, err
 	}
 	if err := a.parse(); err != nil {
 		return nil, err
@@ -69,12 +76,48 @@ func LoadAdapter(graph *gen.Graph) (*Adapter, error) {
 	return a, nil
 }
 
+// newAdapter returns an Adapter for the graph without parsing it.
+func newAdapter(graph *gen.Graph) (*Adapter, error) {
+	lock, err := loadLockFile(lockFilePath(graph))
+	if err != nil {
+		return nil, err
+	}
+	types, err := loadTypesFile(typesFilePath(graph))
+	if err != nil {
+		return nil, err
+	}
+	return &Adapter{
+		graph:            graph,
+		descriptors:      make(map[string]*desc.FileDescriptor),
+		schemaProtoFiles: make(map[string]string),
+		errors:           make(map[string]error),
+		lock:             lock,
+		types:            types,
+	}, nil
+}
+
 // Adapter facilitates the transformation of ent gen.Type to desc.FileDescriptors
 type Adapter struct {
 	graph            *gen.Graph
 	descriptors      map[string]*desc.FileDescriptor
 	schemaProtoFiles map[string]string
 	errors           map[string]error
+	lock             *lockFile
+	types            *typesFile
+	// sharedEnums holds the enums shared by the fields annotated with SharedEnum, per proto package.
+	sharedEnums map[string]map[string]*descriptorpb.EnumDescriptorProto
+	// manifest reports whether the .proto files are stamped with the manifest header, and recorded
+	// in the manifest by Generate.
+	manifest bool
+	// comments holds the leading comments of the fields of the messages, of the services and of their
+	// methods, keyed by the name of their file and "<message>.<field>", "<service>" or "<service>.<method>".
+	comments map[string]map[string]string
+	// imports holds the paths of the files declared by entproto.ExtraMethodImports, and of the file
+	// declaring the (entproto.default) option once it is set on a field.
+	imports []string
+	// defaultValues reports whether the static default values of the fields are set as (entproto.default)
+	// options, see WithDefaultValues.
+	defaultValues bool
 }
 
 // AllFileDescriptors returns a file descriptor per proto package for each package that contains
@@ -100,6 +143,8 @@ func (a *Adapter) GetMessageDescriptor(schemaName string) (*desc.MessageDescript
 // parse transforms the ent gen.Type objects into file descriptors
 func (a *Adapter) parse() error {
 	var dpbDescriptors []*descriptorpb.FileDescriptorProto
+	a.sharedEnums = make(map[string]map[string]*descriptorpb.EnumDescriptorProto)
+	a.comments = make(map[string]map[string]string)
 
 	protoPackages := make(map[string]*descriptorpb.FileDescriptorProto)
 
@@ -119,15 +164,7 @@ func (a *Adapter) parse() error {
 		}
 
 		if _, ok := protoPackages[protoPkg]; !ok {
-			goPkg := a.goPackageName(protoPkg)
-			protoPackages[protoPkg] = &descriptorpb.FileDescriptorProto{
-				Name:    relFileName(protoPkg),
-				Package: &protoPkg,
-				Syntax:  strptr("proto3"),
-				Options: &descriptorpb.FileOptions{
-					GoPackage: &goPkg,
-				},
-			}
+			protoPackages[protoPkg] = a.newFileDescriptor(protoPkg)
 		}
 		fd := protoPackages[protoPkg]
 		fd.MessageType = append(fd.MessageType, messageDescriptor)
@@ -139,6 +176,30 @@ func (a *Adapter) parse() error {
 			continue
 		}
 		fd.Dependency = append(fd.Dependency, depPaths...)
+		fd.Dependency = append(fd.Dependency, enumRefDepPaths(genType, protoPkg)...)
+		if opts := messageDescriptor.GetOptions(); opts != nil && proto.HasExtension(opts, options.E_Openapiv2Schema) {
+			fd.Dependency = append(fd.Dependency, openAPIOptionsPath)
+		}
+		a.addDefaultComments(fd, messageDescriptor)
+		if err := a.addExports(genType, protoPkg, protoPackages); err != nil {
+			a.errors[genType.Name] = err
+			continue
+		}
+
+		edgeIDsDescriptor, err := a.toEdgeIDsMessageDescriptor(genType)
+		if err != nil {
+			a.errors[genType.Name] = err
+			continue
+		}
+		if edgeIDsDescriptor != nil {
+			fd.MessageType = append(fd.MessageType, edgeIDsDescriptor)
+			depPaths, err := a.extractDepPaths(edgeIDsDescriptor)
+			if err != nil {
+				a.errors[genType.Name] = err
+				continue
+			}
+			fd.Dependency = append(fd.Dependency, depPaths...)
+		}
 
 		svcAnnotation, err := extractServiceAnnotation(genType)
 		if errors.Is(err, errNoServiceDef) {
@@ -148,13 +209,15 @@ func (a *Adapter) parse() error {
 			return err
 		}
 		if svcAnnotation.Generate {
-			svcResources, err := a.createServiceResources(genType, svcAnnotation.Methods)
+			svcResources, err := a.createServiceResources(genType, svcAnnotation)
 			if err != nil {
 				return err
 			}
-			fd.Service = append(fd.Service, svcResources.svc)
+			fd.Service = append(fd.Service, svcResources.svcs...)
 			fd.MessageType = append(fd.MessageType, svcResources.svcMessages...)
 			fd.Dependency = append(fd.Dependency, "google/protobuf/empty.proto")
+			fd.Dependency = append(fd.Dependency, svcResources.deps...)
+			a.addComments(fd.GetName(), svcResources.comments)
 		}
 	}
 
@@ -167,7 +230,35 @@ func (a *Adapter) parse() error {
 		dpbDescriptors = append(dpbDescriptors, typeDesc.AsFileDescriptorProto())
 	}
 
-	for _, fd := range protoPackages {
+	// Append the files defining the options of the service methods and the messages imported by their extra
+	// methods, along with their dependencies.
+	var optionFiles []*desc.FileDescriptor
+	for _, op := range append(optionsPaths, dedupe(a.imports)...) {
+		files, err := loadDependencyTree(op)
+		if err != nil {
+			return err
+		}
+		optionFiles = append(optionFiles, files...)
+	}
+	for _, f := range optionFiles {
+		dpbDescriptors = append(dpbDescriptors, f.AsFileDescriptorProto())
+	}
+
+	// Enums referenced with EnumRef may be declared in packages without schemas.
+	for protoPkg := range a.sharedEnums {
+		if _, ok := protoPackages[protoPkg]; !ok {
+			protoPackages[protoPkg] = a.newFileDescriptor(protoPkg)
+		}
+	}
+	for protoPkg, fd := range protoPackages {
+		names := make([]string, 0, len(a.sharedEnums[protoPkg]))
+		for name := range a.sharedEnums[protoPkg] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fd.EnumType = append(fd.EnumType, a.sharedEnums[protoPkg][name])
+		}
 		fd.Dependency = dedupe(fd.Dependency)
 		dpbDescriptors = append(dpbDescriptors, fd)
 	}
@@ -177,19 +268,46 @@ func (a *Adapter) parse() error {
 		return err
 	}
 
-	// cleanup the WKT protos from the map
+	// cleanup the WKT and option protos from the map
 	for _, wp := range wktsPaths {
 		delete(descriptors, wp)
 	}
+	for _, f := range optionFiles {
+		delete(descriptors, f.GetName())
+	}
 
+	header := "Code generated by entproto. DO NOT EDIT."
+	if a.manifest {
+		hash, err := genmanifest.SchemaHash(a.graph)
+		if err != nil {
+			return err
+		}
+		header = genmanifest.Header("entproto", genmanifest.Version(), hash)
+	}
 	for dp, fd := range descriptors {
 		fbuild, err := builder.FromFile(fd)
 		if err != nil {
 			return err
 		}
 		fbuild.SetSyntaxComments(builder.Comments{
-			LeadingComment: " Code generated by entproto. DO NOT EDIT.",
+			LeadingComment: " " + header,
 		})
+		for name, c := range a.comments[dp] {
+			parts := strings.SplitN(name, ".", 2)
+			if len(parts) == 1 {
+				if sb := fbuild.GetService(name); sb != nil {
+					sb.SetComments(builder.Comments{LeadingComment: c})
+				}
+			} else if mb := fbuild.GetMessage(parts[0]); mb != nil {
+				if fb := mb.GetField(parts[1]); fb != nil {
+					fb.SetComments(builder.Comments{LeadingComment: c})
+				}
+			} else if sb := fbuild.GetService(parts[0]); sb != nil {
+				if mb := sb.GetMethod(parts[1]); mb != nil {
+					mb.SetComments(builder.Comments{LeadingComment: c})
+				}
+			}
+		}
 		fd, err = fbuild.Build()
 		if err != nil {
 			return err
@@ -202,6 +320,46 @@ func (a *Adapter) parse() error {
 	return nil
 }
 
+// addComments records the leading comments of the fields and methods of the file.
+func (a *Adapter) addComments(file string, comments map[string]string) {
+	if a.comments[file] == nil {
+		a.comments[file] = make(map[string]string)
+	}
+	for name, c := range comments {
+		a.comments[file][name] = c
+	}
+}
+
+// loadDependencyTree returns the descriptors of the registered proto file at path, and of its transitive dependencies.
+func loadDependencyTree(path string) ([]*desc.FileDescriptor, error) {
+	fd, err := desc.LoadFileDescriptor(path)
+	if err != nil {
+		return nil, err
+	}
+	out := []*desc.FileDescriptor{fd}
+	for _, dep := range fd.GetDependencies() {
+		deps, err := loadDependencyTree(dep.GetName())
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, deps...)
+	}
+	return out, nil
+}
+
+// newFileDescriptor returns an empty file descriptor of the proto package protoPkg.
+func (a *Adapter) newFileDescriptor(protoPkg string) *descriptorpb.FileDescriptorProto {
+	goPkg := a.goPackageName(protoPkg)
+	return &descriptorpb.FileDescriptorProto{
+		Name:    relFileName(protoPkg),
+		Package: &protoPkg,
+		Syntax:  strptr("proto3"),
+		Options: &descriptorpb.FileOptions{
+			GoPackage: &goPkg,
+		},
+	}
+}
+
 func (a *Adapter) goPackageName(protoPkgName string) string {
 	// TODO(rotemtam): make this configurable from an annotation
 	entBase := a.graph.Config.Package
@@ -282,6 +440,25 @@ func (a *Adapter) extractDepPaths(m *descriptorpb.DescriptorProto) ([]string, er
 	return out, nil
 }
 
+// enumRefDepPaths returns the paths of the files declaring the enums referenced with EnumRef by the fields
+// of genType, outside of its proto package protoPkg.
+func enumRefDepPaths(genType *gen.Type, protoPkg string) []string {
+	var out []string
+	for _, f := range genType.Fields {
+		if !f.IsEnum() {
+			continue
+		}
+		enumAnnotation, err := extractEnumAnnotation(f)
+		if err != nil {
+			continue
+		}
+		if pkg, _, ok := enumAnnotation.ref(); ok && pkg != protoPkg {
+			out = append(out, *relFileName(pkg))
+		}
+	}
+	return out
+}
+
 func graphContainsDependency(graph *gen.Graph, fieldTypeName string) bool {
 	gt, err := extractGenTypeByName(graph, extractLastFqnPart(fieldTypeName))
 	if err != nil {
@@ -313,35 +490,54 @@ func (a *Adapter) toProtoMessageDescriptor(genType *gen.Type) (*descriptorpb.Des
 		EnumType: []*descriptorpb.EnumDescriptorProto(nil),
 	}
 
+	if msgAnnot.AutoFieldNumbers {
+		if err := a.lock.assignFieldNumbers(genType); err != nil {
+			return nil, err
+		}
+	}
 	if !genType.ID.UserDefined {
 		genType.ID.Annotations = map[string]interface{}{FieldAnnotation: Field(IDFieldNumber)}
 	}
 
+	if err := verifyNoMixinFieldNumberCollisions(genType); err != nil {
+		return nil, err
+	}
+
 	all := []*gen.Field{genType.ID}
 	all = append(all, genType.Fields...)
 
 	for _, f := range all {
-		if _, ok := f.Annotations[SkipAnnotation]; ok {
+		if _, skip, err := skipMethods(f.Name, f.Annotations); err != nil {
+			return nil, err
+		} else if skip {
 			continue
 		}
 
-		protoField, err := toProtoFieldDescriptor(f)
+		protoField, err := a.toProtoFieldDescriptor(f)
 		if err != nil {
 			return nil, err
 		}
 		// If the field is an enum type, we need to create the enum descriptor as well.
 		if f.Type.Type == field.TypeEnum {
-			dp, err := toProtoEnumDescriptor(f)
+			dp, shared, err := toProtoEnumDescriptor(f)
 			if err != nil {
 				return nil, err
 			}
-			msg.EnumType = append(msg.EnumType, dp)
+			if shared {
+				if err := a.addSharedEnum(genType, f, dp); err != nil {
+					return nil, err
+				}
+			} else {
+				msg.EnumType = append(msg.EnumType, dp)
+			}
 		}
 		msg.Field = append(msg.Field, protoField)
 	}
 
 	for _, e := range genType.Edges {
-		if _, ok := e.Annotations[SkipAnnotation]; ok {
+		if _, skip, err := skipMethods(e.Name, e.Annotations); err != nil {
+			return nil, err
+		} else if skip || msgAnnot.EdgeIDsMessage {
 			continue
 		}
 
@@ -357,6 +553,17 @@ func (a *Adapter) toProtoMessageDescriptor(genType *gen.Type) (*descriptorpb.Des
 	if err := verifyNoDuplicateFieldNumbers(msg); err != nil {
 		return nil, err
 	}
+	if err := verifyNoDuplicateJSONNames(msg); err != nil {
+		return nil, err
+	}
+	if msgAnnot.OpenAPISchema != nil {
+		schema := &options.Schema{}
+		if err := msgAnnot.OpenAPISchema.decode(schema); err != nil {
+			return nil, err
+		}
+		msg.Options = &descriptorpb.MessageOptions{}
+		proto.SetExtension(msg.Options, options.E_Openapiv2Schema, schema)
+	}
 
 	return msg, nil
 }
@@ -374,6 +581,93 @@ func verifyNoDuplicateFieldNumbers(msg *descriptorpb.DescriptorProto) error {
 	return nil
 }
 
+func verifyNoDuplicateJSONNames(msg *descriptorpb.DescriptorProto) error {
+	mem := make(map[string]string)
+	for _, fld := range msg.Field {
+		jsn := fld.GetJsonName()
+		if jsn == "" {
+			jsn = jsonName(fld.GetName())
+		}
+		if other, seen := mem[jsn]; seen {
+			return fmt.Errorf("entproto: json name %q of field %q conflicts with field %q on message %q",
+				jsn, fld.GetName(), other, msg.GetName())
+		}
+		mem[jsn] = fld.GetName()
+	}
+	return nil
+}
+
+// jsonName returns the default json_name protoc assigns to a field, its name in lowerCamelCase.
+func jsonName(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, c := range name {
+		switch {
+		case c == '_':
+			upper = true
+		case upper && 'a' <= c && c <= 'z':
+			b.WriteRune(c - 'a' + 'A')
+			upper = false
+		default:
+			b.WriteRune(c)
+			upper = false
+		}
+	}
+	return b.String()
+}
+
+// verifyNoMixinFieldNumberCollisions reports field numbers that are declared both by a field
+// mixed into the schema and by another field or edge, naming the schema, the mixin and both fields.
+func verifyNoMixinFieldNumberCollisions(genType *gen.Type) error {
+	type declaration struct {
+		desc    string
+		mixedIn bool
+	}
+	seen := make(map[int]declaration)
+	declare := func(name string, annots gen.Annotations, pos *load.Position, kind string) error {
+		if isSkipped(annots) {
+			return nil
+		}
+		annot, ok := annots[FieldAnnotation]
+		if !ok {
+			return nil
+		}
+		pbf, err := decodeFieldAnnotation(name, annot)
+		if err != nil {
+			return err
+		}
+		d := declaration{desc: fmt.Sprintf("%s %q", kind, name)}
+		if pos != nil && pos.MixedIn {
+			d.desc += fmt.Sprintf(" of mixin #%d", pos.MixinIndex)
+			d.mixedIn = true
+		}
+		prev, ok := seen[pbf.Number]
+		if !ok {
+			seen[pbf.Number] = d
+			return nil
+		}
+		if prev.mixedIn || d.mixedIn {
+			return fmt.Errorf("entproto: field number %d on schema %q is declared by %s and by %s",
+				pbf.Number, genType.Name, prev.desc, d.desc)
+		}
+		return nil
+	}
+	if err := declare(genType.ID.Name, genType.ID.Annotations, genType.ID.Position, "field"); err != nil {
+		return err
+	}
+	for _, f := range genType.Fields {
+		if err := declare(f.Name, f.Annotations, f.Position, "field"); err != nil {
+			return err
+		}
+	}
+	for _, e := range genType.Edges {
+		if err := declare(e.Name, e.Annotations, nil, "edge"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (a *Adapter) extractEdgeFieldDescriptor(source *gen.Type, e *gen.Edge) (*descriptorpb.FieldDescriptorProto, error) {
 	t := descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
 	msgTypeName := pascal(e.Type.Name)
@@ -386,6 +680,14 @@ func (a *Adapter) extractEdgeFieldDescriptor(source *gen.Type, e *gen.Edge) (*de
 	if edgeAnnotation.Number == 1 {
 		return nil, fmt.Errorf("entproto: edge %q has number 1 which is reserved for id", e.Name)
 	}
+	if edgeAnnotation.IDOnly {
+		for _, f := range source.Fields {
+			if f.Name == edgeIDsFieldName(e) {
+				return nil, fmt.Errorf("entproto: entproto.IDOnly of edge %q conflicts with field %q", e.Name, f.Name)
+			}
+		}
+		return a.edgeIDsFieldDescriptor(e, edgeAnnotation)
+	}
 
 	fieldNum := int32(edgeAnnotation.Number)
 	fieldDesc := &descriptorpb.FieldDescriptorProto{
@@ -393,10 +695,16 @@ func (a *Adapter) extractEdgeFieldDescriptor(source *gen.Type, e *gen.Edge) (*de
 		Name:   &e.Name,
 		Type:   &t,
 	}
+	if edgeAnnotation.JSONName != "" {
+		fieldDesc.JsonName = &edgeAnnotation.JSONName
+	}
 
 	if !e.Unique {
 		fieldDesc.Label = &repeatedFieldLabel
 	}
+	if edgeAnnotation.Packed {
+		return nil, fmt.Errorf("entproto: entproto.Packed of edge %q requires an edge IDs message", e.Name)
+	}
 
 	relType, err := extractGenTypeByName(a.graph, msgTypeName)
 	if err != nil {
@@ -421,39 +729,72 @@ func (a *Adapter) extractEdgeFieldDescriptor(source *gen.Type, e *gen.Edge) (*de
 	return fieldDesc, nil
 }
 
-func toProtoEnumDescriptor(fld *gen.Field) (*descriptorpb.EnumDescriptorProto, error) {
+// toProtoEnumDescriptor returns the enum descriptor of fld, and reports whether it is a shared enum, declared
+// with SharedEnum or referenced with EnumRef.
+func toProtoEnumDescriptor(fld *gen.Field) (*descriptorpb.EnumDescriptorProto, bool, error) {
 	enumAnnotation, err := extractEnumAnnotation(fld)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	if err := enumAnnotation.Verify(fld); err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	enumName := pascal(fld.Name)
+	if enumAnnotation.Shared != "" {
+		enumName = enumAnnotation.Shared
+	} else if _, name, ok := enumAnnotation.ref(); ok {
+		enumName = name
+	}
 	dp := &descriptorpb.EnumDescriptorProto{
 		Name:  strptr(enumName),
 		Value: []*descriptorpb.EnumValueDescriptorProto{},
 	}
-	if !fld.Default {
+	if enumAnnotation.hasUnspecifiedValue(fld) {
 		dp.Value = append(dp.Value, &descriptorpb.EnumValueDescriptorProto{
 			Number: int32ptr(0),
-			Name:   strptr(strings.ToUpper(snake(fld.Name)) + "_UNSPECIFIED"),
+			Name:   strptr(enumAnnotation.unspecifiedValue(fld)),
 		})
 	}
 	for _, opt := range fld.Enums {
 		n := strings.ToUpper(snake(opt.Value))
 		if !enumAnnotation.OmitFieldPrefix {
-			n = strings.ToUpper(snake(fld.Name)) + "_" + n
+			n = enumAnnotation.prefix(fld) + "_" + n
 		}
 		dp.Value = append(dp.Value, &descriptorpb.EnumValueDescriptorProto{
 			Number: int32ptr(enumAnnotation.Options[opt.Value]),
 			Name:   strptr(n),
 		})
 	}
-	return dp, nil
+	return dp, enumAnnotation.Shared != "" || enumAnnotation.Ref != "", nil
 }
 
-func toProtoFieldDescriptor(f *gen.Field) (*descriptorpb.FieldDescriptorProto, error) {
+// addSharedEnum records the shared enum dp of the field f of genType, declared in the proto package
+// of genType, or in the package of the enum referenced with EnumRef. All fields sharing an enum must
+// agree on its definition.
+func (a *Adapter) addSharedEnum(genType *gen.Type, f *gen.Field, dp *descriptorpb.EnumDescriptorProto) error {
+	protoPkg, err := protoPackageName(genType)
+	if err != nil {
+		return err
+	}
+	if enumAnnotation, err := extractEnumAnnotation(f); err == nil {
+		if pkg, _, ok := enumAnnotation.ref(); ok {
+			protoPkg = pkg
+		}
+	}
+	enums, ok := a.sharedEnums[protoPkg]
+	if !ok {
+		enums = make(map[string]*descriptorpb.EnumDescriptorProto)
+		a.sharedEnums[protoPkg] = enums
+	}
+	if cur, ok := enums[dp.GetName()]; ok && !proto.Equal(cur, dp) {
+		return fmt.Errorf("entproto: field %q of %q defines shared enum %q differently than other fields",
+			f.Name, genType.Name, dp.GetName())
+	}
+	enums[dp.GetName()] = dp
+	return nil
+}
+
+func (a *Adapter) toProtoFieldDescriptor(f *gen.Field) (*descriptorpb.FieldDescriptorProto, error) {
 	fieldDesc := &descriptorpb.FieldDescriptorProto{
 		Name: &f.Name,
 	}
@@ -466,28 +807,51 @@ func toProtoFieldDescriptor(f *gen.Field) (*descriptorpb.FieldDescriptorProto, e
 		return nil, fmt.Errorf("entproto: field %q has number 1 which is reserved for id", f.Name)
 	}
 	fieldDesc.Number = &fieldNumber
+	if fann.JSONName != "" {
+		fieldDesc.JsonName = &fann.JSONName
+	}
 	if fann.Type != descriptorpb.FieldDescriptorProto_Type(0) {
 		fieldDesc.Type = &fann.Type
 		if len(fann.TypeName) > 0 {
 			fieldDesc.TypeName = &fann.TypeName
 		}
-		return fieldDesc, nil
-	}
-	typeDetails, err := extractProtoTypeDetails(f)
-	if err != nil {
-		return nil, err
+	} else {
+		typeDetails, err := a.extractProtoTypeDetails(f)
+		if err != nil {
+			return nil, err
+		}
+		fieldDesc.Type = &typeDetails.protoType
+		if typeDetails.messageName != "" {
+			fieldDesc.TypeName = &typeDetails.messageName
+		}
+		if typeDetails.repeated {
+			fieldDesc.Label = &repeatedFieldLabel
+		}
 	}
-	fieldDesc.Type = &typeDetails.protoType
-	if typeDetails.messageName != "" {
-		fieldDesc.TypeName = &typeDetails.messageName
+	if fann.Packed {
+		if err := setPacked(f.Name, fieldDesc); err != nil {
+			return nil, err
+		}
 	}
-	if typeDetails.repeated {
-		fieldDesc.Label = &repeatedFieldLabel
+	if v, ok := staticDefault(f); ok && a.defaultValues {
+		setDefault(fieldDesc, v)
 	}
 	return fieldDesc, nil
 }
 
-func extractProtoTypeDetails(f *gen.Field) (fieldType, error) {
+func (a *Adapter) extractProtoTypeDetails(f *gen.Field) (fieldType, error) {
+	if _, err := isRFC3339(f); err != nil {
+		return fieldType{}, err
+	}
+	if m := a.TypeMapping(f); m != nil {
+		if f.Optional {
+			return fieldType{
+				protoType:   descriptorpb.FieldDescriptorProto_TYPE_MESSAGE,
+				messageName: scalarWrappers[m.ProtoType],
+			}, nil
+		}
+		return fieldType{protoType: m.ProtoType}, nil
+	}
 	if f.Type.Type == field.TypeJSON {
 		return extractJSONDetails(f)
 	}
@@ -514,10 +878,23 @@ func extractProtoTypeDetails(f *gen.Field) (fieldType, error) {
 	}, nil
 }
 
+// jsonSliceTypes maps the Go types of the JSON fields generated as repeated fields to the protobuf type of their
+// elements. The elements have the same Go type in both representations.
+var jsonSliceTypes = map[string]descriptorpb.FieldDescriptorProto_Type{
+	"[]string":  descriptorpb.FieldDescriptorProto_TYPE_STRING,
+	"[]bool":    descriptorpb.FieldDescriptorProto_TYPE_BOOL,
+	"[]int32":   descriptorpb.FieldDescriptorProto_TYPE_INT32,
+	"[]int64":   descriptorpb.FieldDescriptorProto_TYPE_INT64,
+	"[]uint32":  descriptorpb.FieldDescriptorProto_TYPE_UINT32,
+	"[]uint64":  descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+	"[]float32": descriptorpb.FieldDescriptorProto_TYPE_FLOAT,
+	"[]float64": descriptorpb.FieldDescriptorProto_TYPE_DOUBLE,
+}
+
 func extractJSONDetails(f *gen.Field) (fieldType, error) {
-	if f.Type.Ident == "[]string" {
+	if t, ok := jsonSliceTypes[f.Type.Ident]; ok {
 		return fieldType{
-			protoType: descriptorpb.FieldDescriptorProto_TYPE_STRING,
+			protoType: t,
 			repeated:  true,
 		}, nil
 	}
@@ -17,6 +17,7 @@ package main
 import (
 	"flag"
 	"log"
+	"os"
 
 	"entgo.io/contrib/entproto"
 	"entgo.io/ent/entc"
@@ -26,6 +27,9 @@ import (
 func main() {
 	var (
 		schemaPath = flag.String("path", "", "path to schema directory")
+		manifest   = flag.Bool("manifest", false, "stamp the .proto files and record them in the manifest of the ent directory")
+		defaults   = flag.Bool("default_values", false, "surface the static default values of the fields in the .proto files")
+		check      = flag.Bool("check", false, "report the .proto files not generated from the current schema, without generating them")
 	)
 	flag.Parse()
 	if *schemaPath == "" {
@@ -35,7 +39,27 @@ func main() {
 	if err != nil {
 		log.Fatalf("entproto: failed loading ent graph: %v", err)
 	}
-	if err := entproto.Generate(graph); err != nil {
+	if *check {
+		stale, err := entproto.CheckProtos(graph)
+		if err != nil {
+			log.Fatalf("entproto: failed checking protos: %s", err)
+		}
+		for _, f := range stale {
+			log.Printf("entproto: %s is stale: %s", f.Path, f.Reason)
+		}
+		if len(stale) > 0 {
+			os.Exit(1)
+		}
+		return
+	}
+	var opts []entproto.GenerateOption
+	if *manifest {
+		opts = append(opts, entproto.WithManifest())
+	}
+	if *defaults {
+		opts = append(opts, entproto.WithDefaultValues())
+	}
+	if err := entproto.Generate(graph, opts...); err != nil {
 		log.Fatalf("entproto: failed generating protos: %s", err)
 	}
 }
@@ -17,6 +17,9 @@ package main
 import (
 	"flag"
 	"fmt"
+	"os"
+	"sort"
+	"strings"
 
 	entopts "entgo.io/contrib/entproto/cmd/protoc-gen-ent/options/ent"
 	"entgo.io/contrib/schemast"
@@ -29,46 +32,132 @@ import (
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
-var schemaDir *string
-
 func main() {
-	var flags flag.FlagSet
-	schemaDir = flags.String("schemadir", "./ent/schema", "path to ent schema dir")
+	var (
+		flags flag.FlagSet
+		opts  options
+	)
+	flags.StringVar(&opts.schemaDir, "schemadir", "./ent/schema", "path to ent schema dir")
+	flags.Var(&opts.routes, "route", "route the schemas of a proto package to another schema dir, in the form <package>=<dir>")
+	flags.BoolVar(&opts.registry, "registry", false, "generate a file listing the schemas generated in each schema dir")
+	flags.StringVar(&opts.report, "report", "", "write a report of all unsupported proto constructs to this file, instead of generating schemas")
 	protogen.Options{
 		ParamFunc: flags.Set,
 	}.Run(func(gen *protogen.Plugin) error {
-		return printSchemas(*schemaDir, gen)
+		return run(opts, gen)
 	})
 }
 
-func printSchemas(schemaDir string, gen *protogen.Plugin) error {
-	ctx, err := schemast.Load(schemaDir)
-	if err != nil {
-		return err
+// run generates the schemas of the files passed to the plugin, or reports their unsupported
+// constructs if opts.report is set.
+func run(opts options, gen *protogen.Plugin) error {
+	if opts.report != "" {
+		return printReport(opts, gen)
+	}
+	return printSchemas(opts, gen)
+}
+
+// options configures the plugin.
+type options struct {
+	// schemaDir is the schema dir of messages that are not routed elsewhere.
+	schemaDir string
+	routes    routes
+	registry  bool
+	// report is the path of the unsupported-feature report. If set, schemas are not generated.
+	report string
+}
+
+// routes maps proto packages to the schema dirs their messages are generated into.
+type routes map[string]string
+
+func (r *routes) String() string {
+	pkgs := make([]string, 0, len(*r))
+	for pkg := range *r {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+	for i, pkg := range pkgs {
+		pkgs[i] = pkg + "=" + (*r)[pkg]
+	}
+	return strings.Join(pkgs, ",")
+}
+
+func (r *routes) Set(v string) error {
+	pkg, dir, ok := strings.Cut(v, "=")
+	if !ok || pkg == "" || dir == "" {
+		return fmt.Errorf("protoc-gen-ent: invalid route %q, expected <package>=<dir>", v)
+	}
+	if *r == nil {
+		*r = make(routes)
 	}
-	var mutations []schemast.Mutator
+	(*r)[pkg] = dir
+	return nil
+}
+
+// schemaDirOf returns the schema dir messages of the proto package pkg are generated into. Routes
+// apply to the package and its sub-packages, and the most specific route wins.
+func (o options) schemaDirOf(pkg string) string {
+	dir, match := o.schemaDir, ""
+	for p, d := range o.routes {
+		if (pkg == p || strings.HasPrefix(pkg, p+".")) && len(p) > len(match) {
+			dir, match = d, p
+		}
+	}
+	return dir
+}
+
+func printSchemas(opts options, gen *protogen.Plugin) error {
+	var (
+		dirs      []string
+		mutations = make(map[string][]schemast.Mutator)
+		generated = make(map[string]map[string]string)
+	)
 	for _, f := range gen.Files {
 		if !f.Generate {
 			continue
 		}
+		dir := opts.schemaDirOf(string(f.Desc.Package()))
 		// TODO(rotemtam): handle nested messages recursively?
 		for _, msg := range f.Messages {
-			opts, ok := schemaOpts(msg)
-			if !ok || !opts.GetGen() {
+			schemaOpts, ok := schemaOpts(msg)
+			if !ok || !schemaOpts.GetGen() {
 				continue
 			}
-			schema, err := toSchema(msg, opts)
+			schema, err := toSchema(msg, schemaOpts)
 			if err != nil {
 				return err
 			}
-			mutations = append(mutations, schema)
+			if _, ok := mutations[dir]; !ok {
+				dirs = append(dirs, dir)
+				generated[dir] = make(map[string]string)
+			}
+			mutations[dir] = append(mutations[dir], schema)
+			generated[dir][schema.Name] = string(msg.Desc.FullName())
 		}
 	}
-	if err := schemast.Mutate(ctx, mutations...); err != nil {
-		return err
-	}
-	if err := ctx.Print(schemaDir, schemast.Header("File updated by protoc-gen-ent.")); err != nil {
-		return err
+	sort.Strings(dirs)
+	for _, dir := range dirs {
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			return err
+		}
+		ctx, err := schemast.Load(dir)
+		if err != nil {
+			return err
+		}
+		if err := schemast.Mutate(ctx, mutations[dir]...); err != nil {
+			return err
+		}
+		if err := ctx.Validate(); err != nil {
+			return err
+		}
+		if err := ctx.Print(dir, schemast.Header("File updated by protoc-gen-ent.")); err != nil {
+			return err
+		}
+		if opts.registry {
+			if err := printRegistry(dir, ctx.SchemaPackage.Name, generated[dir]); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -136,9 +225,20 @@ func isEdge(f *protogen.Field) bool {
 func toEdge(f *protogen.Field) (ent.Edge, error) {
 	name := string(f.Desc.Name())
 	msgType := string(f.Desc.Message().Name())
+	if f.Desc.IsMap() {
+		return nil, &unsupportedError{
+			Name:       f.Desc.FullName(),
+			Reason:     fmt.Sprintf("map field %q is not supported", name),
+			Suggestion: "replace the map with a repeated message annotated with (ent.schema).gen = true and an (ent.edge) option",
+		}
+	}
 	opts, ok := edgeOpts(f)
 	if !ok {
-		return nil, fmt.Errorf("protoc-gen-ent: expected ent.edge option on field %q", name)
+		return nil, &unsupportedError{
+			Name:       f.Desc.FullName(),
+			Reason:     fmt.Sprintf("expected ent.edge option on field %q", name),
+			Suggestion: "add [(ent.edge) = {}] to the field",
+		}
 	}
 	var e ent.Edge
 	switch {
@@ -155,6 +255,13 @@ func toEdge(f *protogen.Field) (ent.Edge, error) {
 
 func toField(f *protogen.Field) (ent.Field, error) {
 	name := string(f.Desc.Name())
+	if f.Desc.IsList() {
+		return nil, &unsupportedError{
+			Name:       f.Desc.FullName(),
+			Reason:     fmt.Sprintf("repeated field %q is not supported", name),
+			Suggestion: "move the values to a message annotated with (ent.schema).gen = true and reference it with an (ent.edge) option",
+		}
+	}
 	var fld ent.Field
 	switch f.Desc.Kind() {
 	case protoreflect.StringKind:
@@ -195,7 +302,11 @@ func toField(f *protogen.Field) (ent.Field, error) {
 		}
 		fld = field.Enum(name).Values(values...)
 	default:
-		return nil, fmt.Errorf("protoc-gen-ent: unsupported kind %q", f.Desc.Kind())
+		return nil, &unsupportedError{
+			Name:       f.Desc.FullName(),
+			Reason:     fmt.Sprintf("unsupported kind %q", f.Desc.Kind()),
+			Suggestion: "replace the field with a scalar, enum or message field",
+		}
 	}
 	if opts, ok := fieldOpts(f); ok {
 		applyFieldOpts(fld, opts)
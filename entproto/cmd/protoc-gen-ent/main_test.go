@@ -94,16 +94,99 @@ func TestEnum(t *testing.T) {
 	require.Contains(t, contents, `field.Enum("status").Values("STATUS_UNSPECIFIED", "PENDING", "ACTIVE", "COMPLETE", "FAILED")`)
 }
 
+func TestRouting(t *testing.T) {
+	tt, err := newGenTestWithOptions(t, func(tmp string, opts *options) {
+		require.NoError(t, opts.routes.Set("routing.billing="+filepath.Join(tmp, "billing")))
+		require.NoError(t, opts.routes.Set("routing="+filepath.Join(tmp, "other")))
+		opts.registry = true
+	}, "testdata/routing/billing.proto", "testdata/routing/crm.proto")
+	require.NoError(t, err)
+	require.Len(t, tt.output, 5)
+	contents, err := tt.fileContents("billing/invoice.go")
+	require.NoError(t, err)
+	require.Contains(t, contents, `edge.To("payments", Payment.Type)`)
+	_, err = tt.fileContents("billing/payment.go")
+	require.NoError(t, err)
+	_, err = tt.fileContents("other/customer.go")
+	require.NoError(t, err)
+	registry, err := tt.fileContents("billing/protoc_gen_ent.go")
+	require.NoError(t, err)
+	require.Contains(t, registry, "package billing")
+	require.Contains(t, registry, `"Invoice": "routing.billing.Invoice",`)
+	require.Contains(t, registry, `"Payment": "routing.billing.Payment",`)
+	registry, err = tt.fileContents("other/protoc_gen_ent.go")
+	require.NoError(t, err)
+	require.Contains(t, registry, `"Customer": "routing.crm.Customer",`)
+}
+
+func TestRouting_CrossPackageEdge(t *testing.T) {
+	_, err := newGenTestWithOptions(t, func(tmp string, opts *options) {
+		require.NoError(t, opts.routes.Set("routing.billing="+filepath.Join(tmp, "billing")))
+	}, "testdata/routing/billing.proto", "testdata/routing/sales.proto")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `Order: edge "invoice" references unknown type "Invoice"`)
+}
+
+func TestRoutes(t *testing.T) {
+	var r routes
+	require.Error(t, r.Set("billing"))
+	require.Error(t, r.Set("=./schema"))
+	require.NoError(t, r.Set("billing=./billing/schema"))
+	require.NoError(t, r.Set("billing.v2=./billing/v2/schema"))
+	opts := options{schemaDir: "./schema", routes: r}
+	require.Equal(t, "./schema", opts.schemaDirOf("crm"))
+	require.Equal(t, "./schema", opts.schemaDirOf("billingv2"))
+	require.Equal(t, "./billing/schema", opts.schemaDirOf("billing"))
+	require.Equal(t, "./billing/schema", opts.schemaDirOf("billing.v1"))
+	require.Equal(t, "./billing/v2/schema", opts.schemaDirOf("billing.v2.internal"))
+	require.Equal(t, "billing=./billing/schema,billing.v2=./billing/v2/schema", r.String())
+}
+
+func TestReport(t *testing.T) {
+	_, err := newGenTest(t, "testdata/unsupported.proto")
+	require.EqualError(t, err, `protoc-gen-ent: repeated field "tags" is not supported`)
+
+	var report string
+	tt, err := newGenTestWithOptions(t, func(tmp string, opts *options) {
+		report = filepath.Join(tmp, "report.txt")
+		opts.report = report
+	}, "testdata/unsupported.proto")
+	require.NoError(t, err)
+	require.Len(t, tt.output, 1, "schemas are not generated in report mode")
+	contents, err := tt.fileContents("report.txt")
+	require.NoError(t, err)
+	require.Contains(t, contents, "found 5 unsupported construct(s)")
+	for _, line := range []string{
+		`testdata.Account.tags  repeated field "tags" is not supported`,
+		`testdata.Account.labels  map field "labels" is not supported`,
+		`testdata.Account.address  expected ent.edge option on field "address"  add [(ent.edge) = {}] to the field`,
+		`testdata.Account.plan  edge "plan" references message "testdata.Plan" which is not an ent schema  add option (ent.schema).gen = true to message "testdata.Plan"`,
+		`testdata.Account.Session  nested message "Session" is not supported  move the message to the top level of the file`,
+	} {
+		require.Contains(t, strings.Join(strings.Fields(contents), " "), strings.Join(strings.Fields(line), " "))
+	}
+}
+
 type genTest struct {
 	output map[string]string
 }
 
 func newGenTest(t *testing.T, files ...string) (*genTest, error) {
+	return newGenTestWithOptions(t, nil, files...)
+}
+
+// newGenTestWithOptions runs the plugin on files, after configuring its options with setup.
+// Schema dirs in routes are relative to the output dir.
+func newGenTestWithOptions(t *testing.T, setup func(tmp string, opts *options), files ...string) (*genTest, error) {
 	tmp, err := os.MkdirTemp("", "protoc-gen-ent-")
 	require.NoError(t, err)
 	t.Cleanup(func() {
 		os.RemoveAll(tmp)
 	})
+	opts := options{schemaDir: tmp}
+	if setup != nil {
+		setup(tmp, &opts)
+	}
 	var parser protoparse.Parser
 	var descs []*descriptorpb.FileDescriptorProto
 	tgts := []string{"google/protobuf/descriptor.proto", "options/ent/opts.proto"}
@@ -122,7 +205,7 @@ func newGenTest(t *testing.T, files ...string) (*genTest, error) {
 	if err != nil {
 		return nil, err
 	}
-	err = printSchemas(tmp, gen)
+	err = run(opts, gen)
 	if err != nil {
 		return nil, err
 	}
@@ -138,7 +221,11 @@ func newGenTest(t *testing.T, files ...string) (*genTest, error) {
 		if rerr != nil {
 			return rerr
 		}
-		output[filepath.Base(path)] = string(contents)
+		rel, rerr := filepath.Rel(tmp, path)
+		if rerr != nil {
+			return rerr
+		}
+		output[filepath.ToSlash(rel)] = string(contents)
 		return nil
 	})
 	if err != nil {
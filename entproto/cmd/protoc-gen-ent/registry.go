@@ -0,0 +1,55 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+)
+
+// registryFileName is the name of the file listing the schemas generated into a schema dir.
+const registryFileName = "protoc_gen_ent.go"
+
+// printRegistry writes the registry file of a schema dir, mapping the names of the generated
+// schemas to the full names of the proto messages they were generated from.
+func printRegistry(dir, pkg string, schemas map[string]string) error {
+	if pkg == "" {
+		pkg = filepath.Base(dir)
+	}
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by protoc-gen-ent. DO NOT EDIT.\n\npackage %s\n\n", pkg)
+	b.WriteString("// ProtoSchemas maps the schemas generated by protoc-gen-ent to the full names of\n")
+	b.WriteString("// the proto messages they were generated from.\n")
+	b.WriteString("var ProtoSchemas = map[string]string{\n")
+	for _, name := range names {
+		fmt.Fprintf(&b, "%s: %s,\n", strconv.Quote(name), strconv.Quote(schemas[name]))
+	}
+	b.WriteString("}\n")
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, registryFileName), src, 0600)
+}
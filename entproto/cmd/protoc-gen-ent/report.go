@@ -0,0 +1,161 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// unsupportedError is returned for proto constructs that cannot be converted to an ent schema.
+type unsupportedError struct {
+	// Name is the full name of the unsupported field or message.
+	Name protoreflect.FullName
+	// Reason describes why the construct is not supported.
+	Reason string
+	// Suggestion describes how to change the proto definition to make it supported.
+	Suggestion string
+}
+
+func (e *unsupportedError) Error() string {
+	return "protoc-gen-ent: " + e.Reason
+}
+
+// report collects the unsupported constructs of the proto files passed to the plugin, instead of
+// failing on the first one.
+type report struct {
+	opts   options
+	issues []*unsupportedError
+	// schemas maps the full names of the messages generated by this run to their schema dirs.
+	schemas map[protoreflect.FullName]string
+}
+
+// printReport writes a report of all unsupported constructs of the files passed to the plugin to
+// opts.report. Schemas are not generated in report mode.
+func printReport(opts options, gen *protogen.Plugin) error {
+	r := &report{opts: opts, schemas: make(map[protoreflect.FullName]string)}
+	for _, f := range gen.Files {
+		if !f.Generate {
+			continue
+		}
+		for _, msg := range f.Messages {
+			if schemaOpts, ok := schemaOpts(msg); ok && schemaOpts.GetGen() {
+				r.schemas[msg.Desc.FullName()] = opts.schemaDirOf(string(f.Desc.Package()))
+			}
+		}
+	}
+	for _, f := range gen.Files {
+		if !f.Generate {
+			continue
+		}
+		for _, msg := range f.Messages {
+			if schemaOpts, ok := schemaOpts(msg); ok && schemaOpts.GetGen() {
+				r.checkMessage(msg)
+			}
+			r.checkNested(msg)
+		}
+	}
+	return os.WriteFile(opts.report, r.bytes(), 0600)
+}
+
+// checkMessage records the unsupported fields of a message generated into an ent schema.
+func (r *report) checkMessage(msg *protogen.Message) {
+	for _, f := range msg.Fields {
+		var err error
+		if isEdge(f) {
+			_, err = toEdge(f)
+			if err == nil {
+				err = r.checkEdgeType(msg, f)
+			}
+		} else {
+			_, err = toField(f)
+		}
+		var uerr *unsupportedError
+		if errors.As(err, &uerr) {
+			r.issues = append(r.issues, uerr)
+		} else if err != nil {
+			r.issues = append(r.issues, &unsupportedError{Name: f.Desc.FullName(), Reason: err.Error()})
+		}
+	}
+}
+
+// checkEdgeType verifies that the message referenced by edge f is generated into the schema dir of msg.
+func (r *report) checkEdgeType(msg *protogen.Message, f *protogen.Field) error {
+	target := f.Message
+	dir, ok := r.schemas[target.Desc.FullName()]
+	switch {
+	case ok && dir == r.schemas[msg.Desc.FullName()]:
+		return nil
+	case ok:
+		return &unsupportedError{
+			Name:       f.Desc.FullName(),
+			Reason:     fmt.Sprintf("edge %q references message %q generated into another schema dir", f.Desc.Name(), target.Desc.FullName()),
+			Suggestion: fmt.Sprintf("route package %q to %q", target.Desc.ParentFile().Package(), r.schemas[msg.Desc.FullName()]),
+		}
+	}
+	if schemaOpts, ok := schemaOpts(target); ok && schemaOpts.GetGen() {
+		return &unsupportedError{
+			Name:       f.Desc.FullName(),
+			Reason:     fmt.Sprintf("edge %q references message %q which is not generated by this run", f.Desc.Name(), target.Desc.FullName()),
+			Suggestion: fmt.Sprintf("pass %q to protoc", target.Desc.ParentFile().Path()),
+		}
+	}
+	return &unsupportedError{
+		Name:       f.Desc.FullName(),
+		Reason:     fmt.Sprintf("edge %q references message %q which is not an ent schema", f.Desc.Name(), target.Desc.FullName()),
+		Suggestion: fmt.Sprintf("add option (ent.schema).gen = true to message %q", target.Desc.FullName()),
+	}
+}
+
+// checkNested records the nested messages of msg annotated to be generated, which are not supported.
+func (r *report) checkNested(msg *protogen.Message) {
+	for _, nested := range msg.Messages {
+		if schemaOpts, ok := schemaOpts(nested); ok && schemaOpts.GetGen() {
+			r.issues = append(r.issues, &unsupportedError{
+				Name:       nested.Desc.FullName(),
+				Reason:     fmt.Sprintf("nested message %q is not supported", nested.Desc.Name()),
+				Suggestion: "move the message to the top level of the file",
+			})
+		}
+		r.checkNested(nested)
+	}
+}
+
+// bytes formats the report as a table of the unsupported constructs.
+func (r *report) bytes() []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "protoc-gen-ent: found %d unsupported construct(s)\n", len(r.issues))
+	if len(r.issues) == 0 {
+		return b.Bytes()
+	}
+	b.WriteByte('\n')
+	w := tabwriter.NewWriter(&b, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tREASON\tSUGGESTION")
+	for _, issue := range r.issues {
+		suggestion := issue.Suggestion
+		if suggestion == "" {
+			suggestion = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", issue.Name, issue.Reason, suggestion)
+	}
+	w.Flush()
+	return b.Bytes()
+}
@@ -0,0 +1,281 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+
+	"entgo.io/ent/entc/gen"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	timestamppbPkg = protogen.GoImportPath("google.golang.org/protobuf/types/known/timestamppb")
+	wrapperspbPkg  = protogen.GoImportPath("google.golang.org/protobuf/types/known/wrapperspb")
+)
+
+// wrapperTypes maps the well-known wrapper messages to the Go types of the values the setters of the builders
+// wrap into them.
+var wrapperTypes = map[protoreflect.FullName]string{
+	"google.protobuf.BoolValue":   "bool",
+	"google.protobuf.BytesValue":  "[]byte",
+	"google.protobuf.DoubleValue": "float64",
+	"google.protobuf.FloatValue":  "float32",
+	"google.protobuf.Int32Value":  "int32",
+	"google.protobuf.Int64Value":  "int64",
+	"google.protobuf.StringValue": "string",
+	"google.protobuf.UInt32Value": "uint32",
+	"google.protobuf.UInt64Value": "uint64",
+}
+
+// clientGenerator generates the package wrapping the gRPC clients of the services of a file with ent-like
+// builders, next to the package of the file.
+type clientGenerator struct {
+	*protogen.GeneratedFile
+	File        *protogen.File
+	PackageName string
+	Services    []*clientService
+}
+
+// clientService describes the client of a service, and the methods of the service backing its builders. The
+// methods are nil if the service has none, or if their requests or responses are not shaped as the ones
+// generated by entproto.
+type clientService struct {
+	// Name is the name of the client in the Client struct, the name of the service without its "Service" suffix.
+	Name    string
+	Service *protogen.Service
+	// Message is the message of the entities of the service, and ID its id field.
+	Message *protogen.Message
+	ID      *protogen.Field
+	Create  *protogen.Method
+	Update  *protogen.Method
+	Get     *protogen.Method
+	Delete  *protogen.Method
+	List    *protogen.Method
+}
+
+// generateClient generates the <package>client package of the file, for example "entpbclient" for the services
+// of the "entpb" package, unless the file has no services.
+func generateClient(plugin *protogen.Plugin, file *protogen.File) error {
+	if len(file.Services) == 0 {
+		return nil
+	}
+	pkg := string(file.GoPackageName) + "client"
+	filename := path.Join(path.Dir(file.GeneratedFilenamePrefix), pkg, pkg+".go")
+	g := &clientGenerator{
+		GeneratedFile: plugin.NewGeneratedFile(filename, protogen.GoImportPath(path.Join(string(file.GoImportPath), pkg))),
+		File:          file,
+		PackageName:   pkg,
+	}
+	for _, s := range file.Services {
+		g.Services = append(g.Services, newClientService(s))
+	}
+	tmpl, err := gen.NewTemplate("client").
+		Funcs(template.FuncMap{
+			"ident": g.QualifiedGoIdent,
+			"qualify": func(pkg, ident string) string {
+				return g.QualifiedGoIdent(protogen.GoImportPath(pkg).Ident(ident))
+			},
+		}).
+		ParseFS(templates, "template/client.tmpl")
+	if err != nil {
+		return err
+	}
+	if err := tmpl.ExecuteTemplate(g, "client", g); err != nil {
+		return fmt.Errorf("template execution failed: %w", err)
+	}
+	return nil
+}
+
+// newClientService returns the description of the client of the service s.
+func newClientService(s *protogen.Service) *clientService {
+	cs := &clientService{
+		Name:    strings.TrimSuffix(s.GoName, "Service"),
+		Service: s,
+	}
+	unary := make(map[string]*protogen.Method)
+	for _, m := range s.Methods {
+		if !m.Desc.IsStreamingClient() && !m.Desc.IsStreamingServer() {
+			unary[m.GoName] = m
+		}
+	}
+	// The entities are the responses of the Create and Update methods, or the list of the List responses.
+	for _, name := range []string{"Create", "Update"} {
+		if m, ok := unary[name]; ok && entityField(m.Input, m.Output) != nil {
+			cs.Message = m.Output
+		}
+	}
+	if m, ok := unary["List"]; ok && messageField(m.Input, "page_token") != nil && messageField(m.Output, "next_page_token") != nil {
+		for _, f := range m.Output.Fields {
+			if f.Desc.IsList() && f.Message != nil && (cs.Message == nil || f.Message == cs.Message) {
+				cs.Message, cs.List = f.Message, m
+			}
+		}
+	}
+	if cs.Message == nil {
+		return cs
+	}
+	cs.ID = messageField(cs.Message, "id")
+	for _, name := range []string{"Create", "Update"} {
+		if m, ok := unary[name]; ok && m.Output == cs.Message && entityField(m.Input, cs.Message) != nil {
+			if name == "Create" {
+				cs.Create = m
+			} else {
+				cs.Update = m
+			}
+		}
+	}
+	if cs.ID == nil {
+		cs.Update = nil
+		return cs
+	}
+	if m, ok := unary["Get"]; ok && m.Output == cs.Message && messageField(m.Input, "id") != nil {
+		cs.Get = m
+	}
+	if m, ok := unary["Delete"]; ok && messageField(m.Input, "id") != nil {
+		cs.Delete = m
+	}
+	return cs
+}
+
+// Fields returns the fields of the message of the entities set by the builders, leaving out the oneof and map
+// fields.
+func (cs *clientService) Fields() []*protogen.Field {
+	var fields []*protogen.Field
+	for _, f := range cs.Message.Fields {
+		if f.Oneof == nil && !f.Desc.IsMap() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// Filters returns the fields of the filter of the List requests of the service, or nil if it has none.
+func (cs *clientService) Filters() []*protogen.Field {
+	if f := messageField(cs.List.Input, "filter"); f != nil && f.Message != nil {
+		return f.Message.Fields
+	}
+	return nil
+}
+
+// ListField returns the field of the List responses holding the entities.
+func (cs *clientService) ListField() *protogen.Field {
+	for _, f := range cs.List.Output.Fields {
+		if f.Desc.IsList() && f.Message == cs.Message {
+			return f
+		}
+	}
+	return nil
+}
+
+// Field returns the field of the message msg with the given proto name, or nil if it has none.
+func (*clientService) Field(msg *protogen.Message, name protoreflect.Name) *protogen.Field {
+	return messageField(msg, name)
+}
+
+// EntityField returns the field of the request message req holding an entity of the message msg, or nil if it
+// has none.
+func (*clientService) EntityField(req, msg *protogen.Message) *protogen.Field {
+	return entityField(req, msg)
+}
+
+// messageField returns the field of the message msg with the given proto name, or nil if it has none.
+func messageField(msg *protogen.Message, name protoreflect.Name) *protogen.Field {
+	for _, f := range msg.Fields {
+		if f.Desc.Name() == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// entityField returns the field of the request message req holding an entity of the message msg, or nil if it
+// has none.
+func entityField(req, msg *protogen.Message) *protogen.Field {
+	for _, f := range req.Fields {
+		if !f.Desc.IsList() && f.Message == msg {
+			return f
+		}
+	}
+	return nil
+}
+
+// GoType returns the Go type of the field f.
+func (g *clientGenerator) GoType(f *protogen.Field) string {
+	var typ string
+	switch f.Desc.Kind() {
+	case protoreflect.BoolKind:
+		typ = "bool"
+	case protoreflect.EnumKind:
+		typ = g.QualifiedGoIdent(f.Enum.GoIdent)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		typ = "int32"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		typ = "uint32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		typ = "int64"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		typ = "uint64"
+	case protoreflect.FloatKind:
+		typ = "float32"
+	case protoreflect.DoubleKind:
+		typ = "float64"
+	case protoreflect.StringKind:
+		typ = "string"
+	case protoreflect.BytesKind:
+		typ = "[]byte"
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		typ = "*" + g.QualifiedGoIdent(f.Message.GoIdent)
+	}
+	if f.Desc.IsList() {
+		return "[]" + typ
+	}
+	return typ
+}
+
+// SetterType returns the Go type of the values the setters of the field f take: the wrapped value of the
+// wrapper messages, time.Time for timestamps, and the type of the field otherwise.
+func (g *clientGenerator) SetterType(f *protogen.Field) string {
+	if f.Message == nil || f.Desc.IsList() {
+		return g.GoType(f)
+	}
+	if f.Message.Desc.FullName() == "google.protobuf.Timestamp" {
+		return g.QualifiedGoIdent(protogen.GoImportPath("time").Ident("Time"))
+	}
+	if typ, ok := wrapperTypes[f.Message.Desc.FullName()]; ok {
+		return typ
+	}
+	return g.GoType(f)
+}
+
+// SetterValue returns the expression converting the value v taken by the setters of the field f to the value of
+// the field, see SetterType.
+func (g *clientGenerator) SetterValue(f *protogen.Field, v string) string {
+	if f.Message == nil || f.Desc.IsList() {
+		return v
+	}
+	if f.Message.Desc.FullName() == "google.protobuf.Timestamp" {
+		return fmt.Sprintf("%s(%s)", g.QualifiedGoIdent(timestamppbPkg.Ident("New")), v)
+	}
+	if _, ok := wrapperTypes[f.Message.Desc.FullName()]; ok {
+		name := strings.TrimSuffix(string(f.Message.Desc.Name()), "Value")
+		return fmt.Sprintf("%s(%s)", g.QualifiedGoIdent(wrapperspbPkg.Ident(name)), v)
+	}
+	return v
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"text/template"
 
 	"entgo.io/contrib/entproto"
 	"entgo.io/ent/entc/gen"
@@ -38,7 +39,10 @@ type BinaryMarshallerUnmarshaller interface {
 }
 
 type converter struct {
-	ToEntConversion              string
+	ToEntConversion string
+	// ToEntRangeCheck reports whether ToEntConversion narrows the protobuf value, which is then checked to be
+	// in the range of the ent type.
+	ToEntRangeCheck              bool
 	ToEntScannerConversion       string
 	ToEntConstructor             protogen.GoIdent
 	ToEntMarshallerConstructor   protogen.GoIdent
@@ -48,9 +52,42 @@ type converter struct {
 	ToProtoConstructor           protogen.GoIdent
 	toProtoMarshallerConstructor protogen.GoIdent
 	ToProtoValuer                string
+	// TypeMapping is set for fields whose Go type has a registered entproto.TypeMapping.
+	TypeMapping *mappedConverter
+}
+
+// mappedConverter converts the values of fields whose Go type has a registered entproto.TypeMapping.
+type mappedConverter struct {
+	*entproto.TypeMapping
+	toProto, toEnt *template.Template
+}
+
+// ToProtoExpr returns the Go expression converting the ent value v to its protobuf representation.
+func (c *mappedConverter) ToProtoExpr(v string) (string, error) {
+	return execute(c.toProto, v)
+}
+
+// ToEntExpr returns the Go expression converting the protobuf value v to its ent representation.
+func (c *mappedConverter) ToEntExpr(v string) (string, error) {
+	return execute(c.toEnt, v)
+}
+
+func execute(t *template.Template, v string) (string, error) {
+	// Dereferenced values of nillable fields are parenthesized to allow selectors on them.
+	if strings.HasPrefix(v, "*") {
+		v = "(" + v + ")"
+	}
+	var b strings.Builder
+	if err := t.Execute(&b, v); err != nil {
+		return "", fmt.Errorf("entproto: failed executing %s converter: %w", t.Name(), err)
+	}
+	return b.String(), nil
 }
 
 func (g *serviceGenerator) newConverter(fld *entproto.FieldMappingDescriptor) (*converter, error) {
+	if fld.TypeMapping != nil {
+		return g.newMappedConverter(fld)
+	}
 	out := &converter{}
 	pbd := fld.PbFieldDescriptor
 	switch pbd.GetType() {
@@ -59,13 +96,22 @@ func (g *serviceGenerator) newConverter(fld *entproto.FieldMappingDescriptor) (*
 		dpb.FieldDescriptorProto_TYPE_INT64, dpb.FieldDescriptorProto_TYPE_UINT32,
 		dpb.FieldDescriptorProto_TYPE_UINT64, dpb.FieldDescriptorProto_TYPE_FLOAT,
 		dpb.FieldDescriptorProto_TYPE_DOUBLE:
-		if err := basicTypeConversion(fld.PbFieldDescriptor, fld.EntField, out); err != nil {
+		entField := fld.EntField
+		if fld.IsEdgeField {
+			// Edge fields of an edge IDs message hold the IDs of the referenced type directly.
+			entField = fld.EntEdge.Type.ID
+		}
+		if entField.IsJSON() {
+			// Repeated fields of JSON slices have the same Go type as the ent field.
+			break
+		}
+		if err := basicTypeConversion(fld.PbFieldDescriptor, entField, out); err != nil {
 			return nil, err
 		}
 	case dpb.FieldDescriptorProto_TYPE_ENUM:
 		enumName := fld.PbFieldDescriptor.GetEnumType().GetName()
 		method := fmt.Sprintf("toProto%s_%s", g.EntType.Name, enumName)
-		out.ToProtoConstructor = g.File.GoImportPath.Ident(method)
+		out.ToProtoConstructor = g.Package.GoImportPath.Ident(method)
 	case dpb.FieldDescriptorProto_TYPE_MESSAGE:
 		if fld.IsEdgeField {
 			if err := basicTypeConversion(fld.EdgeIDPbStructFieldDesc(), fld.EntEdge.Type.ID, out); err != nil {
@@ -105,19 +151,45 @@ func (g *serviceGenerator) newConverter(fld *entproto.FieldMappingDescriptor) (*
 	case efld.IsBool(), efld.IsBytes(), efld.IsString():
 	case efld.Type.Numeric():
 		out.ToEntConversion = efld.Type.String()
+		out.ToEntRangeCheck = narrowsInt(pbGoType(fld), efld.Type.Type.String())
 	case efld.IsTime():
 		out.ToEntConstructor = protogen.GoImportPath("entgo.io/contrib/entproto/runtime").Ident("ExtractTime")
 	case efld.IsEnum():
 		enumName := fld.PbFieldDescriptor.GetEnumType().GetName()
 		method := fmt.Sprintf("toEnt%s_%s", g.EntType.Name, enumName)
-		out.ToEntConstructor = g.File.GoImportPath.Ident(method)
-	case efld.IsJSON() && efld.Type.Ident == "[]string":
+		out.ToEntConstructor = g.Package.GoImportPath.Ident(method)
+	case efld.IsJSON() && fld.PbFieldDescriptor.IsRepeated():
 	default:
 		return nil, fmt.Errorf("entproto: no mapping to ent field type %q", efld.Type.ConstName())
 	}
 	return out, nil
 }
 
+// newMappedConverter returns the converter of a field whose Go type has a registered entproto.TypeMapping.
+// Optional fields are wrapped in the wrapper type of the mapped protobuf type.
+func (g *serviceGenerator) newMappedConverter(fld *entproto.FieldMappingDescriptor) (*converter, error) {
+	toProto, toEnt, err := fld.TypeMapping.Template(template.FuncMap{
+		"qualify": func(pkg, ident string) string {
+			return g.QualifiedGoIdent(protogen.GoImportPath(pkg).Ident(ident))
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := &converter{
+		TypeMapping: &mappedConverter{TypeMapping: fld.TypeMapping, toProto: toProto, toEnt: toEnt},
+	}
+	if md := fld.PbFieldDescriptor.GetMessageType(); md != nil && !fld.IsEdgeField {
+		if !isWrapperType(md) {
+			return nil, fmt.Errorf("entproto: no mapping for pb field type %q", md.GetFullyQualifiedName())
+		}
+		typ := strings.Split(md.GetFullyQualifiedName(), ".")[2]
+		out.ToProtoConstructor = protogen.GoImportPath("google.golang.org/protobuf/types/known/wrapperspb").Ident(strings.TrimSuffix(typ, "Value"))
+		out.ToEntModifier = ".GetValue()"
+	}
+	return out, nil
+}
+
 // Supported value scanner types (https://golang.org/pkg/database/sql/driver/#Value): [int64, float64, bool, []byte, string, time.Time]
 func basicTypeConversion(md *desc.FieldDescriptor, entField *gen.Field, conv *converter) error {
 	switch md.GetType() {
@@ -191,6 +263,57 @@ func convertPbMessageType(md *desc.MessageDescriptor, entField *gen.Field, conv
 	return nil
 }
 
+// pbGoType returns the Go type of the protobuf value converted to the ent field or edge ID of fld, which
+// is the type of the ID field of the referenced message for edges.
+func pbGoType(fld *entproto.FieldMappingDescriptor) string {
+	pbd := fld.PbFieldDescriptor
+	if fld.IsEdgeField && pbd.GetMessageType() != nil {
+		pbd = fld.EdgeIDPbStructFieldDesc()
+	}
+	if md := pbd.GetMessageType(); md != nil {
+		return wrapperPrimitives[md.GetFullyQualifiedName()]
+	}
+	return scalarPrimitives[pbd.GetType()]
+}
+
+// narrowsInt reports whether converting a value of the integer type from to the integer type to may
+// truncate it or change its sign.
+func narrowsInt(from, to string) bool {
+	f, ok1 := intTypes[from]
+	t, ok2 := intTypes[to]
+	return ok1 && ok2 && (t.bits < f.bits || t.signed != f.signed)
+}
+
+// intTypes holds the sizes and signedness of the Go integer types.
+var intTypes = map[string]struct {
+	bits   int
+	signed bool
+}{
+	"int":    {64, true},
+	"int8":   {8, true},
+	"int16":  {16, true},
+	"int32":  {32, true},
+	"int64":  {64, true},
+	"uint":   {64, false},
+	"uint8":  {8, false},
+	"uint16": {16, false},
+	"uint32": {32, false},
+	"uint64": {64, false},
+}
+
+var scalarPrimitives = map[dpb.FieldDescriptorProto_Type]string{
+	dpb.FieldDescriptorProto_TYPE_INT32:    "int32",
+	dpb.FieldDescriptorProto_TYPE_SINT32:   "int32",
+	dpb.FieldDescriptorProto_TYPE_SFIXED32: "int32",
+	dpb.FieldDescriptorProto_TYPE_INT64:    "int64",
+	dpb.FieldDescriptorProto_TYPE_SINT64:   "int64",
+	dpb.FieldDescriptorProto_TYPE_SFIXED64: "int64",
+	dpb.FieldDescriptorProto_TYPE_UINT32:   "uint32",
+	dpb.FieldDescriptorProto_TYPE_FIXED32:  "uint32",
+	dpb.FieldDescriptorProto_TYPE_UINT64:   "uint64",
+	dpb.FieldDescriptorProto_TYPE_FIXED64:  "uint64",
+}
+
 func isWrapperType(md *desc.MessageDescriptor) bool {
 	_, ok := wrapperPrimitives[md.GetFullyQualifiedName()]
 	return ok
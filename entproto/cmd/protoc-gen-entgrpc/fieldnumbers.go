@@ -0,0 +1,61 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"path"
+	"text/template"
+
+	"entgo.io/ent/entc/gen"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// fieldNumbersGenerator generates the package declaring the numbers and names of the fields of the messages
+// of a file, next to the package of the file.
+type fieldNumbersGenerator struct {
+	*protogen.GeneratedFile
+	File        *protogen.File
+	PackageName string
+}
+
+// generateFieldNumbers generates the <package>fields package of the file, for example "entpbfields" for the
+// messages of the "entpb" package, unless the file has no messages.
+func generateFieldNumbers(plugin *protogen.Plugin, file *protogen.File) error {
+	if len(file.Messages) == 0 {
+		return nil
+	}
+	pkg := string(file.GoPackageName) + "fields"
+	filename := path.Join(path.Dir(file.GeneratedFilenamePrefix), pkg, pkg+".go")
+	g := &fieldNumbersGenerator{
+		GeneratedFile: plugin.NewGeneratedFile(filename, protogen.GoImportPath(path.Join(string(file.GoImportPath), pkg))),
+		File:          file,
+		PackageName:   pkg,
+	}
+	tmpl, err := gen.NewTemplate("field_numbers").
+		Funcs(template.FuncMap{
+			"qualify": func(pkg, ident string) string {
+				return g.QualifiedGoIdent(protogen.GoImportPath(pkg).Ident(ident))
+			},
+		}).
+		ParseFS(templates, "template/field_numbers.tmpl")
+	if err != nil {
+		return err
+	}
+	if err := tmpl.ExecuteTemplate(g, "field_numbers", g); err != nil {
+		return fmt.Errorf("template execution failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"text/template"
+
+	"entgo.io/ent/entc/gen"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+)
+
+// gatewayGenerator generates the function registering the grpc-gateway handlers of the services of a file.
+type gatewayGenerator struct {
+	*protogen.GeneratedFile
+	File     *protogen.File
+	Services []*protogen.Service
+}
+
+// generateGateway generates the RegisterGatewayHandlers function of the file, registering the handlers generated
+// by protoc-gen-grpc-gateway for its services, unless none of their methods has a google.api.http option.
+func generateGateway(plugin *protogen.Plugin, file *protogen.File) error {
+	var services []*protogen.Service
+	for _, s := range file.Services {
+		if hasHTTPRule(s) {
+			services = append(services, s)
+		}
+	}
+	if len(services) == 0 {
+		return nil
+	}
+	g := &gatewayGenerator{
+		GeneratedFile: plugin.NewGeneratedFile(file.GeneratedFilenamePrefix+"_gateway.go", file.GoImportPath),
+		File:          file,
+		Services:      services,
+	}
+	tmpl, err := gen.NewTemplate("gateway").
+		Funcs(template.FuncMap{
+			"qualify": func(pkg, ident string) string {
+				return g.QualifiedGoIdent(protogen.GoImportPath(pkg).Ident(ident))
+			},
+		}).
+		ParseFS(templates, "template/gateway.tmpl")
+	if err != nil {
+		return err
+	}
+	if err := tmpl.ExecuteTemplate(g, "gateway", g); err != nil {
+		return fmt.Errorf("template execution failed: %w", err)
+	}
+	return nil
+}
+
+// hasHTTPRule reports whether a method of the service has a google.api.http option, for which
+// protoc-gen-grpc-gateway generates the Register<Service>Handler function of the service.
+func hasHTTPRule(s *protogen.Service) bool {
+	for _, m := range s.Methods {
+		if opts := m.Desc.Options(); opts != nil && proto.HasExtension(opts, annotations.E_Http) {
+			return true
+		}
+	}
+	return false
+}
@@ -19,19 +19,37 @@ import (
 	"flag"
 	"fmt"
 	"path"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"text/template"
 
 	"entgo.io/contrib/entproto"
+	entprotoopts "entgo.io/contrib/entproto/options/entproto"
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"github.com/jhump/protoreflect/desc"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 var (
 	entSchemaPath *string
+	fieldNumbers  *bool
+	metrics       *bool
+	client        *bool
+	mocks         *bool
+	tests         *bool
+	edgeDepth     *int
+	layout        *string
+	svcPackage    *string
+	uniqueEdgeIDs *bool
 	snake         = gen.Funcs["snake"].(func(string) string)
+	plural        = gen.Funcs["plural"].(func(string) string)
 	status        = protogen.GoImportPath("google.golang.org/grpc/status")
 	codes         = protogen.GoImportPath("google.golang.org/grpc/codes")
 )
@@ -39,10 +57,28 @@ var (
 func main() {
 	var flags flag.FlagSet
 	entSchemaPath = flags.String("schema_path", "", "ent schema path")
+	fieldNumbers = flags.Bool("field_numbers", false, "generate a package with the field numbers and names of the messages")
+	metrics = flags.Bool("metrics", false, "record the metrics of the methods of the services with the recorder of the runtime.Config")
+	client = flags.Bool("client", false, "generate a package wrapping the gRPC clients of the services with ent-like builders")
+	mocks = flags.Bool("mocks", false, "generate a package with fakes of the services recording their calls")
+	tests = flags.Bool("tests", false, "generate a test suite per service running its methods against an in-memory SQLite database")
+	edgeDepth = flags.Int("edge_depth", 1, "the depth of the eager-loaded edges converted into their messages by the ToProto<T>WithEdges and ToEnt<T>WithEdges functions")
+	svcPackage = flags.String("service_package", "", "the Go import path of the package of the generated services, registration functions and test suites, the package of the messages by default")
+	uniqueEdgeIDs = flags.Bool("unique_edge_ids", false, "load the IDs of the unique edges in all the views of the Get, BatchGet and List methods, rather than only in the WITH_EDGE_IDS view")
+	layout = flags.String("layout", "file", `the layout of the generated services: "file" generates a file per service, "method" a file per method of the services along with a file holding the rest of each service`)
 	protogen.Options{
 		ParamFunc: flags.Set,
 	}.Run(func(plg *protogen.Plugin) error {
-		g, err := entc.LoadGraph(*entSchemaPath, &gen.Config{})
+		if *layout != "file" && *layout != "method" {
+			return fmt.Errorf("entproto: invalid layout %q, expected \"file\" or \"method\"", *layout)
+		}
+		abs, err := filepath.Abs(*entSchemaPath)
+		if err != nil {
+			return err
+		}
+		// The lock and type mappings files of entproto are resolved relative to the codegen
+		// target, which defaults to one directory above the schema.
+		g, err := entc.LoadGraph(*entSchemaPath, &gen.Config{Target: filepath.Dir(abs)})
 		if err != nil {
 			return err
 		}
@@ -53,6 +89,32 @@ func main() {
 			if err := processFile(plg, f, g); err != nil {
 				return err
 			}
+			if err := generateRegister(plg, f, g); err != nil {
+				return err
+			}
+			if err := generateGateway(plg, f); err != nil {
+				return err
+			}
+			if *fieldNumbers {
+				if err := generateFieldNumbers(plg, f); err != nil {
+					return err
+				}
+			}
+			if *client {
+				if err := generateClient(plg, f); err != nil {
+					return err
+				}
+			}
+			if *mocks {
+				if err := generateMocks(plg, f); err != nil {
+					return err
+				}
+			}
+			if *tests {
+				if err := generateTests(plg, f, g); err != nil {
+					return err
+				}
+			}
 		}
 		return nil
 	})
@@ -63,8 +125,17 @@ func processFile(gen *protogen.Plugin, file *protogen.File, graph *gen.Graph) er
 	if len(file.Services) == 0 {
 		return nil
 	}
+	// Schemas annotated with entproto.SplitReadWrite have several services in the file.
+	services := make(map[string][]*protogen.Service)
+	for _, s := range file.Services {
+		typ, err := extractEntTypeName(s, graph)
+		if err != nil {
+			return err
+		}
+		services[typ.Name] = append(services[typ.Name], s)
+	}
 	for _, s := range file.Services {
-		sg, err := newServiceGenerator(gen, file, graph, s)
+		sg, err := newServiceGenerator(gen, file, graph, s, services)
 		if err != nil {
 			return err
 		}
@@ -75,7 +146,7 @@ func processFile(gen *protogen.Plugin, file *protogen.File, graph *gen.Graph) er
 	return nil
 }
 
-func newServiceGenerator(plugin *protogen.Plugin, file *protogen.File, graph *gen.Graph, service *protogen.Service) (*serviceGenerator, error) {
+func newServiceGenerator(plugin *protogen.Plugin, file *protogen.File, graph *gen.Graph, service *protogen.Service, services map[string][]*protogen.Service) (*serviceGenerator, error) {
 	adapter, err := entproto.LoadAdapter(graph)
 	if err != nil {
 		return nil, err
@@ -84,28 +155,75 @@ func newServiceGenerator(plugin *protogen.Plugin, file *protogen.File, graph *ge
 	if err != nil {
 		return nil, err
 	}
-	filename := file.GeneratedFilenamePrefix + "_" + snake(service.GoName) + ".go"
-	g := plugin.NewGeneratedFile(filename, file.GoImportPath)
+	pkg, err := newServicePackage(file)
+	if err != nil {
+		return nil, err
+	}
+	g := plugin.NewGeneratedFile(pkg.filename(file, snake(service.GoName)+".go"), pkg.GoImportPath)
 	fieldMap, err := adapter.FieldMap(typ.Name)
 	if err != nil {
 		return nil, err
 	}
+	edgeIDsFieldMap, err := adapter.EdgeIDsFieldMap(typ.Name)
+	if err != nil {
+		return nil, err
+	}
 	return &serviceGenerator{
-		GeneratedFile: g,
-		EntPackage:    protogen.GoImportPath(graph.Config.Package),
-		File:          file,
-		Service:       service,
-		EntType:       typ,
-		FieldMap:      fieldMap,
+		GeneratedFile:   g,
+		plugin:          plugin,
+		Package:         pkg,
+		EntPackage:      protogen.GoImportPath(graph.Config.Package),
+		File:            file,
+		Service:         service,
+		EntType:         typ,
+		FieldMap:        fieldMap,
+		EdgeIDsFieldMap: edgeIDsFieldMap,
+		SchemaServices:  services[typ.Name],
+		FileServices:    services,
 	}, nil
 }
 
 func (g *serviceGenerator) generate() error {
-	tmpl, err := gen.NewTemplate("service").
+	tmpl, err := g.template()
+	if err != nil {
+		return err
+	}
+	if err := tmpl.ExecuteTemplate(g, "service", g); err != nil {
+		return fmt.Errorf("template execution failed: %w", err)
+	}
+	if !g.MethodLayout() {
+		return nil
+	}
+	methods, err := g.GeneratedMethods()
+	if err != nil {
+		return err
+	}
+	for _, m := range methods {
+		filename := g.Package.filename(g.File, snake(g.Service.GoName)+"_"+snake(m.GoName)+".go")
+		mg := *g
+		mg.GeneratedFile = g.plugin.NewGeneratedFile(filename, g.Package.GoImportPath)
+		tmpl, err := mg.template()
+		if err != nil {
+			return err
+		}
+		if err := tmpl.ExecuteTemplate(&mg, "service_method_file", &methodInput{G: &mg, Method: m}); err != nil {
+			return fmt.Errorf("template execution failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// template returns the templates of the service, qualifying the identifiers they reference in the generated file
+// of g.
+func (g *serviceGenerator) template() (*gen.Template, error) {
+	return gen.NewTemplate("service").
 		Funcs(template.FuncMap{
 			"ident":        g.QualifiedGoIdent,
 			"entIdent":     g.entIdent,
 			"newConverter": g.newConverter,
+			"pbEnumIdent":  g.pbEnumIdent,
+			"pbIdent":      g.pbIdent,
+			"goTypeIdent":  goTypeIdent,
 			"unquote":      strconv.Unquote,
 			"qualify": func(pkg, ident string) string {
 				return g.QualifiedGoIdent(protogen.GoImportPath(pkg).Ident(ident))
@@ -125,6 +243,17 @@ func (g *serviceGenerator) generate() error {
 					strings.Join(args, ","),
 				)
 			},
+			// mapErrf is like statusErrf, but first consults the ErrorMapper of the service with its last argument, the
+			// error being mapped, see runtime.WithErrorMapper.
+			// It can only be used in the methods of the service.
+			"mapErrf": func(code, format string, args ...string) string {
+				return fmt.Sprintf("svc.config.MapError(%s, %s, %s, %s)",
+					args[len(args)-1],
+					g.QualifiedGoIdent(codes.Ident(code)),
+					strconv.Quote(format),
+					strings.Join(args, ","),
+				)
+			},
 			"method": func(m *protogen.Method) *methodInput {
 				return &methodInput{
 					G:      g,
@@ -133,43 +262,592 @@ func (g *serviceGenerator) generate() error {
 			},
 		}).
 		ParseFS(templates, "template/*.tmpl")
-	if err != nil {
-		return err
-	}
-	if err := tmpl.ExecuteTemplate(g, "service", g); err != nil {
-		return fmt.Errorf("template execution failed: %w", err)
-	}
-	return nil
 }
 
 type (
 	serviceGenerator struct {
 		*protogen.GeneratedFile
+		plugin *protogen.Plugin
+		// Package is the package of the generated service, see the service_package flag.
+		Package    *servicePackage
 		EntPackage protogen.GoImportPath
 		File       *protogen.File
 		Service    *protogen.Service
 		EntType    *gen.Type
 		FieldMap   entproto.FieldMap
+		// EdgeIDsFieldMap maps the edges of the schema to the fields of its edge IDs message,
+		// if the schema is annotated with entproto.EdgeIDsMessage.
+		EdgeIDsFieldMap entproto.FieldMap
+		// SchemaServices holds the services of the schema in the file, more than one if the schema is
+		// annotated with entproto.SplitReadWrite.
+		SchemaServices []*protogen.Service
+		// FileServices holds the services of the file, keyed by the name of their schema.
+		FileServices map[string][]*protogen.Service
 	}
 	methodInput struct {
 		G      *serviceGenerator
 		Method *protogen.Method
 	}
+	pageSize struct {
+		Default, Max int
+	}
+	// filteringField is a field of the schema that can be referenced by the filter expressions of the List
+	// method of the service.
+	filteringField struct {
+		*entproto.FieldMappingDescriptor
+		// Type is the name of the filtering.Type of the values of the field, e.g. "String".
+		Type string
+	}
+	totalSize struct {
+		// Max is the number at which counting stops, or 0 if it is not capped.
+		Max int
+	}
 )
 
+// HasEdgeIDsMessage reports whether the schema of the service is annotated with entproto.EdgeIDsMessage.
+func (g *serviceGenerator) HasEdgeIDsMessage() bool {
+	return g.EdgeIDsFieldMap != nil
+}
+
+// Metrics reports whether the methods of the service record their metrics, see the metrics flag.
+func (g *serviceGenerator) Metrics() bool {
+	return *metrics
+}
+
+// DeclaresHelpers reports whether the service declares the package-level functions converting the entities of
+// the schema. They are declared by the first service of the schema and shared by the others.
+func (g *serviceGenerator) DeclaresHelpers() bool {
+	return g.SchemaServices[0] == g.Service
+}
+
+// MethodLayout reports whether the generated methods of the service are declared in a file of their own, see the
+// layout flag.
+func (g *serviceGenerator) MethodLayout() bool {
+	return *layout == "method"
+}
+
+// EdgeDepth returns the depth of the edges converted by the exported converters of the schema, see the
+// edge_depth flag.
+func (g *serviceGenerator) EdgeDepth() int {
+	return *edgeDepth
+}
+
+// UniqueEdgeIDs returns the unique edges whose IDs are loaded by the Get, BatchGet and List methods of the service
+// in all the views, see the unique_edge_ids flag.
+func (g *serviceGenerator) UniqueEdgeIDs() []*entproto.FieldMappingDescriptor {
+	if !*uniqueEdgeIDs {
+		return nil
+	}
+	fm := g.FieldMap
+	if g.HasEdgeIDsMessage() {
+		fm = g.EdgeIDsFieldMap
+	}
+	var edges []*entproto.FieldMappingDescriptor
+	for _, e := range fm.Edges() {
+		if e.EntEdge.Unique {
+			edges = append(edges, e)
+		}
+	}
+	return edges
+}
+
+// ConvertedEdges returns the edges of the schema converted into their messages by the exported converters of the
+// schema. Those are the edges held in messages whose type has a service in the file, and thus its own converters.
+func (g *serviceGenerator) ConvertedEdges() []*entproto.FieldMappingDescriptor {
+	var edges []*entproto.FieldMappingDescriptor
+	for _, e := range g.FieldMap.Edges() {
+		if !e.IsEdgeIDsField() && len(g.FileServices[e.EntEdge.Type.Name]) > 0 {
+			edges = append(edges, e)
+		}
+	}
+	return edges
+}
+
+// SchemaMethods returns the methods of all services of the schema, leaving out the ones rejected by the read-only
+// services.
+func (g *serviceGenerator) SchemaMethods() ([]*protogen.Method, error) {
+	readOnly, err := g.ReadOnly()
+	if err != nil {
+		return nil, err
+	}
+	var methods []*protogen.Method
+	for _, s := range g.SchemaServices {
+		for _, m := range s.Methods {
+			if !readOnly || !g.IsMutation(m) {
+				methods = append(methods, m)
+			}
+		}
+	}
+	return methods, nil
+}
+
+// GeneratedMethods returns the methods of the service implemented by the generator, leaving out the methods
+// added by entproto.ExtraMethod and the ones rejected by the read-only services.
+func (g *serviceGenerator) GeneratedMethods() ([]*protogen.Method, error) {
+	generated, _, err := splitMethods(g.Service, g.EntType)
+	if err != nil {
+		return nil, err
+	}
+	readOnly, err := g.ReadOnly()
+	if err != nil || !readOnly {
+		return generated, err
+	}
+	var methods []*protogen.Method
+	for _, m := range generated {
+		if !g.IsMutation(m) {
+			methods = append(methods, m)
+		}
+	}
+	return methods, nil
+}
+
+// RejectedMethods returns the methods of the service mutating the entities if the service is read-only, which
+// reject all requests.
+func (g *serviceGenerator) RejectedMethods() ([]*protogen.Method, error) {
+	readOnly, err := g.ReadOnly()
+	if err != nil || !readOnly {
+		return nil, err
+	}
+	generated, _, err := splitMethods(g.Service, g.EntType)
+	if err != nil {
+		return nil, err
+	}
+	var methods []*protogen.Method
+	for _, m := range generated {
+		if g.IsMutation(m) {
+			methods = append(methods, m)
+		}
+	}
+	return methods, nil
+}
+
+// ExtraMethods returns the methods of the service added by entproto.ExtraMethod, implemented by the
+// <Service>ExtraMethods passed to the constructor of the service.
+func (g *serviceGenerator) ExtraMethods() ([]*protogen.Method, error) {
+	_, extra, err := splitMethods(g.Service, g.EntType)
+	return extra, err
+}
+
+// splitMethods splits the methods of the service s of the schema t into the methods implemented by the generator
+// and the methods added by entproto.ExtraMethod.
+func splitMethods(s *protogen.Service, t *gen.Type) (generated, extra []*protogen.Method, err error) {
+	names, err := entproto.ExtraMethodNames(t)
+	if err != nil {
+		return nil, nil, err
+	}
+	isExtra := make(map[string]bool, len(names))
+	for _, name := range names {
+		isExtra[name] = true
+	}
+	for _, m := range s.Methods {
+		if isExtra[string(m.Desc.Name())] {
+			extra = append(extra, m)
+		} else {
+			generated = append(generated, m)
+		}
+	}
+	return generated, extra, nil
+}
+
+// HookMethods returns the Create, Update and Delete methods of the service, which run the lifecycle hooks
+// registered on the service. The read-only services have none.
+func (g *serviceGenerator) HookMethods() ([]*protogen.Method, error) {
+	readOnly, err := g.ReadOnly()
+	if err != nil || readOnly {
+		return nil, err
+	}
+	var methods []*protogen.Method
+	for _, m := range g.Service.Methods {
+		switch m.GoName {
+		case "Create", "Update", "Delete":
+			methods = append(methods, m)
+		}
+	}
+	return methods, nil
+}
+
+// HookMethod returns the Create, Update or Delete method of the service whose hooks are named after name, or nil
+// if the service does not run them. The other mutations run the hooks of these methods for each of their entries.
+func (g *serviceGenerator) HookMethod(name string) (*protogen.Method, error) {
+	methods, err := g.HookMethods()
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range methods {
+		if m.GoName == name {
+			return m, nil
+		}
+	}
+	return nil, nil
+}
+
+// IsMutation reports whether the method m of the service mutates the entities, and is rejected by the read-only
+// services.
+func (g *serviceGenerator) IsMutation(m *protogen.Method) bool {
+	switch name := m.GoName; name {
+	case "Create", "Update", "Delete", "BatchCreate", "BatchUpdate", "BatchDelete", "Upsert", "Import",
+		"Delete" + plural(g.EntType.Name), "Restore" + g.EntType.Name:
+		return true
+	default:
+		return strings.HasPrefix(name, "Add"+g.EntType.Name) || strings.HasPrefix(name, "Remove"+g.EntType.Name)
+	}
+}
+
+// WatchMethod returns the Watch method of the service, or nil if it has none. The events of the method are
+// published by a mutation hook registered on the ent client.
+func (g *serviceGenerator) WatchMethod() *protogen.Method {
+	for _, m := range g.Service.Methods {
+		if m.GoName == "Watch"+g.EntType.Name {
+			return m
+		}
+	}
+	return nil
+}
+
+// PageSize returns the default and maximum page sizes of the List method of the service, or nil if they
+// are both entproto.MaxPageSize.
+func (g *serviceGenerator) PageSize() (*pageSize, error) {
+	def, max, err := entproto.PageSizes(g.EntType)
+	if err != nil {
+		return nil, err
+	}
+	if def == entproto.MaxPageSize && max == entproto.MaxPageSize {
+		return nil, nil
+	}
+	return &pageSize{Default: def, Max: max}, nil
+}
+
+// TotalSize returns the counting limit of the total_size field of the List responses of the service, or nil
+// if they do not hold the total number of entries.
+func (g *serviceGenerator) TotalSize() (*totalSize, error) {
+	ok, max, err := entproto.ListTotalSize(g.EntType)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return &totalSize{Max: max}, nil
+}
+
+// PaginationKey returns the field paging the List method of the service, or nil if it is paged by ID.
+func (g *serviceGenerator) PaginationKey() (*gen.Field, error) {
+	return entproto.PaginationKeyField(g.EntType)
+}
+
+// SoftDeleteField returns the field marking the entities of the service as deleted, or nil if they are not
+// soft-deleted.
+func (g *serviceGenerator) SoftDeleteField() (*gen.Field, error) {
+	return entproto.SoftDeleteField(g.EntType)
+}
+
+// TenantField returns the field holding the tenant of the entities of the service, or nil if the service is not
+// scoped to a tenant.
+func (g *serviceGenerator) TenantField() (*gen.Field, error) {
+	return entproto.TenantField(g.EntType)
+}
+
+// TenantEdges returns the edges of the schema of a service scoped to a tenant pointing to schemas whose services
+// are also scoped to a tenant, whose IDs set by the requests are checked against the tenant of the request.
+func (g *serviceGenerator) TenantEdges() ([]*entproto.TenantEdge, error) {
+	return entproto.TenantEdges(g.EntType)
+}
+
+// IdempotencyKeyField returns the field storing the idempotency keys of the Create requests of the service, or nil
+// if its Create method is not idempotent.
+func (g *serviceGenerator) IdempotencyKeyField() (*gen.Field, error) {
+	return entproto.IdempotencyKeyField(g.EntType)
+}
+
+// EtagField returns the mapping of the field versioning the entities of the service, or nil if they are not
+// versioned.
+func (g *serviceGenerator) EtagField() (*entproto.FieldMappingDescriptor, error) {
+	fld, err := entproto.EtagField(g.EntType)
+	if err != nil || fld == nil {
+		return nil, err
+	}
+	fd, ok := g.FieldMap[fld.Name]
+	if !ok {
+		return nil, fmt.Errorf("entproto: etag field %q of schema %q is not part of its message", fld.Name, g.EntType.Name)
+	}
+	return fd, nil
+}
+
+// IsEtagField reports whether fld is the field versioning the entities of the service.
+func (g *serviceGenerator) IsEtagField(fld *entproto.FieldMappingDescriptor) (bool, error) {
+	etag, err := g.EtagField()
+	if err != nil || etag == nil {
+		return false, err
+	}
+	return etag == fld, nil
+}
+
+// constraintColumn is a column of the table of the schema of a service, see ConstraintColumns.
+type constraintColumn struct {
+	// Column is the name of the column in the database.
+	Column string
+	// Field is the name of the proto field holding the value of the column.
+	Field string
+	// Edge reports whether the column is the foreign key of an edge. The edges of the services with an edge IDs
+	// message are set in the edge_ids field of their requests.
+	Edge bool
+}
+
+// ConstraintColumns returns the columns of the table of the schema of the service with a unique or foreign key
+// constraint, that are set by the fields of its message, sorted by column. They name the fields violating the
+// constraints of the database.
+func (g *serviceGenerator) ConstraintColumns() []constraintColumn {
+	cols := make(map[string]constraintColumn)
+	for _, fd := range g.FieldMap.Fields() {
+		if !fd.IsIDField && !fd.EntField.Unique {
+			continue
+		}
+		cols[fd.EntField.StorageKey()] = constraintColumn{Column: fd.EntField.StorageKey(), Field: fd.PbFieldDescriptor.GetName()}
+	}
+	edges := g.FieldMap.Edges()
+	if g.HasEdgeIDsMessage() {
+		edges = g.EdgeIDsFieldMap.Edges()
+	}
+	for _, fd := range edges {
+		e := fd.EntEdge
+		if !e.OwnFK() {
+			continue
+		}
+		if _, ok := cols[e.Rel.Column()]; !ok {
+			cols[e.Rel.Column()] = constraintColumn{Column: e.Rel.Column(), Field: fd.PbFieldDescriptor.GetName(), Edge: g.HasEdgeIDsMessage()}
+		}
+	}
+	out := make([]constraintColumn, 0, len(cols))
+	for _, c := range cols {
+		out = append(out, c)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Column < out[j].Column
+	})
+	return out
+}
+
+// HasReadMask reports whether the Get and List requests of the service select the returned fields.
+func (g *serviceGenerator) HasReadMask() (bool, error) {
+	return entproto.HasReadMask(g.EntType)
+}
+
+// HasUpdateMask reports whether the Update requests of the service select the updated fields.
+func (g *serviceGenerator) HasUpdateMask() (bool, error) {
+	return entproto.HasUpdateMask(g.EntType)
+}
+
+// HasViewerContext reports whether the methods of the service add the viewer of the requests to their context.
+func (g *serviceGenerator) HasViewerContext() (bool, error) {
+	return entproto.HasViewerContext(g.EntType)
+}
+
+// HasPartialBatchCreate reports whether the BatchCreate method of the service creates the entries of the
+// request one by one, responding with a result per entry.
+func (g *serviceGenerator) HasPartialBatchCreate() (bool, error) {
+	return entproto.HasPartialBatchCreate(g.EntType)
+}
+
+// FilterExpression reports whether the filter of the List method of the service is an AIP-160 filter expression.
+func (g *serviceGenerator) FilterExpression() (bool, error) {
+	return entproto.HasFilterExpression(g.EntType)
+}
+
+// FilteringFields returns the fields that can be referenced by the filter expressions of the List method of the
+// service: the fields of the <T>Filter message of the schema, along with its enum fields. The fields annotated
+// with entproto.RedactUnless are left out, as the expressions are parsed regardless of the roles of the callers.
+func (g *serviceGenerator) FilteringFields() []*filteringField {
+	var fields []*filteringField
+	for _, f := range g.FieldMap.Fields() {
+		// The errors of the annotation are reported by Redactions.
+		if role, _ := f.RedactedRole(); role != "" {
+			continue
+		}
+		var typ string
+		switch t := f.EntField.Type.Type; {
+		case f.IsEnumField:
+			if !f.EntField.Sensitive() {
+				typ = "String"
+			}
+		case f.Filter() == nil:
+		case t == field.TypeString:
+			typ = "String"
+		case t == field.TypeBool:
+			typ = "Bool"
+		case t == field.TypeTime:
+			typ = "Time"
+		case t.Float():
+			typ = "Float"
+		case strings.HasPrefix(t.String(), "uint"):
+			typ = "Uint"
+		default:
+			typ = "Int"
+		}
+		if typ != "" {
+			fields = append(fields, &filteringField{FieldMappingDescriptor: f, Type: typ})
+		}
+	}
+	return fields
+}
+
+// DeleteReturnsEntity reports whether the Delete method of the service returns the deleted entity.
+func (g *serviceGenerator) DeleteReturnsEntity() (bool, error) {
+	return entproto.HasDeleteReturnsEntity(g.EntType)
+}
+
+// Cacheable reports whether the Get method of the service serves the entities from the cache of its config. The
+// services scoped to a tenant or to the viewer of the requests, and the ones of schemas with privacy policies,
+// are not cached, as their entities are not visible to all the requests.
+func (g *serviceGenerator) Cacheable() (bool, error) {
+	// The entities cached without their edge IDs message would be served without the IDs of their unique edges.
+	if g.EntType.NumPolicy() > 0 || g.HasEdgeIDsMessage() && len(g.UniqueEdgeIDs()) > 0 {
+		return false, nil
+	}
+	if fld, err := g.TenantField(); err != nil || fld != nil {
+		return false, err
+	}
+	viewer, err := g.HasViewerContext()
+	return !viewer, err
+}
+
+// redaction is a field of the responses of the service cleared unless the caller holds Role.
+type redaction struct {
+	// Name is the full name of the field, such as "entpb.User.ssn".
+	Name string
+	Role string
+}
+
+// Redactions returns the fields of the messages of the service annotated with entproto.RedactUnless, including
+// the fields of its edge IDs message.
+func (g *serviceGenerator) Redactions() ([]redaction, error) {
+	fields := append(g.FieldMap.Fields(), g.FieldMap.Edges()...)
+	if g.HasEdgeIDsMessage() {
+		fields = append(fields, g.EdgeIDsFieldMap.Edges()...)
+	}
+	var redactions []redaction
+	for _, f := range fields {
+		role, err := f.RedactedRole()
+		if err != nil {
+			return nil, err
+		}
+		if role != "" {
+			redactions = append(redactions, redaction{Name: f.PbFieldDescriptor.GetFullyQualifiedName(), Role: role})
+		}
+	}
+	return redactions, nil
+}
+
+// Redacts reports whether the responses of the method are redacted, as they may hold fields annotated with
+// entproto.RedactUnless.
+func (g *serviceGenerator) Redacts(m *protogen.Method) (bool, error) {
+	redactions, err := g.Redactions()
+	if err != nil || len(redactions) == 0 {
+		return false, err
+	}
+	names := make(map[protoreflect.FullName]bool)
+	for _, r := range redactions {
+		names[protoreflect.FullName(r.Name)] = true
+	}
+	return holdsFields(m.Output, names, make(map[*protogen.Message]bool)), nil
+}
+
+// holdsFields reports whether the message m, or one of its nested messages, has one of the named fields.
+func holdsFields(m *protogen.Message, names map[protoreflect.FullName]bool, seen map[*protogen.Message]bool) bool {
+	if seen[m] {
+		return false
+	}
+	seen[m] = true
+	for _, f := range m.Fields {
+		if names[f.Desc.FullName()] || f.Message != nil && holdsFields(f.Message, names, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadOnly reports whether the service rejects the methods mutating the entities, see entproto.ReadOnly.
+func (g *serviceGenerator) ReadOnly() (bool, error) {
+	return entproto.HasReadOnly(g.EntType)
+}
+
+// UpsertKey returns the fields identifying the entities of the Upsert method of the service.
+func (g *serviceGenerator) UpsertKey() ([]*gen.Field, error) {
+	return entproto.UpsertKeyFields(g.EntType)
+}
+
 //go:embed template/*
 var templates embed.FS
 
 func extractEntTypeName(s *protogen.Service, g *gen.Graph) (*gen.Type, error) {
 	typeName := strings.TrimSuffix(s.GoName, "Service")
-	for _, gt := range g.Nodes {
-		if gt.Name == typeName {
-			return gt, nil
+	// The services of schemas annotated with entproto.SplitReadWrite are suffixed with ReadService and WriteService.
+	names := []string{typeName, strings.TrimSuffix(typeName, "Read"), strings.TrimSuffix(typeName, "Write")}
+	for _, name := range names {
+		for _, gt := range g.Nodes {
+			if gt.Name == name {
+				return gt, nil
+			}
 		}
 	}
 	return nil, fmt.Errorf("entproto: type %q of service %q not found in graph", typeName, s.GoName)
 }
 
+// pbIdent returns the qualified Go identifier of the given name, declared by the package of the messages of the
+// file, such as a message type or the server interface of the service.
+func (g *serviceGenerator) pbIdent(name string) string {
+	return g.QualifiedGoIdent(g.File.GoImportPath.Ident(name))
+}
+
+// pbEnumIdent returns the Go identifier of the protobuf enum of the field. Enums nested in a message
+// are prefixed with the message name, while shared enums are declared at the top-level of the file,
+// which is the file of another proto package for enums referenced with entproto.EnumRef.
+func (g *serviceGenerator) pbEnumIdent(fld *entproto.FieldMappingDescriptor) protogen.GoIdent {
+	et := fld.PbFieldDescriptor.GetEnumType()
+	if parent, ok := et.GetParent().(*desc.MessageDescriptor); ok {
+		return g.File.GoImportPath.Ident(parent.GetName() + "_" + et.GetName())
+	}
+	if et.GetFile().GetPackage() != string(g.File.Desc.Package()) {
+		return protogen.GoImportPath(et.GetFile().GetFileOptions().GetGoPackage()).Ident(et.GetName())
+	}
+	return g.File.GoImportPath.Ident(et.GetName())
+}
+
+// HasDefaultValue reports whether the protobuf field of fld is set the (entproto.default) option holding
+// the static default value of the ent field, see entproto.WithDefaultValues. The option is read from the
+// compiled file, as the adapter loaded from the schema is not configured with the options of entproto.
+func (g *serviceGenerator) HasDefaultValue(fld *entproto.FieldMappingDescriptor) bool {
+	msg := g.File.Desc.Messages().ByName(protoreflect.Name(g.EntType.Name))
+	if msg == nil {
+		return false
+	}
+	pbField := msg.Fields().ByName(protoreflect.Name(fld.PbFieldDescriptor.GetName()))
+	if pbField == nil {
+		return false
+	}
+	opts, ok := pbField.Options().(*descriptorpb.FieldOptions)
+	return ok && proto.HasExtension(opts, entprotoopts.E_Default)
+}
+
+// UnspecifiedEnum reports whether the zero value of the enum field fld leaves it unspecified in the Create and
+// Update requests, handled as configured with runtime.WithUnspecifiedEnum. It does not for the fields annotated
+// with entproto.MapZeroValue, whose zero value is one of their options.
+func (g *serviceGenerator) UnspecifiedEnum(fld *entproto.FieldMappingDescriptor) bool {
+	if fld.PbFieldDescriptor.GetType() != descriptorpb.FieldDescriptorProto_TYPE_ENUM || fld.EntField.Optional {
+		return false
+	}
+	annot, _ := fld.EntField.Annotations[entproto.EnumAnnotation].(map[string]any)
+	return annot["MapZeroValue"] != true
+}
+
+// goTypeIdent returns the Go identifier of the custom GoType of the field.
+func goTypeIdent(fld *gen.Field) protogen.GoIdent {
+	// Ident returned from ent already has the packagename prefixed. Strip it since `g.QualifiedGoIdent`
+	// adds it back. Builtin types with a package, like time.Time, have no Ident.
+	ident := fld.Type.Ident
+	if ident == "" {
+		ident = fld.Type.String()
+	}
+	split := strings.Split(ident, ".")
+	return protogen.GoImportPath(fld.Type.PkgPath).Ident(split[len(split)-1])
+}
+
 func (g *serviceGenerator) entIdent(subpath string, ident string) protogen.GoIdent {
 	ip := path.Join(string(g.EntPackage), subpath)
 	return protogen.GoImportPath(ip).Ident(ident)
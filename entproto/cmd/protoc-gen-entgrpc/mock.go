@@ -0,0 +1,62 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"path"
+	"text/template"
+
+	"entgo.io/ent/entc/gen"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// mockGenerator generates the package declaring fakes of the services of a file, next to the package of the
+// file.
+type mockGenerator struct {
+	*protogen.GeneratedFile
+	File        *protogen.File
+	PackageName string
+}
+
+// generateMocks generates the <package>mock package of the file, for example "entpbmock" for the services of
+// the "entpb" package, unless the file has no services.
+func generateMocks(plugin *protogen.Plugin, file *protogen.File) error {
+	if len(file.Services) == 0 {
+		return nil
+	}
+	pkg := string(file.GoPackageName) + "mock"
+	filename := path.Join(path.Dir(file.GeneratedFilenamePrefix), pkg, pkg+".go")
+	g := &mockGenerator{
+		GeneratedFile: plugin.NewGeneratedFile(filename, protogen.GoImportPath(path.Join(string(file.GoImportPath), pkg))),
+		File:          file,
+		PackageName:   pkg,
+	}
+	tmpl, err := gen.NewTemplate("mock").
+		Funcs(template.FuncMap{
+			"ident": g.QualifiedGoIdent,
+			"qualify": func(pkg, ident string) string {
+				return g.QualifiedGoIdent(protogen.GoImportPath(pkg).Ident(ident))
+			},
+		}).
+		ParseFS(templates, "template/mock.tmpl")
+	if err != nil {
+		return err
+	}
+	if err := tmpl.ExecuteTemplate(g, "mock", g); err != nil {
+		return fmt.Errorf("template execution failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"unicode"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// servicePackage is the package of the services of a file, along with their registration functions and test
+// suites, set by the service_package flag.
+type servicePackage struct {
+	GoImportPath  protogen.GoImportPath
+	GoPackageName protogen.GoPackageName
+	// dir is the output directory of the package.
+	dir string
+}
+
+// newServicePackage returns the package of the services of the file, which is the package of its messages unless
+// the service_package flag is set. The output directory of the package is resolved relative to the one of the
+// file, which must share the root of the import paths of the packages.
+func newServicePackage(file *protogen.File) (*servicePackage, error) {
+	dir := path.Dir(file.GeneratedFilenamePrefix)
+	if *svcPackage == "" || *svcPackage == string(file.GoImportPath) {
+		return &servicePackage{GoImportPath: file.GoImportPath, GoPackageName: file.GoPackageName, dir: dir}, nil
+	}
+	// With paths=import, the output directory of the file is its import path. With paths=source_relative, it is
+	// the path of the proto file, which is the suffix of the import path of a file in the same directory.
+	root := string(file.GoImportPath) + "/"
+	if dir != "." {
+		root = strings.TrimSuffix(string(file.GoImportPath), dir)
+		if root == string(file.GoImportPath) || root != "" && !strings.HasSuffix(root, "/") {
+			return nil, fmt.Errorf("entproto: output directory %q of package %q does not match its import path", dir, file.GoImportPath)
+		}
+	}
+	if !strings.HasPrefix(*svcPackage, root) {
+		return nil, fmt.Errorf("entproto: service package %q is not under the output root %q of package %q", *svcPackage, root, file.GoImportPath)
+	}
+	return &servicePackage{
+		GoImportPath:  protogen.GoImportPath(*svcPackage),
+		GoPackageName: packageName(path.Base(*svcPackage)),
+		dir:           strings.TrimPrefix(*svcPackage, root),
+	}, nil
+}
+
+// filename returns the name of the generated file of the package with the given suffix, prefixed with the name
+// of the proto file, e.g. "entpb_user_service.go".
+func (p *servicePackage) filename(file *protogen.File, suffix string) string {
+	return path.Join(p.dir, path.Base(file.GeneratedFilenamePrefix)+"_"+suffix)
+}
+
+// packageName returns the Go package name of the last element of an import path, replacing the characters that
+// are not valid in identifiers with underscores.
+func packageName(base string) protogen.GoPackageName {
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, base)
+	if r := rune(name[0]); unicode.IsDigit(r) {
+		name = "_" + name
+	}
+	return protogen.GoPackageName(name)
+}
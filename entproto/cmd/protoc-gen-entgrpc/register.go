@@ -0,0 +1,84 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"text/template"
+
+	"entgo.io/ent/entc/gen"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// registerGenerator generates the functions registering all the services of a file on a gRPC server.
+type registerGenerator struct {
+	*protogen.GeneratedFile
+	File       *protogen.File
+	Package    *servicePackage
+	EntPackage protogen.GoImportPath
+	// Extra holds the names of the services with methods added by entproto.ExtraMethod, whose implementations
+	// are passed to the registering functions.
+	Extra map[string]bool
+}
+
+// generateRegister generates the RegisterServices and RegisterAll functions of the file, unless the file has no
+// services.
+func generateRegister(plugin *protogen.Plugin, file *protogen.File, graph *gen.Graph) error {
+	if len(file.Services) == 0 {
+		return nil
+	}
+	pkg, err := newServicePackage(file)
+	if err != nil {
+		return err
+	}
+	g := &registerGenerator{
+		GeneratedFile: plugin.NewGeneratedFile(pkg.filename(file, "register.go"), pkg.GoImportPath),
+		File:          file,
+		Package:       pkg,
+		EntPackage:    protogen.GoImportPath(graph.Config.Package),
+		Extra:         make(map[string]bool),
+	}
+	for _, s := range file.Services {
+		typ, err := extractEntTypeName(s, graph)
+		if err != nil {
+			return err
+		}
+		_, extra, err := splitMethods(s, typ)
+		if err != nil {
+			return err
+		}
+		g.Extra[s.GoName] = len(extra) > 0
+	}
+	tmpl, err := gen.NewTemplate("register").
+		Funcs(template.FuncMap{
+			"ident":   g.QualifiedGoIdent,
+			"unquote": strconv.Unquote,
+			"pbIdent": func(name string) string {
+				return g.QualifiedGoIdent(file.GoImportPath.Ident(name))
+			},
+			"qualify": func(pkg, ident string) string {
+				return g.QualifiedGoIdent(protogen.GoImportPath(pkg).Ident(ident))
+			},
+		}).
+		ParseFS(templates, "template/register.tmpl")
+	if err != nil {
+		return err
+	}
+	if err := tmpl.ExecuteTemplate(g, "register", g); err != nil {
+		return fmt.Errorf("template execution failed: %w", err)
+	}
+	return nil
+}
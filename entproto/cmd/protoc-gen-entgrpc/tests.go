@@ -0,0 +1,188 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"text/template"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// testGenerator generates the test suite of a service, running its methods against an in-memory SQLite
+// database.
+type testGenerator struct {
+	*protogen.GeneratedFile
+	*clientService
+	File       *protogen.File
+	Package    *servicePackage
+	EntPackage protogen.GoImportPath
+	EntType    *gen.Type
+	// Fields are the required fields of the schema set by the fixture of the suite.
+	Fields []*gen.Field
+	// Extra reports whether the service has methods added by entproto.ExtraMethod, left unimplemented by the
+	// suite.
+	Extra bool
+}
+
+// generateTests generates the _test.go file of each service of the file with a Create and a Get method, unless
+// it is read-only or its schema has required edges or required fields the fixture of the suite cannot set.
+func generateTests(plugin *protogen.Plugin, file *protogen.File, graph *gen.Graph) error {
+	for _, s := range file.Services {
+		typ, err := extractEntTypeName(s, graph)
+		if err != nil {
+			return err
+		}
+		cs := newClientService(s)
+		if cs.Create == nil || cs.Get == nil {
+			continue
+		}
+		fields, ok := fixtureFields(typ)
+		if !ok {
+			continue
+		}
+		// The suites have no tenant to scope the services to.
+		switch tenant, err := entproto.TenantField(typ); {
+		case err != nil:
+			return err
+		case tenant != nil:
+			continue
+		}
+		// The suites create the entities with the Create method of the service.
+		switch readOnly, err := entproto.HasReadOnly(typ); {
+		case err != nil:
+			return err
+		case readOnly:
+			continue
+		}
+		_, extra, err := splitMethods(s, typ)
+		if err != nil {
+			return err
+		}
+		pkg, err := newServicePackage(file)
+		if err != nil {
+			return err
+		}
+		g := &testGenerator{
+			GeneratedFile: plugin.NewGeneratedFile(pkg.filename(file, snake(s.GoName)+"_test.go"), pkg.GoImportPath),
+			clientService: cs,
+			File:          file,
+			Package:       pkg,
+			EntPackage:    protogen.GoImportPath(graph.Config.Package),
+			EntType:       typ,
+			Fields:        fields,
+			Extra:         len(extra) > 0,
+		}
+		// The suite runs on the SQLite driver.
+		g.Import("github.com/mattn/go-sqlite3")
+		tmpl, err := gen.NewTemplate("test").
+			Funcs(template.FuncMap{
+				"ident":   g.QualifiedGoIdent,
+				"unquote": strconv.Unquote,
+				"pbIdent": func(name string) string {
+					return g.QualifiedGoIdent(file.GoImportPath.Ident(name))
+				},
+				"qualify": func(pkg, ident string) string {
+					return g.QualifiedGoIdent(protogen.GoImportPath(pkg).Ident(ident))
+				},
+			}).
+			ParseFS(templates, "template/test.tmpl")
+		if err != nil {
+			return err
+		}
+		if err := tmpl.ExecuteTemplate(g, "test", g); err != nil {
+			return fmt.Errorf("template execution failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// fixtureFields returns the required fields of the schema set by the fixture of its test suite, or false if the
+// schema has required edges, fields of custom Go types, or an ID or required fields whose values cannot be
+// generated. Required fields with a default value are left to it.
+func fixtureFields(t *gen.Type) ([]*gen.Field, bool) {
+	if !t.ID.Default && !t.ID.Type.Numeric() && !isGoogleUUID(t.ID) && !isString(t.ID) {
+		return nil, false
+	}
+	for _, e := range t.Edges {
+		if !e.Optional {
+			return nil, false
+		}
+	}
+	var fields []*gen.Field
+	for _, f := range t.Fields {
+		switch typ := f.Type.Type; {
+		case f.HasGoType() && !isGoogleUUID(f):
+			// The zero value of custom Go types does not necessarily convert back from its proto form.
+			return nil, false
+		case f.Optional || f.IsEdgeField() || f.Default || typ == field.TypeJSON:
+			// The zero value of JSON fields is stored as a JSON null.
+		case f.Nillable:
+			return nil, false
+		case typ == field.TypeString, typ == field.TypeBool, typ.Numeric(), typ == field.TypeBytes,
+			typ == field.TypeTime, isGoogleUUID(f), typ == field.TypeEnum && len(f.Enums) > 0:
+			fields = append(fields, f)
+		default:
+			return nil, false
+		}
+	}
+	return fields, true
+}
+
+// isGoogleUUID reports whether the field is a UUID field of the github.com/google/uuid type, whose values are
+// generated with uuid.New.
+func isGoogleUUID(f *gen.Field) bool {
+	return f.IsUUID() && f.Type.RType != nil && f.Type.RType.PkgPath == "github.com/google/uuid"
+}
+
+// isString reports whether the field is a string field without a custom Go type.
+func isString(f *gen.Field) bool {
+	return f.IsString() && !f.HasGoType()
+}
+
+// FixtureID returns the expression of the ID set by the fixture of the suite, or an empty string if the ID is
+// generated by the database or by its default function.
+func (g *testGenerator) FixtureID() string {
+	if id := g.EntType.ID; (isGoogleUUID(id) || isString(id)) && !id.Default {
+		return g.FixtureValue(id)
+	}
+	return ""
+}
+
+// FixtureValue returns the expression of the value of the field f set by the fixture of the suite.
+func (g *testGenerator) FixtureValue(f *gen.Field) string {
+	switch typ := f.Type.Type; {
+	case typ == field.TypeString:
+		return strconv.Quote(f.Name)
+	case typ == field.TypeBool:
+		return "true"
+	case typ == field.TypeBytes:
+		return fmt.Sprintf("[]byte(%q)", f.Name)
+	case typ == field.TypeTime:
+		return g.QualifiedGoIdent(protogen.GoImportPath("time").Ident("Now")) + "()"
+	case typ == field.TypeUUID:
+		return g.QualifiedGoIdent(protogen.GoImportPath("github.com/google/uuid").Ident("New")) + "()"
+	case typ == field.TypeEnum:
+		pkg := protogen.GoImportPath(path.Join(string(g.EntPackage), g.EntType.Package()))
+		return g.QualifiedGoIdent(pkg.Ident(f.Enums[0].Name))
+	default:
+		return "1"
+	}
+}
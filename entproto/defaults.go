@@ -0,0 +1,103 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"fmt"
+
+	entprotoopts "entgo.io/contrib/entproto/options/entproto"
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// defaultOptionPath is the path of the file declaring the (entproto.default) field option.
+const defaultOptionPath = "entproto/options.proto"
+
+// WithDefaultValues surfaces the static default values of the ent fields in the generated .proto files.
+// Each field with a static default value is set the (entproto.default) option and a comment holding the
+// value, and the Create, BatchCreate, Upsert and Import methods generated by protoc-gen-entgrpc leave the
+// field to its ent default when the request holds its proto zero value. The file declaring the option is
+// written to entproto/options.proto under the proto directory.
+func WithDefaultValues() GenerateOption {
+	return func(a *Adapter) error {
+		a.defaultValues = true
+		return nil
+	}
+}
+
+// staticDefault returns the default value of the field, if the field is a boolean, string, enum or numeric
+// field with a static default value.
+func staticDefault(f *gen.Field) (string, bool) {
+	if !f.Default || f.DefaultFunc() || f.DefaultValue() == nil || f.IsEdgeField() {
+		return "", false
+	}
+	switch t := f.Type.Type; {
+	case t == field.TypeBool, t == field.TypeString, t == field.TypeEnum, t.Numeric():
+		return fmt.Sprint(f.DefaultValue()), true
+	}
+	return "", false
+}
+
+// setDefault sets the (entproto.default) option of the field descriptor fd to v.
+func setDefault(fd *descriptorpb.FieldDescriptorProto, v string) {
+	if fd.Options == nil {
+		fd.Options = &descriptorpb.FieldOptions{}
+	}
+	proto.SetExtension(fd.Options, entprotoopts.E_Default, v)
+}
+
+// defaultValue returns the value of the (entproto.default) option of the field options, if set.
+func defaultValue(opts *descriptorpb.FieldOptions) (string, bool) {
+	if opts == nil || !proto.HasExtension(opts, entprotoopts.E_Default) {
+		return "", false
+	}
+	return proto.GetExtension(opts, entprotoopts.E_Default).(string), true
+}
+
+// defaultComments returns the leading comments of the fields of the message with an (entproto.default)
+// option, keyed by "<message>.<field>".
+func defaultComments(msg *descriptorpb.DescriptorProto) map[string]string {
+	comments := make(map[string]string)
+	for _, fd := range msg.GetField() {
+		if v, ok := defaultValue(fd.GetOptions()); ok {
+			comments[msg.GetName()+"."+fd.GetName()] = fmt.Sprintf(" Defaults to %q when unset on creation.\n", v)
+		}
+	}
+	return comments
+}
+
+// addDefaultComments records the comments of the fields of msg with an (entproto.default) option, and imports
+// the file declaring the option into fd.
+func (a *Adapter) addDefaultComments(fd *descriptorpb.FileDescriptorProto, msg *descriptorpb.DescriptorProto) {
+	comments := defaultComments(msg)
+	if len(comments) == 0 {
+		return
+	}
+	fd.Dependency = append(fd.Dependency, defaultOptionPath)
+	a.imports = append(a.imports, defaultOptionPath)
+	a.addComments(fd.GetName(), comments)
+}
+
+// usesDefaultOption reports whether the (entproto.default) option is set on a field of the generated files.
+func (a *Adapter) usesDefaultOption() bool {
+	for _, imp := range a.imports {
+		if imp == defaultOptionPath {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"entgo.io/ent/entc/gen"
+)
+
+// DeleteReturnsEntity makes the Delete method of the service return the deleted entity rather than
+// google.protobuf.Empty, for example to record it in an audit trail or to invalidate the caches of the clients.
+// The entity is fetched and deleted in a single transaction. For example:
+//
+//	entproto.Service(
+//		entproto.Methods(entproto.MethodAll),
+//		entproto.DeleteReturnsEntity(),
+//	)
+func DeleteReturnsEntity() ServiceOption {
+	return func(s *service) {
+		s.DeleteReturnsEntity = true
+	}
+}
+
+// HasDeleteReturnsEntity reports whether the Delete method of the service of the schema returns the deleted
+// entity. See DeleteReturnsEntity.
+func HasDeleteReturnsEntity(t *gen.Type) (bool, error) {
+	svc, err := extractServiceAnnotation(t)
+	if err != nil {
+		return false, err
+	}
+	return svc.DeleteReturnsEntity, nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// edgeIDsMessageName returns the name of the message holding the edge IDs of genType.
+func edgeIDsMessageName(genType *gen.Type) string {
+	return genType.Name + "EdgeIds"
+}
+
+var singular = gen.Funcs["singular"].(func(string) string)
+
+// edgeIDsFieldName returns the name of the field holding the IDs of edge e in the edge IDs message,
+// for example "owner_id" for a unique edge, and "pet_ids" for a non-unique edge named "pets".
+func edgeIDsFieldName(e *gen.Edge) string {
+	if e.Unique {
+		return e.Name + "_id"
+	}
+	return singular(e.Name) + "_ids"
+}
+
+// toEdgeIDsMessageDescriptor returns the descriptor of the <Schema>EdgeIds message of genType, or nil if
+// the schema is not annotated with EdgeIDsMessage. Fields of the message reuse the field numbers of the edges.
+func (a *Adapter) toEdgeIDsMessageDescriptor(genType *gen.Type) (*descriptorpb.DescriptorProto, error) {
+	msgAnnot, err := extractMessageAnnotation(genType)
+	if err != nil || !msgAnnot.EdgeIDsMessage {
+		return nil, nil
+	}
+	msg := &descriptorpb.DescriptorProto{
+		Name: strptr(edgeIDsMessageName(genType)),
+	}
+	for _, e := range genType.Edges {
+		if _, skip, err := skipMethods(e.Name, e.Annotations); err != nil {
+			return nil, err
+		} else if skip {
+			continue
+		}
+		edgeAnnotation, err := extractEdgeAnnotation(e)
+		if err != nil {
+			return nil, fmt.Errorf("entproto: failed extracting proto field number annotation: %w", err)
+		}
+		if edgeAnnotation.Number == 1 {
+			return nil, fmt.Errorf("entproto: edge %q has number 1 which is reserved for id", e.Name)
+		}
+		fieldDesc, err := a.edgeIDsFieldDescriptor(e, edgeAnnotation)
+		if err != nil {
+			return nil, err
+		}
+		msg.Field = append(msg.Field, fieldDesc)
+	}
+	if err := verifyNoDuplicateFieldNumbers(msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// edgeIDsFieldDescriptor returns the descriptor of the field holding the IDs of edge e, as generated in the
+// edge IDs message and in place of edges annotated with entproto.IDOnly.
+func (a *Adapter) edgeIDsFieldDescriptor(e *gen.Edge, annot *pbfield) (*descriptorpb.FieldDescriptorProto, error) {
+	fieldDesc, err := a.edgeIDFieldType(e)
+	if err != nil {
+		return nil, fmt.Errorf("entproto: edge %q: %w", e.Name, err)
+	}
+	fieldDesc.Name = strptr(edgeIDsFieldName(e))
+	fieldDesc.Number = int32ptr(int32(annot.Number))
+	if annot.JSONName != "" {
+		fieldDesc.JsonName = &annot.JSONName
+	}
+	if !e.Unique {
+		fieldDesc.Label = &repeatedFieldLabel
+	}
+	if annot.Packed {
+		if err := setPacked(e.Name, fieldDesc); err != nil {
+			return nil, err
+		}
+	}
+	return fieldDesc, nil
+}
+
+// edgeIDFieldType returns a field descriptor holding the protobuf type of the ID of the type edge e points to.
+func (a *Adapter) edgeIDFieldType(e *gen.Edge) (*descriptorpb.FieldDescriptorProto, error) {
+	id := e.Type.ID
+	if fann, err := extractFieldAnnotation(id); err == nil && fann.Type != descriptorpb.FieldDescriptorProto_Type(0) {
+		fieldDesc := &descriptorpb.FieldDescriptorProto{Type: &fann.Type}
+		if len(fann.TypeName) > 0 {
+			fieldDesc.TypeName = &fann.TypeName
+		}
+		return fieldDesc, nil
+	}
+	typeDetails, err := a.extractProtoTypeDetails(id)
+	if err != nil {
+		return nil, err
+	}
+	if typeDetails.repeated || typeDetails.protoType == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+		return nil, fmt.Errorf("unsupported id type %q", id.Type)
+	}
+	return &descriptorpb.FieldDescriptorProto{Type: &typeDetails.protoType}, nil
+}
+
+// EdgeIDsFieldMap returns a FieldMap describing the mapping between the edges of the schema and the fields
+// of its <Schema>EdgeIds message. It returns nil if the schema is not annotated with EdgeIDsMessage.
+func (a *Adapter) EdgeIDsFieldMap(schemaName string) (FieldMap, error) {
+	genType, err := extractGenTypeByName(a.graph, schemaName)
+	if err != nil {
+		return nil, err
+	}
+	msgAnnot, err := extractMessageAnnotation(genType)
+	if err != nil || !msgAnnot.EdgeIDsMessage {
+		return nil, nil
+	}
+	fd, err := a.GetFileDescriptor(schemaName)
+	if err != nil {
+		return nil, err
+	}
+	md := fd.FindMessage(fd.GetPackage() + "." + edgeIDsMessageName(genType))
+	if md == nil {
+		return nil, fmt.Errorf("entproto: could not find message %q", edgeIDsMessageName(genType))
+	}
+	m := make(FieldMap)
+	for _, e := range genType.Edges {
+		fld := md.FindFieldByName(edgeIDsFieldName(e))
+		if fld == nil {
+			continue
+		}
+		m[e.Name] = &FieldMappingDescriptor{
+			EntEdge:           e,
+			PbFieldDescriptor: fld,
+			IsEdgeField:       true,
+		}
+	}
+	return m, nil
+}
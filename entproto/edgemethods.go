@@ -0,0 +1,100 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// genEdgeProtos returns the resources of the List<T><Edge>, Add<T><Edge> and Remove<T><Edge> methods of
+// genType, managing the IDs of each of its non-unique edges without updating the entity itself.
+func (a *Adapter) genEdgeProtos(genType *gen.Type) ([]methodResources, error) {
+	idField, err := a.toProtoFieldDescriptor(genType.ID)
+	if err != nil {
+		return nil, err
+	}
+	idField.Number = int32ptr(1)
+	var out []methodResources
+	for _, e := range genType.Edges {
+		if e.Unique {
+			continue
+		}
+		if _, skip, err := skipMethods(e.Name, e.Annotations); err != nil {
+			return nil, err
+		} else if skip {
+			continue
+		}
+		edgeID, err := a.edgeIDFieldType(e)
+		if err != nil {
+			return nil, fmt.Errorf("entproto: edge %q: %w", e.Name, err)
+		}
+
+		listName := fmt.Sprintf("List%s%s", genType.Name, e.StructField())
+		listInput := &descriptorpb.DescriptorProto{
+			Name:  strptr(listName + "Request"),
+			Field: []*descriptorpb.FieldDescriptorProto{idField},
+		}
+		listIDs := &descriptorpb.FieldDescriptorProto{
+			Name:     strptr(edgeIDsFieldName(e)),
+			Number:   int32ptr(1),
+			Label:    &repeatedFieldLabel,
+			Type:     edgeID.Type,
+			TypeName: edgeID.TypeName,
+		}
+		listOutput := &descriptorpb.DescriptorProto{
+			Name:  strptr(listName + "Response"),
+			Field: []*descriptorpb.FieldDescriptorProto{listIDs},
+		}
+		out = append(out, methodResources{
+			methodDescriptor: &descriptorpb.MethodDescriptorProto{
+				Name:       strptr(listName),
+				InputType:  listInput.Name,
+				OutputType: listOutput.Name,
+			},
+			messages: []*descriptorpb.DescriptorProto{listInput, listOutput},
+		})
+
+		for _, op := range []string{"Add", "Remove"} {
+			name := fmt.Sprintf("%s%s%s", op, genType.Name, singular(e.StructField()))
+			input := &descriptorpb.DescriptorProto{
+				Name: strptr(name + "Request"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					idField,
+					{
+						Name:     strptr(singular(e.Name) + "_id"),
+						Number:   int32ptr(2),
+						Type:     edgeID.Type,
+						TypeName: edgeID.TypeName,
+					},
+				},
+			}
+			out = append(out, methodResources{
+				methodDescriptor: &descriptorpb.MethodDescriptorProto{
+					Name:       strptr(name),
+					InputType:  input.Name,
+					OutputType: strptr("google.protobuf.Empty"),
+				},
+				messages: []*descriptorpb.DescriptorProto{input},
+			})
+		}
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("entproto: Edges method of schema %q requires a non-unique edge", genType.Name)
+	}
+	return out, nil
+}
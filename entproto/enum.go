@@ -17,6 +17,7 @@ package entproto
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/mitchellh/mapstructure"
 
@@ -53,9 +54,92 @@ func OmitFieldPrefix() EnumOption {
 	}
 }
 
+// MapZeroValue allows an option of an Enum field without a default value to be mapped
+// to the number 0. No UNSPECIFIED value is generated for such enums, and messages that
+// leave the field unset are read as the option mapped to 0.
+func MapZeroValue() EnumOption {
+	return func(e *enum) {
+		e.MapZeroValue = true
+	}
+}
+
+// UnspecifiedName overrides the name of the zero value generated for Enum fields without
+// a default value. The name is prefixed with the field name, unless OmitFieldPrefix is set.
+// For example, UnspecifiedName("unknown") on the "status" field generates STATUS_UNKNOWN
+// instead of STATUS_UNSPECIFIED.
+func UnspecifiedName(name string) EnumOption {
+	return func(e *enum) {
+		e.UnspecifiedName = name
+	}
+}
+
+// SharedEnum generates the protobuf enum of the field as a top-level enum with the given name,
+// instead of an enum nested in the message of the schema. All fields of the same proto package
+// annotated with the same name, for example fields declared by a mixin or fields of the same
+// GoType, share a single enum. Their enum definitions must be identical. The labels of a
+// shared enum are prefixed with its name, unless OmitFieldPrefix is set.
+func SharedEnum(name string) EnumOption {
+	return func(e *enum) {
+		e.Shared = name
+	}
+}
+
+// EnumRef generates the field as a reference to the top-level enum with the given fully-qualified name,
+// for example "common.Status", instead of an enum nested in the message of the schema. The enum is declared
+// once in the named proto package, which is generated in its own file if no schema belongs to it, and all
+// fields of all schemas referencing the same name share it. As with SharedEnum, their enum definitions must
+// be identical, and the labels are prefixed with the enum name, unless OmitFieldPrefix is set.
+func EnumRef(name string) EnumOption {
+	return func(e *enum) {
+		e.Ref = name
+	}
+}
+
 type enum struct {
 	Options         map[string]int32
 	OmitFieldPrefix bool
+	MapZeroValue    bool
+	UnspecifiedName string
+	Shared          string
+	Ref             string
+}
+
+// prefix returns the name prefixing the labels of the enum of fld.
+func (e *enum) prefix(fld *gen.Field) string {
+	if e.Shared != "" {
+		return strings.ToUpper(snake(e.Shared))
+	}
+	if _, name, ok := e.ref(); ok {
+		return strings.ToUpper(snake(name))
+	}
+	return strings.ToUpper(snake(fld.Name))
+}
+
+// ref returns the proto package and the name of the enum referenced with EnumRef, and reports whether
+// the enum has a reference.
+func (e *enum) ref() (string, string, bool) {
+	i := strings.LastIndex(e.Ref, ".")
+	if i == -1 {
+		return "", "", false
+	}
+	return e.Ref[:i], e.Ref[i+1:], true
+}
+
+// unspecifiedValue returns the name of the zero value generated for the enum of fld.
+func (e *enum) unspecifiedValue(fld *gen.Field) string {
+	n := "UNSPECIFIED"
+	if e.UnspecifiedName != "" {
+		n = strings.ToUpper(snake(e.UnspecifiedName))
+		if e.OmitFieldPrefix {
+			return n
+		}
+	}
+	return e.prefix(fld) + "_" + n
+}
+
+// hasUnspecifiedValue reports whether a zero value is generated in addition to the enum options.
+func (e *enum) hasUnspecifiedValue(fld *gen.Field) bool {
+	return !fld.Default && !e.MapZeroValue
 }
 
 func (*enum) Name() string {
@@ -72,6 +156,14 @@ func (e *enum) findByNumber(n int32) string {
 }
 
 func (e *enum) Verify(fld *gen.Field) error {
+	if e.Ref != "" {
+		if pkg, name, ok := e.ref(); !ok || pkg == "" || name == "" {
+			return fmt.Errorf("entproto: entproto.EnumRef of field %q expects a fully-qualified name, got %q", fld.Name, e.Ref)
+		}
+		if e.Shared != "" {
+			return fmt.Errorf("entproto: field %q cannot use both entproto.EnumRef and entproto.SharedEnum", fld.Name)
+		}
+	}
 	// Verify that all fields on the Enum are in the annotation.
 	if len(e.Options) != len(fld.Enums) {
 		return ErrEnumFieldsNotAnnotated
@@ -99,6 +191,12 @@ func (e *enum) Verify(fld *gen.Field) error {
 				"entproto: default value for Enum pbfield %q is %q, but the proto annotation pbfield 0 is %q",
 				fld.Name, dv, zeroField)
 		}
+	} else if e.MapZeroValue {
+		// Make sure an option is mapped to the zero option number.
+		if e.findByNumber(0) == "" {
+			return fmt.Errorf("entproto: Enum pbfield %q is annotated with entproto.MapZeroValue but"+
+				" entproto.Enum annotation doesn't contain an option with number 0", fld.Name)
+		}
 	} else {
 		// Make sure no one is using the zero option number.
 		zeroField := e.findByNumber(0)
@@ -0,0 +1,58 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// Etag sets the integer field versioning the entries of the schema, enabling optimistic concurrency control. The
+// Update method only updates an entry if the version of the request matches its stored version, incrementing it
+// on success, and the Delete request holds the version the entry must have to be deleted. For example:
+//
+//	entproto.Service(
+//		entproto.Etag("version"),
+//	)
+//
+// Requests whose version does not match the stored one are rejected with the Aborted code.
+func Etag(field string) ServiceOption {
+	return func(s *service) {
+		s.Etag = field
+	}
+}
+
+// EtagField returns the field versioning the entries of the schema, or nil if the schema is not versioned.
+// See Etag.
+func EtagField(t *gen.Type) (*gen.Field, error) {
+	svc, err := extractServiceAnnotation(t)
+	if err != nil {
+		return nil, err
+	}
+	if svc.Etag == "" {
+		return nil, nil
+	}
+	for _, f := range t.Fields {
+		if f.Name != svc.Etag {
+			continue
+		}
+		if f.Optional || f.Nillable || f.Immutable || !f.Type.Type.Integer() || f.IsEdgeField() {
+			return nil, fmt.Errorf("entproto: etag field %q of schema %q must be a required and mutable integer field", f.Name, t.Name)
+		}
+		return f, nil
+	}
+	return nil, fmt.Errorf("entproto: etag field %q not found in schema %q", svc.Etag, t.Name)
+}
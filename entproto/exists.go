@@ -0,0 +1,71 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// isKeyFilter reports whether a field filtered by ff can identify an entity in Exists requests, i.e. its
+// values are sent as protobuf scalars.
+func isKeyFilter(ff *FieldFilter) bool {
+	return ff != nil && ff.GoType != ""
+}
+
+// toExistsRequestDescriptor returns the descriptor of the Exists<T>Request message of genType. Its key oneof
+// holds the ID of the entity, or the value of one of its unique fields.
+func (a *Adapter) toExistsRequestDescriptor(genType *gen.Type, idField *descriptorpb.FieldDescriptorProto) (*descriptorpb.DescriptorProto, error) {
+	msg := &descriptorpb.DescriptorProto{
+		Name:      strptr(fmt.Sprintf("Exists%sRequest", genType.Name)),
+		OneofDecl: []*descriptorpb.OneofDescriptorProto{{Name: strptr("key")}},
+		Field: []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:       idField.Name,
+				Number:     idField.Number,
+				JsonName:   idField.JsonName,
+				Type:       idField.Type,
+				TypeName:   idField.TypeName,
+				OneofIndex: int32ptr(0),
+			},
+		},
+	}
+	for _, f := range genType.Fields {
+		if !f.Unique {
+			continue
+		}
+		ff, err := a.fieldFilter(f)
+		if err != nil {
+			return nil, err
+		}
+		if !isKeyFilter(ff) {
+			continue
+		}
+		fd, err := a.toProtoFieldDescriptor(f)
+		if err != nil {
+			return nil, err
+		}
+		msg.Field = append(msg.Field, &descriptorpb.FieldDescriptorProto{
+			Name:       fd.Name,
+			Number:     fd.Number,
+			JsonName:   fd.JsonName,
+			Type:       ff.ScalarType.Enum(),
+			OneofIndex: int32ptr(0),
+		})
+	}
+	return msg, nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// ExportTo additionally generates the message of the schema into the proto package pkg, holding the ID of the
+// schema and the named fields only. It allows exporting a single ent type into, say, an internal admin package
+// with all of its fields and a public package with a restricted set of fields. The option may be used more
+// than once to export the message into several packages. For example:
+//
+//	entproto.Message(
+//		entproto.PackageName("admin"),
+//		entproto.ExportTo("public", "name", "size"),
+//	)
+//
+// Edges are not exported, and the service of the schema is only generated in its own package.
+func ExportTo(pkg string, fields ...string) MessageOption {
+	return func(msg *message) {
+		msg.Exports = append(msg.Exports, export{
+			Package: pkg,
+			Fields:  fields,
+		})
+	}
+}
+
+// export describes a proto package the message of a schema is exported into, see ExportTo.
+type export struct {
+	Package string
+	Fields  []string
+}
+
+// addExports adds the messages exported by genType, whose own message is declared in the proto package protoPkg,
+// to the files of their packages.
+func (a *Adapter) addExports(genType *gen.Type, protoPkg string, files map[string]*descriptorpb.FileDescriptorProto) error {
+	msgAnnot, err := extractMessageAnnotation(genType)
+	if err != nil {
+		return err
+	}
+	for _, exp := range msgAnnot.Exports {
+		if exp.Package == protoPkg {
+			return fmt.Errorf("entproto: schema %q cannot be exported into its own proto package %q", genType.Name, protoPkg)
+		}
+		msg, deps, err := a.toExportMessageDescriptor(genType, protoPkg, exp)
+		if err != nil {
+			return err
+		}
+		if _, ok := files[exp.Package]; !ok {
+			files[exp.Package] = a.newFileDescriptor(exp.Package)
+		}
+		fd := files[exp.Package]
+		fd.MessageType = append(fd.MessageType, msg)
+		fd.Dependency = append(fd.Dependency, deps...)
+		a.addDefaultComments(fd, msg)
+	}
+	return nil
+}
+
+// toExportMessageDescriptor returns the descriptor of the message of genType exported into the package of exp,
+// and the paths of the files it depends on.
+func (a *Adapter) toExportMessageDescriptor(genType *gen.Type, protoPkg string, exp export) (*descriptorpb.DescriptorProto, []string, error) {
+	full, err := a.toProtoMessageDescriptor(genType)
+	if err != nil {
+		return nil, nil, err
+	}
+	fields := make(map[string]bool, len(genType.Fields))
+	for _, f := range genType.Fields {
+		fields[f.Name] = true
+	}
+	keep := map[string]bool{genType.ID.Name: true}
+	for _, name := range exp.Fields {
+		if !fields[name] {
+			return nil, nil, fmt.Errorf("entproto: schema %q exports unknown field %q into package %q",
+				genType.Name, name, exp.Package)
+		}
+		keep[name] = true
+	}
+	nested := make(map[string]*descriptorpb.EnumDescriptorProto, len(full.EnumType))
+	for _, e := range full.EnumType {
+		nested[e.GetName()] = e
+	}
+	msg := &descriptorpb.DescriptorProto{
+		Name: full.Name,
+	}
+	var deps []string
+	for _, fd := range full.Field {
+		if !keep[fd.GetName()] {
+			continue
+		}
+		delete(keep, fd.GetName())
+		msg.Field = append(msg.Field, fd)
+		if fd.GetType() != descriptorpb.FieldDescriptorProto_TYPE_ENUM {
+			continue
+		}
+		switch e, ok := nested[fd.GetTypeName()]; {
+		case ok:
+			msg.EnumType = append(msg.EnumType, e)
+		case !strings.Contains(fd.GetTypeName(), "."):
+			// Shared enums are declared in the package of the schema.
+			fd.TypeName = strptr(protoPkg + "." + fd.GetTypeName())
+			deps = append(deps, *relFileName(protoPkg))
+		default:
+			// Enums referenced with EnumRef are declared in the package they name.
+			if pkg := fd.GetTypeName()[:strings.LastIndex(fd.GetTypeName(), ".")]; pkg != exp.Package {
+				deps = append(deps, *relFileName(pkg))
+			}
+		}
+	}
+	for name := range keep {
+		return nil, nil, fmt.Errorf("entproto: schema %q exports field %q into package %q, which is skipped",
+			genType.Name, name, exp.Package)
+	}
+	wkts, err := a.extractDepPaths(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return msg, append(deps, wkts...), nil
+}
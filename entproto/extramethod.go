@@ -0,0 +1,195 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// ExtraMethod adds a custom method to the generated service. The input and output are the names of existing
+// messages, and no wrapper message is generated for the method. They may be the message of an entity, a message
+// generated for the service, such as ListUserResponse, or a well-known type, such as google.protobuf.Empty.
+// For example:
+//
+//	entproto.Service(
+//		entproto.ExtraMethod("Upload", "Attachment", "google.protobuf.Empty", entproto.ExtraMethodClientStream()),
+//	)
+//
+// The method is not implemented by protoc-gen-entgrpc: the generated service declares a <Service>ExtraMethods
+// interface holding the extra methods, and serves them with the implementation passed to its constructor.
+func ExtraMethod(name, input, output string, opts ...ExtraMethodOption) ServiceOption {
+	return func(s *service) {
+		m := &extraMethod{
+			Name:   name,
+			Input:  input,
+			Output: output,
+		}
+		for _, apply := range opts {
+			apply(m)
+		}
+		s.ExtraMethods = append(s.ExtraMethods, m)
+	}
+}
+
+// ExtraMethodOption configures a method added by ExtraMethod.
+type ExtraMethodOption func(*extraMethod)
+
+// ExtraMethodImports declares the files defining the input or output messages of the method that are neither
+// well-known types nor messages of the schemas, such as "google/type/date.proto". The files must be registered
+// in the global protobuf registry, usually by importing their generated Go package in the code-generation
+// program.
+func ExtraMethodImports(paths ...string) ExtraMethodOption {
+	return func(m *extraMethod) {
+		m.Imports = append(m.Imports, paths...)
+	}
+}
+
+// ExtraMethodServerStream declares that the server streams the output messages of the method.
+func ExtraMethodServerStream() ExtraMethodOption {
+	return func(m *extraMethod) {
+		m.ServerStreaming = true
+	}
+}
+
+// ExtraMethodClientStream declares that the client streams the input messages of the method.
+func ExtraMethodClientStream() ExtraMethodOption {
+	return func(m *extraMethod) {
+		m.ClientStreaming = true
+	}
+}
+
+// ExtraMethodBidiStream declares that both the client and the server stream the messages of the method.
+func ExtraMethodBidiStream() ExtraMethodOption {
+	return func(m *extraMethod) {
+		m.ClientStreaming = true
+		m.ServerStreaming = true
+	}
+}
+
+// ExtraMethodComment sets the leading comment of the method in the generated service, which documents the
+// hand-declared method for the client generators, for example as the doc comment of the generated Go method.
+// The comment may span several lines.
+func ExtraMethodComment(comment string) ExtraMethodOption {
+	return func(m *extraMethod) {
+		m.Comment = comment
+	}
+}
+
+type extraMethod struct {
+	Name            string
+	Input           string
+	Output          string
+	ClientStreaming bool
+	ServerStreaming bool
+	Imports         []string
+	Comment         string
+}
+
+var methodNameRegexp = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+
+// extraMethodDescriptor returns the descriptor of the extra method m of the service of genType, and the paths
+// of the files defining its messages. svcMessages holds the names of the messages generated for the service.
+func (a *Adapter) extraMethodDescriptor(genType *gen.Type, m *extraMethod, svcMessages map[string]bool) (*descriptorpb.MethodDescriptorProto, []string, error) {
+	if !methodNameRegexp.MatchString(m.Name) {
+		return nil, nil, fmt.Errorf("extra method name %q must be an upper camel case identifier", m.Name)
+	}
+	if m.Input == "" || m.Output == "" {
+		return nil, nil, fmt.Errorf("extra method %q must have an input and an output message", m.Name)
+	}
+	pkg, err := protoPackageName(genType)
+	if err != nil {
+		return nil, nil, err
+	}
+	deps := append([]string(nil), m.Imports...)
+	for _, typ := range []string{m.Input, m.Output} {
+		path, err := a.extraMethodTypePath(typ, pkg, svcMessages, len(m.Imports) > 0)
+		if err != nil {
+			return nil, nil, fmt.Errorf("extra method %q: %w", m.Name, err)
+		}
+		if path != "" {
+			deps = append(deps, path)
+		}
+	}
+	return &descriptorpb.MethodDescriptorProto{
+		Name:            strptr(m.Name),
+		InputType:       strptr(m.Input),
+		OutputType:      strptr(m.Output),
+		ClientStreaming: optionalBool(m.ClientStreaming),
+		ServerStreaming: optionalBool(m.ServerStreaming),
+	}, deps, nil
+}
+
+// extraMethodTypePath returns the path of the file to import for using the message typ in the proto package
+// pkg, or an empty string if no import is needed. Unqualified names refer to the messages of the schemas of pkg
+// or to the messages generated for the service. Well-known types and the messages of the schemas are imported
+// automatically, and the other qualified names must be defined in the files declared by ExtraMethodImports.
+func (a *Adapter) extraMethodTypePath(typ, pkg string, svcMessages map[string]bool, imports bool) (string, error) {
+	if path, ok := wktsPaths[typ]; ok {
+		return path, nil
+	}
+	i := strings.LastIndexByte(typ, '.')
+	if i == -1 {
+		if svcMessages[typ] {
+			return "", nil
+		}
+		if t, err := extractGenTypeByName(a.graph, typ); err == nil && t != nil {
+			if typPkg, err := protoPackageName(t); err == nil && typPkg == pkg {
+				return "", nil
+			}
+		}
+		return "", fmt.Errorf("message %q is neither a message of the schemas of package %q nor a message of the service", typ, pkg)
+	}
+	if t, err := extractGenTypeByName(a.graph, typ[i+1:]); err == nil && t != nil {
+		typPkg, err := protoPackageName(t)
+		if err != nil {
+			return "", err
+		}
+		if typPkg == typ[:i] {
+			if typPkg == pkg {
+				return "", nil
+			}
+			return *relFileName(typPkg), nil
+		}
+	}
+	if !imports {
+		return "", fmt.Errorf("unknown file defining message %q, declare it with entproto.ExtraMethodImports", typ)
+	}
+	return "", nil
+}
+
+// protoComment returns the comment c in the form of a leading comment of a proto descriptor, with each line
+// separated from the comment markers by a space.
+func protoComment(c string) string {
+	return " " + strings.ReplaceAll(strings.TrimSpace(c), "\n", "\n ") + "\n"
+}
+
+// ExtraMethodNames returns the names of the methods added to the service of the schema by ExtraMethod.
+// Code generators skip them, leaving them to the Unimplemented server embedded in the service.
+func ExtraMethodNames(t *gen.Type) ([]string, error) {
+	svc, err := extractServiceAnnotation(t)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(svc.ExtraMethods))
+	for _, m := range svc.ExtraMethods {
+		names = append(names, m.Name)
+	}
+	return names, nil
+}
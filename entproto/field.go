@@ -39,6 +39,13 @@ type pbfield struct {
 	Number   int
 	Type     descriptorpb.FieldDescriptorProto_Type
 	TypeName string
+	JSONName string
+	OmitFrom Method
+	RFC3339  bool
+	Packed   bool
+	IDOnly   bool
+	// RedactUnless is the role the callers must hold to read the field, see RedactUnless.
+	RedactUnless string
 }
 
 func (f pbfield) Name() string {
@@ -66,20 +73,43 @@ func TypeName(n string) FieldOption {
 	}
 }
 
+// JSONName sets the json_name of the field descriptor, which is used by the JSON representation of the
+// message (e.g, protojson and grpc-gateway) instead of the lowerCamelCase form of the field name.
+// Example:
+//	field.String("user_name").
+//		Annotations(
+//			entproto.Field(2,
+//				entproto.JSONName("login"),
+//			),
+//		)
+func JSONName(n string) FieldOption {
+	return func(p *pbfield) {
+		p.JSONName = n
+	}
+}
+
+// OmitFrom excludes the field from the requests of the given service methods. The field is still generated in
+// the message and returned by all methods, but its value is not read from the requests of the masked methods,
+// leaving it to be set by the schema defaults or by custom methods. It behaves as entproto.Skip with methods.
+// Example:
+//	field.Uint64("exp").
+//		Annotations(
+//			entproto.Field(5,
+//				entproto.OmitFrom(entproto.MethodCreate|entproto.MethodUpdate),
+//			),
+//		)
+func OmitFrom(methods Method) FieldOption {
+	return func(p *pbfield) {
+		p.OmitFrom |= methods
+	}
+}
+
 func extractFieldAnnotation(fld *gen.Field) (*pbfield, error) {
 	annot, ok := fld.Annotations[FieldAnnotation]
 	if !ok {
 		return nil, fmt.Errorf("entproto: field %q does not have an entproto.Field annnoation", fld.Name)
 	}
-
-	var out pbfield
-	err := mapstructure.Decode(annot, &out)
-	if err != nil {
-		return nil, fmt.Errorf("entproto: unable to decode entproto.Field annotation for field %q: %w",
-			fld.Name, err)
-	}
-
-	return &out, nil
+	return decodeFieldAnnotation(fld.Name, annot)
 }
 
 func extractEdgeAnnotation(edge *gen.Edge) (*pbfield, error) {
@@ -87,13 +117,15 @@ func extractEdgeAnnotation(edge *gen.Edge) (*pbfield, error) {
 	if !ok {
 		return nil, fmt.Errorf("entproto: edge %q does not have an entproto.Field annotation", edge.Name)
 	}
+	return decodeFieldAnnotation(edge.Name, annot)
+}
 
+func decodeFieldAnnotation(name string, annot interface{}) (*pbfield, error) {
 	var out pbfield
 	err := mapstructure.Decode(annot, &out)
 	if err != nil {
 		return nil, fmt.Errorf("entproto: unable to decode entproto.Field annotation for field %q: %w",
-			edge.Name, err)
+			name, err)
 	}
-
 	return &out, nil
 }
@@ -81,6 +81,80 @@ func (m FieldMap) Edges() []*FieldMappingDescriptor {
 	return out
 }
 
+// Immutable returns the FieldMappingDescriptor for the non-ID fields of the schema that are immutable and
+// accepted by Update requests. Items are sorted alphabetically on pb field name.
+func (m FieldMap) Immutable() []*FieldMappingDescriptor {
+	var out []*FieldMappingDescriptor
+	for _, f := range m.Fields() {
+		if !f.IsIDField && f.EntField.Immutable && !f.SkippedIn("Update") {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// Sortable returns the FieldMappingDescriptor for the fields of the schema, including its ID, that can be
+// used in the order_by expression of List requests. Items are sorted alphabetically on pb field name.
+func (m FieldMap) Sortable() []*FieldMappingDescriptor {
+	var out []*FieldMappingDescriptor
+	for _, f := range m.Fields() {
+		if f.EntField.Type.Comparable() {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// Unique returns the FieldMappingDescriptor for the non-ID unique fields of the schema that can identify an
+// entity in Exists requests. Items are sorted alphabetically on pb field name.
+func (m FieldMap) Unique() []*FieldMappingDescriptor {
+	var out []*FieldMappingDescriptor
+	for _, f := range m.Fields() {
+		if !f.IsIDField && f.EntField.Unique && isKeyFilter(f.Filter()) {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// Aggregatable returns the FieldMappingDescriptor for the non-ID numeric fields of the schema that can be
+// aggregated by Aggregate requests. Items are sorted alphabetically on pb field name.
+func (m FieldMap) Aggregatable() []*FieldMappingDescriptor {
+	var out []*FieldMappingDescriptor
+	for _, f := range m.Fields() {
+		if !f.IsIDField && f.EntField.Type.Numeric() && isKeyFilter(f.Filter()) {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// Groupable returns the FieldMappingDescriptor for the non-ID enum, bool, string and integer fields of the
+// schema that can be used in the group_by field of Aggregate requests. Items are sorted alphabetically on
+// pb field name.
+func (m FieldMap) Groupable() []*FieldMappingDescriptor {
+	var out []*FieldMappingDescriptor
+	for _, f := range m.Fields() {
+		switch {
+		case f.IsIDField || f.EntField.Sensitive() || f.EntField.Type.Type.Float():
+		case f.EntField.IsEnum() || isKeyFilter(f.Filter()):
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// GroupKeyType returns the Go type the values of the field are scanned into when grouped by Aggregate requests.
+func (d *FieldMappingDescriptor) GroupKeyType() string {
+	switch {
+	case d.EntField.IsEnum():
+		return "string"
+	case d.EntField.Type.Type.Integer():
+		return "int64"
+	}
+	return d.Filter().GoType
+}
+
 func (m FieldMap) Enums() []*FieldMappingDescriptor {
 	var out []*FieldMappingDescriptor
 	for _, f := range m {
@@ -103,6 +177,9 @@ type FieldMappingDescriptor struct {
 	IsIDField         bool
 	IsEnumField       bool
 	ReferencedPbType  *desc.MessageDescriptor
+	// TypeMapping is the type mapping registered for the Go type of the field, or of the ID
+	// of the type the edge points to, if there is one.
+	TypeMapping *TypeMapping
 }
 
 // PbStructField returns the protobuf field descriptor of this field.
@@ -116,6 +193,12 @@ func (d *FieldMappingDescriptor) EdgeIDPbStructField() string {
 	return camelCase(d.EntEdge.Type.ID.Name)
 }
 
+// IsEdgeIDsField reports whether the field holds the IDs of the entities the edge refers to directly, as the
+// fields of the edge IDs message and the edges annotated with entproto.IDOnly do.
+func (d *FieldMappingDescriptor) IsEdgeIDsField() bool {
+	return d.IsEdgeField && d.PbFieldDescriptor.GetMessageType() == nil
+}
+
 // EdgeIDPbStructFieldDesc returns the protobuf field descriptor for the id field
 // of the entity this edge refers to.
 func (d *FieldMappingDescriptor) EdgeIDPbStructFieldDesc() *desc.FieldDescriptor {
@@ -123,6 +206,30 @@ func (d *FieldMappingDescriptor) EdgeIDPbStructFieldDesc() *desc.FieldDescriptor
 	return d.ReferencedPbType.FindFieldByName(snake(field))
 }
 
+// SkippedIn reports whether the field is annotated with entproto.Skip to be ignored by the requests of
+// the named service method. Create, BatchCreate, Upsert and Import share the same mask, as do Update and
+// BatchUpdate.
+func (d *FieldMappingDescriptor) SkippedIn(method string) bool {
+	var annots gen.Annotations
+	switch {
+	case d.IsEdgeField && d.EntEdge != nil:
+		annots = d.EntEdge.Annotations
+	case d.EntField != nil:
+		annots = d.EntField.Annotations
+	}
+	methods, _, err := skipMethods("", annots)
+	if err != nil {
+		return false
+	}
+	switch method {
+	case "Create", "BatchCreate", "Upsert", "Import":
+		return methods.Is(MethodCreate)
+	case "Update", "BatchUpdate":
+		return methods.Is(MethodUpdate)
+	}
+	return false
+}
+
 func (a *Adapter) mapFields(entType *gen.Type, pbType *desc.MessageDescriptor) (FieldMap, error) {
 	m := make(map[string]*FieldMappingDescriptor)
 	for _, fld := range pbType.GetFields() {
@@ -132,28 +239,27 @@ func (a *Adapter) mapFields(entType *gen.Type, pbType *desc.MessageDescriptor) (
 			IsEnumField:       fld.GetEnumType() != nil,
 		}
 		for _, edg := range entType.Edges {
-			if fld.GetName() == edg.Name {
+			if fld.GetName() == edgeFieldName(edg) {
 				fd.IsEdgeField = true
+				fd.EntEdge = edg
 				break
 			}
 		}
 		if fd.IsEdgeField {
-			edg, err := extractEntEdgeByName(entType, fld.GetName())
-			if err != nil {
-				return nil, err
-			}
-			fd.EntEdge = edg
+			edg := fd.EntEdge
 			referenced, err := a.GetMessageDescriptor(edg.Type.Name)
 			if err != nil {
 				return nil, err
 			}
 			fd.ReferencedPbType = referenced
+			fd.TypeMapping = a.TypeMapping(edg.Type.ID)
 		} else {
 			enf, err := extractEntFieldByName(entType, fld.GetName())
 			if err != nil {
 				return nil, err
 			}
 			fd.EntField = enf
+			fd.TypeMapping = a.TypeMapping(enf)
 		}
 		m[fld.GetName()] = fd
 	}
@@ -0,0 +1,209 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"sort"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// FieldFilter describes the comparisons supported for a field by the <T>Filter message of List requests.
+type FieldFilter struct {
+	// Kind is the name of the comparison message nested in the <T>Filter message, e.g. "StringFilter".
+	Kind string
+	// ValueType is the name of the message holding the compared values of the eq, neq, gt, lt, gte and lte
+	// comparisons.
+	ValueType string
+	// ScalarType is the protobuf type of the values of the in comparison.
+	ScalarType descriptorpb.FieldDescriptorProto_Type
+	// GoType is the Go type of the compared values, e.g. "int64". It is empty for timestamps.
+	GoType string
+	// Ordered reports whether the gt, lt, gte and lte comparisons are supported.
+	Ordered bool
+	// In reports whether the in comparison is supported.
+	In bool
+	// Contains reports whether the contains comparison is supported.
+	Contains bool
+}
+
+// filterKinds maps the scalar protobuf types to the name prefix of their comparison message, and their Go type.
+var filterKinds = map[descriptorpb.FieldDescriptorProto_Type]struct{ name, goType string }{
+	descriptorpb.FieldDescriptorProto_TYPE_BOOL:   {"Bool", "bool"},
+	descriptorpb.FieldDescriptorProto_TYPE_STRING: {"String", "string"},
+	descriptorpb.FieldDescriptorProto_TYPE_INT32:  {"Int32", "int32"},
+	descriptorpb.FieldDescriptorProto_TYPE_INT64:  {"Int64", "int64"},
+	descriptorpb.FieldDescriptorProto_TYPE_UINT32: {"UInt32", "uint32"},
+	descriptorpb.FieldDescriptorProto_TYPE_UINT64: {"UInt64", "uint64"},
+	descriptorpb.FieldDescriptorProto_TYPE_FLOAT:  {"Float", "float32"},
+	descriptorpb.FieldDescriptorProto_TYPE_DOUBLE: {"Double", "float64"},
+}
+
+// fieldFilter returns the comparisons supported for the field f, or nil if it cannot be filtered. Only fields
+// of builtin ent types that are mapped to their default protobuf type can be filtered, sensitive fields excluded.
+func (a *Adapter) fieldFilter(f *gen.Field) (*FieldFilter, error) {
+	if f.Sensitive() || f.HasGoType() || isSkipped(f.Annotations) || a.TypeMapping(f) != nil {
+		return nil, nil
+	}
+	fann, err := extractFieldAnnotation(f)
+	if err != nil {
+		return nil, err
+	}
+	if fann.Type != descriptorpb.FieldDescriptorProto_Type(0) {
+		return nil, nil
+	}
+	return fieldFilter(f), nil
+}
+
+func fieldFilter(f *gen.Field) *FieldFilter {
+	if f.Type.Type == field.TypeTime {
+		return &FieldFilter{
+			Kind:      "TimestampFilter",
+			ValueType: "google.protobuf.Timestamp",
+			Ordered:   true,
+		}
+	}
+	cfg, ok := typeMap[f.Type.Type]
+	if !ok || cfg.unsupported {
+		return nil
+	}
+	kind, ok := filterKinds[cfg.pbType]
+	if !ok {
+		return nil
+	}
+	isBool := f.Type.Type == field.TypeBool
+	return &FieldFilter{
+		Kind:       kind.name + "Filter",
+		ValueType:  cfg.optionalType,
+		ScalarType: cfg.pbType,
+		GoType:     kind.goType,
+		Ordered:    !isBool,
+		In:         !isBool,
+		Contains:   f.Type.Type == field.TypeString,
+	}
+}
+
+// filterMessageName returns the name of the filter message of genType.
+func filterMessageName(genType *gen.Type) string {
+	return genType.Name + "Filter"
+}
+
+// toFilterMessageDescriptor returns the descriptor of the <T>Filter message of genType, holding a comparison
+// field for each of its filterable fields, and the paths of the files it depends on. It returns nil if genType
+// has no filterable fields.
+func (a *Adapter) toFilterMessageDescriptor(genType *gen.Type) (*descriptorpb.DescriptorProto, []string, error) {
+	var (
+		deps  []string
+		msg   = &descriptorpb.DescriptorProto{Name: strptr(filterMessageName(genType))}
+		kinds = make(map[string]*FieldFilter)
+	)
+	for _, f := range append([]*gen.Field{genType.ID}, genType.Fields...) {
+		ff, err := a.fieldFilter(f)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ff == nil {
+			continue
+		}
+		fd, err := a.toProtoFieldDescriptor(f)
+		if err != nil {
+			return nil, nil, err
+		}
+		msg.Field = append(msg.Field, &descriptorpb.FieldDescriptorProto{
+			Name:     fd.Name,
+			Number:   fd.Number,
+			JsonName: fd.JsonName,
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: strptr(ff.Kind),
+		})
+		if _, ok := kinds[ff.Kind]; !ok {
+			kinds[ff.Kind] = ff
+			deps = append(deps, wktsPaths[ff.ValueType])
+		}
+	}
+	if len(msg.Field) == 0 {
+		return nil, nil, nil
+	}
+	names := make([]string, 0, len(kinds))
+	for k := range kinds {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		msg.NestedType = append(msg.NestedType, filterKindDescriptor(kinds[k]))
+	}
+	return msg, dedupe(deps), nil
+}
+
+// filterKindDescriptor returns the descriptor of the comparison message of the given kind.
+func filterKindDescriptor(ff *FieldFilter) *descriptorpb.DescriptorProto {
+	msg := &descriptorpb.DescriptorProto{Name: strptr(ff.Kind)}
+	value := func(name string, number int32) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     strptr(name),
+			Number:   int32ptr(number),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: strptr(ff.ValueType),
+		}
+	}
+	msg.Field = append(msg.Field, value("eq", 1), value("neq", 2))
+	if ff.Ordered {
+		msg.Field = append(msg.Field, value("gt", 3), value("lt", 4))
+	}
+	if ff.In {
+		msg.Field = append(msg.Field, &descriptorpb.FieldDescriptorProto{
+			Name:   strptr("in"),
+			Number: int32ptr(5),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+			Type:   ff.ScalarType.Enum(),
+		})
+	}
+	if ff.Contains {
+		msg.Field = append(msg.Field, value("contains", 6))
+	}
+	// The inclusive bounds were added after the other comparisons, hence their numbers.
+	if ff.Ordered {
+		msg.Field = append(msg.Field, value("gte", 7), value("lte", 8))
+	}
+	return msg
+}
+
+// Filter returns the comparisons supported for the field by the <T>Filter message of List requests, or nil
+// if the field cannot be filtered.
+func (d *FieldMappingDescriptor) Filter() *FieldFilter {
+	if d.IsEdgeField || d.EntField == nil || d.TypeMapping != nil ||
+		d.EntField.Sensitive() || d.EntField.HasGoType() {
+		return nil
+	}
+	fann, err := extractFieldAnnotation(d.EntField)
+	if err != nil || fann.Type != descriptorpb.FieldDescriptorProto_Type(0) {
+		return nil
+	}
+	return fieldFilter(d.EntField)
+}
+
+// Filterable returns the FieldMappingDescriptor for the fields of the schema, including its ID, that can be
+// used in the <T>Filter message of List requests. Items are sorted alphabetically on pb field name.
+func (m FieldMap) Filterable() []*FieldMappingDescriptor {
+	var out []*FieldMappingDescriptor
+	for _, f := range m.Fields() {
+		if f.Filter() != nil {
+			out = append(out, f)
+		}
+	}
+	return out
+}
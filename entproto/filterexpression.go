@@ -0,0 +1,47 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"entgo.io/ent/entc/gen"
+)
+
+// FilterExpression makes the filter field of the List request of the service a string holding an AIP-160 filter
+// expression, such as `status = "active" AND points > 10`, rather than a <T>Filter message. The expression may
+// reference the fields that can be filtered with the <T>Filter message, along with the enum fields, by their
+// protobuf names. For example:
+//
+//	entproto.Service(
+//		entproto.Methods(entproto.MethodAll),
+//		entproto.FilterExpression(),
+//	)
+//
+// The expressions are parsed by the entproto/runtime/filtering package. The other methods with a filter, such as
+// Count, keep the <T>Filter message.
+func FilterExpression() ServiceOption {
+	return func(s *service) {
+		s.FilterExpression = true
+	}
+}
+
+// HasFilterExpression reports whether the filter field of the List request of the service of the schema holds an
+// AIP-160 filter expression. See FilterExpression.
+func HasFilterExpression(t *gen.Type) (bool, error) {
+	svc, err := extractServiceAnnotation(t)
+	if err != nil {
+		return false, err
+	}
+	return svc.FilterExpression, nil
+}
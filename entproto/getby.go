@@ -0,0 +1,68 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// genGetByUniqueProtos returns the resources of the Get<T>By<Field> methods of genType, one per unique field
+// whose values are sent as protobuf scalars. The requests hold the value of the field under its entity number.
+func (a *Adapter) genGetByUniqueProtos(genType *gen.Type) ([]methodResources, error) {
+	var out []methodResources
+	for _, f := range genType.Fields {
+		if !f.Unique {
+			continue
+		}
+		ff, err := a.fieldFilter(f)
+		if err != nil {
+			return nil, err
+		}
+		if !isKeyFilter(ff) {
+			continue
+		}
+		fd, err := a.toProtoFieldDescriptor(f)
+		if err != nil {
+			return nil, err
+		}
+		methodName := fmt.Sprintf("Get%sBy%s", genType.Name, f.StructField())
+		input := &descriptorpb.DescriptorProto{
+			Name: strptr(methodName + "Request"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     fd.Name,
+					Number:   int32ptr(1),
+					JsonName: fd.JsonName,
+					Type:     ff.ScalarType.Enum(),
+				},
+			},
+		}
+		out = append(out, methodResources{
+			methodDescriptor: &descriptorpb.MethodDescriptorProto{
+				Name:       strptr(methodName),
+				InputType:  input.Name,
+				OutputType: strptr(genType.Name),
+			},
+			messages: []*descriptorpb.DescriptorProto{input},
+		})
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("entproto: GetByUnique method of schema %q requires a unique field", genType.Name)
+	}
+	return out, nil
+}
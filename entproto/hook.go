@@ -22,20 +22,21 @@ import (
 	"path/filepath"
 	"strings"
 
+	"entgo.io/contrib/genmanifest"
 	"entgo.io/ent/entc/gen"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/protoprint"
 	"go.uber.org/multierr"
 )
 
-// Hook returns a gen.Hook that invokes Generate.
+// Hook returns a gen.Hook that invokes Generate with the given options.
 // To use it programatically:
 //   entc.Generate("./ent/schema", &gen.Config{
 //     Hooks: []gen.Hook{
 //       entproto.Hook(),
 //     },
 //   })
-func Hook() gen.Hook {
+func Hook(opts ...GenerateOption) gen.Hook {
 	return func(next gen.Generator) gen.Generator {
 		return gen.GenerateFunc(func(g *gen.Graph) error {
 			// Because Generate has side effects (it is writing to the filesystem under gen.Config.Target),
@@ -45,20 +46,38 @@ func Hook() gen.Hook {
 			if err != nil {
 				return err
 			}
-			return Generate(g)
+			return Generate(g, opts...)
 		})
 	}
 }
 
+// WithManifest stamps the generated .proto files with the version of entproto and the hash of the schema
+// they were generated from, and records them in the manifest of the ent target directory. See the
+// genmanifest package for detecting stale generated code.
+func WithManifest() GenerateOption {
+	return func(a *Adapter) error {
+		a.manifest = true
+		return nil
+	}
+}
+
 // Generate takes a *gen.Graph and creates .proto files. Next to each .proto file, Generate creates a generate.go
 // file containing a //go:generate directive to invoke protoc and compile Go code from the protobuf definitions.
 // If generate.go already exists next to the .proto file, this step is skipped.
-func Generate(g *gen.Graph) error {
+func Generate(g *gen.Graph, opts ...GenerateOption) error {
 	entProtoDir := path.Join(g.Config.Target, "proto")
-	adapter, err := LoadAdapter(g)
+	adapter, err := newAdapter(g)
 	if err != nil {
 		return fmt.Errorf("entproto: failed parsing ent graph: %w", err)
 	}
+	for _, opt := range opts {
+		if err := opt(adapter); err != nil {
+			return err
+		}
+	}
+	if err := adapter.parse(); err != nil {
+		return fmt.Errorf("entproto: failed parsing ent graph: %w", err)
+	}
 	var errs error
 	for _, schema := range g.Schemas {
 		name := schema.Name
@@ -70,6 +89,16 @@ func Generate(g *gen.Graph) error {
 	if errs != nil {
 		return fmt.Errorf("entproto: failed parsing some schemas: %w", errs)
 	}
+	if adapter.lock.dirty {
+		if err := adapter.lock.write(lockFilePath(g)); err != nil {
+			return fmt.Errorf("entproto: failed writing lock file: %w", err)
+		}
+	}
+	if adapter.types.dirty {
+		if err := adapter.types.write(typesFilePath(g)); err != nil {
+			return fmt.Errorf("entproto: failed writing type mappings file: %w", err)
+		}
+	}
 	allDescriptors := make([]*desc.FileDescriptor, 0, len(adapter.AllFileDescriptors()))
 	for _, filedesc := range adapter.AllFileDescriptors() {
 		allDescriptors = append(allDescriptors, filedesc)
@@ -80,6 +109,23 @@ func Generate(g *gen.Graph) error {
 	if err = printer.PrintProtosToFileSystem(allDescriptors, entProtoDir); err != nil {
 		return fmt.Errorf("entproto: failed writing .proto files: %w", err)
 	}
+	if adapter.usesDefaultOption() {
+		// The file declaring the (entproto.default) option is imported by the printed files, and its Go code
+		// is part of entproto, so no generate.go file is created next to it.
+		optsDesc, err := desc.LoadFileDescriptor(defaultOptionPath)
+		if err != nil {
+			return err
+		}
+		if err := printer.PrintProtosToFileSystem([]*desc.FileDescriptor{optsDesc}, entProtoDir); err != nil {
+			return fmt.Errorf("entproto: failed writing %s: %w", defaultOptionPath, err)
+		}
+	}
+
+	if adapter.manifest {
+		if err := writeManifest(g, entProtoDir, allDescriptors); err != nil {
+			return fmt.Errorf("entproto: failed writing manifest: %w", err)
+		}
+	}
 
 	// Print a generate.go file with protoc command for go file generation
 	for _, fd := range allDescriptors {
@@ -96,6 +142,15 @@ func Generate(g *gen.Graph) error {
 	return nil
 }
 
+// writeManifest records the printed .proto files in the manifest.
+func writeManifest(g *gen.Graph, entProtoDir string, fds []*desc.FileDescriptor) error {
+	files := make([]string, 0, len(fds))
+	for _, fd := range fds {
+		files = append(files, filepath.Join(entProtoDir, fd.GetName()))
+	}
+	return genmanifest.Record(g, "entproto", files...)
+}
+
 func fileExists(fpath string) bool {
 	if _, err := os.Stat(fpath); err != nil {
 		if os.IsNotExist(err) {
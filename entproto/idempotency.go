@@ -0,0 +1,61 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// IdempotencyKey sets the unique string field storing the idempotency key of the request creating each entry of
+// the schema, making the retries of the Create method safe. The key is read from the x-idempotency-key metadata
+// of the request, or from the field of the created entity if the metadata is not set, and stored along with the
+// entry. Retries with the key of a created entry return it, rather than creating a duplicate or failing with the
+// AlreadyExists code. For example:
+//
+//	entproto.Service(
+//		entproto.IdempotencyKey("request_id"),
+//	)
+//
+// The field is usually optional and immutable, so that the requests without a key are still served. The other
+// methods creating entries, such as BatchCreate or Upsert, do not look up the key.
+func IdempotencyKey(field string) ServiceOption {
+	return func(s *service) {
+		s.IdempotencyKey = field
+	}
+}
+
+// IdempotencyKeyField returns the field storing the idempotency key of the entries of the schema, or nil if its
+// Create method is not idempotent. See IdempotencyKey.
+func IdempotencyKeyField(t *gen.Type) (*gen.Field, error) {
+	svc, err := extractServiceAnnotation(t)
+	if err != nil {
+		return nil, err
+	}
+	if svc.IdempotencyKey == "" {
+		return nil, nil
+	}
+	for _, f := range t.Fields {
+		if f.Name != svc.IdempotencyKey {
+			continue
+		}
+		if !f.Unique || !f.IsString() || f.HasGoType() || f.IsEdgeField() {
+			return nil, fmt.Errorf("entproto: idempotency key field %q of schema %q must be a unique string field", f.Name, t.Name)
+		}
+		return f, nil
+	}
+	return nil, fmt.Errorf("entproto: idempotency key field %q not found in schema %q", svc.IdempotencyKey, t.Name)
+}
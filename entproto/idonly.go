@@ -0,0 +1,47 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"entgo.io/ent/entc/gen"
+)
+
+// IDOnly generates the edge as a field holding the IDs of the referenced entities, instead of a field of
+// the message of the referenced type populated only with its ID. It is intended for self-referencing edges,
+// such as the friends of a user, where a field of the message type itself suggests a recursive structure
+// that is never populated beyond the first level. The field is named after the edge as in the edge IDs
+// message, for example "friend_ids" for a non-unique edge named "friends", and "parent_id" for a unique
+// edge named "parent".
+// Example:
+//	edge.To("friends", User.Type).
+//		Annotations(
+//			entproto.Field(6,
+//				entproto.IDOnly(),
+//			),
+//		)
+func IDOnly() FieldOption {
+	return func(p *pbfield) {
+		p.IDOnly = true
+	}
+}
+
+// edgeFieldName returns the name of the field generated for edge e in the message of its schema, which is
+// the name of its edge IDs field if the edge is annotated with entproto.IDOnly, and the name of the edge otherwise.
+func edgeFieldName(e *gen.Edge) string {
+	if annot, err := extractEdgeAnnotation(e); err == nil && annot.IDOnly {
+		return edgeIDsFieldName(e)
+	}
+	return e.Name
+}
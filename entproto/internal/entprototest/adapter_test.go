@@ -15,12 +15,15 @@
 package entprototest
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
 
 	"entgo.io/contrib/entproto"
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
@@ -65,10 +68,12 @@ func (suite *AdapterTestSuite) TestValidMessage() {
 	nameField := message.FindFieldByName("name")
 	suite.NotNil(nameField)
 	suite.EqualValues(2, nameField.GetNumber())
+	suite.EqualValues("title", nameField.GetJSONName())
 
 	tsField := message.FindFieldByName("ts")
 	suite.NotNil(nameField)
 	suite.EqualValues(3, tsField.GetNumber())
+	suite.EqualValues("ts", tsField.GetJSONName())
 	suite.EqualValues("google.protobuf.Timestamp", tsField.GetMessageType().GetFullyQualifiedName())
 
 	uuField := message.FindFieldByName("uuid")
@@ -123,6 +128,72 @@ func (suite *AdapterTestSuite) TestInvalidField() {
 	suite.EqualError(err, "unsupported field type \"TypeJSON\"")
 }
 
+func (suite *AdapterTestSuite) TestRegisterTypeMapping() {
+	_, err := suite.adapter.GetFileDescriptor("MappedFieldMessage")
+	suite.EqualError(err, "unsupported field type \"TypeOther\"")
+
+	graph, err := entc.LoadGraph("./ent/schema", &gen.Config{})
+	suite.Require().NoError(err)
+	adapter, err := entproto.LoadAdapter(graph)
+	suite.Require().NoError(err)
+	conv := entproto.TypeConverter{
+		ToProto:  `{{ qualify "fmt" "Sprintf" }}("%f,%f", {{ . }}.Lat, {{ . }}.Lng)`,
+		ToEnt:    `{{ qualify "entgo.io/contrib/entproto/internal/entprototest/ent/schema" "ParseCoordinate" }}({{ . }})`,
+		ToEntErr: true,
+	}
+	goType := "entgo.io/contrib/entproto/internal/entprototest/ent/schema.Coordinate"
+	err = adapter.RegisterTypeMapping(goType, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, conv)
+	suite.EqualError(err, `entproto: Go type "entgo.io/contrib/entproto/internal/entprototest/ent/schema.Coordinate" cannot be mapped to non-scalar proto type TYPE_MESSAGE`)
+	err = adapter.RegisterTypeMapping(goType, descriptorpb.FieldDescriptorProto_TYPE_STRING, entproto.TypeConverter{ToProto: "{{ . }", ToEnt: "{{ . }}"})
+	suite.ErrorContains(err, "failed parsing ToProto template")
+	suite.Require().NoError(adapter.RegisterTypeMapping(goType, descriptorpb.FieldDescriptorProto_TYPE_STRING, conv))
+
+	message, err := adapter.GetMessageDescriptor("MappedFieldMessage")
+	suite.Require().NoError(err)
+	location := message.FindFieldByName("location")
+	suite.Require().NotNil(location)
+	suite.EqualValues(descriptorpb.FieldDescriptorProto_TYPE_STRING, location.GetType())
+	previous := message.FindFieldByName("previous_location")
+	suite.Require().NotNil(previous)
+	suite.EqualValues("google.protobuf.StringValue", previous.GetMessageType().GetFullyQualifiedName())
+
+	mp, err := adapter.FieldMap("MappedFieldMessage")
+	suite.Require().NoError(err)
+	suite.Require().NotNil(mp["location"].TypeMapping)
+	suite.Equal(goType, mp["location"].TypeMapping.GoType)
+	suite.Equal(conv, mp["location"].TypeMapping.TypeConverter)
+	suite.Nil(mp["id"].TypeMapping)
+
+	// Other schemas are not affected by the mapping.
+	_, err = adapter.GetFileDescriptor("InvalidFieldMessage")
+	suite.EqualError(err, "unsupported field type \"TypeJSON\"")
+}
+
+func (suite *AdapterTestSuite) TestSharedEnum() {
+	fd, err := suite.adapter.GetFileDescriptor("SharedEnumMessage")
+	suite.Require().NoError(err)
+	color := fd.FindEnum("entpb.Color")
+	suite.Require().NotNil(color)
+	suite.Len(color.GetValues(), 3)
+	suite.EqualValues(0, color.FindValueByName("COLOR_UNSPECIFIED").GetNumber())
+	suite.EqualValues(1, color.FindValueByName("COLOR_RED").GetNumber())
+	suite.EqualValues(2, color.FindValueByName("COLOR_GREEN").GetNumber())
+
+	message, err := suite.adapter.GetMessageDescriptor("SharedEnumMessage")
+	suite.Require().NoError(err)
+	suite.Empty(message.GetNestedEnumTypes())
+	suite.Equal(color, message.FindFieldByName("color").GetEnumType())
+
+	other, err := suite.adapter.GetMessageDescriptor("SharedEnumOtherMessage")
+	suite.Require().NoError(err)
+	suite.Empty(other.GetNestedEnumTypes())
+	suite.Equal(color, other.FindFieldByName("color").GetEnumType())
+	suite.Equal(color, other.FindFieldByName("background").GetEnumType())
+
+	_, err = suite.adapter.GetFileDescriptor("SharedEnumVariant")
+	suite.EqualError(err, `entproto: field "color" of "SharedEnumVariant" defines shared enum "Color" differently than other fields`)
+}
+
 func (suite *AdapterTestSuite) TestDuplicateNumber() {
 	_, err := suite.adapter.GetFileDescriptor("DuplicateNumberMessage")
 	suite.EqualError(err, "entproto: field 2 already defined on message \"DuplicateNumberMessage\"")
@@ -180,7 +251,7 @@ func (suite *AdapterTestSuite) TestEnumMessage() {
 	suite.NoError(err)
 
 	message := fd.FindMessage("entpb.MessageWithEnum")
-	suite.Len(message.GetFields(), 3)
+	suite.Len(message.GetFields(), 5)
 
 	// an enum field with defaults
 	enumField := message.FindFieldByName("enum_type")
@@ -202,6 +273,18 @@ func (suite *AdapterTestSuite) TestEnumMessage() {
 	suite.EqualValues(0, enumDesc.FindValueByName("ENUM_WITHOUT_DEFAULT_UNSPECIFIED").GetNumber())
 	suite.EqualValues(1, enumDesc.FindValueByName("ENUM_WITHOUT_DEFAULT_FIRST").GetNumber())
 	suite.EqualValues(2, enumDesc.FindValueByName("ENUM_WITHOUT_DEFAULT_SECOND").GetNumber())
+
+	// an enum field without defaults that maps an option to zero
+	enumDesc = message.FindFieldByName("enum_zero_mapped").GetEnumType()
+	suite.Len(enumDesc.GetValues(), 2)
+	suite.EqualValues(0, enumDesc.FindValueByName("ENUM_ZERO_MAPPED_LOW").GetNumber())
+	suite.EqualValues(1, enumDesc.FindValueByName("ENUM_ZERO_MAPPED_HIGH").GetNumber())
+
+	// an enum field with a custom zero value name
+	enumDesc = message.FindFieldByName("enum_unknown").GetEnumType()
+	suite.Nil(enumDesc.FindValueByName("ENUM_UNKNOWN_UNSPECIFIED"))
+	suite.EqualValues(0, enumDesc.FindValueByName("ENUM_UNKNOWN_UNKNOWN").GetNumber())
+	suite.EqualValues(1, enumDesc.FindValueByName("ENUM_UNKNOWN_KNOWN").GetNumber())
 }
 
 func (suite *AdapterTestSuite) TestMessageWithId() {
@@ -258,3 +341,82 @@ func (suite *AdapterTestSuite) TestOptionals() {
 	suite.Require().EqualValues(descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, bytesField.GetType())
 	suite.Require().EqualValues("BytesValue", uuidField.GetMessageType().GetName())
 }
+
+func (suite *AdapterTestSuite) TestAutoFieldNumbers() {
+	message, err := suite.adapter.GetMessageDescriptor("AutoFieldNumbers")
+	suite.Require().NoError(err)
+	suite.Len(message.GetFields(), 4)
+	suite.EqualValues(1, message.FindFieldByName("id").GetNumber())
+	suite.EqualValues(5, message.FindFieldByName("pinned").GetNumber())
+	suite.EqualValues(6, message.FindFieldByName("first").GetNumber())
+	suite.EqualValues(7, message.FindFieldByName("second").GetNumber())
+	suite.Nil(message.FindFieldByName("skipped"))
+}
+
+func TestAutoFieldNumbersLockFile(t *testing.T) {
+	tgt := t.TempDir()
+	lockPath := filepath.Join(tgt, "proto", entproto.LockFileName)
+	require.NoError(t, os.MkdirAll(filepath.Dir(lockPath), os.ModePerm))
+	require.NoError(t, os.WriteFile(lockPath, []byte(`{"messages":{"AutoFieldNumbers":{"first":3,"removed":9}}}`), 0600))
+	graph, err := entc.LoadGraph("./ent/schema", &gen.Config{Target: tgt})
+	require.NoError(t, err)
+	adapter, err := entproto.LoadAdapter(graph)
+	require.NoError(t, err)
+	message, err := adapter.GetMessageDescriptor("AutoFieldNumbers")
+	require.NoError(t, err)
+	require.EqualValues(t, 3, message.FindFieldByName("first").GetNumber())
+	require.EqualValues(t, 5, message.FindFieldByName("pinned").GetNumber())
+	require.EqualValues(t, 10, message.FindFieldByName("second").GetNumber())
+}
+
+func TestAutoFieldNumbersLockFileCollision(t *testing.T) {
+	tgt := t.TempDir()
+	lockPath := filepath.Join(tgt, "proto", entproto.LockFileName)
+	require.NoError(t, os.MkdirAll(filepath.Dir(lockPath), os.ModePerm))
+	require.NoError(t, os.WriteFile(lockPath, []byte(`{"messages":{"AutoFieldNumbers":{"first":5}}}`), 0600))
+	graph, err := entc.LoadGraph("./ent/schema", &gen.Config{Target: tgt})
+	require.NoError(t, err)
+	adapter, err := entproto.LoadAdapter(graph)
+	require.NoError(t, err)
+	_, err = adapter.GetMessageDescriptor("AutoFieldNumbers")
+	require.EqualError(t, err, `entproto: field number 5 of "pinned" on schema "AutoFieldNumbers" is locked to "first", remove or renumber its entry in entproto.lock.json`)
+}
+
+func TestAutoFieldNumbersReservedRange(t *testing.T) {
+	tgt := t.TempDir()
+	lockPath := filepath.Join(tgt, "proto", entproto.LockFileName)
+	require.NoError(t, os.MkdirAll(filepath.Dir(lockPath), os.ModePerm))
+	require.NoError(t, os.WriteFile(lockPath, []byte(`{"messages":{"AutoFieldNumbers":{"removed":18999}}}`), 0600))
+	graph, err := entc.LoadGraph("./ent/schema", &gen.Config{Target: tgt})
+	require.NoError(t, err)
+	adapter, err := entproto.LoadAdapter(graph)
+	require.NoError(t, err)
+	message, err := adapter.GetMessageDescriptor("AutoFieldNumbers")
+	require.NoError(t, err)
+	require.EqualValues(t, 20000, message.FindFieldByName("first").GetNumber())
+	require.EqualValues(t, 20001, message.FindFieldByName("second").GetNumber())
+}
+
+func (suite *AdapterTestSuite) TestMixinAnnotations() {
+	message, err := suite.adapter.GetMessageDescriptor("MixinAnnotated")
+	suite.Require().NoError(err)
+	suite.Len(message.GetFields(), 3)
+	suite.Nil(message.FindFieldByName("internal"))
+	state := message.FindFieldByName("state")
+	suite.Require().NotNil(state)
+	suite.EqualValues(2, state.GetNumber())
+	suite.EqualValues(1, state.GetEnumType().FindValueByName("STATE_ON").GetNumber())
+	suite.EqualValues(3, message.FindFieldByName("name").GetNumber())
+}
+
+func TestSkipMerge(t *testing.T) {
+	merged := entproto.Skip(entproto.MethodCreate).(schema.Merger).Merge(entproto.Skip(entproto.MethodUpdate))
+	require.Equal(t, entproto.Skip(entproto.MethodCreate|entproto.MethodUpdate), merged)
+	merged = entproto.Skip(entproto.MethodCreate).(schema.Merger).Merge(entproto.Skip())
+	require.Equal(t, entproto.Skip(), merged)
+}
+
+func (suite *AdapterTestSuite) TestMixinFieldNumberCollision() {
+	_, err := suite.adapter.GetMessageDescriptor("MixinCollision")
+	suite.EqualError(err, `entproto: field number 2 on schema "MixinCollision" is declared by field "created_by" of mixin #0 and by field "name"`)
+}
@@ -0,0 +1,134 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/autofieldnumbers"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AutoFieldNumbers is the model entity for the AutoFieldNumbers schema.
+type AutoFieldNumbers struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// First holds the value of the "first" field.
+	First string `json:"first,omitempty"`
+	// Pinned holds the value of the "pinned" field.
+	Pinned string `json:"pinned,omitempty"`
+	// Skipped holds the value of the "skipped" field.
+	Skipped string `json:"skipped,omitempty"`
+	// Second holds the value of the "second" field.
+	Second bool `json:"second,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AutoFieldNumbers) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case autofieldnumbers.FieldSecond:
+			values[i] = new(sql.NullBool)
+		case autofieldnumbers.FieldID:
+			values[i] = new(sql.NullInt64)
+		case autofieldnumbers.FieldFirst, autofieldnumbers.FieldPinned, autofieldnumbers.FieldSkipped:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type AutoFieldNumbers", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AutoFieldNumbers fields.
+func (afn *AutoFieldNumbers) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case autofieldnumbers.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			afn.ID = int(value.Int64)
+		case autofieldnumbers.FieldFirst:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field first", values[i])
+			} else if value.Valid {
+				afn.First = value.String
+			}
+		case autofieldnumbers.FieldPinned:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pinned", values[i])
+			} else if value.Valid {
+				afn.Pinned = value.String
+			}
+		case autofieldnumbers.FieldSkipped:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field skipped", values[i])
+			} else if value.Valid {
+				afn.Skipped = value.String
+			}
+		case autofieldnumbers.FieldSecond:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field second", values[i])
+			} else if value.Valid {
+				afn.Second = value.Bool
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this AutoFieldNumbers.
+// Note that you need to call AutoFieldNumbers.Unwrap() before calling this method if this AutoFieldNumbers
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (afn *AutoFieldNumbers) Update() *AutoFieldNumbersUpdateOne {
+	return (&AutoFieldNumbersClient{config: afn.config}).UpdateOne(afn)
+}
+
+// Unwrap unwraps the AutoFieldNumbers entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (afn *AutoFieldNumbers) Unwrap() *AutoFieldNumbers {
+	_tx, ok := afn.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AutoFieldNumbers is not a transactional entity")
+	}
+	afn.config.driver = _tx.drv
+	return afn
+}
+
+// String implements the fmt.Stringer.
+func (afn *AutoFieldNumbers) String() string {
+	var builder strings.Builder
+	builder.WriteString("AutoFieldNumbers(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", afn.ID))
+	builder.WriteString("first=")
+	builder.WriteString(afn.First)
+	builder.WriteString(", ")
+	builder.WriteString("pinned=")
+	builder.WriteString(afn.Pinned)
+	builder.WriteString(", ")
+	builder.WriteString("skipped=")
+	builder.WriteString(afn.Skipped)
+	builder.WriteString(", ")
+	builder.WriteString("second=")
+	builder.WriteString(fmt.Sprintf("%v", afn.Second))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AutoFieldNumbersSlice is a parsable slice of AutoFieldNumbers.
+type AutoFieldNumbersSlice []*AutoFieldNumbers
+
+func (afn AutoFieldNumbersSlice) config(cfg config) {
+	for _i := range afn {
+		afn[_i].config = cfg
+	}
+}
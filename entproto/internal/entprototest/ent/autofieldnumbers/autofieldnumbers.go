@@ -0,0 +1,39 @@
+// Code generated by ent, DO NOT EDIT.
+
+package autofieldnumbers
+
+const (
+	// Label holds the string label denoting the autofieldnumbers type in the database.
+	Label = "auto_field_numbers"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFirst holds the string denoting the first field in the database.
+	FieldFirst = "first"
+	// FieldPinned holds the string denoting the pinned field in the database.
+	FieldPinned = "pinned"
+	// FieldSkipped holds the string denoting the skipped field in the database.
+	FieldSkipped = "skipped"
+	// FieldSecond holds the string denoting the second field in the database.
+	FieldSecond = "second"
+	// Table holds the table name of the autofieldnumbers in the database.
+	Table = "auto_field_numbers"
+)
+
+// Columns holds all SQL columns for autofieldnumbers fields.
+var Columns = []string{
+	FieldID,
+	FieldFirst,
+	FieldPinned,
+	FieldSkipped,
+	FieldSecond,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,450 @@
+// Code generated by ent, DO NOT EDIT.
+
+package autofieldnumbers
+
+import (
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// First applies equality check predicate on the "first" field. It's identical to FirstEQ.
+func First(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFirst), v))
+	})
+}
+
+// Pinned applies equality check predicate on the "pinned" field. It's identical to PinnedEQ.
+func Pinned(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPinned), v))
+	})
+}
+
+// Skipped applies equality check predicate on the "skipped" field. It's identical to SkippedEQ.
+func Skipped(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSkipped), v))
+	})
+}
+
+// Second applies equality check predicate on the "second" field. It's identical to SecondEQ.
+func Second(v bool) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSecond), v))
+	})
+}
+
+// FirstEQ applies the EQ predicate on the "first" field.
+func FirstEQ(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFirst), v))
+	})
+}
+
+// FirstNEQ applies the NEQ predicate on the "first" field.
+func FirstNEQ(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldFirst), v))
+	})
+}
+
+// FirstIn applies the In predicate on the "first" field.
+func FirstIn(vs ...string) predicate.AutoFieldNumbers {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldFirst), v...))
+	})
+}
+
+// FirstNotIn applies the NotIn predicate on the "first" field.
+func FirstNotIn(vs ...string) predicate.AutoFieldNumbers {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldFirst), v...))
+	})
+}
+
+// FirstGT applies the GT predicate on the "first" field.
+func FirstGT(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldFirst), v))
+	})
+}
+
+// FirstGTE applies the GTE predicate on the "first" field.
+func FirstGTE(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldFirst), v))
+	})
+}
+
+// FirstLT applies the LT predicate on the "first" field.
+func FirstLT(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldFirst), v))
+	})
+}
+
+// FirstLTE applies the LTE predicate on the "first" field.
+func FirstLTE(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldFirst), v))
+	})
+}
+
+// FirstContains applies the Contains predicate on the "first" field.
+func FirstContains(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldFirst), v))
+	})
+}
+
+// FirstHasPrefix applies the HasPrefix predicate on the "first" field.
+func FirstHasPrefix(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldFirst), v))
+	})
+}
+
+// FirstHasSuffix applies the HasSuffix predicate on the "first" field.
+func FirstHasSuffix(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldFirst), v))
+	})
+}
+
+// FirstEqualFold applies the EqualFold predicate on the "first" field.
+func FirstEqualFold(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldFirst), v))
+	})
+}
+
+// FirstContainsFold applies the ContainsFold predicate on the "first" field.
+func FirstContainsFold(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldFirst), v))
+	})
+}
+
+// PinnedEQ applies the EQ predicate on the "pinned" field.
+func PinnedEQ(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPinned), v))
+	})
+}
+
+// PinnedNEQ applies the NEQ predicate on the "pinned" field.
+func PinnedNEQ(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPinned), v))
+	})
+}
+
+// PinnedIn applies the In predicate on the "pinned" field.
+func PinnedIn(vs ...string) predicate.AutoFieldNumbers {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldPinned), v...))
+	})
+}
+
+// PinnedNotIn applies the NotIn predicate on the "pinned" field.
+func PinnedNotIn(vs ...string) predicate.AutoFieldNumbers {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldPinned), v...))
+	})
+}
+
+// PinnedGT applies the GT predicate on the "pinned" field.
+func PinnedGT(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPinned), v))
+	})
+}
+
+// PinnedGTE applies the GTE predicate on the "pinned" field.
+func PinnedGTE(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPinned), v))
+	})
+}
+
+// PinnedLT applies the LT predicate on the "pinned" field.
+func PinnedLT(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPinned), v))
+	})
+}
+
+// PinnedLTE applies the LTE predicate on the "pinned" field.
+func PinnedLTE(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPinned), v))
+	})
+}
+
+// PinnedContains applies the Contains predicate on the "pinned" field.
+func PinnedContains(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPinned), v))
+	})
+}
+
+// PinnedHasPrefix applies the HasPrefix predicate on the "pinned" field.
+func PinnedHasPrefix(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPinned), v))
+	})
+}
+
+// PinnedHasSuffix applies the HasSuffix predicate on the "pinned" field.
+func PinnedHasSuffix(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPinned), v))
+	})
+}
+
+// PinnedEqualFold applies the EqualFold predicate on the "pinned" field.
+func PinnedEqualFold(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPinned), v))
+	})
+}
+
+// PinnedContainsFold applies the ContainsFold predicate on the "pinned" field.
+func PinnedContainsFold(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPinned), v))
+	})
+}
+
+// SkippedEQ applies the EQ predicate on the "skipped" field.
+func SkippedEQ(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSkipped), v))
+	})
+}
+
+// SkippedNEQ applies the NEQ predicate on the "skipped" field.
+func SkippedNEQ(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSkipped), v))
+	})
+}
+
+// SkippedIn applies the In predicate on the "skipped" field.
+func SkippedIn(vs ...string) predicate.AutoFieldNumbers {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldSkipped), v...))
+	})
+}
+
+// SkippedNotIn applies the NotIn predicate on the "skipped" field.
+func SkippedNotIn(vs ...string) predicate.AutoFieldNumbers {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldSkipped), v...))
+	})
+}
+
+// SkippedGT applies the GT predicate on the "skipped" field.
+func SkippedGT(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSkipped), v))
+	})
+}
+
+// SkippedGTE applies the GTE predicate on the "skipped" field.
+func SkippedGTE(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSkipped), v))
+	})
+}
+
+// SkippedLT applies the LT predicate on the "skipped" field.
+func SkippedLT(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSkipped), v))
+	})
+}
+
+// SkippedLTE applies the LTE predicate on the "skipped" field.
+func SkippedLTE(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSkipped), v))
+	})
+}
+
+// SkippedContains applies the Contains predicate on the "skipped" field.
+func SkippedContains(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSkipped), v))
+	})
+}
+
+// SkippedHasPrefix applies the HasPrefix predicate on the "skipped" field.
+func SkippedHasPrefix(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSkipped), v))
+	})
+}
+
+// SkippedHasSuffix applies the HasSuffix predicate on the "skipped" field.
+func SkippedHasSuffix(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSkipped), v))
+	})
+}
+
+// SkippedEqualFold applies the EqualFold predicate on the "skipped" field.
+func SkippedEqualFold(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSkipped), v))
+	})
+}
+
+// SkippedContainsFold applies the ContainsFold predicate on the "skipped" field.
+func SkippedContainsFold(v string) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSkipped), v))
+	})
+}
+
+// SecondEQ applies the EQ predicate on the "second" field.
+func SecondEQ(v bool) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSecond), v))
+	})
+}
+
+// SecondNEQ applies the NEQ predicate on the "second" field.
+func SecondNEQ(v bool) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSecond), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AutoFieldNumbers) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AutoFieldNumbers) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AutoFieldNumbers) predicate.AutoFieldNumbers {
+	return predicate.AutoFieldNumbers(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
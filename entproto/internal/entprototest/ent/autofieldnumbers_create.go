@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/autofieldnumbers"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AutoFieldNumbersCreate is the builder for creating a AutoFieldNumbers entity.
+type AutoFieldNumbersCreate struct {
+	config
+	mutation *AutoFieldNumbersMutation
+	hooks    []Hook
+}
+
+// SetFirst sets the "first" field.
+func (afnc *AutoFieldNumbersCreate) SetFirst(s string) *AutoFieldNumbersCreate {
+	afnc.mutation.SetFirst(s)
+	return afnc
+}
+
+// SetPinned sets the "pinned" field.
+func (afnc *AutoFieldNumbersCreate) SetPinned(s string) *AutoFieldNumbersCreate {
+	afnc.mutation.SetPinned(s)
+	return afnc
+}
+
+// SetSkipped sets the "skipped" field.
+func (afnc *AutoFieldNumbersCreate) SetSkipped(s string) *AutoFieldNumbersCreate {
+	afnc.mutation.SetSkipped(s)
+	return afnc
+}
+
+// SetSecond sets the "second" field.
+func (afnc *AutoFieldNumbersCreate) SetSecond(b bool) *AutoFieldNumbersCreate {
+	afnc.mutation.SetSecond(b)
+	return afnc
+}
+
+// Mutation returns the AutoFieldNumbersMutation object of the builder.
+func (afnc *AutoFieldNumbersCreate) Mutation() *AutoFieldNumbersMutation {
+	return afnc.mutation
+}
+
+// Save creates the AutoFieldNumbers in the database.
+func (afnc *AutoFieldNumbersCreate) Save(ctx context.Context) (*AutoFieldNumbers, error) {
+	var (
+		err  error
+		node *AutoFieldNumbers
+	)
+	if len(afnc.hooks) == 0 {
+		if err = afnc.check(); err != nil {
+			return nil, err
+		}
+		node, err = afnc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AutoFieldNumbersMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = afnc.check(); err != nil {
+				return nil, err
+			}
+			afnc.mutation = mutation
+			if node, err = afnc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(afnc.hooks) - 1; i >= 0; i-- {
+			if afnc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = afnc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, afnc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*AutoFieldNumbers)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from AutoFieldNumbersMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (afnc *AutoFieldNumbersCreate) SaveX(ctx context.Context) *AutoFieldNumbers {
+	v, err := afnc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (afnc *AutoFieldNumbersCreate) Exec(ctx context.Context) error {
+	_, err := afnc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (afnc *AutoFieldNumbersCreate) ExecX(ctx context.Context) {
+	if err := afnc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (afnc *AutoFieldNumbersCreate) check() error {
+	if _, ok := afnc.mutation.First(); !ok {
+		return &ValidationError{Name: "first", err: errors.New(`ent: missing required field "AutoFieldNumbers.first"`)}
+	}
+	if _, ok := afnc.mutation.Pinned(); !ok {
+		return &ValidationError{Name: "pinned", err: errors.New(`ent: missing required field "AutoFieldNumbers.pinned"`)}
+	}
+	if _, ok := afnc.mutation.Skipped(); !ok {
+		return &ValidationError{Name: "skipped", err: errors.New(`ent: missing required field "AutoFieldNumbers.skipped"`)}
+	}
+	if _, ok := afnc.mutation.Second(); !ok {
+		return &ValidationError{Name: "second", err: errors.New(`ent: missing required field "AutoFieldNumbers.second"`)}
+	}
+	return nil
+}
+
+func (afnc *AutoFieldNumbersCreate) sqlSave(ctx context.Context) (*AutoFieldNumbers, error) {
+	_node, _spec := afnc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, afnc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (afnc *AutoFieldNumbersCreate) createSpec() (*AutoFieldNumbers, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AutoFieldNumbers{config: afnc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: autofieldnumbers.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: autofieldnumbers.FieldID,
+			},
+		}
+	)
+	if value, ok := afnc.mutation.First(); ok {
+		_spec.SetField(autofieldnumbers.FieldFirst, field.TypeString, value)
+		_node.First = value
+	}
+	if value, ok := afnc.mutation.Pinned(); ok {
+		_spec.SetField(autofieldnumbers.FieldPinned, field.TypeString, value)
+		_node.Pinned = value
+	}
+	if value, ok := afnc.mutation.Skipped(); ok {
+		_spec.SetField(autofieldnumbers.FieldSkipped, field.TypeString, value)
+		_node.Skipped = value
+	}
+	if value, ok := afnc.mutation.Second(); ok {
+		_spec.SetField(autofieldnumbers.FieldSecond, field.TypeBool, value)
+		_node.Second = value
+	}
+	return _node, _spec
+}
+
+// AutoFieldNumbersCreateBulk is the builder for creating many AutoFieldNumbers entities in bulk.
+type AutoFieldNumbersCreateBulk struct {
+	config
+	builders []*AutoFieldNumbersCreate
+}
+
+// Save creates the AutoFieldNumbers entities in the database.
+func (afncb *AutoFieldNumbersCreateBulk) Save(ctx context.Context) ([]*AutoFieldNumbers, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(afncb.builders))
+	nodes := make([]*AutoFieldNumbers, len(afncb.builders))
+	mutators := make([]Mutator, len(afncb.builders))
+	for i := range afncb.builders {
+		func(i int, root context.Context) {
+			builder := afncb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AutoFieldNumbersMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, afncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, afncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, afncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (afncb *AutoFieldNumbersCreateBulk) SaveX(ctx context.Context) []*AutoFieldNumbers {
+	v, err := afncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (afncb *AutoFieldNumbersCreateBulk) Exec(ctx context.Context) error {
+	_, err := afncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (afncb *AutoFieldNumbersCreateBulk) ExecX(ctx context.Context) {
+	if err := afncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
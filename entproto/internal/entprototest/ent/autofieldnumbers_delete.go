@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/autofieldnumbers"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AutoFieldNumbersDelete is the builder for deleting a AutoFieldNumbers entity.
+type AutoFieldNumbersDelete struct {
+	config
+	hooks    []Hook
+	mutation *AutoFieldNumbersMutation
+}
+
+// Where appends a list predicates to the AutoFieldNumbersDelete builder.
+func (afnd *AutoFieldNumbersDelete) Where(ps ...predicate.AutoFieldNumbers) *AutoFieldNumbersDelete {
+	afnd.mutation.Where(ps...)
+	return afnd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (afnd *AutoFieldNumbersDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(afnd.hooks) == 0 {
+		affected, err = afnd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AutoFieldNumbersMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			afnd.mutation = mutation
+			affected, err = afnd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(afnd.hooks) - 1; i >= 0; i-- {
+			if afnd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = afnd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, afnd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (afnd *AutoFieldNumbersDelete) ExecX(ctx context.Context) int {
+	n, err := afnd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (afnd *AutoFieldNumbersDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: autofieldnumbers.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: autofieldnumbers.FieldID,
+			},
+		},
+	}
+	if ps := afnd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, afnd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// AutoFieldNumbersDeleteOne is the builder for deleting a single AutoFieldNumbers entity.
+type AutoFieldNumbersDeleteOne struct {
+	afnd *AutoFieldNumbersDelete
+}
+
+// Exec executes the deletion query.
+func (afndo *AutoFieldNumbersDeleteOne) Exec(ctx context.Context) error {
+	n, err := afndo.afnd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{autofieldnumbers.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (afndo *AutoFieldNumbersDeleteOne) ExecX(ctx context.Context) {
+	afndo.afnd.ExecX(ctx)
+}
@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/autofieldnumbers"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AutoFieldNumbersQuery is the builder for querying AutoFieldNumbers entities.
+type AutoFieldNumbersQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.AutoFieldNumbers
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AutoFieldNumbersQuery builder.
+func (afnq *AutoFieldNumbersQuery) Where(ps ...predicate.AutoFieldNumbers) *AutoFieldNumbersQuery {
+	afnq.predicates = append(afnq.predicates, ps...)
+	return afnq
+}
+
+// Limit adds a limit step to the query.
+func (afnq *AutoFieldNumbersQuery) Limit(limit int) *AutoFieldNumbersQuery {
+	afnq.limit = &limit
+	return afnq
+}
+
+// Offset adds an offset step to the query.
+func (afnq *AutoFieldNumbersQuery) Offset(offset int) *AutoFieldNumbersQuery {
+	afnq.offset = &offset
+	return afnq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (afnq *AutoFieldNumbersQuery) Unique(unique bool) *AutoFieldNumbersQuery {
+	afnq.unique = &unique
+	return afnq
+}
+
+// Order adds an order step to the query.
+func (afnq *AutoFieldNumbersQuery) Order(o ...OrderFunc) *AutoFieldNumbersQuery {
+	afnq.order = append(afnq.order, o...)
+	return afnq
+}
+
+// First returns the first AutoFieldNumbers entity from the query.
+// Returns a *NotFoundError when no AutoFieldNumbers was found.
+func (afnq *AutoFieldNumbersQuery) First(ctx context.Context) (*AutoFieldNumbers, error) {
+	nodes, err := afnq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{autofieldnumbers.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (afnq *AutoFieldNumbersQuery) FirstX(ctx context.Context) *AutoFieldNumbers {
+	node, err := afnq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AutoFieldNumbers ID from the query.
+// Returns a *NotFoundError when no AutoFieldNumbers ID was found.
+func (afnq *AutoFieldNumbersQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = afnq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{autofieldnumbers.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (afnq *AutoFieldNumbersQuery) FirstIDX(ctx context.Context) int {
+	id, err := afnq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AutoFieldNumbers entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AutoFieldNumbers entity is found.
+// Returns a *NotFoundError when no AutoFieldNumbers entities are found.
+func (afnq *AutoFieldNumbersQuery) Only(ctx context.Context) (*AutoFieldNumbers, error) {
+	nodes, err := afnq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{autofieldnumbers.Label}
+	default:
+		return nil, &NotSingularError{autofieldnumbers.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (afnq *AutoFieldNumbersQuery) OnlyX(ctx context.Context) *AutoFieldNumbers {
+	node, err := afnq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AutoFieldNumbers ID in the query.
+// Returns a *NotSingularError when more than one AutoFieldNumbers ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (afnq *AutoFieldNumbersQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = afnq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{autofieldnumbers.Label}
+	default:
+		err = &NotSingularError{autofieldnumbers.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (afnq *AutoFieldNumbersQuery) OnlyIDX(ctx context.Context) int {
+	id, err := afnq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AutoFieldNumbersSlice.
+func (afnq *AutoFieldNumbersQuery) All(ctx context.Context) ([]*AutoFieldNumbers, error) {
+	if err := afnq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return afnq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (afnq *AutoFieldNumbersQuery) AllX(ctx context.Context) []*AutoFieldNumbers {
+	nodes, err := afnq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AutoFieldNumbers IDs.
+func (afnq *AutoFieldNumbersQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := afnq.Select(autofieldnumbers.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (afnq *AutoFieldNumbersQuery) IDsX(ctx context.Context) []int {
+	ids, err := afnq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (afnq *AutoFieldNumbersQuery) Count(ctx context.Context) (int, error) {
+	if err := afnq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return afnq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (afnq *AutoFieldNumbersQuery) CountX(ctx context.Context) int {
+	count, err := afnq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (afnq *AutoFieldNumbersQuery) Exist(ctx context.Context) (bool, error) {
+	if err := afnq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return afnq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (afnq *AutoFieldNumbersQuery) ExistX(ctx context.Context) bool {
+	exist, err := afnq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AutoFieldNumbersQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (afnq *AutoFieldNumbersQuery) Clone() *AutoFieldNumbersQuery {
+	if afnq == nil {
+		return nil
+	}
+	return &AutoFieldNumbersQuery{
+		config:     afnq.config,
+		limit:      afnq.limit,
+		offset:     afnq.offset,
+		order:      append([]OrderFunc{}, afnq.order...),
+		predicates: append([]predicate.AutoFieldNumbers{}, afnq.predicates...),
+		// clone intermediate query.
+		sql:    afnq.sql.Clone(),
+		path:   afnq.path,
+		unique: afnq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		First string `json:"first,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AutoFieldNumbers.Query().
+//		GroupBy(autofieldnumbers.FieldFirst).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (afnq *AutoFieldNumbersQuery) GroupBy(field string, fields ...string) *AutoFieldNumbersGroupBy {
+	grbuild := &AutoFieldNumbersGroupBy{config: afnq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := afnq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return afnq.sqlQuery(ctx), nil
+	}
+	grbuild.label = autofieldnumbers.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		First string `json:"first,omitempty"`
+//	}
+//
+//	client.AutoFieldNumbers.Query().
+//		Select(autofieldnumbers.FieldFirst).
+//		Scan(ctx, &v)
+func (afnq *AutoFieldNumbersQuery) Select(fields ...string) *AutoFieldNumbersSelect {
+	afnq.fields = append(afnq.fields, fields...)
+	selbuild := &AutoFieldNumbersSelect{AutoFieldNumbersQuery: afnq}
+	selbuild.label = autofieldnumbers.Label
+	selbuild.flds, selbuild.scan = &afnq.fields, selbuild.Scan
+	return selbuild
+}
+
+// Aggregate returns a AutoFieldNumbersSelect configured with the given aggregations.
+func (afnq *AutoFieldNumbersQuery) Aggregate(fns ...AggregateFunc) *AutoFieldNumbersSelect {
+	return afnq.Select().Aggregate(fns...)
+}
+
+func (afnq *AutoFieldNumbersQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range afnq.fields {
+		if !autofieldnumbers.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if afnq.path != nil {
+		prev, err := afnq.path(ctx)
+		if err != nil {
+			return err
+		}
+		afnq.sql = prev
+	}
+	return nil
+}
+
+func (afnq *AutoFieldNumbersQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AutoFieldNumbers, error) {
+	var (
+		nodes = []*AutoFieldNumbers{}
+		_spec = afnq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AutoFieldNumbers).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AutoFieldNumbers{config: afnq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, afnq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (afnq *AutoFieldNumbersQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := afnq.querySpec()
+	_spec.Node.Columns = afnq.fields
+	if len(afnq.fields) > 0 {
+		_spec.Unique = afnq.unique != nil && *afnq.unique
+	}
+	return sqlgraph.CountNodes(ctx, afnq.driver, _spec)
+}
+
+func (afnq *AutoFieldNumbersQuery) sqlExist(ctx context.Context) (bool, error) {
+	switch _, err := afnq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+func (afnq *AutoFieldNumbersQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   autofieldnumbers.Table,
+			Columns: autofieldnumbers.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: autofieldnumbers.FieldID,
+			},
+		},
+		From:   afnq.sql,
+		Unique: true,
+	}
+	if unique := afnq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := afnq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, autofieldnumbers.FieldID)
+		for i := range fields {
+			if fields[i] != autofieldnumbers.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := afnq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := afnq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := afnq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := afnq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (afnq *AutoFieldNumbersQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(afnq.driver.Dialect())
+	t1 := builder.Table(autofieldnumbers.Table)
+	columns := afnq.fields
+	if len(columns) == 0 {
+		columns = autofieldnumbers.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if afnq.sql != nil {
+		selector = afnq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if afnq.unique != nil && *afnq.unique {
+		selector.Distinct()
+	}
+	for _, p := range afnq.predicates {
+		p(selector)
+	}
+	for _, p := range afnq.order {
+		p(selector)
+	}
+	if offset := afnq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := afnq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// AutoFieldNumbersGroupBy is the group-by builder for AutoFieldNumbers entities.
+type AutoFieldNumbersGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (afngb *AutoFieldNumbersGroupBy) Aggregate(fns ...AggregateFunc) *AutoFieldNumbersGroupBy {
+	afngb.fns = append(afngb.fns, fns...)
+	return afngb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (afngb *AutoFieldNumbersGroupBy) Scan(ctx context.Context, v any) error {
+	query, err := afngb.path(ctx)
+	if err != nil {
+		return err
+	}
+	afngb.sql = query
+	return afngb.sqlScan(ctx, v)
+}
+
+func (afngb *AutoFieldNumbersGroupBy) sqlScan(ctx context.Context, v any) error {
+	for _, f := range afngb.fields {
+		if !autofieldnumbers.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := afngb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := afngb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (afngb *AutoFieldNumbersGroupBy) sqlQuery() *sql.Selector {
+	selector := afngb.sql.Select()
+	aggregation := make([]string, 0, len(afngb.fns))
+	for _, fn := range afngb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(afngb.fields)+len(afngb.fns))
+		for _, f := range afngb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(afngb.fields...)...)
+}
+
+// AutoFieldNumbersSelect is the builder for selecting fields of AutoFieldNumbers entities.
+type AutoFieldNumbersSelect struct {
+	*AutoFieldNumbersQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (afns *AutoFieldNumbersSelect) Aggregate(fns ...AggregateFunc) *AutoFieldNumbersSelect {
+	afns.fns = append(afns.fns, fns...)
+	return afns
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (afns *AutoFieldNumbersSelect) Scan(ctx context.Context, v any) error {
+	if err := afns.prepareQuery(ctx); err != nil {
+		return err
+	}
+	afns.sql = afns.AutoFieldNumbersQuery.sqlQuery(ctx)
+	return afns.sqlScan(ctx, v)
+}
+
+func (afns *AutoFieldNumbersSelect) sqlScan(ctx context.Context, v any) error {
+	aggregation := make([]string, 0, len(afns.fns))
+	for _, fn := range afns.fns {
+		aggregation = append(aggregation, fn(afns.sql))
+	}
+	switch n := len(*afns.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		afns.sql.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		afns.sql.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := afns.sql.Query()
+	if err := afns.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
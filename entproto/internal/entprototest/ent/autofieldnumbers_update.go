@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/autofieldnumbers"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AutoFieldNumbersUpdate is the builder for updating AutoFieldNumbers entities.
+type AutoFieldNumbersUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AutoFieldNumbersMutation
+}
+
+// Where appends a list predicates to the AutoFieldNumbersUpdate builder.
+func (afnu *AutoFieldNumbersUpdate) Where(ps ...predicate.AutoFieldNumbers) *AutoFieldNumbersUpdate {
+	afnu.mutation.Where(ps...)
+	return afnu
+}
+
+// SetFirst sets the "first" field.
+func (afnu *AutoFieldNumbersUpdate) SetFirst(s string) *AutoFieldNumbersUpdate {
+	afnu.mutation.SetFirst(s)
+	return afnu
+}
+
+// SetPinned sets the "pinned" field.
+func (afnu *AutoFieldNumbersUpdate) SetPinned(s string) *AutoFieldNumbersUpdate {
+	afnu.mutation.SetPinned(s)
+	return afnu
+}
+
+// SetSkipped sets the "skipped" field.
+func (afnu *AutoFieldNumbersUpdate) SetSkipped(s string) *AutoFieldNumbersUpdate {
+	afnu.mutation.SetSkipped(s)
+	return afnu
+}
+
+// SetSecond sets the "second" field.
+func (afnu *AutoFieldNumbersUpdate) SetSecond(b bool) *AutoFieldNumbersUpdate {
+	afnu.mutation.SetSecond(b)
+	return afnu
+}
+
+// Mutation returns the AutoFieldNumbersMutation object of the builder.
+func (afnu *AutoFieldNumbersUpdate) Mutation() *AutoFieldNumbersMutation {
+	return afnu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (afnu *AutoFieldNumbersUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(afnu.hooks) == 0 {
+		affected, err = afnu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AutoFieldNumbersMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			afnu.mutation = mutation
+			affected, err = afnu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(afnu.hooks) - 1; i >= 0; i-- {
+			if afnu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = afnu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, afnu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (afnu *AutoFieldNumbersUpdate) SaveX(ctx context.Context) int {
+	affected, err := afnu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (afnu *AutoFieldNumbersUpdate) Exec(ctx context.Context) error {
+	_, err := afnu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (afnu *AutoFieldNumbersUpdate) ExecX(ctx context.Context) {
+	if err := afnu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (afnu *AutoFieldNumbersUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   autofieldnumbers.Table,
+			Columns: autofieldnumbers.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: autofieldnumbers.FieldID,
+			},
+		},
+	}
+	if ps := afnu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := afnu.mutation.First(); ok {
+		_spec.SetField(autofieldnumbers.FieldFirst, field.TypeString, value)
+	}
+	if value, ok := afnu.mutation.Pinned(); ok {
+		_spec.SetField(autofieldnumbers.FieldPinned, field.TypeString, value)
+	}
+	if value, ok := afnu.mutation.Skipped(); ok {
+		_spec.SetField(autofieldnumbers.FieldSkipped, field.TypeString, value)
+	}
+	if value, ok := afnu.mutation.Second(); ok {
+		_spec.SetField(autofieldnumbers.FieldSecond, field.TypeBool, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, afnu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{autofieldnumbers.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// AutoFieldNumbersUpdateOne is the builder for updating a single AutoFieldNumbers entity.
+type AutoFieldNumbersUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AutoFieldNumbersMutation
+}
+
+// SetFirst sets the "first" field.
+func (afnuo *AutoFieldNumbersUpdateOne) SetFirst(s string) *AutoFieldNumbersUpdateOne {
+	afnuo.mutation.SetFirst(s)
+	return afnuo
+}
+
+// SetPinned sets the "pinned" field.
+func (afnuo *AutoFieldNumbersUpdateOne) SetPinned(s string) *AutoFieldNumbersUpdateOne {
+	afnuo.mutation.SetPinned(s)
+	return afnuo
+}
+
+// SetSkipped sets the "skipped" field.
+func (afnuo *AutoFieldNumbersUpdateOne) SetSkipped(s string) *AutoFieldNumbersUpdateOne {
+	afnuo.mutation.SetSkipped(s)
+	return afnuo
+}
+
+// SetSecond sets the "second" field.
+func (afnuo *AutoFieldNumbersUpdateOne) SetSecond(b bool) *AutoFieldNumbersUpdateOne {
+	afnuo.mutation.SetSecond(b)
+	return afnuo
+}
+
+// Mutation returns the AutoFieldNumbersMutation object of the builder.
+func (afnuo *AutoFieldNumbersUpdateOne) Mutation() *AutoFieldNumbersMutation {
+	return afnuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (afnuo *AutoFieldNumbersUpdateOne) Select(field string, fields ...string) *AutoFieldNumbersUpdateOne {
+	afnuo.fields = append([]string{field}, fields...)
+	return afnuo
+}
+
+// Save executes the query and returns the updated AutoFieldNumbers entity.
+func (afnuo *AutoFieldNumbersUpdateOne) Save(ctx context.Context) (*AutoFieldNumbers, error) {
+	var (
+		err  error
+		node *AutoFieldNumbers
+	)
+	if len(afnuo.hooks) == 0 {
+		node, err = afnuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AutoFieldNumbersMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			afnuo.mutation = mutation
+			node, err = afnuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(afnuo.hooks) - 1; i >= 0; i-- {
+			if afnuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = afnuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, afnuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*AutoFieldNumbers)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from AutoFieldNumbersMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (afnuo *AutoFieldNumbersUpdateOne) SaveX(ctx context.Context) *AutoFieldNumbers {
+	node, err := afnuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (afnuo *AutoFieldNumbersUpdateOne) Exec(ctx context.Context) error {
+	_, err := afnuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (afnuo *AutoFieldNumbersUpdateOne) ExecX(ctx context.Context) {
+	if err := afnuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (afnuo *AutoFieldNumbersUpdateOne) sqlSave(ctx context.Context) (_node *AutoFieldNumbers, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   autofieldnumbers.Table,
+			Columns: autofieldnumbers.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: autofieldnumbers.FieldID,
+			},
+		},
+	}
+	id, ok := afnuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AutoFieldNumbers.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := afnuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, autofieldnumbers.FieldID)
+		for _, f := range fields {
+			if !autofieldnumbers.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != autofieldnumbers.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := afnuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := afnuo.mutation.First(); ok {
+		_spec.SetField(autofieldnumbers.FieldFirst, field.TypeString, value)
+	}
+	if value, ok := afnuo.mutation.Pinned(); ok {
+		_spec.SetField(autofieldnumbers.FieldPinned, field.TypeString, value)
+	}
+	if value, ok := afnuo.mutation.Skipped(); ok {
+		_spec.SetField(autofieldnumbers.FieldSkipped, field.TypeString, value)
+	}
+	if value, ok := afnuo.mutation.Second(); ok {
+		_spec.SetField(autofieldnumbers.FieldSecond, field.TypeBool, value)
+	}
+	_node = &AutoFieldNumbers{config: afnuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, afnuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{autofieldnumbers.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
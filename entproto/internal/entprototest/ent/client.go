@@ -12,23 +12,33 @@ import (
 	"github.com/google/uuid"
 
 	"entgo.io/contrib/entproto/internal/entprototest/ent/allmethodsservice"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/autofieldnumbers"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/blogpost"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/category"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/dependsonskipped"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/duplicatenumbermessage"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/explicitskippedmessage"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/extramethodservice"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/image"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/implicitskippedmessage"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/invalidfieldmessage"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mappedfieldmessage"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithenum"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithfieldone"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithid"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithoptionals"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithpackagename"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithstrings"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/methodoptionsservice"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixinannotated"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixincollision"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixinserviceoverride"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/nobackref"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/onemethodservice"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/portal"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenummessage"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenumothermessage"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenumvariant"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/skipedgeexample"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/twomethodservice"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/user"
@@ -46,6 +56,8 @@ type Client struct {
 	Schema *migrate.Schema
 	// AllMethodsService is the client for interacting with the AllMethodsService builders.
 	AllMethodsService *AllMethodsServiceClient
+	// AutoFieldNumbers is the client for interacting with the AutoFieldNumbers builders.
+	AutoFieldNumbers *AutoFieldNumbersClient
 	// BlogPost is the client for interacting with the BlogPost builders.
 	BlogPost *BlogPostClient
 	// Category is the client for interacting with the Category builders.
@@ -56,12 +68,16 @@ type Client struct {
 	DuplicateNumberMessage *DuplicateNumberMessageClient
 	// ExplicitSkippedMessage is the client for interacting with the ExplicitSkippedMessage builders.
 	ExplicitSkippedMessage *ExplicitSkippedMessageClient
+	// ExtraMethodService is the client for interacting with the ExtraMethodService builders.
+	ExtraMethodService *ExtraMethodServiceClient
 	// Image is the client for interacting with the Image builders.
 	Image *ImageClient
 	// ImplicitSkippedMessage is the client for interacting with the ImplicitSkippedMessage builders.
 	ImplicitSkippedMessage *ImplicitSkippedMessageClient
 	// InvalidFieldMessage is the client for interacting with the InvalidFieldMessage builders.
 	InvalidFieldMessage *InvalidFieldMessageClient
+	// MappedFieldMessage is the client for interacting with the MappedFieldMessage builders.
+	MappedFieldMessage *MappedFieldMessageClient
 	// MessageWithEnum is the client for interacting with the MessageWithEnum builders.
 	MessageWithEnum *MessageWithEnumClient
 	// MessageWithFieldOne is the client for interacting with the MessageWithFieldOne builders.
@@ -74,12 +90,26 @@ type Client struct {
 	MessageWithPackageName *MessageWithPackageNameClient
 	// MessageWithStrings is the client for interacting with the MessageWithStrings builders.
 	MessageWithStrings *MessageWithStringsClient
+	// MethodOptionsService is the client for interacting with the MethodOptionsService builders.
+	MethodOptionsService *MethodOptionsServiceClient
+	// MixinAnnotated is the client for interacting with the MixinAnnotated builders.
+	MixinAnnotated *MixinAnnotatedClient
+	// MixinCollision is the client for interacting with the MixinCollision builders.
+	MixinCollision *MixinCollisionClient
+	// MixinServiceOverride is the client for interacting with the MixinServiceOverride builders.
+	MixinServiceOverride *MixinServiceOverrideClient
 	// NoBackref is the client for interacting with the NoBackref builders.
 	NoBackref *NoBackrefClient
 	// OneMethodService is the client for interacting with the OneMethodService builders.
 	OneMethodService *OneMethodServiceClient
 	// Portal is the client for interacting with the Portal builders.
 	Portal *PortalClient
+	// SharedEnumMessage is the client for interacting with the SharedEnumMessage builders.
+	SharedEnumMessage *SharedEnumMessageClient
+	// SharedEnumOtherMessage is the client for interacting with the SharedEnumOtherMessage builders.
+	SharedEnumOtherMessage *SharedEnumOtherMessageClient
+	// SharedEnumVariant is the client for interacting with the SharedEnumVariant builders.
+	SharedEnumVariant *SharedEnumVariantClient
 	// SkipEdgeExample is the client for interacting with the SkipEdgeExample builders.
 	SkipEdgeExample *SkipEdgeExampleClient
 	// TwoMethodService is the client for interacting with the TwoMethodService builders.
@@ -102,23 +132,33 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.AllMethodsService = NewAllMethodsServiceClient(c.config)
+	c.AutoFieldNumbers = NewAutoFieldNumbersClient(c.config)
 	c.BlogPost = NewBlogPostClient(c.config)
 	c.Category = NewCategoryClient(c.config)
 	c.DependsOnSkipped = NewDependsOnSkippedClient(c.config)
 	c.DuplicateNumberMessage = NewDuplicateNumberMessageClient(c.config)
 	c.ExplicitSkippedMessage = NewExplicitSkippedMessageClient(c.config)
+	c.ExtraMethodService = NewExtraMethodServiceClient(c.config)
 	c.Image = NewImageClient(c.config)
 	c.ImplicitSkippedMessage = NewImplicitSkippedMessageClient(c.config)
 	c.InvalidFieldMessage = NewInvalidFieldMessageClient(c.config)
+	c.MappedFieldMessage = NewMappedFieldMessageClient(c.config)
 	c.MessageWithEnum = NewMessageWithEnumClient(c.config)
 	c.MessageWithFieldOne = NewMessageWithFieldOneClient(c.config)
 	c.MessageWithID = NewMessageWithIDClient(c.config)
 	c.MessageWithOptionals = NewMessageWithOptionalsClient(c.config)
 	c.MessageWithPackageName = NewMessageWithPackageNameClient(c.config)
 	c.MessageWithStrings = NewMessageWithStringsClient(c.config)
+	c.MethodOptionsService = NewMethodOptionsServiceClient(c.config)
+	c.MixinAnnotated = NewMixinAnnotatedClient(c.config)
+	c.MixinCollision = NewMixinCollisionClient(c.config)
+	c.MixinServiceOverride = NewMixinServiceOverrideClient(c.config)
 	c.NoBackref = NewNoBackrefClient(c.config)
 	c.OneMethodService = NewOneMethodServiceClient(c.config)
 	c.Portal = NewPortalClient(c.config)
+	c.SharedEnumMessage = NewSharedEnumMessageClient(c.config)
+	c.SharedEnumOtherMessage = NewSharedEnumOtherMessageClient(c.config)
+	c.SharedEnumVariant = NewSharedEnumVariantClient(c.config)
 	c.SkipEdgeExample = NewSkipEdgeExampleClient(c.config)
 	c.TwoMethodService = NewTwoMethodServiceClient(c.config)
 	c.User = NewUserClient(c.config)
@@ -157,23 +197,33 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:                    ctx,
 		config:                 cfg,
 		AllMethodsService:      NewAllMethodsServiceClient(cfg),
+		AutoFieldNumbers:       NewAutoFieldNumbersClient(cfg),
 		BlogPost:               NewBlogPostClient(cfg),
 		Category:               NewCategoryClient(cfg),
 		DependsOnSkipped:       NewDependsOnSkippedClient(cfg),
 		DuplicateNumberMessage: NewDuplicateNumberMessageClient(cfg),
 		ExplicitSkippedMessage: NewExplicitSkippedMessageClient(cfg),
+		ExtraMethodService:     NewExtraMethodServiceClient(cfg),
 		Image:                  NewImageClient(cfg),
 		ImplicitSkippedMessage: NewImplicitSkippedMessageClient(cfg),
 		InvalidFieldMessage:    NewInvalidFieldMessageClient(cfg),
+		MappedFieldMessage:     NewMappedFieldMessageClient(cfg),
 		MessageWithEnum:        NewMessageWithEnumClient(cfg),
 		MessageWithFieldOne:    NewMessageWithFieldOneClient(cfg),
 		MessageWithID:          NewMessageWithIDClient(cfg),
 		MessageWithOptionals:   NewMessageWithOptionalsClient(cfg),
 		MessageWithPackageName: NewMessageWithPackageNameClient(cfg),
 		MessageWithStrings:     NewMessageWithStringsClient(cfg),
+		MethodOptionsService:   NewMethodOptionsServiceClient(cfg),
+		MixinAnnotated:         NewMixinAnnotatedClient(cfg),
+		MixinCollision:         NewMixinCollisionClient(cfg),
+		MixinServiceOverride:   NewMixinServiceOverrideClient(cfg),
 		NoBackref:              NewNoBackrefClient(cfg),
 		OneMethodService:       NewOneMethodServiceClient(cfg),
 		Portal:                 NewPortalClient(cfg),
+		SharedEnumMessage:      NewSharedEnumMessageClient(cfg),
+		SharedEnumOtherMessage: NewSharedEnumOtherMessageClient(cfg),
+		SharedEnumVariant:      NewSharedEnumVariantClient(cfg),
 		SkipEdgeExample:        NewSkipEdgeExampleClient(cfg),
 		TwoMethodService:       NewTwoMethodServiceClient(cfg),
 		User:                   NewUserClient(cfg),
@@ -198,23 +248,33 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:                    ctx,
 		config:                 cfg,
 		AllMethodsService:      NewAllMethodsServiceClient(cfg),
+		AutoFieldNumbers:       NewAutoFieldNumbersClient(cfg),
 		BlogPost:               NewBlogPostClient(cfg),
 		Category:               NewCategoryClient(cfg),
 		DependsOnSkipped:       NewDependsOnSkippedClient(cfg),
 		DuplicateNumberMessage: NewDuplicateNumberMessageClient(cfg),
 		ExplicitSkippedMessage: NewExplicitSkippedMessageClient(cfg),
+		ExtraMethodService:     NewExtraMethodServiceClient(cfg),
 		Image:                  NewImageClient(cfg),
 		ImplicitSkippedMessage: NewImplicitSkippedMessageClient(cfg),
 		InvalidFieldMessage:    NewInvalidFieldMessageClient(cfg),
+		MappedFieldMessage:     NewMappedFieldMessageClient(cfg),
 		MessageWithEnum:        NewMessageWithEnumClient(cfg),
 		MessageWithFieldOne:    NewMessageWithFieldOneClient(cfg),
 		MessageWithID:          NewMessageWithIDClient(cfg),
 		MessageWithOptionals:   NewMessageWithOptionalsClient(cfg),
 		MessageWithPackageName: NewMessageWithPackageNameClient(cfg),
 		MessageWithStrings:     NewMessageWithStringsClient(cfg),
+		MethodOptionsService:   NewMethodOptionsServiceClient(cfg),
+		MixinAnnotated:         NewMixinAnnotatedClient(cfg),
+		MixinCollision:         NewMixinCollisionClient(cfg),
+		MixinServiceOverride:   NewMixinServiceOverrideClient(cfg),
 		NoBackref:              NewNoBackrefClient(cfg),
 		OneMethodService:       NewOneMethodServiceClient(cfg),
 		Portal:                 NewPortalClient(cfg),
+		SharedEnumMessage:      NewSharedEnumMessageClient(cfg),
+		SharedEnumOtherMessage: NewSharedEnumOtherMessageClient(cfg),
+		SharedEnumVariant:      NewSharedEnumVariantClient(cfg),
 		SkipEdgeExample:        NewSkipEdgeExampleClient(cfg),
 		TwoMethodService:       NewTwoMethodServiceClient(cfg),
 		User:                   NewUserClient(cfg),
@@ -248,23 +308,33 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	c.AllMethodsService.Use(hooks...)
+	c.AutoFieldNumbers.Use(hooks...)
 	c.BlogPost.Use(hooks...)
 	c.Category.Use(hooks...)
 	c.DependsOnSkipped.Use(hooks...)
 	c.DuplicateNumberMessage.Use(hooks...)
 	c.ExplicitSkippedMessage.Use(hooks...)
+	c.ExtraMethodService.Use(hooks...)
 	c.Image.Use(hooks...)
 	c.ImplicitSkippedMessage.Use(hooks...)
 	c.InvalidFieldMessage.Use(hooks...)
+	c.MappedFieldMessage.Use(hooks...)
 	c.MessageWithEnum.Use(hooks...)
 	c.MessageWithFieldOne.Use(hooks...)
 	c.MessageWithID.Use(hooks...)
 	c.MessageWithOptionals.Use(hooks...)
 	c.MessageWithPackageName.Use(hooks...)
 	c.MessageWithStrings.Use(hooks...)
+	c.MethodOptionsService.Use(hooks...)
+	c.MixinAnnotated.Use(hooks...)
+	c.MixinCollision.Use(hooks...)
+	c.MixinServiceOverride.Use(hooks...)
 	c.NoBackref.Use(hooks...)
 	c.OneMethodService.Use(hooks...)
 	c.Portal.Use(hooks...)
+	c.SharedEnumMessage.Use(hooks...)
+	c.SharedEnumOtherMessage.Use(hooks...)
+	c.SharedEnumVariant.Use(hooks...)
 	c.SkipEdgeExample.Use(hooks...)
 	c.TwoMethodService.Use(hooks...)
 	c.User.Use(hooks...)
@@ -361,6 +431,96 @@ func (c *AllMethodsServiceClient) Hooks() []Hook {
 	return c.hooks.AllMethodsService
 }
 
+// AutoFieldNumbersClient is a client for the AutoFieldNumbers schema.
+type AutoFieldNumbersClient struct {
+	config
+}
+
+// NewAutoFieldNumbersClient returns a client for the AutoFieldNumbers from the given config.
+func NewAutoFieldNumbersClient(c config) *AutoFieldNumbersClient {
+	return &AutoFieldNumbersClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `autofieldnumbers.Hooks(f(g(h())))`.
+func (c *AutoFieldNumbersClient) Use(hooks ...Hook) {
+	c.hooks.AutoFieldNumbers = append(c.hooks.AutoFieldNumbers, hooks...)
+}
+
+// Create returns a builder for creating a AutoFieldNumbers entity.
+func (c *AutoFieldNumbersClient) Create() *AutoFieldNumbersCreate {
+	mutation := newAutoFieldNumbersMutation(c.config, OpCreate)
+	return &AutoFieldNumbersCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AutoFieldNumbers entities.
+func (c *AutoFieldNumbersClient) CreateBulk(builders ...*AutoFieldNumbersCreate) *AutoFieldNumbersCreateBulk {
+	return &AutoFieldNumbersCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AutoFieldNumbers.
+func (c *AutoFieldNumbersClient) Update() *AutoFieldNumbersUpdate {
+	mutation := newAutoFieldNumbersMutation(c.config, OpUpdate)
+	return &AutoFieldNumbersUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AutoFieldNumbersClient) UpdateOne(afn *AutoFieldNumbers) *AutoFieldNumbersUpdateOne {
+	mutation := newAutoFieldNumbersMutation(c.config, OpUpdateOne, withAutoFieldNumbers(afn))
+	return &AutoFieldNumbersUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AutoFieldNumbersClient) UpdateOneID(id int) *AutoFieldNumbersUpdateOne {
+	mutation := newAutoFieldNumbersMutation(c.config, OpUpdateOne, withAutoFieldNumbersID(id))
+	return &AutoFieldNumbersUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AutoFieldNumbers.
+func (c *AutoFieldNumbersClient) Delete() *AutoFieldNumbersDelete {
+	mutation := newAutoFieldNumbersMutation(c.config, OpDelete)
+	return &AutoFieldNumbersDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AutoFieldNumbersClient) DeleteOne(afn *AutoFieldNumbers) *AutoFieldNumbersDeleteOne {
+	return c.DeleteOneID(afn.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AutoFieldNumbersClient) DeleteOneID(id int) *AutoFieldNumbersDeleteOne {
+	builder := c.Delete().Where(autofieldnumbers.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AutoFieldNumbersDeleteOne{builder}
+}
+
+// Query returns a query builder for AutoFieldNumbers.
+func (c *AutoFieldNumbersClient) Query() *AutoFieldNumbersQuery {
+	return &AutoFieldNumbersQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a AutoFieldNumbers entity by its id.
+func (c *AutoFieldNumbersClient) Get(ctx context.Context, id int) (*AutoFieldNumbers, error) {
+	return c.Query().Where(autofieldnumbers.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AutoFieldNumbersClient) GetX(ctx context.Context, id int) *AutoFieldNumbers {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AutoFieldNumbersClient) Hooks() []Hook {
+	return c.hooks.AutoFieldNumbers
+}
+
 // BlogPostClient is a client for the BlogPost schema.
 type BlogPostClient struct {
 	config
@@ -875,6 +1035,96 @@ func (c *ExplicitSkippedMessageClient) Hooks() []Hook {
 	return c.hooks.ExplicitSkippedMessage
 }
 
+// ExtraMethodServiceClient is a client for the ExtraMethodService schema.
+type ExtraMethodServiceClient struct {
+	config
+}
+
+// NewExtraMethodServiceClient returns a client for the ExtraMethodService from the given config.
+func NewExtraMethodServiceClient(c config) *ExtraMethodServiceClient {
+	return &ExtraMethodServiceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `extramethodservice.Hooks(f(g(h())))`.
+func (c *ExtraMethodServiceClient) Use(hooks ...Hook) {
+	c.hooks.ExtraMethodService = append(c.hooks.ExtraMethodService, hooks...)
+}
+
+// Create returns a builder for creating a ExtraMethodService entity.
+func (c *ExtraMethodServiceClient) Create() *ExtraMethodServiceCreate {
+	mutation := newExtraMethodServiceMutation(c.config, OpCreate)
+	return &ExtraMethodServiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExtraMethodService entities.
+func (c *ExtraMethodServiceClient) CreateBulk(builders ...*ExtraMethodServiceCreate) *ExtraMethodServiceCreateBulk {
+	return &ExtraMethodServiceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExtraMethodService.
+func (c *ExtraMethodServiceClient) Update() *ExtraMethodServiceUpdate {
+	mutation := newExtraMethodServiceMutation(c.config, OpUpdate)
+	return &ExtraMethodServiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExtraMethodServiceClient) UpdateOne(ems *ExtraMethodService) *ExtraMethodServiceUpdateOne {
+	mutation := newExtraMethodServiceMutation(c.config, OpUpdateOne, withExtraMethodService(ems))
+	return &ExtraMethodServiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExtraMethodServiceClient) UpdateOneID(id int) *ExtraMethodServiceUpdateOne {
+	mutation := newExtraMethodServiceMutation(c.config, OpUpdateOne, withExtraMethodServiceID(id))
+	return &ExtraMethodServiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExtraMethodService.
+func (c *ExtraMethodServiceClient) Delete() *ExtraMethodServiceDelete {
+	mutation := newExtraMethodServiceMutation(c.config, OpDelete)
+	return &ExtraMethodServiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExtraMethodServiceClient) DeleteOne(ems *ExtraMethodService) *ExtraMethodServiceDeleteOne {
+	return c.DeleteOneID(ems.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExtraMethodServiceClient) DeleteOneID(id int) *ExtraMethodServiceDeleteOne {
+	builder := c.Delete().Where(extramethodservice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExtraMethodServiceDeleteOne{builder}
+}
+
+// Query returns a query builder for ExtraMethodService.
+func (c *ExtraMethodServiceClient) Query() *ExtraMethodServiceQuery {
+	return &ExtraMethodServiceQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ExtraMethodService entity by its id.
+func (c *ExtraMethodServiceClient) Get(ctx context.Context, id int) (*ExtraMethodService, error) {
+	return c.Query().Where(extramethodservice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExtraMethodServiceClient) GetX(ctx context.Context, id int) *ExtraMethodService {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ExtraMethodServiceClient) Hooks() []Hook {
+	return c.hooks.ExtraMethodService
+}
+
 // ImageClient is a client for the Image schema.
 type ImageClient struct {
 	config
@@ -1161,6 +1411,96 @@ func (c *InvalidFieldMessageClient) Hooks() []Hook {
 	return c.hooks.InvalidFieldMessage
 }
 
+// MappedFieldMessageClient is a client for the MappedFieldMessage schema.
+type MappedFieldMessageClient struct {
+	config
+}
+
+// NewMappedFieldMessageClient returns a client for the MappedFieldMessage from the given config.
+func NewMappedFieldMessageClient(c config) *MappedFieldMessageClient {
+	return &MappedFieldMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mappedfieldmessage.Hooks(f(g(h())))`.
+func (c *MappedFieldMessageClient) Use(hooks ...Hook) {
+	c.hooks.MappedFieldMessage = append(c.hooks.MappedFieldMessage, hooks...)
+}
+
+// Create returns a builder for creating a MappedFieldMessage entity.
+func (c *MappedFieldMessageClient) Create() *MappedFieldMessageCreate {
+	mutation := newMappedFieldMessageMutation(c.config, OpCreate)
+	return &MappedFieldMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MappedFieldMessage entities.
+func (c *MappedFieldMessageClient) CreateBulk(builders ...*MappedFieldMessageCreate) *MappedFieldMessageCreateBulk {
+	return &MappedFieldMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MappedFieldMessage.
+func (c *MappedFieldMessageClient) Update() *MappedFieldMessageUpdate {
+	mutation := newMappedFieldMessageMutation(c.config, OpUpdate)
+	return &MappedFieldMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MappedFieldMessageClient) UpdateOne(mfm *MappedFieldMessage) *MappedFieldMessageUpdateOne {
+	mutation := newMappedFieldMessageMutation(c.config, OpUpdateOne, withMappedFieldMessage(mfm))
+	return &MappedFieldMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MappedFieldMessageClient) UpdateOneID(id int) *MappedFieldMessageUpdateOne {
+	mutation := newMappedFieldMessageMutation(c.config, OpUpdateOne, withMappedFieldMessageID(id))
+	return &MappedFieldMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MappedFieldMessage.
+func (c *MappedFieldMessageClient) Delete() *MappedFieldMessageDelete {
+	mutation := newMappedFieldMessageMutation(c.config, OpDelete)
+	return &MappedFieldMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MappedFieldMessageClient) DeleteOne(mfm *MappedFieldMessage) *MappedFieldMessageDeleteOne {
+	return c.DeleteOneID(mfm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MappedFieldMessageClient) DeleteOneID(id int) *MappedFieldMessageDeleteOne {
+	builder := c.Delete().Where(mappedfieldmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MappedFieldMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for MappedFieldMessage.
+func (c *MappedFieldMessageClient) Query() *MappedFieldMessageQuery {
+	return &MappedFieldMessageQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a MappedFieldMessage entity by its id.
+func (c *MappedFieldMessageClient) Get(ctx context.Context, id int) (*MappedFieldMessage, error) {
+	return c.Query().Where(mappedfieldmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MappedFieldMessageClient) GetX(ctx context.Context, id int) *MappedFieldMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MappedFieldMessageClient) Hooks() []Hook {
+	return c.hooks.MappedFieldMessage
+}
+
 // MessageWithEnumClient is a client for the MessageWithEnum schema.
 type MessageWithEnumClient struct {
 	config
@@ -1701,6 +2041,366 @@ func (c *MessageWithStringsClient) Hooks() []Hook {
 	return c.hooks.MessageWithStrings
 }
 
+// MethodOptionsServiceClient is a client for the MethodOptionsService schema.
+type MethodOptionsServiceClient struct {
+	config
+}
+
+// NewMethodOptionsServiceClient returns a client for the MethodOptionsService from the given config.
+func NewMethodOptionsServiceClient(c config) *MethodOptionsServiceClient {
+	return &MethodOptionsServiceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `methodoptionsservice.Hooks(f(g(h())))`.
+func (c *MethodOptionsServiceClient) Use(hooks ...Hook) {
+	c.hooks.MethodOptionsService = append(c.hooks.MethodOptionsService, hooks...)
+}
+
+// Create returns a builder for creating a MethodOptionsService entity.
+func (c *MethodOptionsServiceClient) Create() *MethodOptionsServiceCreate {
+	mutation := newMethodOptionsServiceMutation(c.config, OpCreate)
+	return &MethodOptionsServiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MethodOptionsService entities.
+func (c *MethodOptionsServiceClient) CreateBulk(builders ...*MethodOptionsServiceCreate) *MethodOptionsServiceCreateBulk {
+	return &MethodOptionsServiceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MethodOptionsService.
+func (c *MethodOptionsServiceClient) Update() *MethodOptionsServiceUpdate {
+	mutation := newMethodOptionsServiceMutation(c.config, OpUpdate)
+	return &MethodOptionsServiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MethodOptionsServiceClient) UpdateOne(mos *MethodOptionsService) *MethodOptionsServiceUpdateOne {
+	mutation := newMethodOptionsServiceMutation(c.config, OpUpdateOne, withMethodOptionsService(mos))
+	return &MethodOptionsServiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MethodOptionsServiceClient) UpdateOneID(id int) *MethodOptionsServiceUpdateOne {
+	mutation := newMethodOptionsServiceMutation(c.config, OpUpdateOne, withMethodOptionsServiceID(id))
+	return &MethodOptionsServiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MethodOptionsService.
+func (c *MethodOptionsServiceClient) Delete() *MethodOptionsServiceDelete {
+	mutation := newMethodOptionsServiceMutation(c.config, OpDelete)
+	return &MethodOptionsServiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MethodOptionsServiceClient) DeleteOne(mos *MethodOptionsService) *MethodOptionsServiceDeleteOne {
+	return c.DeleteOneID(mos.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MethodOptionsServiceClient) DeleteOneID(id int) *MethodOptionsServiceDeleteOne {
+	builder := c.Delete().Where(methodoptionsservice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MethodOptionsServiceDeleteOne{builder}
+}
+
+// Query returns a query builder for MethodOptionsService.
+func (c *MethodOptionsServiceClient) Query() *MethodOptionsServiceQuery {
+	return &MethodOptionsServiceQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a MethodOptionsService entity by its id.
+func (c *MethodOptionsServiceClient) Get(ctx context.Context, id int) (*MethodOptionsService, error) {
+	return c.Query().Where(methodoptionsservice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MethodOptionsServiceClient) GetX(ctx context.Context, id int) *MethodOptionsService {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MethodOptionsServiceClient) Hooks() []Hook {
+	return c.hooks.MethodOptionsService
+}
+
+// MixinAnnotatedClient is a client for the MixinAnnotated schema.
+type MixinAnnotatedClient struct {
+	config
+}
+
+// NewMixinAnnotatedClient returns a client for the MixinAnnotated from the given config.
+func NewMixinAnnotatedClient(c config) *MixinAnnotatedClient {
+	return &MixinAnnotatedClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mixinannotated.Hooks(f(g(h())))`.
+func (c *MixinAnnotatedClient) Use(hooks ...Hook) {
+	c.hooks.MixinAnnotated = append(c.hooks.MixinAnnotated, hooks...)
+}
+
+// Create returns a builder for creating a MixinAnnotated entity.
+func (c *MixinAnnotatedClient) Create() *MixinAnnotatedCreate {
+	mutation := newMixinAnnotatedMutation(c.config, OpCreate)
+	return &MixinAnnotatedCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MixinAnnotated entities.
+func (c *MixinAnnotatedClient) CreateBulk(builders ...*MixinAnnotatedCreate) *MixinAnnotatedCreateBulk {
+	return &MixinAnnotatedCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MixinAnnotated.
+func (c *MixinAnnotatedClient) Update() *MixinAnnotatedUpdate {
+	mutation := newMixinAnnotatedMutation(c.config, OpUpdate)
+	return &MixinAnnotatedUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MixinAnnotatedClient) UpdateOne(ma *MixinAnnotated) *MixinAnnotatedUpdateOne {
+	mutation := newMixinAnnotatedMutation(c.config, OpUpdateOne, withMixinAnnotated(ma))
+	return &MixinAnnotatedUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MixinAnnotatedClient) UpdateOneID(id int) *MixinAnnotatedUpdateOne {
+	mutation := newMixinAnnotatedMutation(c.config, OpUpdateOne, withMixinAnnotatedID(id))
+	return &MixinAnnotatedUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MixinAnnotated.
+func (c *MixinAnnotatedClient) Delete() *MixinAnnotatedDelete {
+	mutation := newMixinAnnotatedMutation(c.config, OpDelete)
+	return &MixinAnnotatedDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MixinAnnotatedClient) DeleteOne(ma *MixinAnnotated) *MixinAnnotatedDeleteOne {
+	return c.DeleteOneID(ma.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MixinAnnotatedClient) DeleteOneID(id int) *MixinAnnotatedDeleteOne {
+	builder := c.Delete().Where(mixinannotated.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MixinAnnotatedDeleteOne{builder}
+}
+
+// Query returns a query builder for MixinAnnotated.
+func (c *MixinAnnotatedClient) Query() *MixinAnnotatedQuery {
+	return &MixinAnnotatedQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a MixinAnnotated entity by its id.
+func (c *MixinAnnotatedClient) Get(ctx context.Context, id int) (*MixinAnnotated, error) {
+	return c.Query().Where(mixinannotated.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MixinAnnotatedClient) GetX(ctx context.Context, id int) *MixinAnnotated {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MixinAnnotatedClient) Hooks() []Hook {
+	return c.hooks.MixinAnnotated
+}
+
+// MixinCollisionClient is a client for the MixinCollision schema.
+type MixinCollisionClient struct {
+	config
+}
+
+// NewMixinCollisionClient returns a client for the MixinCollision from the given config.
+func NewMixinCollisionClient(c config) *MixinCollisionClient {
+	return &MixinCollisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mixincollision.Hooks(f(g(h())))`.
+func (c *MixinCollisionClient) Use(hooks ...Hook) {
+	c.hooks.MixinCollision = append(c.hooks.MixinCollision, hooks...)
+}
+
+// Create returns a builder for creating a MixinCollision entity.
+func (c *MixinCollisionClient) Create() *MixinCollisionCreate {
+	mutation := newMixinCollisionMutation(c.config, OpCreate)
+	return &MixinCollisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MixinCollision entities.
+func (c *MixinCollisionClient) CreateBulk(builders ...*MixinCollisionCreate) *MixinCollisionCreateBulk {
+	return &MixinCollisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MixinCollision.
+func (c *MixinCollisionClient) Update() *MixinCollisionUpdate {
+	mutation := newMixinCollisionMutation(c.config, OpUpdate)
+	return &MixinCollisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MixinCollisionClient) UpdateOne(mc *MixinCollision) *MixinCollisionUpdateOne {
+	mutation := newMixinCollisionMutation(c.config, OpUpdateOne, withMixinCollision(mc))
+	return &MixinCollisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MixinCollisionClient) UpdateOneID(id int) *MixinCollisionUpdateOne {
+	mutation := newMixinCollisionMutation(c.config, OpUpdateOne, withMixinCollisionID(id))
+	return &MixinCollisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MixinCollision.
+func (c *MixinCollisionClient) Delete() *MixinCollisionDelete {
+	mutation := newMixinCollisionMutation(c.config, OpDelete)
+	return &MixinCollisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MixinCollisionClient) DeleteOne(mc *MixinCollision) *MixinCollisionDeleteOne {
+	return c.DeleteOneID(mc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MixinCollisionClient) DeleteOneID(id int) *MixinCollisionDeleteOne {
+	builder := c.Delete().Where(mixincollision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MixinCollisionDeleteOne{builder}
+}
+
+// Query returns a query builder for MixinCollision.
+func (c *MixinCollisionClient) Query() *MixinCollisionQuery {
+	return &MixinCollisionQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a MixinCollision entity by its id.
+func (c *MixinCollisionClient) Get(ctx context.Context, id int) (*MixinCollision, error) {
+	return c.Query().Where(mixincollision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MixinCollisionClient) GetX(ctx context.Context, id int) *MixinCollision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MixinCollisionClient) Hooks() []Hook {
+	return c.hooks.MixinCollision
+}
+
+// MixinServiceOverrideClient is a client for the MixinServiceOverride schema.
+type MixinServiceOverrideClient struct {
+	config
+}
+
+// NewMixinServiceOverrideClient returns a client for the MixinServiceOverride from the given config.
+func NewMixinServiceOverrideClient(c config) *MixinServiceOverrideClient {
+	return &MixinServiceOverrideClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mixinserviceoverride.Hooks(f(g(h())))`.
+func (c *MixinServiceOverrideClient) Use(hooks ...Hook) {
+	c.hooks.MixinServiceOverride = append(c.hooks.MixinServiceOverride, hooks...)
+}
+
+// Create returns a builder for creating a MixinServiceOverride entity.
+func (c *MixinServiceOverrideClient) Create() *MixinServiceOverrideCreate {
+	mutation := newMixinServiceOverrideMutation(c.config, OpCreate)
+	return &MixinServiceOverrideCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MixinServiceOverride entities.
+func (c *MixinServiceOverrideClient) CreateBulk(builders ...*MixinServiceOverrideCreate) *MixinServiceOverrideCreateBulk {
+	return &MixinServiceOverrideCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MixinServiceOverride.
+func (c *MixinServiceOverrideClient) Update() *MixinServiceOverrideUpdate {
+	mutation := newMixinServiceOverrideMutation(c.config, OpUpdate)
+	return &MixinServiceOverrideUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MixinServiceOverrideClient) UpdateOne(mso *MixinServiceOverride) *MixinServiceOverrideUpdateOne {
+	mutation := newMixinServiceOverrideMutation(c.config, OpUpdateOne, withMixinServiceOverride(mso))
+	return &MixinServiceOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MixinServiceOverrideClient) UpdateOneID(id int) *MixinServiceOverrideUpdateOne {
+	mutation := newMixinServiceOverrideMutation(c.config, OpUpdateOne, withMixinServiceOverrideID(id))
+	return &MixinServiceOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MixinServiceOverride.
+func (c *MixinServiceOverrideClient) Delete() *MixinServiceOverrideDelete {
+	mutation := newMixinServiceOverrideMutation(c.config, OpDelete)
+	return &MixinServiceOverrideDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MixinServiceOverrideClient) DeleteOne(mso *MixinServiceOverride) *MixinServiceOverrideDeleteOne {
+	return c.DeleteOneID(mso.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MixinServiceOverrideClient) DeleteOneID(id int) *MixinServiceOverrideDeleteOne {
+	builder := c.Delete().Where(mixinserviceoverride.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MixinServiceOverrideDeleteOne{builder}
+}
+
+// Query returns a query builder for MixinServiceOverride.
+func (c *MixinServiceOverrideClient) Query() *MixinServiceOverrideQuery {
+	return &MixinServiceOverrideQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a MixinServiceOverride entity by its id.
+func (c *MixinServiceOverrideClient) Get(ctx context.Context, id int) (*MixinServiceOverride, error) {
+	return c.Query().Where(mixinserviceoverride.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MixinServiceOverrideClient) GetX(ctx context.Context, id int) *MixinServiceOverride {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MixinServiceOverrideClient) Hooks() []Hook {
+	return c.hooks.MixinServiceOverride
+}
+
 // NoBackrefClient is a client for the NoBackref schema.
 type NoBackrefClient struct {
 	config
@@ -2003,6 +2703,276 @@ func (c *PortalClient) Hooks() []Hook {
 	return c.hooks.Portal
 }
 
+// SharedEnumMessageClient is a client for the SharedEnumMessage schema.
+type SharedEnumMessageClient struct {
+	config
+}
+
+// NewSharedEnumMessageClient returns a client for the SharedEnumMessage from the given config.
+func NewSharedEnumMessageClient(c config) *SharedEnumMessageClient {
+	return &SharedEnumMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sharedenummessage.Hooks(f(g(h())))`.
+func (c *SharedEnumMessageClient) Use(hooks ...Hook) {
+	c.hooks.SharedEnumMessage = append(c.hooks.SharedEnumMessage, hooks...)
+}
+
+// Create returns a builder for creating a SharedEnumMessage entity.
+func (c *SharedEnumMessageClient) Create() *SharedEnumMessageCreate {
+	mutation := newSharedEnumMessageMutation(c.config, OpCreate)
+	return &SharedEnumMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SharedEnumMessage entities.
+func (c *SharedEnumMessageClient) CreateBulk(builders ...*SharedEnumMessageCreate) *SharedEnumMessageCreateBulk {
+	return &SharedEnumMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SharedEnumMessage.
+func (c *SharedEnumMessageClient) Update() *SharedEnumMessageUpdate {
+	mutation := newSharedEnumMessageMutation(c.config, OpUpdate)
+	return &SharedEnumMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SharedEnumMessageClient) UpdateOne(sem *SharedEnumMessage) *SharedEnumMessageUpdateOne {
+	mutation := newSharedEnumMessageMutation(c.config, OpUpdateOne, withSharedEnumMessage(sem))
+	return &SharedEnumMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SharedEnumMessageClient) UpdateOneID(id int) *SharedEnumMessageUpdateOne {
+	mutation := newSharedEnumMessageMutation(c.config, OpUpdateOne, withSharedEnumMessageID(id))
+	return &SharedEnumMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SharedEnumMessage.
+func (c *SharedEnumMessageClient) Delete() *SharedEnumMessageDelete {
+	mutation := newSharedEnumMessageMutation(c.config, OpDelete)
+	return &SharedEnumMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SharedEnumMessageClient) DeleteOne(sem *SharedEnumMessage) *SharedEnumMessageDeleteOne {
+	return c.DeleteOneID(sem.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SharedEnumMessageClient) DeleteOneID(id int) *SharedEnumMessageDeleteOne {
+	builder := c.Delete().Where(sharedenummessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SharedEnumMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for SharedEnumMessage.
+func (c *SharedEnumMessageClient) Query() *SharedEnumMessageQuery {
+	return &SharedEnumMessageQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a SharedEnumMessage entity by its id.
+func (c *SharedEnumMessageClient) Get(ctx context.Context, id int) (*SharedEnumMessage, error) {
+	return c.Query().Where(sharedenummessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SharedEnumMessageClient) GetX(ctx context.Context, id int) *SharedEnumMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SharedEnumMessageClient) Hooks() []Hook {
+	return c.hooks.SharedEnumMessage
+}
+
+// SharedEnumOtherMessageClient is a client for the SharedEnumOtherMessage schema.
+type SharedEnumOtherMessageClient struct {
+	config
+}
+
+// NewSharedEnumOtherMessageClient returns a client for the SharedEnumOtherMessage from the given config.
+func NewSharedEnumOtherMessageClient(c config) *SharedEnumOtherMessageClient {
+	return &SharedEnumOtherMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sharedenumothermessage.Hooks(f(g(h())))`.
+func (c *SharedEnumOtherMessageClient) Use(hooks ...Hook) {
+	c.hooks.SharedEnumOtherMessage = append(c.hooks.SharedEnumOtherMessage, hooks...)
+}
+
+// Create returns a builder for creating a SharedEnumOtherMessage entity.
+func (c *SharedEnumOtherMessageClient) Create() *SharedEnumOtherMessageCreate {
+	mutation := newSharedEnumOtherMessageMutation(c.config, OpCreate)
+	return &SharedEnumOtherMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SharedEnumOtherMessage entities.
+func (c *SharedEnumOtherMessageClient) CreateBulk(builders ...*SharedEnumOtherMessageCreate) *SharedEnumOtherMessageCreateBulk {
+	return &SharedEnumOtherMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SharedEnumOtherMessage.
+func (c *SharedEnumOtherMessageClient) Update() *SharedEnumOtherMessageUpdate {
+	mutation := newSharedEnumOtherMessageMutation(c.config, OpUpdate)
+	return &SharedEnumOtherMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SharedEnumOtherMessageClient) UpdateOne(seom *SharedEnumOtherMessage) *SharedEnumOtherMessageUpdateOne {
+	mutation := newSharedEnumOtherMessageMutation(c.config, OpUpdateOne, withSharedEnumOtherMessage(seom))
+	return &SharedEnumOtherMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SharedEnumOtherMessageClient) UpdateOneID(id int) *SharedEnumOtherMessageUpdateOne {
+	mutation := newSharedEnumOtherMessageMutation(c.config, OpUpdateOne, withSharedEnumOtherMessageID(id))
+	return &SharedEnumOtherMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SharedEnumOtherMessage.
+func (c *SharedEnumOtherMessageClient) Delete() *SharedEnumOtherMessageDelete {
+	mutation := newSharedEnumOtherMessageMutation(c.config, OpDelete)
+	return &SharedEnumOtherMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SharedEnumOtherMessageClient) DeleteOne(seom *SharedEnumOtherMessage) *SharedEnumOtherMessageDeleteOne {
+	return c.DeleteOneID(seom.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SharedEnumOtherMessageClient) DeleteOneID(id int) *SharedEnumOtherMessageDeleteOne {
+	builder := c.Delete().Where(sharedenumothermessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SharedEnumOtherMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for SharedEnumOtherMessage.
+func (c *SharedEnumOtherMessageClient) Query() *SharedEnumOtherMessageQuery {
+	return &SharedEnumOtherMessageQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a SharedEnumOtherMessage entity by its id.
+func (c *SharedEnumOtherMessageClient) Get(ctx context.Context, id int) (*SharedEnumOtherMessage, error) {
+	return c.Query().Where(sharedenumothermessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SharedEnumOtherMessageClient) GetX(ctx context.Context, id int) *SharedEnumOtherMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SharedEnumOtherMessageClient) Hooks() []Hook {
+	return c.hooks.SharedEnumOtherMessage
+}
+
+// SharedEnumVariantClient is a client for the SharedEnumVariant schema.
+type SharedEnumVariantClient struct {
+	config
+}
+
+// NewSharedEnumVariantClient returns a client for the SharedEnumVariant from the given config.
+func NewSharedEnumVariantClient(c config) *SharedEnumVariantClient {
+	return &SharedEnumVariantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sharedenumvariant.Hooks(f(g(h())))`.
+func (c *SharedEnumVariantClient) Use(hooks ...Hook) {
+	c.hooks.SharedEnumVariant = append(c.hooks.SharedEnumVariant, hooks...)
+}
+
+// Create returns a builder for creating a SharedEnumVariant entity.
+func (c *SharedEnumVariantClient) Create() *SharedEnumVariantCreate {
+	mutation := newSharedEnumVariantMutation(c.config, OpCreate)
+	return &SharedEnumVariantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SharedEnumVariant entities.
+func (c *SharedEnumVariantClient) CreateBulk(builders ...*SharedEnumVariantCreate) *SharedEnumVariantCreateBulk {
+	return &SharedEnumVariantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SharedEnumVariant.
+func (c *SharedEnumVariantClient) Update() *SharedEnumVariantUpdate {
+	mutation := newSharedEnumVariantMutation(c.config, OpUpdate)
+	return &SharedEnumVariantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SharedEnumVariantClient) UpdateOne(sev *SharedEnumVariant) *SharedEnumVariantUpdateOne {
+	mutation := newSharedEnumVariantMutation(c.config, OpUpdateOne, withSharedEnumVariant(sev))
+	return &SharedEnumVariantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SharedEnumVariantClient) UpdateOneID(id int) *SharedEnumVariantUpdateOne {
+	mutation := newSharedEnumVariantMutation(c.config, OpUpdateOne, withSharedEnumVariantID(id))
+	return &SharedEnumVariantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SharedEnumVariant.
+func (c *SharedEnumVariantClient) Delete() *SharedEnumVariantDelete {
+	mutation := newSharedEnumVariantMutation(c.config, OpDelete)
+	return &SharedEnumVariantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SharedEnumVariantClient) DeleteOne(sev *SharedEnumVariant) *SharedEnumVariantDeleteOne {
+	return c.DeleteOneID(sev.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SharedEnumVariantClient) DeleteOneID(id int) *SharedEnumVariantDeleteOne {
+	builder := c.Delete().Where(sharedenumvariant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SharedEnumVariantDeleteOne{builder}
+}
+
+// Query returns a query builder for SharedEnumVariant.
+func (c *SharedEnumVariantClient) Query() *SharedEnumVariantQuery {
+	return &SharedEnumVariantQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a SharedEnumVariant entity by its id.
+func (c *SharedEnumVariantClient) Get(ctx context.Context, id int) (*SharedEnumVariant, error) {
+	return c.Query().Where(sharedenumvariant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SharedEnumVariantClient) GetX(ctx context.Context, id int) *SharedEnumVariant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SharedEnumVariantClient) Hooks() []Hook {
+	return c.hooks.SharedEnumVariant
+}
+
 // SkipEdgeExampleClient is a client for the SkipEdgeExample schema.
 type SkipEdgeExampleClient struct {
 	config
@@ -25,23 +25,33 @@ type config struct {
 // hooks per client, for fast access.
 type hooks struct {
 	AllMethodsService      []ent.Hook
+	AutoFieldNumbers       []ent.Hook
 	BlogPost               []ent.Hook
 	Category               []ent.Hook
 	DependsOnSkipped       []ent.Hook
 	DuplicateNumberMessage []ent.Hook
 	ExplicitSkippedMessage []ent.Hook
+	ExtraMethodService     []ent.Hook
 	Image                  []ent.Hook
 	ImplicitSkippedMessage []ent.Hook
 	InvalidFieldMessage    []ent.Hook
+	MappedFieldMessage     []ent.Hook
 	MessageWithEnum        []ent.Hook
 	MessageWithFieldOne    []ent.Hook
 	MessageWithID          []ent.Hook
 	MessageWithOptionals   []ent.Hook
 	MessageWithPackageName []ent.Hook
 	MessageWithStrings     []ent.Hook
+	MethodOptionsService   []ent.Hook
+	MixinAnnotated         []ent.Hook
+	MixinCollision         []ent.Hook
+	MixinServiceOverride   []ent.Hook
 	NoBackref              []ent.Hook
 	OneMethodService       []ent.Hook
 	Portal                 []ent.Hook
+	SharedEnumMessage      []ent.Hook
+	SharedEnumOtherMessage []ent.Hook
+	SharedEnumVariant      []ent.Hook
 	SkipEdgeExample        []ent.Hook
 	TwoMethodService       []ent.Hook
 	User                   []ent.Hook
@@ -8,23 +8,33 @@ import (
 	"fmt"
 
 	"entgo.io/contrib/entproto/internal/entprototest/ent/allmethodsservice"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/autofieldnumbers"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/blogpost"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/category"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/dependsonskipped"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/duplicatenumbermessage"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/explicitskippedmessage"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/extramethodservice"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/image"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/implicitskippedmessage"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/invalidfieldmessage"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mappedfieldmessage"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithenum"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithfieldone"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithid"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithoptionals"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithpackagename"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithstrings"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/methodoptionsservice"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixinannotated"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixincollision"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixinserviceoverride"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/nobackref"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/onemethodservice"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/portal"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenummessage"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenumothermessage"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenumvariant"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/skipedgeexample"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/twomethodservice"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/user"
@@ -53,23 +63,33 @@ type OrderFunc func(*sql.Selector)
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
 		allmethodsservice.Table:      allmethodsservice.ValidColumn,
+		autofieldnumbers.Table:       autofieldnumbers.ValidColumn,
 		blogpost.Table:               blogpost.ValidColumn,
 		category.Table:               category.ValidColumn,
 		dependsonskipped.Table:       dependsonskipped.ValidColumn,
 		duplicatenumbermessage.Table: duplicatenumbermessage.ValidColumn,
 		explicitskippedmessage.Table: explicitskippedmessage.ValidColumn,
+		extramethodservice.Table:     extramethodservice.ValidColumn,
 		image.Table:                  image.ValidColumn,
 		implicitskippedmessage.Table: implicitskippedmessage.ValidColumn,
 		invalidfieldmessage.Table:    invalidfieldmessage.ValidColumn,
+		mappedfieldmessage.Table:     mappedfieldmessage.ValidColumn,
 		messagewithenum.Table:        messagewithenum.ValidColumn,
 		messagewithfieldone.Table:    messagewithfieldone.ValidColumn,
 		messagewithid.Table:          messagewithid.ValidColumn,
 		messagewithoptionals.Table:   messagewithoptionals.ValidColumn,
 		messagewithpackagename.Table: messagewithpackagename.ValidColumn,
 		messagewithstrings.Table:     messagewithstrings.ValidColumn,
+		methodoptionsservice.Table:   methodoptionsservice.ValidColumn,
+		mixinannotated.Table:         mixinannotated.ValidColumn,
+		mixincollision.Table:         mixincollision.ValidColumn,
+		mixinserviceoverride.Table:   mixinserviceoverride.ValidColumn,
 		nobackref.Table:              nobackref.ValidColumn,
 		onemethodservice.Table:       onemethodservice.ValidColumn,
 		portal.Table:                 portal.ValidColumn,
+		sharedenummessage.Table:      sharedenummessage.ValidColumn,
+		sharedenumothermessage.Table: sharedenumothermessage.ValidColumn,
+		sharedenumvariant.Table:      sharedenumvariant.ValidColumn,
 		skipedgeexample.Table:        skipedgeexample.ValidColumn,
 		twomethodservice.Table:       twomethodservice.ValidColumn,
 		user.Table:                   user.ValidColumn,
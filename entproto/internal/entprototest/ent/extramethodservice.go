@@ -0,0 +1,87 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/extramethodservice"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ExtraMethodService is the model entity for the ExtraMethodService schema.
+type ExtraMethodService struct {
+	config
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExtraMethodService) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case extramethodservice.FieldID:
+			values[i] = new(sql.NullInt64)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ExtraMethodService", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExtraMethodService fields.
+func (ems *ExtraMethodService) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case extramethodservice.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ems.ID = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this ExtraMethodService.
+// Note that you need to call ExtraMethodService.Unwrap() before calling this method if this ExtraMethodService
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ems *ExtraMethodService) Update() *ExtraMethodServiceUpdateOne {
+	return (&ExtraMethodServiceClient{config: ems.config}).UpdateOne(ems)
+}
+
+// Unwrap unwraps the ExtraMethodService entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ems *ExtraMethodService) Unwrap() *ExtraMethodService {
+	_tx, ok := ems.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExtraMethodService is not a transactional entity")
+	}
+	ems.config.driver = _tx.drv
+	return ems
+}
+
+// String implements the fmt.Stringer.
+func (ems *ExtraMethodService) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExtraMethodService(")
+	builder.WriteString(fmt.Sprintf("id=%v", ems.ID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExtraMethodServices is a parsable slice of ExtraMethodService.
+type ExtraMethodServices []*ExtraMethodService
+
+func (ems ExtraMethodServices) config(cfg config) {
+	for _i := range ems {
+		ems[_i].config = cfg
+	}
+}
@@ -0,0 +1,27 @@
+// Code generated by ent, DO NOT EDIT.
+
+package extramethodservice
+
+const (
+	// Label holds the string label denoting the extramethodservice type in the database.
+	Label = "extra_method_service"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// Table holds the table name of the extramethodservice in the database.
+	Table = "extra_method_services"
+)
+
+// Columns holds all SQL columns for extramethodservice fields.
+var Columns = []string{
+	FieldID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
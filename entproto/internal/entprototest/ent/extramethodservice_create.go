@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/extramethodservice"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExtraMethodServiceCreate is the builder for creating a ExtraMethodService entity.
+type ExtraMethodServiceCreate struct {
+	config
+	mutation *ExtraMethodServiceMutation
+	hooks    []Hook
+}
+
+// Mutation returns the ExtraMethodServiceMutation object of the builder.
+func (emsc *ExtraMethodServiceCreate) Mutation() *ExtraMethodServiceMutation {
+	return emsc.mutation
+}
+
+// Save creates the ExtraMethodService in the database.
+func (emsc *ExtraMethodServiceCreate) Save(ctx context.Context) (*ExtraMethodService, error) {
+	var (
+		err  error
+		node *ExtraMethodService
+	)
+	if len(emsc.hooks) == 0 {
+		if err = emsc.check(); err != nil {
+			return nil, err
+		}
+		node, err = emsc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ExtraMethodServiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = emsc.check(); err != nil {
+				return nil, err
+			}
+			emsc.mutation = mutation
+			if node, err = emsc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(emsc.hooks) - 1; i >= 0; i-- {
+			if emsc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = emsc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, emsc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ExtraMethodService)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ExtraMethodServiceMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (emsc *ExtraMethodServiceCreate) SaveX(ctx context.Context) *ExtraMethodService {
+	v, err := emsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (emsc *ExtraMethodServiceCreate) Exec(ctx context.Context) error {
+	_, err := emsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (emsc *ExtraMethodServiceCreate) ExecX(ctx context.Context) {
+	if err := emsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (emsc *ExtraMethodServiceCreate) check() error {
+	return nil
+}
+
+func (emsc *ExtraMethodServiceCreate) sqlSave(ctx context.Context) (*ExtraMethodService, error) {
+	_node, _spec := emsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, emsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (emsc *ExtraMethodServiceCreate) createSpec() (*ExtraMethodService, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExtraMethodService{config: emsc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: extramethodservice.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: extramethodservice.FieldID,
+			},
+		}
+	)
+	return _node, _spec
+}
+
+// ExtraMethodServiceCreateBulk is the builder for creating many ExtraMethodService entities in bulk.
+type ExtraMethodServiceCreateBulk struct {
+	config
+	builders []*ExtraMethodServiceCreate
+}
+
+// Save creates the ExtraMethodService entities in the database.
+func (emscb *ExtraMethodServiceCreateBulk) Save(ctx context.Context) ([]*ExtraMethodService, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(emscb.builders))
+	nodes := make([]*ExtraMethodService, len(emscb.builders))
+	mutators := make([]Mutator, len(emscb.builders))
+	for i := range emscb.builders {
+		func(i int, root context.Context) {
+			builder := emscb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExtraMethodServiceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, emscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, emscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, emscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (emscb *ExtraMethodServiceCreateBulk) SaveX(ctx context.Context) []*ExtraMethodService {
+	v, err := emscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (emscb *ExtraMethodServiceCreateBulk) Exec(ctx context.Context) error {
+	_, err := emscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (emscb *ExtraMethodServiceCreateBulk) ExecX(ctx context.Context) {
+	if err := emscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/extramethodservice"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExtraMethodServiceDelete is the builder for deleting a ExtraMethodService entity.
+type ExtraMethodServiceDelete struct {
+	config
+	hooks    []Hook
+	mutation *ExtraMethodServiceMutation
+}
+
+// Where appends a list predicates to the ExtraMethodServiceDelete builder.
+func (emsd *ExtraMethodServiceDelete) Where(ps ...predicate.ExtraMethodService) *ExtraMethodServiceDelete {
+	emsd.mutation.Where(ps...)
+	return emsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (emsd *ExtraMethodServiceDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(emsd.hooks) == 0 {
+		affected, err = emsd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ExtraMethodServiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			emsd.mutation = mutation
+			affected, err = emsd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(emsd.hooks) - 1; i >= 0; i-- {
+			if emsd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = emsd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, emsd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (emsd *ExtraMethodServiceDelete) ExecX(ctx context.Context) int {
+	n, err := emsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (emsd *ExtraMethodServiceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: extramethodservice.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: extramethodservice.FieldID,
+			},
+		},
+	}
+	if ps := emsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, emsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// ExtraMethodServiceDeleteOne is the builder for deleting a single ExtraMethodService entity.
+type ExtraMethodServiceDeleteOne struct {
+	emsd *ExtraMethodServiceDelete
+}
+
+// Exec executes the deletion query.
+func (emsdo *ExtraMethodServiceDeleteOne) Exec(ctx context.Context) error {
+	n, err := emsdo.emsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{extramethodservice.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (emsdo *ExtraMethodServiceDeleteOne) ExecX(ctx context.Context) {
+	emsdo.emsd.ExecX(ctx)
+}
@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/extramethodservice"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExtraMethodServiceQuery is the builder for querying ExtraMethodService entities.
+type ExtraMethodServiceQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ExtraMethodService
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ExtraMethodServiceQuery builder.
+func (emsq *ExtraMethodServiceQuery) Where(ps ...predicate.ExtraMethodService) *ExtraMethodServiceQuery {
+	emsq.predicates = append(emsq.predicates, ps...)
+	return emsq
+}
+
+// Limit adds a limit step to the query.
+func (emsq *ExtraMethodServiceQuery) Limit(limit int) *ExtraMethodServiceQuery {
+	emsq.limit = &limit
+	return emsq
+}
+
+// Offset adds an offset step to the query.
+func (emsq *ExtraMethodServiceQuery) Offset(offset int) *ExtraMethodServiceQuery {
+	emsq.offset = &offset
+	return emsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (emsq *ExtraMethodServiceQuery) Unique(unique bool) *ExtraMethodServiceQuery {
+	emsq.unique = &unique
+	return emsq
+}
+
+// Order adds an order step to the query.
+func (emsq *ExtraMethodServiceQuery) Order(o ...OrderFunc) *ExtraMethodServiceQuery {
+	emsq.order = append(emsq.order, o...)
+	return emsq
+}
+
+// First returns the first ExtraMethodService entity from the query.
+// Returns a *NotFoundError when no ExtraMethodService was found.
+func (emsq *ExtraMethodServiceQuery) First(ctx context.Context) (*ExtraMethodService, error) {
+	nodes, err := emsq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{extramethodservice.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (emsq *ExtraMethodServiceQuery) FirstX(ctx context.Context) *ExtraMethodService {
+	node, err := emsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ExtraMethodService ID from the query.
+// Returns a *NotFoundError when no ExtraMethodService ID was found.
+func (emsq *ExtraMethodServiceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = emsq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{extramethodservice.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (emsq *ExtraMethodServiceQuery) FirstIDX(ctx context.Context) int {
+	id, err := emsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ExtraMethodService entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ExtraMethodService entity is found.
+// Returns a *NotFoundError when no ExtraMethodService entities are found.
+func (emsq *ExtraMethodServiceQuery) Only(ctx context.Context) (*ExtraMethodService, error) {
+	nodes, err := emsq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{extramethodservice.Label}
+	default:
+		return nil, &NotSingularError{extramethodservice.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (emsq *ExtraMethodServiceQuery) OnlyX(ctx context.Context) *ExtraMethodService {
+	node, err := emsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ExtraMethodService ID in the query.
+// Returns a *NotSingularError when more than one ExtraMethodService ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (emsq *ExtraMethodServiceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = emsq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{extramethodservice.Label}
+	default:
+		err = &NotSingularError{extramethodservice.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (emsq *ExtraMethodServiceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := emsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ExtraMethodServices.
+func (emsq *ExtraMethodServiceQuery) All(ctx context.Context) ([]*ExtraMethodService, error) {
+	if err := emsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return emsq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (emsq *ExtraMethodServiceQuery) AllX(ctx context.Context) []*ExtraMethodService {
+	nodes, err := emsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ExtraMethodService IDs.
+func (emsq *ExtraMethodServiceQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := emsq.Select(extramethodservice.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (emsq *ExtraMethodServiceQuery) IDsX(ctx context.Context) []int {
+	ids, err := emsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (emsq *ExtraMethodServiceQuery) Count(ctx context.Context) (int, error) {
+	if err := emsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return emsq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (emsq *ExtraMethodServiceQuery) CountX(ctx context.Context) int {
+	count, err := emsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (emsq *ExtraMethodServiceQuery) Exist(ctx context.Context) (bool, error) {
+	if err := emsq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return emsq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (emsq *ExtraMethodServiceQuery) ExistX(ctx context.Context) bool {
+	exist, err := emsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ExtraMethodServiceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (emsq *ExtraMethodServiceQuery) Clone() *ExtraMethodServiceQuery {
+	if emsq == nil {
+		return nil
+	}
+	return &ExtraMethodServiceQuery{
+		config:     emsq.config,
+		limit:      emsq.limit,
+		offset:     emsq.offset,
+		order:      append([]OrderFunc{}, emsq.order...),
+		predicates: append([]predicate.ExtraMethodService{}, emsq.predicates...),
+		// clone intermediate query.
+		sql:    emsq.sql.Clone(),
+		path:   emsq.path,
+		unique: emsq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+func (emsq *ExtraMethodServiceQuery) GroupBy(field string, fields ...string) *ExtraMethodServiceGroupBy {
+	grbuild := &ExtraMethodServiceGroupBy{config: emsq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := emsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return emsq.sqlQuery(ctx), nil
+	}
+	grbuild.label = extramethodservice.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+func (emsq *ExtraMethodServiceQuery) Select(fields ...string) *ExtraMethodServiceSelect {
+	emsq.fields = append(emsq.fields, fields...)
+	selbuild := &ExtraMethodServiceSelect{ExtraMethodServiceQuery: emsq}
+	selbuild.label = extramethodservice.Label
+	selbuild.flds, selbuild.scan = &emsq.fields, selbuild.Scan
+	return selbuild
+}
+
+// Aggregate returns a ExtraMethodServiceSelect configured with the given aggregations.
+func (emsq *ExtraMethodServiceQuery) Aggregate(fns ...AggregateFunc) *ExtraMethodServiceSelect {
+	return emsq.Select().Aggregate(fns...)
+}
+
+func (emsq *ExtraMethodServiceQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range emsq.fields {
+		if !extramethodservice.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if emsq.path != nil {
+		prev, err := emsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		emsq.sql = prev
+	}
+	return nil
+}
+
+func (emsq *ExtraMethodServiceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ExtraMethodService, error) {
+	var (
+		nodes = []*ExtraMethodService{}
+		_spec = emsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ExtraMethodService).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ExtraMethodService{config: emsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, emsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (emsq *ExtraMethodServiceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := emsq.querySpec()
+	_spec.Node.Columns = emsq.fields
+	if len(emsq.fields) > 0 {
+		_spec.Unique = emsq.unique != nil && *emsq.unique
+	}
+	return sqlgraph.CountNodes(ctx, emsq.driver, _spec)
+}
+
+func (emsq *ExtraMethodServiceQuery) sqlExist(ctx context.Context) (bool, error) {
+	switch _, err := emsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+func (emsq *ExtraMethodServiceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   extramethodservice.Table,
+			Columns: extramethodservice.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: extramethodservice.FieldID,
+			},
+		},
+		From:   emsq.sql,
+		Unique: true,
+	}
+	if unique := emsq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := emsq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, extramethodservice.FieldID)
+		for i := range fields {
+			if fields[i] != extramethodservice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := emsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := emsq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := emsq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := emsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (emsq *ExtraMethodServiceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(emsq.driver.Dialect())
+	t1 := builder.Table(extramethodservice.Table)
+	columns := emsq.fields
+	if len(columns) == 0 {
+		columns = extramethodservice.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if emsq.sql != nil {
+		selector = emsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if emsq.unique != nil && *emsq.unique {
+		selector.Distinct()
+	}
+	for _, p := range emsq.predicates {
+		p(selector)
+	}
+	for _, p := range emsq.order {
+		p(selector)
+	}
+	if offset := emsq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := emsq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ExtraMethodServiceGroupBy is the group-by builder for ExtraMethodService entities.
+type ExtraMethodServiceGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (emsgb *ExtraMethodServiceGroupBy) Aggregate(fns ...AggregateFunc) *ExtraMethodServiceGroupBy {
+	emsgb.fns = append(emsgb.fns, fns...)
+	return emsgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (emsgb *ExtraMethodServiceGroupBy) Scan(ctx context.Context, v any) error {
+	query, err := emsgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	emsgb.sql = query
+	return emsgb.sqlScan(ctx, v)
+}
+
+func (emsgb *ExtraMethodServiceGroupBy) sqlScan(ctx context.Context, v any) error {
+	for _, f := range emsgb.fields {
+		if !extramethodservice.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := emsgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := emsgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (emsgb *ExtraMethodServiceGroupBy) sqlQuery() *sql.Selector {
+	selector := emsgb.sql.Select()
+	aggregation := make([]string, 0, len(emsgb.fns))
+	for _, fn := range emsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(emsgb.fields)+len(emsgb.fns))
+		for _, f := range emsgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(emsgb.fields...)...)
+}
+
+// ExtraMethodServiceSelect is the builder for selecting fields of ExtraMethodService entities.
+type ExtraMethodServiceSelect struct {
+	*ExtraMethodServiceQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (emss *ExtraMethodServiceSelect) Aggregate(fns ...AggregateFunc) *ExtraMethodServiceSelect {
+	emss.fns = append(emss.fns, fns...)
+	return emss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (emss *ExtraMethodServiceSelect) Scan(ctx context.Context, v any) error {
+	if err := emss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	emss.sql = emss.ExtraMethodServiceQuery.sqlQuery(ctx)
+	return emss.sqlScan(ctx, v)
+}
+
+func (emss *ExtraMethodServiceSelect) sqlScan(ctx context.Context, v any) error {
+	aggregation := make([]string, 0, len(emss.fns))
+	for _, fn := range emss.fns {
+		aggregation = append(aggregation, fn(emss.sql))
+	}
+	switch n := len(*emss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		emss.sql.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		emss.sql.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := emss.sql.Query()
+	if err := emss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
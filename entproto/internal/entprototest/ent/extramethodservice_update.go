@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/extramethodservice"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExtraMethodServiceUpdate is the builder for updating ExtraMethodService entities.
+type ExtraMethodServiceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExtraMethodServiceMutation
+}
+
+// Where appends a list predicates to the ExtraMethodServiceUpdate builder.
+func (emsu *ExtraMethodServiceUpdate) Where(ps ...predicate.ExtraMethodService) *ExtraMethodServiceUpdate {
+	emsu.mutation.Where(ps...)
+	return emsu
+}
+
+// Mutation returns the ExtraMethodServiceMutation object of the builder.
+func (emsu *ExtraMethodServiceUpdate) Mutation() *ExtraMethodServiceMutation {
+	return emsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (emsu *ExtraMethodServiceUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(emsu.hooks) == 0 {
+		affected, err = emsu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ExtraMethodServiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			emsu.mutation = mutation
+			affected, err = emsu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(emsu.hooks) - 1; i >= 0; i-- {
+			if emsu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = emsu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, emsu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (emsu *ExtraMethodServiceUpdate) SaveX(ctx context.Context) int {
+	affected, err := emsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (emsu *ExtraMethodServiceUpdate) Exec(ctx context.Context) error {
+	_, err := emsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (emsu *ExtraMethodServiceUpdate) ExecX(ctx context.Context) {
+	if err := emsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (emsu *ExtraMethodServiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   extramethodservice.Table,
+			Columns: extramethodservice.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: extramethodservice.FieldID,
+			},
+		},
+	}
+	if ps := emsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, emsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{extramethodservice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ExtraMethodServiceUpdateOne is the builder for updating a single ExtraMethodService entity.
+type ExtraMethodServiceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExtraMethodServiceMutation
+}
+
+// Mutation returns the ExtraMethodServiceMutation object of the builder.
+func (emsuo *ExtraMethodServiceUpdateOne) Mutation() *ExtraMethodServiceMutation {
+	return emsuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (emsuo *ExtraMethodServiceUpdateOne) Select(field string, fields ...string) *ExtraMethodServiceUpdateOne {
+	emsuo.fields = append([]string{field}, fields...)
+	return emsuo
+}
+
+// Save executes the query and returns the updated ExtraMethodService entity.
+func (emsuo *ExtraMethodServiceUpdateOne) Save(ctx context.Context) (*ExtraMethodService, error) {
+	var (
+		err  error
+		node *ExtraMethodService
+	)
+	if len(emsuo.hooks) == 0 {
+		node, err = emsuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ExtraMethodServiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			emsuo.mutation = mutation
+			node, err = emsuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(emsuo.hooks) - 1; i >= 0; i-- {
+			if emsuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = emsuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, emsuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ExtraMethodService)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ExtraMethodServiceMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (emsuo *ExtraMethodServiceUpdateOne) SaveX(ctx context.Context) *ExtraMethodService {
+	node, err := emsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (emsuo *ExtraMethodServiceUpdateOne) Exec(ctx context.Context) error {
+	_, err := emsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (emsuo *ExtraMethodServiceUpdateOne) ExecX(ctx context.Context) {
+	if err := emsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (emsuo *ExtraMethodServiceUpdateOne) sqlSave(ctx context.Context) (_node *ExtraMethodService, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   extramethodservice.Table,
+			Columns: extramethodservice.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: extramethodservice.FieldID,
+			},
+		},
+	}
+	id, ok := emsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExtraMethodService.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := emsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, extramethodservice.FieldID)
+		for _, f := range fields {
+			if !extramethodservice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != extramethodservice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := emsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &ExtraMethodService{config: emsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, emsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{extramethodservice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
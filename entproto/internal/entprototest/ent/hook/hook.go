@@ -22,6 +22,19 @@ func (f AllMethodsServiceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.
 	return f(ctx, mv)
 }
 
+// The AutoFieldNumbersFunc type is an adapter to allow the use of ordinary
+// function as AutoFieldNumbers mutator.
+type AutoFieldNumbersFunc func(context.Context, *ent.AutoFieldNumbersMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AutoFieldNumbersFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.AutoFieldNumbersMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AutoFieldNumbersMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The BlogPostFunc type is an adapter to allow the use of ordinary
 // function as BlogPost mutator.
 type BlogPostFunc func(context.Context, *ent.BlogPostMutation) (ent.Value, error)
@@ -87,6 +100,19 @@ func (f ExplicitSkippedMessageFunc) Mutate(ctx context.Context, m ent.Mutation)
 	return f(ctx, mv)
 }
 
+// The ExtraMethodServiceFunc type is an adapter to allow the use of ordinary
+// function as ExtraMethodService mutator.
+type ExtraMethodServiceFunc func(context.Context, *ent.ExtraMethodServiceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExtraMethodServiceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ExtraMethodServiceMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExtraMethodServiceMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The ImageFunc type is an adapter to allow the use of ordinary
 // function as Image mutator.
 type ImageFunc func(context.Context, *ent.ImageMutation) (ent.Value, error)
@@ -126,6 +152,19 @@ func (f InvalidFieldMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (en
 	return f(ctx, mv)
 }
 
+// The MappedFieldMessageFunc type is an adapter to allow the use of ordinary
+// function as MappedFieldMessage mutator.
+type MappedFieldMessageFunc func(context.Context, *ent.MappedFieldMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MappedFieldMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.MappedFieldMessageMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MappedFieldMessageMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The MessageWithEnumFunc type is an adapter to allow the use of ordinary
 // function as MessageWithEnum mutator.
 type MessageWithEnumFunc func(context.Context, *ent.MessageWithEnumMutation) (ent.Value, error)
@@ -204,6 +243,58 @@ func (f MessageWithStringsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent
 	return f(ctx, mv)
 }
 
+// The MethodOptionsServiceFunc type is an adapter to allow the use of ordinary
+// function as MethodOptionsService mutator.
+type MethodOptionsServiceFunc func(context.Context, *ent.MethodOptionsServiceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MethodOptionsServiceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.MethodOptionsServiceMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MethodOptionsServiceMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The MixinAnnotatedFunc type is an adapter to allow the use of ordinary
+// function as MixinAnnotated mutator.
+type MixinAnnotatedFunc func(context.Context, *ent.MixinAnnotatedMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MixinAnnotatedFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.MixinAnnotatedMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MixinAnnotatedMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The MixinCollisionFunc type is an adapter to allow the use of ordinary
+// function as MixinCollision mutator.
+type MixinCollisionFunc func(context.Context, *ent.MixinCollisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MixinCollisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.MixinCollisionMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MixinCollisionMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The MixinServiceOverrideFunc type is an adapter to allow the use of ordinary
+// function as MixinServiceOverride mutator.
+type MixinServiceOverrideFunc func(context.Context, *ent.MixinServiceOverrideMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MixinServiceOverrideFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.MixinServiceOverrideMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MixinServiceOverrideMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The NoBackrefFunc type is an adapter to allow the use of ordinary
 // function as NoBackref mutator.
 type NoBackrefFunc func(context.Context, *ent.NoBackrefMutation) (ent.Value, error)
@@ -243,6 +334,45 @@ func (f PortalFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return f(ctx, mv)
 }
 
+// The SharedEnumMessageFunc type is an adapter to allow the use of ordinary
+// function as SharedEnumMessage mutator.
+type SharedEnumMessageFunc func(context.Context, *ent.SharedEnumMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SharedEnumMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.SharedEnumMessageMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SharedEnumMessageMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The SharedEnumOtherMessageFunc type is an adapter to allow the use of ordinary
+// function as SharedEnumOtherMessage mutator.
+type SharedEnumOtherMessageFunc func(context.Context, *ent.SharedEnumOtherMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SharedEnumOtherMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.SharedEnumOtherMessageMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SharedEnumOtherMessageMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The SharedEnumVariantFunc type is an adapter to allow the use of ordinary
+// function as SharedEnumVariant mutator.
+type SharedEnumVariantFunc func(context.Context, *ent.SharedEnumVariantMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SharedEnumVariantFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.SharedEnumVariantMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SharedEnumVariantMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The SkipEdgeExampleFunc type is an adapter to allow the use of ordinary
 // function as SkipEdgeExample mutator.
 type SkipEdgeExampleFunc func(context.Context, *ent.SkipEdgeExampleMutation) (ent.Value, error)
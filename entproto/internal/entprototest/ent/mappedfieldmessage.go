@@ -0,0 +1,111 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mappedfieldmessage"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/schema"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MappedFieldMessage is the model entity for the MappedFieldMessage schema.
+type MappedFieldMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Location holds the value of the "location" field.
+	Location schema.Coordinate `json:"location,omitempty"`
+	// PreviousLocation holds the value of the "previous_location" field.
+	PreviousLocation schema.Coordinate `json:"previous_location,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MappedFieldMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mappedfieldmessage.FieldLocation, mappedfieldmessage.FieldPreviousLocation:
+			values[i] = new(schema.Coordinate)
+		case mappedfieldmessage.FieldID:
+			values[i] = new(sql.NullInt64)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type MappedFieldMessage", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MappedFieldMessage fields.
+func (mfm *MappedFieldMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mappedfieldmessage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mfm.ID = int(value.Int64)
+		case mappedfieldmessage.FieldLocation:
+			if value, ok := values[i].(*schema.Coordinate); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value != nil {
+				mfm.Location = *value
+			}
+		case mappedfieldmessage.FieldPreviousLocation:
+			if value, ok := values[i].(*schema.Coordinate); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_location", values[i])
+			} else if value != nil {
+				mfm.PreviousLocation = *value
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this MappedFieldMessage.
+// Note that you need to call MappedFieldMessage.Unwrap() before calling this method if this MappedFieldMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mfm *MappedFieldMessage) Update() *MappedFieldMessageUpdateOne {
+	return (&MappedFieldMessageClient{config: mfm.config}).UpdateOne(mfm)
+}
+
+// Unwrap unwraps the MappedFieldMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mfm *MappedFieldMessage) Unwrap() *MappedFieldMessage {
+	_tx, ok := mfm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MappedFieldMessage is not a transactional entity")
+	}
+	mfm.config.driver = _tx.drv
+	return mfm
+}
+
+// String implements the fmt.Stringer.
+func (mfm *MappedFieldMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("MappedFieldMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mfm.ID))
+	builder.WriteString("location=")
+	builder.WriteString(fmt.Sprintf("%v", mfm.Location))
+	builder.WriteString(", ")
+	builder.WriteString("previous_location=")
+	builder.WriteString(fmt.Sprintf("%v", mfm.PreviousLocation))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MappedFieldMessages is a parsable slice of MappedFieldMessage.
+type MappedFieldMessages []*MappedFieldMessage
+
+func (mfm MappedFieldMessages) config(cfg config) {
+	for _i := range mfm {
+		mfm[_i].config = cfg
+	}
+}
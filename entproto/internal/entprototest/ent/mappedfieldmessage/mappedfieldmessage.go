@@ -0,0 +1,33 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mappedfieldmessage
+
+const (
+	// Label holds the string label denoting the mappedfieldmessage type in the database.
+	Label = "mapped_field_message"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLocation holds the string denoting the location field in the database.
+	FieldLocation = "location"
+	// FieldPreviousLocation holds the string denoting the previous_location field in the database.
+	FieldPreviousLocation = "previous_location"
+	// Table holds the table name of the mappedfieldmessage in the database.
+	Table = "mapped_field_messages"
+)
+
+// Columns holds all SQL columns for mappedfieldmessage fields.
+var Columns = []string{
+	FieldID,
+	FieldLocation,
+	FieldPreviousLocation,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
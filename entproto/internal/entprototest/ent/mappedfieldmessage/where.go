@@ -0,0 +1,268 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mappedfieldmessage
+
+import (
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/schema"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Location applies equality check predicate on the "location" field. It's identical to LocationEQ.
+func Location(v schema.Coordinate) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLocation), v))
+	})
+}
+
+// PreviousLocation applies equality check predicate on the "previous_location" field. It's identical to PreviousLocationEQ.
+func PreviousLocation(v schema.Coordinate) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPreviousLocation), v))
+	})
+}
+
+// LocationEQ applies the EQ predicate on the "location" field.
+func LocationEQ(v schema.Coordinate) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLocation), v))
+	})
+}
+
+// LocationNEQ applies the NEQ predicate on the "location" field.
+func LocationNEQ(v schema.Coordinate) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLocation), v))
+	})
+}
+
+// LocationIn applies the In predicate on the "location" field.
+func LocationIn(vs ...schema.Coordinate) predicate.MappedFieldMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldLocation), v...))
+	})
+}
+
+// LocationNotIn applies the NotIn predicate on the "location" field.
+func LocationNotIn(vs ...schema.Coordinate) predicate.MappedFieldMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldLocation), v...))
+	})
+}
+
+// LocationGT applies the GT predicate on the "location" field.
+func LocationGT(v schema.Coordinate) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLocation), v))
+	})
+}
+
+// LocationGTE applies the GTE predicate on the "location" field.
+func LocationGTE(v schema.Coordinate) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLocation), v))
+	})
+}
+
+// LocationLT applies the LT predicate on the "location" field.
+func LocationLT(v schema.Coordinate) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLocation), v))
+	})
+}
+
+// LocationLTE applies the LTE predicate on the "location" field.
+func LocationLTE(v schema.Coordinate) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLocation), v))
+	})
+}
+
+// PreviousLocationEQ applies the EQ predicate on the "previous_location" field.
+func PreviousLocationEQ(v schema.Coordinate) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPreviousLocation), v))
+	})
+}
+
+// PreviousLocationNEQ applies the NEQ predicate on the "previous_location" field.
+func PreviousLocationNEQ(v schema.Coordinate) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPreviousLocation), v))
+	})
+}
+
+// PreviousLocationIn applies the In predicate on the "previous_location" field.
+func PreviousLocationIn(vs ...schema.Coordinate) predicate.MappedFieldMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldPreviousLocation), v...))
+	})
+}
+
+// PreviousLocationNotIn applies the NotIn predicate on the "previous_location" field.
+func PreviousLocationNotIn(vs ...schema.Coordinate) predicate.MappedFieldMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldPreviousLocation), v...))
+	})
+}
+
+// PreviousLocationGT applies the GT predicate on the "previous_location" field.
+func PreviousLocationGT(v schema.Coordinate) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPreviousLocation), v))
+	})
+}
+
+// PreviousLocationGTE applies the GTE predicate on the "previous_location" field.
+func PreviousLocationGTE(v schema.Coordinate) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPreviousLocation), v))
+	})
+}
+
+// PreviousLocationLT applies the LT predicate on the "previous_location" field.
+func PreviousLocationLT(v schema.Coordinate) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPreviousLocation), v))
+	})
+}
+
+// PreviousLocationLTE applies the LTE predicate on the "previous_location" field.
+func PreviousLocationLTE(v schema.Coordinate) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPreviousLocation), v))
+	})
+}
+
+// PreviousLocationIsNil applies the IsNil predicate on the "previous_location" field.
+func PreviousLocationIsNil() predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPreviousLocation)))
+	})
+}
+
+// PreviousLocationNotNil applies the NotNil predicate on the "previous_location" field.
+func PreviousLocationNotNil() predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPreviousLocation)))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MappedFieldMessage) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MappedFieldMessage) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MappedFieldMessage) predicate.MappedFieldMessage {
+	return predicate.MappedFieldMessage(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
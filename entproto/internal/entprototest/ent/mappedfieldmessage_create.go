@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mappedfieldmessage"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/schema"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MappedFieldMessageCreate is the builder for creating a MappedFieldMessage entity.
+type MappedFieldMessageCreate struct {
+	config
+	mutation *MappedFieldMessageMutation
+	hooks    []Hook
+}
+
+// SetLocation sets the "location" field.
+func (mfmc *MappedFieldMessageCreate) SetLocation(s schema.Coordinate) *MappedFieldMessageCreate {
+	mfmc.mutation.SetLocation(s)
+	return mfmc
+}
+
+// SetPreviousLocation sets the "previous_location" field.
+func (mfmc *MappedFieldMessageCreate) SetPreviousLocation(s schema.Coordinate) *MappedFieldMessageCreate {
+	mfmc.mutation.SetPreviousLocation(s)
+	return mfmc
+}
+
+// SetNillablePreviousLocation sets the "previous_location" field if the given value is not nil.
+func (mfmc *MappedFieldMessageCreate) SetNillablePreviousLocation(s *schema.Coordinate) *MappedFieldMessageCreate {
+	if s != nil {
+		mfmc.SetPreviousLocation(*s)
+	}
+	return mfmc
+}
+
+// Mutation returns the MappedFieldMessageMutation object of the builder.
+func (mfmc *MappedFieldMessageCreate) Mutation() *MappedFieldMessageMutation {
+	return mfmc.mutation
+}
+
+// Save creates the MappedFieldMessage in the database.
+func (mfmc *MappedFieldMessageCreate) Save(ctx context.Context) (*MappedFieldMessage, error) {
+	var (
+		err  error
+		node *MappedFieldMessage
+	)
+	if len(mfmc.hooks) == 0 {
+		if err = mfmc.check(); err != nil {
+			return nil, err
+		}
+		node, err = mfmc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MappedFieldMessageMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = mfmc.check(); err != nil {
+				return nil, err
+			}
+			mfmc.mutation = mutation
+			if node, err = mfmc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(mfmc.hooks) - 1; i >= 0; i-- {
+			if mfmc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mfmc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, mfmc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*MappedFieldMessage)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from MappedFieldMessageMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mfmc *MappedFieldMessageCreate) SaveX(ctx context.Context) *MappedFieldMessage {
+	v, err := mfmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mfmc *MappedFieldMessageCreate) Exec(ctx context.Context) error {
+	_, err := mfmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfmc *MappedFieldMessageCreate) ExecX(ctx context.Context) {
+	if err := mfmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mfmc *MappedFieldMessageCreate) check() error {
+	if _, ok := mfmc.mutation.Location(); !ok {
+		return &ValidationError{Name: "location", err: errors.New(`ent: missing required field "MappedFieldMessage.location"`)}
+	}
+	return nil
+}
+
+func (mfmc *MappedFieldMessageCreate) sqlSave(ctx context.Context) (*MappedFieldMessage, error) {
+	_node, _spec := mfmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mfmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (mfmc *MappedFieldMessageCreate) createSpec() (*MappedFieldMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MappedFieldMessage{config: mfmc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: mappedfieldmessage.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mappedfieldmessage.FieldID,
+			},
+		}
+	)
+	if value, ok := mfmc.mutation.Location(); ok {
+		_spec.SetField(mappedfieldmessage.FieldLocation, field.TypeOther, value)
+		_node.Location = value
+	}
+	if value, ok := mfmc.mutation.PreviousLocation(); ok {
+		_spec.SetField(mappedfieldmessage.FieldPreviousLocation, field.TypeOther, value)
+		_node.PreviousLocation = value
+	}
+	return _node, _spec
+}
+
+// MappedFieldMessageCreateBulk is the builder for creating many MappedFieldMessage entities in bulk.
+type MappedFieldMessageCreateBulk struct {
+	config
+	builders []*MappedFieldMessageCreate
+}
+
+// Save creates the MappedFieldMessage entities in the database.
+func (mfmcb *MappedFieldMessageCreateBulk) Save(ctx context.Context) ([]*MappedFieldMessage, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(mfmcb.builders))
+	nodes := make([]*MappedFieldMessage, len(mfmcb.builders))
+	mutators := make([]Mutator, len(mfmcb.builders))
+	for i := range mfmcb.builders {
+		func(i int, root context.Context) {
+			builder := mfmcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MappedFieldMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mfmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mfmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mfmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfmcb *MappedFieldMessageCreateBulk) SaveX(ctx context.Context) []*MappedFieldMessage {
+	v, err := mfmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mfmcb *MappedFieldMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := mfmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfmcb *MappedFieldMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := mfmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mappedfieldmessage"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MappedFieldMessageDelete is the builder for deleting a MappedFieldMessage entity.
+type MappedFieldMessageDelete struct {
+	config
+	hooks    []Hook
+	mutation *MappedFieldMessageMutation
+}
+
+// Where appends a list predicates to the MappedFieldMessageDelete builder.
+func (mfmd *MappedFieldMessageDelete) Where(ps ...predicate.MappedFieldMessage) *MappedFieldMessageDelete {
+	mfmd.mutation.Where(ps...)
+	return mfmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mfmd *MappedFieldMessageDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(mfmd.hooks) == 0 {
+		affected, err = mfmd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MappedFieldMessageMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mfmd.mutation = mutation
+			affected, err = mfmd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(mfmd.hooks) - 1; i >= 0; i-- {
+			if mfmd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mfmd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mfmd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfmd *MappedFieldMessageDelete) ExecX(ctx context.Context) int {
+	n, err := mfmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mfmd *MappedFieldMessageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: mappedfieldmessage.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mappedfieldmessage.FieldID,
+			},
+		},
+	}
+	if ps := mfmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mfmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// MappedFieldMessageDeleteOne is the builder for deleting a single MappedFieldMessage entity.
+type MappedFieldMessageDeleteOne struct {
+	mfmd *MappedFieldMessageDelete
+}
+
+// Exec executes the deletion query.
+func (mfmdo *MappedFieldMessageDeleteOne) Exec(ctx context.Context) error {
+	n, err := mfmdo.mfmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{mappedfieldmessage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfmdo *MappedFieldMessageDeleteOne) ExecX(ctx context.Context) {
+	mfmdo.mfmd.ExecX(ctx)
+}
@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mappedfieldmessage"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MappedFieldMessageQuery is the builder for querying MappedFieldMessage entities.
+type MappedFieldMessageQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.MappedFieldMessage
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MappedFieldMessageQuery builder.
+func (mfmq *MappedFieldMessageQuery) Where(ps ...predicate.MappedFieldMessage) *MappedFieldMessageQuery {
+	mfmq.predicates = append(mfmq.predicates, ps...)
+	return mfmq
+}
+
+// Limit adds a limit step to the query.
+func (mfmq *MappedFieldMessageQuery) Limit(limit int) *MappedFieldMessageQuery {
+	mfmq.limit = &limit
+	return mfmq
+}
+
+// Offset adds an offset step to the query.
+func (mfmq *MappedFieldMessageQuery) Offset(offset int) *MappedFieldMessageQuery {
+	mfmq.offset = &offset
+	return mfmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mfmq *MappedFieldMessageQuery) Unique(unique bool) *MappedFieldMessageQuery {
+	mfmq.unique = &unique
+	return mfmq
+}
+
+// Order adds an order step to the query.
+func (mfmq *MappedFieldMessageQuery) Order(o ...OrderFunc) *MappedFieldMessageQuery {
+	mfmq.order = append(mfmq.order, o...)
+	return mfmq
+}
+
+// First returns the first MappedFieldMessage entity from the query.
+// Returns a *NotFoundError when no MappedFieldMessage was found.
+func (mfmq *MappedFieldMessageQuery) First(ctx context.Context) (*MappedFieldMessage, error) {
+	nodes, err := mfmq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{mappedfieldmessage.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mfmq *MappedFieldMessageQuery) FirstX(ctx context.Context) *MappedFieldMessage {
+	node, err := mfmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MappedFieldMessage ID from the query.
+// Returns a *NotFoundError when no MappedFieldMessage ID was found.
+func (mfmq *MappedFieldMessageQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mfmq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{mappedfieldmessage.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mfmq *MappedFieldMessageQuery) FirstIDX(ctx context.Context) int {
+	id, err := mfmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MappedFieldMessage entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MappedFieldMessage entity is found.
+// Returns a *NotFoundError when no MappedFieldMessage entities are found.
+func (mfmq *MappedFieldMessageQuery) Only(ctx context.Context) (*MappedFieldMessage, error) {
+	nodes, err := mfmq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{mappedfieldmessage.Label}
+	default:
+		return nil, &NotSingularError{mappedfieldmessage.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mfmq *MappedFieldMessageQuery) OnlyX(ctx context.Context) *MappedFieldMessage {
+	node, err := mfmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MappedFieldMessage ID in the query.
+// Returns a *NotSingularError when more than one MappedFieldMessage ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mfmq *MappedFieldMessageQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mfmq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{mappedfieldmessage.Label}
+	default:
+		err = &NotSingularError{mappedfieldmessage.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mfmq *MappedFieldMessageQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mfmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MappedFieldMessages.
+func (mfmq *MappedFieldMessageQuery) All(ctx context.Context) ([]*MappedFieldMessage, error) {
+	if err := mfmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return mfmq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mfmq *MappedFieldMessageQuery) AllX(ctx context.Context) []*MappedFieldMessage {
+	nodes, err := mfmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MappedFieldMessage IDs.
+func (mfmq *MappedFieldMessageQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := mfmq.Select(mappedfieldmessage.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mfmq *MappedFieldMessageQuery) IDsX(ctx context.Context) []int {
+	ids, err := mfmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mfmq *MappedFieldMessageQuery) Count(ctx context.Context) (int, error) {
+	if err := mfmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return mfmq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mfmq *MappedFieldMessageQuery) CountX(ctx context.Context) int {
+	count, err := mfmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mfmq *MappedFieldMessageQuery) Exist(ctx context.Context) (bool, error) {
+	if err := mfmq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return mfmq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mfmq *MappedFieldMessageQuery) ExistX(ctx context.Context) bool {
+	exist, err := mfmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MappedFieldMessageQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mfmq *MappedFieldMessageQuery) Clone() *MappedFieldMessageQuery {
+	if mfmq == nil {
+		return nil
+	}
+	return &MappedFieldMessageQuery{
+		config:     mfmq.config,
+		limit:      mfmq.limit,
+		offset:     mfmq.offset,
+		order:      append([]OrderFunc{}, mfmq.order...),
+		predicates: append([]predicate.MappedFieldMessage{}, mfmq.predicates...),
+		// clone intermediate query.
+		sql:    mfmq.sql.Clone(),
+		path:   mfmq.path,
+		unique: mfmq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Location schema.Coordinate `json:"location,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MappedFieldMessage.Query().
+//		GroupBy(mappedfieldmessage.FieldLocation).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mfmq *MappedFieldMessageQuery) GroupBy(field string, fields ...string) *MappedFieldMessageGroupBy {
+	grbuild := &MappedFieldMessageGroupBy{config: mfmq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := mfmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return mfmq.sqlQuery(ctx), nil
+	}
+	grbuild.label = mappedfieldmessage.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Location schema.Coordinate `json:"location,omitempty"`
+//	}
+//
+//	client.MappedFieldMessage.Query().
+//		Select(mappedfieldmessage.FieldLocation).
+//		Scan(ctx, &v)
+func (mfmq *MappedFieldMessageQuery) Select(fields ...string) *MappedFieldMessageSelect {
+	mfmq.fields = append(mfmq.fields, fields...)
+	selbuild := &MappedFieldMessageSelect{MappedFieldMessageQuery: mfmq}
+	selbuild.label = mappedfieldmessage.Label
+	selbuild.flds, selbuild.scan = &mfmq.fields, selbuild.Scan
+	return selbuild
+}
+
+// Aggregate returns a MappedFieldMessageSelect configured with the given aggregations.
+func (mfmq *MappedFieldMessageQuery) Aggregate(fns ...AggregateFunc) *MappedFieldMessageSelect {
+	return mfmq.Select().Aggregate(fns...)
+}
+
+func (mfmq *MappedFieldMessageQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range mfmq.fields {
+		if !mappedfieldmessage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mfmq.path != nil {
+		prev, err := mfmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mfmq.sql = prev
+	}
+	return nil
+}
+
+func (mfmq *MappedFieldMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MappedFieldMessage, error) {
+	var (
+		nodes = []*MappedFieldMessage{}
+		_spec = mfmq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MappedFieldMessage).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MappedFieldMessage{config: mfmq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mfmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mfmq *MappedFieldMessageQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mfmq.querySpec()
+	_spec.Node.Columns = mfmq.fields
+	if len(mfmq.fields) > 0 {
+		_spec.Unique = mfmq.unique != nil && *mfmq.unique
+	}
+	return sqlgraph.CountNodes(ctx, mfmq.driver, _spec)
+}
+
+func (mfmq *MappedFieldMessageQuery) sqlExist(ctx context.Context) (bool, error) {
+	switch _, err := mfmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+func (mfmq *MappedFieldMessageQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   mappedfieldmessage.Table,
+			Columns: mappedfieldmessage.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mappedfieldmessage.FieldID,
+			},
+		},
+		From:   mfmq.sql,
+		Unique: true,
+	}
+	if unique := mfmq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := mfmq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mappedfieldmessage.FieldID)
+		for i := range fields {
+			if fields[i] != mappedfieldmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mfmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mfmq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mfmq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mfmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mfmq *MappedFieldMessageQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mfmq.driver.Dialect())
+	t1 := builder.Table(mappedfieldmessage.Table)
+	columns := mfmq.fields
+	if len(columns) == 0 {
+		columns = mappedfieldmessage.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mfmq.sql != nil {
+		selector = mfmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mfmq.unique != nil && *mfmq.unique {
+		selector.Distinct()
+	}
+	for _, p := range mfmq.predicates {
+		p(selector)
+	}
+	for _, p := range mfmq.order {
+		p(selector)
+	}
+	if offset := mfmq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mfmq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MappedFieldMessageGroupBy is the group-by builder for MappedFieldMessage entities.
+type MappedFieldMessageGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mfmgb *MappedFieldMessageGroupBy) Aggregate(fns ...AggregateFunc) *MappedFieldMessageGroupBy {
+	mfmgb.fns = append(mfmgb.fns, fns...)
+	return mfmgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (mfmgb *MappedFieldMessageGroupBy) Scan(ctx context.Context, v any) error {
+	query, err := mfmgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	mfmgb.sql = query
+	return mfmgb.sqlScan(ctx, v)
+}
+
+func (mfmgb *MappedFieldMessageGroupBy) sqlScan(ctx context.Context, v any) error {
+	for _, f := range mfmgb.fields {
+		if !mappedfieldmessage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := mfmgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mfmgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (mfmgb *MappedFieldMessageGroupBy) sqlQuery() *sql.Selector {
+	selector := mfmgb.sql.Select()
+	aggregation := make([]string, 0, len(mfmgb.fns))
+	for _, fn := range mfmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(mfmgb.fields)+len(mfmgb.fns))
+		for _, f := range mfmgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(mfmgb.fields...)...)
+}
+
+// MappedFieldMessageSelect is the builder for selecting fields of MappedFieldMessage entities.
+type MappedFieldMessageSelect struct {
+	*MappedFieldMessageQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mfms *MappedFieldMessageSelect) Aggregate(fns ...AggregateFunc) *MappedFieldMessageSelect {
+	mfms.fns = append(mfms.fns, fns...)
+	return mfms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mfms *MappedFieldMessageSelect) Scan(ctx context.Context, v any) error {
+	if err := mfms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	mfms.sql = mfms.MappedFieldMessageQuery.sqlQuery(ctx)
+	return mfms.sqlScan(ctx, v)
+}
+
+func (mfms *MappedFieldMessageSelect) sqlScan(ctx context.Context, v any) error {
+	aggregation := make([]string, 0, len(mfms.fns))
+	for _, fn := range mfms.fns {
+		aggregation = append(aggregation, fn(mfms.sql))
+	}
+	switch n := len(*mfms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		mfms.sql.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		mfms.sql.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := mfms.sql.Query()
+	if err := mfms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
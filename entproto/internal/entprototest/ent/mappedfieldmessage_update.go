@@ -0,0 +1,316 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mappedfieldmessage"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/schema"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MappedFieldMessageUpdate is the builder for updating MappedFieldMessage entities.
+type MappedFieldMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MappedFieldMessageMutation
+}
+
+// Where appends a list predicates to the MappedFieldMessageUpdate builder.
+func (mfmu *MappedFieldMessageUpdate) Where(ps ...predicate.MappedFieldMessage) *MappedFieldMessageUpdate {
+	mfmu.mutation.Where(ps...)
+	return mfmu
+}
+
+// SetLocation sets the "location" field.
+func (mfmu *MappedFieldMessageUpdate) SetLocation(s schema.Coordinate) *MappedFieldMessageUpdate {
+	mfmu.mutation.SetLocation(s)
+	return mfmu
+}
+
+// SetPreviousLocation sets the "previous_location" field.
+func (mfmu *MappedFieldMessageUpdate) SetPreviousLocation(s schema.Coordinate) *MappedFieldMessageUpdate {
+	mfmu.mutation.SetPreviousLocation(s)
+	return mfmu
+}
+
+// SetNillablePreviousLocation sets the "previous_location" field if the given value is not nil.
+func (mfmu *MappedFieldMessageUpdate) SetNillablePreviousLocation(s *schema.Coordinate) *MappedFieldMessageUpdate {
+	if s != nil {
+		mfmu.SetPreviousLocation(*s)
+	}
+	return mfmu
+}
+
+// ClearPreviousLocation clears the value of the "previous_location" field.
+func (mfmu *MappedFieldMessageUpdate) ClearPreviousLocation() *MappedFieldMessageUpdate {
+	mfmu.mutation.ClearPreviousLocation()
+	return mfmu
+}
+
+// Mutation returns the MappedFieldMessageMutation object of the builder.
+func (mfmu *MappedFieldMessageUpdate) Mutation() *MappedFieldMessageMutation {
+	return mfmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mfmu *MappedFieldMessageUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(mfmu.hooks) == 0 {
+		affected, err = mfmu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MappedFieldMessageMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mfmu.mutation = mutation
+			affected, err = mfmu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(mfmu.hooks) - 1; i >= 0; i-- {
+			if mfmu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mfmu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mfmu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfmu *MappedFieldMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := mfmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mfmu *MappedFieldMessageUpdate) Exec(ctx context.Context) error {
+	_, err := mfmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfmu *MappedFieldMessageUpdate) ExecX(ctx context.Context) {
+	if err := mfmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mfmu *MappedFieldMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   mappedfieldmessage.Table,
+			Columns: mappedfieldmessage.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mappedfieldmessage.FieldID,
+			},
+		},
+	}
+	if ps := mfmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mfmu.mutation.Location(); ok {
+		_spec.SetField(mappedfieldmessage.FieldLocation, field.TypeOther, value)
+	}
+	if value, ok := mfmu.mutation.PreviousLocation(); ok {
+		_spec.SetField(mappedfieldmessage.FieldPreviousLocation, field.TypeOther, value)
+	}
+	if mfmu.mutation.PreviousLocationCleared() {
+		_spec.ClearField(mappedfieldmessage.FieldPreviousLocation, field.TypeOther)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mfmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mappedfieldmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// MappedFieldMessageUpdateOne is the builder for updating a single MappedFieldMessage entity.
+type MappedFieldMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MappedFieldMessageMutation
+}
+
+// SetLocation sets the "location" field.
+func (mfmuo *MappedFieldMessageUpdateOne) SetLocation(s schema.Coordinate) *MappedFieldMessageUpdateOne {
+	mfmuo.mutation.SetLocation(s)
+	return mfmuo
+}
+
+// SetPreviousLocation sets the "previous_location" field.
+func (mfmuo *MappedFieldMessageUpdateOne) SetPreviousLocation(s schema.Coordinate) *MappedFieldMessageUpdateOne {
+	mfmuo.mutation.SetPreviousLocation(s)
+	return mfmuo
+}
+
+// SetNillablePreviousLocation sets the "previous_location" field if the given value is not nil.
+func (mfmuo *MappedFieldMessageUpdateOne) SetNillablePreviousLocation(s *schema.Coordinate) *MappedFieldMessageUpdateOne {
+	if s != nil {
+		mfmuo.SetPreviousLocation(*s)
+	}
+	return mfmuo
+}
+
+// ClearPreviousLocation clears the value of the "previous_location" field.
+func (mfmuo *MappedFieldMessageUpdateOne) ClearPreviousLocation() *MappedFieldMessageUpdateOne {
+	mfmuo.mutation.ClearPreviousLocation()
+	return mfmuo
+}
+
+// Mutation returns the MappedFieldMessageMutation object of the builder.
+func (mfmuo *MappedFieldMessageUpdateOne) Mutation() *MappedFieldMessageMutation {
+	return mfmuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mfmuo *MappedFieldMessageUpdateOne) Select(field string, fields ...string) *MappedFieldMessageUpdateOne {
+	mfmuo.fields = append([]string{field}, fields...)
+	return mfmuo
+}
+
+// Save executes the query and returns the updated MappedFieldMessage entity.
+func (mfmuo *MappedFieldMessageUpdateOne) Save(ctx context.Context) (*MappedFieldMessage, error) {
+	var (
+		err  error
+		node *MappedFieldMessage
+	)
+	if len(mfmuo.hooks) == 0 {
+		node, err = mfmuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MappedFieldMessageMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mfmuo.mutation = mutation
+			node, err = mfmuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(mfmuo.hooks) - 1; i >= 0; i-- {
+			if mfmuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mfmuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, mfmuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*MappedFieldMessage)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from MappedFieldMessageMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfmuo *MappedFieldMessageUpdateOne) SaveX(ctx context.Context) *MappedFieldMessage {
+	node, err := mfmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mfmuo *MappedFieldMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := mfmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfmuo *MappedFieldMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := mfmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mfmuo *MappedFieldMessageUpdateOne) sqlSave(ctx context.Context) (_node *MappedFieldMessage, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   mappedfieldmessage.Table,
+			Columns: mappedfieldmessage.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mappedfieldmessage.FieldID,
+			},
+		},
+	}
+	id, ok := mfmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MappedFieldMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mfmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mappedfieldmessage.FieldID)
+		for _, f := range fields {
+			if !mappedfieldmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mappedfieldmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mfmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mfmuo.mutation.Location(); ok {
+		_spec.SetField(mappedfieldmessage.FieldLocation, field.TypeOther, value)
+	}
+	if value, ok := mfmuo.mutation.PreviousLocation(); ok {
+		_spec.SetField(mappedfieldmessage.FieldPreviousLocation, field.TypeOther, value)
+	}
+	if mfmuo.mutation.PreviousLocationCleared() {
+		_spec.ClearField(mappedfieldmessage.FieldPreviousLocation, field.TypeOther)
+	}
+	_node = &MappedFieldMessage{config: mfmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mfmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mappedfieldmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -19,6 +19,10 @@ type MessageWithEnum struct {
 	EnumType messagewithenum.EnumType `json:"enum_type,omitempty"`
 	// EnumWithoutDefault holds the value of the "enum_without_default" field.
 	EnumWithoutDefault messagewithenum.EnumWithoutDefault `json:"enum_without_default,omitempty"`
+	// EnumZeroMapped holds the value of the "enum_zero_mapped" field.
+	EnumZeroMapped messagewithenum.EnumZeroMapped `json:"enum_zero_mapped,omitempty"`
+	// EnumUnknown holds the value of the "enum_unknown" field.
+	EnumUnknown messagewithenum.EnumUnknown `json:"enum_unknown,omitempty"`
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -28,7 +32,7 @@ func (*MessageWithEnum) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case messagewithenum.FieldID:
 			values[i] = new(sql.NullInt64)
-		case messagewithenum.FieldEnumType, messagewithenum.FieldEnumWithoutDefault:
+		case messagewithenum.FieldEnumType, messagewithenum.FieldEnumWithoutDefault, messagewithenum.FieldEnumZeroMapped, messagewithenum.FieldEnumUnknown:
 			values[i] = new(sql.NullString)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type MessageWithEnum", columns[i])
@@ -63,6 +67,18 @@ func (mwe *MessageWithEnum) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				mwe.EnumWithoutDefault = messagewithenum.EnumWithoutDefault(value.String)
 			}
+		case messagewithenum.FieldEnumZeroMapped:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field enum_zero_mapped", values[i])
+			} else if value.Valid {
+				mwe.EnumZeroMapped = messagewithenum.EnumZeroMapped(value.String)
+			}
+		case messagewithenum.FieldEnumUnknown:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field enum_unknown", values[i])
+			} else if value.Valid {
+				mwe.EnumUnknown = messagewithenum.EnumUnknown(value.String)
+			}
 		}
 	}
 	return nil
@@ -96,6 +112,12 @@ func (mwe *MessageWithEnum) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("enum_without_default=")
 	builder.WriteString(fmt.Sprintf("%v", mwe.EnumWithoutDefault))
+	builder.WriteString(", ")
+	builder.WriteString("enum_zero_mapped=")
+	builder.WriteString(fmt.Sprintf("%v", mwe.EnumZeroMapped))
+	builder.WriteString(", ")
+	builder.WriteString("enum_unknown=")
+	builder.WriteString(fmt.Sprintf("%v", mwe.EnumUnknown))
 	builder.WriteByte(')')
 	return builder.String()
 }
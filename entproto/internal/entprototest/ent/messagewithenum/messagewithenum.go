@@ -15,6 +15,10 @@ const (
 	FieldEnumType = "enum_type"
 	// FieldEnumWithoutDefault holds the string denoting the enum_without_default field in the database.
 	FieldEnumWithoutDefault = "enum_without_default"
+	// FieldEnumZeroMapped holds the string denoting the enum_zero_mapped field in the database.
+	FieldEnumZeroMapped = "enum_zero_mapped"
+	// FieldEnumUnknown holds the string denoting the enum_unknown field in the database.
+	FieldEnumUnknown = "enum_unknown"
 	// Table holds the table name of the messagewithenum in the database.
 	Table = "message_with_enums"
 )
@@ -24,6 +28,8 @@ var Columns = []string{
 	FieldID,
 	FieldEnumType,
 	FieldEnumWithoutDefault,
+	FieldEnumZeroMapped,
+	FieldEnumUnknown,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -86,3 +92,48 @@ func EnumWithoutDefaultValidator(ewd EnumWithoutDefault) error {
 		return fmt.Errorf("messagewithenum: invalid enum value for enum_without_default field: %q", ewd)
 	}
 }
+
+// EnumZeroMapped defines the type for the "enum_zero_mapped" enum field.
+type EnumZeroMapped string
+
+// EnumZeroMapped values.
+const (
+	EnumZeroMappedLow  EnumZeroMapped = "low"
+	EnumZeroMappedHigh EnumZeroMapped = "high"
+)
+
+func (ezm EnumZeroMapped) String() string {
+	return string(ezm)
+}
+
+// EnumZeroMappedValidator is a validator for the "enum_zero_mapped" field enum values. It is called by the builders before save.
+func EnumZeroMappedValidator(ezm EnumZeroMapped) error {
+	switch ezm {
+	case EnumZeroMappedLow, EnumZeroMappedHigh:
+		return nil
+	default:
+		return fmt.Errorf("messagewithenum: invalid enum value for enum_zero_mapped field: %q", ezm)
+	}
+}
+
+// EnumUnknown defines the type for the "enum_unknown" enum field.
+type EnumUnknown string
+
+// EnumUnknown values.
+const (
+	EnumUnknownKnown EnumUnknown = "known"
+)
+
+func (eu EnumUnknown) String() string {
+	return string(eu)
+}
+
+// EnumUnknownValidator is a validator for the "enum_unknown" field enum values. It is called by the builders before save.
+func EnumUnknownValidator(eu EnumUnknown) error {
+	switch eu {
+	case EnumUnknownKnown:
+		return nil
+	default:
+		return fmt.Errorf("messagewithenum: invalid enum value for enum_unknown field: %q", eu)
+	}
+}
@@ -150,6 +150,78 @@ func EnumWithoutDefaultNotIn(vs ...EnumWithoutDefault) predicate.MessageWithEnum
 	})
 }
 
+// EnumZeroMappedEQ applies the EQ predicate on the "enum_zero_mapped" field.
+func EnumZeroMappedEQ(v EnumZeroMapped) predicate.MessageWithEnum {
+	return predicate.MessageWithEnum(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEnumZeroMapped), v))
+	})
+}
+
+// EnumZeroMappedNEQ applies the NEQ predicate on the "enum_zero_mapped" field.
+func EnumZeroMappedNEQ(v EnumZeroMapped) predicate.MessageWithEnum {
+	return predicate.MessageWithEnum(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEnumZeroMapped), v))
+	})
+}
+
+// EnumZeroMappedIn applies the In predicate on the "enum_zero_mapped" field.
+func EnumZeroMappedIn(vs ...EnumZeroMapped) predicate.MessageWithEnum {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MessageWithEnum(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldEnumZeroMapped), v...))
+	})
+}
+
+// EnumZeroMappedNotIn applies the NotIn predicate on the "enum_zero_mapped" field.
+func EnumZeroMappedNotIn(vs ...EnumZeroMapped) predicate.MessageWithEnum {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MessageWithEnum(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldEnumZeroMapped), v...))
+	})
+}
+
+// EnumUnknownEQ applies the EQ predicate on the "enum_unknown" field.
+func EnumUnknownEQ(v EnumUnknown) predicate.MessageWithEnum {
+	return predicate.MessageWithEnum(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEnumUnknown), v))
+	})
+}
+
+// EnumUnknownNEQ applies the NEQ predicate on the "enum_unknown" field.
+func EnumUnknownNEQ(v EnumUnknown) predicate.MessageWithEnum {
+	return predicate.MessageWithEnum(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEnumUnknown), v))
+	})
+}
+
+// EnumUnknownIn applies the In predicate on the "enum_unknown" field.
+func EnumUnknownIn(vs ...EnumUnknown) predicate.MessageWithEnum {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MessageWithEnum(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldEnumUnknown), v...))
+	})
+}
+
+// EnumUnknownNotIn applies the NotIn predicate on the "enum_unknown" field.
+func EnumUnknownNotIn(vs ...EnumUnknown) predicate.MessageWithEnum {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MessageWithEnum(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldEnumUnknown), v...))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.MessageWithEnum) predicate.MessageWithEnum {
 	return predicate.MessageWithEnum(func(s *sql.Selector) {
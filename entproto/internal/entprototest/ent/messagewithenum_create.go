@@ -39,6 +39,18 @@ func (mwec *MessageWithEnumCreate) SetEnumWithoutDefault(mwd messagewithenum.Enu
 	return mwec
 }
 
+// SetEnumZeroMapped sets the "enum_zero_mapped" field.
+func (mwec *MessageWithEnumCreate) SetEnumZeroMapped(mzm messagewithenum.EnumZeroMapped) *MessageWithEnumCreate {
+	mwec.mutation.SetEnumZeroMapped(mzm)
+	return mwec
+}
+
+// SetEnumUnknown sets the "enum_unknown" field.
+func (mwec *MessageWithEnumCreate) SetEnumUnknown(mu messagewithenum.EnumUnknown) *MessageWithEnumCreate {
+	mwec.mutation.SetEnumUnknown(mu)
+	return mwec
+}
+
 // Mutation returns the MessageWithEnumMutation object of the builder.
 func (mwec *MessageWithEnumCreate) Mutation() *MessageWithEnumMutation {
 	return mwec.mutation
@@ -140,6 +152,22 @@ func (mwec *MessageWithEnumCreate) check() error {
 			return &ValidationError{Name: "enum_without_default", err: fmt.Errorf(`ent: validator failed for field "MessageWithEnum.enum_without_default": %w`, err)}
 		}
 	}
+	if _, ok := mwec.mutation.EnumZeroMapped(); !ok {
+		return &ValidationError{Name: "enum_zero_mapped", err: errors.New(`ent: missing required field "MessageWithEnum.enum_zero_mapped"`)}
+	}
+	if v, ok := mwec.mutation.EnumZeroMapped(); ok {
+		if err := messagewithenum.EnumZeroMappedValidator(v); err != nil {
+			return &ValidationError{Name: "enum_zero_mapped", err: fmt.Errorf(`ent: validator failed for field "MessageWithEnum.enum_zero_mapped": %w`, err)}
+		}
+	}
+	if _, ok := mwec.mutation.EnumUnknown(); !ok {
+		return &ValidationError{Name: "enum_unknown", err: errors.New(`ent: missing required field "MessageWithEnum.enum_unknown"`)}
+	}
+	if v, ok := mwec.mutation.EnumUnknown(); ok {
+		if err := messagewithenum.EnumUnknownValidator(v); err != nil {
+			return &ValidationError{Name: "enum_unknown", err: fmt.Errorf(`ent: validator failed for field "MessageWithEnum.enum_unknown": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -175,6 +203,14 @@ func (mwec *MessageWithEnumCreate) createSpec() (*MessageWithEnum, *sqlgraph.Cre
 		_spec.SetField(messagewithenum.FieldEnumWithoutDefault, field.TypeEnum, value)
 		_node.EnumWithoutDefault = value
 	}
+	if value, ok := mwec.mutation.EnumZeroMapped(); ok {
+		_spec.SetField(messagewithenum.FieldEnumZeroMapped, field.TypeEnum, value)
+		_node.EnumZeroMapped = value
+	}
+	if value, ok := mwec.mutation.EnumUnknown(); ok {
+		_spec.SetField(messagewithenum.FieldEnumUnknown, field.TypeEnum, value)
+		_node.EnumUnknown = value
+	}
 	return _node, _spec
 }
 
@@ -47,6 +47,18 @@ func (mweu *MessageWithEnumUpdate) SetEnumWithoutDefault(mwd messagewithenum.Enu
 	return mweu
 }
 
+// SetEnumZeroMapped sets the "enum_zero_mapped" field.
+func (mweu *MessageWithEnumUpdate) SetEnumZeroMapped(mzm messagewithenum.EnumZeroMapped) *MessageWithEnumUpdate {
+	mweu.mutation.SetEnumZeroMapped(mzm)
+	return mweu
+}
+
+// SetEnumUnknown sets the "enum_unknown" field.
+func (mweu *MessageWithEnumUpdate) SetEnumUnknown(mu messagewithenum.EnumUnknown) *MessageWithEnumUpdate {
+	mweu.mutation.SetEnumUnknown(mu)
+	return mweu
+}
+
 // Mutation returns the MessageWithEnumMutation object of the builder.
 func (mweu *MessageWithEnumUpdate) Mutation() *MessageWithEnumMutation {
 	return mweu.mutation
@@ -124,6 +136,16 @@ func (mweu *MessageWithEnumUpdate) check() error {
 			return &ValidationError{Name: "enum_without_default", err: fmt.Errorf(`ent: validator failed for field "MessageWithEnum.enum_without_default": %w`, err)}
 		}
 	}
+	if v, ok := mweu.mutation.EnumZeroMapped(); ok {
+		if err := messagewithenum.EnumZeroMappedValidator(v); err != nil {
+			return &ValidationError{Name: "enum_zero_mapped", err: fmt.Errorf(`ent: validator failed for field "MessageWithEnum.enum_zero_mapped": %w`, err)}
+		}
+	}
+	if v, ok := mweu.mutation.EnumUnknown(); ok {
+		if err := messagewithenum.EnumUnknownValidator(v); err != nil {
+			return &ValidationError{Name: "enum_unknown", err: fmt.Errorf(`ent: validator failed for field "MessageWithEnum.enum_unknown": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -151,6 +173,12 @@ func (mweu *MessageWithEnumUpdate) sqlSave(ctx context.Context) (n int, err erro
 	if value, ok := mweu.mutation.EnumWithoutDefault(); ok {
 		_spec.SetField(messagewithenum.FieldEnumWithoutDefault, field.TypeEnum, value)
 	}
+	if value, ok := mweu.mutation.EnumZeroMapped(); ok {
+		_spec.SetField(messagewithenum.FieldEnumZeroMapped, field.TypeEnum, value)
+	}
+	if value, ok := mweu.mutation.EnumUnknown(); ok {
+		_spec.SetField(messagewithenum.FieldEnumUnknown, field.TypeEnum, value)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, mweu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{messagewithenum.Label}
@@ -190,6 +218,18 @@ func (mweuo *MessageWithEnumUpdateOne) SetEnumWithoutDefault(mwd messagewithenum
 	return mweuo
 }
 
+// SetEnumZeroMapped sets the "enum_zero_mapped" field.
+func (mweuo *MessageWithEnumUpdateOne) SetEnumZeroMapped(mzm messagewithenum.EnumZeroMapped) *MessageWithEnumUpdateOne {
+	mweuo.mutation.SetEnumZeroMapped(mzm)
+	return mweuo
+}
+
+// SetEnumUnknown sets the "enum_unknown" field.
+func (mweuo *MessageWithEnumUpdateOne) SetEnumUnknown(mu messagewithenum.EnumUnknown) *MessageWithEnumUpdateOne {
+	mweuo.mutation.SetEnumUnknown(mu)
+	return mweuo
+}
+
 // Mutation returns the MessageWithEnumMutation object of the builder.
 func (mweuo *MessageWithEnumUpdateOne) Mutation() *MessageWithEnumMutation {
 	return mweuo.mutation
@@ -280,6 +320,16 @@ func (mweuo *MessageWithEnumUpdateOne) check() error {
 			return &ValidationError{Name: "enum_without_default", err: fmt.Errorf(`ent: validator failed for field "MessageWithEnum.enum_without_default": %w`, err)}
 		}
 	}
+	if v, ok := mweuo.mutation.EnumZeroMapped(); ok {
+		if err := messagewithenum.EnumZeroMappedValidator(v); err != nil {
+			return &ValidationError{Name: "enum_zero_mapped", err: fmt.Errorf(`ent: validator failed for field "MessageWithEnum.enum_zero_mapped": %w`, err)}
+		}
+	}
+	if v, ok := mweuo.mutation.EnumUnknown(); ok {
+		if err := messagewithenum.EnumUnknownValidator(v); err != nil {
+			return &ValidationError{Name: "enum_unknown", err: fmt.Errorf(`ent: validator failed for field "MessageWithEnum.enum_unknown": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -324,6 +374,12 @@ func (mweuo *MessageWithEnumUpdateOne) sqlSave(ctx context.Context) (_node *Mess
 	if value, ok := mweuo.mutation.EnumWithoutDefault(); ok {
 		_spec.SetField(messagewithenum.FieldEnumWithoutDefault, field.TypeEnum, value)
 	}
+	if value, ok := mweuo.mutation.EnumZeroMapped(); ok {
+		_spec.SetField(messagewithenum.FieldEnumZeroMapped, field.TypeEnum, value)
+	}
+	if value, ok := mweuo.mutation.EnumUnknown(); ok {
+		_spec.SetField(messagewithenum.FieldEnumUnknown, field.TypeEnum, value)
+	}
 	_node = &MessageWithEnum{config: mweuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
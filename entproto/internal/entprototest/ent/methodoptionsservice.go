@@ -0,0 +1,87 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/methodoptionsservice"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MethodOptionsService is the model entity for the MethodOptionsService schema.
+type MethodOptionsService struct {
+	config
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MethodOptionsService) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case methodoptionsservice.FieldID:
+			values[i] = new(sql.NullInt64)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type MethodOptionsService", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MethodOptionsService fields.
+func (mos *MethodOptionsService) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case methodoptionsservice.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mos.ID = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this MethodOptionsService.
+// Note that you need to call MethodOptionsService.Unwrap() before calling this method if this MethodOptionsService
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mos *MethodOptionsService) Update() *MethodOptionsServiceUpdateOne {
+	return (&MethodOptionsServiceClient{config: mos.config}).UpdateOne(mos)
+}
+
+// Unwrap unwraps the MethodOptionsService entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mos *MethodOptionsService) Unwrap() *MethodOptionsService {
+	_tx, ok := mos.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MethodOptionsService is not a transactional entity")
+	}
+	mos.config.driver = _tx.drv
+	return mos
+}
+
+// String implements the fmt.Stringer.
+func (mos *MethodOptionsService) String() string {
+	var builder strings.Builder
+	builder.WriteString("MethodOptionsService(")
+	builder.WriteString(fmt.Sprintf("id=%v", mos.ID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MethodOptionsServices is a parsable slice of MethodOptionsService.
+type MethodOptionsServices []*MethodOptionsService
+
+func (mos MethodOptionsServices) config(cfg config) {
+	for _i := range mos {
+		mos[_i].config = cfg
+	}
+}
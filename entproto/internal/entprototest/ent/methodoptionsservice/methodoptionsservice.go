@@ -0,0 +1,27 @@
+// Code generated by ent, DO NOT EDIT.
+
+package methodoptionsservice
+
+const (
+	// Label holds the string label denoting the methodoptionsservice type in the database.
+	Label = "method_options_service"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// Table holds the table name of the methodoptionsservice in the database.
+	Table = "method_options_services"
+)
+
+// Columns holds all SQL columns for methodoptionsservice fields.
+var Columns = []string{
+	FieldID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
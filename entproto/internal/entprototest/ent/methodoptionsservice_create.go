@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/methodoptionsservice"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MethodOptionsServiceCreate is the builder for creating a MethodOptionsService entity.
+type MethodOptionsServiceCreate struct {
+	config
+	mutation *MethodOptionsServiceMutation
+	hooks    []Hook
+}
+
+// Mutation returns the MethodOptionsServiceMutation object of the builder.
+func (mosc *MethodOptionsServiceCreate) Mutation() *MethodOptionsServiceMutation {
+	return mosc.mutation
+}
+
+// Save creates the MethodOptionsService in the database.
+func (mosc *MethodOptionsServiceCreate) Save(ctx context.Context) (*MethodOptionsService, error) {
+	var (
+		err  error
+		node *MethodOptionsService
+	)
+	if len(mosc.hooks) == 0 {
+		if err = mosc.check(); err != nil {
+			return nil, err
+		}
+		node, err = mosc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MethodOptionsServiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = mosc.check(); err != nil {
+				return nil, err
+			}
+			mosc.mutation = mutation
+			if node, err = mosc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(mosc.hooks) - 1; i >= 0; i-- {
+			if mosc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mosc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, mosc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*MethodOptionsService)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from MethodOptionsServiceMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mosc *MethodOptionsServiceCreate) SaveX(ctx context.Context) *MethodOptionsService {
+	v, err := mosc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mosc *MethodOptionsServiceCreate) Exec(ctx context.Context) error {
+	_, err := mosc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mosc *MethodOptionsServiceCreate) ExecX(ctx context.Context) {
+	if err := mosc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mosc *MethodOptionsServiceCreate) check() error {
+	return nil
+}
+
+func (mosc *MethodOptionsServiceCreate) sqlSave(ctx context.Context) (*MethodOptionsService, error) {
+	_node, _spec := mosc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mosc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (mosc *MethodOptionsServiceCreate) createSpec() (*MethodOptionsService, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MethodOptionsService{config: mosc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: methodoptionsservice.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: methodoptionsservice.FieldID,
+			},
+		}
+	)
+	return _node, _spec
+}
+
+// MethodOptionsServiceCreateBulk is the builder for creating many MethodOptionsService entities in bulk.
+type MethodOptionsServiceCreateBulk struct {
+	config
+	builders []*MethodOptionsServiceCreate
+}
+
+// Save creates the MethodOptionsService entities in the database.
+func (moscb *MethodOptionsServiceCreateBulk) Save(ctx context.Context) ([]*MethodOptionsService, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(moscb.builders))
+	nodes := make([]*MethodOptionsService, len(moscb.builders))
+	mutators := make([]Mutator, len(moscb.builders))
+	for i := range moscb.builders {
+		func(i int, root context.Context) {
+			builder := moscb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MethodOptionsServiceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, moscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, moscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, moscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (moscb *MethodOptionsServiceCreateBulk) SaveX(ctx context.Context) []*MethodOptionsService {
+	v, err := moscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (moscb *MethodOptionsServiceCreateBulk) Exec(ctx context.Context) error {
+	_, err := moscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (moscb *MethodOptionsServiceCreateBulk) ExecX(ctx context.Context) {
+	if err := moscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/methodoptionsservice"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MethodOptionsServiceDelete is the builder for deleting a MethodOptionsService entity.
+type MethodOptionsServiceDelete struct {
+	config
+	hooks    []Hook
+	mutation *MethodOptionsServiceMutation
+}
+
+// Where appends a list predicates to the MethodOptionsServiceDelete builder.
+func (mosd *MethodOptionsServiceDelete) Where(ps ...predicate.MethodOptionsService) *MethodOptionsServiceDelete {
+	mosd.mutation.Where(ps...)
+	return mosd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mosd *MethodOptionsServiceDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(mosd.hooks) == 0 {
+		affected, err = mosd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MethodOptionsServiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mosd.mutation = mutation
+			affected, err = mosd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(mosd.hooks) - 1; i >= 0; i-- {
+			if mosd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mosd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mosd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mosd *MethodOptionsServiceDelete) ExecX(ctx context.Context) int {
+	n, err := mosd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mosd *MethodOptionsServiceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: methodoptionsservice.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: methodoptionsservice.FieldID,
+			},
+		},
+	}
+	if ps := mosd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mosd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// MethodOptionsServiceDeleteOne is the builder for deleting a single MethodOptionsService entity.
+type MethodOptionsServiceDeleteOne struct {
+	mosd *MethodOptionsServiceDelete
+}
+
+// Exec executes the deletion query.
+func (mosdo *MethodOptionsServiceDeleteOne) Exec(ctx context.Context) error {
+	n, err := mosdo.mosd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{methodoptionsservice.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mosdo *MethodOptionsServiceDeleteOne) ExecX(ctx context.Context) {
+	mosdo.mosd.ExecX(ctx)
+}
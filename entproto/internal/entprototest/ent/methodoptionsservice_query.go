@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/methodoptionsservice"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MethodOptionsServiceQuery is the builder for querying MethodOptionsService entities.
+type MethodOptionsServiceQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.MethodOptionsService
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MethodOptionsServiceQuery builder.
+func (mosq *MethodOptionsServiceQuery) Where(ps ...predicate.MethodOptionsService) *MethodOptionsServiceQuery {
+	mosq.predicates = append(mosq.predicates, ps...)
+	return mosq
+}
+
+// Limit adds a limit step to the query.
+func (mosq *MethodOptionsServiceQuery) Limit(limit int) *MethodOptionsServiceQuery {
+	mosq.limit = &limit
+	return mosq
+}
+
+// Offset adds an offset step to the query.
+func (mosq *MethodOptionsServiceQuery) Offset(offset int) *MethodOptionsServiceQuery {
+	mosq.offset = &offset
+	return mosq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mosq *MethodOptionsServiceQuery) Unique(unique bool) *MethodOptionsServiceQuery {
+	mosq.unique = &unique
+	return mosq
+}
+
+// Order adds an order step to the query.
+func (mosq *MethodOptionsServiceQuery) Order(o ...OrderFunc) *MethodOptionsServiceQuery {
+	mosq.order = append(mosq.order, o...)
+	return mosq
+}
+
+// First returns the first MethodOptionsService entity from the query.
+// Returns a *NotFoundError when no MethodOptionsService was found.
+func (mosq *MethodOptionsServiceQuery) First(ctx context.Context) (*MethodOptionsService, error) {
+	nodes, err := mosq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{methodoptionsservice.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mosq *MethodOptionsServiceQuery) FirstX(ctx context.Context) *MethodOptionsService {
+	node, err := mosq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MethodOptionsService ID from the query.
+// Returns a *NotFoundError when no MethodOptionsService ID was found.
+func (mosq *MethodOptionsServiceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mosq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{methodoptionsservice.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mosq *MethodOptionsServiceQuery) FirstIDX(ctx context.Context) int {
+	id, err := mosq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MethodOptionsService entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MethodOptionsService entity is found.
+// Returns a *NotFoundError when no MethodOptionsService entities are found.
+func (mosq *MethodOptionsServiceQuery) Only(ctx context.Context) (*MethodOptionsService, error) {
+	nodes, err := mosq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{methodoptionsservice.Label}
+	default:
+		return nil, &NotSingularError{methodoptionsservice.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mosq *MethodOptionsServiceQuery) OnlyX(ctx context.Context) *MethodOptionsService {
+	node, err := mosq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MethodOptionsService ID in the query.
+// Returns a *NotSingularError when more than one MethodOptionsService ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mosq *MethodOptionsServiceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mosq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{methodoptionsservice.Label}
+	default:
+		err = &NotSingularError{methodoptionsservice.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mosq *MethodOptionsServiceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mosq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MethodOptionsServices.
+func (mosq *MethodOptionsServiceQuery) All(ctx context.Context) ([]*MethodOptionsService, error) {
+	if err := mosq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return mosq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mosq *MethodOptionsServiceQuery) AllX(ctx context.Context) []*MethodOptionsService {
+	nodes, err := mosq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MethodOptionsService IDs.
+func (mosq *MethodOptionsServiceQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := mosq.Select(methodoptionsservice.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mosq *MethodOptionsServiceQuery) IDsX(ctx context.Context) []int {
+	ids, err := mosq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mosq *MethodOptionsServiceQuery) Count(ctx context.Context) (int, error) {
+	if err := mosq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return mosq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mosq *MethodOptionsServiceQuery) CountX(ctx context.Context) int {
+	count, err := mosq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mosq *MethodOptionsServiceQuery) Exist(ctx context.Context) (bool, error) {
+	if err := mosq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return mosq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mosq *MethodOptionsServiceQuery) ExistX(ctx context.Context) bool {
+	exist, err := mosq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MethodOptionsServiceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mosq *MethodOptionsServiceQuery) Clone() *MethodOptionsServiceQuery {
+	if mosq == nil {
+		return nil
+	}
+	return &MethodOptionsServiceQuery{
+		config:     mosq.config,
+		limit:      mosq.limit,
+		offset:     mosq.offset,
+		order:      append([]OrderFunc{}, mosq.order...),
+		predicates: append([]predicate.MethodOptionsService{}, mosq.predicates...),
+		// clone intermediate query.
+		sql:    mosq.sql.Clone(),
+		path:   mosq.path,
+		unique: mosq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+func (mosq *MethodOptionsServiceQuery) GroupBy(field string, fields ...string) *MethodOptionsServiceGroupBy {
+	grbuild := &MethodOptionsServiceGroupBy{config: mosq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := mosq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return mosq.sqlQuery(ctx), nil
+	}
+	grbuild.label = methodoptionsservice.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+func (mosq *MethodOptionsServiceQuery) Select(fields ...string) *MethodOptionsServiceSelect {
+	mosq.fields = append(mosq.fields, fields...)
+	selbuild := &MethodOptionsServiceSelect{MethodOptionsServiceQuery: mosq}
+	selbuild.label = methodoptionsservice.Label
+	selbuild.flds, selbuild.scan = &mosq.fields, selbuild.Scan
+	return selbuild
+}
+
+// Aggregate returns a MethodOptionsServiceSelect configured with the given aggregations.
+func (mosq *MethodOptionsServiceQuery) Aggregate(fns ...AggregateFunc) *MethodOptionsServiceSelect {
+	return mosq.Select().Aggregate(fns...)
+}
+
+func (mosq *MethodOptionsServiceQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range mosq.fields {
+		if !methodoptionsservice.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mosq.path != nil {
+		prev, err := mosq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mosq.sql = prev
+	}
+	return nil
+}
+
+func (mosq *MethodOptionsServiceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MethodOptionsService, error) {
+	var (
+		nodes = []*MethodOptionsService{}
+		_spec = mosq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MethodOptionsService).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MethodOptionsService{config: mosq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mosq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mosq *MethodOptionsServiceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mosq.querySpec()
+	_spec.Node.Columns = mosq.fields
+	if len(mosq.fields) > 0 {
+		_spec.Unique = mosq.unique != nil && *mosq.unique
+	}
+	return sqlgraph.CountNodes(ctx, mosq.driver, _spec)
+}
+
+func (mosq *MethodOptionsServiceQuery) sqlExist(ctx context.Context) (bool, error) {
+	switch _, err := mosq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+func (mosq *MethodOptionsServiceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   methodoptionsservice.Table,
+			Columns: methodoptionsservice.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: methodoptionsservice.FieldID,
+			},
+		},
+		From:   mosq.sql,
+		Unique: true,
+	}
+	if unique := mosq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := mosq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, methodoptionsservice.FieldID)
+		for i := range fields {
+			if fields[i] != methodoptionsservice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mosq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mosq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mosq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mosq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mosq *MethodOptionsServiceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mosq.driver.Dialect())
+	t1 := builder.Table(methodoptionsservice.Table)
+	columns := mosq.fields
+	if len(columns) == 0 {
+		columns = methodoptionsservice.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mosq.sql != nil {
+		selector = mosq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mosq.unique != nil && *mosq.unique {
+		selector.Distinct()
+	}
+	for _, p := range mosq.predicates {
+		p(selector)
+	}
+	for _, p := range mosq.order {
+		p(selector)
+	}
+	if offset := mosq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mosq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MethodOptionsServiceGroupBy is the group-by builder for MethodOptionsService entities.
+type MethodOptionsServiceGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mosgb *MethodOptionsServiceGroupBy) Aggregate(fns ...AggregateFunc) *MethodOptionsServiceGroupBy {
+	mosgb.fns = append(mosgb.fns, fns...)
+	return mosgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (mosgb *MethodOptionsServiceGroupBy) Scan(ctx context.Context, v any) error {
+	query, err := mosgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	mosgb.sql = query
+	return mosgb.sqlScan(ctx, v)
+}
+
+func (mosgb *MethodOptionsServiceGroupBy) sqlScan(ctx context.Context, v any) error {
+	for _, f := range mosgb.fields {
+		if !methodoptionsservice.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := mosgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mosgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (mosgb *MethodOptionsServiceGroupBy) sqlQuery() *sql.Selector {
+	selector := mosgb.sql.Select()
+	aggregation := make([]string, 0, len(mosgb.fns))
+	for _, fn := range mosgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(mosgb.fields)+len(mosgb.fns))
+		for _, f := range mosgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(mosgb.fields...)...)
+}
+
+// MethodOptionsServiceSelect is the builder for selecting fields of MethodOptionsService entities.
+type MethodOptionsServiceSelect struct {
+	*MethodOptionsServiceQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (moss *MethodOptionsServiceSelect) Aggregate(fns ...AggregateFunc) *MethodOptionsServiceSelect {
+	moss.fns = append(moss.fns, fns...)
+	return moss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (moss *MethodOptionsServiceSelect) Scan(ctx context.Context, v any) error {
+	if err := moss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	moss.sql = moss.MethodOptionsServiceQuery.sqlQuery(ctx)
+	return moss.sqlScan(ctx, v)
+}
+
+func (moss *MethodOptionsServiceSelect) sqlScan(ctx context.Context, v any) error {
+	aggregation := make([]string, 0, len(moss.fns))
+	for _, fn := range moss.fns {
+		aggregation = append(aggregation, fn(moss.sql))
+	}
+	switch n := len(*moss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		moss.sql.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		moss.sql.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := moss.sql.Query()
+	if err := moss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/methodoptionsservice"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MethodOptionsServiceUpdate is the builder for updating MethodOptionsService entities.
+type MethodOptionsServiceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MethodOptionsServiceMutation
+}
+
+// Where appends a list predicates to the MethodOptionsServiceUpdate builder.
+func (mosu *MethodOptionsServiceUpdate) Where(ps ...predicate.MethodOptionsService) *MethodOptionsServiceUpdate {
+	mosu.mutation.Where(ps...)
+	return mosu
+}
+
+// Mutation returns the MethodOptionsServiceMutation object of the builder.
+func (mosu *MethodOptionsServiceUpdate) Mutation() *MethodOptionsServiceMutation {
+	return mosu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mosu *MethodOptionsServiceUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(mosu.hooks) == 0 {
+		affected, err = mosu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MethodOptionsServiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mosu.mutation = mutation
+			affected, err = mosu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(mosu.hooks) - 1; i >= 0; i-- {
+			if mosu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mosu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mosu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mosu *MethodOptionsServiceUpdate) SaveX(ctx context.Context) int {
+	affected, err := mosu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mosu *MethodOptionsServiceUpdate) Exec(ctx context.Context) error {
+	_, err := mosu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mosu *MethodOptionsServiceUpdate) ExecX(ctx context.Context) {
+	if err := mosu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mosu *MethodOptionsServiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   methodoptionsservice.Table,
+			Columns: methodoptionsservice.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: methodoptionsservice.FieldID,
+			},
+		},
+	}
+	if ps := mosu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mosu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{methodoptionsservice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// MethodOptionsServiceUpdateOne is the builder for updating a single MethodOptionsService entity.
+type MethodOptionsServiceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MethodOptionsServiceMutation
+}
+
+// Mutation returns the MethodOptionsServiceMutation object of the builder.
+func (mosuo *MethodOptionsServiceUpdateOne) Mutation() *MethodOptionsServiceMutation {
+	return mosuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mosuo *MethodOptionsServiceUpdateOne) Select(field string, fields ...string) *MethodOptionsServiceUpdateOne {
+	mosuo.fields = append([]string{field}, fields...)
+	return mosuo
+}
+
+// Save executes the query and returns the updated MethodOptionsService entity.
+func (mosuo *MethodOptionsServiceUpdateOne) Save(ctx context.Context) (*MethodOptionsService, error) {
+	var (
+		err  error
+		node *MethodOptionsService
+	)
+	if len(mosuo.hooks) == 0 {
+		node, err = mosuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MethodOptionsServiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mosuo.mutation = mutation
+			node, err = mosuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(mosuo.hooks) - 1; i >= 0; i-- {
+			if mosuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mosuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, mosuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*MethodOptionsService)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from MethodOptionsServiceMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mosuo *MethodOptionsServiceUpdateOne) SaveX(ctx context.Context) *MethodOptionsService {
+	node, err := mosuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mosuo *MethodOptionsServiceUpdateOne) Exec(ctx context.Context) error {
+	_, err := mosuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mosuo *MethodOptionsServiceUpdateOne) ExecX(ctx context.Context) {
+	if err := mosuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mosuo *MethodOptionsServiceUpdateOne) sqlSave(ctx context.Context) (_node *MethodOptionsService, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   methodoptionsservice.Table,
+			Columns: methodoptionsservice.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: methodoptionsservice.FieldID,
+			},
+		},
+	}
+	id, ok := mosuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MethodOptionsService.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mosuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, methodoptionsservice.FieldID)
+		for _, f := range fields {
+			if !methodoptionsservice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != methodoptionsservice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mosuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &MethodOptionsService{config: mosuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mosuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{methodoptionsservice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -18,6 +18,20 @@ var (
 		Columns:    AllMethodsServicesColumns,
 		PrimaryKey: []*schema.Column{AllMethodsServicesColumns[0]},
 	}
+	// AutoFieldNumbersColumns holds the columns for the "auto_field_numbers" table.
+	AutoFieldNumbersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "first", Type: field.TypeString},
+		{Name: "pinned", Type: field.TypeString},
+		{Name: "skipped", Type: field.TypeString},
+		{Name: "second", Type: field.TypeBool},
+	}
+	// AutoFieldNumbersTable holds the schema information for the "auto_field_numbers" table.
+	AutoFieldNumbersTable = &schema.Table{
+		Name:       "auto_field_numbers",
+		Columns:    AutoFieldNumbersColumns,
+		PrimaryKey: []*schema.Column{AutoFieldNumbersColumns[0]},
+	}
 	// BlogPostsColumns holds the columns for the "blog_posts" table.
 	BlogPostsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -85,6 +99,16 @@ var (
 		Columns:    ExplicitSkippedMessagesColumns,
 		PrimaryKey: []*schema.Column{ExplicitSkippedMessagesColumns[0]},
 	}
+	// ExtraMethodServicesColumns holds the columns for the "extra_method_services" table.
+	ExtraMethodServicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+	}
+	// ExtraMethodServicesTable holds the schema information for the "extra_method_services" table.
+	ExtraMethodServicesTable = &schema.Table{
+		Name:       "extra_method_services",
+		Columns:    ExtraMethodServicesColumns,
+		PrimaryKey: []*schema.Column{ExtraMethodServicesColumns[0]},
+	}
 	// ImagesColumns holds the columns for the "images" table.
 	ImagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -135,11 +159,25 @@ var (
 		Columns:    InvalidFieldMessagesColumns,
 		PrimaryKey: []*schema.Column{InvalidFieldMessagesColumns[0]},
 	}
+	// MappedFieldMessagesColumns holds the columns for the "mapped_field_messages" table.
+	MappedFieldMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "location", Type: field.TypeOther, SchemaType: map[string]string{"sqlite3": "varchar"}},
+		{Name: "previous_location", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"sqlite3": "varchar"}},
+	}
+	// MappedFieldMessagesTable holds the schema information for the "mapped_field_messages" table.
+	MappedFieldMessagesTable = &schema.Table{
+		Name:       "mapped_field_messages",
+		Columns:    MappedFieldMessagesColumns,
+		PrimaryKey: []*schema.Column{MappedFieldMessagesColumns[0]},
+	}
 	// MessageWithEnumsColumns holds the columns for the "message_with_enums" table.
 	MessageWithEnumsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "enum_type", Type: field.TypeEnum, Enums: []string{"pending", "active", "suspended", "deleted"}, Default: "pending"},
 		{Name: "enum_without_default", Type: field.TypeEnum, Enums: []string{"first", "second"}},
+		{Name: "enum_zero_mapped", Type: field.TypeEnum, Enums: []string{"low", "high"}},
+		{Name: "enum_unknown", Type: field.TypeEnum, Enums: []string{"known"}},
 	}
 	// MessageWithEnumsTable holds the schema information for the "message_with_enums" table.
 	MessageWithEnumsTable = &schema.Table{
@@ -208,6 +246,53 @@ var (
 		Columns:    MessageWithStringsColumns,
 		PrimaryKey: []*schema.Column{MessageWithStringsColumns[0]},
 	}
+	// MethodOptionsServicesColumns holds the columns for the "method_options_services" table.
+	MethodOptionsServicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+	}
+	// MethodOptionsServicesTable holds the schema information for the "method_options_services" table.
+	MethodOptionsServicesTable = &schema.Table{
+		Name:       "method_options_services",
+		Columns:    MethodOptionsServicesColumns,
+		PrimaryKey: []*schema.Column{MethodOptionsServicesColumns[0]},
+	}
+	// MixinAnnotatedsColumns holds the columns for the "mixin_annotateds" table.
+	MixinAnnotatedsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "state", Type: field.TypeEnum, Enums: []string{"on", "off"}},
+		{Name: "internal", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+	}
+	// MixinAnnotatedsTable holds the schema information for the "mixin_annotateds" table.
+	MixinAnnotatedsTable = &schema.Table{
+		Name:       "mixin_annotateds",
+		Columns:    MixinAnnotatedsColumns,
+		PrimaryKey: []*schema.Column{MixinAnnotatedsColumns[0]},
+	}
+	// MixinCollisionsColumns holds the columns for the "mixin_collisions" table.
+	MixinCollisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+	}
+	// MixinCollisionsTable holds the schema information for the "mixin_collisions" table.
+	MixinCollisionsTable = &schema.Table{
+		Name:       "mixin_collisions",
+		Columns:    MixinCollisionsColumns,
+		PrimaryKey: []*schema.Column{MixinCollisionsColumns[0]},
+	}
+	// MixinServiceOverridesColumns holds the columns for the "mixin_service_overrides" table.
+	MixinServiceOverridesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "state", Type: field.TypeEnum, Enums: []string{"on", "off"}},
+		{Name: "internal", Type: field.TypeString},
+	}
+	// MixinServiceOverridesTable holds the schema information for the "mixin_service_overrides" table.
+	MixinServiceOverridesTable = &schema.Table{
+		Name:       "mixin_service_overrides",
+		Columns:    MixinServiceOverridesColumns,
+		PrimaryKey: []*schema.Column{MixinServiceOverridesColumns[0]},
+	}
 	// NoBackrefsColumns holds the columns for the "no_backrefs" table.
 	NoBackrefsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -249,6 +334,40 @@ var (
 			},
 		},
 	}
+	// SharedEnumMessagesColumns holds the columns for the "shared_enum_messages" table.
+	SharedEnumMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "color", Type: field.TypeEnum, Enums: []string{"red", "green"}},
+	}
+	// SharedEnumMessagesTable holds the schema information for the "shared_enum_messages" table.
+	SharedEnumMessagesTable = &schema.Table{
+		Name:       "shared_enum_messages",
+		Columns:    SharedEnumMessagesColumns,
+		PrimaryKey: []*schema.Column{SharedEnumMessagesColumns[0]},
+	}
+	// SharedEnumOtherMessagesColumns holds the columns for the "shared_enum_other_messages" table.
+	SharedEnumOtherMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "color", Type: field.TypeEnum, Enums: []string{"red", "green"}},
+		{Name: "background", Type: field.TypeEnum, Enums: []string{"red", "green"}},
+	}
+	// SharedEnumOtherMessagesTable holds the schema information for the "shared_enum_other_messages" table.
+	SharedEnumOtherMessagesTable = &schema.Table{
+		Name:       "shared_enum_other_messages",
+		Columns:    SharedEnumOtherMessagesColumns,
+		PrimaryKey: []*schema.Column{SharedEnumOtherMessagesColumns[0]},
+	}
+	// SharedEnumVariantsColumns holds the columns for the "shared_enum_variants" table.
+	SharedEnumVariantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "color", Type: field.TypeEnum, Enums: []string{"red", "blue"}},
+	}
+	// SharedEnumVariantsTable holds the schema information for the "shared_enum_variants" table.
+	SharedEnumVariantsTable = &schema.Table{
+		Name:       "shared_enum_variants",
+		Columns:    SharedEnumVariantsColumns,
+		PrimaryKey: []*schema.Column{SharedEnumVariantsColumns[0]},
+	}
 	// SkipEdgeExamplesColumns holds the columns for the "skip_edge_examples" table.
 	SkipEdgeExamplesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -343,23 +462,33 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AllMethodsServicesTable,
+		AutoFieldNumbersTable,
 		BlogPostsTable,
 		CategoriesTable,
 		DependsOnSkippedsTable,
 		DuplicateNumberMessagesTable,
 		ExplicitSkippedMessagesTable,
+		ExtraMethodServicesTable,
 		ImagesTable,
 		ImplicitSkippedMessagesTable,
 		InvalidFieldMessagesTable,
+		MappedFieldMessagesTable,
 		MessageWithEnumsTable,
 		MessageWithFieldOnesTable,
 		MessageWithIdsTable,
 		MessageWithOptionalsTable,
 		MessageWithPackageNamesTable,
 		MessageWithStringsTable,
+		MethodOptionsServicesTable,
+		MixinAnnotatedsTable,
+		MixinCollisionsTable,
+		MixinServiceOverridesTable,
 		NoBackrefsTable,
 		OneMethodServicesTable,
 		PortalsTable,
+		SharedEnumMessagesTable,
+		SharedEnumOtherMessagesTable,
+		SharedEnumVariantsTable,
 		SkipEdgeExamplesTable,
 		TwoMethodServicesTable,
 		UsersTable,
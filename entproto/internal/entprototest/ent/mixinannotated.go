@@ -0,0 +1,121 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixinannotated"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MixinAnnotated is the model entity for the MixinAnnotated schema.
+type MixinAnnotated struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// State holds the value of the "state" field.
+	State mixinannotated.State `json:"state,omitempty"`
+	// Internal holds the value of the "internal" field.
+	Internal string `json:"internal,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MixinAnnotated) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mixinannotated.FieldID:
+			values[i] = new(sql.NullInt64)
+		case mixinannotated.FieldState, mixinannotated.FieldInternal, mixinannotated.FieldName:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type MixinAnnotated", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MixinAnnotated fields.
+func (ma *MixinAnnotated) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mixinannotated.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ma.ID = int(value.Int64)
+		case mixinannotated.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				ma.State = mixinannotated.State(value.String)
+			}
+		case mixinannotated.FieldInternal:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internal", values[i])
+			} else if value.Valid {
+				ma.Internal = value.String
+			}
+		case mixinannotated.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ma.Name = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this MixinAnnotated.
+// Note that you need to call MixinAnnotated.Unwrap() before calling this method if this MixinAnnotated
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ma *MixinAnnotated) Update() *MixinAnnotatedUpdateOne {
+	return (&MixinAnnotatedClient{config: ma.config}).UpdateOne(ma)
+}
+
+// Unwrap unwraps the MixinAnnotated entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ma *MixinAnnotated) Unwrap() *MixinAnnotated {
+	_tx, ok := ma.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MixinAnnotated is not a transactional entity")
+	}
+	ma.config.driver = _tx.drv
+	return ma
+}
+
+// String implements the fmt.Stringer.
+func (ma *MixinAnnotated) String() string {
+	var builder strings.Builder
+	builder.WriteString("MixinAnnotated(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ma.ID))
+	builder.WriteString("state=")
+	builder.WriteString(fmt.Sprintf("%v", ma.State))
+	builder.WriteString(", ")
+	builder.WriteString("internal=")
+	builder.WriteString(ma.Internal)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ma.Name)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MixinAnnotateds is a parsable slice of MixinAnnotated.
+type MixinAnnotateds []*MixinAnnotated
+
+func (ma MixinAnnotateds) config(cfg config) {
+	for _i := range ma {
+		ma[_i].config = cfg
+	}
+}
@@ -0,0 +1,63 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mixinannotated
+
+import (
+	"fmt"
+)
+
+const (
+	// Label holds the string label denoting the mixinannotated type in the database.
+	Label = "mixin_annotated"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldInternal holds the string denoting the internal field in the database.
+	FieldInternal = "internal"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// Table holds the table name of the mixinannotated in the database.
+	Table = "mixin_annotateds"
+)
+
+// Columns holds all SQL columns for mixinannotated fields.
+var Columns = []string{
+	FieldID,
+	FieldState,
+	FieldInternal,
+	FieldName,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// State defines the type for the "state" enum field.
+type State string
+
+// State values.
+const (
+	StateOn  State = "on"
+	StateOff State = "off"
+)
+
+func (s State) String() string {
+	return string(s)
+}
+
+// StateValidator is a validator for the "state" field enum values. It is called by the builders before save.
+func StateValidator(s State) error {
+	switch s {
+	case StateOn, StateOff:
+		return nil
+	default:
+		return fmt.Errorf("mixinannotated: invalid enum value for state field: %q", s)
+	}
+}
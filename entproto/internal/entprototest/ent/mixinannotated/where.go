@@ -0,0 +1,359 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mixinannotated
+
+import (
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Internal applies equality check predicate on the "internal" field. It's identical to InternalEQ.
+func Internal(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInternal), v))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v State) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldState), v))
+	})
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v State) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldState), v))
+	})
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...State) predicate.MixinAnnotated {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldState), v...))
+	})
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...State) predicate.MixinAnnotated {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldState), v...))
+	})
+}
+
+// InternalEQ applies the EQ predicate on the "internal" field.
+func InternalEQ(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInternal), v))
+	})
+}
+
+// InternalNEQ applies the NEQ predicate on the "internal" field.
+func InternalNEQ(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldInternal), v))
+	})
+}
+
+// InternalIn applies the In predicate on the "internal" field.
+func InternalIn(vs ...string) predicate.MixinAnnotated {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldInternal), v...))
+	})
+}
+
+// InternalNotIn applies the NotIn predicate on the "internal" field.
+func InternalNotIn(vs ...string) predicate.MixinAnnotated {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldInternal), v...))
+	})
+}
+
+// InternalGT applies the GT predicate on the "internal" field.
+func InternalGT(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldInternal), v))
+	})
+}
+
+// InternalGTE applies the GTE predicate on the "internal" field.
+func InternalGTE(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldInternal), v))
+	})
+}
+
+// InternalLT applies the LT predicate on the "internal" field.
+func InternalLT(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldInternal), v))
+	})
+}
+
+// InternalLTE applies the LTE predicate on the "internal" field.
+func InternalLTE(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldInternal), v))
+	})
+}
+
+// InternalContains applies the Contains predicate on the "internal" field.
+func InternalContains(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldInternal), v))
+	})
+}
+
+// InternalHasPrefix applies the HasPrefix predicate on the "internal" field.
+func InternalHasPrefix(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldInternal), v))
+	})
+}
+
+// InternalHasSuffix applies the HasSuffix predicate on the "internal" field.
+func InternalHasSuffix(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldInternal), v))
+	})
+}
+
+// InternalEqualFold applies the EqualFold predicate on the "internal" field.
+func InternalEqualFold(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldInternal), v))
+	})
+}
+
+// InternalContainsFold applies the ContainsFold predicate on the "internal" field.
+func InternalContainsFold(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldInternal), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.MixinAnnotated {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.MixinAnnotated {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MixinAnnotated) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MixinAnnotated) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MixinAnnotated) predicate.MixinAnnotated {
+	return predicate.MixinAnnotated(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
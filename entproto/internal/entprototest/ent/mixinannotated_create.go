@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixinannotated"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MixinAnnotatedCreate is the builder for creating a MixinAnnotated entity.
+type MixinAnnotatedCreate struct {
+	config
+	mutation *MixinAnnotatedMutation
+	hooks    []Hook
+}
+
+// SetState sets the "state" field.
+func (mac *MixinAnnotatedCreate) SetState(m mixinannotated.State) *MixinAnnotatedCreate {
+	mac.mutation.SetState(m)
+	return mac
+}
+
+// SetInternal sets the "internal" field.
+func (mac *MixinAnnotatedCreate) SetInternal(s string) *MixinAnnotatedCreate {
+	mac.mutation.SetInternal(s)
+	return mac
+}
+
+// SetName sets the "name" field.
+func (mac *MixinAnnotatedCreate) SetName(s string) *MixinAnnotatedCreate {
+	mac.mutation.SetName(s)
+	return mac
+}
+
+// Mutation returns the MixinAnnotatedMutation object of the builder.
+func (mac *MixinAnnotatedCreate) Mutation() *MixinAnnotatedMutation {
+	return mac.mutation
+}
+
+// Save creates the MixinAnnotated in the database.
+func (mac *MixinAnnotatedCreate) Save(ctx context.Context) (*MixinAnnotated, error) {
+	var (
+		err  error
+		node *MixinAnnotated
+	)
+	if len(mac.hooks) == 0 {
+		if err = mac.check(); err != nil {
+			return nil, err
+		}
+		node, err = mac.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MixinAnnotatedMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = mac.check(); err != nil {
+				return nil, err
+			}
+			mac.mutation = mutation
+			if node, err = mac.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(mac.hooks) - 1; i >= 0; i-- {
+			if mac.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mac.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, mac.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*MixinAnnotated)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from MixinAnnotatedMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mac *MixinAnnotatedCreate) SaveX(ctx context.Context) *MixinAnnotated {
+	v, err := mac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mac *MixinAnnotatedCreate) Exec(ctx context.Context) error {
+	_, err := mac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mac *MixinAnnotatedCreate) ExecX(ctx context.Context) {
+	if err := mac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mac *MixinAnnotatedCreate) check() error {
+	if _, ok := mac.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "MixinAnnotated.state"`)}
+	}
+	if v, ok := mac.mutation.State(); ok {
+		if err := mixinannotated.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "MixinAnnotated.state": %w`, err)}
+		}
+	}
+	if _, ok := mac.mutation.Internal(); !ok {
+		return &ValidationError{Name: "internal", err: errors.New(`ent: missing required field "MixinAnnotated.internal"`)}
+	}
+	if _, ok := mac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "MixinAnnotated.name"`)}
+	}
+	return nil
+}
+
+func (mac *MixinAnnotatedCreate) sqlSave(ctx context.Context) (*MixinAnnotated, error) {
+	_node, _spec := mac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (mac *MixinAnnotatedCreate) createSpec() (*MixinAnnotated, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MixinAnnotated{config: mac.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: mixinannotated.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mixinannotated.FieldID,
+			},
+		}
+	)
+	if value, ok := mac.mutation.State(); ok {
+		_spec.SetField(mixinannotated.FieldState, field.TypeEnum, value)
+		_node.State = value
+	}
+	if value, ok := mac.mutation.Internal(); ok {
+		_spec.SetField(mixinannotated.FieldInternal, field.TypeString, value)
+		_node.Internal = value
+	}
+	if value, ok := mac.mutation.Name(); ok {
+		_spec.SetField(mixinannotated.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	return _node, _spec
+}
+
+// MixinAnnotatedCreateBulk is the builder for creating many MixinAnnotated entities in bulk.
+type MixinAnnotatedCreateBulk struct {
+	config
+	builders []*MixinAnnotatedCreate
+}
+
+// Save creates the MixinAnnotated entities in the database.
+func (macb *MixinAnnotatedCreateBulk) Save(ctx context.Context) ([]*MixinAnnotated, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(macb.builders))
+	nodes := make([]*MixinAnnotated, len(macb.builders))
+	mutators := make([]Mutator, len(macb.builders))
+	for i := range macb.builders {
+		func(i int, root context.Context) {
+			builder := macb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MixinAnnotatedMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, macb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, macb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, macb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (macb *MixinAnnotatedCreateBulk) SaveX(ctx context.Context) []*MixinAnnotated {
+	v, err := macb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (macb *MixinAnnotatedCreateBulk) Exec(ctx context.Context) error {
+	_, err := macb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (macb *MixinAnnotatedCreateBulk) ExecX(ctx context.Context) {
+	if err := macb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
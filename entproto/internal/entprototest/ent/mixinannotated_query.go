@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixinannotated"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MixinAnnotatedQuery is the builder for querying MixinAnnotated entities.
+type MixinAnnotatedQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.MixinAnnotated
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MixinAnnotatedQuery builder.
+func (maq *MixinAnnotatedQuery) Where(ps ...predicate.MixinAnnotated) *MixinAnnotatedQuery {
+	maq.predicates = append(maq.predicates, ps...)
+	return maq
+}
+
+// Limit adds a limit step to the query.
+func (maq *MixinAnnotatedQuery) Limit(limit int) *MixinAnnotatedQuery {
+	maq.limit = &limit
+	return maq
+}
+
+// Offset adds an offset step to the query.
+func (maq *MixinAnnotatedQuery) Offset(offset int) *MixinAnnotatedQuery {
+	maq.offset = &offset
+	return maq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (maq *MixinAnnotatedQuery) Unique(unique bool) *MixinAnnotatedQuery {
+	maq.unique = &unique
+	return maq
+}
+
+// Order adds an order step to the query.
+func (maq *MixinAnnotatedQuery) Order(o ...OrderFunc) *MixinAnnotatedQuery {
+	maq.order = append(maq.order, o...)
+	return maq
+}
+
+// First returns the first MixinAnnotated entity from the query.
+// Returns a *NotFoundError when no MixinAnnotated was found.
+func (maq *MixinAnnotatedQuery) First(ctx context.Context) (*MixinAnnotated, error) {
+	nodes, err := maq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{mixinannotated.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (maq *MixinAnnotatedQuery) FirstX(ctx context.Context) *MixinAnnotated {
+	node, err := maq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MixinAnnotated ID from the query.
+// Returns a *NotFoundError when no MixinAnnotated ID was found.
+func (maq *MixinAnnotatedQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = maq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{mixinannotated.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (maq *MixinAnnotatedQuery) FirstIDX(ctx context.Context) int {
+	id, err := maq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MixinAnnotated entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MixinAnnotated entity is found.
+// Returns a *NotFoundError when no MixinAnnotated entities are found.
+func (maq *MixinAnnotatedQuery) Only(ctx context.Context) (*MixinAnnotated, error) {
+	nodes, err := maq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{mixinannotated.Label}
+	default:
+		return nil, &NotSingularError{mixinannotated.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (maq *MixinAnnotatedQuery) OnlyX(ctx context.Context) *MixinAnnotated {
+	node, err := maq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MixinAnnotated ID in the query.
+// Returns a *NotSingularError when more than one MixinAnnotated ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (maq *MixinAnnotatedQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = maq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{mixinannotated.Label}
+	default:
+		err = &NotSingularError{mixinannotated.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (maq *MixinAnnotatedQuery) OnlyIDX(ctx context.Context) int {
+	id, err := maq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MixinAnnotateds.
+func (maq *MixinAnnotatedQuery) All(ctx context.Context) ([]*MixinAnnotated, error) {
+	if err := maq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return maq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (maq *MixinAnnotatedQuery) AllX(ctx context.Context) []*MixinAnnotated {
+	nodes, err := maq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MixinAnnotated IDs.
+func (maq *MixinAnnotatedQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := maq.Select(mixinannotated.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (maq *MixinAnnotatedQuery) IDsX(ctx context.Context) []int {
+	ids, err := maq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (maq *MixinAnnotatedQuery) Count(ctx context.Context) (int, error) {
+	if err := maq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return maq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (maq *MixinAnnotatedQuery) CountX(ctx context.Context) int {
+	count, err := maq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (maq *MixinAnnotatedQuery) Exist(ctx context.Context) (bool, error) {
+	if err := maq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return maq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (maq *MixinAnnotatedQuery) ExistX(ctx context.Context) bool {
+	exist, err := maq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MixinAnnotatedQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (maq *MixinAnnotatedQuery) Clone() *MixinAnnotatedQuery {
+	if maq == nil {
+		return nil
+	}
+	return &MixinAnnotatedQuery{
+		config:     maq.config,
+		limit:      maq.limit,
+		offset:     maq.offset,
+		order:      append([]OrderFunc{}, maq.order...),
+		predicates: append([]predicate.MixinAnnotated{}, maq.predicates...),
+		// clone intermediate query.
+		sql:    maq.sql.Clone(),
+		path:   maq.path,
+		unique: maq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		State mixinannotated.State `json:"state,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MixinAnnotated.Query().
+//		GroupBy(mixinannotated.FieldState).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (maq *MixinAnnotatedQuery) GroupBy(field string, fields ...string) *MixinAnnotatedGroupBy {
+	grbuild := &MixinAnnotatedGroupBy{config: maq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := maq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return maq.sqlQuery(ctx), nil
+	}
+	grbuild.label = mixinannotated.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		State mixinannotated.State `json:"state,omitempty"`
+//	}
+//
+//	client.MixinAnnotated.Query().
+//		Select(mixinannotated.FieldState).
+//		Scan(ctx, &v)
+func (maq *MixinAnnotatedQuery) Select(fields ...string) *MixinAnnotatedSelect {
+	maq.fields = append(maq.fields, fields...)
+	selbuild := &MixinAnnotatedSelect{MixinAnnotatedQuery: maq}
+	selbuild.label = mixinannotated.Label
+	selbuild.flds, selbuild.scan = &maq.fields, selbuild.Scan
+	return selbuild
+}
+
+// Aggregate returns a MixinAnnotatedSelect configured with the given aggregations.
+func (maq *MixinAnnotatedQuery) Aggregate(fns ...AggregateFunc) *MixinAnnotatedSelect {
+	return maq.Select().Aggregate(fns...)
+}
+
+func (maq *MixinAnnotatedQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range maq.fields {
+		if !mixinannotated.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if maq.path != nil {
+		prev, err := maq.path(ctx)
+		if err != nil {
+			return err
+		}
+		maq.sql = prev
+	}
+	return nil
+}
+
+func (maq *MixinAnnotatedQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MixinAnnotated, error) {
+	var (
+		nodes = []*MixinAnnotated{}
+		_spec = maq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MixinAnnotated).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MixinAnnotated{config: maq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, maq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (maq *MixinAnnotatedQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := maq.querySpec()
+	_spec.Node.Columns = maq.fields
+	if len(maq.fields) > 0 {
+		_spec.Unique = maq.unique != nil && *maq.unique
+	}
+	return sqlgraph.CountNodes(ctx, maq.driver, _spec)
+}
+
+func (maq *MixinAnnotatedQuery) sqlExist(ctx context.Context) (bool, error) {
+	switch _, err := maq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+func (maq *MixinAnnotatedQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   mixinannotated.Table,
+			Columns: mixinannotated.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mixinannotated.FieldID,
+			},
+		},
+		From:   maq.sql,
+		Unique: true,
+	}
+	if unique := maq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := maq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mixinannotated.FieldID)
+		for i := range fields {
+			if fields[i] != mixinannotated.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := maq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := maq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := maq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := maq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (maq *MixinAnnotatedQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(maq.driver.Dialect())
+	t1 := builder.Table(mixinannotated.Table)
+	columns := maq.fields
+	if len(columns) == 0 {
+		columns = mixinannotated.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if maq.sql != nil {
+		selector = maq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if maq.unique != nil && *maq.unique {
+		selector.Distinct()
+	}
+	for _, p := range maq.predicates {
+		p(selector)
+	}
+	for _, p := range maq.order {
+		p(selector)
+	}
+	if offset := maq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := maq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MixinAnnotatedGroupBy is the group-by builder for MixinAnnotated entities.
+type MixinAnnotatedGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (magb *MixinAnnotatedGroupBy) Aggregate(fns ...AggregateFunc) *MixinAnnotatedGroupBy {
+	magb.fns = append(magb.fns, fns...)
+	return magb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (magb *MixinAnnotatedGroupBy) Scan(ctx context.Context, v any) error {
+	query, err := magb.path(ctx)
+	if err != nil {
+		return err
+	}
+	magb.sql = query
+	return magb.sqlScan(ctx, v)
+}
+
+func (magb *MixinAnnotatedGroupBy) sqlScan(ctx context.Context, v any) error {
+	for _, f := range magb.fields {
+		if !mixinannotated.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := magb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := magb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (magb *MixinAnnotatedGroupBy) sqlQuery() *sql.Selector {
+	selector := magb.sql.Select()
+	aggregation := make([]string, 0, len(magb.fns))
+	for _, fn := range magb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(magb.fields)+len(magb.fns))
+		for _, f := range magb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(magb.fields...)...)
+}
+
+// MixinAnnotatedSelect is the builder for selecting fields of MixinAnnotated entities.
+type MixinAnnotatedSelect struct {
+	*MixinAnnotatedQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mas *MixinAnnotatedSelect) Aggregate(fns ...AggregateFunc) *MixinAnnotatedSelect {
+	mas.fns = append(mas.fns, fns...)
+	return mas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mas *MixinAnnotatedSelect) Scan(ctx context.Context, v any) error {
+	if err := mas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	mas.sql = mas.MixinAnnotatedQuery.sqlQuery(ctx)
+	return mas.sqlScan(ctx, v)
+}
+
+func (mas *MixinAnnotatedSelect) sqlScan(ctx context.Context, v any) error {
+	aggregation := make([]string, 0, len(mas.fns))
+	for _, fn := range mas.fns {
+		aggregation = append(aggregation, fn(mas.sql))
+	}
+	switch n := len(*mas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		mas.sql.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		mas.sql.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := mas.sql.Query()
+	if err := mas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,331 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixinannotated"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MixinAnnotatedUpdate is the builder for updating MixinAnnotated entities.
+type MixinAnnotatedUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MixinAnnotatedMutation
+}
+
+// Where appends a list predicates to the MixinAnnotatedUpdate builder.
+func (mau *MixinAnnotatedUpdate) Where(ps ...predicate.MixinAnnotated) *MixinAnnotatedUpdate {
+	mau.mutation.Where(ps...)
+	return mau
+}
+
+// SetState sets the "state" field.
+func (mau *MixinAnnotatedUpdate) SetState(m mixinannotated.State) *MixinAnnotatedUpdate {
+	mau.mutation.SetState(m)
+	return mau
+}
+
+// SetInternal sets the "internal" field.
+func (mau *MixinAnnotatedUpdate) SetInternal(s string) *MixinAnnotatedUpdate {
+	mau.mutation.SetInternal(s)
+	return mau
+}
+
+// SetName sets the "name" field.
+func (mau *MixinAnnotatedUpdate) SetName(s string) *MixinAnnotatedUpdate {
+	mau.mutation.SetName(s)
+	return mau
+}
+
+// Mutation returns the MixinAnnotatedMutation object of the builder.
+func (mau *MixinAnnotatedUpdate) Mutation() *MixinAnnotatedMutation {
+	return mau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mau *MixinAnnotatedUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(mau.hooks) == 0 {
+		if err = mau.check(); err != nil {
+			return 0, err
+		}
+		affected, err = mau.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MixinAnnotatedMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = mau.check(); err != nil {
+				return 0, err
+			}
+			mau.mutation = mutation
+			affected, err = mau.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(mau.hooks) - 1; i >= 0; i-- {
+			if mau.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mau.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mau.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mau *MixinAnnotatedUpdate) SaveX(ctx context.Context) int {
+	affected, err := mau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mau *MixinAnnotatedUpdate) Exec(ctx context.Context) error {
+	_, err := mau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mau *MixinAnnotatedUpdate) ExecX(ctx context.Context) {
+	if err := mau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mau *MixinAnnotatedUpdate) check() error {
+	if v, ok := mau.mutation.State(); ok {
+		if err := mixinannotated.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "MixinAnnotated.state": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mau *MixinAnnotatedUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   mixinannotated.Table,
+			Columns: mixinannotated.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mixinannotated.FieldID,
+			},
+		},
+	}
+	if ps := mau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mau.mutation.State(); ok {
+		_spec.SetField(mixinannotated.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := mau.mutation.Internal(); ok {
+		_spec.SetField(mixinannotated.FieldInternal, field.TypeString, value)
+	}
+	if value, ok := mau.mutation.Name(); ok {
+		_spec.SetField(mixinannotated.FieldName, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mixinannotated.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// MixinAnnotatedUpdateOne is the builder for updating a single MixinAnnotated entity.
+type MixinAnnotatedUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MixinAnnotatedMutation
+}
+
+// SetState sets the "state" field.
+func (mauo *MixinAnnotatedUpdateOne) SetState(m mixinannotated.State) *MixinAnnotatedUpdateOne {
+	mauo.mutation.SetState(m)
+	return mauo
+}
+
+// SetInternal sets the "internal" field.
+func (mauo *MixinAnnotatedUpdateOne) SetInternal(s string) *MixinAnnotatedUpdateOne {
+	mauo.mutation.SetInternal(s)
+	return mauo
+}
+
+// SetName sets the "name" field.
+func (mauo *MixinAnnotatedUpdateOne) SetName(s string) *MixinAnnotatedUpdateOne {
+	mauo.mutation.SetName(s)
+	return mauo
+}
+
+// Mutation returns the MixinAnnotatedMutation object of the builder.
+func (mauo *MixinAnnotatedUpdateOne) Mutation() *MixinAnnotatedMutation {
+	return mauo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mauo *MixinAnnotatedUpdateOne) Select(field string, fields ...string) *MixinAnnotatedUpdateOne {
+	mauo.fields = append([]string{field}, fields...)
+	return mauo
+}
+
+// Save executes the query and returns the updated MixinAnnotated entity.
+func (mauo *MixinAnnotatedUpdateOne) Save(ctx context.Context) (*MixinAnnotated, error) {
+	var (
+		err  error
+		node *MixinAnnotated
+	)
+	if len(mauo.hooks) == 0 {
+		if err = mauo.check(); err != nil {
+			return nil, err
+		}
+		node, err = mauo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MixinAnnotatedMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = mauo.check(); err != nil {
+				return nil, err
+			}
+			mauo.mutation = mutation
+			node, err = mauo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(mauo.hooks) - 1; i >= 0; i-- {
+			if mauo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mauo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, mauo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*MixinAnnotated)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from MixinAnnotatedMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mauo *MixinAnnotatedUpdateOne) SaveX(ctx context.Context) *MixinAnnotated {
+	node, err := mauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mauo *MixinAnnotatedUpdateOne) Exec(ctx context.Context) error {
+	_, err := mauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mauo *MixinAnnotatedUpdateOne) ExecX(ctx context.Context) {
+	if err := mauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mauo *MixinAnnotatedUpdateOne) check() error {
+	if v, ok := mauo.mutation.State(); ok {
+		if err := mixinannotated.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "MixinAnnotated.state": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mauo *MixinAnnotatedUpdateOne) sqlSave(ctx context.Context) (_node *MixinAnnotated, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   mixinannotated.Table,
+			Columns: mixinannotated.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mixinannotated.FieldID,
+			},
+		},
+	}
+	id, ok := mauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MixinAnnotated.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mixinannotated.FieldID)
+		for _, f := range fields {
+			if !mixinannotated.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mixinannotated.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mauo.mutation.State(); ok {
+		_spec.SetField(mixinannotated.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := mauo.mutation.Internal(); ok {
+		_spec.SetField(mixinannotated.FieldInternal, field.TypeString, value)
+	}
+	if value, ok := mauo.mutation.Name(); ok {
+		_spec.SetField(mixinannotated.FieldName, field.TypeString, value)
+	}
+	_node = &MixinAnnotated{config: mauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mixinannotated.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
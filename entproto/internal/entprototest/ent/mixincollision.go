@@ -0,0 +1,110 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixincollision"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MixinCollision is the model entity for the MixinCollision schema.
+type MixinCollision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MixinCollision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mixincollision.FieldID:
+			values[i] = new(sql.NullInt64)
+		case mixincollision.FieldCreatedBy, mixincollision.FieldName:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type MixinCollision", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MixinCollision fields.
+func (mc *MixinCollision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mixincollision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mc.ID = int(value.Int64)
+		case mixincollision.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				mc.CreatedBy = value.String
+			}
+		case mixincollision.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				mc.Name = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this MixinCollision.
+// Note that you need to call MixinCollision.Unwrap() before calling this method if this MixinCollision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mc *MixinCollision) Update() *MixinCollisionUpdateOne {
+	return (&MixinCollisionClient{config: mc.config}).UpdateOne(mc)
+}
+
+// Unwrap unwraps the MixinCollision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mc *MixinCollision) Unwrap() *MixinCollision {
+	_tx, ok := mc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MixinCollision is not a transactional entity")
+	}
+	mc.config.driver = _tx.drv
+	return mc
+}
+
+// String implements the fmt.Stringer.
+func (mc *MixinCollision) String() string {
+	var builder strings.Builder
+	builder.WriteString("MixinCollision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mc.ID))
+	builder.WriteString("created_by=")
+	builder.WriteString(mc.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(mc.Name)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MixinCollisions is a parsable slice of MixinCollision.
+type MixinCollisions []*MixinCollision
+
+func (mc MixinCollisions) config(cfg config) {
+	for _i := range mc {
+		mc[_i].config = cfg
+	}
+}
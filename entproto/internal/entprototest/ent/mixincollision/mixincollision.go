@@ -0,0 +1,33 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mixincollision
+
+const (
+	// Label holds the string label denoting the mixincollision type in the database.
+	Label = "mixin_collision"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// Table holds the table name of the mixincollision in the database.
+	Table = "mixin_collisions"
+)
+
+// Columns holds all SQL columns for mixincollision fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedBy,
+	FieldName,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixincollision"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MixinCollisionCreate is the builder for creating a MixinCollision entity.
+type MixinCollisionCreate struct {
+	config
+	mutation *MixinCollisionMutation
+	hooks    []Hook
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (mcc *MixinCollisionCreate) SetCreatedBy(s string) *MixinCollisionCreate {
+	mcc.mutation.SetCreatedBy(s)
+	return mcc
+}
+
+// SetName sets the "name" field.
+func (mcc *MixinCollisionCreate) SetName(s string) *MixinCollisionCreate {
+	mcc.mutation.SetName(s)
+	return mcc
+}
+
+// Mutation returns the MixinCollisionMutation object of the builder.
+func (mcc *MixinCollisionCreate) Mutation() *MixinCollisionMutation {
+	return mcc.mutation
+}
+
+// Save creates the MixinCollision in the database.
+func (mcc *MixinCollisionCreate) Save(ctx context.Context) (*MixinCollision, error) {
+	var (
+		err  error
+		node *MixinCollision
+	)
+	if len(mcc.hooks) == 0 {
+		if err = mcc.check(); err != nil {
+			return nil, err
+		}
+		node, err = mcc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MixinCollisionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = mcc.check(); err != nil {
+				return nil, err
+			}
+			mcc.mutation = mutation
+			if node, err = mcc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(mcc.hooks) - 1; i >= 0; i-- {
+			if mcc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mcc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, mcc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*MixinCollision)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from MixinCollisionMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mcc *MixinCollisionCreate) SaveX(ctx context.Context) *MixinCollision {
+	v, err := mcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcc *MixinCollisionCreate) Exec(ctx context.Context) error {
+	_, err := mcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcc *MixinCollisionCreate) ExecX(ctx context.Context) {
+	if err := mcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mcc *MixinCollisionCreate) check() error {
+	if _, ok := mcc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "MixinCollision.created_by"`)}
+	}
+	if _, ok := mcc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "MixinCollision.name"`)}
+	}
+	return nil
+}
+
+func (mcc *MixinCollisionCreate) sqlSave(ctx context.Context) (*MixinCollision, error) {
+	_node, _spec := mcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (mcc *MixinCollisionCreate) createSpec() (*MixinCollision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MixinCollision{config: mcc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: mixincollision.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mixincollision.FieldID,
+			},
+		}
+	)
+	if value, ok := mcc.mutation.CreatedBy(); ok {
+		_spec.SetField(mixincollision.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := mcc.mutation.Name(); ok {
+		_spec.SetField(mixincollision.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	return _node, _spec
+}
+
+// MixinCollisionCreateBulk is the builder for creating many MixinCollision entities in bulk.
+type MixinCollisionCreateBulk struct {
+	config
+	builders []*MixinCollisionCreate
+}
+
+// Save creates the MixinCollision entities in the database.
+func (mccb *MixinCollisionCreateBulk) Save(ctx context.Context) ([]*MixinCollision, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(mccb.builders))
+	nodes := make([]*MixinCollision, len(mccb.builders))
+	mutators := make([]Mutator, len(mccb.builders))
+	for i := range mccb.builders {
+		func(i int, root context.Context) {
+			builder := mccb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MixinCollisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mccb *MixinCollisionCreateBulk) SaveX(ctx context.Context) []*MixinCollision {
+	v, err := mccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mccb *MixinCollisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := mccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mccb *MixinCollisionCreateBulk) ExecX(ctx context.Context) {
+	if err := mccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
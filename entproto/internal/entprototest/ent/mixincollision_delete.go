@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixincollision"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MixinCollisionDelete is the builder for deleting a MixinCollision entity.
+type MixinCollisionDelete struct {
+	config
+	hooks    []Hook
+	mutation *MixinCollisionMutation
+}
+
+// Where appends a list predicates to the MixinCollisionDelete builder.
+func (mcd *MixinCollisionDelete) Where(ps ...predicate.MixinCollision) *MixinCollisionDelete {
+	mcd.mutation.Where(ps...)
+	return mcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mcd *MixinCollisionDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(mcd.hooks) == 0 {
+		affected, err = mcd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MixinCollisionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mcd.mutation = mutation
+			affected, err = mcd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(mcd.hooks) - 1; i >= 0; i-- {
+			if mcd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mcd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mcd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcd *MixinCollisionDelete) ExecX(ctx context.Context) int {
+	n, err := mcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mcd *MixinCollisionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: mixincollision.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mixincollision.FieldID,
+			},
+		},
+	}
+	if ps := mcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// MixinCollisionDeleteOne is the builder for deleting a single MixinCollision entity.
+type MixinCollisionDeleteOne struct {
+	mcd *MixinCollisionDelete
+}
+
+// Exec executes the deletion query.
+func (mcdo *MixinCollisionDeleteOne) Exec(ctx context.Context) error {
+	n, err := mcdo.mcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{mixincollision.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcdo *MixinCollisionDeleteOne) ExecX(ctx context.Context) {
+	mcdo.mcd.ExecX(ctx)
+}
@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixincollision"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MixinCollisionQuery is the builder for querying MixinCollision entities.
+type MixinCollisionQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.MixinCollision
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MixinCollisionQuery builder.
+func (mcq *MixinCollisionQuery) Where(ps ...predicate.MixinCollision) *MixinCollisionQuery {
+	mcq.predicates = append(mcq.predicates, ps...)
+	return mcq
+}
+
+// Limit adds a limit step to the query.
+func (mcq *MixinCollisionQuery) Limit(limit int) *MixinCollisionQuery {
+	mcq.limit = &limit
+	return mcq
+}
+
+// Offset adds an offset step to the query.
+func (mcq *MixinCollisionQuery) Offset(offset int) *MixinCollisionQuery {
+	mcq.offset = &offset
+	return mcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mcq *MixinCollisionQuery) Unique(unique bool) *MixinCollisionQuery {
+	mcq.unique = &unique
+	return mcq
+}
+
+// Order adds an order step to the query.
+func (mcq *MixinCollisionQuery) Order(o ...OrderFunc) *MixinCollisionQuery {
+	mcq.order = append(mcq.order, o...)
+	return mcq
+}
+
+// First returns the first MixinCollision entity from the query.
+// Returns a *NotFoundError when no MixinCollision was found.
+func (mcq *MixinCollisionQuery) First(ctx context.Context) (*MixinCollision, error) {
+	nodes, err := mcq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{mixincollision.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mcq *MixinCollisionQuery) FirstX(ctx context.Context) *MixinCollision {
+	node, err := mcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MixinCollision ID from the query.
+// Returns a *NotFoundError when no MixinCollision ID was found.
+func (mcq *MixinCollisionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mcq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{mixincollision.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mcq *MixinCollisionQuery) FirstIDX(ctx context.Context) int {
+	id, err := mcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MixinCollision entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MixinCollision entity is found.
+// Returns a *NotFoundError when no MixinCollision entities are found.
+func (mcq *MixinCollisionQuery) Only(ctx context.Context) (*MixinCollision, error) {
+	nodes, err := mcq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{mixincollision.Label}
+	default:
+		return nil, &NotSingularError{mixincollision.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mcq *MixinCollisionQuery) OnlyX(ctx context.Context) *MixinCollision {
+	node, err := mcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MixinCollision ID in the query.
+// Returns a *NotSingularError when more than one MixinCollision ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mcq *MixinCollisionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mcq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{mixincollision.Label}
+	default:
+		err = &NotSingularError{mixincollision.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mcq *MixinCollisionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MixinCollisions.
+func (mcq *MixinCollisionQuery) All(ctx context.Context) ([]*MixinCollision, error) {
+	if err := mcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return mcq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mcq *MixinCollisionQuery) AllX(ctx context.Context) []*MixinCollision {
+	nodes, err := mcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MixinCollision IDs.
+func (mcq *MixinCollisionQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := mcq.Select(mixincollision.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mcq *MixinCollisionQuery) IDsX(ctx context.Context) []int {
+	ids, err := mcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mcq *MixinCollisionQuery) Count(ctx context.Context) (int, error) {
+	if err := mcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return mcq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mcq *MixinCollisionQuery) CountX(ctx context.Context) int {
+	count, err := mcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mcq *MixinCollisionQuery) Exist(ctx context.Context) (bool, error) {
+	if err := mcq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return mcq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mcq *MixinCollisionQuery) ExistX(ctx context.Context) bool {
+	exist, err := mcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MixinCollisionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mcq *MixinCollisionQuery) Clone() *MixinCollisionQuery {
+	if mcq == nil {
+		return nil
+	}
+	return &MixinCollisionQuery{
+		config:     mcq.config,
+		limit:      mcq.limit,
+		offset:     mcq.offset,
+		order:      append([]OrderFunc{}, mcq.order...),
+		predicates: append([]predicate.MixinCollision{}, mcq.predicates...),
+		// clone intermediate query.
+		sql:    mcq.sql.Clone(),
+		path:   mcq.path,
+		unique: mcq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedBy string `json:"created_by,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MixinCollision.Query().
+//		GroupBy(mixincollision.FieldCreatedBy).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mcq *MixinCollisionQuery) GroupBy(field string, fields ...string) *MixinCollisionGroupBy {
+	grbuild := &MixinCollisionGroupBy{config: mcq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := mcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return mcq.sqlQuery(ctx), nil
+	}
+	grbuild.label = mixincollision.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedBy string `json:"created_by,omitempty"`
+//	}
+//
+//	client.MixinCollision.Query().
+//		Select(mixincollision.FieldCreatedBy).
+//		Scan(ctx, &v)
+func (mcq *MixinCollisionQuery) Select(fields ...string) *MixinCollisionSelect {
+	mcq.fields = append(mcq.fields, fields...)
+	selbuild := &MixinCollisionSelect{MixinCollisionQuery: mcq}
+	selbuild.label = mixincollision.Label
+	selbuild.flds, selbuild.scan = &mcq.fields, selbuild.Scan
+	return selbuild
+}
+
+// Aggregate returns a MixinCollisionSelect configured with the given aggregations.
+func (mcq *MixinCollisionQuery) Aggregate(fns ...AggregateFunc) *MixinCollisionSelect {
+	return mcq.Select().Aggregate(fns...)
+}
+
+func (mcq *MixinCollisionQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range mcq.fields {
+		if !mixincollision.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mcq.path != nil {
+		prev, err := mcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mcq.sql = prev
+	}
+	return nil
+}
+
+func (mcq *MixinCollisionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MixinCollision, error) {
+	var (
+		nodes = []*MixinCollision{}
+		_spec = mcq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MixinCollision).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MixinCollision{config: mcq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mcq *MixinCollisionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mcq.querySpec()
+	_spec.Node.Columns = mcq.fields
+	if len(mcq.fields) > 0 {
+		_spec.Unique = mcq.unique != nil && *mcq.unique
+	}
+	return sqlgraph.CountNodes(ctx, mcq.driver, _spec)
+}
+
+func (mcq *MixinCollisionQuery) sqlExist(ctx context.Context) (bool, error) {
+	switch _, err := mcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+func (mcq *MixinCollisionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   mixincollision.Table,
+			Columns: mixincollision.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mixincollision.FieldID,
+			},
+		},
+		From:   mcq.sql,
+		Unique: true,
+	}
+	if unique := mcq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := mcq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mixincollision.FieldID)
+		for i := range fields {
+			if fields[i] != mixincollision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mcq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mcq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mcq *MixinCollisionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mcq.driver.Dialect())
+	t1 := builder.Table(mixincollision.Table)
+	columns := mcq.fields
+	if len(columns) == 0 {
+		columns = mixincollision.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mcq.sql != nil {
+		selector = mcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mcq.unique != nil && *mcq.unique {
+		selector.Distinct()
+	}
+	for _, p := range mcq.predicates {
+		p(selector)
+	}
+	for _, p := range mcq.order {
+		p(selector)
+	}
+	if offset := mcq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mcq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MixinCollisionGroupBy is the group-by builder for MixinCollision entities.
+type MixinCollisionGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mcgb *MixinCollisionGroupBy) Aggregate(fns ...AggregateFunc) *MixinCollisionGroupBy {
+	mcgb.fns = append(mcgb.fns, fns...)
+	return mcgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (mcgb *MixinCollisionGroupBy) Scan(ctx context.Context, v any) error {
+	query, err := mcgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	mcgb.sql = query
+	return mcgb.sqlScan(ctx, v)
+}
+
+func (mcgb *MixinCollisionGroupBy) sqlScan(ctx context.Context, v any) error {
+	for _, f := range mcgb.fields {
+		if !mixincollision.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := mcgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mcgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (mcgb *MixinCollisionGroupBy) sqlQuery() *sql.Selector {
+	selector := mcgb.sql.Select()
+	aggregation := make([]string, 0, len(mcgb.fns))
+	for _, fn := range mcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(mcgb.fields)+len(mcgb.fns))
+		for _, f := range mcgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(mcgb.fields...)...)
+}
+
+// MixinCollisionSelect is the builder for selecting fields of MixinCollision entities.
+type MixinCollisionSelect struct {
+	*MixinCollisionQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mcs *MixinCollisionSelect) Aggregate(fns ...AggregateFunc) *MixinCollisionSelect {
+	mcs.fns = append(mcs.fns, fns...)
+	return mcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mcs *MixinCollisionSelect) Scan(ctx context.Context, v any) error {
+	if err := mcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	mcs.sql = mcs.MixinCollisionQuery.sqlQuery(ctx)
+	return mcs.sqlScan(ctx, v)
+}
+
+func (mcs *MixinCollisionSelect) sqlScan(ctx context.Context, v any) error {
+	aggregation := make([]string, 0, len(mcs.fns))
+	for _, fn := range mcs.fns {
+		aggregation = append(aggregation, fn(mcs.sql))
+	}
+	switch n := len(*mcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		mcs.sql.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		mcs.sql.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := mcs.sql.Query()
+	if err := mcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixincollision"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MixinCollisionUpdate is the builder for updating MixinCollision entities.
+type MixinCollisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MixinCollisionMutation
+}
+
+// Where appends a list predicates to the MixinCollisionUpdate builder.
+func (mcu *MixinCollisionUpdate) Where(ps ...predicate.MixinCollision) *MixinCollisionUpdate {
+	mcu.mutation.Where(ps...)
+	return mcu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (mcu *MixinCollisionUpdate) SetCreatedBy(s string) *MixinCollisionUpdate {
+	mcu.mutation.SetCreatedBy(s)
+	return mcu
+}
+
+// SetName sets the "name" field.
+func (mcu *MixinCollisionUpdate) SetName(s string) *MixinCollisionUpdate {
+	mcu.mutation.SetName(s)
+	return mcu
+}
+
+// Mutation returns the MixinCollisionMutation object of the builder.
+func (mcu *MixinCollisionUpdate) Mutation() *MixinCollisionMutation {
+	return mcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mcu *MixinCollisionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(mcu.hooks) == 0 {
+		affected, err = mcu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MixinCollisionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mcu.mutation = mutation
+			affected, err = mcu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(mcu.hooks) - 1; i >= 0; i-- {
+			if mcu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mcu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mcu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcu *MixinCollisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := mcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mcu *MixinCollisionUpdate) Exec(ctx context.Context) error {
+	_, err := mcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcu *MixinCollisionUpdate) ExecX(ctx context.Context) {
+	if err := mcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mcu *MixinCollisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   mixincollision.Table,
+			Columns: mixincollision.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mixincollision.FieldID,
+			},
+		},
+	}
+	if ps := mcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mcu.mutation.CreatedBy(); ok {
+		_spec.SetField(mixincollision.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := mcu.mutation.Name(); ok {
+		_spec.SetField(mixincollision.FieldName, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mixincollision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// MixinCollisionUpdateOne is the builder for updating a single MixinCollision entity.
+type MixinCollisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MixinCollisionMutation
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (mcuo *MixinCollisionUpdateOne) SetCreatedBy(s string) *MixinCollisionUpdateOne {
+	mcuo.mutation.SetCreatedBy(s)
+	return mcuo
+}
+
+// SetName sets the "name" field.
+func (mcuo *MixinCollisionUpdateOne) SetName(s string) *MixinCollisionUpdateOne {
+	mcuo.mutation.SetName(s)
+	return mcuo
+}
+
+// Mutation returns the MixinCollisionMutation object of the builder.
+func (mcuo *MixinCollisionUpdateOne) Mutation() *MixinCollisionMutation {
+	return mcuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mcuo *MixinCollisionUpdateOne) Select(field string, fields ...string) *MixinCollisionUpdateOne {
+	mcuo.fields = append([]string{field}, fields...)
+	return mcuo
+}
+
+// Save executes the query and returns the updated MixinCollision entity.
+func (mcuo *MixinCollisionUpdateOne) Save(ctx context.Context) (*MixinCollision, error) {
+	var (
+		err  error
+		node *MixinCollision
+	)
+	if len(mcuo.hooks) == 0 {
+		node, err = mcuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MixinCollisionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mcuo.mutation = mutation
+			node, err = mcuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(mcuo.hooks) - 1; i >= 0; i-- {
+			if mcuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mcuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, mcuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*MixinCollision)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from MixinCollisionMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcuo *MixinCollisionUpdateOne) SaveX(ctx context.Context) *MixinCollision {
+	node, err := mcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mcuo *MixinCollisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := mcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcuo *MixinCollisionUpdateOne) ExecX(ctx context.Context) {
+	if err := mcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mcuo *MixinCollisionUpdateOne) sqlSave(ctx context.Context) (_node *MixinCollision, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   mixincollision.Table,
+			Columns: mixincollision.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mixincollision.FieldID,
+			},
+		},
+	}
+	id, ok := mcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MixinCollision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mixincollision.FieldID)
+		for _, f := range fields {
+			if !mixincollision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mixincollision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mcuo.mutation.CreatedBy(); ok {
+		_spec.SetField(mixincollision.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := mcuo.mutation.Name(); ok {
+		_spec.SetField(mixincollision.FieldName, field.TypeString, value)
+	}
+	_node = &MixinCollision{config: mcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mixincollision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
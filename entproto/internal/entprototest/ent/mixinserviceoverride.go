@@ -0,0 +1,110 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixinserviceoverride"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MixinServiceOverride is the model entity for the MixinServiceOverride schema.
+type MixinServiceOverride struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// State holds the value of the "state" field.
+	State mixinserviceoverride.State `json:"state,omitempty"`
+	// Internal holds the value of the "internal" field.
+	Internal string `json:"internal,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MixinServiceOverride) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mixinserviceoverride.FieldID:
+			values[i] = new(sql.NullInt64)
+		case mixinserviceoverride.FieldState, mixinserviceoverride.FieldInternal:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type MixinServiceOverride", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MixinServiceOverride fields.
+func (mso *MixinServiceOverride) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mixinserviceoverride.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mso.ID = int(value.Int64)
+		case mixinserviceoverride.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				mso.State = mixinserviceoverride.State(value.String)
+			}
+		case mixinserviceoverride.FieldInternal:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internal", values[i])
+			} else if value.Valid {
+				mso.Internal = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this MixinServiceOverride.
+// Note that you need to call MixinServiceOverride.Unwrap() before calling this method if this MixinServiceOverride
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mso *MixinServiceOverride) Update() *MixinServiceOverrideUpdateOne {
+	return (&MixinServiceOverrideClient{config: mso.config}).UpdateOne(mso)
+}
+
+// Unwrap unwraps the MixinServiceOverride entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mso *MixinServiceOverride) Unwrap() *MixinServiceOverride {
+	_tx, ok := mso.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MixinServiceOverride is not a transactional entity")
+	}
+	mso.config.driver = _tx.drv
+	return mso
+}
+
+// String implements the fmt.Stringer.
+func (mso *MixinServiceOverride) String() string {
+	var builder strings.Builder
+	builder.WriteString("MixinServiceOverride(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mso.ID))
+	builder.WriteString("state=")
+	builder.WriteString(fmt.Sprintf("%v", mso.State))
+	builder.WriteString(", ")
+	builder.WriteString("internal=")
+	builder.WriteString(mso.Internal)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MixinServiceOverrides is a parsable slice of MixinServiceOverride.
+type MixinServiceOverrides []*MixinServiceOverride
+
+func (mso MixinServiceOverrides) config(cfg config) {
+	for _i := range mso {
+		mso[_i].config = cfg
+	}
+}
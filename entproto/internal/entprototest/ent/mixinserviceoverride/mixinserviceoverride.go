@@ -0,0 +1,60 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mixinserviceoverride
+
+import (
+	"fmt"
+)
+
+const (
+	// Label holds the string label denoting the mixinserviceoverride type in the database.
+	Label = "mixin_service_override"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldInternal holds the string denoting the internal field in the database.
+	FieldInternal = "internal"
+	// Table holds the table name of the mixinserviceoverride in the database.
+	Table = "mixin_service_overrides"
+)
+
+// Columns holds all SQL columns for mixinserviceoverride fields.
+var Columns = []string{
+	FieldID,
+	FieldState,
+	FieldInternal,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// State defines the type for the "state" enum field.
+type State string
+
+// State values.
+const (
+	StateOn  State = "on"
+	StateOff State = "off"
+)
+
+func (s State) String() string {
+	return string(s)
+}
+
+// StateValidator is a validator for the "state" field enum values. It is called by the builders before save.
+func StateValidator(s State) error {
+	switch s {
+	case StateOn, StateOff:
+		return nil
+	default:
+		return fmt.Errorf("mixinserviceoverride: invalid enum value for state field: %q", s)
+	}
+}
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixinserviceoverride"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MixinServiceOverrideCreate is the builder for creating a MixinServiceOverride entity.
+type MixinServiceOverrideCreate struct {
+	config
+	mutation *MixinServiceOverrideMutation
+	hooks    []Hook
+}
+
+// SetState sets the "state" field.
+func (msoc *MixinServiceOverrideCreate) SetState(m mixinserviceoverride.State) *MixinServiceOverrideCreate {
+	msoc.mutation.SetState(m)
+	return msoc
+}
+
+// SetInternal sets the "internal" field.
+func (msoc *MixinServiceOverrideCreate) SetInternal(s string) *MixinServiceOverrideCreate {
+	msoc.mutation.SetInternal(s)
+	return msoc
+}
+
+// Mutation returns the MixinServiceOverrideMutation object of the builder.
+func (msoc *MixinServiceOverrideCreate) Mutation() *MixinServiceOverrideMutation {
+	return msoc.mutation
+}
+
+// Save creates the MixinServiceOverride in the database.
+func (msoc *MixinServiceOverrideCreate) Save(ctx context.Context) (*MixinServiceOverride, error) {
+	var (
+		err  error
+		node *MixinServiceOverride
+	)
+	if len(msoc.hooks) == 0 {
+		if err = msoc.check(); err != nil {
+			return nil, err
+		}
+		node, err = msoc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MixinServiceOverrideMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = msoc.check(); err != nil {
+				return nil, err
+			}
+			msoc.mutation = mutation
+			if node, err = msoc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(msoc.hooks) - 1; i >= 0; i-- {
+			if msoc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = msoc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, msoc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*MixinServiceOverride)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from MixinServiceOverrideMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (msoc *MixinServiceOverrideCreate) SaveX(ctx context.Context) *MixinServiceOverride {
+	v, err := msoc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (msoc *MixinServiceOverrideCreate) Exec(ctx context.Context) error {
+	_, err := msoc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msoc *MixinServiceOverrideCreate) ExecX(ctx context.Context) {
+	if err := msoc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (msoc *MixinServiceOverrideCreate) check() error {
+	if _, ok := msoc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "MixinServiceOverride.state"`)}
+	}
+	if v, ok := msoc.mutation.State(); ok {
+		if err := mixinserviceoverride.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "MixinServiceOverride.state": %w`, err)}
+		}
+	}
+	if _, ok := msoc.mutation.Internal(); !ok {
+		return &ValidationError{Name: "internal", err: errors.New(`ent: missing required field "MixinServiceOverride.internal"`)}
+	}
+	return nil
+}
+
+func (msoc *MixinServiceOverrideCreate) sqlSave(ctx context.Context) (*MixinServiceOverride, error) {
+	_node, _spec := msoc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, msoc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (msoc *MixinServiceOverrideCreate) createSpec() (*MixinServiceOverride, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MixinServiceOverride{config: msoc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: mixinserviceoverride.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mixinserviceoverride.FieldID,
+			},
+		}
+	)
+	if value, ok := msoc.mutation.State(); ok {
+		_spec.SetField(mixinserviceoverride.FieldState, field.TypeEnum, value)
+		_node.State = value
+	}
+	if value, ok := msoc.mutation.Internal(); ok {
+		_spec.SetField(mixinserviceoverride.FieldInternal, field.TypeString, value)
+		_node.Internal = value
+	}
+	return _node, _spec
+}
+
+// MixinServiceOverrideCreateBulk is the builder for creating many MixinServiceOverride entities in bulk.
+type MixinServiceOverrideCreateBulk struct {
+	config
+	builders []*MixinServiceOverrideCreate
+}
+
+// Save creates the MixinServiceOverride entities in the database.
+func (msocb *MixinServiceOverrideCreateBulk) Save(ctx context.Context) ([]*MixinServiceOverride, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(msocb.builders))
+	nodes := make([]*MixinServiceOverride, len(msocb.builders))
+	mutators := make([]Mutator, len(msocb.builders))
+	for i := range msocb.builders {
+		func(i int, root context.Context) {
+			builder := msocb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MixinServiceOverrideMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, msocb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, msocb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, msocb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (msocb *MixinServiceOverrideCreateBulk) SaveX(ctx context.Context) []*MixinServiceOverride {
+	v, err := msocb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (msocb *MixinServiceOverrideCreateBulk) Exec(ctx context.Context) error {
+	_, err := msocb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msocb *MixinServiceOverrideCreateBulk) ExecX(ctx context.Context) {
+	if err := msocb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixinserviceoverride"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MixinServiceOverrideDelete is the builder for deleting a MixinServiceOverride entity.
+type MixinServiceOverrideDelete struct {
+	config
+	hooks    []Hook
+	mutation *MixinServiceOverrideMutation
+}
+
+// Where appends a list predicates to the MixinServiceOverrideDelete builder.
+func (msod *MixinServiceOverrideDelete) Where(ps ...predicate.MixinServiceOverride) *MixinServiceOverrideDelete {
+	msod.mutation.Where(ps...)
+	return msod
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (msod *MixinServiceOverrideDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(msod.hooks) == 0 {
+		affected, err = msod.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MixinServiceOverrideMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			msod.mutation = mutation
+			affected, err = msod.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(msod.hooks) - 1; i >= 0; i-- {
+			if msod.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = msod.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, msod.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msod *MixinServiceOverrideDelete) ExecX(ctx context.Context) int {
+	n, err := msod.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (msod *MixinServiceOverrideDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: mixinserviceoverride.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mixinserviceoverride.FieldID,
+			},
+		},
+	}
+	if ps := msod.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, msod.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// MixinServiceOverrideDeleteOne is the builder for deleting a single MixinServiceOverride entity.
+type MixinServiceOverrideDeleteOne struct {
+	msod *MixinServiceOverrideDelete
+}
+
+// Exec executes the deletion query.
+func (msodo *MixinServiceOverrideDeleteOne) Exec(ctx context.Context) error {
+	n, err := msodo.msod.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{mixinserviceoverride.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msodo *MixinServiceOverrideDeleteOne) ExecX(ctx context.Context) {
+	msodo.msod.ExecX(ctx)
+}
@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixinserviceoverride"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MixinServiceOverrideQuery is the builder for querying MixinServiceOverride entities.
+type MixinServiceOverrideQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.MixinServiceOverride
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MixinServiceOverrideQuery builder.
+func (msoq *MixinServiceOverrideQuery) Where(ps ...predicate.MixinServiceOverride) *MixinServiceOverrideQuery {
+	msoq.predicates = append(msoq.predicates, ps...)
+	return msoq
+}
+
+// Limit adds a limit step to the query.
+func (msoq *MixinServiceOverrideQuery) Limit(limit int) *MixinServiceOverrideQuery {
+	msoq.limit = &limit
+	return msoq
+}
+
+// Offset adds an offset step to the query.
+func (msoq *MixinServiceOverrideQuery) Offset(offset int) *MixinServiceOverrideQuery {
+	msoq.offset = &offset
+	return msoq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (msoq *MixinServiceOverrideQuery) Unique(unique bool) *MixinServiceOverrideQuery {
+	msoq.unique = &unique
+	return msoq
+}
+
+// Order adds an order step to the query.
+func (msoq *MixinServiceOverrideQuery) Order(o ...OrderFunc) *MixinServiceOverrideQuery {
+	msoq.order = append(msoq.order, o...)
+	return msoq
+}
+
+// First returns the first MixinServiceOverride entity from the query.
+// Returns a *NotFoundError when no MixinServiceOverride was found.
+func (msoq *MixinServiceOverrideQuery) First(ctx context.Context) (*MixinServiceOverride, error) {
+	nodes, err := msoq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{mixinserviceoverride.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (msoq *MixinServiceOverrideQuery) FirstX(ctx context.Context) *MixinServiceOverride {
+	node, err := msoq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MixinServiceOverride ID from the query.
+// Returns a *NotFoundError when no MixinServiceOverride ID was found.
+func (msoq *MixinServiceOverrideQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = msoq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{mixinserviceoverride.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (msoq *MixinServiceOverrideQuery) FirstIDX(ctx context.Context) int {
+	id, err := msoq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MixinServiceOverride entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MixinServiceOverride entity is found.
+// Returns a *NotFoundError when no MixinServiceOverride entities are found.
+func (msoq *MixinServiceOverrideQuery) Only(ctx context.Context) (*MixinServiceOverride, error) {
+	nodes, err := msoq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{mixinserviceoverride.Label}
+	default:
+		return nil, &NotSingularError{mixinserviceoverride.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (msoq *MixinServiceOverrideQuery) OnlyX(ctx context.Context) *MixinServiceOverride {
+	node, err := msoq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MixinServiceOverride ID in the query.
+// Returns a *NotSingularError when more than one MixinServiceOverride ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (msoq *MixinServiceOverrideQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = msoq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{mixinserviceoverride.Label}
+	default:
+		err = &NotSingularError{mixinserviceoverride.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (msoq *MixinServiceOverrideQuery) OnlyIDX(ctx context.Context) int {
+	id, err := msoq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MixinServiceOverrides.
+func (msoq *MixinServiceOverrideQuery) All(ctx context.Context) ([]*MixinServiceOverride, error) {
+	if err := msoq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return msoq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (msoq *MixinServiceOverrideQuery) AllX(ctx context.Context) []*MixinServiceOverride {
+	nodes, err := msoq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MixinServiceOverride IDs.
+func (msoq *MixinServiceOverrideQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := msoq.Select(mixinserviceoverride.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (msoq *MixinServiceOverrideQuery) IDsX(ctx context.Context) []int {
+	ids, err := msoq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (msoq *MixinServiceOverrideQuery) Count(ctx context.Context) (int, error) {
+	if err := msoq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return msoq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (msoq *MixinServiceOverrideQuery) CountX(ctx context.Context) int {
+	count, err := msoq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (msoq *MixinServiceOverrideQuery) Exist(ctx context.Context) (bool, error) {
+	if err := msoq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return msoq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (msoq *MixinServiceOverrideQuery) ExistX(ctx context.Context) bool {
+	exist, err := msoq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MixinServiceOverrideQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (msoq *MixinServiceOverrideQuery) Clone() *MixinServiceOverrideQuery {
+	if msoq == nil {
+		return nil
+	}
+	return &MixinServiceOverrideQuery{
+		config:     msoq.config,
+		limit:      msoq.limit,
+		offset:     msoq.offset,
+		order:      append([]OrderFunc{}, msoq.order...),
+		predicates: append([]predicate.MixinServiceOverride{}, msoq.predicates...),
+		// clone intermediate query.
+		sql:    msoq.sql.Clone(),
+		path:   msoq.path,
+		unique: msoq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		State mixinserviceoverride.State `json:"state,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MixinServiceOverride.Query().
+//		GroupBy(mixinserviceoverride.FieldState).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (msoq *MixinServiceOverrideQuery) GroupBy(field string, fields ...string) *MixinServiceOverrideGroupBy {
+	grbuild := &MixinServiceOverrideGroupBy{config: msoq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := msoq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return msoq.sqlQuery(ctx), nil
+	}
+	grbuild.label = mixinserviceoverride.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		State mixinserviceoverride.State `json:"state,omitempty"`
+//	}
+//
+//	client.MixinServiceOverride.Query().
+//		Select(mixinserviceoverride.FieldState).
+//		Scan(ctx, &v)
+func (msoq *MixinServiceOverrideQuery) Select(fields ...string) *MixinServiceOverrideSelect {
+	msoq.fields = append(msoq.fields, fields...)
+	selbuild := &MixinServiceOverrideSelect{MixinServiceOverrideQuery: msoq}
+	selbuild.label = mixinserviceoverride.Label
+	selbuild.flds, selbuild.scan = &msoq.fields, selbuild.Scan
+	return selbuild
+}
+
+// Aggregate returns a MixinServiceOverrideSelect configured with the given aggregations.
+func (msoq *MixinServiceOverrideQuery) Aggregate(fns ...AggregateFunc) *MixinServiceOverrideSelect {
+	return msoq.Select().Aggregate(fns...)
+}
+
+func (msoq *MixinServiceOverrideQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range msoq.fields {
+		if !mixinserviceoverride.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if msoq.path != nil {
+		prev, err := msoq.path(ctx)
+		if err != nil {
+			return err
+		}
+		msoq.sql = prev
+	}
+	return nil
+}
+
+func (msoq *MixinServiceOverrideQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MixinServiceOverride, error) {
+	var (
+		nodes = []*MixinServiceOverride{}
+		_spec = msoq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MixinServiceOverride).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MixinServiceOverride{config: msoq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, msoq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (msoq *MixinServiceOverrideQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := msoq.querySpec()
+	_spec.Node.Columns = msoq.fields
+	if len(msoq.fields) > 0 {
+		_spec.Unique = msoq.unique != nil && *msoq.unique
+	}
+	return sqlgraph.CountNodes(ctx, msoq.driver, _spec)
+}
+
+func (msoq *MixinServiceOverrideQuery) sqlExist(ctx context.Context) (bool, error) {
+	switch _, err := msoq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+func (msoq *MixinServiceOverrideQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   mixinserviceoverride.Table,
+			Columns: mixinserviceoverride.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mixinserviceoverride.FieldID,
+			},
+		},
+		From:   msoq.sql,
+		Unique: true,
+	}
+	if unique := msoq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := msoq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mixinserviceoverride.FieldID)
+		for i := range fields {
+			if fields[i] != mixinserviceoverride.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := msoq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := msoq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := msoq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := msoq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (msoq *MixinServiceOverrideQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(msoq.driver.Dialect())
+	t1 := builder.Table(mixinserviceoverride.Table)
+	columns := msoq.fields
+	if len(columns) == 0 {
+		columns = mixinserviceoverride.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if msoq.sql != nil {
+		selector = msoq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if msoq.unique != nil && *msoq.unique {
+		selector.Distinct()
+	}
+	for _, p := range msoq.predicates {
+		p(selector)
+	}
+	for _, p := range msoq.order {
+		p(selector)
+	}
+	if offset := msoq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := msoq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MixinServiceOverrideGroupBy is the group-by builder for MixinServiceOverride entities.
+type MixinServiceOverrideGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (msogb *MixinServiceOverrideGroupBy) Aggregate(fns ...AggregateFunc) *MixinServiceOverrideGroupBy {
+	msogb.fns = append(msogb.fns, fns...)
+	return msogb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (msogb *MixinServiceOverrideGroupBy) Scan(ctx context.Context, v any) error {
+	query, err := msogb.path(ctx)
+	if err != nil {
+		return err
+	}
+	msogb.sql = query
+	return msogb.sqlScan(ctx, v)
+}
+
+func (msogb *MixinServiceOverrideGroupBy) sqlScan(ctx context.Context, v any) error {
+	for _, f := range msogb.fields {
+		if !mixinserviceoverride.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := msogb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := msogb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (msogb *MixinServiceOverrideGroupBy) sqlQuery() *sql.Selector {
+	selector := msogb.sql.Select()
+	aggregation := make([]string, 0, len(msogb.fns))
+	for _, fn := range msogb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(msogb.fields)+len(msogb.fns))
+		for _, f := range msogb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(msogb.fields...)...)
+}
+
+// MixinServiceOverrideSelect is the builder for selecting fields of MixinServiceOverride entities.
+type MixinServiceOverrideSelect struct {
+	*MixinServiceOverrideQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (msos *MixinServiceOverrideSelect) Aggregate(fns ...AggregateFunc) *MixinServiceOverrideSelect {
+	msos.fns = append(msos.fns, fns...)
+	return msos
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (msos *MixinServiceOverrideSelect) Scan(ctx context.Context, v any) error {
+	if err := msos.prepareQuery(ctx); err != nil {
+		return err
+	}
+	msos.sql = msos.MixinServiceOverrideQuery.sqlQuery(ctx)
+	return msos.sqlScan(ctx, v)
+}
+
+func (msos *MixinServiceOverrideSelect) sqlScan(ctx context.Context, v any) error {
+	aggregation := make([]string, 0, len(msos.fns))
+	for _, fn := range msos.fns {
+		aggregation = append(aggregation, fn(msos.sql))
+	}
+	switch n := len(*msos.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		msos.sql.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		msos.sql.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := msos.sql.Query()
+	if err := msos.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixinserviceoverride"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MixinServiceOverrideUpdate is the builder for updating MixinServiceOverride entities.
+type MixinServiceOverrideUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MixinServiceOverrideMutation
+}
+
+// Where appends a list predicates to the MixinServiceOverrideUpdate builder.
+func (msou *MixinServiceOverrideUpdate) Where(ps ...predicate.MixinServiceOverride) *MixinServiceOverrideUpdate {
+	msou.mutation.Where(ps...)
+	return msou
+}
+
+// SetState sets the "state" field.
+func (msou *MixinServiceOverrideUpdate) SetState(m mixinserviceoverride.State) *MixinServiceOverrideUpdate {
+	msou.mutation.SetState(m)
+	return msou
+}
+
+// SetInternal sets the "internal" field.
+func (msou *MixinServiceOverrideUpdate) SetInternal(s string) *MixinServiceOverrideUpdate {
+	msou.mutation.SetInternal(s)
+	return msou
+}
+
+// Mutation returns the MixinServiceOverrideMutation object of the builder.
+func (msou *MixinServiceOverrideUpdate) Mutation() *MixinServiceOverrideMutation {
+	return msou.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (msou *MixinServiceOverrideUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(msou.hooks) == 0 {
+		if err = msou.check(); err != nil {
+			return 0, err
+		}
+		affected, err = msou.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MixinServiceOverrideMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = msou.check(); err != nil {
+				return 0, err
+			}
+			msou.mutation = mutation
+			affected, err = msou.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(msou.hooks) - 1; i >= 0; i-- {
+			if msou.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = msou.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, msou.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (msou *MixinServiceOverrideUpdate) SaveX(ctx context.Context) int {
+	affected, err := msou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (msou *MixinServiceOverrideUpdate) Exec(ctx context.Context) error {
+	_, err := msou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msou *MixinServiceOverrideUpdate) ExecX(ctx context.Context) {
+	if err := msou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (msou *MixinServiceOverrideUpdate) check() error {
+	if v, ok := msou.mutation.State(); ok {
+		if err := mixinserviceoverride.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "MixinServiceOverride.state": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (msou *MixinServiceOverrideUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   mixinserviceoverride.Table,
+			Columns: mixinserviceoverride.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mixinserviceoverride.FieldID,
+			},
+		},
+	}
+	if ps := msou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := msou.mutation.State(); ok {
+		_spec.SetField(mixinserviceoverride.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := msou.mutation.Internal(); ok {
+		_spec.SetField(mixinserviceoverride.FieldInternal, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, msou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mixinserviceoverride.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// MixinServiceOverrideUpdateOne is the builder for updating a single MixinServiceOverride entity.
+type MixinServiceOverrideUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MixinServiceOverrideMutation
+}
+
+// SetState sets the "state" field.
+func (msouo *MixinServiceOverrideUpdateOne) SetState(m mixinserviceoverride.State) *MixinServiceOverrideUpdateOne {
+	msouo.mutation.SetState(m)
+	return msouo
+}
+
+// SetInternal sets the "internal" field.
+func (msouo *MixinServiceOverrideUpdateOne) SetInternal(s string) *MixinServiceOverrideUpdateOne {
+	msouo.mutation.SetInternal(s)
+	return msouo
+}
+
+// Mutation returns the MixinServiceOverrideMutation object of the builder.
+func (msouo *MixinServiceOverrideUpdateOne) Mutation() *MixinServiceOverrideMutation {
+	return msouo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (msouo *MixinServiceOverrideUpdateOne) Select(field string, fields ...string) *MixinServiceOverrideUpdateOne {
+	msouo.fields = append([]string{field}, fields...)
+	return msouo
+}
+
+// Save executes the query and returns the updated MixinServiceOverride entity.
+func (msouo *MixinServiceOverrideUpdateOne) Save(ctx context.Context) (*MixinServiceOverride, error) {
+	var (
+		err  error
+		node *MixinServiceOverride
+	)
+	if len(msouo.hooks) == 0 {
+		if err = msouo.check(); err != nil {
+			return nil, err
+		}
+		node, err = msouo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MixinServiceOverrideMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = msouo.check(); err != nil {
+				return nil, err
+			}
+			msouo.mutation = mutation
+			node, err = msouo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(msouo.hooks) - 1; i >= 0; i-- {
+			if msouo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = msouo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, msouo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*MixinServiceOverride)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from MixinServiceOverrideMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (msouo *MixinServiceOverrideUpdateOne) SaveX(ctx context.Context) *MixinServiceOverride {
+	node, err := msouo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (msouo *MixinServiceOverrideUpdateOne) Exec(ctx context.Context) error {
+	_, err := msouo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msouo *MixinServiceOverrideUpdateOne) ExecX(ctx context.Context) {
+	if err := msouo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (msouo *MixinServiceOverrideUpdateOne) check() error {
+	if v, ok := msouo.mutation.State(); ok {
+		if err := mixinserviceoverride.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "MixinServiceOverride.state": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (msouo *MixinServiceOverrideUpdateOne) sqlSave(ctx context.Context) (_node *MixinServiceOverride, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   mixinserviceoverride.Table,
+			Columns: mixinserviceoverride.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mixinserviceoverride.FieldID,
+			},
+		},
+	}
+	id, ok := msouo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MixinServiceOverride.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := msouo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mixinserviceoverride.FieldID)
+		for _, f := range fields {
+			if !mixinserviceoverride.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mixinserviceoverride.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := msouo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := msouo.mutation.State(); ok {
+		_spec.SetField(mixinserviceoverride.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := msouo.mutation.Internal(); ok {
+		_spec.SetField(mixinserviceoverride.FieldInternal, field.TypeString, value)
+	}
+	_node = &MixinServiceOverride{config: msouo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, msouo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mixinserviceoverride.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -9,21 +9,29 @@ import (
 	"sync"
 	"time"
 
+	"entgo.io/contrib/entproto/internal/entprototest/ent/autofieldnumbers"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/blogpost"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/category"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/dependsonskipped"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/duplicatenumbermessage"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/image"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/invalidfieldmessage"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mappedfieldmessage"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithenum"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithfieldone"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithoptionals"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithpackagename"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithstrings"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixinannotated"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixincollision"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/mixinserviceoverride"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/nobackref"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/portal"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/schema"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenummessage"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenumothermessage"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenumvariant"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/skipedgeexample"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/user"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/validmessage"
@@ -42,23 +50,33 @@ const (
 
 	// Node types.
 	TypeAllMethodsService      = "AllMethodsService"
+	TypeAutoFieldNumbers       = "AutoFieldNumbers"
 	TypeBlogPost               = "BlogPost"
 	TypeCategory               = "Category"
 	TypeDependsOnSkipped       = "DependsOnSkipped"
 	TypeDuplicateNumberMessage = "DuplicateNumberMessage"
 	TypeExplicitSkippedMessage = "ExplicitSkippedMessage"
+	TypeExtraMethodService     = "ExtraMethodService"
 	TypeImage                  = "Image"
 	TypeImplicitSkippedMessage = "ImplicitSkippedMessage"
 	TypeInvalidFieldMessage    = "InvalidFieldMessage"
+	TypeMappedFieldMessage     = "MappedFieldMessage"
 	TypeMessageWithEnum        = "MessageWithEnum"
 	TypeMessageWithFieldOne    = "MessageWithFieldOne"
 	TypeMessageWithID          = "MessageWithID"
 	TypeMessageWithOptionals   = "MessageWithOptionals"
 	TypeMessageWithPackageName = "MessageWithPackageName"
 	TypeMessageWithStrings     = "MessageWithStrings"
+	TypeMethodOptionsService   = "MethodOptionsService"
+	TypeMixinAnnotated         = "MixinAnnotated"
+	TypeMixinCollision         = "MixinCollision"
+	TypeMixinServiceOverride   = "MixinServiceOverride"
 	TypeNoBackref              = "NoBackref"
 	TypeOneMethodService       = "OneMethodService"
 	TypePortal                 = "Portal"
+	TypeSharedEnumMessage      = "SharedEnumMessage"
+	TypeSharedEnumOtherMessage = "SharedEnumOtherMessage"
+	TypeSharedEnumVariant      = "SharedEnumVariant"
 	TypeSkipEdgeExample        = "SkipEdgeExample"
 	TypeTwoMethodService       = "TwoMethodService"
 	TypeUser                   = "User"
@@ -314,38 +332,33 @@ func (m *AllMethodsServiceMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown AllMethodsService edge %s", name)
 }
 
-// BlogPostMutation represents an operation that mutates the BlogPost nodes in the graph.
-type BlogPostMutation struct {
+// AutoFieldNumbersMutation represents an operation that mutates the AutoFieldNumbers nodes in the graph.
+type AutoFieldNumbersMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	title             *string
-	body              *string
-	external_id       *int
-	addexternal_id    *int
-	clearedFields     map[string]struct{}
-	author            *int
-	clearedauthor     bool
-	categories        map[int]struct{}
-	removedcategories map[int]struct{}
-	clearedcategories bool
-	done              bool
-	oldValue          func(context.Context) (*BlogPost, error)
-	predicates        []predicate.BlogPost
+	op            Op
+	typ           string
+	id            *int
+	first         *string
+	pinned        *string
+	skipped       *string
+	second        *bool
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*AutoFieldNumbers, error)
+	predicates    []predicate.AutoFieldNumbers
 }
 
-var _ ent.Mutation = (*BlogPostMutation)(nil)
+var _ ent.Mutation = (*AutoFieldNumbersMutation)(nil)
 
-// blogpostOption allows management of the mutation configuration using functional options.
-type blogpostOption func(*BlogPostMutation)
+// autofieldnumbersOption allows management of the mutation configuration using functional options.
+type autofieldnumbersOption func(*AutoFieldNumbersMutation)
 
-// newBlogPostMutation creates new mutation for the BlogPost entity.
-func newBlogPostMutation(c config, op Op, opts ...blogpostOption) *BlogPostMutation {
-	m := &BlogPostMutation{
+// newAutoFieldNumbersMutation creates new mutation for the AutoFieldNumbers entity.
+func newAutoFieldNumbersMutation(c config, op Op, opts ...autofieldnumbersOption) *AutoFieldNumbersMutation {
+	m := &AutoFieldNumbersMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeBlogPost,
+		typ:           TypeAutoFieldNumbers,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -354,20 +367,20 @@ func newBlogPostMutation(c config, op Op, opts ...blogpostOption) *BlogPostMutat
 	return m
 }
 
-// withBlogPostID sets the ID field of the mutation.
-func withBlogPostID(id int) blogpostOption {
-	return func(m *BlogPostMutation) {
+// withAutoFieldNumbersID sets the ID field of the mutation.
+func withAutoFieldNumbersID(id int) autofieldnumbersOption {
+	return func(m *AutoFieldNumbersMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *BlogPost
+			value *AutoFieldNumbers
 		)
-		m.oldValue = func(ctx context.Context) (*BlogPost, error) {
+		m.oldValue = func(ctx context.Context) (*AutoFieldNumbers, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().BlogPost.Get(ctx, id)
+					value, err = m.Client().AutoFieldNumbers.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -376,10 +389,10 @@ func withBlogPostID(id int) blogpostOption {
 	}
 }
 
-// withBlogPost sets the old BlogPost of the mutation.
-func withBlogPost(node *BlogPost) blogpostOption {
-	return func(m *BlogPostMutation) {
-		m.oldValue = func(context.Context) (*BlogPost, error) {
+// withAutoFieldNumbers sets the old AutoFieldNumbers of the mutation.
+func withAutoFieldNumbers(node *AutoFieldNumbers) autofieldnumbersOption {
+	return func(m *AutoFieldNumbersMutation) {
+		m.oldValue = func(context.Context) (*AutoFieldNumbers, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -388,7 +401,7 @@ func withBlogPost(node *BlogPost) blogpostOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BlogPostMutation) Client() *Client {
+func (m AutoFieldNumbersMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -396,7 +409,7 @@ func (m BlogPostMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m BlogPostMutation) Tx() (*Tx, error) {
+func (m AutoFieldNumbersMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -407,7 +420,7 @@ func (m BlogPostMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *BlogPostMutation) ID() (id int, exists bool) {
+func (m *AutoFieldNumbersMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -418,7 +431,7 @@ func (m *BlogPostMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *BlogPostMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AutoFieldNumbersMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -427,261 +440,187 @@ func (m *BlogPostMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().BlogPost.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AutoFieldNumbers.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *BlogPostMutation) SetTitle(s string) {
-	m.title = &s
+// SetFirst sets the "first" field.
+func (m *AutoFieldNumbersMutation) SetFirst(s string) {
+	m.first = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *BlogPostMutation) Title() (r string, exists bool) {
-	v := m.title
+// First returns the value of the "first" field in the mutation.
+func (m *AutoFieldNumbersMutation) First() (r string, exists bool) {
+	v := m.first
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the BlogPost entity.
-// If the BlogPost object wasn't provided to the builder, the object is fetched from the database.
+// OldFirst returns the old "first" field's value of the AutoFieldNumbers entity.
+// If the AutoFieldNumbers object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BlogPostMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *AutoFieldNumbersMutation) OldFirst(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldFirst is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldFirst requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldFirst: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.First, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *BlogPostMutation) ResetTitle() {
-	m.title = nil
+// ResetFirst resets all changes to the "first" field.
+func (m *AutoFieldNumbersMutation) ResetFirst() {
+	m.first = nil
 }
 
-// SetBody sets the "body" field.
-func (m *BlogPostMutation) SetBody(s string) {
-	m.body = &s
+// SetPinned sets the "pinned" field.
+func (m *AutoFieldNumbersMutation) SetPinned(s string) {
+	m.pinned = &s
 }
 
-// Body returns the value of the "body" field in the mutation.
-func (m *BlogPostMutation) Body() (r string, exists bool) {
-	v := m.body
+// Pinned returns the value of the "pinned" field in the mutation.
+func (m *AutoFieldNumbersMutation) Pinned() (r string, exists bool) {
+	v := m.pinned
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBody returns the old "body" field's value of the BlogPost entity.
-// If the BlogPost object wasn't provided to the builder, the object is fetched from the database.
+// OldPinned returns the old "pinned" field's value of the AutoFieldNumbers entity.
+// If the AutoFieldNumbers object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BlogPostMutation) OldBody(ctx context.Context) (v string, err error) {
+func (m *AutoFieldNumbersMutation) OldPinned(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+		return v, errors.New("OldPinned is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBody requires an ID field in the mutation")
+		return v, errors.New("OldPinned requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+		return v, fmt.Errorf("querying old value for OldPinned: %w", err)
 	}
-	return oldValue.Body, nil
+	return oldValue.Pinned, nil
 }
 
-// ResetBody resets all changes to the "body" field.
-func (m *BlogPostMutation) ResetBody() {
-	m.body = nil
+// ResetPinned resets all changes to the "pinned" field.
+func (m *AutoFieldNumbersMutation) ResetPinned() {
+	m.pinned = nil
 }
 
-// SetExternalID sets the "external_id" field.
-func (m *BlogPostMutation) SetExternalID(i int) {
-	m.external_id = &i
-	m.addexternal_id = nil
+// SetSkipped sets the "skipped" field.
+func (m *AutoFieldNumbersMutation) SetSkipped(s string) {
+	m.skipped = &s
 }
 
-// ExternalID returns the value of the "external_id" field in the mutation.
-func (m *BlogPostMutation) ExternalID() (r int, exists bool) {
-	v := m.external_id
+// Skipped returns the value of the "skipped" field in the mutation.
+func (m *AutoFieldNumbersMutation) Skipped() (r string, exists bool) {
+	v := m.skipped
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExternalID returns the old "external_id" field's value of the BlogPost entity.
-// If the BlogPost object wasn't provided to the builder, the object is fetched from the database.
+// OldSkipped returns the old "skipped" field's value of the AutoFieldNumbers entity.
+// If the AutoFieldNumbers object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BlogPostMutation) OldExternalID(ctx context.Context) (v int, err error) {
+func (m *AutoFieldNumbersMutation) OldSkipped(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExternalID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSkipped is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExternalID requires an ID field in the mutation")
+		return v, errors.New("OldSkipped requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSkipped: %w", err)
 	}
-	return oldValue.ExternalID, nil
+	return oldValue.Skipped, nil
 }
 
-// AddExternalID adds i to the "external_id" field.
-func (m *BlogPostMutation) AddExternalID(i int) {
-	if m.addexternal_id != nil {
-		*m.addexternal_id += i
-	} else {
-		m.addexternal_id = &i
-	}
+// ResetSkipped resets all changes to the "skipped" field.
+func (m *AutoFieldNumbersMutation) ResetSkipped() {
+	m.skipped = nil
 }
 
-// AddedExternalID returns the value that was added to the "external_id" field in this mutation.
-func (m *BlogPostMutation) AddedExternalID() (r int, exists bool) {
-	v := m.addexternal_id
+// SetSecond sets the "second" field.
+func (m *AutoFieldNumbersMutation) SetSecond(b bool) {
+	m.second = &b
+}
+
+// Second returns the value of the "second" field in the mutation.
+func (m *AutoFieldNumbersMutation) Second() (r bool, exists bool) {
+	v := m.second
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetExternalID resets all changes to the "external_id" field.
-func (m *BlogPostMutation) ResetExternalID() {
-	m.external_id = nil
-	m.addexternal_id = nil
-}
-
-// SetAuthorID sets the "author" edge to the User entity by id.
-func (m *BlogPostMutation) SetAuthorID(id int) {
-	m.author = &id
-}
-
-// ClearAuthor clears the "author" edge to the User entity.
-func (m *BlogPostMutation) ClearAuthor() {
-	m.clearedauthor = true
-}
-
-// AuthorCleared reports if the "author" edge to the User entity was cleared.
-func (m *BlogPostMutation) AuthorCleared() bool {
-	return m.clearedauthor
-}
-
-// AuthorID returns the "author" edge ID in the mutation.
-func (m *BlogPostMutation) AuthorID() (id int, exists bool) {
-	if m.author != nil {
-		return *m.author, true
-	}
-	return
-}
-
-// AuthorIDs returns the "author" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AuthorID instead. It exists only for internal usage by the builders.
-func (m *BlogPostMutation) AuthorIDs() (ids []int) {
-	if id := m.author; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetAuthor resets all changes to the "author" edge.
-func (m *BlogPostMutation) ResetAuthor() {
-	m.author = nil
-	m.clearedauthor = false
-}
-
-// AddCategoryIDs adds the "categories" edge to the Category entity by ids.
-func (m *BlogPostMutation) AddCategoryIDs(ids ...int) {
-	if m.categories == nil {
-		m.categories = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.categories[ids[i]] = struct{}{}
-	}
-}
-
-// ClearCategories clears the "categories" edge to the Category entity.
-func (m *BlogPostMutation) ClearCategories() {
-	m.clearedcategories = true
-}
-
-// CategoriesCleared reports if the "categories" edge to the Category entity was cleared.
-func (m *BlogPostMutation) CategoriesCleared() bool {
-	return m.clearedcategories
-}
-
-// RemoveCategoryIDs removes the "categories" edge to the Category entity by IDs.
-func (m *BlogPostMutation) RemoveCategoryIDs(ids ...int) {
-	if m.removedcategories == nil {
-		m.removedcategories = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.categories, ids[i])
-		m.removedcategories[ids[i]] = struct{}{}
+// OldSecond returns the old "second" field's value of the AutoFieldNumbers entity.
+// If the AutoFieldNumbers object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AutoFieldNumbersMutation) OldSecond(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecond is only allowed on UpdateOne operations")
 	}
-}
-
-// RemovedCategories returns the removed IDs of the "categories" edge to the Category entity.
-func (m *BlogPostMutation) RemovedCategoriesIDs() (ids []int) {
-	for id := range m.removedcategories {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecond requires an ID field in the mutation")
 	}
-	return
-}
-
-// CategoriesIDs returns the "categories" edge IDs in the mutation.
-func (m *BlogPostMutation) CategoriesIDs() (ids []int) {
-	for id := range m.categories {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecond: %w", err)
 	}
-	return
+	return oldValue.Second, nil
 }
 
-// ResetCategories resets all changes to the "categories" edge.
-func (m *BlogPostMutation) ResetCategories() {
-	m.categories = nil
-	m.clearedcategories = false
-	m.removedcategories = nil
+// ResetSecond resets all changes to the "second" field.
+func (m *AutoFieldNumbersMutation) ResetSecond() {
+	m.second = nil
 }
 
-// Where appends a list predicates to the BlogPostMutation builder.
-func (m *BlogPostMutation) Where(ps ...predicate.BlogPost) {
+// Where appends a list predicates to the AutoFieldNumbersMutation builder.
+func (m *AutoFieldNumbersMutation) Where(ps ...predicate.AutoFieldNumbers) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *BlogPostMutation) Op() Op {
+func (m *AutoFieldNumbersMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (BlogPost).
-func (m *BlogPostMutation) Type() string {
+// Type returns the node type of this mutation (AutoFieldNumbers).
+func (m *AutoFieldNumbersMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *BlogPostMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.title != nil {
-		fields = append(fields, blogpost.FieldTitle)
+func (m *AutoFieldNumbersMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.first != nil {
+		fields = append(fields, autofieldnumbers.FieldFirst)
 	}
-	if m.body != nil {
-		fields = append(fields, blogpost.FieldBody)
+	if m.pinned != nil {
+		fields = append(fields, autofieldnumbers.FieldPinned)
 	}
-	if m.external_id != nil {
-		fields = append(fields, blogpost.FieldExternalID)
+	if m.skipped != nil {
+		fields = append(fields, autofieldnumbers.FieldSkipped)
+	}
+	if m.second != nil {
+		fields = append(fields, autofieldnumbers.FieldSecond)
 	}
 	return fields
 }
@@ -689,14 +628,16 @@ func (m *BlogPostMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *BlogPostMutation) Field(name string) (ent.Value, bool) {
+func (m *AutoFieldNumbersMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case blogpost.FieldTitle:
-		return m.Title()
-	case blogpost.FieldBody:
-		return m.Body()
-	case blogpost.FieldExternalID:
-		return m.ExternalID()
+	case autofieldnumbers.FieldFirst:
+		return m.First()
+	case autofieldnumbers.FieldPinned:
+		return m.Pinned()
+	case autofieldnumbers.FieldSkipped:
+		return m.Skipped()
+	case autofieldnumbers.FieldSecond:
+		return m.Second()
 	}
 	return nil, false
 }
@@ -704,251 +645,198 @@ func (m *BlogPostMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *BlogPostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AutoFieldNumbersMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case blogpost.FieldTitle:
-		return m.OldTitle(ctx)
-	case blogpost.FieldBody:
-		return m.OldBody(ctx)
-	case blogpost.FieldExternalID:
-		return m.OldExternalID(ctx)
+	case autofieldnumbers.FieldFirst:
+		return m.OldFirst(ctx)
+	case autofieldnumbers.FieldPinned:
+		return m.OldPinned(ctx)
+	case autofieldnumbers.FieldSkipped:
+		return m.OldSkipped(ctx)
+	case autofieldnumbers.FieldSecond:
+		return m.OldSecond(ctx)
 	}
-	return nil, fmt.Errorf("unknown BlogPost field %s", name)
+	return nil, fmt.Errorf("unknown AutoFieldNumbers field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BlogPostMutation) SetField(name string, value ent.Value) error {
+func (m *AutoFieldNumbersMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case blogpost.FieldTitle:
+	case autofieldnumbers.FieldFirst:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetFirst(v)
 		return nil
-	case blogpost.FieldBody:
+	case autofieldnumbers.FieldPinned:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBody(v)
+		m.SetPinned(v)
 		return nil
-	case blogpost.FieldExternalID:
-		v, ok := value.(int)
+	case autofieldnumbers.FieldSkipped:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExternalID(v)
+		m.SetSkipped(v)
+		return nil
+	case autofieldnumbers.FieldSecond:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecond(v)
 		return nil
 	}
-	return fmt.Errorf("unknown BlogPost field %s", name)
+	return fmt.Errorf("unknown AutoFieldNumbers field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *BlogPostMutation) AddedFields() []string {
-	var fields []string
-	if m.addexternal_id != nil {
-		fields = append(fields, blogpost.FieldExternalID)
-	}
-	return fields
+func (m *AutoFieldNumbersMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *BlogPostMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case blogpost.FieldExternalID:
-		return m.AddedExternalID()
-	}
+func (m *AutoFieldNumbersMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BlogPostMutation) AddField(name string, value ent.Value) error {
+func (m *AutoFieldNumbersMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case blogpost.FieldExternalID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddExternalID(v)
-		return nil
 	}
-	return fmt.Errorf("unknown BlogPost numeric field %s", name)
+	return fmt.Errorf("unknown AutoFieldNumbers numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *BlogPostMutation) ClearedFields() []string {
+func (m *AutoFieldNumbersMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *BlogPostMutation) FieldCleared(name string) bool {
+func (m *AutoFieldNumbersMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *BlogPostMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown BlogPost nullable field %s", name)
+func (m *AutoFieldNumbersMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AutoFieldNumbers nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *BlogPostMutation) ResetField(name string) error {
+func (m *AutoFieldNumbersMutation) ResetField(name string) error {
 	switch name {
-	case blogpost.FieldTitle:
-		m.ResetTitle()
+	case autofieldnumbers.FieldFirst:
+		m.ResetFirst()
 		return nil
-	case blogpost.FieldBody:
-		m.ResetBody()
+	case autofieldnumbers.FieldPinned:
+		m.ResetPinned()
 		return nil
-	case blogpost.FieldExternalID:
-		m.ResetExternalID()
+	case autofieldnumbers.FieldSkipped:
+		m.ResetSkipped()
+		return nil
+	case autofieldnumbers.FieldSecond:
+		m.ResetSecond()
 		return nil
 	}
-	return fmt.Errorf("unknown BlogPost field %s", name)
+	return fmt.Errorf("unknown AutoFieldNumbers field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BlogPostMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.author != nil {
-		edges = append(edges, blogpost.EdgeAuthor)
-	}
-	if m.categories != nil {
-		edges = append(edges, blogpost.EdgeCategories)
-	}
+func (m *AutoFieldNumbersMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *BlogPostMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case blogpost.EdgeAuthor:
-		if id := m.author; id != nil {
-			return []ent.Value{*id}
-		}
-	case blogpost.EdgeCategories:
-		ids := make([]ent.Value, 0, len(m.categories))
-		for id := range m.categories {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AutoFieldNumbersMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BlogPostMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedcategories != nil {
-		edges = append(edges, blogpost.EdgeCategories)
-	}
+func (m *AutoFieldNumbersMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *BlogPostMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case blogpost.EdgeCategories:
-		ids := make([]ent.Value, 0, len(m.removedcategories))
-		for id := range m.removedcategories {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AutoFieldNumbersMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BlogPostMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedauthor {
-		edges = append(edges, blogpost.EdgeAuthor)
-	}
-	if m.clearedcategories {
-		edges = append(edges, blogpost.EdgeCategories)
-	}
+func (m *AutoFieldNumbersMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *BlogPostMutation) EdgeCleared(name string) bool {
-	switch name {
-	case blogpost.EdgeAuthor:
-		return m.clearedauthor
-	case blogpost.EdgeCategories:
-		return m.clearedcategories
-	}
+func (m *AutoFieldNumbersMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *BlogPostMutation) ClearEdge(name string) error {
-	switch name {
-	case blogpost.EdgeAuthor:
-		m.ClearAuthor()
-		return nil
-	}
-	return fmt.Errorf("unknown BlogPost unique edge %s", name)
+func (m *AutoFieldNumbersMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AutoFieldNumbers unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *BlogPostMutation) ResetEdge(name string) error {
-	switch name {
-	case blogpost.EdgeAuthor:
-		m.ResetAuthor()
-		return nil
-	case blogpost.EdgeCategories:
-		m.ResetCategories()
-		return nil
-	}
-	return fmt.Errorf("unknown BlogPost edge %s", name)
+func (m *AutoFieldNumbersMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AutoFieldNumbers edge %s", name)
 }
 
-// CategoryMutation represents an operation that mutates the Category nodes in the graph.
-type CategoryMutation struct {
+// BlogPostMutation represents an operation that mutates the BlogPost nodes in the graph.
+type BlogPostMutation struct {
 	config
 	op                Op
 	typ               string
 	id                *int
-	name              *string
-	description       *string
+	title             *string
+	body              *string
+	external_id       *int
+	addexternal_id    *int
 	clearedFields     map[string]struct{}
-	blog_posts        map[int]struct{}
-	removedblog_posts map[int]struct{}
-	clearedblog_posts bool
+	author            *int
+	clearedauthor     bool
+	categories        map[int]struct{}
+	removedcategories map[int]struct{}
+	clearedcategories bool
 	done              bool
-	oldValue          func(context.Context) (*Category, error)
-	predicates        []predicate.Category
+	oldValue          func(context.Context) (*BlogPost, error)
+	predicates        []predicate.BlogPost
 }
 
-var _ ent.Mutation = (*CategoryMutation)(nil)
+var _ ent.Mutation = (*BlogPostMutation)(nil)
 
-// categoryOption allows management of the mutation configuration using functional options.
-type categoryOption func(*CategoryMutation)
+// blogpostOption allows management of the mutation configuration using functional options.
+type blogpostOption func(*BlogPostMutation)
 
-// newCategoryMutation creates new mutation for the Category entity.
-func newCategoryMutation(c config, op Op, opts ...categoryOption) *CategoryMutation {
-	m := &CategoryMutation{
+// newBlogPostMutation creates new mutation for the BlogPost entity.
+func newBlogPostMutation(c config, op Op, opts ...blogpostOption) *BlogPostMutation {
+	m := &BlogPostMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCategory,
+		typ:           TypeBlogPost,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -957,20 +845,20 @@ func newCategoryMutation(c config, op Op, opts ...categoryOption) *CategoryMutat
 	return m
 }
 
-// withCategoryID sets the ID field of the mutation.
-func withCategoryID(id int) categoryOption {
-	return func(m *CategoryMutation) {
+// withBlogPostID sets the ID field of the mutation.
+func withBlogPostID(id int) blogpostOption {
+	return func(m *BlogPostMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Category
+			value *BlogPost
 		)
-		m.oldValue = func(ctx context.Context) (*Category, error) {
+		m.oldValue = func(ctx context.Context) (*BlogPost, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Category.Get(ctx, id)
+					value, err = m.Client().BlogPost.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -979,10 +867,10 @@ func withCategoryID(id int) categoryOption {
 	}
 }
 
-// withCategory sets the old Category of the mutation.
-func withCategory(node *Category) categoryOption {
-	return func(m *CategoryMutation) {
-		m.oldValue = func(context.Context) (*Category, error) {
+// withBlogPost sets the old BlogPost of the mutation.
+func withBlogPost(node *BlogPost) blogpostOption {
+	return func(m *BlogPostMutation) {
+		m.oldValue = func(context.Context) (*BlogPost, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -991,7 +879,7 @@ func withCategory(node *Category) categoryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CategoryMutation) Client() *Client {
+func (m BlogPostMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -999,7 +887,7 @@ func (m CategoryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CategoryMutation) Tx() (*Tx, error) {
+func (m BlogPostMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1010,7 +898,7 @@ func (m CategoryMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CategoryMutation) ID() (id int, exists bool) {
+func (m *BlogPostMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1021,7 +909,7 @@ func (m *CategoryMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CategoryMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BlogPostMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1030,163 +918,261 @@ func (m *CategoryMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Category.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BlogPost.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *CategoryMutation) SetName(s string) {
-	m.name = &s
+// SetTitle sets the "title" field.
+func (m *BlogPostMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *CategoryMutation) Name() (r string, exists bool) {
-	v := m.name
+// Title returns the value of the "title" field in the mutation.
+func (m *BlogPostMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the BlogPost entity.
+// If the BlogPost object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *BlogPostMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Title, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *CategoryMutation) ResetName() {
-	m.name = nil
+// ResetTitle resets all changes to the "title" field.
+func (m *BlogPostMutation) ResetTitle() {
+	m.title = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *CategoryMutation) SetDescription(s string) {
-	m.description = &s
+// SetBody sets the "body" field.
+func (m *BlogPostMutation) SetBody(s string) {
+	m.body = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *CategoryMutation) Description() (r string, exists bool) {
-	v := m.description
+// Body returns the value of the "body" field in the mutation.
+func (m *BlogPostMutation) Body() (r string, exists bool) {
+	v := m.body
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// OldBody returns the old "body" field's value of the BlogPost entity.
+// If the BlogPost object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *BlogPostMutation) OldBody(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldBody requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *CategoryMutation) ResetDescription() {
-	m.description = nil
+	return oldValue.Body, nil
 }
 
-// AddBlogPostIDs adds the "blog_posts" edge to the BlogPost entity by ids.
-func (m *CategoryMutation) AddBlogPostIDs(ids ...int) {
-	if m.blog_posts == nil {
-		m.blog_posts = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.blog_posts[ids[i]] = struct{}{}
-	}
+// ResetBody resets all changes to the "body" field.
+func (m *BlogPostMutation) ResetBody() {
+	m.body = nil
 }
 
-// ClearBlogPosts clears the "blog_posts" edge to the BlogPost entity.
-func (m *CategoryMutation) ClearBlogPosts() {
-	m.clearedblog_posts = true
+// SetExternalID sets the "external_id" field.
+func (m *BlogPostMutation) SetExternalID(i int) {
+	m.external_id = &i
+	m.addexternal_id = nil
 }
 
-// BlogPostsCleared reports if the "blog_posts" edge to the BlogPost entity was cleared.
-func (m *CategoryMutation) BlogPostsCleared() bool {
-	return m.clearedblog_posts
+// ExternalID returns the value of the "external_id" field in the mutation.
+func (m *BlogPostMutation) ExternalID() (r int, exists bool) {
+	v := m.external_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveBlogPostIDs removes the "blog_posts" edge to the BlogPost entity by IDs.
-func (m *CategoryMutation) RemoveBlogPostIDs(ids ...int) {
-	if m.removedblog_posts == nil {
-		m.removedblog_posts = make(map[int]struct{})
+// OldExternalID returns the old "external_id" field's value of the BlogPost entity.
+// If the BlogPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogPostMutation) OldExternalID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
+	}
+	return oldValue.ExternalID, nil
+}
+
+// AddExternalID adds i to the "external_id" field.
+func (m *BlogPostMutation) AddExternalID(i int) {
+	if m.addexternal_id != nil {
+		*m.addexternal_id += i
+	} else {
+		m.addexternal_id = &i
+	}
+}
+
+// AddedExternalID returns the value that was added to the "external_id" field in this mutation.
+func (m *BlogPostMutation) AddedExternalID() (r int, exists bool) {
+	v := m.addexternal_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExternalID resets all changes to the "external_id" field.
+func (m *BlogPostMutation) ResetExternalID() {
+	m.external_id = nil
+	m.addexternal_id = nil
+}
+
+// SetAuthorID sets the "author" edge to the User entity by id.
+func (m *BlogPostMutation) SetAuthorID(id int) {
+	m.author = &id
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (m *BlogPostMutation) ClearAuthor() {
+	m.clearedauthor = true
+}
+
+// AuthorCleared reports if the "author" edge to the User entity was cleared.
+func (m *BlogPostMutation) AuthorCleared() bool {
+	return m.clearedauthor
+}
+
+// AuthorID returns the "author" edge ID in the mutation.
+func (m *BlogPostMutation) AuthorID() (id int, exists bool) {
+	if m.author != nil {
+		return *m.author, true
+	}
+	return
+}
+
+// AuthorIDs returns the "author" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AuthorID instead. It exists only for internal usage by the builders.
+func (m *BlogPostMutation) AuthorIDs() (ids []int) {
+	if id := m.author; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAuthor resets all changes to the "author" edge.
+func (m *BlogPostMutation) ResetAuthor() {
+	m.author = nil
+	m.clearedauthor = false
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by ids.
+func (m *BlogPostMutation) AddCategoryIDs(ids ...int) {
+	if m.categories == nil {
+		m.categories = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.blog_posts, ids[i])
-		m.removedblog_posts[ids[i]] = struct{}{}
+		m.categories[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedBlogPosts returns the removed IDs of the "blog_posts" edge to the BlogPost entity.
-func (m *CategoryMutation) RemovedBlogPostsIDs() (ids []int) {
-	for id := range m.removedblog_posts {
+// ClearCategories clears the "categories" edge to the Category entity.
+func (m *BlogPostMutation) ClearCategories() {
+	m.clearedcategories = true
+}
+
+// CategoriesCleared reports if the "categories" edge to the Category entity was cleared.
+func (m *BlogPostMutation) CategoriesCleared() bool {
+	return m.clearedcategories
+}
+
+// RemoveCategoryIDs removes the "categories" edge to the Category entity by IDs.
+func (m *BlogPostMutation) RemoveCategoryIDs(ids ...int) {
+	if m.removedcategories == nil {
+		m.removedcategories = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.categories, ids[i])
+		m.removedcategories[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCategories returns the removed IDs of the "categories" edge to the Category entity.
+func (m *BlogPostMutation) RemovedCategoriesIDs() (ids []int) {
+	for id := range m.removedcategories {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// BlogPostsIDs returns the "blog_posts" edge IDs in the mutation.
-func (m *CategoryMutation) BlogPostsIDs() (ids []int) {
-	for id := range m.blog_posts {
+// CategoriesIDs returns the "categories" edge IDs in the mutation.
+func (m *BlogPostMutation) CategoriesIDs() (ids []int) {
+	for id := range m.categories {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetBlogPosts resets all changes to the "blog_posts" edge.
-func (m *CategoryMutation) ResetBlogPosts() {
-	m.blog_posts = nil
-	m.clearedblog_posts = false
-	m.removedblog_posts = nil
+// ResetCategories resets all changes to the "categories" edge.
+func (m *BlogPostMutation) ResetCategories() {
+	m.categories = nil
+	m.clearedcategories = false
+	m.removedcategories = nil
 }
 
-// Where appends a list predicates to the CategoryMutation builder.
-func (m *CategoryMutation) Where(ps ...predicate.Category) {
+// Where appends a list predicates to the BlogPostMutation builder.
+func (m *BlogPostMutation) Where(ps ...predicate.BlogPost) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *CategoryMutation) Op() Op {
+func (m *BlogPostMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Category).
-func (m *CategoryMutation) Type() string {
+// Type returns the node type of this mutation (BlogPost).
+func (m *BlogPostMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CategoryMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.name != nil {
-		fields = append(fields, category.FieldName)
+func (m *BlogPostMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.title != nil {
+		fields = append(fields, blogpost.FieldTitle)
 	}
-	if m.description != nil {
-		fields = append(fields, category.FieldDescription)
+	if m.body != nil {
+		fields = append(fields, blogpost.FieldBody)
+	}
+	if m.external_id != nil {
+		fields = append(fields, blogpost.FieldExternalID)
 	}
 	return fields
 }
@@ -1194,12 +1180,14 @@ func (m *CategoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
+func (m *BlogPostMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case category.FieldName:
-		return m.Name()
-	case category.FieldDescription:
-		return m.Description()
+	case blogpost.FieldTitle:
+		return m.Title()
+	case blogpost.FieldBody:
+		return m.Body()
+	case blogpost.FieldExternalID:
+		return m.ExternalID()
 	}
 	return nil, false
 }
@@ -1207,110 +1195,144 @@ func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BlogPostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case category.FieldName:
-		return m.OldName(ctx)
-	case category.FieldDescription:
-		return m.OldDescription(ctx)
+	case blogpost.FieldTitle:
+		return m.OldTitle(ctx)
+	case blogpost.FieldBody:
+		return m.OldBody(ctx)
+	case blogpost.FieldExternalID:
+		return m.OldExternalID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Category field %s", name)
+	return nil, fmt.Errorf("unknown BlogPost field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CategoryMutation) SetField(name string, value ent.Value) error {
+func (m *BlogPostMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case category.FieldName:
+	case blogpost.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetTitle(v)
 		return nil
-	case category.FieldDescription:
+	case blogpost.FieldBody:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetBody(v)
+		return nil
+	case blogpost.FieldExternalID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Category field %s", name)
+	return fmt.Errorf("unknown BlogPost field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CategoryMutation) AddedFields() []string {
-	return nil
+func (m *BlogPostMutation) AddedFields() []string {
+	var fields []string
+	if m.addexternal_id != nil {
+		fields = append(fields, blogpost.FieldExternalID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CategoryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BlogPostMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case blogpost.FieldExternalID:
+		return m.AddedExternalID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CategoryMutation) AddField(name string, value ent.Value) error {
+func (m *BlogPostMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case blogpost.FieldExternalID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExternalID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Category numeric field %s", name)
+	return fmt.Errorf("unknown BlogPost numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CategoryMutation) ClearedFields() []string {
+func (m *BlogPostMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CategoryMutation) FieldCleared(name string) bool {
+func (m *BlogPostMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CategoryMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Category nullable field %s", name)
+func (m *BlogPostMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown BlogPost nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CategoryMutation) ResetField(name string) error {
+func (m *BlogPostMutation) ResetField(name string) error {
 	switch name {
-	case category.FieldName:
-		m.ResetName()
+	case blogpost.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case category.FieldDescription:
-		m.ResetDescription()
+	case blogpost.FieldBody:
+		m.ResetBody()
+		return nil
+	case blogpost.FieldExternalID:
+		m.ResetExternalID()
 		return nil
 	}
-	return fmt.Errorf("unknown Category field %s", name)
+	return fmt.Errorf("unknown BlogPost field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CategoryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.blog_posts != nil {
-		edges = append(edges, category.EdgeBlogPosts)
+func (m *BlogPostMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.author != nil {
+		edges = append(edges, blogpost.EdgeAuthor)
+	}
+	if m.categories != nil {
+		edges = append(edges, blogpost.EdgeCategories)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CategoryMutation) AddedIDs(name string) []ent.Value {
+func (m *BlogPostMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case category.EdgeBlogPosts:
-		ids := make([]ent.Value, 0, len(m.blog_posts))
-		for id := range m.blog_posts {
+	case blogpost.EdgeAuthor:
+		if id := m.author; id != nil {
+			return []ent.Value{*id}
+		}
+	case blogpost.EdgeCategories:
+		ids := make([]ent.Value, 0, len(m.categories))
+		for id := range m.categories {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1319,21 +1341,21 @@ func (m *CategoryMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CategoryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedblog_posts != nil {
-		edges = append(edges, category.EdgeBlogPosts)
+func (m *BlogPostMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedcategories != nil {
+		edges = append(edges, blogpost.EdgeCategories)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CategoryMutation) RemovedIDs(name string) []ent.Value {
+func (m *BlogPostMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case category.EdgeBlogPosts:
-		ids := make([]ent.Value, 0, len(m.removedblog_posts))
-		for id := range m.removedblog_posts {
+	case blogpost.EdgeCategories:
+		ids := make([]ent.Value, 0, len(m.removedcategories))
+		for id := range m.removedcategories {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1342,70 +1364,82 @@ func (m *CategoryMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CategoryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedblog_posts {
-		edges = append(edges, category.EdgeBlogPosts)
+func (m *BlogPostMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedauthor {
+		edges = append(edges, blogpost.EdgeAuthor)
+	}
+	if m.clearedcategories {
+		edges = append(edges, blogpost.EdgeCategories)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CategoryMutation) EdgeCleared(name string) bool {
+func (m *BlogPostMutation) EdgeCleared(name string) bool {
 	switch name {
-	case category.EdgeBlogPosts:
-		return m.clearedblog_posts
+	case blogpost.EdgeAuthor:
+		return m.clearedauthor
+	case blogpost.EdgeCategories:
+		return m.clearedcategories
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CategoryMutation) ClearEdge(name string) error {
+func (m *BlogPostMutation) ClearEdge(name string) error {
 	switch name {
+	case blogpost.EdgeAuthor:
+		m.ClearAuthor()
+		return nil
 	}
-	return fmt.Errorf("unknown Category unique edge %s", name)
+	return fmt.Errorf("unknown BlogPost unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CategoryMutation) ResetEdge(name string) error {
+func (m *BlogPostMutation) ResetEdge(name string) error {
 	switch name {
-	case category.EdgeBlogPosts:
-		m.ResetBlogPosts()
+	case blogpost.EdgeAuthor:
+		m.ResetAuthor()
+		return nil
+	case blogpost.EdgeCategories:
+		m.ResetCategories()
 		return nil
 	}
-	return fmt.Errorf("unknown Category edge %s", name)
+	return fmt.Errorf("unknown BlogPost edge %s", name)
 }
 
-// DependsOnSkippedMutation represents an operation that mutates the DependsOnSkipped nodes in the graph.
-type DependsOnSkippedMutation struct {
+// CategoryMutation represents an operation that mutates the Category nodes in the graph.
+type CategoryMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	name           *string
-	clearedFields  map[string]struct{}
-	skipped        map[int]struct{}
-	removedskipped map[int]struct{}
-	clearedskipped bool
-	done           bool
-	oldValue       func(context.Context) (*DependsOnSkipped, error)
-	predicates     []predicate.DependsOnSkipped
+	op                Op
+	typ               string
+	id                *int
+	name              *string
+	description       *string
+	clearedFields     map[string]struct{}
+	blog_posts        map[int]struct{}
+	removedblog_posts map[int]struct{}
+	clearedblog_posts bool
+	done              bool
+	oldValue          func(context.Context) (*Category, error)
+	predicates        []predicate.Category
 }
 
-var _ ent.Mutation = (*DependsOnSkippedMutation)(nil)
+var _ ent.Mutation = (*CategoryMutation)(nil)
 
-// dependsonskippedOption allows management of the mutation configuration using functional options.
-type dependsonskippedOption func(*DependsOnSkippedMutation)
+// categoryOption allows management of the mutation configuration using functional options.
+type categoryOption func(*CategoryMutation)
 
-// newDependsOnSkippedMutation creates new mutation for the DependsOnSkipped entity.
-func newDependsOnSkippedMutation(c config, op Op, opts ...dependsonskippedOption) *DependsOnSkippedMutation {
-	m := &DependsOnSkippedMutation{
+// newCategoryMutation creates new mutation for the Category entity.
+func newCategoryMutation(c config, op Op, opts ...categoryOption) *CategoryMutation {
+	m := &CategoryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDependsOnSkipped,
+		typ:           TypeCategory,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1414,20 +1448,20 @@ func newDependsOnSkippedMutation(c config, op Op, opts ...dependsonskippedOption
 	return m
 }
 
-// withDependsOnSkippedID sets the ID field of the mutation.
-func withDependsOnSkippedID(id int) dependsonskippedOption {
-	return func(m *DependsOnSkippedMutation) {
+// withCategoryID sets the ID field of the mutation.
+func withCategoryID(id int) categoryOption {
+	return func(m *CategoryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DependsOnSkipped
+			value *Category
 		)
-		m.oldValue = func(ctx context.Context) (*DependsOnSkipped, error) {
+		m.oldValue = func(ctx context.Context) (*Category, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DependsOnSkipped.Get(ctx, id)
+					value, err = m.Client().Category.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1436,10 +1470,10 @@ func withDependsOnSkippedID(id int) dependsonskippedOption {
 	}
 }
 
-// withDependsOnSkipped sets the old DependsOnSkipped of the mutation.
-func withDependsOnSkipped(node *DependsOnSkipped) dependsonskippedOption {
-	return func(m *DependsOnSkippedMutation) {
-		m.oldValue = func(context.Context) (*DependsOnSkipped, error) {
+// withCategory sets the old Category of the mutation.
+func withCategory(node *Category) categoryOption {
+	return func(m *CategoryMutation) {
+		m.oldValue = func(context.Context) (*Category, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1448,7 +1482,7 @@ func withDependsOnSkipped(node *DependsOnSkipped) dependsonskippedOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DependsOnSkippedMutation) Client() *Client {
+func (m CategoryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1456,7 +1490,7 @@ func (m DependsOnSkippedMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DependsOnSkippedMutation) Tx() (*Tx, error) {
+func (m CategoryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1467,7 +1501,7 @@ func (m DependsOnSkippedMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DependsOnSkippedMutation) ID() (id int, exists bool) {
+func (m *CategoryMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1478,7 +1512,7 @@ func (m *DependsOnSkippedMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DependsOnSkippedMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CategoryMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1487,19 +1521,19 @@ func (m *DependsOnSkippedMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DependsOnSkipped.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Category.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *DependsOnSkippedMutation) SetName(s string) {
+func (m *CategoryMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *DependsOnSkippedMutation) Name() (r string, exists bool) {
+func (m *CategoryMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -1507,10 +1541,10 @@ func (m *DependsOnSkippedMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the DependsOnSkipped entity.
-// If the DependsOnSkipped object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DependsOnSkippedMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CategoryMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -1525,86 +1559,125 @@ func (m *DependsOnSkippedMutation) OldName(ctx context.Context) (v string, err e
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *DependsOnSkippedMutation) ResetName() {
+func (m *CategoryMutation) ResetName() {
 	m.name = nil
 }
 
-// AddSkippedIDs adds the "skipped" edge to the ImplicitSkippedMessage entity by ids.
-func (m *DependsOnSkippedMutation) AddSkippedIDs(ids ...int) {
-	if m.skipped == nil {
-		m.skipped = make(map[int]struct{})
+// SetDescription sets the "description" field.
+func (m *CategoryMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *CategoryMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *CategoryMutation) ResetDescription() {
+	m.description = nil
+}
+
+// AddBlogPostIDs adds the "blog_posts" edge to the BlogPost entity by ids.
+func (m *CategoryMutation) AddBlogPostIDs(ids ...int) {
+	if m.blog_posts == nil {
+		m.blog_posts = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.skipped[ids[i]] = struct{}{}
+		m.blog_posts[ids[i]] = struct{}{}
 	}
 }
 
-// ClearSkipped clears the "skipped" edge to the ImplicitSkippedMessage entity.
-func (m *DependsOnSkippedMutation) ClearSkipped() {
-	m.clearedskipped = true
+// ClearBlogPosts clears the "blog_posts" edge to the BlogPost entity.
+func (m *CategoryMutation) ClearBlogPosts() {
+	m.clearedblog_posts = true
 }
 
-// SkippedCleared reports if the "skipped" edge to the ImplicitSkippedMessage entity was cleared.
-func (m *DependsOnSkippedMutation) SkippedCleared() bool {
-	return m.clearedskipped
+// BlogPostsCleared reports if the "blog_posts" edge to the BlogPost entity was cleared.
+func (m *CategoryMutation) BlogPostsCleared() bool {
+	return m.clearedblog_posts
 }
 
-// RemoveSkippedIDs removes the "skipped" edge to the ImplicitSkippedMessage entity by IDs.
-func (m *DependsOnSkippedMutation) RemoveSkippedIDs(ids ...int) {
-	if m.removedskipped == nil {
-		m.removedskipped = make(map[int]struct{})
+// RemoveBlogPostIDs removes the "blog_posts" edge to the BlogPost entity by IDs.
+func (m *CategoryMutation) RemoveBlogPostIDs(ids ...int) {
+	if m.removedblog_posts == nil {
+		m.removedblog_posts = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.skipped, ids[i])
-		m.removedskipped[ids[i]] = struct{}{}
+		delete(m.blog_posts, ids[i])
+		m.removedblog_posts[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedSkipped returns the removed IDs of the "skipped" edge to the ImplicitSkippedMessage entity.
-func (m *DependsOnSkippedMutation) RemovedSkippedIDs() (ids []int) {
-	for id := range m.removedskipped {
+// RemovedBlogPosts returns the removed IDs of the "blog_posts" edge to the BlogPost entity.
+func (m *CategoryMutation) RemovedBlogPostsIDs() (ids []int) {
+	for id := range m.removedblog_posts {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// SkippedIDs returns the "skipped" edge IDs in the mutation.
-func (m *DependsOnSkippedMutation) SkippedIDs() (ids []int) {
-	for id := range m.skipped {
+// BlogPostsIDs returns the "blog_posts" edge IDs in the mutation.
+func (m *CategoryMutation) BlogPostsIDs() (ids []int) {
+	for id := range m.blog_posts {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetSkipped resets all changes to the "skipped" edge.
-func (m *DependsOnSkippedMutation) ResetSkipped() {
-	m.skipped = nil
-	m.clearedskipped = false
-	m.removedskipped = nil
+// ResetBlogPosts resets all changes to the "blog_posts" edge.
+func (m *CategoryMutation) ResetBlogPosts() {
+	m.blog_posts = nil
+	m.clearedblog_posts = false
+	m.removedblog_posts = nil
 }
 
-// Where appends a list predicates to the DependsOnSkippedMutation builder.
-func (m *DependsOnSkippedMutation) Where(ps ...predicate.DependsOnSkipped) {
+// Where appends a list predicates to the CategoryMutation builder.
+func (m *CategoryMutation) Where(ps ...predicate.Category) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *DependsOnSkippedMutation) Op() Op {
+func (m *CategoryMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (DependsOnSkipped).
-func (m *DependsOnSkippedMutation) Type() string {
+// Type returns the node type of this mutation (Category).
+func (m *CategoryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DependsOnSkippedMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.name != nil {
-		fields = append(fields, dependsonskipped.FieldName)
+func (m *CategoryMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, category.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, category.FieldDescription)
 	}
 	return fields
 }
@@ -1612,10 +1685,12 @@ func (m *DependsOnSkippedMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DependsOnSkippedMutation) Field(name string) (ent.Value, bool) {
+func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case dependsonskipped.FieldName:
+	case category.FieldName:
 		return m.Name()
+	case category.FieldDescription:
+		return m.Description()
 	}
 	return nil, false
 }
@@ -1623,98 +1698,110 @@ func (m *DependsOnSkippedMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DependsOnSkippedMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case dependsonskipped.FieldName:
+	case category.FieldName:
 		return m.OldName(ctx)
+	case category.FieldDescription:
+		return m.OldDescription(ctx)
 	}
-	return nil, fmt.Errorf("unknown DependsOnSkipped field %s", name)
+	return nil, fmt.Errorf("unknown Category field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DependsOnSkippedMutation) SetField(name string, value ent.Value) error {
+func (m *CategoryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case dependsonskipped.FieldName:
+	case category.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
+	case category.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
 	}
-	return fmt.Errorf("unknown DependsOnSkipped field %s", name)
+	return fmt.Errorf("unknown Category field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DependsOnSkippedMutation) AddedFields() []string {
+func (m *CategoryMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DependsOnSkippedMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CategoryMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DependsOnSkippedMutation) AddField(name string, value ent.Value) error {
+func (m *CategoryMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown DependsOnSkipped numeric field %s", name)
+	return fmt.Errorf("unknown Category numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DependsOnSkippedMutation) ClearedFields() []string {
+func (m *CategoryMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DependsOnSkippedMutation) FieldCleared(name string) bool {
+func (m *CategoryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DependsOnSkippedMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown DependsOnSkipped nullable field %s", name)
+func (m *CategoryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Category nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DependsOnSkippedMutation) ResetField(name string) error {
+func (m *CategoryMutation) ResetField(name string) error {
 	switch name {
-	case dependsonskipped.FieldName:
+	case category.FieldName:
 		m.ResetName()
 		return nil
+	case category.FieldDescription:
+		m.ResetDescription()
+		return nil
 	}
-	return fmt.Errorf("unknown DependsOnSkipped field %s", name)
+	return fmt.Errorf("unknown Category field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DependsOnSkippedMutation) AddedEdges() []string {
+func (m *CategoryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.skipped != nil {
-		edges = append(edges, dependsonskipped.EdgeSkipped)
+	if m.blog_posts != nil {
+		edges = append(edges, category.EdgeBlogPosts)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DependsOnSkippedMutation) AddedIDs(name string) []ent.Value {
+func (m *CategoryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case dependsonskipped.EdgeSkipped:
-		ids := make([]ent.Value, 0, len(m.skipped))
-		for id := range m.skipped {
+	case category.EdgeBlogPosts:
+		ids := make([]ent.Value, 0, len(m.blog_posts))
+		for id := range m.blog_posts {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1723,21 +1810,21 @@ func (m *DependsOnSkippedMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DependsOnSkippedMutation) RemovedEdges() []string {
+func (m *CategoryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedskipped != nil {
-		edges = append(edges, dependsonskipped.EdgeSkipped)
+	if m.removedblog_posts != nil {
+		edges = append(edges, category.EdgeBlogPosts)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DependsOnSkippedMutation) RemovedIDs(name string) []ent.Value {
+func (m *CategoryMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case dependsonskipped.EdgeSkipped:
-		ids := make([]ent.Value, 0, len(m.removedskipped))
-		for id := range m.removedskipped {
+	case category.EdgeBlogPosts:
+		ids := make([]ent.Value, 0, len(m.removedblog_posts))
+		for id := range m.removedblog_posts {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1746,68 +1833,70 @@ func (m *DependsOnSkippedMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DependsOnSkippedMutation) ClearedEdges() []string {
+func (m *CategoryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedskipped {
-		edges = append(edges, dependsonskipped.EdgeSkipped)
+	if m.clearedblog_posts {
+		edges = append(edges, category.EdgeBlogPosts)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DependsOnSkippedMutation) EdgeCleared(name string) bool {
+func (m *CategoryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case dependsonskipped.EdgeSkipped:
-		return m.clearedskipped
+	case category.EdgeBlogPosts:
+		return m.clearedblog_posts
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DependsOnSkippedMutation) ClearEdge(name string) error {
+func (m *CategoryMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown DependsOnSkipped unique edge %s", name)
+	return fmt.Errorf("unknown Category unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DependsOnSkippedMutation) ResetEdge(name string) error {
+func (m *CategoryMutation) ResetEdge(name string) error {
 	switch name {
-	case dependsonskipped.EdgeSkipped:
-		m.ResetSkipped()
+	case category.EdgeBlogPosts:
+		m.ResetBlogPosts()
 		return nil
 	}
-	return fmt.Errorf("unknown DependsOnSkipped edge %s", name)
+	return fmt.Errorf("unknown Category edge %s", name)
 }
 
-// DuplicateNumberMessageMutation represents an operation that mutates the DuplicateNumberMessage nodes in the graph.
-type DuplicateNumberMessageMutation struct {
+// DependsOnSkippedMutation represents an operation that mutates the DependsOnSkipped nodes in the graph.
+type DependsOnSkippedMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	hello         *string
-	world         *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*DuplicateNumberMessage, error)
-	predicates    []predicate.DuplicateNumberMessage
+	op             Op
+	typ            string
+	id             *int
+	name           *string
+	clearedFields  map[string]struct{}
+	skipped        map[int]struct{}
+	removedskipped map[int]struct{}
+	clearedskipped bool
+	done           bool
+	oldValue       func(context.Context) (*DependsOnSkipped, error)
+	predicates     []predicate.DependsOnSkipped
 }
 
-var _ ent.Mutation = (*DuplicateNumberMessageMutation)(nil)
+var _ ent.Mutation = (*DependsOnSkippedMutation)(nil)
 
-// duplicatenumbermessageOption allows management of the mutation configuration using functional options.
-type duplicatenumbermessageOption func(*DuplicateNumberMessageMutation)
+// dependsonskippedOption allows management of the mutation configuration using functional options.
+type dependsonskippedOption func(*DependsOnSkippedMutation)
 
-// newDuplicateNumberMessageMutation creates new mutation for the DuplicateNumberMessage entity.
-func newDuplicateNumberMessageMutation(c config, op Op, opts ...duplicatenumbermessageOption) *DuplicateNumberMessageMutation {
-	m := &DuplicateNumberMessageMutation{
+// newDependsOnSkippedMutation creates new mutation for the DependsOnSkipped entity.
+func newDependsOnSkippedMutation(c config, op Op, opts ...dependsonskippedOption) *DependsOnSkippedMutation {
+	m := &DependsOnSkippedMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDuplicateNumberMessage,
+		typ:           TypeDependsOnSkipped,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1816,20 +1905,20 @@ func newDuplicateNumberMessageMutation(c config, op Op, opts ...duplicatenumberm
 	return m
 }
 
-// withDuplicateNumberMessageID sets the ID field of the mutation.
-func withDuplicateNumberMessageID(id int) duplicatenumbermessageOption {
-	return func(m *DuplicateNumberMessageMutation) {
+// withDependsOnSkippedID sets the ID field of the mutation.
+func withDependsOnSkippedID(id int) dependsonskippedOption {
+	return func(m *DependsOnSkippedMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DuplicateNumberMessage
+			value *DependsOnSkipped
 		)
-		m.oldValue = func(ctx context.Context) (*DuplicateNumberMessage, error) {
+		m.oldValue = func(ctx context.Context) (*DependsOnSkipped, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DuplicateNumberMessage.Get(ctx, id)
+					value, err = m.Client().DependsOnSkipped.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1838,10 +1927,10 @@ func withDuplicateNumberMessageID(id int) duplicatenumbermessageOption {
 	}
 }
 
-// withDuplicateNumberMessage sets the old DuplicateNumberMessage of the mutation.
-func withDuplicateNumberMessage(node *DuplicateNumberMessage) duplicatenumbermessageOption {
-	return func(m *DuplicateNumberMessageMutation) {
-		m.oldValue = func(context.Context) (*DuplicateNumberMessage, error) {
+// withDependsOnSkipped sets the old DependsOnSkipped of the mutation.
+func withDependsOnSkipped(node *DependsOnSkipped) dependsonskippedOption {
+	return func(m *DependsOnSkippedMutation) {
+		m.oldValue = func(context.Context) (*DependsOnSkipped, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1850,7 +1939,7 @@ func withDuplicateNumberMessage(node *DuplicateNumberMessage) duplicatenumbermes
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DuplicateNumberMessageMutation) Client() *Client {
+func (m DependsOnSkippedMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1858,7 +1947,7 @@ func (m DuplicateNumberMessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DuplicateNumberMessageMutation) Tx() (*Tx, error) {
+func (m DependsOnSkippedMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1869,7 +1958,7 @@ func (m DuplicateNumberMessageMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DuplicateNumberMessageMutation) ID() (id int, exists bool) {
+func (m *DependsOnSkippedMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1880,7 +1969,7 @@ func (m *DuplicateNumberMessageMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DuplicateNumberMessageMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DependsOnSkippedMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1889,109 +1978,124 @@ func (m *DuplicateNumberMessageMutation) IDs(ctx context.Context) ([]int, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DuplicateNumberMessage.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DependsOnSkipped.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetHello sets the "hello" field.
-func (m *DuplicateNumberMessageMutation) SetHello(s string) {
-	m.hello = &s
+// SetName sets the "name" field.
+func (m *DependsOnSkippedMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Hello returns the value of the "hello" field in the mutation.
-func (m *DuplicateNumberMessageMutation) Hello() (r string, exists bool) {
-	v := m.hello
+// Name returns the value of the "name" field in the mutation.
+func (m *DependsOnSkippedMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHello returns the old "hello" field's value of the DuplicateNumberMessage entity.
-// If the DuplicateNumberMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the DependsOnSkipped entity.
+// If the DependsOnSkipped object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DuplicateNumberMessageMutation) OldHello(ctx context.Context) (v string, err error) {
+func (m *DependsOnSkippedMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHello is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHello requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHello: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Hello, nil
-}
-
-// ResetHello resets all changes to the "hello" field.
-func (m *DuplicateNumberMessageMutation) ResetHello() {
-	m.hello = nil
+	return oldValue.Name, nil
 }
 
-// SetWorld sets the "world" field.
-func (m *DuplicateNumberMessageMutation) SetWorld(s string) {
-	m.world = &s
+// ResetName resets all changes to the "name" field.
+func (m *DependsOnSkippedMutation) ResetName() {
+	m.name = nil
 }
 
-// World returns the value of the "world" field in the mutation.
-func (m *DuplicateNumberMessageMutation) World() (r string, exists bool) {
-	v := m.world
-	if v == nil {
-		return
+// AddSkippedIDs adds the "skipped" edge to the ImplicitSkippedMessage entity by ids.
+func (m *DependsOnSkippedMutation) AddSkippedIDs(ids ...int) {
+	if m.skipped == nil {
+		m.skipped = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.skipped[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldWorld returns the old "world" field's value of the DuplicateNumberMessage entity.
-// If the DuplicateNumberMessage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DuplicateNumberMessageMutation) OldWorld(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWorld is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWorld requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWorld: %w", err)
-	}
-	return oldValue.World, nil
+// ClearSkipped clears the "skipped" edge to the ImplicitSkippedMessage entity.
+func (m *DependsOnSkippedMutation) ClearSkipped() {
+	m.clearedskipped = true
 }
 
-// ResetWorld resets all changes to the "world" field.
-func (m *DuplicateNumberMessageMutation) ResetWorld() {
-	m.world = nil
+// SkippedCleared reports if the "skipped" edge to the ImplicitSkippedMessage entity was cleared.
+func (m *DependsOnSkippedMutation) SkippedCleared() bool {
+	return m.clearedskipped
 }
 
-// Where appends a list predicates to the DuplicateNumberMessageMutation builder.
-func (m *DuplicateNumberMessageMutation) Where(ps ...predicate.DuplicateNumberMessage) {
+// RemoveSkippedIDs removes the "skipped" edge to the ImplicitSkippedMessage entity by IDs.
+func (m *DependsOnSkippedMutation) RemoveSkippedIDs(ids ...int) {
+	if m.removedskipped == nil {
+		m.removedskipped = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.skipped, ids[i])
+		m.removedskipped[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSkipped returns the removed IDs of the "skipped" edge to the ImplicitSkippedMessage entity.
+func (m *DependsOnSkippedMutation) RemovedSkippedIDs() (ids []int) {
+	for id := range m.removedskipped {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SkippedIDs returns the "skipped" edge IDs in the mutation.
+func (m *DependsOnSkippedMutation) SkippedIDs() (ids []int) {
+	for id := range m.skipped {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSkipped resets all changes to the "skipped" edge.
+func (m *DependsOnSkippedMutation) ResetSkipped() {
+	m.skipped = nil
+	m.clearedskipped = false
+	m.removedskipped = nil
+}
+
+// Where appends a list predicates to the DependsOnSkippedMutation builder.
+func (m *DependsOnSkippedMutation) Where(ps ...predicate.DependsOnSkipped) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *DuplicateNumberMessageMutation) Op() Op {
+func (m *DependsOnSkippedMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (DuplicateNumberMessage).
-func (m *DuplicateNumberMessageMutation) Type() string {
+// Type returns the node type of this mutation (DependsOnSkipped).
+func (m *DependsOnSkippedMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DuplicateNumberMessageMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.hello != nil {
-		fields = append(fields, duplicatenumbermessage.FieldHello)
-	}
-	if m.world != nil {
-		fields = append(fields, duplicatenumbermessage.FieldWorld)
+func (m *DependsOnSkippedMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.name != nil {
+		fields = append(fields, dependsonskipped.FieldName)
 	}
 	return fields
 }
@@ -1999,12 +2103,10 @@ func (m *DuplicateNumberMessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DuplicateNumberMessageMutation) Field(name string) (ent.Value, bool) {
+func (m *DependsOnSkippedMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case duplicatenumbermessage.FieldHello:
-		return m.Hello()
-	case duplicatenumbermessage.FieldWorld:
-		return m.World()
+	case dependsonskipped.FieldName:
+		return m.Name()
 	}
 	return nil, false
 }
@@ -2012,165 +2114,191 @@ func (m *DuplicateNumberMessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DuplicateNumberMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DependsOnSkippedMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case duplicatenumbermessage.FieldHello:
-		return m.OldHello(ctx)
-	case duplicatenumbermessage.FieldWorld:
-		return m.OldWorld(ctx)
+	case dependsonskipped.FieldName:
+		return m.OldName(ctx)
 	}
-	return nil, fmt.Errorf("unknown DuplicateNumberMessage field %s", name)
+	return nil, fmt.Errorf("unknown DependsOnSkipped field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DuplicateNumberMessageMutation) SetField(name string, value ent.Value) error {
+func (m *DependsOnSkippedMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case duplicatenumbermessage.FieldHello:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHello(v)
-		return nil
-	case duplicatenumbermessage.FieldWorld:
+	case dependsonskipped.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWorld(v)
+		m.SetName(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DuplicateNumberMessage field %s", name)
+	return fmt.Errorf("unknown DependsOnSkipped field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DuplicateNumberMessageMutation) AddedFields() []string {
+func (m *DependsOnSkippedMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DuplicateNumberMessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DependsOnSkippedMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DuplicateNumberMessageMutation) AddField(name string, value ent.Value) error {
+func (m *DependsOnSkippedMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown DuplicateNumberMessage numeric field %s", name)
+	return fmt.Errorf("unknown DependsOnSkipped numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DuplicateNumberMessageMutation) ClearedFields() []string {
+func (m *DependsOnSkippedMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DuplicateNumberMessageMutation) FieldCleared(name string) bool {
+func (m *DependsOnSkippedMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DuplicateNumberMessageMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown DuplicateNumberMessage nullable field %s", name)
+func (m *DependsOnSkippedMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DependsOnSkipped nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DuplicateNumberMessageMutation) ResetField(name string) error {
+func (m *DependsOnSkippedMutation) ResetField(name string) error {
 	switch name {
-	case duplicatenumbermessage.FieldHello:
-		m.ResetHello()
-		return nil
-	case duplicatenumbermessage.FieldWorld:
-		m.ResetWorld()
+	case dependsonskipped.FieldName:
+		m.ResetName()
 		return nil
 	}
-	return fmt.Errorf("unknown DuplicateNumberMessage field %s", name)
+	return fmt.Errorf("unknown DependsOnSkipped field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DuplicateNumberMessageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *DependsOnSkippedMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.skipped != nil {
+		edges = append(edges, dependsonskipped.EdgeSkipped)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DuplicateNumberMessageMutation) AddedIDs(name string) []ent.Value {
+func (m *DependsOnSkippedMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case dependsonskipped.EdgeSkipped:
+		ids := make([]ent.Value, 0, len(m.skipped))
+		for id := range m.skipped {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DuplicateNumberMessageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *DependsOnSkippedMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedskipped != nil {
+		edges = append(edges, dependsonskipped.EdgeSkipped)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DuplicateNumberMessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *DependsOnSkippedMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case dependsonskipped.EdgeSkipped:
+		ids := make([]ent.Value, 0, len(m.removedskipped))
+		for id := range m.removedskipped {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DuplicateNumberMessageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *DependsOnSkippedMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedskipped {
+		edges = append(edges, dependsonskipped.EdgeSkipped)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DuplicateNumberMessageMutation) EdgeCleared(name string) bool {
+func (m *DependsOnSkippedMutation) EdgeCleared(name string) bool {
+	switch name {
+	case dependsonskipped.EdgeSkipped:
+		return m.clearedskipped
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DuplicateNumberMessageMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown DuplicateNumberMessage unique edge %s", name)
+func (m *DependsOnSkippedMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DependsOnSkipped unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DuplicateNumberMessageMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown DuplicateNumberMessage edge %s", name)
+func (m *DependsOnSkippedMutation) ResetEdge(name string) error {
+	switch name {
+	case dependsonskipped.EdgeSkipped:
+		m.ResetSkipped()
+		return nil
+	}
+	return fmt.Errorf("unknown DependsOnSkipped edge %s", name)
 }
 
-// ExplicitSkippedMessageMutation represents an operation that mutates the ExplicitSkippedMessage nodes in the graph.
-type ExplicitSkippedMessageMutation struct {
+// DuplicateNumberMessageMutation represents an operation that mutates the DuplicateNumberMessage nodes in the graph.
+type DuplicateNumberMessageMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
+	hello         *string
+	world         *string
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*ExplicitSkippedMessage, error)
-	predicates    []predicate.ExplicitSkippedMessage
+	oldValue      func(context.Context) (*DuplicateNumberMessage, error)
+	predicates    []predicate.DuplicateNumberMessage
 }
 
-var _ ent.Mutation = (*ExplicitSkippedMessageMutation)(nil)
+var _ ent.Mutation = (*DuplicateNumberMessageMutation)(nil)
 
-// explicitskippedmessageOption allows management of the mutation configuration using functional options.
-type explicitskippedmessageOption func(*ExplicitSkippedMessageMutation)
+// duplicatenumbermessageOption allows management of the mutation configuration using functional options.
+type duplicatenumbermessageOption func(*DuplicateNumberMessageMutation)
 
-// newExplicitSkippedMessageMutation creates new mutation for the ExplicitSkippedMessage entity.
-func newExplicitSkippedMessageMutation(c config, op Op, opts ...explicitskippedmessageOption) *ExplicitSkippedMessageMutation {
-	m := &ExplicitSkippedMessageMutation{
+// newDuplicateNumberMessageMutation creates new mutation for the DuplicateNumberMessage entity.
+func newDuplicateNumberMessageMutation(c config, op Op, opts ...duplicatenumbermessageOption) *DuplicateNumberMessageMutation {
+	m := &DuplicateNumberMessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeExplicitSkippedMessage,
+		typ:           TypeDuplicateNumberMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2179,20 +2307,20 @@ func newExplicitSkippedMessageMutation(c config, op Op, opts ...explicitskippedm
 	return m
 }
 
-// withExplicitSkippedMessageID sets the ID field of the mutation.
-func withExplicitSkippedMessageID(id int) explicitskippedmessageOption {
-	return func(m *ExplicitSkippedMessageMutation) {
+// withDuplicateNumberMessageID sets the ID field of the mutation.
+func withDuplicateNumberMessageID(id int) duplicatenumbermessageOption {
+	return func(m *DuplicateNumberMessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ExplicitSkippedMessage
+			value *DuplicateNumberMessage
 		)
-		m.oldValue = func(ctx context.Context) (*ExplicitSkippedMessage, error) {
+		m.oldValue = func(ctx context.Context) (*DuplicateNumberMessage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ExplicitSkippedMessage.Get(ctx, id)
+					value, err = m.Client().DuplicateNumberMessage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2201,10 +2329,10 @@ func withExplicitSkippedMessageID(id int) explicitskippedmessageOption {
 	}
 }
 
-// withExplicitSkippedMessage sets the old ExplicitSkippedMessage of the mutation.
-func withExplicitSkippedMessage(node *ExplicitSkippedMessage) explicitskippedmessageOption {
-	return func(m *ExplicitSkippedMessageMutation) {
-		m.oldValue = func(context.Context) (*ExplicitSkippedMessage, error) {
+// withDuplicateNumberMessage sets the old DuplicateNumberMessage of the mutation.
+func withDuplicateNumberMessage(node *DuplicateNumberMessage) duplicatenumbermessageOption {
+	return func(m *DuplicateNumberMessageMutation) {
+		m.oldValue = func(context.Context) (*DuplicateNumberMessage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2213,7 +2341,7 @@ func withExplicitSkippedMessage(node *ExplicitSkippedMessage) explicitskippedmes
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ExplicitSkippedMessageMutation) Client() *Client {
+func (m DuplicateNumberMessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2221,7 +2349,7 @@ func (m ExplicitSkippedMessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ExplicitSkippedMessageMutation) Tx() (*Tx, error) {
+func (m DuplicateNumberMessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2232,7 +2360,7 @@ func (m ExplicitSkippedMessageMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ExplicitSkippedMessageMutation) ID() (id int, exists bool) {
+func (m *DuplicateNumberMessageMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2243,7 +2371,7 @@ func (m *ExplicitSkippedMessageMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ExplicitSkippedMessageMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DuplicateNumberMessageMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2252,178 +2380,288 @@ func (m *ExplicitSkippedMessageMutation) IDs(ctx context.Context) ([]int, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ExplicitSkippedMessage.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DuplicateNumberMessage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// Where appends a list predicates to the ExplicitSkippedMessageMutation builder.
-func (m *ExplicitSkippedMessageMutation) Where(ps ...predicate.ExplicitSkippedMessage) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// Op returns the operation name.
-func (m *ExplicitSkippedMessageMutation) Op() Op {
-	return m.op
+// SetHello sets the "hello" field.
+func (m *DuplicateNumberMessageMutation) SetHello(s string) {
+	m.hello = &s
 }
 
-// Type returns the node type of this mutation (ExplicitSkippedMessage).
-func (m *ExplicitSkippedMessageMutation) Type() string {
-	return m.typ
+// Hello returns the value of the "hello" field in the mutation.
+func (m *DuplicateNumberMessageMutation) Hello() (r string, exists bool) {
+	v := m.hello
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ExplicitSkippedMessageMutation) Fields() []string {
-	fields := make([]string, 0, 0)
-	return fields
+// OldHello returns the old "hello" field's value of the DuplicateNumberMessage entity.
+// If the DuplicateNumberMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DuplicateNumberMessageMutation) OldHello(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHello is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHello requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHello: %w", err)
+	}
+	return oldValue.Hello, nil
+}
+
+// ResetHello resets all changes to the "hello" field.
+func (m *DuplicateNumberMessageMutation) ResetHello() {
+	m.hello = nil
+}
+
+// SetWorld sets the "world" field.
+func (m *DuplicateNumberMessageMutation) SetWorld(s string) {
+	m.world = &s
+}
+
+// World returns the value of the "world" field in the mutation.
+func (m *DuplicateNumberMessageMutation) World() (r string, exists bool) {
+	v := m.world
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorld returns the old "world" field's value of the DuplicateNumberMessage entity.
+// If the DuplicateNumberMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DuplicateNumberMessageMutation) OldWorld(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorld is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorld requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorld: %w", err)
+	}
+	return oldValue.World, nil
+}
+
+// ResetWorld resets all changes to the "world" field.
+func (m *DuplicateNumberMessageMutation) ResetWorld() {
+	m.world = nil
+}
+
+// Where appends a list predicates to the DuplicateNumberMessageMutation builder.
+func (m *DuplicateNumberMessageMutation) Where(ps ...predicate.DuplicateNumberMessage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *DuplicateNumberMessageMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (DuplicateNumberMessage).
+func (m *DuplicateNumberMessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DuplicateNumberMessageMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.hello != nil {
+		fields = append(fields, duplicatenumbermessage.FieldHello)
+	}
+	if m.world != nil {
+		fields = append(fields, duplicatenumbermessage.FieldWorld)
+	}
+	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ExplicitSkippedMessageMutation) Field(name string) (ent.Value, bool) {
+func (m *DuplicateNumberMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case duplicatenumbermessage.FieldHello:
+		return m.Hello()
+	case duplicatenumbermessage.FieldWorld:
+		return m.World()
+	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ExplicitSkippedMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown ExplicitSkippedMessage field %s", name)
+func (m *DuplicateNumberMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case duplicatenumbermessage.FieldHello:
+		return m.OldHello(ctx)
+	case duplicatenumbermessage.FieldWorld:
+		return m.OldWorld(ctx)
+	}
+	return nil, fmt.Errorf("unknown DuplicateNumberMessage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ExplicitSkippedMessageMutation) SetField(name string, value ent.Value) error {
+func (m *DuplicateNumberMessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case duplicatenumbermessage.FieldHello:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHello(v)
+		return nil
+	case duplicatenumbermessage.FieldWorld:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorld(v)
+		return nil
 	}
-	return fmt.Errorf("unknown ExplicitSkippedMessage field %s", name)
+	return fmt.Errorf("unknown DuplicateNumberMessage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ExplicitSkippedMessageMutation) AddedFields() []string {
+func (m *DuplicateNumberMessageMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ExplicitSkippedMessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DuplicateNumberMessageMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ExplicitSkippedMessageMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown ExplicitSkippedMessage numeric field %s", name)
+func (m *DuplicateNumberMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DuplicateNumberMessage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ExplicitSkippedMessageMutation) ClearedFields() []string {
+func (m *DuplicateNumberMessageMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ExplicitSkippedMessageMutation) FieldCleared(name string) bool {
+func (m *DuplicateNumberMessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ExplicitSkippedMessageMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ExplicitSkippedMessage nullable field %s", name)
+func (m *DuplicateNumberMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DuplicateNumberMessage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ExplicitSkippedMessageMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown ExplicitSkippedMessage field %s", name)
+func (m *DuplicateNumberMessageMutation) ResetField(name string) error {
+	switch name {
+	case duplicatenumbermessage.FieldHello:
+		m.ResetHello()
+		return nil
+	case duplicatenumbermessage.FieldWorld:
+		m.ResetWorld()
+		return nil
+	}
+	return fmt.Errorf("unknown DuplicateNumberMessage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ExplicitSkippedMessageMutation) AddedEdges() []string {
+func (m *DuplicateNumberMessageMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ExplicitSkippedMessageMutation) AddedIDs(name string) []ent.Value {
+func (m *DuplicateNumberMessageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ExplicitSkippedMessageMutation) RemovedEdges() []string {
+func (m *DuplicateNumberMessageMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ExplicitSkippedMessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *DuplicateNumberMessageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ExplicitSkippedMessageMutation) ClearedEdges() []string {
+func (m *DuplicateNumberMessageMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ExplicitSkippedMessageMutation) EdgeCleared(name string) bool {
+func (m *DuplicateNumberMessageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ExplicitSkippedMessageMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ExplicitSkippedMessage unique edge %s", name)
+func (m *DuplicateNumberMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DuplicateNumberMessage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ExplicitSkippedMessageMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ExplicitSkippedMessage edge %s", name)
+func (m *DuplicateNumberMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DuplicateNumberMessage edge %s", name)
 }
 
-// ImageMutation represents an operation that mutates the Image nodes in the graph.
-type ImageMutation struct {
+// ExplicitSkippedMessageMutation represents an operation that mutates the ExplicitSkippedMessage nodes in the graph.
+type ExplicitSkippedMessageMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *uuid.UUID
-	url_path                *string
-	clearedFields           map[string]struct{}
-	user_profile_pic        map[int]struct{}
-	removeduser_profile_pic map[int]struct{}
-	cleareduser_profile_pic bool
-	done                    bool
-	oldValue                func(context.Context) (*Image, error)
-	predicates              []predicate.Image
+	op            Op
+	typ           string
+	id            *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ExplicitSkippedMessage, error)
+	predicates    []predicate.ExplicitSkippedMessage
 }
 
-var _ ent.Mutation = (*ImageMutation)(nil)
+var _ ent.Mutation = (*ExplicitSkippedMessageMutation)(nil)
 
-// imageOption allows management of the mutation configuration using functional options.
-type imageOption func(*ImageMutation)
+// explicitskippedmessageOption allows management of the mutation configuration using functional options.
+type explicitskippedmessageOption func(*ExplicitSkippedMessageMutation)
 
-// newImageMutation creates new mutation for the Image entity.
-func newImageMutation(c config, op Op, opts ...imageOption) *ImageMutation {
-	m := &ImageMutation{
+// newExplicitSkippedMessageMutation creates new mutation for the ExplicitSkippedMessage entity.
+func newExplicitSkippedMessageMutation(c config, op Op, opts ...explicitskippedmessageOption) *ExplicitSkippedMessageMutation {
+	m := &ExplicitSkippedMessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeImage,
+		typ:           TypeExplicitSkippedMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2432,20 +2670,20 @@ func newImageMutation(c config, op Op, opts ...imageOption) *ImageMutation {
 	return m
 }
 
-// withImageID sets the ID field of the mutation.
-func withImageID(id uuid.UUID) imageOption {
-	return func(m *ImageMutation) {
+// withExplicitSkippedMessageID sets the ID field of the mutation.
+func withExplicitSkippedMessageID(id int) explicitskippedmessageOption {
+	return func(m *ExplicitSkippedMessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Image
+			value *ExplicitSkippedMessage
 		)
-		m.oldValue = func(ctx context.Context) (*Image, error) {
+		m.oldValue = func(ctx context.Context) (*ExplicitSkippedMessage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Image.Get(ctx, id)
+					value, err = m.Client().ExplicitSkippedMessage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2454,10 +2692,10 @@ func withImageID(id uuid.UUID) imageOption {
 	}
 }
 
-// withImage sets the old Image of the mutation.
-func withImage(node *Image) imageOption {
-	return func(m *ImageMutation) {
-		m.oldValue = func(context.Context) (*Image, error) {
+// withExplicitSkippedMessage sets the old ExplicitSkippedMessage of the mutation.
+func withExplicitSkippedMessage(node *ExplicitSkippedMessage) explicitskippedmessageOption {
+	return func(m *ExplicitSkippedMessageMutation) {
+		m.oldValue = func(context.Context) (*ExplicitSkippedMessage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2466,7 +2704,7 @@ func withImage(node *Image) imageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ImageMutation) Client() *Client {
+func (m ExplicitSkippedMessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2474,7 +2712,7 @@ func (m ImageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ImageMutation) Tx() (*Tx, error) {
+func (m ExplicitSkippedMessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2483,15 +2721,9 @@ func (m ImageMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Image entities.
-func (m *ImageMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ImageMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ExplicitSkippedMessageMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2502,334 +2734,183 @@ func (m *ImageMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ImageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ExplicitSkippedMessageMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uuid.UUID{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Image.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ExplicitSkippedMessage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetURLPath sets the "url_path" field.
-func (m *ImageMutation) SetURLPath(s string) {
-	m.url_path = &s
-}
-
-// URLPath returns the value of the "url_path" field in the mutation.
-func (m *ImageMutation) URLPath() (r string, exists bool) {
-	v := m.url_path
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldURLPath returns the old "url_path" field's value of the Image entity.
-// If the Image object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImageMutation) OldURLPath(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURLPath is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURLPath requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURLPath: %w", err)
-	}
-	return oldValue.URLPath, nil
-}
-
-// ResetURLPath resets all changes to the "url_path" field.
-func (m *ImageMutation) ResetURLPath() {
-	m.url_path = nil
-}
-
-// AddUserProfilePicIDs adds the "user_profile_pic" edge to the User entity by ids.
-func (m *ImageMutation) AddUserProfilePicIDs(ids ...int) {
-	if m.user_profile_pic == nil {
-		m.user_profile_pic = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.user_profile_pic[ids[i]] = struct{}{}
-	}
-}
-
-// ClearUserProfilePic clears the "user_profile_pic" edge to the User entity.
-func (m *ImageMutation) ClearUserProfilePic() {
-	m.cleareduser_profile_pic = true
-}
-
-// UserProfilePicCleared reports if the "user_profile_pic" edge to the User entity was cleared.
-func (m *ImageMutation) UserProfilePicCleared() bool {
-	return m.cleareduser_profile_pic
-}
-
-// RemoveUserProfilePicIDs removes the "user_profile_pic" edge to the User entity by IDs.
-func (m *ImageMutation) RemoveUserProfilePicIDs(ids ...int) {
-	if m.removeduser_profile_pic == nil {
-		m.removeduser_profile_pic = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.user_profile_pic, ids[i])
-		m.removeduser_profile_pic[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedUserProfilePic returns the removed IDs of the "user_profile_pic" edge to the User entity.
-func (m *ImageMutation) RemovedUserProfilePicIDs() (ids []int) {
-	for id := range m.removeduser_profile_pic {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// UserProfilePicIDs returns the "user_profile_pic" edge IDs in the mutation.
-func (m *ImageMutation) UserProfilePicIDs() (ids []int) {
-	for id := range m.user_profile_pic {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetUserProfilePic resets all changes to the "user_profile_pic" edge.
-func (m *ImageMutation) ResetUserProfilePic() {
-	m.user_profile_pic = nil
-	m.cleareduser_profile_pic = false
-	m.removeduser_profile_pic = nil
-}
-
-// Where appends a list predicates to the ImageMutation builder.
-func (m *ImageMutation) Where(ps ...predicate.Image) {
-	m.predicates = append(m.predicates, ps...)
+// Where appends a list predicates to the ExplicitSkippedMessageMutation builder.
+func (m *ExplicitSkippedMessageMutation) Where(ps ...predicate.ExplicitSkippedMessage) {
+	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *ImageMutation) Op() Op {
+func (m *ExplicitSkippedMessageMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Image).
-func (m *ImageMutation) Type() string {
+// Type returns the node type of this mutation (ExplicitSkippedMessage).
+func (m *ExplicitSkippedMessageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ImageMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.url_path != nil {
-		fields = append(fields, image.FieldURLPath)
-	}
+func (m *ExplicitSkippedMessageMutation) Fields() []string {
+	fields := make([]string, 0, 0)
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ImageMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case image.FieldURLPath:
-		return m.URLPath()
-	}
+func (m *ExplicitSkippedMessageMutation) Field(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ImageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case image.FieldURLPath:
-		return m.OldURLPath(ctx)
-	}
-	return nil, fmt.Errorf("unknown Image field %s", name)
+func (m *ExplicitSkippedMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, fmt.Errorf("unknown ExplicitSkippedMessage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ImageMutation) SetField(name string, value ent.Value) error {
+func (m *ExplicitSkippedMessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case image.FieldURLPath:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetURLPath(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Image field %s", name)
+	return fmt.Errorf("unknown ExplicitSkippedMessage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ImageMutation) AddedFields() []string {
+func (m *ExplicitSkippedMessageMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ImageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ExplicitSkippedMessageMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ImageMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Image numeric field %s", name)
+func (m *ExplicitSkippedMessageMutation) AddField(name string, value ent.Value) error {
+	return fmt.Errorf("unknown ExplicitSkippedMessage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ImageMutation) ClearedFields() []string {
+func (m *ExplicitSkippedMessageMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ImageMutation) FieldCleared(name string) bool {
+func (m *ExplicitSkippedMessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ImageMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Image nullable field %s", name)
+func (m *ExplicitSkippedMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ExplicitSkippedMessage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ImageMutation) ResetField(name string) error {
-	switch name {
-	case image.FieldURLPath:
-		m.ResetURLPath()
-		return nil
-	}
-	return fmt.Errorf("unknown Image field %s", name)
+func (m *ExplicitSkippedMessageMutation) ResetField(name string) error {
+	return fmt.Errorf("unknown ExplicitSkippedMessage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ImageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user_profile_pic != nil {
-		edges = append(edges, image.EdgeUserProfilePic)
-	}
+func (m *ExplicitSkippedMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ImageMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case image.EdgeUserProfilePic:
-		ids := make([]ent.Value, 0, len(m.user_profile_pic))
-		for id := range m.user_profile_pic {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ExplicitSkippedMessageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ImageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removeduser_profile_pic != nil {
-		edges = append(edges, image.EdgeUserProfilePic)
-	}
+func (m *ExplicitSkippedMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ImageMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case image.EdgeUserProfilePic:
-		ids := make([]ent.Value, 0, len(m.removeduser_profile_pic))
-		for id := range m.removeduser_profile_pic {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ExplicitSkippedMessageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ImageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser_profile_pic {
-		edges = append(edges, image.EdgeUserProfilePic)
-	}
+func (m *ExplicitSkippedMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ImageMutation) EdgeCleared(name string) bool {
-	switch name {
-	case image.EdgeUserProfilePic:
-		return m.cleareduser_profile_pic
-	}
+func (m *ExplicitSkippedMessageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ImageMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Image unique edge %s", name)
+func (m *ExplicitSkippedMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExplicitSkippedMessage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ImageMutation) ResetEdge(name string) error {
-	switch name {
-	case image.EdgeUserProfilePic:
-		m.ResetUserProfilePic()
-		return nil
-	}
-	return fmt.Errorf("unknown Image edge %s", name)
+func (m *ExplicitSkippedMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExplicitSkippedMessage edge %s", name)
 }
 
-// ImplicitSkippedMessageMutation represents an operation that mutates the ImplicitSkippedMessage nodes in the graph.
-type ImplicitSkippedMessageMutation struct {
+// ExtraMethodServiceMutation represents an operation that mutates the ExtraMethodService nodes in the graph.
+type ExtraMethodServiceMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*ImplicitSkippedMessage, error)
-	predicates    []predicate.ImplicitSkippedMessage
+	oldValue      func(context.Context) (*ExtraMethodService, error)
+	predicates    []predicate.ExtraMethodService
 }
 
-var _ ent.Mutation = (*ImplicitSkippedMessageMutation)(nil)
+var _ ent.Mutation = (*ExtraMethodServiceMutation)(nil)
 
-// implicitskippedmessageOption allows management of the mutation configuration using functional options.
-type implicitskippedmessageOption func(*ImplicitSkippedMessageMutation)
+// extramethodserviceOption allows management of the mutation configuration using functional options.
+type extramethodserviceOption func(*ExtraMethodServiceMutation)
 
-// newImplicitSkippedMessageMutation creates new mutation for the ImplicitSkippedMessage entity.
-func newImplicitSkippedMessageMutation(c config, op Op, opts ...implicitskippedmessageOption) *ImplicitSkippedMessageMutation {
-	m := &ImplicitSkippedMessageMutation{
+// newExtraMethodServiceMutation creates new mutation for the ExtraMethodService entity.
+func newExtraMethodServiceMutation(c config, op Op, opts ...extramethodserviceOption) *ExtraMethodServiceMutation {
+	m := &ExtraMethodServiceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeImplicitSkippedMessage,
+		typ:           TypeExtraMethodService,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2838,20 +2919,20 @@ func newImplicitSkippedMessageMutation(c config, op Op, opts ...implicitskippedm
 	return m
 }
 
-// withImplicitSkippedMessageID sets the ID field of the mutation.
-func withImplicitSkippedMessageID(id int) implicitskippedmessageOption {
-	return func(m *ImplicitSkippedMessageMutation) {
+// withExtraMethodServiceID sets the ID field of the mutation.
+func withExtraMethodServiceID(id int) extramethodserviceOption {
+	return func(m *ExtraMethodServiceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ImplicitSkippedMessage
+			value *ExtraMethodService
 		)
-		m.oldValue = func(ctx context.Context) (*ImplicitSkippedMessage, error) {
+		m.oldValue = func(ctx context.Context) (*ExtraMethodService, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ImplicitSkippedMessage.Get(ctx, id)
+					value, err = m.Client().ExtraMethodService.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2860,10 +2941,10 @@ func withImplicitSkippedMessageID(id int) implicitskippedmessageOption {
 	}
 }
 
-// withImplicitSkippedMessage sets the old ImplicitSkippedMessage of the mutation.
-func withImplicitSkippedMessage(node *ImplicitSkippedMessage) implicitskippedmessageOption {
-	return func(m *ImplicitSkippedMessageMutation) {
-		m.oldValue = func(context.Context) (*ImplicitSkippedMessage, error) {
+// withExtraMethodService sets the old ExtraMethodService of the mutation.
+func withExtraMethodService(node *ExtraMethodService) extramethodserviceOption {
+	return func(m *ExtraMethodServiceMutation) {
+		m.oldValue = func(context.Context) (*ExtraMethodService, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2872,7 +2953,7 @@ func withImplicitSkippedMessage(node *ImplicitSkippedMessage) implicitskippedmes
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ImplicitSkippedMessageMutation) Client() *Client {
+func (m ExtraMethodServiceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2880,7 +2961,7 @@ func (m ImplicitSkippedMessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ImplicitSkippedMessageMutation) Tx() (*Tx, error) {
+func (m ExtraMethodServiceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2891,7 +2972,7 @@ func (m ImplicitSkippedMessageMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ImplicitSkippedMessageMutation) ID() (id int, exists bool) {
+func (m *ExtraMethodServiceMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2902,7 +2983,7 @@ func (m *ImplicitSkippedMessageMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ImplicitSkippedMessageMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ExtraMethodServiceMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2911,31 +2992,31 @@ func (m *ImplicitSkippedMessageMutation) IDs(ctx context.Context) ([]int, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ImplicitSkippedMessage.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ExtraMethodService.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// Where appends a list predicates to the ImplicitSkippedMessageMutation builder.
-func (m *ImplicitSkippedMessageMutation) Where(ps ...predicate.ImplicitSkippedMessage) {
+// Where appends a list predicates to the ExtraMethodServiceMutation builder.
+func (m *ExtraMethodServiceMutation) Where(ps ...predicate.ExtraMethodService) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *ImplicitSkippedMessageMutation) Op() Op {
+func (m *ExtraMethodServiceMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (ImplicitSkippedMessage).
-func (m *ImplicitSkippedMessageMutation) Type() string {
+// Type returns the node type of this mutation (ExtraMethodService).
+func (m *ExtraMethodServiceMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ImplicitSkippedMessageMutation) Fields() []string {
+func (m *ExtraMethodServiceMutation) Fields() []string {
 	fields := make([]string, 0, 0)
 	return fields
 }
@@ -2943,143 +3024,146 @@ func (m *ImplicitSkippedMessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ImplicitSkippedMessageMutation) Field(name string) (ent.Value, bool) {
+func (m *ExtraMethodServiceMutation) Field(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ImplicitSkippedMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown ImplicitSkippedMessage field %s", name)
+func (m *ExtraMethodServiceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, fmt.Errorf("unknown ExtraMethodService field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ImplicitSkippedMessageMutation) SetField(name string, value ent.Value) error {
+func (m *ExtraMethodServiceMutation) SetField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown ImplicitSkippedMessage field %s", name)
+	return fmt.Errorf("unknown ExtraMethodService field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ImplicitSkippedMessageMutation) AddedFields() []string {
+func (m *ExtraMethodServiceMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ImplicitSkippedMessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ExtraMethodServiceMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ImplicitSkippedMessageMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown ImplicitSkippedMessage numeric field %s", name)
+func (m *ExtraMethodServiceMutation) AddField(name string, value ent.Value) error {
+	return fmt.Errorf("unknown ExtraMethodService numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ImplicitSkippedMessageMutation) ClearedFields() []string {
+func (m *ExtraMethodServiceMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ImplicitSkippedMessageMutation) FieldCleared(name string) bool {
+func (m *ExtraMethodServiceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ImplicitSkippedMessageMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ImplicitSkippedMessage nullable field %s", name)
+func (m *ExtraMethodServiceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ExtraMethodService nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ImplicitSkippedMessageMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown ImplicitSkippedMessage field %s", name)
+func (m *ExtraMethodServiceMutation) ResetField(name string) error {
+	return fmt.Errorf("unknown ExtraMethodService field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ImplicitSkippedMessageMutation) AddedEdges() []string {
+func (m *ExtraMethodServiceMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ImplicitSkippedMessageMutation) AddedIDs(name string) []ent.Value {
+func (m *ExtraMethodServiceMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ImplicitSkippedMessageMutation) RemovedEdges() []string {
+func (m *ExtraMethodServiceMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ImplicitSkippedMessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *ExtraMethodServiceMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ImplicitSkippedMessageMutation) ClearedEdges() []string {
+func (m *ExtraMethodServiceMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ImplicitSkippedMessageMutation) EdgeCleared(name string) bool {
+func (m *ExtraMethodServiceMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ImplicitSkippedMessageMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ImplicitSkippedMessage unique edge %s", name)
+func (m *ExtraMethodServiceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExtraMethodService unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ImplicitSkippedMessageMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ImplicitSkippedMessage edge %s", name)
+func (m *ExtraMethodServiceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExtraMethodService edge %s", name)
 }
 
-// InvalidFieldMessageMutation represents an operation that mutates the InvalidFieldMessage nodes in the graph.
-type InvalidFieldMessageMutation struct {
+// ImageMutation represents an operation that mutates the Image nodes in the graph.
+type ImageMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	json          **schema.SomeJSON
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*InvalidFieldMessage, error)
-	predicates    []predicate.InvalidFieldMessage
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	url_path                *string
+	clearedFields           map[string]struct{}
+	user_profile_pic        map[int]struct{}
+	removeduser_profile_pic map[int]struct{}
+	cleareduser_profile_pic bool
+	done                    bool
+	oldValue                func(context.Context) (*Image, error)
+	predicates              []predicate.Image
 }
 
-var _ ent.Mutation = (*InvalidFieldMessageMutation)(nil)
+var _ ent.Mutation = (*ImageMutation)(nil)
 
-// invalidfieldmessageOption allows management of the mutation configuration using functional options.
-type invalidfieldmessageOption func(*InvalidFieldMessageMutation)
+// imageOption allows management of the mutation configuration using functional options.
+type imageOption func(*ImageMutation)
 
-// newInvalidFieldMessageMutation creates new mutation for the InvalidFieldMessage entity.
-func newInvalidFieldMessageMutation(c config, op Op, opts ...invalidfieldmessageOption) *InvalidFieldMessageMutation {
-	m := &InvalidFieldMessageMutation{
+// newImageMutation creates new mutation for the Image entity.
+func newImageMutation(c config, op Op, opts ...imageOption) *ImageMutation {
+	m := &ImageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeInvalidFieldMessage,
+		typ:           TypeImage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3088,20 +3172,20 @@ func newInvalidFieldMessageMutation(c config, op Op, opts ...invalidfieldmessage
 	return m
 }
 
-// withInvalidFieldMessageID sets the ID field of the mutation.
-func withInvalidFieldMessageID(id int) invalidfieldmessageOption {
-	return func(m *InvalidFieldMessageMutation) {
+// withImageID sets the ID field of the mutation.
+func withImageID(id uuid.UUID) imageOption {
+	return func(m *ImageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *InvalidFieldMessage
+			value *Image
 		)
-		m.oldValue = func(ctx context.Context) (*InvalidFieldMessage, error) {
+		m.oldValue = func(ctx context.Context) (*Image, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().InvalidFieldMessage.Get(ctx, id)
+					value, err = m.Client().Image.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3110,10 +3194,10 @@ func withInvalidFieldMessageID(id int) invalidfieldmessageOption {
 	}
 }
 
-// withInvalidFieldMessage sets the old InvalidFieldMessage of the mutation.
-func withInvalidFieldMessage(node *InvalidFieldMessage) invalidfieldmessageOption {
-	return func(m *InvalidFieldMessageMutation) {
-		m.oldValue = func(context.Context) (*InvalidFieldMessage, error) {
+// withImage sets the old Image of the mutation.
+func withImage(node *Image) imageOption {
+	return func(m *ImageMutation) {
+		m.oldValue = func(context.Context) (*Image, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3122,7 +3206,7 @@ func withInvalidFieldMessage(node *InvalidFieldMessage) invalidfieldmessageOptio
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m InvalidFieldMessageMutation) Client() *Client {
+func (m ImageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3130,7 +3214,7 @@ func (m InvalidFieldMessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m InvalidFieldMessageMutation) Tx() (*Tx, error) {
+func (m ImageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3139,9 +3223,15 @@ func (m InvalidFieldMessageMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Image entities.
+func (m *ImageMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *InvalidFieldMessageMutation) ID() (id int, exists bool) {
+func (m *ImageMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3152,79 +3242,133 @@ func (m *InvalidFieldMessageMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *InvalidFieldMessageMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ImageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().InvalidFieldMessage.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Image.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetJSON sets the "json" field.
-func (m *InvalidFieldMessageMutation) SetJSON(sj *schema.SomeJSON) {
-	m.json = &sj
+// SetURLPath sets the "url_path" field.
+func (m *ImageMutation) SetURLPath(s string) {
+	m.url_path = &s
 }
 
-// JSON returns the value of the "json" field in the mutation.
-func (m *InvalidFieldMessageMutation) JSON() (r *schema.SomeJSON, exists bool) {
-	v := m.json
+// URLPath returns the value of the "url_path" field in the mutation.
+func (m *ImageMutation) URLPath() (r string, exists bool) {
+	v := m.url_path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJSON returns the old "json" field's value of the InvalidFieldMessage entity.
-// If the InvalidFieldMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldURLPath returns the old "url_path" field's value of the Image entity.
+// If the Image object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InvalidFieldMessageMutation) OldJSON(ctx context.Context) (v *schema.SomeJSON, err error) {
+func (m *ImageMutation) OldURLPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJSON is only allowed on UpdateOne operations")
+		return v, errors.New("OldURLPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJSON requires an ID field in the mutation")
+		return v, errors.New("OldURLPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJSON: %w", err)
+		return v, fmt.Errorf("querying old value for OldURLPath: %w", err)
 	}
-	return oldValue.JSON, nil
+	return oldValue.URLPath, nil
 }
 
-// ResetJSON resets all changes to the "json" field.
-func (m *InvalidFieldMessageMutation) ResetJSON() {
-	m.json = nil
+// ResetURLPath resets all changes to the "url_path" field.
+func (m *ImageMutation) ResetURLPath() {
+	m.url_path = nil
 }
 
-// Where appends a list predicates to the InvalidFieldMessageMutation builder.
-func (m *InvalidFieldMessageMutation) Where(ps ...predicate.InvalidFieldMessage) {
+// AddUserProfilePicIDs adds the "user_profile_pic" edge to the User entity by ids.
+func (m *ImageMutation) AddUserProfilePicIDs(ids ...int) {
+	if m.user_profile_pic == nil {
+		m.user_profile_pic = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.user_profile_pic[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserProfilePic clears the "user_profile_pic" edge to the User entity.
+func (m *ImageMutation) ClearUserProfilePic() {
+	m.cleareduser_profile_pic = true
+}
+
+// UserProfilePicCleared reports if the "user_profile_pic" edge to the User entity was cleared.
+func (m *ImageMutation) UserProfilePicCleared() bool {
+	return m.cleareduser_profile_pic
+}
+
+// RemoveUserProfilePicIDs removes the "user_profile_pic" edge to the User entity by IDs.
+func (m *ImageMutation) RemoveUserProfilePicIDs(ids ...int) {
+	if m.removeduser_profile_pic == nil {
+		m.removeduser_profile_pic = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.user_profile_pic, ids[i])
+		m.removeduser_profile_pic[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserProfilePic returns the removed IDs of the "user_profile_pic" edge to the User entity.
+func (m *ImageMutation) RemovedUserProfilePicIDs() (ids []int) {
+	for id := range m.removeduser_profile_pic {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserProfilePicIDs returns the "user_profile_pic" edge IDs in the mutation.
+func (m *ImageMutation) UserProfilePicIDs() (ids []int) {
+	for id := range m.user_profile_pic {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserProfilePic resets all changes to the "user_profile_pic" edge.
+func (m *ImageMutation) ResetUserProfilePic() {
+	m.user_profile_pic = nil
+	m.cleareduser_profile_pic = false
+	m.removeduser_profile_pic = nil
+}
+
+// Where appends a list predicates to the ImageMutation builder.
+func (m *ImageMutation) Where(ps ...predicate.Image) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *InvalidFieldMessageMutation) Op() Op {
+func (m *ImageMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (InvalidFieldMessage).
-func (m *InvalidFieldMessageMutation) Type() string {
+// Type returns the node type of this mutation (Image).
+func (m *ImageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *InvalidFieldMessageMutation) Fields() []string {
+func (m *ImageMutation) Fields() []string {
 	fields := make([]string, 0, 1)
-	if m.json != nil {
-		fields = append(fields, invalidfieldmessage.FieldJSON)
+	if m.url_path != nil {
+		fields = append(fields, image.FieldURLPath)
 	}
 	return fields
 }
@@ -3232,10 +3376,10 @@ func (m *InvalidFieldMessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *InvalidFieldMessageMutation) Field(name string) (ent.Value, bool) {
+func (m *ImageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case invalidfieldmessage.FieldJSON:
-		return m.JSON()
+	case image.FieldURLPath:
+		return m.URLPath()
 	}
 	return nil, false
 }
@@ -3243,155 +3387,189 @@ func (m *InvalidFieldMessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *InvalidFieldMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ImageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case invalidfieldmessage.FieldJSON:
-		return m.OldJSON(ctx)
+	case image.FieldURLPath:
+		return m.OldURLPath(ctx)
 	}
-	return nil, fmt.Errorf("unknown InvalidFieldMessage field %s", name)
+	return nil, fmt.Errorf("unknown Image field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *InvalidFieldMessageMutation) SetField(name string, value ent.Value) error {
+func (m *ImageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case invalidfieldmessage.FieldJSON:
-		v, ok := value.(*schema.SomeJSON)
+	case image.FieldURLPath:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetJSON(v)
+		m.SetURLPath(v)
 		return nil
 	}
-	return fmt.Errorf("unknown InvalidFieldMessage field %s", name)
+	return fmt.Errorf("unknown Image field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *InvalidFieldMessageMutation) AddedFields() []string {
+func (m *ImageMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *InvalidFieldMessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ImageMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *InvalidFieldMessageMutation) AddField(name string, value ent.Value) error {
+func (m *ImageMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown InvalidFieldMessage numeric field %s", name)
+	return fmt.Errorf("unknown Image numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *InvalidFieldMessageMutation) ClearedFields() []string {
+func (m *ImageMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *InvalidFieldMessageMutation) FieldCleared(name string) bool {
+func (m *ImageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *InvalidFieldMessageMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown InvalidFieldMessage nullable field %s", name)
+func (m *ImageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Image nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *InvalidFieldMessageMutation) ResetField(name string) error {
+func (m *ImageMutation) ResetField(name string) error {
 	switch name {
-	case invalidfieldmessage.FieldJSON:
-		m.ResetJSON()
+	case image.FieldURLPath:
+		m.ResetURLPath()
 		return nil
 	}
-	return fmt.Errorf("unknown InvalidFieldMessage field %s", name)
+	return fmt.Errorf("unknown Image field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *InvalidFieldMessageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ImageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user_profile_pic != nil {
+		edges = append(edges, image.EdgeUserProfilePic)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *InvalidFieldMessageMutation) AddedIDs(name string) []ent.Value {
+func (m *ImageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case image.EdgeUserProfilePic:
+		ids := make([]ent.Value, 0, len(m.user_profile_pic))
+		for id := range m.user_profile_pic {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *InvalidFieldMessageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ImageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removeduser_profile_pic != nil {
+		edges = append(edges, image.EdgeUserProfilePic)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *InvalidFieldMessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *ImageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case image.EdgeUserProfilePic:
+		ids := make([]ent.Value, 0, len(m.removeduser_profile_pic))
+		for id := range m.removeduser_profile_pic {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *InvalidFieldMessageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ImageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser_profile_pic {
+		edges = append(edges, image.EdgeUserProfilePic)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *InvalidFieldMessageMutation) EdgeCleared(name string) bool {
+func (m *ImageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case image.EdgeUserProfilePic:
+		return m.cleareduser_profile_pic
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *InvalidFieldMessageMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown InvalidFieldMessage unique edge %s", name)
+func (m *ImageMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Image unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *InvalidFieldMessageMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown InvalidFieldMessage edge %s", name)
+func (m *ImageMutation) ResetEdge(name string) error {
+	switch name {
+	case image.EdgeUserProfilePic:
+		m.ResetUserProfilePic()
+		return nil
+	}
+	return fmt.Errorf("unknown Image edge %s", name)
 }
 
-// MessageWithEnumMutation represents an operation that mutates the MessageWithEnum nodes in the graph.
-type MessageWithEnumMutation struct {
+// ImplicitSkippedMessageMutation represents an operation that mutates the ImplicitSkippedMessage nodes in the graph.
+type ImplicitSkippedMessageMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int
-	enum_type            *messagewithenum.EnumType
-	enum_without_default *messagewithenum.EnumWithoutDefault
-	clearedFields        map[string]struct{}
-	done                 bool
-	oldValue             func(context.Context) (*MessageWithEnum, error)
-	predicates           []predicate.MessageWithEnum
+	op            Op
+	typ           string
+	id            *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ImplicitSkippedMessage, error)
+	predicates    []predicate.ImplicitSkippedMessage
 }
 
-var _ ent.Mutation = (*MessageWithEnumMutation)(nil)
+var _ ent.Mutation = (*ImplicitSkippedMessageMutation)(nil)
 
-// messagewithenumOption allows management of the mutation configuration using functional options.
-type messagewithenumOption func(*MessageWithEnumMutation)
+// implicitskippedmessageOption allows management of the mutation configuration using functional options.
+type implicitskippedmessageOption func(*ImplicitSkippedMessageMutation)
 
-// newMessageWithEnumMutation creates new mutation for the MessageWithEnum entity.
-func newMessageWithEnumMutation(c config, op Op, opts ...messagewithenumOption) *MessageWithEnumMutation {
-	m := &MessageWithEnumMutation{
+// newImplicitSkippedMessageMutation creates new mutation for the ImplicitSkippedMessage entity.
+func newImplicitSkippedMessageMutation(c config, op Op, opts ...implicitskippedmessageOption) *ImplicitSkippedMessageMutation {
+	m := &ImplicitSkippedMessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessageWithEnum,
+		typ:           TypeImplicitSkippedMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3400,20 +3578,20 @@ func newMessageWithEnumMutation(c config, op Op, opts ...messagewithenumOption)
 	return m
 }
 
-// withMessageWithEnumID sets the ID field of the mutation.
-func withMessageWithEnumID(id int) messagewithenumOption {
-	return func(m *MessageWithEnumMutation) {
+// withImplicitSkippedMessageID sets the ID field of the mutation.
+func withImplicitSkippedMessageID(id int) implicitskippedmessageOption {
+	return func(m *ImplicitSkippedMessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MessageWithEnum
+			value *ImplicitSkippedMessage
 		)
-		m.oldValue = func(ctx context.Context) (*MessageWithEnum, error) {
+		m.oldValue = func(ctx context.Context) (*ImplicitSkippedMessage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MessageWithEnum.Get(ctx, id)
+					value, err = m.Client().ImplicitSkippedMessage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3422,10 +3600,10 @@ func withMessageWithEnumID(id int) messagewithenumOption {
 	}
 }
 
-// withMessageWithEnum sets the old MessageWithEnum of the mutation.
-func withMessageWithEnum(node *MessageWithEnum) messagewithenumOption {
-	return func(m *MessageWithEnumMutation) {
-		m.oldValue = func(context.Context) (*MessageWithEnum, error) {
+// withImplicitSkippedMessage sets the old ImplicitSkippedMessage of the mutation.
+func withImplicitSkippedMessage(node *ImplicitSkippedMessage) implicitskippedmessageOption {
+	return func(m *ImplicitSkippedMessageMutation) {
+		m.oldValue = func(context.Context) (*ImplicitSkippedMessage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3434,7 +3612,7 @@ func withMessageWithEnum(node *MessageWithEnum) messagewithenumOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageWithEnumMutation) Client() *Client {
+func (m ImplicitSkippedMessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3442,7 +3620,7 @@ func (m MessageWithEnumMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageWithEnumMutation) Tx() (*Tx, error) {
+func (m ImplicitSkippedMessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3453,7 +3631,7 @@ func (m MessageWithEnumMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageWithEnumMutation) ID() (id int, exists bool) {
+func (m *ImplicitSkippedMessageMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3464,7 +3642,7 @@ func (m *MessageWithEnumMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageWithEnumMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ImplicitSkippedMessageMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3473,290 +3651,175 @@ func (m *MessageWithEnumMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MessageWithEnum.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ImplicitSkippedMessage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEnumType sets the "enum_type" field.
-func (m *MessageWithEnumMutation) SetEnumType(mt messagewithenum.EnumType) {
-	m.enum_type = &mt
-}
-
-// EnumType returns the value of the "enum_type" field in the mutation.
-func (m *MessageWithEnumMutation) EnumType() (r messagewithenum.EnumType, exists bool) {
-	v := m.enum_type
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEnumType returns the old "enum_type" field's value of the MessageWithEnum entity.
-// If the MessageWithEnum object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithEnumMutation) OldEnumType(ctx context.Context) (v messagewithenum.EnumType, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnumType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnumType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnumType: %w", err)
-	}
-	return oldValue.EnumType, nil
-}
-
-// ResetEnumType resets all changes to the "enum_type" field.
-func (m *MessageWithEnumMutation) ResetEnumType() {
-	m.enum_type = nil
-}
-
-// SetEnumWithoutDefault sets the "enum_without_default" field.
-func (m *MessageWithEnumMutation) SetEnumWithoutDefault(mwd messagewithenum.EnumWithoutDefault) {
-	m.enum_without_default = &mwd
-}
-
-// EnumWithoutDefault returns the value of the "enum_without_default" field in the mutation.
-func (m *MessageWithEnumMutation) EnumWithoutDefault() (r messagewithenum.EnumWithoutDefault, exists bool) {
-	v := m.enum_without_default
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEnumWithoutDefault returns the old "enum_without_default" field's value of the MessageWithEnum entity.
-// If the MessageWithEnum object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithEnumMutation) OldEnumWithoutDefault(ctx context.Context) (v messagewithenum.EnumWithoutDefault, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnumWithoutDefault is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnumWithoutDefault requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnumWithoutDefault: %w", err)
-	}
-	return oldValue.EnumWithoutDefault, nil
-}
-
-// ResetEnumWithoutDefault resets all changes to the "enum_without_default" field.
-func (m *MessageWithEnumMutation) ResetEnumWithoutDefault() {
-	m.enum_without_default = nil
-}
-
-// Where appends a list predicates to the MessageWithEnumMutation builder.
-func (m *MessageWithEnumMutation) Where(ps ...predicate.MessageWithEnum) {
+// Where appends a list predicates to the ImplicitSkippedMessageMutation builder.
+func (m *ImplicitSkippedMessageMutation) Where(ps ...predicate.ImplicitSkippedMessage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *MessageWithEnumMutation) Op() Op {
+func (m *ImplicitSkippedMessageMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (MessageWithEnum).
-func (m *MessageWithEnumMutation) Type() string {
+// Type returns the node type of this mutation (ImplicitSkippedMessage).
+func (m *ImplicitSkippedMessageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageWithEnumMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.enum_type != nil {
-		fields = append(fields, messagewithenum.FieldEnumType)
-	}
-	if m.enum_without_default != nil {
-		fields = append(fields, messagewithenum.FieldEnumWithoutDefault)
-	}
+func (m *ImplicitSkippedMessageMutation) Fields() []string {
+	fields := make([]string, 0, 0)
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageWithEnumMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case messagewithenum.FieldEnumType:
-		return m.EnumType()
-	case messagewithenum.FieldEnumWithoutDefault:
-		return m.EnumWithoutDefault()
-	}
+func (m *ImplicitSkippedMessageMutation) Field(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageWithEnumMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case messagewithenum.FieldEnumType:
-		return m.OldEnumType(ctx)
-	case messagewithenum.FieldEnumWithoutDefault:
-		return m.OldEnumWithoutDefault(ctx)
-	}
-	return nil, fmt.Errorf("unknown MessageWithEnum field %s", name)
+func (m *ImplicitSkippedMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, fmt.Errorf("unknown ImplicitSkippedMessage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageWithEnumMutation) SetField(name string, value ent.Value) error {
+func (m *ImplicitSkippedMessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case messagewithenum.FieldEnumType:
-		v, ok := value.(messagewithenum.EnumType)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnumType(v)
-		return nil
-	case messagewithenum.FieldEnumWithoutDefault:
-		v, ok := value.(messagewithenum.EnumWithoutDefault)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnumWithoutDefault(v)
-		return nil
 	}
-	return fmt.Errorf("unknown MessageWithEnum field %s", name)
+	return fmt.Errorf("unknown ImplicitSkippedMessage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageWithEnumMutation) AddedFields() []string {
+func (m *ImplicitSkippedMessageMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageWithEnumMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ImplicitSkippedMessageMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageWithEnumMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown MessageWithEnum numeric field %s", name)
+func (m *ImplicitSkippedMessageMutation) AddField(name string, value ent.Value) error {
+	return fmt.Errorf("unknown ImplicitSkippedMessage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageWithEnumMutation) ClearedFields() []string {
+func (m *ImplicitSkippedMessageMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageWithEnumMutation) FieldCleared(name string) bool {
+func (m *ImplicitSkippedMessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageWithEnumMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MessageWithEnum nullable field %s", name)
+func (m *ImplicitSkippedMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ImplicitSkippedMessage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageWithEnumMutation) ResetField(name string) error {
-	switch name {
-	case messagewithenum.FieldEnumType:
-		m.ResetEnumType()
-		return nil
-	case messagewithenum.FieldEnumWithoutDefault:
-		m.ResetEnumWithoutDefault()
-		return nil
-	}
-	return fmt.Errorf("unknown MessageWithEnum field %s", name)
+func (m *ImplicitSkippedMessageMutation) ResetField(name string) error {
+	return fmt.Errorf("unknown ImplicitSkippedMessage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageWithEnumMutation) AddedEdges() []string {
+func (m *ImplicitSkippedMessageMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageWithEnumMutation) AddedIDs(name string) []ent.Value {
+func (m *ImplicitSkippedMessageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageWithEnumMutation) RemovedEdges() []string {
+func (m *ImplicitSkippedMessageMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageWithEnumMutation) RemovedIDs(name string) []ent.Value {
+func (m *ImplicitSkippedMessageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageWithEnumMutation) ClearedEdges() []string {
+func (m *ImplicitSkippedMessageMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageWithEnumMutation) EdgeCleared(name string) bool {
+func (m *ImplicitSkippedMessageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageWithEnumMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown MessageWithEnum unique edge %s", name)
+func (m *ImplicitSkippedMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ImplicitSkippedMessage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageWithEnumMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown MessageWithEnum edge %s", name)
+func (m *ImplicitSkippedMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ImplicitSkippedMessage edge %s", name)
 }
 
-// MessageWithFieldOneMutation represents an operation that mutates the MessageWithFieldOne nodes in the graph.
-type MessageWithFieldOneMutation struct {
+// InvalidFieldMessageMutation represents an operation that mutates the InvalidFieldMessage nodes in the graph.
+type InvalidFieldMessageMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	field_one     *int32
-	addfield_one  *int32
+	json          **schema.SomeJSON
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*MessageWithFieldOne, error)
-	predicates    []predicate.MessageWithFieldOne
+	oldValue      func(context.Context) (*InvalidFieldMessage, error)
+	predicates    []predicate.InvalidFieldMessage
 }
 
-var _ ent.Mutation = (*MessageWithFieldOneMutation)(nil)
+var _ ent.Mutation = (*InvalidFieldMessageMutation)(nil)
 
-// messagewithfieldoneOption allows management of the mutation configuration using functional options.
-type messagewithfieldoneOption func(*MessageWithFieldOneMutation)
+// invalidfieldmessageOption allows management of the mutation configuration using functional options.
+type invalidfieldmessageOption func(*InvalidFieldMessageMutation)
 
-// newMessageWithFieldOneMutation creates new mutation for the MessageWithFieldOne entity.
-func newMessageWithFieldOneMutation(c config, op Op, opts ...messagewithfieldoneOption) *MessageWithFieldOneMutation {
-	m := &MessageWithFieldOneMutation{
+// newInvalidFieldMessageMutation creates new mutation for the InvalidFieldMessage entity.
+func newInvalidFieldMessageMutation(c config, op Op, opts ...invalidfieldmessageOption) *InvalidFieldMessageMutation {
+	m := &InvalidFieldMessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessageWithFieldOne,
+		typ:           TypeInvalidFieldMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3765,20 +3828,20 @@ func newMessageWithFieldOneMutation(c config, op Op, opts ...messagewithfieldone
 	return m
 }
 
-// withMessageWithFieldOneID sets the ID field of the mutation.
-func withMessageWithFieldOneID(id int) messagewithfieldoneOption {
-	return func(m *MessageWithFieldOneMutation) {
+// withInvalidFieldMessageID sets the ID field of the mutation.
+func withInvalidFieldMessageID(id int) invalidfieldmessageOption {
+	return func(m *InvalidFieldMessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MessageWithFieldOne
+			value *InvalidFieldMessage
 		)
-		m.oldValue = func(ctx context.Context) (*MessageWithFieldOne, error) {
+		m.oldValue = func(ctx context.Context) (*InvalidFieldMessage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MessageWithFieldOne.Get(ctx, id)
+					value, err = m.Client().InvalidFieldMessage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3787,10 +3850,10 @@ func withMessageWithFieldOneID(id int) messagewithfieldoneOption {
 	}
 }
 
-// withMessageWithFieldOne sets the old MessageWithFieldOne of the mutation.
-func withMessageWithFieldOne(node *MessageWithFieldOne) messagewithfieldoneOption {
-	return func(m *MessageWithFieldOneMutation) {
-		m.oldValue = func(context.Context) (*MessageWithFieldOne, error) {
+// withInvalidFieldMessage sets the old InvalidFieldMessage of the mutation.
+func withInvalidFieldMessage(node *InvalidFieldMessage) invalidfieldmessageOption {
+	return func(m *InvalidFieldMessageMutation) {
+		m.oldValue = func(context.Context) (*InvalidFieldMessage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3799,7 +3862,7 @@ func withMessageWithFieldOne(node *MessageWithFieldOne) messagewithfieldoneOptio
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageWithFieldOneMutation) Client() *Client {
+func (m InvalidFieldMessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3807,7 +3870,7 @@ func (m MessageWithFieldOneMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageWithFieldOneMutation) Tx() (*Tx, error) {
+func (m InvalidFieldMessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3818,7 +3881,7 @@ func (m MessageWithFieldOneMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageWithFieldOneMutation) ID() (id int, exists bool) {
+func (m *InvalidFieldMessageMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3829,7 +3892,7 @@ func (m *MessageWithFieldOneMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageWithFieldOneMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *InvalidFieldMessageMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3838,90 +3901,70 @@ func (m *MessageWithFieldOneMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MessageWithFieldOne.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().InvalidFieldMessage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetFieldOne sets the "field_one" field.
-func (m *MessageWithFieldOneMutation) SetFieldOne(i int32) {
-	m.field_one = &i
-	m.addfield_one = nil
+// SetJSON sets the "json" field.
+func (m *InvalidFieldMessageMutation) SetJSON(sj *schema.SomeJSON) {
+	m.json = &sj
 }
 
-// FieldOne returns the value of the "field_one" field in the mutation.
-func (m *MessageWithFieldOneMutation) FieldOne() (r int32, exists bool) {
-	v := m.field_one
+// JSON returns the value of the "json" field in the mutation.
+func (m *InvalidFieldMessageMutation) JSON() (r *schema.SomeJSON, exists bool) {
+	v := m.json
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFieldOne returns the old "field_one" field's value of the MessageWithFieldOne entity.
-// If the MessageWithFieldOne object wasn't provided to the builder, the object is fetched from the database.
+// OldJSON returns the old "json" field's value of the InvalidFieldMessage entity.
+// If the InvalidFieldMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithFieldOneMutation) OldFieldOne(ctx context.Context) (v int32, err error) {
+func (m *InvalidFieldMessageMutation) OldJSON(ctx context.Context) (v *schema.SomeJSON, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFieldOne is only allowed on UpdateOne operations")
+		return v, errors.New("OldJSON is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFieldOne requires an ID field in the mutation")
+		return v, errors.New("OldJSON requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFieldOne: %w", err)
-	}
-	return oldValue.FieldOne, nil
-}
-
-// AddFieldOne adds i to the "field_one" field.
-func (m *MessageWithFieldOneMutation) AddFieldOne(i int32) {
-	if m.addfield_one != nil {
-		*m.addfield_one += i
-	} else {
-		m.addfield_one = &i
-	}
-}
-
-// AddedFieldOne returns the value that was added to the "field_one" field in this mutation.
-func (m *MessageWithFieldOneMutation) AddedFieldOne() (r int32, exists bool) {
-	v := m.addfield_one
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldJSON: %w", err)
 	}
-	return *v, true
+	return oldValue.JSON, nil
 }
 
-// ResetFieldOne resets all changes to the "field_one" field.
-func (m *MessageWithFieldOneMutation) ResetFieldOne() {
-	m.field_one = nil
-	m.addfield_one = nil
+// ResetJSON resets all changes to the "json" field.
+func (m *InvalidFieldMessageMutation) ResetJSON() {
+	m.json = nil
 }
 
-// Where appends a list predicates to the MessageWithFieldOneMutation builder.
-func (m *MessageWithFieldOneMutation) Where(ps ...predicate.MessageWithFieldOne) {
+// Where appends a list predicates to the InvalidFieldMessageMutation builder.
+func (m *InvalidFieldMessageMutation) Where(ps ...predicate.InvalidFieldMessage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *MessageWithFieldOneMutation) Op() Op {
+func (m *InvalidFieldMessageMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (MessageWithFieldOne).
-func (m *MessageWithFieldOneMutation) Type() string {
+// Type returns the node type of this mutation (InvalidFieldMessage).
+func (m *InvalidFieldMessageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageWithFieldOneMutation) Fields() []string {
+func (m *InvalidFieldMessageMutation) Fields() []string {
 	fields := make([]string, 0, 1)
-	if m.field_one != nil {
-		fields = append(fields, messagewithfieldone.FieldFieldOne)
+	if m.json != nil {
+		fields = append(fields, invalidfieldmessage.FieldJSON)
 	}
 	return fields
 }
@@ -3929,10 +3972,10 @@ func (m *MessageWithFieldOneMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageWithFieldOneMutation) Field(name string) (ent.Value, bool) {
+func (m *InvalidFieldMessageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case messagewithfieldone.FieldFieldOne:
-		return m.FieldOne()
+	case invalidfieldmessage.FieldJSON:
+		return m.JSON()
 	}
 	return nil, false
 }
@@ -3940,168 +3983,155 @@ func (m *MessageWithFieldOneMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageWithFieldOneMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *InvalidFieldMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case messagewithfieldone.FieldFieldOne:
-		return m.OldFieldOne(ctx)
+	case invalidfieldmessage.FieldJSON:
+		return m.OldJSON(ctx)
 	}
-	return nil, fmt.Errorf("unknown MessageWithFieldOne field %s", name)
+	return nil, fmt.Errorf("unknown InvalidFieldMessage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageWithFieldOneMutation) SetField(name string, value ent.Value) error {
+func (m *InvalidFieldMessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case messagewithfieldone.FieldFieldOne:
-		v, ok := value.(int32)
+	case invalidfieldmessage.FieldJSON:
+		v, ok := value.(*schema.SomeJSON)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFieldOne(v)
+		m.SetJSON(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MessageWithFieldOne field %s", name)
+	return fmt.Errorf("unknown InvalidFieldMessage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageWithFieldOneMutation) AddedFields() []string {
-	var fields []string
-	if m.addfield_one != nil {
-		fields = append(fields, messagewithfieldone.FieldFieldOne)
-	}
-	return fields
+func (m *InvalidFieldMessageMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageWithFieldOneMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case messagewithfieldone.FieldFieldOne:
-		return m.AddedFieldOne()
-	}
+func (m *InvalidFieldMessageMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageWithFieldOneMutation) AddField(name string, value ent.Value) error {
+func (m *InvalidFieldMessageMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case messagewithfieldone.FieldFieldOne:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFieldOne(v)
-		return nil
 	}
-	return fmt.Errorf("unknown MessageWithFieldOne numeric field %s", name)
+	return fmt.Errorf("unknown InvalidFieldMessage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageWithFieldOneMutation) ClearedFields() []string {
+func (m *InvalidFieldMessageMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageWithFieldOneMutation) FieldCleared(name string) bool {
+func (m *InvalidFieldMessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageWithFieldOneMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MessageWithFieldOne nullable field %s", name)
+func (m *InvalidFieldMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown InvalidFieldMessage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageWithFieldOneMutation) ResetField(name string) error {
+func (m *InvalidFieldMessageMutation) ResetField(name string) error {
 	switch name {
-	case messagewithfieldone.FieldFieldOne:
-		m.ResetFieldOne()
+	case invalidfieldmessage.FieldJSON:
+		m.ResetJSON()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageWithFieldOne field %s", name)
+	return fmt.Errorf("unknown InvalidFieldMessage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageWithFieldOneMutation) AddedEdges() []string {
+func (m *InvalidFieldMessageMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageWithFieldOneMutation) AddedIDs(name string) []ent.Value {
+func (m *InvalidFieldMessageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageWithFieldOneMutation) RemovedEdges() []string {
+func (m *InvalidFieldMessageMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageWithFieldOneMutation) RemovedIDs(name string) []ent.Value {
+func (m *InvalidFieldMessageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageWithFieldOneMutation) ClearedEdges() []string {
+func (m *InvalidFieldMessageMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageWithFieldOneMutation) EdgeCleared(name string) bool {
+func (m *InvalidFieldMessageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageWithFieldOneMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown MessageWithFieldOne unique edge %s", name)
+func (m *InvalidFieldMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown InvalidFieldMessage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageWithFieldOneMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown MessageWithFieldOne edge %s", name)
+func (m *InvalidFieldMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown InvalidFieldMessage edge %s", name)
 }
 
-// MessageWithIDMutation represents an operation that mutates the MessageWithID nodes in the graph.
-type MessageWithIDMutation struct {
+// MappedFieldMessageMutation represents an operation that mutates the MappedFieldMessage nodes in the graph.
+type MappedFieldMessageMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int32
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*MessageWithID, error)
-	predicates    []predicate.MessageWithID
+	op                Op
+	typ               string
+	id                *int
+	location          *schema.Coordinate
+	previous_location *schema.Coordinate
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*MappedFieldMessage, error)
+	predicates        []predicate.MappedFieldMessage
 }
 
-var _ ent.Mutation = (*MessageWithIDMutation)(nil)
+var _ ent.Mutation = (*MappedFieldMessageMutation)(nil)
 
-// messagewithidOption allows management of the mutation configuration using functional options.
-type messagewithidOption func(*MessageWithIDMutation)
+// mappedfieldmessageOption allows management of the mutation configuration using functional options.
+type mappedfieldmessageOption func(*MappedFieldMessageMutation)
 
-// newMessageWithIDMutation creates new mutation for the MessageWithID entity.
-func newMessageWithIDMutation(c config, op Op, opts ...messagewithidOption) *MessageWithIDMutation {
-	m := &MessageWithIDMutation{
+// newMappedFieldMessageMutation creates new mutation for the MappedFieldMessage entity.
+func newMappedFieldMessageMutation(c config, op Op, opts ...mappedfieldmessageOption) *MappedFieldMessageMutation {
+	m := &MappedFieldMessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessageWithID,
+		typ:           TypeMappedFieldMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4110,20 +4140,20 @@ func newMessageWithIDMutation(c config, op Op, opts ...messagewithidOption) *Mes
 	return m
 }
 
-// withMessageWithIDID sets the ID field of the mutation.
-func withMessageWithIDID(id int32) messagewithidOption {
-	return func(m *MessageWithIDMutation) {
+// withMappedFieldMessageID sets the ID field of the mutation.
+func withMappedFieldMessageID(id int) mappedfieldmessageOption {
+	return func(m *MappedFieldMessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MessageWithID
+			value *MappedFieldMessage
 		)
-		m.oldValue = func(ctx context.Context) (*MessageWithID, error) {
+		m.oldValue = func(ctx context.Context) (*MappedFieldMessage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MessageWithID.Get(ctx, id)
+					value, err = m.Client().MappedFieldMessage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4132,10 +4162,10 @@ func withMessageWithIDID(id int32) messagewithidOption {
 	}
 }
 
-// withMessageWithID sets the old MessageWithID of the mutation.
-func withMessageWithID(node *MessageWithID) messagewithidOption {
-	return func(m *MessageWithIDMutation) {
-		m.oldValue = func(context.Context) (*MessageWithID, error) {
+// withMappedFieldMessage sets the old MappedFieldMessage of the mutation.
+func withMappedFieldMessage(node *MappedFieldMessage) mappedfieldmessageOption {
+	return func(m *MappedFieldMessageMutation) {
+		m.oldValue = func(context.Context) (*MappedFieldMessage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4144,7 +4174,7 @@ func withMessageWithID(node *MessageWithID) messagewithidOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageWithIDMutation) Client() *Client {
+func (m MappedFieldMessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4152,7 +4182,7 @@ func (m MessageWithIDMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageWithIDMutation) Tx() (*Tx, error) {
+func (m MappedFieldMessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4161,15 +4191,9 @@ func (m MessageWithIDMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of MessageWithID entities.
-func (m *MessageWithIDMutation) SetID(id int32) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageWithIDMutation) ID() (id int32, exists bool) {
+func (m *MappedFieldMessageMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4180,194 +4204,323 @@ func (m *MessageWithIDMutation) ID() (id int32, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageWithIDMutation) IDs(ctx context.Context) ([]int32, error) {
+func (m *MappedFieldMessageMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int32{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MessageWithID.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().MappedFieldMessage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// Where appends a list predicates to the MessageWithIDMutation builder.
-func (m *MessageWithIDMutation) Where(ps ...predicate.MessageWithID) {
+// SetLocation sets the "location" field.
+func (m *MappedFieldMessageMutation) SetLocation(s schema.Coordinate) {
+	m.location = &s
+}
+
+// Location returns the value of the "location" field in the mutation.
+func (m *MappedFieldMessageMutation) Location() (r schema.Coordinate, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocation returns the old "location" field's value of the MappedFieldMessage entity.
+// If the MappedFieldMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MappedFieldMessageMutation) OldLocation(ctx context.Context) (v schema.Coordinate, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+	}
+	return oldValue.Location, nil
+}
+
+// ResetLocation resets all changes to the "location" field.
+func (m *MappedFieldMessageMutation) ResetLocation() {
+	m.location = nil
+}
+
+// SetPreviousLocation sets the "previous_location" field.
+func (m *MappedFieldMessageMutation) SetPreviousLocation(s schema.Coordinate) {
+	m.previous_location = &s
+}
+
+// PreviousLocation returns the value of the "previous_location" field in the mutation.
+func (m *MappedFieldMessageMutation) PreviousLocation() (r schema.Coordinate, exists bool) {
+	v := m.previous_location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousLocation returns the old "previous_location" field's value of the MappedFieldMessage entity.
+// If the MappedFieldMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MappedFieldMessageMutation) OldPreviousLocation(ctx context.Context) (v schema.Coordinate, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousLocation: %w", err)
+	}
+	return oldValue.PreviousLocation, nil
+}
+
+// ClearPreviousLocation clears the value of the "previous_location" field.
+func (m *MappedFieldMessageMutation) ClearPreviousLocation() {
+	m.previous_location = nil
+	m.clearedFields[mappedfieldmessage.FieldPreviousLocation] = struct{}{}
+}
+
+// PreviousLocationCleared returns if the "previous_location" field was cleared in this mutation.
+func (m *MappedFieldMessageMutation) PreviousLocationCleared() bool {
+	_, ok := m.clearedFields[mappedfieldmessage.FieldPreviousLocation]
+	return ok
+}
+
+// ResetPreviousLocation resets all changes to the "previous_location" field.
+func (m *MappedFieldMessageMutation) ResetPreviousLocation() {
+	m.previous_location = nil
+	delete(m.clearedFields, mappedfieldmessage.FieldPreviousLocation)
+}
+
+// Where appends a list predicates to the MappedFieldMessageMutation builder.
+func (m *MappedFieldMessageMutation) Where(ps ...predicate.MappedFieldMessage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *MessageWithIDMutation) Op() Op {
+func (m *MappedFieldMessageMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (MessageWithID).
-func (m *MessageWithIDMutation) Type() string {
+// Type returns the node type of this mutation (MappedFieldMessage).
+func (m *MappedFieldMessageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageWithIDMutation) Fields() []string {
-	fields := make([]string, 0, 0)
+func (m *MappedFieldMessageMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.location != nil {
+		fields = append(fields, mappedfieldmessage.FieldLocation)
+	}
+	if m.previous_location != nil {
+		fields = append(fields, mappedfieldmessage.FieldPreviousLocation)
+	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageWithIDMutation) Field(name string) (ent.Value, bool) {
+func (m *MappedFieldMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mappedfieldmessage.FieldLocation:
+		return m.Location()
+	case mappedfieldmessage.FieldPreviousLocation:
+		return m.PreviousLocation()
+	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageWithIDMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown MessageWithID field %s", name)
+func (m *MappedFieldMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mappedfieldmessage.FieldLocation:
+		return m.OldLocation(ctx)
+	case mappedfieldmessage.FieldPreviousLocation:
+		return m.OldPreviousLocation(ctx)
+	}
+	return nil, fmt.Errorf("unknown MappedFieldMessage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageWithIDMutation) SetField(name string, value ent.Value) error {
+func (m *MappedFieldMessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case mappedfieldmessage.FieldLocation:
+		v, ok := value.(schema.Coordinate)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case mappedfieldmessage.FieldPreviousLocation:
+		v, ok := value.(schema.Coordinate)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousLocation(v)
+		return nil
 	}
-	return fmt.Errorf("unknown MessageWithID field %s", name)
+	return fmt.Errorf("unknown MappedFieldMessage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageWithIDMutation) AddedFields() []string {
+func (m *MappedFieldMessageMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageWithIDMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MappedFieldMessageMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageWithIDMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown MessageWithID numeric field %s", name)
+func (m *MappedFieldMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MappedFieldMessage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageWithIDMutation) ClearedFields() []string {
-	return nil
+func (m *MappedFieldMessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(mappedfieldmessage.FieldPreviousLocation) {
+		fields = append(fields, mappedfieldmessage.FieldPreviousLocation)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageWithIDMutation) FieldCleared(name string) bool {
+func (m *MappedFieldMessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageWithIDMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MessageWithID nullable field %s", name)
+func (m *MappedFieldMessageMutation) ClearField(name string) error {
+	switch name {
+	case mappedfieldmessage.FieldPreviousLocation:
+		m.ClearPreviousLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown MappedFieldMessage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageWithIDMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown MessageWithID field %s", name)
+func (m *MappedFieldMessageMutation) ResetField(name string) error {
+	switch name {
+	case mappedfieldmessage.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case mappedfieldmessage.FieldPreviousLocation:
+		m.ResetPreviousLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown MappedFieldMessage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageWithIDMutation) AddedEdges() []string {
+func (m *MappedFieldMessageMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageWithIDMutation) AddedIDs(name string) []ent.Value {
+func (m *MappedFieldMessageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageWithIDMutation) RemovedEdges() []string {
+func (m *MappedFieldMessageMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageWithIDMutation) RemovedIDs(name string) []ent.Value {
+func (m *MappedFieldMessageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageWithIDMutation) ClearedEdges() []string {
+func (m *MappedFieldMessageMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageWithIDMutation) EdgeCleared(name string) bool {
+func (m *MappedFieldMessageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageWithIDMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown MessageWithID unique edge %s", name)
+func (m *MappedFieldMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MappedFieldMessage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageWithIDMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown MessageWithID edge %s", name)
+func (m *MappedFieldMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MappedFieldMessage edge %s", name)
 }
 
-// MessageWithOptionalsMutation represents an operation that mutates the MessageWithOptionals nodes in the graph.
-type MessageWithOptionalsMutation struct {
+// MessageWithEnumMutation represents an operation that mutates the MessageWithEnum nodes in the graph.
+type MessageWithEnumMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	str_optional      *string
-	int_optional      *int8
-	addint_optional   *int8
-	uint_optional     *uint8
-	adduint_optional  *int8
-	float_optional    *float32
-	addfloat_optional *float32
-	bool_optional     *bool
-	bytes_optional    *[]byte
-	uuid_optional     *uuid.UUID
-	time_optional     *time.Time
-	clearedFields     map[string]struct{}
-	done              bool
-	oldValue          func(context.Context) (*MessageWithOptionals, error)
-	predicates        []predicate.MessageWithOptionals
+	op                   Op
+	typ                  string
+	id                   *int
+	enum_type            *messagewithenum.EnumType
+	enum_without_default *messagewithenum.EnumWithoutDefault
+	enum_zero_mapped     *messagewithenum.EnumZeroMapped
+	enum_unknown         *messagewithenum.EnumUnknown
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*MessageWithEnum, error)
+	predicates           []predicate.MessageWithEnum
 }
 
-var _ ent.Mutation = (*MessageWithOptionalsMutation)(nil)
+var _ ent.Mutation = (*MessageWithEnumMutation)(nil)
 
-// messagewithoptionalsOption allows management of the mutation configuration using functional options.
-type messagewithoptionalsOption func(*MessageWithOptionalsMutation)
+// messagewithenumOption allows management of the mutation configuration using functional options.
+type messagewithenumOption func(*MessageWithEnumMutation)
 
-// newMessageWithOptionalsMutation creates new mutation for the MessageWithOptionals entity.
-func newMessageWithOptionalsMutation(c config, op Op, opts ...messagewithoptionalsOption) *MessageWithOptionalsMutation {
-	m := &MessageWithOptionalsMutation{
+// newMessageWithEnumMutation creates new mutation for the MessageWithEnum entity.
+func newMessageWithEnumMutation(c config, op Op, opts ...messagewithenumOption) *MessageWithEnumMutation {
+	m := &MessageWithEnumMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessageWithOptionals,
+		typ:           TypeMessageWithEnum,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4376,20 +4529,20 @@ func newMessageWithOptionalsMutation(c config, op Op, opts ...messagewithoptiona
 	return m
 }
 
-// withMessageWithOptionalsID sets the ID field of the mutation.
-func withMessageWithOptionalsID(id int) messagewithoptionalsOption {
-	return func(m *MessageWithOptionalsMutation) {
+// withMessageWithEnumID sets the ID field of the mutation.
+func withMessageWithEnumID(id int) messagewithenumOption {
+	return func(m *MessageWithEnumMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MessageWithOptionals
+			value *MessageWithEnum
 		)
-		m.oldValue = func(ctx context.Context) (*MessageWithOptionals, error) {
+		m.oldValue = func(ctx context.Context) (*MessageWithEnum, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MessageWithOptionals.Get(ctx, id)
+					value, err = m.Client().MessageWithEnum.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4398,10 +4551,10 @@ func withMessageWithOptionalsID(id int) messagewithoptionalsOption {
 	}
 }
 
-// withMessageWithOptionals sets the old MessageWithOptionals of the mutation.
-func withMessageWithOptionals(node *MessageWithOptionals) messagewithoptionalsOption {
-	return func(m *MessageWithOptionalsMutation) {
-		m.oldValue = func(context.Context) (*MessageWithOptionals, error) {
+// withMessageWithEnum sets the old MessageWithEnum of the mutation.
+func withMessageWithEnum(node *MessageWithEnum) messagewithenumOption {
+	return func(m *MessageWithEnumMutation) {
+		m.oldValue = func(context.Context) (*MessageWithEnum, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4410,7 +4563,7 @@ func withMessageWithOptionals(node *MessageWithOptionals) messagewithoptionalsOp
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageWithOptionalsMutation) Client() *Client {
+func (m MessageWithEnumMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4418,7 +4571,7 @@ func (m MessageWithOptionalsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageWithOptionalsMutation) Tx() (*Tx, error) {
+func (m MessageWithEnumMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4429,7 +4582,7 @@ func (m MessageWithOptionalsMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageWithOptionalsMutation) ID() (id int, exists bool) {
+func (m *MessageWithEnumMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4440,7 +4593,7 @@ func (m *MessageWithOptionalsMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageWithOptionalsMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *MessageWithEnumMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4449,864 +4602,4323 @@ func (m *MessageWithOptionalsMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MessageWithOptionals.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().MessageWithEnum.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetStrOptional sets the "str_optional" field.
-func (m *MessageWithOptionalsMutation) SetStrOptional(s string) {
-	m.str_optional = &s
+// SetEnumType sets the "enum_type" field.
+func (m *MessageWithEnumMutation) SetEnumType(mt messagewithenum.EnumType) {
+	m.enum_type = &mt
 }
 
-// StrOptional returns the value of the "str_optional" field in the mutation.
-func (m *MessageWithOptionalsMutation) StrOptional() (r string, exists bool) {
-	v := m.str_optional
+// EnumType returns the value of the "enum_type" field in the mutation.
+func (m *MessageWithEnumMutation) EnumType() (r messagewithenum.EnumType, exists bool) {
+	v := m.enum_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStrOptional returns the old "str_optional" field's value of the MessageWithOptionals entity.
-// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// OldEnumType returns the old "enum_type" field's value of the MessageWithEnum entity.
+// If the MessageWithEnum object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithOptionalsMutation) OldStrOptional(ctx context.Context) (v string, err error) {
+func (m *MessageWithEnumMutation) OldEnumType(ctx context.Context) (v messagewithenum.EnumType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStrOptional is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnumType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStrOptional requires an ID field in the mutation")
+		return v, errors.New("OldEnumType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStrOptional: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnumType: %w", err)
 	}
-	return oldValue.StrOptional, nil
-}
-
-// ClearStrOptional clears the value of the "str_optional" field.
-func (m *MessageWithOptionalsMutation) ClearStrOptional() {
-	m.str_optional = nil
-	m.clearedFields[messagewithoptionals.FieldStrOptional] = struct{}{}
+	return oldValue.EnumType, nil
 }
 
-// StrOptionalCleared returns if the "str_optional" field was cleared in this mutation.
-func (m *MessageWithOptionalsMutation) StrOptionalCleared() bool {
-	_, ok := m.clearedFields[messagewithoptionals.FieldStrOptional]
-	return ok
+// ResetEnumType resets all changes to the "enum_type" field.
+func (m *MessageWithEnumMutation) ResetEnumType() {
+	m.enum_type = nil
 }
 
-// ResetStrOptional resets all changes to the "str_optional" field.
-func (m *MessageWithOptionalsMutation) ResetStrOptional() {
-	m.str_optional = nil
-	delete(m.clearedFields, messagewithoptionals.FieldStrOptional)
+// SetEnumWithoutDefault sets the "enum_without_default" field.
+func (m *MessageWithEnumMutation) SetEnumWithoutDefault(mwd messagewithenum.EnumWithoutDefault) {
+	m.enum_without_default = &mwd
 }
 
-// SetIntOptional sets the "int_optional" field.
-func (m *MessageWithOptionalsMutation) SetIntOptional(i int8) {
-	m.int_optional = &i
-	m.addint_optional = nil
-}
-
-// IntOptional returns the value of the "int_optional" field in the mutation.
-func (m *MessageWithOptionalsMutation) IntOptional() (r int8, exists bool) {
-	v := m.int_optional
+// EnumWithoutDefault returns the value of the "enum_without_default" field in the mutation.
+func (m *MessageWithEnumMutation) EnumWithoutDefault() (r messagewithenum.EnumWithoutDefault, exists bool) {
+	v := m.enum_without_default
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIntOptional returns the old "int_optional" field's value of the MessageWithOptionals entity.
-// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// OldEnumWithoutDefault returns the old "enum_without_default" field's value of the MessageWithEnum entity.
+// If the MessageWithEnum object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithOptionalsMutation) OldIntOptional(ctx context.Context) (v int8, err error) {
+func (m *MessageWithEnumMutation) OldEnumWithoutDefault(ctx context.Context) (v messagewithenum.EnumWithoutDefault, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIntOptional is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnumWithoutDefault is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIntOptional requires an ID field in the mutation")
+		return v, errors.New("OldEnumWithoutDefault requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIntOptional: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnumWithoutDefault: %w", err)
 	}
-	return oldValue.IntOptional, nil
+	return oldValue.EnumWithoutDefault, nil
 }
 
-// AddIntOptional adds i to the "int_optional" field.
-func (m *MessageWithOptionalsMutation) AddIntOptional(i int8) {
-	if m.addint_optional != nil {
-		*m.addint_optional += i
-	} else {
-		m.addint_optional = &i
-	}
+// ResetEnumWithoutDefault resets all changes to the "enum_without_default" field.
+func (m *MessageWithEnumMutation) ResetEnumWithoutDefault() {
+	m.enum_without_default = nil
 }
 
-// AddedIntOptional returns the value that was added to the "int_optional" field in this mutation.
-func (m *MessageWithOptionalsMutation) AddedIntOptional() (r int8, exists bool) {
-	v := m.addint_optional
+// SetEnumZeroMapped sets the "enum_zero_mapped" field.
+func (m *MessageWithEnumMutation) SetEnumZeroMapped(mzm messagewithenum.EnumZeroMapped) {
+	m.enum_zero_mapped = &mzm
+}
+
+// EnumZeroMapped returns the value of the "enum_zero_mapped" field in the mutation.
+func (m *MessageWithEnumMutation) EnumZeroMapped() (r messagewithenum.EnumZeroMapped, exists bool) {
+	v := m.enum_zero_mapped
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearIntOptional clears the value of the "int_optional" field.
-func (m *MessageWithOptionalsMutation) ClearIntOptional() {
-	m.int_optional = nil
-	m.addint_optional = nil
-	m.clearedFields[messagewithoptionals.FieldIntOptional] = struct{}{}
-}
-
-// IntOptionalCleared returns if the "int_optional" field was cleared in this mutation.
-func (m *MessageWithOptionalsMutation) IntOptionalCleared() bool {
-	_, ok := m.clearedFields[messagewithoptionals.FieldIntOptional]
-	return ok
+// OldEnumZeroMapped returns the old "enum_zero_mapped" field's value of the MessageWithEnum entity.
+// If the MessageWithEnum object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithEnumMutation) OldEnumZeroMapped(ctx context.Context) (v messagewithenum.EnumZeroMapped, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnumZeroMapped is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnumZeroMapped requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnumZeroMapped: %w", err)
+	}
+	return oldValue.EnumZeroMapped, nil
 }
 
-// ResetIntOptional resets all changes to the "int_optional" field.
-func (m *MessageWithOptionalsMutation) ResetIntOptional() {
-	m.int_optional = nil
-	m.addint_optional = nil
-	delete(m.clearedFields, messagewithoptionals.FieldIntOptional)
+// ResetEnumZeroMapped resets all changes to the "enum_zero_mapped" field.
+func (m *MessageWithEnumMutation) ResetEnumZeroMapped() {
+	m.enum_zero_mapped = nil
 }
 
-// SetUintOptional sets the "uint_optional" field.
-func (m *MessageWithOptionalsMutation) SetUintOptional(u uint8) {
-	m.uint_optional = &u
-	m.adduint_optional = nil
+// SetEnumUnknown sets the "enum_unknown" field.
+func (m *MessageWithEnumMutation) SetEnumUnknown(mu messagewithenum.EnumUnknown) {
+	m.enum_unknown = &mu
 }
 
-// UintOptional returns the value of the "uint_optional" field in the mutation.
-func (m *MessageWithOptionalsMutation) UintOptional() (r uint8, exists bool) {
-	v := m.uint_optional
+// EnumUnknown returns the value of the "enum_unknown" field in the mutation.
+func (m *MessageWithEnumMutation) EnumUnknown() (r messagewithenum.EnumUnknown, exists bool) {
+	v := m.enum_unknown
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUintOptional returns the old "uint_optional" field's value of the MessageWithOptionals entity.
-// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// OldEnumUnknown returns the old "enum_unknown" field's value of the MessageWithEnum entity.
+// If the MessageWithEnum object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithOptionalsMutation) OldUintOptional(ctx context.Context) (v uint8, err error) {
+func (m *MessageWithEnumMutation) OldEnumUnknown(ctx context.Context) (v messagewithenum.EnumUnknown, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUintOptional is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnumUnknown is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUintOptional requires an ID field in the mutation")
+		return v, errors.New("OldEnumUnknown requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUintOptional: %w", err)
-	}
-	return oldValue.UintOptional, nil
-}
-
-// AddUintOptional adds u to the "uint_optional" field.
-func (m *MessageWithOptionalsMutation) AddUintOptional(u int8) {
-	if m.adduint_optional != nil {
-		*m.adduint_optional += u
-	} else {
-		m.adduint_optional = &u
-	}
-}
-
-// AddedUintOptional returns the value that was added to the "uint_optional" field in this mutation.
-func (m *MessageWithOptionalsMutation) AddedUintOptional() (r int8, exists bool) {
-	v := m.adduint_optional
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldEnumUnknown: %w", err)
 	}
-	return *v, true
+	return oldValue.EnumUnknown, nil
 }
 
-// ClearUintOptional clears the value of the "uint_optional" field.
-func (m *MessageWithOptionalsMutation) ClearUintOptional() {
-	m.uint_optional = nil
-	m.adduint_optional = nil
-	m.clearedFields[messagewithoptionals.FieldUintOptional] = struct{}{}
+// ResetEnumUnknown resets all changes to the "enum_unknown" field.
+func (m *MessageWithEnumMutation) ResetEnumUnknown() {
+	m.enum_unknown = nil
 }
 
-// UintOptionalCleared returns if the "uint_optional" field was cleared in this mutation.
-func (m *MessageWithOptionalsMutation) UintOptionalCleared() bool {
-	_, ok := m.clearedFields[messagewithoptionals.FieldUintOptional]
-	return ok
+// Where appends a list predicates to the MessageWithEnumMutation builder.
+func (m *MessageWithEnumMutation) Where(ps ...predicate.MessageWithEnum) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetUintOptional resets all changes to the "uint_optional" field.
-func (m *MessageWithOptionalsMutation) ResetUintOptional() {
-	m.uint_optional = nil
-	m.adduint_optional = nil
-	delete(m.clearedFields, messagewithoptionals.FieldUintOptional)
+// Op returns the operation name.
+func (m *MessageWithEnumMutation) Op() Op {
+	return m.op
 }
 
-// SetFloatOptional sets the "float_optional" field.
-func (m *MessageWithOptionalsMutation) SetFloatOptional(f float32) {
-	m.float_optional = &f
-	m.addfloat_optional = nil
+// Type returns the node type of this mutation (MessageWithEnum).
+func (m *MessageWithEnumMutation) Type() string {
+	return m.typ
 }
 
-// FloatOptional returns the value of the "float_optional" field in the mutation.
-func (m *MessageWithOptionalsMutation) FloatOptional() (r float32, exists bool) {
-	v := m.float_optional
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageWithEnumMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.enum_type != nil {
+		fields = append(fields, messagewithenum.FieldEnumType)
 	}
-	return *v, true
-}
-
-// OldFloatOptional returns the old "float_optional" field's value of the MessageWithOptionals entity.
-// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithOptionalsMutation) OldFloatOptional(ctx context.Context) (v float32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFloatOptional is only allowed on UpdateOne operations")
+	if m.enum_without_default != nil {
+		fields = append(fields, messagewithenum.FieldEnumWithoutDefault)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFloatOptional requires an ID field in the mutation")
+	if m.enum_zero_mapped != nil {
+		fields = append(fields, messagewithenum.FieldEnumZeroMapped)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFloatOptional: %w", err)
+	if m.enum_unknown != nil {
+		fields = append(fields, messagewithenum.FieldEnumUnknown)
 	}
-	return oldValue.FloatOptional, nil
+	return fields
 }
 
-// AddFloatOptional adds f to the "float_optional" field.
-func (m *MessageWithOptionalsMutation) AddFloatOptional(f float32) {
-	if m.addfloat_optional != nil {
-		*m.addfloat_optional += f
-	} else {
-		m.addfloat_optional = &f
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageWithEnumMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagewithenum.FieldEnumType:
+		return m.EnumType()
+	case messagewithenum.FieldEnumWithoutDefault:
+		return m.EnumWithoutDefault()
+	case messagewithenum.FieldEnumZeroMapped:
+		return m.EnumZeroMapped()
+	case messagewithenum.FieldEnumUnknown:
+		return m.EnumUnknown()
 	}
+	return nil, false
 }
 
-// AddedFloatOptional returns the value that was added to the "float_optional" field in this mutation.
-func (m *MessageWithOptionalsMutation) AddedFloatOptional() (r float32, exists bool) {
-	v := m.addfloat_optional
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageWithEnumMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagewithenum.FieldEnumType:
+		return m.OldEnumType(ctx)
+	case messagewithenum.FieldEnumWithoutDefault:
+		return m.OldEnumWithoutDefault(ctx)
+	case messagewithenum.FieldEnumZeroMapped:
+		return m.OldEnumZeroMapped(ctx)
+	case messagewithenum.FieldEnumUnknown:
+		return m.OldEnumUnknown(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown MessageWithEnum field %s", name)
 }
 
-// ClearFloatOptional clears the value of the "float_optional" field.
-func (m *MessageWithOptionalsMutation) ClearFloatOptional() {
-	m.float_optional = nil
-	m.addfloat_optional = nil
-	m.clearedFields[messagewithoptionals.FieldFloatOptional] = struct{}{}
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageWithEnumMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagewithenum.FieldEnumType:
+		v, ok := value.(messagewithenum.EnumType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnumType(v)
+		return nil
+	case messagewithenum.FieldEnumWithoutDefault:
+		v, ok := value.(messagewithenum.EnumWithoutDefault)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnumWithoutDefault(v)
+		return nil
+	case messagewithenum.FieldEnumZeroMapped:
+		v, ok := value.(messagewithenum.EnumZeroMapped)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnumZeroMapped(v)
+		return nil
+	case messagewithenum.FieldEnumUnknown:
+		v, ok := value.(messagewithenum.EnumUnknown)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnumUnknown(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithEnum field %s", name)
 }
 
-// FloatOptionalCleared returns if the "float_optional" field was cleared in this mutation.
-func (m *MessageWithOptionalsMutation) FloatOptionalCleared() bool {
-	_, ok := m.clearedFields[messagewithoptionals.FieldFloatOptional]
-	return ok
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageWithEnumMutation) AddedFields() []string {
+	return nil
 }
 
-// ResetFloatOptional resets all changes to the "float_optional" field.
-func (m *MessageWithOptionalsMutation) ResetFloatOptional() {
-	m.float_optional = nil
-	m.addfloat_optional = nil
-	delete(m.clearedFields, messagewithoptionals.FieldFloatOptional)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageWithEnumMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// SetBoolOptional sets the "bool_optional" field.
-func (m *MessageWithOptionalsMutation) SetBoolOptional(b bool) {
-	m.bool_optional = &b
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageWithEnumMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageWithEnum numeric field %s", name)
 }
 
-// BoolOptional returns the value of the "bool_optional" field in the mutation.
-func (m *MessageWithOptionalsMutation) BoolOptional() (r bool, exists bool) {
-	v := m.bool_optional
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageWithEnumMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageWithEnumMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageWithEnumMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageWithEnum nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageWithEnumMutation) ResetField(name string) error {
+	switch name {
+	case messagewithenum.FieldEnumType:
+		m.ResetEnumType()
+		return nil
+	case messagewithenum.FieldEnumWithoutDefault:
+		m.ResetEnumWithoutDefault()
+		return nil
+	case messagewithenum.FieldEnumZeroMapped:
+		m.ResetEnumZeroMapped()
+		return nil
+	case messagewithenum.FieldEnumUnknown:
+		m.ResetEnumUnknown()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithEnum field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageWithEnumMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageWithEnumMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageWithEnumMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageWithEnumMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageWithEnumMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageWithEnumMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageWithEnumMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithEnum unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageWithEnumMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithEnum edge %s", name)
+}
+
+// MessageWithFieldOneMutation represents an operation that mutates the MessageWithFieldOne nodes in the graph.
+type MessageWithFieldOneMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	field_one     *int32
+	addfield_one  *int32
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MessageWithFieldOne, error)
+	predicates    []predicate.MessageWithFieldOne
+}
+
+var _ ent.Mutation = (*MessageWithFieldOneMutation)(nil)
+
+// messagewithfieldoneOption allows management of the mutation configuration using functional options.
+type messagewithfieldoneOption func(*MessageWithFieldOneMutation)
+
+// newMessageWithFieldOneMutation creates new mutation for the MessageWithFieldOne entity.
+func newMessageWithFieldOneMutation(c config, op Op, opts ...messagewithfieldoneOption) *MessageWithFieldOneMutation {
+	m := &MessageWithFieldOneMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageWithFieldOne,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageWithFieldOneID sets the ID field of the mutation.
+func withMessageWithFieldOneID(id int) messagewithfieldoneOption {
+	return func(m *MessageWithFieldOneMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageWithFieldOne
+		)
+		m.oldValue = func(ctx context.Context) (*MessageWithFieldOne, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageWithFieldOne.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageWithFieldOne sets the old MessageWithFieldOne of the mutation.
+func withMessageWithFieldOne(node *MessageWithFieldOne) messagewithfieldoneOption {
+	return func(m *MessageWithFieldOneMutation) {
+		m.oldValue = func(context.Context) (*MessageWithFieldOne, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageWithFieldOneMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageWithFieldOneMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageWithFieldOneMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageWithFieldOneMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageWithFieldOne.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFieldOne sets the "field_one" field.
+func (m *MessageWithFieldOneMutation) SetFieldOne(i int32) {
+	m.field_one = &i
+	m.addfield_one = nil
+}
+
+// FieldOne returns the value of the "field_one" field in the mutation.
+func (m *MessageWithFieldOneMutation) FieldOne() (r int32, exists bool) {
+	v := m.field_one
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFieldOne returns the old "field_one" field's value of the MessageWithFieldOne entity.
+// If the MessageWithFieldOne object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithFieldOneMutation) OldFieldOne(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFieldOne is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFieldOne requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFieldOne: %w", err)
+	}
+	return oldValue.FieldOne, nil
+}
+
+// AddFieldOne adds i to the "field_one" field.
+func (m *MessageWithFieldOneMutation) AddFieldOne(i int32) {
+	if m.addfield_one != nil {
+		*m.addfield_one += i
+	} else {
+		m.addfield_one = &i
+	}
+}
+
+// AddedFieldOne returns the value that was added to the "field_one" field in this mutation.
+func (m *MessageWithFieldOneMutation) AddedFieldOne() (r int32, exists bool) {
+	v := m.addfield_one
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFieldOne resets all changes to the "field_one" field.
+func (m *MessageWithFieldOneMutation) ResetFieldOne() {
+	m.field_one = nil
+	m.addfield_one = nil
+}
+
+// Where appends a list predicates to the MessageWithFieldOneMutation builder.
+func (m *MessageWithFieldOneMutation) Where(ps ...predicate.MessageWithFieldOne) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *MessageWithFieldOneMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (MessageWithFieldOne).
+func (m *MessageWithFieldOneMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageWithFieldOneMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.field_one != nil {
+		fields = append(fields, messagewithfieldone.FieldFieldOne)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageWithFieldOneMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagewithfieldone.FieldFieldOne:
+		return m.FieldOne()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageWithFieldOneMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagewithfieldone.FieldFieldOne:
+		return m.OldFieldOne(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageWithFieldOne field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageWithFieldOneMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagewithfieldone.FieldFieldOne:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFieldOne(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithFieldOne field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageWithFieldOneMutation) AddedFields() []string {
+	var fields []string
+	if m.addfield_one != nil {
+		fields = append(fields, messagewithfieldone.FieldFieldOne)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageWithFieldOneMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case messagewithfieldone.FieldFieldOne:
+		return m.AddedFieldOne()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageWithFieldOneMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case messagewithfieldone.FieldFieldOne:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFieldOne(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithFieldOne numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageWithFieldOneMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageWithFieldOneMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageWithFieldOneMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageWithFieldOne nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageWithFieldOneMutation) ResetField(name string) error {
+	switch name {
+	case messagewithfieldone.FieldFieldOne:
+		m.ResetFieldOne()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithFieldOne field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageWithFieldOneMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageWithFieldOneMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageWithFieldOneMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageWithFieldOneMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageWithFieldOneMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageWithFieldOneMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageWithFieldOneMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithFieldOne unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageWithFieldOneMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithFieldOne edge %s", name)
+}
+
+// MessageWithIDMutation represents an operation that mutates the MessageWithID nodes in the graph.
+type MessageWithIDMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int32
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MessageWithID, error)
+	predicates    []predicate.MessageWithID
+}
+
+var _ ent.Mutation = (*MessageWithIDMutation)(nil)
+
+// messagewithidOption allows management of the mutation configuration using functional options.
+type messagewithidOption func(*MessageWithIDMutation)
+
+// newMessageWithIDMutation creates new mutation for the MessageWithID entity.
+func newMessageWithIDMutation(c config, op Op, opts ...messagewithidOption) *MessageWithIDMutation {
+	m := &MessageWithIDMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageWithID,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageWithIDID sets the ID field of the mutation.
+func withMessageWithIDID(id int32) messagewithidOption {
+	return func(m *MessageWithIDMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageWithID
+		)
+		m.oldValue = func(ctx context.Context) (*MessageWithID, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageWithID.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageWithID sets the old MessageWithID of the mutation.
+func withMessageWithID(node *MessageWithID) messagewithidOption {
+	return func(m *MessageWithIDMutation) {
+		m.oldValue = func(context.Context) (*MessageWithID, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageWithIDMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageWithIDMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageWithID entities.
+func (m *MessageWithIDMutation) SetID(id int32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageWithIDMutation) ID() (id int32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageWithIDMutation) IDs(ctx context.Context) ([]int32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageWithID.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// Where appends a list predicates to the MessageWithIDMutation builder.
+func (m *MessageWithIDMutation) Where(ps ...predicate.MessageWithID) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *MessageWithIDMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (MessageWithID).
+func (m *MessageWithIDMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageWithIDMutation) Fields() []string {
+	fields := make([]string, 0, 0)
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageWithIDMutation) Field(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageWithIDMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, fmt.Errorf("unknown MessageWithID field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageWithIDMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageWithID field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageWithIDMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageWithIDMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageWithIDMutation) AddField(name string, value ent.Value) error {
+	return fmt.Errorf("unknown MessageWithID numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageWithIDMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageWithIDMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageWithIDMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageWithID nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageWithIDMutation) ResetField(name string) error {
+	return fmt.Errorf("unknown MessageWithID field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageWithIDMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageWithIDMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageWithIDMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageWithIDMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageWithIDMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageWithIDMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageWithIDMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithID unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageWithIDMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithID edge %s", name)
+}
+
+// MessageWithOptionalsMutation represents an operation that mutates the MessageWithOptionals nodes in the graph.
+type MessageWithOptionalsMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	str_optional      *string
+	int_optional      *int8
+	addint_optional   *int8
+	uint_optional     *uint8
+	adduint_optional  *int8
+	float_optional    *float32
+	addfloat_optional *float32
+	bool_optional     *bool
+	bytes_optional    *[]byte
+	uuid_optional     *uuid.UUID
+	time_optional     *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*MessageWithOptionals, error)
+	predicates        []predicate.MessageWithOptionals
+}
+
+var _ ent.Mutation = (*MessageWithOptionalsMutation)(nil)
+
+// messagewithoptionalsOption allows management of the mutation configuration using functional options.
+type messagewithoptionalsOption func(*MessageWithOptionalsMutation)
+
+// newMessageWithOptionalsMutation creates new mutation for the MessageWithOptionals entity.
+func newMessageWithOptionalsMutation(c config, op Op, opts ...messagewithoptionalsOption) *MessageWithOptionalsMutation {
+	m := &MessageWithOptionalsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageWithOptionals,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageWithOptionalsID sets the ID field of the mutation.
+func withMessageWithOptionalsID(id int) messagewithoptionalsOption {
+	return func(m *MessageWithOptionalsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageWithOptionals
+		)
+		m.oldValue = func(ctx context.Context) (*MessageWithOptionals, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageWithOptionals.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageWithOptionals sets the old MessageWithOptionals of the mutation.
+func withMessageWithOptionals(node *MessageWithOptionals) messagewithoptionalsOption {
+	return func(m *MessageWithOptionalsMutation) {
+		m.oldValue = func(context.Context) (*MessageWithOptionals, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageWithOptionalsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageWithOptionalsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageWithOptionalsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageWithOptionalsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageWithOptionals.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStrOptional sets the "str_optional" field.
+func (m *MessageWithOptionalsMutation) SetStrOptional(s string) {
+	m.str_optional = &s
+}
+
+// StrOptional returns the value of the "str_optional" field in the mutation.
+func (m *MessageWithOptionalsMutation) StrOptional() (r string, exists bool) {
+	v := m.str_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrOptional returns the old "str_optional" field's value of the MessageWithOptionals entity.
+// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithOptionalsMutation) OldStrOptional(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStrOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStrOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrOptional: %w", err)
+	}
+	return oldValue.StrOptional, nil
+}
+
+// ClearStrOptional clears the value of the "str_optional" field.
+func (m *MessageWithOptionalsMutation) ClearStrOptional() {
+	m.str_optional = nil
+	m.clearedFields[messagewithoptionals.FieldStrOptional] = struct{}{}
+}
+
+// StrOptionalCleared returns if the "str_optional" field was cleared in this mutation.
+func (m *MessageWithOptionalsMutation) StrOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithoptionals.FieldStrOptional]
+	return ok
+}
+
+// ResetStrOptional resets all changes to the "str_optional" field.
+func (m *MessageWithOptionalsMutation) ResetStrOptional() {
+	m.str_optional = nil
+	delete(m.clearedFields, messagewithoptionals.FieldStrOptional)
+}
+
+// SetIntOptional sets the "int_optional" field.
+func (m *MessageWithOptionalsMutation) SetIntOptional(i int8) {
+	m.int_optional = &i
+	m.addint_optional = nil
+}
+
+// IntOptional returns the value of the "int_optional" field in the mutation.
+func (m *MessageWithOptionalsMutation) IntOptional() (r int8, exists bool) {
+	v := m.int_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntOptional returns the old "int_optional" field's value of the MessageWithOptionals entity.
+// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithOptionalsMutation) OldIntOptional(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIntOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIntOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntOptional: %w", err)
+	}
+	return oldValue.IntOptional, nil
+}
+
+// AddIntOptional adds i to the "int_optional" field.
+func (m *MessageWithOptionalsMutation) AddIntOptional(i int8) {
+	if m.addint_optional != nil {
+		*m.addint_optional += i
+	} else {
+		m.addint_optional = &i
+	}
+}
+
+// AddedIntOptional returns the value that was added to the "int_optional" field in this mutation.
+func (m *MessageWithOptionalsMutation) AddedIntOptional() (r int8, exists bool) {
+	v := m.addint_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearIntOptional clears the value of the "int_optional" field.
+func (m *MessageWithOptionalsMutation) ClearIntOptional() {
+	m.int_optional = nil
+	m.addint_optional = nil
+	m.clearedFields[messagewithoptionals.FieldIntOptional] = struct{}{}
+}
+
+// IntOptionalCleared returns if the "int_optional" field was cleared in this mutation.
+func (m *MessageWithOptionalsMutation) IntOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithoptionals.FieldIntOptional]
+	return ok
+}
+
+// ResetIntOptional resets all changes to the "int_optional" field.
+func (m *MessageWithOptionalsMutation) ResetIntOptional() {
+	m.int_optional = nil
+	m.addint_optional = nil
+	delete(m.clearedFields, messagewithoptionals.FieldIntOptional)
+}
+
+// SetUintOptional sets the "uint_optional" field.
+func (m *MessageWithOptionalsMutation) SetUintOptional(u uint8) {
+	m.uint_optional = &u
+	m.adduint_optional = nil
+}
+
+// UintOptional returns the value of the "uint_optional" field in the mutation.
+func (m *MessageWithOptionalsMutation) UintOptional() (r uint8, exists bool) {
+	v := m.uint_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUintOptional returns the old "uint_optional" field's value of the MessageWithOptionals entity.
+// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithOptionalsMutation) OldUintOptional(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUintOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUintOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUintOptional: %w", err)
+	}
+	return oldValue.UintOptional, nil
+}
+
+// AddUintOptional adds u to the "uint_optional" field.
+func (m *MessageWithOptionalsMutation) AddUintOptional(u int8) {
+	if m.adduint_optional != nil {
+		*m.adduint_optional += u
+	} else {
+		m.adduint_optional = &u
+	}
+}
+
+// AddedUintOptional returns the value that was added to the "uint_optional" field in this mutation.
+func (m *MessageWithOptionalsMutation) AddedUintOptional() (r int8, exists bool) {
+	v := m.adduint_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUintOptional clears the value of the "uint_optional" field.
+func (m *MessageWithOptionalsMutation) ClearUintOptional() {
+	m.uint_optional = nil
+	m.adduint_optional = nil
+	m.clearedFields[messagewithoptionals.FieldUintOptional] = struct{}{}
+}
+
+// UintOptionalCleared returns if the "uint_optional" field was cleared in this mutation.
+func (m *MessageWithOptionalsMutation) UintOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithoptionals.FieldUintOptional]
+	return ok
+}
+
+// ResetUintOptional resets all changes to the "uint_optional" field.
+func (m *MessageWithOptionalsMutation) ResetUintOptional() {
+	m.uint_optional = nil
+	m.adduint_optional = nil
+	delete(m.clearedFields, messagewithoptionals.FieldUintOptional)
+}
+
+// SetFloatOptional sets the "float_optional" field.
+func (m *MessageWithOptionalsMutation) SetFloatOptional(f float32) {
+	m.float_optional = &f
+	m.addfloat_optional = nil
+}
+
+// FloatOptional returns the value of the "float_optional" field in the mutation.
+func (m *MessageWithOptionalsMutation) FloatOptional() (r float32, exists bool) {
+	v := m.float_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFloatOptional returns the old "float_optional" field's value of the MessageWithOptionals entity.
+// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithOptionalsMutation) OldFloatOptional(ctx context.Context) (v float32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFloatOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFloatOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFloatOptional: %w", err)
+	}
+	return oldValue.FloatOptional, nil
+}
+
+// AddFloatOptional adds f to the "float_optional" field.
+func (m *MessageWithOptionalsMutation) AddFloatOptional(f float32) {
+	if m.addfloat_optional != nil {
+		*m.addfloat_optional += f
+	} else {
+		m.addfloat_optional = &f
+	}
+}
+
+// AddedFloatOptional returns the value that was added to the "float_optional" field in this mutation.
+func (m *MessageWithOptionalsMutation) AddedFloatOptional() (r float32, exists bool) {
+	v := m.addfloat_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFloatOptional clears the value of the "float_optional" field.
+func (m *MessageWithOptionalsMutation) ClearFloatOptional() {
+	m.float_optional = nil
+	m.addfloat_optional = nil
+	m.clearedFields[messagewithoptionals.FieldFloatOptional] = struct{}{}
+}
+
+// FloatOptionalCleared returns if the "float_optional" field was cleared in this mutation.
+func (m *MessageWithOptionalsMutation) FloatOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithoptionals.FieldFloatOptional]
+	return ok
+}
+
+// ResetFloatOptional resets all changes to the "float_optional" field.
+func (m *MessageWithOptionalsMutation) ResetFloatOptional() {
+	m.float_optional = nil
+	m.addfloat_optional = nil
+	delete(m.clearedFields, messagewithoptionals.FieldFloatOptional)
+}
+
+// SetBoolOptional sets the "bool_optional" field.
+func (m *MessageWithOptionalsMutation) SetBoolOptional(b bool) {
+	m.bool_optional = &b
+}
+
+// BoolOptional returns the value of the "bool_optional" field in the mutation.
+func (m *MessageWithOptionalsMutation) BoolOptional() (r bool, exists bool) {
+	v := m.bool_optional
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
 // OldBoolOptional returns the old "bool_optional" field's value of the MessageWithOptionals entity.
 // If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithOptionalsMutation) OldBoolOptional(ctx context.Context) (v bool, err error) {
+func (m *MessageWithOptionalsMutation) OldBoolOptional(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBoolOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBoolOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBoolOptional: %w", err)
+	}
+	return oldValue.BoolOptional, nil
+}
+
+// ClearBoolOptional clears the value of the "bool_optional" field.
+func (m *MessageWithOptionalsMutation) ClearBoolOptional() {
+	m.bool_optional = nil
+	m.clearedFields[messagewithoptionals.FieldBoolOptional] = struct{}{}
+}
+
+// BoolOptionalCleared returns if the "bool_optional" field was cleared in this mutation.
+func (m *MessageWithOptionalsMutation) BoolOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithoptionals.FieldBoolOptional]
+	return ok
+}
+
+// ResetBoolOptional resets all changes to the "bool_optional" field.
+func (m *MessageWithOptionalsMutation) ResetBoolOptional() {
+	m.bool_optional = nil
+	delete(m.clearedFields, messagewithoptionals.FieldBoolOptional)
+}
+
+// SetBytesOptional sets the "bytes_optional" field.
+func (m *MessageWithOptionalsMutation) SetBytesOptional(b []byte) {
+	m.bytes_optional = &b
+}
+
+// BytesOptional returns the value of the "bytes_optional" field in the mutation.
+func (m *MessageWithOptionalsMutation) BytesOptional() (r []byte, exists bool) {
+	v := m.bytes_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBytesOptional returns the old "bytes_optional" field's value of the MessageWithOptionals entity.
+// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithOptionalsMutation) OldBytesOptional(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBytesOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBytesOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBytesOptional: %w", err)
+	}
+	return oldValue.BytesOptional, nil
+}
+
+// ClearBytesOptional clears the value of the "bytes_optional" field.
+func (m *MessageWithOptionalsMutation) ClearBytesOptional() {
+	m.bytes_optional = nil
+	m.clearedFields[messagewithoptionals.FieldBytesOptional] = struct{}{}
+}
+
+// BytesOptionalCleared returns if the "bytes_optional" field was cleared in this mutation.
+func (m *MessageWithOptionalsMutation) BytesOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithoptionals.FieldBytesOptional]
+	return ok
+}
+
+// ResetBytesOptional resets all changes to the "bytes_optional" field.
+func (m *MessageWithOptionalsMutation) ResetBytesOptional() {
+	m.bytes_optional = nil
+	delete(m.clearedFields, messagewithoptionals.FieldBytesOptional)
+}
+
+// SetUUIDOptional sets the "uuid_optional" field.
+func (m *MessageWithOptionalsMutation) SetUUIDOptional(u uuid.UUID) {
+	m.uuid_optional = &u
+}
+
+// UUIDOptional returns the value of the "uuid_optional" field in the mutation.
+func (m *MessageWithOptionalsMutation) UUIDOptional() (r uuid.UUID, exists bool) {
+	v := m.uuid_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUUIDOptional returns the old "uuid_optional" field's value of the MessageWithOptionals entity.
+// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithOptionalsMutation) OldUUIDOptional(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUUIDOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUUIDOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUUIDOptional: %w", err)
+	}
+	return oldValue.UUIDOptional, nil
+}
+
+// ClearUUIDOptional clears the value of the "uuid_optional" field.
+func (m *MessageWithOptionalsMutation) ClearUUIDOptional() {
+	m.uuid_optional = nil
+	m.clearedFields[messagewithoptionals.FieldUUIDOptional] = struct{}{}
+}
+
+// UUIDOptionalCleared returns if the "uuid_optional" field was cleared in this mutation.
+func (m *MessageWithOptionalsMutation) UUIDOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithoptionals.FieldUUIDOptional]
+	return ok
+}
+
+// ResetUUIDOptional resets all changes to the "uuid_optional" field.
+func (m *MessageWithOptionalsMutation) ResetUUIDOptional() {
+	m.uuid_optional = nil
+	delete(m.clearedFields, messagewithoptionals.FieldUUIDOptional)
+}
+
+// SetTimeOptional sets the "time_optional" field.
+func (m *MessageWithOptionalsMutation) SetTimeOptional(t time.Time) {
+	m.time_optional = &t
+}
+
+// TimeOptional returns the value of the "time_optional" field in the mutation.
+func (m *MessageWithOptionalsMutation) TimeOptional() (r time.Time, exists bool) {
+	v := m.time_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeOptional returns the old "time_optional" field's value of the MessageWithOptionals entity.
+// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithOptionalsMutation) OldTimeOptional(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeOptional: %w", err)
+	}
+	return oldValue.TimeOptional, nil
+}
+
+// ClearTimeOptional clears the value of the "time_optional" field.
+func (m *MessageWithOptionalsMutation) ClearTimeOptional() {
+	m.time_optional = nil
+	m.clearedFields[messagewithoptionals.FieldTimeOptional] = struct{}{}
+}
+
+// TimeOptionalCleared returns if the "time_optional" field was cleared in this mutation.
+func (m *MessageWithOptionalsMutation) TimeOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithoptionals.FieldTimeOptional]
+	return ok
+}
+
+// ResetTimeOptional resets all changes to the "time_optional" field.
+func (m *MessageWithOptionalsMutation) ResetTimeOptional() {
+	m.time_optional = nil
+	delete(m.clearedFields, messagewithoptionals.FieldTimeOptional)
+}
+
+// Where appends a list predicates to the MessageWithOptionalsMutation builder.
+func (m *MessageWithOptionalsMutation) Where(ps ...predicate.MessageWithOptionals) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *MessageWithOptionalsMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (MessageWithOptionals).
+func (m *MessageWithOptionalsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageWithOptionalsMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.str_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldStrOptional)
+	}
+	if m.int_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldIntOptional)
+	}
+	if m.uint_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldUintOptional)
+	}
+	if m.float_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldFloatOptional)
+	}
+	if m.bool_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldBoolOptional)
+	}
+	if m.bytes_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldBytesOptional)
+	}
+	if m.uuid_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldUUIDOptional)
+	}
+	if m.time_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldTimeOptional)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageWithOptionalsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagewithoptionals.FieldStrOptional:
+		return m.StrOptional()
+	case messagewithoptionals.FieldIntOptional:
+		return m.IntOptional()
+	case messagewithoptionals.FieldUintOptional:
+		return m.UintOptional()
+	case messagewithoptionals.FieldFloatOptional:
+		return m.FloatOptional()
+	case messagewithoptionals.FieldBoolOptional:
+		return m.BoolOptional()
+	case messagewithoptionals.FieldBytesOptional:
+		return m.BytesOptional()
+	case messagewithoptionals.FieldUUIDOptional:
+		return m.UUIDOptional()
+	case messagewithoptionals.FieldTimeOptional:
+		return m.TimeOptional()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageWithOptionalsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagewithoptionals.FieldStrOptional:
+		return m.OldStrOptional(ctx)
+	case messagewithoptionals.FieldIntOptional:
+		return m.OldIntOptional(ctx)
+	case messagewithoptionals.FieldUintOptional:
+		return m.OldUintOptional(ctx)
+	case messagewithoptionals.FieldFloatOptional:
+		return m.OldFloatOptional(ctx)
+	case messagewithoptionals.FieldBoolOptional:
+		return m.OldBoolOptional(ctx)
+	case messagewithoptionals.FieldBytesOptional:
+		return m.OldBytesOptional(ctx)
+	case messagewithoptionals.FieldUUIDOptional:
+		return m.OldUUIDOptional(ctx)
+	case messagewithoptionals.FieldTimeOptional:
+		return m.OldTimeOptional(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageWithOptionals field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageWithOptionalsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagewithoptionals.FieldStrOptional:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrOptional(v)
+		return nil
+	case messagewithoptionals.FieldIntOptional:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntOptional(v)
+		return nil
+	case messagewithoptionals.FieldUintOptional:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUintOptional(v)
+		return nil
+	case messagewithoptionals.FieldFloatOptional:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFloatOptional(v)
+		return nil
+	case messagewithoptionals.FieldBoolOptional:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBoolOptional(v)
+		return nil
+	case messagewithoptionals.FieldBytesOptional:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBytesOptional(v)
+		return nil
+	case messagewithoptionals.FieldUUIDOptional:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUUIDOptional(v)
+		return nil
+	case messagewithoptionals.FieldTimeOptional:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeOptional(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithOptionals field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageWithOptionalsMutation) AddedFields() []string {
+	var fields []string
+	if m.addint_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldIntOptional)
+	}
+	if m.adduint_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldUintOptional)
+	}
+	if m.addfloat_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldFloatOptional)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageWithOptionalsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case messagewithoptionals.FieldIntOptional:
+		return m.AddedIntOptional()
+	case messagewithoptionals.FieldUintOptional:
+		return m.AddedUintOptional()
+	case messagewithoptionals.FieldFloatOptional:
+		return m.AddedFloatOptional()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageWithOptionalsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case messagewithoptionals.FieldIntOptional:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIntOptional(v)
+		return nil
+	case messagewithoptionals.FieldUintOptional:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUintOptional(v)
+		return nil
+	case messagewithoptionals.FieldFloatOptional:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFloatOptional(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithOptionals numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageWithOptionalsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(messagewithoptionals.FieldStrOptional) {
+		fields = append(fields, messagewithoptionals.FieldStrOptional)
+	}
+	if m.FieldCleared(messagewithoptionals.FieldIntOptional) {
+		fields = append(fields, messagewithoptionals.FieldIntOptional)
+	}
+	if m.FieldCleared(messagewithoptionals.FieldUintOptional) {
+		fields = append(fields, messagewithoptionals.FieldUintOptional)
+	}
+	if m.FieldCleared(messagewithoptionals.FieldFloatOptional) {
+		fields = append(fields, messagewithoptionals.FieldFloatOptional)
+	}
+	if m.FieldCleared(messagewithoptionals.FieldBoolOptional) {
+		fields = append(fields, messagewithoptionals.FieldBoolOptional)
+	}
+	if m.FieldCleared(messagewithoptionals.FieldBytesOptional) {
+		fields = append(fields, messagewithoptionals.FieldBytesOptional)
+	}
+	if m.FieldCleared(messagewithoptionals.FieldUUIDOptional) {
+		fields = append(fields, messagewithoptionals.FieldUUIDOptional)
+	}
+	if m.FieldCleared(messagewithoptionals.FieldTimeOptional) {
+		fields = append(fields, messagewithoptionals.FieldTimeOptional)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageWithOptionalsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageWithOptionalsMutation) ClearField(name string) error {
+	switch name {
+	case messagewithoptionals.FieldStrOptional:
+		m.ClearStrOptional()
+		return nil
+	case messagewithoptionals.FieldIntOptional:
+		m.ClearIntOptional()
+		return nil
+	case messagewithoptionals.FieldUintOptional:
+		m.ClearUintOptional()
+		return nil
+	case messagewithoptionals.FieldFloatOptional:
+		m.ClearFloatOptional()
+		return nil
+	case messagewithoptionals.FieldBoolOptional:
+		m.ClearBoolOptional()
+		return nil
+	case messagewithoptionals.FieldBytesOptional:
+		m.ClearBytesOptional()
+		return nil
+	case messagewithoptionals.FieldUUIDOptional:
+		m.ClearUUIDOptional()
+		return nil
+	case messagewithoptionals.FieldTimeOptional:
+		m.ClearTimeOptional()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithOptionals nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageWithOptionalsMutation) ResetField(name string) error {
+	switch name {
+	case messagewithoptionals.FieldStrOptional:
+		m.ResetStrOptional()
+		return nil
+	case messagewithoptionals.FieldIntOptional:
+		m.ResetIntOptional()
+		return nil
+	case messagewithoptionals.FieldUintOptional:
+		m.ResetUintOptional()
+		return nil
+	case messagewithoptionals.FieldFloatOptional:
+		m.ResetFloatOptional()
+		return nil
+	case messagewithoptionals.FieldBoolOptional:
+		m.ResetBoolOptional()
+		return nil
+	case messagewithoptionals.FieldBytesOptional:
+		m.ResetBytesOptional()
+		return nil
+	case messagewithoptionals.FieldUUIDOptional:
+		m.ResetUUIDOptional()
+		return nil
+	case messagewithoptionals.FieldTimeOptional:
+		m.ResetTimeOptional()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithOptionals field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageWithOptionalsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageWithOptionalsMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageWithOptionalsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageWithOptionalsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageWithOptionalsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageWithOptionalsMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageWithOptionalsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithOptionals unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageWithOptionalsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithOptionals edge %s", name)
+}
+
+// MessageWithPackageNameMutation represents an operation that mutates the MessageWithPackageName nodes in the graph.
+type MessageWithPackageNameMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MessageWithPackageName, error)
+	predicates    []predicate.MessageWithPackageName
+}
+
+var _ ent.Mutation = (*MessageWithPackageNameMutation)(nil)
+
+// messagewithpackagenameOption allows management of the mutation configuration using functional options.
+type messagewithpackagenameOption func(*MessageWithPackageNameMutation)
+
+// newMessageWithPackageNameMutation creates new mutation for the MessageWithPackageName entity.
+func newMessageWithPackageNameMutation(c config, op Op, opts ...messagewithpackagenameOption) *MessageWithPackageNameMutation {
+	m := &MessageWithPackageNameMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageWithPackageName,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageWithPackageNameID sets the ID field of the mutation.
+func withMessageWithPackageNameID(id int) messagewithpackagenameOption {
+	return func(m *MessageWithPackageNameMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageWithPackageName
+		)
+		m.oldValue = func(ctx context.Context) (*MessageWithPackageName, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageWithPackageName.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageWithPackageName sets the old MessageWithPackageName of the mutation.
+func withMessageWithPackageName(node *MessageWithPackageName) messagewithpackagenameOption {
+	return func(m *MessageWithPackageNameMutation) {
+		m.oldValue = func(context.Context) (*MessageWithPackageName, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageWithPackageNameMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageWithPackageNameMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageWithPackageNameMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageWithPackageNameMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageWithPackageName.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *MessageWithPackageNameMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MessageWithPackageNameMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the MessageWithPackageName entity.
+// If the MessageWithPackageName object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithPackageNameMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MessageWithPackageNameMutation) ResetName() {
+	m.name = nil
+}
+
+// Where appends a list predicates to the MessageWithPackageNameMutation builder.
+func (m *MessageWithPackageNameMutation) Where(ps ...predicate.MessageWithPackageName) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *MessageWithPackageNameMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (MessageWithPackageName).
+func (m *MessageWithPackageNameMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageWithPackageNameMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.name != nil {
+		fields = append(fields, messagewithpackagename.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageWithPackageNameMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagewithpackagename.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageWithPackageNameMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagewithpackagename.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageWithPackageName field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageWithPackageNameMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagewithpackagename.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithPackageName field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageWithPackageNameMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageWithPackageNameMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageWithPackageNameMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageWithPackageName numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageWithPackageNameMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageWithPackageNameMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageWithPackageNameMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageWithPackageName nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageWithPackageNameMutation) ResetField(name string) error {
+	switch name {
+	case messagewithpackagename.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithPackageName field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageWithPackageNameMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageWithPackageNameMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageWithPackageNameMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageWithPackageNameMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageWithPackageNameMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageWithPackageNameMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageWithPackageNameMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithPackageName unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageWithPackageNameMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithPackageName edge %s", name)
+}
+
+// MessageWithStringsMutation represents an operation that mutates the MessageWithStrings nodes in the graph.
+type MessageWithStringsMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	strings       *[]string
+	appendstrings []string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MessageWithStrings, error)
+	predicates    []predicate.MessageWithStrings
+}
+
+var _ ent.Mutation = (*MessageWithStringsMutation)(nil)
+
+// messagewithstringsOption allows management of the mutation configuration using functional options.
+type messagewithstringsOption func(*MessageWithStringsMutation)
+
+// newMessageWithStringsMutation creates new mutation for the MessageWithStrings entity.
+func newMessageWithStringsMutation(c config, op Op, opts ...messagewithstringsOption) *MessageWithStringsMutation {
+	m := &MessageWithStringsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageWithStrings,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageWithStringsID sets the ID field of the mutation.
+func withMessageWithStringsID(id int) messagewithstringsOption {
+	return func(m *MessageWithStringsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageWithStrings
+		)
+		m.oldValue = func(ctx context.Context) (*MessageWithStrings, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageWithStrings.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageWithStrings sets the old MessageWithStrings of the mutation.
+func withMessageWithStrings(node *MessageWithStrings) messagewithstringsOption {
+	return func(m *MessageWithStringsMutation) {
+		m.oldValue = func(context.Context) (*MessageWithStrings, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageWithStringsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageWithStringsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageWithStringsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageWithStringsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageWithStrings.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStrings sets the "strings" field.
+func (m *MessageWithStringsMutation) SetStrings(s []string) {
+	m.strings = &s
+	m.appendstrings = nil
+}
+
+// Strings returns the value of the "strings" field in the mutation.
+func (m *MessageWithStringsMutation) Strings() (r []string, exists bool) {
+	v := m.strings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrings returns the old "strings" field's value of the MessageWithStrings entity.
+// If the MessageWithStrings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithStringsMutation) OldStrings(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStrings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStrings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrings: %w", err)
+	}
+	return oldValue.Strings, nil
+}
+
+// AppendStrings adds s to the "strings" field.
+func (m *MessageWithStringsMutation) AppendStrings(s []string) {
+	m.appendstrings = append(m.appendstrings, s...)
+}
+
+// AppendedStrings returns the list of values that were appended to the "strings" field in this mutation.
+func (m *MessageWithStringsMutation) AppendedStrings() ([]string, bool) {
+	if len(m.appendstrings) == 0 {
+		return nil, false
+	}
+	return m.appendstrings, true
+}
+
+// ResetStrings resets all changes to the "strings" field.
+func (m *MessageWithStringsMutation) ResetStrings() {
+	m.strings = nil
+	m.appendstrings = nil
+}
+
+// Where appends a list predicates to the MessageWithStringsMutation builder.
+func (m *MessageWithStringsMutation) Where(ps ...predicate.MessageWithStrings) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *MessageWithStringsMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (MessageWithStrings).
+func (m *MessageWithStringsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageWithStringsMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.strings != nil {
+		fields = append(fields, messagewithstrings.FieldStrings)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageWithStringsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagewithstrings.FieldStrings:
+		return m.Strings()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageWithStringsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagewithstrings.FieldStrings:
+		return m.OldStrings(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageWithStrings field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageWithStringsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagewithstrings.FieldStrings:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrings(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithStrings field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageWithStringsMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageWithStringsMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageWithStringsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageWithStrings numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageWithStringsMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageWithStringsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageWithStringsMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageWithStrings nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageWithStringsMutation) ResetField(name string) error {
+	switch name {
+	case messagewithstrings.FieldStrings:
+		m.ResetStrings()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithStrings field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageWithStringsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageWithStringsMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageWithStringsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageWithStringsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageWithStringsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageWithStringsMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageWithStringsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithStrings unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageWithStringsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithStrings edge %s", name)
+}
+
+// MethodOptionsServiceMutation represents an operation that mutates the MethodOptionsService nodes in the graph.
+type MethodOptionsServiceMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MethodOptionsService, error)
+	predicates    []predicate.MethodOptionsService
+}
+
+var _ ent.Mutation = (*MethodOptionsServiceMutation)(nil)
+
+// methodoptionsserviceOption allows management of the mutation configuration using functional options.
+type methodoptionsserviceOption func(*MethodOptionsServiceMutation)
+
+// newMethodOptionsServiceMutation creates new mutation for the MethodOptionsService entity.
+func newMethodOptionsServiceMutation(c config, op Op, opts ...methodoptionsserviceOption) *MethodOptionsServiceMutation {
+	m := &MethodOptionsServiceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMethodOptionsService,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMethodOptionsServiceID sets the ID field of the mutation.
+func withMethodOptionsServiceID(id int) methodoptionsserviceOption {
+	return func(m *MethodOptionsServiceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MethodOptionsService
+		)
+		m.oldValue = func(ctx context.Context) (*MethodOptionsService, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MethodOptionsService.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMethodOptionsService sets the old MethodOptionsService of the mutation.
+func withMethodOptionsService(node *MethodOptionsService) methodoptionsserviceOption {
+	return func(m *MethodOptionsServiceMutation) {
+		m.oldValue = func(context.Context) (*MethodOptionsService, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MethodOptionsServiceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MethodOptionsServiceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MethodOptionsServiceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MethodOptionsServiceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MethodOptionsService.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// Where appends a list predicates to the MethodOptionsServiceMutation builder.
+func (m *MethodOptionsServiceMutation) Where(ps ...predicate.MethodOptionsService) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *MethodOptionsServiceMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (MethodOptionsService).
+func (m *MethodOptionsServiceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MethodOptionsServiceMutation) Fields() []string {
+	fields := make([]string, 0, 0)
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MethodOptionsServiceMutation) Field(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MethodOptionsServiceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, fmt.Errorf("unknown MethodOptionsService field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MethodOptionsServiceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MethodOptionsService field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MethodOptionsServiceMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MethodOptionsServiceMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MethodOptionsServiceMutation) AddField(name string, value ent.Value) error {
+	return fmt.Errorf("unknown MethodOptionsService numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MethodOptionsServiceMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MethodOptionsServiceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MethodOptionsServiceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MethodOptionsService nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MethodOptionsServiceMutation) ResetField(name string) error {
+	return fmt.Errorf("unknown MethodOptionsService field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MethodOptionsServiceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MethodOptionsServiceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MethodOptionsServiceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MethodOptionsServiceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MethodOptionsServiceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MethodOptionsServiceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MethodOptionsServiceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MethodOptionsService unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MethodOptionsServiceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MethodOptionsService edge %s", name)
+}
+
+// MixinAnnotatedMutation represents an operation that mutates the MixinAnnotated nodes in the graph.
+type MixinAnnotatedMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	state         *mixinannotated.State
+	internal      *string
+	name          *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MixinAnnotated, error)
+	predicates    []predicate.MixinAnnotated
+}
+
+var _ ent.Mutation = (*MixinAnnotatedMutation)(nil)
+
+// mixinannotatedOption allows management of the mutation configuration using functional options.
+type mixinannotatedOption func(*MixinAnnotatedMutation)
+
+// newMixinAnnotatedMutation creates new mutation for the MixinAnnotated entity.
+func newMixinAnnotatedMutation(c config, op Op, opts ...mixinannotatedOption) *MixinAnnotatedMutation {
+	m := &MixinAnnotatedMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMixinAnnotated,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMixinAnnotatedID sets the ID field of the mutation.
+func withMixinAnnotatedID(id int) mixinannotatedOption {
+	return func(m *MixinAnnotatedMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MixinAnnotated
+		)
+		m.oldValue = func(ctx context.Context) (*MixinAnnotated, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MixinAnnotated.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMixinAnnotated sets the old MixinAnnotated of the mutation.
+func withMixinAnnotated(node *MixinAnnotated) mixinannotatedOption {
+	return func(m *MixinAnnotatedMutation) {
+		m.oldValue = func(context.Context) (*MixinAnnotated, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MixinAnnotatedMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MixinAnnotatedMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MixinAnnotatedMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MixinAnnotatedMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MixinAnnotated.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetState sets the "state" field.
+func (m *MixinAnnotatedMutation) SetState(value mixinannotated.State) {
+	m.state = &value
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *MixinAnnotatedMutation) State() (r mixinannotated.State, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the MixinAnnotated entity.
+// If the MixinAnnotated object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MixinAnnotatedMutation) OldState(ctx context.Context) (v mixinannotated.State, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *MixinAnnotatedMutation) ResetState() {
+	m.state = nil
+}
+
+// SetInternal sets the "internal" field.
+func (m *MixinAnnotatedMutation) SetInternal(s string) {
+	m.internal = &s
+}
+
+// Internal returns the value of the "internal" field in the mutation.
+func (m *MixinAnnotatedMutation) Internal() (r string, exists bool) {
+	v := m.internal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInternal returns the old "internal" field's value of the MixinAnnotated entity.
+// If the MixinAnnotated object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MixinAnnotatedMutation) OldInternal(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInternal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInternal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInternal: %w", err)
+	}
+	return oldValue.Internal, nil
+}
+
+// ResetInternal resets all changes to the "internal" field.
+func (m *MixinAnnotatedMutation) ResetInternal() {
+	m.internal = nil
+}
+
+// SetName sets the "name" field.
+func (m *MixinAnnotatedMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MixinAnnotatedMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the MixinAnnotated entity.
+// If the MixinAnnotated object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MixinAnnotatedMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MixinAnnotatedMutation) ResetName() {
+	m.name = nil
+}
+
+// Where appends a list predicates to the MixinAnnotatedMutation builder.
+func (m *MixinAnnotatedMutation) Where(ps ...predicate.MixinAnnotated) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *MixinAnnotatedMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (MixinAnnotated).
+func (m *MixinAnnotatedMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MixinAnnotatedMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.state != nil {
+		fields = append(fields, mixinannotated.FieldState)
+	}
+	if m.internal != nil {
+		fields = append(fields, mixinannotated.FieldInternal)
+	}
+	if m.name != nil {
+		fields = append(fields, mixinannotated.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MixinAnnotatedMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mixinannotated.FieldState:
+		return m.State()
+	case mixinannotated.FieldInternal:
+		return m.Internal()
+	case mixinannotated.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MixinAnnotatedMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mixinannotated.FieldState:
+		return m.OldState(ctx)
+	case mixinannotated.FieldInternal:
+		return m.OldInternal(ctx)
+	case mixinannotated.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown MixinAnnotated field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MixinAnnotatedMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mixinannotated.FieldState:
+		v, ok := value.(mixinannotated.State)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case mixinannotated.FieldInternal:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInternal(v)
+		return nil
+	case mixinannotated.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MixinAnnotated field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MixinAnnotatedMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MixinAnnotatedMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MixinAnnotatedMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MixinAnnotated numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MixinAnnotatedMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MixinAnnotatedMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MixinAnnotatedMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MixinAnnotated nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MixinAnnotatedMutation) ResetField(name string) error {
+	switch name {
+	case mixinannotated.FieldState:
+		m.ResetState()
+		return nil
+	case mixinannotated.FieldInternal:
+		m.ResetInternal()
+		return nil
+	case mixinannotated.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown MixinAnnotated field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MixinAnnotatedMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MixinAnnotatedMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MixinAnnotatedMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MixinAnnotatedMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MixinAnnotatedMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MixinAnnotatedMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MixinAnnotatedMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MixinAnnotated unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MixinAnnotatedMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MixinAnnotated edge %s", name)
+}
+
+// MixinCollisionMutation represents an operation that mutates the MixinCollision nodes in the graph.
+type MixinCollisionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_by    *string
+	name          *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MixinCollision, error)
+	predicates    []predicate.MixinCollision
+}
+
+var _ ent.Mutation = (*MixinCollisionMutation)(nil)
+
+// mixincollisionOption allows management of the mutation configuration using functional options.
+type mixincollisionOption func(*MixinCollisionMutation)
+
+// newMixinCollisionMutation creates new mutation for the MixinCollision entity.
+func newMixinCollisionMutation(c config, op Op, opts ...mixincollisionOption) *MixinCollisionMutation {
+	m := &MixinCollisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMixinCollision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMixinCollisionID sets the ID field of the mutation.
+func withMixinCollisionID(id int) mixincollisionOption {
+	return func(m *MixinCollisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MixinCollision
+		)
+		m.oldValue = func(ctx context.Context) (*MixinCollision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MixinCollision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMixinCollision sets the old MixinCollision of the mutation.
+func withMixinCollision(node *MixinCollision) mixincollisionOption {
+	return func(m *MixinCollisionMutation) {
+		m.oldValue = func(context.Context) (*MixinCollision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MixinCollisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MixinCollisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MixinCollisionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MixinCollisionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MixinCollision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *MixinCollisionMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *MixinCollisionMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the MixinCollision entity.
+// If the MixinCollision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MixinCollisionMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *MixinCollisionMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// SetName sets the "name" field.
+func (m *MixinCollisionMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MixinCollisionMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the MixinCollision entity.
+// If the MixinCollision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MixinCollisionMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MixinCollisionMutation) ResetName() {
+	m.name = nil
+}
+
+// Where appends a list predicates to the MixinCollisionMutation builder.
+func (m *MixinCollisionMutation) Where(ps ...predicate.MixinCollision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *MixinCollisionMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (MixinCollision).
+func (m *MixinCollisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MixinCollisionMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.created_by != nil {
+		fields = append(fields, mixincollision.FieldCreatedBy)
+	}
+	if m.name != nil {
+		fields = append(fields, mixincollision.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MixinCollisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mixincollision.FieldCreatedBy:
+		return m.CreatedBy()
+	case mixincollision.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MixinCollisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mixincollision.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case mixincollision.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown MixinCollision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MixinCollisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mixincollision.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case mixincollision.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MixinCollision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MixinCollisionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MixinCollisionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MixinCollisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MixinCollision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MixinCollisionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MixinCollisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MixinCollisionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MixinCollision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MixinCollisionMutation) ResetField(name string) error {
+	switch name {
+	case mixincollision.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case mixincollision.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown MixinCollision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MixinCollisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MixinCollisionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MixinCollisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MixinCollisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MixinCollisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MixinCollisionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MixinCollisionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MixinCollision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MixinCollisionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MixinCollision edge %s", name)
+}
+
+// MixinServiceOverrideMutation represents an operation that mutates the MixinServiceOverride nodes in the graph.
+type MixinServiceOverrideMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	state         *mixinserviceoverride.State
+	internal      *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MixinServiceOverride, error)
+	predicates    []predicate.MixinServiceOverride
+}
+
+var _ ent.Mutation = (*MixinServiceOverrideMutation)(nil)
+
+// mixinserviceoverrideOption allows management of the mutation configuration using functional options.
+type mixinserviceoverrideOption func(*MixinServiceOverrideMutation)
+
+// newMixinServiceOverrideMutation creates new mutation for the MixinServiceOverride entity.
+func newMixinServiceOverrideMutation(c config, op Op, opts ...mixinserviceoverrideOption) *MixinServiceOverrideMutation {
+	m := &MixinServiceOverrideMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMixinServiceOverride,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMixinServiceOverrideID sets the ID field of the mutation.
+func withMixinServiceOverrideID(id int) mixinserviceoverrideOption {
+	return func(m *MixinServiceOverrideMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MixinServiceOverride
+		)
+		m.oldValue = func(ctx context.Context) (*MixinServiceOverride, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MixinServiceOverride.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMixinServiceOverride sets the old MixinServiceOverride of the mutation.
+func withMixinServiceOverride(node *MixinServiceOverride) mixinserviceoverrideOption {
+	return func(m *MixinServiceOverrideMutation) {
+		m.oldValue = func(context.Context) (*MixinServiceOverride, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MixinServiceOverrideMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MixinServiceOverrideMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MixinServiceOverrideMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MixinServiceOverrideMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MixinServiceOverride.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetState sets the "state" field.
+func (m *MixinServiceOverrideMutation) SetState(value mixinserviceoverride.State) {
+	m.state = &value
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *MixinServiceOverrideMutation) State() (r mixinserviceoverride.State, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the MixinServiceOverride entity.
+// If the MixinServiceOverride object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MixinServiceOverrideMutation) OldState(ctx context.Context) (v mixinserviceoverride.State, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBoolOptional is only allowed on UpdateOne operations")
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBoolOptional requires an ID field in the mutation")
+		return v, errors.New("OldState requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBoolOptional: %w", err)
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
 	}
-	return oldValue.BoolOptional, nil
-}
-
-// ClearBoolOptional clears the value of the "bool_optional" field.
-func (m *MessageWithOptionalsMutation) ClearBoolOptional() {
-	m.bool_optional = nil
-	m.clearedFields[messagewithoptionals.FieldBoolOptional] = struct{}{}
-}
-
-// BoolOptionalCleared returns if the "bool_optional" field was cleared in this mutation.
-func (m *MessageWithOptionalsMutation) BoolOptionalCleared() bool {
-	_, ok := m.clearedFields[messagewithoptionals.FieldBoolOptional]
-	return ok
+	return oldValue.State, nil
 }
 
-// ResetBoolOptional resets all changes to the "bool_optional" field.
-func (m *MessageWithOptionalsMutation) ResetBoolOptional() {
-	m.bool_optional = nil
-	delete(m.clearedFields, messagewithoptionals.FieldBoolOptional)
+// ResetState resets all changes to the "state" field.
+func (m *MixinServiceOverrideMutation) ResetState() {
+	m.state = nil
 }
 
-// SetBytesOptional sets the "bytes_optional" field.
-func (m *MessageWithOptionalsMutation) SetBytesOptional(b []byte) {
-	m.bytes_optional = &b
+// SetInternal sets the "internal" field.
+func (m *MixinServiceOverrideMutation) SetInternal(s string) {
+	m.internal = &s
 }
 
-// BytesOptional returns the value of the "bytes_optional" field in the mutation.
-func (m *MessageWithOptionalsMutation) BytesOptional() (r []byte, exists bool) {
-	v := m.bytes_optional
+// Internal returns the value of the "internal" field in the mutation.
+func (m *MixinServiceOverrideMutation) Internal() (r string, exists bool) {
+	v := m.internal
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBytesOptional returns the old "bytes_optional" field's value of the MessageWithOptionals entity.
-// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// OldInternal returns the old "internal" field's value of the MixinServiceOverride entity.
+// If the MixinServiceOverride object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithOptionalsMutation) OldBytesOptional(ctx context.Context) (v []byte, err error) {
+func (m *MixinServiceOverrideMutation) OldInternal(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBytesOptional is only allowed on UpdateOne operations")
+		return v, errors.New("OldInternal is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBytesOptional requires an ID field in the mutation")
+		return v, errors.New("OldInternal requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBytesOptional: %w", err)
+		return v, fmt.Errorf("querying old value for OldInternal: %w", err)
+	}
+	return oldValue.Internal, nil
+}
+
+// ResetInternal resets all changes to the "internal" field.
+func (m *MixinServiceOverrideMutation) ResetInternal() {
+	m.internal = nil
+}
+
+// Where appends a list predicates to the MixinServiceOverrideMutation builder.
+func (m *MixinServiceOverrideMutation) Where(ps ...predicate.MixinServiceOverride) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *MixinServiceOverrideMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (MixinServiceOverride).
+func (m *MixinServiceOverrideMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MixinServiceOverrideMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.state != nil {
+		fields = append(fields, mixinserviceoverride.FieldState)
+	}
+	if m.internal != nil {
+		fields = append(fields, mixinserviceoverride.FieldInternal)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MixinServiceOverrideMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mixinserviceoverride.FieldState:
+		return m.State()
+	case mixinserviceoverride.FieldInternal:
+		return m.Internal()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MixinServiceOverrideMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mixinserviceoverride.FieldState:
+		return m.OldState(ctx)
+	case mixinserviceoverride.FieldInternal:
+		return m.OldInternal(ctx)
+	}
+	return nil, fmt.Errorf("unknown MixinServiceOverride field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MixinServiceOverrideMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mixinserviceoverride.FieldState:
+		v, ok := value.(mixinserviceoverride.State)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case mixinserviceoverride.FieldInternal:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInternal(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MixinServiceOverride field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MixinServiceOverrideMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MixinServiceOverrideMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MixinServiceOverrideMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MixinServiceOverride numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MixinServiceOverrideMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MixinServiceOverrideMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MixinServiceOverrideMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MixinServiceOverride nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MixinServiceOverrideMutation) ResetField(name string) error {
+	switch name {
+	case mixinserviceoverride.FieldState:
+		m.ResetState()
+		return nil
+	case mixinserviceoverride.FieldInternal:
+		m.ResetInternal()
+		return nil
+	}
+	return fmt.Errorf("unknown MixinServiceOverride field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MixinServiceOverrideMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MixinServiceOverrideMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MixinServiceOverrideMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MixinServiceOverrideMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MixinServiceOverrideMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MixinServiceOverrideMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MixinServiceOverrideMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MixinServiceOverride unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MixinServiceOverrideMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MixinServiceOverride edge %s", name)
+}
+
+// NoBackrefMutation represents an operation that mutates the NoBackref nodes in the graph.
+type NoBackrefMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	clearedFields map[string]struct{}
+	images        map[uuid.UUID]struct{}
+	removedimages map[uuid.UUID]struct{}
+	clearedimages bool
+	done          bool
+	oldValue      func(context.Context) (*NoBackref, error)
+	predicates    []predicate.NoBackref
+}
+
+var _ ent.Mutation = (*NoBackrefMutation)(nil)
+
+// nobackrefOption allows management of the mutation configuration using functional options.
+type nobackrefOption func(*NoBackrefMutation)
+
+// newNoBackrefMutation creates new mutation for the NoBackref entity.
+func newNoBackrefMutation(c config, op Op, opts ...nobackrefOption) *NoBackrefMutation {
+	m := &NoBackrefMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNoBackref,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNoBackrefID sets the ID field of the mutation.
+func withNoBackrefID(id int) nobackrefOption {
+	return func(m *NoBackrefMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NoBackref
+		)
+		m.oldValue = func(ctx context.Context) (*NoBackref, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NoBackref.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.BytesOptional, nil
-}
-
-// ClearBytesOptional clears the value of the "bytes_optional" field.
-func (m *MessageWithOptionalsMutation) ClearBytesOptional() {
-	m.bytes_optional = nil
-	m.clearedFields[messagewithoptionals.FieldBytesOptional] = struct{}{}
 }
 
-// BytesOptionalCleared returns if the "bytes_optional" field was cleared in this mutation.
-func (m *MessageWithOptionalsMutation) BytesOptionalCleared() bool {
-	_, ok := m.clearedFields[messagewithoptionals.FieldBytesOptional]
-	return ok
+// withNoBackref sets the old NoBackref of the mutation.
+func withNoBackref(node *NoBackref) nobackrefOption {
+	return func(m *NoBackrefMutation) {
+		m.oldValue = func(context.Context) (*NoBackref, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ResetBytesOptional resets all changes to the "bytes_optional" field.
-func (m *MessageWithOptionalsMutation) ResetBytesOptional() {
-	m.bytes_optional = nil
-	delete(m.clearedFields, messagewithoptionals.FieldBytesOptional)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NoBackrefMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetUUIDOptional sets the "uuid_optional" field.
-func (m *MessageWithOptionalsMutation) SetUUIDOptional(u uuid.UUID) {
-	m.uuid_optional = &u
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NoBackrefMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// UUIDOptional returns the value of the "uuid_optional" field in the mutation.
-func (m *MessageWithOptionalsMutation) UUIDOptional() (r uuid.UUID, exists bool) {
-	v := m.uuid_optional
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NoBackrefMutation) ID() (id int, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldUUIDOptional returns the old "uuid_optional" field's value of the MessageWithOptionals entity.
-// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithOptionalsMutation) OldUUIDOptional(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUUIDOptional is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUUIDOptional requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUUIDOptional: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NoBackrefMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NoBackref.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.UUIDOptional, nil
-}
-
-// ClearUUIDOptional clears the value of the "uuid_optional" field.
-func (m *MessageWithOptionalsMutation) ClearUUIDOptional() {
-	m.uuid_optional = nil
-	m.clearedFields[messagewithoptionals.FieldUUIDOptional] = struct{}{}
-}
-
-// UUIDOptionalCleared returns if the "uuid_optional" field was cleared in this mutation.
-func (m *MessageWithOptionalsMutation) UUIDOptionalCleared() bool {
-	_, ok := m.clearedFields[messagewithoptionals.FieldUUIDOptional]
-	return ok
 }
 
-// ResetUUIDOptional resets all changes to the "uuid_optional" field.
-func (m *MessageWithOptionalsMutation) ResetUUIDOptional() {
-	m.uuid_optional = nil
-	delete(m.clearedFields, messagewithoptionals.FieldUUIDOptional)
+// AddImageIDs adds the "images" edge to the Image entity by ids.
+func (m *NoBackrefMutation) AddImageIDs(ids ...uuid.UUID) {
+	if m.images == nil {
+		m.images = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.images[ids[i]] = struct{}{}
+	}
 }
 
-// SetTimeOptional sets the "time_optional" field.
-func (m *MessageWithOptionalsMutation) SetTimeOptional(t time.Time) {
-	m.time_optional = &t
+// ClearImages clears the "images" edge to the Image entity.
+func (m *NoBackrefMutation) ClearImages() {
+	m.clearedimages = true
 }
 
-// TimeOptional returns the value of the "time_optional" field in the mutation.
-func (m *MessageWithOptionalsMutation) TimeOptional() (r time.Time, exists bool) {
-	v := m.time_optional
-	if v == nil {
-		return
-	}
-	return *v, true
+// ImagesCleared reports if the "images" edge to the Image entity was cleared.
+func (m *NoBackrefMutation) ImagesCleared() bool {
+	return m.clearedimages
 }
 
-// OldTimeOptional returns the old "time_optional" field's value of the MessageWithOptionals entity.
-// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithOptionalsMutation) OldTimeOptional(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimeOptional is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimeOptional requires an ID field in the mutation")
+// RemoveImageIDs removes the "images" edge to the Image entity by IDs.
+func (m *NoBackrefMutation) RemoveImageIDs(ids ...uuid.UUID) {
+	if m.removedimages == nil {
+		m.removedimages = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimeOptional: %w", err)
+	for i := range ids {
+		delete(m.images, ids[i])
+		m.removedimages[ids[i]] = struct{}{}
 	}
-	return oldValue.TimeOptional, nil
 }
 
-// ClearTimeOptional clears the value of the "time_optional" field.
-func (m *MessageWithOptionalsMutation) ClearTimeOptional() {
-	m.time_optional = nil
-	m.clearedFields[messagewithoptionals.FieldTimeOptional] = struct{}{}
+// RemovedImages returns the removed IDs of the "images" edge to the Image entity.
+func (m *NoBackrefMutation) RemovedImagesIDs() (ids []uuid.UUID) {
+	for id := range m.removedimages {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// TimeOptionalCleared returns if the "time_optional" field was cleared in this mutation.
-func (m *MessageWithOptionalsMutation) TimeOptionalCleared() bool {
-	_, ok := m.clearedFields[messagewithoptionals.FieldTimeOptional]
-	return ok
+// ImagesIDs returns the "images" edge IDs in the mutation.
+func (m *NoBackrefMutation) ImagesIDs() (ids []uuid.UUID) {
+	for id := range m.images {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ResetTimeOptional resets all changes to the "time_optional" field.
-func (m *MessageWithOptionalsMutation) ResetTimeOptional() {
-	m.time_optional = nil
-	delete(m.clearedFields, messagewithoptionals.FieldTimeOptional)
+// ResetImages resets all changes to the "images" edge.
+func (m *NoBackrefMutation) ResetImages() {
+	m.images = nil
+	m.clearedimages = false
+	m.removedimages = nil
 }
 
-// Where appends a list predicates to the MessageWithOptionalsMutation builder.
-func (m *MessageWithOptionalsMutation) Where(ps ...predicate.MessageWithOptionals) {
+// Where appends a list predicates to the NoBackrefMutation builder.
+func (m *NoBackrefMutation) Where(ps ...predicate.NoBackref) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *MessageWithOptionalsMutation) Op() Op {
+func (m *NoBackrefMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (MessageWithOptionals).
-func (m *MessageWithOptionalsMutation) Type() string {
+// Type returns the node type of this mutation (NoBackref).
+func (m *NoBackrefMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageWithOptionalsMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.str_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldStrOptional)
-	}
-	if m.int_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldIntOptional)
-	}
-	if m.uint_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldUintOptional)
-	}
-	if m.float_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldFloatOptional)
-	}
-	if m.bool_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldBoolOptional)
-	}
-	if m.bytes_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldBytesOptional)
-	}
-	if m.uuid_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldUUIDOptional)
-	}
-	if m.time_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldTimeOptional)
-	}
+func (m *NoBackrefMutation) Fields() []string {
+	fields := make([]string, 0, 0)
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageWithOptionalsMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case messagewithoptionals.FieldStrOptional:
-		return m.StrOptional()
-	case messagewithoptionals.FieldIntOptional:
-		return m.IntOptional()
-	case messagewithoptionals.FieldUintOptional:
-		return m.UintOptional()
-	case messagewithoptionals.FieldFloatOptional:
-		return m.FloatOptional()
-	case messagewithoptionals.FieldBoolOptional:
-		return m.BoolOptional()
-	case messagewithoptionals.FieldBytesOptional:
-		return m.BytesOptional()
-	case messagewithoptionals.FieldUUIDOptional:
-		return m.UUIDOptional()
-	case messagewithoptionals.FieldTimeOptional:
-		return m.TimeOptional()
-	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *MessageWithOptionalsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case messagewithoptionals.FieldStrOptional:
-		return m.OldStrOptional(ctx)
-	case messagewithoptionals.FieldIntOptional:
-		return m.OldIntOptional(ctx)
-	case messagewithoptionals.FieldUintOptional:
-		return m.OldUintOptional(ctx)
-	case messagewithoptionals.FieldFloatOptional:
-		return m.OldFloatOptional(ctx)
-	case messagewithoptionals.FieldBoolOptional:
-		return m.OldBoolOptional(ctx)
-	case messagewithoptionals.FieldBytesOptional:
-		return m.OldBytesOptional(ctx)
-	case messagewithoptionals.FieldUUIDOptional:
-		return m.OldUUIDOptional(ctx)
-	case messagewithoptionals.FieldTimeOptional:
-		return m.OldTimeOptional(ctx)
-	}
-	return nil, fmt.Errorf("unknown MessageWithOptionals field %s", name)
+func (m *NoBackrefMutation) Field(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NoBackrefMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, fmt.Errorf("unknown NoBackref field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageWithOptionalsMutation) SetField(name string, value ent.Value) error {
+func (m *NoBackrefMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case messagewithoptionals.FieldStrOptional:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStrOptional(v)
-		return nil
-	case messagewithoptionals.FieldIntOptional:
-		v, ok := value.(int8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIntOptional(v)
-		return nil
-	case messagewithoptionals.FieldUintOptional:
-		v, ok := value.(uint8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUintOptional(v)
-		return nil
-	case messagewithoptionals.FieldFloatOptional:
-		v, ok := value.(float32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFloatOptional(v)
-		return nil
-	case messagewithoptionals.FieldBoolOptional:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBoolOptional(v)
-		return nil
-	case messagewithoptionals.FieldBytesOptional:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBytesOptional(v)
-		return nil
-	case messagewithoptionals.FieldUUIDOptional:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUUIDOptional(v)
-		return nil
-	case messagewithoptionals.FieldTimeOptional:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTimeOptional(v)
-		return nil
 	}
-	return fmt.Errorf("unknown MessageWithOptionals field %s", name)
+	return fmt.Errorf("unknown NoBackref field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageWithOptionalsMutation) AddedFields() []string {
-	var fields []string
-	if m.addint_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldIntOptional)
-	}
-	if m.adduint_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldUintOptional)
-	}
-	if m.addfloat_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldFloatOptional)
-	}
-	return fields
+func (m *NoBackrefMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageWithOptionalsMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case messagewithoptionals.FieldIntOptional:
-		return m.AddedIntOptional()
-	case messagewithoptionals.FieldUintOptional:
-		return m.AddedUintOptional()
-	case messagewithoptionals.FieldFloatOptional:
-		return m.AddedFloatOptional()
-	}
+func (m *NoBackrefMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageWithOptionalsMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case messagewithoptionals.FieldIntOptional:
-		v, ok := value.(int8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddIntOptional(v)
-		return nil
-	case messagewithoptionals.FieldUintOptional:
-		v, ok := value.(int8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUintOptional(v)
-		return nil
-	case messagewithoptionals.FieldFloatOptional:
-		v, ok := value.(float32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFloatOptional(v)
-		return nil
-	}
-	return fmt.Errorf("unknown MessageWithOptionals numeric field %s", name)
+func (m *NoBackrefMutation) AddField(name string, value ent.Value) error {
+	return fmt.Errorf("unknown NoBackref numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageWithOptionalsMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(messagewithoptionals.FieldStrOptional) {
-		fields = append(fields, messagewithoptionals.FieldStrOptional)
-	}
-	if m.FieldCleared(messagewithoptionals.FieldIntOptional) {
-		fields = append(fields, messagewithoptionals.FieldIntOptional)
-	}
-	if m.FieldCleared(messagewithoptionals.FieldUintOptional) {
-		fields = append(fields, messagewithoptionals.FieldUintOptional)
-	}
-	if m.FieldCleared(messagewithoptionals.FieldFloatOptional) {
-		fields = append(fields, messagewithoptionals.FieldFloatOptional)
-	}
-	if m.FieldCleared(messagewithoptionals.FieldBoolOptional) {
-		fields = append(fields, messagewithoptionals.FieldBoolOptional)
-	}
-	if m.FieldCleared(messagewithoptionals.FieldBytesOptional) {
-		fields = append(fields, messagewithoptionals.FieldBytesOptional)
-	}
-	if m.FieldCleared(messagewithoptionals.FieldUUIDOptional) {
-		fields = append(fields, messagewithoptionals.FieldUUIDOptional)
-	}
-	if m.FieldCleared(messagewithoptionals.FieldTimeOptional) {
-		fields = append(fields, messagewithoptionals.FieldTimeOptional)
-	}
-	return fields
+func (m *NoBackrefMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageWithOptionalsMutation) FieldCleared(name string) bool {
+func (m *NoBackrefMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageWithOptionalsMutation) ClearField(name string) error {
-	switch name {
-	case messagewithoptionals.FieldStrOptional:
-		m.ClearStrOptional()
-		return nil
-	case messagewithoptionals.FieldIntOptional:
-		m.ClearIntOptional()
-		return nil
-	case messagewithoptionals.FieldUintOptional:
-		m.ClearUintOptional()
-		return nil
-	case messagewithoptionals.FieldFloatOptional:
-		m.ClearFloatOptional()
-		return nil
-	case messagewithoptionals.FieldBoolOptional:
-		m.ClearBoolOptional()
-		return nil
-	case messagewithoptionals.FieldBytesOptional:
-		m.ClearBytesOptional()
-		return nil
-	case messagewithoptionals.FieldUUIDOptional:
-		m.ClearUUIDOptional()
-		return nil
-	case messagewithoptionals.FieldTimeOptional:
-		m.ClearTimeOptional()
-		return nil
-	}
-	return fmt.Errorf("unknown MessageWithOptionals nullable field %s", name)
+func (m *NoBackrefMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown NoBackref nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageWithOptionalsMutation) ResetField(name string) error {
-	switch name {
-	case messagewithoptionals.FieldStrOptional:
-		m.ResetStrOptional()
-		return nil
-	case messagewithoptionals.FieldIntOptional:
-		m.ResetIntOptional()
-		return nil
-	case messagewithoptionals.FieldUintOptional:
-		m.ResetUintOptional()
-		return nil
-	case messagewithoptionals.FieldFloatOptional:
-		m.ResetFloatOptional()
-		return nil
-	case messagewithoptionals.FieldBoolOptional:
-		m.ResetBoolOptional()
-		return nil
-	case messagewithoptionals.FieldBytesOptional:
-		m.ResetBytesOptional()
-		return nil
-	case messagewithoptionals.FieldUUIDOptional:
-		m.ResetUUIDOptional()
-		return nil
-	case messagewithoptionals.FieldTimeOptional:
-		m.ResetTimeOptional()
-		return nil
-	}
-	return fmt.Errorf("unknown MessageWithOptionals field %s", name)
+func (m *NoBackrefMutation) ResetField(name string) error {
+	return fmt.Errorf("unknown NoBackref field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageWithOptionalsMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *NoBackrefMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.images != nil {
+		edges = append(edges, nobackref.EdgeImages)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageWithOptionalsMutation) AddedIDs(name string) []ent.Value {
+func (m *NoBackrefMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case nobackref.EdgeImages:
+		ids := make([]ent.Value, 0, len(m.images))
+		for id := range m.images {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageWithOptionalsMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *NoBackrefMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedimages != nil {
+		edges = append(edges, nobackref.EdgeImages)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageWithOptionalsMutation) RemovedIDs(name string) []ent.Value {
+func (m *NoBackrefMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case nobackref.EdgeImages:
+		ids := make([]ent.Value, 0, len(m.removedimages))
+		for id := range m.removedimages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageWithOptionalsMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *NoBackrefMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedimages {
+		edges = append(edges, nobackref.EdgeImages)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageWithOptionalsMutation) EdgeCleared(name string) bool {
+func (m *NoBackrefMutation) EdgeCleared(name string) bool {
+	switch name {
+	case nobackref.EdgeImages:
+		return m.clearedimages
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageWithOptionalsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown MessageWithOptionals unique edge %s", name)
+func (m *NoBackrefMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NoBackref unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageWithOptionalsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown MessageWithOptionals edge %s", name)
+func (m *NoBackrefMutation) ResetEdge(name string) error {
+	switch name {
+	case nobackref.EdgeImages:
+		m.ResetImages()
+		return nil
+	}
+	return fmt.Errorf("unknown NoBackref edge %s", name)
 }
 
-// MessageWithPackageNameMutation represents an operation that mutates the MessageWithPackageName nodes in the graph.
-type MessageWithPackageNameMutation struct {
+// OneMethodServiceMutation represents an operation that mutates the OneMethodService nodes in the graph.
+type OneMethodServiceMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	name          *string
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*MessageWithPackageName, error)
-	predicates    []predicate.MessageWithPackageName
+	oldValue      func(context.Context) (*OneMethodService, error)
+	predicates    []predicate.OneMethodService
 }
 
-var _ ent.Mutation = (*MessageWithPackageNameMutation)(nil)
+var _ ent.Mutation = (*OneMethodServiceMutation)(nil)
 
-// messagewithpackagenameOption allows management of the mutation configuration using functional options.
-type messagewithpackagenameOption func(*MessageWithPackageNameMutation)
+// onemethodserviceOption allows management of the mutation configuration using functional options.
+type onemethodserviceOption func(*OneMethodServiceMutation)
 
-// newMessageWithPackageNameMutation creates new mutation for the MessageWithPackageName entity.
-func newMessageWithPackageNameMutation(c config, op Op, opts ...messagewithpackagenameOption) *MessageWithPackageNameMutation {
-	m := &MessageWithPackageNameMutation{
+// newOneMethodServiceMutation creates new mutation for the OneMethodService entity.
+func newOneMethodServiceMutation(c config, op Op, opts ...onemethodserviceOption) *OneMethodServiceMutation {
+	m := &OneMethodServiceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessageWithPackageName,
+		typ:           TypeOneMethodService,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5315,20 +8927,20 @@ func newMessageWithPackageNameMutation(c config, op Op, opts ...messagewithpacka
 	return m
 }
 
-// withMessageWithPackageNameID sets the ID field of the mutation.
-func withMessageWithPackageNameID(id int) messagewithpackagenameOption {
-	return func(m *MessageWithPackageNameMutation) {
+// withOneMethodServiceID sets the ID field of the mutation.
+func withOneMethodServiceID(id int) onemethodserviceOption {
+	return func(m *OneMethodServiceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MessageWithPackageName
+			value *OneMethodService
 		)
-		m.oldValue = func(ctx context.Context) (*MessageWithPackageName, error) {
+		m.oldValue = func(ctx context.Context) (*OneMethodService, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MessageWithPackageName.Get(ctx, id)
+					value, err = m.Client().OneMethodService.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5337,10 +8949,10 @@ func withMessageWithPackageNameID(id int) messagewithpackagenameOption {
 	}
 }
 
-// withMessageWithPackageName sets the old MessageWithPackageName of the mutation.
-func withMessageWithPackageName(node *MessageWithPackageName) messagewithpackagenameOption {
-	return func(m *MessageWithPackageNameMutation) {
-		m.oldValue = func(context.Context) (*MessageWithPackageName, error) {
+// withOneMethodService sets the old OneMethodService of the mutation.
+func withOneMethodService(node *OneMethodService) onemethodserviceOption {
+	return func(m *OneMethodServiceMutation) {
+		m.oldValue = func(context.Context) (*OneMethodService, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5349,7 +8961,7 @@ func withMessageWithPackageName(node *MessageWithPackageName) messagewithpackage
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageWithPackageNameMutation) Client() *Client {
+func (m OneMethodServiceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5357,7 +8969,7 @@ func (m MessageWithPackageNameMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageWithPackageNameMutation) Tx() (*Tx, error) {
+func (m OneMethodServiceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5368,7 +8980,7 @@ func (m MessageWithPackageNameMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageWithPackageNameMutation) ID() (id int, exists bool) {
+func (m *OneMethodServiceMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5379,7 +8991,7 @@ func (m *MessageWithPackageNameMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageWithPackageNameMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *OneMethodServiceMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5388,237 +9000,178 @@ func (m *MessageWithPackageNameMutation) IDs(ctx context.Context) ([]int, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MessageWithPackageName.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().OneMethodService.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *MessageWithPackageNameMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *MessageWithPackageNameMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the MessageWithPackageName entity.
-// If the MessageWithPackageName object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithPackageNameMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *MessageWithPackageNameMutation) ResetName() {
-	m.name = nil
-}
-
-// Where appends a list predicates to the MessageWithPackageNameMutation builder.
-func (m *MessageWithPackageNameMutation) Where(ps ...predicate.MessageWithPackageName) {
+// Where appends a list predicates to the OneMethodServiceMutation builder.
+func (m *OneMethodServiceMutation) Where(ps ...predicate.OneMethodService) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *MessageWithPackageNameMutation) Op() Op {
+func (m *OneMethodServiceMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (MessageWithPackageName).
-func (m *MessageWithPackageNameMutation) Type() string {
+// Type returns the node type of this mutation (OneMethodService).
+func (m *OneMethodServiceMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageWithPackageNameMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.name != nil {
-		fields = append(fields, messagewithpackagename.FieldName)
-	}
+func (m *OneMethodServiceMutation) Fields() []string {
+	fields := make([]string, 0, 0)
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageWithPackageNameMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case messagewithpackagename.FieldName:
-		return m.Name()
-	}
+func (m *OneMethodServiceMutation) Field(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageWithPackageNameMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case messagewithpackagename.FieldName:
-		return m.OldName(ctx)
-	}
-	return nil, fmt.Errorf("unknown MessageWithPackageName field %s", name)
+func (m *OneMethodServiceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, fmt.Errorf("unknown OneMethodService field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageWithPackageNameMutation) SetField(name string, value ent.Value) error {
+func (m *OneMethodServiceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case messagewithpackagename.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
 	}
-	return fmt.Errorf("unknown MessageWithPackageName field %s", name)
+	return fmt.Errorf("unknown OneMethodService field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageWithPackageNameMutation) AddedFields() []string {
+func (m *OneMethodServiceMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageWithPackageNameMutation) AddedField(name string) (ent.Value, bool) {
+func (m *OneMethodServiceMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageWithPackageNameMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown MessageWithPackageName numeric field %s", name)
+func (m *OneMethodServiceMutation) AddField(name string, value ent.Value) error {
+	return fmt.Errorf("unknown OneMethodService numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageWithPackageNameMutation) ClearedFields() []string {
+func (m *OneMethodServiceMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageWithPackageNameMutation) FieldCleared(name string) bool {
+func (m *OneMethodServiceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageWithPackageNameMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MessageWithPackageName nullable field %s", name)
+func (m *OneMethodServiceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown OneMethodService nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageWithPackageNameMutation) ResetField(name string) error {
-	switch name {
-	case messagewithpackagename.FieldName:
-		m.ResetName()
-		return nil
-	}
-	return fmt.Errorf("unknown MessageWithPackageName field %s", name)
+func (m *OneMethodServiceMutation) ResetField(name string) error {
+	return fmt.Errorf("unknown OneMethodService field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageWithPackageNameMutation) AddedEdges() []string {
+func (m *OneMethodServiceMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageWithPackageNameMutation) AddedIDs(name string) []ent.Value {
+func (m *OneMethodServiceMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageWithPackageNameMutation) RemovedEdges() []string {
+func (m *OneMethodServiceMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageWithPackageNameMutation) RemovedIDs(name string) []ent.Value {
+func (m *OneMethodServiceMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageWithPackageNameMutation) ClearedEdges() []string {
+func (m *OneMethodServiceMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageWithPackageNameMutation) EdgeCleared(name string) bool {
+func (m *OneMethodServiceMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageWithPackageNameMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown MessageWithPackageName unique edge %s", name)
+func (m *OneMethodServiceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OneMethodService unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageWithPackageNameMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown MessageWithPackageName edge %s", name)
+func (m *OneMethodServiceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OneMethodService edge %s", name)
 }
 
-// MessageWithStringsMutation represents an operation that mutates the MessageWithStrings nodes in the graph.
-type MessageWithStringsMutation struct {
+// PortalMutation represents an operation that mutates the Portal nodes in the graph.
+type PortalMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	strings       *[]string
-	appendstrings []string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*MessageWithStrings, error)
-	predicates    []predicate.MessageWithStrings
+	op              Op
+	typ             string
+	id              *int
+	name            *string
+	description     *string
+	clearedFields   map[string]struct{}
+	category        *int
+	clearedcategory bool
+	done            bool
+	oldValue        func(context.Context) (*Portal, error)
+	predicates      []predicate.Portal
 }
 
-var _ ent.Mutation = (*MessageWithStringsMutation)(nil)
+var _ ent.Mutation = (*PortalMutation)(nil)
 
-// messagewithstringsOption allows management of the mutation configuration using functional options.
-type messagewithstringsOption func(*MessageWithStringsMutation)
+// portalOption allows management of the mutation configuration using functional options.
+type portalOption func(*PortalMutation)
 
-// newMessageWithStringsMutation creates new mutation for the MessageWithStrings entity.
-func newMessageWithStringsMutation(c config, op Op, opts ...messagewithstringsOption) *MessageWithStringsMutation {
-	m := &MessageWithStringsMutation{
+// newPortalMutation creates new mutation for the Portal entity.
+func newPortalMutation(c config, op Op, opts ...portalOption) *PortalMutation {
+	m := &PortalMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessageWithStrings,
+		typ:           TypePortal,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5627,20 +9180,20 @@ func newMessageWithStringsMutation(c config, op Op, opts ...messagewithstringsOp
 	return m
 }
 
-// withMessageWithStringsID sets the ID field of the mutation.
-func withMessageWithStringsID(id int) messagewithstringsOption {
-	return func(m *MessageWithStringsMutation) {
+// withPortalID sets the ID field of the mutation.
+func withPortalID(id int) portalOption {
+	return func(m *PortalMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MessageWithStrings
+			value *Portal
 		)
-		m.oldValue = func(ctx context.Context) (*MessageWithStrings, error) {
+		m.oldValue = func(ctx context.Context) (*Portal, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MessageWithStrings.Get(ctx, id)
+					value, err = m.Client().Portal.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5649,10 +9202,10 @@ func withMessageWithStringsID(id int) messagewithstringsOption {
 	}
 }
 
-// withMessageWithStrings sets the old MessageWithStrings of the mutation.
-func withMessageWithStrings(node *MessageWithStrings) messagewithstringsOption {
-	return func(m *MessageWithStringsMutation) {
-		m.oldValue = func(context.Context) (*MessageWithStrings, error) {
+// withPortal sets the old Portal of the mutation.
+func withPortal(node *Portal) portalOption {
+	return func(m *PortalMutation) {
+		m.oldValue = func(context.Context) (*Portal, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5661,7 +9214,7 @@ func withMessageWithStrings(node *MessageWithStrings) messagewithstringsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageWithStringsMutation) Client() *Client {
+func (m PortalMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5669,7 +9222,7 @@ func (m MessageWithStringsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageWithStringsMutation) Tx() (*Tx, error) {
+func (m PortalMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5680,7 +9233,7 @@ func (m MessageWithStringsMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageWithStringsMutation) ID() (id int, exists bool) {
+func (m *PortalMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5691,7 +9244,7 @@ func (m *MessageWithStringsMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageWithStringsMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *PortalMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5700,85 +9253,148 @@ func (m *MessageWithStringsMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MessageWithStrings.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Portal.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetStrings sets the "strings" field.
-func (m *MessageWithStringsMutation) SetStrings(s []string) {
-	m.strings = &s
-	m.appendstrings = nil
+// SetName sets the "name" field.
+func (m *PortalMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Strings returns the value of the "strings" field in the mutation.
-func (m *MessageWithStringsMutation) Strings() (r []string, exists bool) {
-	v := m.strings
+// Name returns the value of the "name" field in the mutation.
+func (m *PortalMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStrings returns the old "strings" field's value of the MessageWithStrings entity.
-// If the MessageWithStrings object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Portal entity.
+// If the Portal object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithStringsMutation) OldStrings(ctx context.Context) (v []string, err error) {
+func (m *PortalMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStrings is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStrings requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStrings: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Strings, nil
+	return oldValue.Name, nil
 }
 
-// AppendStrings adds s to the "strings" field.
-func (m *MessageWithStringsMutation) AppendStrings(s []string) {
-	m.appendstrings = append(m.appendstrings, s...)
+// ResetName resets all changes to the "name" field.
+func (m *PortalMutation) ResetName() {
+	m.name = nil
 }
 
-// AppendedStrings returns the list of values that were appended to the "strings" field in this mutation.
-func (m *MessageWithStringsMutation) AppendedStrings() ([]string, bool) {
-	if len(m.appendstrings) == 0 {
-		return nil, false
+// SetDescription sets the "description" field.
+func (m *PortalMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *PortalMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
 	}
-	return m.appendstrings, true
+	return *v, true
 }
 
-// ResetStrings resets all changes to the "strings" field.
-func (m *MessageWithStringsMutation) ResetStrings() {
-	m.strings = nil
-	m.appendstrings = nil
+// OldDescription returns the old "description" field's value of the Portal entity.
+// If the Portal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortalMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
 }
 
-// Where appends a list predicates to the MessageWithStringsMutation builder.
-func (m *MessageWithStringsMutation) Where(ps ...predicate.MessageWithStrings) {
+// ResetDescription resets all changes to the "description" field.
+func (m *PortalMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetCategoryID sets the "category" edge to the Category entity by id.
+func (m *PortalMutation) SetCategoryID(id int) {
+	m.category = &id
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (m *PortalMutation) ClearCategory() {
+	m.clearedcategory = true
+}
+
+// CategoryCleared reports if the "category" edge to the Category entity was cleared.
+func (m *PortalMutation) CategoryCleared() bool {
+	return m.clearedcategory
+}
+
+// CategoryID returns the "category" edge ID in the mutation.
+func (m *PortalMutation) CategoryID() (id int, exists bool) {
+	if m.category != nil {
+		return *m.category, true
+	}
+	return
+}
+
+// CategoryIDs returns the "category" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CategoryID instead. It exists only for internal usage by the builders.
+func (m *PortalMutation) CategoryIDs() (ids []int) {
+	if id := m.category; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCategory resets all changes to the "category" edge.
+func (m *PortalMutation) ResetCategory() {
+	m.category = nil
+	m.clearedcategory = false
+}
+
+// Where appends a list predicates to the PortalMutation builder.
+func (m *PortalMutation) Where(ps ...predicate.Portal) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *MessageWithStringsMutation) Op() Op {
+func (m *PortalMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (MessageWithStrings).
-func (m *MessageWithStringsMutation) Type() string {
+// Type returns the node type of this mutation (Portal).
+func (m *PortalMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageWithStringsMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.strings != nil {
-		fields = append(fields, messagewithstrings.FieldStrings)
+func (m *PortalMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, portal.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, portal.FieldDescription)
 	}
 	return fields
 }
@@ -5786,10 +9402,12 @@ func (m *MessageWithStringsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageWithStringsMutation) Field(name string) (ent.Value, bool) {
+func (m *PortalMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case messagewithstrings.FieldStrings:
-		return m.Strings()
+	case portal.FieldName:
+		return m.Name()
+	case portal.FieldDescription:
+		return m.Description()
 	}
 	return nil, false
 }
@@ -5797,156 +9415,192 @@ func (m *MessageWithStringsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageWithStringsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PortalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case messagewithstrings.FieldStrings:
-		return m.OldStrings(ctx)
+	case portal.FieldName:
+		return m.OldName(ctx)
+	case portal.FieldDescription:
+		return m.OldDescription(ctx)
 	}
-	return nil, fmt.Errorf("unknown MessageWithStrings field %s", name)
+	return nil, fmt.Errorf("unknown Portal field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageWithStringsMutation) SetField(name string, value ent.Value) error {
+func (m *PortalMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case messagewithstrings.FieldStrings:
-		v, ok := value.([]string)
+	case portal.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStrings(v)
+		m.SetName(v)
+		return nil
+	case portal.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MessageWithStrings field %s", name)
+	return fmt.Errorf("unknown Portal field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageWithStringsMutation) AddedFields() []string {
+func (m *PortalMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageWithStringsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PortalMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageWithStringsMutation) AddField(name string, value ent.Value) error {
+func (m *PortalMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown MessageWithStrings numeric field %s", name)
+	return fmt.Errorf("unknown Portal numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageWithStringsMutation) ClearedFields() []string {
+func (m *PortalMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageWithStringsMutation) FieldCleared(name string) bool {
+func (m *PortalMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageWithStringsMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MessageWithStrings nullable field %s", name)
+func (m *PortalMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Portal nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageWithStringsMutation) ResetField(name string) error {
+func (m *PortalMutation) ResetField(name string) error {
 	switch name {
-	case messagewithstrings.FieldStrings:
-		m.ResetStrings()
+	case portal.FieldName:
+		m.ResetName()
+		return nil
+	case portal.FieldDescription:
+		m.ResetDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageWithStrings field %s", name)
+	return fmt.Errorf("unknown Portal field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageWithStringsMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *PortalMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.category != nil {
+		edges = append(edges, portal.EdgeCategory)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageWithStringsMutation) AddedIDs(name string) []ent.Value {
+func (m *PortalMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case portal.EdgeCategory:
+		if id := m.category; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageWithStringsMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *PortalMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageWithStringsMutation) RemovedIDs(name string) []ent.Value {
+func (m *PortalMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageWithStringsMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *PortalMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcategory {
+		edges = append(edges, portal.EdgeCategory)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageWithStringsMutation) EdgeCleared(name string) bool {
+func (m *PortalMutation) EdgeCleared(name string) bool {
+	switch name {
+	case portal.EdgeCategory:
+		return m.clearedcategory
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageWithStringsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown MessageWithStrings unique edge %s", name)
+func (m *PortalMutation) ClearEdge(name string) error {
+	switch name {
+	case portal.EdgeCategory:
+		m.ClearCategory()
+		return nil
+	}
+	return fmt.Errorf("unknown Portal unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageWithStringsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown MessageWithStrings edge %s", name)
+func (m *PortalMutation) ResetEdge(name string) error {
+	switch name {
+	case portal.EdgeCategory:
+		m.ResetCategory()
+		return nil
+	}
+	return fmt.Errorf("unknown Portal edge %s", name)
 }
-
-// NoBackrefMutation represents an operation that mutates the NoBackref nodes in the graph.
-type NoBackrefMutation struct {
+
+// SharedEnumMessageMutation represents an operation that mutates the SharedEnumMessage nodes in the graph.
+type SharedEnumMessageMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
+	color         *sharedenummessage.Color
 	clearedFields map[string]struct{}
-	images        map[uuid.UUID]struct{}
-	removedimages map[uuid.UUID]struct{}
-	clearedimages bool
 	done          bool
-	oldValue      func(context.Context) (*NoBackref, error)
-	predicates    []predicate.NoBackref
+	oldValue      func(context.Context) (*SharedEnumMessage, error)
+	predicates    []predicate.SharedEnumMessage
 }
 
-var _ ent.Mutation = (*NoBackrefMutation)(nil)
+var _ ent.Mutation = (*SharedEnumMessageMutation)(nil)
 
-// nobackrefOption allows management of the mutation configuration using functional options.
-type nobackrefOption func(*NoBackrefMutation)
+// sharedenummessageOption allows management of the mutation configuration using functional options.
+type sharedenummessageOption func(*SharedEnumMessageMutation)
 
-// newNoBackrefMutation creates new mutation for the NoBackref entity.
-func newNoBackrefMutation(c config, op Op, opts ...nobackrefOption) *NoBackrefMutation {
-	m := &NoBackrefMutation{
+// newSharedEnumMessageMutation creates new mutation for the SharedEnumMessage entity.
+func newSharedEnumMessageMutation(c config, op Op, opts ...sharedenummessageOption) *SharedEnumMessageMutation {
+	m := &SharedEnumMessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNoBackref,
+		typ:           TypeSharedEnumMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5955,20 +9609,20 @@ func newNoBackrefMutation(c config, op Op, opts ...nobackrefOption) *NoBackrefMu
 	return m
 }
 
-// withNoBackrefID sets the ID field of the mutation.
-func withNoBackrefID(id int) nobackrefOption {
-	return func(m *NoBackrefMutation) {
+// withSharedEnumMessageID sets the ID field of the mutation.
+func withSharedEnumMessageID(id int) sharedenummessageOption {
+	return func(m *SharedEnumMessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *NoBackref
+			value *SharedEnumMessage
 		)
-		m.oldValue = func(ctx context.Context) (*NoBackref, error) {
+		m.oldValue = func(ctx context.Context) (*SharedEnumMessage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().NoBackref.Get(ctx, id)
+					value, err = m.Client().SharedEnumMessage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5977,10 +9631,10 @@ func withNoBackrefID(id int) nobackrefOption {
 	}
 }
 
-// withNoBackref sets the old NoBackref of the mutation.
-func withNoBackref(node *NoBackref) nobackrefOption {
-	return func(m *NoBackrefMutation) {
-		m.oldValue = func(context.Context) (*NoBackref, error) {
+// withSharedEnumMessage sets the old SharedEnumMessage of the mutation.
+func withSharedEnumMessage(node *SharedEnumMessage) sharedenummessageOption {
+	return func(m *SharedEnumMessageMutation) {
+		m.oldValue = func(context.Context) (*SharedEnumMessage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5989,7 +9643,7 @@ func withNoBackref(node *NoBackref) nobackrefOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NoBackrefMutation) Client() *Client {
+func (m SharedEnumMessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5997,7 +9651,7 @@ func (m NoBackrefMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NoBackrefMutation) Tx() (*Tx, error) {
+func (m SharedEnumMessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6008,7 +9662,7 @@ func (m NoBackrefMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NoBackrefMutation) ID() (id int, exists bool) {
+func (m *SharedEnumMessageMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6019,7 +9673,7 @@ func (m *NoBackrefMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NoBackrefMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SharedEnumMessageMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6028,264 +9682,237 @@ func (m *NoBackrefMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().NoBackref.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SharedEnumMessage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// AddImageIDs adds the "images" edge to the Image entity by ids.
-func (m *NoBackrefMutation) AddImageIDs(ids ...uuid.UUID) {
-	if m.images == nil {
-		m.images = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.images[ids[i]] = struct{}{}
-	}
-}
-
-// ClearImages clears the "images" edge to the Image entity.
-func (m *NoBackrefMutation) ClearImages() {
-	m.clearedimages = true
-}
-
-// ImagesCleared reports if the "images" edge to the Image entity was cleared.
-func (m *NoBackrefMutation) ImagesCleared() bool {
-	return m.clearedimages
+// SetColor sets the "color" field.
+func (m *SharedEnumMessageMutation) SetColor(s sharedenummessage.Color) {
+	m.color = &s
 }
 
-// RemoveImageIDs removes the "images" edge to the Image entity by IDs.
-func (m *NoBackrefMutation) RemoveImageIDs(ids ...uuid.UUID) {
-	if m.removedimages == nil {
-		m.removedimages = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.images, ids[i])
-		m.removedimages[ids[i]] = struct{}{}
+// Color returns the value of the "color" field in the mutation.
+func (m *SharedEnumMessageMutation) Color() (r sharedenummessage.Color, exists bool) {
+	v := m.color
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedImages returns the removed IDs of the "images" edge to the Image entity.
-func (m *NoBackrefMutation) RemovedImagesIDs() (ids []uuid.UUID) {
-	for id := range m.removedimages {
-		ids = append(ids, id)
+// OldColor returns the old "color" field's value of the SharedEnumMessage entity.
+// If the SharedEnumMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedEnumMessageMutation) OldColor(ctx context.Context) (v sharedenummessage.Color, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ImagesIDs returns the "images" edge IDs in the mutation.
-func (m *NoBackrefMutation) ImagesIDs() (ids []uuid.UUID) {
-	for id := range m.images {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColor requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+	}
+	return oldValue.Color, nil
 }
 
-// ResetImages resets all changes to the "images" edge.
-func (m *NoBackrefMutation) ResetImages() {
-	m.images = nil
-	m.clearedimages = false
-	m.removedimages = nil
+// ResetColor resets all changes to the "color" field.
+func (m *SharedEnumMessageMutation) ResetColor() {
+	m.color = nil
 }
 
-// Where appends a list predicates to the NoBackrefMutation builder.
-func (m *NoBackrefMutation) Where(ps ...predicate.NoBackref) {
+// Where appends a list predicates to the SharedEnumMessageMutation builder.
+func (m *SharedEnumMessageMutation) Where(ps ...predicate.SharedEnumMessage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *NoBackrefMutation) Op() Op {
+func (m *SharedEnumMessageMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (NoBackref).
-func (m *NoBackrefMutation) Type() string {
+// Type returns the node type of this mutation (SharedEnumMessage).
+func (m *SharedEnumMessageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NoBackrefMutation) Fields() []string {
-	fields := make([]string, 0, 0)
+func (m *SharedEnumMessageMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.color != nil {
+		fields = append(fields, sharedenummessage.FieldColor)
+	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NoBackrefMutation) Field(name string) (ent.Value, bool) {
+func (m *SharedEnumMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sharedenummessage.FieldColor:
+		return m.Color()
+	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NoBackrefMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown NoBackref field %s", name)
+func (m *SharedEnumMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sharedenummessage.FieldColor:
+		return m.OldColor(ctx)
+	}
+	return nil, fmt.Errorf("unknown SharedEnumMessage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NoBackrefMutation) SetField(name string, value ent.Value) error {
+func (m *SharedEnumMessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case sharedenummessage.FieldColor:
+		v, ok := value.(sharedenummessage.Color)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColor(v)
+		return nil
 	}
-	return fmt.Errorf("unknown NoBackref field %s", name)
+	return fmt.Errorf("unknown SharedEnumMessage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NoBackrefMutation) AddedFields() []string {
+func (m *SharedEnumMessageMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NoBackrefMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SharedEnumMessageMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NoBackrefMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown NoBackref numeric field %s", name)
+func (m *SharedEnumMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SharedEnumMessage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NoBackrefMutation) ClearedFields() []string {
+func (m *SharedEnumMessageMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NoBackrefMutation) FieldCleared(name string) bool {
+func (m *SharedEnumMessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NoBackrefMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown NoBackref nullable field %s", name)
+func (m *SharedEnumMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SharedEnumMessage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NoBackrefMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown NoBackref field %s", name)
+func (m *SharedEnumMessageMutation) ResetField(name string) error {
+	switch name {
+	case sharedenummessage.FieldColor:
+		m.ResetColor()
+		return nil
+	}
+	return fmt.Errorf("unknown SharedEnumMessage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NoBackrefMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.images != nil {
-		edges = append(edges, nobackref.EdgeImages)
-	}
+func (m *SharedEnumMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NoBackrefMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case nobackref.EdgeImages:
-		ids := make([]ent.Value, 0, len(m.images))
-		for id := range m.images {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SharedEnumMessageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NoBackrefMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedimages != nil {
-		edges = append(edges, nobackref.EdgeImages)
-	}
+func (m *SharedEnumMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NoBackrefMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case nobackref.EdgeImages:
-		ids := make([]ent.Value, 0, len(m.removedimages))
-		for id := range m.removedimages {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SharedEnumMessageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NoBackrefMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedimages {
-		edges = append(edges, nobackref.EdgeImages)
-	}
+func (m *SharedEnumMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NoBackrefMutation) EdgeCleared(name string) bool {
-	switch name {
-	case nobackref.EdgeImages:
-		return m.clearedimages
-	}
+func (m *SharedEnumMessageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NoBackrefMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown NoBackref unique edge %s", name)
+func (m *SharedEnumMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SharedEnumMessage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NoBackrefMutation) ResetEdge(name string) error {
-	switch name {
-	case nobackref.EdgeImages:
-		m.ResetImages()
-		return nil
-	}
-	return fmt.Errorf("unknown NoBackref edge %s", name)
+func (m *SharedEnumMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SharedEnumMessage edge %s", name)
 }
 
-// OneMethodServiceMutation represents an operation that mutates the OneMethodService nodes in the graph.
-type OneMethodServiceMutation struct {
+// SharedEnumOtherMessageMutation represents an operation that mutates the SharedEnumOtherMessage nodes in the graph.
+type SharedEnumOtherMessageMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
+	color         *sharedenumothermessage.Color
+	background    *sharedenumothermessage.Background
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*OneMethodService, error)
-	predicates    []predicate.OneMethodService
+	oldValue      func(context.Context) (*SharedEnumOtherMessage, error)
+	predicates    []predicate.SharedEnumOtherMessage
 }
 
-var _ ent.Mutation = (*OneMethodServiceMutation)(nil)
+var _ ent.Mutation = (*SharedEnumOtherMessageMutation)(nil)
 
-// onemethodserviceOption allows management of the mutation configuration using functional options.
-type onemethodserviceOption func(*OneMethodServiceMutation)
+// sharedenumothermessageOption allows management of the mutation configuration using functional options.
+type sharedenumothermessageOption func(*SharedEnumOtherMessageMutation)
 
-// newOneMethodServiceMutation creates new mutation for the OneMethodService entity.
-func newOneMethodServiceMutation(c config, op Op, opts ...onemethodserviceOption) *OneMethodServiceMutation {
-	m := &OneMethodServiceMutation{
+// newSharedEnumOtherMessageMutation creates new mutation for the SharedEnumOtherMessage entity.
+func newSharedEnumOtherMessageMutation(c config, op Op, opts ...sharedenumothermessageOption) *SharedEnumOtherMessageMutation {
+	m := &SharedEnumOtherMessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOneMethodService,
+		typ:           TypeSharedEnumOtherMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6294,20 +9921,20 @@ func newOneMethodServiceMutation(c config, op Op, opts ...onemethodserviceOption
 	return m
 }
 
-// withOneMethodServiceID sets the ID field of the mutation.
-func withOneMethodServiceID(id int) onemethodserviceOption {
-	return func(m *OneMethodServiceMutation) {
+// withSharedEnumOtherMessageID sets the ID field of the mutation.
+func withSharedEnumOtherMessageID(id int) sharedenumothermessageOption {
+	return func(m *SharedEnumOtherMessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *OneMethodService
+			value *SharedEnumOtherMessage
 		)
-		m.oldValue = func(ctx context.Context) (*OneMethodService, error) {
+		m.oldValue = func(ctx context.Context) (*SharedEnumOtherMessage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().OneMethodService.Get(ctx, id)
+					value, err = m.Client().SharedEnumOtherMessage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6316,10 +9943,10 @@ func withOneMethodServiceID(id int) onemethodserviceOption {
 	}
 }
 
-// withOneMethodService sets the old OneMethodService of the mutation.
-func withOneMethodService(node *OneMethodService) onemethodserviceOption {
-	return func(m *OneMethodServiceMutation) {
-		m.oldValue = func(context.Context) (*OneMethodService, error) {
+// withSharedEnumOtherMessage sets the old SharedEnumOtherMessage of the mutation.
+func withSharedEnumOtherMessage(node *SharedEnumOtherMessage) sharedenumothermessageOption {
+	return func(m *SharedEnumOtherMessageMutation) {
+		m.oldValue = func(context.Context) (*SharedEnumOtherMessage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6328,7 +9955,7 @@ func withOneMethodService(node *OneMethodService) onemethodserviceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OneMethodServiceMutation) Client() *Client {
+func (m SharedEnumOtherMessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6336,7 +9963,7 @@ func (m OneMethodServiceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m OneMethodServiceMutation) Tx() (*Tx, error) {
+func (m SharedEnumOtherMessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6347,7 +9974,7 @@ func (m OneMethodServiceMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *OneMethodServiceMutation) ID() (id int, exists bool) {
+func (m *SharedEnumOtherMessageMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6358,7 +9985,7 @@ func (m *OneMethodServiceMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *OneMethodServiceMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SharedEnumOtherMessageMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6367,178 +9994,289 @@ func (m *OneMethodServiceMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().OneMethodService.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SharedEnumOtherMessage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// Where appends a list predicates to the OneMethodServiceMutation builder.
-func (m *OneMethodServiceMutation) Where(ps ...predicate.OneMethodService) {
+// SetColor sets the "color" field.
+func (m *SharedEnumOtherMessageMutation) SetColor(s sharedenumothermessage.Color) {
+	m.color = &s
+}
+
+// Color returns the value of the "color" field in the mutation.
+func (m *SharedEnumOtherMessageMutation) Color() (r sharedenumothermessage.Color, exists bool) {
+	v := m.color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColor returns the old "color" field's value of the SharedEnumOtherMessage entity.
+// If the SharedEnumOtherMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedEnumOtherMessageMutation) OldColor(ctx context.Context) (v sharedenumothermessage.Color, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+	}
+	return oldValue.Color, nil
+}
+
+// ResetColor resets all changes to the "color" field.
+func (m *SharedEnumOtherMessageMutation) ResetColor() {
+	m.color = nil
+}
+
+// SetBackground sets the "background" field.
+func (m *SharedEnumOtherMessageMutation) SetBackground(s sharedenumothermessage.Background) {
+	m.background = &s
+}
+
+// Background returns the value of the "background" field in the mutation.
+func (m *SharedEnumOtherMessageMutation) Background() (r sharedenumothermessage.Background, exists bool) {
+	v := m.background
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBackground returns the old "background" field's value of the SharedEnumOtherMessage entity.
+// If the SharedEnumOtherMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedEnumOtherMessageMutation) OldBackground(ctx context.Context) (v sharedenumothermessage.Background, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBackground is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBackground requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBackground: %w", err)
+	}
+	return oldValue.Background, nil
+}
+
+// ResetBackground resets all changes to the "background" field.
+func (m *SharedEnumOtherMessageMutation) ResetBackground() {
+	m.background = nil
+}
+
+// Where appends a list predicates to the SharedEnumOtherMessageMutation builder.
+func (m *SharedEnumOtherMessageMutation) Where(ps ...predicate.SharedEnumOtherMessage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *OneMethodServiceMutation) Op() Op {
+func (m *SharedEnumOtherMessageMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (OneMethodService).
-func (m *OneMethodServiceMutation) Type() string {
+// Type returns the node type of this mutation (SharedEnumOtherMessage).
+func (m *SharedEnumOtherMessageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *OneMethodServiceMutation) Fields() []string {
-	fields := make([]string, 0, 0)
+func (m *SharedEnumOtherMessageMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.color != nil {
+		fields = append(fields, sharedenumothermessage.FieldColor)
+	}
+	if m.background != nil {
+		fields = append(fields, sharedenumothermessage.FieldBackground)
+	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OneMethodServiceMutation) Field(name string) (ent.Value, bool) {
+func (m *SharedEnumOtherMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sharedenumothermessage.FieldColor:
+		return m.Color()
+	case sharedenumothermessage.FieldBackground:
+		return m.Background()
+	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OneMethodServiceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown OneMethodService field %s", name)
+func (m *SharedEnumOtherMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sharedenumothermessage.FieldColor:
+		return m.OldColor(ctx)
+	case sharedenumothermessage.FieldBackground:
+		return m.OldBackground(ctx)
+	}
+	return nil, fmt.Errorf("unknown SharedEnumOtherMessage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OneMethodServiceMutation) SetField(name string, value ent.Value) error {
+func (m *SharedEnumOtherMessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case sharedenumothermessage.FieldColor:
+		v, ok := value.(sharedenumothermessage.Color)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColor(v)
+		return nil
+	case sharedenumothermessage.FieldBackground:
+		v, ok := value.(sharedenumothermessage.Background)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBackground(v)
+		return nil
 	}
-	return fmt.Errorf("unknown OneMethodService field %s", name)
+	return fmt.Errorf("unknown SharedEnumOtherMessage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OneMethodServiceMutation) AddedFields() []string {
+func (m *SharedEnumOtherMessageMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OneMethodServiceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SharedEnumOtherMessageMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OneMethodServiceMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown OneMethodService numeric field %s", name)
+func (m *SharedEnumOtherMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SharedEnumOtherMessage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OneMethodServiceMutation) ClearedFields() []string {
+func (m *SharedEnumOtherMessageMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OneMethodServiceMutation) FieldCleared(name string) bool {
+func (m *SharedEnumOtherMessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OneMethodServiceMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown OneMethodService nullable field %s", name)
+func (m *SharedEnumOtherMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SharedEnumOtherMessage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OneMethodServiceMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown OneMethodService field %s", name)
+func (m *SharedEnumOtherMessageMutation) ResetField(name string) error {
+	switch name {
+	case sharedenumothermessage.FieldColor:
+		m.ResetColor()
+		return nil
+	case sharedenumothermessage.FieldBackground:
+		m.ResetBackground()
+		return nil
+	}
+	return fmt.Errorf("unknown SharedEnumOtherMessage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OneMethodServiceMutation) AddedEdges() []string {
+func (m *SharedEnumOtherMessageMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OneMethodServiceMutation) AddedIDs(name string) []ent.Value {
+func (m *SharedEnumOtherMessageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OneMethodServiceMutation) RemovedEdges() []string {
+func (m *SharedEnumOtherMessageMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OneMethodServiceMutation) RemovedIDs(name string) []ent.Value {
+func (m *SharedEnumOtherMessageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OneMethodServiceMutation) ClearedEdges() []string {
+func (m *SharedEnumOtherMessageMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OneMethodServiceMutation) EdgeCleared(name string) bool {
+func (m *SharedEnumOtherMessageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OneMethodServiceMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown OneMethodService unique edge %s", name)
+func (m *SharedEnumOtherMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SharedEnumOtherMessage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OneMethodServiceMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown OneMethodService edge %s", name)
+func (m *SharedEnumOtherMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SharedEnumOtherMessage edge %s", name)
 }
 
-// PortalMutation represents an operation that mutates the Portal nodes in the graph.
-type PortalMutation struct {
+// SharedEnumVariantMutation represents an operation that mutates the SharedEnumVariant nodes in the graph.
+type SharedEnumVariantMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	name            *string
-	description     *string
-	clearedFields   map[string]struct{}
-	category        *int
-	clearedcategory bool
-	done            bool
-	oldValue        func(context.Context) (*Portal, error)
-	predicates      []predicate.Portal
+	op            Op
+	typ           string
+	id            *int
+	color         *sharedenumvariant.Color
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SharedEnumVariant, error)
+	predicates    []predicate.SharedEnumVariant
 }
 
-var _ ent.Mutation = (*PortalMutation)(nil)
+var _ ent.Mutation = (*SharedEnumVariantMutation)(nil)
 
-// portalOption allows management of the mutation configuration using functional options.
-type portalOption func(*PortalMutation)
+// sharedenumvariantOption allows management of the mutation configuration using functional options.
+type sharedenumvariantOption func(*SharedEnumVariantMutation)
 
-// newPortalMutation creates new mutation for the Portal entity.
-func newPortalMutation(c config, op Op, opts ...portalOption) *PortalMutation {
-	m := &PortalMutation{
+// newSharedEnumVariantMutation creates new mutation for the SharedEnumVariant entity.
+func newSharedEnumVariantMutation(c config, op Op, opts ...sharedenumvariantOption) *SharedEnumVariantMutation {
+	m := &SharedEnumVariantMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePortal,
+		typ:           TypeSharedEnumVariant,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6547,20 +10285,20 @@ func newPortalMutation(c config, op Op, opts ...portalOption) *PortalMutation {
 	return m
 }
 
-// withPortalID sets the ID field of the mutation.
-func withPortalID(id int) portalOption {
-	return func(m *PortalMutation) {
+// withSharedEnumVariantID sets the ID field of the mutation.
+func withSharedEnumVariantID(id int) sharedenumvariantOption {
+	return func(m *SharedEnumVariantMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Portal
+			value *SharedEnumVariant
 		)
-		m.oldValue = func(ctx context.Context) (*Portal, error) {
+		m.oldValue = func(ctx context.Context) (*SharedEnumVariant, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Portal.Get(ctx, id)
+					value, err = m.Client().SharedEnumVariant.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6569,10 +10307,10 @@ func withPortalID(id int) portalOption {
 	}
 }
 
-// withPortal sets the old Portal of the mutation.
-func withPortal(node *Portal) portalOption {
-	return func(m *PortalMutation) {
-		m.oldValue = func(context.Context) (*Portal, error) {
+// withSharedEnumVariant sets the old SharedEnumVariant of the mutation.
+func withSharedEnumVariant(node *SharedEnumVariant) sharedenumvariantOption {
+	return func(m *SharedEnumVariantMutation) {
+		m.oldValue = func(context.Context) (*SharedEnumVariant, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6581,7 +10319,7 @@ func withPortal(node *Portal) portalOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PortalMutation) Client() *Client {
+func (m SharedEnumVariantMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6589,7 +10327,7 @@ func (m PortalMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PortalMutation) Tx() (*Tx, error) {
+func (m SharedEnumVariantMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6600,7 +10338,7 @@ func (m PortalMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PortalMutation) ID() (id int, exists bool) {
+func (m *SharedEnumVariantMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6611,7 +10349,7 @@ func (m *PortalMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PortalMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SharedEnumVariantMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6620,148 +10358,70 @@ func (m *PortalMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Portal.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SharedEnumVariant.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *PortalMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *PortalMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the Portal entity.
-// If the Portal object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PortalMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *PortalMutation) ResetName() {
-	m.name = nil
-}
-
-// SetDescription sets the "description" field.
-func (m *PortalMutation) SetDescription(s string) {
-	m.description = &s
+// SetColor sets the "color" field.
+func (m *SharedEnumVariantMutation) SetColor(s sharedenumvariant.Color) {
+	m.color = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *PortalMutation) Description() (r string, exists bool) {
-	v := m.description
+// Color returns the value of the "color" field in the mutation.
+func (m *SharedEnumVariantMutation) Color() (r sharedenumvariant.Color, exists bool) {
+	v := m.color
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Portal entity.
-// If the Portal object wasn't provided to the builder, the object is fetched from the database.
+// OldColor returns the old "color" field's value of the SharedEnumVariant entity.
+// If the SharedEnumVariant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PortalMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *SharedEnumVariantMutation) OldColor(ctx context.Context) (v sharedenumvariant.Color, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldColor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
-	}
-	return oldValue.Description, nil
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *PortalMutation) ResetDescription() {
-	m.description = nil
-}
-
-// SetCategoryID sets the "category" edge to the Category entity by id.
-func (m *PortalMutation) SetCategoryID(id int) {
-	m.category = &id
-}
-
-// ClearCategory clears the "category" edge to the Category entity.
-func (m *PortalMutation) ClearCategory() {
-	m.clearedcategory = true
-}
-
-// CategoryCleared reports if the "category" edge to the Category entity was cleared.
-func (m *PortalMutation) CategoryCleared() bool {
-	return m.clearedcategory
-}
-
-// CategoryID returns the "category" edge ID in the mutation.
-func (m *PortalMutation) CategoryID() (id int, exists bool) {
-	if m.category != nil {
-		return *m.category, true
-	}
-	return
-}
-
-// CategoryIDs returns the "category" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CategoryID instead. It exists only for internal usage by the builders.
-func (m *PortalMutation) CategoryIDs() (ids []int) {
-	if id := m.category; id != nil {
-		ids = append(ids, *id)
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
 	}
-	return
+	return oldValue.Color, nil
 }
 
-// ResetCategory resets all changes to the "category" edge.
-func (m *PortalMutation) ResetCategory() {
-	m.category = nil
-	m.clearedcategory = false
+// ResetColor resets all changes to the "color" field.
+func (m *SharedEnumVariantMutation) ResetColor() {
+	m.color = nil
 }
 
-// Where appends a list predicates to the PortalMutation builder.
-func (m *PortalMutation) Where(ps ...predicate.Portal) {
+// Where appends a list predicates to the SharedEnumVariantMutation builder.
+func (m *SharedEnumVariantMutation) Where(ps ...predicate.SharedEnumVariant) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *PortalMutation) Op() Op {
+func (m *SharedEnumVariantMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Portal).
-func (m *PortalMutation) Type() string {
+// Type returns the node type of this mutation (SharedEnumVariant).
+func (m *SharedEnumVariantMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PortalMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.name != nil {
-		fields = append(fields, portal.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, portal.FieldDescription)
+func (m *SharedEnumVariantMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.color != nil {
+		fields = append(fields, sharedenumvariant.FieldColor)
 	}
 	return fields
 }
@@ -6769,12 +10429,10 @@ func (m *PortalMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PortalMutation) Field(name string) (ent.Value, bool) {
+func (m *SharedEnumVariantMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case portal.FieldName:
-		return m.Name()
-	case portal.FieldDescription:
-		return m.Description()
+	case sharedenumvariant.FieldColor:
+		return m.Color()
 	}
 	return nil, false
 }
@@ -6782,166 +10440,128 @@ func (m *PortalMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PortalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SharedEnumVariantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case portal.FieldName:
-		return m.OldName(ctx)
-	case portal.FieldDescription:
-		return m.OldDescription(ctx)
+	case sharedenumvariant.FieldColor:
+		return m.OldColor(ctx)
 	}
-	return nil, fmt.Errorf("unknown Portal field %s", name)
+	return nil, fmt.Errorf("unknown SharedEnumVariant field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PortalMutation) SetField(name string, value ent.Value) error {
+func (m *SharedEnumVariantMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case portal.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case portal.FieldDescription:
-		v, ok := value.(string)
+	case sharedenumvariant.FieldColor:
+		v, ok := value.(sharedenumvariant.Color)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetColor(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Portal field %s", name)
+	return fmt.Errorf("unknown SharedEnumVariant field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PortalMutation) AddedFields() []string {
+func (m *SharedEnumVariantMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PortalMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SharedEnumVariantMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PortalMutation) AddField(name string, value ent.Value) error {
+func (m *SharedEnumVariantMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Portal numeric field %s", name)
+	return fmt.Errorf("unknown SharedEnumVariant numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PortalMutation) ClearedFields() []string {
+func (m *SharedEnumVariantMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PortalMutation) FieldCleared(name string) bool {
+func (m *SharedEnumVariantMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PortalMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Portal nullable field %s", name)
+func (m *SharedEnumVariantMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SharedEnumVariant nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PortalMutation) ResetField(name string) error {
+func (m *SharedEnumVariantMutation) ResetField(name string) error {
 	switch name {
-	case portal.FieldName:
-		m.ResetName()
-		return nil
-	case portal.FieldDescription:
-		m.ResetDescription()
+	case sharedenumvariant.FieldColor:
+		m.ResetColor()
 		return nil
 	}
-	return fmt.Errorf("unknown Portal field %s", name)
+	return fmt.Errorf("unknown SharedEnumVariant field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PortalMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.category != nil {
-		edges = append(edges, portal.EdgeCategory)
-	}
+func (m *SharedEnumVariantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PortalMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case portal.EdgeCategory:
-		if id := m.category; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *SharedEnumVariantMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PortalMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *SharedEnumVariantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PortalMutation) RemovedIDs(name string) []ent.Value {
+func (m *SharedEnumVariantMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PortalMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedcategory {
-		edges = append(edges, portal.EdgeCategory)
-	}
+func (m *SharedEnumVariantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PortalMutation) EdgeCleared(name string) bool {
-	switch name {
-	case portal.EdgeCategory:
-		return m.clearedcategory
-	}
+func (m *SharedEnumVariantMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PortalMutation) ClearEdge(name string) error {
-	switch name {
-	case portal.EdgeCategory:
-		m.ClearCategory()
-		return nil
-	}
-	return fmt.Errorf("unknown Portal unique edge %s", name)
+func (m *SharedEnumVariantMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SharedEnumVariant unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PortalMutation) ResetEdge(name string) error {
-	switch name {
-	case portal.EdgeCategory:
-		m.ResetCategory()
-		return nil
-	}
-	return fmt.Errorf("unknown Portal edge %s", name)
+func (m *SharedEnumVariantMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SharedEnumVariant edge %s", name)
 }
 
 // SkipEdgeExampleMutation represents an operation that mutates the SkipEdgeExample nodes in the graph.
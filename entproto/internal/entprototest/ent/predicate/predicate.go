@@ -9,6 +9,9 @@ import (
 // AllMethodsService is the predicate function for allmethodsservice builders.
 type AllMethodsService func(*sql.Selector)
 
+// AutoFieldNumbers is the predicate function for autofieldnumbers builders.
+type AutoFieldNumbers func(*sql.Selector)
+
 // BlogPost is the predicate function for blogpost builders.
 type BlogPost func(*sql.Selector)
 
@@ -24,6 +27,9 @@ type DuplicateNumberMessage func(*sql.Selector)
 // ExplicitSkippedMessage is the predicate function for explicitskippedmessage builders.
 type ExplicitSkippedMessage func(*sql.Selector)
 
+// ExtraMethodService is the predicate function for extramethodservice builders.
+type ExtraMethodService func(*sql.Selector)
+
 // Image is the predicate function for image builders.
 type Image func(*sql.Selector)
 
@@ -33,6 +39,9 @@ type ImplicitSkippedMessage func(*sql.Selector)
 // InvalidFieldMessage is the predicate function for invalidfieldmessage builders.
 type InvalidFieldMessage func(*sql.Selector)
 
+// MappedFieldMessage is the predicate function for mappedfieldmessage builders.
+type MappedFieldMessage func(*sql.Selector)
+
 // MessageWithEnum is the predicate function for messagewithenum builders.
 type MessageWithEnum func(*sql.Selector)
 
@@ -51,6 +60,18 @@ type MessageWithPackageName func(*sql.Selector)
 // MessageWithStrings is the predicate function for messagewithstrings builders.
 type MessageWithStrings func(*sql.Selector)
 
+// MethodOptionsService is the predicate function for methodoptionsservice builders.
+type MethodOptionsService func(*sql.Selector)
+
+// MixinAnnotated is the predicate function for mixinannotated builders.
+type MixinAnnotated func(*sql.Selector)
+
+// MixinCollision is the predicate function for mixincollision builders.
+type MixinCollision func(*sql.Selector)
+
+// MixinServiceOverride is the predicate function for mixinserviceoverride builders.
+type MixinServiceOverride func(*sql.Selector)
+
 // NoBackref is the predicate function for nobackref builders.
 type NoBackref func(*sql.Selector)
 
@@ -60,6 +81,15 @@ type OneMethodService func(*sql.Selector)
 // Portal is the predicate function for portal builders.
 type Portal func(*sql.Selector)
 
+// SharedEnumMessage is the predicate function for sharedenummessage builders.
+type SharedEnumMessage func(*sql.Selector)
+
+// SharedEnumOtherMessage is the predicate function for sharedenumothermessage builders.
+type SharedEnumOtherMessage func(*sql.Selector)
+
+// SharedEnumVariant is the predicate function for sharedenumvariant builders.
+type SharedEnumVariant func(*sql.Selector)
+
 // SkipEdgeExample is the predicate function for skipedgeexample builders.
 type SkipEdgeExample func(*sql.Selector)
 
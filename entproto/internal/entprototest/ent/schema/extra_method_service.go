@@ -0,0 +1,47 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+)
+
+// ExtraMethodService holds the schema definition for the ExtraMethodService entity.
+type ExtraMethodService struct {
+	ent.Schema
+}
+
+func (ExtraMethodService) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(
+			entproto.Methods(entproto.MethodGet|entproto.MethodList),
+			entproto.Comment("ExtraMethodServiceService serves the entries along with extra methods."),
+			entproto.WithMethodOptions(entproto.MethodGet,
+				entproto.MethodComment("Get returns the entry with the given ID."),
+			),
+			entproto.ExtraMethod("Search", "ListExtraMethodServiceRequest", "ListExtraMethodServiceResponse",
+				entproto.ExtraMethodComment("Search returns the entries matching the query.\nThe results are ranked by relevance."),
+			),
+			entproto.ExtraMethod("Touch", "ExtraMethodService", "google.protobuf.Timestamp"),
+			entproto.ExtraMethod("ListMessages", "google.type.Date", "io.entgo.apps.todo.MessageWithPackageName",
+				entproto.ExtraMethodServerStream(),
+				entproto.ExtraMethodImports("google/type/date.proto"),
+			),
+		),
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// Coordinate is a custom Go type that entproto cannot map without a registered type mapping.
+type Coordinate struct {
+	Lat, Lng float64
+}
+
+// ParseCoordinate parses a coordinate in the "<lat>,<lng>" notation.
+func ParseCoordinate(s string) (Coordinate, error) {
+	var c Coordinate
+	if _, err := fmt.Sscanf(s, "%f,%f", &c.Lat, &c.Lng); err != nil {
+		return Coordinate{}, err
+	}
+	return c, nil
+}
+
+// Value implements the driver.Valuer interface.
+func (c Coordinate) Value() (driver.Value, error) {
+	return fmt.Sprintf("%f,%f", c.Lat, c.Lng), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (c *Coordinate) Scan(src interface{}) error {
+	s, ok := src.(string)
+	if !ok {
+		return fmt.Errorf("unexpected coordinate type %T", src)
+	}
+	v, err := ParseCoordinate(s)
+	if err != nil {
+		return err
+	}
+	*c = v
+	return nil
+}
+
+// MappedFieldMessage holds the schema definition for the MappedFieldMessage entity.
+type MappedFieldMessage struct {
+	ent.Schema
+}
+
+// Fields of the MappedFieldMessage.
+func (MappedFieldMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.Other("location", Coordinate{}).
+			SchemaType(map[string]string{dialect.SQLite: "varchar"}).
+			Annotations(entproto.Field(2)),
+		field.Other("previous_location", Coordinate{}).
+			SchemaType(map[string]string{dialect.SQLite: "varchar"}).
+			Optional().
+			Annotations(entproto.Field(3)),
+	}
+}
+
+func (MappedFieldMessage) Annotations() []schema.Annotation {
+	return []schema.Annotation{entproto.Message()}
+}
@@ -50,6 +50,23 @@ func (MessageWithEnum) Fields() []ent.Field {
 					"second": 2,
 				}),
 			),
+		field.Enum("enum_zero_mapped").
+			Values("low", "high").
+			Annotations(
+				entproto.Field(4),
+				entproto.Enum(map[string]int32{
+					"low":  0,
+					"high": 1,
+				}, entproto.MapZeroValue()),
+			),
+		field.Enum("enum_unknown").
+			Values("known").
+			Annotations(
+				entproto.Field(5),
+				entproto.Enum(map[string]int32{
+					"known": 1,
+				}, entproto.UnspecifiedName("unknown")),
+			),
 	}
 }
 
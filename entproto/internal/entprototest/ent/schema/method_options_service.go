@@ -0,0 +1,59 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// MethodOptionsService holds the schema definition for the MethodOptionsService entity.
+type MethodOptionsService struct {
+	ent.Schema
+}
+
+func (MethodOptionsService) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(
+			entproto.OpenAPISchema(&options.Schema{
+				JsonSchema: &options.JSONSchema{Title: "Method options"},
+				Example:    `{"id": 1}`,
+			}),
+		),
+		entproto.Service(
+			entproto.Methods(entproto.MethodGet|entproto.MethodList|entproto.MethodDelete),
+			entproto.WithMethodOptions(entproto.MethodGet|entproto.MethodList,
+				entproto.IdempotencyLevel(descriptorpb.MethodOptions_NO_SIDE_EFFECTS),
+			),
+			entproto.WithMethodOptions(entproto.MethodGet,
+				entproto.MethodSignature("id"),
+				entproto.MethodSignature("id", "view"),
+				entproto.OpenAPIOperation(&options.Operation{
+					Summary: "Get an entry",
+					Tags:    []string{"entries"},
+					Responses: map[string]*options.Response{
+						"200": {Examples: map[string]string{"application/json": `{"id": 1}`}},
+					},
+				}),
+			),
+			entproto.WithMethodOptions(entproto.MethodDelete,
+				entproto.IdempotencyLevel(descriptorpb.MethodOptions_IDEMPOTENT),
+			),
+		),
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// ServiceMixin holds the mixin definition for schemas exposed as protobuf services.
+type ServiceMixin struct {
+	mixin.Schema
+}
+
+// Fields of the ServiceMixin.
+func (ServiceMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("state").
+			Values("on", "off").
+			Annotations(
+				entproto.Field(2),
+				entproto.Enum(map[string]int32{
+					"on":  1,
+					"off": 2,
+				}),
+			),
+		field.String("internal").
+			Annotations(entproto.Skip()),
+	}
+}
+
+func (ServiceMixin) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(entproto.Methods(entproto.MethodGet)),
+	}
+}
+
+// MixinAnnotated holds the schema definition for the MixinAnnotated entity.
+type MixinAnnotated struct {
+	ent.Schema
+}
+
+// Mixin of the MixinAnnotated.
+func (MixinAnnotated) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		ServiceMixin{},
+	}
+}
+
+// Fields of the MixinAnnotated.
+func (MixinAnnotated) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Annotations(entproto.Field(3)),
+	}
+}
+
+// MixinServiceOverride holds the schema definition for the MixinServiceOverride entity.
+type MixinServiceOverride struct {
+	ent.Schema
+}
+
+// Mixin of the MixinServiceOverride.
+func (MixinServiceOverride) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		ServiceMixin{},
+	}
+}
+
+func (MixinServiceOverride) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Service(entproto.Methods(entproto.MethodGet | entproto.MethodList)),
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// AuditMixin holds the mixin definition for audited schemas.
+type AuditMixin struct {
+	mixin.Schema
+}
+
+// Fields of the AuditMixin.
+func (AuditMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("created_by").
+			Annotations(entproto.Field(2)),
+	}
+}
+
+// MixinCollision holds the schema definition for the MixinCollision entity.
+type MixinCollision struct {
+	ent.Schema
+}
+
+// Mixin of the MixinCollision.
+func (MixinCollision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		AuditMixin{},
+	}
+}
+
+// Fields of the MixinCollision.
+func (MixinCollision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Annotations(entproto.Field(2)),
+	}
+}
+
+func (MixinCollision) Annotations() []schema.Annotation {
+	return []schema.Annotation{entproto.Message()}
+}
@@ -0,0 +1,115 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// ColorMixin holds the mixin definition of a field generated as a shared enum.
+type ColorMixin struct {
+	mixin.Schema
+}
+
+// Fields of the ColorMixin.
+func (ColorMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("color").
+			Values("red", "green").
+			Annotations(
+				entproto.Field(2),
+				entproto.Enum(map[string]int32{
+					"red":   1,
+					"green": 2,
+				}, entproto.SharedEnum("Color")),
+			),
+	}
+}
+
+// SharedEnumMessage holds the schema definition for the SharedEnumMessage entity.
+type SharedEnumMessage struct {
+	ent.Schema
+}
+
+// Mixin of the SharedEnumMessage.
+func (SharedEnumMessage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		ColorMixin{},
+	}
+}
+
+func (SharedEnumMessage) Annotations() []schema.Annotation {
+	return []schema.Annotation{entproto.Message()}
+}
+
+// SharedEnumOtherMessage holds the schema definition for the SharedEnumOtherMessage entity.
+type SharedEnumOtherMessage struct {
+	ent.Schema
+}
+
+// Mixin of the SharedEnumOtherMessage.
+func (SharedEnumOtherMessage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		ColorMixin{},
+	}
+}
+
+// Fields of the SharedEnumOtherMessage.
+func (SharedEnumOtherMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("background").
+			Values("red", "green").
+			Annotations(
+				entproto.Field(3),
+				entproto.Enum(map[string]int32{
+					"red":   1,
+					"green": 2,
+				}, entproto.SharedEnum("Color")),
+			),
+	}
+}
+
+func (SharedEnumOtherMessage) Annotations() []schema.Annotation {
+	return []schema.Annotation{entproto.Message()}
+}
+
+// SharedEnumVariant holds the schema definition for the SharedEnumVariant entity,
+// whose definition of the shared enum differs from the other messages.
+type SharedEnumVariant struct {
+	ent.Schema
+}
+
+// Fields of the SharedEnumVariant.
+func (SharedEnumVariant) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("color").
+			Values("red", "blue").
+			Annotations(
+				entproto.Field(2),
+				entproto.Enum(map[string]int32{
+					"red":  1,
+					"blue": 2,
+				}, entproto.SharedEnum("Color")),
+			),
+	}
+}
+
+func (SharedEnumVariant) Annotations() []schema.Annotation {
+	return []schema.Annotation{entproto.Message()}
+}
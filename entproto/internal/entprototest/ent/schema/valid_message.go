@@ -32,7 +32,7 @@ type ValidMessage struct {
 func (ValidMessage) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").
-			Annotations(entproto.Field(2)),
+			Annotations(entproto.Field(2, entproto.JSONName("title"))),
 		field.Time("ts").
 			Annotations(entproto.Field(3)),
 		field.UUID("uuid", uuid.New()).
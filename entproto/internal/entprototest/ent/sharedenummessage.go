@@ -0,0 +1,99 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenummessage"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SharedEnumMessage is the model entity for the SharedEnumMessage schema.
+type SharedEnumMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Color holds the value of the "color" field.
+	Color sharedenummessage.Color `json:"color,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SharedEnumMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sharedenummessage.FieldID:
+			values[i] = new(sql.NullInt64)
+		case sharedenummessage.FieldColor:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type SharedEnumMessage", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SharedEnumMessage fields.
+func (sem *SharedEnumMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sharedenummessage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sem.ID = int(value.Int64)
+		case sharedenummessage.FieldColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field color", values[i])
+			} else if value.Valid {
+				sem.Color = sharedenummessage.Color(value.String)
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this SharedEnumMessage.
+// Note that you need to call SharedEnumMessage.Unwrap() before calling this method if this SharedEnumMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sem *SharedEnumMessage) Update() *SharedEnumMessageUpdateOne {
+	return (&SharedEnumMessageClient{config: sem.config}).UpdateOne(sem)
+}
+
+// Unwrap unwraps the SharedEnumMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sem *SharedEnumMessage) Unwrap() *SharedEnumMessage {
+	_tx, ok := sem.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SharedEnumMessage is not a transactional entity")
+	}
+	sem.config.driver = _tx.drv
+	return sem
+}
+
+// String implements the fmt.Stringer.
+func (sem *SharedEnumMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("SharedEnumMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sem.ID))
+	builder.WriteString("color=")
+	builder.WriteString(fmt.Sprintf("%v", sem.Color))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SharedEnumMessages is a parsable slice of SharedEnumMessage.
+type SharedEnumMessages []*SharedEnumMessage
+
+func (sem SharedEnumMessages) config(cfg config) {
+	for _i := range sem {
+		sem[_i].config = cfg
+	}
+}
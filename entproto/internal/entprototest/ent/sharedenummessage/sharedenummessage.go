@@ -0,0 +1,57 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sharedenummessage
+
+import (
+	"fmt"
+)
+
+const (
+	// Label holds the string label denoting the sharedenummessage type in the database.
+	Label = "shared_enum_message"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldColor holds the string denoting the color field in the database.
+	FieldColor = "color"
+	// Table holds the table name of the sharedenummessage in the database.
+	Table = "shared_enum_messages"
+)
+
+// Columns holds all SQL columns for sharedenummessage fields.
+var Columns = []string{
+	FieldID,
+	FieldColor,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Color defines the type for the "color" enum field.
+type Color string
+
+// Color values.
+const (
+	ColorRed   Color = "red"
+	ColorGreen Color = "green"
+)
+
+func (c Color) String() string {
+	return string(c)
+}
+
+// ColorValidator is a validator for the "color" field enum values. It is called by the builders before save.
+func ColorValidator(c Color) error {
+	switch c {
+	case ColorRed, ColorGreen:
+		return nil
+	default:
+		return fmt.Errorf("sharedenummessage: invalid enum value for color field: %q", c)
+	}
+}
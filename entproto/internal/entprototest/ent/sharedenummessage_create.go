@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenummessage"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SharedEnumMessageCreate is the builder for creating a SharedEnumMessage entity.
+type SharedEnumMessageCreate struct {
+	config
+	mutation *SharedEnumMessageMutation
+	hooks    []Hook
+}
+
+// SetColor sets the "color" field.
+func (semc *SharedEnumMessageCreate) SetColor(s sharedenummessage.Color) *SharedEnumMessageCreate {
+	semc.mutation.SetColor(s)
+	return semc
+}
+
+// Mutation returns the SharedEnumMessageMutation object of the builder.
+func (semc *SharedEnumMessageCreate) Mutation() *SharedEnumMessageMutation {
+	return semc.mutation
+}
+
+// Save creates the SharedEnumMessage in the database.
+func (semc *SharedEnumMessageCreate) Save(ctx context.Context) (*SharedEnumMessage, error) {
+	var (
+		err  error
+		node *SharedEnumMessage
+	)
+	if len(semc.hooks) == 0 {
+		if err = semc.check(); err != nil {
+			return nil, err
+		}
+		node, err = semc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SharedEnumMessageMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = semc.check(); err != nil {
+				return nil, err
+			}
+			semc.mutation = mutation
+			if node, err = semc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(semc.hooks) - 1; i >= 0; i-- {
+			if semc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = semc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, semc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*SharedEnumMessage)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from SharedEnumMessageMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (semc *SharedEnumMessageCreate) SaveX(ctx context.Context) *SharedEnumMessage {
+	v, err := semc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (semc *SharedEnumMessageCreate) Exec(ctx context.Context) error {
+	_, err := semc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (semc *SharedEnumMessageCreate) ExecX(ctx context.Context) {
+	if err := semc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (semc *SharedEnumMessageCreate) check() error {
+	if _, ok := semc.mutation.Color(); !ok {
+		return &ValidationError{Name: "color", err: errors.New(`ent: missing required field "SharedEnumMessage.color"`)}
+	}
+	if v, ok := semc.mutation.Color(); ok {
+		if err := sharedenummessage.ColorValidator(v); err != nil {
+			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "SharedEnumMessage.color": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (semc *SharedEnumMessageCreate) sqlSave(ctx context.Context) (*SharedEnumMessage, error) {
+	_node, _spec := semc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, semc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (semc *SharedEnumMessageCreate) createSpec() (*SharedEnumMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SharedEnumMessage{config: semc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: sharedenummessage.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharedenummessage.FieldID,
+			},
+		}
+	)
+	if value, ok := semc.mutation.Color(); ok {
+		_spec.SetField(sharedenummessage.FieldColor, field.TypeEnum, value)
+		_node.Color = value
+	}
+	return _node, _spec
+}
+
+// SharedEnumMessageCreateBulk is the builder for creating many SharedEnumMessage entities in bulk.
+type SharedEnumMessageCreateBulk struct {
+	config
+	builders []*SharedEnumMessageCreate
+}
+
+// Save creates the SharedEnumMessage entities in the database.
+func (semcb *SharedEnumMessageCreateBulk) Save(ctx context.Context) ([]*SharedEnumMessage, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(semcb.builders))
+	nodes := make([]*SharedEnumMessage, len(semcb.builders))
+	mutators := make([]Mutator, len(semcb.builders))
+	for i := range semcb.builders {
+		func(i int, root context.Context) {
+			builder := semcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SharedEnumMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, semcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, semcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, semcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (semcb *SharedEnumMessageCreateBulk) SaveX(ctx context.Context) []*SharedEnumMessage {
+	v, err := semcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (semcb *SharedEnumMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := semcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (semcb *SharedEnumMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := semcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
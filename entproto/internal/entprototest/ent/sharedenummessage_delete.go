@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenummessage"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SharedEnumMessageDelete is the builder for deleting a SharedEnumMessage entity.
+type SharedEnumMessageDelete struct {
+	config
+	hooks    []Hook
+	mutation *SharedEnumMessageMutation
+}
+
+// Where appends a list predicates to the SharedEnumMessageDelete builder.
+func (semd *SharedEnumMessageDelete) Where(ps ...predicate.SharedEnumMessage) *SharedEnumMessageDelete {
+	semd.mutation.Where(ps...)
+	return semd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (semd *SharedEnumMessageDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(semd.hooks) == 0 {
+		affected, err = semd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SharedEnumMessageMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			semd.mutation = mutation
+			affected, err = semd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(semd.hooks) - 1; i >= 0; i-- {
+			if semd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = semd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, semd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (semd *SharedEnumMessageDelete) ExecX(ctx context.Context) int {
+	n, err := semd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (semd *SharedEnumMessageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: sharedenummessage.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharedenummessage.FieldID,
+			},
+		},
+	}
+	if ps := semd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, semd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// SharedEnumMessageDeleteOne is the builder for deleting a single SharedEnumMessage entity.
+type SharedEnumMessageDeleteOne struct {
+	semd *SharedEnumMessageDelete
+}
+
+// Exec executes the deletion query.
+func (semdo *SharedEnumMessageDeleteOne) Exec(ctx context.Context) error {
+	n, err := semdo.semd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{sharedenummessage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (semdo *SharedEnumMessageDeleteOne) ExecX(ctx context.Context) {
+	semdo.semd.ExecX(ctx)
+}
@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenummessage"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SharedEnumMessageQuery is the builder for querying SharedEnumMessage entities.
+type SharedEnumMessageQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.SharedEnumMessage
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SharedEnumMessageQuery builder.
+func (semq *SharedEnumMessageQuery) Where(ps ...predicate.SharedEnumMessage) *SharedEnumMessageQuery {
+	semq.predicates = append(semq.predicates, ps...)
+	return semq
+}
+
+// Limit adds a limit step to the query.
+func (semq *SharedEnumMessageQuery) Limit(limit int) *SharedEnumMessageQuery {
+	semq.limit = &limit
+	return semq
+}
+
+// Offset adds an offset step to the query.
+func (semq *SharedEnumMessageQuery) Offset(offset int) *SharedEnumMessageQuery {
+	semq.offset = &offset
+	return semq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (semq *SharedEnumMessageQuery) Unique(unique bool) *SharedEnumMessageQuery {
+	semq.unique = &unique
+	return semq
+}
+
+// Order adds an order step to the query.
+func (semq *SharedEnumMessageQuery) Order(o ...OrderFunc) *SharedEnumMessageQuery {
+	semq.order = append(semq.order, o...)
+	return semq
+}
+
+// First returns the first SharedEnumMessage entity from the query.
+// Returns a *NotFoundError when no SharedEnumMessage was found.
+func (semq *SharedEnumMessageQuery) First(ctx context.Context) (*SharedEnumMessage, error) {
+	nodes, err := semq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{sharedenummessage.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (semq *SharedEnumMessageQuery) FirstX(ctx context.Context) *SharedEnumMessage {
+	node, err := semq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SharedEnumMessage ID from the query.
+// Returns a *NotFoundError when no SharedEnumMessage ID was found.
+func (semq *SharedEnumMessageQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = semq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{sharedenummessage.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (semq *SharedEnumMessageQuery) FirstIDX(ctx context.Context) int {
+	id, err := semq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SharedEnumMessage entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SharedEnumMessage entity is found.
+// Returns a *NotFoundError when no SharedEnumMessage entities are found.
+func (semq *SharedEnumMessageQuery) Only(ctx context.Context) (*SharedEnumMessage, error) {
+	nodes, err := semq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{sharedenummessage.Label}
+	default:
+		return nil, &NotSingularError{sharedenummessage.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (semq *SharedEnumMessageQuery) OnlyX(ctx context.Context) *SharedEnumMessage {
+	node, err := semq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SharedEnumMessage ID in the query.
+// Returns a *NotSingularError when more than one SharedEnumMessage ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (semq *SharedEnumMessageQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = semq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{sharedenummessage.Label}
+	default:
+		err = &NotSingularError{sharedenummessage.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (semq *SharedEnumMessageQuery) OnlyIDX(ctx context.Context) int {
+	id, err := semq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SharedEnumMessages.
+func (semq *SharedEnumMessageQuery) All(ctx context.Context) ([]*SharedEnumMessage, error) {
+	if err := semq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return semq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (semq *SharedEnumMessageQuery) AllX(ctx context.Context) []*SharedEnumMessage {
+	nodes, err := semq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SharedEnumMessage IDs.
+func (semq *SharedEnumMessageQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := semq.Select(sharedenummessage.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (semq *SharedEnumMessageQuery) IDsX(ctx context.Context) []int {
+	ids, err := semq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (semq *SharedEnumMessageQuery) Count(ctx context.Context) (int, error) {
+	if err := semq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return semq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (semq *SharedEnumMessageQuery) CountX(ctx context.Context) int {
+	count, err := semq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (semq *SharedEnumMessageQuery) Exist(ctx context.Context) (bool, error) {
+	if err := semq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return semq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (semq *SharedEnumMessageQuery) ExistX(ctx context.Context) bool {
+	exist, err := semq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SharedEnumMessageQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (semq *SharedEnumMessageQuery) Clone() *SharedEnumMessageQuery {
+	if semq == nil {
+		return nil
+	}
+	return &SharedEnumMessageQuery{
+		config:     semq.config,
+		limit:      semq.limit,
+		offset:     semq.offset,
+		order:      append([]OrderFunc{}, semq.order...),
+		predicates: append([]predicate.SharedEnumMessage{}, semq.predicates...),
+		// clone intermediate query.
+		sql:    semq.sql.Clone(),
+		path:   semq.path,
+		unique: semq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Color sharedenummessage.Color `json:"color,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SharedEnumMessage.Query().
+//		GroupBy(sharedenummessage.FieldColor).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (semq *SharedEnumMessageQuery) GroupBy(field string, fields ...string) *SharedEnumMessageGroupBy {
+	grbuild := &SharedEnumMessageGroupBy{config: semq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := semq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return semq.sqlQuery(ctx), nil
+	}
+	grbuild.label = sharedenummessage.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Color sharedenummessage.Color `json:"color,omitempty"`
+//	}
+//
+//	client.SharedEnumMessage.Query().
+//		Select(sharedenummessage.FieldColor).
+//		Scan(ctx, &v)
+func (semq *SharedEnumMessageQuery) Select(fields ...string) *SharedEnumMessageSelect {
+	semq.fields = append(semq.fields, fields...)
+	selbuild := &SharedEnumMessageSelect{SharedEnumMessageQuery: semq}
+	selbuild.label = sharedenummessage.Label
+	selbuild.flds, selbuild.scan = &semq.fields, selbuild.Scan
+	return selbuild
+}
+
+// Aggregate returns a SharedEnumMessageSelect configured with the given aggregations.
+func (semq *SharedEnumMessageQuery) Aggregate(fns ...AggregateFunc) *SharedEnumMessageSelect {
+	return semq.Select().Aggregate(fns...)
+}
+
+func (semq *SharedEnumMessageQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range semq.fields {
+		if !sharedenummessage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if semq.path != nil {
+		prev, err := semq.path(ctx)
+		if err != nil {
+			return err
+		}
+		semq.sql = prev
+	}
+	return nil
+}
+
+func (semq *SharedEnumMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SharedEnumMessage, error) {
+	var (
+		nodes = []*SharedEnumMessage{}
+		_spec = semq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SharedEnumMessage).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SharedEnumMessage{config: semq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, semq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (semq *SharedEnumMessageQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := semq.querySpec()
+	_spec.Node.Columns = semq.fields
+	if len(semq.fields) > 0 {
+		_spec.Unique = semq.unique != nil && *semq.unique
+	}
+	return sqlgraph.CountNodes(ctx, semq.driver, _spec)
+}
+
+func (semq *SharedEnumMessageQuery) sqlExist(ctx context.Context) (bool, error) {
+	switch _, err := semq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+func (semq *SharedEnumMessageQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sharedenummessage.Table,
+			Columns: sharedenummessage.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharedenummessage.FieldID,
+			},
+		},
+		From:   semq.sql,
+		Unique: true,
+	}
+	if unique := semq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := semq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharedenummessage.FieldID)
+		for i := range fields {
+			if fields[i] != sharedenummessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := semq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := semq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := semq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := semq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (semq *SharedEnumMessageQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(semq.driver.Dialect())
+	t1 := builder.Table(sharedenummessage.Table)
+	columns := semq.fields
+	if len(columns) == 0 {
+		columns = sharedenummessage.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if semq.sql != nil {
+		selector = semq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if semq.unique != nil && *semq.unique {
+		selector.Distinct()
+	}
+	for _, p := range semq.predicates {
+		p(selector)
+	}
+	for _, p := range semq.order {
+		p(selector)
+	}
+	if offset := semq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := semq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SharedEnumMessageGroupBy is the group-by builder for SharedEnumMessage entities.
+type SharedEnumMessageGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (semgb *SharedEnumMessageGroupBy) Aggregate(fns ...AggregateFunc) *SharedEnumMessageGroupBy {
+	semgb.fns = append(semgb.fns, fns...)
+	return semgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (semgb *SharedEnumMessageGroupBy) Scan(ctx context.Context, v any) error {
+	query, err := semgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	semgb.sql = query
+	return semgb.sqlScan(ctx, v)
+}
+
+func (semgb *SharedEnumMessageGroupBy) sqlScan(ctx context.Context, v any) error {
+	for _, f := range semgb.fields {
+		if !sharedenummessage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := semgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := semgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (semgb *SharedEnumMessageGroupBy) sqlQuery() *sql.Selector {
+	selector := semgb.sql.Select()
+	aggregation := make([]string, 0, len(semgb.fns))
+	for _, fn := range semgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(semgb.fields)+len(semgb.fns))
+		for _, f := range semgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(semgb.fields...)...)
+}
+
+// SharedEnumMessageSelect is the builder for selecting fields of SharedEnumMessage entities.
+type SharedEnumMessageSelect struct {
+	*SharedEnumMessageQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sems *SharedEnumMessageSelect) Aggregate(fns ...AggregateFunc) *SharedEnumMessageSelect {
+	sems.fns = append(sems.fns, fns...)
+	return sems
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sems *SharedEnumMessageSelect) Scan(ctx context.Context, v any) error {
+	if err := sems.prepareQuery(ctx); err != nil {
+		return err
+	}
+	sems.sql = sems.SharedEnumMessageQuery.sqlQuery(ctx)
+	return sems.sqlScan(ctx, v)
+}
+
+func (sems *SharedEnumMessageSelect) sqlScan(ctx context.Context, v any) error {
+	aggregation := make([]string, 0, len(sems.fns))
+	for _, fn := range sems.fns {
+		aggregation = append(aggregation, fn(sems.sql))
+	}
+	switch n := len(*sems.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		sems.sql.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		sems.sql.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := sems.sql.Query()
+	if err := sems.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenummessage"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SharedEnumMessageUpdate is the builder for updating SharedEnumMessage entities.
+type SharedEnumMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SharedEnumMessageMutation
+}
+
+// Where appends a list predicates to the SharedEnumMessageUpdate builder.
+func (semu *SharedEnumMessageUpdate) Where(ps ...predicate.SharedEnumMessage) *SharedEnumMessageUpdate {
+	semu.mutation.Where(ps...)
+	return semu
+}
+
+// SetColor sets the "color" field.
+func (semu *SharedEnumMessageUpdate) SetColor(s sharedenummessage.Color) *SharedEnumMessageUpdate {
+	semu.mutation.SetColor(s)
+	return semu
+}
+
+// Mutation returns the SharedEnumMessageMutation object of the builder.
+func (semu *SharedEnumMessageUpdate) Mutation() *SharedEnumMessageMutation {
+	return semu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (semu *SharedEnumMessageUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(semu.hooks) == 0 {
+		if err = semu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = semu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SharedEnumMessageMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = semu.check(); err != nil {
+				return 0, err
+			}
+			semu.mutation = mutation
+			affected, err = semu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(semu.hooks) - 1; i >= 0; i-- {
+			if semu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = semu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, semu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (semu *SharedEnumMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := semu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (semu *SharedEnumMessageUpdate) Exec(ctx context.Context) error {
+	_, err := semu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (semu *SharedEnumMessageUpdate) ExecX(ctx context.Context) {
+	if err := semu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (semu *SharedEnumMessageUpdate) check() error {
+	if v, ok := semu.mutation.Color(); ok {
+		if err := sharedenummessage.ColorValidator(v); err != nil {
+			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "SharedEnumMessage.color": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (semu *SharedEnumMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sharedenummessage.Table,
+			Columns: sharedenummessage.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharedenummessage.FieldID,
+			},
+		},
+	}
+	if ps := semu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := semu.mutation.Color(); ok {
+		_spec.SetField(sharedenummessage.FieldColor, field.TypeEnum, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, semu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharedenummessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SharedEnumMessageUpdateOne is the builder for updating a single SharedEnumMessage entity.
+type SharedEnumMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SharedEnumMessageMutation
+}
+
+// SetColor sets the "color" field.
+func (semuo *SharedEnumMessageUpdateOne) SetColor(s sharedenummessage.Color) *SharedEnumMessageUpdateOne {
+	semuo.mutation.SetColor(s)
+	return semuo
+}
+
+// Mutation returns the SharedEnumMessageMutation object of the builder.
+func (semuo *SharedEnumMessageUpdateOne) Mutation() *SharedEnumMessageMutation {
+	return semuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (semuo *SharedEnumMessageUpdateOne) Select(field string, fields ...string) *SharedEnumMessageUpdateOne {
+	semuo.fields = append([]string{field}, fields...)
+	return semuo
+}
+
+// Save executes the query and returns the updated SharedEnumMessage entity.
+func (semuo *SharedEnumMessageUpdateOne) Save(ctx context.Context) (*SharedEnumMessage, error) {
+	var (
+		err  error
+		node *SharedEnumMessage
+	)
+	if len(semuo.hooks) == 0 {
+		if err = semuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = semuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SharedEnumMessageMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = semuo.check(); err != nil {
+				return nil, err
+			}
+			semuo.mutation = mutation
+			node, err = semuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(semuo.hooks) - 1; i >= 0; i-- {
+			if semuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = semuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, semuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*SharedEnumMessage)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from SharedEnumMessageMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (semuo *SharedEnumMessageUpdateOne) SaveX(ctx context.Context) *SharedEnumMessage {
+	node, err := semuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (semuo *SharedEnumMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := semuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (semuo *SharedEnumMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := semuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (semuo *SharedEnumMessageUpdateOne) check() error {
+	if v, ok := semuo.mutation.Color(); ok {
+		if err := sharedenummessage.ColorValidator(v); err != nil {
+			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "SharedEnumMessage.color": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (semuo *SharedEnumMessageUpdateOne) sqlSave(ctx context.Context) (_node *SharedEnumMessage, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sharedenummessage.Table,
+			Columns: sharedenummessage.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharedenummessage.FieldID,
+			},
+		},
+	}
+	id, ok := semuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SharedEnumMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := semuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharedenummessage.FieldID)
+		for _, f := range fields {
+			if !sharedenummessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sharedenummessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := semuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := semuo.mutation.Color(); ok {
+		_spec.SetField(sharedenummessage.FieldColor, field.TypeEnum, value)
+	}
+	_node = &SharedEnumMessage{config: semuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, semuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharedenummessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,110 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenumothermessage"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SharedEnumOtherMessage is the model entity for the SharedEnumOtherMessage schema.
+type SharedEnumOtherMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Color holds the value of the "color" field.
+	Color sharedenumothermessage.Color `json:"color,omitempty"`
+	// Background holds the value of the "background" field.
+	Background sharedenumothermessage.Background `json:"background,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SharedEnumOtherMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sharedenumothermessage.FieldID:
+			values[i] = new(sql.NullInt64)
+		case sharedenumothermessage.FieldColor, sharedenumothermessage.FieldBackground:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type SharedEnumOtherMessage", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SharedEnumOtherMessage fields.
+func (seom *SharedEnumOtherMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sharedenumothermessage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			seom.ID = int(value.Int64)
+		case sharedenumothermessage.FieldColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field color", values[i])
+			} else if value.Valid {
+				seom.Color = sharedenumothermessage.Color(value.String)
+			}
+		case sharedenumothermessage.FieldBackground:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field background", values[i])
+			} else if value.Valid {
+				seom.Background = sharedenumothermessage.Background(value.String)
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this SharedEnumOtherMessage.
+// Note that you need to call SharedEnumOtherMessage.Unwrap() before calling this method if this SharedEnumOtherMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (seom *SharedEnumOtherMessage) Update() *SharedEnumOtherMessageUpdateOne {
+	return (&SharedEnumOtherMessageClient{config: seom.config}).UpdateOne(seom)
+}
+
+// Unwrap unwraps the SharedEnumOtherMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (seom *SharedEnumOtherMessage) Unwrap() *SharedEnumOtherMessage {
+	_tx, ok := seom.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SharedEnumOtherMessage is not a transactional entity")
+	}
+	seom.config.driver = _tx.drv
+	return seom
+}
+
+// String implements the fmt.Stringer.
+func (seom *SharedEnumOtherMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("SharedEnumOtherMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", seom.ID))
+	builder.WriteString("color=")
+	builder.WriteString(fmt.Sprintf("%v", seom.Color))
+	builder.WriteString(", ")
+	builder.WriteString("background=")
+	builder.WriteString(fmt.Sprintf("%v", seom.Background))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SharedEnumOtherMessages is a parsable slice of SharedEnumOtherMessage.
+type SharedEnumOtherMessages []*SharedEnumOtherMessage
+
+func (seom SharedEnumOtherMessages) config(cfg config) {
+	for _i := range seom {
+		seom[_i].config = cfg
+	}
+}
@@ -0,0 +1,83 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sharedenumothermessage
+
+import (
+	"fmt"
+)
+
+const (
+	// Label holds the string label denoting the sharedenumothermessage type in the database.
+	Label = "shared_enum_other_message"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldColor holds the string denoting the color field in the database.
+	FieldColor = "color"
+	// FieldBackground holds the string denoting the background field in the database.
+	FieldBackground = "background"
+	// Table holds the table name of the sharedenumothermessage in the database.
+	Table = "shared_enum_other_messages"
+)
+
+// Columns holds all SQL columns for sharedenumothermessage fields.
+var Columns = []string{
+	FieldID,
+	FieldColor,
+	FieldBackground,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Color defines the type for the "color" enum field.
+type Color string
+
+// Color values.
+const (
+	ColorRed   Color = "red"
+	ColorGreen Color = "green"
+)
+
+func (c Color) String() string {
+	return string(c)
+}
+
+// ColorValidator is a validator for the "color" field enum values. It is called by the builders before save.
+func ColorValidator(c Color) error {
+	switch c {
+	case ColorRed, ColorGreen:
+		return nil
+	default:
+		return fmt.Errorf("sharedenumothermessage: invalid enum value for color field: %q", c)
+	}
+}
+
+// Background defines the type for the "background" enum field.
+type Background string
+
+// Background values.
+const (
+	BackgroundRed   Background = "red"
+	BackgroundGreen Background = "green"
+)
+
+func (b Background) String() string {
+	return string(b)
+}
+
+// BackgroundValidator is a validator for the "background" field enum values. It is called by the builders before save.
+func BackgroundValidator(b Background) error {
+	switch b {
+	case BackgroundRed, BackgroundGreen:
+		return nil
+	default:
+		return fmt.Errorf("sharedenumothermessage: invalid enum value for background field: %q", b)
+	}
+}
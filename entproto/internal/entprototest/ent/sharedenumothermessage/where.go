@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sharedenumothermessage
+
+import (
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SharedEnumOtherMessage {
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SharedEnumOtherMessage {
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SharedEnumOtherMessage {
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SharedEnumOtherMessage {
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SharedEnumOtherMessage {
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SharedEnumOtherMessage {
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SharedEnumOtherMessage {
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SharedEnumOtherMessage {
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SharedEnumOtherMessage {
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// ColorEQ applies the EQ predicate on the "color" field.
+func ColorEQ(v Color) predicate.SharedEnumOtherMessage {
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldColor), v))
+	})
+}
+
+// ColorNEQ applies the NEQ predicate on the "color" field.
+func ColorNEQ(v Color) predicate.SharedEnumOtherMessage {
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldColor), v))
+	})
+}
+
+// ColorIn applies the In predicate on the "color" field.
+func ColorIn(vs ...Color) predicate.SharedEnumOtherMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldColor), v...))
+	})
+}
+
+// ColorNotIn applies the NotIn predicate on the "color" field.
+func ColorNotIn(vs ...Color) predicate.SharedEnumOtherMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldColor), v...))
+	})
+}
+
+// BackgroundEQ applies the EQ predicate on the "background" field.
+func BackgroundEQ(v Background) predicate.SharedEnumOtherMessage {
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBackground), v))
+	})
+}
+
+// BackgroundNEQ applies the NEQ predicate on the "background" field.
+func BackgroundNEQ(v Background) predicate.SharedEnumOtherMessage {
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBackground), v))
+	})
+}
+
+// BackgroundIn applies the In predicate on the "background" field.
+func BackgroundIn(vs ...Background) predicate.SharedEnumOtherMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldBackground), v...))
+	})
+}
+
+// BackgroundNotIn applies the NotIn predicate on the "background" field.
+func BackgroundNotIn(vs ...Background) predicate.SharedEnumOtherMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldBackground), v...))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SharedEnumOtherMessage) predicate.SharedEnumOtherMessage {
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SharedEnumOtherMessage) predicate.SharedEnumOtherMessage {
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SharedEnumOtherMessage) predicate.SharedEnumOtherMessage {
+	return predicate.SharedEnumOtherMessage(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenumothermessage"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SharedEnumOtherMessageCreate is the builder for creating a SharedEnumOtherMessage entity.
+type SharedEnumOtherMessageCreate struct {
+	config
+	mutation *SharedEnumOtherMessageMutation
+	hooks    []Hook
+}
+
+// SetColor sets the "color" field.
+func (seomc *SharedEnumOtherMessageCreate) SetColor(s sharedenumothermessage.Color) *SharedEnumOtherMessageCreate {
+	seomc.mutation.SetColor(s)
+	return seomc
+}
+
+// SetBackground sets the "background" field.
+func (seomc *SharedEnumOtherMessageCreate) SetBackground(s sharedenumothermessage.Background) *SharedEnumOtherMessageCreate {
+	seomc.mutation.SetBackground(s)
+	return seomc
+}
+
+// Mutation returns the SharedEnumOtherMessageMutation object of the builder.
+func (seomc *SharedEnumOtherMessageCreate) Mutation() *SharedEnumOtherMessageMutation {
+	return seomc.mutation
+}
+
+// Save creates the SharedEnumOtherMessage in the database.
+func (seomc *SharedEnumOtherMessageCreate) Save(ctx context.Context) (*SharedEnumOtherMessage, error) {
+	var (
+		err  error
+		node *SharedEnumOtherMessage
+	)
+	if len(seomc.hooks) == 0 {
+		if err = seomc.check(); err != nil {
+			return nil, err
+		}
+		node, err = seomc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SharedEnumOtherMessageMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = seomc.check(); err != nil {
+				return nil, err
+			}
+			seomc.mutation = mutation
+			if node, err = seomc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(seomc.hooks) - 1; i >= 0; i-- {
+			if seomc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = seomc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, seomc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*SharedEnumOtherMessage)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from SharedEnumOtherMessageMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (seomc *SharedEnumOtherMessageCreate) SaveX(ctx context.Context) *SharedEnumOtherMessage {
+	v, err := seomc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (seomc *SharedEnumOtherMessageCreate) Exec(ctx context.Context) error {
+	_, err := seomc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seomc *SharedEnumOtherMessageCreate) ExecX(ctx context.Context) {
+	if err := seomc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (seomc *SharedEnumOtherMessageCreate) check() error {
+	if _, ok := seomc.mutation.Color(); !ok {
+		return &ValidationError{Name: "color", err: errors.New(`ent: missing required field "SharedEnumOtherMessage.color"`)}
+	}
+	if v, ok := seomc.mutation.Color(); ok {
+		if err := sharedenumothermessage.ColorValidator(v); err != nil {
+			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "SharedEnumOtherMessage.color": %w`, err)}
+		}
+	}
+	if _, ok := seomc.mutation.Background(); !ok {
+		return &ValidationError{Name: "background", err: errors.New(`ent: missing required field "SharedEnumOtherMessage.background"`)}
+	}
+	if v, ok := seomc.mutation.Background(); ok {
+		if err := sharedenumothermessage.BackgroundValidator(v); err != nil {
+			return &ValidationError{Name: "background", err: fmt.Errorf(`ent: validator failed for field "SharedEnumOtherMessage.background": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (seomc *SharedEnumOtherMessageCreate) sqlSave(ctx context.Context) (*SharedEnumOtherMessage, error) {
+	_node, _spec := seomc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, seomc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (seomc *SharedEnumOtherMessageCreate) createSpec() (*SharedEnumOtherMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SharedEnumOtherMessage{config: seomc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: sharedenumothermessage.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharedenumothermessage.FieldID,
+			},
+		}
+	)
+	if value, ok := seomc.mutation.Color(); ok {
+		_spec.SetField(sharedenumothermessage.FieldColor, field.TypeEnum, value)
+		_node.Color = value
+	}
+	if value, ok := seomc.mutation.Background(); ok {
+		_spec.SetField(sharedenumothermessage.FieldBackground, field.TypeEnum, value)
+		_node.Background = value
+	}
+	return _node, _spec
+}
+
+// SharedEnumOtherMessageCreateBulk is the builder for creating many SharedEnumOtherMessage entities in bulk.
+type SharedEnumOtherMessageCreateBulk struct {
+	config
+	builders []*SharedEnumOtherMessageCreate
+}
+
+// Save creates the SharedEnumOtherMessage entities in the database.
+func (seomcb *SharedEnumOtherMessageCreateBulk) Save(ctx context.Context) ([]*SharedEnumOtherMessage, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(seomcb.builders))
+	nodes := make([]*SharedEnumOtherMessage, len(seomcb.builders))
+	mutators := make([]Mutator, len(seomcb.builders))
+	for i := range seomcb.builders {
+		func(i int, root context.Context) {
+			builder := seomcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SharedEnumOtherMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, seomcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, seomcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, seomcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (seomcb *SharedEnumOtherMessageCreateBulk) SaveX(ctx context.Context) []*SharedEnumOtherMessage {
+	v, err := seomcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (seomcb *SharedEnumOtherMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := seomcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seomcb *SharedEnumOtherMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := seomcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenumothermessage"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SharedEnumOtherMessageDelete is the builder for deleting a SharedEnumOtherMessage entity.
+type SharedEnumOtherMessageDelete struct {
+	config
+	hooks    []Hook
+	mutation *SharedEnumOtherMessageMutation
+}
+
+// Where appends a list predicates to the SharedEnumOtherMessageDelete builder.
+func (seomd *SharedEnumOtherMessageDelete) Where(ps ...predicate.SharedEnumOtherMessage) *SharedEnumOtherMessageDelete {
+	seomd.mutation.Where(ps...)
+	return seomd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (seomd *SharedEnumOtherMessageDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(seomd.hooks) == 0 {
+		affected, err = seomd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SharedEnumOtherMessageMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			seomd.mutation = mutation
+			affected, err = seomd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(seomd.hooks) - 1; i >= 0; i-- {
+			if seomd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = seomd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, seomd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seomd *SharedEnumOtherMessageDelete) ExecX(ctx context.Context) int {
+	n, err := seomd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (seomd *SharedEnumOtherMessageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: sharedenumothermessage.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharedenumothermessage.FieldID,
+			},
+		},
+	}
+	if ps := seomd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, seomd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// SharedEnumOtherMessageDeleteOne is the builder for deleting a single SharedEnumOtherMessage entity.
+type SharedEnumOtherMessageDeleteOne struct {
+	seomd *SharedEnumOtherMessageDelete
+}
+
+// Exec executes the deletion query.
+func (seomdo *SharedEnumOtherMessageDeleteOne) Exec(ctx context.Context) error {
+	n, err := seomdo.seomd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{sharedenumothermessage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seomdo *SharedEnumOtherMessageDeleteOne) ExecX(ctx context.Context) {
+	seomdo.seomd.ExecX(ctx)
+}
@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenumothermessage"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SharedEnumOtherMessageQuery is the builder for querying SharedEnumOtherMessage entities.
+type SharedEnumOtherMessageQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.SharedEnumOtherMessage
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SharedEnumOtherMessageQuery builder.
+func (seomq *SharedEnumOtherMessageQuery) Where(ps ...predicate.SharedEnumOtherMessage) *SharedEnumOtherMessageQuery {
+	seomq.predicates = append(seomq.predicates, ps...)
+	return seomq
+}
+
+// Limit adds a limit step to the query.
+func (seomq *SharedEnumOtherMessageQuery) Limit(limit int) *SharedEnumOtherMessageQuery {
+	seomq.limit = &limit
+	return seomq
+}
+
+// Offset adds an offset step to the query.
+func (seomq *SharedEnumOtherMessageQuery) Offset(offset int) *SharedEnumOtherMessageQuery {
+	seomq.offset = &offset
+	return seomq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (seomq *SharedEnumOtherMessageQuery) Unique(unique bool) *SharedEnumOtherMessageQuery {
+	seomq.unique = &unique
+	return seomq
+}
+
+// Order adds an order step to the query.
+func (seomq *SharedEnumOtherMessageQuery) Order(o ...OrderFunc) *SharedEnumOtherMessageQuery {
+	seomq.order = append(seomq.order, o...)
+	return seomq
+}
+
+// First returns the first SharedEnumOtherMessage entity from the query.
+// Returns a *NotFoundError when no SharedEnumOtherMessage was found.
+func (seomq *SharedEnumOtherMessageQuery) First(ctx context.Context) (*SharedEnumOtherMessage, error) {
+	nodes, err := seomq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{sharedenumothermessage.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (seomq *SharedEnumOtherMessageQuery) FirstX(ctx context.Context) *SharedEnumOtherMessage {
+	node, err := seomq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SharedEnumOtherMessage ID from the query.
+// Returns a *NotFoundError when no SharedEnumOtherMessage ID was found.
+func (seomq *SharedEnumOtherMessageQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = seomq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{sharedenumothermessage.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (seomq *SharedEnumOtherMessageQuery) FirstIDX(ctx context.Context) int {
+	id, err := seomq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SharedEnumOtherMessage entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SharedEnumOtherMessage entity is found.
+// Returns a *NotFoundError when no SharedEnumOtherMessage entities are found.
+func (seomq *SharedEnumOtherMessageQuery) Only(ctx context.Context) (*SharedEnumOtherMessage, error) {
+	nodes, err := seomq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{sharedenumothermessage.Label}
+	default:
+		return nil, &NotSingularError{sharedenumothermessage.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (seomq *SharedEnumOtherMessageQuery) OnlyX(ctx context.Context) *SharedEnumOtherMessage {
+	node, err := seomq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SharedEnumOtherMessage ID in the query.
+// Returns a *NotSingularError when more than one SharedEnumOtherMessage ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (seomq *SharedEnumOtherMessageQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = seomq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{sharedenumothermessage.Label}
+	default:
+		err = &NotSingularError{sharedenumothermessage.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (seomq *SharedEnumOtherMessageQuery) OnlyIDX(ctx context.Context) int {
+	id, err := seomq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SharedEnumOtherMessages.
+func (seomq *SharedEnumOtherMessageQuery) All(ctx context.Context) ([]*SharedEnumOtherMessage, error) {
+	if err := seomq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return seomq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (seomq *SharedEnumOtherMessageQuery) AllX(ctx context.Context) []*SharedEnumOtherMessage {
+	nodes, err := seomq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SharedEnumOtherMessage IDs.
+func (seomq *SharedEnumOtherMessageQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := seomq.Select(sharedenumothermessage.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (seomq *SharedEnumOtherMessageQuery) IDsX(ctx context.Context) []int {
+	ids, err := seomq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (seomq *SharedEnumOtherMessageQuery) Count(ctx context.Context) (int, error) {
+	if err := seomq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return seomq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (seomq *SharedEnumOtherMessageQuery) CountX(ctx context.Context) int {
+	count, err := seomq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (seomq *SharedEnumOtherMessageQuery) Exist(ctx context.Context) (bool, error) {
+	if err := seomq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return seomq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (seomq *SharedEnumOtherMessageQuery) ExistX(ctx context.Context) bool {
+	exist, err := seomq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SharedEnumOtherMessageQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (seomq *SharedEnumOtherMessageQuery) Clone() *SharedEnumOtherMessageQuery {
+	if seomq == nil {
+		return nil
+	}
+	return &SharedEnumOtherMessageQuery{
+		config:     seomq.config,
+		limit:      seomq.limit,
+		offset:     seomq.offset,
+		order:      append([]OrderFunc{}, seomq.order...),
+		predicates: append([]predicate.SharedEnumOtherMessage{}, seomq.predicates...),
+		// clone intermediate query.
+		sql:    seomq.sql.Clone(),
+		path:   seomq.path,
+		unique: seomq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Color sharedenumothermessage.Color `json:"color,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SharedEnumOtherMessage.Query().
+//		GroupBy(sharedenumothermessage.FieldColor).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (seomq *SharedEnumOtherMessageQuery) GroupBy(field string, fields ...string) *SharedEnumOtherMessageGroupBy {
+	grbuild := &SharedEnumOtherMessageGroupBy{config: seomq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := seomq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return seomq.sqlQuery(ctx), nil
+	}
+	grbuild.label = sharedenumothermessage.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Color sharedenumothermessage.Color `json:"color,omitempty"`
+//	}
+//
+//	client.SharedEnumOtherMessage.Query().
+//		Select(sharedenumothermessage.FieldColor).
+//		Scan(ctx, &v)
+func (seomq *SharedEnumOtherMessageQuery) Select(fields ...string) *SharedEnumOtherMessageSelect {
+	seomq.fields = append(seomq.fields, fields...)
+	selbuild := &SharedEnumOtherMessageSelect{SharedEnumOtherMessageQuery: seomq}
+	selbuild.label = sharedenumothermessage.Label
+	selbuild.flds, selbuild.scan = &seomq.fields, selbuild.Scan
+	return selbuild
+}
+
+// Aggregate returns a SharedEnumOtherMessageSelect configured with the given aggregations.
+func (seomq *SharedEnumOtherMessageQuery) Aggregate(fns ...AggregateFunc) *SharedEnumOtherMessageSelect {
+	return seomq.Select().Aggregate(fns...)
+}
+
+func (seomq *SharedEnumOtherMessageQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range seomq.fields {
+		if !sharedenumothermessage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if seomq.path != nil {
+		prev, err := seomq.path(ctx)
+		if err != nil {
+			return err
+		}
+		seomq.sql = prev
+	}
+	return nil
+}
+
+func (seomq *SharedEnumOtherMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SharedEnumOtherMessage, error) {
+	var (
+		nodes = []*SharedEnumOtherMessage{}
+		_spec = seomq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SharedEnumOtherMessage).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SharedEnumOtherMessage{config: seomq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, seomq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (seomq *SharedEnumOtherMessageQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := seomq.querySpec()
+	_spec.Node.Columns = seomq.fields
+	if len(seomq.fields) > 0 {
+		_spec.Unique = seomq.unique != nil && *seomq.unique
+	}
+	return sqlgraph.CountNodes(ctx, seomq.driver, _spec)
+}
+
+func (seomq *SharedEnumOtherMessageQuery) sqlExist(ctx context.Context) (bool, error) {
+	switch _, err := seomq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+func (seomq *SharedEnumOtherMessageQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sharedenumothermessage.Table,
+			Columns: sharedenumothermessage.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharedenumothermessage.FieldID,
+			},
+		},
+		From:   seomq.sql,
+		Unique: true,
+	}
+	if unique := seomq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := seomq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharedenumothermessage.FieldID)
+		for i := range fields {
+			if fields[i] != sharedenumothermessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := seomq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := seomq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := seomq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := seomq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (seomq *SharedEnumOtherMessageQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(seomq.driver.Dialect())
+	t1 := builder.Table(sharedenumothermessage.Table)
+	columns := seomq.fields
+	if len(columns) == 0 {
+		columns = sharedenumothermessage.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if seomq.sql != nil {
+		selector = seomq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if seomq.unique != nil && *seomq.unique {
+		selector.Distinct()
+	}
+	for _, p := range seomq.predicates {
+		p(selector)
+	}
+	for _, p := range seomq.order {
+		p(selector)
+	}
+	if offset := seomq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := seomq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SharedEnumOtherMessageGroupBy is the group-by builder for SharedEnumOtherMessage entities.
+type SharedEnumOtherMessageGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (seomgb *SharedEnumOtherMessageGroupBy) Aggregate(fns ...AggregateFunc) *SharedEnumOtherMessageGroupBy {
+	seomgb.fns = append(seomgb.fns, fns...)
+	return seomgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (seomgb *SharedEnumOtherMessageGroupBy) Scan(ctx context.Context, v any) error {
+	query, err := seomgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	seomgb.sql = query
+	return seomgb.sqlScan(ctx, v)
+}
+
+func (seomgb *SharedEnumOtherMessageGroupBy) sqlScan(ctx context.Context, v any) error {
+	for _, f := range seomgb.fields {
+		if !sharedenumothermessage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := seomgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := seomgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (seomgb *SharedEnumOtherMessageGroupBy) sqlQuery() *sql.Selector {
+	selector := seomgb.sql.Select()
+	aggregation := make([]string, 0, len(seomgb.fns))
+	for _, fn := range seomgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(seomgb.fields)+len(seomgb.fns))
+		for _, f := range seomgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(seomgb.fields...)...)
+}
+
+// SharedEnumOtherMessageSelect is the builder for selecting fields of SharedEnumOtherMessage entities.
+type SharedEnumOtherMessageSelect struct {
+	*SharedEnumOtherMessageQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (seoms *SharedEnumOtherMessageSelect) Aggregate(fns ...AggregateFunc) *SharedEnumOtherMessageSelect {
+	seoms.fns = append(seoms.fns, fns...)
+	return seoms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (seoms *SharedEnumOtherMessageSelect) Scan(ctx context.Context, v any) error {
+	if err := seoms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	seoms.sql = seoms.SharedEnumOtherMessageQuery.sqlQuery(ctx)
+	return seoms.sqlScan(ctx, v)
+}
+
+func (seoms *SharedEnumOtherMessageSelect) sqlScan(ctx context.Context, v any) error {
+	aggregation := make([]string, 0, len(seoms.fns))
+	for _, fn := range seoms.fns {
+		aggregation = append(aggregation, fn(seoms.sql))
+	}
+	switch n := len(*seoms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		seoms.sql.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		seoms.sql.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := seoms.sql.Query()
+	if err := seoms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenumothermessage"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SharedEnumOtherMessageUpdate is the builder for updating SharedEnumOtherMessage entities.
+type SharedEnumOtherMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SharedEnumOtherMessageMutation
+}
+
+// Where appends a list predicates to the SharedEnumOtherMessageUpdate builder.
+func (seomu *SharedEnumOtherMessageUpdate) Where(ps ...predicate.SharedEnumOtherMessage) *SharedEnumOtherMessageUpdate {
+	seomu.mutation.Where(ps...)
+	return seomu
+}
+
+// SetColor sets the "color" field.
+func (seomu *SharedEnumOtherMessageUpdate) SetColor(s sharedenumothermessage.Color) *SharedEnumOtherMessageUpdate {
+	seomu.mutation.SetColor(s)
+	return seomu
+}
+
+// SetBackground sets the "background" field.
+func (seomu *SharedEnumOtherMessageUpdate) SetBackground(s sharedenumothermessage.Background) *SharedEnumOtherMessageUpdate {
+	seomu.mutation.SetBackground(s)
+	return seomu
+}
+
+// Mutation returns the SharedEnumOtherMessageMutation object of the builder.
+func (seomu *SharedEnumOtherMessageUpdate) Mutation() *SharedEnumOtherMessageMutation {
+	return seomu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (seomu *SharedEnumOtherMessageUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(seomu.hooks) == 0 {
+		if err = seomu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = seomu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SharedEnumOtherMessageMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = seomu.check(); err != nil {
+				return 0, err
+			}
+			seomu.mutation = mutation
+			affected, err = seomu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(seomu.hooks) - 1; i >= 0; i-- {
+			if seomu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = seomu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, seomu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (seomu *SharedEnumOtherMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := seomu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (seomu *SharedEnumOtherMessageUpdate) Exec(ctx context.Context) error {
+	_, err := seomu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seomu *SharedEnumOtherMessageUpdate) ExecX(ctx context.Context) {
+	if err := seomu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (seomu *SharedEnumOtherMessageUpdate) check() error {
+	if v, ok := seomu.mutation.Color(); ok {
+		if err := sharedenumothermessage.ColorValidator(v); err != nil {
+			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "SharedEnumOtherMessage.color": %w`, err)}
+		}
+	}
+	if v, ok := seomu.mutation.Background(); ok {
+		if err := sharedenumothermessage.BackgroundValidator(v); err != nil {
+			return &ValidationError{Name: "background", err: fmt.Errorf(`ent: validator failed for field "SharedEnumOtherMessage.background": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (seomu *SharedEnumOtherMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sharedenumothermessage.Table,
+			Columns: sharedenumothermessage.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharedenumothermessage.FieldID,
+			},
+		},
+	}
+	if ps := seomu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := seomu.mutation.Color(); ok {
+		_spec.SetField(sharedenumothermessage.FieldColor, field.TypeEnum, value)
+	}
+	if value, ok := seomu.mutation.Background(); ok {
+		_spec.SetField(sharedenumothermessage.FieldBackground, field.TypeEnum, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, seomu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharedenumothermessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SharedEnumOtherMessageUpdateOne is the builder for updating a single SharedEnumOtherMessage entity.
+type SharedEnumOtherMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SharedEnumOtherMessageMutation
+}
+
+// SetColor sets the "color" field.
+func (seomuo *SharedEnumOtherMessageUpdateOne) SetColor(s sharedenumothermessage.Color) *SharedEnumOtherMessageUpdateOne {
+	seomuo.mutation.SetColor(s)
+	return seomuo
+}
+
+// SetBackground sets the "background" field.
+func (seomuo *SharedEnumOtherMessageUpdateOne) SetBackground(s sharedenumothermessage.Background) *SharedEnumOtherMessageUpdateOne {
+	seomuo.mutation.SetBackground(s)
+	return seomuo
+}
+
+// Mutation returns the SharedEnumOtherMessageMutation object of the builder.
+func (seomuo *SharedEnumOtherMessageUpdateOne) Mutation() *SharedEnumOtherMessageMutation {
+	return seomuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (seomuo *SharedEnumOtherMessageUpdateOne) Select(field string, fields ...string) *SharedEnumOtherMessageUpdateOne {
+	seomuo.fields = append([]string{field}, fields...)
+	return seomuo
+}
+
+// Save executes the query and returns the updated SharedEnumOtherMessage entity.
+func (seomuo *SharedEnumOtherMessageUpdateOne) Save(ctx context.Context) (*SharedEnumOtherMessage, error) {
+	var (
+		err  error
+		node *SharedEnumOtherMessage
+	)
+	if len(seomuo.hooks) == 0 {
+		if err = seomuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = seomuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SharedEnumOtherMessageMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = seomuo.check(); err != nil {
+				return nil, err
+			}
+			seomuo.mutation = mutation
+			node, err = seomuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(seomuo.hooks) - 1; i >= 0; i-- {
+			if seomuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = seomuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, seomuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*SharedEnumOtherMessage)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from SharedEnumOtherMessageMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (seomuo *SharedEnumOtherMessageUpdateOne) SaveX(ctx context.Context) *SharedEnumOtherMessage {
+	node, err := seomuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (seomuo *SharedEnumOtherMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := seomuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seomuo *SharedEnumOtherMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := seomuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (seomuo *SharedEnumOtherMessageUpdateOne) check() error {
+	if v, ok := seomuo.mutation.Color(); ok {
+		if err := sharedenumothermessage.ColorValidator(v); err != nil {
+			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "SharedEnumOtherMessage.color": %w`, err)}
+		}
+	}
+	if v, ok := seomuo.mutation.Background(); ok {
+		if err := sharedenumothermessage.BackgroundValidator(v); err != nil {
+			return &ValidationError{Name: "background", err: fmt.Errorf(`ent: validator failed for field "SharedEnumOtherMessage.background": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (seomuo *SharedEnumOtherMessageUpdateOne) sqlSave(ctx context.Context) (_node *SharedEnumOtherMessage, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sharedenumothermessage.Table,
+			Columns: sharedenumothermessage.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharedenumothermessage.FieldID,
+			},
+		},
+	}
+	id, ok := seomuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SharedEnumOtherMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := seomuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharedenumothermessage.FieldID)
+		for _, f := range fields {
+			if !sharedenumothermessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sharedenumothermessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := seomuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := seomuo.mutation.Color(); ok {
+		_spec.SetField(sharedenumothermessage.FieldColor, field.TypeEnum, value)
+	}
+	if value, ok := seomuo.mutation.Background(); ok {
+		_spec.SetField(sharedenumothermessage.FieldBackground, field.TypeEnum, value)
+	}
+	_node = &SharedEnumOtherMessage{config: seomuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, seomuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharedenumothermessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
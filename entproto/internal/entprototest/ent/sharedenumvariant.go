@@ -0,0 +1,99 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenumvariant"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SharedEnumVariant is the model entity for the SharedEnumVariant schema.
+type SharedEnumVariant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Color holds the value of the "color" field.
+	Color sharedenumvariant.Color `json:"color,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SharedEnumVariant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sharedenumvariant.FieldID:
+			values[i] = new(sql.NullInt64)
+		case sharedenumvariant.FieldColor:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type SharedEnumVariant", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SharedEnumVariant fields.
+func (sev *SharedEnumVariant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sharedenumvariant.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sev.ID = int(value.Int64)
+		case sharedenumvariant.FieldColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field color", values[i])
+			} else if value.Valid {
+				sev.Color = sharedenumvariant.Color(value.String)
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this SharedEnumVariant.
+// Note that you need to call SharedEnumVariant.Unwrap() before calling this method if this SharedEnumVariant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sev *SharedEnumVariant) Update() *SharedEnumVariantUpdateOne {
+	return (&SharedEnumVariantClient{config: sev.config}).UpdateOne(sev)
+}
+
+// Unwrap unwraps the SharedEnumVariant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sev *SharedEnumVariant) Unwrap() *SharedEnumVariant {
+	_tx, ok := sev.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SharedEnumVariant is not a transactional entity")
+	}
+	sev.config.driver = _tx.drv
+	return sev
+}
+
+// String implements the fmt.Stringer.
+func (sev *SharedEnumVariant) String() string {
+	var builder strings.Builder
+	builder.WriteString("SharedEnumVariant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sev.ID))
+	builder.WriteString("color=")
+	builder.WriteString(fmt.Sprintf("%v", sev.Color))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SharedEnumVariants is a parsable slice of SharedEnumVariant.
+type SharedEnumVariants []*SharedEnumVariant
+
+func (sev SharedEnumVariants) config(cfg config) {
+	for _i := range sev {
+		sev[_i].config = cfg
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sharedenumvariant
+
+import (
+	"fmt"
+)
+
+const (
+	// Label holds the string label denoting the sharedenumvariant type in the database.
+	Label = "shared_enum_variant"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldColor holds the string denoting the color field in the database.
+	FieldColor = "color"
+	// Table holds the table name of the sharedenumvariant in the database.
+	Table = "shared_enum_variants"
+)
+
+// Columns holds all SQL columns for sharedenumvariant fields.
+var Columns = []string{
+	FieldID,
+	FieldColor,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Color defines the type for the "color" enum field.
+type Color string
+
+// Color values.
+const (
+	ColorRed  Color = "red"
+	ColorBlue Color = "blue"
+)
+
+func (c Color) String() string {
+	return string(c)
+}
+
+// ColorValidator is a validator for the "color" field enum values. It is called by the builders before save.
+func ColorValidator(c Color) error {
+	switch c {
+	case ColorRed, ColorBlue:
+		return nil
+	default:
+		return fmt.Errorf("sharedenumvariant: invalid enum value for color field: %q", c)
+	}
+}
@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenumvariant"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SharedEnumVariantCreate is the builder for creating a SharedEnumVariant entity.
+type SharedEnumVariantCreate struct {
+	config
+	mutation *SharedEnumVariantMutation
+	hooks    []Hook
+}
+
+// SetColor sets the "color" field.
+func (sevc *SharedEnumVariantCreate) SetColor(s sharedenumvariant.Color) *SharedEnumVariantCreate {
+	sevc.mutation.SetColor(s)
+	return sevc
+}
+
+// Mutation returns the SharedEnumVariantMutation object of the builder.
+func (sevc *SharedEnumVariantCreate) Mutation() *SharedEnumVariantMutation {
+	return sevc.mutation
+}
+
+// Save creates the SharedEnumVariant in the database.
+func (sevc *SharedEnumVariantCreate) Save(ctx context.Context) (*SharedEnumVariant, error) {
+	var (
+		err  error
+		node *SharedEnumVariant
+	)
+	if len(sevc.hooks) == 0 {
+		if err = sevc.check(); err != nil {
+			return nil, err
+		}
+		node, err = sevc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SharedEnumVariantMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sevc.check(); err != nil {
+				return nil, err
+			}
+			sevc.mutation = mutation
+			if node, err = sevc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sevc.hooks) - 1; i >= 0; i-- {
+			if sevc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sevc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, sevc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*SharedEnumVariant)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from SharedEnumVariantMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sevc *SharedEnumVariantCreate) SaveX(ctx context.Context) *SharedEnumVariant {
+	v, err := sevc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sevc *SharedEnumVariantCreate) Exec(ctx context.Context) error {
+	_, err := sevc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sevc *SharedEnumVariantCreate) ExecX(ctx context.Context) {
+	if err := sevc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sevc *SharedEnumVariantCreate) check() error {
+	if _, ok := sevc.mutation.Color(); !ok {
+		return &ValidationError{Name: "color", err: errors.New(`ent: missing required field "SharedEnumVariant.color"`)}
+	}
+	if v, ok := sevc.mutation.Color(); ok {
+		if err := sharedenumvariant.ColorValidator(v); err != nil {
+			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "SharedEnumVariant.color": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sevc *SharedEnumVariantCreate) sqlSave(ctx context.Context) (*SharedEnumVariant, error) {
+	_node, _spec := sevc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sevc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (sevc *SharedEnumVariantCreate) createSpec() (*SharedEnumVariant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SharedEnumVariant{config: sevc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: sharedenumvariant.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharedenumvariant.FieldID,
+			},
+		}
+	)
+	if value, ok := sevc.mutation.Color(); ok {
+		_spec.SetField(sharedenumvariant.FieldColor, field.TypeEnum, value)
+		_node.Color = value
+	}
+	return _node, _spec
+}
+
+// SharedEnumVariantCreateBulk is the builder for creating many SharedEnumVariant entities in bulk.
+type SharedEnumVariantCreateBulk struct {
+	config
+	builders []*SharedEnumVariantCreate
+}
+
+// Save creates the SharedEnumVariant entities in the database.
+func (sevcb *SharedEnumVariantCreateBulk) Save(ctx context.Context) ([]*SharedEnumVariant, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(sevcb.builders))
+	nodes := make([]*SharedEnumVariant, len(sevcb.builders))
+	mutators := make([]Mutator, len(sevcb.builders))
+	for i := range sevcb.builders {
+		func(i int, root context.Context) {
+			builder := sevcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SharedEnumVariantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sevcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sevcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sevcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sevcb *SharedEnumVariantCreateBulk) SaveX(ctx context.Context) []*SharedEnumVariant {
+	v, err := sevcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sevcb *SharedEnumVariantCreateBulk) Exec(ctx context.Context) error {
+	_, err := sevcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sevcb *SharedEnumVariantCreateBulk) ExecX(ctx context.Context) {
+	if err := sevcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenumvariant"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SharedEnumVariantDelete is the builder for deleting a SharedEnumVariant entity.
+type SharedEnumVariantDelete struct {
+	config
+	hooks    []Hook
+	mutation *SharedEnumVariantMutation
+}
+
+// Where appends a list predicates to the SharedEnumVariantDelete builder.
+func (sevd *SharedEnumVariantDelete) Where(ps ...predicate.SharedEnumVariant) *SharedEnumVariantDelete {
+	sevd.mutation.Where(ps...)
+	return sevd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sevd *SharedEnumVariantDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(sevd.hooks) == 0 {
+		affected, err = sevd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SharedEnumVariantMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			sevd.mutation = mutation
+			affected, err = sevd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(sevd.hooks) - 1; i >= 0; i-- {
+			if sevd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sevd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sevd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sevd *SharedEnumVariantDelete) ExecX(ctx context.Context) int {
+	n, err := sevd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sevd *SharedEnumVariantDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: sharedenumvariant.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharedenumvariant.FieldID,
+			},
+		},
+	}
+	if ps := sevd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sevd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// SharedEnumVariantDeleteOne is the builder for deleting a single SharedEnumVariant entity.
+type SharedEnumVariantDeleteOne struct {
+	sevd *SharedEnumVariantDelete
+}
+
+// Exec executes the deletion query.
+func (sevdo *SharedEnumVariantDeleteOne) Exec(ctx context.Context) error {
+	n, err := sevdo.sevd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{sharedenumvariant.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sevdo *SharedEnumVariantDeleteOne) ExecX(ctx context.Context) {
+	sevdo.sevd.ExecX(ctx)
+}
@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenumvariant"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SharedEnumVariantQuery is the builder for querying SharedEnumVariant entities.
+type SharedEnumVariantQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.SharedEnumVariant
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SharedEnumVariantQuery builder.
+func (sevq *SharedEnumVariantQuery) Where(ps ...predicate.SharedEnumVariant) *SharedEnumVariantQuery {
+	sevq.predicates = append(sevq.predicates, ps...)
+	return sevq
+}
+
+// Limit adds a limit step to the query.
+func (sevq *SharedEnumVariantQuery) Limit(limit int) *SharedEnumVariantQuery {
+	sevq.limit = &limit
+	return sevq
+}
+
+// Offset adds an offset step to the query.
+func (sevq *SharedEnumVariantQuery) Offset(offset int) *SharedEnumVariantQuery {
+	sevq.offset = &offset
+	return sevq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (sevq *SharedEnumVariantQuery) Unique(unique bool) *SharedEnumVariantQuery {
+	sevq.unique = &unique
+	return sevq
+}
+
+// Order adds an order step to the query.
+func (sevq *SharedEnumVariantQuery) Order(o ...OrderFunc) *SharedEnumVariantQuery {
+	sevq.order = append(sevq.order, o...)
+	return sevq
+}
+
+// First returns the first SharedEnumVariant entity from the query.
+// Returns a *NotFoundError when no SharedEnumVariant was found.
+func (sevq *SharedEnumVariantQuery) First(ctx context.Context) (*SharedEnumVariant, error) {
+	nodes, err := sevq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{sharedenumvariant.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (sevq *SharedEnumVariantQuery) FirstX(ctx context.Context) *SharedEnumVariant {
+	node, err := sevq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SharedEnumVariant ID from the query.
+// Returns a *NotFoundError when no SharedEnumVariant ID was found.
+func (sevq *SharedEnumVariantQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = sevq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{sharedenumvariant.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (sevq *SharedEnumVariantQuery) FirstIDX(ctx context.Context) int {
+	id, err := sevq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SharedEnumVariant entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SharedEnumVariant entity is found.
+// Returns a *NotFoundError when no SharedEnumVariant entities are found.
+func (sevq *SharedEnumVariantQuery) Only(ctx context.Context) (*SharedEnumVariant, error) {
+	nodes, err := sevq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{sharedenumvariant.Label}
+	default:
+		return nil, &NotSingularError{sharedenumvariant.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (sevq *SharedEnumVariantQuery) OnlyX(ctx context.Context) *SharedEnumVariant {
+	node, err := sevq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SharedEnumVariant ID in the query.
+// Returns a *NotSingularError when more than one SharedEnumVariant ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (sevq *SharedEnumVariantQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = sevq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{sharedenumvariant.Label}
+	default:
+		err = &NotSingularError{sharedenumvariant.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (sevq *SharedEnumVariantQuery) OnlyIDX(ctx context.Context) int {
+	id, err := sevq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SharedEnumVariants.
+func (sevq *SharedEnumVariantQuery) All(ctx context.Context) ([]*SharedEnumVariant, error) {
+	if err := sevq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return sevq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sevq *SharedEnumVariantQuery) AllX(ctx context.Context) []*SharedEnumVariant {
+	nodes, err := sevq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SharedEnumVariant IDs.
+func (sevq *SharedEnumVariantQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := sevq.Select(sharedenumvariant.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (sevq *SharedEnumVariantQuery) IDsX(ctx context.Context) []int {
+	ids, err := sevq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (sevq *SharedEnumVariantQuery) Count(ctx context.Context) (int, error) {
+	if err := sevq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return sevq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (sevq *SharedEnumVariantQuery) CountX(ctx context.Context) int {
+	count, err := sevq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (sevq *SharedEnumVariantQuery) Exist(ctx context.Context) (bool, error) {
+	if err := sevq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return sevq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (sevq *SharedEnumVariantQuery) ExistX(ctx context.Context) bool {
+	exist, err := sevq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SharedEnumVariantQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (sevq *SharedEnumVariantQuery) Clone() *SharedEnumVariantQuery {
+	if sevq == nil {
+		return nil
+	}
+	return &SharedEnumVariantQuery{
+		config:     sevq.config,
+		limit:      sevq.limit,
+		offset:     sevq.offset,
+		order:      append([]OrderFunc{}, sevq.order...),
+		predicates: append([]predicate.SharedEnumVariant{}, sevq.predicates...),
+		// clone intermediate query.
+		sql:    sevq.sql.Clone(),
+		path:   sevq.path,
+		unique: sevq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Color sharedenumvariant.Color `json:"color,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SharedEnumVariant.Query().
+//		GroupBy(sharedenumvariant.FieldColor).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (sevq *SharedEnumVariantQuery) GroupBy(field string, fields ...string) *SharedEnumVariantGroupBy {
+	grbuild := &SharedEnumVariantGroupBy{config: sevq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := sevq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return sevq.sqlQuery(ctx), nil
+	}
+	grbuild.label = sharedenumvariant.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Color sharedenumvariant.Color `json:"color,omitempty"`
+//	}
+//
+//	client.SharedEnumVariant.Query().
+//		Select(sharedenumvariant.FieldColor).
+//		Scan(ctx, &v)
+func (sevq *SharedEnumVariantQuery) Select(fields ...string) *SharedEnumVariantSelect {
+	sevq.fields = append(sevq.fields, fields...)
+	selbuild := &SharedEnumVariantSelect{SharedEnumVariantQuery: sevq}
+	selbuild.label = sharedenumvariant.Label
+	selbuild.flds, selbuild.scan = &sevq.fields, selbuild.Scan
+	return selbuild
+}
+
+// Aggregate returns a SharedEnumVariantSelect configured with the given aggregations.
+func (sevq *SharedEnumVariantQuery) Aggregate(fns ...AggregateFunc) *SharedEnumVariantSelect {
+	return sevq.Select().Aggregate(fns...)
+}
+
+func (sevq *SharedEnumVariantQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range sevq.fields {
+		if !sharedenumvariant.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if sevq.path != nil {
+		prev, err := sevq.path(ctx)
+		if err != nil {
+			return err
+		}
+		sevq.sql = prev
+	}
+	return nil
+}
+
+func (sevq *SharedEnumVariantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SharedEnumVariant, error) {
+	var (
+		nodes = []*SharedEnumVariant{}
+		_spec = sevq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SharedEnumVariant).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SharedEnumVariant{config: sevq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, sevq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (sevq *SharedEnumVariantQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := sevq.querySpec()
+	_spec.Node.Columns = sevq.fields
+	if len(sevq.fields) > 0 {
+		_spec.Unique = sevq.unique != nil && *sevq.unique
+	}
+	return sqlgraph.CountNodes(ctx, sevq.driver, _spec)
+}
+
+func (sevq *SharedEnumVariantQuery) sqlExist(ctx context.Context) (bool, error) {
+	switch _, err := sevq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+func (sevq *SharedEnumVariantQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sharedenumvariant.Table,
+			Columns: sharedenumvariant.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharedenumvariant.FieldID,
+			},
+		},
+		From:   sevq.sql,
+		Unique: true,
+	}
+	if unique := sevq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := sevq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharedenumvariant.FieldID)
+		for i := range fields {
+			if fields[i] != sharedenumvariant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := sevq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := sevq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := sevq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := sevq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (sevq *SharedEnumVariantQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(sevq.driver.Dialect())
+	t1 := builder.Table(sharedenumvariant.Table)
+	columns := sevq.fields
+	if len(columns) == 0 {
+		columns = sharedenumvariant.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if sevq.sql != nil {
+		selector = sevq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if sevq.unique != nil && *sevq.unique {
+		selector.Distinct()
+	}
+	for _, p := range sevq.predicates {
+		p(selector)
+	}
+	for _, p := range sevq.order {
+		p(selector)
+	}
+	if offset := sevq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := sevq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SharedEnumVariantGroupBy is the group-by builder for SharedEnumVariant entities.
+type SharedEnumVariantGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sevgb *SharedEnumVariantGroupBy) Aggregate(fns ...AggregateFunc) *SharedEnumVariantGroupBy {
+	sevgb.fns = append(sevgb.fns, fns...)
+	return sevgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (sevgb *SharedEnumVariantGroupBy) Scan(ctx context.Context, v any) error {
+	query, err := sevgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	sevgb.sql = query
+	return sevgb.sqlScan(ctx, v)
+}
+
+func (sevgb *SharedEnumVariantGroupBy) sqlScan(ctx context.Context, v any) error {
+	for _, f := range sevgb.fields {
+		if !sharedenumvariant.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := sevgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sevgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (sevgb *SharedEnumVariantGroupBy) sqlQuery() *sql.Selector {
+	selector := sevgb.sql.Select()
+	aggregation := make([]string, 0, len(sevgb.fns))
+	for _, fn := range sevgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(sevgb.fields)+len(sevgb.fns))
+		for _, f := range sevgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(sevgb.fields...)...)
+}
+
+// SharedEnumVariantSelect is the builder for selecting fields of SharedEnumVariant entities.
+type SharedEnumVariantSelect struct {
+	*SharedEnumVariantQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sevs *SharedEnumVariantSelect) Aggregate(fns ...AggregateFunc) *SharedEnumVariantSelect {
+	sevs.fns = append(sevs.fns, fns...)
+	return sevs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sevs *SharedEnumVariantSelect) Scan(ctx context.Context, v any) error {
+	if err := sevs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	sevs.sql = sevs.SharedEnumVariantQuery.sqlQuery(ctx)
+	return sevs.sqlScan(ctx, v)
+}
+
+func (sevs *SharedEnumVariantSelect) sqlScan(ctx context.Context, v any) error {
+	aggregation := make([]string, 0, len(sevs.fns))
+	for _, fn := range sevs.fns {
+		aggregation = append(aggregation, fn(sevs.sql))
+	}
+	switch n := len(*sevs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		sevs.sql.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		sevs.sql.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := sevs.sql.Query()
+	if err := sevs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/sharedenumvariant"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SharedEnumVariantUpdate is the builder for updating SharedEnumVariant entities.
+type SharedEnumVariantUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SharedEnumVariantMutation
+}
+
+// Where appends a list predicates to the SharedEnumVariantUpdate builder.
+func (sevu *SharedEnumVariantUpdate) Where(ps ...predicate.SharedEnumVariant) *SharedEnumVariantUpdate {
+	sevu.mutation.Where(ps...)
+	return sevu
+}
+
+// SetColor sets the "color" field.
+func (sevu *SharedEnumVariantUpdate) SetColor(s sharedenumvariant.Color) *SharedEnumVariantUpdate {
+	sevu.mutation.SetColor(s)
+	return sevu
+}
+
+// Mutation returns the SharedEnumVariantMutation object of the builder.
+func (sevu *SharedEnumVariantUpdate) Mutation() *SharedEnumVariantMutation {
+	return sevu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sevu *SharedEnumVariantUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(sevu.hooks) == 0 {
+		if err = sevu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = sevu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SharedEnumVariantMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sevu.check(); err != nil {
+				return 0, err
+			}
+			sevu.mutation = mutation
+			affected, err = sevu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(sevu.hooks) - 1; i >= 0; i-- {
+			if sevu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sevu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sevu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sevu *SharedEnumVariantUpdate) SaveX(ctx context.Context) int {
+	affected, err := sevu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sevu *SharedEnumVariantUpdate) Exec(ctx context.Context) error {
+	_, err := sevu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sevu *SharedEnumVariantUpdate) ExecX(ctx context.Context) {
+	if err := sevu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sevu *SharedEnumVariantUpdate) check() error {
+	if v, ok := sevu.mutation.Color(); ok {
+		if err := sharedenumvariant.ColorValidator(v); err != nil {
+			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "SharedEnumVariant.color": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sevu *SharedEnumVariantUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sharedenumvariant.Table,
+			Columns: sharedenumvariant.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharedenumvariant.FieldID,
+			},
+		},
+	}
+	if ps := sevu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sevu.mutation.Color(); ok {
+		_spec.SetField(sharedenumvariant.FieldColor, field.TypeEnum, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sevu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharedenumvariant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SharedEnumVariantUpdateOne is the builder for updating a single SharedEnumVariant entity.
+type SharedEnumVariantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SharedEnumVariantMutation
+}
+
+// SetColor sets the "color" field.
+func (sevuo *SharedEnumVariantUpdateOne) SetColor(s sharedenumvariant.Color) *SharedEnumVariantUpdateOne {
+	sevuo.mutation.SetColor(s)
+	return sevuo
+}
+
+// Mutation returns the SharedEnumVariantMutation object of the builder.
+func (sevuo *SharedEnumVariantUpdateOne) Mutation() *SharedEnumVariantMutation {
+	return sevuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sevuo *SharedEnumVariantUpdateOne) Select(field string, fields ...string) *SharedEnumVariantUpdateOne {
+	sevuo.fields = append([]string{field}, fields...)
+	return sevuo
+}
+
+// Save executes the query and returns the updated SharedEnumVariant entity.
+func (sevuo *SharedEnumVariantUpdateOne) Save(ctx context.Context) (*SharedEnumVariant, error) {
+	var (
+		err  error
+		node *SharedEnumVariant
+	)
+	if len(sevuo.hooks) == 0 {
+		if err = sevuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = sevuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SharedEnumVariantMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sevuo.check(); err != nil {
+				return nil, err
+			}
+			sevuo.mutation = mutation
+			node, err = sevuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sevuo.hooks) - 1; i >= 0; i-- {
+			if sevuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sevuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, sevuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*SharedEnumVariant)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from SharedEnumVariantMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sevuo *SharedEnumVariantUpdateOne) SaveX(ctx context.Context) *SharedEnumVariant {
+	node, err := sevuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sevuo *SharedEnumVariantUpdateOne) Exec(ctx context.Context) error {
+	_, err := sevuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sevuo *SharedEnumVariantUpdateOne) ExecX(ctx context.Context) {
+	if err := sevuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sevuo *SharedEnumVariantUpdateOne) check() error {
+	if v, ok := sevuo.mutation.Color(); ok {
+		if err := sharedenumvariant.ColorValidator(v); err != nil {
+			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "SharedEnumVariant.color": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sevuo *SharedEnumVariantUpdateOne) sqlSave(ctx context.Context) (_node *SharedEnumVariant, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sharedenumvariant.Table,
+			Columns: sharedenumvariant.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharedenumvariant.FieldID,
+			},
+		},
+	}
+	id, ok := sevuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SharedEnumVariant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sevuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharedenumvariant.FieldID)
+		for _, f := range fields {
+			if !sharedenumvariant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sharedenumvariant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sevuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sevuo.mutation.Color(); ok {
+		_spec.SetField(sharedenumvariant.FieldColor, field.TypeEnum, value)
+	}
+	_node = &SharedEnumVariant{config: sevuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sevuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharedenumvariant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
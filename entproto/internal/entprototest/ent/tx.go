@@ -14,6 +14,8 @@ type Tx struct {
 	config
 	// AllMethodsService is the client for interacting with the AllMethodsService builders.
 	AllMethodsService *AllMethodsServiceClient
+	// AutoFieldNumbers is the client for interacting with the AutoFieldNumbers builders.
+	AutoFieldNumbers *AutoFieldNumbersClient
 	// BlogPost is the client for interacting with the BlogPost builders.
 	BlogPost *BlogPostClient
 	// Category is the client for interacting with the Category builders.
@@ -24,12 +26,16 @@ type Tx struct {
 	DuplicateNumberMessage *DuplicateNumberMessageClient
 	// ExplicitSkippedMessage is the client for interacting with the ExplicitSkippedMessage builders.
 	ExplicitSkippedMessage *ExplicitSkippedMessageClient
+	// ExtraMethodService is the client for interacting with the ExtraMethodService builders.
+	ExtraMethodService *ExtraMethodServiceClient
 	// Image is the client for interacting with the Image builders.
 	Image *ImageClient
 	// ImplicitSkippedMessage is the client for interacting with the ImplicitSkippedMessage builders.
 	ImplicitSkippedMessage *ImplicitSkippedMessageClient
 	// InvalidFieldMessage is the client for interacting with the InvalidFieldMessage builders.
 	InvalidFieldMessage *InvalidFieldMessageClient
+	// MappedFieldMessage is the client for interacting with the MappedFieldMessage builders.
+	MappedFieldMessage *MappedFieldMessageClient
 	// MessageWithEnum is the client for interacting with the MessageWithEnum builders.
 	MessageWithEnum *MessageWithEnumClient
 	// MessageWithFieldOne is the client for interacting with the MessageWithFieldOne builders.
@@ -42,12 +48,26 @@ type Tx struct {
 	MessageWithPackageName *MessageWithPackageNameClient
 	// MessageWithStrings is the client for interacting with the MessageWithStrings builders.
 	MessageWithStrings *MessageWithStringsClient
+	// MethodOptionsService is the client for interacting with the MethodOptionsService builders.
+	MethodOptionsService *MethodOptionsServiceClient
+	// MixinAnnotated is the client for interacting with the MixinAnnotated builders.
+	MixinAnnotated *MixinAnnotatedClient
+	// MixinCollision is the client for interacting with the MixinCollision builders.
+	MixinCollision *MixinCollisionClient
+	// MixinServiceOverride is the client for interacting with the MixinServiceOverride builders.
+	MixinServiceOverride *MixinServiceOverrideClient
 	// NoBackref is the client for interacting with the NoBackref builders.
 	NoBackref *NoBackrefClient
 	// OneMethodService is the client for interacting with the OneMethodService builders.
 	OneMethodService *OneMethodServiceClient
 	// Portal is the client for interacting with the Portal builders.
 	Portal *PortalClient
+	// SharedEnumMessage is the client for interacting with the SharedEnumMessage builders.
+	SharedEnumMessage *SharedEnumMessageClient
+	// SharedEnumOtherMessage is the client for interacting with the SharedEnumOtherMessage builders.
+	SharedEnumOtherMessage *SharedEnumOtherMessageClient
+	// SharedEnumVariant is the client for interacting with the SharedEnumVariant builders.
+	SharedEnumVariant *SharedEnumVariantClient
 	// SkipEdgeExample is the client for interacting with the SkipEdgeExample builders.
 	SkipEdgeExample *SkipEdgeExampleClient
 	// TwoMethodService is the client for interacting with the TwoMethodService builders.
@@ -188,23 +208,33 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.AllMethodsService = NewAllMethodsServiceClient(tx.config)
+	tx.AutoFieldNumbers = NewAutoFieldNumbersClient(tx.config)
 	tx.BlogPost = NewBlogPostClient(tx.config)
 	tx.Category = NewCategoryClient(tx.config)
 	tx.DependsOnSkipped = NewDependsOnSkippedClient(tx.config)
 	tx.DuplicateNumberMessage = NewDuplicateNumberMessageClient(tx.config)
 	tx.ExplicitSkippedMessage = NewExplicitSkippedMessageClient(tx.config)
+	tx.ExtraMethodService = NewExtraMethodServiceClient(tx.config)
 	tx.Image = NewImageClient(tx.config)
 	tx.ImplicitSkippedMessage = NewImplicitSkippedMessageClient(tx.config)
 	tx.InvalidFieldMessage = NewInvalidFieldMessageClient(tx.config)
+	tx.MappedFieldMessage = NewMappedFieldMessageClient(tx.config)
 	tx.MessageWithEnum = NewMessageWithEnumClient(tx.config)
 	tx.MessageWithFieldOne = NewMessageWithFieldOneClient(tx.config)
 	tx.MessageWithID = NewMessageWithIDClient(tx.config)
 	tx.MessageWithOptionals = NewMessageWithOptionalsClient(tx.config)
 	tx.MessageWithPackageName = NewMessageWithPackageNameClient(tx.config)
 	tx.MessageWithStrings = NewMessageWithStringsClient(tx.config)
+	tx.MethodOptionsService = NewMethodOptionsServiceClient(tx.config)
+	tx.MixinAnnotated = NewMixinAnnotatedClient(tx.config)
+	tx.MixinCollision = NewMixinCollisionClient(tx.config)
+	tx.MixinServiceOverride = NewMixinServiceOverrideClient(tx.config)
 	tx.NoBackref = NewNoBackrefClient(tx.config)
 	tx.OneMethodService = NewOneMethodServiceClient(tx.config)
 	tx.Portal = NewPortalClient(tx.config)
+	tx.SharedEnumMessage = NewSharedEnumMessageClient(tx.config)
+	tx.SharedEnumOtherMessage = NewSharedEnumOtherMessageClient(tx.config)
+	tx.SharedEnumVariant = NewSharedEnumVariantClient(tx.config)
 	tx.SkipEdgeExample = NewSkipEdgeExampleClient(tx.config)
 	tx.TwoMethodService = NewTwoMethodServiceClient(tx.config)
 	tx.User = NewUserClient(tx.config)
@@ -14,6 +14,14 @@
 
 package entprototest
 
+import (
+	"github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
 func (suite *AdapterTestSuite) TestServiceGeneration() {
 	// Test default method generation
 	fd, err := suite.adapter.GetFileDescriptor("BlogPost")
@@ -88,6 +96,16 @@ func (suite *AdapterTestSuite) TestServiceGeneration() {
 	suite.Require().NotNil(batchCreateMeth)
 	suite.EqualValues("BatchCreateAllMethodsServicesRequest", batchCreateMeth.GetInputType().GetName())
 	suite.EqualValues("BatchCreateAllMethodsServicesResponse", batchCreateMeth.GetOutputType().GetName())
+	// Exists, Count, Upsert, BatchGet, BatchUpdate, BatchDelete, Stats, Export and Import are not part of MethodAll
+	suite.Nil(svc.FindMethodByName("Exists"))
+	suite.Nil(svc.FindMethodByName("Count"))
+	suite.Nil(svc.FindMethodByName("Upsert"))
+	suite.Nil(svc.FindMethodByName("BatchGet"))
+	suite.Nil(svc.FindMethodByName("BatchUpdate"))
+	suite.Nil(svc.FindMethodByName("BatchDelete"))
+	suite.Nil(svc.FindMethodByName("Stats"))
+	suite.Nil(svc.FindMethodByName("Export"))
+	suite.Nil(svc.FindMethodByName("Import"))
 
 	// Test single method generation
 	fd, err = suite.adapter.GetFileDescriptor("OneMethodService")
@@ -182,3 +200,109 @@ func (suite *AdapterTestSuite) TestServiceGeneration() {
 	suite.EqualValues("BatchCreateMessageWithIDsRequest", batchCreateMeth.GetInputType().GetName())
 	suite.EqualValues("BatchCreateMessageWithIDsResponse", batchCreateMeth.GetOutputType().GetName())
 }
+
+func (suite *AdapterTestSuite) TestServiceFromMixin() {
+	fd, err := suite.adapter.GetFileDescriptor("MixinAnnotated")
+	suite.Require().NoError(err)
+
+	svc := fd.FindService("entpb.MixinAnnotatedService")
+	suite.Require().NotNil(svc)
+	suite.Len(svc.GetMethods(), 1)
+	suite.NotNil(svc.FindMethodByName("Get"))
+
+	// The schema's annotation overrides the one declared on its mixin.
+	fd, err = suite.adapter.GetFileDescriptor("MixinServiceOverride")
+	suite.Require().NoError(err)
+
+	svc = fd.FindService("entpb.MixinServiceOverrideService")
+	suite.Require().NotNil(svc)
+	suite.Len(svc.GetMethods(), 2)
+	suite.NotNil(svc.FindMethodByName("Get"))
+	suite.NotNil(svc.FindMethodByName("List"))
+}
+
+func (suite *AdapterTestSuite) TestServiceMethodOptions() {
+	fd, err := suite.adapter.GetFileDescriptor("MethodOptionsService")
+	suite.Require().NoError(err)
+	suite.Contains(fd.AsFileDescriptorProto().GetDependency(), "google/api/client.proto")
+
+	svc := fd.FindService("entpb.MethodOptionsServiceService")
+	suite.Require().NotNil(svc)
+
+	getOpts := svc.FindMethodByName("Get").GetMethodOptions()
+	suite.Require().NotNil(getOpts)
+	suite.EqualValues(descriptorpb.MethodOptions_NO_SIDE_EFFECTS, getOpts.GetIdempotencyLevel())
+	suite.EqualValues([]string{"id", "id,view"}, proto.GetExtension(getOpts, annotations.E_MethodSignature))
+
+	listOpts := svc.FindMethodByName("List").GetMethodOptions()
+	suite.Require().NotNil(listOpts)
+	suite.EqualValues(descriptorpb.MethodOptions_NO_SIDE_EFFECTS, listOpts.GetIdempotencyLevel())
+	suite.Empty(proto.GetExtension(listOpts, annotations.E_MethodSignature))
+
+	deleteOpts := svc.FindMethodByName("Delete").GetMethodOptions()
+	suite.Require().NotNil(deleteOpts)
+	suite.EqualValues(descriptorpb.MethodOptions_IDEMPOTENT, deleteOpts.GetIdempotencyLevel())
+}
+
+func (suite *AdapterTestSuite) TestServiceOpenAPIOptions() {
+	fd, err := suite.adapter.GetFileDescriptor("MethodOptionsService")
+	suite.Require().NoError(err)
+	suite.Contains(fd.AsFileDescriptorProto().GetDependency(), "protoc-gen-openapiv2/options/annotations.proto")
+
+	svc := fd.FindService("entpb.MethodOptionsServiceService")
+	suite.Require().NotNil(svc)
+	op := proto.GetExtension(svc.FindMethodByName("Get").GetMethodOptions(), options.E_Openapiv2Operation).(*options.Operation)
+	suite.Equal("Get an entry", op.GetSummary())
+	suite.Equal([]string{"entries"}, op.GetTags())
+	suite.Equal(`{"id": 1}`, op.GetResponses()["200"].GetExamples()["application/json"])
+	suite.False(proto.HasExtension(svc.FindMethodByName("List").GetMethodOptions(), options.E_Openapiv2Operation))
+
+	msg := fd.FindMessage("entpb.MethodOptionsService")
+	suite.Require().NotNil(msg)
+	schema := proto.GetExtension(msg.GetMessageOptions(), options.E_Openapiv2Schema).(*options.Schema)
+	suite.Equal("Method options", schema.GetJsonSchema().GetTitle())
+	suite.Equal(`{"id": 1}`, schema.GetExample())
+}
+
+func (suite *AdapterTestSuite) TestServiceExtraMethods() {
+	fd, err := suite.adapter.GetFileDescriptor("ExtraMethodService")
+	suite.Require().NoError(err)
+	deps := fd.AsFileDescriptorProto().GetDependency()
+	suite.Contains(deps, "google/protobuf/timestamp.proto")
+	suite.Contains(deps, "google/type/date.proto")
+	suite.Contains(deps, "io/entgo/apps/todo/todo.proto")
+
+	svc := fd.FindService("entpb.ExtraMethodServiceService")
+	suite.Require().NotNil(svc)
+
+	touch := svc.FindMethodByName("Touch")
+	suite.Require().NotNil(touch)
+	suite.EqualValues("entpb.ExtraMethodService", touch.GetInputType().GetFullyQualifiedName())
+	suite.EqualValues("google.protobuf.Timestamp", touch.GetOutputType().GetFullyQualifiedName())
+	suite.False(touch.IsClientStreaming())
+	suite.False(touch.IsServerStreaming())
+
+	// Extra methods reuse the messages of the service rather than generating their own.
+	search := svc.FindMethodByName("Search")
+	suite.Require().NotNil(search)
+	suite.Equal(svc.FindMethodByName("List").GetInputType(), search.GetInputType())
+	suite.Equal(svc.FindMethodByName("List").GetOutputType(), search.GetOutputType())
+	suite.Nil(fd.FindMessage("entpb.SearchRequest"))
+	suite.Nil(fd.FindMessage("entpb.SearchResponse"))
+	suite.Equal(" Search returns the entries matching the query.\n The results are ranked by relevance.\n",
+		search.GetSourceInfo().GetLeadingComments())
+	suite.Empty(touch.GetSourceInfo().GetLeadingComments())
+
+	suite.Equal(" ExtraMethodServiceService serves the entries along with extra methods.\n",
+		svc.GetSourceInfo().GetLeadingComments())
+	suite.Equal(" Get returns the entry with the given ID.\n",
+		svc.FindMethodByName("Get").GetSourceInfo().GetLeadingComments())
+	suite.Empty(svc.FindMethodByName("List").GetSourceInfo().GetLeadingComments())
+
+	list := svc.FindMethodByName("ListMessages")
+	suite.Require().NotNil(list)
+	suite.EqualValues("google.type.Date", list.GetInputType().GetFullyQualifiedName())
+	suite.EqualValues("io.entgo.apps.todo.MessageWithPackageName", list.GetOutputType().GetFullyQualifiedName())
+	suite.False(list.IsClientStreaming())
+	suite.True(list.IsServerStreaming())
+}
@@ -19,9 +19,10 @@ type Attachment struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AttachmentQuery when eager-loading is set.
-	Edges           AttachmentEdges `json:"edges"`
-	pet_attachment  *int
-	user_attachment *uint32
+	Edges               AttachmentEdges `json:"edges"`
+	pet_attachment      *int
+	project_attachments *int
+	user_attachment     *uint32
 }
 
 // AttachmentEdges holds the relations/edges for other nodes in the graph.
@@ -66,7 +67,9 @@ func (*Attachment) scanValues(columns []string) ([]any, error) {
 			values[i] = new(uuid.UUID)
 		case attachment.ForeignKeys[0]: // pet_attachment
 			values[i] = new(sql.NullInt64)
-		case attachment.ForeignKeys[1]: // user_attachment
+		case attachment.ForeignKeys[1]: // project_attachments
+			values[i] = new(sql.NullInt64)
+		case attachment.ForeignKeys[2]: // user_attachment
 			values[i] = new(sql.NullInt64)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Attachment", columns[i])
@@ -97,6 +100,13 @@ func (a *Attachment) assignValues(columns []string, values []any) error {
 				*a.pet_attachment = int(value.Int64)
 			}
 		case attachment.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field project_attachments", value)
+			} else if value.Valid {
+				a.project_attachments = new(int)
+				*a.project_attachments = int(value.Int64)
+			}
+		case attachment.ForeignKeys[2]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_attachment", value)
 			} else if value.Valid {
@@ -25,11 +25,14 @@ type config struct {
 // hooks per client, for fast access.
 type hooks struct {
 	Attachment      []ent.Hook
+	Document        []ent.Hook
 	Group           []ent.Hook
+	Label           []ent.Hook
 	MultiWordSchema []ent.Hook
 	NilExample      []ent.Hook
 	Pet             []ent.Hook
 	Pony            []ent.Hook
+	Project         []ent.Hook
 	SkipEdgeExample []ent.Hook
 	Todo            []ent.Hook
 	User            []ent.Hook
@@ -0,0 +1,389 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/todo/ent/document"
+	"entgo.io/contrib/entproto/internal/todo/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DocumentUpdate is the builder for updating Document entities.
+type DocumentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DocumentMutation
+}
+
+// Where appends a list predicates to the DocumentUpdate builder.
+func (du *DocumentUpdate) Where(ps ...predicate.Document) *DocumentUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetTitle sets the "title" field.
+func (du *DocumentUpdate) SetTitle(s string) *DocumentUpdate {
+	du.mutation.SetTitle(s)
+	return du
+}
+
+// SetParentID sets the "parent" edge to the Document entity by ID.
+func (du *DocumentUpdate) SetParentID(id int) *DocumentUpdate {
+	du.mutation.SetParentID(id)
+	return du
+}
+
+// SetNillableParentID sets the "parent" edge to the Document entity by ID if the given value is not nil.
+func (du *DocumentUpdate) SetNillableParentID(id *int) *DocumentUpdate {
+	if id != nil {
+		du = du.SetParentID(*id)
+	}
+	return du
+}
+
+// SetParent sets the "parent" edge to the Document entity.
+func (du *DocumentUpdate) SetParent(d *Document) *DocumentUpdate {
+	return du.SetParentID(d.ID)
+}
+
+// Mutation returns the DocumentMutation object of the builder.
+func (du *DocumentUpdate) Mutation() *DocumentMutation {
+	return du.mutation
+}
+
+// ClearParent clears the "parent" edge to the Document entity.
+func (du *DocumentUpdate) ClearParent() *DocumentUpdate {
+	du.mutation.ClearParent()
+	return du
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DocumentUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(du.hooks) == 0 {
+		affected, err = du.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DocumentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			du.mutation = mutation
+			affected, err = du.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(du.hooks) - 1; i >= 0; i-- {
+			if du.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = du.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, du.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DocumentUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DocumentUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DocumentUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (du *DocumentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   document.Table,
+			Columns: document.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: document.FieldID,
+			},
+		},
+	}
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.Title(); ok {
+		_spec.SetField(document.FieldTitle, field.TypeString, value)
+	}
+	if du.mutation.RequestIDCleared() {
+		_spec.ClearField(document.FieldRequestID, field.TypeString)
+	}
+	if du.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   document.ParentTable,
+			Columns: []string{document.ParentColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: document.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   document.ParentTable,
+			Columns: []string{document.ParentColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: document.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{document.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// DocumentUpdateOne is the builder for updating a single Document entity.
+type DocumentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DocumentMutation
+}
+
+// SetTitle sets the "title" field.
+func (duo *DocumentUpdateOne) SetTitle(s string) *DocumentUpdateOne {
+	duo.mutation.SetTitle(s)
+	return duo
+}
+
+// SetParentID sets the "parent" edge to the Document entity by ID.
+func (duo *DocumentUpdateOne) SetParentID(id int) *DocumentUpdateOne {
+	duo.mutation.SetParentID(id)
+	return duo
+}
+
+// SetNillableParentID sets the "parent" edge to the Document entity by ID if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableParentID(id *int) *DocumentUpdateOne {
+	if id != nil {
+		duo = duo.SetParentID(*id)
+	}
+	return duo
+}
+
+// SetParent sets the "parent" edge to the Document entity.
+func (duo *DocumentUpdateOne) SetParent(d *Document) *DocumentUpdateOne {
+	return duo.SetParentID(d.ID)
+}
+
+// Mutation returns the DocumentMutation object of the builder.
+func (duo *DocumentUpdateOne) Mutation() *DocumentMutation {
+	return duo.mutation
+}
+
+// ClearParent clears the "parent" edge to the Document entity.
+func (duo *DocumentUpdateOne) ClearParent() *DocumentUpdateOne {
+	duo.mutation.ClearParent()
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DocumentUpdateOne) Select(field string, fields ...string) *DocumentUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Document entity.
+func (duo *DocumentUpdateOne) Save(ctx context.Context) (*Document, error) {
+	var (
+		err  error
+		node *Document
+	)
+	if len(duo.hooks) == 0 {
+		node, err = duo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DocumentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			duo.mutation = mutation
+			node, err = duo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(duo.hooks) - 1; i >= 0; i-- {
+			if duo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = duo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, duo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Document)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from DocumentMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DocumentUpdateOne) SaveX(ctx context.Context) *Document {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DocumentUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DocumentUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (duo *DocumentUpdateOne) sqlSave(ctx context.Context) (_node *Document, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   document.Table,
+			Columns: document.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: document.FieldID,
+			},
+		},
+	}
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Document.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, document.FieldID)
+		for _, f := range fields {
+			if !document.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != document.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.Title(); ok {
+		_spec.SetField(document.FieldTitle, field.TypeString, value)
+	}
+	if duo.mutation.RequestIDCleared() {
+		_spec.ClearField(document.FieldRequestID, field.TypeString)
+	}
+	if duo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   document.ParentTable,
+			Columns: []string{document.ParentColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: document.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   document.ParentTable,
+			Columns: []string{document.ParentColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: document.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Document{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{document.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -8,11 +8,14 @@ import (
 	"fmt"
 
 	"entgo.io/contrib/entproto/internal/todo/ent/attachment"
+	"entgo.io/contrib/entproto/internal/todo/ent/document"
 	"entgo.io/contrib/entproto/internal/todo/ent/group"
+	"entgo.io/contrib/entproto/internal/todo/ent/label"
 	"entgo.io/contrib/entproto/internal/todo/ent/multiwordschema"
 	"entgo.io/contrib/entproto/internal/todo/ent/nilexample"
 	"entgo.io/contrib/entproto/internal/todo/ent/pet"
 	"entgo.io/contrib/entproto/internal/todo/ent/pony"
+	"entgo.io/contrib/entproto/internal/todo/ent/project"
 	"entgo.io/contrib/entproto/internal/todo/ent/skipedgeexample"
 	"entgo.io/contrib/entproto/internal/todo/ent/todo"
 	"entgo.io/contrib/entproto/internal/todo/ent/user"
@@ -40,11 +43,14 @@ type OrderFunc func(*sql.Selector)
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
 		attachment.Table:      attachment.ValidColumn,
+		document.Table:        document.ValidColumn,
 		group.Table:           group.ValidColumn,
+		label.Table:           label.ValidColumn,
 		multiwordschema.Table: multiwordschema.ValidColumn,
 		nilexample.Table:      nilexample.ValidColumn,
 		pet.Table:             pet.ValidColumn,
 		pony.Table:            pony.ValidColumn,
+		project.Table:         project.ValidColumn,
 		skipedgeexample.Table: skipedgeexample.ValidColumn,
 		todo.Table:            todo.ValidColumn,
 		user.Table:            user.ValidColumn,
@@ -14,5 +14,5 @@
 
 package ent
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate ./schema
-//go:generate go run entgo.io/contrib/entproto/cmd/entproto -path ./schema
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature sql/upsert ./schema
+//go:generate go run entgo.io/contrib/entproto/cmd/entproto -path ./schema -manifest -default_values
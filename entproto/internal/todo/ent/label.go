@@ -0,0 +1,97 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/contrib/entproto/internal/todo/ent/label"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Label is the model entity for the Label schema.
+type Label struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Color holds the value of the "color" field.
+	Color string `json:"color,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Label) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case label.FieldID, label.FieldColor:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Label", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Label fields.
+func (l *Label) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case label.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				l.ID = value.String
+			}
+		case label.FieldColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field color", values[i])
+			} else if value.Valid {
+				l.Color = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Label.
+// Note that you need to call Label.Unwrap() before calling this method if this Label
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (l *Label) Update() *LabelUpdateOne {
+	return (&LabelClient{config: l.config}).UpdateOne(l)
+}
+
+// Unwrap unwraps the Label entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (l *Label) Unwrap() *Label {
+	_tx, ok := l.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Label is not a transactional entity")
+	}
+	l.config.driver = _tx.drv
+	return l
+}
+
+// String implements the fmt.Stringer.
+func (l *Label) String() string {
+	var builder strings.Builder
+	builder.WriteString("Label(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", l.ID))
+	builder.WriteString("color=")
+	builder.WriteString(l.Color)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Labels is a parsable slice of Label.
+type Labels []*Label
+
+func (l Labels) config(cfg config) {
+	for _i := range l {
+		l[_i].config = cfg
+	}
+}
@@ -0,0 +1,37 @@
+// Code generated by ent, DO NOT EDIT.
+
+package label
+
+const (
+	// Label holds the string label denoting the label type in the database.
+	Label = "label"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldColor holds the string denoting the color field in the database.
+	FieldColor = "color"
+	// Table holds the table name of the label in the database.
+	Table = "labels"
+)
+
+// Columns holds all SQL columns for label fields.
+var Columns = []string{
+	FieldID,
+	FieldColor,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultColor holds the default value on creation for the "color" field.
+	DefaultColor string
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
@@ -12,6 +12,7 @@ var (
 	AttachmentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "pet_attachment", Type: field.TypeInt, Nullable: true},
+		{Name: "project_attachments", Type: field.TypeInt, Nullable: true},
 		{Name: "user_attachment", Type: field.TypeUint32, Unique: true, Nullable: true},
 	}
 	// AttachmentsTable holds the schema information for the "attachments" table.
@@ -27,13 +28,41 @@ var (
 				OnDelete:   schema.SetNull,
 			},
 			{
-				Symbol:     "attachments_users_attachment",
+				Symbol:     "attachments_projects_attachments",
 				Columns:    []*schema.Column{AttachmentsColumns[2]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "attachments_users_attachment",
+				Columns:    []*schema.Column{AttachmentsColumns[3]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// DocumentsColumns holds the columns for the "documents" table.
+	DocumentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "title", Type: field.TypeString},
+		{Name: "request_id", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "document_parent", Type: field.TypeInt, Unique: true, Nullable: true},
+	}
+	// DocumentsTable holds the schema information for the "documents" table.
+	DocumentsTable = &schema.Table{
+		Name:       "documents",
+		Columns:    DocumentsColumns,
+		PrimaryKey: []*schema.Column{DocumentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "documents_documents_parent",
+				Columns:    []*schema.Column{DocumentsColumns[4]},
+				RefColumns: []*schema.Column{DocumentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// GroupsColumns holds the columns for the "groups" table.
 	GroupsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -45,6 +74,17 @@ var (
 		Columns:    GroupsColumns,
 		PrimaryKey: []*schema.Column{GroupsColumns[0]},
 	}
+	// LabelsColumns holds the columns for the "labels" table.
+	LabelsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "color", Type: field.TypeString, Default: "grey"},
+	}
+	// LabelsTable holds the schema information for the "labels" table.
+	LabelsTable = &schema.Table{
+		Name:       "labels",
+		Columns:    LabelsColumns,
+		PrimaryKey: []*schema.Column{LabelsColumns[0]},
+	}
 	// MultiWordSchemasColumns holds the columns for the "multi_word_schemas" table.
 	MultiWordSchemasColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -61,6 +101,7 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "str_nil", Type: field.TypeString, Nullable: true},
 		{Name: "time_nil", Type: field.TypeTime, Nullable: true},
+		{Name: "time_str_nil", Type: field.TypeTime, Nullable: true},
 	}
 	// NilExamplesTable holds the schema information for the "nil_examples" table.
 	NilExamplesTable = &schema.Table{
@@ -71,6 +112,7 @@ var (
 	// PetsColumns holds the columns for the "pets" table.
 	PetsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "size", Type: field.TypeEnum, Enums: []string{"medium", "small", "large"}, Default: "medium"},
 		{Name: "user_pet", Type: field.TypeUint32, Unique: true, Nullable: true},
 	}
 	// PetsTable holds the schema information for the "pets" table.
@@ -81,7 +123,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "pets_users_pet",
-				Columns:    []*schema.Column{PetsColumns[1]},
+				Columns:    []*schema.Column{PetsColumns[2]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -90,7 +132,13 @@ var (
 	// PoniesColumns holds the columns for the "ponies" table.
 	PoniesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "size", Type: field.TypeEnum, Enums: []string{"medium", "small", "large"}, Default: "medium"},
 		{Name: "name", Type: field.TypeString},
+		{Name: "height", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "varchar(16)", "postgres": "varchar", "sqlite3": "varchar"}},
+		{Name: "scores", Type: field.TypeJSON, Nullable: true},
+		{Name: "rank", Type: field.TypeInt8, Nullable: true},
+		{Name: "color", Type: field.TypeString, Default: "bay"},
+		{Name: "priority", Type: field.TypeEnum, Enums: []string{"low", "high"}, Default: "low"},
 	}
 	// PoniesTable holds the schema information for the "ponies" table.
 	PoniesTable = &schema.Table{
@@ -98,6 +146,29 @@ var (
 		Columns:    PoniesColumns,
 		PrimaryKey: []*schema.Column{PoniesColumns[0]},
 	}
+	// ProjectsColumns holds the columns for the "projects" table.
+	ProjectsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "priority", Type: field.TypeEnum, Enums: []string{"low", "high"}, Default: "low"},
+		{Name: "version", Type: field.TypeInt, Default: 0},
+		{Name: "project_owner", Type: field.TypeUint32, Nullable: true},
+	}
+	// ProjectsTable holds the schema information for the "projects" table.
+	ProjectsTable = &schema.Table{
+		Name:       "projects",
+		Columns:    ProjectsColumns,
+		PrimaryKey: []*schema.Column{ProjectsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "projects_users_owner",
+				Columns:    []*schema.Column{ProjectsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// SkipEdgeExamplesColumns holds the columns for the "skip_edge_examples" table.
 	SkipEdgeExamplesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -161,6 +232,7 @@ var (
 		{Name: "type", Type: field.TypeString, Nullable: true},
 		{Name: "labels", Type: field.TypeJSON, Nullable: true},
 		{Name: "device_type", Type: field.TypeEnum, Enums: []string{"GLOWY9000", "SPEEDY300"}, Default: "GLOWY9000"},
+		{Name: "created_at", Type: field.TypeTime},
 		{Name: "omit_prefix", Type: field.TypeEnum, Enums: []string{"foo", "bar"}},
 		{Name: "user_group", Type: field.TypeInt, Nullable: true},
 	}
@@ -172,7 +244,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_groups_group",
-				Columns:    []*schema.Column{UsersColumns[22]},
+				Columns:    []*schema.Column{UsersColumns[23]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -203,28 +275,62 @@ var (
 			},
 		},
 	}
+	// UserFriendsColumns holds the columns for the "user_friends" table.
+	UserFriendsColumns = []*schema.Column{
+		{Name: "user_id", Type: field.TypeUint32},
+		{Name: "friend_id", Type: field.TypeUint32},
+	}
+	// UserFriendsTable holds the schema information for the "user_friends" table.
+	UserFriendsTable = &schema.Table{
+		Name:       "user_friends",
+		Columns:    UserFriendsColumns,
+		PrimaryKey: []*schema.Column{UserFriendsColumns[0], UserFriendsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_friends_user_id",
+				Columns:    []*schema.Column{UserFriendsColumns[0]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_friends_friend_id",
+				Columns:    []*schema.Column{UserFriendsColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AttachmentsTable,
+		DocumentsTable,
 		GroupsTable,
+		LabelsTable,
 		MultiWordSchemasTable,
 		NilExamplesTable,
 		PetsTable,
 		PoniesTable,
+		ProjectsTable,
 		SkipEdgeExamplesTable,
 		TodosTable,
 		UsersTable,
 		AttachmentRecipientsTable,
+		UserFriendsTable,
 	}
 )
 
 func init() {
 	AttachmentsTable.ForeignKeys[0].RefTable = PetsTable
-	AttachmentsTable.ForeignKeys[1].RefTable = UsersTable
+	AttachmentsTable.ForeignKeys[1].RefTable = ProjectsTable
+	AttachmentsTable.ForeignKeys[2].RefTable = UsersTable
+	DocumentsTable.ForeignKeys[0].RefTable = DocumentsTable
 	PetsTable.ForeignKeys[0].RefTable = UsersTable
+	ProjectsTable.ForeignKeys[0].RefTable = UsersTable
 	SkipEdgeExamplesTable.ForeignKeys[0].RefTable = UsersTable
 	TodosTable.ForeignKeys[0].RefTable = UsersTable
 	UsersTable.ForeignKeys[0].RefTable = GroupsTable
 	AttachmentRecipientsTable.ForeignKeys[0].RefTable = AttachmentsTable
 	AttachmentRecipientsTable.ForeignKeys[1].RefTable = UsersTable
+	UserFriendsTable.ForeignKeys[0].RefTable = UsersTable
+	UserFriendsTable.ForeignKeys[1].RefTable = UsersTable
 }
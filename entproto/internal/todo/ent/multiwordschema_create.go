@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"entgo.io/contrib/entproto/internal/todo/ent/multiwordschema"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -17,6 +18,7 @@ type MultiWordSchemaCreate struct {
 	config
 	mutation *MultiWordSchemaMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUnit sets the "unit" field.
@@ -153,6 +155,7 @@ func (mwsc *MultiWordSchemaCreate) createSpec() (*MultiWordSchema, *sqlgraph.Cre
 			},
 		}
 	)
+	_spec.OnConflict = mwsc.conflict
 	if value, ok := mwsc.mutation.Unit(); ok {
 		_spec.SetField(multiwordschema.FieldUnit, field.TypeEnum, value)
 		_node.Unit = value
@@ -160,10 +163,159 @@ func (mwsc *MultiWordSchemaCreate) createSpec() (*MultiWordSchema, *sqlgraph.Cre
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MultiWordSchema.Create().
+//		SetUnit(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MultiWordSchemaUpsert) {
+//			SetUnit(v+v).
+//		}).
+//		Exec(ctx)
+func (mwsc *MultiWordSchemaCreate) OnConflict(opts ...sql.ConflictOption) *MultiWordSchemaUpsertOne {
+	mwsc.conflict = opts
+	return &MultiWordSchemaUpsertOne{
+		create: mwsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MultiWordSchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mwsc *MultiWordSchemaCreate) OnConflictColumns(columns ...string) *MultiWordSchemaUpsertOne {
+	mwsc.conflict = append(mwsc.conflict, sql.ConflictColumns(columns...))
+	return &MultiWordSchemaUpsertOne{
+		create: mwsc,
+	}
+}
+
+type (
+	// MultiWordSchemaUpsertOne is the builder for "upsert"-ing
+	//  one MultiWordSchema node.
+	MultiWordSchemaUpsertOne struct {
+		create *MultiWordSchemaCreate
+	}
+
+	// MultiWordSchemaUpsert is the "OnConflict" setter.
+	MultiWordSchemaUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUnit sets the "unit" field.
+func (u *MultiWordSchemaUpsert) SetUnit(v multiwordschema.Unit) *MultiWordSchemaUpsert {
+	u.Set(multiwordschema.FieldUnit, v)
+	return u
+}
+
+// UpdateUnit sets the "unit" field to the value that was provided on create.
+func (u *MultiWordSchemaUpsert) UpdateUnit() *MultiWordSchemaUpsert {
+	u.SetExcluded(multiwordschema.FieldUnit)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.MultiWordSchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *MultiWordSchemaUpsertOne) UpdateNewValues() *MultiWordSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MultiWordSchema.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MultiWordSchemaUpsertOne) Ignore() *MultiWordSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MultiWordSchemaUpsertOne) DoNothing() *MultiWordSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MultiWordSchemaCreate.OnConflict
+// documentation for more info.
+func (u *MultiWordSchemaUpsertOne) Update(set func(*MultiWordSchemaUpsert)) *MultiWordSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MultiWordSchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUnit sets the "unit" field.
+func (u *MultiWordSchemaUpsertOne) SetUnit(v multiwordschema.Unit) *MultiWordSchemaUpsertOne {
+	return u.Update(func(s *MultiWordSchemaUpsert) {
+		s.SetUnit(v)
+	})
+}
+
+// UpdateUnit sets the "unit" field to the value that was provided on create.
+func (u *MultiWordSchemaUpsertOne) UpdateUnit() *MultiWordSchemaUpsertOne {
+	return u.Update(func(s *MultiWordSchemaUpsert) {
+		s.UpdateUnit()
+	})
+}
+
+// Exec executes the query.
+func (u *MultiWordSchemaUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MultiWordSchemaCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MultiWordSchemaUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MultiWordSchemaUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MultiWordSchemaUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // MultiWordSchemaCreateBulk is the builder for creating many MultiWordSchema entities in bulk.
 type MultiWordSchemaCreateBulk struct {
 	config
 	builders []*MultiWordSchemaCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the MultiWordSchema entities in the database.
@@ -190,6 +342,7 @@ func (mwscb *MultiWordSchemaCreateBulk) Save(ctx context.Context) ([]*MultiWordS
 					_, err = mutators[i+1].Mutate(root, mwscb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mwscb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, mwscb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -243,3 +396,118 @@ func (mwscb *MultiWordSchemaCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MultiWordSchema.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MultiWordSchemaUpsert) {
+//			SetUnit(v+v).
+//		}).
+//		Exec(ctx)
+func (mwscb *MultiWordSchemaCreateBulk) OnConflict(opts ...sql.ConflictOption) *MultiWordSchemaUpsertBulk {
+	mwscb.conflict = opts
+	return &MultiWordSchemaUpsertBulk{
+		create: mwscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MultiWordSchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mwscb *MultiWordSchemaCreateBulk) OnConflictColumns(columns ...string) *MultiWordSchemaUpsertBulk {
+	mwscb.conflict = append(mwscb.conflict, sql.ConflictColumns(columns...))
+	return &MultiWordSchemaUpsertBulk{
+		create: mwscb,
+	}
+}
+
+// MultiWordSchemaUpsertBulk is the builder for "upsert"-ing
+// a bulk of MultiWordSchema nodes.
+type MultiWordSchemaUpsertBulk struct {
+	create *MultiWordSchemaCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MultiWordSchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *MultiWordSchemaUpsertBulk) UpdateNewValues() *MultiWordSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MultiWordSchema.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MultiWordSchemaUpsertBulk) Ignore() *MultiWordSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MultiWordSchemaUpsertBulk) DoNothing() *MultiWordSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MultiWordSchemaCreateBulk.OnConflict
+// documentation for more info.
+func (u *MultiWordSchemaUpsertBulk) Update(set func(*MultiWordSchemaUpsert)) *MultiWordSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MultiWordSchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUnit sets the "unit" field.
+func (u *MultiWordSchemaUpsertBulk) SetUnit(v multiwordschema.Unit) *MultiWordSchemaUpsertBulk {
+	return u.Update(func(s *MultiWordSchemaUpsert) {
+		s.SetUnit(v)
+	})
+}
+
+// UpdateUnit sets the "unit" field to the value that was provided on create.
+func (u *MultiWordSchemaUpsertBulk) UpdateUnit() *MultiWordSchemaUpsertBulk {
+	return u.Update(func(s *MultiWordSchemaUpsert) {
+		s.UpdateUnit()
+	})
+}
+
+// Exec executes the query.
+func (u *MultiWordSchemaUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MultiWordSchemaCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MultiWordSchemaCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MultiWordSchemaUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
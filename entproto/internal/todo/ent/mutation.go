@@ -10,12 +10,15 @@ import (
 	"time"
 
 	"entgo.io/contrib/entproto/internal/todo/ent/attachment"
+	"entgo.io/contrib/entproto/internal/todo/ent/document"
 	"entgo.io/contrib/entproto/internal/todo/ent/group"
+	"entgo.io/contrib/entproto/internal/todo/ent/label"
 	"entgo.io/contrib/entproto/internal/todo/ent/multiwordschema"
 	"entgo.io/contrib/entproto/internal/todo/ent/nilexample"
 	"entgo.io/contrib/entproto/internal/todo/ent/pet"
 	"entgo.io/contrib/entproto/internal/todo/ent/pony"
 	"entgo.io/contrib/entproto/internal/todo/ent/predicate"
+	"entgo.io/contrib/entproto/internal/todo/ent/project"
 	"entgo.io/contrib/entproto/internal/todo/ent/schema"
 	"entgo.io/contrib/entproto/internal/todo/ent/skipedgeexample"
 	"entgo.io/contrib/entproto/internal/todo/ent/todo"
@@ -35,11 +38,14 @@ const (
 
 	// Node types.
 	TypeAttachment      = "Attachment"
+	TypeDocument        = "Document"
 	TypeGroup           = "Group"
+	TypeLabel           = "Label"
 	TypeMultiWordSchema = "MultiWordSchema"
 	TypeNilExample      = "NilExample"
 	TypePet             = "Pet"
 	TypePony            = "Pony"
+	TypeProject         = "Project"
 	TypeSkipEdgeExample = "SkipEdgeExample"
 	TypeTodo            = "Todo"
 	TypeUser            = "User"
@@ -452,33 +458,35 @@ func (m *AttachmentMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Attachment edge %s", name)
 }
 
-// GroupMutation represents an operation that mutates the Group nodes in the graph.
-type GroupMutation struct {
+// DocumentMutation represents an operation that mutates the Document nodes in the graph.
+type DocumentMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	name          *string
+	tenant_id     *int
+	addtenant_id  *int
+	title         *string
+	request_id    *string
 	clearedFields map[string]struct{}
-	users         map[uint32]struct{}
-	removedusers  map[uint32]struct{}
-	clearedusers  bool
+	parent        *int
+	clearedparent bool
 	done          bool
-	oldValue      func(context.Context) (*Group, error)
-	predicates    []predicate.Group
+	oldValue      func(context.Context) (*Document, error)
+	predicates    []predicate.Document
 }
 
-var _ ent.Mutation = (*GroupMutation)(nil)
+var _ ent.Mutation = (*DocumentMutation)(nil)
 
-// groupOption allows management of the mutation configuration using functional options.
-type groupOption func(*GroupMutation)
+// documentOption allows management of the mutation configuration using functional options.
+type documentOption func(*DocumentMutation)
 
-// newGroupMutation creates new mutation for the Group entity.
-func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
-	m := &GroupMutation{
+// newDocumentMutation creates new mutation for the Document entity.
+func newDocumentMutation(c config, op Op, opts ...documentOption) *DocumentMutation {
+	m := &DocumentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGroup,
+		typ:           TypeDocument,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -487,20 +495,20 @@ func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
 	return m
 }
 
-// withGroupID sets the ID field of the mutation.
-func withGroupID(id int) groupOption {
-	return func(m *GroupMutation) {
+// withDocumentID sets the ID field of the mutation.
+func withDocumentID(id int) documentOption {
+	return func(m *DocumentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Group
+			value *Document
 		)
-		m.oldValue = func(ctx context.Context) (*Group, error) {
+		m.oldValue = func(ctx context.Context) (*Document, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Group.Get(ctx, id)
+					value, err = m.Client().Document.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -509,10 +517,10 @@ func withGroupID(id int) groupOption {
 	}
 }
 
-// withGroup sets the old Group of the mutation.
-func withGroup(node *Group) groupOption {
-	return func(m *GroupMutation) {
-		m.oldValue = func(context.Context) (*Group, error) {
+// withDocument sets the old Document of the mutation.
+func withDocument(node *Document) documentOption {
+	return func(m *DocumentMutation) {
+		m.oldValue = func(context.Context) (*Document, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -521,7 +529,7 @@ func withGroup(node *Group) groupOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GroupMutation) Client() *Client {
+func (m DocumentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -529,7 +537,7 @@ func (m GroupMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GroupMutation) Tx() (*Tx, error) {
+func (m DocumentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -540,7 +548,7 @@ func (m GroupMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GroupMutation) ID() (id int, exists bool) {
+func (m *DocumentMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -551,7 +559,7 @@ func (m *GroupMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GroupMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DocumentMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -560,124 +568,220 @@ func (m *GroupMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Document.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *GroupMutation) SetName(s string) {
-	m.name = &s
+// SetTenantID sets the "tenant_id" field.
+func (m *DocumentMutation) SetTenantID(i int) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *GroupMutation) Name() (r string, exists bool) {
-	v := m.name
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *DocumentMutation) TenantID() (r int, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *DocumentMutation) OldTenantID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.TenantID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *GroupMutation) ResetName() {
-	m.name = nil
+// AddTenantID adds i to the "tenant_id" field.
+func (m *DocumentMutation) AddTenantID(i int) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
 }
 
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *GroupMutation) AddUserIDs(ids ...uint32) {
-	if m.users == nil {
-		m.users = make(map[uint32]struct{})
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *DocumentMutation) AddedTenantID() (r int, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *DocumentMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *DocumentMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *DocumentMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *GroupMutation) ClearUsers() {
-	m.clearedusers = true
+// OldTitle returns the old "title" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *GroupMutation) UsersCleared() bool {
-	return m.clearedusers
+// ResetTitle resets all changes to the "title" field.
+func (m *DocumentMutation) ResetTitle() {
+	m.title = nil
 }
 
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *GroupMutation) RemoveUserIDs(ids ...uint32) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[uint32]struct{})
+// SetRequestID sets the "request_id" field.
+func (m *DocumentMutation) SetRequestID(s string) {
+	m.request_id = &s
+}
+
+// RequestID returns the value of the "request_id" field in the mutation.
+func (m *DocumentMutation) RequestID() (r string, exists bool) {
+	v := m.request_id
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldRequestID returns the old "request_id" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldRequestID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
+	}
+	return oldValue.RequestID, nil
 }
 
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *GroupMutation) RemovedUsersIDs() (ids []uint32) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
+// ClearRequestID clears the value of the "request_id" field.
+func (m *DocumentMutation) ClearRequestID() {
+	m.request_id = nil
+	m.clearedFields[document.FieldRequestID] = struct{}{}
+}
+
+// RequestIDCleared returns if the "request_id" field was cleared in this mutation.
+func (m *DocumentMutation) RequestIDCleared() bool {
+	_, ok := m.clearedFields[document.FieldRequestID]
+	return ok
+}
+
+// ResetRequestID resets all changes to the "request_id" field.
+func (m *DocumentMutation) ResetRequestID() {
+	m.request_id = nil
+	delete(m.clearedFields, document.FieldRequestID)
+}
+
+// SetParentID sets the "parent" edge to the Document entity by id.
+func (m *DocumentMutation) SetParentID(id int) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Document entity.
+func (m *DocumentMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Document entity was cleared.
+func (m *DocumentMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *DocumentMutation) ParentID() (id int, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
 	}
 	return
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *GroupMutation) UsersIDs() (ids []uint32) {
-	for id := range m.users {
-		ids = append(ids, id)
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *DocumentMutation) ParentIDs() (ids []int) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *GroupMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
+// ResetParent resets all changes to the "parent" edge.
+func (m *DocumentMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
 }
 
-// Where appends a list predicates to the GroupMutation builder.
-func (m *GroupMutation) Where(ps ...predicate.Group) {
+// Where appends a list predicates to the DocumentMutation builder.
+func (m *DocumentMutation) Where(ps ...predicate.Document) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *GroupMutation) Op() Op {
+func (m *DocumentMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Group).
-func (m *GroupMutation) Type() string {
+// Type returns the node type of this mutation (Document).
+func (m *DocumentMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GroupMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.name != nil {
-		fields = append(fields, group.FieldName)
+func (m *DocumentMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.tenant_id != nil {
+		fields = append(fields, document.FieldTenantID)
+	}
+	if m.title != nil {
+		fields = append(fields, document.FieldTitle)
+	}
+	if m.request_id != nil {
+		fields = append(fields, document.FieldRequestID)
 	}
 	return fields
 }
@@ -685,10 +789,14 @@ func (m *GroupMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GroupMutation) Field(name string) (ent.Value, bool) {
+func (m *DocumentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case group.FieldName:
-		return m.Name()
+	case document.FieldTenantID:
+		return m.TenantID()
+	case document.FieldTitle:
+		return m.Title()
+	case document.FieldRequestID:
+		return m.RequestID()
 	}
 	return nil, false
 }
@@ -696,190 +804,231 @@ func (m *GroupMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DocumentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case group.FieldName:
-		return m.OldName(ctx)
+	case document.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case document.FieldTitle:
+		return m.OldTitle(ctx)
+	case document.FieldRequestID:
+		return m.OldRequestID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Group field %s", name)
+	return nil, fmt.Errorf("unknown Document field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupMutation) SetField(name string, value ent.Value) error {
+func (m *DocumentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case group.FieldName:
+	case document.FieldTenantID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case document.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetTitle(v)
+		return nil
+	case document.FieldRequestID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Group field %s", name)
+	return fmt.Errorf("unknown Document field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GroupMutation) AddedFields() []string {
-	return nil
+func (m *DocumentMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, document.FieldTenantID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DocumentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case document.FieldTenantID:
+		return m.AddedTenantID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupMutation) AddField(name string, value ent.Value) error {
+func (m *DocumentMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case document.FieldTenantID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Group numeric field %s", name)
+	return fmt.Errorf("unknown Document numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GroupMutation) ClearedFields() []string {
-	return nil
+func (m *DocumentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(document.FieldRequestID) {
+		fields = append(fields, document.FieldRequestID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GroupMutation) FieldCleared(name string) bool {
+func (m *DocumentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GroupMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Group nullable field %s", name)
+func (m *DocumentMutation) ClearField(name string) error {
+	switch name {
+	case document.FieldRequestID:
+		m.ClearRequestID()
+		return nil
+	}
+	return fmt.Errorf("unknown Document nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GroupMutation) ResetField(name string) error {
+func (m *DocumentMutation) ResetField(name string) error {
 	switch name {
-	case group.FieldName:
-		m.ResetName()
+	case document.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case document.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case document.FieldRequestID:
+		m.ResetRequestID()
 		return nil
 	}
-	return fmt.Errorf("unknown Group field %s", name)
+	return fmt.Errorf("unknown Document field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GroupMutation) AddedEdges() []string {
+func (m *DocumentMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.users != nil {
-		edges = append(edges, group.EdgeUsers)
+	if m.parent != nil {
+		edges = append(edges, document.EdgeParent)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GroupMutation) AddedIDs(name string) []ent.Value {
+func (m *DocumentMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case group.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
-			ids = append(ids, id)
+	case document.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GroupMutation) RemovedEdges() []string {
+func (m *DocumentMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedusers != nil {
-		edges = append(edges, group.EdgeUsers)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case group.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *DocumentMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GroupMutation) ClearedEdges() []string {
+func (m *DocumentMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedusers {
-		edges = append(edges, group.EdgeUsers)
+	if m.clearedparent {
+		edges = append(edges, document.EdgeParent)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GroupMutation) EdgeCleared(name string) bool {
+func (m *DocumentMutation) EdgeCleared(name string) bool {
 	switch name {
-	case group.EdgeUsers:
-		return m.clearedusers
+	case document.EdgeParent:
+		return m.clearedparent
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GroupMutation) ClearEdge(name string) error {
+func (m *DocumentMutation) ClearEdge(name string) error {
 	switch name {
+	case document.EdgeParent:
+		m.ClearParent()
+		return nil
 	}
-	return fmt.Errorf("unknown Group unique edge %s", name)
+	return fmt.Errorf("unknown Document unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GroupMutation) ResetEdge(name string) error {
+func (m *DocumentMutation) ResetEdge(name string) error {
 	switch name {
-	case group.EdgeUsers:
-		m.ResetUsers()
+	case document.EdgeParent:
+		m.ResetParent()
 		return nil
 	}
-	return fmt.Errorf("unknown Group edge %s", name)
+	return fmt.Errorf("unknown Document edge %s", name)
 }
 
-// MultiWordSchemaMutation represents an operation that mutates the MultiWordSchema nodes in the graph.
-type MultiWordSchemaMutation struct {
+// GroupMutation represents an operation that mutates the Group nodes in the graph.
+type GroupMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	unit          *multiwordschema.Unit
+	name          *string
 	clearedFields map[string]struct{}
+	users         map[uint32]struct{}
+	removedusers  map[uint32]struct{}
+	clearedusers  bool
 	done          bool
-	oldValue      func(context.Context) (*MultiWordSchema, error)
-	predicates    []predicate.MultiWordSchema
+	oldValue      func(context.Context) (*Group, error)
+	predicates    []predicate.Group
 }
 
-var _ ent.Mutation = (*MultiWordSchemaMutation)(nil)
+var _ ent.Mutation = (*GroupMutation)(nil)
 
-// multiwordschemaOption allows management of the mutation configuration using functional options.
-type multiwordschemaOption func(*MultiWordSchemaMutation)
+// groupOption allows management of the mutation configuration using functional options.
+type groupOption func(*GroupMutation)
 
-// newMultiWordSchemaMutation creates new mutation for the MultiWordSchema entity.
-func newMultiWordSchemaMutation(c config, op Op, opts ...multiwordschemaOption) *MultiWordSchemaMutation {
-	m := &MultiWordSchemaMutation{
+// newGroupMutation creates new mutation for the Group entity.
+func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
+	m := &GroupMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMultiWordSchema,
+		typ:           TypeGroup,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -888,20 +1037,20 @@ func newMultiWordSchemaMutation(c config, op Op, opts ...multiwordschemaOption)
 	return m
 }
 
-// withMultiWordSchemaID sets the ID field of the mutation.
-func withMultiWordSchemaID(id int) multiwordschemaOption {
-	return func(m *MultiWordSchemaMutation) {
+// withGroupID sets the ID field of the mutation.
+func withGroupID(id int) groupOption {
+	return func(m *GroupMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MultiWordSchema
+			value *Group
 		)
-		m.oldValue = func(ctx context.Context) (*MultiWordSchema, error) {
+		m.oldValue = func(ctx context.Context) (*Group, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MultiWordSchema.Get(ctx, id)
+					value, err = m.Client().Group.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -910,10 +1059,10 @@ func withMultiWordSchemaID(id int) multiwordschemaOption {
 	}
 }
 
-// withMultiWordSchema sets the old MultiWordSchema of the mutation.
-func withMultiWordSchema(node *MultiWordSchema) multiwordschemaOption {
-	return func(m *MultiWordSchemaMutation) {
-		m.oldValue = func(context.Context) (*MultiWordSchema, error) {
+// withGroup sets the old Group of the mutation.
+func withGroup(node *Group) groupOption {
+	return func(m *GroupMutation) {
+		m.oldValue = func(context.Context) (*Group, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -922,7 +1071,7 @@ func withMultiWordSchema(node *MultiWordSchema) multiwordschemaOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MultiWordSchemaMutation) Client() *Client {
+func (m GroupMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -930,7 +1079,7 @@ func (m MultiWordSchemaMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MultiWordSchemaMutation) Tx() (*Tx, error) {
+func (m GroupMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -941,7 +1090,7 @@ func (m MultiWordSchemaMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MultiWordSchemaMutation) ID() (id int, exists bool) {
+func (m *GroupMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -952,7 +1101,7 @@ func (m *MultiWordSchemaMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MultiWordSchemaMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *GroupMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -961,70 +1110,124 @@ func (m *MultiWordSchemaMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MultiWordSchema.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUnit sets the "unit" field.
-func (m *MultiWordSchemaMutation) SetUnit(value multiwordschema.Unit) {
-	m.unit = &value
+// SetName sets the "name" field.
+func (m *GroupMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Unit returns the value of the "unit" field in the mutation.
-func (m *MultiWordSchemaMutation) Unit() (r multiwordschema.Unit, exists bool) {
-	v := m.unit
+// Name returns the value of the "name" field in the mutation.
+func (m *GroupMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUnit returns the old "unit" field's value of the MultiWordSchema entity.
-// If the MultiWordSchema object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MultiWordSchemaMutation) OldUnit(ctx context.Context) (v multiwordschema.Unit, err error) {
+func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUnit is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUnit requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUnit: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Unit, nil
+	return oldValue.Name, nil
 }
 
-// ResetUnit resets all changes to the "unit" field.
-func (m *MultiWordSchemaMutation) ResetUnit() {
-	m.unit = nil
+// ResetName resets all changes to the "name" field.
+func (m *GroupMutation) ResetName() {
+	m.name = nil
 }
 
-// Where appends a list predicates to the MultiWordSchemaMutation builder.
-func (m *MultiWordSchemaMutation) Where(ps ...predicate.MultiWordSchema) {
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *GroupMutation) AddUserIDs(ids ...uint32) {
+	if m.users == nil {
+		m.users = make(map[uint32]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *GroupMutation) ClearUsers() {
+	m.clearedusers = true
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *GroupMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *GroupMutation) RemoveUserIDs(ids ...uint32) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[uint32]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *GroupMutation) RemovedUsersIDs() (ids []uint32) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *GroupMutation) UsersIDs() (ids []uint32) {
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *GroupMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
+}
+
+// Where appends a list predicates to the GroupMutation builder.
+func (m *GroupMutation) Where(ps ...predicate.Group) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *MultiWordSchemaMutation) Op() Op {
+func (m *GroupMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (MultiWordSchema).
-func (m *MultiWordSchemaMutation) Type() string {
+// Type returns the node type of this mutation (Group).
+func (m *GroupMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MultiWordSchemaMutation) Fields() []string {
+func (m *GroupMutation) Fields() []string {
 	fields := make([]string, 0, 1)
-	if m.unit != nil {
-		fields = append(fields, multiwordschema.FieldUnit)
+	if m.name != nil {
+		fields = append(fields, group.FieldName)
 	}
 	return fields
 }
@@ -1032,10 +1235,10 @@ func (m *MultiWordSchemaMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MultiWordSchemaMutation) Field(name string) (ent.Value, bool) {
+func (m *GroupMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case multiwordschema.FieldUnit:
-		return m.Unit()
+	case group.FieldName:
+		return m.Name()
 	}
 	return nil, false
 }
@@ -1043,155 +1246,190 @@ func (m *MultiWordSchemaMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MultiWordSchemaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case multiwordschema.FieldUnit:
-		return m.OldUnit(ctx)
+	case group.FieldName:
+		return m.OldName(ctx)
 	}
-	return nil, fmt.Errorf("unknown MultiWordSchema field %s", name)
+	return nil, fmt.Errorf("unknown Group field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MultiWordSchemaMutation) SetField(name string, value ent.Value) error {
+func (m *GroupMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case multiwordschema.FieldUnit:
-		v, ok := value.(multiwordschema.Unit)
+	case group.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUnit(v)
+		m.SetName(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MultiWordSchema field %s", name)
+	return fmt.Errorf("unknown Group field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MultiWordSchemaMutation) AddedFields() []string {
+func (m *GroupMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MultiWordSchemaMutation) AddedField(name string) (ent.Value, bool) {
+func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MultiWordSchemaMutation) AddField(name string, value ent.Value) error {
+func (m *GroupMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown MultiWordSchema numeric field %s", name)
+	return fmt.Errorf("unknown Group numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MultiWordSchemaMutation) ClearedFields() []string {
+func (m *GroupMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MultiWordSchemaMutation) FieldCleared(name string) bool {
+func (m *GroupMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MultiWordSchemaMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MultiWordSchema nullable field %s", name)
+func (m *GroupMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Group nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MultiWordSchemaMutation) ResetField(name string) error {
+func (m *GroupMutation) ResetField(name string) error {
 	switch name {
-	case multiwordschema.FieldUnit:
-		m.ResetUnit()
+	case group.FieldName:
+		m.ResetName()
 		return nil
 	}
-	return fmt.Errorf("unknown MultiWordSchema field %s", name)
+	return fmt.Errorf("unknown Group field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MultiWordSchemaMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *GroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.users != nil {
+		edges = append(edges, group.EdgeUsers)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MultiWordSchemaMutation) AddedIDs(name string) []ent.Value {
+func (m *GroupMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case group.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MultiWordSchemaMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *GroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedusers != nil {
+		edges = append(edges, group.EdgeUsers)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MultiWordSchemaMutation) RemovedIDs(name string) []ent.Value {
+func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case group.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MultiWordSchemaMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *GroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedusers {
+		edges = append(edges, group.EdgeUsers)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MultiWordSchemaMutation) EdgeCleared(name string) bool {
+func (m *GroupMutation) EdgeCleared(name string) bool {
+	switch name {
+	case group.EdgeUsers:
+		return m.clearedusers
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MultiWordSchemaMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown MultiWordSchema unique edge %s", name)
+func (m *GroupMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Group unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MultiWordSchemaMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown MultiWordSchema edge %s", name)
+func (m *GroupMutation) ResetEdge(name string) error {
+	switch name {
+	case group.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	}
+	return fmt.Errorf("unknown Group edge %s", name)
 }
 
-// NilExampleMutation represents an operation that mutates the NilExample nodes in the graph.
-type NilExampleMutation struct {
+// LabelMutation represents an operation that mutates the Label nodes in the graph.
+type LabelMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *int
-	str_nil       *string
-	time_nil      *time.Time
+	id            *string
+	color         *string
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*NilExample, error)
-	predicates    []predicate.NilExample
+	oldValue      func(context.Context) (*Label, error)
+	predicates    []predicate.Label
 }
 
-var _ ent.Mutation = (*NilExampleMutation)(nil)
+var _ ent.Mutation = (*LabelMutation)(nil)
 
-// nilexampleOption allows management of the mutation configuration using functional options.
-type nilexampleOption func(*NilExampleMutation)
+// labelOption allows management of the mutation configuration using functional options.
+type labelOption func(*LabelMutation)
 
-// newNilExampleMutation creates new mutation for the NilExample entity.
-func newNilExampleMutation(c config, op Op, opts ...nilexampleOption) *NilExampleMutation {
-	m := &NilExampleMutation{
+// newLabelMutation creates new mutation for the Label entity.
+func newLabelMutation(c config, op Op, opts ...labelOption) *LabelMutation {
+	m := &LabelMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNilExample,
+		typ:           TypeLabel,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1200,20 +1438,20 @@ func newNilExampleMutation(c config, op Op, opts ...nilexampleOption) *NilExampl
 	return m
 }
 
-// withNilExampleID sets the ID field of the mutation.
-func withNilExampleID(id int) nilexampleOption {
-	return func(m *NilExampleMutation) {
+// withLabelID sets the ID field of the mutation.
+func withLabelID(id string) labelOption {
+	return func(m *LabelMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *NilExample
+			value *Label
 		)
-		m.oldValue = func(ctx context.Context) (*NilExample, error) {
+		m.oldValue = func(ctx context.Context) (*Label, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().NilExample.Get(ctx, id)
+					value, err = m.Client().Label.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1222,10 +1460,10 @@ func withNilExampleID(id int) nilexampleOption {
 	}
 }
 
-// withNilExample sets the old NilExample of the mutation.
-func withNilExample(node *NilExample) nilexampleOption {
-	return func(m *NilExampleMutation) {
-		m.oldValue = func(context.Context) (*NilExample, error) {
+// withLabel sets the old Label of the mutation.
+func withLabel(node *Label) labelOption {
+	return func(m *LabelMutation) {
+		m.oldValue = func(context.Context) (*Label, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1234,7 +1472,7 @@ func withNilExample(node *NilExample) nilexampleOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NilExampleMutation) Client() *Client {
+func (m LabelMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1242,7 +1480,7 @@ func (m NilExampleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NilExampleMutation) Tx() (*Tx, error) {
+func (m LabelMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1251,9 +1489,15 @@ func (m NilExampleMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Label entities.
+func (m *LabelMutation) SetID(id string) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NilExampleMutation) ID() (id int, exists bool) {
+func (m *LabelMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1264,144 +1508,79 @@ func (m *NilExampleMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NilExampleMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *LabelMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []string{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().NilExample.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Label.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetStrNil sets the "str_nil" field.
-func (m *NilExampleMutation) SetStrNil(s string) {
-	m.str_nil = &s
-}
-
-// StrNil returns the value of the "str_nil" field in the mutation.
-func (m *NilExampleMutation) StrNil() (r string, exists bool) {
-	v := m.str_nil
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStrNil returns the old "str_nil" field's value of the NilExample entity.
-// If the NilExample object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NilExampleMutation) OldStrNil(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStrNil is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStrNil requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStrNil: %w", err)
-	}
-	return oldValue.StrNil, nil
-}
-
-// ClearStrNil clears the value of the "str_nil" field.
-func (m *NilExampleMutation) ClearStrNil() {
-	m.str_nil = nil
-	m.clearedFields[nilexample.FieldStrNil] = struct{}{}
-}
-
-// StrNilCleared returns if the "str_nil" field was cleared in this mutation.
-func (m *NilExampleMutation) StrNilCleared() bool {
-	_, ok := m.clearedFields[nilexample.FieldStrNil]
-	return ok
-}
-
-// ResetStrNil resets all changes to the "str_nil" field.
-func (m *NilExampleMutation) ResetStrNil() {
-	m.str_nil = nil
-	delete(m.clearedFields, nilexample.FieldStrNil)
-}
-
-// SetTimeNil sets the "time_nil" field.
-func (m *NilExampleMutation) SetTimeNil(t time.Time) {
-	m.time_nil = &t
+// SetColor sets the "color" field.
+func (m *LabelMutation) SetColor(s string) {
+	m.color = &s
 }
 
-// TimeNil returns the value of the "time_nil" field in the mutation.
-func (m *NilExampleMutation) TimeNil() (r time.Time, exists bool) {
-	v := m.time_nil
+// Color returns the value of the "color" field in the mutation.
+func (m *LabelMutation) Color() (r string, exists bool) {
+	v := m.color
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimeNil returns the old "time_nil" field's value of the NilExample entity.
-// If the NilExample object wasn't provided to the builder, the object is fetched from the database.
+// OldColor returns the old "color" field's value of the Label entity.
+// If the Label object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NilExampleMutation) OldTimeNil(ctx context.Context) (v *time.Time, err error) {
+func (m *LabelMutation) OldColor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimeNil is only allowed on UpdateOne operations")
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimeNil requires an ID field in the mutation")
+		return v, errors.New("OldColor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimeNil: %w", err)
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
 	}
-	return oldValue.TimeNil, nil
-}
-
-// ClearTimeNil clears the value of the "time_nil" field.
-func (m *NilExampleMutation) ClearTimeNil() {
-	m.time_nil = nil
-	m.clearedFields[nilexample.FieldTimeNil] = struct{}{}
+	return oldValue.Color, nil
 }
 
-// TimeNilCleared returns if the "time_nil" field was cleared in this mutation.
-func (m *NilExampleMutation) TimeNilCleared() bool {
-	_, ok := m.clearedFields[nilexample.FieldTimeNil]
-	return ok
-}
-
-// ResetTimeNil resets all changes to the "time_nil" field.
-func (m *NilExampleMutation) ResetTimeNil() {
-	m.time_nil = nil
-	delete(m.clearedFields, nilexample.FieldTimeNil)
+// ResetColor resets all changes to the "color" field.
+func (m *LabelMutation) ResetColor() {
+	m.color = nil
 }
 
-// Where appends a list predicates to the NilExampleMutation builder.
-func (m *NilExampleMutation) Where(ps ...predicate.NilExample) {
+// Where appends a list predicates to the LabelMutation builder.
+func (m *LabelMutation) Where(ps ...predicate.Label) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *NilExampleMutation) Op() Op {
+func (m *LabelMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (NilExample).
-func (m *NilExampleMutation) Type() string {
+// Type returns the node type of this mutation (Label).
+func (m *LabelMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NilExampleMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.str_nil != nil {
-		fields = append(fields, nilexample.FieldStrNil)
-	}
-	if m.time_nil != nil {
-		fields = append(fields, nilexample.FieldTimeNil)
+func (m *LabelMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.color != nil {
+		fields = append(fields, label.FieldColor)
 	}
 	return fields
 }
@@ -1409,12 +1588,10 @@ func (m *NilExampleMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NilExampleMutation) Field(name string) (ent.Value, bool) {
+func (m *LabelMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case nilexample.FieldStrNil:
-		return m.StrNil()
-	case nilexample.FieldTimeNil:
-		return m.TimeNil()
+	case label.FieldColor:
+		return m.Color()
 	}
 	return nil, false
 }
@@ -1422,185 +1599,154 @@ func (m *NilExampleMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NilExampleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *LabelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case nilexample.FieldStrNil:
-		return m.OldStrNil(ctx)
-	case nilexample.FieldTimeNil:
-		return m.OldTimeNil(ctx)
+	case label.FieldColor:
+		return m.OldColor(ctx)
 	}
-	return nil, fmt.Errorf("unknown NilExample field %s", name)
+	return nil, fmt.Errorf("unknown Label field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NilExampleMutation) SetField(name string, value ent.Value) error {
+func (m *LabelMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case nilexample.FieldStrNil:
+	case label.FieldColor:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStrNil(v)
-		return nil
-	case nilexample.FieldTimeNil:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTimeNil(v)
+		m.SetColor(v)
 		return nil
 	}
-	return fmt.Errorf("unknown NilExample field %s", name)
+	return fmt.Errorf("unknown Label field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NilExampleMutation) AddedFields() []string {
+func (m *LabelMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NilExampleMutation) AddedField(name string) (ent.Value, bool) {
+func (m *LabelMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NilExampleMutation) AddField(name string, value ent.Value) error {
+func (m *LabelMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown NilExample numeric field %s", name)
+	return fmt.Errorf("unknown Label numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NilExampleMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(nilexample.FieldStrNil) {
-		fields = append(fields, nilexample.FieldStrNil)
-	}
-	if m.FieldCleared(nilexample.FieldTimeNil) {
-		fields = append(fields, nilexample.FieldTimeNil)
-	}
-	return fields
+func (m *LabelMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NilExampleMutation) FieldCleared(name string) bool {
+func (m *LabelMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NilExampleMutation) ClearField(name string) error {
-	switch name {
-	case nilexample.FieldStrNil:
-		m.ClearStrNil()
-		return nil
-	case nilexample.FieldTimeNil:
-		m.ClearTimeNil()
-		return nil
-	}
-	return fmt.Errorf("unknown NilExample nullable field %s", name)
+func (m *LabelMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Label nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NilExampleMutation) ResetField(name string) error {
+func (m *LabelMutation) ResetField(name string) error {
 	switch name {
-	case nilexample.FieldStrNil:
-		m.ResetStrNil()
-		return nil
-	case nilexample.FieldTimeNil:
-		m.ResetTimeNil()
+	case label.FieldColor:
+		m.ResetColor()
 		return nil
 	}
-	return fmt.Errorf("unknown NilExample field %s", name)
+	return fmt.Errorf("unknown Label field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NilExampleMutation) AddedEdges() []string {
+func (m *LabelMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NilExampleMutation) AddedIDs(name string) []ent.Value {
+func (m *LabelMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NilExampleMutation) RemovedEdges() []string {
+func (m *LabelMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NilExampleMutation) RemovedIDs(name string) []ent.Value {
+func (m *LabelMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NilExampleMutation) ClearedEdges() []string {
+func (m *LabelMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NilExampleMutation) EdgeCleared(name string) bool {
+func (m *LabelMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NilExampleMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown NilExample unique edge %s", name)
+func (m *LabelMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Label unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NilExampleMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown NilExample edge %s", name)
+func (m *LabelMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Label edge %s", name)
 }
 
-// PetMutation represents an operation that mutates the Pet nodes in the graph.
-type PetMutation struct {
+// MultiWordSchemaMutation represents an operation that mutates the MultiWordSchema nodes in the graph.
+type MultiWordSchemaMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	clearedFields     map[string]struct{}
-	owner             *uint32
-	clearedowner      bool
-	attachment        map[uuid.UUID]struct{}
-	removedattachment map[uuid.UUID]struct{}
-	clearedattachment bool
-	done              bool
-	oldValue          func(context.Context) (*Pet, error)
-	predicates        []predicate.Pet
+	op            Op
+	typ           string
+	id            *int
+	unit          *multiwordschema.Unit
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MultiWordSchema, error)
+	predicates    []predicate.MultiWordSchema
 }
 
-var _ ent.Mutation = (*PetMutation)(nil)
+var _ ent.Mutation = (*MultiWordSchemaMutation)(nil)
 
-// petOption allows management of the mutation configuration using functional options.
-type petOption func(*PetMutation)
+// multiwordschemaOption allows management of the mutation configuration using functional options.
+type multiwordschemaOption func(*MultiWordSchemaMutation)
 
-// newPetMutation creates new mutation for the Pet entity.
-func newPetMutation(c config, op Op, opts ...petOption) *PetMutation {
-	m := &PetMutation{
+// newMultiWordSchemaMutation creates new mutation for the MultiWordSchema entity.
+func newMultiWordSchemaMutation(c config, op Op, opts ...multiwordschemaOption) *MultiWordSchemaMutation {
+	m := &MultiWordSchemaMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePet,
+		typ:           TypeMultiWordSchema,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1609,20 +1755,20 @@ func newPetMutation(c config, op Op, opts ...petOption) *PetMutation {
 	return m
 }
 
-// withPetID sets the ID field of the mutation.
-func withPetID(id int) petOption {
-	return func(m *PetMutation) {
+// withMultiWordSchemaID sets the ID field of the mutation.
+func withMultiWordSchemaID(id int) multiwordschemaOption {
+	return func(m *MultiWordSchemaMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Pet
+			value *MultiWordSchema
 		)
-		m.oldValue = func(ctx context.Context) (*Pet, error) {
+		m.oldValue = func(ctx context.Context) (*MultiWordSchema, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Pet.Get(ctx, id)
+					value, err = m.Client().MultiWordSchema.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1631,10 +1777,10 @@ func withPetID(id int) petOption {
 	}
 }
 
-// withPet sets the old Pet of the mutation.
-func withPet(node *Pet) petOption {
-	return func(m *PetMutation) {
-		m.oldValue = func(context.Context) (*Pet, error) {
+// withMultiWordSchema sets the old MultiWordSchema of the mutation.
+func withMultiWordSchema(node *MultiWordSchema) multiwordschemaOption {
+	return func(m *MultiWordSchemaMutation) {
+		m.oldValue = func(context.Context) (*MultiWordSchema, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1643,7 +1789,7 @@ func withPet(node *Pet) petOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PetMutation) Client() *Client {
+func (m MultiWordSchemaMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1651,7 +1797,7 @@ func (m PetMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PetMutation) Tx() (*Tx, error) {
+func (m MultiWordSchemaMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1662,7 +1808,7 @@ func (m PetMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PetMutation) ID() (id int, exists bool) {
+func (m *MultiWordSchemaMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1673,7 +1819,7 @@ func (m *PetMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PetMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *MultiWordSchemaMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1682,322 +1828,1917 @@ func (m *PetMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Pet.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().MultiWordSchema.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *PetMutation) SetOwnerID(id uint32) {
-	m.owner = &id
-}
-
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *PetMutation) ClearOwner() {
-	m.clearedowner = true
+// SetUnit sets the "unit" field.
+func (m *MultiWordSchemaMutation) SetUnit(value multiwordschema.Unit) {
+	m.unit = &value
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *PetMutation) OwnerCleared() bool {
-	return m.clearedowner
-}
-
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *PetMutation) OwnerID() (id uint32, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
-	}
-	return
-}
-
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *PetMutation) OwnerIDs() (ids []uint32) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetOwner resets all changes to the "owner" edge.
-func (m *PetMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
-}
-
-// AddAttachmentIDs adds the "attachment" edge to the Attachment entity by ids.
-func (m *PetMutation) AddAttachmentIDs(ids ...uuid.UUID) {
-	if m.attachment == nil {
-		m.attachment = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.attachment[ids[i]] = struct{}{}
+// Unit returns the value of the "unit" field in the mutation.
+func (m *MultiWordSchemaMutation) Unit() (r multiwordschema.Unit, exists bool) {
+	v := m.unit
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearAttachment clears the "attachment" edge to the Attachment entity.
-func (m *PetMutation) ClearAttachment() {
-	m.clearedattachment = true
-}
-
-// AttachmentCleared reports if the "attachment" edge to the Attachment entity was cleared.
-func (m *PetMutation) AttachmentCleared() bool {
-	return m.clearedattachment
-}
-
-// RemoveAttachmentIDs removes the "attachment" edge to the Attachment entity by IDs.
-func (m *PetMutation) RemoveAttachmentIDs(ids ...uuid.UUID) {
-	if m.removedattachment == nil {
-		m.removedattachment = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.attachment, ids[i])
-		m.removedattachment[ids[i]] = struct{}{}
+// OldUnit returns the old "unit" field's value of the MultiWordSchema entity.
+// If the MultiWordSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultiWordSchemaMutation) OldUnit(ctx context.Context) (v multiwordschema.Unit, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnit is only allowed on UpdateOne operations")
 	}
-}
-
-// RemovedAttachment returns the removed IDs of the "attachment" edge to the Attachment entity.
-func (m *PetMutation) RemovedAttachmentIDs() (ids []uuid.UUID) {
-	for id := range m.removedattachment {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnit requires an ID field in the mutation")
 	}
-	return
-}
-
-// AttachmentIDs returns the "attachment" edge IDs in the mutation.
-func (m *PetMutation) AttachmentIDs() (ids []uuid.UUID) {
-	for id := range m.attachment {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnit: %w", err)
 	}
-	return
+	return oldValue.Unit, nil
 }
 
-// ResetAttachment resets all changes to the "attachment" edge.
-func (m *PetMutation) ResetAttachment() {
-	m.attachment = nil
-	m.clearedattachment = false
-	m.removedattachment = nil
+// ResetUnit resets all changes to the "unit" field.
+func (m *MultiWordSchemaMutation) ResetUnit() {
+	m.unit = nil
 }
 
-// Where appends a list predicates to the PetMutation builder.
-func (m *PetMutation) Where(ps ...predicate.Pet) {
+// Where appends a list predicates to the MultiWordSchemaMutation builder.
+func (m *MultiWordSchemaMutation) Where(ps ...predicate.MultiWordSchema) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *PetMutation) Op() Op {
+func (m *MultiWordSchemaMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Pet).
-func (m *PetMutation) Type() string {
+// Type returns the node type of this mutation (MultiWordSchema).
+func (m *MultiWordSchemaMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PetMutation) Fields() []string {
-	fields := make([]string, 0, 0)
+func (m *MultiWordSchemaMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.unit != nil {
+		fields = append(fields, multiwordschema.FieldUnit)
+	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PetMutation) Field(name string) (ent.Value, bool) {
+func (m *MultiWordSchemaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case multiwordschema.FieldUnit:
+		return m.Unit()
+	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown Pet field %s", name)
+func (m *MultiWordSchemaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case multiwordschema.FieldUnit:
+		return m.OldUnit(ctx)
+	}
+	return nil, fmt.Errorf("unknown MultiWordSchema field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PetMutation) SetField(name string, value ent.Value) error {
+func (m *MultiWordSchemaMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case multiwordschema.FieldUnit:
+		v, ok := value.(multiwordschema.Unit)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnit(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Pet field %s", name)
+	return fmt.Errorf("unknown MultiWordSchema field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PetMutation) AddedFields() []string {
+func (m *MultiWordSchemaMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PetMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MultiWordSchemaMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PetMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown Pet numeric field %s", name)
+func (m *MultiWordSchemaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MultiWordSchema numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PetMutation) ClearedFields() []string {
+func (m *MultiWordSchemaMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PetMutation) FieldCleared(name string) bool {
+func (m *MultiWordSchemaMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PetMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Pet nullable field %s", name)
+func (m *MultiWordSchemaMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MultiWordSchema nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PetMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown Pet field %s", name)
+func (m *MultiWordSchemaMutation) ResetField(name string) error {
+	switch name {
+	case multiwordschema.FieldUnit:
+		m.ResetUnit()
+		return nil
+	}
+	return fmt.Errorf("unknown MultiWordSchema field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PetMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.owner != nil {
-		edges = append(edges, pet.EdgeOwner)
-	}
-	if m.attachment != nil {
-		edges = append(edges, pet.EdgeAttachment)
-	}
+func (m *MultiWordSchemaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PetMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case pet.EdgeOwner:
-		if id := m.owner; id != nil {
-			return []ent.Value{*id}
-		}
-	case pet.EdgeAttachment:
-		ids := make([]ent.Value, 0, len(m.attachment))
-		for id := range m.attachment {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *MultiWordSchemaMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PetMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedattachment != nil {
-		edges = append(edges, pet.EdgeAttachment)
-	}
+func (m *MultiWordSchemaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PetMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case pet.EdgeAttachment:
-		ids := make([]ent.Value, 0, len(m.removedattachment))
-		for id := range m.removedattachment {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *MultiWordSchemaMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PetMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedowner {
-		edges = append(edges, pet.EdgeOwner)
-	}
-	if m.clearedattachment {
-		edges = append(edges, pet.EdgeAttachment)
-	}
+func (m *MultiWordSchemaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PetMutation) EdgeCleared(name string) bool {
-	switch name {
-	case pet.EdgeOwner:
-		return m.clearedowner
-	case pet.EdgeAttachment:
-		return m.clearedattachment
-	}
+func (m *MultiWordSchemaMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PetMutation) ClearEdge(name string) error {
-	switch name {
-	case pet.EdgeOwner:
-		m.ClearOwner()
-		return nil
-	}
-	return fmt.Errorf("unknown Pet unique edge %s", name)
+func (m *MultiWordSchemaMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MultiWordSchema unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PetMutation) ResetEdge(name string) error {
-	switch name {
-	case pet.EdgeOwner:
-		m.ResetOwner()
-		return nil
-	case pet.EdgeAttachment:
-		m.ResetAttachment()
-		return nil
-	}
-	return fmt.Errorf("unknown Pet edge %s", name)
+func (m *MultiWordSchemaMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MultiWordSchema edge %s", name)
 }
 
-// PonyMutation represents an operation that mutates the Pony nodes in the graph.
-type PonyMutation struct {
+// NilExampleMutation represents an operation that mutates the NilExample nodes in the graph.
+type NilExampleMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	name          *string
+	str_nil       *string
+	time_nil      *time.Time
+	time_str_nil  *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*Pony, error)
-	predicates    []predicate.Pony
+	oldValue      func(context.Context) (*NilExample, error)
+	predicates    []predicate.NilExample
 }
 
-var _ ent.Mutation = (*PonyMutation)(nil)
+var _ ent.Mutation = (*NilExampleMutation)(nil)
 
-// ponyOption allows management of the mutation configuration using functional options.
-type ponyOption func(*PonyMutation)
+// nilexampleOption allows management of the mutation configuration using functional options.
+type nilexampleOption func(*NilExampleMutation)
+
+// newNilExampleMutation creates new mutation for the NilExample entity.
+func newNilExampleMutation(c config, op Op, opts ...nilexampleOption) *NilExampleMutation {
+	m := &NilExampleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNilExample,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNilExampleID sets the ID field of the mutation.
+func withNilExampleID(id int) nilexampleOption {
+	return func(m *NilExampleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NilExample
+		)
+		m.oldValue = func(ctx context.Context) (*NilExample, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NilExample.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNilExample sets the old NilExample of the mutation.
+func withNilExample(node *NilExample) nilexampleOption {
+	return func(m *NilExampleMutation) {
+		m.oldValue = func(context.Context) (*NilExample, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NilExampleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NilExampleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NilExampleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NilExampleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NilExample.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStrNil sets the "str_nil" field.
+func (m *NilExampleMutation) SetStrNil(s string) {
+	m.str_nil = &s
+}
+
+// StrNil returns the value of the "str_nil" field in the mutation.
+func (m *NilExampleMutation) StrNil() (r string, exists bool) {
+	v := m.str_nil
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrNil returns the old "str_nil" field's value of the NilExample entity.
+// If the NilExample object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NilExampleMutation) OldStrNil(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStrNil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStrNil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrNil: %w", err)
+	}
+	return oldValue.StrNil, nil
+}
+
+// ClearStrNil clears the value of the "str_nil" field.
+func (m *NilExampleMutation) ClearStrNil() {
+	m.str_nil = nil
+	m.clearedFields[nilexample.FieldStrNil] = struct{}{}
+}
+
+// StrNilCleared returns if the "str_nil" field was cleared in this mutation.
+func (m *NilExampleMutation) StrNilCleared() bool {
+	_, ok := m.clearedFields[nilexample.FieldStrNil]
+	return ok
+}
+
+// ResetStrNil resets all changes to the "str_nil" field.
+func (m *NilExampleMutation) ResetStrNil() {
+	m.str_nil = nil
+	delete(m.clearedFields, nilexample.FieldStrNil)
+}
+
+// SetTimeNil sets the "time_nil" field.
+func (m *NilExampleMutation) SetTimeNil(t time.Time) {
+	m.time_nil = &t
+}
+
+// TimeNil returns the value of the "time_nil" field in the mutation.
+func (m *NilExampleMutation) TimeNil() (r time.Time, exists bool) {
+	v := m.time_nil
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeNil returns the old "time_nil" field's value of the NilExample entity.
+// If the NilExample object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NilExampleMutation) OldTimeNil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeNil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeNil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeNil: %w", err)
+	}
+	return oldValue.TimeNil, nil
+}
+
+// ClearTimeNil clears the value of the "time_nil" field.
+func (m *NilExampleMutation) ClearTimeNil() {
+	m.time_nil = nil
+	m.clearedFields[nilexample.FieldTimeNil] = struct{}{}
+}
+
+// TimeNilCleared returns if the "time_nil" field was cleared in this mutation.
+func (m *NilExampleMutation) TimeNilCleared() bool {
+	_, ok := m.clearedFields[nilexample.FieldTimeNil]
+	return ok
+}
+
+// ResetTimeNil resets all changes to the "time_nil" field.
+func (m *NilExampleMutation) ResetTimeNil() {
+	m.time_nil = nil
+	delete(m.clearedFields, nilexample.FieldTimeNil)
+}
+
+// SetTimeStrNil sets the "time_str_nil" field.
+func (m *NilExampleMutation) SetTimeStrNil(t time.Time) {
+	m.time_str_nil = &t
+}
+
+// TimeStrNil returns the value of the "time_str_nil" field in the mutation.
+func (m *NilExampleMutation) TimeStrNil() (r time.Time, exists bool) {
+	v := m.time_str_nil
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeStrNil returns the old "time_str_nil" field's value of the NilExample entity.
+// If the NilExample object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NilExampleMutation) OldTimeStrNil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeStrNil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeStrNil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeStrNil: %w", err)
+	}
+	return oldValue.TimeStrNil, nil
+}
+
+// ClearTimeStrNil clears the value of the "time_str_nil" field.
+func (m *NilExampleMutation) ClearTimeStrNil() {
+	m.time_str_nil = nil
+	m.clearedFields[nilexample.FieldTimeStrNil] = struct{}{}
+}
+
+// TimeStrNilCleared returns if the "time_str_nil" field was cleared in this mutation.
+func (m *NilExampleMutation) TimeStrNilCleared() bool {
+	_, ok := m.clearedFields[nilexample.FieldTimeStrNil]
+	return ok
+}
+
+// ResetTimeStrNil resets all changes to the "time_str_nil" field.
+func (m *NilExampleMutation) ResetTimeStrNil() {
+	m.time_str_nil = nil
+	delete(m.clearedFields, nilexample.FieldTimeStrNil)
+}
+
+// Where appends a list predicates to the NilExampleMutation builder.
+func (m *NilExampleMutation) Where(ps ...predicate.NilExample) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *NilExampleMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (NilExample).
+func (m *NilExampleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NilExampleMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.str_nil != nil {
+		fields = append(fields, nilexample.FieldStrNil)
+	}
+	if m.time_nil != nil {
+		fields = append(fields, nilexample.FieldTimeNil)
+	}
+	if m.time_str_nil != nil {
+		fields = append(fields, nilexample.FieldTimeStrNil)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NilExampleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case nilexample.FieldStrNil:
+		return m.StrNil()
+	case nilexample.FieldTimeNil:
+		return m.TimeNil()
+	case nilexample.FieldTimeStrNil:
+		return m.TimeStrNil()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NilExampleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case nilexample.FieldStrNil:
+		return m.OldStrNil(ctx)
+	case nilexample.FieldTimeNil:
+		return m.OldTimeNil(ctx)
+	case nilexample.FieldTimeStrNil:
+		return m.OldTimeStrNil(ctx)
+	}
+	return nil, fmt.Errorf("unknown NilExample field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NilExampleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case nilexample.FieldStrNil:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrNil(v)
+		return nil
+	case nilexample.FieldTimeNil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeNil(v)
+		return nil
+	case nilexample.FieldTimeStrNil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeStrNil(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NilExample field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NilExampleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NilExampleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NilExampleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NilExample numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NilExampleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(nilexample.FieldStrNil) {
+		fields = append(fields, nilexample.FieldStrNil)
+	}
+	if m.FieldCleared(nilexample.FieldTimeNil) {
+		fields = append(fields, nilexample.FieldTimeNil)
+	}
+	if m.FieldCleared(nilexample.FieldTimeStrNil) {
+		fields = append(fields, nilexample.FieldTimeStrNil)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NilExampleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NilExampleMutation) ClearField(name string) error {
+	switch name {
+	case nilexample.FieldStrNil:
+		m.ClearStrNil()
+		return nil
+	case nilexample.FieldTimeNil:
+		m.ClearTimeNil()
+		return nil
+	case nilexample.FieldTimeStrNil:
+		m.ClearTimeStrNil()
+		return nil
+	}
+	return fmt.Errorf("unknown NilExample nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NilExampleMutation) ResetField(name string) error {
+	switch name {
+	case nilexample.FieldStrNil:
+		m.ResetStrNil()
+		return nil
+	case nilexample.FieldTimeNil:
+		m.ResetTimeNil()
+		return nil
+	case nilexample.FieldTimeStrNil:
+		m.ResetTimeStrNil()
+		return nil
+	}
+	return fmt.Errorf("unknown NilExample field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NilExampleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NilExampleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NilExampleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NilExampleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NilExampleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NilExampleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NilExampleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown NilExample unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NilExampleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown NilExample edge %s", name)
+}
+
+// PetMutation represents an operation that mutates the Pet nodes in the graph.
+type PetMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	size              *schema.Size
+	clearedFields     map[string]struct{}
+	owner             *uint32
+	clearedowner      bool
+	attachment        map[uuid.UUID]struct{}
+	removedattachment map[uuid.UUID]struct{}
+	clearedattachment bool
+	done              bool
+	oldValue          func(context.Context) (*Pet, error)
+	predicates        []predicate.Pet
+}
+
+var _ ent.Mutation = (*PetMutation)(nil)
+
+// petOption allows management of the mutation configuration using functional options.
+type petOption func(*PetMutation)
+
+// newPetMutation creates new mutation for the Pet entity.
+func newPetMutation(c config, op Op, opts ...petOption) *PetMutation {
+	m := &PetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePet,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPetID sets the ID field of the mutation.
+func withPetID(id int) petOption {
+	return func(m *PetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Pet
+		)
+		m.oldValue = func(ctx context.Context) (*Pet, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Pet.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPet sets the old Pet of the mutation.
+func withPet(node *Pet) petOption {
+	return func(m *PetMutation) {
+		m.oldValue = func(context.Context) (*Pet, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PetMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PetMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Pet.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSize sets the "size" field.
+func (m *PetMutation) SetSize(s schema.Size) {
+	m.size = &s
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *PetMutation) Size() (r schema.Size, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldSize(ctx context.Context) (v schema.Size, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *PetMutation) ResetSize() {
+	m.size = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *PetMutation) SetOwnerID(id uint32) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *PetMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *PetMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *PetMutation) OwnerID() (id uint32, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *PetMutation) OwnerIDs() (ids []uint32) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *PetMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// AddAttachmentIDs adds the "attachment" edge to the Attachment entity by ids.
+func (m *PetMutation) AddAttachmentIDs(ids ...uuid.UUID) {
+	if m.attachment == nil {
+		m.attachment = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.attachment[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttachment clears the "attachment" edge to the Attachment entity.
+func (m *PetMutation) ClearAttachment() {
+	m.clearedattachment = true
+}
+
+// AttachmentCleared reports if the "attachment" edge to the Attachment entity was cleared.
+func (m *PetMutation) AttachmentCleared() bool {
+	return m.clearedattachment
+}
+
+// RemoveAttachmentIDs removes the "attachment" edge to the Attachment entity by IDs.
+func (m *PetMutation) RemoveAttachmentIDs(ids ...uuid.UUID) {
+	if m.removedattachment == nil {
+		m.removedattachment = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.attachment, ids[i])
+		m.removedattachment[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttachment returns the removed IDs of the "attachment" edge to the Attachment entity.
+func (m *PetMutation) RemovedAttachmentIDs() (ids []uuid.UUID) {
+	for id := range m.removedattachment {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttachmentIDs returns the "attachment" edge IDs in the mutation.
+func (m *PetMutation) AttachmentIDs() (ids []uuid.UUID) {
+	for id := range m.attachment {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttachment resets all changes to the "attachment" edge.
+func (m *PetMutation) ResetAttachment() {
+	m.attachment = nil
+	m.clearedattachment = false
+	m.removedattachment = nil
+}
+
+// Where appends a list predicates to the PetMutation builder.
+func (m *PetMutation) Where(ps ...predicate.Pet) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *PetMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Pet).
+func (m *PetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PetMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.size != nil {
+		fields = append(fields, pet.FieldSize)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pet.FieldSize:
+		return m.Size()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pet.FieldSize:
+		return m.OldSize(ctx)
+	}
+	return nil, fmt.Errorf("unknown Pet field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pet.FieldSize:
+		v, ok := value.(schema.Size)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Pet field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PetMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PetMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Pet numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PetMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PetMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Pet nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PetMutation) ResetField(name string) error {
+	switch name {
+	case pet.FieldSize:
+		m.ResetSize()
+		return nil
+	}
+	return fmt.Errorf("unknown Pet field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.owner != nil {
+		edges = append(edges, pet.EdgeOwner)
+	}
+	if m.attachment != nil {
+		edges = append(edges, pet.EdgeAttachment)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PetMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pet.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case pet.EdgeAttachment:
+		ids := make([]ent.Value, 0, len(m.attachment))
+		for id := range m.attachment {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedattachment != nil {
+		edges = append(edges, pet.EdgeAttachment)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PetMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case pet.EdgeAttachment:
+		ids := make([]ent.Value, 0, len(m.removedattachment))
+		for id := range m.removedattachment {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedowner {
+		edges = append(edges, pet.EdgeOwner)
+	}
+	if m.clearedattachment {
+		edges = append(edges, pet.EdgeAttachment)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PetMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pet.EdgeOwner:
+		return m.clearedowner
+	case pet.EdgeAttachment:
+		return m.clearedattachment
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PetMutation) ClearEdge(name string) error {
+	switch name {
+	case pet.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Pet unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PetMutation) ResetEdge(name string) error {
+	switch name {
+	case pet.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case pet.EdgeAttachment:
+		m.ResetAttachment()
+		return nil
+	}
+	return fmt.Errorf("unknown Pet edge %s", name)
+}
+
+// PonyMutation represents an operation that mutates the Pony nodes in the graph.
+type PonyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	size          *schema.Size
+	name          *string
+	height        *schema.Height
+	scores        *[]int64
+	appendscores  []int64
+	rank          *int8
+	addrank       *int8
+	color         *string
+	priority      *pony.Priority
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Pony, error)
+	predicates    []predicate.Pony
+}
+
+var _ ent.Mutation = (*PonyMutation)(nil)
+
+// ponyOption allows management of the mutation configuration using functional options.
+type ponyOption func(*PonyMutation)
+
+// newPonyMutation creates new mutation for the Pony entity.
+func newPonyMutation(c config, op Op, opts ...ponyOption) *PonyMutation {
+	m := &PonyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePony,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPonyID sets the ID field of the mutation.
+func withPonyID(id int) ponyOption {
+	return func(m *PonyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Pony
+		)
+		m.oldValue = func(ctx context.Context) (*Pony, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Pony.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPony sets the old Pony of the mutation.
+func withPony(node *Pony) ponyOption {
+	return func(m *PonyMutation) {
+		m.oldValue = func(context.Context) (*Pony, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PonyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PonyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PonyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PonyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Pony.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSize sets the "size" field.
+func (m *PonyMutation) SetSize(s schema.Size) {
+	m.size = &s
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *PonyMutation) Size() (r schema.Size, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the Pony entity.
+// If the Pony object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PonyMutation) OldSize(ctx context.Context) (v schema.Size, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *PonyMutation) ResetSize() {
+	m.size = nil
+}
+
+// SetName sets the "name" field.
+func (m *PonyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PonyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Pony entity.
+// If the Pony object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PonyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PonyMutation) ResetName() {
+	m.name = nil
+}
+
+// SetHeight sets the "height" field.
+func (m *PonyMutation) SetHeight(s schema.Height) {
+	m.height = &s
+}
+
+// Height returns the value of the "height" field in the mutation.
+func (m *PonyMutation) Height() (r schema.Height, exists bool) {
+	v := m.height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeight returns the old "height" field's value of the Pony entity.
+// If the Pony object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PonyMutation) OldHeight(ctx context.Context) (v schema.Height, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	}
+	return oldValue.Height, nil
+}
+
+// ResetHeight resets all changes to the "height" field.
+func (m *PonyMutation) ResetHeight() {
+	m.height = nil
+}
+
+// SetScores sets the "scores" field.
+func (m *PonyMutation) SetScores(i []int64) {
+	m.scores = &i
+	m.appendscores = nil
+}
+
+// Scores returns the value of the "scores" field in the mutation.
+func (m *PonyMutation) Scores() (r []int64, exists bool) {
+	v := m.scores
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScores returns the old "scores" field's value of the Pony entity.
+// If the Pony object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PonyMutation) OldScores(ctx context.Context) (v []int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScores is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScores requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScores: %w", err)
+	}
+	return oldValue.Scores, nil
+}
+
+// AppendScores adds i to the "scores" field.
+func (m *PonyMutation) AppendScores(i []int64) {
+	m.appendscores = append(m.appendscores, i...)
+}
+
+// AppendedScores returns the list of values that were appended to the "scores" field in this mutation.
+func (m *PonyMutation) AppendedScores() ([]int64, bool) {
+	if len(m.appendscores) == 0 {
+		return nil, false
+	}
+	return m.appendscores, true
+}
+
+// ClearScores clears the value of the "scores" field.
+func (m *PonyMutation) ClearScores() {
+	m.scores = nil
+	m.appendscores = nil
+	m.clearedFields[pony.FieldScores] = struct{}{}
+}
+
+// ScoresCleared returns if the "scores" field was cleared in this mutation.
+func (m *PonyMutation) ScoresCleared() bool {
+	_, ok := m.clearedFields[pony.FieldScores]
+	return ok
+}
+
+// ResetScores resets all changes to the "scores" field.
+func (m *PonyMutation) ResetScores() {
+	m.scores = nil
+	m.appendscores = nil
+	delete(m.clearedFields, pony.FieldScores)
+}
+
+// SetRank sets the "rank" field.
+func (m *PonyMutation) SetRank(i int8) {
+	m.rank = &i
+	m.addrank = nil
+}
+
+// Rank returns the value of the "rank" field in the mutation.
+func (m *PonyMutation) Rank() (r int8, exists bool) {
+	v := m.rank
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRank returns the old "rank" field's value of the Pony entity.
+// If the Pony object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PonyMutation) OldRank(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRank is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRank requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRank: %w", err)
+	}
+	return oldValue.Rank, nil
+}
+
+// AddRank adds i to the "rank" field.
+func (m *PonyMutation) AddRank(i int8) {
+	if m.addrank != nil {
+		*m.addrank += i
+	} else {
+		m.addrank = &i
+	}
+}
+
+// AddedRank returns the value that was added to the "rank" field in this mutation.
+func (m *PonyMutation) AddedRank() (r int8, exists bool) {
+	v := m.addrank
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRank clears the value of the "rank" field.
+func (m *PonyMutation) ClearRank() {
+	m.rank = nil
+	m.addrank = nil
+	m.clearedFields[pony.FieldRank] = struct{}{}
+}
+
+// RankCleared returns if the "rank" field was cleared in this mutation.
+func (m *PonyMutation) RankCleared() bool {
+	_, ok := m.clearedFields[pony.FieldRank]
+	return ok
+}
+
+// ResetRank resets all changes to the "rank" field.
+func (m *PonyMutation) ResetRank() {
+	m.rank = nil
+	m.addrank = nil
+	delete(m.clearedFields, pony.FieldRank)
+}
+
+// SetColor sets the "color" field.
+func (m *PonyMutation) SetColor(s string) {
+	m.color = &s
+}
+
+// Color returns the value of the "color" field in the mutation.
+func (m *PonyMutation) Color() (r string, exists bool) {
+	v := m.color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColor returns the old "color" field's value of the Pony entity.
+// If the Pony object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PonyMutation) OldColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+	}
+	return oldValue.Color, nil
+}
+
+// ResetColor resets all changes to the "color" field.
+func (m *PonyMutation) ResetColor() {
+	m.color = nil
+}
+
+// SetPriority sets the "priority" field.
+func (m *PonyMutation) SetPriority(po pony.Priority) {
+	m.priority = &po
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *PonyMutation) Priority() (r pony.Priority, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the Pony entity.
+// If the Pony object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PonyMutation) OldPriority(ctx context.Context) (v pony.Priority, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *PonyMutation) ResetPriority() {
+	m.priority = nil
+}
+
+// Where appends a list predicates to the PonyMutation builder.
+func (m *PonyMutation) Where(ps ...predicate.Pony) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *PonyMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Pony).
+func (m *PonyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PonyMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.size != nil {
+		fields = append(fields, pony.FieldSize)
+	}
+	if m.name != nil {
+		fields = append(fields, pony.FieldName)
+	}
+	if m.height != nil {
+		fields = append(fields, pony.FieldHeight)
+	}
+	if m.scores != nil {
+		fields = append(fields, pony.FieldScores)
+	}
+	if m.rank != nil {
+		fields = append(fields, pony.FieldRank)
+	}
+	if m.color != nil {
+		fields = append(fields, pony.FieldColor)
+	}
+	if m.priority != nil {
+		fields = append(fields, pony.FieldPriority)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PonyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pony.FieldSize:
+		return m.Size()
+	case pony.FieldName:
+		return m.Name()
+	case pony.FieldHeight:
+		return m.Height()
+	case pony.FieldScores:
+		return m.Scores()
+	case pony.FieldRank:
+		return m.Rank()
+	case pony.FieldColor:
+		return m.Color()
+	case pony.FieldPriority:
+		return m.Priority()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PonyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pony.FieldSize:
+		return m.OldSize(ctx)
+	case pony.FieldName:
+		return m.OldName(ctx)
+	case pony.FieldHeight:
+		return m.OldHeight(ctx)
+	case pony.FieldScores:
+		return m.OldScores(ctx)
+	case pony.FieldRank:
+		return m.OldRank(ctx)
+	case pony.FieldColor:
+		return m.OldColor(ctx)
+	case pony.FieldPriority:
+		return m.OldPriority(ctx)
+	}
+	return nil, fmt.Errorf("unknown Pony field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PonyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pony.FieldSize:
+		v, ok := value.(schema.Size)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case pony.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case pony.FieldHeight:
+		v, ok := value.(schema.Height)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case pony.FieldScores:
+		v, ok := value.([]int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScores(v)
+		return nil
+	case pony.FieldRank:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRank(v)
+		return nil
+	case pony.FieldColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColor(v)
+		return nil
+	case pony.FieldPriority:
+		v, ok := value.(pony.Priority)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Pony field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PonyMutation) AddedFields() []string {
+	var fields []string
+	if m.addrank != nil {
+		fields = append(fields, pony.FieldRank)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PonyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pony.FieldRank:
+		return m.AddedRank()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PonyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case pony.FieldRank:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRank(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Pony numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PonyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pony.FieldScores) {
+		fields = append(fields, pony.FieldScores)
+	}
+	if m.FieldCleared(pony.FieldRank) {
+		fields = append(fields, pony.FieldRank)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PonyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PonyMutation) ClearField(name string) error {
+	switch name {
+	case pony.FieldScores:
+		m.ClearScores()
+		return nil
+	case pony.FieldRank:
+		m.ClearRank()
+		return nil
+	}
+	return fmt.Errorf("unknown Pony nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PonyMutation) ResetField(name string) error {
+	switch name {
+	case pony.FieldSize:
+		m.ResetSize()
+		return nil
+	case pony.FieldName:
+		m.ResetName()
+		return nil
+	case pony.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case pony.FieldScores:
+		m.ResetScores()
+		return nil
+	case pony.FieldRank:
+		m.ResetRank()
+		return nil
+	case pony.FieldColor:
+		m.ResetColor()
+		return nil
+	case pony.FieldPriority:
+		m.ResetPriority()
+		return nil
+	}
+	return fmt.Errorf("unknown Pony field %s", name)
+}
 
-// newPonyMutation creates new mutation for the Pony entity.
-func newPonyMutation(c config, op Op, opts ...ponyOption) *PonyMutation {
-	m := &PonyMutation{
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PonyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PonyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PonyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PonyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PonyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PonyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PonyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Pony unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PonyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Pony edge %s", name)
+}
+
+// ProjectMutation represents an operation that mutates the Project nodes in the graph.
+type ProjectMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	deleted_at         *time.Time
+	priority           *project.Priority
+	version            *int
+	addversion         *int
+	clearedFields      map[string]struct{}
+	owner              *uint32
+	clearedowner       bool
+	attachments        map[uuid.UUID]struct{}
+	removedattachments map[uuid.UUID]struct{}
+	clearedattachments bool
+	done               bool
+	oldValue           func(context.Context) (*Project, error)
+	predicates         []predicate.Project
+}
+
+var _ ent.Mutation = (*ProjectMutation)(nil)
+
+// projectOption allows management of the mutation configuration using functional options.
+type projectOption func(*ProjectMutation)
+
+// newProjectMutation creates new mutation for the Project entity.
+func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation {
+	m := &ProjectMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePony,
+		typ:           TypeProject,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2006,20 +3747,20 @@ func newPonyMutation(c config, op Op, opts ...ponyOption) *PonyMutation {
 	return m
 }
 
-// withPonyID sets the ID field of the mutation.
-func withPonyID(id int) ponyOption {
-	return func(m *PonyMutation) {
+// withProjectID sets the ID field of the mutation.
+func withProjectID(id int) projectOption {
+	return func(m *ProjectMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Pony
+			value *Project
 		)
-		m.oldValue = func(ctx context.Context) (*Pony, error) {
+		m.oldValue = func(ctx context.Context) (*Project, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Pony.Get(ctx, id)
+					value, err = m.Client().Project.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2028,121 +3769,364 @@ func withPonyID(id int) ponyOption {
 	}
 }
 
-// withPony sets the old Pony of the mutation.
-func withPony(node *Pony) ponyOption {
-	return func(m *PonyMutation) {
-		m.oldValue = func(context.Context) (*Pony, error) {
+// withProject sets the old Project of the mutation.
+func withProject(node *Project) projectOption {
+	return func(m *ProjectMutation) {
+		m.oldValue = func(context.Context) (*Project, error) {
 			return node, nil
 		}
 		m.id = &node.ID
 	}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PonyMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProjectMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProjectMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProjectMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProjectMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Project.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ProjectMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ProjectMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ProjectMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ProjectMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ProjectMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *ProjectMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[project.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *ProjectMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[project.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ProjectMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, project.FieldDeletedAt)
+}
+
+// SetPriority sets the "priority" field.
+func (m *ProjectMutation) SetPriority(pr project.Priority) {
+	m.priority = &pr
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *ProjectMutation) Priority() (r project.Priority, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldPriority(ctx context.Context) (v project.Priority, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *ProjectMutation) ResetPriority() {
+	m.priority = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *ProjectMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *ProjectMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *ProjectMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *ProjectMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *ProjectMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *ProjectMutation) SetOwnerID(id uint32) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *ProjectMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *ProjectMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *ProjectMutation) OwnerID() (id uint32, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *ProjectMutation) OwnerIDs() (ids []uint32) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *ProjectMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m PonyMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by ids.
+func (m *ProjectMutation) AddAttachmentIDs(ids ...uuid.UUID) {
+	if m.attachments == nil {
+		m.attachments = make(map[uuid.UUID]struct{})
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *PonyMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+	for i := range ids {
+		m.attachments[ids[i]] = struct{}{}
 	}
-	return *m.id, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *PonyMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Pony.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ClearAttachments clears the "attachments" edge to the Attachment entity.
+func (m *ProjectMutation) ClearAttachments() {
+	m.clearedattachments = true
 }
 
-// SetName sets the "name" field.
-func (m *PonyMutation) SetName(s string) {
-	m.name = &s
+// AttachmentsCleared reports if the "attachments" edge to the Attachment entity was cleared.
+func (m *ProjectMutation) AttachmentsCleared() bool {
+	return m.clearedattachments
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PonyMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+// RemoveAttachmentIDs removes the "attachments" edge to the Attachment entity by IDs.
+func (m *ProjectMutation) RemoveAttachmentIDs(ids ...uuid.UUID) {
+	if m.removedattachments == nil {
+		m.removedattachments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.attachments, ids[i])
+		m.removedattachments[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Pony entity.
-// If the Pony object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PonyMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+// RemovedAttachments returns the removed IDs of the "attachments" edge to the Attachment entity.
+func (m *ProjectMutation) RemovedAttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedattachments {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	return
+}
+
+// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
+func (m *ProjectMutation) AttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.attachments {
+		ids = append(ids, id)
 	}
-	return oldValue.Name, nil
+	return
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PonyMutation) ResetName() {
-	m.name = nil
+// ResetAttachments resets all changes to the "attachments" edge.
+func (m *ProjectMutation) ResetAttachments() {
+	m.attachments = nil
+	m.clearedattachments = false
+	m.removedattachments = nil
 }
 
-// Where appends a list predicates to the PonyMutation builder.
-func (m *PonyMutation) Where(ps ...predicate.Pony) {
+// Where appends a list predicates to the ProjectMutation builder.
+func (m *ProjectMutation) Where(ps ...predicate.Project) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *PonyMutation) Op() Op {
+func (m *ProjectMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Pony).
-func (m *PonyMutation) Type() string {
+// Type returns the node type of this mutation (Project).
+func (m *ProjectMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PonyMutation) Fields() []string {
-	fields := make([]string, 0, 1)
+func (m *ProjectMutation) Fields() []string {
+	fields := make([]string, 0, 4)
 	if m.name != nil {
-		fields = append(fields, pony.FieldName)
+		fields = append(fields, project.FieldName)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, project.FieldDeletedAt)
+	}
+	if m.priority != nil {
+		fields = append(fields, project.FieldPriority)
+	}
+	if m.version != nil {
+		fields = append(fields, project.FieldVersion)
 	}
 	return fields
 }
@@ -2150,10 +4134,16 @@ func (m *PonyMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PonyMutation) Field(name string) (ent.Value, bool) {
+func (m *ProjectMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case pony.FieldName:
+	case project.FieldName:
 		return m.Name()
+	case project.FieldDeletedAt:
+		return m.DeletedAt()
+	case project.FieldPriority:
+		return m.Priority()
+	case project.FieldVersion:
+		return m.Version()
 	}
 	return nil, false
 }
@@ -2161,128 +4151,242 @@ func (m *PonyMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PonyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ProjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case pony.FieldName:
+	case project.FieldName:
 		return m.OldName(ctx)
+	case project.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case project.FieldPriority:
+		return m.OldPriority(ctx)
+	case project.FieldVersion:
+		return m.OldVersion(ctx)
 	}
-	return nil, fmt.Errorf("unknown Pony field %s", name)
+	return nil, fmt.Errorf("unknown Project field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PonyMutation) SetField(name string, value ent.Value) error {
+func (m *ProjectMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case pony.FieldName:
+	case project.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
+	case project.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case project.FieldPriority:
+		v, ok := value.(project.Priority)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case project.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Pony field %s", name)
+	return fmt.Errorf("unknown Project field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PonyMutation) AddedFields() []string {
-	return nil
+func (m *ProjectMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, project.FieldVersion)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PonyMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ProjectMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case project.FieldVersion:
+		return m.AddedVersion()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PonyMutation) AddField(name string, value ent.Value) error {
+func (m *ProjectMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case project.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Pony numeric field %s", name)
+	return fmt.Errorf("unknown Project numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PonyMutation) ClearedFields() []string {
-	return nil
+func (m *ProjectMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(project.FieldDeletedAt) {
+		fields = append(fields, project.FieldDeletedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PonyMutation) FieldCleared(name string) bool {
+func (m *ProjectMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PonyMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Pony nullable field %s", name)
+func (m *ProjectMutation) ClearField(name string) error {
+	switch name {
+	case project.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Project nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PonyMutation) ResetField(name string) error {
+func (m *ProjectMutation) ResetField(name string) error {
 	switch name {
-	case pony.FieldName:
+	case project.FieldName:
 		m.ResetName()
 		return nil
+	case project.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case project.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case project.FieldVersion:
+		m.ResetVersion()
+		return nil
 	}
-	return fmt.Errorf("unknown Pony field %s", name)
+	return fmt.Errorf("unknown Project field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PonyMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ProjectMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.owner != nil {
+		edges = append(edges, project.EdgeOwner)
+	}
+	if m.attachments != nil {
+		edges = append(edges, project.EdgeAttachments)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PonyMutation) AddedIDs(name string) []ent.Value {
+func (m *ProjectMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case project.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case project.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.attachments))
+		for id := range m.attachments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PonyMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ProjectMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedattachments != nil {
+		edges = append(edges, project.EdgeAttachments)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PonyMutation) RemovedIDs(name string) []ent.Value {
+func (m *ProjectMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case project.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.removedattachments))
+		for id := range m.removedattachments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PonyMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ProjectMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedowner {
+		edges = append(edges, project.EdgeOwner)
+	}
+	if m.clearedattachments {
+		edges = append(edges, project.EdgeAttachments)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PonyMutation) EdgeCleared(name string) bool {
+func (m *ProjectMutation) EdgeCleared(name string) bool {
+	switch name {
+	case project.EdgeOwner:
+		return m.clearedowner
+	case project.EdgeAttachments:
+		return m.clearedattachments
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PonyMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Pony unique edge %s", name)
+func (m *ProjectMutation) ClearEdge(name string) error {
+	switch name {
+	case project.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Project unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PonyMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Pony edge %s", name)
+func (m *ProjectMutation) ResetEdge(name string) error {
+	switch name {
+	case project.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case project.EdgeAttachments:
+		m.ResetAttachments()
+		return nil
+	}
+	return fmt.Errorf("unknown Project edge %s", name)
 }
 
 // SkipEdgeExampleMutation represents an operation that mutates the SkipEdgeExample nodes in the graph.
@@ -3068,6 +5172,7 @@ type UserMutation struct {
 	labels             *[]string
 	appendlabels       []string
 	device_type        *user.DeviceType
+	created_at         *time.Time
 	omit_prefix        *user.OmitPrefix
 	clearedFields      map[string]struct{}
 	group              *int
@@ -3079,6 +5184,9 @@ type UserMutation struct {
 	clearedreceived_1  bool
 	pet                *int
 	clearedpet         bool
+	friends            map[uint32]struct{}
+	removedfriends     map[uint32]struct{}
+	clearedfriends     bool
 	skip_edge          *int
 	clearedskip_edge   bool
 	done               bool
@@ -4192,6 +6300,42 @@ func (m *UserMutation) ResetDeviceType() {
 	m.device_type = nil
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
 // SetOmitPrefix sets the "omit_prefix" field.
 func (m *UserMutation) SetOmitPrefix(up user.OmitPrefix) {
 	m.omit_prefix = &up
@@ -4399,6 +6543,60 @@ func (m *UserMutation) ResetPet() {
 	m.clearedpet = false
 }
 
+// AddFriendIDs adds the "friends" edge to the User entity by ids.
+func (m *UserMutation) AddFriendIDs(ids ...uint32) {
+	if m.friends == nil {
+		m.friends = make(map[uint32]struct{})
+	}
+	for i := range ids {
+		m.friends[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFriends clears the "friends" edge to the User entity.
+func (m *UserMutation) ClearFriends() {
+	m.clearedfriends = true
+}
+
+// FriendsCleared reports if the "friends" edge to the User entity was cleared.
+func (m *UserMutation) FriendsCleared() bool {
+	return m.clearedfriends
+}
+
+// RemoveFriendIDs removes the "friends" edge to the User entity by IDs.
+func (m *UserMutation) RemoveFriendIDs(ids ...uint32) {
+	if m.removedfriends == nil {
+		m.removedfriends = make(map[uint32]struct{})
+	}
+	for i := range ids {
+		delete(m.friends, ids[i])
+		m.removedfriends[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFriends returns the removed IDs of the "friends" edge to the User entity.
+func (m *UserMutation) RemovedFriendsIDs() (ids []uint32) {
+	for id := range m.removedfriends {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FriendsIDs returns the "friends" edge IDs in the mutation.
+func (m *UserMutation) FriendsIDs() (ids []uint32) {
+	for id := range m.friends {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFriends resets all changes to the "friends" edge.
+func (m *UserMutation) ResetFriends() {
+	m.friends = nil
+	m.clearedfriends = false
+	m.removedfriends = nil
+}
+
 // SetSkipEdgeID sets the "skip_edge" edge to the SkipEdgeExample entity by id.
 func (m *UserMutation) SetSkipEdgeID(id int) {
 	m.skip_edge = &id
@@ -4457,7 +6655,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 21)
+	fields := make([]string, 0, 22)
 	if m.user_name != nil {
 		fields = append(fields, user.FieldUserName)
 	}
@@ -4518,6 +6716,9 @@ func (m *UserMutation) Fields() []string {
 	if m.device_type != nil {
 		fields = append(fields, user.FieldDeviceType)
 	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
 	if m.omit_prefix != nil {
 		fields = append(fields, user.FieldOmitPrefix)
 	}
@@ -4569,6 +6770,8 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.Labels()
 	case user.FieldDeviceType:
 		return m.DeviceType()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
 	case user.FieldOmitPrefix:
 		return m.OmitPrefix()
 	}
@@ -4620,6 +6823,8 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldLabels(ctx)
 	case user.FieldDeviceType:
 		return m.OldDeviceType(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	case user.FieldOmitPrefix:
 		return m.OldOmitPrefix(ctx)
 	}
@@ -4771,6 +6976,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetDeviceType(v)
 		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	case user.FieldOmitPrefix:
 		v, ok := value.(user.OmitPrefix)
 		if !ok {
@@ -5037,6 +7249,9 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldDeviceType:
 		m.ResetDeviceType()
 		return nil
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	case user.FieldOmitPrefix:
 		m.ResetOmitPrefix()
 		return nil
@@ -5046,7 +7261,7 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 6)
 	if m.group != nil {
 		edges = append(edges, user.EdgeGroup)
 	}
@@ -5059,6 +7274,9 @@ func (m *UserMutation) AddedEdges() []string {
 	if m.pet != nil {
 		edges = append(edges, user.EdgePet)
 	}
+	if m.friends != nil {
+		edges = append(edges, user.EdgeFriends)
+	}
 	if m.skip_edge != nil {
 		edges = append(edges, user.EdgeSkipEdge)
 	}
@@ -5087,6 +7305,12 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 		if id := m.pet; id != nil {
 			return []ent.Value{*id}
 		}
+	case user.EdgeFriends:
+		ids := make([]ent.Value, 0, len(m.friends))
+		for id := range m.friends {
+			ids = append(ids, id)
+		}
+		return ids
 	case user.EdgeSkipEdge:
 		if id := m.skip_edge; id != nil {
 			return []ent.Value{*id}
@@ -5097,10 +7321,13 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 6)
 	if m.removedreceived_1 != nil {
 		edges = append(edges, user.EdgeReceived1)
 	}
+	if m.removedfriends != nil {
+		edges = append(edges, user.EdgeFriends)
+	}
 	return edges
 }
 
@@ -5114,13 +7341,19 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeFriends:
+		ids := make([]ent.Value, 0, len(m.removedfriends))
+		for id := range m.removedfriends {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 6)
 	if m.clearedgroup {
 		edges = append(edges, user.EdgeGroup)
 	}
@@ -5133,6 +7366,9 @@ func (m *UserMutation) ClearedEdges() []string {
 	if m.clearedpet {
 		edges = append(edges, user.EdgePet)
 	}
+	if m.clearedfriends {
+		edges = append(edges, user.EdgeFriends)
+	}
 	if m.clearedskip_edge {
 		edges = append(edges, user.EdgeSkipEdge)
 	}
@@ -5151,6 +7387,8 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedreceived_1
 	case user.EdgePet:
 		return m.clearedpet
+	case user.EdgeFriends:
+		return m.clearedfriends
 	case user.EdgeSkipEdge:
 		return m.clearedskip_edge
 	}
@@ -5193,6 +7431,9 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgePet:
 		m.ResetPet()
 		return nil
+	case user.EdgeFriends:
+		m.ResetFriends()
+		return nil
 	case user.EdgeSkipEdge:
 		m.ResetSkipEdge()
 		return nil
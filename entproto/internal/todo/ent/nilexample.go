@@ -20,6 +20,8 @@ type NilExample struct {
 	StrNil *string `json:"str_nil,omitempty"`
 	// TimeNil holds the value of the "time_nil" field.
 	TimeNil *time.Time `json:"time_nil,omitempty"`
+	// TimeStrNil holds the value of the "time_str_nil" field.
+	TimeStrNil *time.Time `json:"time_str_nil,omitempty"`
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -31,7 +33,7 @@ func (*NilExample) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case nilexample.FieldStrNil:
 			values[i] = new(sql.NullString)
-		case nilexample.FieldTimeNil:
+		case nilexample.FieldTimeNil, nilexample.FieldTimeStrNil:
 			values[i] = new(sql.NullTime)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type NilExample", columns[i])
@@ -68,6 +70,13 @@ func (ne *NilExample) assignValues(columns []string, values []any) error {
 				ne.TimeNil = new(time.Time)
 				*ne.TimeNil = value.Time
 			}
+		case nilexample.FieldTimeStrNil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field time_str_nil", values[i])
+			} else if value.Valid {
+				ne.TimeStrNil = new(time.Time)
+				*ne.TimeStrNil = value.Time
+			}
 		}
 	}
 	return nil
@@ -105,6 +114,11 @@ func (ne *NilExample) String() string {
 		builder.WriteString("time_nil=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	if v := ne.TimeStrNil; v != nil {
+		builder.WriteString("time_str_nil=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
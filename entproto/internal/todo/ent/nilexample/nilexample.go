@@ -11,6 +11,8 @@ const (
 	FieldStrNil = "str_nil"
 	// FieldTimeNil holds the string denoting the time_nil field in the database.
 	FieldTimeNil = "time_nil"
+	// FieldTimeStrNil holds the string denoting the time_str_nil field in the database.
+	FieldTimeStrNil = "time_str_nil"
 	// Table holds the table name of the nilexample in the database.
 	Table = "nil_examples"
 )
@@ -20,6 +22,7 @@ var Columns = []string{
 	FieldID,
 	FieldStrNil,
 	FieldTimeNil,
+	FieldTimeStrNil,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
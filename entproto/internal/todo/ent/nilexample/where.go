@@ -94,6 +94,13 @@ func TimeNil(v time.Time) predicate.NilExample {
 	})
 }
 
+// TimeStrNil applies equality check predicate on the "time_str_nil" field. It's identical to TimeStrNilEQ.
+func TimeStrNil(v time.Time) predicate.NilExample {
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTimeStrNil), v))
+	})
+}
+
 // StrNilEQ applies the EQ predicate on the "str_nil" field.
 func StrNilEQ(v string) predicate.NilExample {
 	return predicate.NilExample(func(s *sql.Selector) {
@@ -285,6 +292,84 @@ func TimeNilNotNil() predicate.NilExample {
 	})
 }
 
+// TimeStrNilEQ applies the EQ predicate on the "time_str_nil" field.
+func TimeStrNilEQ(v time.Time) predicate.NilExample {
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTimeStrNil), v))
+	})
+}
+
+// TimeStrNilNEQ applies the NEQ predicate on the "time_str_nil" field.
+func TimeStrNilNEQ(v time.Time) predicate.NilExample {
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTimeStrNil), v))
+	})
+}
+
+// TimeStrNilIn applies the In predicate on the "time_str_nil" field.
+func TimeStrNilIn(vs ...time.Time) predicate.NilExample {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldTimeStrNil), v...))
+	})
+}
+
+// TimeStrNilNotIn applies the NotIn predicate on the "time_str_nil" field.
+func TimeStrNilNotIn(vs ...time.Time) predicate.NilExample {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldTimeStrNil), v...))
+	})
+}
+
+// TimeStrNilGT applies the GT predicate on the "time_str_nil" field.
+func TimeStrNilGT(v time.Time) predicate.NilExample {
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTimeStrNil), v))
+	})
+}
+
+// TimeStrNilGTE applies the GTE predicate on the "time_str_nil" field.
+func TimeStrNilGTE(v time.Time) predicate.NilExample {
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTimeStrNil), v))
+	})
+}
+
+// TimeStrNilLT applies the LT predicate on the "time_str_nil" field.
+func TimeStrNilLT(v time.Time) predicate.NilExample {
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTimeStrNil), v))
+	})
+}
+
+// TimeStrNilLTE applies the LTE predicate on the "time_str_nil" field.
+func TimeStrNilLTE(v time.Time) predicate.NilExample {
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTimeStrNil), v))
+	})
+}
+
+// TimeStrNilIsNil applies the IsNil predicate on the "time_str_nil" field.
+func TimeStrNilIsNil() predicate.NilExample {
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTimeStrNil)))
+	})
+}
+
+// TimeStrNilNotNil applies the NotNil predicate on the "time_str_nil" field.
+func TimeStrNilNotNil() predicate.NilExample {
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTimeStrNil)))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.NilExample) predicate.NilExample {
 	return predicate.NilExample(func(s *sql.Selector) {
@@ -4,10 +4,12 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"entgo.io/contrib/entproto/internal/todo/ent/nilexample"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -17,6 +19,7 @@ type NilExampleCreate struct {
 	config
 	mutation *NilExampleMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetStrNil sets the "str_nil" field.
@@ -47,6 +50,20 @@ func (nec *NilExampleCreate) SetNillableTimeNil(t *time.Time) *NilExampleCreate
 	return nec
 }
 
+// SetTimeStrNil sets the "time_str_nil" field.
+func (nec *NilExampleCreate) SetTimeStrNil(t time.Time) *NilExampleCreate {
+	nec.mutation.SetTimeStrNil(t)
+	return nec
+}
+
+// SetNillableTimeStrNil sets the "time_str_nil" field if the given value is not nil.
+func (nec *NilExampleCreate) SetNillableTimeStrNil(t *time.Time) *NilExampleCreate {
+	if t != nil {
+		nec.SetTimeStrNil(*t)
+	}
+	return nec
+}
+
 // Mutation returns the NilExampleMutation object of the builder.
 func (nec *NilExampleCreate) Mutation() *NilExampleMutation {
 	return nec.mutation
@@ -150,6 +167,7 @@ func (nec *NilExampleCreate) createSpec() (*NilExample, *sqlgraph.CreateSpec) {
 			},
 		}
 	)
+	_spec.OnConflict = nec.conflict
 	if value, ok := nec.mutation.StrNil(); ok {
 		_spec.SetField(nilexample.FieldStrNil, field.TypeString, value)
 		_node.StrNil = &value
@@ -158,13 +176,257 @@ func (nec *NilExampleCreate) createSpec() (*NilExample, *sqlgraph.CreateSpec) {
 		_spec.SetField(nilexample.FieldTimeNil, field.TypeTime, value)
 		_node.TimeNil = &value
 	}
+	if value, ok := nec.mutation.TimeStrNil(); ok {
+		_spec.SetField(nilexample.FieldTimeStrNil, field.TypeTime, value)
+		_node.TimeStrNil = &value
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NilExample.Create().
+//		SetStrNil(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NilExampleUpsert) {
+//			SetStrNil(v+v).
+//		}).
+//		Exec(ctx)
+func (nec *NilExampleCreate) OnConflict(opts ...sql.ConflictOption) *NilExampleUpsertOne {
+	nec.conflict = opts
+	return &NilExampleUpsertOne{
+		create: nec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NilExample.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (nec *NilExampleCreate) OnConflictColumns(columns ...string) *NilExampleUpsertOne {
+	nec.conflict = append(nec.conflict, sql.ConflictColumns(columns...))
+	return &NilExampleUpsertOne{
+		create: nec,
+	}
+}
+
+type (
+	// NilExampleUpsertOne is the builder for "upsert"-ing
+	//  one NilExample node.
+	NilExampleUpsertOne struct {
+		create *NilExampleCreate
+	}
+
+	// NilExampleUpsert is the "OnConflict" setter.
+	NilExampleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStrNil sets the "str_nil" field.
+func (u *NilExampleUpsert) SetStrNil(v string) *NilExampleUpsert {
+	u.Set(nilexample.FieldStrNil, v)
+	return u
+}
+
+// UpdateStrNil sets the "str_nil" field to the value that was provided on create.
+func (u *NilExampleUpsert) UpdateStrNil() *NilExampleUpsert {
+	u.SetExcluded(nilexample.FieldStrNil)
+	return u
+}
+
+// ClearStrNil clears the value of the "str_nil" field.
+func (u *NilExampleUpsert) ClearStrNil() *NilExampleUpsert {
+	u.SetNull(nilexample.FieldStrNil)
+	return u
+}
+
+// SetTimeNil sets the "time_nil" field.
+func (u *NilExampleUpsert) SetTimeNil(v time.Time) *NilExampleUpsert {
+	u.Set(nilexample.FieldTimeNil, v)
+	return u
+}
+
+// UpdateTimeNil sets the "time_nil" field to the value that was provided on create.
+func (u *NilExampleUpsert) UpdateTimeNil() *NilExampleUpsert {
+	u.SetExcluded(nilexample.FieldTimeNil)
+	return u
+}
+
+// ClearTimeNil clears the value of the "time_nil" field.
+func (u *NilExampleUpsert) ClearTimeNil() *NilExampleUpsert {
+	u.SetNull(nilexample.FieldTimeNil)
+	return u
+}
+
+// SetTimeStrNil sets the "time_str_nil" field.
+func (u *NilExampleUpsert) SetTimeStrNil(v time.Time) *NilExampleUpsert {
+	u.Set(nilexample.FieldTimeStrNil, v)
+	return u
+}
+
+// UpdateTimeStrNil sets the "time_str_nil" field to the value that was provided on create.
+func (u *NilExampleUpsert) UpdateTimeStrNil() *NilExampleUpsert {
+	u.SetExcluded(nilexample.FieldTimeStrNil)
+	return u
+}
+
+// ClearTimeStrNil clears the value of the "time_str_nil" field.
+func (u *NilExampleUpsert) ClearTimeStrNil() *NilExampleUpsert {
+	u.SetNull(nilexample.FieldTimeStrNil)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.NilExample.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NilExampleUpsertOne) UpdateNewValues() *NilExampleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NilExample.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NilExampleUpsertOne) Ignore() *NilExampleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NilExampleUpsertOne) DoNothing() *NilExampleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NilExampleCreate.OnConflict
+// documentation for more info.
+func (u *NilExampleUpsertOne) Update(set func(*NilExampleUpsert)) *NilExampleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NilExampleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStrNil sets the "str_nil" field.
+func (u *NilExampleUpsertOne) SetStrNil(v string) *NilExampleUpsertOne {
+	return u.Update(func(s *NilExampleUpsert) {
+		s.SetStrNil(v)
+	})
+}
+
+// UpdateStrNil sets the "str_nil" field to the value that was provided on create.
+func (u *NilExampleUpsertOne) UpdateStrNil() *NilExampleUpsertOne {
+	return u.Update(func(s *NilExampleUpsert) {
+		s.UpdateStrNil()
+	})
+}
+
+// ClearStrNil clears the value of the "str_nil" field.
+func (u *NilExampleUpsertOne) ClearStrNil() *NilExampleUpsertOne {
+	return u.Update(func(s *NilExampleUpsert) {
+		s.ClearStrNil()
+	})
+}
+
+// SetTimeNil sets the "time_nil" field.
+func (u *NilExampleUpsertOne) SetTimeNil(v time.Time) *NilExampleUpsertOne {
+	return u.Update(func(s *NilExampleUpsert) {
+		s.SetTimeNil(v)
+	})
+}
+
+// UpdateTimeNil sets the "time_nil" field to the value that was provided on create.
+func (u *NilExampleUpsertOne) UpdateTimeNil() *NilExampleUpsertOne {
+	return u.Update(func(s *NilExampleUpsert) {
+		s.UpdateTimeNil()
+	})
+}
+
+// ClearTimeNil clears the value of the "time_nil" field.
+func (u *NilExampleUpsertOne) ClearTimeNil() *NilExampleUpsertOne {
+	return u.Update(func(s *NilExampleUpsert) {
+		s.ClearTimeNil()
+	})
+}
+
+// SetTimeStrNil sets the "time_str_nil" field.
+func (u *NilExampleUpsertOne) SetTimeStrNil(v time.Time) *NilExampleUpsertOne {
+	return u.Update(func(s *NilExampleUpsert) {
+		s.SetTimeStrNil(v)
+	})
+}
+
+// UpdateTimeStrNil sets the "time_str_nil" field to the value that was provided on create.
+func (u *NilExampleUpsertOne) UpdateTimeStrNil() *NilExampleUpsertOne {
+	return u.Update(func(s *NilExampleUpsert) {
+		s.UpdateTimeStrNil()
+	})
+}
+
+// ClearTimeStrNil clears the value of the "time_str_nil" field.
+func (u *NilExampleUpsertOne) ClearTimeStrNil() *NilExampleUpsertOne {
+	return u.Update(func(s *NilExampleUpsert) {
+		s.ClearTimeStrNil()
+	})
+}
+
+// Exec executes the query.
+func (u *NilExampleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NilExampleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NilExampleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NilExampleUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NilExampleUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // NilExampleCreateBulk is the builder for creating many NilExample entities in bulk.
 type NilExampleCreateBulk struct {
 	config
 	builders []*NilExampleCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the NilExample entities in the database.
@@ -190,6 +452,7 @@ func (necb *NilExampleCreateBulk) Save(ctx context.Context) ([]*NilExample, erro
 					_, err = mutators[i+1].Mutate(root, necb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = necb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, necb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -243,3 +506,167 @@ func (necb *NilExampleCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NilExample.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NilExampleUpsert) {
+//			SetStrNil(v+v).
+//		}).
+//		Exec(ctx)
+func (necb *NilExampleCreateBulk) OnConflict(opts ...sql.ConflictOption) *NilExampleUpsertBulk {
+	necb.conflict = opts
+	return &NilExampleUpsertBulk{
+		create: necb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NilExample.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (necb *NilExampleCreateBulk) OnConflictColumns(columns ...string) *NilExampleUpsertBulk {
+	necb.conflict = append(necb.conflict, sql.ConflictColumns(columns...))
+	return &NilExampleUpsertBulk{
+		create: necb,
+	}
+}
+
+// NilExampleUpsertBulk is the builder for "upsert"-ing
+// a bulk of NilExample nodes.
+type NilExampleUpsertBulk struct {
+	create *NilExampleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NilExample.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NilExampleUpsertBulk) UpdateNewValues() *NilExampleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NilExample.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NilExampleUpsertBulk) Ignore() *NilExampleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NilExampleUpsertBulk) DoNothing() *NilExampleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NilExampleCreateBulk.OnConflict
+// documentation for more info.
+func (u *NilExampleUpsertBulk) Update(set func(*NilExampleUpsert)) *NilExampleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NilExampleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStrNil sets the "str_nil" field.
+func (u *NilExampleUpsertBulk) SetStrNil(v string) *NilExampleUpsertBulk {
+	return u.Update(func(s *NilExampleUpsert) {
+		s.SetStrNil(v)
+	})
+}
+
+// UpdateStrNil sets the "str_nil" field to the value that was provided on create.
+func (u *NilExampleUpsertBulk) UpdateStrNil() *NilExampleUpsertBulk {
+	return u.Update(func(s *NilExampleUpsert) {
+		s.UpdateStrNil()
+	})
+}
+
+// ClearStrNil clears the value of the "str_nil" field.
+func (u *NilExampleUpsertBulk) ClearStrNil() *NilExampleUpsertBulk {
+	return u.Update(func(s *NilExampleUpsert) {
+		s.ClearStrNil()
+	})
+}
+
+// SetTimeNil sets the "time_nil" field.
+func (u *NilExampleUpsertBulk) SetTimeNil(v time.Time) *NilExampleUpsertBulk {
+	return u.Update(func(s *NilExampleUpsert) {
+		s.SetTimeNil(v)
+	})
+}
+
+// UpdateTimeNil sets the "time_nil" field to the value that was provided on create.
+func (u *NilExampleUpsertBulk) UpdateTimeNil() *NilExampleUpsertBulk {
+	return u.Update(func(s *NilExampleUpsert) {
+		s.UpdateTimeNil()
+	})
+}
+
+// ClearTimeNil clears the value of the "time_nil" field.
+func (u *NilExampleUpsertBulk) ClearTimeNil() *NilExampleUpsertBulk {
+	return u.Update(func(s *NilExampleUpsert) {
+		s.ClearTimeNil()
+	})
+}
+
+// SetTimeStrNil sets the "time_str_nil" field.
+func (u *NilExampleUpsertBulk) SetTimeStrNil(v time.Time) *NilExampleUpsertBulk {
+	return u.Update(func(s *NilExampleUpsert) {
+		s.SetTimeStrNil(v)
+	})
+}
+
+// UpdateTimeStrNil sets the "time_str_nil" field to the value that was provided on create.
+func (u *NilExampleUpsertBulk) UpdateTimeStrNil() *NilExampleUpsertBulk {
+	return u.Update(func(s *NilExampleUpsert) {
+		s.UpdateTimeStrNil()
+	})
+}
+
+// ClearTimeStrNil clears the value of the "time_str_nil" field.
+func (u *NilExampleUpsertBulk) ClearTimeStrNil() *NilExampleUpsertBulk {
+	return u.Update(func(s *NilExampleUpsert) {
+		s.ClearTimeStrNil()
+	})
+}
+
+// Exec executes the query.
+func (u *NilExampleUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NilExampleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NilExampleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NilExampleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -68,6 +68,26 @@ func (neu *NilExampleUpdate) ClearTimeNil() *NilExampleUpdate {
 	return neu
 }
 
+// SetTimeStrNil sets the "time_str_nil" field.
+func (neu *NilExampleUpdate) SetTimeStrNil(t time.Time) *NilExampleUpdate {
+	neu.mutation.SetTimeStrNil(t)
+	return neu
+}
+
+// SetNillableTimeStrNil sets the "time_str_nil" field if the given value is not nil.
+func (neu *NilExampleUpdate) SetNillableTimeStrNil(t *time.Time) *NilExampleUpdate {
+	if t != nil {
+		neu.SetTimeStrNil(*t)
+	}
+	return neu
+}
+
+// ClearTimeStrNil clears the value of the "time_str_nil" field.
+func (neu *NilExampleUpdate) ClearTimeStrNil() *NilExampleUpdate {
+	neu.mutation.ClearTimeStrNil()
+	return neu
+}
+
 // Mutation returns the NilExampleMutation object of the builder.
 func (neu *NilExampleUpdate) Mutation() *NilExampleMutation {
 	return neu.mutation
@@ -157,6 +177,12 @@ func (neu *NilExampleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if neu.mutation.TimeNilCleared() {
 		_spec.ClearField(nilexample.FieldTimeNil, field.TypeTime)
 	}
+	if value, ok := neu.mutation.TimeStrNil(); ok {
+		_spec.SetField(nilexample.FieldTimeStrNil, field.TypeTime, value)
+	}
+	if neu.mutation.TimeStrNilCleared() {
+		_spec.ClearField(nilexample.FieldTimeStrNil, field.TypeTime)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, neu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{nilexample.Label}
@@ -216,6 +242,26 @@ func (neuo *NilExampleUpdateOne) ClearTimeNil() *NilExampleUpdateOne {
 	return neuo
 }
 
+// SetTimeStrNil sets the "time_str_nil" field.
+func (neuo *NilExampleUpdateOne) SetTimeStrNil(t time.Time) *NilExampleUpdateOne {
+	neuo.mutation.SetTimeStrNil(t)
+	return neuo
+}
+
+// SetNillableTimeStrNil sets the "time_str_nil" field if the given value is not nil.
+func (neuo *NilExampleUpdateOne) SetNillableTimeStrNil(t *time.Time) *NilExampleUpdateOne {
+	if t != nil {
+		neuo.SetTimeStrNil(*t)
+	}
+	return neuo
+}
+
+// ClearTimeStrNil clears the value of the "time_str_nil" field.
+func (neuo *NilExampleUpdateOne) ClearTimeStrNil() *NilExampleUpdateOne {
+	neuo.mutation.ClearTimeStrNil()
+	return neuo
+}
+
 // Mutation returns the NilExampleMutation object of the builder.
 func (neuo *NilExampleUpdateOne) Mutation() *NilExampleMutation {
 	return neuo.mutation
@@ -335,6 +381,12 @@ func (neuo *NilExampleUpdateOne) sqlSave(ctx context.Context) (_node *NilExample
 	if neuo.mutation.TimeNilCleared() {
 		_spec.ClearField(nilexample.FieldTimeNil, field.TypeTime)
 	}
+	if value, ok := neuo.mutation.TimeStrNil(); ok {
+		_spec.SetField(nilexample.FieldTimeStrNil, field.TypeTime, value)
+	}
+	if neuo.mutation.TimeStrNilCleared() {
+		_spec.ClearField(nilexample.FieldTimeStrNil, field.TypeTime)
+	}
 	_node = &NilExample{config: neuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -7,15 +7,18 @@ import (
 	"strings"
 
 	"entgo.io/contrib/entproto/internal/todo/ent/pet"
+	"entgo.io/contrib/entproto/internal/todo/ent/schema"
 	"entgo.io/contrib/entproto/internal/todo/ent/user"
 	"entgo.io/ent/dialect/sql"
 )
 
 // Pet is the model entity for the Pet schema.
 type Pet struct {
-	config
+	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// Size holds the value of the "size" field.
+	Size schema.Size `json:"size,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PetQuery when eager-loading is set.
 	Edges    PetEdges `json:"edges"`
@@ -62,6 +65,8 @@ func (*Pet) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case pet.FieldID:
 			values[i] = new(sql.NullInt64)
+		case pet.FieldSize:
+			values[i] = new(sql.NullString)
 		case pet.ForeignKeys[0]: // user_pet
 			values[i] = new(sql.NullInt64)
 		default:
@@ -85,6 +90,12 @@ func (pe *Pet) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			pe.ID = int(value.Int64)
+		case pet.FieldSize:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				pe.Size = schema.Size(value.String)
+			}
 		case pet.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_pet", value)
@@ -129,7 +140,9 @@ func (pe *Pet) Unwrap() *Pet {
 func (pe *Pet) String() string {
 	var builder strings.Builder
 	builder.WriteString("Pet(")
-	builder.WriteString(fmt.Sprintf("id=%v", pe.ID))
+	builder.WriteString(fmt.Sprintf("id=%v, ", pe.ID))
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", pe.Size))
 	builder.WriteByte(')')
 	return builder.String()
 }
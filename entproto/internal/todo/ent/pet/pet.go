@@ -2,11 +2,19 @@
 
 package pet
 
+import (
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/todo/ent/schema"
+)
+
 const (
 	// Label holds the string label denoting the pet type in the database.
 	Label = "pet"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
 	// EdgeAttachment holds the string denoting the attachment edge name in mutations.
@@ -34,6 +42,7 @@ const (
 // Columns holds all SQL columns for pet fields.
 var Columns = []string{
 	FieldID,
+	FieldSize,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "pets"
@@ -56,3 +65,15 @@ func ValidColumn(column string) bool {
 	}
 	return false
 }
+
+const DefaultSize schema.Size = "medium"
+
+// SizeValidator is a validator for the "size" field enum values. It is called by the builders before save.
+func SizeValidator(s schema.Size) error {
+	switch s {
+	case "medium", "small", "large":
+		return nil
+	default:
+		return fmt.Errorf("pet: invalid enum value for size field: %q", s)
+	}
+}
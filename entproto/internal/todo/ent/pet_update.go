@@ -10,6 +10,7 @@ import (
 	"entgo.io/contrib/entproto/internal/todo/ent/attachment"
 	"entgo.io/contrib/entproto/internal/todo/ent/pet"
 	"entgo.io/contrib/entproto/internal/todo/ent/predicate"
+	"entgo.io/contrib/entproto/internal/todo/ent/schema"
 	"entgo.io/contrib/entproto/internal/todo/ent/user"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -30,6 +31,20 @@ func (pu *PetUpdate) Where(ps ...predicate.Pet) *PetUpdate {
 	return pu
 }
 
+// SetSize sets the "size" field.
+func (pu *PetUpdate) SetSize(s schema.Size) *PetUpdate {
+	pu.mutation.SetSize(s)
+	return pu
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (pu *PetUpdate) SetNillableSize(s *schema.Size) *PetUpdate {
+	if s != nil {
+		pu.SetSize(*s)
+	}
+	return pu
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (pu *PetUpdate) SetOwnerID(id uint32) *PetUpdate {
 	pu.mutation.SetOwnerID(id)
@@ -103,6 +118,9 @@ func (pu *PetUpdate) Save(ctx context.Context) (int, error) {
 		affected int
 	)
 	if len(pu.hooks) == 0 {
+		if err = pu.check(); err != nil {
+			return 0, err
+		}
 		affected, err = pu.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -110,6 +128,9 @@ func (pu *PetUpdate) Save(ctx context.Context) (int, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = pu.check(); err != nil {
+				return 0, err
+			}
 			pu.mutation = mutation
 			affected, err = pu.sqlSave(ctx)
 			mutation.done = true
@@ -150,6 +171,16 @@ func (pu *PetUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (pu *PetUpdate) check() error {
+	if v, ok := pu.mutation.Size(); ok {
+		if err := pet.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Pet.size": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -168,6 +199,9 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := pu.mutation.Size(); ok {
+		_spec.SetField(pet.FieldSize, field.TypeEnum, value)
+	}
 	if pu.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -276,6 +310,20 @@ type PetUpdateOne struct {
 	mutation *PetMutation
 }
 
+// SetSize sets the "size" field.
+func (puo *PetUpdateOne) SetSize(s schema.Size) *PetUpdateOne {
+	puo.mutation.SetSize(s)
+	return puo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (puo *PetUpdateOne) SetNillableSize(s *schema.Size) *PetUpdateOne {
+	if s != nil {
+		puo.SetSize(*s)
+	}
+	return puo
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (puo *PetUpdateOne) SetOwnerID(id uint32) *PetUpdateOne {
 	puo.mutation.SetOwnerID(id)
@@ -356,6 +404,9 @@ func (puo *PetUpdateOne) Save(ctx context.Context) (*Pet, error) {
 		node *Pet
 	)
 	if len(puo.hooks) == 0 {
+		if err = puo.check(); err != nil {
+			return nil, err
+		}
 		node, err = puo.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -363,6 +414,9 @@ func (puo *PetUpdateOne) Save(ctx context.Context) (*Pet, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = puo.check(); err != nil {
+				return nil, err
+			}
 			puo.mutation = mutation
 			node, err = puo.sqlSave(ctx)
 			mutation.done = true
@@ -409,6 +463,16 @@ func (puo *PetUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (puo *PetUpdateOne) check() error {
+	if v, ok := puo.mutation.Size(); ok {
+		if err := pet.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Pet.size": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (puo *PetUpdateOne) sqlSave(ctx context.Context) (_node *Pet, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -444,6 +508,9 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (_node *Pet, err error) {
 			}
 		}
 	}
+	if value, ok := puo.mutation.Size(); ok {
+		_spec.SetField(pet.FieldSize, field.TypeEnum, value)
+	}
 	if puo.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -3,10 +3,12 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"entgo.io/contrib/entproto/internal/todo/ent/pony"
+	"entgo.io/contrib/entproto/internal/todo/ent/schema"
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -15,8 +17,20 @@ type Pony struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// Size holds the value of the "size" field.
+	Size schema.Size `json:"size,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// Height holds the value of the "height" field.
+	Height schema.Height `json:"height,omitempty"`
+	// Scores holds the value of the "scores" field.
+	Scores []int64 `json:"scores,omitempty"`
+	// Rank holds the value of the "rank" field.
+	Rank int8 `json:"rank,omitempty"`
+	// Color holds the value of the "color" field.
+	Color string `json:"color,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority pony.Priority `json:"priority,omitempty"`
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -24,9 +38,13 @@ func (*Pony) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case pony.FieldID:
+		case pony.FieldScores:
+			values[i] = new([]byte)
+		case pony.FieldHeight:
+			values[i] = new(schema.Height)
+		case pony.FieldID, pony.FieldRank:
 			values[i] = new(sql.NullInt64)
-		case pony.FieldName:
+		case pony.FieldSize, pony.FieldName, pony.FieldColor, pony.FieldPriority:
 			values[i] = new(sql.NullString)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Pony", columns[i])
@@ -49,12 +67,50 @@ func (po *Pony) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			po.ID = int(value.Int64)
+		case pony.FieldSize:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				po.Size = schema.Size(value.String)
+			}
 		case pony.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
 			} else if value.Valid {
 				po.Name = value.String
 			}
+		case pony.FieldHeight:
+			if value, ok := values[i].(*schema.Height); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value != nil {
+				po.Height = *value
+			}
+		case pony.FieldScores:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scores", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &po.Scores); err != nil {
+					return fmt.Errorf("unmarshal field scores: %w", err)
+				}
+			}
+		case pony.FieldRank:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rank", values[i])
+			} else if value.Valid {
+				po.Rank = int8(value.Int64)
+			}
+		case pony.FieldColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field color", values[i])
+			} else if value.Valid {
+				po.Color = value.String
+			}
+		case pony.FieldPriority:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				po.Priority = pony.Priority(value.String)
+			}
 		}
 	}
 	return nil
@@ -83,8 +139,26 @@ func (po *Pony) String() string {
 	var builder strings.Builder
 	builder.WriteString("Pony(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", po.ID))
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", po.Size))
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(po.Name)
+	builder.WriteString(", ")
+	builder.WriteString("height=")
+	builder.WriteString(fmt.Sprintf("%v", po.Height))
+	builder.WriteString(", ")
+	builder.WriteString("scores=")
+	builder.WriteString(fmt.Sprintf("%v", po.Scores))
+	builder.WriteString(", ")
+	builder.WriteString("rank=")
+	builder.WriteString(fmt.Sprintf("%v", po.Rank))
+	builder.WriteString(", ")
+	builder.WriteString("color=")
+	builder.WriteString(po.Color)
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", po.Priority))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -2,13 +2,31 @@
 
 package pony
 
+import (
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/todo/ent/schema"
+)
+
 const (
 	// Label holds the string label denoting the pony type in the database.
 	Label = "pony"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldHeight holds the string denoting the height field in the database.
+	FieldHeight = "height"
+	// FieldScores holds the string denoting the scores field in the database.
+	FieldScores = "scores"
+	// FieldRank holds the string denoting the rank field in the database.
+	FieldRank = "rank"
+	// FieldColor holds the string denoting the color field in the database.
+	FieldColor = "color"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
 	// Table holds the table name of the pony in the database.
 	Table = "ponies"
 )
@@ -16,7 +34,13 @@ const (
 // Columns holds all SQL columns for pony fields.
 var Columns = []string{
 	FieldID,
+	FieldSize,
 	FieldName,
+	FieldHeight,
+	FieldScores,
+	FieldRank,
+	FieldColor,
+	FieldPriority,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -28,3 +52,48 @@ func ValidColumn(column string) bool {
 	}
 	return false
 }
+
+var (
+	// DefaultHeight holds the default value on creation for the "height" field.
+	DefaultHeight schema.Height
+	// DefaultColor holds the default value on creation for the "color" field.
+	DefaultColor string
+)
+
+const DefaultSize schema.Size = "medium"
+
+// SizeValidator is a validator for the "size" field enum values. It is called by the builders before save.
+func SizeValidator(s schema.Size) error {
+	switch s {
+	case "medium", "small", "large":
+		return nil
+	default:
+		return fmt.Errorf("pony: invalid enum value for size field: %q", s)
+	}
+}
+
+// Priority defines the type for the "priority" enum field.
+type Priority string
+
+// PriorityLow is the default value of the Priority enum.
+const DefaultPriority = PriorityLow
+
+// Priority values.
+const (
+	PriorityLow  Priority = "low"
+	PriorityHigh Priority = "high"
+)
+
+func (pr Priority) String() string {
+	return string(pr)
+}
+
+// PriorityValidator is a validator for the "priority" field enum values. It is called by the builders before save.
+func PriorityValidator(pr Priority) error {
+	switch pr {
+	case PriorityLow, PriorityHigh:
+		return nil
+	default:
+		return fmt.Errorf("pony: invalid enum value for priority field: %q", pr)
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 
 	"entgo.io/contrib/entproto/internal/todo/ent/pony"
+	"entgo.io/contrib/entproto/internal/todo/ent/schema"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -17,6 +19,21 @@ type PonyCreate struct {
 	config
 	mutation *PonyMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSize sets the "size" field.
+func (pc *PonyCreate) SetSize(s schema.Size) *PonyCreate {
+	pc.mutation.SetSize(s)
+	return pc
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (pc *PonyCreate) SetNillableSize(s *schema.Size) *PonyCreate {
+	if s != nil {
+		pc.SetSize(*s)
+	}
+	return pc
 }
 
 // SetName sets the "name" field.
@@ -25,6 +42,68 @@ func (pc *PonyCreate) SetName(s string) *PonyCreate {
 	return pc
 }
 
+// SetHeight sets the "height" field.
+func (pc *PonyCreate) SetHeight(s schema.Height) *PonyCreate {
+	pc.mutation.SetHeight(s)
+	return pc
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (pc *PonyCreate) SetNillableHeight(s *schema.Height) *PonyCreate {
+	if s != nil {
+		pc.SetHeight(*s)
+	}
+	return pc
+}
+
+// SetScores sets the "scores" field.
+func (pc *PonyCreate) SetScores(i []int64) *PonyCreate {
+	pc.mutation.SetScores(i)
+	return pc
+}
+
+// SetRank sets the "rank" field.
+func (pc *PonyCreate) SetRank(i int8) *PonyCreate {
+	pc.mutation.SetRank(i)
+	return pc
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (pc *PonyCreate) SetNillableRank(i *int8) *PonyCreate {
+	if i != nil {
+		pc.SetRank(*i)
+	}
+	return pc
+}
+
+// SetColor sets the "color" field.
+func (pc *PonyCreate) SetColor(s string) *PonyCreate {
+	pc.mutation.SetColor(s)
+	return pc
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (pc *PonyCreate) SetNillableColor(s *string) *PonyCreate {
+	if s != nil {
+		pc.SetColor(*s)
+	}
+	return pc
+}
+
+// SetPriority sets the "priority" field.
+func (pc *PonyCreate) SetPriority(po pony.Priority) *PonyCreate {
+	pc.mutation.SetPriority(po)
+	return pc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (pc *PonyCreate) SetNillablePriority(po *pony.Priority) *PonyCreate {
+	if po != nil {
+		pc.SetPriority(*po)
+	}
+	return pc
+}
+
 // Mutation returns the PonyMutation object of the builder.
 func (pc *PonyCreate) Mutation() *PonyMutation {
 	return pc.mutation
@@ -36,6 +115,7 @@ func (pc *PonyCreate) Save(ctx context.Context) (*Pony, error) {
 		err  error
 		node *Pony
 	)
+	pc.defaults()
 	if len(pc.hooks) == 0 {
 		if err = pc.check(); err != nil {
 			return nil, err
@@ -99,11 +179,53 @@ func (pc *PonyCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (pc *PonyCreate) defaults() {
+	if _, ok := pc.mutation.Size(); !ok {
+		v := pony.DefaultSize
+		pc.mutation.SetSize(v)
+	}
+	if _, ok := pc.mutation.Height(); !ok {
+		v := pony.DefaultHeight
+		pc.mutation.SetHeight(v)
+	}
+	if _, ok := pc.mutation.Color(); !ok {
+		v := pony.DefaultColor
+		pc.mutation.SetColor(v)
+	}
+	if _, ok := pc.mutation.Priority(); !ok {
+		v := pony.DefaultPriority
+		pc.mutation.SetPriority(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (pc *PonyCreate) check() error {
+	if _, ok := pc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Pony.size"`)}
+	}
+	if v, ok := pc.mutation.Size(); ok {
+		if err := pony.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Pony.size": %w`, err)}
+		}
+	}
 	if _, ok := pc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Pony.name"`)}
 	}
+	if _, ok := pc.mutation.Height(); !ok {
+		return &ValidationError{Name: "height", err: errors.New(`ent: missing required field "Pony.height"`)}
+	}
+	if _, ok := pc.mutation.Color(); !ok {
+		return &ValidationError{Name: "color", err: errors.New(`ent: missing required field "Pony.color"`)}
+	}
+	if _, ok := pc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Pony.priority"`)}
+	}
+	if v, ok := pc.mutation.Priority(); ok {
+		if err := pony.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Pony.priority": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -131,17 +253,386 @@ func (pc *PonyCreate) createSpec() (*Pony, *sqlgraph.CreateSpec) {
 			},
 		}
 	)
+	_spec.OnConflict = pc.conflict
+	if value, ok := pc.mutation.Size(); ok {
+		_spec.SetField(pony.FieldSize, field.TypeEnum, value)
+		_node.Size = value
+	}
 	if value, ok := pc.mutation.Name(); ok {
 		_spec.SetField(pony.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := pc.mutation.Height(); ok {
+		_spec.SetField(pony.FieldHeight, field.TypeOther, value)
+		_node.Height = value
+	}
+	if value, ok := pc.mutation.Scores(); ok {
+		_spec.SetField(pony.FieldScores, field.TypeJSON, value)
+		_node.Scores = value
+	}
+	if value, ok := pc.mutation.Rank(); ok {
+		_spec.SetField(pony.FieldRank, field.TypeInt8, value)
+		_node.Rank = value
+	}
+	if value, ok := pc.mutation.Color(); ok {
+		_spec.SetField(pony.FieldColor, field.TypeString, value)
+		_node.Color = value
+	}
+	if value, ok := pc.mutation.Priority(); ok {
+		_spec.SetField(pony.FieldPriority, field.TypeEnum, value)
+		_node.Priority = value
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Pony.Create().
+//		SetSize(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PonyUpsert) {
+//			SetSize(v+v).
+//		}).
+//		Exec(ctx)
+func (pc *PonyCreate) OnConflict(opts ...sql.ConflictOption) *PonyUpsertOne {
+	pc.conflict = opts
+	return &PonyUpsertOne{
+		create: pc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Pony.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pc *PonyCreate) OnConflictColumns(columns ...string) *PonyUpsertOne {
+	pc.conflict = append(pc.conflict, sql.ConflictColumns(columns...))
+	return &PonyUpsertOne{
+		create: pc,
+	}
+}
+
+type (
+	// PonyUpsertOne is the builder for "upsert"-ing
+	//  one Pony node.
+	PonyUpsertOne struct {
+		create *PonyCreate
+	}
+
+	// PonyUpsert is the "OnConflict" setter.
+	PonyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSize sets the "size" field.
+func (u *PonyUpsert) SetSize(v schema.Size) *PonyUpsert {
+	u.Set(pony.FieldSize, v)
+	return u
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *PonyUpsert) UpdateSize() *PonyUpsert {
+	u.SetExcluded(pony.FieldSize)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PonyUpsert) SetName(v string) *PonyUpsert {
+	u.Set(pony.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PonyUpsert) UpdateName() *PonyUpsert {
+	u.SetExcluded(pony.FieldName)
+	return u
+}
+
+// SetHeight sets the "height" field.
+func (u *PonyUpsert) SetHeight(v schema.Height) *PonyUpsert {
+	u.Set(pony.FieldHeight, v)
+	return u
+}
+
+// UpdateHeight sets the "height" field to the value that was provided on create.
+func (u *PonyUpsert) UpdateHeight() *PonyUpsert {
+	u.SetExcluded(pony.FieldHeight)
+	return u
+}
+
+// SetScores sets the "scores" field.
+func (u *PonyUpsert) SetScores(v []int64) *PonyUpsert {
+	u.Set(pony.FieldScores, v)
+	return u
+}
+
+// UpdateScores sets the "scores" field to the value that was provided on create.
+func (u *PonyUpsert) UpdateScores() *PonyUpsert {
+	u.SetExcluded(pony.FieldScores)
+	return u
+}
+
+// ClearScores clears the value of the "scores" field.
+func (u *PonyUpsert) ClearScores() *PonyUpsert {
+	u.SetNull(pony.FieldScores)
+	return u
+}
+
+// SetRank sets the "rank" field.
+func (u *PonyUpsert) SetRank(v int8) *PonyUpsert {
+	u.Set(pony.FieldRank, v)
+	return u
+}
+
+// UpdateRank sets the "rank" field to the value that was provided on create.
+func (u *PonyUpsert) UpdateRank() *PonyUpsert {
+	u.SetExcluded(pony.FieldRank)
+	return u
+}
+
+// AddRank adds v to the "rank" field.
+func (u *PonyUpsert) AddRank(v int8) *PonyUpsert {
+	u.Add(pony.FieldRank, v)
+	return u
+}
+
+// ClearRank clears the value of the "rank" field.
+func (u *PonyUpsert) ClearRank() *PonyUpsert {
+	u.SetNull(pony.FieldRank)
+	return u
+}
+
+// SetColor sets the "color" field.
+func (u *PonyUpsert) SetColor(v string) *PonyUpsert {
+	u.Set(pony.FieldColor, v)
+	return u
+}
+
+// UpdateColor sets the "color" field to the value that was provided on create.
+func (u *PonyUpsert) UpdateColor() *PonyUpsert {
+	u.SetExcluded(pony.FieldColor)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *PonyUpsert) SetPriority(v pony.Priority) *PonyUpsert {
+	u.Set(pony.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *PonyUpsert) UpdatePriority() *PonyUpsert {
+	u.SetExcluded(pony.FieldPriority)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Pony.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PonyUpsertOne) UpdateNewValues() *PonyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Pony.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PonyUpsertOne) Ignore() *PonyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PonyUpsertOne) DoNothing() *PonyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PonyCreate.OnConflict
+// documentation for more info.
+func (u *PonyUpsertOne) Update(set func(*PonyUpsert)) *PonyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PonyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *PonyUpsertOne) SetSize(v schema.Size) *PonyUpsertOne {
+	return u.Update(func(s *PonyUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *PonyUpsertOne) UpdateSize() *PonyUpsertOne {
+	return u.Update(func(s *PonyUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PonyUpsertOne) SetName(v string) *PonyUpsertOne {
+	return u.Update(func(s *PonyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PonyUpsertOne) UpdateName() *PonyUpsertOne {
+	return u.Update(func(s *PonyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetHeight sets the "height" field.
+func (u *PonyUpsertOne) SetHeight(v schema.Height) *PonyUpsertOne {
+	return u.Update(func(s *PonyUpsert) {
+		s.SetHeight(v)
+	})
+}
+
+// UpdateHeight sets the "height" field to the value that was provided on create.
+func (u *PonyUpsertOne) UpdateHeight() *PonyUpsertOne {
+	return u.Update(func(s *PonyUpsert) {
+		s.UpdateHeight()
+	})
+}
+
+// SetScores sets the "scores" field.
+func (u *PonyUpsertOne) SetScores(v []int64) *PonyUpsertOne {
+	return u.Update(func(s *PonyUpsert) {
+		s.SetScores(v)
+	})
+}
+
+// UpdateScores sets the "scores" field to the value that was provided on create.
+func (u *PonyUpsertOne) UpdateScores() *PonyUpsertOne {
+	return u.Update(func(s *PonyUpsert) {
+		s.UpdateScores()
+	})
+}
+
+// ClearScores clears the value of the "scores" field.
+func (u *PonyUpsertOne) ClearScores() *PonyUpsertOne {
+	return u.Update(func(s *PonyUpsert) {
+		s.ClearScores()
+	})
+}
+
+// SetRank sets the "rank" field.
+func (u *PonyUpsertOne) SetRank(v int8) *PonyUpsertOne {
+	return u.Update(func(s *PonyUpsert) {
+		s.SetRank(v)
+	})
+}
+
+// AddRank adds v to the "rank" field.
+func (u *PonyUpsertOne) AddRank(v int8) *PonyUpsertOne {
+	return u.Update(func(s *PonyUpsert) {
+		s.AddRank(v)
+	})
+}
+
+// UpdateRank sets the "rank" field to the value that was provided on create.
+func (u *PonyUpsertOne) UpdateRank() *PonyUpsertOne {
+	return u.Update(func(s *PonyUpsert) {
+		s.UpdateRank()
+	})
+}
+
+// ClearRank clears the value of the "rank" field.
+func (u *PonyUpsertOne) ClearRank() *PonyUpsertOne {
+	return u.Update(func(s *PonyUpsert) {
+		s.ClearRank()
+	})
+}
+
+// SetColor sets the "color" field.
+func (u *PonyUpsertOne) SetColor(v string) *PonyUpsertOne {
+	return u.Update(func(s *PonyUpsert) {
+		s.SetColor(v)
+	})
+}
+
+// UpdateColor sets the "color" field to the value that was provided on create.
+func (u *PonyUpsertOne) UpdateColor() *PonyUpsertOne {
+	return u.Update(func(s *PonyUpsert) {
+		s.UpdateColor()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *PonyUpsertOne) SetPriority(v pony.Priority) *PonyUpsertOne {
+	return u.Update(func(s *PonyUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *PonyUpsertOne) UpdatePriority() *PonyUpsertOne {
+	return u.Update(func(s *PonyUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// Exec executes the query.
+func (u *PonyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PonyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PonyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PonyUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PonyUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // PonyCreateBulk is the builder for creating many Pony entities in bulk.
 type PonyCreateBulk struct {
 	config
 	builders []*PonyCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Pony entities in the database.
@@ -152,6 +643,7 @@ func (pcb *PonyCreateBulk) Save(ctx context.Context) ([]*Pony, error) {
 	for i := range pcb.builders {
 		func(i int, root context.Context) {
 			builder := pcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*PonyMutation)
 				if !ok {
@@ -167,6 +659,7 @@ func (pcb *PonyCreateBulk) Save(ctx context.Context) ([]*Pony, error) {
 					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -220,3 +713,223 @@ func (pcb *PonyCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Pony.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PonyUpsert) {
+//			SetSize(v+v).
+//		}).
+//		Exec(ctx)
+func (pcb *PonyCreateBulk) OnConflict(opts ...sql.ConflictOption) *PonyUpsertBulk {
+	pcb.conflict = opts
+	return &PonyUpsertBulk{
+		create: pcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Pony.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcb *PonyCreateBulk) OnConflictColumns(columns ...string) *PonyUpsertBulk {
+	pcb.conflict = append(pcb.conflict, sql.ConflictColumns(columns...))
+	return &PonyUpsertBulk{
+		create: pcb,
+	}
+}
+
+// PonyUpsertBulk is the builder for "upsert"-ing
+// a bulk of Pony nodes.
+type PonyUpsertBulk struct {
+	create *PonyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Pony.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PonyUpsertBulk) UpdateNewValues() *PonyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Pony.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PonyUpsertBulk) Ignore() *PonyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PonyUpsertBulk) DoNothing() *PonyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PonyCreateBulk.OnConflict
+// documentation for more info.
+func (u *PonyUpsertBulk) Update(set func(*PonyUpsert)) *PonyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PonyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *PonyUpsertBulk) SetSize(v schema.Size) *PonyUpsertBulk {
+	return u.Update(func(s *PonyUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *PonyUpsertBulk) UpdateSize() *PonyUpsertBulk {
+	return u.Update(func(s *PonyUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PonyUpsertBulk) SetName(v string) *PonyUpsertBulk {
+	return u.Update(func(s *PonyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PonyUpsertBulk) UpdateName() *PonyUpsertBulk {
+	return u.Update(func(s *PonyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetHeight sets the "height" field.
+func (u *PonyUpsertBulk) SetHeight(v schema.Height) *PonyUpsertBulk {
+	return u.Update(func(s *PonyUpsert) {
+		s.SetHeight(v)
+	})
+}
+
+// UpdateHeight sets the "height" field to the value that was provided on create.
+func (u *PonyUpsertBulk) UpdateHeight() *PonyUpsertBulk {
+	return u.Update(func(s *PonyUpsert) {
+		s.UpdateHeight()
+	})
+}
+
+// SetScores sets the "scores" field.
+func (u *PonyUpsertBulk) SetScores(v []int64) *PonyUpsertBulk {
+	return u.Update(func(s *PonyUpsert) {
+		s.SetScores(v)
+	})
+}
+
+// UpdateScores sets the "scores" field to the value that was provided on create.
+func (u *PonyUpsertBulk) UpdateScores() *PonyUpsertBulk {
+	return u.Update(func(s *PonyUpsert) {
+		s.UpdateScores()
+	})
+}
+
+// ClearScores clears the value of the "scores" field.
+func (u *PonyUpsertBulk) ClearScores() *PonyUpsertBulk {
+	return u.Update(func(s *PonyUpsert) {
+		s.ClearScores()
+	})
+}
+
+// SetRank sets the "rank" field.
+func (u *PonyUpsertBulk) SetRank(v int8) *PonyUpsertBulk {
+	return u.Update(func(s *PonyUpsert) {
+		s.SetRank(v)
+	})
+}
+
+// AddRank adds v to the "rank" field.
+func (u *PonyUpsertBulk) AddRank(v int8) *PonyUpsertBulk {
+	return u.Update(func(s *PonyUpsert) {
+		s.AddRank(v)
+	})
+}
+
+// UpdateRank sets the "rank" field to the value that was provided on create.
+func (u *PonyUpsertBulk) UpdateRank() *PonyUpsertBulk {
+	return u.Update(func(s *PonyUpsert) {
+		s.UpdateRank()
+	})
+}
+
+// ClearRank clears the value of the "rank" field.
+func (u *PonyUpsertBulk) ClearRank() *PonyUpsertBulk {
+	return u.Update(func(s *PonyUpsert) {
+		s.ClearRank()
+	})
+}
+
+// SetColor sets the "color" field.
+func (u *PonyUpsertBulk) SetColor(v string) *PonyUpsertBulk {
+	return u.Update(func(s *PonyUpsert) {
+		s.SetColor(v)
+	})
+}
+
+// UpdateColor sets the "color" field to the value that was provided on create.
+func (u *PonyUpsertBulk) UpdateColor() *PonyUpsertBulk {
+	return u.Update(func(s *PonyUpsert) {
+		s.UpdateColor()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *PonyUpsertBulk) SetPriority(v pony.Priority) *PonyUpsertBulk {
+	return u.Update(func(s *PonyUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *PonyUpsertBulk) UpdatePriority() *PonyUpsertBulk {
+	return u.Update(func(s *PonyUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// Exec executes the query.
+func (u *PonyUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PonyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PonyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PonyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -253,12 +253,12 @@ func (pq *PonyQuery) Clone() *PonyQuery {
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		Size schema.Size `json:"size,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Pony.Query().
-//		GroupBy(pony.FieldName).
+//		GroupBy(pony.FieldSize).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (pq *PonyQuery) GroupBy(field string, fields ...string) *PonyGroupBy {
@@ -281,11 +281,11 @@ func (pq *PonyQuery) GroupBy(field string, fields ...string) *PonyGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		Size schema.Size `json:"size,omitempty"`
 //	}
 //
 //	client.Pony.Query().
-//		Select(pony.FieldName).
+//		Select(pony.FieldSize).
 //		Scan(ctx, &v)
 func (pq *PonyQuery) Select(fields ...string) *PonySelect {
 	pq.fields = append(pq.fields, fields...)
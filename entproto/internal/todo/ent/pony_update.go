@@ -9,8 +9,10 @@ import (
 
 	"entgo.io/contrib/entproto/internal/todo/ent/pony"
 	"entgo.io/contrib/entproto/internal/todo/ent/predicate"
+	"entgo.io/contrib/entproto/internal/todo/ent/schema"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -27,12 +29,113 @@ func (pu *PonyUpdate) Where(ps ...predicate.Pony) *PonyUpdate {
 	return pu
 }
 
+// SetSize sets the "size" field.
+func (pu *PonyUpdate) SetSize(s schema.Size) *PonyUpdate {
+	pu.mutation.SetSize(s)
+	return pu
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (pu *PonyUpdate) SetNillableSize(s *schema.Size) *PonyUpdate {
+	if s != nil {
+		pu.SetSize(*s)
+	}
+	return pu
+}
+
 // SetName sets the "name" field.
 func (pu *PonyUpdate) SetName(s string) *PonyUpdate {
 	pu.mutation.SetName(s)
 	return pu
 }
 
+// SetHeight sets the "height" field.
+func (pu *PonyUpdate) SetHeight(s schema.Height) *PonyUpdate {
+	pu.mutation.SetHeight(s)
+	return pu
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (pu *PonyUpdate) SetNillableHeight(s *schema.Height) *PonyUpdate {
+	if s != nil {
+		pu.SetHeight(*s)
+	}
+	return pu
+}
+
+// SetScores sets the "scores" field.
+func (pu *PonyUpdate) SetScores(i []int64) *PonyUpdate {
+	pu.mutation.SetScores(i)
+	return pu
+}
+
+// AppendScores appends i to the "scores" field.
+func (pu *PonyUpdate) AppendScores(i []int64) *PonyUpdate {
+	pu.mutation.AppendScores(i)
+	return pu
+}
+
+// ClearScores clears the value of the "scores" field.
+func (pu *PonyUpdate) ClearScores() *PonyUpdate {
+	pu.mutation.ClearScores()
+	return pu
+}
+
+// SetRank sets the "rank" field.
+func (pu *PonyUpdate) SetRank(i int8) *PonyUpdate {
+	pu.mutation.ResetRank()
+	pu.mutation.SetRank(i)
+	return pu
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (pu *PonyUpdate) SetNillableRank(i *int8) *PonyUpdate {
+	if i != nil {
+		pu.SetRank(*i)
+	}
+	return pu
+}
+
+// AddRank adds i to the "rank" field.
+func (pu *PonyUpdate) AddRank(i int8) *PonyUpdate {
+	pu.mutation.AddRank(i)
+	return pu
+}
+
+// ClearRank clears the value of the "rank" field.
+func (pu *PonyUpdate) ClearRank() *PonyUpdate {
+	pu.mutation.ClearRank()
+	return pu
+}
+
+// SetColor sets the "color" field.
+func (pu *PonyUpdate) SetColor(s string) *PonyUpdate {
+	pu.mutation.SetColor(s)
+	return pu
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (pu *PonyUpdate) SetNillableColor(s *string) *PonyUpdate {
+	if s != nil {
+		pu.SetColor(*s)
+	}
+	return pu
+}
+
+// SetPriority sets the "priority" field.
+func (pu *PonyUpdate) SetPriority(po pony.Priority) *PonyUpdate {
+	pu.mutation.SetPriority(po)
+	return pu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (pu *PonyUpdate) SetNillablePriority(po *pony.Priority) *PonyUpdate {
+	if po != nil {
+		pu.SetPriority(*po)
+	}
+	return pu
+}
+
 // Mutation returns the PonyMutation object of the builder.
 func (pu *PonyUpdate) Mutation() *PonyMutation {
 	return pu.mutation
@@ -45,6 +148,9 @@ func (pu *PonyUpdate) Save(ctx context.Context) (int, error) {
 		affected int
 	)
 	if len(pu.hooks) == 0 {
+		if err = pu.check(); err != nil {
+			return 0, err
+		}
 		affected, err = pu.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -52,6 +158,9 @@ func (pu *PonyUpdate) Save(ctx context.Context) (int, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = pu.check(); err != nil {
+				return 0, err
+			}
 			pu.mutation = mutation
 			affected, err = pu.sqlSave(ctx)
 			mutation.done = true
@@ -92,6 +201,21 @@ func (pu *PonyUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (pu *PonyUpdate) check() error {
+	if v, ok := pu.mutation.Size(); ok {
+		if err := pony.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Pony.size": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Priority(); ok {
+		if err := pony.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Pony.priority": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (pu *PonyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -110,9 +234,41 @@ func (pu *PonyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := pu.mutation.Size(); ok {
+		_spec.SetField(pony.FieldSize, field.TypeEnum, value)
+	}
 	if value, ok := pu.mutation.Name(); ok {
 		_spec.SetField(pony.FieldName, field.TypeString, value)
 	}
+	if value, ok := pu.mutation.Height(); ok {
+		_spec.SetField(pony.FieldHeight, field.TypeOther, value)
+	}
+	if value, ok := pu.mutation.Scores(); ok {
+		_spec.SetField(pony.FieldScores, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedScores(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pony.FieldScores, value)
+		})
+	}
+	if pu.mutation.ScoresCleared() {
+		_spec.ClearField(pony.FieldScores, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.Rank(); ok {
+		_spec.SetField(pony.FieldRank, field.TypeInt8, value)
+	}
+	if value, ok := pu.mutation.AddedRank(); ok {
+		_spec.AddField(pony.FieldRank, field.TypeInt8, value)
+	}
+	if pu.mutation.RankCleared() {
+		_spec.ClearField(pony.FieldRank, field.TypeInt8)
+	}
+	if value, ok := pu.mutation.Color(); ok {
+		_spec.SetField(pony.FieldColor, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Priority(); ok {
+		_spec.SetField(pony.FieldPriority, field.TypeEnum, value)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{pony.Label}
@@ -132,12 +288,113 @@ type PonyUpdateOne struct {
 	mutation *PonyMutation
 }
 
+// SetSize sets the "size" field.
+func (puo *PonyUpdateOne) SetSize(s schema.Size) *PonyUpdateOne {
+	puo.mutation.SetSize(s)
+	return puo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (puo *PonyUpdateOne) SetNillableSize(s *schema.Size) *PonyUpdateOne {
+	if s != nil {
+		puo.SetSize(*s)
+	}
+	return puo
+}
+
 // SetName sets the "name" field.
 func (puo *PonyUpdateOne) SetName(s string) *PonyUpdateOne {
 	puo.mutation.SetName(s)
 	return puo
 }
 
+// SetHeight sets the "height" field.
+func (puo *PonyUpdateOne) SetHeight(s schema.Height) *PonyUpdateOne {
+	puo.mutation.SetHeight(s)
+	return puo
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (puo *PonyUpdateOne) SetNillableHeight(s *schema.Height) *PonyUpdateOne {
+	if s != nil {
+		puo.SetHeight(*s)
+	}
+	return puo
+}
+
+// SetScores sets the "scores" field.
+func (puo *PonyUpdateOne) SetScores(i []int64) *PonyUpdateOne {
+	puo.mutation.SetScores(i)
+	return puo
+}
+
+// AppendScores appends i to the "scores" field.
+func (puo *PonyUpdateOne) AppendScores(i []int64) *PonyUpdateOne {
+	puo.mutation.AppendScores(i)
+	return puo
+}
+
+// ClearScores clears the value of the "scores" field.
+func (puo *PonyUpdateOne) ClearScores() *PonyUpdateOne {
+	puo.mutation.ClearScores()
+	return puo
+}
+
+// SetRank sets the "rank" field.
+func (puo *PonyUpdateOne) SetRank(i int8) *PonyUpdateOne {
+	puo.mutation.ResetRank()
+	puo.mutation.SetRank(i)
+	return puo
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (puo *PonyUpdateOne) SetNillableRank(i *int8) *PonyUpdateOne {
+	if i != nil {
+		puo.SetRank(*i)
+	}
+	return puo
+}
+
+// AddRank adds i to the "rank" field.
+func (puo *PonyUpdateOne) AddRank(i int8) *PonyUpdateOne {
+	puo.mutation.AddRank(i)
+	return puo
+}
+
+// ClearRank clears the value of the "rank" field.
+func (puo *PonyUpdateOne) ClearRank() *PonyUpdateOne {
+	puo.mutation.ClearRank()
+	return puo
+}
+
+// SetColor sets the "color" field.
+func (puo *PonyUpdateOne) SetColor(s string) *PonyUpdateOne {
+	puo.mutation.SetColor(s)
+	return puo
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (puo *PonyUpdateOne) SetNillableColor(s *string) *PonyUpdateOne {
+	if s != nil {
+		puo.SetColor(*s)
+	}
+	return puo
+}
+
+// SetPriority sets the "priority" field.
+func (puo *PonyUpdateOne) SetPriority(po pony.Priority) *PonyUpdateOne {
+	puo.mutation.SetPriority(po)
+	return puo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (puo *PonyUpdateOne) SetNillablePriority(po *pony.Priority) *PonyUpdateOne {
+	if po != nil {
+		puo.SetPriority(*po)
+	}
+	return puo
+}
+
 // Mutation returns the PonyMutation object of the builder.
 func (puo *PonyUpdateOne) Mutation() *PonyMutation {
 	return puo.mutation
@@ -157,6 +414,9 @@ func (puo *PonyUpdateOne) Save(ctx context.Context) (*Pony, error) {
 		node *Pony
 	)
 	if len(puo.hooks) == 0 {
+		if err = puo.check(); err != nil {
+			return nil, err
+		}
 		node, err = puo.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -164,6 +424,9 @@ func (puo *PonyUpdateOne) Save(ctx context.Context) (*Pony, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = puo.check(); err != nil {
+				return nil, err
+			}
 			puo.mutation = mutation
 			node, err = puo.sqlSave(ctx)
 			mutation.done = true
@@ -210,6 +473,21 @@ func (puo *PonyUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (puo *PonyUpdateOne) check() error {
+	if v, ok := puo.mutation.Size(); ok {
+		if err := pony.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Pony.size": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Priority(); ok {
+		if err := pony.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Pony.priority": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (puo *PonyUpdateOne) sqlSave(ctx context.Context) (_node *Pony, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -245,9 +523,41 @@ func (puo *PonyUpdateOne) sqlSave(ctx context.Context) (_node *Pony, err error)
 			}
 		}
 	}
+	if value, ok := puo.mutation.Size(); ok {
+		_spec.SetField(pony.FieldSize, field.TypeEnum, value)
+	}
 	if value, ok := puo.mutation.Name(); ok {
 		_spec.SetField(pony.FieldName, field.TypeString, value)
 	}
+	if value, ok := puo.mutation.Height(); ok {
+		_spec.SetField(pony.FieldHeight, field.TypeOther, value)
+	}
+	if value, ok := puo.mutation.Scores(); ok {
+		_spec.SetField(pony.FieldScores, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedScores(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pony.FieldScores, value)
+		})
+	}
+	if puo.mutation.ScoresCleared() {
+		_spec.ClearField(pony.FieldScores, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.Rank(); ok {
+		_spec.SetField(pony.FieldRank, field.TypeInt8, value)
+	}
+	if value, ok := puo.mutation.AddedRank(); ok {
+		_spec.AddField(pony.FieldRank, field.TypeInt8, value)
+	}
+	if puo.mutation.RankCleared() {
+		_spec.ClearField(pony.FieldRank, field.TypeInt8)
+	}
+	if value, ok := puo.mutation.Color(); ok {
+		_spec.SetField(pony.FieldColor, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Priority(); ok {
+		_spec.SetField(pony.FieldPriority, field.TypeEnum, value)
+	}
 	_node = &Pony{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
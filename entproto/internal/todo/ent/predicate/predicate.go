@@ -9,9 +9,15 @@ import (
 // Attachment is the predicate function for attachment builders.
 type Attachment func(*sql.Selector)
 
+// Document is the predicate function for document builders.
+type Document func(*sql.Selector)
+
 // Group is the predicate function for group builders.
 type Group func(*sql.Selector)
 
+// Label is the predicate function for label builders.
+type Label func(*sql.Selector)
+
 // MultiWordSchema is the predicate function for multiwordschema builders.
 type MultiWordSchema func(*sql.Selector)
 
@@ -24,6 +30,9 @@ type Pet func(*sql.Selector)
 // Pony is the predicate function for pony builders.
 type Pony func(*sql.Selector)
 
+// Project is the predicate function for project builders.
+type Project func(*sql.Selector)
+
 // SkipEdgeExample is the predicate function for skipedgeexample builders.
 type SkipEdgeExample func(*sql.Selector)
 
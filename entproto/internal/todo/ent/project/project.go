@@ -0,0 +1,107 @@
+// Code generated by ent, DO NOT EDIT.
+
+package project
+
+import (
+	"fmt"
+)
+
+const (
+	// Label holds the string label denoting the project type in the database.
+	Label = "project"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// EdgeOwner holds the string denoting the owner edge name in mutations.
+	EdgeOwner = "owner"
+	// EdgeAttachments holds the string denoting the attachments edge name in mutations.
+	EdgeAttachments = "attachments"
+	// UserFieldID holds the string denoting the ID field of the User.
+	UserFieldID = "user_id"
+	// Table holds the table name of the project in the database.
+	Table = "projects"
+	// OwnerTable is the table that holds the owner relation/edge.
+	OwnerTable = "projects"
+	// OwnerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	OwnerInverseTable = "users"
+	// OwnerColumn is the table column denoting the owner relation/edge.
+	OwnerColumn = "project_owner"
+	// AttachmentsTable is the table that holds the attachments relation/edge.
+	AttachmentsTable = "attachments"
+	// AttachmentsInverseTable is the table name for the Attachment entity.
+	// It exists in this package in order to avoid circular dependency with the "attachment" package.
+	AttachmentsInverseTable = "attachments"
+	// AttachmentsColumn is the table column denoting the attachments relation/edge.
+	AttachmentsColumn = "project_attachments"
+)
+
+// Columns holds all SQL columns for project fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldDeletedAt,
+	FieldPriority,
+	FieldVersion,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "projects"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"project_owner",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
+)
+
+// Priority defines the type for the "priority" enum field.
+type Priority string
+
+// PriorityLow is the default value of the Priority enum.
+const DefaultPriority = PriorityLow
+
+// Priority values.
+const (
+	PriorityLow  Priority = "low"
+	PriorityHigh Priority = "high"
+)
+
+func (pr Priority) String() string {
+	return string(pr)
+}
+
+// PriorityValidator is a validator for the "priority" field enum values. It is called by the builders before save.
+func PriorityValidator(pr Priority) error {
+	switch pr {
+	case PriorityLow, PriorityHigh:
+		return nil
+	default:
+		return fmt.Errorf("project: invalid enum value for priority field: %q", pr)
+	}
+}
@@ -16,15 +16,24 @@ package entpb
 
 import (
 	"context"
+	"database/sql"
+	"encoding/base64"
 	"strconv"
 	"testing"
 	"time"
 
+	"entgo.io/contrib/entproto"
 	"entgo.io/contrib/entproto/internal/todo/ent"
+	"entgo.io/contrib/entproto/internal/todo/ent/attachment"
 	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
 	"entgo.io/contrib/entproto/internal/todo/ent/user"
+	"entgo.io/contrib/entproto/runtime"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -32,7 +41,7 @@ import (
 func TestAttachmentService_Get(t *testing.T) {
 	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
 	defer client.Close()
-	svc := NewAttachmentService(client)
+	svc := NewAttachmentService(client, nil)
 
 	ctx := context.Background()
 	attachment := client.Attachment.Create().SaveX(ctx)
@@ -53,10 +62,185 @@ func TestAttachmentService_Get(t *testing.T) {
 	require.EqualValues(t, respStatus.Code(), codes.InvalidArgument)
 }
 
+func TestAttachmentService_CacheEdges(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewAttachmentService(client, nil, runtime.WithCache(runtime.NewMemoryCache(), time.Minute))
+	ctx := context.Background()
+	recipient := client.User.Create().
+		SetUserName("rotemtam").
+		SetJoined(time.Now()).
+		SetPoints(10).
+		SetExp(1000).
+		SetStatus(user.StatusActive).
+		SetExternalID(1).
+		SetCrmID(uuid.New()).
+		SetCustomPb(1).
+		SetOmitPrefix(user.OmitPrefixFoo).
+		SaveX(ctx)
+	created := client.Attachment.Create().SaveX(ctx)
+	id, err := created.ID.MarshalBinary()
+	require.NoError(t, err)
+
+	_, err = svc.Get(ctx, &GetAttachmentRequest{Id: id})
+	require.NoError(t, err)
+	require.True(t, svc.config.CachedEntity(ctx, "Attachment", created.ID, &Attachment{}))
+
+	// Edge writes invalidate the cached entities.
+	_, err = svc.AddAttachmentRecipient(ctx, &AddAttachmentRecipientRequest{Id: id, RecipientId: recipient.ID})
+	require.NoError(t, err)
+	require.False(t, svc.config.CachedEntity(ctx, "Attachment", created.ID, &Attachment{}))
+	_, err = svc.Get(ctx, &GetAttachmentRequest{Id: id})
+	require.NoError(t, err)
+	require.True(t, svc.config.CachedEntity(ctx, "Attachment", created.ID, &Attachment{}))
+	_, err = svc.RemoveAttachmentRecipient(ctx, &RemoveAttachmentRecipientRequest{Id: id, RecipientId: recipient.ID})
+	require.NoError(t, err)
+	require.False(t, svc.config.CachedEntity(ctx, "Attachment", created.ID, &Attachment{}))
+	require.Zero(t, created.QueryRecipients().CountX(ctx))
+}
+
+func TestAttachmentService_BatchDelete(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewAttachmentService(client, nil)
+	ctx := context.Background()
+
+	var ids [][]byte
+	for i := 0; i < 3; i++ {
+		id, err := client.Attachment.Create().SaveX(ctx).ID.MarshalBinary()
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	_, err := svc.BatchDelete(ctx, &BatchDeleteAttachmentsRequest{Ids: ids[:2]})
+	require.NoError(t, err)
+	count, err := svc.Count(ctx, &CountAttachmentsRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, count.Count)
+	exists, err := svc.Exists(ctx, &ExistsAttachmentRequest{Key: &ExistsAttachmentRequest_Id{Id: ids[2]}})
+	require.NoError(t, err)
+	require.True(t, exists.Exists)
+	exists, err = svc.Exists(ctx, &ExistsAttachmentRequest{Key: &ExistsAttachmentRequest_Id{Id: ids[0]}})
+	require.NoError(t, err)
+	require.False(t, exists.Exists)
+
+	_, err = svc.BatchDelete(ctx, &BatchDeleteAttachmentsRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = svc.BatchDelete(ctx, &BatchDeleteAttachmentsRequest{Ids: [][]byte{[]byte("short")}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, 1, client.Attachment.Query().CountX(ctx))
+}
+
+func TestAttachmentService_UUID(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewAttachmentService(client, nil)
+	ctx := context.Background()
+
+	// The ID set by the request is kept, and generated otherwise.
+	id := uuid.New()
+	created, err := svc.Create(ctx, &CreateAttachmentRequest{Attachment: &Attachment{Id: id[:]}})
+	require.NoError(t, err)
+	require.Equal(t, id[:], created.GetId())
+	require.True(t, client.Attachment.Query().Where(attachment.ID(id)).ExistX(ctx))
+	created, err = svc.Create(ctx, &CreateAttachmentRequest{Attachment: &Attachment{}})
+	require.NoError(t, err)
+	require.Len(t, created.GetId(), 16)
+	require.NotEqual(t, id[:], created.GetId())
+	_, err = svc.Create(ctx, &CreateAttachmentRequest{Attachment: &Attachment{Id: []byte("short")}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Pages are tokenized by the UUIDs of their entries.
+	for i := 0; i < 3; i++ {
+		client.Attachment.Create().SaveX(ctx)
+	}
+	seen := make(map[string]bool)
+	req := &ListAttachmentRequest{PageSize: 2}
+	for {
+		list, err := svc.List(ctx, req)
+		require.NoError(t, err)
+		for _, a := range list.GetAttachmentList() {
+			require.False(t, seen[string(a.GetId())])
+			seen[string(a.GetId())] = true
+		}
+		if list.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = list.GetNextPageToken()
+	}
+	require.Len(t, seen, 5)
+	_, err = svc.List(ctx, &ListAttachmentRequest{PageToken: base64.StdEncoding.EncodeToString([]byte("short"))})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAttachmentService_PartialBatchCreate(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewAttachmentService(client, nil)
+	ctx := context.Background()
+
+	// The entry referencing a missing user fails without failing the others.
+	resp, err := svc.BatchCreate(ctx, &BatchCreateAttachmentsRequest{
+		Requests: []*CreateAttachmentRequest{
+			{Attachment: &Attachment{}},
+			{Attachment: &Attachment{User: &User{Id: 1000}}},
+			{Attachment: &Attachment{}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 3)
+	require.NotNil(t, resp.Results[0].GetAttachment())
+	require.Nil(t, resp.Results[0].GetStatus())
+	require.Nil(t, resp.Results[1].GetAttachment())
+	require.EqualValues(t, codes.FailedPrecondition, resp.Results[1].GetStatus().GetCode())
+	details := status.FromProto(resp.Results[1].GetStatus()).Details()
+	require.Len(t, details, 1)
+	require.Equal(t, runtime.ReasonForeignKeyConstraint, details[0].(*errdetails.ErrorInfo).GetReason())
+	require.NotNil(t, resp.Results[2].GetAttachment())
+	require.Equal(t, 2, client.Attachment.Query().CountX(ctx))
+
+	_, err = svc.BatchCreate(ctx, &BatchCreateAttachmentsRequest{
+		Requests: make([]*CreateAttachmentRequest, entproto.MaxBatchCreateSize+1),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAttachmentService_BatchCreateParallelism(t *testing.T) {
+	// The entries share a single connection, which serializes their inserts on the in-memory database.
+	db, err := sql.Open(dialect.SQLite, "file:parallel?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(entsql.OpenDB(dialect.SQLite, db))))
+	defer client.Close()
+	svc := NewAttachmentService(client, nil, runtime.WithBatchCreateParallelism(4))
+	ctx := context.Background()
+
+	// The results keep the order of the entries, whatever the order of their creation.
+	var requests []*CreateAttachmentRequest
+	for i := 0; i < 20; i++ {
+		id := uuid.New()
+		a := &Attachment{Id: id[:]}
+		if i%5 == 3 {
+			a.User = &User{Id: 1000}
+		}
+		requests = append(requests, &CreateAttachmentRequest{Attachment: a})
+	}
+	resp, err := svc.BatchCreate(ctx, &BatchCreateAttachmentsRequest{Requests: requests})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, len(requests))
+	for i, r := range resp.Results {
+		if i%5 == 3 {
+			require.EqualValues(t, codes.FailedPrecondition, r.GetStatus().GetCode())
+			continue
+		}
+		require.Equal(t, requests[i].GetAttachment().GetId(), r.GetAttachment().GetId())
+	}
+	require.Equal(t, 16, client.Attachment.Query().CountX(ctx))
+}
+
 func TestAttachmentService_MultiEdge(t *testing.T) {
 	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
 	defer client.Close()
-	svc := NewAttachmentService(client)
+	svc := NewAttachmentService(client, nil)
 	ctx := context.Background()
 	var users []*ent.User
 	for i := 0; i < 5; i++ {
@@ -99,3 +283,21 @@ func TestAttachmentService_MultiEdge(t *testing.T) {
 	require.NotNil(t, get.User)
 	require.Len(t, get.Recipients, 4)
 }
+
+func TestAttachmentService_ExtraMethods(t *testing.T) {
+	streams := make(map[string]grpc.StreamDesc)
+	for _, s := range AttachmentService_ServiceDesc.Streams {
+		streams[s.StreamName] = s
+	}
+	require.True(t, streams["Upload"].ClientStreams)
+	require.False(t, streams["Upload"].ServerStreams)
+	require.True(t, streams["Sync"].ClientStreams)
+	require.True(t, streams["Sync"].ServerStreams)
+
+	// Extra methods without an implementation are left to the embedded Unimplemented server.
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewAttachmentService(client, nil)
+	err := svc.Upload(nil)
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -0,0 +1,193 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entpb
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
+	"entgo.io/contrib/entproto/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestDocumentService_Tenant(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewDocumentService(client, nil, runtime.WithTenant(runtime.TenantHeader("tenant-id")))
+	ctx := context.Background()
+	tenant1 := metadata.NewIncomingContext(ctx, metadata.Pairs("tenant-id", "1"))
+	tenant2 := metadata.NewIncomingContext(ctx, metadata.Pairs("tenant-id", "2"))
+
+	// The created documents belong to the tenant of the request, whatever the value of the request.
+	doc, err := svc.Create(tenant1, &CreateDocumentRequest{Document: &Document{TenantId: 2, Title: "doc"}})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, doc.GetTenantId())
+	_, err = svc.BatchCreate(tenant2, &BatchCreateDocumentsRequest{Requests: []*CreateDocumentRequest{
+		{Document: &Document{Title: "other"}},
+	}})
+	require.NoError(t, err)
+
+	got, err := svc.Get(tenant1, &GetDocumentRequest{Id: doc.GetId()})
+	require.NoError(t, err)
+	require.Equal(t, "doc", got.GetTitle())
+	list, err := svc.List(tenant1, &ListDocumentRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.GetDocumentList(), 1)
+	count, err := svc.Count(tenant2, &CountDocumentsRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, count.GetCount())
+
+	// The documents of the other tenants are not found.
+	_, err = svc.Get(tenant2, &GetDocumentRequest{Id: doc.GetId()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.Update(tenant2, &UpdateDocumentRequest{Document: &Document{Id: doc.GetId(), TenantId: 1, Title: "updated"}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.Delete(tenant2, &DeleteDocumentRequest{Id: doc.GetId()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	deleted, err := svc.DeleteDocuments(tenant2, &DeleteDocumentsRequest{Filter: &DocumentFilter{
+		Id: &DocumentFilter_Int64Filter{Gt: wrapperspb.Int64(0)},
+	}})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, deleted.GetDeleted())
+	got, err = svc.Get(tenant1, &GetDocumentRequest{Id: doc.GetId()})
+	require.NoError(t, err)
+	require.Equal(t, "doc", got.GetTitle())
+
+	updated, err := svc.Update(tenant1, &UpdateDocumentRequest{Document: &Document{Id: doc.GetId(), TenantId: 1, Title: "updated"}})
+	require.NoError(t, err)
+	require.Equal(t, "updated", updated.GetTitle())
+	// Delete returns the deleted document.
+	removed, err := svc.Delete(tenant1, &DeleteDocumentRequest{Id: doc.GetId()})
+	require.NoError(t, err)
+	require.Equal(t, doc.GetId(), removed.GetId())
+	require.Equal(t, "updated", removed.GetTitle())
+	_, err = svc.Get(tenant1, &GetDocumentRequest{Id: doc.GetId()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.Delete(tenant1, &DeleteDocumentRequest{Id: doc.GetId()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Requests without a valid tenant are rejected.
+	_, err = svc.List(ctx, &ListDocumentRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = svc.List(metadata.NewIncomingContext(ctx, metadata.Pairs("tenant-id", "one")), &ListDocumentRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = NewDocumentService(client, nil).List(tenant1, &ListDocumentRequest{})
+	require.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestDocumentService_TenantEdges(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewDocumentService(client, nil, runtime.WithTenant(runtime.TenantHeader("tenant-id")))
+	ctx := context.Background()
+	tenant1 := metadata.NewIncomingContext(ctx, metadata.Pairs("tenant-id", "1"))
+	tenant2 := metadata.NewIncomingContext(ctx, metadata.Pairs("tenant-id", "2"))
+	parent, err := svc.Create(tenant1, &CreateDocumentRequest{Document: &Document{Title: "parent"}})
+	require.NoError(t, err)
+	other, err := svc.Create(tenant2, &CreateDocumentRequest{Document: &Document{Title: "other"}})
+	require.NoError(t, err)
+
+	// The entries of the tenant can be linked.
+	child, err := svc.Create(tenant1, &CreateDocumentRequest{Document: &Document{Title: "child", ParentId: parent.GetId()}})
+	require.NoError(t, err)
+	require.EqualValues(t, parent.GetId(), client.Document.GetX(ctx, int(child.GetId())).QueryParent().OnlyIDX(ctx), "parent is linked")
+
+	// The entries of the other tenants are not found.
+	_, err = svc.Create(tenant2, &CreateDocumentRequest{Document: &Document{Title: "child", ParentId: parent.GetId()}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.Update(tenant1, &UpdateDocumentRequest{Document: &Document{Id: child.GetId(), TenantId: 1, Title: "child", ParentId: other.GetId()}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.BatchCreate(tenant2, &BatchCreateDocumentsRequest{Requests: []*CreateDocumentRequest{
+		{Document: &Document{Title: "child", ParentId: parent.GetId()}},
+	}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.EqualValues(t, parent.GetId(), client.Document.GetX(ctx, int(child.GetId())).QueryParent().OnlyIDX(ctx), "parent is unchanged")
+}
+
+func TestDocumentService_IdempotencyKey(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewDocumentService(client, nil, runtime.WithTenant(runtime.TenantHeader("tenant-id")))
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("tenant-id", "1"))
+	keyed := metadata.NewIncomingContext(context.Background(), metadata.Pairs("tenant-id", "1", runtime.IdempotencyKeyHeader, "key"))
+
+	// Retries with the key of the metadata return the document created by the first request.
+	doc, err := svc.Create(keyed, &CreateDocumentRequest{Document: &Document{Title: "doc"}})
+	require.NoError(t, err)
+	require.Equal(t, "key", doc.GetRequestId().GetValue())
+	retry, err := svc.Create(keyed, &CreateDocumentRequest{Document: &Document{Title: "retry"}})
+	require.NoError(t, err)
+	require.Equal(t, doc.GetId(), retry.GetId())
+	require.Equal(t, "doc", retry.GetTitle())
+
+	// The key falls back to the field of the request.
+	retry, err = svc.Create(ctx, &CreateDocumentRequest{Document: &Document{Title: "retry", RequestId: wrapperspb.String("key")}})
+	require.NoError(t, err)
+	require.Equal(t, doc.GetId(), retry.GetId())
+	other, err := svc.Create(ctx, &CreateDocumentRequest{Document: &Document{Title: "other", RequestId: wrapperspb.String("other")}})
+	require.NoError(t, err)
+	require.NotEqual(t, doc.GetId(), other.GetId())
+
+	// Requests without a key always create a document.
+	for i := 0; i < 2; i++ {
+		_, err = svc.Create(ctx, &CreateDocumentRequest{Document: &Document{Title: "doc"}})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 4, client.Document.Query().CountX(ctx))
+}
+
+// documentExtraMethods implements the extra methods of the DocumentService.
+type documentExtraMethods struct {
+	archived []int64
+}
+
+var _ DocumentServiceExtraMethods = (*documentExtraMethods)(nil)
+
+func (d *documentExtraMethods) Archive(_ context.Context, req *Document) (*Document, error) {
+	d.archived = append(d.archived, req.GetId())
+	return req, nil
+}
+
+func (d *documentExtraMethods) Tail(*ListDocumentRequest, DocumentService_TailServer) error {
+	return status.Error(codes.Unavailable, "unavailable")
+}
+
+func TestDocumentService_ExtraMethods(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	// The extra methods are served by the implementation passed to the constructor.
+	extra := &documentExtraMethods{}
+	svc := NewDocumentService(client, extra)
+	doc, err := svc.Archive(ctx, &Document{Id: 1, Title: "doc"})
+	require.NoError(t, err)
+	require.Equal(t, "doc", doc.GetTitle())
+	require.Equal(t, []int64{1}, extra.archived)
+	err = svc.Tail(&ListDocumentRequest{}, nil)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	// Without an implementation, they answer with the Unimplemented code.
+	svc = NewDocumentService(client, nil)
+	_, err = svc.Archive(ctx, &Document{Id: 1})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+	err = svc.Tail(&ListDocumentRequest{}, nil)
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
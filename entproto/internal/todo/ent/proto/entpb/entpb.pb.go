@@ -1,4 +1,4 @@
-// Code generated by entproto. DO NOT EDIT.
+// Code generated by entproto (entgo.io/contrib (devel)) from schema 830b4fd7dfe10a4d207535c61c07c2c632abf9d1dba0d9b450b1e8b270bbc3f3, DO NOT EDIT.
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
@@ -9,9 +9,13 @@
 package entpb
 
 import (
+	common "entgo.io/contrib/entproto/internal/todo/ent/proto/common"
+	_ "entgo.io/contrib/entproto/options/entproto"
+	status "google.golang.org/genproto/googleapis/rpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
@@ -25,6 +29,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Size int32
+
+const (
+	Size_SIZE_MEDIUM Size = 0
+	Size_SIZE_SMALL  Size = 1
+	Size_SIZE_LARGE  Size = 2
+)
+
+// Enum value maps for Size.
+var (
+	Size_name = map[int32]string{
+		0: "SIZE_MEDIUM",
+		1: "SIZE_SMALL",
+		2: "SIZE_LARGE",
+	}
+	Size_value = map[string]int32{
+		"SIZE_MEDIUM": 0,
+		"SIZE_SMALL":  1,
+		"SIZE_LARGE":  2,
+	}
+)
+
+func (x Size) Enum() *Size {
+	p := new(Size)
+	*p = x
+	return p
+}
+
+func (x Size) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Size) Descriptor() protoreflect.EnumDescriptor {
+	return file_entpb_entpb_proto_enumTypes[0].Descriptor()
+}
+
+func (Size) Type() protoreflect.EnumType {
+	return &file_entpb_entpb_proto_enumTypes[0]
+}
+
+func (x Size) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Size.Descriptor instead.
+func (Size) EnumDescriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{0}
+}
+
 type GetAttachmentRequest_View int32
 
 const (
@@ -58,11 +111,11 @@ func (x GetAttachmentRequest_View) String() string {
 }
 
 func (GetAttachmentRequest_View) Descriptor() protoreflect.EnumDescriptor {
-	return file_entpb_entpb_proto_enumTypes[0].Descriptor()
+	return file_entpb_entpb_proto_enumTypes[1].Descriptor()
 }
 
 func (GetAttachmentRequest_View) Type() protoreflect.EnumType {
-	return &file_entpb_entpb_proto_enumTypes[0]
+	return &file_entpb_entpb_proto_enumTypes[1]
 }
 
 func (x GetAttachmentRequest_View) Number() protoreflect.EnumNumber {
@@ -107,11 +160,11 @@ func (x ListAttachmentRequest_View) String() string {
 }
 
 func (ListAttachmentRequest_View) Descriptor() protoreflect.EnumDescriptor {
-	return file_entpb_entpb_proto_enumTypes[1].Descriptor()
+	return file_entpb_entpb_proto_enumTypes[2].Descriptor()
 }
 
 func (ListAttachmentRequest_View) Type() protoreflect.EnumType {
-	return &file_entpb_entpb_proto_enumTypes[1]
+	return &file_entpb_entpb_proto_enumTypes[2]
 }
 
 func (x ListAttachmentRequest_View) Number() protoreflect.EnumNumber {
@@ -120,7 +173,252 @@ func (x ListAttachmentRequest_View) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ListAttachmentRequest_View.Descriptor instead.
 func (ListAttachmentRequest_View) EnumDescriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{5, 0}
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type GetDocumentRequest_View int32
+
+const (
+	GetDocumentRequest_VIEW_UNSPECIFIED GetDocumentRequest_View = 0
+	GetDocumentRequest_BASIC            GetDocumentRequest_View = 1
+	GetDocumentRequest_WITH_EDGE_IDS    GetDocumentRequest_View = 2
+)
+
+// Enum value maps for GetDocumentRequest_View.
+var (
+	GetDocumentRequest_View_name = map[int32]string{
+		0: "VIEW_UNSPECIFIED",
+		1: "BASIC",
+		2: "WITH_EDGE_IDS",
+	}
+	GetDocumentRequest_View_value = map[string]int32{
+		"VIEW_UNSPECIFIED": 0,
+		"BASIC":            1,
+		"WITH_EDGE_IDS":    2,
+	}
+)
+
+func (x GetDocumentRequest_View) Enum() *GetDocumentRequest_View {
+	p := new(GetDocumentRequest_View)
+	*p = x
+	return p
+}
+
+func (x GetDocumentRequest_View) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetDocumentRequest_View) Descriptor() protoreflect.EnumDescriptor {
+	return file_entpb_entpb_proto_enumTypes[3].Descriptor()
+}
+
+func (GetDocumentRequest_View) Type() protoreflect.EnumType {
+	return &file_entpb_entpb_proto_enumTypes[3]
+}
+
+func (x GetDocumentRequest_View) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetDocumentRequest_View.Descriptor instead.
+func (GetDocumentRequest_View) EnumDescriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{21, 0}
+}
+
+type ListDocumentRequest_View int32
+
+const (
+	ListDocumentRequest_VIEW_UNSPECIFIED ListDocumentRequest_View = 0
+	ListDocumentRequest_BASIC            ListDocumentRequest_View = 1
+	ListDocumentRequest_WITH_EDGE_IDS    ListDocumentRequest_View = 2
+)
+
+// Enum value maps for ListDocumentRequest_View.
+var (
+	ListDocumentRequest_View_name = map[int32]string{
+		0: "VIEW_UNSPECIFIED",
+		1: "BASIC",
+		2: "WITH_EDGE_IDS",
+	}
+	ListDocumentRequest_View_value = map[string]int32{
+		"VIEW_UNSPECIFIED": 0,
+		"BASIC":            1,
+		"WITH_EDGE_IDS":    2,
+	}
+)
+
+func (x ListDocumentRequest_View) Enum() *ListDocumentRequest_View {
+	p := new(ListDocumentRequest_View)
+	*p = x
+	return p
+}
+
+func (x ListDocumentRequest_View) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListDocumentRequest_View) Descriptor() protoreflect.EnumDescriptor {
+	return file_entpb_entpb_proto_enumTypes[4].Descriptor()
+}
+
+func (ListDocumentRequest_View) Type() protoreflect.EnumType {
+	return &file_entpb_entpb_proto_enumTypes[4]
+}
+
+func (x ListDocumentRequest_View) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListDocumentRequest_View.Descriptor instead.
+func (ListDocumentRequest_View) EnumDescriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{27, 0}
+}
+
+type GetLabelRequest_View int32
+
+const (
+	GetLabelRequest_VIEW_UNSPECIFIED GetLabelRequest_View = 0
+	GetLabelRequest_BASIC            GetLabelRequest_View = 1
+	GetLabelRequest_WITH_EDGE_IDS    GetLabelRequest_View = 2
+)
+
+// Enum value maps for GetLabelRequest_View.
+var (
+	GetLabelRequest_View_name = map[int32]string{
+		0: "VIEW_UNSPECIFIED",
+		1: "BASIC",
+		2: "WITH_EDGE_IDS",
+	}
+	GetLabelRequest_View_value = map[string]int32{
+		"VIEW_UNSPECIFIED": 0,
+		"BASIC":            1,
+		"WITH_EDGE_IDS":    2,
+	}
+)
+
+func (x GetLabelRequest_View) Enum() *GetLabelRequest_View {
+	p := new(GetLabelRequest_View)
+	*p = x
+	return p
+}
+
+func (x GetLabelRequest_View) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetLabelRequest_View) Descriptor() protoreflect.EnumDescriptor {
+	return file_entpb_entpb_proto_enumTypes[5].Descriptor()
+}
+
+func (GetLabelRequest_View) Type() protoreflect.EnumType {
+	return &file_entpb_entpb_proto_enumTypes[5]
+}
+
+func (x GetLabelRequest_View) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetLabelRequest_View.Descriptor instead.
+func (GetLabelRequest_View) EnumDescriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{36, 0}
+}
+
+type ListLabelRequest_View int32
+
+const (
+	ListLabelRequest_VIEW_UNSPECIFIED ListLabelRequest_View = 0
+	ListLabelRequest_BASIC            ListLabelRequest_View = 1
+	ListLabelRequest_WITH_EDGE_IDS    ListLabelRequest_View = 2
+)
+
+// Enum value maps for ListLabelRequest_View.
+var (
+	ListLabelRequest_View_name = map[int32]string{
+		0: "VIEW_UNSPECIFIED",
+		1: "BASIC",
+		2: "WITH_EDGE_IDS",
+	}
+	ListLabelRequest_View_value = map[string]int32{
+		"VIEW_UNSPECIFIED": 0,
+		"BASIC":            1,
+		"WITH_EDGE_IDS":    2,
+	}
+)
+
+func (x ListLabelRequest_View) Enum() *ListLabelRequest_View {
+	p := new(ListLabelRequest_View)
+	*p = x
+	return p
+}
+
+func (x ListLabelRequest_View) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListLabelRequest_View) Descriptor() protoreflect.EnumDescriptor {
+	return file_entpb_entpb_proto_enumTypes[6].Descriptor()
+}
+
+func (ListLabelRequest_View) Type() protoreflect.EnumType {
+	return &file_entpb_entpb_proto_enumTypes[6]
+}
+
+func (x ListLabelRequest_View) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListLabelRequest_View.Descriptor instead.
+func (ListLabelRequest_View) EnumDescriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{40, 0}
+}
+
+type ExportLabelRequest_Format int32
+
+const (
+	ExportLabelRequest_FORMAT_UNSPECIFIED ExportLabelRequest_Format = 0
+	ExportLabelRequest_PROTO_DELIMITED    ExportLabelRequest_Format = 1
+	ExportLabelRequest_NDJSON             ExportLabelRequest_Format = 2
+)
+
+// Enum value maps for ExportLabelRequest_Format.
+var (
+	ExportLabelRequest_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "PROTO_DELIMITED",
+		2: "NDJSON",
+	}
+	ExportLabelRequest_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"PROTO_DELIMITED":    1,
+		"NDJSON":             2,
+	}
+)
+
+func (x ExportLabelRequest_Format) Enum() *ExportLabelRequest_Format {
+	p := new(ExportLabelRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ExportLabelRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportLabelRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_entpb_entpb_proto_enumTypes[7].Descriptor()
+}
+
+func (ExportLabelRequest_Format) Type() protoreflect.EnumType {
+	return &file_entpb_entpb_proto_enumTypes[7]
+}
+
+func (x ExportLabelRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportLabelRequest_Format.Descriptor instead.
+func (ExportLabelRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{44, 0}
 }
 
 type MultiWordSchema_Unit int32
@@ -153,11 +451,11 @@ func (x MultiWordSchema_Unit) String() string {
 }
 
 func (MultiWordSchema_Unit) Descriptor() protoreflect.EnumDescriptor {
-	return file_entpb_entpb_proto_enumTypes[2].Descriptor()
+	return file_entpb_entpb_proto_enumTypes[8].Descriptor()
 }
 
 func (MultiWordSchema_Unit) Type() protoreflect.EnumType {
-	return &file_entpb_entpb_proto_enumTypes[2]
+	return &file_entpb_entpb_proto_enumTypes[8]
 }
 
 func (x MultiWordSchema_Unit) Number() protoreflect.EnumNumber {
@@ -166,7 +464,7 @@ func (x MultiWordSchema_Unit) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MultiWordSchema_Unit.Descriptor instead.
 func (MultiWordSchema_Unit) EnumDescriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{10, 0}
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{46, 0}
 }
 
 type GetMultiWordSchemaRequest_View int32
@@ -202,11 +500,11 @@ func (x GetMultiWordSchemaRequest_View) String() string {
 }
 
 func (GetMultiWordSchemaRequest_View) Descriptor() protoreflect.EnumDescriptor {
-	return file_entpb_entpb_proto_enumTypes[3].Descriptor()
+	return file_entpb_entpb_proto_enumTypes[9].Descriptor()
 }
 
 func (GetMultiWordSchemaRequest_View) Type() protoreflect.EnumType {
-	return &file_entpb_entpb_proto_enumTypes[3]
+	return &file_entpb_entpb_proto_enumTypes[9]
 }
 
 func (x GetMultiWordSchemaRequest_View) Number() protoreflect.EnumNumber {
@@ -215,7 +513,7 @@ func (x GetMultiWordSchemaRequest_View) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GetMultiWordSchemaRequest_View.Descriptor instead.
 func (GetMultiWordSchemaRequest_View) EnumDescriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{12, 0}
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{48, 0}
 }
 
 type ListMultiWordSchemaRequest_View int32
@@ -251,11 +549,11 @@ func (x ListMultiWordSchemaRequest_View) String() string {
 }
 
 func (ListMultiWordSchemaRequest_View) Descriptor() protoreflect.EnumDescriptor {
-	return file_entpb_entpb_proto_enumTypes[4].Descriptor()
+	return file_entpb_entpb_proto_enumTypes[10].Descriptor()
 }
 
 func (ListMultiWordSchemaRequest_View) Type() protoreflect.EnumType {
-	return &file_entpb_entpb_proto_enumTypes[4]
+	return &file_entpb_entpb_proto_enumTypes[10]
 }
 
 func (x ListMultiWordSchemaRequest_View) Number() protoreflect.EnumNumber {
@@ -264,7 +562,7 @@ func (x ListMultiWordSchemaRequest_View) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ListMultiWordSchemaRequest_View.Descriptor instead.
 func (ListMultiWordSchemaRequest_View) EnumDescriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{15, 0}
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{52, 0}
 }
 
 type GetNilExampleRequest_View int32
@@ -300,11 +598,11 @@ func (x GetNilExampleRequest_View) String() string {
 }
 
 func (GetNilExampleRequest_View) Descriptor() protoreflect.EnumDescriptor {
-	return file_entpb_entpb_proto_enumTypes[5].Descriptor()
+	return file_entpb_entpb_proto_enumTypes[11].Descriptor()
 }
 
 func (GetNilExampleRequest_View) Type() protoreflect.EnumType {
-	return &file_entpb_entpb_proto_enumTypes[5]
+	return &file_entpb_entpb_proto_enumTypes[11]
 }
 
 func (x GetNilExampleRequest_View) Number() protoreflect.EnumNumber {
@@ -313,7 +611,7 @@ func (x GetNilExampleRequest_View) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GetNilExampleRequest_View.Descriptor instead.
 func (GetNilExampleRequest_View) EnumDescriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{21, 0}
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{58, 0}
 }
 
 type ListNilExampleRequest_View int32
@@ -349,11 +647,11 @@ func (x ListNilExampleRequest_View) String() string {
 }
 
 func (ListNilExampleRequest_View) Descriptor() protoreflect.EnumDescriptor {
-	return file_entpb_entpb_proto_enumTypes[6].Descriptor()
+	return file_entpb_entpb_proto_enumTypes[12].Descriptor()
 }
 
 func (ListNilExampleRequest_View) Type() protoreflect.EnumType {
-	return &file_entpb_entpb_proto_enumTypes[6]
+	return &file_entpb_entpb_proto_enumTypes[12]
 }
 
 func (x ListNilExampleRequest_View) Number() protoreflect.EnumNumber {
@@ -362,7 +660,7 @@ func (x ListNilExampleRequest_View) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ListNilExampleRequest_View.Descriptor instead.
 func (ListNilExampleRequest_View) EnumDescriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{24, 0}
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{62, 0}
 }
 
 type GetPetRequest_View int32
@@ -398,11 +696,11 @@ func (x GetPetRequest_View) String() string {
 }
 
 func (GetPetRequest_View) Descriptor() protoreflect.EnumDescriptor {
-	return file_entpb_entpb_proto_enumTypes[7].Descriptor()
+	return file_entpb_entpb_proto_enumTypes[13].Descriptor()
 }
 
 func (GetPetRequest_View) Type() protoreflect.EnumType {
-	return &file_entpb_entpb_proto_enumTypes[7]
+	return &file_entpb_entpb_proto_enumTypes[13]
 }
 
 func (x GetPetRequest_View) Number() protoreflect.EnumNumber {
@@ -411,7 +709,7 @@ func (x GetPetRequest_View) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GetPetRequest_View.Descriptor instead.
 func (GetPetRequest_View) EnumDescriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{30, 0}
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{68, 0}
 }
 
 type ListPetRequest_View int32
@@ -447,11 +745,11 @@ func (x ListPetRequest_View) String() string {
 }
 
 func (ListPetRequest_View) Descriptor() protoreflect.EnumDescriptor {
-	return file_entpb_entpb_proto_enumTypes[8].Descriptor()
+	return file_entpb_entpb_proto_enumTypes[14].Descriptor()
 }
 
 func (ListPetRequest_View) Type() protoreflect.EnumType {
-	return &file_entpb_entpb_proto_enumTypes[8]
+	return &file_entpb_entpb_proto_enumTypes[14]
 }
 
 func (x ListPetRequest_View) Number() protoreflect.EnumNumber {
@@ -460,56 +758,350 @@ func (x ListPetRequest_View) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ListPetRequest_View.Descriptor instead.
 func (ListPetRequest_View) EnumDescriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{33, 0}
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{72, 0}
 }
 
-type Todo_Status int32
+type ProjectLookupRequest_View int32
 
 const (
-	Todo_STATUS_PENDING     Todo_Status = 0
-	Todo_STATUS_IN_PROGRESS Todo_Status = 1
-	Todo_STATUS_DONE        Todo_Status = 2
+	ProjectLookupRequest_VIEW_UNSPECIFIED ProjectLookupRequest_View = 0
+	ProjectLookupRequest_BASIC            ProjectLookupRequest_View = 1
+	ProjectLookupRequest_WITH_EDGE_IDS    ProjectLookupRequest_View = 2
 )
 
-// Enum value maps for Todo_Status.
+// Enum value maps for ProjectLookupRequest_View.
 var (
-	Todo_Status_name = map[int32]string{
-		0: "STATUS_PENDING",
-		1: "STATUS_IN_PROGRESS",
-		2: "STATUS_DONE",
+	ProjectLookupRequest_View_name = map[int32]string{
+		0: "VIEW_UNSPECIFIED",
+		1: "BASIC",
+		2: "WITH_EDGE_IDS",
 	}
-	Todo_Status_value = map[string]int32{
-		"STATUS_PENDING":     0,
-		"STATUS_IN_PROGRESS": 1,
-		"STATUS_DONE":        2,
+	ProjectLookupRequest_View_value = map[string]int32{
+		"VIEW_UNSPECIFIED": 0,
+		"BASIC":            1,
+		"WITH_EDGE_IDS":    2,
 	}
 )
 
-func (x Todo_Status) Enum() *Todo_Status {
-	p := new(Todo_Status)
+func (x ProjectLookupRequest_View) Enum() *ProjectLookupRequest_View {
+	p := new(ProjectLookupRequest_View)
 	*p = x
 	return p
 }
 
-func (x Todo_Status) String() string {
+func (x ProjectLookupRequest_View) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (Todo_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_entpb_entpb_proto_enumTypes[9].Descriptor()
+func (ProjectLookupRequest_View) Descriptor() protoreflect.EnumDescriptor {
+	return file_entpb_entpb_proto_enumTypes[15].Descriptor()
 }
 
-func (Todo_Status) Type() protoreflect.EnumType {
-	return &file_entpb_entpb_proto_enumTypes[9]
+func (ProjectLookupRequest_View) Type() protoreflect.EnumType {
+	return &file_entpb_entpb_proto_enumTypes[15]
 }
 
-func (x Todo_Status) Number() protoreflect.EnumNumber {
+func (x ProjectLookupRequest_View) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use Todo_Status.Descriptor instead.
-func (Todo_Status) EnumDescriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{41, 0}
+// Deprecated: Use ProjectLookupRequest_View.Descriptor instead.
+func (ProjectLookupRequest_View) EnumDescriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{83, 0}
+}
+
+type ListProjectRequest_View int32
+
+const (
+	ListProjectRequest_VIEW_UNSPECIFIED ListProjectRequest_View = 0
+	ListProjectRequest_BASIC            ListProjectRequest_View = 1
+	ListProjectRequest_WITH_EDGE_IDS    ListProjectRequest_View = 2
+)
+
+// Enum value maps for ListProjectRequest_View.
+var (
+	ListProjectRequest_View_name = map[int32]string{
+		0: "VIEW_UNSPECIFIED",
+		1: "BASIC",
+		2: "WITH_EDGE_IDS",
+	}
+	ListProjectRequest_View_value = map[string]int32{
+		"VIEW_UNSPECIFIED": 0,
+		"BASIC":            1,
+		"WITH_EDGE_IDS":    2,
+	}
+)
+
+func (x ListProjectRequest_View) Enum() *ListProjectRequest_View {
+	p := new(ListProjectRequest_View)
+	*p = x
+	return p
+}
+
+func (x ListProjectRequest_View) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListProjectRequest_View) Descriptor() protoreflect.EnumDescriptor {
+	return file_entpb_entpb_proto_enumTypes[16].Descriptor()
+}
+
+func (ListProjectRequest_View) Type() protoreflect.EnumType {
+	return &file_entpb_entpb_proto_enumTypes[16]
+}
+
+func (x ListProjectRequest_View) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListProjectRequest_View.Descriptor instead.
+func (ListProjectRequest_View) EnumDescriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{94, 0}
+}
+
+type BatchGetProjectsRequest_View int32
+
+const (
+	BatchGetProjectsRequest_VIEW_UNSPECIFIED BatchGetProjectsRequest_View = 0
+	BatchGetProjectsRequest_BASIC            BatchGetProjectsRequest_View = 1
+	BatchGetProjectsRequest_WITH_EDGE_IDS    BatchGetProjectsRequest_View = 2
+)
+
+// Enum value maps for BatchGetProjectsRequest_View.
+var (
+	BatchGetProjectsRequest_View_name = map[int32]string{
+		0: "VIEW_UNSPECIFIED",
+		1: "BASIC",
+		2: "WITH_EDGE_IDS",
+	}
+	BatchGetProjectsRequest_View_value = map[string]int32{
+		"VIEW_UNSPECIFIED": 0,
+		"BASIC":            1,
+		"WITH_EDGE_IDS":    2,
+	}
+)
+
+func (x BatchGetProjectsRequest_View) Enum() *BatchGetProjectsRequest_View {
+	p := new(BatchGetProjectsRequest_View)
+	*p = x
+	return p
+}
+
+func (x BatchGetProjectsRequest_View) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchGetProjectsRequest_View) Descriptor() protoreflect.EnumDescriptor {
+	return file_entpb_entpb_proto_enumTypes[17].Descriptor()
+}
+
+func (BatchGetProjectsRequest_View) Type() protoreflect.EnumType {
+	return &file_entpb_entpb_proto_enumTypes[17]
+}
+
+func (x BatchGetProjectsRequest_View) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchGetProjectsRequest_View.Descriptor instead.
+func (BatchGetProjectsRequest_View) EnumDescriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{104, 0}
+}
+
+type ExportProjectRequest_Format int32
+
+const (
+	ExportProjectRequest_FORMAT_UNSPECIFIED ExportProjectRequest_Format = 0
+	ExportProjectRequest_PROTO_DELIMITED    ExportProjectRequest_Format = 1
+	ExportProjectRequest_NDJSON             ExportProjectRequest_Format = 2
+)
+
+// Enum value maps for ExportProjectRequest_Format.
+var (
+	ExportProjectRequest_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "PROTO_DELIMITED",
+		2: "NDJSON",
+	}
+	ExportProjectRequest_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"PROTO_DELIMITED":    1,
+		"NDJSON":             2,
+	}
+)
+
+func (x ExportProjectRequest_Format) Enum() *ExportProjectRequest_Format {
+	p := new(ExportProjectRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ExportProjectRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportProjectRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_entpb_entpb_proto_enumTypes[18].Descriptor()
+}
+
+func (ExportProjectRequest_Format) Type() protoreflect.EnumType {
+	return &file_entpb_entpb_proto_enumTypes[18]
+}
+
+func (x ExportProjectRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportProjectRequest_Format.Descriptor instead.
+func (ExportProjectRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{109, 0}
+}
+
+type Todo_Status int32
+
+const (
+	Todo_STATUS_PENDING     Todo_Status = 0
+	Todo_STATUS_IN_PROGRESS Todo_Status = 1
+	Todo_STATUS_DONE        Todo_Status = 2
+)
+
+// Enum value maps for Todo_Status.
+var (
+	Todo_Status_name = map[int32]string{
+		0: "STATUS_PENDING",
+		1: "STATUS_IN_PROGRESS",
+		2: "STATUS_DONE",
+	}
+	Todo_Status_value = map[string]int32{
+		"STATUS_PENDING":     0,
+		"STATUS_IN_PROGRESS": 1,
+		"STATUS_DONE":        2,
+	}
+)
+
+func (x Todo_Status) Enum() *Todo_Status {
+	p := new(Todo_Status)
+	*p = x
+	return p
+}
+
+func (x Todo_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Todo_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_entpb_entpb_proto_enumTypes[19].Descriptor()
+}
+
+func (Todo_Status) Type() protoreflect.EnumType {
+	return &file_entpb_entpb_proto_enumTypes[19]
+}
+
+func (x Todo_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Todo_Status.Descriptor instead.
+func (Todo_Status) EnumDescriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{115, 0}
+}
+
+type GetTodoRequest_View int32
+
+const (
+	GetTodoRequest_VIEW_UNSPECIFIED GetTodoRequest_View = 0
+	GetTodoRequest_BASIC            GetTodoRequest_View = 1
+	GetTodoRequest_WITH_EDGE_IDS    GetTodoRequest_View = 2
+)
+
+// Enum value maps for GetTodoRequest_View.
+var (
+	GetTodoRequest_View_name = map[int32]string{
+		0: "VIEW_UNSPECIFIED",
+		1: "BASIC",
+		2: "WITH_EDGE_IDS",
+	}
+	GetTodoRequest_View_value = map[string]int32{
+		"VIEW_UNSPECIFIED": 0,
+		"BASIC":            1,
+		"WITH_EDGE_IDS":    2,
+	}
+)
+
+func (x GetTodoRequest_View) Enum() *GetTodoRequest_View {
+	p := new(GetTodoRequest_View)
+	*p = x
+	return p
+}
+
+func (x GetTodoRequest_View) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetTodoRequest_View) Descriptor() protoreflect.EnumDescriptor {
+	return file_entpb_entpb_proto_enumTypes[20].Descriptor()
+}
+
+func (GetTodoRequest_View) Type() protoreflect.EnumType {
+	return &file_entpb_entpb_proto_enumTypes[20]
+}
+
+func (x GetTodoRequest_View) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetTodoRequest_View.Descriptor instead.
+func (GetTodoRequest_View) EnumDescriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{117, 0}
+}
+
+type ListTodoRequest_View int32
+
+const (
+	ListTodoRequest_VIEW_UNSPECIFIED ListTodoRequest_View = 0
+	ListTodoRequest_BASIC            ListTodoRequest_View = 1
+	ListTodoRequest_WITH_EDGE_IDS    ListTodoRequest_View = 2
+)
+
+// Enum value maps for ListTodoRequest_View.
+var (
+	ListTodoRequest_View_name = map[int32]string{
+		0: "VIEW_UNSPECIFIED",
+		1: "BASIC",
+		2: "WITH_EDGE_IDS",
+	}
+	ListTodoRequest_View_value = map[string]int32{
+		"VIEW_UNSPECIFIED": 0,
+		"BASIC":            1,
+		"WITH_EDGE_IDS":    2,
+	}
+)
+
+func (x ListTodoRequest_View) Enum() *ListTodoRequest_View {
+	p := new(ListTodoRequest_View)
+	*p = x
+	return p
+}
+
+func (x ListTodoRequest_View) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListTodoRequest_View) Descriptor() protoreflect.EnumDescriptor {
+	return file_entpb_entpb_proto_enumTypes[21].Descriptor()
+}
+
+func (ListTodoRequest_View) Type() protoreflect.EnumType {
+	return &file_entpb_entpb_proto_enumTypes[21]
+}
+
+func (x ListTodoRequest_View) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListTodoRequest_View.Descriptor instead.
+func (ListTodoRequest_View) EnumDescriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{121, 0}
 }
 
 type User_Status int32
@@ -545,11 +1137,11 @@ func (x User_Status) String() string {
 }
 
 func (User_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_entpb_entpb_proto_enumTypes[10].Descriptor()
+	return file_entpb_entpb_proto_enumTypes[22].Descriptor()
 }
 
 func (User_Status) Type() protoreflect.EnumType {
-	return &file_entpb_entpb_proto_enumTypes[10]
+	return &file_entpb_entpb_proto_enumTypes[22]
 }
 
 func (x User_Status) Number() protoreflect.EnumNumber {
@@ -558,7 +1150,7 @@ func (x User_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use User_Status.Descriptor instead.
 func (User_Status) EnumDescriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{42, 0}
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{125, 0}
 }
 
 type User_DeviceType int32
@@ -591,11 +1183,11 @@ func (x User_DeviceType) String() string {
 }
 
 func (User_DeviceType) Descriptor() protoreflect.EnumDescriptor {
-	return file_entpb_entpb_proto_enumTypes[11].Descriptor()
+	return file_entpb_entpb_proto_enumTypes[23].Descriptor()
 }
 
 func (User_DeviceType) Type() protoreflect.EnumType {
-	return &file_entpb_entpb_proto_enumTypes[11]
+	return &file_entpb_entpb_proto_enumTypes[23]
 }
 
 func (x User_DeviceType) Number() protoreflect.EnumNumber {
@@ -604,7 +1196,7 @@ func (x User_DeviceType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use User_DeviceType.Descriptor instead.
 func (User_DeviceType) EnumDescriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{42, 1}
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{125, 1}
 }
 
 type User_OmitPrefix int32
@@ -640,11 +1232,11 @@ func (x User_OmitPrefix) String() string {
 }
 
 func (User_OmitPrefix) Descriptor() protoreflect.EnumDescriptor {
-	return file_entpb_entpb_proto_enumTypes[12].Descriptor()
+	return file_entpb_entpb_proto_enumTypes[24].Descriptor()
 }
 
 func (User_OmitPrefix) Type() protoreflect.EnumType {
-	return &file_entpb_entpb_proto_enumTypes[12]
+	return &file_entpb_entpb_proto_enumTypes[24]
 }
 
 func (x User_OmitPrefix) Number() protoreflect.EnumNumber {
@@ -653,7 +1245,7 @@ func (x User_OmitPrefix) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use User_OmitPrefix.Descriptor instead.
 func (User_OmitPrefix) EnumDescriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{42, 2}
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{125, 2}
 }
 
 type GetUserRequest_View int32
@@ -689,11 +1281,11 @@ func (x GetUserRequest_View) String() string {
 }
 
 func (GetUserRequest_View) Descriptor() protoreflect.EnumDescriptor {
-	return file_entpb_entpb_proto_enumTypes[13].Descriptor()
+	return file_entpb_entpb_proto_enumTypes[25].Descriptor()
 }
 
 func (GetUserRequest_View) Type() protoreflect.EnumType {
-	return &file_entpb_entpb_proto_enumTypes[13]
+	return &file_entpb_entpb_proto_enumTypes[25]
 }
 
 func (x GetUserRequest_View) Number() protoreflect.EnumNumber {
@@ -702,7 +1294,7 @@ func (x GetUserRequest_View) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GetUserRequest_View.Descriptor instead.
 func (GetUserRequest_View) EnumDescriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{44, 0}
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{127, 0}
 }
 
 type ListUserRequest_View int32
@@ -738,11 +1330,11 @@ func (x ListUserRequest_View) String() string {
 }
 
 func (ListUserRequest_View) Descriptor() protoreflect.EnumDescriptor {
-	return file_entpb_entpb_proto_enumTypes[14].Descriptor()
+	return file_entpb_entpb_proto_enumTypes[26].Descriptor()
 }
 
 func (ListUserRequest_View) Type() protoreflect.EnumType {
-	return &file_entpb_entpb_proto_enumTypes[14]
+	return &file_entpb_entpb_proto_enumTypes[26]
 }
 
 func (x ListUserRequest_View) Number() protoreflect.EnumNumber {
@@ -751,54 +1343,253 @@ func (x ListUserRequest_View) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ListUserRequest_View.Descriptor instead.
 func (ListUserRequest_View) EnumDescriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{47, 0}
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{138, 0}
 }
 
-type Attachment struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type BatchGetUsersRequest_View int32
 
-	Id         []byte  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	User       *User   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	Recipients []*User `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients,omitempty"`
-}
+const (
+	BatchGetUsersRequest_VIEW_UNSPECIFIED BatchGetUsersRequest_View = 0
+	BatchGetUsersRequest_BASIC            BatchGetUsersRequest_View = 1
+	BatchGetUsersRequest_WITH_EDGE_IDS    BatchGetUsersRequest_View = 2
+)
 
-func (x *Attachment) Reset() {
-	*x = Attachment{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for BatchGetUsersRequest_View.
+var (
+	BatchGetUsersRequest_View_name = map[int32]string{
+		0: "VIEW_UNSPECIFIED",
+		1: "BASIC",
+		2: "WITH_EDGE_IDS",
+	}
+	BatchGetUsersRequest_View_value = map[string]int32{
+		"VIEW_UNSPECIFIED": 0,
+		"BASIC":            1,
+		"WITH_EDGE_IDS":    2,
 	}
+)
+
+func (x BatchGetUsersRequest_View) Enum() *BatchGetUsersRequest_View {
+	p := new(BatchGetUsersRequest_View)
+	*p = x
+	return p
 }
 
-func (x *Attachment) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x BatchGetUsersRequest_View) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*Attachment) ProtoMessage() {}
+func (BatchGetUsersRequest_View) Descriptor() protoreflect.EnumDescriptor {
+	return file_entpb_entpb_proto_enumTypes[27].Descriptor()
+}
 
-func (x *Attachment) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (BatchGetUsersRequest_View) Type() protoreflect.EnumType {
+	return &file_entpb_entpb_proto_enumTypes[27]
 }
 
-// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
-func (*Attachment) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{0}
+func (x BatchGetUsersRequest_View) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Attachment) GetId() []byte {
-	if x != nil {
-		return x.Id
+// Deprecated: Use BatchGetUsersRequest_View.Descriptor instead.
+func (BatchGetUsersRequest_View) EnumDescriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{148, 0}
+}
+
+type ExportUserRequest_Format int32
+
+const (
+	ExportUserRequest_FORMAT_UNSPECIFIED ExportUserRequest_Format = 0
+	ExportUserRequest_PROTO_DELIMITED    ExportUserRequest_Format = 1
+	ExportUserRequest_NDJSON             ExportUserRequest_Format = 2
+)
+
+// Enum value maps for ExportUserRequest_Format.
+var (
+	ExportUserRequest_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "PROTO_DELIMITED",
+		2: "NDJSON",
+	}
+	ExportUserRequest_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"PROTO_DELIMITED":    1,
+		"NDJSON":             2,
+	}
+)
+
+func (x ExportUserRequest_Format) Enum() *ExportUserRequest_Format {
+	p := new(ExportUserRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ExportUserRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportUserRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_entpb_entpb_proto_enumTypes[28].Descriptor()
+}
+
+func (ExportUserRequest_Format) Type() protoreflect.EnumType {
+	return &file_entpb_entpb_proto_enumTypes[28]
+}
+
+func (x ExportUserRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportUserRequest_Format.Descriptor instead.
+func (ExportUserRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{155, 0}
+}
+
+type ImportUserRequest_Format int32
+
+const (
+	ImportUserRequest_FORMAT_UNSPECIFIED ImportUserRequest_Format = 0
+	ImportUserRequest_PROTO_DELIMITED    ImportUserRequest_Format = 1
+	ImportUserRequest_NDJSON             ImportUserRequest_Format = 2
+)
+
+// Enum value maps for ImportUserRequest_Format.
+var (
+	ImportUserRequest_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "PROTO_DELIMITED",
+		2: "NDJSON",
+	}
+	ImportUserRequest_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"PROTO_DELIMITED":    1,
+		"NDJSON":             2,
+	}
+)
+
+func (x ImportUserRequest_Format) Enum() *ImportUserRequest_Format {
+	p := new(ImportUserRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ImportUserRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportUserRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_entpb_entpb_proto_enumTypes[29].Descriptor()
+}
+
+func (ImportUserRequest_Format) Type() protoreflect.EnumType {
+	return &file_entpb_entpb_proto_enumTypes[29]
+}
+
+func (x ImportUserRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportUserRequest_Format.Descriptor instead.
+func (ImportUserRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{157, 0}
+}
+
+type UserEvent_Type int32
+
+const (
+	UserEvent_TYPE_UNSPECIFIED UserEvent_Type = 0
+	UserEvent_CREATED          UserEvent_Type = 1
+	UserEvent_UPDATED          UserEvent_Type = 2
+	UserEvent_DELETED          UserEvent_Type = 3
+)
+
+// Enum value maps for UserEvent_Type.
+var (
+	UserEvent_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "CREATED",
+		2: "UPDATED",
+		3: "DELETED",
+	}
+	UserEvent_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"CREATED":          1,
+		"UPDATED":          2,
+		"DELETED":          3,
+	}
+)
+
+func (x UserEvent_Type) Enum() *UserEvent_Type {
+	p := new(UserEvent_Type)
+	*p = x
+	return p
+}
+
+func (x UserEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_entpb_entpb_proto_enumTypes[30].Descriptor()
+}
+
+func (UserEvent_Type) Type() protoreflect.EnumType {
+	return &file_entpb_entpb_proto_enumTypes[30]
+}
+
+func (x UserEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserEvent_Type.Descriptor instead.
+func (UserEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{160, 0}
+}
+
+type Attachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         []byte  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	User       *User   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Recipients []*User `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients,omitempty"`
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Attachment) GetId() []byte {
+	if x != nil {
+		return x.Id
 	}
 	return nil
 }
@@ -919,6 +1710,119 @@ func (x *GetAttachmentRequest) GetView() GetAttachmentRequest_View {
 	return GetAttachmentRequest_VIEW_UNSPECIFIED
 }
 
+type ExistsAttachmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Key:
+	//	*ExistsAttachmentRequest_Id
+	Key isExistsAttachmentRequest_Key `protobuf_oneof:"key"`
+}
+
+func (x *ExistsAttachmentRequest) Reset() {
+	*x = ExistsAttachmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExistsAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExistsAttachmentRequest) ProtoMessage() {}
+
+func (x *ExistsAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExistsAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*ExistsAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *ExistsAttachmentRequest) GetKey() isExistsAttachmentRequest_Key {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (x *ExistsAttachmentRequest) GetId() []byte {
+	if x, ok := x.GetKey().(*ExistsAttachmentRequest_Id); ok {
+		return x.Id
+	}
+	return nil
+}
+
+type isExistsAttachmentRequest_Key interface {
+	isExistsAttachmentRequest_Key()
+}
+
+type ExistsAttachmentRequest_Id struct {
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3,oneof"`
+}
+
+func (*ExistsAttachmentRequest_Id) isExistsAttachmentRequest_Key() {}
+
+type ExistsAttachmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exists bool `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+}
+
+func (x *ExistsAttachmentResponse) Reset() {
+	*x = ExistsAttachmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExistsAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExistsAttachmentResponse) ProtoMessage() {}
+
+func (x *ExistsAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExistsAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*ExistsAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExistsAttachmentResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
 type UpdateAttachmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -930,7 +1834,7 @@ type UpdateAttachmentRequest struct {
 func (x *UpdateAttachmentRequest) Reset() {
 	*x = UpdateAttachmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[3]
+		mi := &file_entpb_entpb_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -943,7 +1847,7 @@ func (x *UpdateAttachmentRequest) String() string {
 func (*UpdateAttachmentRequest) ProtoMessage() {}
 
 func (x *UpdateAttachmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[3]
+	mi := &file_entpb_entpb_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -956,7 +1860,7 @@ func (x *UpdateAttachmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAttachmentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAttachmentRequest) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{3}
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateAttachmentRequest) GetAttachment() *Attachment {
@@ -977,7 +1881,7 @@ type DeleteAttachmentRequest struct {
 func (x *DeleteAttachmentRequest) Reset() {
 	*x = DeleteAttachmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[4]
+		mi := &file_entpb_entpb_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -990,7 +1894,7 @@ func (x *DeleteAttachmentRequest) String() string {
 func (*DeleteAttachmentRequest) ProtoMessage() {}
 
 func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[4]
+	mi := &file_entpb_entpb_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,7 +1907,7 @@ func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{4}
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeleteAttachmentRequest) GetId() []byte {
@@ -1018,15 +1922,18 @@ type ListAttachmentRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// The maximum number of entries to return. Defaults to 1000 if unset, and larger
+	// values are capped to 1000.
 	PageSize  int32                      `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	PageToken string                     `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	View      ListAttachmentRequest_View `protobuf:"varint,3,opt,name=view,proto3,enum=entpb.ListAttachmentRequest_View" json:"view,omitempty"`
+	OrderBy   string                     `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 }
 
 func (x *ListAttachmentRequest) Reset() {
 	*x = ListAttachmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[5]
+		mi := &file_entpb_entpb_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1039,7 +1946,7 @@ func (x *ListAttachmentRequest) String() string {
 func (*ListAttachmentRequest) ProtoMessage() {}
 
 func (x *ListAttachmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[5]
+	mi := &file_entpb_entpb_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1052,7 +1959,7 @@ func (x *ListAttachmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAttachmentRequest.ProtoReflect.Descriptor instead.
 func (*ListAttachmentRequest) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{5}
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListAttachmentRequest) GetPageSize() int32 {
@@ -1076,6 +1983,13 @@ func (x *ListAttachmentRequest) GetView() ListAttachmentRequest_View {
 	return ListAttachmentRequest_VIEW_UNSPECIFIED
 }
 
+func (x *ListAttachmentRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListAttachmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1088,7 +2002,7 @@ type ListAttachmentResponse struct {
 func (x *ListAttachmentResponse) Reset() {
 	*x = ListAttachmentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[6]
+		mi := &file_entpb_entpb_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1101,7 +2015,7 @@ func (x *ListAttachmentResponse) String() string {
 func (*ListAttachmentResponse) ProtoMessage() {}
 
 func (x *ListAttachmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[6]
+	mi := &file_entpb_entpb_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1114,7 +2028,7 @@ func (x *ListAttachmentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAttachmentResponse.ProtoReflect.Descriptor instead.
 func (*ListAttachmentResponse) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{6}
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListAttachmentResponse) GetAttachmentList() []*Attachment {
@@ -1131,31 +2045,29 @@ func (x *ListAttachmentResponse) GetNextPageToken() string {
 	return ""
 }
 
-type BatchCreateAttachmentsRequest struct {
+type CountAttachmentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Requests []*CreateAttachmentRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-func (x *BatchCreateAttachmentsRequest) Reset() {
-	*x = BatchCreateAttachmentsRequest{}
+func (x *CountAttachmentsRequest) Reset() {
+	*x = CountAttachmentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[7]
+		mi := &file_entpb_entpb_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BatchCreateAttachmentsRequest) String() string {
+func (x *CountAttachmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCreateAttachmentsRequest) ProtoMessage() {}
+func (*CountAttachmentsRequest) ProtoMessage() {}
 
-func (x *BatchCreateAttachmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[7]
+func (x *CountAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1166,43 +2078,36 @@ func (x *BatchCreateAttachmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCreateAttachmentsRequest.ProtoReflect.Descriptor instead.
-func (*BatchCreateAttachmentsRequest) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *BatchCreateAttachmentsRequest) GetRequests() []*CreateAttachmentRequest {
-	if x != nil {
-		return x.Requests
-	}
-	return nil
+// Deprecated: Use CountAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*CountAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{9}
 }
 
-type BatchCreateAttachmentsResponse struct {
+type CountAttachmentsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Attachments []*Attachment `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *BatchCreateAttachmentsResponse) Reset() {
-	*x = BatchCreateAttachmentsResponse{}
+func (x *CountAttachmentsResponse) Reset() {
+	*x = CountAttachmentsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[8]
+		mi := &file_entpb_entpb_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BatchCreateAttachmentsResponse) String() string {
+func (x *CountAttachmentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCreateAttachmentsResponse) ProtoMessage() {}
+func (*CountAttachmentsResponse) ProtoMessage() {}
 
-func (x *BatchCreateAttachmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[8]
+func (x *CountAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1213,45 +2118,43 @@ func (x *BatchCreateAttachmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCreateAttachmentsResponse.ProtoReflect.Descriptor instead.
-func (*BatchCreateAttachmentsResponse) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CountAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*CountAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *BatchCreateAttachmentsResponse) GetAttachments() []*Attachment {
+func (x *CountAttachmentsResponse) GetCount() int64 {
 	if x != nil {
-		return x.Attachments
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-type Group struct {
+type BatchCreateAttachmentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name  string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Users []*User `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+	Requests []*CreateAttachmentRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-func (x *Group) Reset() {
-	*x = Group{}
+func (x *BatchCreateAttachmentsRequest) Reset() {
+	*x = BatchCreateAttachmentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[9]
+		mi := &file_entpb_entpb_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Group) String() string {
+func (x *BatchCreateAttachmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Group) ProtoMessage() {}
+func (*BatchCreateAttachmentsRequest) ProtoMessage() {}
 
-func (x *Group) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[9]
+func (x *BatchCreateAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1262,58 +2165,44 @@ func (x *Group) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Group.ProtoReflect.Descriptor instead.
-func (*Group) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *Group) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Group) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use BatchCreateAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Group) GetUsers() []*User {
+func (x *BatchCreateAttachmentsRequest) GetRequests() []*CreateAttachmentRequest {
 	if x != nil {
-		return x.Users
+		return x.Requests
 	}
 	return nil
 }
 
-type MultiWordSchema struct {
+type BatchCreateAttachmentsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Unit MultiWordSchema_Unit `protobuf:"varint,2,opt,name=unit,proto3,enum=entpb.MultiWordSchema_Unit" json:"unit,omitempty"`
+	// The results of the requests, in the same order.
+	Results []*BatchCreateAttachmentResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *MultiWordSchema) Reset() {
-	*x = MultiWordSchema{}
+func (x *BatchCreateAttachmentsResponse) Reset() {
+	*x = BatchCreateAttachmentsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[10]
+		mi := &file_entpb_entpb_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MultiWordSchema) String() string {
+func (x *BatchCreateAttachmentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiWordSchema) ProtoMessage() {}
+func (*BatchCreateAttachmentsResponse) ProtoMessage() {}
 
-func (x *MultiWordSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[10]
+func (x *BatchCreateAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1324,50 +2213,46 @@ func (x *MultiWordSchema) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiWordSchema.ProtoReflect.Descriptor instead.
-func (*MultiWordSchema) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *MultiWordSchema) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use BatchCreateAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *MultiWordSchema) GetUnit() MultiWordSchema_Unit {
+func (x *BatchCreateAttachmentsResponse) GetResults() []*BatchCreateAttachmentResult {
 	if x != nil {
-		return x.Unit
+		return x.Results
 	}
-	return MultiWordSchema_UNIT_M
+	return nil
 }
 
-type CreateMultiWordSchemaRequest struct {
+type BatchCreateAttachmentResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MultiWordSchema *MultiWordSchema `protobuf:"bytes,1,opt,name=multi_word_schema,json=multiWordSchema,proto3" json:"multi_word_schema,omitempty"`
+	// Types that are assignable to Result:
+	//	*BatchCreateAttachmentResult_Attachment
+	//	*BatchCreateAttachmentResult_Status
+	Result isBatchCreateAttachmentResult_Result `protobuf_oneof:"result"`
 }
 
-func (x *CreateMultiWordSchemaRequest) Reset() {
-	*x = CreateMultiWordSchemaRequest{}
+func (x *BatchCreateAttachmentResult) Reset() {
+	*x = BatchCreateAttachmentResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[11]
+		mi := &file_entpb_entpb_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateMultiWordSchemaRequest) String() string {
+func (x *BatchCreateAttachmentResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMultiWordSchemaRequest) ProtoMessage() {}
+func (*BatchCreateAttachmentResult) ProtoMessage() {}
 
-func (x *CreateMultiWordSchemaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[11]
+func (x *BatchCreateAttachmentResult) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1378,44 +2263,73 @@ func (x *CreateMultiWordSchemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMultiWordSchemaRequest.ProtoReflect.Descriptor instead.
-func (*CreateMultiWordSchemaRequest) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use BatchCreateAttachmentResult.ProtoReflect.Descriptor instead.
+func (*BatchCreateAttachmentResult) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreateMultiWordSchemaRequest) GetMultiWordSchema() *MultiWordSchema {
-	if x != nil {
-		return x.MultiWordSchema
+func (m *BatchCreateAttachmentResult) GetResult() isBatchCreateAttachmentResult_Result {
+	if m != nil {
+		return m.Result
 	}
 	return nil
 }
 
-type GetMultiWordSchemaRequest struct {
+func (x *BatchCreateAttachmentResult) GetAttachment() *Attachment {
+	if x, ok := x.GetResult().(*BatchCreateAttachmentResult_Attachment); ok {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *BatchCreateAttachmentResult) GetStatus() *status.Status {
+	if x, ok := x.GetResult().(*BatchCreateAttachmentResult_Status); ok {
+		return x.Status
+	}
+	return nil
+}
+
+type isBatchCreateAttachmentResult_Result interface {
+	isBatchCreateAttachmentResult_Result()
+}
+
+type BatchCreateAttachmentResult_Attachment struct {
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3,oneof"`
+}
+
+type BatchCreateAttachmentResult_Status struct {
+	Status *status.Status `protobuf:"bytes,2,opt,name=status,proto3,oneof"`
+}
+
+func (*BatchCreateAttachmentResult_Attachment) isBatchCreateAttachmentResult_Result() {}
+
+func (*BatchCreateAttachmentResult_Status) isBatchCreateAttachmentResult_Result() {}
+
+type BatchDeleteAttachmentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int64                          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	View GetMultiWordSchemaRequest_View `protobuf:"varint,2,opt,name=view,proto3,enum=entpb.GetMultiWordSchemaRequest_View" json:"view,omitempty"`
+	Ids [][]byte `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *GetMultiWordSchemaRequest) Reset() {
-	*x = GetMultiWordSchemaRequest{}
+func (x *BatchDeleteAttachmentsRequest) Reset() {
+	*x = BatchDeleteAttachmentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[12]
+		mi := &file_entpb_entpb_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMultiWordSchemaRequest) String() string {
+func (x *BatchDeleteAttachmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMultiWordSchemaRequest) ProtoMessage() {}
+func (*BatchDeleteAttachmentsRequest) ProtoMessage() {}
 
-func (x *GetMultiWordSchemaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[12]
+func (x *BatchDeleteAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1426,50 +2340,43 @@ func (x *GetMultiWordSchemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMultiWordSchemaRequest.ProtoReflect.Descriptor instead.
-func (*GetMultiWordSchemaRequest) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *GetMultiWordSchemaRequest) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use BatchDeleteAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetMultiWordSchemaRequest) GetView() GetMultiWordSchemaRequest_View {
+func (x *BatchDeleteAttachmentsRequest) GetIds() [][]byte {
 	if x != nil {
-		return x.View
+		return x.Ids
 	}
-	return GetMultiWordSchemaRequest_VIEW_UNSPECIFIED
+	return nil
 }
 
-type UpdateMultiWordSchemaRequest struct {
+type ListAttachmentRecipientsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MultiWordSchema *MultiWordSchema `protobuf:"bytes,1,opt,name=multi_word_schema,json=multiWordSchema,proto3" json:"multi_word_schema,omitempty"`
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *UpdateMultiWordSchemaRequest) Reset() {
-	*x = UpdateMultiWordSchemaRequest{}
+func (x *ListAttachmentRecipientsRequest) Reset() {
+	*x = ListAttachmentRecipientsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[13]
+		mi := &file_entpb_entpb_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateMultiWordSchemaRequest) String() string {
+func (x *ListAttachmentRecipientsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMultiWordSchemaRequest) ProtoMessage() {}
+func (*ListAttachmentRecipientsRequest) ProtoMessage() {}
 
-func (x *UpdateMultiWordSchemaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[13]
+func (x *ListAttachmentRecipientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1480,43 +2387,43 @@ func (x *UpdateMultiWordSchemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMultiWordSchemaRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMultiWordSchemaRequest) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListAttachmentRecipientsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentRecipientsRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UpdateMultiWordSchemaRequest) GetMultiWordSchema() *MultiWordSchema {
+func (x *ListAttachmentRecipientsRequest) GetId() []byte {
 	if x != nil {
-		return x.MultiWordSchema
+		return x.Id
 	}
 	return nil
 }
 
-type DeleteMultiWordSchemaRequest struct {
+type ListAttachmentRecipientsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RecipientIds []uint32 `protobuf:"varint,1,rep,packed,name=recipient_ids,json=recipientIds,proto3" json:"recipient_ids,omitempty"`
 }
 
-func (x *DeleteMultiWordSchemaRequest) Reset() {
-	*x = DeleteMultiWordSchemaRequest{}
+func (x *ListAttachmentRecipientsResponse) Reset() {
+	*x = ListAttachmentRecipientsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[14]
+		mi := &file_entpb_entpb_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteMultiWordSchemaRequest) String() string {
+func (x *ListAttachmentRecipientsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMultiWordSchemaRequest) ProtoMessage() {}
+func (*ListAttachmentRecipientsResponse) ProtoMessage() {}
 
-func (x *DeleteMultiWordSchemaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[14]
+func (x *ListAttachmentRecipientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1527,45 +2434,44 @@ func (x *DeleteMultiWordSchemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMultiWordSchemaRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMultiWordSchemaRequest) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListAttachmentRecipientsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentRecipientsResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DeleteMultiWordSchemaRequest) GetId() int64 {
+func (x *ListAttachmentRecipientsResponse) GetRecipientIds() []uint32 {
 	if x != nil {
-		return x.Id
+		return x.RecipientIds
 	}
-	return 0
+	return nil
 }
 
-type ListMultiWordSchemaRequest struct {
+type AddAttachmentRecipientRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageSize  int32                           `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken string                          `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	View      ListMultiWordSchemaRequest_View `protobuf:"varint,3,opt,name=view,proto3,enum=entpb.ListMultiWordSchemaRequest_View" json:"view,omitempty"`
+	Id          []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RecipientId uint32 `protobuf:"varint,2,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
 }
 
-func (x *ListMultiWordSchemaRequest) Reset() {
-	*x = ListMultiWordSchemaRequest{}
+func (x *AddAttachmentRecipientRequest) Reset() {
+	*x = AddAttachmentRecipientRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[15]
+		mi := &file_entpb_entpb_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListMultiWordSchemaRequest) String() string {
+func (x *AddAttachmentRecipientRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMultiWordSchemaRequest) ProtoMessage() {}
+func (*AddAttachmentRecipientRequest) ProtoMessage() {}
 
-func (x *ListMultiWordSchemaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[15]
+func (x *AddAttachmentRecipientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1576,58 +2482,51 @@ func (x *ListMultiWordSchemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMultiWordSchemaRequest.ProtoReflect.Descriptor instead.
-func (*ListMultiWordSchemaRequest) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *ListMultiWordSchemaRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use AddAttachmentRecipientRequest.ProtoReflect.Descriptor instead.
+func (*AddAttachmentRecipientRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListMultiWordSchemaRequest) GetPageToken() string {
+func (x *AddAttachmentRecipientRequest) GetId() []byte {
 	if x != nil {
-		return x.PageToken
+		return x.Id
 	}
-	return ""
+	return nil
 }
 
-func (x *ListMultiWordSchemaRequest) GetView() ListMultiWordSchemaRequest_View {
+func (x *AddAttachmentRecipientRequest) GetRecipientId() uint32 {
 	if x != nil {
-		return x.View
+		return x.RecipientId
 	}
-	return ListMultiWordSchemaRequest_VIEW_UNSPECIFIED
+	return 0
 }
 
-type ListMultiWordSchemaResponse struct {
+type RemoveAttachmentRecipientRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MultiWordSchemaList []*MultiWordSchema `protobuf:"bytes,1,rep,name=multi_word_schema_list,json=multiWordSchemaList,proto3" json:"multi_word_schema_list,omitempty"`
-	NextPageToken       string             `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Id          []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RecipientId uint32 `protobuf:"varint,2,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
 }
 
-func (x *ListMultiWordSchemaResponse) Reset() {
-	*x = ListMultiWordSchemaResponse{}
+func (x *RemoveAttachmentRecipientRequest) Reset() {
+	*x = RemoveAttachmentRecipientRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[16]
+		mi := &file_entpb_entpb_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListMultiWordSchemaResponse) String() string {
+func (x *RemoveAttachmentRecipientRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMultiWordSchemaResponse) ProtoMessage() {}
+func (*RemoveAttachmentRecipientRequest) ProtoMessage() {}
 
-func (x *ListMultiWordSchemaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[16]
+func (x *RemoveAttachmentRecipientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1638,50 +2537,54 @@ func (x *ListMultiWordSchemaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMultiWordSchemaResponse.ProtoReflect.Descriptor instead.
-func (*ListMultiWordSchemaResponse) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use RemoveAttachmentRecipientRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAttachmentRecipientRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListMultiWordSchemaResponse) GetMultiWordSchemaList() []*MultiWordSchema {
+func (x *RemoveAttachmentRecipientRequest) GetId() []byte {
 	if x != nil {
-		return x.MultiWordSchemaList
+		return x.Id
 	}
 	return nil
 }
 
-func (x *ListMultiWordSchemaResponse) GetNextPageToken() string {
+func (x *RemoveAttachmentRecipientRequest) GetRecipientId() uint32 {
 	if x != nil {
-		return x.NextPageToken
+		return x.RecipientId
 	}
-	return ""
+	return 0
 }
 
-type BatchCreateMultiWordSchemasRequest struct {
+type Document struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Requests []*CreateMultiWordSchemaRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Id        int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId  int64                   `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Title     string                  `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	RequestId *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ParentId  int64                   `protobuf:"varint,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
 }
 
-func (x *BatchCreateMultiWordSchemasRequest) Reset() {
-	*x = BatchCreateMultiWordSchemasRequest{}
+func (x *Document) Reset() {
+	*x = Document{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[17]
+		mi := &file_entpb_entpb_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BatchCreateMultiWordSchemasRequest) String() string {
+func (x *Document) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCreateMultiWordSchemasRequest) ProtoMessage() {}
+func (*Document) ProtoMessage() {}
 
-func (x *BatchCreateMultiWordSchemasRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[17]
+func (x *Document) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1692,138 +2595,56 @@ func (x *BatchCreateMultiWordSchemasRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCreateMultiWordSchemasRequest.ProtoReflect.Descriptor instead.
-func (*BatchCreateMultiWordSchemasRequest) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use Document.ProtoReflect.Descriptor instead.
+func (*Document) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *BatchCreateMultiWordSchemasRequest) GetRequests() []*CreateMultiWordSchemaRequest {
+func (x *Document) GetId() int64 {
 	if x != nil {
-		return x.Requests
-	}
-	return nil
-}
-
-type BatchCreateMultiWordSchemasResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	MultiWordSchemas []*MultiWordSchema `protobuf:"bytes,1,rep,name=multi_word_schemas,json=multiWordSchemas,proto3" json:"multi_word_schemas,omitempty"`
-}
-
-func (x *BatchCreateMultiWordSchemasResponse) Reset() {
-	*x = BatchCreateMultiWordSchemasResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *BatchCreateMultiWordSchemasResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*BatchCreateMultiWordSchemasResponse) ProtoMessage() {}
-
-func (x *BatchCreateMultiWordSchemasResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Id
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use BatchCreateMultiWordSchemasResponse.ProtoReflect.Descriptor instead.
-func (*BatchCreateMultiWordSchemasResponse) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{18}
+	return 0
 }
 
-func (x *BatchCreateMultiWordSchemasResponse) GetMultiWordSchemas() []*MultiWordSchema {
+func (x *Document) GetTenantId() int64 {
 	if x != nil {
-		return x.MultiWordSchemas
-	}
-	return nil
-}
-
-type NilExample struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id      int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	StrNil  *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=str_nil,json=strNil,proto3" json:"str_nil,omitempty"`
-	TimeNil *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=time_nil,json=timeNil,proto3" json:"time_nil,omitempty"`
-}
-
-func (x *NilExample) Reset() {
-	*x = NilExample{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *NilExample) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*NilExample) ProtoMessage() {}
-
-func (x *NilExample) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TenantId
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use NilExample.ProtoReflect.Descriptor instead.
-func (*NilExample) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{19}
+	return 0
 }
 
-func (x *NilExample) GetId() int64 {
+func (x *Document) GetTitle() string {
 	if x != nil {
-		return x.Id
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *NilExample) GetStrNil() *wrapperspb.StringValue {
+func (x *Document) GetRequestId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.StrNil
+		return x.RequestId
 	}
 	return nil
 }
 
-func (x *NilExample) GetTimeNil() *timestamppb.Timestamp {
+func (x *Document) GetParentId() int64 {
 	if x != nil {
-		return x.TimeNil
+		return x.ParentId
 	}
-	return nil
+	return 0
 }
 
-type CreateNilExampleRequest struct {
+type CreateDocumentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NilExample *NilExample `protobuf:"bytes,1,opt,name=nil_example,json=nilExample,proto3" json:"nil_example,omitempty"`
+	Document *Document `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
 }
 
-func (x *CreateNilExampleRequest) Reset() {
-	*x = CreateNilExampleRequest{}
+func (x *CreateDocumentRequest) Reset() {
+	*x = CreateDocumentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1831,13 +2652,13 @@ func (x *CreateNilExampleRequest) Reset() {
 	}
 }
 
-func (x *CreateNilExampleRequest) String() string {
+func (x *CreateDocumentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNilExampleRequest) ProtoMessage() {}
+func (*CreateDocumentRequest) ProtoMessage() {}
 
-func (x *CreateNilExampleRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateDocumentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1849,29 +2670,29 @@ func (x *CreateNilExampleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNilExampleRequest.ProtoReflect.Descriptor instead.
-func (*CreateNilExampleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateDocumentRequest.ProtoReflect.Descriptor instead.
+func (*CreateDocumentRequest) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *CreateNilExampleRequest) GetNilExample() *NilExample {
+func (x *CreateDocumentRequest) GetDocument() *Document {
 	if x != nil {
-		return x.NilExample
+		return x.Document
 	}
 	return nil
 }
 
-type GetNilExampleRequest struct {
+type GetDocumentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int64                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	View GetNilExampleRequest_View `protobuf:"varint,2,opt,name=view,proto3,enum=entpb.GetNilExampleRequest_View" json:"view,omitempty"`
+	Id   int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	View GetDocumentRequest_View `protobuf:"varint,2,opt,name=view,proto3,enum=entpb.GetDocumentRequest_View" json:"view,omitempty"`
 }
 
-func (x *GetNilExampleRequest) Reset() {
-	*x = GetNilExampleRequest{}
+func (x *GetDocumentRequest) Reset() {
+	*x = GetDocumentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1879,13 +2700,13 @@ func (x *GetNilExampleRequest) Reset() {
 	}
 }
 
-func (x *GetNilExampleRequest) String() string {
+func (x *GetDocumentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNilExampleRequest) ProtoMessage() {}
+func (*GetDocumentRequest) ProtoMessage() {}
 
-func (x *GetNilExampleRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDocumentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1897,35 +2718,35 @@ func (x *GetNilExampleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNilExampleRequest.ProtoReflect.Descriptor instead.
-func (*GetNilExampleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDocumentRequest.ProtoReflect.Descriptor instead.
+func (*GetDocumentRequest) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetNilExampleRequest) GetId() int64 {
+func (x *GetDocumentRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetNilExampleRequest) GetView() GetNilExampleRequest_View {
+func (x *GetDocumentRequest) GetView() GetDocumentRequest_View {
 	if x != nil {
 		return x.View
 	}
-	return GetNilExampleRequest_VIEW_UNSPECIFIED
+	return GetDocumentRequest_VIEW_UNSPECIFIED
 }
 
-type UpdateNilExampleRequest struct {
+type UpdateDocumentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NilExample *NilExample `protobuf:"bytes,1,opt,name=nil_example,json=nilExample,proto3" json:"nil_example,omitempty"`
+	Document *Document `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
 }
 
-func (x *UpdateNilExampleRequest) Reset() {
-	*x = UpdateNilExampleRequest{}
+func (x *UpdateDocumentRequest) Reset() {
+	*x = UpdateDocumentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1933,13 +2754,13 @@ func (x *UpdateNilExampleRequest) Reset() {
 	}
 }
 
-func (x *UpdateNilExampleRequest) String() string {
+func (x *UpdateDocumentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateNilExampleRequest) ProtoMessage() {}
+func (*UpdateDocumentRequest) ProtoMessage() {}
 
-func (x *UpdateNilExampleRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateDocumentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1951,19 +2772,19 @@ func (x *UpdateNilExampleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateNilExampleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateNilExampleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateDocumentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDocumentRequest) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UpdateNilExampleRequest) GetNilExample() *NilExample {
+func (x *UpdateDocumentRequest) GetDocument() *Document {
 	if x != nil {
-		return x.NilExample
+		return x.Document
 	}
 	return nil
 }
 
-type DeleteNilExampleRequest struct {
+type DeleteDocumentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1971,8 +2792,8 @@ type DeleteNilExampleRequest struct {
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *DeleteNilExampleRequest) Reset() {
-	*x = DeleteNilExampleRequest{}
+func (x *DeleteDocumentRequest) Reset() {
+	*x = DeleteDocumentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1980,13 +2801,13 @@ func (x *DeleteNilExampleRequest) Reset() {
 	}
 }
 
-func (x *DeleteNilExampleRequest) String() string {
+func (x *DeleteDocumentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteNilExampleRequest) ProtoMessage() {}
+func (*DeleteDocumentRequest) ProtoMessage() {}
 
-func (x *DeleteNilExampleRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteDocumentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1998,30 +2819,31 @@ func (x *DeleteNilExampleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteNilExampleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteNilExampleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteDocumentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDocumentRequest) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *DeleteNilExampleRequest) GetId() int64 {
+func (x *DeleteDocumentRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type ListNilExampleRequest struct {
+type DocumentFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageSize  int32                      `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken string                     `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	View      ListNilExampleRequest_View `protobuf:"varint,3,opt,name=view,proto3,enum=entpb.ListNilExampleRequest_View" json:"view,omitempty"`
+	Id        *DocumentFilter_Int64Filter  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId  *DocumentFilter_Int64Filter  `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Title     *DocumentFilter_StringFilter `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	RequestId *DocumentFilter_StringFilter `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
-func (x *ListNilExampleRequest) Reset() {
-	*x = ListNilExampleRequest{}
+func (x *DocumentFilter) Reset() {
+	*x = DocumentFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2029,13 +2851,13 @@ func (x *ListNilExampleRequest) Reset() {
 	}
 }
 
-func (x *ListNilExampleRequest) String() string {
+func (x *DocumentFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNilExampleRequest) ProtoMessage() {}
+func (*DocumentFilter) ProtoMessage() {}
 
-func (x *ListNilExampleRequest) ProtoReflect() protoreflect.Message {
+func (x *DocumentFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2047,43 +2869,49 @@ func (x *ListNilExampleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNilExampleRequest.ProtoReflect.Descriptor instead.
-func (*ListNilExampleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DocumentFilter.ProtoReflect.Descriptor instead.
+func (*DocumentFilter) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ListNilExampleRequest) GetPageSize() int32 {
+func (x *DocumentFilter) GetId() *DocumentFilter_Int64Filter {
 	if x != nil {
-		return x.PageSize
+		return x.Id
 	}
-	return 0
+	return nil
 }
 
-func (x *ListNilExampleRequest) GetPageToken() string {
+func (x *DocumentFilter) GetTenantId() *DocumentFilter_Int64Filter {
 	if x != nil {
-		return x.PageToken
+		return x.TenantId
 	}
-	return ""
+	return nil
 }
 
-func (x *ListNilExampleRequest) GetView() ListNilExampleRequest_View {
+func (x *DocumentFilter) GetTitle() *DocumentFilter_StringFilter {
 	if x != nil {
-		return x.View
+		return x.Title
 	}
-	return ListNilExampleRequest_VIEW_UNSPECIFIED
+	return nil
 }
 
-type ListNilExampleResponse struct {
+func (x *DocumentFilter) GetRequestId() *DocumentFilter_StringFilter {
+	if x != nil {
+		return x.RequestId
+	}
+	return nil
+}
+
+type DeleteDocumentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NilExampleList []*NilExample `protobuf:"bytes,1,rep,name=nil_example_list,json=nilExampleList,proto3" json:"nil_example_list,omitempty"`
-	NextPageToken  string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Filter *DocumentFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *ListNilExampleResponse) Reset() {
-	*x = ListNilExampleResponse{}
+func (x *DeleteDocumentsRequest) Reset() {
+	*x = DeleteDocumentsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2091,13 +2919,13 @@ func (x *ListNilExampleResponse) Reset() {
 	}
 }
 
-func (x *ListNilExampleResponse) String() string {
+func (x *DeleteDocumentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNilExampleResponse) ProtoMessage() {}
+func (*DeleteDocumentsRequest) ProtoMessage() {}
 
-func (x *ListNilExampleResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteDocumentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2109,35 +2937,28 @@ func (x *ListNilExampleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNilExampleResponse.ProtoReflect.Descriptor instead.
-func (*ListNilExampleResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDocumentsRequest) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ListNilExampleResponse) GetNilExampleList() []*NilExample {
+func (x *DeleteDocumentsRequest) GetFilter() *DocumentFilter {
 	if x != nil {
-		return x.NilExampleList
+		return x.Filter
 	}
 	return nil
 }
 
-func (x *ListNilExampleResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
-type BatchCreateNilExamplesRequest struct {
+type DeleteDocumentsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Requests []*CreateNilExampleRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Deleted int64 `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
 }
 
-func (x *BatchCreateNilExamplesRequest) Reset() {
-	*x = BatchCreateNilExamplesRequest{}
+func (x *DeleteDocumentsResponse) Reset() {
+	*x = DeleteDocumentsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2145,13 +2966,13 @@ func (x *BatchCreateNilExamplesRequest) Reset() {
 	}
 }
 
-func (x *BatchCreateNilExamplesRequest) String() string {
+func (x *DeleteDocumentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCreateNilExamplesRequest) ProtoMessage() {}
+func (*DeleteDocumentsResponse) ProtoMessage() {}
 
-func (x *BatchCreateNilExamplesRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteDocumentsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2163,28 +2984,34 @@ func (x *BatchCreateNilExamplesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCreateNilExamplesRequest.ProtoReflect.Descriptor instead.
-func (*BatchCreateNilExamplesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDocumentsResponse) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *BatchCreateNilExamplesRequest) GetRequests() []*CreateNilExampleRequest {
+func (x *DeleteDocumentsResponse) GetDeleted() int64 {
 	if x != nil {
-		return x.Requests
+		return x.Deleted
 	}
-	return nil
+	return 0
 }
 
-type BatchCreateNilExamplesResponse struct {
+type ListDocumentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NilExamples []*NilExample `protobuf:"bytes,1,rep,name=nil_examples,json=nilExamples,proto3" json:"nil_examples,omitempty"`
+	// The maximum number of entries to return. Defaults to 1000 if unset, and larger
+	// values are capped to 1000.
+	PageSize  int32                    `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                   `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	View      ListDocumentRequest_View `protobuf:"varint,3,opt,name=view,proto3,enum=entpb.ListDocumentRequest_View" json:"view,omitempty"`
+	OrderBy   string                   `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Filter    *DocumentFilter          `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *BatchCreateNilExamplesResponse) Reset() {
-	*x = BatchCreateNilExamplesResponse{}
+func (x *ListDocumentRequest) Reset() {
+	*x = ListDocumentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2192,13 +3019,13 @@ func (x *BatchCreateNilExamplesResponse) Reset() {
 	}
 }
 
-func (x *BatchCreateNilExamplesResponse) String() string {
+func (x *ListDocumentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCreateNilExamplesResponse) ProtoMessage() {}
+func (*ListDocumentRequest) ProtoMessage() {}
 
-func (x *BatchCreateNilExamplesResponse) ProtoReflect() protoreflect.Message {
+func (x *ListDocumentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2210,30 +3037,57 @@ func (x *BatchCreateNilExamplesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCreateNilExamplesResponse.ProtoReflect.Descriptor instead.
-func (*BatchCreateNilExamplesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDocumentRequest.ProtoReflect.Descriptor instead.
+func (*ListDocumentRequest) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *BatchCreateNilExamplesResponse) GetNilExamples() []*NilExample {
+func (x *ListDocumentRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.NilExamples
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDocumentRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDocumentRequest) GetView() ListDocumentRequest_View {
+	if x != nil {
+		return x.View
+	}
+	return ListDocumentRequest_VIEW_UNSPECIFIED
+}
+
+func (x *ListDocumentRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListDocumentRequest) GetFilter() *DocumentFilter {
+	if x != nil {
+		return x.Filter
 	}
 	return nil
 }
 
-type Pet struct {
+type ListDocumentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         int64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Owner      *User         `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	Attachment []*Attachment `protobuf:"bytes,3,rep,name=attachment,proto3" json:"attachment,omitempty"`
+	DocumentList  []*Document `protobuf:"bytes,1,rep,name=document_list,json=documentList,proto3" json:"document_list,omitempty"`
+	NextPageToken string      `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *Pet) Reset() {
-	*x = Pet{}
+func (x *ListDocumentResponse) Reset() {
+	*x = ListDocumentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2241,13 +3095,13 @@ func (x *Pet) Reset() {
 	}
 }
 
-func (x *Pet) String() string {
+func (x *ListDocumentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pet) ProtoMessage() {}
+func (*ListDocumentResponse) ProtoMessage() {}
 
-func (x *Pet) ProtoReflect() protoreflect.Message {
+func (x *ListDocumentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2259,42 +3113,35 @@ func (x *Pet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pet.ProtoReflect.Descriptor instead.
-func (*Pet) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDocumentResponse.ProtoReflect.Descriptor instead.
+func (*ListDocumentResponse) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *Pet) GetId() int64 {
+func (x *ListDocumentResponse) GetDocumentList() []*Document {
 	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Pet) GetOwner() *User {
-	if x != nil {
-		return x.Owner
+		return x.DocumentList
 	}
 	return nil
 }
 
-func (x *Pet) GetAttachment() []*Attachment {
+func (x *ListDocumentResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Attachment
+		return x.NextPageToken
 	}
-	return nil
+	return ""
 }
 
-type CreatePetRequest struct {
+type CountDocumentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pet *Pet `protobuf:"bytes,1,opt,name=pet,proto3" json:"pet,omitempty"`
+	Filter *DocumentFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *CreatePetRequest) Reset() {
-	*x = CreatePetRequest{}
+func (x *CountDocumentsRequest) Reset() {
+	*x = CountDocumentsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2302,13 +3149,13 @@ func (x *CreatePetRequest) Reset() {
 	}
 }
 
-func (x *CreatePetRequest) String() string {
+func (x *CountDocumentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePetRequest) ProtoMessage() {}
+func (*CountDocumentsRequest) ProtoMessage() {}
 
-func (x *CreatePetRequest) ProtoReflect() protoreflect.Message {
+func (x *CountDocumentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2320,29 +3167,28 @@ func (x *CreatePetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePetRequest.ProtoReflect.Descriptor instead.
-func (*CreatePetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CountDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*CountDocumentsRequest) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *CreatePetRequest) GetPet() *Pet {
+func (x *CountDocumentsRequest) GetFilter() *DocumentFilter {
 	if x != nil {
-		return x.Pet
+		return x.Filter
 	}
 	return nil
 }
 
-type GetPetRequest struct {
+type CountDocumentsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	View GetPetRequest_View `protobuf:"varint,2,opt,name=view,proto3,enum=entpb.GetPetRequest_View" json:"view,omitempty"`
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *GetPetRequest) Reset() {
-	*x = GetPetRequest{}
+func (x *CountDocumentsResponse) Reset() {
+	*x = CountDocumentsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2350,13 +3196,13 @@ func (x *GetPetRequest) Reset() {
 	}
 }
 
-func (x *GetPetRequest) String() string {
+func (x *CountDocumentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPetRequest) ProtoMessage() {}
+func (*CountDocumentsResponse) ProtoMessage() {}
 
-func (x *GetPetRequest) ProtoReflect() protoreflect.Message {
+func (x *CountDocumentsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2368,35 +3214,28 @@ func (x *GetPetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPetRequest.ProtoReflect.Descriptor instead.
-func (*GetPetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CountDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*CountDocumentsResponse) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetPetRequest) GetId() int64 {
+func (x *CountDocumentsResponse) GetCount() int64 {
 	if x != nil {
-		return x.Id
+		return x.Count
 	}
 	return 0
 }
 
-func (x *GetPetRequest) GetView() GetPetRequest_View {
-	if x != nil {
-		return x.View
-	}
-	return GetPetRequest_VIEW_UNSPECIFIED
-}
-
-type UpdatePetRequest struct {
+type BatchCreateDocumentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pet *Pet `protobuf:"bytes,1,opt,name=pet,proto3" json:"pet,omitempty"`
+	Requests []*CreateDocumentRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-func (x *UpdatePetRequest) Reset() {
-	*x = UpdatePetRequest{}
+func (x *BatchCreateDocumentsRequest) Reset() {
+	*x = BatchCreateDocumentsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2404,13 +3243,13 @@ func (x *UpdatePetRequest) Reset() {
 	}
 }
 
-func (x *UpdatePetRequest) String() string {
+func (x *BatchCreateDocumentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePetRequest) ProtoMessage() {}
+func (*BatchCreateDocumentsRequest) ProtoMessage() {}
 
-func (x *UpdatePetRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchCreateDocumentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2422,28 +3261,28 @@ func (x *UpdatePetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePetRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchCreateDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateDocumentsRequest) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *UpdatePetRequest) GetPet() *Pet {
+func (x *BatchCreateDocumentsRequest) GetRequests() []*CreateDocumentRequest {
 	if x != nil {
-		return x.Pet
+		return x.Requests
 	}
 	return nil
 }
 
-type DeletePetRequest struct {
+type BatchCreateDocumentsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Documents []*Document `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
 }
 
-func (x *DeletePetRequest) Reset() {
-	*x = DeletePetRequest{}
+func (x *BatchCreateDocumentsResponse) Reset() {
+	*x = BatchCreateDocumentsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2451,13 +3290,13 @@ func (x *DeletePetRequest) Reset() {
 	}
 }
 
-func (x *DeletePetRequest) String() string {
+func (x *BatchCreateDocumentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePetRequest) ProtoMessage() {}
+func (*BatchCreateDocumentsResponse) ProtoMessage() {}
 
-func (x *DeletePetRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchCreateDocumentsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2469,30 +3308,30 @@ func (x *DeletePetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePetRequest.ProtoReflect.Descriptor instead.
-func (*DeletePetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchCreateDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateDocumentsResponse) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *DeletePetRequest) GetId() int64 {
+func (x *BatchCreateDocumentsResponse) GetDocuments() []*Document {
 	if x != nil {
-		return x.Id
+		return x.Documents
 	}
-	return 0
+	return nil
 }
 
-type ListPetRequest struct {
+type Group struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageSize  int32               `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken string              `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	View      ListPetRequest_View `protobuf:"varint,3,opt,name=view,proto3,enum=entpb.ListPetRequest_View" json:"view,omitempty"`
+	Id    int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Users []*User `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
 }
 
-func (x *ListPetRequest) Reset() {
-	*x = ListPetRequest{}
+func (x *Group) Reset() {
+	*x = Group{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2500,13 +3339,13 @@ func (x *ListPetRequest) Reset() {
 	}
 }
 
-func (x *ListPetRequest) String() string {
+func (x *Group) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPetRequest) ProtoMessage() {}
+func (*Group) ProtoMessage() {}
 
-func (x *ListPetRequest) ProtoReflect() protoreflect.Message {
+func (x *Group) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2518,43 +3357,44 @@ func (x *ListPetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPetRequest.ProtoReflect.Descriptor instead.
-func (*ListPetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ListPetRequest) GetPageSize() int32 {
+func (x *Group) GetId() int64 {
 	if x != nil {
-		return x.PageSize
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListPetRequest) GetPageToken() string {
+func (x *Group) GetName() string {
 	if x != nil {
-		return x.PageToken
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListPetRequest) GetView() ListPetRequest_View {
+func (x *Group) GetUsers() []*User {
 	if x != nil {
-		return x.View
+		return x.Users
 	}
-	return ListPetRequest_VIEW_UNSPECIFIED
+	return nil
 }
 
-type ListPetResponse struct {
+type Label struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PetList       []*Pet `protobuf:"bytes,1,rep,name=pet_list,json=petList,proto3" json:"pet_list,omitempty"`
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Defaults to "grey" when unset on creation.
+	Color string `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
 }
 
-func (x *ListPetResponse) Reset() {
-	*x = ListPetResponse{}
+func (x *Label) Reset() {
+	*x = Label{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2562,13 +3402,13 @@ func (x *ListPetResponse) Reset() {
 	}
 }
 
-func (x *ListPetResponse) String() string {
+func (x *Label) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPetResponse) ProtoMessage() {}
+func (*Label) ProtoMessage() {}
 
-func (x *ListPetResponse) ProtoReflect() protoreflect.Message {
+func (x *Label) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2580,35 +3420,35 @@ func (x *ListPetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPetResponse.ProtoReflect.Descriptor instead.
-func (*ListPetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Label.ProtoReflect.Descriptor instead.
+func (*Label) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ListPetResponse) GetPetList() []*Pet {
+func (x *Label) GetId() string {
 	if x != nil {
-		return x.PetList
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ListPetResponse) GetNextPageToken() string {
+func (x *Label) GetColor() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Color
 	}
 	return ""
 }
 
-type BatchCreatePetsRequest struct {
+type CreateLabelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Requests []*CreatePetRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Label *Label `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func (x *BatchCreatePetsRequest) Reset() {
-	*x = BatchCreatePetsRequest{}
+func (x *CreateLabelRequest) Reset() {
+	*x = CreateLabelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2616,13 +3456,13 @@ func (x *BatchCreatePetsRequest) Reset() {
 	}
 }
 
-func (x *BatchCreatePetsRequest) String() string {
+func (x *CreateLabelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCreatePetsRequest) ProtoMessage() {}
+func (*CreateLabelRequest) ProtoMessage() {}
 
-func (x *BatchCreatePetsRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateLabelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2634,28 +3474,29 @@ func (x *BatchCreatePetsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCreatePetsRequest.ProtoReflect.Descriptor instead.
-func (*BatchCreatePetsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateLabelRequest.ProtoReflect.Descriptor instead.
+func (*CreateLabelRequest) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *BatchCreatePetsRequest) GetRequests() []*CreatePetRequest {
+func (x *CreateLabelRequest) GetLabel() *Label {
 	if x != nil {
-		return x.Requests
+		return x.Label
 	}
 	return nil
 }
 
-type BatchCreatePetsResponse struct {
+type GetLabelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pets []*Pet `protobuf:"bytes,1,rep,name=pets,proto3" json:"pets,omitempty"`
+	Id   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	View GetLabelRequest_View `protobuf:"varint,2,opt,name=view,proto3,enum=entpb.GetLabelRequest_View" json:"view,omitempty"`
 }
 
-func (x *BatchCreatePetsResponse) Reset() {
-	*x = BatchCreatePetsResponse{}
+func (x *GetLabelRequest) Reset() {
+	*x = GetLabelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2663,13 +3504,13 @@ func (x *BatchCreatePetsResponse) Reset() {
 	}
 }
 
-func (x *BatchCreatePetsResponse) String() string {
+func (x *GetLabelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCreatePetsResponse) ProtoMessage() {}
+func (*GetLabelRequest) ProtoMessage() {}
 
-func (x *BatchCreatePetsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetLabelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2681,29 +3522,35 @@ func (x *BatchCreatePetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCreatePetsResponse.ProtoReflect.Descriptor instead.
-func (*BatchCreatePetsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLabelRequest.ProtoReflect.Descriptor instead.
+func (*GetLabelRequest) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *BatchCreatePetsResponse) GetPets() []*Pet {
+func (x *GetLabelRequest) GetId() string {
 	if x != nil {
-		return x.Pets
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type Pony struct {
+func (x *GetLabelRequest) GetView() GetLabelRequest_View {
+	if x != nil {
+		return x.View
+	}
+	return GetLabelRequest_VIEW_UNSPECIFIED
+}
+
+type UpdateLabelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Label *Label `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func (x *Pony) Reset() {
-	*x = Pony{}
+func (x *UpdateLabelRequest) Reset() {
+	*x = UpdateLabelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2711,13 +3558,13 @@ func (x *Pony) Reset() {
 	}
 }
 
-func (x *Pony) String() string {
+func (x *UpdateLabelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pony) ProtoMessage() {}
+func (*UpdateLabelRequest) ProtoMessage() {}
 
-func (x *Pony) ProtoReflect() protoreflect.Message {
+func (x *UpdateLabelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2729,35 +3576,28 @@ func (x *Pony) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pony.ProtoReflect.Descriptor instead.
-func (*Pony) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateLabelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLabelRequest) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *Pony) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Pony) GetName() string {
+func (x *UpdateLabelRequest) GetLabel() *Label {
 	if x != nil {
-		return x.Name
+		return x.Label
 	}
-	return ""
+	return nil
 }
 
-type CreatePonyRequest struct {
+type DeleteLabelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pony *Pony `protobuf:"bytes,1,opt,name=pony,proto3" json:"pony,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CreatePonyRequest) Reset() {
-	*x = CreatePonyRequest{}
+func (x *DeleteLabelRequest) Reset() {
+	*x = DeleteLabelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2765,13 +3605,13 @@ func (x *CreatePonyRequest) Reset() {
 	}
 }
 
-func (x *CreatePonyRequest) String() string {
+func (x *DeleteLabelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePonyRequest) ProtoMessage() {}
+func (*DeleteLabelRequest) ProtoMessage() {}
 
-func (x *CreatePonyRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteLabelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2783,28 +3623,29 @@ func (x *CreatePonyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePonyRequest.ProtoReflect.Descriptor instead.
-func (*CreatePonyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteLabelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLabelRequest) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *CreatePonyRequest) GetPony() *Pony {
+func (x *DeleteLabelRequest) GetId() string {
 	if x != nil {
-		return x.Pony
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type BatchCreatePoniesRequest struct {
+type LabelFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Requests []*CreatePonyRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Id    *LabelFilter_StringFilter `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Color *LabelFilter_StringFilter `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
 }
 
-func (x *BatchCreatePoniesRequest) Reset() {
-	*x = BatchCreatePoniesRequest{}
+func (x *LabelFilter) Reset() {
+	*x = LabelFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2812,13 +3653,13 @@ func (x *BatchCreatePoniesRequest) Reset() {
 	}
 }
 
-func (x *BatchCreatePoniesRequest) String() string {
+func (x *LabelFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCreatePoniesRequest) ProtoMessage() {}
+func (*LabelFilter) ProtoMessage() {}
 
-func (x *BatchCreatePoniesRequest) ProtoReflect() protoreflect.Message {
+func (x *LabelFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2830,28 +3671,41 @@ func (x *BatchCreatePoniesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCreatePoniesRequest.ProtoReflect.Descriptor instead.
-func (*BatchCreatePoniesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LabelFilter.ProtoReflect.Descriptor instead.
+func (*LabelFilter) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *BatchCreatePoniesRequest) GetRequests() []*CreatePonyRequest {
+func (x *LabelFilter) GetId() *LabelFilter_StringFilter {
 	if x != nil {
-		return x.Requests
+		return x.Id
 	}
 	return nil
 }
 
-type BatchCreatePoniesResponse struct {
+func (x *LabelFilter) GetColor() *LabelFilter_StringFilter {
+	if x != nil {
+		return x.Color
+	}
+	return nil
+}
+
+type ListLabelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ponies []*Pony `protobuf:"bytes,1,rep,name=ponies,proto3" json:"ponies,omitempty"`
+	// The maximum number of entries to return. Defaults to 1000 if unset, and larger
+	// values are capped to 1000.
+	PageSize  int32                 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	View      ListLabelRequest_View `protobuf:"varint,3,opt,name=view,proto3,enum=entpb.ListLabelRequest_View" json:"view,omitempty"`
+	OrderBy   string                `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Filter    *LabelFilter          `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *BatchCreatePoniesResponse) Reset() {
-	*x = BatchCreatePoniesResponse{}
+func (x *ListLabelRequest) Reset() {
+	*x = ListLabelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2859,13 +3713,13 @@ func (x *BatchCreatePoniesResponse) Reset() {
 	}
 }
 
-func (x *BatchCreatePoniesResponse) String() string {
+func (x *ListLabelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCreatePoniesResponse) ProtoMessage() {}
+func (*ListLabelRequest) ProtoMessage() {}
 
-func (x *BatchCreatePoniesResponse) ProtoReflect() protoreflect.Message {
+func (x *ListLabelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2877,31 +3731,57 @@ func (x *BatchCreatePoniesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCreatePoniesResponse.ProtoReflect.Descriptor instead.
-func (*BatchCreatePoniesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListLabelRequest.ProtoReflect.Descriptor instead.
+func (*ListLabelRequest) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *BatchCreatePoniesResponse) GetPonies() []*Pony {
+func (x *ListLabelRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Ponies
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLabelRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListLabelRequest) GetView() ListLabelRequest_View {
+	if x != nil {
+		return x.View
+	}
+	return ListLabelRequest_VIEW_UNSPECIFIED
+}
+
+func (x *ListLabelRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListLabelRequest) GetFilter() *LabelFilter {
+	if x != nil {
+		return x.Filter
 	}
 	return nil
 }
 
-type Todo struct {
+type ListLabelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     int64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Task   string      `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
-	Status Todo_Status `protobuf:"varint,3,opt,name=status,proto3,enum=entpb.Todo_Status" json:"status,omitempty"`
-	User   *User       `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	LabelList     []*Label `protobuf:"bytes,1,rep,name=label_list,json=labelList,proto3" json:"label_list,omitempty"`
+	NextPageToken string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *Todo) Reset() {
-	*x = Todo{}
+func (x *ListLabelResponse) Reset() {
+	*x = ListLabelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2909,13 +3789,13 @@ func (x *Todo) Reset() {
 	}
 }
 
-func (x *Todo) String() string {
+func (x *ListLabelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Todo) ProtoMessage() {}
+func (*ListLabelResponse) ProtoMessage() {}
 
-func (x *Todo) ProtoReflect() protoreflect.Message {
+func (x *ListLabelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2927,73 +3807,35 @@ func (x *Todo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Todo.ProtoReflect.Descriptor instead.
-func (*Todo) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListLabelResponse.ProtoReflect.Descriptor instead.
+func (*ListLabelResponse) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *Todo) GetId() int64 {
+func (x *ListLabelResponse) GetLabelList() []*Label {
 	if x != nil {
-		return x.Id
+		return x.LabelList
 	}
-	return 0
+	return nil
 }
 
-func (x *Todo) GetTask() string {
+func (x *ListLabelResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Task
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *Todo) GetStatus() Todo_Status {
-	if x != nil {
-		return x.Status
-	}
-	return Todo_STATUS_PENDING
-}
-
-func (x *Todo) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
-}
-
-type User struct {
+type BatchCreateLabelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             uint32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserName       string                  `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	Joined         *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=joined,proto3" json:"joined,omitempty"`
-	Points         uint32                  `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
-	Exp            uint64                  `protobuf:"varint,5,opt,name=exp,proto3" json:"exp,omitempty"`
-	Status         User_Status             `protobuf:"varint,6,opt,name=status,proto3,enum=entpb.User_Status" json:"status,omitempty"`
-	ExternalId     int64                   `protobuf:"varint,8,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
-	CrmId          []byte                  `protobuf:"bytes,9,opt,name=crm_id,json=crmId,proto3" json:"crm_id,omitempty"`
-	Banned         bool                    `protobuf:"varint,10,opt,name=banned,proto3" json:"banned,omitempty"`
-	CustomPb       uint64                  `protobuf:"varint,12,opt,name=custom_pb,json=customPb,proto3" json:"custom_pb,omitempty"`
-	OptNum         *wrapperspb.Int64Value  `protobuf:"bytes,13,opt,name=opt_num,json=optNum,proto3" json:"opt_num,omitempty"`
-	OptStr         *wrapperspb.StringValue `protobuf:"bytes,14,opt,name=opt_str,json=optStr,proto3" json:"opt_str,omitempty"`
-	OptBool        *wrapperspb.BoolValue   `protobuf:"bytes,15,opt,name=opt_bool,json=optBool,proto3" json:"opt_bool,omitempty"`
-	BigInt         *wrapperspb.StringValue `protobuf:"bytes,17,opt,name=big_int,json=bigInt,proto3" json:"big_int,omitempty"`
-	BUser_1        *wrapperspb.Int64Value  `protobuf:"bytes,18,opt,name=b_user_1,json=bUser1,proto3" json:"b_user_1,omitempty"`
-	HeightInCm     float32                 `protobuf:"fixed32,19,opt,name=height_in_cm,json=heightInCm,proto3" json:"height_in_cm,omitempty"`
-	AccountBalance float64                 `protobuf:"fixed64,20,opt,name=account_balance,json=accountBalance,proto3" json:"account_balance,omitempty"`
-	Type           *wrapperspb.StringValue `protobuf:"bytes,23,opt,name=type,proto3" json:"type,omitempty"`
-	Labels         []string                `protobuf:"bytes,24,rep,name=labels,proto3" json:"labels,omitempty"`
-	DeviceType     User_DeviceType         `protobuf:"varint,100,opt,name=device_type,json=deviceType,proto3,enum=entpb.User_DeviceType" json:"device_type,omitempty"`
-	OmitPrefix     User_OmitPrefix         `protobuf:"varint,103,opt,name=omit_prefix,json=omitPrefix,proto3,enum=entpb.User_OmitPrefix" json:"omit_prefix,omitempty"`
-	Group          *Group                  `protobuf:"bytes,7,opt,name=group,proto3" json:"group,omitempty"`
-	Attachment     *Attachment             `protobuf:"bytes,11,opt,name=attachment,proto3" json:"attachment,omitempty"`
-	Received_1     []*Attachment           `protobuf:"bytes,16,rep,name=received_1,json=received1,proto3" json:"received_1,omitempty"`
-	Pet            *Pet                    `protobuf:"bytes,21,opt,name=pet,proto3" json:"pet,omitempty"`
+	Requests []*CreateLabelRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-func (x *User) Reset() {
-	*x = User{}
+func (x *BatchCreateLabelsRequest) Reset() {
+	*x = BatchCreateLabelsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_entpb_entpb_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3001,13 +3843,13 @@ func (x *User) Reset() {
 	}
 }
 
-func (x *User) String() string {
+func (x *BatchCreateLabelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*BatchCreateLabelsRequest) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
+func (x *BatchCreateLabelsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_entpb_entpb_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3019,211 +3861,154 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchCreateLabelsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateLabelsRequest) Descriptor() ([]byte, []int) {
 	return file_entpb_entpb_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *User) GetId() uint32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *User) GetUserName() string {
-	if x != nil {
-		return x.UserName
-	}
-	return ""
-}
-
-func (x *User) GetJoined() *timestamppb.Timestamp {
+func (x *BatchCreateLabelsRequest) GetRequests() []*CreateLabelRequest {
 	if x != nil {
-		return x.Joined
+		return x.Requests
 	}
 	return nil
 }
 
-func (x *User) GetPoints() uint32 {
-	if x != nil {
-		return x.Points
-	}
-	return 0
-}
-
-func (x *User) GetExp() uint64 {
-	if x != nil {
-		return x.Exp
-	}
-	return 0
-}
-
-func (x *User) GetStatus() User_Status {
-	if x != nil {
-		return x.Status
-	}
-	return User_STATUS_UNSPECIFIED
-}
-
-func (x *User) GetExternalId() int64 {
-	if x != nil {
-		return x.ExternalId
-	}
-	return 0
-}
-
-func (x *User) GetCrmId() []byte {
-	if x != nil {
-		return x.CrmId
-	}
-	return nil
-}
+type BatchCreateLabelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *User) GetBanned() bool {
-	if x != nil {
-		return x.Banned
-	}
-	return false
+	Labels []*Label `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"`
 }
 
-func (x *User) GetCustomPb() uint64 {
-	if x != nil {
-		return x.CustomPb
+func (x *BatchCreateLabelsResponse) Reset() {
+	*x = BatchCreateLabelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *User) GetOptNum() *wrapperspb.Int64Value {
-	if x != nil {
-		return x.OptNum
-	}
-	return nil
+func (x *BatchCreateLabelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *User) GetOptStr() *wrapperspb.StringValue {
-	if x != nil {
-		return x.OptStr
-	}
-	return nil
-}
+func (*BatchCreateLabelsResponse) ProtoMessage() {}
 
-func (x *User) GetOptBool() *wrapperspb.BoolValue {
-	if x != nil {
-		return x.OptBool
+func (x *BatchCreateLabelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *User) GetBigInt() *wrapperspb.StringValue {
-	if x != nil {
-		return x.BigInt
-	}
-	return nil
+// Deprecated: Use BatchCreateLabelsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateLabelsResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *User) GetBUser_1() *wrapperspb.Int64Value {
+func (x *BatchCreateLabelsResponse) GetLabels() []*Label {
 	if x != nil {
-		return x.BUser_1
+		return x.Labels
 	}
 	return nil
 }
 
-func (x *User) GetHeightInCm() float32 {
-	if x != nil {
-		return x.HeightInCm
-	}
-	return 0
-}
+type ExportLabelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *User) GetAccountBalance() float64 {
-	if x != nil {
-		return x.AccountBalance
-	}
-	return 0
+	Format    ExportLabelRequest_Format `protobuf:"varint,1,opt,name=format,proto3,enum=entpb.ExportLabelRequest_Format" json:"format,omitempty"`
+	Cursor    string                    `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	BatchSize int32                     `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
 }
 
-func (x *User) GetType() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Type
+func (x *ExportLabelRequest) Reset() {
+	*x = ExportLabelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *User) GetLabels() []string {
-	if x != nil {
-		return x.Labels
-	}
-	return nil
+func (x *ExportLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *User) GetDeviceType() User_DeviceType {
-	if x != nil {
-		return x.DeviceType
-	}
-	return User_DEVICE_TYPE_GLOWY9000
-}
+func (*ExportLabelRequest) ProtoMessage() {}
 
-func (x *User) GetOmitPrefix() User_OmitPrefix {
-	if x != nil {
-		return x.OmitPrefix
+func (x *ExportLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return User_OMIT_PREFIX_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *User) GetGroup() *Group {
-	if x != nil {
-		return x.Group
-	}
-	return nil
+// Deprecated: Use ExportLabelRequest.ProtoReflect.Descriptor instead.
+func (*ExportLabelRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *User) GetAttachment() *Attachment {
+func (x *ExportLabelRequest) GetFormat() ExportLabelRequest_Format {
 	if x != nil {
-		return x.Attachment
+		return x.Format
 	}
-	return nil
+	return ExportLabelRequest_FORMAT_UNSPECIFIED
 }
 
-func (x *User) GetReceived_1() []*Attachment {
+func (x *ExportLabelRequest) GetCursor() string {
 	if x != nil {
-		return x.Received_1
+		return x.Cursor
 	}
-	return nil
+	return ""
 }
 
-func (x *User) GetPet() *Pet {
+func (x *ExportLabelRequest) GetBatchSize() int32 {
 	if x != nil {
-		return x.Pet
+		return x.BatchSize
 	}
-	return nil
+	return 0
 }
 
-type CreateUserRequest struct {
+type ExportLabelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Data   []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
-func (x *CreateUserRequest) Reset() {
-	*x = CreateUserRequest{}
+func (x *ExportLabelResponse) Reset() {
+	*x = ExportLabelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[43]
+		mi := &file_entpb_entpb_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateUserRequest) String() string {
+func (x *ExportLabelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRequest) ProtoMessage() {}
+func (*ExportLabelResponse) ProtoMessage() {}
 
-func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[43]
+func (x *ExportLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3234,44 +4019,52 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ExportLabelResponse.ProtoReflect.Descriptor instead.
+func (*ExportLabelResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *CreateUserRequest) GetUser() *User {
+func (x *ExportLabelResponse) GetData() []byte {
 	if x != nil {
-		return x.User
+		return x.Data
 	}
 	return nil
 }
 
-type GetUserRequest struct {
+func (x *ExportLabelResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type MultiWordSchema struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   uint32              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	View GetUserRequest_View `protobuf:"varint,2,opt,name=view,proto3,enum=entpb.GetUserRequest_View" json:"view,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Defaults to "m" when unset on creation.
+	Unit MultiWordSchema_Unit `protobuf:"varint,2,opt,name=unit,proto3,enum=entpb.MultiWordSchema_Unit" json:"unit,omitempty"`
 }
 
-func (x *GetUserRequest) Reset() {
-	*x = GetUserRequest{}
+func (x *MultiWordSchema) Reset() {
+	*x = MultiWordSchema{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[44]
+		mi := &file_entpb_entpb_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserRequest) String() string {
+func (x *MultiWordSchema) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRequest) ProtoMessage() {}
+func (*MultiWordSchema) ProtoMessage() {}
 
-func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[44]
+func (x *MultiWordSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3282,50 +4075,50 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
-func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use MultiWordSchema.ProtoReflect.Descriptor instead.
+func (*MultiWordSchema) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetUserRequest) GetId() uint32 {
+func (x *MultiWordSchema) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetUserRequest) GetView() GetUserRequest_View {
+func (x *MultiWordSchema) GetUnit() MultiWordSchema_Unit {
 	if x != nil {
-		return x.View
+		return x.Unit
 	}
-	return GetUserRequest_VIEW_UNSPECIFIED
+	return MultiWordSchema_UNIT_M
 }
 
-type UpdateUserRequest struct {
+type CreateMultiWordSchemaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	MultiWordSchema *MultiWordSchema `protobuf:"bytes,1,opt,name=multi_word_schema,json=multiWordSchema,proto3" json:"multi_word_schema,omitempty"`
 }
 
-func (x *UpdateUserRequest) Reset() {
-	*x = UpdateUserRequest{}
+func (x *CreateMultiWordSchemaRequest) Reset() {
+	*x = CreateMultiWordSchemaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[45]
+		mi := &file_entpb_entpb_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateUserRequest) String() string {
+func (x *CreateMultiWordSchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserRequest) ProtoMessage() {}
+func (*CreateMultiWordSchemaRequest) ProtoMessage() {}
 
-func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[45]
+func (x *CreateMultiWordSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3336,43 +4129,44 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use CreateMultiWordSchemaRequest.ProtoReflect.Descriptor instead.
+func (*CreateMultiWordSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *UpdateUserRequest) GetUser() *User {
+func (x *CreateMultiWordSchemaRequest) GetMultiWordSchema() *MultiWordSchema {
 	if x != nil {
-		return x.User
+		return x.MultiWordSchema
 	}
 	return nil
 }
 
-type DeleteUserRequest struct {
+type GetMultiWordSchemaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id   int64                          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	View GetMultiWordSchemaRequest_View `protobuf:"varint,2,opt,name=view,proto3,enum=entpb.GetMultiWordSchemaRequest_View" json:"view,omitempty"`
 }
 
-func (x *DeleteUserRequest) Reset() {
-	*x = DeleteUserRequest{}
+func (x *GetMultiWordSchemaRequest) Reset() {
+	*x = GetMultiWordSchemaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[46]
+		mi := &file_entpb_entpb_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteUserRequest) String() string {
+func (x *GetMultiWordSchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserRequest) ProtoMessage() {}
+func (*GetMultiWordSchemaRequest) ProtoMessage() {}
 
-func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[46]
+func (x *GetMultiWordSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3383,45 +4177,50 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use GetMultiWordSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetMultiWordSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *DeleteUserRequest) GetId() uint32 {
+func (x *GetMultiWordSchemaRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type ListUserRequest struct {
+func (x *GetMultiWordSchemaRequest) GetView() GetMultiWordSchemaRequest_View {
+	if x != nil {
+		return x.View
+	}
+	return GetMultiWordSchemaRequest_VIEW_UNSPECIFIED
+}
+
+type UpdateMultiWordSchemaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageSize  int32                `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken string               `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	View      ListUserRequest_View `protobuf:"varint,3,opt,name=view,proto3,enum=entpb.ListUserRequest_View" json:"view,omitempty"`
+	MultiWordSchema *MultiWordSchema `protobuf:"bytes,1,opt,name=multi_word_schema,json=multiWordSchema,proto3" json:"multi_word_schema,omitempty"`
 }
 
-func (x *ListUserRequest) Reset() {
-	*x = ListUserRequest{}
+func (x *UpdateMultiWordSchemaRequest) Reset() {
+	*x = UpdateMultiWordSchemaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[47]
+		mi := &file_entpb_entpb_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUserRequest) String() string {
+func (x *UpdateMultiWordSchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserRequest) ProtoMessage() {}
+func (*UpdateMultiWordSchemaRequest) ProtoMessage() {}
 
-func (x *ListUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[47]
+func (x *UpdateMultiWordSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3432,58 +4231,90 @@ func (x *ListUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserRequest.ProtoReflect.Descriptor instead.
-func (*ListUserRequest) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use UpdateMultiWordSchemaRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMultiWordSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ListUserRequest) GetPageSize() int32 {
+func (x *UpdateMultiWordSchemaRequest) GetMultiWordSchema() *MultiWordSchema {
 	if x != nil {
-		return x.PageSize
+		return x.MultiWordSchema
 	}
-	return 0
+	return nil
 }
 
-func (x *ListUserRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
+type DeleteMultiWordSchemaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteMultiWordSchemaRequest) Reset() {
+	*x = DeleteMultiWordSchemaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ListUserRequest) GetView() ListUserRequest_View {
+func (x *DeleteMultiWordSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMultiWordSchemaRequest) ProtoMessage() {}
+
+func (x *DeleteMultiWordSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMultiWordSchemaRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMultiWordSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteMultiWordSchemaRequest) GetId() int64 {
 	if x != nil {
-		return x.View
+		return x.Id
 	}
-	return ListUserRequest_VIEW_UNSPECIFIED
+	return 0
 }
 
-type ListUserResponse struct {
+type MultiWordSchemaFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserList      []*User `protobuf:"bytes,1,rep,name=user_list,json=userList,proto3" json:"user_list,omitempty"`
-	NextPageToken string  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Id *MultiWordSchemaFilter_Int64Filter `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListUserResponse) Reset() {
-	*x = ListUserResponse{}
+func (x *MultiWordSchemaFilter) Reset() {
+	*x = MultiWordSchemaFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[48]
+		mi := &file_entpb_entpb_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUserResponse) String() string {
+func (x *MultiWordSchemaFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserResponse) ProtoMessage() {}
+func (*MultiWordSchemaFilter) ProtoMessage() {}
 
-func (x *ListUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[48]
+func (x *MultiWordSchemaFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3494,50 +4325,49 @@ func (x *ListUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserResponse.ProtoReflect.Descriptor instead.
-func (*ListUserResponse) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use MultiWordSchemaFilter.ProtoReflect.Descriptor instead.
+func (*MultiWordSchemaFilter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ListUserResponse) GetUserList() []*User {
+func (x *MultiWordSchemaFilter) GetId() *MultiWordSchemaFilter_Int64Filter {
 	if x != nil {
-		return x.UserList
+		return x.Id
 	}
 	return nil
 }
 
-func (x *ListUserResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
-type BatchCreateUsersRequest struct {
+type ListMultiWordSchemaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Requests []*CreateUserRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	// The maximum number of entries to return. Defaults to 2 if unset, and larger
+	// values are capped to 3.
+	PageSize  int32                           `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                          `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	View      ListMultiWordSchemaRequest_View `protobuf:"varint,3,opt,name=view,proto3,enum=entpb.ListMultiWordSchemaRequest_View" json:"view,omitempty"`
+	OrderBy   string                          `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Filter    *MultiWordSchemaFilter          `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *BatchCreateUsersRequest) Reset() {
-	*x = BatchCreateUsersRequest{}
+func (x *ListMultiWordSchemaRequest) Reset() {
+	*x = ListMultiWordSchemaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[49]
+		mi := &file_entpb_entpb_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BatchCreateUsersRequest) String() string {
+func (x *ListMultiWordSchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCreateUsersRequest) ProtoMessage() {}
+func (*ListMultiWordSchemaRequest) ProtoMessage() {}
 
-func (x *BatchCreateUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[49]
+func (x *ListMultiWordSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3548,43 +4378,72 @@ func (x *BatchCreateUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCreateUsersRequest.ProtoReflect.Descriptor instead.
-func (*BatchCreateUsersRequest) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use ListMultiWordSchemaRequest.ProtoReflect.Descriptor instead.
+func (*ListMultiWordSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *BatchCreateUsersRequest) GetRequests() []*CreateUserRequest {
+func (x *ListMultiWordSchemaRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Requests
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMultiWordSchemaRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMultiWordSchemaRequest) GetView() ListMultiWordSchemaRequest_View {
+	if x != nil {
+		return x.View
+	}
+	return ListMultiWordSchemaRequest_VIEW_UNSPECIFIED
+}
+
+func (x *ListMultiWordSchemaRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListMultiWordSchemaRequest) GetFilter() *MultiWordSchemaFilter {
+	if x != nil {
+		return x.Filter
 	}
 	return nil
 }
 
-type BatchCreateUsersResponse struct {
+type ListMultiWordSchemaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	MultiWordSchemaList []*MultiWordSchema `protobuf:"bytes,1,rep,name=multi_word_schema_list,json=multiWordSchemaList,proto3" json:"multi_word_schema_list,omitempty"`
+	NextPageToken       string             `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *BatchCreateUsersResponse) Reset() {
-	*x = BatchCreateUsersResponse{}
+func (x *ListMultiWordSchemaResponse) Reset() {
+	*x = ListMultiWordSchemaResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_entpb_entpb_proto_msgTypes[50]
+		mi := &file_entpb_entpb_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BatchCreateUsersResponse) String() string {
+func (x *ListMultiWordSchemaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCreateUsersResponse) ProtoMessage() {}
+func (*ListMultiWordSchemaResponse) ProtoMessage() {}
 
-func (x *BatchCreateUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_entpb_entpb_proto_msgTypes[50]
+func (x *ListMultiWordSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3595,791 +4454,12964 @@ func (x *BatchCreateUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCreateUsersResponse.ProtoReflect.Descriptor instead.
-func (*BatchCreateUsersResponse) Descriptor() ([]byte, []int) {
-	return file_entpb_entpb_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use ListMultiWordSchemaResponse.ProtoReflect.Descriptor instead.
+func (*ListMultiWordSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *BatchCreateUsersResponse) GetUsers() []*User {
+func (x *ListMultiWordSchemaResponse) GetMultiWordSchemaList() []*MultiWordSchema {
 	if x != nil {
-		return x.Users
+		return x.MultiWordSchemaList
 	}
 	return nil
 }
 
-var File_entpb_entpb_proto protoreflect.FileDescriptor
+func (x *ListMultiWordSchemaResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
 
-var file_entpb_entpb_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2f, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
-	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6a, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61,
-	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70,
-	0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
-	0x65, 0x6e, 0x74, 0x73, 0x22, 0x4c, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74,
-	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x31, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x61,
-	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
-	0x6e, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x04, 0x76,
-	0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65,
-	0x77, 0x22, 0x3a, 0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45,
-	0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49,
-	0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x4c, 0x0a,
-	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61,
-	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x17, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc6, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x04,
-	0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76,
-	0x69, 0x65, 0x77, 0x22, 0x3a, 0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56,
-	0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
-	0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22,
-	0x7c, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0f, 0x61, 0x74, 0x74,
-	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5b, 0x0a,
-	0x1d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61,
-	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a,
-	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x55, 0x0a, 0x1e, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0b,
-	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0x4e, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21,
-	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x22, 0x73, 0x0a, 0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x52,
-	0x04, 0x75, 0x6e, 0x69, 0x74, 0x22, 0x1f, 0x0a, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x0a, 0x0a,
-	0x06, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x4d, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x49,
-	0x54, 0x5f, 0x46, 0x54, 0x10, 0x01, 0x22, 0x62, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x11, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f,
-	0x77, 0x6f, 0x72, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57,
-	0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
-	0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0xa2, 0x01, 0x0a, 0x19, 0x47,
-	0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76,
-	0x69, 0x65, 0x77, 0x22, 0x3a, 0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56,
-	0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
-	0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22,
-	0x62, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f,
-	0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x42, 0x0a, 0x11, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x73, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x22, 0x2e, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0xd0, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3a,
-	0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f,
-	0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x3a, 0x0a, 0x04, 0x56, 0x69,
-	0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49,
-	0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45,
-	0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x92, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x16, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f,
-	0x77, 0x6f, 0x72, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6c, 0x69, 0x73, 0x74,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x13,
-	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
-	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x65, 0x0a, 0x22, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57,
-	0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x3f, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x22, 0x6b, 0x0a, 0x23, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x12, 0x6d, 0x75, 0x6c,
-	0x74, 0x69, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x10, 0x6d,
-	0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x22,
-	0x8a, 0x01, 0x0a, 0x0a, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35,
-	0x0a, 0x07, 0x73, 0x74, 0x72, 0x5f, 0x6e, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x73,
-	0x74, 0x72, 0x4e, 0x69, 0x6c, 0x12, 0x35, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x69,
-	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x4e, 0x69, 0x6c, 0x22, 0x4d, 0x0a, 0x17,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0b, 0x6e, 0x69, 0x6c, 0x5f, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52,
-	0x0a, 0x6e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x14,
-	0x47, 0x65, 0x74, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x20, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x69,
-	0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x3a, 0x0a, 0x04, 0x56, 0x69,
-	0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49,
-	0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45,
-	0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x4d, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x32, 0x0a, 0x0b, 0x6e, 0x69, 0x6c, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4e,
-	0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x0a, 0x6e, 0x69, 0x6c, 0x45, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x22, 0x29, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e,
-	0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0xc6, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
-	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x3a, 0x0a,
-	0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42,
-	0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45,
-	0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x7d, 0x0a, 0x16, 0x4c, 0x69, 0x73,
-	0x74, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x10, 0x6e, 0x69, 0x6c, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x52, 0x0e, 0x6e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
-	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5b, 0x0a, 0x1d, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x56, 0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x6e, 0x69, 0x6c, 0x5f, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x52, 0x0b, 0x6e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x6b, 0x0a,
-	0x03, 0x50, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
-	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x30, 0x0a, 0x10, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
-	0x0a, 0x03, 0x70, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x74, 0x52, 0x03, 0x70, 0x65, 0x74, 0x22, 0x8a, 0x01, 0x0a,
-	0x0d, 0x47, 0x65, 0x74, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d,
-	0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x3a, 0x0a,
-	0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42,
-	0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45,
-	0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x30, 0x0a, 0x10, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
-	0x03, 0x70, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x50, 0x65, 0x74, 0x52, 0x03, 0x70, 0x65, 0x74, 0x22, 0x22, 0x0a, 0x10, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0xb8, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e,
-	0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x3a,
-	0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
-	0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x45, 0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x60, 0x0a, 0x0f, 0x4c, 0x69,
-	0x73, 0x74, 0x50, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
-	0x08, 0x70, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x74, 0x52, 0x07, 0x70, 0x65, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
-	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4d, 0x0a, 0x16,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x39, 0x0a, 0x17, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x70, 0x65, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x74,
-	0x52, 0x04, 0x70, 0x65, 0x74, 0x73, 0x22, 0x2a, 0x0a, 0x04, 0x50, 0x6f, 0x6e, 0x79, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x34, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6e, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x6f, 0x6e, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x6f,
-	0x6e, 0x79, 0x52, 0x04, 0x70, 0x6f, 0x6e, 0x79, 0x22, 0x50, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6e, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x40, 0x0a, 0x19, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6e, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x70, 0x6f, 0x6e, 0x69, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x50, 0x6f, 0x6e, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6e, 0x69, 0x65, 0x73, 0x22, 0xbe, 0x01, 0x0a,
-	0x04, 0x54, 0x6f, 0x64, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x54, 0x6f, 0x64, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x45, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49,
-	0x4e, 0x47, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49,
-	0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x22, 0xb4, 0x09,
-	0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
-	0x10, 0x0a, 0x03, 0x65, 0x78, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x65, 0x78,
-	0x70, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x12, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a,
-	0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x15,
-	0x0a, 0x06, 0x63, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x63, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x1b, 0x0a,
-	0x09, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x62, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x62, 0x12, 0x34, 0x0a, 0x07, 0x6f, 0x70,
-	0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
-	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x4e, 0x75, 0x6d,
-	0x12, 0x35, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x06, 0x6f, 0x70, 0x74, 0x53, 0x74, 0x72, 0x12, 0x35, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x5f, 0x62,
-	0x6f, 0x6f, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x35,
-	0x0a, 0x07, 0x62, 0x69, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x62,
-	0x69, 0x67, 0x49, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x62, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x31, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x62, 0x55, 0x73, 0x65, 0x72, 0x31, 0x12, 0x20, 0x0a, 0x0c,
-	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x6d, 0x18, 0x13, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x0a, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x49, 0x6e, 0x43, 0x6d, 0x12, 0x27,
-	0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x12, 0x37, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x64, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x6f, 0x6d,
-	0x69, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x4f, 0x6d, 0x69,
-	0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x0a, 0x6f, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x12, 0x22, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
-	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x31, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x31, 0x12, 0x1c, 0x0a, 0x03,
-	0x70, 0x65, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x50, 0x65, 0x74, 0x52, 0x03, 0x70, 0x65, 0x74, 0x22, 0x47, 0x0a, 0x06, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01,
-	0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56,
-	0x45, 0x10, 0x02, 0x22, 0x42, 0x0a, 0x0a, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x47, 0x4c, 0x4f, 0x57, 0x59, 0x39, 0x30, 0x30, 0x30, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15,
-	0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x45,
-	0x44, 0x59, 0x33, 0x30, 0x30, 0x10, 0x01, 0x22, 0x3b, 0x0a, 0x0a, 0x4f, 0x6d, 0x69, 0x74, 0x50,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x4d, 0x49, 0x54, 0x5f, 0x50, 0x52,
-	0x45, 0x46, 0x49, 0x58, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x4f, 0x4f, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x42,
-	0x41, 0x52, 0x10, 0x02, 0x22, 0x34, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x8c, 0x01, 0x0a, 0x0e, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a,
-	0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x3a, 0x0a,
-	0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42,
-	0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45,
-	0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x34, 0x0a, 0x11, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
-	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22,
-	0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0xba, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
-	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
-	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2f, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52,
-	0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x3a, 0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a,
-	0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11,
-	0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10,
-	0x02, 0x22, 0x64, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
-	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4f, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x3d, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x32, 0xa7, 0x03, 0x0a, 0x11, 0x41, 0x74, 0x74, 0x61,
-	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a,
-	0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x03, 0x47, 0x65,
-	0x74, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74,
-	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11,
-	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x3b, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x40,
-	0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x43, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74,
-	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x32, 0xe3, 0x03, 0x0a, 0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x06,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x12, 0x3f, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x20, 0x2e, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x12, 0x45, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x23,
-	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x45, 0x0a, 0x06, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x4d, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64,
-	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57,
-	0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x64, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x12, 0x29, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa7, 0x03, 0x0a, 0x11, 0x4e, 0x69, 0x6c, 0x45,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a,
-	0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x03, 0x47, 0x65,
-	0x74, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x69, 0x6c,
-	0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11,
-	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x12, 0x3b, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x40,
-	0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x43, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x69,
-	0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x32, 0xd3, 0x02, 0x0a, 0x0a, 0x50, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x2d, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x74, 0x12,
-	0x27, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x14, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x17, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x12, 0x17, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x35, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x5f, 0x0a, 0x0b, 0x50, 0x6f, 0x6e, 0x79, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6e, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6e, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xdf, 0x02, 0x0a, 0x0b, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x12, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x03, 0x47, 0x65, 0x74,
-	0x12, 0x15, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18,
-	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
-	0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x37, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x65, 0x6e,
-	0x74, 0x67, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x2f, 0x65,
-	0x6e, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2f, 0x74, 0x6f, 0x64, 0x6f, 0x2f, 0x65, 0x6e, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type BatchCreateMultiWordSchemasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*CreateMultiWordSchemaRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-var (
-	file_entpb_entpb_proto_rawDescOnce sync.Once
-	file_entpb_entpb_proto_rawDescData = file_entpb_entpb_proto_rawDesc
-)
+func (x *BatchCreateMultiWordSchemasRequest) Reset() {
+	*x = BatchCreateMultiWordSchemasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_entpb_entpb_proto_rawDescGZIP() []byte {
-	file_entpb_entpb_proto_rawDescOnce.Do(func() {
-		file_entpb_entpb_proto_rawDescData = protoimpl.X.CompressGZIP(file_entpb_entpb_proto_rawDescData)
-	})
-	return file_entpb_entpb_proto_rawDescData
+func (x *BatchCreateMultiWordSchemasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_entpb_entpb_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
-var file_entpb_entpb_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
-var file_entpb_entpb_proto_goTypes = []interface{}{
-	(GetAttachmentRequest_View)(0),              // 0: entpb.GetAttachmentRequest.View
-	(ListAttachmentRequest_View)(0),             // 1: entpb.ListAttachmentRequest.View
-	(MultiWordSchema_Unit)(0),                   // 2: entpb.MultiWordSchema.Unit
-	(GetMultiWordSchemaRequest_View)(0),         // 3: entpb.GetMultiWordSchemaRequest.View
-	(ListMultiWordSchemaRequest_View)(0),        // 4: entpb.ListMultiWordSchemaRequest.View
-	(GetNilExampleRequest_View)(0),              // 5: entpb.GetNilExampleRequest.View
-	(ListNilExampleRequest_View)(0),             // 6: entpb.ListNilExampleRequest.View
-	(GetPetRequest_View)(0),                     // 7: entpb.GetPetRequest.View
-	(ListPetRequest_View)(0),                    // 8: entpb.ListPetRequest.View
-	(Todo_Status)(0),                            // 9: entpb.Todo.Status
-	(User_Status)(0),                            // 10: entpb.User.Status
-	(User_DeviceType)(0),                        // 11: entpb.User.DeviceType
-	(User_OmitPrefix)(0),                        // 12: entpb.User.OmitPrefix
-	(GetUserRequest_View)(0),                    // 13: entpb.GetUserRequest.View
-	(ListUserRequest_View)(0),                   // 14: entpb.ListUserRequest.View
-	(*Attachment)(nil),                          // 15: entpb.Attachment
-	(*CreateAttachmentRequest)(nil),             // 16: entpb.CreateAttachmentRequest
-	(*GetAttachmentRequest)(nil),                // 17: entpb.GetAttachmentRequest
-	(*UpdateAttachmentRequest)(nil),             // 18: entpb.UpdateAttachmentRequest
-	(*DeleteAttachmentRequest)(nil),             // 19: entpb.DeleteAttachmentRequest
-	(*ListAttachmentRequest)(nil),               // 20: entpb.ListAttachmentRequest
-	(*ListAttachmentResponse)(nil),              // 21: entpb.ListAttachmentResponse
-	(*BatchCreateAttachmentsRequest)(nil),       // 22: entpb.BatchCreateAttachmentsRequest
-	(*BatchCreateAttachmentsResponse)(nil),      // 23: entpb.BatchCreateAttachmentsResponse
-	(*Group)(nil),                               // 24: entpb.Group
-	(*MultiWordSchema)(nil),                     // 25: entpb.MultiWordSchema
-	(*CreateMultiWordSchemaRequest)(nil),        // 26: entpb.CreateMultiWordSchemaRequest
-	(*GetMultiWordSchemaRequest)(nil),           // 27: entpb.GetMultiWordSchemaRequest
-	(*UpdateMultiWordSchemaRequest)(nil),        // 28: entpb.UpdateMultiWordSchemaRequest
-	(*DeleteMultiWordSchemaRequest)(nil),        // 29: entpb.DeleteMultiWordSchemaRequest
-	(*ListMultiWordSchemaRequest)(nil),          // 30: entpb.ListMultiWordSchemaRequest
-	(*ListMultiWordSchemaResponse)(nil),         // 31: entpb.ListMultiWordSchemaResponse
-	(*BatchCreateMultiWordSchemasRequest)(nil),  // 32: entpb.BatchCreateMultiWordSchemasRequest
-	(*BatchCreateMultiWordSchemasResponse)(nil), // 33: entpb.BatchCreateMultiWordSchemasResponse
-	(*NilExample)(nil),                          // 34: entpb.NilExample
-	(*CreateNilExampleRequest)(nil),             // 35: entpb.CreateNilExampleRequest
-	(*GetNilExampleRequest)(nil),                // 36: entpb.GetNilExampleRequest
-	(*UpdateNilExampleRequest)(nil),             // 37: entpb.UpdateNilExampleRequest
-	(*DeleteNilExampleRequest)(nil),             // 38: entpb.DeleteNilExampleRequest
-	(*ListNilExampleRequest)(nil),               // 39: entpb.ListNilExampleRequest
-	(*ListNilExampleResponse)(nil),              // 40: entpb.ListNilExampleResponse
-	(*BatchCreateNilExamplesRequest)(nil),       // 41: entpb.BatchCreateNilExamplesRequest
-	(*BatchCreateNilExamplesResponse)(nil),      // 42: entpb.BatchCreateNilExamplesResponse
-	(*Pet)(nil),                                 // 43: entpb.Pet
-	(*CreatePetRequest)(nil),                    // 44: entpb.CreatePetRequest
-	(*GetPetRequest)(nil),                       // 45: entpb.GetPetRequest
-	(*UpdatePetRequest)(nil),                    // 46: entpb.UpdatePetRequest
-	(*DeletePetRequest)(nil),                    // 47: entpb.DeletePetRequest
-	(*ListPetRequest)(nil),                      // 48: entpb.ListPetRequest
-	(*ListPetResponse)(nil),                     // 49: entpb.ListPetResponse
-	(*BatchCreatePetsRequest)(nil),              // 50: entpb.BatchCreatePetsRequest
-	(*BatchCreatePetsResponse)(nil),             // 51: entpb.BatchCreatePetsResponse
-	(*Pony)(nil),                                // 52: entpb.Pony
-	(*CreatePonyRequest)(nil),                   // 53: entpb.CreatePonyRequest
-	(*BatchCreatePoniesRequest)(nil),            // 54: entpb.BatchCreatePoniesRequest
-	(*BatchCreatePoniesResponse)(nil),           // 55: entpb.BatchCreatePoniesResponse
-	(*Todo)(nil),                                // 56: entpb.Todo
-	(*User)(nil),                                // 57: entpb.User
-	(*CreateUserRequest)(nil),                   // 58: entpb.CreateUserRequest
-	(*GetUserRequest)(nil),                      // 59: entpb.GetUserRequest
-	(*UpdateUserRequest)(nil),                   // 60: entpb.UpdateUserRequest
-	(*DeleteUserRequest)(nil),                   // 61: entpb.DeleteUserRequest
-	(*ListUserRequest)(nil),                     // 62: entpb.ListUserRequest
-	(*ListUserResponse)(nil),                    // 63: entpb.ListUserResponse
-	(*BatchCreateUsersRequest)(nil),             // 64: entpb.BatchCreateUsersRequest
-	(*BatchCreateUsersResponse)(nil),            // 65: entpb.BatchCreateUsersResponse
-	(*wrapperspb.StringValue)(nil),              // 66: google.protobuf.StringValue
-	(*timestamppb.Timestamp)(nil),               // 67: google.protobuf.Timestamp
-	(*wrapperspb.Int64Value)(nil),               // 68: google.protobuf.Int64Value
-	(*wrapperspb.BoolValue)(nil),                // 69: google.protobuf.BoolValue
-	(*emptypb.Empty)(nil),                       // 70: google.protobuf.Empty
+func (*BatchCreateMultiWordSchemasRequest) ProtoMessage() {}
+
+func (x *BatchCreateMultiWordSchemasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_entpb_entpb_proto_depIdxs = []int32{
-	57, // 0: entpb.Attachment.user:type_name -> entpb.User
-	57, // 1: entpb.Attachment.recipients:type_name -> entpb.User
-	15, // 2: entpb.CreateAttachmentRequest.attachment:type_name -> entpb.Attachment
-	0,  // 3: entpb.GetAttachmentRequest.view:type_name -> entpb.GetAttachmentRequest.View
-	15, // 4: entpb.UpdateAttachmentRequest.attachment:type_name -> entpb.Attachment
-	1,  // 5: entpb.ListAttachmentRequest.view:type_name -> entpb.ListAttachmentRequest.View
-	15, // 6: entpb.ListAttachmentResponse.attachment_list:type_name -> entpb.Attachment
-	16, // 7: entpb.BatchCreateAttachmentsRequest.requests:type_name -> entpb.CreateAttachmentRequest
-	15, // 8: entpb.BatchCreateAttachmentsResponse.attachments:type_name -> entpb.Attachment
-	57, // 9: entpb.Group.users:type_name -> entpb.User
-	2,  // 10: entpb.MultiWordSchema.unit:type_name -> entpb.MultiWordSchema.Unit
-	25, // 11: entpb.CreateMultiWordSchemaRequest.multi_word_schema:type_name -> entpb.MultiWordSchema
-	3,  // 12: entpb.GetMultiWordSchemaRequest.view:type_name -> entpb.GetMultiWordSchemaRequest.View
-	25, // 13: entpb.UpdateMultiWordSchemaRequest.multi_word_schema:type_name -> entpb.MultiWordSchema
-	4,  // 14: entpb.ListMultiWordSchemaRequest.view:type_name -> entpb.ListMultiWordSchemaRequest.View
-	25, // 15: entpb.ListMultiWordSchemaResponse.multi_word_schema_list:type_name -> entpb.MultiWordSchema
-	26, // 16: entpb.BatchCreateMultiWordSchemasRequest.requests:type_name -> entpb.CreateMultiWordSchemaRequest
-	25, // 17: entpb.BatchCreateMultiWordSchemasResponse.multi_word_schemas:type_name -> entpb.MultiWordSchema
-	66, // 18: entpb.NilExample.str_nil:type_name -> google.protobuf.StringValue
-	67, // 19: entpb.NilExample.time_nil:type_name -> google.protobuf.Timestamp
-	34, // 20: entpb.CreateNilExampleRequest.nil_example:type_name -> entpb.NilExample
-	5,  // 21: entpb.GetNilExampleRequest.view:type_name -> entpb.GetNilExampleRequest.View
-	34, // 22: entpb.UpdateNilExampleRequest.nil_example:type_name -> entpb.NilExample
-	6,  // 23: entpb.ListNilExampleRequest.view:type_name -> entpb.ListNilExampleRequest.View
-	34, // 24: entpb.ListNilExampleResponse.nil_example_list:type_name -> entpb.NilExample
-	35, // 25: entpb.BatchCreateNilExamplesRequest.requests:type_name -> entpb.CreateNilExampleRequest
-	34, // 26: entpb.BatchCreateNilExamplesResponse.nil_examples:type_name -> entpb.NilExample
-	57, // 27: entpb.Pet.owner:type_name -> entpb.User
-	15, // 28: entpb.Pet.attachment:type_name -> entpb.Attachment
-	43, // 29: entpb.CreatePetRequest.pet:type_name -> entpb.Pet
-	7,  // 30: entpb.GetPetRequest.view:type_name -> entpb.GetPetRequest.View
-	43, // 31: entpb.UpdatePetRequest.pet:type_name -> entpb.Pet
-	8,  // 32: entpb.ListPetRequest.view:type_name -> entpb.ListPetRequest.View
-	43, // 33: entpb.ListPetResponse.pet_list:type_name -> entpb.Pet
-	44, // 34: entpb.BatchCreatePetsRequest.requests:type_name -> entpb.CreatePetRequest
-	43, // 35: entpb.BatchCreatePetsResponse.pets:type_name -> entpb.Pet
-	52, // 36: entpb.CreatePonyRequest.pony:type_name -> entpb.Pony
-	53, // 37: entpb.BatchCreatePoniesRequest.requests:type_name -> entpb.CreatePonyRequest
-	52, // 38: entpb.BatchCreatePoniesResponse.ponies:type_name -> entpb.Pony
-	9,  // 39: entpb.Todo.status:type_name -> entpb.Todo.Status
-	57, // 40: entpb.Todo.user:type_name -> entpb.User
-	67, // 41: entpb.User.joined:type_name -> google.protobuf.Timestamp
-	10, // 42: entpb.User.status:type_name -> entpb.User.Status
-	68, // 43: entpb.User.opt_num:type_name -> google.protobuf.Int64Value
-	66, // 44: entpb.User.opt_str:type_name -> google.protobuf.StringValue
-	69, // 45: entpb.User.opt_bool:type_name -> google.protobuf.BoolValue
-	66, // 46: entpb.User.big_int:type_name -> google.protobuf.StringValue
-	68, // 47: entpb.User.b_user_1:type_name -> google.protobuf.Int64Value
-	66, // 48: entpb.User.type:type_name -> google.protobuf.StringValue
-	11, // 49: entpb.User.device_type:type_name -> entpb.User.DeviceType
-	12, // 50: entpb.User.omit_prefix:type_name -> entpb.User.OmitPrefix
-	24, // 51: entpb.User.group:type_name -> entpb.Group
-	15, // 52: entpb.User.attachment:type_name -> entpb.Attachment
-	15, // 53: entpb.User.received_1:type_name -> entpb.Attachment
-	43, // 54: entpb.User.pet:type_name -> entpb.Pet
-	57, // 55: entpb.CreateUserRequest.user:type_name -> entpb.User
-	13, // 56: entpb.GetUserRequest.view:type_name -> entpb.GetUserRequest.View
-	57, // 57: entpb.UpdateUserRequest.user:type_name -> entpb.User
-	14, // 58: entpb.ListUserRequest.view:type_name -> entpb.ListUserRequest.View
-	57, // 59: entpb.ListUserResponse.user_list:type_name -> entpb.User
-	58, // 60: entpb.BatchCreateUsersRequest.requests:type_name -> entpb.CreateUserRequest
-	57, // 61: entpb.BatchCreateUsersResponse.users:type_name -> entpb.User
-	16, // 62: entpb.AttachmentService.Create:input_type -> entpb.CreateAttachmentRequest
-	17, // 63: entpb.AttachmentService.Get:input_type -> entpb.GetAttachmentRequest
-	18, // 64: entpb.AttachmentService.Update:input_type -> entpb.UpdateAttachmentRequest
-	19, // 65: entpb.AttachmentService.Delete:input_type -> entpb.DeleteAttachmentRequest
-	20, // 66: entpb.AttachmentService.List:input_type -> entpb.ListAttachmentRequest
-	22, // 67: entpb.AttachmentService.BatchCreate:input_type -> entpb.BatchCreateAttachmentsRequest
-	26, // 68: entpb.MultiWordSchemaService.Create:input_type -> entpb.CreateMultiWordSchemaRequest
-	27, // 69: entpb.MultiWordSchemaService.Get:input_type -> entpb.GetMultiWordSchemaRequest
-	28, // 70: entpb.MultiWordSchemaService.Update:input_type -> entpb.UpdateMultiWordSchemaRequest
-	29, // 71: entpb.MultiWordSchemaService.Delete:input_type -> entpb.DeleteMultiWordSchemaRequest
-	30, // 72: entpb.MultiWordSchemaService.List:input_type -> entpb.ListMultiWordSchemaRequest
-	32, // 73: entpb.MultiWordSchemaService.BatchCreate:input_type -> entpb.BatchCreateMultiWordSchemasRequest
-	35, // 74: entpb.NilExampleService.Create:input_type -> entpb.CreateNilExampleRequest
-	36, // 75: entpb.NilExampleService.Get:input_type -> entpb.GetNilExampleRequest
-	37, // 76: entpb.NilExampleService.Update:input_type -> entpb.UpdateNilExampleRequest
-	38, // 77: entpb.NilExampleService.Delete:input_type -> entpb.DeleteNilExampleRequest
-	39, // 78: entpb.NilExampleService.List:input_type -> entpb.ListNilExampleRequest
-	41, // 79: entpb.NilExampleService.BatchCreate:input_type -> entpb.BatchCreateNilExamplesRequest
-	44, // 80: entpb.PetService.Create:input_type -> entpb.CreatePetRequest
-	45, // 81: entpb.PetService.Get:input_type -> entpb.GetPetRequest
-	46, // 82: entpb.PetService.Update:input_type -> entpb.UpdatePetRequest
-	47, // 83: entpb.PetService.Delete:input_type -> entpb.DeletePetRequest
-	48, // 84: entpb.PetService.List:input_type -> entpb.ListPetRequest
-	50, // 85: entpb.PetService.BatchCreate:input_type -> entpb.BatchCreatePetsRequest
-	54, // 86: entpb.PonyService.BatchCreate:input_type -> entpb.BatchCreatePoniesRequest
-	58, // 87: entpb.UserService.Create:input_type -> entpb.CreateUserRequest
-	59, // 88: entpb.UserService.Get:input_type -> entpb.GetUserRequest
-	60, // 89: entpb.UserService.Update:input_type -> entpb.UpdateUserRequest
-	61, // 90: entpb.UserService.Delete:input_type -> entpb.DeleteUserRequest
-	62, // 91: entpb.UserService.List:input_type -> entpb.ListUserRequest
-	64, // 92: entpb.UserService.BatchCreate:input_type -> entpb.BatchCreateUsersRequest
-	15, // 93: entpb.AttachmentService.Create:output_type -> entpb.Attachment
-	15, // 94: entpb.AttachmentService.Get:output_type -> entpb.Attachment
-	15, // 95: entpb.AttachmentService.Update:output_type -> entpb.Attachment
-	70, // 96: entpb.AttachmentService.Delete:output_type -> google.protobuf.Empty
-	21, // 97: entpb.AttachmentService.List:output_type -> entpb.ListAttachmentResponse
-	23, // 98: entpb.AttachmentService.BatchCreate:output_type -> entpb.BatchCreateAttachmentsResponse
-	25, // 99: entpb.MultiWordSchemaService.Create:output_type -> entpb.MultiWordSchema
-	25, // 100: entpb.MultiWordSchemaService.Get:output_type -> entpb.MultiWordSchema
-	25, // 101: entpb.MultiWordSchemaService.Update:output_type -> entpb.MultiWordSchema
-	70, // 102: entpb.MultiWordSchemaService.Delete:output_type -> google.protobuf.Empty
-	31, // 103: entpb.MultiWordSchemaService.List:output_type -> entpb.ListMultiWordSchemaResponse
-	33, // 104: entpb.MultiWordSchemaService.BatchCreate:output_type -> entpb.BatchCreateMultiWordSchemasResponse
-	34, // 105: entpb.NilExampleService.Create:output_type -> entpb.NilExample
-	34, // 106: entpb.NilExampleService.Get:output_type -> entpb.NilExample
-	34, // 107: entpb.NilExampleService.Update:output_type -> entpb.NilExample
-	70, // 108: entpb.NilExampleService.Delete:output_type -> google.protobuf.Empty
-	40, // 109: entpb.NilExampleService.List:output_type -> entpb.ListNilExampleResponse
-	42, // 110: entpb.NilExampleService.BatchCreate:output_type -> entpb.BatchCreateNilExamplesResponse
-	43, // 111: entpb.PetService.Create:output_type -> entpb.Pet
-	43, // 112: entpb.PetService.Get:output_type -> entpb.Pet
-	43, // 113: entpb.PetService.Update:output_type -> entpb.Pet
-	70, // 114: entpb.PetService.Delete:output_type -> google.protobuf.Empty
-	49, // 115: entpb.PetService.List:output_type -> entpb.ListPetResponse
-	51, // 116: entpb.PetService.BatchCreate:output_type -> entpb.BatchCreatePetsResponse
-	55, // 117: entpb.PonyService.BatchCreate:output_type -> entpb.BatchCreatePoniesResponse
-	57, // 118: entpb.UserService.Create:output_type -> entpb.User
-	57, // 119: entpb.UserService.Get:output_type -> entpb.User
-	57, // 120: entpb.UserService.Update:output_type -> entpb.User
-	70, // 121: entpb.UserService.Delete:output_type -> google.protobuf.Empty
-	63, // 122: entpb.UserService.List:output_type -> entpb.ListUserResponse
-	65, // 123: entpb.UserService.BatchCreate:output_type -> entpb.BatchCreateUsersResponse
-	93, // [93:124] is the sub-list for method output_type
-	62, // [62:93] is the sub-list for method input_type
-	62, // [62:62] is the sub-list for extension type_name
-	62, // [62:62] is the sub-list for extension extendee
-	0,  // [0:62] is the sub-list for field type_name
+
+// Deprecated: Use BatchCreateMultiWordSchemasRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateMultiWordSchemasRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{54}
 }
 
-func init() { file_entpb_entpb_proto_init() }
-func file_entpb_entpb_proto_init() {
-	if File_entpb_entpb_proto != nil {
-		return
+func (x *BatchCreateMultiWordSchemasRequest) GetRequests() []*CreateMultiWordSchemaRequest {
+	if x != nil {
+		return x.Requests
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_entpb_entpb_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Attachment); i {
+	return nil
+}
+
+type BatchCreateMultiWordSchemasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MultiWordSchemas []*MultiWordSchema `protobuf:"bytes,1,rep,name=multi_word_schemas,json=multiWordSchemas,proto3" json:"multi_word_schemas,omitempty"`
+}
+
+func (x *BatchCreateMultiWordSchemasResponse) Reset() {
+	*x = BatchCreateMultiWordSchemasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateMultiWordSchemasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateMultiWordSchemasResponse) ProtoMessage() {}
+
+func (x *BatchCreateMultiWordSchemasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateMultiWordSchemasResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateMultiWordSchemasResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *BatchCreateMultiWordSchemasResponse) GetMultiWordSchemas() []*MultiWordSchema {
+	if x != nil {
+		return x.MultiWordSchemas
+	}
+	return nil
+}
+
+type NilExample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StrNil     *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=str_nil,json=strNil,proto3" json:"str_nil,omitempty"`
+	TimeNil    *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=time_nil,json=timeNil,proto3" json:"time_nil,omitempty"`
+	TimeStrNil *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=time_str_nil,json=timeStrNil,proto3" json:"time_str_nil,omitempty"`
+}
+
+func (x *NilExample) Reset() {
+	*x = NilExample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NilExample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NilExample) ProtoMessage() {}
+
+func (x *NilExample) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NilExample.ProtoReflect.Descriptor instead.
+func (*NilExample) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *NilExample) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NilExample) GetStrNil() *wrapperspb.StringValue {
+	if x != nil {
+		return x.StrNil
+	}
+	return nil
+}
+
+func (x *NilExample) GetTimeNil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeNil
+	}
+	return nil
+}
+
+func (x *NilExample) GetTimeStrNil() *wrapperspb.StringValue {
+	if x != nil {
+		return x.TimeStrNil
+	}
+	return nil
+}
+
+type CreateNilExampleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NilExample *NilExample `protobuf:"bytes,1,opt,name=nil_example,json=nilExample,proto3" json:"nil_example,omitempty"`
+}
+
+func (x *CreateNilExampleRequest) Reset() {
+	*x = CreateNilExampleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateNilExampleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNilExampleRequest) ProtoMessage() {}
+
+func (x *CreateNilExampleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNilExampleRequest.ProtoReflect.Descriptor instead.
+func (*CreateNilExampleRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateNilExampleRequest) GetNilExample() *NilExample {
+	if x != nil {
+		return x.NilExample
+	}
+	return nil
+}
+
+type GetNilExampleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	View GetNilExampleRequest_View `protobuf:"varint,2,opt,name=view,proto3,enum=entpb.GetNilExampleRequest_View" json:"view,omitempty"`
+}
+
+func (x *GetNilExampleRequest) Reset() {
+	*x = GetNilExampleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNilExampleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNilExampleRequest) ProtoMessage() {}
+
+func (x *GetNilExampleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNilExampleRequest.ProtoReflect.Descriptor instead.
+func (*GetNilExampleRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetNilExampleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetNilExampleRequest) GetView() GetNilExampleRequest_View {
+	if x != nil {
+		return x.View
+	}
+	return GetNilExampleRequest_VIEW_UNSPECIFIED
+}
+
+type UpdateNilExampleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NilExample *NilExample `protobuf:"bytes,1,opt,name=nil_example,json=nilExample,proto3" json:"nil_example,omitempty"`
+}
+
+func (x *UpdateNilExampleRequest) Reset() {
+	*x = UpdateNilExampleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateNilExampleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNilExampleRequest) ProtoMessage() {}
+
+func (x *UpdateNilExampleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNilExampleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNilExampleRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateNilExampleRequest) GetNilExample() *NilExample {
+	if x != nil {
+		return x.NilExample
+	}
+	return nil
+}
+
+type DeleteNilExampleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteNilExampleRequest) Reset() {
+	*x = DeleteNilExampleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteNilExampleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNilExampleRequest) ProtoMessage() {}
+
+func (x *DeleteNilExampleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNilExampleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNilExampleRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteNilExampleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type NilExampleFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      *NilExampleFilter_Int64Filter     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StrNil  *NilExampleFilter_StringFilter    `protobuf:"bytes,2,opt,name=str_nil,json=strNil,proto3" json:"str_nil,omitempty"`
+	TimeNil *NilExampleFilter_TimestampFilter `protobuf:"bytes,3,opt,name=time_nil,json=timeNil,proto3" json:"time_nil,omitempty"`
+}
+
+func (x *NilExampleFilter) Reset() {
+	*x = NilExampleFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NilExampleFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NilExampleFilter) ProtoMessage() {}
+
+func (x *NilExampleFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NilExampleFilter.ProtoReflect.Descriptor instead.
+func (*NilExampleFilter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *NilExampleFilter) GetId() *NilExampleFilter_Int64Filter {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *NilExampleFilter) GetStrNil() *NilExampleFilter_StringFilter {
+	if x != nil {
+		return x.StrNil
+	}
+	return nil
+}
+
+func (x *NilExampleFilter) GetTimeNil() *NilExampleFilter_TimestampFilter {
+	if x != nil {
+		return x.TimeNil
+	}
+	return nil
+}
+
+type ListNilExampleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of entries to return. Defaults to 1000 if unset, and larger
+	// values are capped to 1000.
+	PageSize  int32                      `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                     `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	View      ListNilExampleRequest_View `protobuf:"varint,3,opt,name=view,proto3,enum=entpb.ListNilExampleRequest_View" json:"view,omitempty"`
+	OrderBy   string                     `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Filter    *NilExampleFilter          `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ListNilExampleRequest) Reset() {
+	*x = ListNilExampleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNilExampleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNilExampleRequest) ProtoMessage() {}
+
+func (x *ListNilExampleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNilExampleRequest.ProtoReflect.Descriptor instead.
+func (*ListNilExampleRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListNilExampleRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNilExampleRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListNilExampleRequest) GetView() ListNilExampleRequest_View {
+	if x != nil {
+		return x.View
+	}
+	return ListNilExampleRequest_VIEW_UNSPECIFIED
+}
+
+func (x *ListNilExampleRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListNilExampleRequest) GetFilter() *NilExampleFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ListNilExampleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NilExampleList []*NilExample `protobuf:"bytes,1,rep,name=nil_example_list,json=nilExampleList,proto3" json:"nil_example_list,omitempty"`
+	NextPageToken  string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListNilExampleResponse) Reset() {
+	*x = ListNilExampleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNilExampleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNilExampleResponse) ProtoMessage() {}
+
+func (x *ListNilExampleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNilExampleResponse.ProtoReflect.Descriptor instead.
+func (*ListNilExampleResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListNilExampleResponse) GetNilExampleList() []*NilExample {
+	if x != nil {
+		return x.NilExampleList
+	}
+	return nil
+}
+
+func (x *ListNilExampleResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type BatchCreateNilExamplesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*CreateNilExampleRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *BatchCreateNilExamplesRequest) Reset() {
+	*x = BatchCreateNilExamplesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateNilExamplesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateNilExamplesRequest) ProtoMessage() {}
+
+func (x *BatchCreateNilExamplesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateNilExamplesRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateNilExamplesRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *BatchCreateNilExamplesRequest) GetRequests() []*CreateNilExampleRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BatchCreateNilExamplesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NilExamples []*NilExample `protobuf:"bytes,1,rep,name=nil_examples,json=nilExamples,proto3" json:"nil_examples,omitempty"`
+}
+
+func (x *BatchCreateNilExamplesResponse) Reset() {
+	*x = BatchCreateNilExamplesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateNilExamplesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateNilExamplesResponse) ProtoMessage() {}
+
+func (x *BatchCreateNilExamplesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateNilExamplesResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateNilExamplesResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *BatchCreateNilExamplesResponse) GetNilExamples() []*NilExample {
+	if x != nil {
+		return x.NilExamples
+	}
+	return nil
+}
+
+type Pet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Defaults to "medium" when unset on creation.
+	Size       Size          `protobuf:"varint,4,opt,name=size,proto3,enum=entpb.Size" json:"size,omitempty"`
+	Owner      *User         `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Attachment []*Attachment `protobuf:"bytes,3,rep,name=attachment,proto3" json:"attachment,omitempty"`
+}
+
+func (x *Pet) Reset() {
+	*x = Pet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pet) ProtoMessage() {}
+
+func (x *Pet) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pet.ProtoReflect.Descriptor instead.
+func (*Pet) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Pet) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Pet) GetSize() Size {
+	if x != nil {
+		return x.Size
+	}
+	return Size_SIZE_MEDIUM
+}
+
+func (x *Pet) GetOwner() *User {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *Pet) GetAttachment() []*Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type CreatePetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pet *Pet `protobuf:"bytes,1,opt,name=pet,proto3" json:"pet,omitempty"`
+}
+
+func (x *CreatePetRequest) Reset() {
+	*x = CreatePetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePetRequest) ProtoMessage() {}
+
+func (x *CreatePetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePetRequest.ProtoReflect.Descriptor instead.
+func (*CreatePetRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreatePetRequest) GetPet() *Pet {
+	if x != nil {
+		return x.Pet
+	}
+	return nil
+}
+
+type GetPetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	View GetPetRequest_View `protobuf:"varint,2,opt,name=view,proto3,enum=entpb.GetPetRequest_View" json:"view,omitempty"`
+}
+
+func (x *GetPetRequest) Reset() {
+	*x = GetPetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPetRequest) ProtoMessage() {}
+
+func (x *GetPetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPetRequest.ProtoReflect.Descriptor instead.
+func (*GetPetRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetPetRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetPetRequest) GetView() GetPetRequest_View {
+	if x != nil {
+		return x.View
+	}
+	return GetPetRequest_VIEW_UNSPECIFIED
+}
+
+type UpdatePetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pet *Pet `protobuf:"bytes,1,opt,name=pet,proto3" json:"pet,omitempty"`
+}
+
+func (x *UpdatePetRequest) Reset() {
+	*x = UpdatePetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePetRequest) ProtoMessage() {}
+
+func (x *UpdatePetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePetRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePetRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UpdatePetRequest) GetPet() *Pet {
+	if x != nil {
+		return x.Pet
+	}
+	return nil
+}
+
+type DeletePetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeletePetRequest) Reset() {
+	*x = DeletePetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePetRequest) ProtoMessage() {}
+
+func (x *DeletePetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePetRequest.ProtoReflect.Descriptor instead.
+func (*DeletePetRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DeletePetRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PetFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id *PetFilter_Int64Filter `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PetFilter) Reset() {
+	*x = PetFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PetFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PetFilter) ProtoMessage() {}
+
+func (x *PetFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PetFilter.ProtoReflect.Descriptor instead.
+func (*PetFilter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *PetFilter) GetId() *PetFilter_Int64Filter {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type ListPetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of entries to return. Defaults to 1000 if unset, and larger
+	// values are capped to 1000.
+	PageSize  int32               `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string              `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	View      ListPetRequest_View `protobuf:"varint,3,opt,name=view,proto3,enum=entpb.ListPetRequest_View" json:"view,omitempty"`
+	OrderBy   string              `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Filter    *PetFilter          `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ListPetRequest) Reset() {
+	*x = ListPetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPetRequest) ProtoMessage() {}
+
+func (x *ListPetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPetRequest.ProtoReflect.Descriptor instead.
+func (*ListPetRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListPetRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPetRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPetRequest) GetView() ListPetRequest_View {
+	if x != nil {
+		return x.View
+	}
+	return ListPetRequest_VIEW_UNSPECIFIED
+}
+
+func (x *ListPetRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListPetRequest) GetFilter() *PetFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ListPetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PetList       []*Pet `protobuf:"bytes,1,rep,name=pet_list,json=petList,proto3" json:"pet_list,omitempty"`
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListPetResponse) Reset() {
+	*x = ListPetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPetResponse) ProtoMessage() {}
+
+func (x *ListPetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPetResponse.ProtoReflect.Descriptor instead.
+func (*ListPetResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListPetResponse) GetPetList() []*Pet {
+	if x != nil {
+		return x.PetList
+	}
+	return nil
+}
+
+func (x *ListPetResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type BatchCreatePetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*CreatePetRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *BatchCreatePetsRequest) Reset() {
+	*x = BatchCreatePetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreatePetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreatePetsRequest) ProtoMessage() {}
+
+func (x *BatchCreatePetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreatePetsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreatePetsRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *BatchCreatePetsRequest) GetRequests() []*CreatePetRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BatchCreatePetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pets []*Pet `protobuf:"bytes,1,rep,name=pets,proto3" json:"pets,omitempty"`
+}
+
+func (x *BatchCreatePetsResponse) Reset() {
+	*x = BatchCreatePetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreatePetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreatePetsResponse) ProtoMessage() {}
+
+func (x *BatchCreatePetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreatePetsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreatePetsResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *BatchCreatePetsResponse) GetPets() []*Pet {
+	if x != nil {
+		return x.Pets
+	}
+	return nil
+}
+
+type Pony struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Defaults to "medium" when unset on creation.
+	Size   Size                   `protobuf:"varint,4,opt,name=size,proto3,enum=entpb.Size" json:"size,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Height string                 `protobuf:"bytes,3,opt,name=height,proto3" json:"height,omitempty"`
+	Scores []int64                `protobuf:"varint,5,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	Rank   *wrapperspb.Int32Value `protobuf:"bytes,7,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Defaults to "bay" when unset on creation.
+	Color string `protobuf:"bytes,8,opt,name=color,proto3" json:"color,omitempty"`
+	// Defaults to "low" when unset on creation.
+	Priority common.Priority `protobuf:"varint,6,opt,name=priority,proto3,enum=common.Priority" json:"priority,omitempty"`
+}
+
+func (x *Pony) Reset() {
+	*x = Pony{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pony) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pony) ProtoMessage() {}
+
+func (x *Pony) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pony.ProtoReflect.Descriptor instead.
+func (*Pony) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *Pony) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Pony) GetSize() Size {
+	if x != nil {
+		return x.Size
+	}
+	return Size_SIZE_MEDIUM
+}
+
+func (x *Pony) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pony) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+func (x *Pony) GetScores() []int64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *Pony) GetRank() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Rank
+	}
+	return nil
+}
+
+func (x *Pony) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Pony) GetPriority() common.Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return common.Priority(0)
+}
+
+type CreatePonyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pony *Pony `protobuf:"bytes,1,opt,name=pony,proto3" json:"pony,omitempty"`
+}
+
+func (x *CreatePonyRequest) Reset() {
+	*x = CreatePonyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePonyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePonyRequest) ProtoMessage() {}
+
+func (x *CreatePonyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePonyRequest.ProtoReflect.Descriptor instead.
+func (*CreatePonyRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CreatePonyRequest) GetPony() *Pony {
+	if x != nil {
+		return x.Pony
+	}
+	return nil
+}
+
+type BatchCreatePoniesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*CreatePonyRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *BatchCreatePoniesRequest) Reset() {
+	*x = BatchCreatePoniesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreatePoniesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreatePoniesRequest) ProtoMessage() {}
+
+func (x *BatchCreatePoniesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreatePoniesRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreatePoniesRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *BatchCreatePoniesRequest) GetRequests() []*CreatePonyRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BatchCreatePoniesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ponies []*Pony `protobuf:"bytes,1,rep,name=ponies,proto3" json:"ponies,omitempty"`
+}
+
+func (x *BatchCreatePoniesResponse) Reset() {
+	*x = BatchCreatePoniesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreatePoniesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreatePoniesResponse) ProtoMessage() {}
+
+func (x *BatchCreatePoniesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreatePoniesResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreatePoniesResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *BatchCreatePoniesResponse) GetPonies() []*Pony {
+	if x != nil {
+		return x.Ponies
+	}
+	return nil
+}
+
+type Project struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Defaults to "low" when unset on creation.
+	Priority common.Priority `protobuf:"varint,6,opt,name=priority,proto3,enum=common.Priority" json:"priority,omitempty"`
+	// Defaults to "0" when unset on creation.
+	Version int64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *Project) Reset() {
+	*x = Project{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project) ProtoMessage() {}
+
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Project) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Project) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Project) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Project) GetPriority() common.Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return common.Priority(0)
+}
+
+func (x *Project) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ProjectEdgeIds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerId       uint32   `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	AttachmentIds [][]byte `protobuf:"bytes,4,rep,name=attachment_ids,json=attachmentIds,proto3" json:"attachment_ids,omitempty"`
+}
+
+func (x *ProjectEdgeIds) Reset() {
+	*x = ProjectEdgeIds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectEdgeIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectEdgeIds) ProtoMessage() {}
+
+func (x *ProjectEdgeIds) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectEdgeIds.ProtoReflect.Descriptor instead.
+func (*ProjectEdgeIds) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ProjectEdgeIds) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *ProjectEdgeIds) GetAttachmentIds() [][]byte {
+	if x != nil {
+		return x.AttachmentIds
+	}
+	return nil
+}
+
+type CreateProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Project *Project        `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	EdgeIds *ProjectEdgeIds `protobuf:"bytes,2,opt,name=edge_ids,json=edgeIds,proto3" json:"edge_ids,omitempty"`
+}
+
+func (x *CreateProjectRequest) Reset() {
+	*x = CreateProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectRequest) ProtoMessage() {}
+
+func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CreateProjectRequest) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *CreateProjectRequest) GetEdgeIds() *ProjectEdgeIds {
+	if x != nil {
+		return x.EdgeIds
+	}
+	return nil
+}
+
+type ProjectLookupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	View ProjectLookupRequest_View `protobuf:"varint,2,opt,name=view,proto3,enum=entpb.ProjectLookupRequest_View" json:"view,omitempty"`
+	// Includes the entry if its deleted_at is set.
+	IncludeDeleted bool `protobuf:"varint,4,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+}
+
+func (x *ProjectLookupRequest) Reset() {
+	*x = ProjectLookupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectLookupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectLookupRequest) ProtoMessage() {}
+
+func (x *ProjectLookupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectLookupRequest.ProtoReflect.Descriptor instead.
+func (*ProjectLookupRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ProjectLookupRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProjectLookupRequest) GetView() ProjectLookupRequest_View {
+	if x != nil {
+		return x.View
+	}
+	return ProjectLookupRequest_VIEW_UNSPECIFIED
+}
+
+func (x *ProjectLookupRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type GetProjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Project *Project        `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	EdgeIds *ProjectEdgeIds `protobuf:"bytes,2,opt,name=edge_ids,json=edgeIds,proto3" json:"edge_ids,omitempty"`
+}
+
+func (x *GetProjectResponse) Reset() {
+	*x = GetProjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectResponse) ProtoMessage() {}
+
+func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *GetProjectResponse) GetEdgeIds() *ProjectEdgeIds {
+	if x != nil {
+		return x.EdgeIds
+	}
+	return nil
+}
+
+type GetProjectByNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetProjectByNameRequest) Reset() {
+	*x = GetProjectByNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProjectByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectByNameRequest) ProtoMessage() {}
+
+func (x *GetProjectByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectByNameRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetProjectByNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ExistsProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Key:
+	//	*ExistsProjectRequest_Id
+	//	*ExistsProjectRequest_Name
+	Key isExistsProjectRequest_Key `protobuf_oneof:"key"`
+}
+
+func (x *ExistsProjectRequest) Reset() {
+	*x = ExistsProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExistsProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExistsProjectRequest) ProtoMessage() {}
+
+func (x *ExistsProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExistsProjectRequest.ProtoReflect.Descriptor instead.
+func (*ExistsProjectRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{86}
+}
+
+func (m *ExistsProjectRequest) GetKey() isExistsProjectRequest_Key {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (x *ExistsProjectRequest) GetId() int64 {
+	if x, ok := x.GetKey().(*ExistsProjectRequest_Id); ok {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExistsProjectRequest) GetName() string {
+	if x, ok := x.GetKey().(*ExistsProjectRequest_Name); ok {
+		return x.Name
+	}
+	return ""
+}
+
+type isExistsProjectRequest_Key interface {
+	isExistsProjectRequest_Key()
+}
+
+type ExistsProjectRequest_Id struct {
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3,oneof"`
+}
+
+type ExistsProjectRequest_Name struct {
+	Name string `protobuf:"bytes,2,opt,name=name,proto3,oneof"`
+}
+
+func (*ExistsProjectRequest_Id) isExistsProjectRequest_Key() {}
+
+func (*ExistsProjectRequest_Name) isExistsProjectRequest_Key() {}
+
+type ExistsProjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exists bool `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+}
+
+func (x *ExistsProjectResponse) Reset() {
+	*x = ExistsProjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExistsProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExistsProjectResponse) ProtoMessage() {}
+
+func (x *ExistsProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExistsProjectResponse.ProtoReflect.Descriptor instead.
+func (*ExistsProjectResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ExistsProjectResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+type UpdateProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Project *Project        `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	EdgeIds *ProjectEdgeIds `protobuf:"bytes,2,opt,name=edge_ids,json=edgeIds,proto3" json:"edge_ids,omitempty"`
+}
+
+func (x *UpdateProjectRequest) Reset() {
+	*x = UpdateProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectRequest) ProtoMessage() {}
+
+func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UpdateProjectRequest) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *UpdateProjectRequest) GetEdgeIds() *ProjectEdgeIds {
+	if x != nil {
+		return x.EdgeIds
+	}
+	return nil
+}
+
+type DeleteProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The stored version of the entry, the request is aborted if it does not match.
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *DeleteProjectRequest) Reset() {
+	*x = DeleteProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectRequest) ProtoMessage() {}
+
+func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DeleteProjectRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteProjectRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ProjectFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        *ProjectFilter_Int64Filter     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      *ProjectFilter_StringFilter    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DeletedAt *ProjectFilter_TimestampFilter `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Version   *ProjectFilter_Int64Filter     `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *ProjectFilter) Reset() {
+	*x = ProjectFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectFilter) ProtoMessage() {}
+
+func (x *ProjectFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectFilter.ProtoReflect.Descriptor instead.
+func (*ProjectFilter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ProjectFilter) GetId() *ProjectFilter_Int64Filter {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ProjectFilter) GetName() *ProjectFilter_StringFilter {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *ProjectFilter) GetDeletedAt() *ProjectFilter_TimestampFilter {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *ProjectFilter) GetVersion() *ProjectFilter_Int64Filter {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+type DeleteProjectsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *ProjectFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *DeleteProjectsRequest) Reset() {
+	*x = DeleteProjectsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectsRequest) ProtoMessage() {}
+
+func (x *DeleteProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *DeleteProjectsRequest) GetFilter() *ProjectFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type DeleteProjectsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deleted int64 `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *DeleteProjectsResponse) Reset() {
+	*x = DeleteProjectsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectsResponse) ProtoMessage() {}
+
+func (x *DeleteProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *DeleteProjectsResponse) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+type RestoreProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RestoreProjectRequest) Reset() {
+	*x = RestoreProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProjectRequest) ProtoMessage() {}
+
+func (x *RestoreProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProjectRequest.ProtoReflect.Descriptor instead.
+func (*RestoreProjectRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RestoreProjectRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of entries to return. Defaults to 1000 if unset, and larger
+	// values are capped to 1000.
+	PageSize  int32                   `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                  `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	View      ListProjectRequest_View `protobuf:"varint,3,opt,name=view,proto3,enum=entpb.ListProjectRequest_View" json:"view,omitempty"`
+	OrderBy   string                  `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// An AIP-160 filter expression, e.g. `name = "foo" AND id > 10`.
+	Filter string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Includes the entries whose deleted_at is set.
+	IncludeDeleted bool `protobuf:"varint,6,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+}
+
+func (x *ListProjectRequest) Reset() {
+	*x = ListProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectRequest) ProtoMessage() {}
+
+func (x *ListProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListProjectRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProjectRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListProjectRequest) GetView() ListProjectRequest_View {
+	if x != nil {
+		return x.View
+	}
+	return ListProjectRequest_VIEW_UNSPECIFIED
+}
+
+func (x *ListProjectRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListProjectRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListProjectRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListProjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectList   []*Project        `protobuf:"bytes,1,rep,name=project_list,json=projectList,proto3" json:"project_list,omitempty"`
+	NextPageToken string            `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	EdgeIds       []*ProjectEdgeIds `protobuf:"bytes,3,rep,name=edge_ids,json=edgeIds,proto3" json:"edge_ids,omitempty"`
+	// The number of entries matching the request across all pages, capped to 3.
+	TotalSize int32 `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+}
+
+func (x *ListProjectResponse) Reset() {
+	*x = ListProjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectResponse) ProtoMessage() {}
+
+func (x *ListProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListProjectResponse) GetProjectList() []*Project {
+	if x != nil {
+		return x.ProjectList
+	}
+	return nil
+}
+
+func (x *ListProjectResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListProjectResponse) GetEdgeIds() []*ProjectEdgeIds {
+	if x != nil {
+		return x.EdgeIds
+	}
+	return nil
+}
+
+func (x *ListProjectResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type StreamProjectsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter    *ProjectFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	BatchSize int32          `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+}
+
+func (x *StreamProjectsRequest) Reset() {
+	*x = StreamProjectsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamProjectsRequest) ProtoMessage() {}
+
+func (x *StreamProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamProjectsRequest.ProtoReflect.Descriptor instead.
+func (*StreamProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *StreamProjectsRequest) GetFilter() *ProjectFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *StreamProjectsRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type CountProjectsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *ProjectFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *CountProjectsRequest) Reset() {
+	*x = CountProjectsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountProjectsRequest) ProtoMessage() {}
+
+func (x *CountProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountProjectsRequest.ProtoReflect.Descriptor instead.
+func (*CountProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CountProjectsRequest) GetFilter() *ProjectFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type CountProjectsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CountProjectsResponse) Reset() {
+	*x = CountProjectsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountProjectsResponse) ProtoMessage() {}
+
+func (x *CountProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountProjectsResponse.ProtoReflect.Descriptor instead.
+func (*CountProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *CountProjectsResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AggregateProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field   string         `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	GroupBy string         `protobuf:"bytes,2,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	Filter  *ProjectFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *AggregateProjectRequest) Reset() {
+	*x = AggregateProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateProjectRequest) ProtoMessage() {}
+
+func (x *AggregateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateProjectRequest.ProtoReflect.Descriptor instead.
+func (*AggregateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *AggregateProjectRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AggregateProjectRequest) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *AggregateProjectRequest) GetFilter() *ProjectFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type AggregateProjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*AggregateProjectResponse_Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *AggregateProjectResponse) Reset() {
+	*x = AggregateProjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateProjectResponse) ProtoMessage() {}
+
+func (x *AggregateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateProjectResponse.ProtoReflect.Descriptor instead.
+func (*AggregateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *AggregateProjectResponse) GetGroups() []*AggregateProjectResponse_Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type BatchCreateProjectsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*CreateProjectRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *BatchCreateProjectsRequest) Reset() {
+	*x = BatchCreateProjectsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateProjectsRequest) ProtoMessage() {}
+
+func (x *BatchCreateProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateProjectsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *BatchCreateProjectsRequest) GetRequests() []*CreateProjectRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BatchCreateProjectsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Projects []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+}
+
+func (x *BatchCreateProjectsResponse) Reset() {
+	*x = BatchCreateProjectsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateProjectsResponse) ProtoMessage() {}
+
+func (x *BatchCreateProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateProjectsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *BatchCreateProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+type UpsertProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Project *Project        `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	EdgeIds *ProjectEdgeIds `protobuf:"bytes,2,opt,name=edge_ids,json=edgeIds,proto3" json:"edge_ids,omitempty"`
+}
+
+func (x *UpsertProjectRequest) Reset() {
+	*x = UpsertProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertProjectRequest) ProtoMessage() {}
+
+func (x *UpsertProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertProjectRequest.ProtoReflect.Descriptor instead.
+func (*UpsertProjectRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *UpsertProjectRequest) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *UpsertProjectRequest) GetEdgeIds() *ProjectEdgeIds {
+	if x != nil {
+		return x.EdgeIds
+	}
+	return nil
+}
+
+type BatchGetProjectsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids  []int64                      `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	View BatchGetProjectsRequest_View `protobuf:"varint,2,opt,name=view,proto3,enum=entpb.BatchGetProjectsRequest_View" json:"view,omitempty"`
+}
+
+func (x *BatchGetProjectsRequest) Reset() {
+	*x = BatchGetProjectsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProjectsRequest) ProtoMessage() {}
+
+func (x *BatchGetProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProjectsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *BatchGetProjectsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchGetProjectsRequest) GetView() BatchGetProjectsRequest_View {
+	if x != nil {
+		return x.View
+	}
+	return BatchGetProjectsRequest_VIEW_UNSPECIFIED
+}
+
+type ProjectBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Projects   []*Project        `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	MissingIds []int64           `protobuf:"varint,2,rep,packed,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"`
+	EdgeIds    []*ProjectEdgeIds `protobuf:"bytes,3,rep,name=edge_ids,json=edgeIds,proto3" json:"edge_ids,omitempty"`
+}
+
+func (x *ProjectBatch) Reset() {
+	*x = ProjectBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectBatch) ProtoMessage() {}
+
+func (x *ProjectBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectBatch.ProtoReflect.Descriptor instead.
+func (*ProjectBatch) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ProjectBatch) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *ProjectBatch) GetMissingIds() []int64 {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+func (x *ProjectBatch) GetEdgeIds() []*ProjectEdgeIds {
+	if x != nil {
+		return x.EdgeIds
+	}
+	return nil
+}
+
+type BatchDeleteProjectsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids    []int64        `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Filter *ProjectFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *BatchDeleteProjectsRequest) Reset() {
+	*x = BatchDeleteProjectsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchDeleteProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteProjectsRequest) ProtoMessage() {}
+
+func (x *BatchDeleteProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteProjectsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *BatchDeleteProjectsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchDeleteProjectsRequest) GetFilter() *ProjectFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type StatsProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StatsProjectRequest) Reset() {
+	*x = StatsProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsProjectRequest) ProtoMessage() {}
+
+func (x *StatsProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsProjectRequest.ProtoReflect.Descriptor instead.
+func (*StatsProjectRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{107}
+}
+
+type StatsProjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count  int64                              `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Fields []*StatsProjectResponse_FieldStats `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *StatsProjectResponse) Reset() {
+	*x = StatsProjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsProjectResponse) ProtoMessage() {}
+
+func (x *StatsProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsProjectResponse.ProtoReflect.Descriptor instead.
+func (*StatsProjectResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *StatsProjectResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StatsProjectResponse) GetFields() []*StatsProjectResponse_FieldStats {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ExportProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format    ExportProjectRequest_Format `protobuf:"varint,1,opt,name=format,proto3,enum=entpb.ExportProjectRequest_Format" json:"format,omitempty"`
+	Cursor    string                      `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	BatchSize int32                       `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+}
+
+func (x *ExportProjectRequest) Reset() {
+	*x = ExportProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProjectRequest) ProtoMessage() {}
+
+func (x *ExportProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProjectRequest.ProtoReflect.Descriptor instead.
+func (*ExportProjectRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ExportProjectRequest) GetFormat() ExportProjectRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return ExportProjectRequest_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportProjectRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ExportProjectRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type ExportProjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data   []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ExportProjectResponse) Reset() {
+	*x = ExportProjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProjectResponse) ProtoMessage() {}
+
+func (x *ExportProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProjectResponse.ProtoReflect.Descriptor instead.
+func (*ExportProjectResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ExportProjectResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportProjectResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListProjectAttachmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListProjectAttachmentsRequest) Reset() {
+	*x = ListProjectAttachmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProjectAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListProjectAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListProjectAttachmentsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListProjectAttachmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AttachmentIds [][]byte `protobuf:"bytes,1,rep,name=attachment_ids,json=attachmentIds,proto3" json:"attachment_ids,omitempty"`
+}
+
+func (x *ListProjectAttachmentsResponse) Reset() {
+	*x = ListProjectAttachmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProjectAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListProjectAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ListProjectAttachmentsResponse) GetAttachmentIds() [][]byte {
+	if x != nil {
+		return x.AttachmentIds
+	}
+	return nil
+}
+
+type AddProjectAttachmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AttachmentId []byte `protobuf:"bytes,2,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+}
+
+func (x *AddProjectAttachmentRequest) Reset() {
+	*x = AddProjectAttachmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddProjectAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProjectAttachmentRequest) ProtoMessage() {}
+
+func (x *AddProjectAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProjectAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*AddProjectAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *AddProjectAttachmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddProjectAttachmentRequest) GetAttachmentId() []byte {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return nil
+}
+
+type RemoveProjectAttachmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AttachmentId []byte `protobuf:"bytes,2,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+}
+
+func (x *RemoveProjectAttachmentRequest) Reset() {
+	*x = RemoveProjectAttachmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveProjectAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveProjectAttachmentRequest) ProtoMessage() {}
+
+func (x *RemoveProjectAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveProjectAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*RemoveProjectAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *RemoveProjectAttachmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RemoveProjectAttachmentRequest) GetAttachmentId() []byte {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return nil
+}
+
+type Todo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Task string `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	// Defaults to "pending" when unset on creation.
+	Status Todo_Status `protobuf:"varint,3,opt,name=status,proto3,enum=entpb.Todo_Status" json:"status,omitempty"`
+	User   *User       `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *Todo) Reset() {
+	*x = Todo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Todo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Todo) ProtoMessage() {}
+
+func (x *Todo) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Todo.ProtoReflect.Descriptor instead.
+func (*Todo) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *Todo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Todo) GetTask() string {
+	if x != nil {
+		return x.Task
+	}
+	return ""
+}
+
+func (x *Todo) GetStatus() Todo_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Todo_STATUS_PENDING
+}
+
+func (x *Todo) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type CreateTodoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Todo *Todo `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+}
+
+func (x *CreateTodoRequest) Reset() {
+	*x = CreateTodoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTodoRequest) ProtoMessage() {}
+
+func (x *CreateTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTodoRequest.ProtoReflect.Descriptor instead.
+func (*CreateTodoRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CreateTodoRequest) GetTodo() *Todo {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+type GetTodoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	View GetTodoRequest_View `protobuf:"varint,2,opt,name=view,proto3,enum=entpb.GetTodoRequest_View" json:"view,omitempty"`
+}
+
+func (x *GetTodoRequest) Reset() {
+	*x = GetTodoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTodoRequest) ProtoMessage() {}
+
+func (x *GetTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTodoRequest.ProtoReflect.Descriptor instead.
+func (*GetTodoRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetTodoRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetTodoRequest) GetView() GetTodoRequest_View {
+	if x != nil {
+		return x.View
+	}
+	return GetTodoRequest_VIEW_UNSPECIFIED
+}
+
+type UpdateTodoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Todo *Todo `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+}
+
+func (x *UpdateTodoRequest) Reset() {
+	*x = UpdateTodoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTodoRequest) ProtoMessage() {}
+
+func (x *UpdateTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTodoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTodoRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *UpdateTodoRequest) GetTodo() *Todo {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+type DeleteTodoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteTodoRequest) Reset() {
+	*x = DeleteTodoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTodoRequest) ProtoMessage() {}
+
+func (x *DeleteTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTodoRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTodoRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *DeleteTodoRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type TodoFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   *TodoFilter_Int64Filter  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Task *TodoFilter_StringFilter `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+}
+
+func (x *TodoFilter) Reset() {
+	*x = TodoFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TodoFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TodoFilter) ProtoMessage() {}
+
+func (x *TodoFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TodoFilter.ProtoReflect.Descriptor instead.
+func (*TodoFilter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *TodoFilter) GetId() *TodoFilter_Int64Filter {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *TodoFilter) GetTask() *TodoFilter_StringFilter {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type ListTodoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of entries to return. Defaults to 1000 if unset, and larger
+	// values are capped to 1000.
+	PageSize  int32                `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string               `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	View      ListTodoRequest_View `protobuf:"varint,3,opt,name=view,proto3,enum=entpb.ListTodoRequest_View" json:"view,omitempty"`
+	OrderBy   string               `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Filter    *TodoFilter          `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ListTodoRequest) Reset() {
+	*x = ListTodoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTodoRequest) ProtoMessage() {}
+
+func (x *ListTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTodoRequest.ProtoReflect.Descriptor instead.
+func (*ListTodoRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ListTodoRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTodoRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTodoRequest) GetView() ListTodoRequest_View {
+	if x != nil {
+		return x.View
+	}
+	return ListTodoRequest_VIEW_UNSPECIFIED
+}
+
+func (x *ListTodoRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListTodoRequest) GetFilter() *TodoFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ListTodoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TodoList      []*Todo `protobuf:"bytes,1,rep,name=todo_list,json=todoList,proto3" json:"todo_list,omitempty"`
+	NextPageToken string  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListTodoResponse) Reset() {
+	*x = ListTodoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTodoResponse) ProtoMessage() {}
+
+func (x *ListTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTodoResponse.ProtoReflect.Descriptor instead.
+func (*ListTodoResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ListTodoResponse) GetTodoList() []*Todo {
+	if x != nil {
+		return x.TodoList
+	}
+	return nil
+}
+
+func (x *ListTodoResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type BatchCreateTodosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*CreateTodoRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *BatchCreateTodosRequest) Reset() {
+	*x = BatchCreateTodosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateTodosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateTodosRequest) ProtoMessage() {}
+
+func (x *BatchCreateTodosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateTodosRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateTodosRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *BatchCreateTodosRequest) GetRequests() []*CreateTodoRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BatchCreateTodosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Todos []*Todo `protobuf:"bytes,1,rep,name=todos,proto3" json:"todos,omitempty"`
+}
+
+func (x *BatchCreateTodosResponse) Reset() {
+	*x = BatchCreateTodosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateTodosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateTodosResponse) ProtoMessage() {}
+
+func (x *BatchCreateTodosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateTodosResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateTodosResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *BatchCreateTodosResponse) GetTodos() []*Todo {
+	if x != nil {
+		return x.Todos
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserName   string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Joined     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=joined,proto3" json:"joined,omitempty"`
+	Points     uint32                 `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	Exp        uint64                 `protobuf:"varint,5,opt,name=exp,proto3" json:"exp,omitempty"`
+	Status     User_Status            `protobuf:"varint,6,opt,name=status,proto3,enum=entpb.User_Status" json:"status,omitempty"`
+	ExternalId int64                  `protobuf:"varint,8,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	CrmId      []byte                 `protobuf:"bytes,9,opt,name=crm_id,json=crmId,proto3" json:"crm_id,omitempty"`
+	// Defaults to "false" when unset on creation.
+	Banned   bool                    `protobuf:"varint,10,opt,name=banned,proto3" json:"banned,omitempty"`
+	CustomPb uint64                  `protobuf:"varint,12,opt,name=custom_pb,json=customPb,proto3" json:"custom_pb,omitempty"`
+	OptNum   *wrapperspb.Int64Value  `protobuf:"bytes,13,opt,name=opt_num,json=optNum,proto3" json:"opt_num,omitempty"`
+	OptStr   *wrapperspb.StringValue `protobuf:"bytes,14,opt,name=opt_str,json=optStr,proto3" json:"opt_str,omitempty"`
+	OptBool  *wrapperspb.BoolValue   `protobuf:"bytes,15,opt,name=opt_bool,json=optBool,proto3" json:"opt_bool,omitempty"`
+	BigInt   *wrapperspb.StringValue `protobuf:"bytes,17,opt,name=big_int,json=bigInt,proto3" json:"big_int,omitempty"`
+	BUser_1  *wrapperspb.Int64Value  `protobuf:"bytes,18,opt,name=b_user_1,json=bUser1,proto3" json:"b_user_1,omitempty"`
+	// Defaults to "0" when unset on creation.
+	HeightInCm float32 `protobuf:"fixed32,19,opt,name=height_in_cm,json=heightInCm,proto3" json:"height_in_cm,omitempty"`
+	// Defaults to "0" when unset on creation.
+	AccountBalance float64                 `protobuf:"fixed64,20,opt,name=account_balance,json=accountBalance,proto3" json:"account_balance,omitempty"`
+	Type           *wrapperspb.StringValue `protobuf:"bytes,23,opt,name=type,proto3" json:"type,omitempty"`
+	Labels         []string                `protobuf:"bytes,24,rep,name=labels,proto3" json:"labels,omitempty"`
+	// Defaults to "GLOWY9000" when unset on creation.
+	DeviceType User_DeviceType        `protobuf:"varint,100,opt,name=device_type,json=deviceType,proto3,enum=entpb.User_DeviceType" json:"device_type,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	OmitPrefix User_OmitPrefix        `protobuf:"varint,103,opt,name=omit_prefix,json=omitPrefix,proto3,enum=entpb.User_OmitPrefix" json:"omit_prefix,omitempty"`
+	Group      *Group                 `protobuf:"bytes,7,opt,name=group,proto3" json:"group,omitempty"`
+	Attachment *Attachment            `protobuf:"bytes,11,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Received_1 []*Attachment          `protobuf:"bytes,16,rep,name=received_1,json=received1,proto3" json:"received_1,omitempty"`
+	Pet        *Pet                   `protobuf:"bytes,21,opt,name=pet,proto3" json:"pet,omitempty"`
+	FriendIds  []uint32               `protobuf:"varint,26,rep,packed,name=friend_ids,json=friendIds,proto3" json:"friend_ids,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *User) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *User) GetJoined() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Joined
+	}
+	return nil
+}
+
+func (x *User) GetPoints() uint32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *User) GetExp() uint64 {
+	if x != nil {
+		return x.Exp
+	}
+	return 0
+}
+
+func (x *User) GetStatus() User_Status {
+	if x != nil {
+		return x.Status
+	}
+	return User_STATUS_UNSPECIFIED
+}
+
+func (x *User) GetExternalId() int64 {
+	if x != nil {
+		return x.ExternalId
+	}
+	return 0
+}
+
+func (x *User) GetCrmId() []byte {
+	if x != nil {
+		return x.CrmId
+	}
+	return nil
+}
+
+func (x *User) GetBanned() bool {
+	if x != nil {
+		return x.Banned
+	}
+	return false
+}
+
+func (x *User) GetCustomPb() uint64 {
+	if x != nil {
+		return x.CustomPb
+	}
+	return 0
+}
+
+func (x *User) GetOptNum() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.OptNum
+	}
+	return nil
+}
+
+func (x *User) GetOptStr() *wrapperspb.StringValue {
+	if x != nil {
+		return x.OptStr
+	}
+	return nil
+}
+
+func (x *User) GetOptBool() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.OptBool
+	}
+	return nil
+}
+
+func (x *User) GetBigInt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.BigInt
+	}
+	return nil
+}
+
+func (x *User) GetBUser_1() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.BUser_1
+	}
+	return nil
+}
+
+func (x *User) GetHeightInCm() float32 {
+	if x != nil {
+		return x.HeightInCm
+	}
+	return 0
+}
+
+func (x *User) GetAccountBalance() float64 {
+	if x != nil {
+		return x.AccountBalance
+	}
+	return 0
+}
+
+func (x *User) GetType() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *User) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *User) GetDeviceType() User_DeviceType {
+	if x != nil {
+		return x.DeviceType
+	}
+	return User_DEVICE_TYPE_GLOWY9000
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetOmitPrefix() User_OmitPrefix {
+	if x != nil {
+		return x.OmitPrefix
+	}
+	return User_OMIT_PREFIX_UNSPECIFIED
+}
+
+func (x *User) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *User) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *User) GetReceived_1() []*Attachment {
+	if x != nil {
+		return x.Received_1
+	}
+	return nil
+}
+
+func (x *User) GetPet() *Pet {
+	if x != nil {
+		return x.Pet
+	}
+	return nil
+}
+
+func (x *User) GetFriendIds() []uint32 {
+	if x != nil {
+		return x.FriendIds
+	}
+	return nil
+}
+
+type CreateUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *CreateUserRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	View     GetUserRequest_View    `protobuf:"varint,2,opt,name=view,proto3,enum=entpb.GetUserRequest_View" json:"view,omitempty"`
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetUserRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetUserRequest) GetView() GetUserRequest_View {
+	if x != nil {
+		return x.View
+	}
+	return GetUserRequest_VIEW_UNSPECIFIED
+}
+
+func (x *GetUserRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type GetUserByUserNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+}
+
+func (x *GetUserByUserNameRequest) Reset() {
+	*x = GetUserByUserNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserByUserNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByUserNameRequest) ProtoMessage() {}
+
+func (x *GetUserByUserNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByUserNameRequest.ProtoReflect.Descriptor instead.
+func (*GetUserByUserNameRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *GetUserByUserNameRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+type GetUserByExternalIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExternalId int64 `protobuf:"varint,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (x *GetUserByExternalIDRequest) Reset() {
+	*x = GetUserByExternalIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserByExternalIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByExternalIDRequest) ProtoMessage() {}
+
+func (x *GetUserByExternalIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByExternalIDRequest.ProtoReflect.Descriptor instead.
+func (*GetUserByExternalIDRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *GetUserByExternalIDRequest) GetExternalId() int64 {
+	if x != nil {
+		return x.ExternalId
+	}
+	return 0
+}
+
+type GetUserByBUser1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BUser_1 int64 `protobuf:"varint,1,opt,name=b_user_1,json=bUser1,proto3" json:"b_user_1,omitempty"`
+}
+
+func (x *GetUserByBUser1Request) Reset() {
+	*x = GetUserByBUser1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserByBUser1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByBUser1Request) ProtoMessage() {}
+
+func (x *GetUserByBUser1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByBUser1Request.ProtoReflect.Descriptor instead.
+func (*GetUserByBUser1Request) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetUserByBUser1Request) GetBUser_1() int64 {
+	if x != nil {
+		return x.BUser_1
+	}
+	return 0
+}
+
+type ExistsUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Key:
+	//	*ExistsUserRequest_Id
+	//	*ExistsUserRequest_UserName
+	//	*ExistsUserRequest_ExternalId
+	//	*ExistsUserRequest_BUser_1
+	Key isExistsUserRequest_Key `protobuf_oneof:"key"`
+}
+
+func (x *ExistsUserRequest) Reset() {
+	*x = ExistsUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExistsUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExistsUserRequest) ProtoMessage() {}
+
+func (x *ExistsUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExistsUserRequest.ProtoReflect.Descriptor instead.
+func (*ExistsUserRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{131}
+}
+
+func (m *ExistsUserRequest) GetKey() isExistsUserRequest_Key {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (x *ExistsUserRequest) GetId() uint32 {
+	if x, ok := x.GetKey().(*ExistsUserRequest_Id); ok {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExistsUserRequest) GetUserName() string {
+	if x, ok := x.GetKey().(*ExistsUserRequest_UserName); ok {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ExistsUserRequest) GetExternalId() int64 {
+	if x, ok := x.GetKey().(*ExistsUserRequest_ExternalId); ok {
+		return x.ExternalId
+	}
+	return 0
+}
+
+func (x *ExistsUserRequest) GetBUser_1() int64 {
+	if x, ok := x.GetKey().(*ExistsUserRequest_BUser_1); ok {
+		return x.BUser_1
+	}
+	return 0
+}
+
+type isExistsUserRequest_Key interface {
+	isExistsUserRequest_Key()
+}
+
+type ExistsUserRequest_Id struct {
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3,oneof"`
+}
+
+type ExistsUserRequest_UserName struct {
+	UserName string `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3,oneof"`
+}
+
+type ExistsUserRequest_ExternalId struct {
+	ExternalId int64 `protobuf:"varint,8,opt,name=external_id,json=externalId,proto3,oneof"`
+}
+
+type ExistsUserRequest_BUser_1 struct {
+	BUser_1 int64 `protobuf:"varint,18,opt,name=b_user_1,json=bUser1,proto3,oneof"`
+}
+
+func (*ExistsUserRequest_Id) isExistsUserRequest_Key() {}
+
+func (*ExistsUserRequest_UserName) isExistsUserRequest_Key() {}
+
+func (*ExistsUserRequest_ExternalId) isExistsUserRequest_Key() {}
+
+func (*ExistsUserRequest_BUser_1) isExistsUserRequest_Key() {}
+
+type ExistsUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exists bool `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+}
+
+func (x *ExistsUserResponse) Reset() {
+	*x = ExistsUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExistsUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExistsUserResponse) ProtoMessage() {}
+
+func (x *ExistsUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExistsUserResponse.ProtoReflect.Descriptor instead.
+func (*ExistsUserResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ExistsUserResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+type UpdateUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User       *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *UpdateUserRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *DeleteUserRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UserFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             *UserFilter_UInt32Filter    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserName       *UserFilter_StringFilter    `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Joined         *UserFilter_TimestampFilter `protobuf:"bytes,3,opt,name=joined,proto3" json:"joined,omitempty"`
+	Points         *UserFilter_UInt32Filter    `protobuf:"bytes,4,opt,name=points,proto3" json:"points,omitempty"`
+	Exp            *UserFilter_UInt64Filter    `protobuf:"bytes,5,opt,name=exp,proto3" json:"exp,omitempty"`
+	ExternalId     *UserFilter_Int64Filter     `protobuf:"bytes,8,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Banned         *UserFilter_BoolFilter      `protobuf:"bytes,10,opt,name=banned,proto3" json:"banned,omitempty"`
+	OptNum         *UserFilter_Int64Filter     `protobuf:"bytes,13,opt,name=opt_num,json=optNum,proto3" json:"opt_num,omitempty"`
+	OptStr         *UserFilter_StringFilter    `protobuf:"bytes,14,opt,name=opt_str,json=optStr,proto3" json:"opt_str,omitempty"`
+	OptBool        *UserFilter_BoolFilter      `protobuf:"bytes,15,opt,name=opt_bool,json=optBool,proto3" json:"opt_bool,omitempty"`
+	BUser_1        *UserFilter_Int64Filter     `protobuf:"bytes,18,opt,name=b_user_1,json=bUser1,proto3" json:"b_user_1,omitempty"`
+	HeightInCm     *UserFilter_FloatFilter     `protobuf:"bytes,19,opt,name=height_in_cm,json=heightInCm,proto3" json:"height_in_cm,omitempty"`
+	AccountBalance *UserFilter_DoubleFilter    `protobuf:"bytes,20,opt,name=account_balance,json=accountBalance,proto3" json:"account_balance,omitempty"`
+	Type           *UserFilter_StringFilter    `protobuf:"bytes,23,opt,name=type,proto3" json:"type,omitempty"`
+	CreatedAt      *UserFilter_TimestampFilter `protobuf:"bytes,25,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *UserFilter) Reset() {
+	*x = UserFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFilter) ProtoMessage() {}
+
+func (x *UserFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFilter.ProtoReflect.Descriptor instead.
+func (*UserFilter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *UserFilter) GetId() *UserFilter_UInt32Filter {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UserFilter) GetUserName() *UserFilter_StringFilter {
+	if x != nil {
+		return x.UserName
+	}
+	return nil
+}
+
+func (x *UserFilter) GetJoined() *UserFilter_TimestampFilter {
+	if x != nil {
+		return x.Joined
+	}
+	return nil
+}
+
+func (x *UserFilter) GetPoints() *UserFilter_UInt32Filter {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *UserFilter) GetExp() *UserFilter_UInt64Filter {
+	if x != nil {
+		return x.Exp
+	}
+	return nil
+}
+
+func (x *UserFilter) GetExternalId() *UserFilter_Int64Filter {
+	if x != nil {
+		return x.ExternalId
+	}
+	return nil
+}
+
+func (x *UserFilter) GetBanned() *UserFilter_BoolFilter {
+	if x != nil {
+		return x.Banned
+	}
+	return nil
+}
+
+func (x *UserFilter) GetOptNum() *UserFilter_Int64Filter {
+	if x != nil {
+		return x.OptNum
+	}
+	return nil
+}
+
+func (x *UserFilter) GetOptStr() *UserFilter_StringFilter {
+	if x != nil {
+		return x.OptStr
+	}
+	return nil
+}
+
+func (x *UserFilter) GetOptBool() *UserFilter_BoolFilter {
+	if x != nil {
+		return x.OptBool
+	}
+	return nil
+}
+
+func (x *UserFilter) GetBUser_1() *UserFilter_Int64Filter {
+	if x != nil {
+		return x.BUser_1
+	}
+	return nil
+}
+
+func (x *UserFilter) GetHeightInCm() *UserFilter_FloatFilter {
+	if x != nil {
+		return x.HeightInCm
+	}
+	return nil
+}
+
+func (x *UserFilter) GetAccountBalance() *UserFilter_DoubleFilter {
+	if x != nil {
+		return x.AccountBalance
+	}
+	return nil
+}
+
+func (x *UserFilter) GetType() *UserFilter_StringFilter {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *UserFilter) GetCreatedAt() *UserFilter_TimestampFilter {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type DeleteUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *UserFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *DeleteUsersRequest) Reset() {
+	*x = DeleteUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUsersRequest) ProtoMessage() {}
+
+func (x *DeleteUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUsersRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUsersRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *DeleteUsersRequest) GetFilter() *UserFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type DeleteUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deleted int64 `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *DeleteUsersResponse) Reset() {
+	*x = DeleteUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUsersResponse) ProtoMessage() {}
+
+func (x *DeleteUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUsersResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUsersResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *DeleteUsersResponse) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+type ListUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of entries to return. Defaults to 1000 if unset, and larger
+	// values are capped to 1000.
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	View      ListUserRequest_View   `protobuf:"varint,3,opt,name=view,proto3,enum=entpb.ListUserRequest_View" json:"view,omitempty"`
+	OrderBy   string                 `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Filter    *UserFilter            `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	ReadMask  *fieldmaskpb.FieldMask `protobuf:"bytes,7,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+}
+
+func (x *ListUserRequest) Reset() {
+	*x = ListUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserRequest) ProtoMessage() {}
+
+func (x *ListUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserRequest.ProtoReflect.Descriptor instead.
+func (*ListUserRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ListUserRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUserRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUserRequest) GetView() ListUserRequest_View {
+	if x != nil {
+		return x.View
+	}
+	return ListUserRequest_VIEW_UNSPECIFIED
+}
+
+func (x *ListUserRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListUserRequest) GetFilter() *UserFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListUserRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type ListUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserList      []*User `protobuf:"bytes,1,rep,name=user_list,json=userList,proto3" json:"user_list,omitempty"`
+	NextPageToken string  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListUserResponse) Reset() {
+	*x = ListUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserResponse) ProtoMessage() {}
+
+func (x *ListUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserResponse.ProtoReflect.Descriptor instead.
+func (*ListUserResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ListUserResponse) GetUserList() []*User {
+	if x != nil {
+		return x.UserList
+	}
+	return nil
+}
+
+func (x *ListUserResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type StreamUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter    *UserFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	BatchSize int32       `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+}
+
+func (x *StreamUsersRequest) Reset() {
+	*x = StreamUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamUsersRequest) ProtoMessage() {}
+
+func (x *StreamUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamUsersRequest.ProtoReflect.Descriptor instead.
+func (*StreamUsersRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *StreamUsersRequest) GetFilter() *UserFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *StreamUsersRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type CountUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *UserFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *CountUsersRequest) Reset() {
+	*x = CountUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountUsersRequest) ProtoMessage() {}
+
+func (x *CountUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountUsersRequest.ProtoReflect.Descriptor instead.
+func (*CountUsersRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *CountUsersRequest) GetFilter() *UserFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type CountUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CountUsersResponse) Reset() {
+	*x = CountUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountUsersResponse) ProtoMessage() {}
+
+func (x *CountUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountUsersResponse.ProtoReflect.Descriptor instead.
+func (*CountUsersResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *CountUsersResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AggregateUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field   string      `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	GroupBy string      `protobuf:"bytes,2,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	Filter  *UserFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *AggregateUserRequest) Reset() {
+	*x = AggregateUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateUserRequest) ProtoMessage() {}
+
+func (x *AggregateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateUserRequest.ProtoReflect.Descriptor instead.
+func (*AggregateUserRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *AggregateUserRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AggregateUserRequest) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *AggregateUserRequest) GetFilter() *UserFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type AggregateUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*AggregateUserResponse_Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *AggregateUserResponse) Reset() {
+	*x = AggregateUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateUserResponse) ProtoMessage() {}
+
+func (x *AggregateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateUserResponse.ProtoReflect.Descriptor instead.
+func (*AggregateUserResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *AggregateUserResponse) GetGroups() []*AggregateUserResponse_Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type BatchCreateUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*CreateUserRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *BatchCreateUsersRequest) Reset() {
+	*x = BatchCreateUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateUsersRequest) ProtoMessage() {}
+
+func (x *BatchCreateUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateUsersRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateUsersRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *BatchCreateUsersRequest) GetRequests() []*CreateUserRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BatchCreateUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *BatchCreateUsersResponse) Reset() {
+	*x = BatchCreateUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateUsersResponse) ProtoMessage() {}
+
+func (x *BatchCreateUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateUsersResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateUsersResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *BatchCreateUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type UpsertUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *UpsertUserRequest) Reset() {
+	*x = UpsertUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertUserRequest) ProtoMessage() {}
+
+func (x *UpsertUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertUserRequest.ProtoReflect.Descriptor instead.
+func (*UpsertUserRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *UpsertUserRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type BatchGetUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids  []uint32                  `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	View BatchGetUsersRequest_View `protobuf:"varint,2,opt,name=view,proto3,enum=entpb.BatchGetUsersRequest_View" json:"view,omitempty"`
+}
+
+func (x *BatchGetUsersRequest) Reset() {
+	*x = BatchGetUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUsersRequest) ProtoMessage() {}
+
+func (x *BatchGetUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUsersRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetUsersRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *BatchGetUsersRequest) GetIds() []uint32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchGetUsersRequest) GetView() BatchGetUsersRequest_View {
+	if x != nil {
+		return x.View
+	}
+	return BatchGetUsersRequest_VIEW_UNSPECIFIED
+}
+
+type BatchGetUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users      []*User  `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	MissingIds []uint32 `protobuf:"varint,2,rep,packed,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"`
+}
+
+func (x *BatchGetUsersResponse) Reset() {
+	*x = BatchGetUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUsersResponse) ProtoMessage() {}
+
+func (x *BatchGetUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUsersResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetUsersResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *BatchGetUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *BatchGetUsersResponse) GetMissingIds() []uint32 {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+type BatchUpdateUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*UpdateUserRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *BatchUpdateUsersRequest) Reset() {
+	*x = BatchUpdateUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdateUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateUsersRequest) ProtoMessage() {}
+
+func (x *BatchUpdateUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateUsersRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateUsersRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *BatchUpdateUsersRequest) GetRequests() []*UpdateUserRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BatchUpdateUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *BatchUpdateUsersResponse) Reset() {
+	*x = BatchUpdateUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdateUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateUsersResponse) ProtoMessage() {}
+
+func (x *BatchUpdateUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateUsersResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateUsersResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *BatchUpdateUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type BatchDeleteUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids    []uint32    `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Filter *UserFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *BatchDeleteUsersRequest) Reset() {
+	*x = BatchDeleteUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchDeleteUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteUsersRequest) ProtoMessage() {}
+
+func (x *BatchDeleteUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteUsersRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteUsersRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *BatchDeleteUsersRequest) GetIds() []uint32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchDeleteUsersRequest) GetFilter() *UserFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type StatsUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StatsUserRequest) Reset() {
+	*x = StatsUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsUserRequest) ProtoMessage() {}
+
+func (x *StatsUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsUserRequest.ProtoReflect.Descriptor instead.
+func (*StatsUserRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{153}
+}
+
+type StatsUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count  int64                           `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Fields []*StatsUserResponse_FieldStats `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *StatsUserResponse) Reset() {
+	*x = StatsUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsUserResponse) ProtoMessage() {}
+
+func (x *StatsUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsUserResponse.ProtoReflect.Descriptor instead.
+func (*StatsUserResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *StatsUserResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StatsUserResponse) GetFields() []*StatsUserResponse_FieldStats {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ExportUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format    ExportUserRequest_Format `protobuf:"varint,1,opt,name=format,proto3,enum=entpb.ExportUserRequest_Format" json:"format,omitempty"`
+	Cursor    string                   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	BatchSize int32                    `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+}
+
+func (x *ExportUserRequest) Reset() {
+	*x = ExportUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserRequest) ProtoMessage() {}
+
+func (x *ExportUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *ExportUserRequest) GetFormat() ExportUserRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return ExportUserRequest_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportUserRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ExportUserRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type ExportUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data   []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ExportUserResponse) Reset() {
+	*x = ExportUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserResponse) ProtoMessage() {}
+
+func (x *ExportUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *ExportUserResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportUserResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ImportUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format ImportUserRequest_Format `protobuf:"varint,1,opt,name=format,proto3,enum=entpb.ImportUserRequest_Format" json:"format,omitempty"`
+	Data   []byte                   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ImportUserRequest) Reset() {
+	*x = ImportUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUserRequest) ProtoMessage() {}
+
+func (x *ImportUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUserRequest.ProtoReflect.Descriptor instead.
+func (*ImportUserRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *ImportUserRequest) GetFormat() ImportUserRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return ImportUserRequest_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportUserRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ImportUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count  int64  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ImportUserResponse) Reset() {
+	*x = ImportUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUserResponse) ProtoMessage() {}
+
+func (x *ImportUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUserResponse.ProtoReflect.Descriptor instead.
+func (*ImportUserResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *ImportUserResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ImportUserResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type WatchUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WatchUserRequest) Reset() {
+	*x = WatchUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchUserRequest) ProtoMessage() {}
+
+func (x *WatchUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchUserRequest.ProtoReflect.Descriptor instead.
+func (*WatchUserRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{159}
+}
+
+type UserEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type UserEvent_Type `protobuf:"varint,1,opt,name=type,proto3,enum=entpb.UserEvent_Type" json:"type,omitempty"`
+	Id   uint32         `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	User *User          `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *UserEvent) Reset() {
+	*x = UserEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserEvent) ProtoMessage() {}
+
+func (x *UserEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserEvent.ProtoReflect.Descriptor instead.
+func (*UserEvent) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *UserEvent) GetType() UserEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return UserEvent_TYPE_UNSPECIFIED
+}
+
+func (x *UserEvent) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserEvent) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type DocumentFilter_Int64Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq  *wrapperspb.Int64Value `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq *wrapperspb.Int64Value `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt  *wrapperspb.Int64Value `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt  *wrapperspb.Int64Value `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	In  []int64                `protobuf:"varint,5,rep,packed,name=in,proto3" json:"in,omitempty"`
+	Gte *wrapperspb.Int64Value `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte *wrapperspb.Int64Value `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *DocumentFilter_Int64Filter) Reset() {
+	*x = DocumentFilter_Int64Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocumentFilter_Int64Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentFilter_Int64Filter) ProtoMessage() {}
+
+func (x *DocumentFilter_Int64Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentFilter_Int64Filter.ProtoReflect.Descriptor instead.
+func (*DocumentFilter_Int64Filter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{24, 0}
+}
+
+func (x *DocumentFilter_Int64Filter) GetEq() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *DocumentFilter_Int64Filter) GetNeq() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *DocumentFilter_Int64Filter) GetGt() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *DocumentFilter_Int64Filter) GetLt() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *DocumentFilter_Int64Filter) GetIn() []int64 {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *DocumentFilter_Int64Filter) GetGte() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *DocumentFilter_Int64Filter) GetLte() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type DocumentFilter_StringFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq       *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq      *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt       *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt       *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	In       []string                `protobuf:"bytes,5,rep,name=in,proto3" json:"in,omitempty"`
+	Contains *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=contains,proto3" json:"contains,omitempty"`
+	Gte      *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte      *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *DocumentFilter_StringFilter) Reset() {
+	*x = DocumentFilter_StringFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocumentFilter_StringFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentFilter_StringFilter) ProtoMessage() {}
+
+func (x *DocumentFilter_StringFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentFilter_StringFilter.ProtoReflect.Descriptor instead.
+func (*DocumentFilter_StringFilter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{24, 1}
+}
+
+func (x *DocumentFilter_StringFilter) GetEq() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *DocumentFilter_StringFilter) GetNeq() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *DocumentFilter_StringFilter) GetGt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *DocumentFilter_StringFilter) GetLt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *DocumentFilter_StringFilter) GetIn() []string {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *DocumentFilter_StringFilter) GetContains() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Contains
+	}
+	return nil
+}
+
+func (x *DocumentFilter_StringFilter) GetGte() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *DocumentFilter_StringFilter) GetLte() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type LabelFilter_StringFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq       *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq      *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt       *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt       *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	In       []string                `protobuf:"bytes,5,rep,name=in,proto3" json:"in,omitempty"`
+	Contains *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=contains,proto3" json:"contains,omitempty"`
+	Gte      *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte      *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *LabelFilter_StringFilter) Reset() {
+	*x = LabelFilter_StringFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LabelFilter_StringFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelFilter_StringFilter) ProtoMessage() {}
+
+func (x *LabelFilter_StringFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelFilter_StringFilter.ProtoReflect.Descriptor instead.
+func (*LabelFilter_StringFilter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{39, 0}
+}
+
+func (x *LabelFilter_StringFilter) GetEq() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *LabelFilter_StringFilter) GetNeq() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *LabelFilter_StringFilter) GetGt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *LabelFilter_StringFilter) GetLt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *LabelFilter_StringFilter) GetIn() []string {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *LabelFilter_StringFilter) GetContains() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Contains
+	}
+	return nil
+}
+
+func (x *LabelFilter_StringFilter) GetGte() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *LabelFilter_StringFilter) GetLte() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type MultiWordSchemaFilter_Int64Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq  *wrapperspb.Int64Value `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq *wrapperspb.Int64Value `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt  *wrapperspb.Int64Value `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt  *wrapperspb.Int64Value `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	In  []int64                `protobuf:"varint,5,rep,packed,name=in,proto3" json:"in,omitempty"`
+	Gte *wrapperspb.Int64Value `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte *wrapperspb.Int64Value `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *MultiWordSchemaFilter_Int64Filter) Reset() {
+	*x = MultiWordSchemaFilter_Int64Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiWordSchemaFilter_Int64Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiWordSchemaFilter_Int64Filter) ProtoMessage() {}
+
+func (x *MultiWordSchemaFilter_Int64Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiWordSchemaFilter_Int64Filter.ProtoReflect.Descriptor instead.
+func (*MultiWordSchemaFilter_Int64Filter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{51, 0}
+}
+
+func (x *MultiWordSchemaFilter_Int64Filter) GetEq() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *MultiWordSchemaFilter_Int64Filter) GetNeq() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *MultiWordSchemaFilter_Int64Filter) GetGt() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *MultiWordSchemaFilter_Int64Filter) GetLt() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *MultiWordSchemaFilter_Int64Filter) GetIn() []int64 {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *MultiWordSchemaFilter_Int64Filter) GetGte() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *MultiWordSchemaFilter_Int64Filter) GetLte() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type NilExampleFilter_Int64Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq  *wrapperspb.Int64Value `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq *wrapperspb.Int64Value `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt  *wrapperspb.Int64Value `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt  *wrapperspb.Int64Value `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	In  []int64                `protobuf:"varint,5,rep,packed,name=in,proto3" json:"in,omitempty"`
+	Gte *wrapperspb.Int64Value `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte *wrapperspb.Int64Value `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *NilExampleFilter_Int64Filter) Reset() {
+	*x = NilExampleFilter_Int64Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NilExampleFilter_Int64Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NilExampleFilter_Int64Filter) ProtoMessage() {}
+
+func (x *NilExampleFilter_Int64Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NilExampleFilter_Int64Filter.ProtoReflect.Descriptor instead.
+func (*NilExampleFilter_Int64Filter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{61, 0}
+}
+
+func (x *NilExampleFilter_Int64Filter) GetEq() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *NilExampleFilter_Int64Filter) GetNeq() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *NilExampleFilter_Int64Filter) GetGt() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *NilExampleFilter_Int64Filter) GetLt() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *NilExampleFilter_Int64Filter) GetIn() []int64 {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *NilExampleFilter_Int64Filter) GetGte() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *NilExampleFilter_Int64Filter) GetLte() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type NilExampleFilter_StringFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq       *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq      *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt       *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt       *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	In       []string                `protobuf:"bytes,5,rep,name=in,proto3" json:"in,omitempty"`
+	Contains *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=contains,proto3" json:"contains,omitempty"`
+	Gte      *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte      *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *NilExampleFilter_StringFilter) Reset() {
+	*x = NilExampleFilter_StringFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NilExampleFilter_StringFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NilExampleFilter_StringFilter) ProtoMessage() {}
+
+func (x *NilExampleFilter_StringFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NilExampleFilter_StringFilter.ProtoReflect.Descriptor instead.
+func (*NilExampleFilter_StringFilter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{61, 1}
+}
+
+func (x *NilExampleFilter_StringFilter) GetEq() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *NilExampleFilter_StringFilter) GetNeq() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *NilExampleFilter_StringFilter) GetGt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *NilExampleFilter_StringFilter) GetLt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *NilExampleFilter_StringFilter) GetIn() []string {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *NilExampleFilter_StringFilter) GetContains() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Contains
+	}
+	return nil
+}
+
+func (x *NilExampleFilter_StringFilter) GetGte() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *NilExampleFilter_StringFilter) GetLte() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type NilExampleFilter_TimestampFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	Gte *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *NilExampleFilter_TimestampFilter) Reset() {
+	*x = NilExampleFilter_TimestampFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NilExampleFilter_TimestampFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NilExampleFilter_TimestampFilter) ProtoMessage() {}
+
+func (x *NilExampleFilter_TimestampFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NilExampleFilter_TimestampFilter.ProtoReflect.Descriptor instead.
+func (*NilExampleFilter_TimestampFilter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{61, 2}
+}
+
+func (x *NilExampleFilter_TimestampFilter) GetEq() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *NilExampleFilter_TimestampFilter) GetNeq() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *NilExampleFilter_TimestampFilter) GetGt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *NilExampleFilter_TimestampFilter) GetLt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *NilExampleFilter_TimestampFilter) GetGte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *NilExampleFilter_TimestampFilter) GetLte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type PetFilter_Int64Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq  *wrapperspb.Int64Value `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq *wrapperspb.Int64Value `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt  *wrapperspb.Int64Value `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt  *wrapperspb.Int64Value `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	In  []int64                `protobuf:"varint,5,rep,packed,name=in,proto3" json:"in,omitempty"`
+	Gte *wrapperspb.Int64Value `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte *wrapperspb.Int64Value `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *PetFilter_Int64Filter) Reset() {
+	*x = PetFilter_Int64Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PetFilter_Int64Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PetFilter_Int64Filter) ProtoMessage() {}
+
+func (x *PetFilter_Int64Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PetFilter_Int64Filter.ProtoReflect.Descriptor instead.
+func (*PetFilter_Int64Filter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{71, 0}
+}
+
+func (x *PetFilter_Int64Filter) GetEq() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *PetFilter_Int64Filter) GetNeq() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *PetFilter_Int64Filter) GetGt() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *PetFilter_Int64Filter) GetLt() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *PetFilter_Int64Filter) GetIn() []int64 {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *PetFilter_Int64Filter) GetGte() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *PetFilter_Int64Filter) GetLte() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type ProjectFilter_Int64Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq  *wrapperspb.Int64Value `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq *wrapperspb.Int64Value `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt  *wrapperspb.Int64Value `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt  *wrapperspb.Int64Value `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	In  []int64                `protobuf:"varint,5,rep,packed,name=in,proto3" json:"in,omitempty"`
+	Gte *wrapperspb.Int64Value `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte *wrapperspb.Int64Value `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *ProjectFilter_Int64Filter) Reset() {
+	*x = ProjectFilter_Int64Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectFilter_Int64Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectFilter_Int64Filter) ProtoMessage() {}
+
+func (x *ProjectFilter_Int64Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectFilter_Int64Filter.ProtoReflect.Descriptor instead.
+func (*ProjectFilter_Int64Filter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{90, 0}
+}
+
+func (x *ProjectFilter_Int64Filter) GetEq() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *ProjectFilter_Int64Filter) GetNeq() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *ProjectFilter_Int64Filter) GetGt() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *ProjectFilter_Int64Filter) GetLt() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *ProjectFilter_Int64Filter) GetIn() []int64 {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *ProjectFilter_Int64Filter) GetGte() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *ProjectFilter_Int64Filter) GetLte() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type ProjectFilter_StringFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq       *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq      *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt       *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt       *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	In       []string                `protobuf:"bytes,5,rep,name=in,proto3" json:"in,omitempty"`
+	Contains *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=contains,proto3" json:"contains,omitempty"`
+	Gte      *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte      *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *ProjectFilter_StringFilter) Reset() {
+	*x = ProjectFilter_StringFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectFilter_StringFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectFilter_StringFilter) ProtoMessage() {}
+
+func (x *ProjectFilter_StringFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectFilter_StringFilter.ProtoReflect.Descriptor instead.
+func (*ProjectFilter_StringFilter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{90, 1}
+}
+
+func (x *ProjectFilter_StringFilter) GetEq() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *ProjectFilter_StringFilter) GetNeq() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *ProjectFilter_StringFilter) GetGt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *ProjectFilter_StringFilter) GetLt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *ProjectFilter_StringFilter) GetIn() []string {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *ProjectFilter_StringFilter) GetContains() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Contains
+	}
+	return nil
+}
+
+func (x *ProjectFilter_StringFilter) GetGte() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *ProjectFilter_StringFilter) GetLte() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type ProjectFilter_TimestampFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	Gte *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *ProjectFilter_TimestampFilter) Reset() {
+	*x = ProjectFilter_TimestampFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectFilter_TimestampFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectFilter_TimestampFilter) ProtoMessage() {}
+
+func (x *ProjectFilter_TimestampFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectFilter_TimestampFilter.ProtoReflect.Descriptor instead.
+func (*ProjectFilter_TimestampFilter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{90, 2}
+}
+
+func (x *ProjectFilter_TimestampFilter) GetEq() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *ProjectFilter_TimestampFilter) GetNeq() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *ProjectFilter_TimestampFilter) GetGt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *ProjectFilter_TimestampFilter) GetLt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *ProjectFilter_TimestampFilter) GetGte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *ProjectFilter_TimestampFilter) GetLte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type AggregateProjectResponse_Group struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string                  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count int64                   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Sum   *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=sum,proto3" json:"sum,omitempty"`
+	Avg   *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=avg,proto3" json:"avg,omitempty"`
+	Min   *wrapperspb.DoubleValue `protobuf:"bytes,5,opt,name=min,proto3" json:"min,omitempty"`
+	Max   *wrapperspb.DoubleValue `protobuf:"bytes,6,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *AggregateProjectResponse_Group) Reset() {
+	*x = AggregateProjectResponse_Group{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateProjectResponse_Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateProjectResponse_Group) ProtoMessage() {}
+
+func (x *AggregateProjectResponse_Group) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateProjectResponse_Group.ProtoReflect.Descriptor instead.
+func (*AggregateProjectResponse_Group) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{100, 0}
+}
+
+func (x *AggregateProjectResponse_Group) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AggregateProjectResponse_Group) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AggregateProjectResponse_Group) GetSum() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Sum
+	}
+	return nil
+}
+
+func (x *AggregateProjectResponse_Group) GetAvg() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Avg
+	}
+	return nil
+}
+
+func (x *AggregateProjectResponse_Group) GetMin() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *AggregateProjectResponse_Group) GetMax() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+type StatsProjectResponse_FieldStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field  string                             `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Values []*StatsProjectResponse_ValueCount `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *StatsProjectResponse_FieldStats) Reset() {
+	*x = StatsProjectResponse_FieldStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsProjectResponse_FieldStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsProjectResponse_FieldStats) ProtoMessage() {}
+
+func (x *StatsProjectResponse_FieldStats) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsProjectResponse_FieldStats.ProtoReflect.Descriptor instead.
+func (*StatsProjectResponse_FieldStats) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{108, 0}
+}
+
+func (x *StatsProjectResponse_FieldStats) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *StatsProjectResponse_FieldStats) GetValues() []*StatsProjectResponse_ValueCount {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type StatsProjectResponse_ValueCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *StatsProjectResponse_ValueCount) Reset() {
+	*x = StatsProjectResponse_ValueCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsProjectResponse_ValueCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsProjectResponse_ValueCount) ProtoMessage() {}
+
+func (x *StatsProjectResponse_ValueCount) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsProjectResponse_ValueCount.ProtoReflect.Descriptor instead.
+func (*StatsProjectResponse_ValueCount) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{108, 1}
+}
+
+func (x *StatsProjectResponse_ValueCount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *StatsProjectResponse_ValueCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type TodoFilter_Int64Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq  *wrapperspb.Int64Value `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq *wrapperspb.Int64Value `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt  *wrapperspb.Int64Value `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt  *wrapperspb.Int64Value `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	In  []int64                `protobuf:"varint,5,rep,packed,name=in,proto3" json:"in,omitempty"`
+	Gte *wrapperspb.Int64Value `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte *wrapperspb.Int64Value `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *TodoFilter_Int64Filter) Reset() {
+	*x = TodoFilter_Int64Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TodoFilter_Int64Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TodoFilter_Int64Filter) ProtoMessage() {}
+
+func (x *TodoFilter_Int64Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TodoFilter_Int64Filter.ProtoReflect.Descriptor instead.
+func (*TodoFilter_Int64Filter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{120, 0}
+}
+
+func (x *TodoFilter_Int64Filter) GetEq() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *TodoFilter_Int64Filter) GetNeq() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *TodoFilter_Int64Filter) GetGt() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *TodoFilter_Int64Filter) GetLt() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *TodoFilter_Int64Filter) GetIn() []int64 {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *TodoFilter_Int64Filter) GetGte() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *TodoFilter_Int64Filter) GetLte() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type TodoFilter_StringFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq       *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq      *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt       *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt       *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	In       []string                `protobuf:"bytes,5,rep,name=in,proto3" json:"in,omitempty"`
+	Contains *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=contains,proto3" json:"contains,omitempty"`
+	Gte      *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte      *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *TodoFilter_StringFilter) Reset() {
+	*x = TodoFilter_StringFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TodoFilter_StringFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TodoFilter_StringFilter) ProtoMessage() {}
+
+func (x *TodoFilter_StringFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TodoFilter_StringFilter.ProtoReflect.Descriptor instead.
+func (*TodoFilter_StringFilter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{120, 1}
+}
+
+func (x *TodoFilter_StringFilter) GetEq() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *TodoFilter_StringFilter) GetNeq() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *TodoFilter_StringFilter) GetGt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *TodoFilter_StringFilter) GetLt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *TodoFilter_StringFilter) GetIn() []string {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *TodoFilter_StringFilter) GetContains() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Contains
+	}
+	return nil
+}
+
+func (x *TodoFilter_StringFilter) GetGte() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *TodoFilter_StringFilter) GetLte() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type UserFilter_BoolFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq  *wrapperspb.BoolValue `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq *wrapperspb.BoolValue `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+}
+
+func (x *UserFilter_BoolFilter) Reset() {
+	*x = UserFilter_BoolFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserFilter_BoolFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFilter_BoolFilter) ProtoMessage() {}
+
+func (x *UserFilter_BoolFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFilter_BoolFilter.ProtoReflect.Descriptor instead.
+func (*UserFilter_BoolFilter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{135, 0}
+}
+
+func (x *UserFilter_BoolFilter) GetEq() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *UserFilter_BoolFilter) GetNeq() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+type UserFilter_DoubleFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq  *wrapperspb.DoubleValue `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt  *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt  *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	In  []float64               `protobuf:"fixed64,5,rep,packed,name=in,proto3" json:"in,omitempty"`
+	Gte *wrapperspb.DoubleValue `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte *wrapperspb.DoubleValue `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *UserFilter_DoubleFilter) Reset() {
+	*x = UserFilter_DoubleFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserFilter_DoubleFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFilter_DoubleFilter) ProtoMessage() {}
+
+func (x *UserFilter_DoubleFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFilter_DoubleFilter.ProtoReflect.Descriptor instead.
+func (*UserFilter_DoubleFilter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{135, 1}
+}
+
+func (x *UserFilter_DoubleFilter) GetEq() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *UserFilter_DoubleFilter) GetNeq() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *UserFilter_DoubleFilter) GetGt() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *UserFilter_DoubleFilter) GetLt() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *UserFilter_DoubleFilter) GetIn() []float64 {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *UserFilter_DoubleFilter) GetGte() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *UserFilter_DoubleFilter) GetLte() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type UserFilter_FloatFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq  *wrapperspb.FloatValue `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq *wrapperspb.FloatValue `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt  *wrapperspb.FloatValue `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt  *wrapperspb.FloatValue `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	In  []float32              `protobuf:"fixed32,5,rep,packed,name=in,proto3" json:"in,omitempty"`
+	Gte *wrapperspb.FloatValue `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte *wrapperspb.FloatValue `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *UserFilter_FloatFilter) Reset() {
+	*x = UserFilter_FloatFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserFilter_FloatFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFilter_FloatFilter) ProtoMessage() {}
+
+func (x *UserFilter_FloatFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFilter_FloatFilter.ProtoReflect.Descriptor instead.
+func (*UserFilter_FloatFilter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{135, 2}
+}
+
+func (x *UserFilter_FloatFilter) GetEq() *wrapperspb.FloatValue {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *UserFilter_FloatFilter) GetNeq() *wrapperspb.FloatValue {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *UserFilter_FloatFilter) GetGt() *wrapperspb.FloatValue {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *UserFilter_FloatFilter) GetLt() *wrapperspb.FloatValue {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *UserFilter_FloatFilter) GetIn() []float32 {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *UserFilter_FloatFilter) GetGte() *wrapperspb.FloatValue {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *UserFilter_FloatFilter) GetLte() *wrapperspb.FloatValue {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type UserFilter_Int64Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq  *wrapperspb.Int64Value `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq *wrapperspb.Int64Value `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt  *wrapperspb.Int64Value `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt  *wrapperspb.Int64Value `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	In  []int64                `protobuf:"varint,5,rep,packed,name=in,proto3" json:"in,omitempty"`
+	Gte *wrapperspb.Int64Value `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte *wrapperspb.Int64Value `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *UserFilter_Int64Filter) Reset() {
+	*x = UserFilter_Int64Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserFilter_Int64Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFilter_Int64Filter) ProtoMessage() {}
+
+func (x *UserFilter_Int64Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFilter_Int64Filter.ProtoReflect.Descriptor instead.
+func (*UserFilter_Int64Filter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{135, 3}
+}
+
+func (x *UserFilter_Int64Filter) GetEq() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *UserFilter_Int64Filter) GetNeq() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *UserFilter_Int64Filter) GetGt() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *UserFilter_Int64Filter) GetLt() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *UserFilter_Int64Filter) GetIn() []int64 {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *UserFilter_Int64Filter) GetGte() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *UserFilter_Int64Filter) GetLte() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type UserFilter_StringFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq       *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq      *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt       *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt       *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	In       []string                `protobuf:"bytes,5,rep,name=in,proto3" json:"in,omitempty"`
+	Contains *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=contains,proto3" json:"contains,omitempty"`
+	Gte      *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte      *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *UserFilter_StringFilter) Reset() {
+	*x = UserFilter_StringFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserFilter_StringFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFilter_StringFilter) ProtoMessage() {}
+
+func (x *UserFilter_StringFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFilter_StringFilter.ProtoReflect.Descriptor instead.
+func (*UserFilter_StringFilter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{135, 4}
+}
+
+func (x *UserFilter_StringFilter) GetEq() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *UserFilter_StringFilter) GetNeq() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *UserFilter_StringFilter) GetGt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *UserFilter_StringFilter) GetLt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *UserFilter_StringFilter) GetIn() []string {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *UserFilter_StringFilter) GetContains() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Contains
+	}
+	return nil
+}
+
+func (x *UserFilter_StringFilter) GetGte() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *UserFilter_StringFilter) GetLte() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type UserFilter_TimestampFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	Gte *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *UserFilter_TimestampFilter) Reset() {
+	*x = UserFilter_TimestampFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserFilter_TimestampFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFilter_TimestampFilter) ProtoMessage() {}
+
+func (x *UserFilter_TimestampFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFilter_TimestampFilter.ProtoReflect.Descriptor instead.
+func (*UserFilter_TimestampFilter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{135, 5}
+}
+
+func (x *UserFilter_TimestampFilter) GetEq() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *UserFilter_TimestampFilter) GetNeq() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *UserFilter_TimestampFilter) GetGt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *UserFilter_TimestampFilter) GetLt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *UserFilter_TimestampFilter) GetGte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *UserFilter_TimestampFilter) GetLte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type UserFilter_UInt32Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq  *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq *wrapperspb.UInt32Value `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt  *wrapperspb.UInt32Value `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt  *wrapperspb.UInt32Value `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	In  []uint32                `protobuf:"varint,5,rep,packed,name=in,proto3" json:"in,omitempty"`
+	Gte *wrapperspb.UInt32Value `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte *wrapperspb.UInt32Value `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *UserFilter_UInt32Filter) Reset() {
+	*x = UserFilter_UInt32Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserFilter_UInt32Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFilter_UInt32Filter) ProtoMessage() {}
+
+func (x *UserFilter_UInt32Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFilter_UInt32Filter.ProtoReflect.Descriptor instead.
+func (*UserFilter_UInt32Filter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{135, 6}
+}
+
+func (x *UserFilter_UInt32Filter) GetEq() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *UserFilter_UInt32Filter) GetNeq() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *UserFilter_UInt32Filter) GetGt() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *UserFilter_UInt32Filter) GetLt() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *UserFilter_UInt32Filter) GetIn() []uint32 {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *UserFilter_UInt32Filter) GetGte() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *UserFilter_UInt32Filter) GetLte() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type UserFilter_UInt64Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eq  *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	Neq *wrapperspb.UInt64Value `protobuf:"bytes,2,opt,name=neq,proto3" json:"neq,omitempty"`
+	Gt  *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	Lt  *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	In  []uint64                `protobuf:"varint,5,rep,packed,name=in,proto3" json:"in,omitempty"`
+	Gte *wrapperspb.UInt64Value `protobuf:"bytes,7,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lte *wrapperspb.UInt64Value `protobuf:"bytes,8,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (x *UserFilter_UInt64Filter) Reset() {
+	*x = UserFilter_UInt64Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserFilter_UInt64Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFilter_UInt64Filter) ProtoMessage() {}
+
+func (x *UserFilter_UInt64Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFilter_UInt64Filter.ProtoReflect.Descriptor instead.
+func (*UserFilter_UInt64Filter) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{135, 7}
+}
+
+func (x *UserFilter_UInt64Filter) GetEq() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *UserFilter_UInt64Filter) GetNeq() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Neq
+	}
+	return nil
+}
+
+func (x *UserFilter_UInt64Filter) GetGt() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *UserFilter_UInt64Filter) GetLt() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *UserFilter_UInt64Filter) GetIn() []uint64 {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *UserFilter_UInt64Filter) GetGte() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *UserFilter_UInt64Filter) GetLte() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+type AggregateUserResponse_Group struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string                  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count int64                   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Sum   *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=sum,proto3" json:"sum,omitempty"`
+	Avg   *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=avg,proto3" json:"avg,omitempty"`
+	Min   *wrapperspb.DoubleValue `protobuf:"bytes,5,opt,name=min,proto3" json:"min,omitempty"`
+	Max   *wrapperspb.DoubleValue `protobuf:"bytes,6,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *AggregateUserResponse_Group) Reset() {
+	*x = AggregateUserResponse_Group{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateUserResponse_Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateUserResponse_Group) ProtoMessage() {}
+
+func (x *AggregateUserResponse_Group) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateUserResponse_Group.ProtoReflect.Descriptor instead.
+func (*AggregateUserResponse_Group) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{144, 0}
+}
+
+func (x *AggregateUserResponse_Group) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AggregateUserResponse_Group) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AggregateUserResponse_Group) GetSum() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Sum
+	}
+	return nil
+}
+
+func (x *AggregateUserResponse_Group) GetAvg() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Avg
+	}
+	return nil
+}
+
+func (x *AggregateUserResponse_Group) GetMin() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *AggregateUserResponse_Group) GetMax() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+type StatsUserResponse_FieldStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field  string                          `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Values []*StatsUserResponse_ValueCount `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *StatsUserResponse_FieldStats) Reset() {
+	*x = StatsUserResponse_FieldStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsUserResponse_FieldStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsUserResponse_FieldStats) ProtoMessage() {}
+
+func (x *StatsUserResponse_FieldStats) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsUserResponse_FieldStats.ProtoReflect.Descriptor instead.
+func (*StatsUserResponse_FieldStats) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{154, 0}
+}
+
+func (x *StatsUserResponse_FieldStats) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *StatsUserResponse_FieldStats) GetValues() []*StatsUserResponse_ValueCount {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type StatsUserResponse_ValueCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *StatsUserResponse_ValueCount) Reset() {
+	*x = StatsUserResponse_ValueCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_entpb_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsUserResponse_ValueCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsUserResponse_ValueCount) ProtoMessage() {}
+
+func (x *StatsUserResponse_ValueCount) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_entpb_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsUserResponse_ValueCount.ProtoReflect.Descriptor instead.
+func (*StatsUserResponse_ValueCount) Descriptor() ([]byte, []int) {
+	return file_entpb_entpb_proto_rawDescGZIP(), []int{154, 1}
+}
+
+func (x *StatsUserResponse_ValueCount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *StatsUserResponse_ValueCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_entpb_entpb_proto protoreflect.FileDescriptor
+
+var file_entpb_entpb_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2f, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x1a, 0x13, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x16, 0x65, 0x6e, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x72, 0x70, 0x63, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x6a, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x2b, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x4c, 0x0a, 0x17,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x20, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56,
+	0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x3a, 0x0a, 0x04, 0x56, 0x69, 0x65,
+	0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49, 0x43,
+	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f,
+	0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x32, 0x0a, 0x17, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x02,
+	0x69, 0x64, 0x42, 0x05, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x32, 0x0a, 0x18, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x4c, 0x0a,
+	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x17, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0xe1, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x04,
+	0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76,
+	0x69, 0x65, 0x77, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0x3a,
+	0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x45, 0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x7c, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0e, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x30, 0x0a, 0x18, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5b, 0x0a, 0x1d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x22, 0x5e, 0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x1b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x31, 0x0a, 0x1d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x69,
+	0x64, 0x73, 0x22, 0x31, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0x47, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x0c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0x52,
+	0x0a, 0x1d, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0x55, 0x0a, 0x20, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xa7, 0x01, 0x0a, 0x08, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x08,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x32, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04,
+	0x76, 0x69, 0x65, 0x77, 0x22, 0x3a, 0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10,
+	0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a,
+	0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02,
+	0x22, 0x44, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x27, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0xa9, 0x07, 0x0a, 0x0e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x31, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x08, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x41, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x1a, 0xb1, 0x02, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x2b, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x65, 0x71, 0x12,
+	0x2d, 0x0a, 0x03, 0x6e, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6e, 0x65, 0x71, 0x12, 0x2b,
+	0x0a, 0x02, 0x67, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x67, 0x74, 0x12, 0x2b, 0x0a, 0x02, 0x6c,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x2d, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x1a, 0xf2, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x02, 0x65, 0x71, 0x12, 0x2e, 0x0a, 0x03, 0x6e, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x03, 0x6e, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x02, 0x67, 0x74, 0x12, 0x2c, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x6c,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x6e, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x03, 0x67,
+	0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x6c,
+	0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x22, 0x47, 0x0a, 0x16, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x22, 0x33, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x8c, 0x02, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x33, 0x0a,
+	0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x2d, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x3a, 0x0a, 0x04,
+	0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41,
+	0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x44,
+	0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x74, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x34, 0x0a, 0x0d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x46,
+	0x0a, 0x15, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x2e, 0x0a, 0x16, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x57, 0x0a, 0x1b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22,
+	0x4d, 0x0a, 0x1c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2d, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x4e,
+	0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x37,
+	0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x92, 0xa7, 0x49, 0x04, 0x67, 0x72, 0x65, 0x79,
+	0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x38, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x22, 0x8e, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77,
+	0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x3a, 0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14,
+	0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12,
+	0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53,
+	0x10, 0x02, 0x22, 0x38, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x24, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0xea, 0x03, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x2f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x1a, 0xf2, 0x02, 0x0a, 0x0c, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x02, 0x65,
+	0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x65, 0x71, 0x12, 0x2e, 0x0a, 0x03, 0x6e, 0x65, 0x71,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6e, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x02, 0x67, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x02, 0x67, 0x74, 0x12, 0x2c, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12,
+	0x2e, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12,
+	0x2e, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x22,
+	0x83, 0x02, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x30, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x2a, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x3a, 0x0a, 0x04, 0x56, 0x69, 0x65,
+	0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49, 0x43,
+	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f,
+	0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x68, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x09, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x51, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x22, 0x41, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x24, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x06, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x41, 0x0a,
+	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x4d, 0x49, 0x54,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x44, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02,
+	0x22, 0x41, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0x7a, 0x0a, 0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x55, 0x6e, 0x69,
+	0x74, 0x42, 0x05, 0x92, 0xa7, 0x49, 0x01, 0x6d, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x22, 0x1f,
+	0x0a, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x4d,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x46, 0x54, 0x10, 0x01, 0x22,
+	0x62, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f,
+	0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x42, 0x0a, 0x11, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x22, 0xa2, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x39, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x25, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x3a, 0x0a, 0x04,
+	0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41,
+	0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x44,
+	0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x62, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x11, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x0f, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x2e, 0x0a, 0x1c,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x85, 0x03, 0x0a,
+	0x15, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x02, 0x69, 0x64,
+	0x1a, 0xb1, 0x02, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x2b, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x65, 0x71, 0x12, 0x2d, 0x0a,
+	0x03, 0x6e, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6e, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x02,
+	0x67, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x67, 0x74, 0x12, 0x2b, 0x0a, 0x02, 0x6c, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x2d, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x03, 0x6c, 0x74, 0x65, 0x22, 0xa1, 0x02, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x3a, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57,
+	0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x3a, 0x0a, 0x04,
+	0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41,
+	0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x44,
+	0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x92, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x16, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x52, 0x13, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x65, 0x0a,
+	0x22, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x22, 0x6b, 0x0a, 0x23, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x12, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x73, 0x22, 0xca, 0x01, 0x0a, 0x0a, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x35, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x5f, 0x6e, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x06, 0x73, 0x74, 0x72, 0x4e, 0x69, 0x6c, 0x12, 0x35, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6e, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x4e, 0x69, 0x6c, 0x12, 0x3e,
+	0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x5f, 0x6e, 0x69, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x72, 0x4e, 0x69, 0x6c, 0x22, 0x4d,
+	0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0b, 0x6e, 0x69, 0x6c,
+	0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x52, 0x0a, 0x6e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x22, 0x98, 0x01,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x3a, 0x0a, 0x04,
+	0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41,
+	0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x44,
+	0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x4d, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0b, 0x6e, 0x69, 0x6c, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x0a, 0x6e, 0x69, 0x6c,
+	0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x22, 0x29, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x95, 0x09, 0x0a, 0x10, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x69, 0x6c, 0x45,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x07,
+	0x73, 0x74, 0x72, 0x5f, 0x6e, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x06, 0x73, 0x74, 0x72, 0x4e, 0x69, 0x6c, 0x12, 0x42, 0x0a, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x4e, 0x69, 0x6c, 0x1a,
+	0xb1, 0x02, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x2b, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
+	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x65, 0x71, 0x12, 0x2d, 0x0a, 0x03,
+	0x6e, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6e, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x02, 0x67,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x67, 0x74, 0x12, 0x2b, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x2d, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x03, 0x67, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03,
+	0x6c, 0x74, 0x65, 0x1a, 0xf2, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02,
+	0x65, 0x71, 0x12, 0x2e, 0x0a, 0x03, 0x6e, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6e,
+	0x65, 0x71, 0x12, 0x2c, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x67, 0x74,
+	0x12, 0x2c, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x38,
+	0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x1a, 0x9f, 0x02, 0x0a, 0x0f, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x02,
+	0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x03, 0x6e, 0x65, 0x71, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x03, 0x6e, 0x65, 0x71, 0x12, 0x2a, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02,
+	0x67, 0x74, 0x12, 0x2a, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x2c,
+	0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x03,
+	0x6c, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x22, 0x92, 0x02, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x35, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x69, 0x6c, 0x45, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x65,
+	0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x69, 0x6c, 0x45, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x22, 0x3a, 0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56,
+	0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22,
+	0x7d, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x10, 0x6e, 0x69, 0x6c,
+	0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x69, 0x6c, 0x45,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x0e, 0x6e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5b,
+	0x0a, 0x1d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x6c,
+	0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x56, 0x0a, 0x1e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x0c, 0x6e, 0x69, 0x6c, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x69, 0x6c, 0x45,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x0b, 0x6e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x03, 0x50, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x0a, 0x92, 0xa7, 0x49, 0x06, 0x6d, 0x65, 0x64, 0x69,
+	0x75, 0x6d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x0a, 0x61,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x30,
+	0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x70, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x74, 0x52, 0x03, 0x70, 0x65, 0x74,
+	0x22, 0x8a, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65,
+	0x77, 0x22, 0x3a, 0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45,
+	0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x30, 0x0a,
+	0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x03, 0x70, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x74, 0x52, 0x03, 0x70, 0x65, 0x74, 0x22,
+	0x22, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0xed, 0x02, 0x0a, 0x09, 0x50, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x02, 0x69, 0x64, 0x1a,
+	0xb1, 0x02, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x2b, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
+	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x65, 0x71, 0x12, 0x2d, 0x0a, 0x03,
+	0x6e, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6e, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x02, 0x67,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x67, 0x74, 0x12, 0x2b, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x2d, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x03, 0x67, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03,
+	0x6c, 0x74, 0x65, 0x22, 0xfd, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x28, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x3a, 0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12,
+	0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01,
+	0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x49, 0x44,
+	0x53, 0x10, 0x02, 0x22, 0x60, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x65, 0x74, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x50, 0x65, 0x74, 0x52, 0x07, 0x70, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4d, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x33, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x22, 0x39, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1e, 0x0a, 0x04, 0x70, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x74, 0x52, 0x04, 0x70, 0x65, 0x74, 0x73, 0x22,
+	0x92, 0x02, 0x0a, 0x04, 0x50, 0x6f, 0x6e, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53,
+	0x69, 0x7a, 0x65, 0x42, 0x0a, 0x92, 0xa7, 0x49, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1a, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x03, 0x42, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x2f, 0x0a,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1d,
+	0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0x92,
+	0xa7, 0x49, 0x03, 0x62, 0x61, 0x79, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x35, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x42, 0x07, 0x92, 0xa7, 0x49, 0x03, 0x6c, 0x6f, 0x77, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x22, 0x34, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x6f, 0x6e,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x50, 0x6f, 0x6e, 0x79, 0x52, 0x04, 0x70, 0x6f, 0x6e, 0x79, 0x22, 0x50, 0x0a, 0x18, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6e, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x40, 0x0a, 0x19,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6e, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x70, 0x6f, 0x6e,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x50, 0x6f, 0x6e, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6e, 0x69, 0x65, 0x73, 0x22, 0xc0,
+	0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39,
+	0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x07, 0x92,
+	0xa7, 0x49, 0x03, 0x6c, 0x6f, 0x77, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x1f, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x05, 0x92, 0xa7, 0x49, 0x01, 0x30, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x52, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x64, 0x67, 0x65,
+	0x49, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0x72, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x65, 0x64, 0x67, 0x65, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x64, 0x67, 0x65, 0x49, 0x64, 0x73,
+	0x52, 0x07, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x14, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x34, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x20, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69,
+	0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x22, 0x3a, 0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45,
+	0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x70, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x30, 0x0a,
+	0x08, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x45,
+	0x64, 0x67, 0x65, 0x49, 0x64, 0x73, 0x52, 0x07, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x73, 0x22,
+	0x2d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x45,
+	0x0a, 0x14, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x05,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x2f, 0x0a, 0x15, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x72, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x65, 0x64, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x64, 0x67, 0x65, 0x49, 0x64,
+	0x73, 0x52, 0x07, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x73, 0x22, 0x40, 0x0a, 0x14, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc4, 0x09, 0x0a,
+	0x0d, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x30,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x35, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3a, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0xb1, 0x02, 0x0a, 0x0b, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x02, 0x65, 0x71, 0x12, 0x2d, 0x0a, 0x03, 0x6e, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x03, 0x6e, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x67,
+	0x74, 0x12, 0x2b, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x2d,
+	0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
+	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x2d, 0x0a,
+	0x03, 0x6c, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x1a, 0xf2, 0x02, 0x0a,
+	0x0c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2c, 0x0a,
+	0x02, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x65, 0x71, 0x12, 0x2e, 0x0a, 0x03, 0x6e,
+	0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6e, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x02, 0x67,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x67, 0x74, 0x12, 0x2c, 0x0a, 0x02, 0x6c, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x73, 0x12, 0x2e, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x67, 0x74,
+	0x65, 0x12, 0x2e, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6c, 0x74,
+	0x65, 0x1a, 0x9f, 0x02, 0x0a, 0x0f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x65,
+	0x71, 0x12, 0x2c, 0x0a, 0x03, 0x6e, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x6e, 0x65, 0x71, 0x12,
+	0x2a, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x67, 0x74, 0x12, 0x2a, 0x0a, 0x02, 0x6c,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03,
+	0x6c, 0x74, 0x65, 0x22, 0x45, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x32, 0x0a, 0x16, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x27,
+	0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9c, 0x02, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x32, 0x0a, 0x04, 0x76, 0x69,
+	0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19,
+	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x3a, 0x0a, 0x04, 0x56, 0x69,
+	0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49,
+	0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45,
+	0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0xc1, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x30, 0x0a, 0x08, 0x65, 0x64, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x64, 0x67, 0x65, 0x49,
+	0x64, 0x73, 0x52, 0x07, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x64, 0x0a, 0x15, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x44, 0x0a, 0x14, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x2d, 0x0a, 0x15, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x78, 0x0a, 0x17, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42,
+	0x79, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22,
+	0xcb, 0x02, 0x0a, 0x18, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0xef, 0x01, 0x0a, 0x05,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a,
+	0x03, 0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x73, 0x75, 0x6d, 0x12, 0x2e, 0x0a,
+	0x03, 0x61, 0x76, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x61, 0x76, 0x67, 0x12, 0x2e, 0x0a,
+	0x03, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x2e, 0x0a,
+	0x03, 0x6d, 0x61, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x55, 0x0a,
+	0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x22, 0x49, 0x0a, 0x1b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22,
+	0x72, 0x0a, 0x14, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x30, 0x0a, 0x08, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x45, 0x64, 0x67, 0x65, 0x49, 0x64, 0x73, 0x52, 0x07, 0x65, 0x64, 0x67, 0x65,
+	0x49, 0x64, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64,
+	0x73, 0x12, 0x37, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x23, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x3a, 0x0a, 0x04, 0x56, 0x69,
+	0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49,
+	0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45,
+	0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x8d, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x64, 0x67, 0x65, 0x49, 0x64, 0x73, 0x52, 0x07, 0x65,
+	0x64, 0x67, 0x65, 0x49, 0x64, 0x73, 0x22, 0x5c, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8a, 0x02, 0x0a, 0x14,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x62, 0x0a, 0x0a, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x3e,
+	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x38,
+	0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xcc, 0x01, 0x0a, 0x14, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x22, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x41, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16,
+	0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x5f,
+	0x44, 0x45, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4e,
+	0x44, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x22, 0x43, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x2f, 0x0a, 0x1d,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x47, 0x0a,
+	0x1e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0x52, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x61, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x1e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x22, 0xcb, 0x01, 0x0a, 0x04, 0x54, 0x6f, 0x64, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
+	0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x12, 0x37,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x64, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x0b, 0x92, 0xa7, 0x49, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x45, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x22,
+	0x34, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x64, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x64, 0x6f, 0x52,
+	0x04, 0x74, 0x6f, 0x64, 0x6f, 0x22, 0x8c, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x64,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69,
+	0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x3a, 0x0a, 0x04, 0x56, 0x69, 0x65, 0x77,
+	0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10,
+	0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x49,
+	0x44, 0x53, 0x10, 0x02, 0x22, 0x34, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f,
+	0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x6f, 0x64,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x54, 0x6f, 0x64, 0x6f, 0x52, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x22, 0x23, 0x0a, 0x11, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x98, 0x06, 0x0a, 0x0a, 0x54, 0x6f, 0x64, 0x6f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2d,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x54, 0x6f, 0x64, 0x6f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e,
+	0x74, 0x36, 0x34, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a,
+	0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x64, 0x6f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x04, 0x74, 0x61, 0x73,
+	0x6b, 0x1a, 0xb1, 0x02, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x2b, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x65, 0x71, 0x12, 0x2d,
+	0x0a, 0x03, 0x6e, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
+	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6e, 0x65, 0x71, 0x12, 0x2b, 0x0a,
+	0x02, 0x67, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x67, 0x74, 0x12, 0x2b, 0x0a, 0x02, 0x6c, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x2d, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x03, 0x6c, 0x74, 0x65, 0x1a, 0xf2, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x02, 0x65, 0x71, 0x12, 0x2e, 0x0a, 0x03, 0x6e, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x03, 0x6e, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02,
+	0x67, 0x74, 0x12, 0x2c, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x6c, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e,
+	0x12, 0x38, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x03, 0x67, 0x74,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x6c, 0x74,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x22, 0x80, 0x02, 0x0a, 0x0f, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x6f, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2f, 0x0a, 0x04, 0x76, 0x69,
+	0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54,
+	0x6f, 0x64, 0x6f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x22, 0x3a, 0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45,
+	0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x64, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x09, 0x74, 0x6f, 0x64, 0x6f, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x64,
+	0x6f, 0x52, 0x08, 0x74, 0x6f, 0x64, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x4f, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x64, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34,
+	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x6f, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x22, 0x3d, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x64, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x21, 0x0a, 0x05, 0x74, 0x6f, 0x64, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x64, 0x6f, 0x52, 0x05, 0x74, 0x6f,
+	0x64, 0x6f, 0x73, 0x22, 0xb6, 0x0a, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x6a, 0x6f, 0x69,
+	0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x78, 0x70, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x03, 0x65, 0x78, 0x70, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x06, 0x62,
+	0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x42, 0x09, 0x92, 0xa7, 0x49,
+	0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x62, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x62, 0x12, 0x34, 0x0a, 0x07, 0x6f,
+	0x70, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x4e, 0x75,
+	0x6d, 0x12, 0x35, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x06, 0x6f, 0x70, 0x74, 0x53, 0x74, 0x72, 0x12, 0x35, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x5f,
+	0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x12,
+	0x35, 0x0a, 0x07, 0x62, 0x69, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06,
+	0x62, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x62, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x31, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x62, 0x55, 0x73, 0x65, 0x72, 0x31, 0x12, 0x27, 0x0a,
+	0x0c, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x6d, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x02, 0x42, 0x05, 0x92, 0xa7, 0x49, 0x01, 0x30, 0x52, 0x0a, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x49, 0x6e, 0x43, 0x6d, 0x12, 0x2e, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x05, 0x92, 0xa7, 0x49, 0x01, 0x30, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x12, 0x46, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0d, 0x92,
+	0xa7, 0x49, 0x09, 0x47, 0x4c, 0x4f, 0x57, 0x59, 0x39, 0x30, 0x30, 0x30, 0x52, 0x0a, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x0b, 0x6f, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x4f, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x52, 0x0a, 0x6f, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x22, 0x0a, 0x05,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x31, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f,
+	0x31, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x31, 0x12, 0x1c, 0x0a, 0x03, 0x70, 0x65, 0x74, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x74, 0x52, 0x03,
+	0x70, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x49,
+	0x64, 0x73, 0x22, 0x47, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x22, 0x42, 0x0a, 0x0a, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x45, 0x56,
+	0x49, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x4c, 0x4f, 0x57, 0x59, 0x39, 0x30,
+	0x30, 0x30, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x59, 0x33, 0x30, 0x30, 0x10, 0x01, 0x22,
+	0x3b, 0x0a, 0x0a, 0x4f, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1b, 0x0a,
+	0x17, 0x4f, 0x4d, 0x49, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x49, 0x58, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x4f,
+	0x4f, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x41, 0x52, 0x10, 0x02, 0x22, 0x34, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x22, 0xc5, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52,
+	0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61,
+	0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x3a,
+	0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x45, 0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x37, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x49, 0x64, 0x22, 0x32, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x42,
+	0x55, 0x73, 0x65, 0x72, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x08,
+	0x62, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x62, 0x55, 0x73, 0x65, 0x72, 0x31, 0x22, 0x8a, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d,
+	0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x62, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x31, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x06, 0x62, 0x55, 0x73, 0x65, 0x72, 0x31, 0x42, 0x05, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x22, 0x2c, 0x0a, 0x12, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x22, 0x71, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x22, 0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8f, 0x19, 0x0a, 0x0a, 0x55, 0x73,
+	0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64,
+	0x12, 0x36, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x03, 0x65, 0x78, 0x70, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x03, 0x65, 0x78, 0x70, 0x12, 0x3e, 0x0a, 0x0b, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x62, 0x61,
+	0x6e, 0x6e, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64,
+	0x12, 0x36, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x06, 0x6f, 0x70, 0x74, 0x4e, 0x75, 0x6d, 0x12, 0x37, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x53, 0x74,
+	0x72, 0x12, 0x37, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x37, 0x0a, 0x08, 0x62, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x31, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x62, 0x55, 0x73,
+	0x65, 0x72, 0x31, 0x12, 0x3f, 0x0a, 0x0c, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x69, 0x6e,
+	0x5f, 0x63, 0x6d, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x49, 0x6e, 0x43, 0x6d, 0x12, 0x47, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0e, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x1a, 0x66, 0x0a, 0x0a, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x2a, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x65, 0x71, 0x12, 0x2c, 0x0a,
+	0x03, 0x6e, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6e, 0x65, 0x71, 0x1a, 0xb8, 0x02, 0x0a, 0x0c,
+	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x02,
+	0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x65, 0x71, 0x12, 0x2e, 0x0a, 0x03, 0x6e, 0x65,
+	0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6e, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x02, 0x67, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x67, 0x74, 0x12, 0x2c, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x2e, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x1a, 0xb1, 0x02, 0x0a, 0x0b, 0x46, 0x6c, 0x6f, 0x61, 0x74,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x02, 0x65, 0x71, 0x12, 0x2d, 0x0a, 0x03, 0x6e, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6e,
+	0x65, 0x71, 0x12, 0x2b, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x67, 0x74, 0x12,
+	0x2b, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c,
+	0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x02, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x2d, 0x0a, 0x03,
+	0x67, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x03, 0x6c,
+	0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x1a, 0xb1, 0x02, 0x0a, 0x0b, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x02, 0x65, 0x71,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x02, 0x65, 0x71, 0x12, 0x2d, 0x0a, 0x03, 0x6e, 0x65, 0x71, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x03, 0x6e, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x02, 0x67, 0x74, 0x12, 0x2b, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x6c, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x02, 0x69, 0x6e,
+	0x12, 0x2d, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12,
+	0x2d, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x1a, 0xf2,
+	0x02, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x2c, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x65, 0x71, 0x12, 0x2e, 0x0a,
+	0x03, 0x6e, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6e, 0x65, 0x71, 0x12, 0x2c, 0x0a,
+	0x02, 0x67, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x67, 0x74, 0x12, 0x2c, 0x0a, 0x02, 0x6c,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03,
+	0x67, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03,
+	0x6c, 0x74, 0x65, 0x1a, 0x9f, 0x02, 0x0a, 0x0f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x02, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x03, 0x6e, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x6e, 0x65,
+	0x71, 0x12, 0x2a, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x67, 0x74, 0x12, 0x2a, 0x0a,
+	0x02, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x67, 0x74, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x03, 0x6c, 0x74, 0x65, 0x1a, 0xb8, 0x02, 0x0a, 0x0c, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x02, 0x65, 0x71, 0x12, 0x2e, 0x0a, 0x03, 0x6e, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x03, 0x6e, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02,
+	0x67, 0x74, 0x12, 0x2c, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x6c, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x6e,
+	0x12, 0x2e, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x67, 0x74, 0x65,
+	0x12, 0x2e, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6c, 0x74, 0x65,
+	0x1a, 0xb8, 0x02, 0x0a, 0x0c, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x2c, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x65, 0x71, 0x12,
+	0x2e, 0x0a, 0x03, 0x6e, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6e, 0x65, 0x71, 0x12,
+	0x2c, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x67, 0x74, 0x12, 0x2c, 0x0a,
+	0x02, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x04, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x2e, 0x0a, 0x03, 0x67,
+	0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x6c,
+	0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x22, 0x3f, 0x0a, 0x12, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x2f, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xb9, 0x02,
+	0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2f, 0x0a,
+	0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19,
+	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d,
+	0x61, 0x73, 0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x3a, 0x0a,
+	0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42,
+	0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45,
+	0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x64, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x5e, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x3e, 0x0a, 0x11, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22,
+	0x2a, 0x0a, 0x12, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x72, 0x0a, 0x14, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x79, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22,
+	0xc5, 0x02, 0x0a, 0x15, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0xef, 0x01, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x73, 0x75, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x03, 0x73, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x03, 0x61, 0x76, 0x67, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x03, 0x61, 0x76, 0x67, 0x12, 0x2e, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x2e, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x4f, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x3d, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x34, 0x0a, 0x11, 0x55, 0x70, 0x73, 0x65, 0x72,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x9a, 0x01,
+	0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0d, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x3a,
+	0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x45, 0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x22, 0x5b, 0x0a, 0x15, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x22, 0x4f, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x3d, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x56, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52,
+	0x03, 0x69, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22,
+	0x12, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x81, 0x02, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x73, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x3b, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x5f, 0x0a, 0x0a,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x3b, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x38, 0x0a,
+	0x0a, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc6, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x41, 0x0a,
+	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x4d, 0x49, 0x54,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x44, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02,
+	0x22, 0x40, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x22, 0xa3, 0x01, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x41, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x54, 0x4f,
+	0x5f, 0x44, 0x45, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
+	0x4e, 0x44, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x22, 0x42, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x12, 0x0a, 0x10,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0xac, 0x01, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x29,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x43, 0x0a, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a,
+	0x37, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x49, 0x5a, 0x45, 0x5f,
+	0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x49, 0x5a, 0x45,
+	0x5f, 0x53, 0x4d, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x49, 0x5a, 0x45,
+	0x5f, 0x4c, 0x41, 0x52, 0x47, 0x45, 0x10, 0x02, 0x32, 0x95, 0x08, 0x0a, 0x11, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b,
+	0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x03, 0x47,
+	0x65, 0x74, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x49, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x06, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x04,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x48, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0b, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x6b, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x26, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x56, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5c, 0x0a, 0x19, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x30,
+	0x0a, 0x04, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x28, 0x01, 0x30, 0x01,
+	0x32, 0x84, 0x05, 0x0a, 0x0f, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1c,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a,
+	0x03, 0x47, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0f, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x37, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0f, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x50, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x0f,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x1a,
+	0x0f, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x35, 0x0a, 0x04, 0x54, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x32, 0xae, 0x03, 0x0a, 0x0c, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x12, 0x19, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x2b, 0x0a, 0x03, 0x47,
+	0x65, 0x74, 0x12, 0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x31, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x19, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x3b, 0x0a, 0x06, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x17, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x12, 0x1f, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x19, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x32, 0xe3, 0x03, 0x0a, 0x16, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x23, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x3f, 0x0a, 0x03, 0x47, 0x65,
+	0x74, 0x12, 0x20, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x45, 0x0a, 0x06, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x45, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x04, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x21, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x29, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x57, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x6f, 0x72, 0x64, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa7,
+	0x03, 0x0a, 0x11, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1e,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x6c,
+	0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x12, 0x35, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x69,
+	0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x69, 0x6c, 0x45, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x69,
+	0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x1c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x69, 0x6c, 0x45,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0b,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e,
+	0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x70, 0x0a, 0x0e, 0x50, 0x65, 0x74, 0x52,
+	0x65, 0x61, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x03, 0x47, 0x65,
+	0x74, 0x12, 0x14, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x50, 0x65, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf8, 0x01, 0x0a, 0x0f, 0x50,
+	0x65, 0x74, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2d,
+	0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x74, 0x12, 0x2d, 0x0a,
+	0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x74, 0x12, 0x39, 0x0a, 0x06,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4c, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x5f, 0x0a, 0x0b, 0x50, 0x6f, 0x6e, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6e, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6e, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe4, 0x0b, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x3d, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x42, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x43, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1b, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x3d, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4d,
+	0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x12, 0x1c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
+	0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x1c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x3d, 0x0a,
+	0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1c,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x30, 0x01, 0x12, 0x42,
+	0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x06, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x48, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x40, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x45, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x65, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x24, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x52, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x58, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xdf, 0x02,
+	0x0a, 0x0b, 0x54, 0x6f, 0x64, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2f, 0x0a,
+	0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x64, 0x6f, 0x12, 0x29,
+	0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x64, 0x6f, 0x12, 0x2f, 0x0a, 0x06, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x64, 0x6f, 0x12, 0x3a, 0x0a, 0x06, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x6f, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x64, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x6f, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4e, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1e,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x64, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x64, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0xf7, 0x0a, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x2f, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x29, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x45,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x49, 0x44, 0x12, 0x21, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x42, 0x79, 0x42, 0x55, 0x73, 0x65, 0x72, 0x31, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x42, 0x55, 0x73, 0x65, 0x72, 0x31,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x18,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x18,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x44, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x19, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x37, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x19,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x12, 0x18, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x08, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x12,
+	0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0b, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x3a, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
+	0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x3f,
+	0x0a, 0x06, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12,
+	0x38, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x39, 0x5a, 0x37, 0x65, 0x6e, 0x74,
+	0x67, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x2f, 0x65, 0x6e,
+	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x74, 0x6f, 0x64, 0x6f, 0x2f, 0x65, 0x6e, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_entpb_entpb_proto_rawDescOnce sync.Once
+	file_entpb_entpb_proto_rawDescData = file_entpb_entpb_proto_rawDesc
+)
+
+func file_entpb_entpb_proto_rawDescGZIP() []byte {
+	file_entpb_entpb_proto_rawDescOnce.Do(func() {
+		file_entpb_entpb_proto_rawDescData = protoimpl.X.CompressGZIP(file_entpb_entpb_proto_rawDescData)
+	})
+	return file_entpb_entpb_proto_rawDescData
+}
+
+var file_entpb_entpb_proto_enumTypes = make([]protoimpl.EnumInfo, 31)
+var file_entpb_entpb_proto_msgTypes = make([]protoimpl.MessageInfo, 188)
+var file_entpb_entpb_proto_goTypes = []interface{}{
+	(Size)(0),                                   // 0: entpb.Size
+	(GetAttachmentRequest_View)(0),              // 1: entpb.GetAttachmentRequest.View
+	(ListAttachmentRequest_View)(0),             // 2: entpb.ListAttachmentRequest.View
+	(GetDocumentRequest_View)(0),                // 3: entpb.GetDocumentRequest.View
+	(ListDocumentRequest_View)(0),               // 4: entpb.ListDocumentRequest.View
+	(GetLabelRequest_View)(0),                   // 5: entpb.GetLabelRequest.View
+	(ListLabelRequest_View)(0),                  // 6: entpb.ListLabelRequest.View
+	(ExportLabelRequest_Format)(0),              // 7: entpb.ExportLabelRequest.Format
+	(MultiWordSchema_Unit)(0),                   // 8: entpb.MultiWordSchema.Unit
+	(GetMultiWordSchemaRequest_View)(0),         // 9: entpb.GetMultiWordSchemaRequest.View
+	(ListMultiWordSchemaRequest_View)(0),        // 10: entpb.ListMultiWordSchemaRequest.View
+	(GetNilExampleRequest_View)(0),              // 11: entpb.GetNilExampleRequest.View
+	(ListNilExampleRequest_View)(0),             // 12: entpb.ListNilExampleRequest.View
+	(GetPetRequest_View)(0),                     // 13: entpb.GetPetRequest.View
+	(ListPetRequest_View)(0),                    // 14: entpb.ListPetRequest.View
+	(ProjectLookupRequest_View)(0),              // 15: entpb.ProjectLookupRequest.View
+	(ListProjectRequest_View)(0),                // 16: entpb.ListProjectRequest.View
+	(BatchGetProjectsRequest_View)(0),           // 17: entpb.BatchGetProjectsRequest.View
+	(ExportProjectRequest_Format)(0),            // 18: entpb.ExportProjectRequest.Format
+	(Todo_Status)(0),                            // 19: entpb.Todo.Status
+	(GetTodoRequest_View)(0),                    // 20: entpb.GetTodoRequest.View
+	(ListTodoRequest_View)(0),                   // 21: entpb.ListTodoRequest.View
+	(User_Status)(0),                            // 22: entpb.User.Status
+	(User_DeviceType)(0),                        // 23: entpb.User.DeviceType
+	(User_OmitPrefix)(0),                        // 24: entpb.User.OmitPrefix
+	(GetUserRequest_View)(0),                    // 25: entpb.GetUserRequest.View
+	(ListUserRequest_View)(0),                   // 26: entpb.ListUserRequest.View
+	(BatchGetUsersRequest_View)(0),              // 27: entpb.BatchGetUsersRequest.View
+	(ExportUserRequest_Format)(0),               // 28: entpb.ExportUserRequest.Format
+	(ImportUserRequest_Format)(0),               // 29: entpb.ImportUserRequest.Format
+	(UserEvent_Type)(0),                         // 30: entpb.UserEvent.Type
+	(*Attachment)(nil),                          // 31: entpb.Attachment
+	(*CreateAttachmentRequest)(nil),             // 32: entpb.CreateAttachmentRequest
+	(*GetAttachmentRequest)(nil),                // 33: entpb.GetAttachmentRequest
+	(*ExistsAttachmentRequest)(nil),             // 34: entpb.ExistsAttachmentRequest
+	(*ExistsAttachmentResponse)(nil),            // 35: entpb.ExistsAttachmentResponse
+	(*UpdateAttachmentRequest)(nil),             // 36: entpb.UpdateAttachmentRequest
+	(*DeleteAttachmentRequest)(nil),             // 37: entpb.DeleteAttachmentRequest
+	(*ListAttachmentRequest)(nil),               // 38: entpb.ListAttachmentRequest
+	(*ListAttachmentResponse)(nil),              // 39: entpb.ListAttachmentResponse
+	(*CountAttachmentsRequest)(nil),             // 40: entpb.CountAttachmentsRequest
+	(*CountAttachmentsResponse)(nil),            // 41: entpb.CountAttachmentsResponse
+	(*BatchCreateAttachmentsRequest)(nil),       // 42: entpb.BatchCreateAttachmentsRequest
+	(*BatchCreateAttachmentsResponse)(nil),      // 43: entpb.BatchCreateAttachmentsResponse
+	(*BatchCreateAttachmentResult)(nil),         // 44: entpb.BatchCreateAttachmentResult
+	(*BatchDeleteAttachmentsRequest)(nil),       // 45: entpb.BatchDeleteAttachmentsRequest
+	(*ListAttachmentRecipientsRequest)(nil),     // 46: entpb.ListAttachmentRecipientsRequest
+	(*ListAttachmentRecipientsResponse)(nil),    // 47: entpb.ListAttachmentRecipientsResponse
+	(*AddAttachmentRecipientRequest)(nil),       // 48: entpb.AddAttachmentRecipientRequest
+	(*RemoveAttachmentRecipientRequest)(nil),    // 49: entpb.RemoveAttachmentRecipientRequest
+	(*Document)(nil),                            // 50: entpb.Document
+	(*CreateDocumentRequest)(nil),               // 51: entpb.CreateDocumentRequest
+	(*GetDocumentRequest)(nil),                  // 52: entpb.GetDocumentRequest
+	(*UpdateDocumentRequest)(nil),               // 53: entpb.UpdateDocumentRequest
+	(*DeleteDocumentRequest)(nil),               // 54: entpb.DeleteDocumentRequest
+	(*DocumentFilter)(nil),                      // 55: entpb.DocumentFilter
+	(*DeleteDocumentsRequest)(nil),              // 56: entpb.DeleteDocumentsRequest
+	(*DeleteDocumentsResponse)(nil),             // 57: entpb.DeleteDocumentsResponse
+	(*ListDocumentRequest)(nil),                 // 58: entpb.ListDocumentRequest
+	(*ListDocumentResponse)(nil),                // 59: entpb.ListDocumentResponse
+	(*CountDocumentsRequest)(nil),               // 60: entpb.CountDocumentsRequest
+	(*CountDocumentsResponse)(nil),              // 61: entpb.CountDocumentsResponse
+	(*BatchCreateDocumentsRequest)(nil),         // 62: entpb.BatchCreateDocumentsRequest
+	(*BatchCreateDocumentsResponse)(nil),        // 63: entpb.BatchCreateDocumentsResponse
+	(*Group)(nil),                               // 64: entpb.Group
+	(*Label)(nil),                               // 65: entpb.Label
+	(*CreateLabelRequest)(nil),                  // 66: entpb.CreateLabelRequest
+	(*GetLabelRequest)(nil),                     // 67: entpb.GetLabelRequest
+	(*UpdateLabelRequest)(nil),                  // 68: entpb.UpdateLabelRequest
+	(*DeleteLabelRequest)(nil),                  // 69: entpb.DeleteLabelRequest
+	(*LabelFilter)(nil),                         // 70: entpb.LabelFilter
+	(*ListLabelRequest)(nil),                    // 71: entpb.ListLabelRequest
+	(*ListLabelResponse)(nil),                   // 72: entpb.ListLabelResponse
+	(*BatchCreateLabelsRequest)(nil),            // 73: entpb.BatchCreateLabelsRequest
+	(*BatchCreateLabelsResponse)(nil),           // 74: entpb.BatchCreateLabelsResponse
+	(*ExportLabelRequest)(nil),                  // 75: entpb.ExportLabelRequest
+	(*ExportLabelResponse)(nil),                 // 76: entpb.ExportLabelResponse
+	(*MultiWordSchema)(nil),                     // 77: entpb.MultiWordSchema
+	(*CreateMultiWordSchemaRequest)(nil),        // 78: entpb.CreateMultiWordSchemaRequest
+	(*GetMultiWordSchemaRequest)(nil),           // 79: entpb.GetMultiWordSchemaRequest
+	(*UpdateMultiWordSchemaRequest)(nil),        // 80: entpb.UpdateMultiWordSchemaRequest
+	(*DeleteMultiWordSchemaRequest)(nil),        // 81: entpb.DeleteMultiWordSchemaRequest
+	(*MultiWordSchemaFilter)(nil),               // 82: entpb.MultiWordSchemaFilter
+	(*ListMultiWordSchemaRequest)(nil),          // 83: entpb.ListMultiWordSchemaRequest
+	(*ListMultiWordSchemaResponse)(nil),         // 84: entpb.ListMultiWordSchemaResponse
+	(*BatchCreateMultiWordSchemasRequest)(nil),  // 85: entpb.BatchCreateMultiWordSchemasRequest
+	(*BatchCreateMultiWordSchemasResponse)(nil), // 86: entpb.BatchCreateMultiWordSchemasResponse
+	(*NilExample)(nil),                          // 87: entpb.NilExample
+	(*CreateNilExampleRequest)(nil),             // 88: entpb.CreateNilExampleRequest
+	(*GetNilExampleRequest)(nil),                // 89: entpb.GetNilExampleRequest
+	(*UpdateNilExampleRequest)(nil),             // 90: entpb.UpdateNilExampleRequest
+	(*DeleteNilExampleRequest)(nil),             // 91: entpb.DeleteNilExampleRequest
+	(*NilExampleFilter)(nil),                    // 92: entpb.NilExampleFilter
+	(*ListNilExampleRequest)(nil),               // 93: entpb.ListNilExampleRequest
+	(*ListNilExampleResponse)(nil),              // 94: entpb.ListNilExampleResponse
+	(*BatchCreateNilExamplesRequest)(nil),       // 95: entpb.BatchCreateNilExamplesRequest
+	(*BatchCreateNilExamplesResponse)(nil),      // 96: entpb.BatchCreateNilExamplesResponse
+	(*Pet)(nil),                                 // 97: entpb.Pet
+	(*CreatePetRequest)(nil),                    // 98: entpb.CreatePetRequest
+	(*GetPetRequest)(nil),                       // 99: entpb.GetPetRequest
+	(*UpdatePetRequest)(nil),                    // 100: entpb.UpdatePetRequest
+	(*DeletePetRequest)(nil),                    // 101: entpb.DeletePetRequest
+	(*PetFilter)(nil),                           // 102: entpb.PetFilter
+	(*ListPetRequest)(nil),                      // 103: entpb.ListPetRequest
+	(*ListPetResponse)(nil),                     // 104: entpb.ListPetResponse
+	(*BatchCreatePetsRequest)(nil),              // 105: entpb.BatchCreatePetsRequest
+	(*BatchCreatePetsResponse)(nil),             // 106: entpb.BatchCreatePetsResponse
+	(*Pony)(nil),                                // 107: entpb.Pony
+	(*CreatePonyRequest)(nil),                   // 108: entpb.CreatePonyRequest
+	(*BatchCreatePoniesRequest)(nil),            // 109: entpb.BatchCreatePoniesRequest
+	(*BatchCreatePoniesResponse)(nil),           // 110: entpb.BatchCreatePoniesResponse
+	(*Project)(nil),                             // 111: entpb.Project
+	(*ProjectEdgeIds)(nil),                      // 112: entpb.ProjectEdgeIds
+	(*CreateProjectRequest)(nil),                // 113: entpb.CreateProjectRequest
+	(*ProjectLookupRequest)(nil),                // 114: entpb.ProjectLookupRequest
+	(*GetProjectResponse)(nil),                  // 115: entpb.GetProjectResponse
+	(*GetProjectByNameRequest)(nil),             // 116: entpb.GetProjectByNameRequest
+	(*ExistsProjectRequest)(nil),                // 117: entpb.ExistsProjectRequest
+	(*ExistsProjectResponse)(nil),               // 118: entpb.ExistsProjectResponse
+	(*UpdateProjectRequest)(nil),                // 119: entpb.UpdateProjectRequest
+	(*DeleteProjectRequest)(nil),                // 120: entpb.DeleteProjectRequest
+	(*ProjectFilter)(nil),                       // 121: entpb.ProjectFilter
+	(*DeleteProjectsRequest)(nil),               // 122: entpb.DeleteProjectsRequest
+	(*DeleteProjectsResponse)(nil),              // 123: entpb.DeleteProjectsResponse
+	(*RestoreProjectRequest)(nil),               // 124: entpb.RestoreProjectRequest
+	(*ListProjectRequest)(nil),                  // 125: entpb.ListProjectRequest
+	(*ListProjectResponse)(nil),                 // 126: entpb.ListProjectResponse
+	(*StreamProjectsRequest)(nil),               // 127: entpb.StreamProjectsRequest
+	(*CountProjectsRequest)(nil),                // 128: entpb.CountProjectsRequest
+	(*CountProjectsResponse)(nil),               // 129: entpb.CountProjectsResponse
+	(*AggregateProjectRequest)(nil),             // 130: entpb.AggregateProjectRequest
+	(*AggregateProjectResponse)(nil),            // 131: entpb.AggregateProjectResponse
+	(*BatchCreateProjectsRequest)(nil),          // 132: entpb.BatchCreateProjectsRequest
+	(*BatchCreateProjectsResponse)(nil),         // 133: entpb.BatchCreateProjectsResponse
+	(*UpsertProjectRequest)(nil),                // 134: entpb.UpsertProjectRequest
+	(*BatchGetProjectsRequest)(nil),             // 135: entpb.BatchGetProjectsRequest
+	(*ProjectBatch)(nil),                        // 136: entpb.ProjectBatch
+	(*BatchDeleteProjectsRequest)(nil),          // 137: entpb.BatchDeleteProjectsRequest
+	(*StatsProjectRequest)(nil),                 // 138: entpb.StatsProjectRequest
+	(*StatsProjectResponse)(nil),                // 139: entpb.StatsProjectResponse
+	(*ExportProjectRequest)(nil),                // 140: entpb.ExportProjectRequest
+	(*ExportProjectResponse)(nil),               // 141: entpb.ExportProjectResponse
+	(*ListProjectAttachmentsRequest)(nil),       // 142: entpb.ListProjectAttachmentsRequest
+	(*ListProjectAttachmentsResponse)(nil),      // 143: entpb.ListProjectAttachmentsResponse
+	(*AddProjectAttachmentRequest)(nil),         // 144: entpb.AddProjectAttachmentRequest
+	(*RemoveProjectAttachmentRequest)(nil),      // 145: entpb.RemoveProjectAttachmentRequest
+	(*Todo)(nil),                                // 146: entpb.Todo
+	(*CreateTodoRequest)(nil),                   // 147: entpb.CreateTodoRequest
+	(*GetTodoRequest)(nil),                      // 148: entpb.GetTodoRequest
+	(*UpdateTodoRequest)(nil),                   // 149: entpb.UpdateTodoRequest
+	(*DeleteTodoRequest)(nil),                   // 150: entpb.DeleteTodoRequest
+	(*TodoFilter)(nil),                          // 151: entpb.TodoFilter
+	(*ListTodoRequest)(nil),                     // 152: entpb.ListTodoRequest
+	(*ListTodoResponse)(nil),                    // 153: entpb.ListTodoResponse
+	(*BatchCreateTodosRequest)(nil),             // 154: entpb.BatchCreateTodosRequest
+	(*BatchCreateTodosResponse)(nil),            // 155: entpb.BatchCreateTodosResponse
+	(*User)(nil),                                // 156: entpb.User
+	(*CreateUserRequest)(nil),                   // 157: entpb.CreateUserRequest
+	(*GetUserRequest)(nil),                      // 158: entpb.GetUserRequest
+	(*GetUserByUserNameRequest)(nil),            // 159: entpb.GetUserByUserNameRequest
+	(*GetUserByExternalIDRequest)(nil),          // 160: entpb.GetUserByExternalIDRequest
+	(*GetUserByBUser1Request)(nil),              // 161: entpb.GetUserByBUser1Request
+	(*ExistsUserRequest)(nil),                   // 162: entpb.ExistsUserRequest
+	(*ExistsUserResponse)(nil),                  // 163: entpb.ExistsUserResponse
+	(*UpdateUserRequest)(nil),                   // 164: entpb.UpdateUserRequest
+	(*DeleteUserRequest)(nil),                   // 165: entpb.DeleteUserRequest
+	(*UserFilter)(nil),                          // 166: entpb.UserFilter
+	(*DeleteUsersRequest)(nil),                  // 167: entpb.DeleteUsersRequest
+	(*DeleteUsersResponse)(nil),                 // 168: entpb.DeleteUsersResponse
+	(*ListUserRequest)(nil),                     // 169: entpb.ListUserRequest
+	(*ListUserResponse)(nil),                    // 170: entpb.ListUserResponse
+	(*StreamUsersRequest)(nil),                  // 171: entpb.StreamUsersRequest
+	(*CountUsersRequest)(nil),                   // 172: entpb.CountUsersRequest
+	(*CountUsersResponse)(nil),                  // 173: entpb.CountUsersResponse
+	(*AggregateUserRequest)(nil),                // 174: entpb.AggregateUserRequest
+	(*AggregateUserResponse)(nil),               // 175: entpb.AggregateUserResponse
+	(*BatchCreateUsersRequest)(nil),             // 176: entpb.BatchCreateUsersRequest
+	(*BatchCreateUsersResponse)(nil),            // 177: entpb.BatchCreateUsersResponse
+	(*UpsertUserRequest)(nil),                   // 178: entpb.UpsertUserRequest
+	(*BatchGetUsersRequest)(nil),                // 179: entpb.BatchGetUsersRequest
+	(*BatchGetUsersResponse)(nil),               // 180: entpb.BatchGetUsersResponse
+	(*BatchUpdateUsersRequest)(nil),             // 181: entpb.BatchUpdateUsersRequest
+	(*BatchUpdateUsersResponse)(nil),            // 182: entpb.BatchUpdateUsersResponse
+	(*BatchDeleteUsersRequest)(nil),             // 183: entpb.BatchDeleteUsersRequest
+	(*StatsUserRequest)(nil),                    // 184: entpb.StatsUserRequest
+	(*StatsUserResponse)(nil),                   // 185: entpb.StatsUserResponse
+	(*ExportUserRequest)(nil),                   // 186: entpb.ExportUserRequest
+	(*ExportUserResponse)(nil),                  // 187: entpb.ExportUserResponse
+	(*ImportUserRequest)(nil),                   // 188: entpb.ImportUserRequest
+	(*ImportUserResponse)(nil),                  // 189: entpb.ImportUserResponse
+	(*WatchUserRequest)(nil),                    // 190: entpb.WatchUserRequest
+	(*UserEvent)(nil),                           // 191: entpb.UserEvent
+	(*DocumentFilter_Int64Filter)(nil),          // 192: entpb.DocumentFilter.Int64Filter
+	(*DocumentFilter_StringFilter)(nil),         // 193: entpb.DocumentFilter.StringFilter
+	(*LabelFilter_StringFilter)(nil),            // 194: entpb.LabelFilter.StringFilter
+	(*MultiWordSchemaFilter_Int64Filter)(nil),   // 195: entpb.MultiWordSchemaFilter.Int64Filter
+	(*NilExampleFilter_Int64Filter)(nil),        // 196: entpb.NilExampleFilter.Int64Filter
+	(*NilExampleFilter_StringFilter)(nil),       // 197: entpb.NilExampleFilter.StringFilter
+	(*NilExampleFilter_TimestampFilter)(nil),    // 198: entpb.NilExampleFilter.TimestampFilter
+	(*PetFilter_Int64Filter)(nil),               // 199: entpb.PetFilter.Int64Filter
+	(*ProjectFilter_Int64Filter)(nil),           // 200: entpb.ProjectFilter.Int64Filter
+	(*ProjectFilter_StringFilter)(nil),          // 201: entpb.ProjectFilter.StringFilter
+	(*ProjectFilter_TimestampFilter)(nil),       // 202: entpb.ProjectFilter.TimestampFilter
+	(*AggregateProjectResponse_Group)(nil),      // 203: entpb.AggregateProjectResponse.Group
+	(*StatsProjectResponse_FieldStats)(nil),     // 204: entpb.StatsProjectResponse.FieldStats
+	(*StatsProjectResponse_ValueCount)(nil),     // 205: entpb.StatsProjectResponse.ValueCount
+	(*TodoFilter_Int64Filter)(nil),              // 206: entpb.TodoFilter.Int64Filter
+	(*TodoFilter_StringFilter)(nil),             // 207: entpb.TodoFilter.StringFilter
+	(*UserFilter_BoolFilter)(nil),               // 208: entpb.UserFilter.BoolFilter
+	(*UserFilter_DoubleFilter)(nil),             // 209: entpb.UserFilter.DoubleFilter
+	(*UserFilter_FloatFilter)(nil),              // 210: entpb.UserFilter.FloatFilter
+	(*UserFilter_Int64Filter)(nil),              // 211: entpb.UserFilter.Int64Filter
+	(*UserFilter_StringFilter)(nil),             // 212: entpb.UserFilter.StringFilter
+	(*UserFilter_TimestampFilter)(nil),          // 213: entpb.UserFilter.TimestampFilter
+	(*UserFilter_UInt32Filter)(nil),             // 214: entpb.UserFilter.UInt32Filter
+	(*UserFilter_UInt64Filter)(nil),             // 215: entpb.UserFilter.UInt64Filter
+	(*AggregateUserResponse_Group)(nil),         // 216: entpb.AggregateUserResponse.Group
+	(*StatsUserResponse_FieldStats)(nil),        // 217: entpb.StatsUserResponse.FieldStats
+	(*StatsUserResponse_ValueCount)(nil),        // 218: entpb.StatsUserResponse.ValueCount
+	(*status.Status)(nil),                       // 219: google.rpc.Status
+	(*wrapperspb.StringValue)(nil),              // 220: google.protobuf.StringValue
+	(*timestamppb.Timestamp)(nil),               // 221: google.protobuf.Timestamp
+	(*wrapperspb.Int32Value)(nil),               // 222: google.protobuf.Int32Value
+	(common.Priority)(0),                        // 223: common.Priority
+	(*wrapperspb.Int64Value)(nil),               // 224: google.protobuf.Int64Value
+	(*wrapperspb.BoolValue)(nil),                // 225: google.protobuf.BoolValue
+	(*fieldmaskpb.FieldMask)(nil),               // 226: google.protobuf.FieldMask
+	(*wrapperspb.DoubleValue)(nil),              // 227: google.protobuf.DoubleValue
+	(*wrapperspb.FloatValue)(nil),               // 228: google.protobuf.FloatValue
+	(*wrapperspb.UInt32Value)(nil),              // 229: google.protobuf.UInt32Value
+	(*wrapperspb.UInt64Value)(nil),              // 230: google.protobuf.UInt64Value
+	(*emptypb.Empty)(nil),                       // 231: google.protobuf.Empty
+}
+var file_entpb_entpb_proto_depIdxs = []int32{
+	156, // 0: entpb.Attachment.user:type_name -> entpb.User
+	156, // 1: entpb.Attachment.recipients:type_name -> entpb.User
+	31,  // 2: entpb.CreateAttachmentRequest.attachment:type_name -> entpb.Attachment
+	1,   // 3: entpb.GetAttachmentRequest.view:type_name -> entpb.GetAttachmentRequest.View
+	31,  // 4: entpb.UpdateAttachmentRequest.attachment:type_name -> entpb.Attachment
+	2,   // 5: entpb.ListAttachmentRequest.view:type_name -> entpb.ListAttachmentRequest.View
+	31,  // 6: entpb.ListAttachmentResponse.attachment_list:type_name -> entpb.Attachment
+	32,  // 7: entpb.BatchCreateAttachmentsRequest.requests:type_name -> entpb.CreateAttachmentRequest
+	44,  // 8: entpb.BatchCreateAttachmentsResponse.results:type_name -> entpb.BatchCreateAttachmentResult
+	31,  // 9: entpb.BatchCreateAttachmentResult.attachment:type_name -> entpb.Attachment
+	219, // 10: entpb.BatchCreateAttachmentResult.status:type_name -> google.rpc.Status
+	220, // 11: entpb.Document.request_id:type_name -> google.protobuf.StringValue
+	50,  // 12: entpb.CreateDocumentRequest.document:type_name -> entpb.Document
+	3,   // 13: entpb.GetDocumentRequest.view:type_name -> entpb.GetDocumentRequest.View
+	50,  // 14: entpb.UpdateDocumentRequest.document:type_name -> entpb.Document
+	192, // 15: entpb.DocumentFilter.id:type_name -> entpb.DocumentFilter.Int64Filter
+	192, // 16: entpb.DocumentFilter.tenant_id:type_name -> entpb.DocumentFilter.Int64Filter
+	193, // 17: entpb.DocumentFilter.title:type_name -> entpb.DocumentFilter.StringFilter
+	193, // 18: entpb.DocumentFilter.request_id:type_name -> entpb.DocumentFilter.StringFilter
+	55,  // 19: entpb.DeleteDocumentsRequest.filter:type_name -> entpb.DocumentFilter
+	4,   // 20: entpb.ListDocumentRequest.view:type_name -> entpb.ListDocumentRequest.View
+	55,  // 21: entpb.ListDocumentRequest.filter:type_name -> entpb.DocumentFilter
+	50,  // 22: entpb.ListDocumentResponse.document_list:type_name -> entpb.Document
+	55,  // 23: entpb.CountDocumentsRequest.filter:type_name -> entpb.DocumentFilter
+	51,  // 24: entpb.BatchCreateDocumentsRequest.requests:type_name -> entpb.CreateDocumentRequest
+	50,  // 25: entpb.BatchCreateDocumentsResponse.documents:type_name -> entpb.Document
+	156, // 26: entpb.Group.users:type_name -> entpb.User
+	65,  // 27: entpb.CreateLabelRequest.label:type_name -> entpb.Label
+	5,   // 28: entpb.GetLabelRequest.view:type_name -> entpb.GetLabelRequest.View
+	65,  // 29: entpb.UpdateLabelRequest.label:type_name -> entpb.Label
+	194, // 30: entpb.LabelFilter.id:type_name -> entpb.LabelFilter.StringFilter
+	194, // 31: entpb.LabelFilter.color:type_name -> entpb.LabelFilter.StringFilter
+	6,   // 32: entpb.ListLabelRequest.view:type_name -> entpb.ListLabelRequest.View
+	70,  // 33: entpb.ListLabelRequest.filter:type_name -> entpb.LabelFilter
+	65,  // 34: entpb.ListLabelResponse.label_list:type_name -> entpb.Label
+	66,  // 35: entpb.BatchCreateLabelsRequest.requests:type_name -> entpb.CreateLabelRequest
+	65,  // 36: entpb.BatchCreateLabelsResponse.labels:type_name -> entpb.Label
+	7,   // 37: entpb.ExportLabelRequest.format:type_name -> entpb.ExportLabelRequest.Format
+	8,   // 38: entpb.MultiWordSchema.unit:type_name -> entpb.MultiWordSchema.Unit
+	77,  // 39: entpb.CreateMultiWordSchemaRequest.multi_word_schema:type_name -> entpb.MultiWordSchema
+	9,   // 40: entpb.GetMultiWordSchemaRequest.view:type_name -> entpb.GetMultiWordSchemaRequest.View
+	77,  // 41: entpb.UpdateMultiWordSchemaRequest.multi_word_schema:type_name -> entpb.MultiWordSchema
+	195, // 42: entpb.MultiWordSchemaFilter.id:type_name -> entpb.MultiWordSchemaFilter.Int64Filter
+	10,  // 43: entpb.ListMultiWordSchemaRequest.view:type_name -> entpb.ListMultiWordSchemaRequest.View
+	82,  // 44: entpb.ListMultiWordSchemaRequest.filter:type_name -> entpb.MultiWordSchemaFilter
+	77,  // 45: entpb.ListMultiWordSchemaResponse.multi_word_schema_list:type_name -> entpb.MultiWordSchema
+	78,  // 46: entpb.BatchCreateMultiWordSchemasRequest.requests:type_name -> entpb.CreateMultiWordSchemaRequest
+	77,  // 47: entpb.BatchCreateMultiWordSchemasResponse.multi_word_schemas:type_name -> entpb.MultiWordSchema
+	220, // 48: entpb.NilExample.str_nil:type_name -> google.protobuf.StringValue
+	221, // 49: entpb.NilExample.time_nil:type_name -> google.protobuf.Timestamp
+	220, // 50: entpb.NilExample.time_str_nil:type_name -> google.protobuf.StringValue
+	87,  // 51: entpb.CreateNilExampleRequest.nil_example:type_name -> entpb.NilExample
+	11,  // 52: entpb.GetNilExampleRequest.view:type_name -> entpb.GetNilExampleRequest.View
+	87,  // 53: entpb.UpdateNilExampleRequest.nil_example:type_name -> entpb.NilExample
+	196, // 54: entpb.NilExampleFilter.id:type_name -> entpb.NilExampleFilter.Int64Filter
+	197, // 55: entpb.NilExampleFilter.str_nil:type_name -> entpb.NilExampleFilter.StringFilter
+	198, // 56: entpb.NilExampleFilter.time_nil:type_name -> entpb.NilExampleFilter.TimestampFilter
+	12,  // 57: entpb.ListNilExampleRequest.view:type_name -> entpb.ListNilExampleRequest.View
+	92,  // 58: entpb.ListNilExampleRequest.filter:type_name -> entpb.NilExampleFilter
+	87,  // 59: entpb.ListNilExampleResponse.nil_example_list:type_name -> entpb.NilExample
+	88,  // 60: entpb.BatchCreateNilExamplesRequest.requests:type_name -> entpb.CreateNilExampleRequest
+	87,  // 61: entpb.BatchCreateNilExamplesResponse.nil_examples:type_name -> entpb.NilExample
+	0,   // 62: entpb.Pet.size:type_name -> entpb.Size
+	156, // 63: entpb.Pet.owner:type_name -> entpb.User
+	31,  // 64: entpb.Pet.attachment:type_name -> entpb.Attachment
+	97,  // 65: entpb.CreatePetRequest.pet:type_name -> entpb.Pet
+	13,  // 66: entpb.GetPetRequest.view:type_name -> entpb.GetPetRequest.View
+	97,  // 67: entpb.UpdatePetRequest.pet:type_name -> entpb.Pet
+	199, // 68: entpb.PetFilter.id:type_name -> entpb.PetFilter.Int64Filter
+	14,  // 69: entpb.ListPetRequest.view:type_name -> entpb.ListPetRequest.View
+	102, // 70: entpb.ListPetRequest.filter:type_name -> entpb.PetFilter
+	97,  // 71: entpb.ListPetResponse.pet_list:type_name -> entpb.Pet
+	98,  // 72: entpb.BatchCreatePetsRequest.requests:type_name -> entpb.CreatePetRequest
+	97,  // 73: entpb.BatchCreatePetsResponse.pets:type_name -> entpb.Pet
+	0,   // 74: entpb.Pony.size:type_name -> entpb.Size
+	222, // 75: entpb.Pony.rank:type_name -> google.protobuf.Int32Value
+	223, // 76: entpb.Pony.priority:type_name -> common.Priority
+	107, // 77: entpb.CreatePonyRequest.pony:type_name -> entpb.Pony
+	108, // 78: entpb.BatchCreatePoniesRequest.requests:type_name -> entpb.CreatePonyRequest
+	107, // 79: entpb.BatchCreatePoniesResponse.ponies:type_name -> entpb.Pony
+	221, // 80: entpb.Project.deleted_at:type_name -> google.protobuf.Timestamp
+	223, // 81: entpb.Project.priority:type_name -> common.Priority
+	111, // 82: entpb.CreateProjectRequest.project:type_name -> entpb.Project
+	112, // 83: entpb.CreateProjectRequest.edge_ids:type_name -> entpb.ProjectEdgeIds
+	15,  // 84: entpb.ProjectLookupRequest.view:type_name -> entpb.ProjectLookupRequest.View
+	111, // 85: entpb.GetProjectResponse.project:type_name -> entpb.Project
+	112, // 86: entpb.GetProjectResponse.edge_ids:type_name -> entpb.ProjectEdgeIds
+	111, // 87: entpb.UpdateProjectRequest.project:type_name -> entpb.Project
+	112, // 88: entpb.UpdateProjectRequest.edge_ids:type_name -> entpb.ProjectEdgeIds
+	200, // 89: entpb.ProjectFilter.id:type_name -> entpb.ProjectFilter.Int64Filter
+	201, // 90: entpb.ProjectFilter.name:type_name -> entpb.ProjectFilter.StringFilter
+	202, // 91: entpb.ProjectFilter.deleted_at:type_name -> entpb.ProjectFilter.TimestampFilter
+	200, // 92: entpb.ProjectFilter.version:type_name -> entpb.ProjectFilter.Int64Filter
+	121, // 93: entpb.DeleteProjectsRequest.filter:type_name -> entpb.ProjectFilter
+	16,  // 94: entpb.ListProjectRequest.view:type_name -> entpb.ListProjectRequest.View
+	111, // 95: entpb.ListProjectResponse.project_list:type_name -> entpb.Project
+	112, // 96: entpb.ListProjectResponse.edge_ids:type_name -> entpb.ProjectEdgeIds
+	121, // 97: entpb.StreamProjectsRequest.filter:type_name -> entpb.ProjectFilter
+	121, // 98: entpb.CountProjectsRequest.filter:type_name -> entpb.ProjectFilter
+	121, // 99: entpb.AggregateProjectRequest.filter:type_name -> entpb.ProjectFilter
+	203, // 100: entpb.AggregateProjectResponse.groups:type_name -> entpb.AggregateProjectResponse.Group
+	113, // 101: entpb.BatchCreateProjectsRequest.requests:type_name -> entpb.CreateProjectRequest
+	111, // 102: entpb.BatchCreateProjectsResponse.projects:type_name -> entpb.Project
+	111, // 103: entpb.UpsertProjectRequest.project:type_name -> entpb.Project
+	112, // 104: entpb.UpsertProjectRequest.edge_ids:type_name -> entpb.ProjectEdgeIds
+	17,  // 105: entpb.BatchGetProjectsRequest.view:type_name -> entpb.BatchGetProjectsRequest.View
+	111, // 106: entpb.ProjectBatch.projects:type_name -> entpb.Project
+	112, // 107: entpb.ProjectBatch.edge_ids:type_name -> entpb.ProjectEdgeIds
+	121, // 108: entpb.BatchDeleteProjectsRequest.filter:type_name -> entpb.ProjectFilter
+	204, // 109: entpb.StatsProjectResponse.fields:type_name -> entpb.StatsProjectResponse.FieldStats
+	18,  // 110: entpb.ExportProjectRequest.format:type_name -> entpb.ExportProjectRequest.Format
+	19,  // 111: entpb.Todo.status:type_name -> entpb.Todo.Status
+	156, // 112: entpb.Todo.user:type_name -> entpb.User
+	146, // 113: entpb.CreateTodoRequest.todo:type_name -> entpb.Todo
+	20,  // 114: entpb.GetTodoRequest.view:type_name -> entpb.GetTodoRequest.View
+	146, // 115: entpb.UpdateTodoRequest.todo:type_name -> entpb.Todo
+	206, // 116: entpb.TodoFilter.id:type_name -> entpb.TodoFilter.Int64Filter
+	207, // 117: entpb.TodoFilter.task:type_name -> entpb.TodoFilter.StringFilter
+	21,  // 118: entpb.ListTodoRequest.view:type_name -> entpb.ListTodoRequest.View
+	151, // 119: entpb.ListTodoRequest.filter:type_name -> entpb.TodoFilter
+	146, // 120: entpb.ListTodoResponse.todo_list:type_name -> entpb.Todo
+	147, // 121: entpb.BatchCreateTodosRequest.requests:type_name -> entpb.CreateTodoRequest
+	146, // 122: entpb.BatchCreateTodosResponse.todos:type_name -> entpb.Todo
+	221, // 123: entpb.User.joined:type_name -> google.protobuf.Timestamp
+	22,  // 124: entpb.User.status:type_name -> entpb.User.Status
+	224, // 125: entpb.User.opt_num:type_name -> google.protobuf.Int64Value
+	220, // 126: entpb.User.opt_str:type_name -> google.protobuf.StringValue
+	225, // 127: entpb.User.opt_bool:type_name -> google.protobuf.BoolValue
+	220, // 128: entpb.User.big_int:type_name -> google.protobuf.StringValue
+	224, // 129: entpb.User.b_user_1:type_name -> google.protobuf.Int64Value
+	220, // 130: entpb.User.type:type_name -> google.protobuf.StringValue
+	23,  // 131: entpb.User.device_type:type_name -> entpb.User.DeviceType
+	221, // 132: entpb.User.created_at:type_name -> google.protobuf.Timestamp
+	24,  // 133: entpb.User.omit_prefix:type_name -> entpb.User.OmitPrefix
+	64,  // 134: entpb.User.group:type_name -> entpb.Group
+	31,  // 135: entpb.User.attachment:type_name -> entpb.Attachment
+	31,  // 136: entpb.User.received_1:type_name -> entpb.Attachment
+	97,  // 137: entpb.User.pet:type_name -> entpb.Pet
+	156, // 138: entpb.CreateUserRequest.user:type_name -> entpb.User
+	25,  // 139: entpb.GetUserRequest.view:type_name -> entpb.GetUserRequest.View
+	226, // 140: entpb.GetUserRequest.read_mask:type_name -> google.protobuf.FieldMask
+	156, // 141: entpb.UpdateUserRequest.user:type_name -> entpb.User
+	226, // 142: entpb.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	214, // 143: entpb.UserFilter.id:type_name -> entpb.UserFilter.UInt32Filter
+	212, // 144: entpb.UserFilter.user_name:type_name -> entpb.UserFilter.StringFilter
+	213, // 145: entpb.UserFilter.joined:type_name -> entpb.UserFilter.TimestampFilter
+	214, // 146: entpb.UserFilter.points:type_name -> entpb.UserFilter.UInt32Filter
+	215, // 147: entpb.UserFilter.exp:type_name -> entpb.UserFilter.UInt64Filter
+	211, // 148: entpb.UserFilter.external_id:type_name -> entpb.UserFilter.Int64Filter
+	208, // 149: entpb.UserFilter.banned:type_name -> entpb.UserFilter.BoolFilter
+	211, // 150: entpb.UserFilter.opt_num:type_name -> entpb.UserFilter.Int64Filter
+	212, // 151: entpb.UserFilter.opt_str:type_name -> entpb.UserFilter.StringFilter
+	208, // 152: entpb.UserFilter.opt_bool:type_name -> entpb.UserFilter.BoolFilter
+	211, // 153: entpb.UserFilter.b_user_1:type_name -> entpb.UserFilter.Int64Filter
+	210, // 154: entpb.UserFilter.height_in_cm:type_name -> entpb.UserFilter.FloatFilter
+	209, // 155: entpb.UserFilter.account_balance:type_name -> entpb.UserFilter.DoubleFilter
+	212, // 156: entpb.UserFilter.type:type_name -> entpb.UserFilter.StringFilter
+	213, // 157: entpb.UserFilter.created_at:type_name -> entpb.UserFilter.TimestampFilter
+	166, // 158: entpb.DeleteUsersRequest.filter:type_name -> entpb.UserFilter
+	26,  // 159: entpb.ListUserRequest.view:type_name -> entpb.ListUserRequest.View
+	166, // 160: entpb.ListUserRequest.filter:type_name -> entpb.UserFilter
+	226, // 161: entpb.ListUserRequest.read_mask:type_name -> google.protobuf.FieldMask
+	156, // 162: entpb.ListUserResponse.user_list:type_name -> entpb.User
+	166, // 163: entpb.StreamUsersRequest.filter:type_name -> entpb.UserFilter
+	166, // 164: entpb.CountUsersRequest.filter:type_name -> entpb.UserFilter
+	166, // 165: entpb.AggregateUserRequest.filter:type_name -> entpb.UserFilter
+	216, // 166: entpb.AggregateUserResponse.groups:type_name -> entpb.AggregateUserResponse.Group
+	157, // 167: entpb.BatchCreateUsersRequest.requests:type_name -> entpb.CreateUserRequest
+	156, // 168: entpb.BatchCreateUsersResponse.users:type_name -> entpb.User
+	156, // 169: entpb.UpsertUserRequest.user:type_name -> entpb.User
+	27,  // 170: entpb.BatchGetUsersRequest.view:type_name -> entpb.BatchGetUsersRequest.View
+	156, // 171: entpb.BatchGetUsersResponse.users:type_name -> entpb.User
+	164, // 172: entpb.BatchUpdateUsersRequest.requests:type_name -> entpb.UpdateUserRequest
+	156, // 173: entpb.BatchUpdateUsersResponse.users:type_name -> entpb.User
+	166, // 174: entpb.BatchDeleteUsersRequest.filter:type_name -> entpb.UserFilter
+	217, // 175: entpb.StatsUserResponse.fields:type_name -> entpb.StatsUserResponse.FieldStats
+	28,  // 176: entpb.ExportUserRequest.format:type_name -> entpb.ExportUserRequest.Format
+	29,  // 177: entpb.ImportUserRequest.format:type_name -> entpb.ImportUserRequest.Format
+	30,  // 178: entpb.UserEvent.type:type_name -> entpb.UserEvent.Type
+	156, // 179: entpb.UserEvent.user:type_name -> entpb.User
+	224, // 180: entpb.DocumentFilter.Int64Filter.eq:type_name -> google.protobuf.Int64Value
+	224, // 181: entpb.DocumentFilter.Int64Filter.neq:type_name -> google.protobuf.Int64Value
+	224, // 182: entpb.DocumentFilter.Int64Filter.gt:type_name -> google.protobuf.Int64Value
+	224, // 183: entpb.DocumentFilter.Int64Filter.lt:type_name -> google.protobuf.Int64Value
+	224, // 184: entpb.DocumentFilter.Int64Filter.gte:type_name -> google.protobuf.Int64Value
+	224, // 185: entpb.DocumentFilter.Int64Filter.lte:type_name -> google.protobuf.Int64Value
+	220, // 186: entpb.DocumentFilter.StringFilter.eq:type_name -> google.protobuf.StringValue
+	220, // 187: entpb.DocumentFilter.StringFilter.neq:type_name -> google.protobuf.StringValue
+	220, // 188: entpb.DocumentFilter.StringFilter.gt:type_name -> google.protobuf.StringValue
+	220, // 189: entpb.DocumentFilter.StringFilter.lt:type_name -> google.protobuf.StringValue
+	220, // 190: entpb.DocumentFilter.StringFilter.contains:type_name -> google.protobuf.StringValue
+	220, // 191: entpb.DocumentFilter.StringFilter.gte:type_name -> google.protobuf.StringValue
+	220, // 192: entpb.DocumentFilter.StringFilter.lte:type_name -> google.protobuf.StringValue
+	220, // 193: entpb.LabelFilter.StringFilter.eq:type_name -> google.protobuf.StringValue
+	220, // 194: entpb.LabelFilter.StringFilter.neq:type_name -> google.protobuf.StringValue
+	220, // 195: entpb.LabelFilter.StringFilter.gt:type_name -> google.protobuf.StringValue
+	220, // 196: entpb.LabelFilter.StringFilter.lt:type_name -> google.protobuf.StringValue
+	220, // 197: entpb.LabelFilter.StringFilter.contains:type_name -> google.protobuf.StringValue
+	220, // 198: entpb.LabelFilter.StringFilter.gte:type_name -> google.protobuf.StringValue
+	220, // 199: entpb.LabelFilter.StringFilter.lte:type_name -> google.protobuf.StringValue
+	224, // 200: entpb.MultiWordSchemaFilter.Int64Filter.eq:type_name -> google.protobuf.Int64Value
+	224, // 201: entpb.MultiWordSchemaFilter.Int64Filter.neq:type_name -> google.protobuf.Int64Value
+	224, // 202: entpb.MultiWordSchemaFilter.Int64Filter.gt:type_name -> google.protobuf.Int64Value
+	224, // 203: entpb.MultiWordSchemaFilter.Int64Filter.lt:type_name -> google.protobuf.Int64Value
+	224, // 204: entpb.MultiWordSchemaFilter.Int64Filter.gte:type_name -> google.protobuf.Int64Value
+	224, // 205: entpb.MultiWordSchemaFilter.Int64Filter.lte:type_name -> google.protobuf.Int64Value
+	224, // 206: entpb.NilExampleFilter.Int64Filter.eq:type_name -> google.protobuf.Int64Value
+	224, // 207: entpb.NilExampleFilter.Int64Filter.neq:type_name -> google.protobuf.Int64Value
+	224, // 208: entpb.NilExampleFilter.Int64Filter.gt:type_name -> google.protobuf.Int64Value
+	224, // 209: entpb.NilExampleFilter.Int64Filter.lt:type_name -> google.protobuf.Int64Value
+	224, // 210: entpb.NilExampleFilter.Int64Filter.gte:type_name -> google.protobuf.Int64Value
+	224, // 211: entpb.NilExampleFilter.Int64Filter.lte:type_name -> google.protobuf.Int64Value
+	220, // 212: entpb.NilExampleFilter.StringFilter.eq:type_name -> google.protobuf.StringValue
+	220, // 213: entpb.NilExampleFilter.StringFilter.neq:type_name -> google.protobuf.StringValue
+	220, // 214: entpb.NilExampleFilter.StringFilter.gt:type_name -> google.protobuf.StringValue
+	220, // 215: entpb.NilExampleFilter.StringFilter.lt:type_name -> google.protobuf.StringValue
+	220, // 216: entpb.NilExampleFilter.StringFilter.contains:type_name -> google.protobuf.StringValue
+	220, // 217: entpb.NilExampleFilter.StringFilter.gte:type_name -> google.protobuf.StringValue
+	220, // 218: entpb.NilExampleFilter.StringFilter.lte:type_name -> google.protobuf.StringValue
+	221, // 219: entpb.NilExampleFilter.TimestampFilter.eq:type_name -> google.protobuf.Timestamp
+	221, // 220: entpb.NilExampleFilter.TimestampFilter.neq:type_name -> google.protobuf.Timestamp
+	221, // 221: entpb.NilExampleFilter.TimestampFilter.gt:type_name -> google.protobuf.Timestamp
+	221, // 222: entpb.NilExampleFilter.TimestampFilter.lt:type_name -> google.protobuf.Timestamp
+	221, // 223: entpb.NilExampleFilter.TimestampFilter.gte:type_name -> google.protobuf.Timestamp
+	221, // 224: entpb.NilExampleFilter.TimestampFilter.lte:type_name -> google.protobuf.Timestamp
+	224, // 225: entpb.PetFilter.Int64Filter.eq:type_name -> google.protobuf.Int64Value
+	224, // 226: entpb.PetFilter.Int64Filter.neq:type_name -> google.protobuf.Int64Value
+	224, // 227: entpb.PetFilter.Int64Filter.gt:type_name -> google.protobuf.Int64Value
+	224, // 228: entpb.PetFilter.Int64Filter.lt:type_name -> google.protobuf.Int64Value
+	224, // 229: entpb.PetFilter.Int64Filter.gte:type_name -> google.protobuf.Int64Value
+	224, // 230: entpb.PetFilter.Int64Filter.lte:type_name -> google.protobuf.Int64Value
+	224, // 231: entpb.ProjectFilter.Int64Filter.eq:type_name -> google.protobuf.Int64Value
+	224, // 232: entpb.ProjectFilter.Int64Filter.neq:type_name -> google.protobuf.Int64Value
+	224, // 233: entpb.ProjectFilter.Int64Filter.gt:type_name -> google.protobuf.Int64Value
+	224, // 234: entpb.ProjectFilter.Int64Filter.lt:type_name -> google.protobuf.Int64Value
+	224, // 235: entpb.ProjectFilter.Int64Filter.gte:type_name -> google.protobuf.Int64Value
+	224, // 236: entpb.ProjectFilter.Int64Filter.lte:type_name -> google.protobuf.Int64Value
+	220, // 237: entpb.ProjectFilter.StringFilter.eq:type_name -> google.protobuf.StringValue
+	220, // 238: entpb.ProjectFilter.StringFilter.neq:type_name -> google.protobuf.StringValue
+	220, // 239: entpb.ProjectFilter.StringFilter.gt:type_name -> google.protobuf.StringValue
+	220, // 240: entpb.ProjectFilter.StringFilter.lt:type_name -> google.protobuf.StringValue
+	220, // 241: entpb.ProjectFilter.StringFilter.contains:type_name -> google.protobuf.StringValue
+	220, // 242: entpb.ProjectFilter.StringFilter.gte:type_name -> google.protobuf.StringValue
+	220, // 243: entpb.ProjectFilter.StringFilter.lte:type_name -> google.protobuf.StringValue
+	221, // 244: entpb.ProjectFilter.TimestampFilter.eq:type_name -> google.protobuf.Timestamp
+	221, // 245: entpb.ProjectFilter.TimestampFilter.neq:type_name -> google.protobuf.Timestamp
+	221, // 246: entpb.ProjectFilter.TimestampFilter.gt:type_name -> google.protobuf.Timestamp
+	221, // 247: entpb.ProjectFilter.TimestampFilter.lt:type_name -> google.protobuf.Timestamp
+	221, // 248: entpb.ProjectFilter.TimestampFilter.gte:type_name -> google.protobuf.Timestamp
+	221, // 249: entpb.ProjectFilter.TimestampFilter.lte:type_name -> google.protobuf.Timestamp
+	227, // 250: entpb.AggregateProjectResponse.Group.sum:type_name -> google.protobuf.DoubleValue
+	227, // 251: entpb.AggregateProjectResponse.Group.avg:type_name -> google.protobuf.DoubleValue
+	227, // 252: entpb.AggregateProjectResponse.Group.min:type_name -> google.protobuf.DoubleValue
+	227, // 253: entpb.AggregateProjectResponse.Group.max:type_name -> google.protobuf.DoubleValue
+	205, // 254: entpb.StatsProjectResponse.FieldStats.values:type_name -> entpb.StatsProjectResponse.ValueCount
+	224, // 255: entpb.TodoFilter.Int64Filter.eq:type_name -> google.protobuf.Int64Value
+	224, // 256: entpb.TodoFilter.Int64Filter.neq:type_name -> google.protobuf.Int64Value
+	224, // 257: entpb.TodoFilter.Int64Filter.gt:type_name -> google.protobuf.Int64Value
+	224, // 258: entpb.TodoFilter.Int64Filter.lt:type_name -> google.protobuf.Int64Value
+	224, // 259: entpb.TodoFilter.Int64Filter.gte:type_name -> google.protobuf.Int64Value
+	224, // 260: entpb.TodoFilter.Int64Filter.lte:type_name -> google.protobuf.Int64Value
+	220, // 261: entpb.TodoFilter.StringFilter.eq:type_name -> google.protobuf.StringValue
+	220, // 262: entpb.TodoFilter.StringFilter.neq:type_name -> google.protobuf.StringValue
+	220, // 263: entpb.TodoFilter.StringFilter.gt:type_name -> google.protobuf.StringValue
+	220, // 264: entpb.TodoFilter.StringFilter.lt:type_name -> google.protobuf.StringValue
+	220, // 265: entpb.TodoFilter.StringFilter.contains:type_name -> google.protobuf.StringValue
+	220, // 266: entpb.TodoFilter.StringFilter.gte:type_name -> google.protobuf.StringValue
+	220, // 267: entpb.TodoFilter.StringFilter.lte:type_name -> google.protobuf.StringValue
+	225, // 268: entpb.UserFilter.BoolFilter.eq:type_name -> google.protobuf.BoolValue
+	225, // 269: entpb.UserFilter.BoolFilter.neq:type_name -> google.protobuf.BoolValue
+	227, // 270: entpb.UserFilter.DoubleFilter.eq:type_name -> google.protobuf.DoubleValue
+	227, // 271: entpb.UserFilter.DoubleFilter.neq:type_name -> google.protobuf.DoubleValue
+	227, // 272: entpb.UserFilter.DoubleFilter.gt:type_name -> google.protobuf.DoubleValue
+	227, // 273: entpb.UserFilter.DoubleFilter.lt:type_name -> google.protobuf.DoubleValue
+	227, // 274: entpb.UserFilter.DoubleFilter.gte:type_name -> google.protobuf.DoubleValue
+	227, // 275: entpb.UserFilter.DoubleFilter.lte:type_name -> google.protobuf.DoubleValue
+	228, // 276: entpb.UserFilter.FloatFilter.eq:type_name -> google.protobuf.FloatValue
+	228, // 277: entpb.UserFilter.FloatFilter.neq:type_name -> google.protobuf.FloatValue
+	228, // 278: entpb.UserFilter.FloatFilter.gt:type_name -> google.protobuf.FloatValue
+	228, // 279: entpb.UserFilter.FloatFilter.lt:type_name -> google.protobuf.FloatValue
+	228, // 280: entpb.UserFilter.FloatFilter.gte:type_name -> google.protobuf.FloatValue
+	228, // 281: entpb.UserFilter.FloatFilter.lte:type_name -> google.protobuf.FloatValue
+	224, // 282: entpb.UserFilter.Int64Filter.eq:type_name -> google.protobuf.Int64Value
+	224, // 283: entpb.UserFilter.Int64Filter.neq:type_name -> google.protobuf.Int64Value
+	224, // 284: entpb.UserFilter.Int64Filter.gt:type_name -> google.protobuf.Int64Value
+	224, // 285: entpb.UserFilter.Int64Filter.lt:type_name -> google.protobuf.Int64Value
+	224, // 286: entpb.UserFilter.Int64Filter.gte:type_name -> google.protobuf.Int64Value
+	224, // 287: entpb.UserFilter.Int64Filter.lte:type_name -> google.protobuf.Int64Value
+	220, // 288: entpb.UserFilter.StringFilter.eq:type_name -> google.protobuf.StringValue
+	220, // 289: entpb.UserFilter.StringFilter.neq:type_name -> google.protobuf.StringValue
+	220, // 290: entpb.UserFilter.StringFilter.gt:type_name -> google.protobuf.StringValue
+	220, // 291: entpb.UserFilter.StringFilter.lt:type_name -> google.protobuf.StringValue
+	220, // 292: entpb.UserFilter.StringFilter.contains:type_name -> google.protobuf.StringValue
+	220, // 293: entpb.UserFilter.StringFilter.gte:type_name -> google.protobuf.StringValue
+	220, // 294: entpb.UserFilter.StringFilter.lte:type_name -> google.protobuf.StringValue
+	221, // 295: entpb.UserFilter.TimestampFilter.eq:type_name -> google.protobuf.Timestamp
+	221, // 296: entpb.UserFilter.TimestampFilter.neq:type_name -> google.protobuf.Timestamp
+	221, // 297: entpb.UserFilter.TimestampFilter.gt:type_name -> google.protobuf.Timestamp
+	221, // 298: entpb.UserFilter.TimestampFilter.lt:type_name -> google.protobuf.Timestamp
+	221, // 299: entpb.UserFilter.TimestampFilter.gte:type_name -> google.protobuf.Timestamp
+	221, // 300: entpb.UserFilter.TimestampFilter.lte:type_name -> google.protobuf.Timestamp
+	229, // 301: entpb.UserFilter.UInt32Filter.eq:type_name -> google.protobuf.UInt32Value
+	229, // 302: entpb.UserFilter.UInt32Filter.neq:type_name -> google.protobuf.UInt32Value
+	229, // 303: entpb.UserFilter.UInt32Filter.gt:type_name -> google.protobuf.UInt32Value
+	229, // 304: entpb.UserFilter.UInt32Filter.lt:type_name -> google.protobuf.UInt32Value
+	229, // 305: entpb.UserFilter.UInt32Filter.gte:type_name -> google.protobuf.UInt32Value
+	229, // 306: entpb.UserFilter.UInt32Filter.lte:type_name -> google.protobuf.UInt32Value
+	230, // 307: entpb.UserFilter.UInt64Filter.eq:type_name -> google.protobuf.UInt64Value
+	230, // 308: entpb.UserFilter.UInt64Filter.neq:type_name -> google.protobuf.UInt64Value
+	230, // 309: entpb.UserFilter.UInt64Filter.gt:type_name -> google.protobuf.UInt64Value
+	230, // 310: entpb.UserFilter.UInt64Filter.lt:type_name -> google.protobuf.UInt64Value
+	230, // 311: entpb.UserFilter.UInt64Filter.gte:type_name -> google.protobuf.UInt64Value
+	230, // 312: entpb.UserFilter.UInt64Filter.lte:type_name -> google.protobuf.UInt64Value
+	227, // 313: entpb.AggregateUserResponse.Group.sum:type_name -> google.protobuf.DoubleValue
+	227, // 314: entpb.AggregateUserResponse.Group.avg:type_name -> google.protobuf.DoubleValue
+	227, // 315: entpb.AggregateUserResponse.Group.min:type_name -> google.protobuf.DoubleValue
+	227, // 316: entpb.AggregateUserResponse.Group.max:type_name -> google.protobuf.DoubleValue
+	218, // 317: entpb.StatsUserResponse.FieldStats.values:type_name -> entpb.StatsUserResponse.ValueCount
+	32,  // 318: entpb.AttachmentService.Create:input_type -> entpb.CreateAttachmentRequest
+	33,  // 319: entpb.AttachmentService.Get:input_type -> entpb.GetAttachmentRequest
+	34,  // 320: entpb.AttachmentService.Exists:input_type -> entpb.ExistsAttachmentRequest
+	36,  // 321: entpb.AttachmentService.Update:input_type -> entpb.UpdateAttachmentRequest
+	37,  // 322: entpb.AttachmentService.Delete:input_type -> entpb.DeleteAttachmentRequest
+	38,  // 323: entpb.AttachmentService.List:input_type -> entpb.ListAttachmentRequest
+	40,  // 324: entpb.AttachmentService.Count:input_type -> entpb.CountAttachmentsRequest
+	42,  // 325: entpb.AttachmentService.BatchCreate:input_type -> entpb.BatchCreateAttachmentsRequest
+	45,  // 326: entpb.AttachmentService.BatchDelete:input_type -> entpb.BatchDeleteAttachmentsRequest
+	46,  // 327: entpb.AttachmentService.ListAttachmentRecipients:input_type -> entpb.ListAttachmentRecipientsRequest
+	48,  // 328: entpb.AttachmentService.AddAttachmentRecipient:input_type -> entpb.AddAttachmentRecipientRequest
+	49,  // 329: entpb.AttachmentService.RemoveAttachmentRecipient:input_type -> entpb.RemoveAttachmentRecipientRequest
+	31,  // 330: entpb.AttachmentService.Upload:input_type -> entpb.Attachment
+	31,  // 331: entpb.AttachmentService.Sync:input_type -> entpb.Attachment
+	51,  // 332: entpb.DocumentService.Create:input_type -> entpb.CreateDocumentRequest
+	52,  // 333: entpb.DocumentService.Get:input_type -> entpb.GetDocumentRequest
+	53,  // 334: entpb.DocumentService.Update:input_type -> entpb.UpdateDocumentRequest
+	54,  // 335: entpb.DocumentService.Delete:input_type -> entpb.DeleteDocumentRequest
+	56,  // 336: entpb.DocumentService.DeleteDocuments:input_type -> entpb.DeleteDocumentsRequest
+	58,  // 337: entpb.DocumentService.List:input_type -> entpb.ListDocumentRequest
+	60,  // 338: entpb.DocumentService.Count:input_type -> entpb.CountDocumentsRequest
+	62,  // 339: entpb.DocumentService.BatchCreate:input_type -> entpb.BatchCreateDocumentsRequest
+	50,  // 340: entpb.DocumentService.Archive:input_type -> entpb.Document
+	58,  // 341: entpb.DocumentService.Tail:input_type -> entpb.ListDocumentRequest
+	66,  // 342: entpb.LabelService.Create:input_type -> entpb.CreateLabelRequest
+	67,  // 343: entpb.LabelService.Get:input_type -> entpb.GetLabelRequest
+	68,  // 344: entpb.LabelService.Update:input_type -> entpb.UpdateLabelRequest
+	69,  // 345: entpb.LabelService.Delete:input_type -> entpb.DeleteLabelRequest
+	71,  // 346: entpb.LabelService.List:input_type -> entpb.ListLabelRequest
+	73,  // 347: entpb.LabelService.BatchCreate:input_type -> entpb.BatchCreateLabelsRequest
+	75,  // 348: entpb.LabelService.Export:input_type -> entpb.ExportLabelRequest
+	78,  // 349: entpb.MultiWordSchemaService.Create:input_type -> entpb.CreateMultiWordSchemaRequest
+	79,  // 350: entpb.MultiWordSchemaService.Get:input_type -> entpb.GetMultiWordSchemaRequest
+	80,  // 351: entpb.MultiWordSchemaService.Update:input_type -> entpb.UpdateMultiWordSchemaRequest
+	81,  // 352: entpb.MultiWordSchemaService.Delete:input_type -> entpb.DeleteMultiWordSchemaRequest
+	83,  // 353: entpb.MultiWordSchemaService.List:input_type -> entpb.ListMultiWordSchemaRequest
+	85,  // 354: entpb.MultiWordSchemaService.BatchCreate:input_type -> entpb.BatchCreateMultiWordSchemasRequest
+	88,  // 355: entpb.NilExampleService.Create:input_type -> entpb.CreateNilExampleRequest
+	89,  // 356: entpb.NilExampleService.Get:input_type -> entpb.GetNilExampleRequest
+	90,  // 357: entpb.NilExampleService.Update:input_type -> entpb.UpdateNilExampleRequest
+	91,  // 358: entpb.NilExampleService.Delete:input_type -> entpb.DeleteNilExampleRequest
+	93,  // 359: entpb.NilExampleService.List:input_type -> entpb.ListNilExampleRequest
+	95,  // 360: entpb.NilExampleService.BatchCreate:input_type -> entpb.BatchCreateNilExamplesRequest
+	99,  // 361: entpb.PetReadService.Get:input_type -> entpb.GetPetRequest
+	103, // 362: entpb.PetReadService.List:input_type -> entpb.ListPetRequest
+	98,  // 363: entpb.PetWriteService.Create:input_type -> entpb.CreatePetRequest
+	100, // 364: entpb.PetWriteService.Update:input_type -> entpb.UpdatePetRequest
+	101, // 365: entpb.PetWriteService.Delete:input_type -> entpb.DeletePetRequest
+	105, // 366: entpb.PetWriteService.BatchCreate:input_type -> entpb.BatchCreatePetsRequest
+	109, // 367: entpb.PonyService.BatchCreate:input_type -> entpb.BatchCreatePoniesRequest
+	113, // 368: entpb.ProjectService.Create:input_type -> entpb.CreateProjectRequest
+	114, // 369: entpb.ProjectService.Get:input_type -> entpb.ProjectLookupRequest
+	116, // 370: entpb.ProjectService.GetProjectByName:input_type -> entpb.GetProjectByNameRequest
+	117, // 371: entpb.ProjectService.Exists:input_type -> entpb.ExistsProjectRequest
+	119, // 372: entpb.ProjectService.Update:input_type -> entpb.UpdateProjectRequest
+	120, // 373: entpb.ProjectService.Delete:input_type -> entpb.DeleteProjectRequest
+	122, // 374: entpb.ProjectService.DeleteProjects:input_type -> entpb.DeleteProjectsRequest
+	124, // 375: entpb.ProjectService.RestoreProject:input_type -> entpb.RestoreProjectRequest
+	125, // 376: entpb.ProjectService.List:input_type -> entpb.ListProjectRequest
+	127, // 377: entpb.ProjectService.StreamProjects:input_type -> entpb.StreamProjectsRequest
+	128, // 378: entpb.ProjectService.Count:input_type -> entpb.CountProjectsRequest
+	130, // 379: entpb.ProjectService.AggregateProject:input_type -> entpb.AggregateProjectRequest
+	132, // 380: entpb.ProjectService.BatchCreate:input_type -> entpb.BatchCreateProjectsRequest
+	134, // 381: entpb.ProjectService.Upsert:input_type -> entpb.UpsertProjectRequest
+	135, // 382: entpb.ProjectService.BatchGet:input_type -> entpb.BatchGetProjectsRequest
+	137, // 383: entpb.ProjectService.BatchDelete:input_type -> entpb.BatchDeleteProjectsRequest
+	138, // 384: entpb.ProjectService.Stats:input_type -> entpb.StatsProjectRequest
+	140, // 385: entpb.ProjectService.Export:input_type -> entpb.ExportProjectRequest
+	142, // 386: entpb.ProjectService.ListProjectAttachments:input_type -> entpb.ListProjectAttachmentsRequest
+	144, // 387: entpb.ProjectService.AddProjectAttachment:input_type -> entpb.AddProjectAttachmentRequest
+	145, // 388: entpb.ProjectService.RemoveProjectAttachment:input_type -> entpb.RemoveProjectAttachmentRequest
+	147, // 389: entpb.TodoService.Create:input_type -> entpb.CreateTodoRequest
+	148, // 390: entpb.TodoService.Get:input_type -> entpb.GetTodoRequest
+	149, // 391: entpb.TodoService.Update:input_type -> entpb.UpdateTodoRequest
+	150, // 392: entpb.TodoService.Delete:input_type -> entpb.DeleteTodoRequest
+	152, // 393: entpb.TodoService.List:input_type -> entpb.ListTodoRequest
+	154, // 394: entpb.TodoService.BatchCreate:input_type -> entpb.BatchCreateTodosRequest
+	157, // 395: entpb.UserService.Create:input_type -> entpb.CreateUserRequest
+	158, // 396: entpb.UserService.Get:input_type -> entpb.GetUserRequest
+	159, // 397: entpb.UserService.GetUserByUserName:input_type -> entpb.GetUserByUserNameRequest
+	160, // 398: entpb.UserService.GetUserByExternalID:input_type -> entpb.GetUserByExternalIDRequest
+	161, // 399: entpb.UserService.GetUserByBUser1:input_type -> entpb.GetUserByBUser1Request
+	162, // 400: entpb.UserService.Exists:input_type -> entpb.ExistsUserRequest
+	164, // 401: entpb.UserService.Update:input_type -> entpb.UpdateUserRequest
+	165, // 402: entpb.UserService.Delete:input_type -> entpb.DeleteUserRequest
+	167, // 403: entpb.UserService.DeleteUsers:input_type -> entpb.DeleteUsersRequest
+	169, // 404: entpb.UserService.List:input_type -> entpb.ListUserRequest
+	171, // 405: entpb.UserService.StreamUsers:input_type -> entpb.StreamUsersRequest
+	172, // 406: entpb.UserService.Count:input_type -> entpb.CountUsersRequest
+	174, // 407: entpb.UserService.AggregateUser:input_type -> entpb.AggregateUserRequest
+	176, // 408: entpb.UserService.BatchCreate:input_type -> entpb.BatchCreateUsersRequest
+	178, // 409: entpb.UserService.Upsert:input_type -> entpb.UpsertUserRequest
+	179, // 410: entpb.UserService.BatchGet:input_type -> entpb.BatchGetUsersRequest
+	181, // 411: entpb.UserService.BatchUpdate:input_type -> entpb.BatchUpdateUsersRequest
+	183, // 412: entpb.UserService.BatchDelete:input_type -> entpb.BatchDeleteUsersRequest
+	184, // 413: entpb.UserService.Stats:input_type -> entpb.StatsUserRequest
+	186, // 414: entpb.UserService.Export:input_type -> entpb.ExportUserRequest
+	188, // 415: entpb.UserService.Import:input_type -> entpb.ImportUserRequest
+	190, // 416: entpb.UserService.WatchUser:input_type -> entpb.WatchUserRequest
+	31,  // 417: entpb.AttachmentService.Create:output_type -> entpb.Attachment
+	31,  // 418: entpb.AttachmentService.Get:output_type -> entpb.Attachment
+	35,  // 419: entpb.AttachmentService.Exists:output_type -> entpb.ExistsAttachmentResponse
+	31,  // 420: entpb.AttachmentService.Update:output_type -> entpb.Attachment
+	231, // 421: entpb.AttachmentService.Delete:output_type -> google.protobuf.Empty
+	39,  // 422: entpb.AttachmentService.List:output_type -> entpb.ListAttachmentResponse
+	41,  // 423: entpb.AttachmentService.Count:output_type -> entpb.CountAttachmentsResponse
+	43,  // 424: entpb.AttachmentService.BatchCreate:output_type -> entpb.BatchCreateAttachmentsResponse
+	231, // 425: entpb.AttachmentService.BatchDelete:output_type -> google.protobuf.Empty
+	47,  // 426: entpb.AttachmentService.ListAttachmentRecipients:output_type -> entpb.ListAttachmentRecipientsResponse
+	231, // 427: entpb.AttachmentService.AddAttachmentRecipient:output_type -> google.protobuf.Empty
+	231, // 428: entpb.AttachmentService.RemoveAttachmentRecipient:output_type -> google.protobuf.Empty
+	231, // 429: entpb.AttachmentService.Upload:output_type -> google.protobuf.Empty
+	31,  // 430: entpb.AttachmentService.Sync:output_type -> entpb.Attachment
+	50,  // 431: entpb.DocumentService.Create:output_type -> entpb.Document
+	50,  // 432: entpb.DocumentService.Get:output_type -> entpb.Document
+	50,  // 433: entpb.DocumentService.Update:output_type -> entpb.Document
+	50,  // 434: entpb.DocumentService.Delete:output_type -> entpb.Document
+	57,  // 435: entpb.DocumentService.DeleteDocuments:output_type -> entpb.DeleteDocumentsResponse
+	59,  // 436: entpb.DocumentService.List:output_type -> entpb.ListDocumentResponse
+	61,  // 437: entpb.DocumentService.Count:output_type -> entpb.CountDocumentsResponse
+	63,  // 438: entpb.DocumentService.BatchCreate:output_type -> entpb.BatchCreateDocumentsResponse
+	50,  // 439: entpb.DocumentService.Archive:output_type -> entpb.Document
+	50,  // 440: entpb.DocumentService.Tail:output_type -> entpb.Document
+	65,  // 441: entpb.LabelService.Create:output_type -> entpb.Label
+	65,  // 442: entpb.LabelService.Get:output_type -> entpb.Label
+	65,  // 443: entpb.LabelService.Update:output_type -> entpb.Label
+	231, // 444: entpb.LabelService.Delete:output_type -> google.protobuf.Empty
+	72,  // 445: entpb.LabelService.List:output_type -> entpb.ListLabelResponse
+	74,  // 446: entpb.LabelService.BatchCreate:output_type -> entpb.BatchCreateLabelsResponse
+	76,  // 447: entpb.LabelService.Export:output_type -> entpb.ExportLabelResponse
+	77,  // 448: entpb.MultiWordSchemaService.Create:output_type -> entpb.MultiWordSchema
+	77,  // 449: entpb.MultiWordSchemaService.Get:output_type -> entpb.MultiWordSchema
+	77,  // 450: entpb.MultiWordSchemaService.Update:output_type -> entpb.MultiWordSchema
+	231, // 451: entpb.MultiWordSchemaService.Delete:output_type -> google.protobuf.Empty
+	84,  // 452: entpb.MultiWordSchemaService.List:output_type -> entpb.ListMultiWordSchemaResponse
+	86,  // 453: entpb.MultiWordSchemaService.BatchCreate:output_type -> entpb.BatchCreateMultiWordSchemasResponse
+	87,  // 454: entpb.NilExampleService.Create:output_type -> entpb.NilExample
+	87,  // 455: entpb.NilExampleService.Get:output_type -> entpb.NilExample
+	87,  // 456: entpb.NilExampleService.Update:output_type -> entpb.NilExample
+	231, // 457: entpb.NilExampleService.Delete:output_type -> google.protobuf.Empty
+	94,  // 458: entpb.NilExampleService.List:output_type -> entpb.ListNilExampleResponse
+	96,  // 459: entpb.NilExampleService.BatchCreate:output_type -> entpb.BatchCreateNilExamplesResponse
+	97,  // 460: entpb.PetReadService.Get:output_type -> entpb.Pet
+	104, // 461: entpb.PetReadService.List:output_type -> entpb.ListPetResponse
+	97,  // 462: entpb.PetWriteService.Create:output_type -> entpb.Pet
+	97,  // 463: entpb.PetWriteService.Update:output_type -> entpb.Pet
+	231, // 464: entpb.PetWriteService.Delete:output_type -> google.protobuf.Empty
+	106, // 465: entpb.PetWriteService.BatchCreate:output_type -> entpb.BatchCreatePetsResponse
+	110, // 466: entpb.PonyService.BatchCreate:output_type -> entpb.BatchCreatePoniesResponse
+	111, // 467: entpb.ProjectService.Create:output_type -> entpb.Project
+	115, // 468: entpb.ProjectService.Get:output_type -> entpb.GetProjectResponse
+	111, // 469: entpb.ProjectService.GetProjectByName:output_type -> entpb.Project
+	118, // 470: entpb.ProjectService.Exists:output_type -> entpb.ExistsProjectResponse
+	111, // 471: entpb.ProjectService.Update:output_type -> entpb.Project
+	231, // 472: entpb.ProjectService.Delete:output_type -> google.protobuf.Empty
+	123, // 473: entpb.ProjectService.DeleteProjects:output_type -> entpb.DeleteProjectsResponse
+	111, // 474: entpb.ProjectService.RestoreProject:output_type -> entpb.Project
+	126, // 475: entpb.ProjectService.List:output_type -> entpb.ListProjectResponse
+	111, // 476: entpb.ProjectService.StreamProjects:output_type -> entpb.Project
+	129, // 477: entpb.ProjectService.Count:output_type -> entpb.CountProjectsResponse
+	131, // 478: entpb.ProjectService.AggregateProject:output_type -> entpb.AggregateProjectResponse
+	133, // 479: entpb.ProjectService.BatchCreate:output_type -> entpb.BatchCreateProjectsResponse
+	111, // 480: entpb.ProjectService.Upsert:output_type -> entpb.Project
+	136, // 481: entpb.ProjectService.BatchGet:output_type -> entpb.ProjectBatch
+	231, // 482: entpb.ProjectService.BatchDelete:output_type -> google.protobuf.Empty
+	139, // 483: entpb.ProjectService.Stats:output_type -> entpb.StatsProjectResponse
+	141, // 484: entpb.ProjectService.Export:output_type -> entpb.ExportProjectResponse
+	143, // 485: entpb.ProjectService.ListProjectAttachments:output_type -> entpb.ListProjectAttachmentsResponse
+	231, // 486: entpb.ProjectService.AddProjectAttachment:output_type -> google.protobuf.Empty
+	231, // 487: entpb.ProjectService.RemoveProjectAttachment:output_type -> google.protobuf.Empty
+	146, // 488: entpb.TodoService.Create:output_type -> entpb.Todo
+	146, // 489: entpb.TodoService.Get:output_type -> entpb.Todo
+	146, // 490: entpb.TodoService.Update:output_type -> entpb.Todo
+	231, // 491: entpb.TodoService.Delete:output_type -> google.protobuf.Empty
+	153, // 492: entpb.TodoService.List:output_type -> entpb.ListTodoResponse
+	155, // 493: entpb.TodoService.BatchCreate:output_type -> entpb.BatchCreateTodosResponse
+	156, // 494: entpb.UserService.Create:output_type -> entpb.User
+	156, // 495: entpb.UserService.Get:output_type -> entpb.User
+	156, // 496: entpb.UserService.GetUserByUserName:output_type -> entpb.User
+	156, // 497: entpb.UserService.GetUserByExternalID:output_type -> entpb.User
+	156, // 498: entpb.UserService.GetUserByBUser1:output_type -> entpb.User
+	163, // 499: entpb.UserService.Exists:output_type -> entpb.ExistsUserResponse
+	156, // 500: entpb.UserService.Update:output_type -> entpb.User
+	231, // 501: entpb.UserService.Delete:output_type -> google.protobuf.Empty
+	168, // 502: entpb.UserService.DeleteUsers:output_type -> entpb.DeleteUsersResponse
+	170, // 503: entpb.UserService.List:output_type -> entpb.ListUserResponse
+	156, // 504: entpb.UserService.StreamUsers:output_type -> entpb.User
+	173, // 505: entpb.UserService.Count:output_type -> entpb.CountUsersResponse
+	175, // 506: entpb.UserService.AggregateUser:output_type -> entpb.AggregateUserResponse
+	177, // 507: entpb.UserService.BatchCreate:output_type -> entpb.BatchCreateUsersResponse
+	156, // 508: entpb.UserService.Upsert:output_type -> entpb.User
+	180, // 509: entpb.UserService.BatchGet:output_type -> entpb.BatchGetUsersResponse
+	182, // 510: entpb.UserService.BatchUpdate:output_type -> entpb.BatchUpdateUsersResponse
+	231, // 511: entpb.UserService.BatchDelete:output_type -> google.protobuf.Empty
+	185, // 512: entpb.UserService.Stats:output_type -> entpb.StatsUserResponse
+	187, // 513: entpb.UserService.Export:output_type -> entpb.ExportUserResponse
+	189, // 514: entpb.UserService.Import:output_type -> entpb.ImportUserResponse
+	191, // 515: entpb.UserService.WatchUser:output_type -> entpb.UserEvent
+	417, // [417:516] is the sub-list for method output_type
+	318, // [318:417] is the sub-list for method input_type
+	318, // [318:318] is the sub-list for extension type_name
+	318, // [318:318] is the sub-list for extension extendee
+	0,   // [0:318] is the sub-list for field type_name
+}
+
+func init() { file_entpb_entpb_proto_init() }
+func file_entpb_entpb_proto_init() {
+	if File_entpb_entpb_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_entpb_entpb_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Attachment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExistsAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExistsAttachmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAttachmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountAttachmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountAttachmentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateAttachmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateAttachmentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateAttachmentResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchDeleteAttachmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAttachmentRecipientsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAttachmentRecipientsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAttachmentRecipientRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAttachmentRecipientRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Document); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDocumentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDocumentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDocumentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDocumentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDocumentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDocumentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDocumentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDocumentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountDocumentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountDocumentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateDocumentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateDocumentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Label); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLabelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLabelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLabelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLabelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LabelFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLabelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLabelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateLabelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateLabelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportLabelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportLabelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiWordSchema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMultiWordSchemaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMultiWordSchemaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMultiWordSchemaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteMultiWordSchemaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiWordSchemaFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMultiWordSchemaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMultiWordSchemaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateMultiWordSchemasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateMultiWordSchemasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NilExample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateNilExampleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNilExampleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateNilExampleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteNilExampleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NilExampleFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNilExampleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNilExampleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateNilExamplesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateNilExamplesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PetFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreatePetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreatePetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pony); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePonyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreatePoniesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreatePoniesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Project); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectEdgeIds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectLookupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectByNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExistsProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExistsProjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProjectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProjectsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamProjectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountProjectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountProjectsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateProjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateProjectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateProjectsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetProjectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchDeleteProjectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsProjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportProjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectAttachmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectAttachmentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProjectAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveProjectAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Todo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTodoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTodoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTodoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTodoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TodoFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTodoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTodoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateTodosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4390,8 +17422,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAttachmentRequest); i {
+		file_entpb_entpb_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateTodosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4402,8 +17434,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAttachmentRequest); i {
+		file_entpb_entpb_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4414,8 +17446,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAttachmentRequest); i {
+		file_entpb_entpb_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4426,8 +17458,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteAttachmentRequest); i {
+		file_entpb_entpb_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4438,8 +17470,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAttachmentRequest); i {
+		file_entpb_entpb_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserByUserNameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4450,8 +17482,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAttachmentResponse); i {
+		file_entpb_entpb_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserByExternalIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4462,8 +17494,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchCreateAttachmentsRequest); i {
+		file_entpb_entpb_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserByBUser1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4474,8 +17506,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchCreateAttachmentsResponse); i {
+		file_entpb_entpb_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExistsUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4486,8 +17518,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Group); i {
+		file_entpb_entpb_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExistsUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4498,8 +17530,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiWordSchema); i {
+		file_entpb_entpb_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4510,8 +17542,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMultiWordSchemaRequest); i {
+		file_entpb_entpb_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4522,8 +17554,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMultiWordSchemaRequest); i {
+		file_entpb_entpb_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4534,8 +17566,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMultiWordSchemaRequest); i {
+		file_entpb_entpb_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUsersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4546,8 +17578,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteMultiWordSchemaRequest); i {
+		file_entpb_entpb_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUsersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4558,8 +17590,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMultiWordSchemaRequest); i {
+		file_entpb_entpb_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4570,8 +17602,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMultiWordSchemaResponse); i {
+		file_entpb_entpb_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4582,8 +17614,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchCreateMultiWordSchemasRequest); i {
+		file_entpb_entpb_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamUsersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4594,8 +17626,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchCreateMultiWordSchemasResponse); i {
+		file_entpb_entpb_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountUsersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4606,8 +17638,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NilExample); i {
+		file_entpb_entpb_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountUsersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4618,8 +17650,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateNilExampleRequest); i {
+		file_entpb_entpb_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4630,8 +17662,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNilExampleRequest); i {
+		file_entpb_entpb_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4642,8 +17674,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateNilExampleRequest); i {
+		file_entpb_entpb_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateUsersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4654,8 +17686,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteNilExampleRequest); i {
+		file_entpb_entpb_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateUsersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4666,8 +17698,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListNilExampleRequest); i {
+		file_entpb_entpb_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4678,8 +17710,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListNilExampleResponse); i {
+		file_entpb_entpb_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetUsersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4690,8 +17722,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchCreateNilExamplesRequest); i {
+		file_entpb_entpb_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetUsersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4702,8 +17734,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchCreateNilExamplesResponse); i {
+		file_entpb_entpb_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdateUsersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4714,8 +17746,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pet); i {
+		file_entpb_entpb_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdateUsersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4726,8 +17758,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePetRequest); i {
+		file_entpb_entpb_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchDeleteUsersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4738,8 +17770,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPetRequest); i {
+		file_entpb_entpb_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4750,8 +17782,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePetRequest); i {
+		file_entpb_entpb_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4762,8 +17794,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePetRequest); i {
+		file_entpb_entpb_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4774,8 +17806,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPetRequest); i {
+		file_entpb_entpb_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4786,8 +17818,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPetResponse); i {
+		file_entpb_entpb_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4798,8 +17830,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchCreatePetsRequest); i {
+		file_entpb_entpb_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4810,8 +17842,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchCreatePetsResponse); i {
+		file_entpb_entpb_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4822,8 +17854,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pony); i {
+		file_entpb_entpb_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4834,8 +17866,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePonyRequest); i {
+		file_entpb_entpb_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentFilter_Int64Filter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4846,8 +17878,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchCreatePoniesRequest); i {
+		file_entpb_entpb_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentFilter_StringFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4858,8 +17890,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchCreatePoniesResponse); i {
+		file_entpb_entpb_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LabelFilter_StringFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4870,8 +17902,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Todo); i {
+		file_entpb_entpb_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiWordSchemaFilter_Int64Filter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4882,8 +17914,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+		file_entpb_entpb_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NilExampleFilter_Int64Filter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4894,8 +17926,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserRequest); i {
+		file_entpb_entpb_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NilExampleFilter_StringFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4906,8 +17938,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserRequest); i {
+		file_entpb_entpb_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NilExampleFilter_TimestampFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4918,8 +17950,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUserRequest); i {
+		file_entpb_entpb_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PetFilter_Int64Filter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4930,8 +17962,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteUserRequest); i {
+		file_entpb_entpb_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectFilter_Int64Filter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4942,8 +17974,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUserRequest); i {
+		file_entpb_entpb_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectFilter_StringFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4954,8 +17986,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUserResponse); i {
+		file_entpb_entpb_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectFilter_TimestampFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4966,8 +17998,8 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchCreateUsersRequest); i {
+		file_entpb_entpb_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateProjectResponse_Group); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4978,8 +18010,104 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_entpb_entpb_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchCreateUsersResponse); i {
+		file_entpb_entpb_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsProjectResponse_FieldStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsProjectResponse_ValueCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TodoFilter_Int64Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TodoFilter_StringFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserFilter_BoolFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserFilter_DoubleFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserFilter_FloatFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserFilter_Int64Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserFilter_StringFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4990,16 +18118,105 @@ func file_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
+		file_entpb_entpb_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserFilter_TimestampFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserFilter_UInt32Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserFilter_UInt64Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateUserResponse_Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsUserResponse_FieldStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_entpb_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsUserResponse_ValueCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_entpb_entpb_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*ExistsAttachmentRequest_Id)(nil),
+	}
+	file_entpb_entpb_proto_msgTypes[13].OneofWrappers = []interface{}{
+		(*BatchCreateAttachmentResult_Attachment)(nil),
+		(*BatchCreateAttachmentResult_Status)(nil),
+	}
+	file_entpb_entpb_proto_msgTypes[86].OneofWrappers = []interface{}{
+		(*ExistsProjectRequest_Id)(nil),
+		(*ExistsProjectRequest_Name)(nil),
+	}
+	file_entpb_entpb_proto_msgTypes[131].OneofWrappers = []interface{}{
+		(*ExistsUserRequest_Id)(nil),
+		(*ExistsUserRequest_UserName)(nil),
+		(*ExistsUserRequest_ExternalId)(nil),
+		(*ExistsUserRequest_BUser_1)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_entpb_entpb_proto_rawDesc,
-			NumEnums:      15,
-			NumMessages:   51,
+			NumEnums:      31,
+			NumMessages:   188,
 			NumExtensions: 0,
-			NumServices:   6,
+			NumServices:   11,
 		},
 		GoTypes:           file_entpb_entpb_proto_goTypes,
 		DependencyIndexes: file_entpb_entpb_proto_depIdxs,
@@ -8,25 +8,199 @@ import (
 	ent "entgo.io/contrib/entproto/internal/todo/ent"
 	attachment "entgo.io/contrib/entproto/internal/todo/ent/attachment"
 	user "entgo.io/contrib/entproto/internal/todo/ent/user"
-	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	runtime "entgo.io/contrib/entproto/runtime"
+	errors "errors"
 	fmt "fmt"
 	uuid "github.com/google/uuid"
+	errgroup "golang.org/x/sync/errgroup"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	strconv "strconv"
+	time "time"
 )
 
 // AttachmentService implements AttachmentServiceServer
 type AttachmentService struct {
 	client *ent.Client
+	// config holds the configuration of the service, set by the options of the constructor.
+	config runtime.Config
+	// hooks are run around the methods of the service, see Use.
+	hooks []AttachmentServiceHooks
+	// extra implements the methods added by entproto.ExtraMethod, see NewAttachmentService.
+	extra AttachmentServiceExtraMethods
 	UnimplementedAttachmentServiceServer
 }
 
-// NewAttachmentService returns a new AttachmentService
-func NewAttachmentService(client *ent.Client) *AttachmentService {
-	return &AttachmentService{
+// AttachmentServiceOption configures a AttachmentService, see NewAttachmentService.
+// The options of the runtime package, such as runtime.WithMaxPageSize, are AttachmentServiceOptions.
+// The hooks of the service are registered with WithAttachmentServiceHooks.
+type AttachmentServiceOption interface {
+	ApplyConfig(*runtime.Config)
+}
+
+// attachmentServiceOption is a AttachmentServiceOption configuring the AttachmentService itself rather than its runtime config.
+type attachmentServiceOption func(*AttachmentService)
+
+// ApplyConfig implements AttachmentServiceOption.
+func (attachmentServiceOption) ApplyConfig(*runtime.Config) {}
+
+// NewAttachmentService returns a new AttachmentService, serving the methods added by entproto.ExtraMethod with extra and
+// configured by the given options
+func NewAttachmentService(client *ent.Client, extra AttachmentServiceExtraMethods, opts ...AttachmentServiceOption) *AttachmentService {
+	svc := &AttachmentService{
 		client: client,
+		extra:  extra,
+	}
+	for _, opt := range opts {
+		opt.ApplyConfig(&svc.config)
+		if o, ok := opt.(attachmentServiceOption); ok {
+			o(svc)
+		}
+	}
+	return svc
+}
+
+// AttachmentServiceHooks holds the functions run around the methods of the AttachmentService,
+// registered with its Use method. The Before hooks receive the mutation builder before it is saved, and the
+// After hooks the response of the method once the entity is saved. A hook failing with an error fails the
+// method with this error, which should be a gRPC status error. Nil hooks are skipped.
+//
+// The methods creating, updating or deleting several entries run the hooks for each of them. The Upsert and
+// Import methods run the Create hooks with a request holding the entity, and the methods deleting entries by IDs
+// or by filter run the Delete hooks with a request holding the ID of each deleted entry.
+type AttachmentServiceHooks struct {
+	BeforeCreate func(ctx context.Context, req *CreateAttachmentRequest, m *ent.AttachmentCreate) error
+	AfterCreate  func(ctx context.Context, req *CreateAttachmentRequest, res *Attachment) error
+	BeforeUpdate func(ctx context.Context, req *UpdateAttachmentRequest, m *ent.AttachmentUpdateOne) error
+	AfterUpdate  func(ctx context.Context, req *UpdateAttachmentRequest, res *Attachment) error
+	BeforeDelete func(ctx context.Context, req *DeleteAttachmentRequest) error
+	AfterDelete  func(ctx context.Context, req *DeleteAttachmentRequest) error
+}
+
+// Use registers hooks run around the methods of the service, in the order they are registered. It is not safe to
+// call it while the service serves requests.
+func (svc *AttachmentService) Use(hooks ...AttachmentServiceHooks) {
+	svc.hooks = append(svc.hooks, hooks...)
+}
+
+// WithAttachmentServiceHooks registers hooks run around the methods of the service, like its Use method.
+func WithAttachmentServiceHooks(hooks ...AttachmentServiceHooks) AttachmentServiceOption {
+	return attachmentServiceOption(func(svc *AttachmentService) {
+		svc.Use(hooks...)
+	})
+}
+
+// runBeforeCreateHooks runs the BeforeCreate hooks of the service, stopping at the first failing one.
+func (svc *AttachmentService) runBeforeCreateHooks(ctx context.Context, req *CreateAttachmentRequest, m *ent.AttachmentCreate) error {
+	for _, h := range svc.hooks {
+		if h.BeforeCreate != nil {
+			if err := h.BeforeCreate(ctx, req, m); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runAfterCreateHooks runs the AfterCreate hooks of the service, stopping at the first failing one.
+func (svc *AttachmentService) runAfterCreateHooks(ctx context.Context, req *CreateAttachmentRequest, res *Attachment) error {
+	for _, h := range svc.hooks {
+		if h.AfterCreate != nil {
+			if err := h.AfterCreate(ctx, req, res); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runBeforeUpdateHooks runs the BeforeUpdate hooks of the service, stopping at the first failing one.
+func (svc *AttachmentService) runBeforeUpdateHooks(ctx context.Context, req *UpdateAttachmentRequest, m *ent.AttachmentUpdateOne) error {
+	for _, h := range svc.hooks {
+		if h.BeforeUpdate != nil {
+			if err := h.BeforeUpdate(ctx, req, m); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runAfterUpdateHooks runs the AfterUpdate hooks of the service, stopping at the first failing one.
+func (svc *AttachmentService) runAfterUpdateHooks(ctx context.Context, req *UpdateAttachmentRequest, res *Attachment) error {
+	for _, h := range svc.hooks {
+		if h.AfterUpdate != nil {
+			if err := h.AfterUpdate(ctx, req, res); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runBeforeDeleteHooks runs the BeforeDelete hooks of the service, stopping at the first failing one.
+func (svc *AttachmentService) runBeforeDeleteHooks(ctx context.Context, req *DeleteAttachmentRequest) error {
+	for _, h := range svc.hooks {
+		if h.BeforeDelete != nil {
+			if err := h.BeforeDelete(ctx, req); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runAfterDeleteHooks runs the AfterDelete hooks of the service, stopping at the first failing one.
+func (svc *AttachmentService) runAfterDeleteHooks(ctx context.Context, req *DeleteAttachmentRequest) error {
+	for _, h := range svc.hooks {
+		if h.AfterDelete != nil {
+			if err := h.AfterDelete(ctx, req); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// toDeleteAttachmentRequests returns the requests deleting the entries of the IDs, passed to the Delete
+// hooks by the methods deleting several entries.
+func toDeleteAttachmentRequests(ids []uuid.UUID) ([]*DeleteAttachmentRequest, error) {
+	reqs := make([]*DeleteAttachmentRequest, len(ids))
+	for i, item := range ids {
+		id, err := item.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		reqs[i] = &DeleteAttachmentRequest{Id: id}
+	}
+	return reqs, nil
+}
+
+// AttachmentServiceExtraMethods implements the methods of the AttachmentService added by entproto.ExtraMethod.
+// The AttachmentService returned by NewAttachmentService serves them with the given implementation.
+type AttachmentServiceExtraMethods interface {
+	// Upload stores the attachments streamed by the client.
+	Upload(AttachmentService_UploadServer) error
+	Sync(AttachmentService_SyncServer) error
+}
+
+// Upload implements AttachmentServiceServer.Upload with the AttachmentServiceExtraMethods of the service.
+// It answers with the Unimplemented code if the service has none.
+func (svc *AttachmentService) Upload(stream AttachmentService_UploadServer) error {
+	if svc.extra == nil {
+		return svc.UnimplementedAttachmentServiceServer.Upload(stream)
 	}
+	return svc.extra.Upload(stream)
+}
+
+// Sync implements AttachmentServiceServer.Sync with the AttachmentServiceExtraMethods of the service.
+// It answers with the Unimplemented code if the service has none.
+func (svc *AttachmentService) Sync(stream AttachmentService_SyncServer) error {
+	if svc.extra == nil {
+		return svc.UnimplementedAttachmentServiceServer.Sync(stream)
+	}
+	return svc.extra.Sync(stream)
 }
 
 // toProtoAttachment transforms the ent type to the pb type
@@ -52,6 +226,86 @@ func toProtoAttachment(e *ent.Attachment) (*Attachment, error) {
 	return v, nil
 }
 
+// ToProtoAttachmentWithEdges transforms the ent type to the pb type, converting its eager-loaded edges
+// into their messages down to the edge depth the package was generated with, see the edge_depth flag.
+// Edges beyond that depth, and edges of types without a service in the package, only hold their IDs.
+func ToProtoAttachmentWithEdges(e *ent.Attachment) (*Attachment, error) {
+	return toProtoAttachmentWithEdges(e, 1)
+}
+
+// toProtoAttachmentWithEdges transforms the ent type to the pb type, converting its eager-loaded edges
+// into their messages down to depth levels.
+func toProtoAttachmentWithEdges(e *ent.Attachment, depth int) (*Attachment, error) {
+	v, err := toProtoAttachment(e)
+	if err != nil {
+		return nil, err
+	}
+	if depth == 0 {
+		return v, nil
+	}
+	for i, edg := range e.Edges.Recipients {
+		if v.Recipients[i], err = toProtoUserWithEdges(edg, depth-1); err != nil {
+			return nil, err
+		}
+	}
+	if edg := e.Edges.User; edg != nil {
+		if v.User, err = toProtoUserWithEdges(edg, depth-1); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// ToEntAttachmentWithEdges transforms the pb type to the ent type, the reverse of ToProtoAttachmentWithEdges.
+// The edges of the message are set on the Edges of the entity down to the edge depth the package was
+// generated with. Edges beyond that depth only hold their IDs.
+func ToEntAttachmentWithEdges(v *Attachment) (*ent.Attachment, error) {
+	return toEntAttachmentWithEdges(v, 1)
+}
+
+// toEntAttachmentWithEdges transforms the pb type to the ent type, converting the edges of the message
+// into their entities down to depth levels.
+func toEntAttachmentWithEdges(v *Attachment, depth int) (*ent.Attachment, error) {
+	e, err := toEntAttachment(v)
+	if err != nil {
+		return nil, err
+	}
+	if depth == 0 {
+		return e, nil
+	}
+	for i, edg := range v.GetRecipients() {
+		if e.Edges.Recipients[i], err = toEntUserWithEdges(edg, depth-1); err != nil {
+			return nil, err
+		}
+	}
+	if edg := v.GetUser(); edg != nil {
+		if e.Edges.User, err = toEntUserWithEdges(edg, depth-1); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// toEntAttachment transforms the pb type to the ent type, setting the edges of the entity to entities
+// holding their IDs only.
+func toEntAttachment(v *Attachment) (*ent.Attachment, error) {
+	e := &ent.Attachment{}
+	var attachmentID uuid.UUID
+	if err := (&attachmentID).UnmarshalBinary(v.GetId()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	e.ID = attachmentID
+	for _, edg := range v.GetRecipients() {
+		recipientsID := uint32(edg.GetId())
+		e.Edges.Recipients = append(e.Edges.Recipients, &ent.User{ID: recipientsID})
+	}
+	if edg := v.GetUser(); edg != nil {
+		userID := uint32(edg.GetId())
+		e.Edges.User = &ent.User{ID: userID}
+	}
+	return e, nil
+}
+
 // toProtoAttachmentList transforms a list of ent type to a list of pb type
 func toProtoAttachmentList(e []*ent.Attachment) ([]*Attachment, error) {
 	var pbList []*Attachment
@@ -65,33 +319,86 @@ func toProtoAttachmentList(e []*ent.Attachment) ([]*Attachment, error) {
 	return pbList, nil
 }
 
+// attachmentValidationError returns an InvalidArgument status error for the ent validation error err,
+// holding the violation of the field of the request it failed on. prefix is the path of the Attachment
+// message in the request, or empty if the request is the message itself.
+func attachmentValidationError(err error, prefix string) error {
+	var verr *ent.ValidationError
+	if !errors.As(err, &verr) {
+		return status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	field := prefix + verr.Name
+	return runtime.FieldViolation(field, err)
+}
+
+// attachmentConstraintField returns the path of the field of the request violating the database constraint
+// of err, or an empty string if it is unknown. prefix is the path of the Attachment message in the request,
+// or empty if the request is the message itself.
+func attachmentConstraintField(err error, prefix string) string {
+	switch runtime.ConstraintColumn(err) {
+	case "id":
+		return prefix + "id"
+	case "user_attachment":
+		return prefix + "user"
+	}
+	return ""
+}
+
 // Create implements AttachmentServiceServer.Create
 func (svc *AttachmentService) Create(ctx context.Context, req *CreateAttachmentRequest) (*Attachment, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.AttachmentService/Create", "Attachment")
+	if err := svc.config.Writable("AttachmentService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.AttachmentService", "Create", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveCreate(ctx, req)
+	return res, svc.config.RecordRequest("entpb.AttachmentService", "Create", start, runtime.EndSpan(span, err))
+}
+
+// serveCreate serves the Create method within its span.
+func (svc *AttachmentService) serveCreate(ctx context.Context, req *CreateAttachmentRequest) (*Attachment, error) {
 	attachment := req.GetAttachment()
-	m, err := svc.createBuilder(attachment)
+	m, err := svc.createBuilder(ctx, attachment)
 	if err != nil {
 		return nil, err
 	}
+	if err := svc.runBeforeCreateHooks(ctx, req, m); err != nil {
+		return nil, err
+	}
+	runtime.TraceQuery(ctx)
 	res, err := m.Save(ctx)
 	switch {
 	case err == nil:
+		runtime.TraceID(ctx, res.ID)
+		runtime.TraceConvert(ctx)
 		proto, err := toProtoAttachment(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		if err := svc.runAfterCreateHooks(ctx, req, proto); err != nil {
+			return nil, err
 		}
 		return proto, nil
-	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsValidationError(err):
+		return nil, attachmentValidationError(err, "attachment.")
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, svc.config.ConstraintError(err, attachmentConstraintField(err, "attachment."))
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Get implements AttachmentServiceServer.Get
 func (svc *AttachmentService) Get(ctx context.Context, req *GetAttachmentRequest) (*Attachment, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.AttachmentService/Get", "Attachment")
+	res, err := svc.serveGet(ctx, req)
+	return res, svc.config.RecordRequest("entpb.AttachmentService", "Get", start, runtime.EndSpan(span, err))
+}
+
+// serveGet serves the Get method within its span.
+func (svc *AttachmentService) serveGet(ctx context.Context, req *GetAttachmentRequest) (*Attachment, error) {
 	var (
 		err error
 		get *ent.Attachment
@@ -100,6 +407,16 @@ func (svc *AttachmentService) Get(ctx context.Context, req *GetAttachmentRequest
 	if err := (&id).UnmarshalBinary(req.GetId()); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
 	}
+	runtime.TraceID(ctx, id)
+	// Entities requested without their edges are served from the cache of the service, if any.
+	cacheable := (req.GetView() == GetAttachmentRequest_VIEW_UNSPECIFIED || req.GetView() == GetAttachmentRequest_BASIC)
+	if cacheable {
+		cached := &Attachment{}
+		if svc.config.CachedEntity(ctx, "Attachment", id, cached) {
+			return cached, nil
+		}
+	}
+	runtime.TraceQuery(ctx)
 	switch req.GetView() {
 	case GetAttachmentRequest_VIEW_UNSPECIFIED, GetAttachmentRequest_BASIC:
 		get, err = svc.client.Attachment.Get(ctx, id)
@@ -118,98 +435,227 @@ func (svc *AttachmentService) Get(ctx context.Context, req *GetAttachmentRequest
 	}
 	switch {
 	case err == nil:
-		return toProtoAttachment(get)
+		runtime.TraceConvert(ctx)
+		protoGet, err := toProtoAttachment(get)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		if cacheable {
+			svc.config.CacheEntity(ctx, "Attachment", id, protoGet)
+		}
+		return protoGet, nil
 	case ent.IsNotFound(err):
-		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+		return nil, svc.config.MapError(err, codes.NotFound, "not found: %s", err)
+	default:
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Exists implements AttachmentServiceServer.Exists
+func (svc *AttachmentService) Exists(ctx context.Context, req *ExistsAttachmentRequest) (*ExistsAttachmentResponse, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.AttachmentService/Exists", "Attachment")
+	res, err := svc.serveExists(ctx, req)
+	return res, svc.config.RecordRequest("entpb.AttachmentService", "Exists", start, runtime.EndSpan(span, err))
+}
+
+// serveExists serves the Exists method within its span.
+func (svc *AttachmentService) serveExists(ctx context.Context, req *ExistsAttachmentRequest) (*ExistsAttachmentResponse, error) {
+	query := svc.client.Attachment.Query()
+	switch key := req.GetKey().(type) {
+	case *ExistsAttachmentRequest_Id:
+		var id uuid.UUID
+		if err := (&id).UnmarshalBinary(key.Id); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		query = query.Where(attachment.ID(id))
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: key must be set")
+	}
+	exists, err := query.Exist(ctx)
+	if err != nil {
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
+	return &ExistsAttachmentResponse{
+		Exists: exists,
+	}, nil
 
 }
 
 // Update implements AttachmentServiceServer.Update
 func (svc *AttachmentService) Update(ctx context.Context, req *UpdateAttachmentRequest) (*Attachment, error) {
-	attachment := req.GetAttachment()
-	var attachmentID uuid.UUID
-	if err := (&attachmentID).UnmarshalBinary(attachment.GetId()); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.AttachmentService/Update", "Attachment")
+	if err := svc.config.Writable("AttachmentService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.AttachmentService", "Update", start, runtime.EndSpan(span, err))
 	}
-	m := svc.client.Attachment.UpdateOneID(attachmentID)
-	for _, item := range attachment.GetRecipients() {
-		recipients := uint32(item.GetId())
-		m.AddRecipientIDs(recipients)
+	res, err := svc.serveUpdate(ctx, req)
+	return res, svc.config.RecordRequest("entpb.AttachmentService", "Update", start, runtime.EndSpan(span, err))
+}
+
+// serveUpdate serves the Update method within its span.
+func (svc *AttachmentService) serveUpdate(ctx context.Context, req *UpdateAttachmentRequest) (*Attachment, error) {
+	attachment := req.GetAttachment()
+	m, err := svc.updateBuilder(ctx, svc.client, attachment)
+	if err != nil {
+		return nil, err
 	}
-	if attachment.GetUser() != nil {
-		attachmentUser := uint32(attachment.GetUser().GetId())
-		m.SetUserID(attachmentUser)
+	if err := svc.runBeforeUpdateHooks(ctx, req, m); err != nil {
+		return nil, err
 	}
-
+	runtime.TraceQuery(ctx)
 	res, err := m.Save(ctx)
 	switch {
 	case err == nil:
+		runtime.TraceID(ctx, res.ID)
+		if err := svc.config.InvalidateCache(ctx, "Attachment", res.ID); err != nil {
+			return nil, err
+		}
+		runtime.TraceConvert(ctx)
 		proto, err := toProtoAttachment(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		if err := svc.runAfterUpdateHooks(ctx, req, proto); err != nil {
+			return nil, err
 		}
 		return proto, nil
-	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsValidationError(err):
+		return nil, attachmentValidationError(err, "attachment.")
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, svc.config.ConstraintError(err, attachmentConstraintField(err, "attachment."))
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Delete implements AttachmentServiceServer.Delete
 func (svc *AttachmentService) Delete(ctx context.Context, req *DeleteAttachmentRequest) (*emptypb.Empty, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.AttachmentService/Delete", "Attachment")
+	if err := svc.config.Writable("AttachmentService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.AttachmentService", "Delete", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveDelete(ctx, req)
+	return res, svc.config.RecordRequest("entpb.AttachmentService", "Delete", start, runtime.EndSpan(span, err))
+}
+
+// serveDelete serves the Delete method within its span.
+func (svc *AttachmentService) serveDelete(ctx context.Context, req *DeleteAttachmentRequest) (*emptypb.Empty, error) {
 	var err error
 	var id uuid.UUID
 	if err := (&id).UnmarshalBinary(req.GetId()); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
 	}
+	runtime.TraceID(ctx, id)
+	if err := svc.runBeforeDeleteHooks(ctx, req); err != nil {
+		return nil, err
+	}
+	runtime.TraceQuery(ctx)
 	err = svc.client.Attachment.DeleteOneID(id).Exec(ctx)
 	switch {
 	case err == nil:
+		if err := svc.config.InvalidateCache(ctx, "Attachment", id); err != nil {
+			return nil, err
+		}
+		if err := svc.runAfterDeleteHooks(ctx, req); err != nil {
+			return nil, err
+		}
 		return &emptypb.Empty{}, nil
 	case ent.IsNotFound(err):
-		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+		return nil, svc.config.MapError(err, codes.NotFound, "not found: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, svc.config.ConstraintError(err, "")
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
 // List implements AttachmentServiceServer.List
 func (svc *AttachmentService) List(ctx context.Context, req *ListAttachmentRequest) (*ListAttachmentResponse, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.AttachmentService/List", "Attachment")
+	res, err := svc.serveList(ctx, req)
+	return res, svc.config.RecordRequest("entpb.AttachmentService", "List", start, runtime.EndSpan(span, err))
+}
+
+// serveList serves the List method within its span.
+func (svc *AttachmentService) serveList(ctx context.Context, req *ListAttachmentRequest) (*ListAttachmentResponse, error) {
 	var (
 		err      error
 		entList  []*ent.Attachment
 		pageSize int
+		offset   int
 	)
+	defaultSize, maxSize := svc.config.PageSizes(entproto.MaxPageSize, entproto.MaxPageSize)
 	pageSize = int(req.GetPageSize())
 	switch {
 	case pageSize < 0:
 		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
-	case pageSize == 0 || pageSize > entproto.MaxPageSize:
-		pageSize = entproto.MaxPageSize
-	}
-	listQuery := svc.client.Attachment.Query().
-		Order(ent.Desc(attachment.FieldID)).
-		Limit(pageSize + 1)
-	if req.GetPageToken() != "" {
-		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+	case pageSize == 0:
+		pageSize = defaultSize
+	case pageSize > maxSize:
+		pageSize = maxSize
+	}
+	svc.config.RecordPageSize("entpb.AttachmentService", "List", pageSize)
+	cursor, err := svc.config.VerifyPageToken("entpb.AttachmentService/List", req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	listQuery := svc.client.Attachment.Query()
+	listQuery = listQuery.Limit(pageSize + 1)
+	if req.GetOrderBy() != "" {
+		terms, err := runtime.ParseOrderBy(req.GetOrderBy())
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+			return nil, svc.config.MapError(err, codes.InvalidArgument, "order by is invalid: %s", err)
 		}
-		pageToken, err := uuid.ParseBytes(bytes)
-		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		for _, t := range terms {
+			var field string
+			switch t.Field {
+			case "id":
+				field = attachment.FieldID
+			default:
+				return nil, status.Errorf(codes.InvalidArgument, "order by field %q is not sortable", t.Field)
+			}
+			if t.Desc {
+				listQuery = listQuery.Order(ent.Desc(field))
+			} else {
+				listQuery = listQuery.Order(ent.Asc(field))
+			}
+		}
+		// The ID breaks the ties between entities, making the pages stable.
+		// Ordered pages are tokenized by their offset.
+		listQuery = listQuery.Order(ent.Asc(attachment.FieldID))
+		if cursor != "" {
+			bytes, err := base64.StdEncoding.DecodeString(cursor)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, "page token is invalid")
+			}
+			offset, err = strconv.Atoi(string(bytes))
+			if err != nil || offset < 0 {
+				return nil, status.Error(codes.InvalidArgument, "page token is invalid")
+			}
+			listQuery = listQuery.Offset(offset)
+		}
+	} else {
+		listQuery = listQuery.Order(ent.Desc(attachment.FieldID))
+		if cursor != "" {
+			bytes, err := base64.StdEncoding.DecodeString(cursor)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, "page token is invalid")
+			}
+			pageToken, err := uuid.ParseBytes(bytes)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, "page token is invalid")
+			}
+			listQuery = listQuery.
+				Where(attachment.IDLTE(pageToken))
 		}
-		listQuery = listQuery.
-			Where(attachment.IDLTE(pageToken))
 	}
+	runtime.TraceQuery(ctx)
 	switch req.GetView() {
 	case ListAttachmentRequest_VIEW_UNSPECIFIED, ListAttachmentRequest_BASIC:
 		entList, err = listQuery.All(ctx)
@@ -226,62 +672,351 @@ func (svc *AttachmentService) List(ctx context.Context, req *ListAttachmentReque
 	switch {
 	case err == nil:
 		var nextPageToken string
-		if len(entList) == pageSize+1 {
+		switch {
+		case len(entList) <= pageSize:
+		case req.GetOrderBy() != "":
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(strconv.Itoa(offset + pageSize)))
+			entList = entList[:len(entList)-1]
+		default:
 			nextPageToken = base64.StdEncoding.EncodeToString(
 				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
 			entList = entList[:len(entList)-1]
 		}
+		nextPageToken = svc.config.SignPageToken("entpb.AttachmentService/List", nextPageToken)
+		runtime.TraceRows(ctx, len(entList))
+		runtime.TraceConvert(ctx)
 		protoList, err := toProtoAttachmentList(entList)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 		}
 		return &ListAttachmentResponse{
 			AttachmentList: protoList,
 			NextPageToken:  nextPageToken,
 		}, nil
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
+// Count implements AttachmentServiceServer.Count
+func (svc *AttachmentService) Count(ctx context.Context, req *CountAttachmentsRequest) (*CountAttachmentsResponse, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.AttachmentService/Count", "Attachment")
+	res, err := svc.serveCount(ctx, req)
+	return res, svc.config.RecordRequest("entpb.AttachmentService", "Count", start, runtime.EndSpan(span, err))
+}
+
+// serveCount serves the Count method within its span.
+func (svc *AttachmentService) serveCount(ctx context.Context, req *CountAttachmentsRequest) (*CountAttachmentsResponse, error) {
+	countQuery := svc.client.Attachment.Query()
+	count, err := countQuery.Count(ctx)
+	if err != nil {
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	return &CountAttachmentsResponse{
+		Count: int64(count),
+	}, nil
+
+}
+
 // BatchCreate implements AttachmentServiceServer.BatchCreate
 func (svc *AttachmentService) BatchCreate(ctx context.Context, req *BatchCreateAttachmentsRequest) (*BatchCreateAttachmentsResponse, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.AttachmentService/BatchCreate", "Attachment")
+	if err := svc.config.Writable("AttachmentService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.AttachmentService", "BatchCreate", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveBatchCreate(ctx, req)
+	return res, svc.config.RecordRequest("entpb.AttachmentService", "BatchCreate", start, runtime.EndSpan(span, err))
+}
+
+// serveBatchCreate serves the BatchCreate method within its span.
+func (svc *AttachmentService) serveBatchCreate(ctx context.Context, req *BatchCreateAttachmentsRequest) (*BatchCreateAttachmentsResponse, error) {
 	requests := req.GetRequests()
-	if len(requests) > entproto.MaxBatchCreateSize {
-		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	svc.config.RecordBatchSize("entpb.AttachmentService", "BatchCreate", len(requests))
+	maxSize := svc.config.BatchSize(entproto.MaxBatchCreateSize)
+	if len(requests) > maxSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", maxSize)
 	}
-	bulk := make([]*ent.AttachmentCreate, len(requests))
+	runtime.TraceQuery(ctx)
+	// The entries are created concurrently if the service is configured with runtime.WithBatchCreateParallelism,
+	// each filling the result at its index.
+	results := make([]*BatchCreateAttachmentResult, len(requests))
+	var g errgroup.Group
+	g.SetLimit(svc.config.Parallelism())
 	for i, req := range requests {
-		attachment := req.GetAttachment()
-		var err error
-		bulk[i], err = svc.createBuilder(attachment)
-		if err != nil {
+		i, req := i, req
+		g.Go(func() error {
+			attachment := req.GetAttachment()
+			m, err := svc.createBuilder(ctx, attachment)
+			if err == nil {
+				err = svc.runBeforeCreateHooks(ctx, req, m)
+			}
+			if err == nil {
+				var res *ent.Attachment
+				res, err = m.Save(ctx)
+				switch {
+				case err == nil:
+					protoEntity, err := toProtoAttachment(res)
+					if err != nil {
+						return svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+					}
+					// The entry is created, but the failure of its After hooks is its result.
+					if err := svc.runAfterCreateHooks(ctx, req, protoEntity); err != nil {
+						results[i] = &BatchCreateAttachmentResult{
+							Result: &BatchCreateAttachmentResult_Status{Status: status.Convert(err).Proto()},
+						}
+						return nil
+					}
+					results[i] = &BatchCreateAttachmentResult{
+						Result: &BatchCreateAttachmentResult_Attachment{Attachment: protoEntity},
+					}
+					return nil
+				case ent.IsValidationError(err):
+					err = attachmentValidationError(err, "attachment.")
+				case ent.IsConstraintError(err):
+					err = svc.config.ConstraintError(err, attachmentConstraintField(err, "attachment."))
+				default:
+					err = svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+				}
+			}
+			// The failure of an entry does not fail the others.
+			results[i] = &BatchCreateAttachmentResult{
+				Result: &BatchCreateAttachmentResult_Status{Status: status.Convert(err).Proto()},
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return &BatchCreateAttachmentsResponse{
+		Results: results,
+	}, nil
+
+}
+
+// BatchDelete implements AttachmentServiceServer.BatchDelete
+func (svc *AttachmentService) BatchDelete(ctx context.Context, req *BatchDeleteAttachmentsRequest) (*emptypb.Empty, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.AttachmentService/BatchDelete", "Attachment")
+	if err := svc.config.Writable("AttachmentService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.AttachmentService", "BatchDelete", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveBatchDelete(ctx, req)
+	return res, svc.config.RecordRequest("entpb.AttachmentService", "BatchDelete", start, runtime.EndSpan(span, err))
+}
+
+// serveBatchDelete serves the BatchDelete method within its span.
+func (svc *AttachmentService) serveBatchDelete(ctx context.Context, req *BatchDeleteAttachmentsRequest) (*emptypb.Empty, error) {
+	ids := req.GetIds()
+	svc.config.RecordBatchSize("entpb.AttachmentService", "BatchDelete", len(ids))
+	maxSize := svc.config.BatchSize(entproto.MaxBatchDeleteSize)
+	if len(ids) > maxSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", maxSize)
+	}
+	if len(ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: ids must be set")
+	}
+	entIDs := make([]uuid.UUID, len(ids))
+	for i, item := range ids {
+		var id uuid.UUID
+		if err := (&id).UnmarshalBinary(item); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		entIDs[i] = id
+	}
+	tx, err := svc.client.Tx(ctx)
+	if err != nil {
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	// The IDs of the entries are looked up in the transaction deleting them, so the hooks are run for each
+	// of them.
+	idQuery := tx.Attachment.Query()
+	idQuery = idQuery.Where(attachment.IDIn(entIDs...))
+	deletedIDs, err := idQuery.IDs(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	deleteReqs, err := toDeleteAttachmentRequests(deletedIDs)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	for _, req := range deleteReqs {
+		if err := svc.runBeforeDeleteHooks(ctx, req); err != nil {
+			_ = tx.Rollback()
 			return nil, err
 		}
 	}
-	res, err := svc.client.Attachment.CreateBulk(bulk...).Save(ctx)
+	deleteQuery := tx.Attachment.Delete()
+	deleteQuery = deleteQuery.Where(attachment.IDIn(deletedIDs...))
+	runtime.TraceQuery(ctx)
+	n, err := deleteQuery.Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	for _, id := range deletedIDs {
+		if err := svc.config.InvalidateCache(ctx, "Attachment", id); err != nil {
+			return nil, err
+		}
+	}
+	for _, req := range deleteReqs {
+		if err := svc.runAfterDeleteHooks(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	runtime.TraceRows(ctx, n)
+	return &emptypb.Empty{}, nil
+
+}
+
+// ListAttachmentRecipients implements AttachmentServiceServer.ListAttachmentRecipients
+func (svc *AttachmentService) ListAttachmentRecipients(ctx context.Context, req *ListAttachmentRecipientsRequest) (*ListAttachmentRecipientsResponse, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.AttachmentService/ListAttachmentRecipients", "Attachment")
+	res, err := svc.serveListAttachmentRecipients(ctx, req)
+	return res, svc.config.RecordRequest("entpb.AttachmentService", "ListAttachmentRecipients", start, runtime.EndSpan(span, err))
+}
+
+// serveListAttachmentRecipients serves the ListAttachmentRecipients method within its span.
+func (svc *AttachmentService) serveListAttachmentRecipients(ctx context.Context, req *ListAttachmentRecipientsRequest) (*ListAttachmentRecipientsResponse, error) {
+	var (
+		err error
+		get *ent.Attachment
+	)
+	var id uuid.UUID
+	if err := (&id).UnmarshalBinary(req.GetId()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	get, err = svc.client.Attachment.Get(ctx, id)
 	switch {
 	case err == nil:
-		protoList, err := toProtoAttachmentList(res)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	case ent.IsNotFound(err):
+		return nil, svc.config.MapError(err, codes.NotFound, "not found: %s", err)
+	default:
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	ids, err := svc.client.Attachment.QueryRecipients(get).IDs(ctx)
+	if err != nil {
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	res := &ListAttachmentRecipientsResponse{}
+	for _, edg := range ids {
+		recipientID := edg
+		res.RecipientIds = append(res.RecipientIds, recipientID)
+	}
+	return res, nil
+
+}
+
+// AddAttachmentRecipient implements AttachmentServiceServer.AddAttachmentRecipient
+func (svc *AttachmentService) AddAttachmentRecipient(ctx context.Context, req *AddAttachmentRecipientRequest) (*emptypb.Empty, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.AttachmentService/AddAttachmentRecipient", "Attachment")
+	if err := svc.config.Writable("AttachmentService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.AttachmentService", "AddAttachmentRecipient", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveAddAttachmentRecipient(ctx, req)
+	return res, svc.config.RecordRequest("entpb.AttachmentService", "AddAttachmentRecipient", start, runtime.EndSpan(span, err))
+}
+
+// serveAddAttachmentRecipient serves the AddAttachmentRecipient method within its span.
+func (svc *AttachmentService) serveAddAttachmentRecipient(ctx context.Context, req *AddAttachmentRecipientRequest) (*emptypb.Empty, error) {
+	var err error
+	var id uuid.UUID
+	if err := (&id).UnmarshalBinary(req.GetId()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	recipientID := uint32(req.GetRecipientId())
+	err = svc.client.Attachment.UpdateOneID(id).AddRecipientIDs(recipientID).Exec(ctx)
+	switch {
+	case err == nil:
+		if err := svc.config.InvalidateCache(ctx, "Attachment", id); err != nil {
+			return nil, err
 		}
-		return &BatchCreateAttachmentsResponse{
-			Attachments: protoList,
-		}, nil
-	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, svc.config.MapError(err, codes.NotFound, "not found: %s", err)
 	case ent.IsConstraintError(err):
+		return nil, svc.config.ConstraintError(err, "")
+	default:
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// RemoveAttachmentRecipient implements AttachmentServiceServer.RemoveAttachmentRecipient
+func (svc *AttachmentService) RemoveAttachmentRecipient(ctx context.Context, req *RemoveAttachmentRecipientRequest) (*emptypb.Empty, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.AttachmentService/RemoveAttachmentRecipient", "Attachment")
+	if err := svc.config.Writable("AttachmentService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.AttachmentService", "RemoveAttachmentRecipient", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveRemoveAttachmentRecipient(ctx, req)
+	return res, svc.config.RecordRequest("entpb.AttachmentService", "RemoveAttachmentRecipient", start, runtime.EndSpan(span, err))
+}
+
+// serveRemoveAttachmentRecipient serves the RemoveAttachmentRecipient method within its span.
+func (svc *AttachmentService) serveRemoveAttachmentRecipient(ctx context.Context, req *RemoveAttachmentRecipientRequest) (*emptypb.Empty, error) {
+	var err error
+	var id uuid.UUID
+	if err := (&id).UnmarshalBinary(req.GetId()); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	recipientID := uint32(req.GetRecipientId())
+	err = svc.client.Attachment.UpdateOneID(id).RemoveRecipientIDs(recipientID).Exec(ctx)
+	switch {
+	case err == nil:
+		if err := svc.config.InvalidateCache(ctx, "Attachment", id); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, svc.config.MapError(err, codes.NotFound, "not found: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, svc.config.ConstraintError(err, "")
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
-func (svc *AttachmentService) createBuilder(attachment *Attachment) (*ent.AttachmentCreate, error) {
+func (svc *AttachmentService) createBuilder(ctx context.Context, attachment *Attachment) (*ent.AttachmentCreate, error) {
 	m := svc.client.Attachment.Create()
+	// The id is generated unless the request sets one.
+	if len(attachment.GetId()) > 0 {
+		var attachmentID uuid.UUID
+		if err := (&attachmentID).UnmarshalBinary(attachment.GetId()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		m.SetID(attachmentID)
+	}
+	for _, item := range attachment.GetRecipients() {
+		recipients := uint32(item.GetId())
+		m.AddRecipientIDs(recipients)
+	}
+	if attachment.GetUser() != nil {
+		attachmentUser := uint32(attachment.GetUser().GetId())
+		m.SetUserID(attachmentUser)
+	}
+
+	return m, nil
+}
+
+func (svc *AttachmentService) updateBuilder(ctx context.Context, client *ent.Client, attachment *Attachment) (*ent.AttachmentUpdateOne, error) {
+	var attachmentID uuid.UUID
+	if err := (&attachmentID).UnmarshalBinary(attachment.GetId()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	m := client.Attachment.UpdateOneID(attachmentID)
 	for _, item := range attachment.GetRecipients() {
 		recipients := uint32(item.GetId())
 		m.AddRecipientIDs(recipients)
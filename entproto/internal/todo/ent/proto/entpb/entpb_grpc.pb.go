@@ -25,10 +25,19 @@ const _ = grpc.SupportPackageIsVersion7
 type AttachmentServiceClient interface {
 	Create(ctx context.Context, in *CreateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
 	Get(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
+	Exists(ctx context.Context, in *ExistsAttachmentRequest, opts ...grpc.CallOption) (*ExistsAttachmentResponse, error)
 	Update(ctx context.Context, in *UpdateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
 	Delete(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	List(ctx context.Context, in *ListAttachmentRequest, opts ...grpc.CallOption) (*ListAttachmentResponse, error)
+	Count(ctx context.Context, in *CountAttachmentsRequest, opts ...grpc.CallOption) (*CountAttachmentsResponse, error)
 	BatchCreate(ctx context.Context, in *BatchCreateAttachmentsRequest, opts ...grpc.CallOption) (*BatchCreateAttachmentsResponse, error)
+	BatchDelete(ctx context.Context, in *BatchDeleteAttachmentsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListAttachmentRecipients(ctx context.Context, in *ListAttachmentRecipientsRequest, opts ...grpc.CallOption) (*ListAttachmentRecipientsResponse, error)
+	AddAttachmentRecipient(ctx context.Context, in *AddAttachmentRecipientRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveAttachmentRecipient(ctx context.Context, in *RemoveAttachmentRecipientRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Upload stores the attachments streamed by the client.
+	Upload(ctx context.Context, opts ...grpc.CallOption) (AttachmentService_UploadClient, error)
+	Sync(ctx context.Context, opts ...grpc.CallOption) (AttachmentService_SyncClient, error)
 }
 
 type attachmentServiceClient struct {
@@ -57,6 +66,15 @@ func (c *attachmentServiceClient) Get(ctx context.Context, in *GetAttachmentRequ
 	return out, nil
 }
 
+func (c *attachmentServiceClient) Exists(ctx context.Context, in *ExistsAttachmentRequest, opts ...grpc.CallOption) (*ExistsAttachmentResponse, error) {
+	out := new(ExistsAttachmentResponse)
+	err := c.cc.Invoke(ctx, "/entpb.AttachmentService/Exists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *attachmentServiceClient) Update(ctx context.Context, in *UpdateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error) {
 	out := new(Attachment)
 	err := c.cc.Invoke(ctx, "/entpb.AttachmentService/Update", in, out, opts...)
@@ -84,6 +102,15 @@ func (c *attachmentServiceClient) List(ctx context.Context, in *ListAttachmentRe
 	return out, nil
 }
 
+func (c *attachmentServiceClient) Count(ctx context.Context, in *CountAttachmentsRequest, opts ...grpc.CallOption) (*CountAttachmentsResponse, error) {
+	out := new(CountAttachmentsResponse)
+	err := c.cc.Invoke(ctx, "/entpb.AttachmentService/Count", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *attachmentServiceClient) BatchCreate(ctx context.Context, in *BatchCreateAttachmentsRequest, opts ...grpc.CallOption) (*BatchCreateAttachmentsResponse, error) {
 	out := new(BatchCreateAttachmentsResponse)
 	err := c.cc.Invoke(ctx, "/entpb.AttachmentService/BatchCreate", in, out, opts...)
@@ -93,16 +120,126 @@ func (c *attachmentServiceClient) BatchCreate(ctx context.Context, in *BatchCrea
 	return out, nil
 }
 
+func (c *attachmentServiceClient) BatchDelete(ctx context.Context, in *BatchDeleteAttachmentsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/entpb.AttachmentService/BatchDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) ListAttachmentRecipients(ctx context.Context, in *ListAttachmentRecipientsRequest, opts ...grpc.CallOption) (*ListAttachmentRecipientsResponse, error) {
+	out := new(ListAttachmentRecipientsResponse)
+	err := c.cc.Invoke(ctx, "/entpb.AttachmentService/ListAttachmentRecipients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) AddAttachmentRecipient(ctx context.Context, in *AddAttachmentRecipientRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/entpb.AttachmentService/AddAttachmentRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) RemoveAttachmentRecipient(ctx context.Context, in *RemoveAttachmentRecipientRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/entpb.AttachmentService/RemoveAttachmentRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) Upload(ctx context.Context, opts ...grpc.CallOption) (AttachmentService_UploadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AttachmentService_ServiceDesc.Streams[0], "/entpb.AttachmentService/Upload", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &attachmentServiceUploadClient{stream}
+	return x, nil
+}
+
+type AttachmentService_UploadClient interface {
+	Send(*Attachment) error
+	CloseAndRecv() (*emptypb.Empty, error)
+	grpc.ClientStream
+}
+
+type attachmentServiceUploadClient struct {
+	grpc.ClientStream
+}
+
+func (x *attachmentServiceUploadClient) Send(m *Attachment) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *attachmentServiceUploadClient) CloseAndRecv() (*emptypb.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(emptypb.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *attachmentServiceClient) Sync(ctx context.Context, opts ...grpc.CallOption) (AttachmentService_SyncClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AttachmentService_ServiceDesc.Streams[1], "/entpb.AttachmentService/Sync", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &attachmentServiceSyncClient{stream}
+	return x, nil
+}
+
+type AttachmentService_SyncClient interface {
+	Send(*Attachment) error
+	Recv() (*Attachment, error)
+	grpc.ClientStream
+}
+
+type attachmentServiceSyncClient struct {
+	grpc.ClientStream
+}
+
+func (x *attachmentServiceSyncClient) Send(m *Attachment) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *attachmentServiceSyncClient) Recv() (*Attachment, error) {
+	m := new(Attachment)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AttachmentServiceServer is the server API for AttachmentService service.
 // All implementations must embed UnimplementedAttachmentServiceServer
 // for forward compatibility
 type AttachmentServiceServer interface {
 	Create(context.Context, *CreateAttachmentRequest) (*Attachment, error)
 	Get(context.Context, *GetAttachmentRequest) (*Attachment, error)
+	Exists(context.Context, *ExistsAttachmentRequest) (*ExistsAttachmentResponse, error)
 	Update(context.Context, *UpdateAttachmentRequest) (*Attachment, error)
 	Delete(context.Context, *DeleteAttachmentRequest) (*emptypb.Empty, error)
 	List(context.Context, *ListAttachmentRequest) (*ListAttachmentResponse, error)
+	Count(context.Context, *CountAttachmentsRequest) (*CountAttachmentsResponse, error)
 	BatchCreate(context.Context, *BatchCreateAttachmentsRequest) (*BatchCreateAttachmentsResponse, error)
+	BatchDelete(context.Context, *BatchDeleteAttachmentsRequest) (*emptypb.Empty, error)
+	ListAttachmentRecipients(context.Context, *ListAttachmentRecipientsRequest) (*ListAttachmentRecipientsResponse, error)
+	AddAttachmentRecipient(context.Context, *AddAttachmentRecipientRequest) (*emptypb.Empty, error)
+	RemoveAttachmentRecipient(context.Context, *RemoveAttachmentRecipientRequest) (*emptypb.Empty, error)
+	// Upload stores the attachments streamed by the client.
+	Upload(AttachmentService_UploadServer) error
+	Sync(AttachmentService_SyncServer) error
 	mustEmbedUnimplementedAttachmentServiceServer()
 }
 
@@ -116,6 +253,9 @@ func (UnimplementedAttachmentServiceServer) Create(context.Context, *CreateAttac
 func (UnimplementedAttachmentServiceServer) Get(context.Context, *GetAttachmentRequest) (*Attachment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
+func (UnimplementedAttachmentServiceServer) Exists(context.Context, *ExistsAttachmentRequest) (*ExistsAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exists not implemented")
+}
 func (UnimplementedAttachmentServiceServer) Update(context.Context, *UpdateAttachmentRequest) (*Attachment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
@@ -125,9 +265,30 @@ func (UnimplementedAttachmentServiceServer) Delete(context.Context, *DeleteAttac
 func (UnimplementedAttachmentServiceServer) List(context.Context, *ListAttachmentRequest) (*ListAttachmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedAttachmentServiceServer) Count(context.Context, *CountAttachmentsRequest) (*CountAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
 func (UnimplementedAttachmentServiceServer) BatchCreate(context.Context, *BatchCreateAttachmentsRequest) (*BatchCreateAttachmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
 }
+func (UnimplementedAttachmentServiceServer) BatchDelete(context.Context, *BatchDeleteAttachmentsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
+}
+func (UnimplementedAttachmentServiceServer) ListAttachmentRecipients(context.Context, *ListAttachmentRecipientsRequest) (*ListAttachmentRecipientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachmentRecipients not implemented")
+}
+func (UnimplementedAttachmentServiceServer) AddAttachmentRecipient(context.Context, *AddAttachmentRecipientRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAttachmentRecipient not implemented")
+}
+func (UnimplementedAttachmentServiceServer) RemoveAttachmentRecipient(context.Context, *RemoveAttachmentRecipientRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAttachmentRecipient not implemented")
+}
+func (UnimplementedAttachmentServiceServer) Upload(AttachmentService_UploadServer) error {
+	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
+}
+func (UnimplementedAttachmentServiceServer) Sync(AttachmentService_SyncServer) error {
+	return status.Errorf(codes.Unimplemented, "method Sync not implemented")
+}
 func (UnimplementedAttachmentServiceServer) mustEmbedUnimplementedAttachmentServiceServer() {}
 
 // UnsafeAttachmentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -177,6 +338,24 @@ func _AttachmentService_Get_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AttachmentService_Exists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExistsAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).Exists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.AttachmentService/Exists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).Exists(ctx, req.(*ExistsAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AttachmentService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateAttachmentRequest)
 	if err := dec(in); err != nil {
@@ -231,6 +410,24 @@ func _AttachmentService_List_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AttachmentService_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).Count(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.AttachmentService/Count",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).Count(ctx, req.(*CountAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AttachmentService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BatchCreateAttachmentsRequest)
 	if err := dec(in); err != nil {
@@ -249,6 +446,130 @@ func _AttachmentService_BatchCreate_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AttachmentService_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).BatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.AttachmentService/BatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).BatchDelete(ctx, req.(*BatchDeleteAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_ListAttachmentRecipients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentRecipientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).ListAttachmentRecipients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.AttachmentService/ListAttachmentRecipients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).ListAttachmentRecipients(ctx, req.(*ListAttachmentRecipientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_AddAttachmentRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAttachmentRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).AddAttachmentRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.AttachmentService/AddAttachmentRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).AddAttachmentRecipient(ctx, req.(*AddAttachmentRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_RemoveAttachmentRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAttachmentRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).RemoveAttachmentRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.AttachmentService/RemoveAttachmentRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).RemoveAttachmentRecipient(ctx, req.(*RemoveAttachmentRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_Upload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AttachmentServiceServer).Upload(&attachmentServiceUploadServer{stream})
+}
+
+type AttachmentService_UploadServer interface {
+	SendAndClose(*emptypb.Empty) error
+	Recv() (*Attachment, error)
+	grpc.ServerStream
+}
+
+type attachmentServiceUploadServer struct {
+	grpc.ServerStream
+}
+
+func (x *attachmentServiceUploadServer) SendAndClose(m *emptypb.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *attachmentServiceUploadServer) Recv() (*Attachment, error) {
+	m := new(Attachment)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _AttachmentService_Sync_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AttachmentServiceServer).Sync(&attachmentServiceSyncServer{stream})
+}
+
+type AttachmentService_SyncServer interface {
+	Send(*Attachment) error
+	Recv() (*Attachment, error)
+	grpc.ServerStream
+}
+
+type attachmentServiceSyncServer struct {
+	grpc.ServerStream
+}
+
+func (x *attachmentServiceSyncServer) Send(m *Attachment) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *attachmentServiceSyncServer) Recv() (*Attachment, error) {
+	m := new(Attachment)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AttachmentService_ServiceDesc is the grpc.ServiceDesc for AttachmentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -264,6 +585,10 @@ var AttachmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _AttachmentService_Get_Handler,
 		},
+		{
+			MethodName: "Exists",
+			Handler:    _AttachmentService_Exists_Handler,
+		},
 		{
 			MethodName: "Update",
 			Handler:    _AttachmentService_Update_Handler,
@@ -276,972 +601,3207 @@ var AttachmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _AttachmentService_List_Handler,
 		},
+		{
+			MethodName: "Count",
+			Handler:    _AttachmentService_Count_Handler,
+		},
 		{
 			MethodName: "BatchCreate",
 			Handler:    _AttachmentService_BatchCreate_Handler,
 		},
+		{
+			MethodName: "BatchDelete",
+			Handler:    _AttachmentService_BatchDelete_Handler,
+		},
+		{
+			MethodName: "ListAttachmentRecipients",
+			Handler:    _AttachmentService_ListAttachmentRecipients_Handler,
+		},
+		{
+			MethodName: "AddAttachmentRecipient",
+			Handler:    _AttachmentService_AddAttachmentRecipient_Handler,
+		},
+		{
+			MethodName: "RemoveAttachmentRecipient",
+			Handler:    _AttachmentService_RemoveAttachmentRecipient_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Upload",
+			Handler:       _AttachmentService_Upload_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Sync",
+			Handler:       _AttachmentService_Sync_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "entpb/entpb.proto",
 }
 
-// MultiWordSchemaServiceClient is the client API for MultiWordSchemaService service.
+// DocumentServiceClient is the client API for DocumentService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type MultiWordSchemaServiceClient interface {
-	Create(ctx context.Context, in *CreateMultiWordSchemaRequest, opts ...grpc.CallOption) (*MultiWordSchema, error)
-	Get(ctx context.Context, in *GetMultiWordSchemaRequest, opts ...grpc.CallOption) (*MultiWordSchema, error)
-	Update(ctx context.Context, in *UpdateMultiWordSchemaRequest, opts ...grpc.CallOption) (*MultiWordSchema, error)
-	Delete(ctx context.Context, in *DeleteMultiWordSchemaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	List(ctx context.Context, in *ListMultiWordSchemaRequest, opts ...grpc.CallOption) (*ListMultiWordSchemaResponse, error)
-	BatchCreate(ctx context.Context, in *BatchCreateMultiWordSchemasRequest, opts ...grpc.CallOption) (*BatchCreateMultiWordSchemasResponse, error)
+type DocumentServiceClient interface {
+	Create(ctx context.Context, in *CreateDocumentRequest, opts ...grpc.CallOption) (*Document, error)
+	Get(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*Document, error)
+	Update(ctx context.Context, in *UpdateDocumentRequest, opts ...grpc.CallOption) (*Document, error)
+	Delete(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*Document, error)
+	DeleteDocuments(ctx context.Context, in *DeleteDocumentsRequest, opts ...grpc.CallOption) (*DeleteDocumentsResponse, error)
+	List(ctx context.Context, in *ListDocumentRequest, opts ...grpc.CallOption) (*ListDocumentResponse, error)
+	Count(ctx context.Context, in *CountDocumentsRequest, opts ...grpc.CallOption) (*CountDocumentsResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateDocumentsRequest, opts ...grpc.CallOption) (*BatchCreateDocumentsResponse, error)
+	// Archive moves the document to the archive of its tenant.
+	Archive(ctx context.Context, in *Document, opts ...grpc.CallOption) (*Document, error)
+	Tail(ctx context.Context, in *ListDocumentRequest, opts ...grpc.CallOption) (DocumentService_TailClient, error)
 }
 
-type multiWordSchemaServiceClient struct {
+type documentServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewMultiWordSchemaServiceClient(cc grpc.ClientConnInterface) MultiWordSchemaServiceClient {
-	return &multiWordSchemaServiceClient{cc}
+func NewDocumentServiceClient(cc grpc.ClientConnInterface) DocumentServiceClient {
+	return &documentServiceClient{cc}
 }
 
-func (c *multiWordSchemaServiceClient) Create(ctx context.Context, in *CreateMultiWordSchemaRequest, opts ...grpc.CallOption) (*MultiWordSchema, error) {
-	out := new(MultiWordSchema)
-	err := c.cc.Invoke(ctx, "/entpb.MultiWordSchemaService/Create", in, out, opts...)
+func (c *documentServiceClient) Create(ctx context.Context, in *CreateDocumentRequest, opts ...grpc.CallOption) (*Document, error) {
+	out := new(Document)
+	err := c.cc.Invoke(ctx, "/entpb.DocumentService/Create", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *multiWordSchemaServiceClient) Get(ctx context.Context, in *GetMultiWordSchemaRequest, opts ...grpc.CallOption) (*MultiWordSchema, error) {
-	out := new(MultiWordSchema)
-	err := c.cc.Invoke(ctx, "/entpb.MultiWordSchemaService/Get", in, out, opts...)
+func (c *documentServiceClient) Get(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*Document, error) {
+	out := new(Document)
+	err := c.cc.Invoke(ctx, "/entpb.DocumentService/Get", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *multiWordSchemaServiceClient) Update(ctx context.Context, in *UpdateMultiWordSchemaRequest, opts ...grpc.CallOption) (*MultiWordSchema, error) {
-	out := new(MultiWordSchema)
-	err := c.cc.Invoke(ctx, "/entpb.MultiWordSchemaService/Update", in, out, opts...)
+func (c *documentServiceClient) Update(ctx context.Context, in *UpdateDocumentRequest, opts ...grpc.CallOption) (*Document, error) {
+	out := new(Document)
+	err := c.cc.Invoke(ctx, "/entpb.DocumentService/Update", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *multiWordSchemaServiceClient) Delete(ctx context.Context, in *DeleteMultiWordSchemaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/entpb.MultiWordSchemaService/Delete", in, out, opts...)
+func (c *documentServiceClient) Delete(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*Document, error) {
+	out := new(Document)
+	err := c.cc.Invoke(ctx, "/entpb.DocumentService/Delete", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *multiWordSchemaServiceClient) List(ctx context.Context, in *ListMultiWordSchemaRequest, opts ...grpc.CallOption) (*ListMultiWordSchemaResponse, error) {
-	out := new(ListMultiWordSchemaResponse)
-	err := c.cc.Invoke(ctx, "/entpb.MultiWordSchemaService/List", in, out, opts...)
+func (c *documentServiceClient) DeleteDocuments(ctx context.Context, in *DeleteDocumentsRequest, opts ...grpc.CallOption) (*DeleteDocumentsResponse, error) {
+	out := new(DeleteDocumentsResponse)
+	err := c.cc.Invoke(ctx, "/entpb.DocumentService/DeleteDocuments", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *multiWordSchemaServiceClient) BatchCreate(ctx context.Context, in *BatchCreateMultiWordSchemasRequest, opts ...grpc.CallOption) (*BatchCreateMultiWordSchemasResponse, error) {
-	out := new(BatchCreateMultiWordSchemasResponse)
-	err := c.cc.Invoke(ctx, "/entpb.MultiWordSchemaService/BatchCreate", in, out, opts...)
+func (c *documentServiceClient) List(ctx context.Context, in *ListDocumentRequest, opts ...grpc.CallOption) (*ListDocumentResponse, error) {
+	out := new(ListDocumentResponse)
+	err := c.cc.Invoke(ctx, "/entpb.DocumentService/List", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// MultiWordSchemaServiceServer is the server API for MultiWordSchemaService service.
-// All implementations must embed UnimplementedMultiWordSchemaServiceServer
-// for forward compatibility
-type MultiWordSchemaServiceServer interface {
-	Create(context.Context, *CreateMultiWordSchemaRequest) (*MultiWordSchema, error)
-	Get(context.Context, *GetMultiWordSchemaRequest) (*MultiWordSchema, error)
-	Update(context.Context, *UpdateMultiWordSchemaRequest) (*MultiWordSchema, error)
-	Delete(context.Context, *DeleteMultiWordSchemaRequest) (*emptypb.Empty, error)
-	List(context.Context, *ListMultiWordSchemaRequest) (*ListMultiWordSchemaResponse, error)
-	BatchCreate(context.Context, *BatchCreateMultiWordSchemasRequest) (*BatchCreateMultiWordSchemasResponse, error)
-	mustEmbedUnimplementedMultiWordSchemaServiceServer()
+func (c *documentServiceClient) Count(ctx context.Context, in *CountDocumentsRequest, opts ...grpc.CallOption) (*CountDocumentsResponse, error) {
+	out := new(CountDocumentsResponse)
+	err := c.cc.Invoke(ctx, "/entpb.DocumentService/Count", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedMultiWordSchemaServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedMultiWordSchemaServiceServer struct {
+func (c *documentServiceClient) BatchCreate(ctx context.Context, in *BatchCreateDocumentsRequest, opts ...grpc.CallOption) (*BatchCreateDocumentsResponse, error) {
+	out := new(BatchCreateDocumentsResponse)
+	err := c.cc.Invoke(ctx, "/entpb.DocumentService/BatchCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedMultiWordSchemaServiceServer) Create(context.Context, *CreateMultiWordSchemaRequest) (*MultiWordSchema, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+func (c *documentServiceClient) Archive(ctx context.Context, in *Document, opts ...grpc.CallOption) (*Document, error) {
+	out := new(Document)
+	err := c.cc.Invoke(ctx, "/entpb.DocumentService/Archive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMultiWordSchemaServiceServer) Get(context.Context, *GetMultiWordSchemaRequest) (*MultiWordSchema, error) {
+
+func (c *documentServiceClient) Tail(ctx context.Context, in *ListDocumentRequest, opts ...grpc.CallOption) (DocumentService_TailClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DocumentService_ServiceDesc.Streams[0], "/entpb.DocumentService/Tail", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &documentServiceTailClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DocumentService_TailClient interface {
+	Recv() (*Document, error)
+	grpc.ClientStream
+}
+
+type documentServiceTailClient struct {
+	grpc.ClientStream
+}
+
+func (x *documentServiceTailClient) Recv() (*Document, error) {
+	m := new(Document)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DocumentServiceServer is the server API for DocumentService service.
+// All implementations must embed UnimplementedDocumentServiceServer
+// for forward compatibility
+type DocumentServiceServer interface {
+	Create(context.Context, *CreateDocumentRequest) (*Document, error)
+	Get(context.Context, *GetDocumentRequest) (*Document, error)
+	Update(context.Context, *UpdateDocumentRequest) (*Document, error)
+	Delete(context.Context, *DeleteDocumentRequest) (*Document, error)
+	DeleteDocuments(context.Context, *DeleteDocumentsRequest) (*DeleteDocumentsResponse, error)
+	List(context.Context, *ListDocumentRequest) (*ListDocumentResponse, error)
+	Count(context.Context, *CountDocumentsRequest) (*CountDocumentsResponse, error)
+	BatchCreate(context.Context, *BatchCreateDocumentsRequest) (*BatchCreateDocumentsResponse, error)
+	// Archive moves the document to the archive of its tenant.
+	Archive(context.Context, *Document) (*Document, error)
+	Tail(*ListDocumentRequest, DocumentService_TailServer) error
+	mustEmbedUnimplementedDocumentServiceServer()
+}
+
+// UnimplementedDocumentServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDocumentServiceServer struct {
+}
+
+func (UnimplementedDocumentServiceServer) Create(context.Context, *CreateDocumentRequest) (*Document, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedDocumentServiceServer) Get(context.Context, *GetDocumentRequest) (*Document, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedMultiWordSchemaServiceServer) Update(context.Context, *UpdateMultiWordSchemaRequest) (*MultiWordSchema, error) {
+func (UnimplementedDocumentServiceServer) Update(context.Context, *UpdateDocumentRequest) (*Document, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedMultiWordSchemaServiceServer) Delete(context.Context, *DeleteMultiWordSchemaRequest) (*emptypb.Empty, error) {
+func (UnimplementedDocumentServiceServer) Delete(context.Context, *DeleteDocumentRequest) (*Document, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedMultiWordSchemaServiceServer) List(context.Context, *ListMultiWordSchemaRequest) (*ListMultiWordSchemaResponse, error) {
+func (UnimplementedDocumentServiceServer) DeleteDocuments(context.Context, *DeleteDocumentsRequest) (*DeleteDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDocuments not implemented")
+}
+func (UnimplementedDocumentServiceServer) List(context.Context, *ListDocumentRequest) (*ListDocumentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedMultiWordSchemaServiceServer) BatchCreate(context.Context, *BatchCreateMultiWordSchemasRequest) (*BatchCreateMultiWordSchemasResponse, error) {
+func (UnimplementedDocumentServiceServer) Count(context.Context, *CountDocumentsRequest) (*CountDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+func (UnimplementedDocumentServiceServer) BatchCreate(context.Context, *BatchCreateDocumentsRequest) (*BatchCreateDocumentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
 }
-func (UnimplementedMultiWordSchemaServiceServer) mustEmbedUnimplementedMultiWordSchemaServiceServer() {
+func (UnimplementedDocumentServiceServer) Archive(context.Context, *Document) (*Document, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Archive not implemented")
 }
+func (UnimplementedDocumentServiceServer) Tail(*ListDocumentRequest, DocumentService_TailServer) error {
+	return status.Errorf(codes.Unimplemented, "method Tail not implemented")
+}
+func (UnimplementedDocumentServiceServer) mustEmbedUnimplementedDocumentServiceServer() {}
 
-// UnsafeMultiWordSchemaServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MultiWordSchemaServiceServer will
+// UnsafeDocumentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DocumentServiceServer will
 // result in compilation errors.
-type UnsafeMultiWordSchemaServiceServer interface {
-	mustEmbedUnimplementedMultiWordSchemaServiceServer()
+type UnsafeDocumentServiceServer interface {
+	mustEmbedUnimplementedDocumentServiceServer()
 }
 
-func RegisterMultiWordSchemaServiceServer(s grpc.ServiceRegistrar, srv MultiWordSchemaServiceServer) {
-	s.RegisterService(&MultiWordSchemaService_ServiceDesc, srv)
+func RegisterDocumentServiceServer(s grpc.ServiceRegistrar, srv DocumentServiceServer) {
+	s.RegisterService(&DocumentService_ServiceDesc, srv)
 }
 
-func _MultiWordSchemaService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateMultiWordSchemaRequest)
+func _DocumentService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDocumentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MultiWordSchemaServiceServer).Create(ctx, in)
+		return srv.(DocumentServiceServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/entpb.MultiWordSchemaService/Create",
+		FullMethod: "/entpb.DocumentService/Create",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MultiWordSchemaServiceServer).Create(ctx, req.(*CreateMultiWordSchemaRequest))
+		return srv.(DocumentServiceServer).Create(ctx, req.(*CreateDocumentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MultiWordSchemaService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMultiWordSchemaRequest)
+func _DocumentService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MultiWordSchemaServiceServer).Get(ctx, in)
+		return srv.(DocumentServiceServer).Get(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/entpb.MultiWordSchemaService/Get",
+		FullMethod: "/entpb.DocumentService/Get",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MultiWordSchemaServiceServer).Get(ctx, req.(*GetMultiWordSchemaRequest))
+		return srv.(DocumentServiceServer).Get(ctx, req.(*GetDocumentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MultiWordSchemaService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMultiWordSchemaRequest)
+func _DocumentService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDocumentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MultiWordSchemaServiceServer).Update(ctx, in)
+		return srv.(DocumentServiceServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/entpb.MultiWordSchemaService/Update",
+		FullMethod: "/entpb.DocumentService/Update",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MultiWordSchemaServiceServer).Update(ctx, req.(*UpdateMultiWordSchemaRequest))
+		return srv.(DocumentServiceServer).Update(ctx, req.(*UpdateDocumentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MultiWordSchemaService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteMultiWordSchemaRequest)
+func _DocumentService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDocumentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MultiWordSchemaServiceServer).Delete(ctx, in)
+		return srv.(DocumentServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/entpb.MultiWordSchemaService/Delete",
+		FullMethod: "/entpb.DocumentService/Delete",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MultiWordSchemaServiceServer).Delete(ctx, req.(*DeleteMultiWordSchemaRequest))
+		return srv.(DocumentServiceServer).Delete(ctx, req.(*DeleteDocumentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MultiWordSchemaService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMultiWordSchemaRequest)
+func _DocumentService_DeleteDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDocumentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MultiWordSchemaServiceServer).List(ctx, in)
+		return srv.(DocumentServiceServer).DeleteDocuments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/entpb.MultiWordSchemaService/List",
+		FullMethod: "/entpb.DocumentService/DeleteDocuments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MultiWordSchemaServiceServer).List(ctx, req.(*ListMultiWordSchemaRequest))
+		return srv.(DocumentServiceServer).DeleteDocuments(ctx, req.(*DeleteDocumentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MultiWordSchemaService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchCreateMultiWordSchemasRequest)
+func _DocumentService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDocumentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MultiWordSchemaServiceServer).BatchCreate(ctx, in)
+		return srv.(DocumentServiceServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/entpb.MultiWordSchemaService/BatchCreate",
+		FullMethod: "/entpb.DocumentService/List",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MultiWordSchemaServiceServer).BatchCreate(ctx, req.(*BatchCreateMultiWordSchemasRequest))
+		return srv.(DocumentServiceServer).List(ctx, req.(*ListDocumentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// MultiWordSchemaService_ServiceDesc is the grpc.ServiceDesc for MultiWordSchemaService service.
+func _DocumentService_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).Count(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.DocumentService/Count",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).Count(ctx, req.(*CountDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.DocumentService/BatchCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).BatchCreate(ctx, req.(*BatchCreateDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_Archive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Document)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).Archive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.DocumentService/Archive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).Archive(ctx, req.(*Document))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_Tail_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListDocumentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DocumentServiceServer).Tail(m, &documentServiceTailServer{stream})
+}
+
+type DocumentService_TailServer interface {
+	Send(*Document) error
+	grpc.ServerStream
+}
+
+type documentServiceTailServer struct {
+	grpc.ServerStream
+}
+
+func (x *documentServiceTailServer) Send(m *Document) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// DocumentService_ServiceDesc is the grpc.ServiceDesc for DocumentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var MultiWordSchemaService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "entpb.MultiWordSchemaService",
-	HandlerType: (*MultiWordSchemaServiceServer)(nil),
+var DocumentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.DocumentService",
+	HandlerType: (*DocumentServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Create",
-			Handler:    _MultiWordSchemaService_Create_Handler,
+			Handler:    _DocumentService_Create_Handler,
 		},
 		{
 			MethodName: "Get",
-			Handler:    _MultiWordSchemaService_Get_Handler,
+			Handler:    _DocumentService_Get_Handler,
 		},
 		{
 			MethodName: "Update",
-			Handler:    _MultiWordSchemaService_Update_Handler,
+			Handler:    _DocumentService_Update_Handler,
 		},
 		{
 			MethodName: "Delete",
-			Handler:    _MultiWordSchemaService_Delete_Handler,
+			Handler:    _DocumentService_Delete_Handler,
+		},
+		{
+			MethodName: "DeleteDocuments",
+			Handler:    _DocumentService_DeleteDocuments_Handler,
 		},
 		{
 			MethodName: "List",
-			Handler:    _MultiWordSchemaService_List_Handler,
+			Handler:    _DocumentService_List_Handler,
+		},
+		{
+			MethodName: "Count",
+			Handler:    _DocumentService_Count_Handler,
 		},
 		{
 			MethodName: "BatchCreate",
-			Handler:    _MultiWordSchemaService_BatchCreate_Handler,
+			Handler:    _DocumentService_BatchCreate_Handler,
+		},
+		{
+			MethodName: "Archive",
+			Handler:    _DocumentService_Archive_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Tail",
+			Handler:       _DocumentService_Tail_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "entpb/entpb.proto",
 }
 
-// NilExampleServiceClient is the client API for NilExampleService service.
+// LabelServiceClient is the client API for LabelService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type NilExampleServiceClient interface {
-	Create(ctx context.Context, in *CreateNilExampleRequest, opts ...grpc.CallOption) (*NilExample, error)
-	Get(ctx context.Context, in *GetNilExampleRequest, opts ...grpc.CallOption) (*NilExample, error)
-	Update(ctx context.Context, in *UpdateNilExampleRequest, opts ...grpc.CallOption) (*NilExample, error)
-	Delete(ctx context.Context, in *DeleteNilExampleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	List(ctx context.Context, in *ListNilExampleRequest, opts ...grpc.CallOption) (*ListNilExampleResponse, error)
-	BatchCreate(ctx context.Context, in *BatchCreateNilExamplesRequest, opts ...grpc.CallOption) (*BatchCreateNilExamplesResponse, error)
+type LabelServiceClient interface {
+	Create(ctx context.Context, in *CreateLabelRequest, opts ...grpc.CallOption) (*Label, error)
+	Get(ctx context.Context, in *GetLabelRequest, opts ...grpc.CallOption) (*Label, error)
+	Update(ctx context.Context, in *UpdateLabelRequest, opts ...grpc.CallOption) (*Label, error)
+	Delete(ctx context.Context, in *DeleteLabelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListLabelRequest, opts ...grpc.CallOption) (*ListLabelResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateLabelsRequest, opts ...grpc.CallOption) (*BatchCreateLabelsResponse, error)
+	Export(ctx context.Context, in *ExportLabelRequest, opts ...grpc.CallOption) (LabelService_ExportClient, error)
 }
 
-type nilExampleServiceClient struct {
+type labelServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewNilExampleServiceClient(cc grpc.ClientConnInterface) NilExampleServiceClient {
-	return &nilExampleServiceClient{cc}
+func NewLabelServiceClient(cc grpc.ClientConnInterface) LabelServiceClient {
+	return &labelServiceClient{cc}
 }
 
-func (c *nilExampleServiceClient) Create(ctx context.Context, in *CreateNilExampleRequest, opts ...grpc.CallOption) (*NilExample, error) {
-	out := new(NilExample)
-	err := c.cc.Invoke(ctx, "/entpb.NilExampleService/Create", in, out, opts...)
+func (c *labelServiceClient) Create(ctx context.Context, in *CreateLabelRequest, opts ...grpc.CallOption) (*Label, error) {
+	out := new(Label)
+	err := c.cc.Invoke(ctx, "/entpb.LabelService/Create", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *nilExampleServiceClient) Get(ctx context.Context, in *GetNilExampleRequest, opts ...grpc.CallOption) (*NilExample, error) {
-	out := new(NilExample)
-	err := c.cc.Invoke(ctx, "/entpb.NilExampleService/Get", in, out, opts...)
+func (c *labelServiceClient) Get(ctx context.Context, in *GetLabelRequest, opts ...grpc.CallOption) (*Label, error) {
+	out := new(Label)
+	err := c.cc.Invoke(ctx, "/entpb.LabelService/Get", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *nilExampleServiceClient) Update(ctx context.Context, in *UpdateNilExampleRequest, opts ...grpc.CallOption) (*NilExample, error) {
-	out := new(NilExample)
-	err := c.cc.Invoke(ctx, "/entpb.NilExampleService/Update", in, out, opts...)
+func (c *labelServiceClient) Update(ctx context.Context, in *UpdateLabelRequest, opts ...grpc.CallOption) (*Label, error) {
+	out := new(Label)
+	err := c.cc.Invoke(ctx, "/entpb.LabelService/Update", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *nilExampleServiceClient) Delete(ctx context.Context, in *DeleteNilExampleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *labelServiceClient) Delete(ctx context.Context, in *DeleteLabelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/entpb.NilExampleService/Delete", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/entpb.LabelService/Delete", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *nilExampleServiceClient) List(ctx context.Context, in *ListNilExampleRequest, opts ...grpc.CallOption) (*ListNilExampleResponse, error) {
-	out := new(ListNilExampleResponse)
-	err := c.cc.Invoke(ctx, "/entpb.NilExampleService/List", in, out, opts...)
+func (c *labelServiceClient) List(ctx context.Context, in *ListLabelRequest, opts ...grpc.CallOption) (*ListLabelResponse, error) {
+	out := new(ListLabelResponse)
+	err := c.cc.Invoke(ctx, "/entpb.LabelService/List", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *nilExampleServiceClient) BatchCreate(ctx context.Context, in *BatchCreateNilExamplesRequest, opts ...grpc.CallOption) (*BatchCreateNilExamplesResponse, error) {
-	out := new(BatchCreateNilExamplesResponse)
-	err := c.cc.Invoke(ctx, "/entpb.NilExampleService/BatchCreate", in, out, opts...)
+func (c *labelServiceClient) BatchCreate(ctx context.Context, in *BatchCreateLabelsRequest, opts ...grpc.CallOption) (*BatchCreateLabelsResponse, error) {
+	out := new(BatchCreateLabelsResponse)
+	err := c.cc.Invoke(ctx, "/entpb.LabelService/BatchCreate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// NilExampleServiceServer is the server API for NilExampleService service.
-// All implementations must embed UnimplementedNilExampleServiceServer
+func (c *labelServiceClient) Export(ctx context.Context, in *ExportLabelRequest, opts ...grpc.CallOption) (LabelService_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LabelService_ServiceDesc.Streams[0], "/entpb.LabelService/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &labelServiceExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LabelService_ExportClient interface {
+	Recv() (*ExportLabelResponse, error)
+	grpc.ClientStream
+}
+
+type labelServiceExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *labelServiceExportClient) Recv() (*ExportLabelResponse, error) {
+	m := new(ExportLabelResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// LabelServiceServer is the server API for LabelService service.
+// All implementations must embed UnimplementedLabelServiceServer
 // for forward compatibility
-type NilExampleServiceServer interface {
-	Create(context.Context, *CreateNilExampleRequest) (*NilExample, error)
-	Get(context.Context, *GetNilExampleRequest) (*NilExample, error)
-	Update(context.Context, *UpdateNilExampleRequest) (*NilExample, error)
-	Delete(context.Context, *DeleteNilExampleRequest) (*emptypb.Empty, error)
-	List(context.Context, *ListNilExampleRequest) (*ListNilExampleResponse, error)
-	BatchCreate(context.Context, *BatchCreateNilExamplesRequest) (*BatchCreateNilExamplesResponse, error)
-	mustEmbedUnimplementedNilExampleServiceServer()
+type LabelServiceServer interface {
+	Create(context.Context, *CreateLabelRequest) (*Label, error)
+	Get(context.Context, *GetLabelRequest) (*Label, error)
+	Update(context.Context, *UpdateLabelRequest) (*Label, error)
+	Delete(context.Context, *DeleteLabelRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListLabelRequest) (*ListLabelResponse, error)
+	BatchCreate(context.Context, *BatchCreateLabelsRequest) (*BatchCreateLabelsResponse, error)
+	Export(*ExportLabelRequest, LabelService_ExportServer) error
+	mustEmbedUnimplementedLabelServiceServer()
 }
 
-// UnimplementedNilExampleServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedNilExampleServiceServer struct {
+// UnimplementedLabelServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLabelServiceServer struct {
 }
 
-func (UnimplementedNilExampleServiceServer) Create(context.Context, *CreateNilExampleRequest) (*NilExample, error) {
+func (UnimplementedLabelServiceServer) Create(context.Context, *CreateLabelRequest) (*Label, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
-func (UnimplementedNilExampleServiceServer) Get(context.Context, *GetNilExampleRequest) (*NilExample, error) {
+func (UnimplementedLabelServiceServer) Get(context.Context, *GetLabelRequest) (*Label, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedNilExampleServiceServer) Update(context.Context, *UpdateNilExampleRequest) (*NilExample, error) {
+func (UnimplementedLabelServiceServer) Update(context.Context, *UpdateLabelRequest) (*Label, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedNilExampleServiceServer) Delete(context.Context, *DeleteNilExampleRequest) (*emptypb.Empty, error) {
+func (UnimplementedLabelServiceServer) Delete(context.Context, *DeleteLabelRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedNilExampleServiceServer) List(context.Context, *ListNilExampleRequest) (*ListNilExampleResponse, error) {
+func (UnimplementedLabelServiceServer) List(context.Context, *ListLabelRequest) (*ListLabelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedNilExampleServiceServer) BatchCreate(context.Context, *BatchCreateNilExamplesRequest) (*BatchCreateNilExamplesResponse, error) {
+func (UnimplementedLabelServiceServer) BatchCreate(context.Context, *BatchCreateLabelsRequest) (*BatchCreateLabelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
 }
-func (UnimplementedNilExampleServiceServer) mustEmbedUnimplementedNilExampleServiceServer() {}
+func (UnimplementedLabelServiceServer) Export(*ExportLabelRequest, LabelService_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedLabelServiceServer) mustEmbedUnimplementedLabelServiceServer() {}
 
-// UnsafeNilExampleServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to NilExampleServiceServer will
+// UnsafeLabelServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LabelServiceServer will
 // result in compilation errors.
-type UnsafeNilExampleServiceServer interface {
-	mustEmbedUnimplementedNilExampleServiceServer()
+type UnsafeLabelServiceServer interface {
+	mustEmbedUnimplementedLabelServiceServer()
 }
 
-func RegisterNilExampleServiceServer(s grpc.ServiceRegistrar, srv NilExampleServiceServer) {
-	s.RegisterService(&NilExampleService_ServiceDesc, srv)
+func RegisterLabelServiceServer(s grpc.ServiceRegistrar, srv LabelServiceServer) {
+	s.RegisterService(&LabelService_ServiceDesc, srv)
 }
 
-func _NilExampleService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateNilExampleRequest)
+func _LabelService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLabelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NilExampleServiceServer).Create(ctx, in)
+		return srv.(LabelServiceServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/entpb.NilExampleService/Create",
+		FullMethod: "/entpb.LabelService/Create",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NilExampleServiceServer).Create(ctx, req.(*CreateNilExampleRequest))
+		return srv.(LabelServiceServer).Create(ctx, req.(*CreateLabelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NilExampleService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNilExampleRequest)
+func _LabelService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLabelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NilExampleServiceServer).Get(ctx, in)
+		return srv.(LabelServiceServer).Get(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/entpb.NilExampleService/Get",
+		FullMethod: "/entpb.LabelService/Get",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NilExampleServiceServer).Get(ctx, req.(*GetNilExampleRequest))
+		return srv.(LabelServiceServer).Get(ctx, req.(*GetLabelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NilExampleService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateNilExampleRequest)
+func _LabelService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLabelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NilExampleServiceServer).Update(ctx, in)
+		return srv.(LabelServiceServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/entpb.NilExampleService/Update",
+		FullMethod: "/entpb.LabelService/Update",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NilExampleServiceServer).Update(ctx, req.(*UpdateNilExampleRequest))
+		return srv.(LabelServiceServer).Update(ctx, req.(*UpdateLabelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NilExampleService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteNilExampleRequest)
+func _LabelService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLabelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NilExampleServiceServer).Delete(ctx, in)
+		return srv.(LabelServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/entpb.NilExampleService/Delete",
+		FullMethod: "/entpb.LabelService/Delete",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NilExampleServiceServer).Delete(ctx, req.(*DeleteNilExampleRequest))
+		return srv.(LabelServiceServer).Delete(ctx, req.(*DeleteLabelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NilExampleService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListNilExampleRequest)
+func _LabelService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLabelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NilExampleServiceServer).List(ctx, in)
+		return srv.(LabelServiceServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/entpb.NilExampleService/List",
+		FullMethod: "/entpb.LabelService/List",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NilExampleServiceServer).List(ctx, req.(*ListNilExampleRequest))
+		return srv.(LabelServiceServer).List(ctx, req.(*ListLabelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NilExampleService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchCreateNilExamplesRequest)
+func _LabelService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateLabelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NilExampleServiceServer).BatchCreate(ctx, in)
+		return srv.(LabelServiceServer).BatchCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/entpb.NilExampleService/BatchCreate",
+		FullMethod: "/entpb.LabelService/BatchCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NilExampleServiceServer).BatchCreate(ctx, req.(*BatchCreateNilExamplesRequest))
+		return srv.(LabelServiceServer).BatchCreate(ctx, req.(*BatchCreateLabelsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// NilExampleService_ServiceDesc is the grpc.ServiceDesc for NilExampleService service.
+func _LabelService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportLabelRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LabelServiceServer).Export(m, &labelServiceExportServer{stream})
+}
+
+type LabelService_ExportServer interface {
+	Send(*ExportLabelResponse) error
+	grpc.ServerStream
+}
+
+type labelServiceExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *labelServiceExportServer) Send(m *ExportLabelResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// LabelService_ServiceDesc is the grpc.ServiceDesc for LabelService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var NilExampleService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "entpb.NilExampleService",
-	HandlerType: (*NilExampleServiceServer)(nil),
+var LabelService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.LabelService",
+	HandlerType: (*LabelServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Create",
-			Handler:    _NilExampleService_Create_Handler,
+			Handler:    _LabelService_Create_Handler,
 		},
 		{
 			MethodName: "Get",
-			Handler:    _NilExampleService_Get_Handler,
+			Handler:    _LabelService_Get_Handler,
 		},
 		{
 			MethodName: "Update",
-			Handler:    _NilExampleService_Update_Handler,
+			Handler:    _LabelService_Update_Handler,
 		},
 		{
 			MethodName: "Delete",
-			Handler:    _NilExampleService_Delete_Handler,
+			Handler:    _LabelService_Delete_Handler,
 		},
 		{
 			MethodName: "List",
-			Handler:    _NilExampleService_List_Handler,
+			Handler:    _LabelService_List_Handler,
 		},
 		{
 			MethodName: "BatchCreate",
-			Handler:    _NilExampleService_BatchCreate_Handler,
+			Handler:    _LabelService_BatchCreate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _LabelService_Export_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "entpb/entpb.proto",
 }
 
-// PetServiceClient is the client API for PetService service.
+// MultiWordSchemaServiceClient is the client API for MultiWordSchemaService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type PetServiceClient interface {
-	Create(ctx context.Context, in *CreatePetRequest, opts ...grpc.CallOption) (*Pet, error)
-	Get(ctx context.Context, in *GetPetRequest, opts ...grpc.CallOption) (*Pet, error)
-	Update(ctx context.Context, in *UpdatePetRequest, opts ...grpc.CallOption) (*Pet, error)
-	Delete(ctx context.Context, in *DeletePetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	List(ctx context.Context, in *ListPetRequest, opts ...grpc.CallOption) (*ListPetResponse, error)
-	BatchCreate(ctx context.Context, in *BatchCreatePetsRequest, opts ...grpc.CallOption) (*BatchCreatePetsResponse, error)
+type MultiWordSchemaServiceClient interface {
+	Create(ctx context.Context, in *CreateMultiWordSchemaRequest, opts ...grpc.CallOption) (*MultiWordSchema, error)
+	Get(ctx context.Context, in *GetMultiWordSchemaRequest, opts ...grpc.CallOption) (*MultiWordSchema, error)
+	Update(ctx context.Context, in *UpdateMultiWordSchemaRequest, opts ...grpc.CallOption) (*MultiWordSchema, error)
+	Delete(ctx context.Context, in *DeleteMultiWordSchemaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListMultiWordSchemaRequest, opts ...grpc.CallOption) (*ListMultiWordSchemaResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateMultiWordSchemasRequest, opts ...grpc.CallOption) (*BatchCreateMultiWordSchemasResponse, error)
 }
 
-type petServiceClient struct {
+type multiWordSchemaServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewPetServiceClient(cc grpc.ClientConnInterface) PetServiceClient {
-	return &petServiceClient{cc}
+func NewMultiWordSchemaServiceClient(cc grpc.ClientConnInterface) MultiWordSchemaServiceClient {
+	return &multiWordSchemaServiceClient{cc}
 }
 
-func (c *petServiceClient) Create(ctx context.Context, in *CreatePetRequest, opts ...grpc.CallOption) (*Pet, error) {
-	out := new(Pet)
-	err := c.cc.Invoke(ctx, "/entpb.PetService/Create", in, out, opts...)
+func (c *multiWordSchemaServiceClient) Create(ctx context.Context, in *CreateMultiWordSchemaRequest, opts ...grpc.CallOption) (*MultiWordSchema, error) {
+	out := new(MultiWordSchema)
+	err := c.cc.Invoke(ctx, "/entpb.MultiWordSchemaService/Create", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *petServiceClient) Get(ctx context.Context, in *GetPetRequest, opts ...grpc.CallOption) (*Pet, error) {
-	out := new(Pet)
-	err := c.cc.Invoke(ctx, "/entpb.PetService/Get", in, out, opts...)
+func (c *multiWordSchemaServiceClient) Get(ctx context.Context, in *GetMultiWordSchemaRequest, opts ...grpc.CallOption) (*MultiWordSchema, error) {
+	out := new(MultiWordSchema)
+	err := c.cc.Invoke(ctx, "/entpb.MultiWordSchemaService/Get", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *petServiceClient) Update(ctx context.Context, in *UpdatePetRequest, opts ...grpc.CallOption) (*Pet, error) {
-	out := new(Pet)
-	err := c.cc.Invoke(ctx, "/entpb.PetService/Update", in, out, opts...)
+func (c *multiWordSchemaServiceClient) Update(ctx context.Context, in *UpdateMultiWordSchemaRequest, opts ...grpc.CallOption) (*MultiWordSchema, error) {
+	out := new(MultiWordSchema)
+	err := c.cc.Invoke(ctx, "/entpb.MultiWordSchemaService/Update", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *petServiceClient) Delete(ctx context.Context, in *DeletePetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *multiWordSchemaServiceClient) Delete(ctx context.Context, in *DeleteMultiWordSchemaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/entpb.PetService/Delete", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/entpb.MultiWordSchemaService/Delete", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *petServiceClient) List(ctx context.Context, in *ListPetRequest, opts ...grpc.CallOption) (*ListPetResponse, error) {
-	out := new(ListPetResponse)
-	err := c.cc.Invoke(ctx, "/entpb.PetService/List", in, out, opts...)
+func (c *multiWordSchemaServiceClient) List(ctx context.Context, in *ListMultiWordSchemaRequest, opts ...grpc.CallOption) (*ListMultiWordSchemaResponse, error) {
+	out := new(ListMultiWordSchemaResponse)
+	err := c.cc.Invoke(ctx, "/entpb.MultiWordSchemaService/List", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *petServiceClient) BatchCreate(ctx context.Context, in *BatchCreatePetsRequest, opts ...grpc.CallOption) (*BatchCreatePetsResponse, error) {
-	out := new(BatchCreatePetsResponse)
-	err := c.cc.Invoke(ctx, "/entpb.PetService/BatchCreate", in, out, opts...)
+func (c *multiWordSchemaServiceClient) BatchCreate(ctx context.Context, in *BatchCreateMultiWordSchemasRequest, opts ...grpc.CallOption) (*BatchCreateMultiWordSchemasResponse, error) {
+	out := new(BatchCreateMultiWordSchemasResponse)
+	err := c.cc.Invoke(ctx, "/entpb.MultiWordSchemaService/BatchCreate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// PetServiceServer is the server API for PetService service.
-// All implementations must embed UnimplementedPetServiceServer
+// MultiWordSchemaServiceServer is the server API for MultiWordSchemaService service.
+// All implementations must embed UnimplementedMultiWordSchemaServiceServer
 // for forward compatibility
-type PetServiceServer interface {
-	Create(context.Context, *CreatePetRequest) (*Pet, error)
-	Get(context.Context, *GetPetRequest) (*Pet, error)
-	Update(context.Context, *UpdatePetRequest) (*Pet, error)
-	Delete(context.Context, *DeletePetRequest) (*emptypb.Empty, error)
-	List(context.Context, *ListPetRequest) (*ListPetResponse, error)
-	BatchCreate(context.Context, *BatchCreatePetsRequest) (*BatchCreatePetsResponse, error)
-	mustEmbedUnimplementedPetServiceServer()
+type MultiWordSchemaServiceServer interface {
+	Create(context.Context, *CreateMultiWordSchemaRequest) (*MultiWordSchema, error)
+	Get(context.Context, *GetMultiWordSchemaRequest) (*MultiWordSchema, error)
+	Update(context.Context, *UpdateMultiWordSchemaRequest) (*MultiWordSchema, error)
+	Delete(context.Context, *DeleteMultiWordSchemaRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListMultiWordSchemaRequest) (*ListMultiWordSchemaResponse, error)
+	BatchCreate(context.Context, *BatchCreateMultiWordSchemasRequest) (*BatchCreateMultiWordSchemasResponse, error)
+	mustEmbedUnimplementedMultiWordSchemaServiceServer()
 }
 
-// UnimplementedPetServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedPetServiceServer struct {
+// UnimplementedMultiWordSchemaServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMultiWordSchemaServiceServer struct {
 }
 
-func (UnimplementedPetServiceServer) Create(context.Context, *CreatePetRequest) (*Pet, error) {
+func (UnimplementedMultiWordSchemaServiceServer) Create(context.Context, *CreateMultiWordSchemaRequest) (*MultiWordSchema, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
-func (UnimplementedPetServiceServer) Get(context.Context, *GetPetRequest) (*Pet, error) {
+func (UnimplementedMultiWordSchemaServiceServer) Get(context.Context, *GetMultiWordSchemaRequest) (*MultiWordSchema, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedPetServiceServer) Update(context.Context, *UpdatePetRequest) (*Pet, error) {
+func (UnimplementedMultiWordSchemaServiceServer) Update(context.Context, *UpdateMultiWordSchemaRequest) (*MultiWordSchema, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedPetServiceServer) Delete(context.Context, *DeletePetRequest) (*emptypb.Empty, error) {
+func (UnimplementedMultiWordSchemaServiceServer) Delete(context.Context, *DeleteMultiWordSchemaRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedPetServiceServer) List(context.Context, *ListPetRequest) (*ListPetResponse, error) {
+func (UnimplementedMultiWordSchemaServiceServer) List(context.Context, *ListMultiWordSchemaRequest) (*ListMultiWordSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedPetServiceServer) BatchCreate(context.Context, *BatchCreatePetsRequest) (*BatchCreatePetsResponse, error) {
+func (UnimplementedMultiWordSchemaServiceServer) BatchCreate(context.Context, *BatchCreateMultiWordSchemasRequest) (*BatchCreateMultiWordSchemasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
 }
-func (UnimplementedPetServiceServer) mustEmbedUnimplementedPetServiceServer() {}
+func (UnimplementedMultiWordSchemaServiceServer) mustEmbedUnimplementedMultiWordSchemaServiceServer() {
+}
 
-// UnsafePetServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PetServiceServer will
+// UnsafeMultiWordSchemaServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MultiWordSchemaServiceServer will
 // result in compilation errors.
-type UnsafePetServiceServer interface {
-	mustEmbedUnimplementedPetServiceServer()
+type UnsafeMultiWordSchemaServiceServer interface {
+	mustEmbedUnimplementedMultiWordSchemaServiceServer()
 }
 
-func RegisterPetServiceServer(s grpc.ServiceRegistrar, srv PetServiceServer) {
-	s.RegisterService(&PetService_ServiceDesc, srv)
+func RegisterMultiWordSchemaServiceServer(s grpc.ServiceRegistrar, srv MultiWordSchemaServiceServer) {
+	s.RegisterService(&MultiWordSchemaService_ServiceDesc, srv)
 }
 
-func _PetService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePetRequest)
+func _MultiWordSchemaService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMultiWordSchemaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PetServiceServer).Create(ctx, in)
+		return srv.(MultiWordSchemaServiceServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/entpb.PetService/Create",
+		FullMethod: "/entpb.MultiWordSchemaService/Create",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PetServiceServer).Create(ctx, req.(*CreatePetRequest))
+		return srv.(MultiWordSchemaServiceServer).Create(ctx, req.(*CreateMultiWordSchemaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PetService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPetRequest)
+func _MultiWordSchemaService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMultiWordSchemaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PetServiceServer).Get(ctx, in)
+		return srv.(MultiWordSchemaServiceServer).Get(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/entpb.PetService/Get",
+		FullMethod: "/entpb.MultiWordSchemaService/Get",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PetServiceServer).Get(ctx, req.(*GetPetRequest))
+		return srv.(MultiWordSchemaServiceServer).Get(ctx, req.(*GetMultiWordSchemaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PetService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePetRequest)
+func _MultiWordSchemaService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMultiWordSchemaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PetServiceServer).Update(ctx, in)
+		return srv.(MultiWordSchemaServiceServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/entpb.PetService/Update",
+		FullMethod: "/entpb.MultiWordSchemaService/Update",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PetServiceServer).Update(ctx, req.(*UpdatePetRequest))
+		return srv.(MultiWordSchemaServiceServer).Update(ctx, req.(*UpdateMultiWordSchemaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PetService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePetRequest)
+func _MultiWordSchemaService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMultiWordSchemaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PetServiceServer).Delete(ctx, in)
+		return srv.(MultiWordSchemaServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/entpb.PetService/Delete",
+		FullMethod: "/entpb.MultiWordSchemaService/Delete",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PetServiceServer).Delete(ctx, req.(*DeletePetRequest))
+		return srv.(MultiWordSchemaServiceServer).Delete(ctx, req.(*DeleteMultiWordSchemaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PetService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPetRequest)
+func _MultiWordSchemaService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMultiWordSchemaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PetServiceServer).List(ctx, in)
+		return srv.(MultiWordSchemaServiceServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/entpb.PetService/List",
+		FullMethod: "/entpb.MultiWordSchemaService/List",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PetServiceServer).List(ctx, req.(*ListPetRequest))
+		return srv.(MultiWordSchemaServiceServer).List(ctx, req.(*ListMultiWordSchemaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PetService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchCreatePetsRequest)
+func _MultiWordSchemaService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateMultiWordSchemasRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PetServiceServer).BatchCreate(ctx, in)
+		return srv.(MultiWordSchemaServiceServer).BatchCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/entpb.PetService/BatchCreate",
+		FullMethod: "/entpb.MultiWordSchemaService/BatchCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PetServiceServer).BatchCreate(ctx, req.(*BatchCreatePetsRequest))
+		return srv.(MultiWordSchemaServiceServer).BatchCreate(ctx, req.(*BatchCreateMultiWordSchemasRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// PetService_ServiceDesc is the grpc.ServiceDesc for PetService service.
+// MultiWordSchemaService_ServiceDesc is the grpc.ServiceDesc for MultiWordSchemaService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var PetService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "entpb.PetService",
-	HandlerType: (*PetServiceServer)(nil),
+var MultiWordSchemaService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.MultiWordSchemaService",
+	HandlerType: (*MultiWordSchemaServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Create",
-			Handler:    _PetService_Create_Handler,
+			Handler:    _MultiWordSchemaService_Create_Handler,
 		},
 		{
 			MethodName: "Get",
-			Handler:    _PetService_Get_Handler,
+			Handler:    _MultiWordSchemaService_Get_Handler,
 		},
 		{
 			MethodName: "Update",
-			Handler:    _PetService_Update_Handler,
+			Handler:    _MultiWordSchemaService_Update_Handler,
 		},
 		{
 			MethodName: "Delete",
-			Handler:    _PetService_Delete_Handler,
+			Handler:    _MultiWordSchemaService_Delete_Handler,
 		},
 		{
 			MethodName: "List",
-			Handler:    _PetService_List_Handler,
+			Handler:    _MultiWordSchemaService_List_Handler,
 		},
 		{
 			MethodName: "BatchCreate",
-			Handler:    _PetService_BatchCreate_Handler,
+			Handler:    _MultiWordSchemaService_BatchCreate_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "entpb/entpb.proto",
 }
 
-// PonyServiceClient is the client API for PonyService service.
+// NilExampleServiceClient is the client API for NilExampleService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type PonyServiceClient interface {
-	BatchCreate(ctx context.Context, in *BatchCreatePoniesRequest, opts ...grpc.CallOption) (*BatchCreatePoniesResponse, error)
+type NilExampleServiceClient interface {
+	Create(ctx context.Context, in *CreateNilExampleRequest, opts ...grpc.CallOption) (*NilExample, error)
+	Get(ctx context.Context, in *GetNilExampleRequest, opts ...grpc.CallOption) (*NilExample, error)
+	Update(ctx context.Context, in *UpdateNilExampleRequest, opts ...grpc.CallOption) (*NilExample, error)
+	Delete(ctx context.Context, in *DeleteNilExampleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListNilExampleRequest, opts ...grpc.CallOption) (*ListNilExampleResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateNilExamplesRequest, opts ...grpc.CallOption) (*BatchCreateNilExamplesResponse, error)
 }
 
-type ponyServiceClient struct {
+type nilExampleServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewPonyServiceClient(cc grpc.ClientConnInterface) PonyServiceClient {
-	return &ponyServiceClient{cc}
+func NewNilExampleServiceClient(cc grpc.ClientConnInterface) NilExampleServiceClient {
+	return &nilExampleServiceClient{cc}
 }
 
-func (c *ponyServiceClient) BatchCreate(ctx context.Context, in *BatchCreatePoniesRequest, opts ...grpc.CallOption) (*BatchCreatePoniesResponse, error) {
-	out := new(BatchCreatePoniesResponse)
-	err := c.cc.Invoke(ctx, "/entpb.PonyService/BatchCreate", in, out, opts...)
+func (c *nilExampleServiceClient) Create(ctx context.Context, in *CreateNilExampleRequest, opts ...grpc.CallOption) (*NilExample, error) {
+	out := new(NilExample)
+	err := c.cc.Invoke(ctx, "/entpb.NilExampleService/Create", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// PonyServiceServer is the server API for PonyService service.
-// All implementations must embed UnimplementedPonyServiceServer
-// for forward compatibility
-type PonyServiceServer interface {
-	BatchCreate(context.Context, *BatchCreatePoniesRequest) (*BatchCreatePoniesResponse, error)
-	mustEmbedUnimplementedPonyServiceServer()
-}
-
-// UnimplementedPonyServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedPonyServiceServer struct {
-}
-
-func (UnimplementedPonyServiceServer) BatchCreate(context.Context, *BatchCreatePoniesRequest) (*BatchCreatePoniesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
-}
-func (UnimplementedPonyServiceServer) mustEmbedUnimplementedPonyServiceServer() {}
-
-// UnsafePonyServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PonyServiceServer will
-// result in compilation errors.
-type UnsafePonyServiceServer interface {
-	mustEmbedUnimplementedPonyServiceServer()
-}
-
-func RegisterPonyServiceServer(s grpc.ServiceRegistrar, srv PonyServiceServer) {
-	s.RegisterService(&PonyService_ServiceDesc, srv)
-}
-
-func _PonyService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchCreatePoniesRequest)
-	if err := dec(in); err != nil {
+func (c *nilExampleServiceClient) Get(ctx context.Context, in *GetNilExampleRequest, opts ...grpc.CallOption) (*NilExample, error) {
+	out := new(NilExample)
+	err := c.cc.Invoke(ctx, "/entpb.NilExampleService/Get", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PonyServiceServer).BatchCreate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/entpb.PonyService/BatchCreate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PonyServiceServer).BatchCreate(ctx, req.(*BatchCreatePoniesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-// PonyService_ServiceDesc is the grpc.ServiceDesc for PonyService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var PonyService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "entpb.PonyService",
-	HandlerType: (*PonyServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "BatchCreate",
-			Handler:    _PonyService_BatchCreate_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "entpb/entpb.proto",
-}
-
-// UserServiceClient is the client API for UserService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type UserServiceClient interface {
-	Create(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
-	Get(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error)
-	Update(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
-	Delete(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	List(ctx context.Context, in *ListUserRequest, opts ...grpc.CallOption) (*ListUserResponse, error)
-	BatchCreate(ctx context.Context, in *BatchCreateUsersRequest, opts ...grpc.CallOption) (*BatchCreateUsersResponse, error)
-}
-
-type userServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
-	return &userServiceClient{cc}
+	return out, nil
 }
 
-func (c *userServiceClient) Create(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/entpb.UserService/Create", in, out, opts...)
+func (c *nilExampleServiceClient) Update(ctx context.Context, in *UpdateNilExampleRequest, opts ...grpc.CallOption) (*NilExample, error) {
+	out := new(NilExample)
+	err := c.cc.Invoke(ctx, "/entpb.NilExampleService/Update", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userServiceClient) Get(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/entpb.UserService/Get", in, out, opts...)
+func (c *nilExampleServiceClient) Delete(ctx context.Context, in *DeleteNilExampleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/entpb.NilExampleService/Delete", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userServiceClient) Update(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/entpb.UserService/Update", in, out, opts...)
+func (c *nilExampleServiceClient) List(ctx context.Context, in *ListNilExampleRequest, opts ...grpc.CallOption) (*ListNilExampleResponse, error) {
+	out := new(ListNilExampleResponse)
+	err := c.cc.Invoke(ctx, "/entpb.NilExampleService/List", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userServiceClient) Delete(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/entpb.UserService/Delete", in, out, opts...)
+func (c *nilExampleServiceClient) BatchCreate(ctx context.Context, in *BatchCreateNilExamplesRequest, opts ...grpc.CallOption) (*BatchCreateNilExamplesResponse, error) {
+	out := new(BatchCreateNilExamplesResponse)
+	err := c.cc.Invoke(ctx, "/entpb.NilExampleService/BatchCreate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userServiceClient) List(ctx context.Context, in *ListUserRequest, opts ...grpc.CallOption) (*ListUserResponse, error) {
-	out := new(ListUserResponse)
-	err := c.cc.Invoke(ctx, "/entpb.UserService/List", in, out, opts...)
-	if err != nil {
+// NilExampleServiceServer is the server API for NilExampleService service.
+// All implementations must embed UnimplementedNilExampleServiceServer
+// for forward compatibility
+type NilExampleServiceServer interface {
+	Create(context.Context, *CreateNilExampleRequest) (*NilExample, error)
+	Get(context.Context, *GetNilExampleRequest) (*NilExample, error)
+	Update(context.Context, *UpdateNilExampleRequest) (*NilExample, error)
+	Delete(context.Context, *DeleteNilExampleRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListNilExampleRequest) (*ListNilExampleResponse, error)
+	BatchCreate(context.Context, *BatchCreateNilExamplesRequest) (*BatchCreateNilExamplesResponse, error)
+	mustEmbedUnimplementedNilExampleServiceServer()
+}
+
+// UnimplementedNilExampleServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedNilExampleServiceServer struct {
+}
+
+func (UnimplementedNilExampleServiceServer) Create(context.Context, *CreateNilExampleRequest) (*NilExample, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedNilExampleServiceServer) Get(context.Context, *GetNilExampleRequest) (*NilExample, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedNilExampleServiceServer) Update(context.Context, *UpdateNilExampleRequest) (*NilExample, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedNilExampleServiceServer) Delete(context.Context, *DeleteNilExampleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedNilExampleServiceServer) List(context.Context, *ListNilExampleRequest) (*ListNilExampleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedNilExampleServiceServer) BatchCreate(context.Context, *BatchCreateNilExamplesRequest) (*BatchCreateNilExamplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedNilExampleServiceServer) mustEmbedUnimplementedNilExampleServiceServer() {}
+
+// UnsafeNilExampleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NilExampleServiceServer will
+// result in compilation errors.
+type UnsafeNilExampleServiceServer interface {
+	mustEmbedUnimplementedNilExampleServiceServer()
+}
+
+func RegisterNilExampleServiceServer(s grpc.ServiceRegistrar, srv NilExampleServiceServer) {
+	s.RegisterService(&NilExampleService_ServiceDesc, srv)
+}
+
+func _NilExampleService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNilExampleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NilExampleServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.NilExampleService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NilExampleServiceServer).Create(ctx, req.(*CreateNilExampleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NilExampleService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNilExampleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NilExampleServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.NilExampleService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NilExampleServiceServer).Get(ctx, req.(*GetNilExampleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NilExampleService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNilExampleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NilExampleServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.NilExampleService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NilExampleServiceServer).Update(ctx, req.(*UpdateNilExampleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NilExampleService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNilExampleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NilExampleServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.NilExampleService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NilExampleServiceServer).Delete(ctx, req.(*DeleteNilExampleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NilExampleService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNilExampleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NilExampleServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.NilExampleService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NilExampleServiceServer).List(ctx, req.(*ListNilExampleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NilExampleService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateNilExamplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NilExampleServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.NilExampleService/BatchCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NilExampleServiceServer).BatchCreate(ctx, req.(*BatchCreateNilExamplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NilExampleService_ServiceDesc is the grpc.ServiceDesc for NilExampleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NilExampleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.NilExampleService",
+	HandlerType: (*NilExampleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _NilExampleService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _NilExampleService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _NilExampleService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _NilExampleService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _NilExampleService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _NilExampleService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+// PetReadServiceClient is the client API for PetReadService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PetReadServiceClient interface {
+	Get(ctx context.Context, in *GetPetRequest, opts ...grpc.CallOption) (*Pet, error)
+	List(ctx context.Context, in *ListPetRequest, opts ...grpc.CallOption) (*ListPetResponse, error)
+}
+
+type petReadServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPetReadServiceClient(cc grpc.ClientConnInterface) PetReadServiceClient {
+	return &petReadServiceClient{cc}
+}
+
+func (c *petReadServiceClient) Get(ctx context.Context, in *GetPetRequest, opts ...grpc.CallOption) (*Pet, error) {
+	out := new(Pet)
+	err := c.cc.Invoke(ctx, "/entpb.PetReadService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petReadServiceClient) List(ctx context.Context, in *ListPetRequest, opts ...grpc.CallOption) (*ListPetResponse, error) {
+	out := new(ListPetResponse)
+	err := c.cc.Invoke(ctx, "/entpb.PetReadService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PetReadServiceServer is the server API for PetReadService service.
+// All implementations must embed UnimplementedPetReadServiceServer
+// for forward compatibility
+type PetReadServiceServer interface {
+	Get(context.Context, *GetPetRequest) (*Pet, error)
+	List(context.Context, *ListPetRequest) (*ListPetResponse, error)
+	mustEmbedUnimplementedPetReadServiceServer()
+}
+
+// UnimplementedPetReadServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPetReadServiceServer struct {
+}
+
+func (UnimplementedPetReadServiceServer) Get(context.Context, *GetPetRequest) (*Pet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedPetReadServiceServer) List(context.Context, *ListPetRequest) (*ListPetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedPetReadServiceServer) mustEmbedUnimplementedPetReadServiceServer() {}
+
+// UnsafePetReadServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PetReadServiceServer will
+// result in compilation errors.
+type UnsafePetReadServiceServer interface {
+	mustEmbedUnimplementedPetReadServiceServer()
+}
+
+func RegisterPetReadServiceServer(s grpc.ServiceRegistrar, srv PetReadServiceServer) {
+	s.RegisterService(&PetReadService_ServiceDesc, srv)
+}
+
+func _PetReadService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetReadServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.PetReadService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetReadServiceServer).Get(ctx, req.(*GetPetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetReadService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetReadServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.PetReadService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetReadServiceServer).List(ctx, req.(*ListPetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PetReadService_ServiceDesc is the grpc.ServiceDesc for PetReadService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PetReadService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.PetReadService",
+	HandlerType: (*PetReadServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _PetReadService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _PetReadService_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+// PetWriteServiceClient is the client API for PetWriteService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PetWriteServiceClient interface {
+	// Create creates a pet, along with the edges to its owner and attachment.
+	Create(ctx context.Context, in *CreatePetRequest, opts ...grpc.CallOption) (*Pet, error)
+	Update(ctx context.Context, in *UpdatePetRequest, opts ...grpc.CallOption) (*Pet, error)
+	Delete(ctx context.Context, in *DeletePetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BatchCreate(ctx context.Context, in *BatchCreatePetsRequest, opts ...grpc.CallOption) (*BatchCreatePetsResponse, error)
+}
+
+type petWriteServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPetWriteServiceClient(cc grpc.ClientConnInterface) PetWriteServiceClient {
+	return &petWriteServiceClient{cc}
+}
+
+func (c *petWriteServiceClient) Create(ctx context.Context, in *CreatePetRequest, opts ...grpc.CallOption) (*Pet, error) {
+	out := new(Pet)
+	err := c.cc.Invoke(ctx, "/entpb.PetWriteService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petWriteServiceClient) Update(ctx context.Context, in *UpdatePetRequest, opts ...grpc.CallOption) (*Pet, error) {
+	out := new(Pet)
+	err := c.cc.Invoke(ctx, "/entpb.PetWriteService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petWriteServiceClient) Delete(ctx context.Context, in *DeletePetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/entpb.PetWriteService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petWriteServiceClient) BatchCreate(ctx context.Context, in *BatchCreatePetsRequest, opts ...grpc.CallOption) (*BatchCreatePetsResponse, error) {
+	out := new(BatchCreatePetsResponse)
+	err := c.cc.Invoke(ctx, "/entpb.PetWriteService/BatchCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PetWriteServiceServer is the server API for PetWriteService service.
+// All implementations must embed UnimplementedPetWriteServiceServer
+// for forward compatibility
+type PetWriteServiceServer interface {
+	// Create creates a pet, along with the edges to its owner and attachment.
+	Create(context.Context, *CreatePetRequest) (*Pet, error)
+	Update(context.Context, *UpdatePetRequest) (*Pet, error)
+	Delete(context.Context, *DeletePetRequest) (*emptypb.Empty, error)
+	BatchCreate(context.Context, *BatchCreatePetsRequest) (*BatchCreatePetsResponse, error)
+	mustEmbedUnimplementedPetWriteServiceServer()
+}
+
+// UnimplementedPetWriteServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPetWriteServiceServer struct {
+}
+
+func (UnimplementedPetWriteServiceServer) Create(context.Context, *CreatePetRequest) (*Pet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedPetWriteServiceServer) Update(context.Context, *UpdatePetRequest) (*Pet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedPetWriteServiceServer) Delete(context.Context, *DeletePetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedPetWriteServiceServer) BatchCreate(context.Context, *BatchCreatePetsRequest) (*BatchCreatePetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedPetWriteServiceServer) mustEmbedUnimplementedPetWriteServiceServer() {}
+
+// UnsafePetWriteServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PetWriteServiceServer will
+// result in compilation errors.
+type UnsafePetWriteServiceServer interface {
+	mustEmbedUnimplementedPetWriteServiceServer()
+}
+
+func RegisterPetWriteServiceServer(s grpc.ServiceRegistrar, srv PetWriteServiceServer) {
+	s.RegisterService(&PetWriteService_ServiceDesc, srv)
+}
+
+func _PetWriteService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetWriteServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.PetWriteService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetWriteServiceServer).Create(ctx, req.(*CreatePetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetWriteService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetWriteServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.PetWriteService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetWriteServiceServer).Update(ctx, req.(*UpdatePetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetWriteService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetWriteServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.PetWriteService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetWriteServiceServer).Delete(ctx, req.(*DeletePetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetWriteService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreatePetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetWriteServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.PetWriteService/BatchCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetWriteServiceServer).BatchCreate(ctx, req.(*BatchCreatePetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PetWriteService_ServiceDesc is the grpc.ServiceDesc for PetWriteService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PetWriteService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.PetWriteService",
+	HandlerType: (*PetWriteServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _PetWriteService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _PetWriteService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _PetWriteService_Delete_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _PetWriteService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+// PonyServiceClient is the client API for PonyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PonyServiceClient interface {
+	BatchCreate(ctx context.Context, in *BatchCreatePoniesRequest, opts ...grpc.CallOption) (*BatchCreatePoniesResponse, error)
+}
+
+type ponyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPonyServiceClient(cc grpc.ClientConnInterface) PonyServiceClient {
+	return &ponyServiceClient{cc}
+}
+
+func (c *ponyServiceClient) BatchCreate(ctx context.Context, in *BatchCreatePoniesRequest, opts ...grpc.CallOption) (*BatchCreatePoniesResponse, error) {
+	out := new(BatchCreatePoniesResponse)
+	err := c.cc.Invoke(ctx, "/entpb.PonyService/BatchCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PonyServiceServer is the server API for PonyService service.
+// All implementations must embed UnimplementedPonyServiceServer
+// for forward compatibility
+type PonyServiceServer interface {
+	BatchCreate(context.Context, *BatchCreatePoniesRequest) (*BatchCreatePoniesResponse, error)
+	mustEmbedUnimplementedPonyServiceServer()
+}
+
+// UnimplementedPonyServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPonyServiceServer struct {
+}
+
+func (UnimplementedPonyServiceServer) BatchCreate(context.Context, *BatchCreatePoniesRequest) (*BatchCreatePoniesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedPonyServiceServer) mustEmbedUnimplementedPonyServiceServer() {}
+
+// UnsafePonyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PonyServiceServer will
+// result in compilation errors.
+type UnsafePonyServiceServer interface {
+	mustEmbedUnimplementedPonyServiceServer()
+}
+
+func RegisterPonyServiceServer(s grpc.ServiceRegistrar, srv PonyServiceServer) {
+	s.RegisterService(&PonyService_ServiceDesc, srv)
+}
+
+func _PonyService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreatePoniesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PonyServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.PonyService/BatchCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PonyServiceServer).BatchCreate(ctx, req.(*BatchCreatePoniesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PonyService_ServiceDesc is the grpc.ServiceDesc for PonyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PonyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.PonyService",
+	HandlerType: (*PonyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BatchCreate",
+			Handler:    _PonyService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+// ProjectServiceClient is the client API for ProjectService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProjectServiceClient interface {
+	Create(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*Project, error)
+	Get(ctx context.Context, in *ProjectLookupRequest, opts ...grpc.CallOption) (*GetProjectResponse, error)
+	GetProjectByName(ctx context.Context, in *GetProjectByNameRequest, opts ...grpc.CallOption) (*Project, error)
+	Exists(ctx context.Context, in *ExistsProjectRequest, opts ...grpc.CallOption) (*ExistsProjectResponse, error)
+	Update(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*Project, error)
+	Delete(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteProjects(ctx context.Context, in *DeleteProjectsRequest, opts ...grpc.CallOption) (*DeleteProjectsResponse, error)
+	RestoreProject(ctx context.Context, in *RestoreProjectRequest, opts ...grpc.CallOption) (*Project, error)
+	List(ctx context.Context, in *ListProjectRequest, opts ...grpc.CallOption) (*ListProjectResponse, error)
+	StreamProjects(ctx context.Context, in *StreamProjectsRequest, opts ...grpc.CallOption) (ProjectService_StreamProjectsClient, error)
+	Count(ctx context.Context, in *CountProjectsRequest, opts ...grpc.CallOption) (*CountProjectsResponse, error)
+	AggregateProject(ctx context.Context, in *AggregateProjectRequest, opts ...grpc.CallOption) (*AggregateProjectResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateProjectsRequest, opts ...grpc.CallOption) (*BatchCreateProjectsResponse, error)
+	Upsert(ctx context.Context, in *UpsertProjectRequest, opts ...grpc.CallOption) (*Project, error)
+	BatchGet(ctx context.Context, in *BatchGetProjectsRequest, opts ...grpc.CallOption) (*ProjectBatch, error)
+	BatchDelete(ctx context.Context, in *BatchDeleteProjectsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Stats(ctx context.Context, in *StatsProjectRequest, opts ...grpc.CallOption) (*StatsProjectResponse, error)
+	Export(ctx context.Context, in *ExportProjectRequest, opts ...grpc.CallOption) (ProjectService_ExportClient, error)
+	ListProjectAttachments(ctx context.Context, in *ListProjectAttachmentsRequest, opts ...grpc.CallOption) (*ListProjectAttachmentsResponse, error)
+	AddProjectAttachment(ctx context.Context, in *AddProjectAttachmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveProjectAttachment(ctx context.Context, in *RemoveProjectAttachmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type projectServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProjectServiceClient(cc grpc.ClientConnInterface) ProjectServiceClient {
+	return &projectServiceClient{cc}
+}
+
+func (c *projectServiceClient) Create(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*Project, error) {
+	out := new(Project)
+	err := c.cc.Invoke(ctx, "/entpb.ProjectService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) Get(ctx context.Context, in *ProjectLookupRequest, opts ...grpc.CallOption) (*GetProjectResponse, error) {
+	out := new(GetProjectResponse)
+	err := c.cc.Invoke(ctx, "/entpb.ProjectService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) GetProjectByName(ctx context.Context, in *GetProjectByNameRequest, opts ...grpc.CallOption) (*Project, error) {
+	out := new(Project)
+	err := c.cc.Invoke(ctx, "/entpb.ProjectService/GetProjectByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) Exists(ctx context.Context, in *ExistsProjectRequest, opts ...grpc.CallOption) (*ExistsProjectResponse, error) {
+	out := new(ExistsProjectResponse)
+	err := c.cc.Invoke(ctx, "/entpb.ProjectService/Exists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) Update(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*Project, error) {
+	out := new(Project)
+	err := c.cc.Invoke(ctx, "/entpb.ProjectService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) Delete(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/entpb.ProjectService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) DeleteProjects(ctx context.Context, in *DeleteProjectsRequest, opts ...grpc.CallOption) (*DeleteProjectsResponse, error) {
+	out := new(DeleteProjectsResponse)
+	err := c.cc.Invoke(ctx, "/entpb.ProjectService/DeleteProjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) RestoreProject(ctx context.Context, in *RestoreProjectRequest, opts ...grpc.CallOption) (*Project, error) {
+	out := new(Project)
+	err := c.cc.Invoke(ctx, "/entpb.ProjectService/RestoreProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) List(ctx context.Context, in *ListProjectRequest, opts ...grpc.CallOption) (*ListProjectResponse, error) {
+	out := new(ListProjectResponse)
+	err := c.cc.Invoke(ctx, "/entpb.ProjectService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) StreamProjects(ctx context.Context, in *StreamProjectsRequest, opts ...grpc.CallOption) (ProjectService_StreamProjectsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ProjectService_ServiceDesc.Streams[0], "/entpb.ProjectService/StreamProjects", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &projectServiceStreamProjectsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProjectService_StreamProjectsClient interface {
+	Recv() (*Project, error)
+	grpc.ClientStream
+}
+
+type projectServiceStreamProjectsClient struct {
+	grpc.ClientStream
+}
+
+func (x *projectServiceStreamProjectsClient) Recv() (*Project, error) {
+	m := new(Project)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *projectServiceClient) Count(ctx context.Context, in *CountProjectsRequest, opts ...grpc.CallOption) (*CountProjectsResponse, error) {
+	out := new(CountProjectsResponse)
+	err := c.cc.Invoke(ctx, "/entpb.ProjectService/Count", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) AggregateProject(ctx context.Context, in *AggregateProjectRequest, opts ...grpc.CallOption) (*AggregateProjectResponse, error) {
+	out := new(AggregateProjectResponse)
+	err := c.cc.Invoke(ctx, "/entpb.ProjectService/AggregateProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) BatchCreate(ctx context.Context, in *BatchCreateProjectsRequest, opts ...grpc.CallOption) (*BatchCreateProjectsResponse, error) {
+	out := new(BatchCreateProjectsResponse)
+	err := c.cc.Invoke(ctx, "/entpb.ProjectService/BatchCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) Upsert(ctx context.Context, in *UpsertProjectRequest, opts ...grpc.CallOption) (*Project, error) {
+	out := new(Project)
+	err := c.cc.Invoke(ctx, "/entpb.ProjectService/Upsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) BatchGet(ctx context.Context, in *BatchGetProjectsRequest, opts ...grpc.CallOption) (*ProjectBatch, error) {
+	out := new(ProjectBatch)
+	err := c.cc.Invoke(ctx, "/entpb.ProjectService/BatchGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) BatchDelete(ctx context.Context, in *BatchDeleteProjectsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/entpb.ProjectService/BatchDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) Stats(ctx context.Context, in *StatsProjectRequest, opts ...grpc.CallOption) (*StatsProjectResponse, error) {
+	out := new(StatsProjectResponse)
+	err := c.cc.Invoke(ctx, "/entpb.ProjectService/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) Export(ctx context.Context, in *ExportProjectRequest, opts ...grpc.CallOption) (ProjectService_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ProjectService_ServiceDesc.Streams[1], "/entpb.ProjectService/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &projectServiceExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProjectService_ExportClient interface {
+	Recv() (*ExportProjectResponse, error)
+	grpc.ClientStream
+}
+
+type projectServiceExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *projectServiceExportClient) Recv() (*ExportProjectResponse, error) {
+	m := new(ExportProjectResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *projectServiceClient) ListProjectAttachments(ctx context.Context, in *ListProjectAttachmentsRequest, opts ...grpc.CallOption) (*ListProjectAttachmentsResponse, error) {
+	out := new(ListProjectAttachmentsResponse)
+	err := c.cc.Invoke(ctx, "/entpb.ProjectService/ListProjectAttachments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) AddProjectAttachment(ctx context.Context, in *AddProjectAttachmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/entpb.ProjectService/AddProjectAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) RemoveProjectAttachment(ctx context.Context, in *RemoveProjectAttachmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/entpb.ProjectService/RemoveProjectAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProjectServiceServer is the server API for ProjectService service.
+// All implementations must embed UnimplementedProjectServiceServer
+// for forward compatibility
+type ProjectServiceServer interface {
+	Create(context.Context, *CreateProjectRequest) (*Project, error)
+	Get(context.Context, *ProjectLookupRequest) (*GetProjectResponse, error)
+	GetProjectByName(context.Context, *GetProjectByNameRequest) (*Project, error)
+	Exists(context.Context, *ExistsProjectRequest) (*ExistsProjectResponse, error)
+	Update(context.Context, *UpdateProjectRequest) (*Project, error)
+	Delete(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error)
+	DeleteProjects(context.Context, *DeleteProjectsRequest) (*DeleteProjectsResponse, error)
+	RestoreProject(context.Context, *RestoreProjectRequest) (*Project, error)
+	List(context.Context, *ListProjectRequest) (*ListProjectResponse, error)
+	StreamProjects(*StreamProjectsRequest, ProjectService_StreamProjectsServer) error
+	Count(context.Context, *CountProjectsRequest) (*CountProjectsResponse, error)
+	AggregateProject(context.Context, *AggregateProjectRequest) (*AggregateProjectResponse, error)
+	BatchCreate(context.Context, *BatchCreateProjectsRequest) (*BatchCreateProjectsResponse, error)
+	Upsert(context.Context, *UpsertProjectRequest) (*Project, error)
+	BatchGet(context.Context, *BatchGetProjectsRequest) (*ProjectBatch, error)
+	BatchDelete(context.Context, *BatchDeleteProjectsRequest) (*emptypb.Empty, error)
+	Stats(context.Context, *StatsProjectRequest) (*StatsProjectResponse, error)
+	Export(*ExportProjectRequest, ProjectService_ExportServer) error
+	ListProjectAttachments(context.Context, *ListProjectAttachmentsRequest) (*ListProjectAttachmentsResponse, error)
+	AddProjectAttachment(context.Context, *AddProjectAttachmentRequest) (*emptypb.Empty, error)
+	RemoveProjectAttachment(context.Context, *RemoveProjectAttachmentRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedProjectServiceServer()
+}
+
+// UnimplementedProjectServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedProjectServiceServer struct {
+}
+
+func (UnimplementedProjectServiceServer) Create(context.Context, *CreateProjectRequest) (*Project, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedProjectServiceServer) Get(context.Context, *ProjectLookupRequest) (*GetProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedProjectServiceServer) GetProjectByName(context.Context, *GetProjectByNameRequest) (*Project, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectByName not implemented")
+}
+func (UnimplementedProjectServiceServer) Exists(context.Context, *ExistsProjectRequest) (*ExistsProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exists not implemented")
+}
+func (UnimplementedProjectServiceServer) Update(context.Context, *UpdateProjectRequest) (*Project, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedProjectServiceServer) Delete(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedProjectServiceServer) DeleteProjects(context.Context, *DeleteProjectsRequest) (*DeleteProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProjects not implemented")
+}
+func (UnimplementedProjectServiceServer) RestoreProject(context.Context, *RestoreProjectRequest) (*Project, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProject not implemented")
+}
+func (UnimplementedProjectServiceServer) List(context.Context, *ListProjectRequest) (*ListProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedProjectServiceServer) StreamProjects(*StreamProjectsRequest, ProjectService_StreamProjectsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamProjects not implemented")
+}
+func (UnimplementedProjectServiceServer) Count(context.Context, *CountProjectsRequest) (*CountProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+func (UnimplementedProjectServiceServer) AggregateProject(context.Context, *AggregateProjectRequest) (*AggregateProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateProject not implemented")
+}
+func (UnimplementedProjectServiceServer) BatchCreate(context.Context, *BatchCreateProjectsRequest) (*BatchCreateProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedProjectServiceServer) Upsert(context.Context, *UpsertProjectRequest) (*Project, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upsert not implemented")
+}
+func (UnimplementedProjectServiceServer) BatchGet(context.Context, *BatchGetProjectsRequest) (*ProjectBatch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGet not implemented")
+}
+func (UnimplementedProjectServiceServer) BatchDelete(context.Context, *BatchDeleteProjectsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
+}
+func (UnimplementedProjectServiceServer) Stats(context.Context, *StatsProjectRequest) (*StatsProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (UnimplementedProjectServiceServer) Export(*ExportProjectRequest, ProjectService_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedProjectServiceServer) ListProjectAttachments(context.Context, *ListProjectAttachmentsRequest) (*ListProjectAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectAttachments not implemented")
+}
+func (UnimplementedProjectServiceServer) AddProjectAttachment(context.Context, *AddProjectAttachmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProjectAttachment not implemented")
+}
+func (UnimplementedProjectServiceServer) RemoveProjectAttachment(context.Context, *RemoveProjectAttachmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProjectAttachment not implemented")
+}
+func (UnimplementedProjectServiceServer) mustEmbedUnimplementedProjectServiceServer() {}
+
+// UnsafeProjectServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProjectServiceServer will
+// result in compilation errors.
+type UnsafeProjectServiceServer interface {
+	mustEmbedUnimplementedProjectServiceServer()
+}
+
+func RegisterProjectServiceServer(s grpc.ServiceRegistrar, srv ProjectServiceServer) {
+	s.RegisterService(&ProjectService_ServiceDesc, srv)
+}
+
+func _ProjectService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.ProjectService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).Create(ctx, req.(*CreateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectLookupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.ProjectService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).Get(ctx, req.(*ProjectLookupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetProjectByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetProjectByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.ProjectService/GetProjectByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetProjectByName(ctx, req.(*GetProjectByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_Exists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExistsProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).Exists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.ProjectService/Exists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).Exists(ctx, req.(*ExistsProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.ProjectService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).Update(ctx, req.(*UpdateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.ProjectService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).Delete(ctx, req.(*DeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_DeleteProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).DeleteProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.ProjectService/DeleteProjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).DeleteProjects(ctx, req.(*DeleteProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_RestoreProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).RestoreProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.ProjectService/RestoreProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).RestoreProject(ctx, req.(*RestoreProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.ProjectService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).List(ctx, req.(*ListProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_StreamProjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamProjectsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProjectServiceServer).StreamProjects(m, &projectServiceStreamProjectsServer{stream})
+}
+
+type ProjectService_StreamProjectsServer interface {
+	Send(*Project) error
+	grpc.ServerStream
+}
+
+type projectServiceStreamProjectsServer struct {
+	grpc.ServerStream
+}
+
+func (x *projectServiceStreamProjectsServer) Send(m *Project) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ProjectService_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).Count(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.ProjectService/Count",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).Count(ctx, req.(*CountProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_AggregateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).AggregateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.ProjectService/AggregateProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).AggregateProject(ctx, req.(*AggregateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.ProjectService/BatchCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).BatchCreate(ctx, req.(*BatchCreateProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_Upsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).Upsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.ProjectService/Upsert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).Upsert(ctx, req.(*UpsertProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_BatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).BatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.ProjectService/BatchGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).BatchGet(ctx, req.(*BatchGetProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).BatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.ProjectService/BatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).BatchDelete(ctx, req.(*BatchDeleteProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.ProjectService/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).Stats(ctx, req.(*StatsProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportProjectRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProjectServiceServer).Export(m, &projectServiceExportServer{stream})
+}
+
+type ProjectService_ExportServer interface {
+	Send(*ExportProjectResponse) error
+	grpc.ServerStream
+}
+
+type projectServiceExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *projectServiceExportServer) Send(m *ExportProjectResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ProjectService_ListProjectAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListProjectAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.ProjectService/ListProjectAttachments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListProjectAttachments(ctx, req.(*ListProjectAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_AddProjectAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProjectAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).AddProjectAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.ProjectService/AddProjectAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).AddProjectAttachment(ctx, req.(*AddProjectAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_RemoveProjectAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveProjectAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).RemoveProjectAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.ProjectService/RemoveProjectAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).RemoveProjectAttachment(ctx, req.(*RemoveProjectAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProjectService_ServiceDesc is the grpc.ServiceDesc for ProjectService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProjectService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.ProjectService",
+	HandlerType: (*ProjectServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ProjectService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ProjectService_Get_Handler,
+		},
+		{
+			MethodName: "GetProjectByName",
+			Handler:    _ProjectService_GetProjectByName_Handler,
+		},
+		{
+			MethodName: "Exists",
+			Handler:    _ProjectService_Exists_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ProjectService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ProjectService_Delete_Handler,
+		},
+		{
+			MethodName: "DeleteProjects",
+			Handler:    _ProjectService_DeleteProjects_Handler,
+		},
+		{
+			MethodName: "RestoreProject",
+			Handler:    _ProjectService_RestoreProject_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ProjectService_List_Handler,
+		},
+		{
+			MethodName: "Count",
+			Handler:    _ProjectService_Count_Handler,
+		},
+		{
+			MethodName: "AggregateProject",
+			Handler:    _ProjectService_AggregateProject_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _ProjectService_BatchCreate_Handler,
+		},
+		{
+			MethodName: "Upsert",
+			Handler:    _ProjectService_Upsert_Handler,
+		},
+		{
+			MethodName: "BatchGet",
+			Handler:    _ProjectService_BatchGet_Handler,
+		},
+		{
+			MethodName: "BatchDelete",
+			Handler:    _ProjectService_BatchDelete_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _ProjectService_Stats_Handler,
+		},
+		{
+			MethodName: "ListProjectAttachments",
+			Handler:    _ProjectService_ListProjectAttachments_Handler,
+		},
+		{
+			MethodName: "AddProjectAttachment",
+			Handler:    _ProjectService_AddProjectAttachment_Handler,
+		},
+		{
+			MethodName: "RemoveProjectAttachment",
+			Handler:    _ProjectService_RemoveProjectAttachment_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamProjects",
+			Handler:       _ProjectService_StreamProjects_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Export",
+			Handler:       _ProjectService_Export_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "entpb/entpb.proto",
+}
+
+// TodoServiceClient is the client API for TodoService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TodoServiceClient interface {
+	Create(ctx context.Context, in *CreateTodoRequest, opts ...grpc.CallOption) (*Todo, error)
+	Get(ctx context.Context, in *GetTodoRequest, opts ...grpc.CallOption) (*Todo, error)
+	Update(ctx context.Context, in *UpdateTodoRequest, opts ...grpc.CallOption) (*Todo, error)
+	Delete(ctx context.Context, in *DeleteTodoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListTodoRequest, opts ...grpc.CallOption) (*ListTodoResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateTodosRequest, opts ...grpc.CallOption) (*BatchCreateTodosResponse, error)
+}
+
+type todoServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTodoServiceClient(cc grpc.ClientConnInterface) TodoServiceClient {
+	return &todoServiceClient{cc}
+}
+
+func (c *todoServiceClient) Create(ctx context.Context, in *CreateTodoRequest, opts ...grpc.CallOption) (*Todo, error) {
+	out := new(Todo)
+	err := c.cc.Invoke(ctx, "/entpb.TodoService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) Get(ctx context.Context, in *GetTodoRequest, opts ...grpc.CallOption) (*Todo, error) {
+	out := new(Todo)
+	err := c.cc.Invoke(ctx, "/entpb.TodoService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) Update(ctx context.Context, in *UpdateTodoRequest, opts ...grpc.CallOption) (*Todo, error) {
+	out := new(Todo)
+	err := c.cc.Invoke(ctx, "/entpb.TodoService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) Delete(ctx context.Context, in *DeleteTodoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/entpb.TodoService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) List(ctx context.Context, in *ListTodoRequest, opts ...grpc.CallOption) (*ListTodoResponse, error) {
+	out := new(ListTodoResponse)
+	err := c.cc.Invoke(ctx, "/entpb.TodoService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) BatchCreate(ctx context.Context, in *BatchCreateTodosRequest, opts ...grpc.CallOption) (*BatchCreateTodosResponse, error) {
+	out := new(BatchCreateTodosResponse)
+	err := c.cc.Invoke(ctx, "/entpb.TodoService/BatchCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TodoServiceServer is the server API for TodoService service.
+// All implementations must embed UnimplementedTodoServiceServer
+// for forward compatibility
+type TodoServiceServer interface {
+	Create(context.Context, *CreateTodoRequest) (*Todo, error)
+	Get(context.Context, *GetTodoRequest) (*Todo, error)
+	Update(context.Context, *UpdateTodoRequest) (*Todo, error)
+	Delete(context.Context, *DeleteTodoRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListTodoRequest) (*ListTodoResponse, error)
+	BatchCreate(context.Context, *BatchCreateTodosRequest) (*BatchCreateTodosResponse, error)
+	mustEmbedUnimplementedTodoServiceServer()
+}
+
+// UnimplementedTodoServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTodoServiceServer struct {
+}
+
+func (UnimplementedTodoServiceServer) Create(context.Context, *CreateTodoRequest) (*Todo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedTodoServiceServer) Get(context.Context, *GetTodoRequest) (*Todo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedTodoServiceServer) Update(context.Context, *UpdateTodoRequest) (*Todo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedTodoServiceServer) Delete(context.Context, *DeleteTodoRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedTodoServiceServer) List(context.Context, *ListTodoRequest) (*ListTodoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedTodoServiceServer) BatchCreate(context.Context, *BatchCreateTodosRequest) (*BatchCreateTodosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedTodoServiceServer) mustEmbedUnimplementedTodoServiceServer() {}
+
+// UnsafeTodoServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TodoServiceServer will
+// result in compilation errors.
+type UnsafeTodoServiceServer interface {
+	mustEmbedUnimplementedTodoServiceServer()
+}
+
+func RegisterTodoServiceServer(s grpc.ServiceRegistrar, srv TodoServiceServer) {
+	s.RegisterService(&TodoService_ServiceDesc, srv)
+}
+
+func _TodoService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.TodoService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).Create(ctx, req.(*CreateTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.TodoService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).Get(ctx, req.(*GetTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.TodoService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).Update(ctx, req.(*UpdateTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.TodoService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).Delete(ctx, req.(*DeleteTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.TodoService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).List(ctx, req.(*ListTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTodosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.TodoService/BatchCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).BatchCreate(ctx, req.(*BatchCreateTodosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TodoService_ServiceDesc is the grpc.ServiceDesc for TodoService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TodoService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.TodoService",
+	HandlerType: (*TodoServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _TodoService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _TodoService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _TodoService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _TodoService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _TodoService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _TodoService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+// UserServiceClient is the client API for UserService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserServiceClient interface {
+	Create(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
+	Get(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error)
+	GetUserByUserName(ctx context.Context, in *GetUserByUserNameRequest, opts ...grpc.CallOption) (*User, error)
+	GetUserByExternalID(ctx context.Context, in *GetUserByExternalIDRequest, opts ...grpc.CallOption) (*User, error)
+	GetUserByBUser1(ctx context.Context, in *GetUserByBUser1Request, opts ...grpc.CallOption) (*User, error)
+	Exists(ctx context.Context, in *ExistsUserRequest, opts ...grpc.CallOption) (*ExistsUserResponse, error)
+	Update(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
+	Delete(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteUsers(ctx context.Context, in *DeleteUsersRequest, opts ...grpc.CallOption) (*DeleteUsersResponse, error)
+	List(ctx context.Context, in *ListUserRequest, opts ...grpc.CallOption) (*ListUserResponse, error)
+	StreamUsers(ctx context.Context, in *StreamUsersRequest, opts ...grpc.CallOption) (UserService_StreamUsersClient, error)
+	Count(ctx context.Context, in *CountUsersRequest, opts ...grpc.CallOption) (*CountUsersResponse, error)
+	AggregateUser(ctx context.Context, in *AggregateUserRequest, opts ...grpc.CallOption) (*AggregateUserResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateUsersRequest, opts ...grpc.CallOption) (*BatchCreateUsersResponse, error)
+	Upsert(ctx context.Context, in *UpsertUserRequest, opts ...grpc.CallOption) (*User, error)
+	BatchGet(ctx context.Context, in *BatchGetUsersRequest, opts ...grpc.CallOption) (*BatchGetUsersResponse, error)
+	BatchUpdate(ctx context.Context, in *BatchUpdateUsersRequest, opts ...grpc.CallOption) (*BatchUpdateUsersResponse, error)
+	BatchDelete(ctx context.Context, in *BatchDeleteUsersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Stats(ctx context.Context, in *StatsUserRequest, opts ...grpc.CallOption) (*StatsUserResponse, error)
+	Export(ctx context.Context, in *ExportUserRequest, opts ...grpc.CallOption) (UserService_ExportClient, error)
+	Import(ctx context.Context, opts ...grpc.CallOption) (UserService_ImportClient, error)
+	WatchUser(ctx context.Context, in *WatchUserRequest, opts ...grpc.CallOption) (UserService_WatchUserClient, error)
+}
+
+type userServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
+	return &userServiceClient{cc}
+}
+
+func (c *userServiceClient) Create(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/entpb.UserService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Get(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/entpb.UserService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserByUserName(ctx context.Context, in *GetUserByUserNameRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/entpb.UserService/GetUserByUserName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserByExternalID(ctx context.Context, in *GetUserByExternalIDRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/entpb.UserService/GetUserByExternalID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserByBUser1(ctx context.Context, in *GetUserByBUser1Request, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/entpb.UserService/GetUserByBUser1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Exists(ctx context.Context, in *ExistsUserRequest, opts ...grpc.CallOption) (*ExistsUserResponse, error) {
+	out := new(ExistsUserResponse)
+	err := c.cc.Invoke(ctx, "/entpb.UserService/Exists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Update(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/entpb.UserService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Delete(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/entpb.UserService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUsers(ctx context.Context, in *DeleteUsersRequest, opts ...grpc.CallOption) (*DeleteUsersResponse, error) {
+	out := new(DeleteUsersResponse)
+	err := c.cc.Invoke(ctx, "/entpb.UserService/DeleteUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) List(ctx context.Context, in *ListUserRequest, opts ...grpc.CallOption) (*ListUserResponse, error) {
+	out := new(ListUserResponse)
+	err := c.cc.Invoke(ctx, "/entpb.UserService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) StreamUsers(ctx context.Context, in *StreamUsersRequest, opts ...grpc.CallOption) (UserService_StreamUsersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[0], "/entpb.UserService/StreamUsers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userServiceStreamUsersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UserService_StreamUsersClient interface {
+	Recv() (*User, error)
+	grpc.ClientStream
+}
+
+type userServiceStreamUsersClient struct {
+	grpc.ClientStream
+}
+
+func (x *userServiceStreamUsersClient) Recv() (*User, error) {
+	m := new(User)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *userServiceClient) Count(ctx context.Context, in *CountUsersRequest, opts ...grpc.CallOption) (*CountUsersResponse, error) {
+	out := new(CountUsersResponse)
+	err := c.cc.Invoke(ctx, "/entpb.UserService/Count", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AggregateUser(ctx context.Context, in *AggregateUserRequest, opts ...grpc.CallOption) (*AggregateUserResponse, error) {
+	out := new(AggregateUserResponse)
+	err := c.cc.Invoke(ctx, "/entpb.UserService/AggregateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BatchCreate(ctx context.Context, in *BatchCreateUsersRequest, opts ...grpc.CallOption) (*BatchCreateUsersResponse, error) {
+	out := new(BatchCreateUsersResponse)
+	err := c.cc.Invoke(ctx, "/entpb.UserService/BatchCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Upsert(ctx context.Context, in *UpsertUserRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/entpb.UserService/Upsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BatchGet(ctx context.Context, in *BatchGetUsersRequest, opts ...grpc.CallOption) (*BatchGetUsersResponse, error) {
+	out := new(BatchGetUsersResponse)
+	err := c.cc.Invoke(ctx, "/entpb.UserService/BatchGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BatchUpdate(ctx context.Context, in *BatchUpdateUsersRequest, opts ...grpc.CallOption) (*BatchUpdateUsersResponse, error) {
+	out := new(BatchUpdateUsersResponse)
+	err := c.cc.Invoke(ctx, "/entpb.UserService/BatchUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BatchDelete(ctx context.Context, in *BatchDeleteUsersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/entpb.UserService/BatchDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Stats(ctx context.Context, in *StatsUserRequest, opts ...grpc.CallOption) (*StatsUserResponse, error) {
+	out := new(StatsUserResponse)
+	err := c.cc.Invoke(ctx, "/entpb.UserService/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Export(ctx context.Context, in *ExportUserRequest, opts ...grpc.CallOption) (UserService_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[1], "/entpb.UserService/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userServiceExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UserService_ExportClient interface {
+	Recv() (*ExportUserResponse, error)
+	grpc.ClientStream
+}
+
+type userServiceExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *userServiceExportClient) Recv() (*ExportUserResponse, error) {
+	m := new(ExportUserResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *userServiceClient) Import(ctx context.Context, opts ...grpc.CallOption) (UserService_ImportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[2], "/entpb.UserService/Import", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userServiceImportClient{stream}
+	return x, nil
+}
+
+type UserService_ImportClient interface {
+	Send(*ImportUserRequest) error
+	CloseAndRecv() (*ImportUserResponse, error)
+	grpc.ClientStream
+}
+
+type userServiceImportClient struct {
+	grpc.ClientStream
+}
+
+func (x *userServiceImportClient) Send(m *ImportUserRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *userServiceImportClient) CloseAndRecv() (*ImportUserResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportUserResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *userServiceClient) WatchUser(ctx context.Context, in *WatchUserRequest, opts ...grpc.CallOption) (UserService_WatchUserClient, error) {
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[3], "/entpb.UserService/WatchUser", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userServiceWatchUserClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return x, nil
 }
 
-func (c *userServiceClient) BatchCreate(ctx context.Context, in *BatchCreateUsersRequest, opts ...grpc.CallOption) (*BatchCreateUsersResponse, error) {
-	out := new(BatchCreateUsersResponse)
-	err := c.cc.Invoke(ctx, "/entpb.UserService/BatchCreate", in, out, opts...)
-	if err != nil {
+type UserService_WatchUserClient interface {
+	Recv() (*UserEvent, error)
+	grpc.ClientStream
+}
+
+type userServiceWatchUserClient struct {
+	grpc.ClientStream
+}
+
+func (x *userServiceWatchUserClient) Recv() (*UserEvent, error) {
+	m := new(UserEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
 // UserServiceServer is the server API for UserService service.
@@ -1250,10 +3810,26 @@ func (c *userServiceClient) BatchCreate(ctx context.Context, in *BatchCreateUser
 type UserServiceServer interface {
 	Create(context.Context, *CreateUserRequest) (*User, error)
 	Get(context.Context, *GetUserRequest) (*User, error)
+	GetUserByUserName(context.Context, *GetUserByUserNameRequest) (*User, error)
+	GetUserByExternalID(context.Context, *GetUserByExternalIDRequest) (*User, error)
+	GetUserByBUser1(context.Context, *GetUserByBUser1Request) (*User, error)
+	Exists(context.Context, *ExistsUserRequest) (*ExistsUserResponse, error)
 	Update(context.Context, *UpdateUserRequest) (*User, error)
 	Delete(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
+	DeleteUsers(context.Context, *DeleteUsersRequest) (*DeleteUsersResponse, error)
 	List(context.Context, *ListUserRequest) (*ListUserResponse, error)
+	StreamUsers(*StreamUsersRequest, UserService_StreamUsersServer) error
+	Count(context.Context, *CountUsersRequest) (*CountUsersResponse, error)
+	AggregateUser(context.Context, *AggregateUserRequest) (*AggregateUserResponse, error)
 	BatchCreate(context.Context, *BatchCreateUsersRequest) (*BatchCreateUsersResponse, error)
+	Upsert(context.Context, *UpsertUserRequest) (*User, error)
+	BatchGet(context.Context, *BatchGetUsersRequest) (*BatchGetUsersResponse, error)
+	BatchUpdate(context.Context, *BatchUpdateUsersRequest) (*BatchUpdateUsersResponse, error)
+	BatchDelete(context.Context, *BatchDeleteUsersRequest) (*emptypb.Empty, error)
+	Stats(context.Context, *StatsUserRequest) (*StatsUserResponse, error)
+	Export(*ExportUserRequest, UserService_ExportServer) error
+	Import(UserService_ImportServer) error
+	WatchUser(*WatchUserRequest, UserService_WatchUserServer) error
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -1267,18 +3843,66 @@ func (UnimplementedUserServiceServer) Create(context.Context, *CreateUserRequest
 func (UnimplementedUserServiceServer) Get(context.Context, *GetUserRequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
+func (UnimplementedUserServiceServer) GetUserByUserName(context.Context, *GetUserByUserNameRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByUserName not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserByExternalID(context.Context, *GetUserByExternalIDRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByExternalID not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserByBUser1(context.Context, *GetUserByBUser1Request) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByBUser1 not implemented")
+}
+func (UnimplementedUserServiceServer) Exists(context.Context, *ExistsUserRequest) (*ExistsUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exists not implemented")
+}
 func (UnimplementedUserServiceServer) Update(context.Context, *UpdateUserRequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
 func (UnimplementedUserServiceServer) Delete(context.Context, *DeleteUserRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedUserServiceServer) DeleteUsers(context.Context, *DeleteUsersRequest) (*DeleteUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUsers not implemented")
+}
 func (UnimplementedUserServiceServer) List(context.Context, *ListUserRequest) (*ListUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedUserServiceServer) StreamUsers(*StreamUsersRequest, UserService_StreamUsersServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamUsers not implemented")
+}
+func (UnimplementedUserServiceServer) Count(context.Context, *CountUsersRequest) (*CountUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+func (UnimplementedUserServiceServer) AggregateUser(context.Context, *AggregateUserRequest) (*AggregateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateUser not implemented")
+}
 func (UnimplementedUserServiceServer) BatchCreate(context.Context, *BatchCreateUsersRequest) (*BatchCreateUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
 }
+func (UnimplementedUserServiceServer) Upsert(context.Context, *UpsertUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upsert not implemented")
+}
+func (UnimplementedUserServiceServer) BatchGet(context.Context, *BatchGetUsersRequest) (*BatchGetUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGet not implemented")
+}
+func (UnimplementedUserServiceServer) BatchUpdate(context.Context, *BatchUpdateUsersRequest) (*BatchUpdateUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdate not implemented")
+}
+func (UnimplementedUserServiceServer) BatchDelete(context.Context, *BatchDeleteUsersRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
+}
+func (UnimplementedUserServiceServer) Stats(context.Context, *StatsUserRequest) (*StatsUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (UnimplementedUserServiceServer) Export(*ExportUserRequest, UserService_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedUserServiceServer) Import(UserService_ImportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (UnimplementedUserServiceServer) WatchUser(*WatchUserRequest, UserService_WatchUserServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchUser not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1328,6 +3952,78 @@ func _UserService_Get_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetUserByUserName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByUserNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserByUserName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.UserService/GetUserByUserName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserByUserName(ctx, req.(*GetUserByUserNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserByExternalID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByExternalIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserByExternalID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.UserService/GetUserByExternalID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserByExternalID(ctx, req.(*GetUserByExternalIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserByBUser1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByBUser1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserByBUser1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.UserService/GetUserByBUser1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserByBUser1(ctx, req.(*GetUserByBUser1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Exists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExistsUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Exists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.UserService/Exists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Exists(ctx, req.(*ExistsUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateUserRequest)
 	if err := dec(in); err != nil {
@@ -1364,6 +4060,24 @@ func _UserService_Delete_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_DeleteUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.UserService/DeleteUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUsers(ctx, req.(*DeleteUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListUserRequest)
 	if err := dec(in); err != nil {
@@ -1382,6 +4096,63 @@ func _UserService_List_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_StreamUsers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamUsersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).StreamUsers(m, &userServiceStreamUsersServer{stream})
+}
+
+type UserService_StreamUsersServer interface {
+	Send(*User) error
+	grpc.ServerStream
+}
+
+type userServiceStreamUsersServer struct {
+	grpc.ServerStream
+}
+
+func (x *userServiceStreamUsersServer) Send(m *User) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _UserService_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Count(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.UserService/Count",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Count(ctx, req.(*CountUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AggregateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AggregateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.UserService/AggregateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AggregateUser(ctx, req.(*AggregateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BatchCreateUsersRequest)
 	if err := dec(in); err != nil {
@@ -1400,6 +4171,164 @@ func _UserService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_Upsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Upsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.UserService/Upsert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Upsert(ctx, req.(*UpsertUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.UserService/BatchGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BatchGet(ctx, req.(*BatchGetUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BatchUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BatchUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.UserService/BatchUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BatchUpdate(ctx, req.(*BatchUpdateUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.UserService/BatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BatchDelete(ctx, req.(*BatchDeleteUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.UserService/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Stats(ctx, req.(*StatsUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUserRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).Export(m, &userServiceExportServer{stream})
+}
+
+type UserService_ExportServer interface {
+	Send(*ExportUserResponse) error
+	grpc.ServerStream
+}
+
+type userServiceExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *userServiceExportServer) Send(m *ExportUserResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _UserService_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UserServiceServer).Import(&userServiceImportServer{stream})
+}
+
+type UserService_ImportServer interface {
+	SendAndClose(*ImportUserResponse) error
+	Recv() (*ImportUserRequest, error)
+	grpc.ServerStream
+}
+
+type userServiceImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *userServiceImportServer) SendAndClose(m *ImportUserResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *userServiceImportServer) Recv() (*ImportUserRequest, error) {
+	m := new(ImportUserRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _UserService_WatchUser_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchUserRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).WatchUser(m, &userServiceWatchUserServer{stream})
+}
+
+type UserService_WatchUserServer interface {
+	Send(*UserEvent) error
+	grpc.ServerStream
+}
+
+type userServiceWatchUserServer struct {
+	grpc.ServerStream
+}
+
+func (x *userServiceWatchUserServer) Send(m *UserEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1415,6 +4344,22 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _UserService_Get_Handler,
 		},
+		{
+			MethodName: "GetUserByUserName",
+			Handler:    _UserService_GetUserByUserName_Handler,
+		},
+		{
+			MethodName: "GetUserByExternalID",
+			Handler:    _UserService_GetUserByExternalID_Handler,
+		},
+		{
+			MethodName: "GetUserByBUser1",
+			Handler:    _UserService_GetUserByBUser1_Handler,
+		},
+		{
+			MethodName: "Exists",
+			Handler:    _UserService_Exists_Handler,
+		},
 		{
 			MethodName: "Update",
 			Handler:    _UserService_Update_Handler,
@@ -1423,15 +4368,68 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _UserService_Delete_Handler,
 		},
+		{
+			MethodName: "DeleteUsers",
+			Handler:    _UserService_DeleteUsers_Handler,
+		},
 		{
 			MethodName: "List",
 			Handler:    _UserService_List_Handler,
 		},
+		{
+			MethodName: "Count",
+			Handler:    _UserService_Count_Handler,
+		},
+		{
+			MethodName: "AggregateUser",
+			Handler:    _UserService_AggregateUser_Handler,
+		},
 		{
 			MethodName: "BatchCreate",
 			Handler:    _UserService_BatchCreate_Handler,
 		},
+		{
+			MethodName: "Upsert",
+			Handler:    _UserService_Upsert_Handler,
+		},
+		{
+			MethodName: "BatchGet",
+			Handler:    _UserService_BatchGet_Handler,
+		},
+		{
+			MethodName: "BatchUpdate",
+			Handler:    _UserService_BatchUpdate_Handler,
+		},
+		{
+			MethodName: "BatchDelete",
+			Handler:    _UserService_BatchDelete_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _UserService_Stats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamUsers",
+			Handler:       _UserService_StreamUsers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Export",
+			Handler:       _UserService_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Import",
+			Handler:       _UserService_Import_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchUser",
+			Handler:       _UserService_WatchUser_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "entpb/entpb.proto",
 }
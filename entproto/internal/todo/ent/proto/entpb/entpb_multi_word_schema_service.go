@@ -7,26 +7,166 @@ import (
 	entproto "entgo.io/contrib/entproto"
 	ent "entgo.io/contrib/entproto/internal/todo/ent"
 	multiwordschema "entgo.io/contrib/entproto/internal/todo/ent/multiwordschema"
-	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	runtime "entgo.io/contrib/entproto/runtime"
+	errors "errors"
 	fmt "fmt"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	strconv "strconv"
 	strings "strings"
+	time "time"
 )
 
 // MultiWordSchemaService implements MultiWordSchemaServiceServer
 type MultiWordSchemaService struct {
 	client *ent.Client
+	// config holds the configuration of the service, set by the options of the constructor.
+	config runtime.Config
+	// hooks are run around the methods of the service, see Use.
+	hooks []MultiWordSchemaServiceHooks
 	UnimplementedMultiWordSchemaServiceServer
 }
 
-// NewMultiWordSchemaService returns a new MultiWordSchemaService
-func NewMultiWordSchemaService(client *ent.Client) *MultiWordSchemaService {
-	return &MultiWordSchemaService{
+// MultiWordSchemaServiceOption configures a MultiWordSchemaService, see NewMultiWordSchemaService.
+// The options of the runtime package, such as runtime.WithMaxPageSize, are MultiWordSchemaServiceOptions.
+// The hooks of the service are registered with WithMultiWordSchemaServiceHooks.
+type MultiWordSchemaServiceOption interface {
+	ApplyConfig(*runtime.Config)
+}
+
+// multiWordSchemaServiceOption is a MultiWordSchemaServiceOption configuring the MultiWordSchemaService itself rather than its runtime config.
+type multiWordSchemaServiceOption func(*MultiWordSchemaService)
+
+// ApplyConfig implements MultiWordSchemaServiceOption.
+func (multiWordSchemaServiceOption) ApplyConfig(*runtime.Config) {}
+
+// NewMultiWordSchemaService returns a new MultiWordSchemaService, configured by the given options
+func NewMultiWordSchemaService(client *ent.Client, opts ...MultiWordSchemaServiceOption) *MultiWordSchemaService {
+	svc := &MultiWordSchemaService{
 		client: client,
 	}
+	for _, opt := range opts {
+		opt.ApplyConfig(&svc.config)
+		if o, ok := opt.(multiWordSchemaServiceOption); ok {
+			o(svc)
+		}
+	}
+	return svc
+}
+
+// MultiWordSchemaServiceHooks holds the functions run around the methods of the MultiWordSchemaService,
+// registered with its Use method. The Before hooks receive the mutation builder before it is saved, and the
+// After hooks the response of the method once the entity is saved. A hook failing with an error fails the
+// method with this error, which should be a gRPC status error. Nil hooks are skipped.
+//
+// The methods creating, updating or deleting several entries run the hooks for each of them. The Upsert and
+// Import methods run the Create hooks with a request holding the entity, and the methods deleting entries by IDs
+// or by filter run the Delete hooks with a request holding the ID of each deleted entry.
+type MultiWordSchemaServiceHooks struct {
+	BeforeCreate func(ctx context.Context, req *CreateMultiWordSchemaRequest, m *ent.MultiWordSchemaCreate) error
+	AfterCreate  func(ctx context.Context, req *CreateMultiWordSchemaRequest, res *MultiWordSchema) error
+	BeforeUpdate func(ctx context.Context, req *UpdateMultiWordSchemaRequest, m *ent.MultiWordSchemaUpdateOne) error
+	AfterUpdate  func(ctx context.Context, req *UpdateMultiWordSchemaRequest, res *MultiWordSchema) error
+	BeforeDelete func(ctx context.Context, req *DeleteMultiWordSchemaRequest) error
+	AfterDelete  func(ctx context.Context, req *DeleteMultiWordSchemaRequest) error
+}
+
+// Use registers hooks run around the methods of the service, in the order they are registered. It is not safe to
+// call it while the service serves requests.
+func (svc *MultiWordSchemaService) Use(hooks ...MultiWordSchemaServiceHooks) {
+	svc.hooks = append(svc.hooks, hooks...)
+}
+
+// WithMultiWordSchemaServiceHooks registers hooks run around the methods of the service, like its Use method.
+func WithMultiWordSchemaServiceHooks(hooks ...MultiWordSchemaServiceHooks) MultiWordSchemaServiceOption {
+	return multiWordSchemaServiceOption(func(svc *MultiWordSchemaService) {
+		svc.Use(hooks...)
+	})
+}
+
+// runBeforeCreateHooks runs the BeforeCreate hooks of the service, stopping at the first failing one.
+func (svc *MultiWordSchemaService) runBeforeCreateHooks(ctx context.Context, req *CreateMultiWordSchemaRequest, m *ent.MultiWordSchemaCreate) error {
+	for _, h := range svc.hooks {
+		if h.BeforeCreate != nil {
+			if err := h.BeforeCreate(ctx, req, m); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runAfterCreateHooks runs the AfterCreate hooks of the service, stopping at the first failing one.
+func (svc *MultiWordSchemaService) runAfterCreateHooks(ctx context.Context, req *CreateMultiWordSchemaRequest, res *MultiWordSchema) error {
+	for _, h := range svc.hooks {
+		if h.AfterCreate != nil {
+			if err := h.AfterCreate(ctx, req, res); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runBeforeUpdateHooks runs the BeforeUpdate hooks of the service, stopping at the first failing one.
+func (svc *MultiWordSchemaService) runBeforeUpdateHooks(ctx context.Context, req *UpdateMultiWordSchemaRequest, m *ent.MultiWordSchemaUpdateOne) error {
+	for _, h := range svc.hooks {
+		if h.BeforeUpdate != nil {
+			if err := h.BeforeUpdate(ctx, req, m); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runAfterUpdateHooks runs the AfterUpdate hooks of the service, stopping at the first failing one.
+func (svc *MultiWordSchemaService) runAfterUpdateHooks(ctx context.Context, req *UpdateMultiWordSchemaRequest, res *MultiWordSchema) error {
+	for _, h := range svc.hooks {
+		if h.AfterUpdate != nil {
+			if err := h.AfterUpdate(ctx, req, res); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runBeforeDeleteHooks runs the BeforeDelete hooks of the service, stopping at the first failing one.
+func (svc *MultiWordSchemaService) runBeforeDeleteHooks(ctx context.Context, req *DeleteMultiWordSchemaRequest) error {
+	for _, h := range svc.hooks {
+		if h.BeforeDelete != nil {
+			if err := h.BeforeDelete(ctx, req); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runAfterDeleteHooks runs the AfterDelete hooks of the service, stopping at the first failing one.
+func (svc *MultiWordSchemaService) runAfterDeleteHooks(ctx context.Context, req *DeleteMultiWordSchemaRequest) error {
+	for _, h := range svc.hooks {
+		if h.AfterDelete != nil {
+			if err := h.AfterDelete(ctx, req); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ToProtoMultiWordSchemaUnit converts the unit enum of the ent type to the pb enum.
+// Unknown values are converted to the zero value of the pb enum.
+func ToProtoMultiWordSchemaUnit(e multiwordschema.Unit) MultiWordSchema_Unit {
+	return toProtoMultiWordSchema_Unit(e)
+}
+
+// ToEntMultiWordSchemaUnit converts the pb enum to the unit enum of the ent type.
+// Unknown values are converted to the empty value.
+func ToEntMultiWordSchemaUnit(e MultiWordSchema_Unit) multiwordschema.Unit {
+	return toEntMultiWordSchema_Unit(e)
 }
 
 func toProtoMultiWordSchema_Unit(e multiwordschema.Unit) MultiWordSchema_Unit {
@@ -57,6 +197,51 @@ func toProtoMultiWordSchema(e *ent.MultiWordSchema) (*MultiWordSchema, error) {
 	return v, nil
 }
 
+// ToProtoMultiWordSchemaWithEdges transforms the ent type to the pb type, converting its eager-loaded edges
+// into their messages down to the edge depth the package was generated with, see the edge_depth flag.
+// Edges beyond that depth, and edges of types without a service in the package, only hold their IDs.
+func ToProtoMultiWordSchemaWithEdges(e *ent.MultiWordSchema) (*MultiWordSchema, error) {
+	return toProtoMultiWordSchemaWithEdges(e, 1)
+}
+
+// toProtoMultiWordSchemaWithEdges transforms the ent type to the pb type, converting its eager-loaded edges
+// into their messages down to depth levels.
+func toProtoMultiWordSchemaWithEdges(e *ent.MultiWordSchema, depth int) (*MultiWordSchema, error) {
+	v, err := toProtoMultiWordSchema(e)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ToEntMultiWordSchemaWithEdges transforms the pb type to the ent type, the reverse of ToProtoMultiWordSchemaWithEdges.
+// The edges of the message are set on the Edges of the entity down to the edge depth the package was
+// generated with. Edges beyond that depth only hold their IDs.
+func ToEntMultiWordSchemaWithEdges(v *MultiWordSchema) (*ent.MultiWordSchema, error) {
+	return toEntMultiWordSchemaWithEdges(v, 1)
+}
+
+// toEntMultiWordSchemaWithEdges transforms the pb type to the ent type, converting the edges of the message
+// into their entities down to depth levels.
+func toEntMultiWordSchemaWithEdges(v *MultiWordSchema, depth int) (*ent.MultiWordSchema, error) {
+	e, err := toEntMultiWordSchema(v)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// toEntMultiWordSchema transforms the pb type to the ent type, setting the edges of the entity to entities
+// holding their IDs only.
+func toEntMultiWordSchema(v *MultiWordSchema) (*ent.MultiWordSchema, error) {
+	e := &ent.MultiWordSchema{}
+	multiwordschemaID := int(v.GetId())
+	e.ID = multiwordschemaID
+	multiwordschemaUnit := toEntMultiWordSchema_Unit(v.GetUnit())
+	e.Unit = multiwordschemaUnit
+	return e, nil
+}
+
 // toProtoMultiWordSchemaList transforms a list of ent type to a list of pb type
 func toProtoMultiWordSchemaList(e []*ent.MultiWordSchema) ([]*MultiWordSchema, error) {
 	var pbList []*MultiWordSchema
@@ -70,38 +255,99 @@ func toProtoMultiWordSchemaList(e []*ent.MultiWordSchema) ([]*MultiWordSchema, e
 	return pbList, nil
 }
 
+// multiwordschemaValidationError returns an InvalidArgument status error for the ent validation error err,
+// holding the violation of the field of the request it failed on. prefix is the path of the MultiWordSchema
+// message in the request, or empty if the request is the message itself.
+func multiwordschemaValidationError(err error, prefix string) error {
+	var verr *ent.ValidationError
+	if !errors.As(err, &verr) {
+		return status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	field := prefix + verr.Name
+	return runtime.FieldViolation(field, err)
+}
+
+// multiwordschemaConstraintField returns the path of the field of the request violating the database constraint
+// of err, or an empty string if it is unknown. prefix is the path of the MultiWordSchema message in the request,
+// or empty if the request is the message itself.
+func multiwordschemaConstraintField(err error, prefix string) string {
+	switch runtime.ConstraintColumn(err) {
+	case "id":
+		return prefix + "id"
+	}
+	return ""
+}
+
 // Create implements MultiWordSchemaServiceServer.Create
 func (svc *MultiWordSchemaService) Create(ctx context.Context, req *CreateMultiWordSchemaRequest) (*MultiWordSchema, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.MultiWordSchemaService/Create", "MultiWordSchema")
+	if err := svc.config.Writable("MultiWordSchemaService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.MultiWordSchemaService", "Create", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveCreate(ctx, req)
+	return res, svc.config.RecordRequest("entpb.MultiWordSchemaService", "Create", start, runtime.EndSpan(span, err))
+}
+
+// serveCreate serves the Create method within its span.
+func (svc *MultiWordSchemaService) serveCreate(ctx context.Context, req *CreateMultiWordSchemaRequest) (*MultiWordSchema, error) {
 	multiwordschema := req.GetMultiWordSchema()
-	m, err := svc.createBuilder(multiwordschema)
+	m, err := svc.createBuilder(ctx, multiwordschema)
 	if err != nil {
 		return nil, err
 	}
+	if err := svc.runBeforeCreateHooks(ctx, req, m); err != nil {
+		return nil, err
+	}
+	runtime.TraceQuery(ctx)
 	res, err := m.Save(ctx)
 	switch {
 	case err == nil:
+		runtime.TraceID(ctx, res.ID)
+		runtime.TraceConvert(ctx)
 		proto, err := toProtoMultiWordSchema(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		if err := svc.runAfterCreateHooks(ctx, req, proto); err != nil {
+			return nil, err
 		}
 		return proto, nil
-	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsValidationError(err):
+		return nil, multiwordschemaValidationError(err, "multi_word_schema.")
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, svc.config.ConstraintError(err, multiwordschemaConstraintField(err, "multi_word_schema."))
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Get implements MultiWordSchemaServiceServer.Get
 func (svc *MultiWordSchemaService) Get(ctx context.Context, req *GetMultiWordSchemaRequest) (*MultiWordSchema, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.MultiWordSchemaService/Get", "MultiWordSchema")
+	res, err := svc.serveGet(ctx, req)
+	return res, svc.config.RecordRequest("entpb.MultiWordSchemaService", "Get", start, runtime.EndSpan(span, err))
+}
+
+// serveGet serves the Get method within its span.
+func (svc *MultiWordSchemaService) serveGet(ctx context.Context, req *GetMultiWordSchemaRequest) (*MultiWordSchema, error) {
 	var (
 		err error
 		get *ent.MultiWordSchema
 	)
 	id := int(req.GetId())
+	runtime.TraceID(ctx, id)
+	// Entities requested without their edges are served from the cache of the service, if any.
+	cacheable := (req.GetView() == GetMultiWordSchemaRequest_VIEW_UNSPECIFIED || req.GetView() == GetMultiWordSchemaRequest_BASIC)
+	if cacheable {
+		cached := &MultiWordSchema{}
+		if svc.config.CachedEntity(ctx, "MultiWordSchema", id, cached) {
+			return cached, nil
+		}
+	}
+	runtime.TraceQuery(ctx)
 	switch req.GetView() {
 	case GetMultiWordSchemaRequest_VIEW_UNSPECIFIED, GetMultiWordSchemaRequest_BASIC:
 		get, err = svc.client.MultiWordSchema.Get(ctx, id)
@@ -114,87 +360,225 @@ func (svc *MultiWordSchemaService) Get(ctx context.Context, req *GetMultiWordSch
 	}
 	switch {
 	case err == nil:
-		return toProtoMultiWordSchema(get)
+		runtime.TraceConvert(ctx)
+		protoGet, err := toProtoMultiWordSchema(get)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		if cacheable {
+			svc.config.CacheEntity(ctx, "MultiWordSchema", id, protoGet)
+		}
+		return protoGet, nil
 	case ent.IsNotFound(err):
-		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+		return nil, svc.config.MapError(err, codes.NotFound, "not found: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Update implements MultiWordSchemaServiceServer.Update
 func (svc *MultiWordSchemaService) Update(ctx context.Context, req *UpdateMultiWordSchemaRequest) (*MultiWordSchema, error) {
-	multiwordschema := req.GetMultiWordSchema()
-	multiwordschemaID := int(multiwordschema.GetId())
-	m := svc.client.MultiWordSchema.UpdateOneID(multiwordschemaID)
-	multiwordschemaUnit := toEntMultiWordSchema_Unit(multiwordschema.GetUnit())
-	m.SetUnit(multiwordschemaUnit)
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.MultiWordSchemaService/Update", "MultiWordSchema")
+	if err := svc.config.Writable("MultiWordSchemaService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.MultiWordSchemaService", "Update", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveUpdate(ctx, req)
+	return res, svc.config.RecordRequest("entpb.MultiWordSchemaService", "Update", start, runtime.EndSpan(span, err))
+}
 
+// serveUpdate serves the Update method within its span.
+func (svc *MultiWordSchemaService) serveUpdate(ctx context.Context, req *UpdateMultiWordSchemaRequest) (*MultiWordSchema, error) {
+	multiwordschema := req.GetMultiWordSchema()
+	m, err := svc.updateBuilder(ctx, svc.client, multiwordschema)
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.runBeforeUpdateHooks(ctx, req, m); err != nil {
+		return nil, err
+	}
+	runtime.TraceQuery(ctx)
 	res, err := m.Save(ctx)
 	switch {
 	case err == nil:
+		runtime.TraceID(ctx, res.ID)
+		if err := svc.config.InvalidateCache(ctx, "MultiWordSchema", res.ID); err != nil {
+			return nil, err
+		}
+		runtime.TraceConvert(ctx)
 		proto, err := toProtoMultiWordSchema(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		if err := svc.runAfterUpdateHooks(ctx, req, proto); err != nil {
+			return nil, err
 		}
 		return proto, nil
-	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsValidationError(err):
+		return nil, multiwordschemaValidationError(err, "multi_word_schema.")
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, svc.config.ConstraintError(err, multiwordschemaConstraintField(err, "multi_word_schema."))
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Delete implements MultiWordSchemaServiceServer.Delete
 func (svc *MultiWordSchemaService) Delete(ctx context.Context, req *DeleteMultiWordSchemaRequest) (*emptypb.Empty, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.MultiWordSchemaService/Delete", "MultiWordSchema")
+	if err := svc.config.Writable("MultiWordSchemaService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.MultiWordSchemaService", "Delete", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveDelete(ctx, req)
+	return res, svc.config.RecordRequest("entpb.MultiWordSchemaService", "Delete", start, runtime.EndSpan(span, err))
+}
+
+// serveDelete serves the Delete method within its span.
+func (svc *MultiWordSchemaService) serveDelete(ctx context.Context, req *DeleteMultiWordSchemaRequest) (*emptypb.Empty, error) {
 	var err error
 	id := int(req.GetId())
+	runtime.TraceID(ctx, id)
+	if err := svc.runBeforeDeleteHooks(ctx, req); err != nil {
+		return nil, err
+	}
+	runtime.TraceQuery(ctx)
 	err = svc.client.MultiWordSchema.DeleteOneID(id).Exec(ctx)
 	switch {
 	case err == nil:
+		if err := svc.config.InvalidateCache(ctx, "MultiWordSchema", id); err != nil {
+			return nil, err
+		}
+		if err := svc.runAfterDeleteHooks(ctx, req); err != nil {
+			return nil, err
+		}
 		return &emptypb.Empty{}, nil
 	case ent.IsNotFound(err):
-		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+		return nil, svc.config.MapError(err, codes.NotFound, "not found: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, svc.config.ConstraintError(err, "")
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
 // List implements MultiWordSchemaServiceServer.List
 func (svc *MultiWordSchemaService) List(ctx context.Context, req *ListMultiWordSchemaRequest) (*ListMultiWordSchemaResponse, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.MultiWordSchemaService/List", "MultiWordSchema")
+	res, err := svc.serveList(ctx, req)
+	return res, svc.config.RecordRequest("entpb.MultiWordSchemaService", "List", start, runtime.EndSpan(span, err))
+}
+
+// serveList serves the List method within its span.
+func (svc *MultiWordSchemaService) serveList(ctx context.Context, req *ListMultiWordSchemaRequest) (*ListMultiWordSchemaResponse, error) {
 	var (
 		err      error
 		entList  []*ent.MultiWordSchema
 		pageSize int
+		offset   int
 	)
+	defaultSize, maxSize := svc.config.PageSizes(2, 3)
 	pageSize = int(req.GetPageSize())
 	switch {
 	case pageSize < 0:
 		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
-	case pageSize == 0 || pageSize > entproto.MaxPageSize:
-		pageSize = entproto.MaxPageSize
-	}
-	listQuery := svc.client.MultiWordSchema.Query().
-		Order(ent.Desc(multiwordschema.FieldID)).
-		Limit(pageSize + 1)
-	if req.GetPageToken() != "" {
-		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
-		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+	case pageSize == 0:
+		pageSize = defaultSize
+	case pageSize > maxSize:
+		pageSize = maxSize
+	}
+	svc.config.RecordPageSize("entpb.MultiWordSchemaService", "List", pageSize)
+	cursor, err := svc.config.VerifyPageToken("entpb.MultiWordSchemaService/List", req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	listQuery := svc.client.MultiWordSchema.Query()
+	if filter := req.GetFilter(); filter != nil {
+		if c := filter.Id; c != nil {
+			if c.Eq != nil {
+				listQuery = listQuery.Where(multiwordschema.IDEQ(int(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				listQuery = listQuery.Where(multiwordschema.IDNEQ(int(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				listQuery = listQuery.Where(multiwordschema.IDGT(int(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				listQuery = listQuery.Where(multiwordschema.IDLT(int(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				listQuery = listQuery.Where(multiwordschema.IDGTE(int(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				listQuery = listQuery.Where(multiwordschema.IDLTE(int(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]int, len(c.In))
+				for i := range c.In {
+					vs[i] = int(c.In[i])
+				}
+				listQuery = listQuery.Where(multiwordschema.IDIn(vs...))
+			}
 		}
-		token, err := strconv.ParseInt(string(bytes), 10, 32)
+	}
+	listQuery = listQuery.Limit(pageSize + 1)
+	if req.GetOrderBy() != "" {
+		terms, err := runtime.ParseOrderBy(req.GetOrderBy())
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+			return nil, svc.config.MapError(err, codes.InvalidArgument, "order by is invalid: %s", err)
+		}
+		for _, t := range terms {
+			var field string
+			switch t.Field {
+			case "id":
+				field = multiwordschema.FieldID
+			case "unit":
+				field = multiwordschema.FieldUnit
+			default:
+				return nil, status.Errorf(codes.InvalidArgument, "order by field %q is not sortable", t.Field)
+			}
+			if t.Desc {
+				listQuery = listQuery.Order(ent.Desc(field))
+			} else {
+				listQuery = listQuery.Order(ent.Asc(field))
+			}
+		}
+		// The ID breaks the ties between entities, making the pages stable.
+		// Ordered pages are tokenized by their offset.
+		listQuery = listQuery.Order(ent.Asc(multiwordschema.FieldID))
+		if cursor != "" {
+			bytes, err := base64.StdEncoding.DecodeString(cursor)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, "page token is invalid")
+			}
+			offset, err = strconv.Atoi(string(bytes))
+			if err != nil || offset < 0 {
+				return nil, status.Error(codes.InvalidArgument, "page token is invalid")
+			}
+			listQuery = listQuery.Offset(offset)
+		}
+	} else {
+		listQuery = listQuery.Order(ent.Desc(multiwordschema.FieldID))
+		if cursor != "" {
+			bytes, err := base64.StdEncoding.DecodeString(cursor)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, "page token is invalid")
+			}
+			n, err := strconv.ParseInt(string(bytes), 10, 0)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, "page token is invalid")
+			}
+			pageToken := int(n)
+			listQuery = listQuery.
+				Where(multiwordschema.IDLTE(pageToken))
 		}
-		pageToken := int(token)
-		listQuery = listQuery.
-			Where(multiwordschema.IDLTE(pageToken))
 	}
+	runtime.TraceQuery(ctx)
 	switch req.GetView() {
 	case ListMultiWordSchemaRequest_VIEW_UNSPECIFIED, ListMultiWordSchemaRequest_BASIC:
 		entList, err = listQuery.All(ctx)
@@ -205,63 +589,114 @@ func (svc *MultiWordSchemaService) List(ctx context.Context, req *ListMultiWordS
 	switch {
 	case err == nil:
 		var nextPageToken string
-		if len(entList) == pageSize+1 {
+		switch {
+		case len(entList) <= pageSize:
+		case req.GetOrderBy() != "":
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(strconv.Itoa(offset + pageSize)))
+			entList = entList[:len(entList)-1]
+		default:
 			nextPageToken = base64.StdEncoding.EncodeToString(
 				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
 			entList = entList[:len(entList)-1]
 		}
+		nextPageToken = svc.config.SignPageToken("entpb.MultiWordSchemaService/List", nextPageToken)
+		runtime.TraceRows(ctx, len(entList))
+		runtime.TraceConvert(ctx)
 		protoList, err := toProtoMultiWordSchemaList(entList)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 		}
 		return &ListMultiWordSchemaResponse{
 			MultiWordSchemaList: protoList,
 			NextPageToken:       nextPageToken,
 		}, nil
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
 // BatchCreate implements MultiWordSchemaServiceServer.BatchCreate
 func (svc *MultiWordSchemaService) BatchCreate(ctx context.Context, req *BatchCreateMultiWordSchemasRequest) (*BatchCreateMultiWordSchemasResponse, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.MultiWordSchemaService/BatchCreate", "MultiWordSchema")
+	if err := svc.config.Writable("MultiWordSchemaService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.MultiWordSchemaService", "BatchCreate", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveBatchCreate(ctx, req)
+	return res, svc.config.RecordRequest("entpb.MultiWordSchemaService", "BatchCreate", start, runtime.EndSpan(span, err))
+}
+
+// serveBatchCreate serves the BatchCreate method within its span.
+func (svc *MultiWordSchemaService) serveBatchCreate(ctx context.Context, req *BatchCreateMultiWordSchemasRequest) (*BatchCreateMultiWordSchemasResponse, error) {
 	requests := req.GetRequests()
-	if len(requests) > entproto.MaxBatchCreateSize {
-		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	svc.config.RecordBatchSize("entpb.MultiWordSchemaService", "BatchCreate", len(requests))
+	maxSize := svc.config.BatchSize(entproto.MaxBatchCreateSize)
+	if len(requests) > maxSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", maxSize)
 	}
 	bulk := make([]*ent.MultiWordSchemaCreate, len(requests))
 	for i, req := range requests {
 		multiwordschema := req.GetMultiWordSchema()
 		var err error
-		bulk[i], err = svc.createBuilder(multiwordschema)
+		bulk[i], err = svc.createBuilder(ctx, multiwordschema)
 		if err != nil {
 			return nil, err
 		}
+		if err := svc.runBeforeCreateHooks(ctx, req, bulk[i]); err != nil {
+			return nil, err
+		}
 	}
+	runtime.TraceQuery(ctx)
 	res, err := svc.client.MultiWordSchema.CreateBulk(bulk...).Save(ctx)
 	switch {
 	case err == nil:
+		runtime.TraceRows(ctx, len(res))
+		runtime.TraceConvert(ctx)
 		protoList, err := toProtoMultiWordSchemaList(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		for i, req := range requests {
+			if err := svc.runAfterCreateHooks(ctx, req, protoList[i]); err != nil {
+				return nil, err
+			}
 		}
 		return &BatchCreateMultiWordSchemasResponse{
 			MultiWordSchemas: protoList,
 		}, nil
-	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsValidationError(err):
+		return nil, multiwordschemaValidationError(err, "multi_word_schema.")
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, svc.config.ConstraintError(err, multiwordschemaConstraintField(err, "multi_word_schema."))
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
-func (svc *MultiWordSchemaService) createBuilder(multiwordschema *MultiWordSchema) (*ent.MultiWordSchemaCreate, error) {
+func (svc *MultiWordSchemaService) createBuilder(ctx context.Context, multiwordschema *MultiWordSchema) (*ent.MultiWordSchemaCreate, error) {
 	m := svc.client.MultiWordSchema.Create()
-	multiwordschemaUnit := toEntMultiWordSchema_Unit(multiwordschema.GetUnit())
-	m.SetUnit(multiwordschemaUnit)
+	if multiwordschema.GetUnit() != 0 {
+		multiwordschemaUnit := toEntMultiWordSchema_Unit(multiwordschema.GetUnit())
+		m.SetUnit(multiwordschemaUnit)
+	} else if svc.config.UnspecifiedEnum == runtime.UnspecifiedEnumReject {
+		return nil, runtime.UnspecifiedEnumError("multi_word_schema.unit")
+	}
+
+	return m, nil
+}
+
+func (svc *MultiWordSchemaService) updateBuilder(ctx context.Context, client *ent.Client, multiwordschema *MultiWordSchema) (*ent.MultiWordSchemaUpdateOne, error) {
+	multiwordschemaID := int(multiwordschema.GetId())
+	m := client.MultiWordSchema.UpdateOneID(multiwordschemaID)
+	// The unspecified unit is the value mapped to its default.
+	if multiwordschema.GetUnit() != 0 || svc.config.UnspecifiedEnum == runtime.UnspecifiedEnumDefault {
+		multiwordschemaUnit := toEntMultiWordSchema_Unit(multiwordschema.GetUnit())
+		m.SetUnit(multiwordschemaUnit)
+	} else if svc.config.UnspecifiedEnum == runtime.UnspecifiedEnumReject {
+		return nil, runtime.UnspecifiedEnumError("multi_word_schema.unit")
+	}
 	return m, nil
 }
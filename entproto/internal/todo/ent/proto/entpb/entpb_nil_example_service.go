@@ -8,7 +8,7 @@ import (
 	ent "entgo.io/contrib/entproto/internal/todo/ent"
 	nilexample "entgo.io/contrib/entproto/internal/todo/ent/nilexample"
 	runtime "entgo.io/contrib/entproto/runtime"
-	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	errors "errors"
 	fmt "fmt"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -16,19 +16,146 @@ import (
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	strconv "strconv"
+	time "time"
 )
 
 // NilExampleService implements NilExampleServiceServer
 type NilExampleService struct {
 	client *ent.Client
+	// config holds the configuration of the service, set by the options of the constructor.
+	config runtime.Config
+	// hooks are run around the methods of the service, see Use.
+	hooks []NilExampleServiceHooks
 	UnimplementedNilExampleServiceServer
 }
 
-// NewNilExampleService returns a new NilExampleService
-func NewNilExampleService(client *ent.Client) *NilExampleService {
-	return &NilExampleService{
+// NilExampleServiceOption configures a NilExampleService, see NewNilExampleService.
+// The options of the runtime package, such as runtime.WithMaxPageSize, are NilExampleServiceOptions.
+// The hooks of the service are registered with WithNilExampleServiceHooks.
+type NilExampleServiceOption interface {
+	ApplyConfig(*runtime.Config)
+}
+
+// nilExampleServiceOption is a NilExampleServiceOption configuring the NilExampleService itself rather than its runtime config.
+type nilExampleServiceOption func(*NilExampleService)
+
+// ApplyConfig implements NilExampleServiceOption.
+func (nilExampleServiceOption) ApplyConfig(*runtime.Config) {}
+
+// NewNilExampleService returns a new NilExampleService, configured by the given options
+func NewNilExampleService(client *ent.Client, opts ...NilExampleServiceOption) *NilExampleService {
+	svc := &NilExampleService{
 		client: client,
 	}
+	for _, opt := range opts {
+		opt.ApplyConfig(&svc.config)
+		if o, ok := opt.(nilExampleServiceOption); ok {
+			o(svc)
+		}
+	}
+	return svc
+}
+
+// NilExampleServiceHooks holds the functions run around the methods of the NilExampleService,
+// registered with its Use method. The Before hooks receive the mutation builder before it is saved, and the
+// After hooks the response of the method once the entity is saved. A hook failing with an error fails the
+// method with this error, which should be a gRPC status error. Nil hooks are skipped.
+//
+// The methods creating, updating or deleting several entries run the hooks for each of them. The Upsert and
+// Import methods run the Create hooks with a request holding the entity, and the methods deleting entries by IDs
+// or by filter run the Delete hooks with a request holding the ID of each deleted entry.
+type NilExampleServiceHooks struct {
+	BeforeCreate func(ctx context.Context, req *CreateNilExampleRequest, m *ent.NilExampleCreate) error
+	AfterCreate  func(ctx context.Context, req *CreateNilExampleRequest, res *NilExample) error
+	BeforeUpdate func(ctx context.Context, req *UpdateNilExampleRequest, m *ent.NilExampleUpdateOne) error
+	AfterUpdate  func(ctx context.Context, req *UpdateNilExampleRequest, res *NilExample) error
+	BeforeDelete func(ctx context.Context, req *DeleteNilExampleRequest) error
+	AfterDelete  func(ctx context.Context, req *DeleteNilExampleRequest) error
+}
+
+// Use registers hooks run around the methods of the service, in the order they are registered. It is not safe to
+// call it while the service serves requests.
+func (svc *NilExampleService) Use(hooks ...NilExampleServiceHooks) {
+	svc.hooks = append(svc.hooks, hooks...)
+}
+
+// WithNilExampleServiceHooks registers hooks run around the methods of the service, like its Use method.
+func WithNilExampleServiceHooks(hooks ...NilExampleServiceHooks) NilExampleServiceOption {
+	return nilExampleServiceOption(func(svc *NilExampleService) {
+		svc.Use(hooks...)
+	})
+}
+
+// runBeforeCreateHooks runs the BeforeCreate hooks of the service, stopping at the first failing one.
+func (svc *NilExampleService) runBeforeCreateHooks(ctx context.Context, req *CreateNilExampleRequest, m *ent.NilExampleCreate) error {
+	for _, h := range svc.hooks {
+		if h.BeforeCreate != nil {
+			if err := h.BeforeCreate(ctx, req, m); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runAfterCreateHooks runs the AfterCreate hooks of the service, stopping at the first failing one.
+func (svc *NilExampleService) runAfterCreateHooks(ctx context.Context, req *CreateNilExampleRequest, res *NilExample) error {
+	for _, h := range svc.hooks {
+		if h.AfterCreate != nil {
+			if err := h.AfterCreate(ctx, req, res); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runBeforeUpdateHooks runs the BeforeUpdate hooks of the service, stopping at the first failing one.
+func (svc *NilExampleService) runBeforeUpdateHooks(ctx context.Context, req *UpdateNilExampleRequest, m *ent.NilExampleUpdateOne) error {
+	for _, h := range svc.hooks {
+		if h.BeforeUpdate != nil {
+			if err := h.BeforeUpdate(ctx, req, m); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runAfterUpdateHooks runs the AfterUpdate hooks of the service, stopping at the first failing one.
+func (svc *NilExampleService) runAfterUpdateHooks(ctx context.Context, req *UpdateNilExampleRequest, res *NilExample) error {
+	for _, h := range svc.hooks {
+		if h.AfterUpdate != nil {
+			if err := h.AfterUpdate(ctx, req, res); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runBeforeDeleteHooks runs the BeforeDelete hooks of the service, stopping at the first failing one.
+func (svc *NilExampleService) runBeforeDeleteHooks(ctx context.Context, req *DeleteNilExampleRequest) error {
+	for _, h := range svc.hooks {
+		if h.BeforeDelete != nil {
+			if err := h.BeforeDelete(ctx, req); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runAfterDeleteHooks runs the AfterDelete hooks of the service, stopping at the first failing one.
+func (svc *NilExampleService) runAfterDeleteHooks(ctx context.Context, req *DeleteNilExampleRequest) error {
+	for _, h := range svc.hooks {
+		if h.AfterDelete != nil {
+			if err := h.AfterDelete(ctx, req); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // toProtoNilExample transforms the ent type to the pb type
@@ -44,9 +171,72 @@ func toProtoNilExample(e *ent.NilExample) (*NilExample, error) {
 		time_nil := timestamppb.New(*e.TimeNil)
 		v.TimeNil = time_nil
 	}
+	if e.TimeStrNil != nil {
+		time_str_nilValue := (*e.TimeStrNil).Format(time.RFC3339Nano)
+		time_str_nil := wrapperspb.String(time_str_nilValue)
+		v.TimeStrNil = time_str_nil
+	}
+	return v, nil
+}
+
+// ToProtoNilExampleWithEdges transforms the ent type to the pb type, converting its eager-loaded edges
+// into their messages down to the edge depth the package was generated with, see the edge_depth flag.
+// Edges beyond that depth, and edges of types without a service in the package, only hold their IDs.
+func ToProtoNilExampleWithEdges(e *ent.NilExample) (*NilExample, error) {
+	return toProtoNilExampleWithEdges(e, 1)
+}
+
+// toProtoNilExampleWithEdges transforms the ent type to the pb type, converting its eager-loaded edges
+// into their messages down to depth levels.
+func toProtoNilExampleWithEdges(e *ent.NilExample, depth int) (*NilExample, error) {
+	v, err := toProtoNilExample(e)
+	if err != nil {
+		return nil, err
+	}
 	return v, nil
 }
 
+// ToEntNilExampleWithEdges transforms the pb type to the ent type, the reverse of ToProtoNilExampleWithEdges.
+// The edges of the message are set on the Edges of the entity down to the edge depth the package was
+// generated with. Edges beyond that depth only hold their IDs.
+func ToEntNilExampleWithEdges(v *NilExample) (*ent.NilExample, error) {
+	return toEntNilExampleWithEdges(v, 1)
+}
+
+// toEntNilExampleWithEdges transforms the pb type to the ent type, converting the edges of the message
+// into their entities down to depth levels.
+func toEntNilExampleWithEdges(v *NilExample, depth int) (*ent.NilExample, error) {
+	e, err := toEntNilExample(v)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// toEntNilExample transforms the pb type to the ent type, setting the edges of the entity to entities
+// holding their IDs only.
+func toEntNilExample(v *NilExample) (*ent.NilExample, error) {
+	e := &ent.NilExample{}
+	nilexampleID := int(v.GetId())
+	e.ID = nilexampleID
+	if v.GetStrNil() != nil {
+		nilexampleStrNil := v.GetStrNil().GetValue()
+		e.StrNil = &nilexampleStrNil
+	}
+	if v.GetTimeNil() != nil {
+		nilexampleTimeNil := runtime.ExtractTime(v.GetTimeNil())
+		e.TimeNil = &nilexampleTimeNil
+	}
+	if v.GetTimeStrNil() != nil {
+		nilexampleTimeStrNil, err := time.Parse(time.RFC3339Nano, v.GetTimeStrNil().GetValue())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		e.TimeStrNil = &nilexampleTimeStrNil
+	}
+	return e, nil
+}
+
 // toProtoNilExampleList transforms a list of ent type to a list of pb type
 func toProtoNilExampleList(e []*ent.NilExample) ([]*NilExample, error) {
 	var pbList []*NilExample
@@ -60,38 +250,99 @@ func toProtoNilExampleList(e []*ent.NilExample) ([]*NilExample, error) {
 	return pbList, nil
 }
 
+// nilexampleValidationError returns an InvalidArgument status error for the ent validation error err,
+// holding the violation of the field of the request it failed on. prefix is the path of the NilExample
+// message in the request, or empty if the request is the message itself.
+func nilexampleValidationError(err error, prefix string) error {
+	var verr *ent.ValidationError
+	if !errors.As(err, &verr) {
+		return status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	field := prefix + verr.Name
+	return runtime.FieldViolation(field, err)
+}
+
+// nilexampleConstraintField returns the path of the field of the request violating the database constraint
+// of err, or an empty string if it is unknown. prefix is the path of the NilExample message in the request,
+// or empty if the request is the message itself.
+func nilexampleConstraintField(err error, prefix string) string {
+	switch runtime.ConstraintColumn(err) {
+	case "id":
+		return prefix + "id"
+	}
+	return ""
+}
+
 // Create implements NilExampleServiceServer.Create
 func (svc *NilExampleService) Create(ctx context.Context, req *CreateNilExampleRequest) (*NilExample, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.NilExampleService/Create", "NilExample")
+	if err := svc.config.Writable("NilExampleService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.NilExampleService", "Create", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveCreate(ctx, req)
+	return res, svc.config.RecordRequest("entpb.NilExampleService", "Create", start, runtime.EndSpan(span, err))
+}
+
+// serveCreate serves the Create method within its span.
+func (svc *NilExampleService) serveCreate(ctx context.Context, req *CreateNilExampleRequest) (*NilExample, error) {
 	nilexample := req.GetNilExample()
-	m, err := svc.createBuilder(nilexample)
+	m, err := svc.createBuilder(ctx, nilexample)
 	if err != nil {
 		return nil, err
 	}
+	if err := svc.runBeforeCreateHooks(ctx, req, m); err != nil {
+		return nil, err
+	}
+	runtime.TraceQuery(ctx)
 	res, err := m.Save(ctx)
 	switch {
 	case err == nil:
+		runtime.TraceID(ctx, res.ID)
+		runtime.TraceConvert(ctx)
 		proto, err := toProtoNilExample(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		if err := svc.runAfterCreateHooks(ctx, req, proto); err != nil {
+			return nil, err
 		}
 		return proto, nil
-	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsValidationError(err):
+		return nil, nilexampleValidationError(err, "nil_example.")
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, svc.config.ConstraintError(err, nilexampleConstraintField(err, "nil_example."))
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Get implements NilExampleServiceServer.Get
 func (svc *NilExampleService) Get(ctx context.Context, req *GetNilExampleRequest) (*NilExample, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.NilExampleService/Get", "NilExample")
+	res, err := svc.serveGet(ctx, req)
+	return res, svc.config.RecordRequest("entpb.NilExampleService", "Get", start, runtime.EndSpan(span, err))
+}
+
+// serveGet serves the Get method within its span.
+func (svc *NilExampleService) serveGet(ctx context.Context, req *GetNilExampleRequest) (*NilExample, error) {
 	var (
 		err error
 		get *ent.NilExample
 	)
 	id := int(req.GetId())
+	runtime.TraceID(ctx, id)
+	// Entities requested without their edges are served from the cache of the service, if any.
+	cacheable := (req.GetView() == GetNilExampleRequest_VIEW_UNSPECIFIED || req.GetView() == GetNilExampleRequest_BASIC)
+	if cacheable {
+		cached := &NilExample{}
+		if svc.config.CachedEntity(ctx, "NilExample", id, cached) {
+			return cached, nil
+		}
+	}
+	runtime.TraceQuery(ctx)
 	switch req.GetView() {
 	case GetNilExampleRequest_VIEW_UNSPECIFIED, GetNilExampleRequest_BASIC:
 		get, err = svc.client.NilExample.Get(ctx, id)
@@ -104,93 +355,275 @@ func (svc *NilExampleService) Get(ctx context.Context, req *GetNilExampleRequest
 	}
 	switch {
 	case err == nil:
-		return toProtoNilExample(get)
+		runtime.TraceConvert(ctx)
+		protoGet, err := toProtoNilExample(get)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		if cacheable {
+			svc.config.CacheEntity(ctx, "NilExample", id, protoGet)
+		}
+		return protoGet, nil
 	case ent.IsNotFound(err):
-		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+		return nil, svc.config.MapError(err, codes.NotFound, "not found: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Update implements NilExampleServiceServer.Update
 func (svc *NilExampleService) Update(ctx context.Context, req *UpdateNilExampleRequest) (*NilExample, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.NilExampleService/Update", "NilExample")
+	if err := svc.config.Writable("NilExampleService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.NilExampleService", "Update", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveUpdate(ctx, req)
+	return res, svc.config.RecordRequest("entpb.NilExampleService", "Update", start, runtime.EndSpan(span, err))
+}
+
+// serveUpdate serves the Update method within its span.
+func (svc *NilExampleService) serveUpdate(ctx context.Context, req *UpdateNilExampleRequest) (*NilExample, error) {
 	nilexample := req.GetNilExample()
-	nilexampleID := int(nilexample.GetId())
-	m := svc.client.NilExample.UpdateOneID(nilexampleID)
-	if nilexample.GetStrNil() != nil {
-		nilexampleStrNil := nilexample.GetStrNil().GetValue()
-		m.SetStrNil(nilexampleStrNil)
+	m, err := svc.updateBuilder(ctx, svc.client, nilexample)
+	if err != nil {
+		return nil, err
 	}
-	if nilexample.GetTimeNil() != nil {
-		nilexampleTimeNil := runtime.ExtractTime(nilexample.GetTimeNil())
-		m.SetTimeNil(nilexampleTimeNil)
+	if err := svc.runBeforeUpdateHooks(ctx, req, m); err != nil {
+		return nil, err
 	}
-
+	runtime.TraceQuery(ctx)
 	res, err := m.Save(ctx)
 	switch {
 	case err == nil:
+		runtime.TraceID(ctx, res.ID)
+		if err := svc.config.InvalidateCache(ctx, "NilExample", res.ID); err != nil {
+			return nil, err
+		}
+		runtime.TraceConvert(ctx)
 		proto, err := toProtoNilExample(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		if err := svc.runAfterUpdateHooks(ctx, req, proto); err != nil {
+			return nil, err
 		}
 		return proto, nil
-	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsValidationError(err):
+		return nil, nilexampleValidationError(err, "nil_example.")
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, svc.config.ConstraintError(err, nilexampleConstraintField(err, "nil_example."))
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Delete implements NilExampleServiceServer.Delete
 func (svc *NilExampleService) Delete(ctx context.Context, req *DeleteNilExampleRequest) (*emptypb.Empty, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.NilExampleService/Delete", "NilExample")
+	if err := svc.config.Writable("NilExampleService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.NilExampleService", "Delete", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveDelete(ctx, req)
+	return res, svc.config.RecordRequest("entpb.NilExampleService", "Delete", start, runtime.EndSpan(span, err))
+}
+
+// serveDelete serves the Delete method within its span.
+func (svc *NilExampleService) serveDelete(ctx context.Context, req *DeleteNilExampleRequest) (*emptypb.Empty, error) {
 	var err error
 	id := int(req.GetId())
+	runtime.TraceID(ctx, id)
+	if err := svc.runBeforeDeleteHooks(ctx, req); err != nil {
+		return nil, err
+	}
+	runtime.TraceQuery(ctx)
 	err = svc.client.NilExample.DeleteOneID(id).Exec(ctx)
 	switch {
 	case err == nil:
+		if err := svc.config.InvalidateCache(ctx, "NilExample", id); err != nil {
+			return nil, err
+		}
+		if err := svc.runAfterDeleteHooks(ctx, req); err != nil {
+			return nil, err
+		}
 		return &emptypb.Empty{}, nil
 	case ent.IsNotFound(err):
-		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+		return nil, svc.config.MapError(err, codes.NotFound, "not found: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, svc.config.ConstraintError(err, "")
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
 // List implements NilExampleServiceServer.List
 func (svc *NilExampleService) List(ctx context.Context, req *ListNilExampleRequest) (*ListNilExampleResponse, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.NilExampleService/List", "NilExample")
+	res, err := svc.serveList(ctx, req)
+	return res, svc.config.RecordRequest("entpb.NilExampleService", "List", start, runtime.EndSpan(span, err))
+}
+
+// serveList serves the List method within its span.
+func (svc *NilExampleService) serveList(ctx context.Context, req *ListNilExampleRequest) (*ListNilExampleResponse, error) {
 	var (
 		err      error
 		entList  []*ent.NilExample
 		pageSize int
+		offset   int
 	)
+	defaultSize, maxSize := svc.config.PageSizes(entproto.MaxPageSize, entproto.MaxPageSize)
 	pageSize = int(req.GetPageSize())
 	switch {
 	case pageSize < 0:
 		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
-	case pageSize == 0 || pageSize > entproto.MaxPageSize:
-		pageSize = entproto.MaxPageSize
-	}
-	listQuery := svc.client.NilExample.Query().
-		Order(ent.Desc(nilexample.FieldID)).
-		Limit(pageSize + 1)
-	if req.GetPageToken() != "" {
-		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
-		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+	case pageSize == 0:
+		pageSize = defaultSize
+	case pageSize > maxSize:
+		pageSize = maxSize
+	}
+	svc.config.RecordPageSize("entpb.NilExampleService", "List", pageSize)
+	cursor, err := svc.config.VerifyPageToken("entpb.NilExampleService/List", req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	listQuery := svc.client.NilExample.Query()
+	if filter := req.GetFilter(); filter != nil {
+		if c := filter.Id; c != nil {
+			if c.Eq != nil {
+				listQuery = listQuery.Where(nilexample.IDEQ(int(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				listQuery = listQuery.Where(nilexample.IDNEQ(int(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				listQuery = listQuery.Where(nilexample.IDGT(int(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				listQuery = listQuery.Where(nilexample.IDLT(int(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				listQuery = listQuery.Where(nilexample.IDGTE(int(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				listQuery = listQuery.Where(nilexample.IDLTE(int(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]int, len(c.In))
+				for i := range c.In {
+					vs[i] = int(c.In[i])
+				}
+				listQuery = listQuery.Where(nilexample.IDIn(vs...))
+			}
+		}
+		if c := filter.StrNil; c != nil {
+			if c.Eq != nil {
+				listQuery = listQuery.Where(nilexample.StrNilEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				listQuery = listQuery.Where(nilexample.StrNilNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				listQuery = listQuery.Where(nilexample.StrNilGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				listQuery = listQuery.Where(nilexample.StrNilLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				listQuery = listQuery.Where(nilexample.StrNilGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				listQuery = listQuery.Where(nilexample.StrNilLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				listQuery = listQuery.Where(nilexample.StrNilIn(c.In...))
+			}
+			if c.Contains != nil {
+				listQuery = listQuery.Where(nilexample.StrNilContains(c.Contains.GetValue()))
+			}
 		}
-		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if c := filter.TimeNil; c != nil {
+			if c.Eq != nil {
+				listQuery = listQuery.Where(nilexample.TimeNilEQ(c.Eq.AsTime()))
+			}
+			if c.Neq != nil {
+				listQuery = listQuery.Where(nilexample.TimeNilNEQ(c.Neq.AsTime()))
+			}
+			if c.Gt != nil {
+				listQuery = listQuery.Where(nilexample.TimeNilGT(c.Gt.AsTime()))
+			}
+			if c.Lt != nil {
+				listQuery = listQuery.Where(nilexample.TimeNilLT(c.Lt.AsTime()))
+			}
+			if c.Gte != nil {
+				listQuery = listQuery.Where(nilexample.TimeNilGTE(c.Gte.AsTime()))
+			}
+			if c.Lte != nil {
+				listQuery = listQuery.Where(nilexample.TimeNilLTE(c.Lte.AsTime()))
+			}
+		}
+	}
+	listQuery = listQuery.Limit(pageSize + 1)
+	if req.GetOrderBy() != "" {
+		terms, err := runtime.ParseOrderBy(req.GetOrderBy())
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+			return nil, svc.config.MapError(err, codes.InvalidArgument, "order by is invalid: %s", err)
+		}
+		for _, t := range terms {
+			var field string
+			switch t.Field {
+			case "id":
+				field = nilexample.FieldID
+			case "str_nil":
+				field = nilexample.FieldStrNil
+			case "time_nil":
+				field = nilexample.FieldTimeNil
+			case "time_str_nil":
+				field = nilexample.FieldTimeStrNil
+			default:
+				return nil, status.Errorf(codes.InvalidArgument, "order by field %q is not sortable", t.Field)
+			}
+			if t.Desc {
+				listQuery = listQuery.Order(ent.Desc(field))
+			} else {
+				listQuery = listQuery.Order(ent.Asc(field))
+			}
+		}
+		// The ID breaks the ties between entities, making the pages stable.
+		// Ordered pages are tokenized by their offset.
+		listQuery = listQuery.Order(ent.Asc(nilexample.FieldID))
+		if cursor != "" {
+			bytes, err := base64.StdEncoding.DecodeString(cursor)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, "page token is invalid")
+			}
+			offset, err = strconv.Atoi(string(bytes))
+			if err != nil || offset < 0 {
+				return nil, status.Error(codes.InvalidArgument, "page token is invalid")
+			}
+			listQuery = listQuery.Offset(offset)
+		}
+	} else {
+		listQuery = listQuery.Order(ent.Desc(nilexample.FieldID))
+		if cursor != "" {
+			bytes, err := base64.StdEncoding.DecodeString(cursor)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, "page token is invalid")
+			}
+			n, err := strconv.ParseInt(string(bytes), 10, 0)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, "page token is invalid")
+			}
+			pageToken := int(n)
+			listQuery = listQuery.
+				Where(nilexample.IDLTE(pageToken))
 		}
-		pageToken := int(token)
-		listQuery = listQuery.
-			Where(nilexample.IDLTE(pageToken))
 	}
+	runtime.TraceQuery(ctx)
 	switch req.GetView() {
 	case ListNilExampleRequest_VIEW_UNSPECIFIED, ListNilExampleRequest_BASIC:
 		entList, err = listQuery.All(ctx)
@@ -201,61 +634,94 @@ func (svc *NilExampleService) List(ctx context.Context, req *ListNilExampleReque
 	switch {
 	case err == nil:
 		var nextPageToken string
-		if len(entList) == pageSize+1 {
+		switch {
+		case len(entList) <= pageSize:
+		case req.GetOrderBy() != "":
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(strconv.Itoa(offset + pageSize)))
+			entList = entList[:len(entList)-1]
+		default:
 			nextPageToken = base64.StdEncoding.EncodeToString(
 				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
 			entList = entList[:len(entList)-1]
 		}
+		nextPageToken = svc.config.SignPageToken("entpb.NilExampleService/List", nextPageToken)
+		runtime.TraceRows(ctx, len(entList))
+		runtime.TraceConvert(ctx)
 		protoList, err := toProtoNilExampleList(entList)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 		}
 		return &ListNilExampleResponse{
 			NilExampleList: protoList,
 			NextPageToken:  nextPageToken,
 		}, nil
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
 // BatchCreate implements NilExampleServiceServer.BatchCreate
 func (svc *NilExampleService) BatchCreate(ctx context.Context, req *BatchCreateNilExamplesRequest) (*BatchCreateNilExamplesResponse, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.NilExampleService/BatchCreate", "NilExample")
+	if err := svc.config.Writable("NilExampleService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.NilExampleService", "BatchCreate", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveBatchCreate(ctx, req)
+	return res, svc.config.RecordRequest("entpb.NilExampleService", "BatchCreate", start, runtime.EndSpan(span, err))
+}
+
+// serveBatchCreate serves the BatchCreate method within its span.
+func (svc *NilExampleService) serveBatchCreate(ctx context.Context, req *BatchCreateNilExamplesRequest) (*BatchCreateNilExamplesResponse, error) {
 	requests := req.GetRequests()
-	if len(requests) > entproto.MaxBatchCreateSize {
-		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	svc.config.RecordBatchSize("entpb.NilExampleService", "BatchCreate", len(requests))
+	maxSize := svc.config.BatchSize(entproto.MaxBatchCreateSize)
+	if len(requests) > maxSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", maxSize)
 	}
 	bulk := make([]*ent.NilExampleCreate, len(requests))
 	for i, req := range requests {
 		nilexample := req.GetNilExample()
 		var err error
-		bulk[i], err = svc.createBuilder(nilexample)
+		bulk[i], err = svc.createBuilder(ctx, nilexample)
 		if err != nil {
 			return nil, err
 		}
+		if err := svc.runBeforeCreateHooks(ctx, req, bulk[i]); err != nil {
+			return nil, err
+		}
 	}
+	runtime.TraceQuery(ctx)
 	res, err := svc.client.NilExample.CreateBulk(bulk...).Save(ctx)
 	switch {
 	case err == nil:
+		runtime.TraceRows(ctx, len(res))
+		runtime.TraceConvert(ctx)
 		protoList, err := toProtoNilExampleList(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		for i, req := range requests {
+			if err := svc.runAfterCreateHooks(ctx, req, protoList[i]); err != nil {
+				return nil, err
+			}
 		}
 		return &BatchCreateNilExamplesResponse{
 			NilExamples: protoList,
 		}, nil
-	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsValidationError(err):
+		return nil, nilexampleValidationError(err, "nil_example.")
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, svc.config.ConstraintError(err, nilexampleConstraintField(err, "nil_example."))
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
-func (svc *NilExampleService) createBuilder(nilexample *NilExample) (*ent.NilExampleCreate, error) {
+func (svc *NilExampleService) createBuilder(ctx context.Context, nilexample *NilExample) (*ent.NilExampleCreate, error) {
 	m := svc.client.NilExample.Create()
 	if nilexample.GetStrNil() != nil {
 		nilexampleStrNil := nilexample.GetStrNil().GetValue()
@@ -265,5 +731,34 @@ func (svc *NilExampleService) createBuilder(nilexample *NilExample) (*ent.NilExa
 		nilexampleTimeNil := runtime.ExtractTime(nilexample.GetTimeNil())
 		m.SetTimeNil(nilexampleTimeNil)
 	}
+	if nilexample.GetTimeStrNil() != nil {
+		nilexampleTimeStrNil, err := time.Parse(time.RFC3339Nano, nilexample.GetTimeStrNil().GetValue())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		m.SetTimeStrNil(nilexampleTimeStrNil)
+	}
+
+	return m, nil
+}
+
+func (svc *NilExampleService) updateBuilder(ctx context.Context, client *ent.Client, nilexample *NilExample) (*ent.NilExampleUpdateOne, error) {
+	nilexampleID := int(nilexample.GetId())
+	m := client.NilExample.UpdateOneID(nilexampleID)
+	if nilexample.GetStrNil() != nil {
+		nilexampleStrNil := nilexample.GetStrNil().GetValue()
+		m.SetStrNil(nilexampleStrNil)
+	}
+	if nilexample.GetTimeNil() != nil {
+		nilexampleTimeNil := runtime.ExtractTime(nilexample.GetTimeNil())
+		m.SetTimeNil(nilexampleTimeNil)
+	}
+	if nilexample.GetTimeStrNil() != nil {
+		nilexampleTimeStrNil, err := time.Parse(time.RFC3339Nano, nilexample.GetTimeStrNil().GetValue())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		m.SetTimeStrNil(nilexampleTimeStrNil)
+	}
 	return m, nil
 }
@@ -5,34 +5,202 @@ import (
 	context "context"
 	entproto "entgo.io/contrib/entproto"
 	ent "entgo.io/contrib/entproto/internal/todo/ent"
-	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	pony "entgo.io/contrib/entproto/internal/todo/ent/pony"
+	common "entgo.io/contrib/entproto/internal/todo/ent/proto/common"
+	schema "entgo.io/contrib/entproto/internal/todo/ent/schema"
+	runtime "entgo.io/contrib/entproto/runtime"
+	errors "errors"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	strings "strings"
+	time "time"
 )
 
 // PonyService implements PonyServiceServer
 type PonyService struct {
 	client *ent.Client
+	// config holds the configuration of the service, set by the options of the constructor.
+	config runtime.Config
 	UnimplementedPonyServiceServer
 }
 
-// NewPonyService returns a new PonyService
-func NewPonyService(client *ent.Client) *PonyService {
-	return &PonyService{
+// PonyServiceOption configures a PonyService, see NewPonyService.
+// The options of the runtime package, such as runtime.WithMaxPageSize, are PonyServiceOptions.
+type PonyServiceOption interface {
+	ApplyConfig(*runtime.Config)
+}
+
+// ponyServiceOption is a PonyServiceOption configuring the PonyService itself rather than its runtime config.
+type ponyServiceOption func(*PonyService)
+
+// ApplyConfig implements PonyServiceOption.
+func (ponyServiceOption) ApplyConfig(*runtime.Config) {}
+
+// NewPonyService returns a new PonyService, configured by the given options
+func NewPonyService(client *ent.Client, opts ...PonyServiceOption) *PonyService {
+	svc := &PonyService{
 		client: client,
 	}
+	for _, opt := range opts {
+		opt.ApplyConfig(&svc.config)
+		if o, ok := opt.(ponyServiceOption); ok {
+			o(svc)
+		}
+	}
+	return svc
+}
+
+// ToProtoPonyPriority converts the priority enum of the ent type to the pb enum.
+// Unknown values are converted to the zero value of the pb enum.
+func ToProtoPonyPriority(e pony.Priority) common.Priority {
+	return toProtoPony_Priority(e)
+}
+
+// ToEntPonyPriority converts the pb enum to the priority enum of the ent type.
+// Unknown values are converted to the empty value.
+func ToEntPonyPriority(e common.Priority) pony.Priority {
+	return toEntPony_Priority(e)
+}
+
+func toProtoPony_Priority(e pony.Priority) common.Priority {
+	if v, ok := common.Priority_value[strings.ToUpper("PRIORITY_"+string(e))]; ok {
+		return common.Priority(v)
+	}
+	return common.Priority(0)
+}
+
+func toEntPony_Priority(e common.Priority) pony.Priority {
+	if v, ok := common.Priority_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"PRIORITY_LOW":  "low",
+			"PRIORITY_HIGH": "high",
+		}[v]
+		return pony.Priority(entVal)
+	}
+	return ""
+}
+
+// ToProtoPonySize converts the size enum of the ent type to the pb enum.
+// Unknown values are converted to the zero value of the pb enum.
+func ToProtoPonySize(e schema.Size) Size {
+	return toProtoPony_Size(e)
+}
+
+// ToEntPonySize converts the pb enum to the size enum of the ent type.
+// Unknown values are converted to the empty value.
+func ToEntPonySize(e Size) schema.Size {
+	return toEntPony_Size(e)
+}
+
+func toProtoPony_Size(e schema.Size) Size {
+	if v, ok := Size_value[strings.ToUpper("SIZE_"+string(e))]; ok {
+		return Size(v)
+	}
+	return Size(0)
+}
+
+func toEntPony_Size(e Size) schema.Size {
+	if v, ok := Size_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"SIZE_MEDIUM": "medium",
+			"SIZE_SMALL":  "small",
+			"SIZE_LARGE":  "large",
+		}[v]
+		return schema.Size(entVal)
+	}
+	return ""
 }
 
 // toProtoPony transforms the ent type to the pb type
 func toProtoPony(e *ent.Pony) (*Pony, error) {
 	v := &Pony{}
+	color := e.Color
+	v.Color = color
+	height := e.Height.String()
+	v.Height = height
 	id := int64(e.ID)
 	v.Id = id
 	name := e.Name
 	v.Name = name
+	priority := toProtoPony_Priority(e.Priority)
+	v.Priority = priority
+	rank := wrapperspb.Int32(int32(e.Rank))
+	v.Rank = rank
+	scores := e.Scores
+	v.Scores = scores
+	size := toProtoPony_Size(e.Size)
+	v.Size = size
+	return v, nil
+}
+
+// ToProtoPonyWithEdges transforms the ent type to the pb type, converting its eager-loaded edges
+// into their messages down to the edge depth the package was generated with, see the edge_depth flag.
+// Edges beyond that depth, and edges of types without a service in the package, only hold their IDs.
+func ToProtoPonyWithEdges(e *ent.Pony) (*Pony, error) {
+	return toProtoPonyWithEdges(e, 1)
+}
+
+// toProtoPonyWithEdges transforms the ent type to the pb type, converting its eager-loaded edges
+// into their messages down to depth levels.
+func toProtoPonyWithEdges(e *ent.Pony, depth int) (*Pony, error) {
+	v, err := toProtoPony(e)
+	if err != nil {
+		return nil, err
+	}
 	return v, nil
 }
 
+// ToEntPonyWithEdges transforms the pb type to the ent type, the reverse of ToProtoPonyWithEdges.
+// The edges of the message are set on the Edges of the entity down to the edge depth the package was
+// generated with. Edges beyond that depth only hold their IDs.
+func ToEntPonyWithEdges(v *Pony) (*ent.Pony, error) {
+	return toEntPonyWithEdges(v, 1)
+}
+
+// toEntPonyWithEdges transforms the pb type to the ent type, converting the edges of the message
+// into their entities down to depth levels.
+func toEntPonyWithEdges(v *Pony, depth int) (*ent.Pony, error) {
+	e, err := toEntPony(v)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// toEntPony transforms the pb type to the ent type, setting the edges of the entity to entities
+// holding their IDs only.
+func toEntPony(v *Pony) (*ent.Pony, error) {
+	e := &ent.Pony{}
+	ponyColor := v.GetColor()
+	e.Color = ponyColor
+	ponyHeight, err := schema.ParseHeight(v.GetHeight())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	e.Height = ponyHeight
+	ponyID := int(v.GetId())
+	e.ID = ponyID
+	ponyName := v.GetName()
+	e.Name = ponyName
+	ponyPriority := toEntPony_Priority(v.GetPriority())
+	e.Priority = ponyPriority
+	if v.GetRank() != nil {
+		ponyRank, err := runtime.ConvertInt[int8](v.GetRank().GetValue())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		e.Rank = ponyRank
+	}
+	if v.GetScores() != nil {
+		ponyScores := v.GetScores()
+		e.Scores = ponyScores
+	}
+	ponySize := toEntPony_Size(v.GetSize())
+	e.Size = ponySize
+	return e, nil
+}
+
 // toProtoPonyList transforms a list of ent type to a list of pb type
 func toProtoPonyList(e []*ent.Pony) ([]*Pony, error) {
 	var pbList []*Pony
@@ -46,44 +214,116 @@ func toProtoPonyList(e []*ent.Pony) ([]*Pony, error) {
 	return pbList, nil
 }
 
+// ponyValidationError returns an InvalidArgument status error for the ent validation error err,
+// holding the violation of the field of the request it failed on. prefix is the path of the Pony
+// message in the request, or empty if the request is the message itself.
+func ponyValidationError(err error, prefix string) error {
+	var verr *ent.ValidationError
+	if !errors.As(err, &verr) {
+		return status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	field := prefix + verr.Name
+	return runtime.FieldViolation(field, err)
+}
+
+// ponyConstraintField returns the path of the field of the request violating the database constraint
+// of err, or an empty string if it is unknown. prefix is the path of the Pony message in the request,
+// or empty if the request is the message itself.
+func ponyConstraintField(err error, prefix string) string {
+	switch runtime.ConstraintColumn(err) {
+	case "id":
+		return prefix + "id"
+	}
+	return ""
+}
+
 // BatchCreate implements PonyServiceServer.BatchCreate
 func (svc *PonyService) BatchCreate(ctx context.Context, req *BatchCreatePoniesRequest) (*BatchCreatePoniesResponse, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.PonyService/BatchCreate", "Pony")
+	if err := svc.config.Writable("PonyService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.PonyService", "BatchCreate", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveBatchCreate(ctx, req)
+	return res, svc.config.RecordRequest("entpb.PonyService", "BatchCreate", start, runtime.EndSpan(span, err))
+}
+
+// serveBatchCreate serves the BatchCreate method within its span.
+func (svc *PonyService) serveBatchCreate(ctx context.Context, req *BatchCreatePoniesRequest) (*BatchCreatePoniesResponse, error) {
 	requests := req.GetRequests()
-	if len(requests) > entproto.MaxBatchCreateSize {
-		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	svc.config.RecordBatchSize("entpb.PonyService", "BatchCreate", len(requests))
+	maxSize := svc.config.BatchSize(entproto.MaxBatchCreateSize)
+	if len(requests) > maxSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", maxSize)
 	}
 	bulk := make([]*ent.PonyCreate, len(requests))
 	for i, req := range requests {
 		pony := req.GetPony()
 		var err error
-		bulk[i], err = svc.createBuilder(pony)
+		bulk[i], err = svc.createBuilder(ctx, pony)
 		if err != nil {
 			return nil, err
 		}
 	}
+	runtime.TraceQuery(ctx)
 	res, err := svc.client.Pony.CreateBulk(bulk...).Save(ctx)
 	switch {
 	case err == nil:
+		runtime.TraceRows(ctx, len(res))
+		runtime.TraceConvert(ctx)
 		protoList, err := toProtoPonyList(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 		}
 		return &BatchCreatePoniesResponse{
 			Ponies: protoList,
 		}, nil
-	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsValidationError(err):
+		return nil, ponyValidationError(err, "pony.")
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, svc.config.ConstraintError(err, ponyConstraintField(err, "pony."))
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
-func (svc *PonyService) createBuilder(pony *Pony) (*ent.PonyCreate, error) {
+func (svc *PonyService) createBuilder(ctx context.Context, pony *Pony) (*ent.PonyCreate, error) {
 	m := svc.client.Pony.Create()
+	if pony.GetColor() != "" {
+		ponyColor := pony.GetColor()
+		m.SetColor(ponyColor)
+	}
+	ponyHeight, err := schema.ParseHeight(pony.GetHeight())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	m.SetHeight(ponyHeight)
 	ponyName := pony.GetName()
 	m.SetName(ponyName)
+	if pony.GetPriority() != 0 {
+		ponyPriority := toEntPony_Priority(pony.GetPriority())
+		m.SetPriority(ponyPriority)
+	} else if svc.config.UnspecifiedEnum == runtime.UnspecifiedEnumReject {
+		return nil, runtime.UnspecifiedEnumError("pony.priority")
+	}
+	if pony.GetRank() != nil {
+		ponyRank, err := runtime.ConvertInt[int8](pony.GetRank().GetValue())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		m.SetRank(ponyRank)
+	}
+	if pony.GetScores() != nil {
+		ponyScores := pony.GetScores()
+		m.SetScores(ponyScores)
+	}
+	if pony.GetSize() != 0 {
+		ponySize := toEntPony_Size(pony.GetSize())
+		m.SetSize(ponySize)
+	} else if svc.config.UnspecifiedEnum == runtime.UnspecifiedEnumReject {
+		return nil, runtime.UnspecifiedEnumError("pony.size")
+	}
+
 	return m, nil
 }
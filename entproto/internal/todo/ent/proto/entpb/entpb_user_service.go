@@ -8,34 +8,199 @@ import (
 	ent "entgo.io/contrib/entproto/internal/todo/ent"
 	attachment "entgo.io/contrib/entproto/internal/todo/ent/attachment"
 	group "entgo.io/contrib/entproto/internal/todo/ent/group"
+	hook "entgo.io/contrib/entproto/internal/todo/ent/hook"
 	pet "entgo.io/contrib/entproto/internal/todo/ent/pet"
 	schema "entgo.io/contrib/entproto/internal/todo/ent/schema"
 	user "entgo.io/contrib/entproto/internal/todo/ent/user"
 	runtime "entgo.io/contrib/entproto/runtime"
-	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
 	errors "errors"
 	fmt "fmt"
 	uuid "github.com/google/uuid"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	proto "google.golang.org/protobuf/proto"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	io "io"
 	strconv "strconv"
 	strings "strings"
+	time "time"
 )
 
 // UserService implements UserServiceServer
 type UserService struct {
 	client *ent.Client
+	// config holds the configuration of the service, set by the options of the constructor.
+	config runtime.Config
+	// hooks are run around the methods of the service, see Use.
+	hooks []UserServiceHooks
+	// feed publishes the events of the WatchUser method.
+	// It is shared by the services of the client, see runtime.FeedOf.
+	feed *runtime.Feed
 	UnimplementedUserServiceServer
 }
 
-// NewUserService returns a new UserService
-func NewUserService(client *ent.Client) *UserService {
-	return &UserService{
+// UserServiceOption configures a UserService, see NewUserService.
+// The options of the runtime package, such as runtime.WithMaxPageSize, are UserServiceOptions.
+// The hooks of the service are registered with WithUserServiceHooks.
+type UserServiceOption interface {
+	ApplyConfig(*runtime.Config)
+}
+
+// userServiceOption is a UserServiceOption configuring the UserService itself rather than its runtime config.
+type userServiceOption func(*UserService)
+
+// ApplyConfig implements UserServiceOption.
+func (userServiceOption) ApplyConfig(*runtime.Config) {}
+
+// NewUserService returns a new UserService, configured by the given options
+func NewUserService(client *ent.Client, opts ...UserServiceOption) *UserService {
+	svc := &UserService{
 		client: client,
 	}
+	for _, opt := range opts {
+		opt.ApplyConfig(&svc.config)
+		if o, ok := opt.(userServiceOption); ok {
+			o(svc)
+		}
+	}
+	svc.feed = runtime.FeedOf(client, "entpb.UserService", func(feed *runtime.Feed) {
+		client.User.Use(userServiceWatchHook(client, feed))
+	})
+	return svc
+}
+
+// userServiceRedactions maps the fields of the responses of the UserService to the role the callers
+// must hold to read them, see entproto.RedactUnless.
+var userServiceRedactions = runtime.Redactions{
+	"entpb.User.opt_num": "admin",
+}
+
+// UserServiceHooks holds the functions run around the methods of the UserService,
+// registered with its Use method. The Before hooks receive the mutation builder before it is saved, and the
+// After hooks the response of the method once the entity is saved. A hook failing with an error fails the
+// method with this error, which should be a gRPC status error. Nil hooks are skipped.
+//
+// The methods creating, updating or deleting several entries run the hooks for each of them. The Upsert and
+// Import methods run the Create hooks with a request holding the entity, and the methods deleting entries by IDs
+// or by filter run the Delete hooks with a request holding the ID of each deleted entry.
+type UserServiceHooks struct {
+	BeforeCreate func(ctx context.Context, req *CreateUserRequest, m *ent.UserCreate) error
+	AfterCreate  func(ctx context.Context, req *CreateUserRequest, res *User) error
+	BeforeUpdate func(ctx context.Context, req *UpdateUserRequest, m *ent.UserUpdateOne) error
+	AfterUpdate  func(ctx context.Context, req *UpdateUserRequest, res *User) error
+	BeforeDelete func(ctx context.Context, req *DeleteUserRequest) error
+	AfterDelete  func(ctx context.Context, req *DeleteUserRequest) error
+}
+
+// Use registers hooks run around the methods of the service, in the order they are registered. It is not safe to
+// call it while the service serves requests.
+func (svc *UserService) Use(hooks ...UserServiceHooks) {
+	svc.hooks = append(svc.hooks, hooks...)
+}
+
+// WithUserServiceHooks registers hooks run around the methods of the service, like its Use method.
+func WithUserServiceHooks(hooks ...UserServiceHooks) UserServiceOption {
+	return userServiceOption(func(svc *UserService) {
+		svc.Use(hooks...)
+	})
+}
+
+// runBeforeCreateHooks runs the BeforeCreate hooks of the service, stopping at the first failing one.
+func (svc *UserService) runBeforeCreateHooks(ctx context.Context, req *CreateUserRequest, m *ent.UserCreate) error {
+	for _, h := range svc.hooks {
+		if h.BeforeCreate != nil {
+			if err := h.BeforeCreate(ctx, req, m); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runAfterCreateHooks runs the AfterCreate hooks of the service, stopping at the first failing one.
+func (svc *UserService) runAfterCreateHooks(ctx context.Context, req *CreateUserRequest, res *User) error {
+	for _, h := range svc.hooks {
+		if h.AfterCreate != nil {
+			if err := h.AfterCreate(ctx, req, res); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runBeforeUpdateHooks runs the BeforeUpdate hooks of the service, stopping at the first failing one.
+func (svc *UserService) runBeforeUpdateHooks(ctx context.Context, req *UpdateUserRequest, m *ent.UserUpdateOne) error {
+	for _, h := range svc.hooks {
+		if h.BeforeUpdate != nil {
+			if err := h.BeforeUpdate(ctx, req, m); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runAfterUpdateHooks runs the AfterUpdate hooks of the service, stopping at the first failing one.
+func (svc *UserService) runAfterUpdateHooks(ctx context.Context, req *UpdateUserRequest, res *User) error {
+	for _, h := range svc.hooks {
+		if h.AfterUpdate != nil {
+			if err := h.AfterUpdate(ctx, req, res); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runBeforeDeleteHooks runs the BeforeDelete hooks of the service, stopping at the first failing one.
+func (svc *UserService) runBeforeDeleteHooks(ctx context.Context, req *DeleteUserRequest) error {
+	for _, h := range svc.hooks {
+		if h.BeforeDelete != nil {
+			if err := h.BeforeDelete(ctx, req); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runAfterDeleteHooks runs the AfterDelete hooks of the service, stopping at the first failing one.
+func (svc *UserService) runAfterDeleteHooks(ctx context.Context, req *DeleteUserRequest) error {
+	for _, h := range svc.hooks {
+		if h.AfterDelete != nil {
+			if err := h.AfterDelete(ctx, req); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// toDeleteUserRequests returns the requests deleting the entries of the IDs, passed to the Delete
+// hooks by the methods deleting several entries.
+func toDeleteUserRequests(ids []uint32) ([]*DeleteUserRequest, error) {
+	reqs := make([]*DeleteUserRequest, len(ids))
+	for i, item := range ids {
+		id := item
+		reqs[i] = &DeleteUserRequest{Id: id}
+	}
+	return reqs, nil
+}
+
+// ToProtoUserDeviceType converts the device_type enum of the ent type to the pb enum.
+// Unknown values are converted to the zero value of the pb enum.
+func ToProtoUserDeviceType(e user.DeviceType) User_DeviceType {
+	return toProtoUser_DeviceType(e)
+}
+
+// ToEntUserDeviceType converts the pb enum to the device_type enum of the ent type.
+// Unknown values are converted to the empty value.
+func ToEntUserDeviceType(e User_DeviceType) user.DeviceType {
+	return toEntUser_DeviceType(e)
 }
 
 func toProtoUser_DeviceType(e user.DeviceType) User_DeviceType {
@@ -56,6 +221,18 @@ func toEntUser_DeviceType(e User_DeviceType) user.DeviceType {
 	return ""
 }
 
+// ToProtoUserOmitPrefix converts the omit_prefix enum of the ent type to the pb enum.
+// Unknown values are converted to the zero value of the pb enum.
+func ToProtoUserOmitPrefix(e user.OmitPrefix) User_OmitPrefix {
+	return toProtoUser_OmitPrefix(e)
+}
+
+// ToEntUserOmitPrefix converts the pb enum to the omit_prefix enum of the ent type.
+// Unknown values are converted to the empty value.
+func ToEntUserOmitPrefix(e User_OmitPrefix) user.OmitPrefix {
+	return toEntUser_OmitPrefix(e)
+}
+
 func toProtoUser_OmitPrefix(e user.OmitPrefix) User_OmitPrefix {
 	if v, ok := User_OmitPrefix_value[strings.ToUpper(string(e))]; ok {
 		return User_OmitPrefix(v)
@@ -74,6 +251,18 @@ func toEntUser_OmitPrefix(e User_OmitPrefix) user.OmitPrefix {
 	return ""
 }
 
+// ToProtoUserStatus converts the status enum of the ent type to the pb enum.
+// Unknown values are converted to the zero value of the pb enum.
+func ToProtoUserStatus(e user.Status) User_Status {
+	return toProtoUser_Status(e)
+}
+
+// ToEntUserStatus converts the pb enum to the status enum of the ent type.
+// Unknown values are converted to the empty value.
+func ToEntUserStatus(e User_Status) user.Status {
+	return toEntUser_Status(e)
+}
+
 func toProtoUser_Status(e user.Status) User_Status {
 	if v, ok := User_Status_value[strings.ToUpper("STATUS_"+string(e))]; ok {
 		return User_Status(v)
@@ -111,6 +300,8 @@ func toProtoUser(e *ent.User) (*User, error) {
 	}
 	big_int := wrapperspb.String(big_intTyped)
 	v.BigInt = big_int
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
 	crm_id, err := e.CrmID.MarshalBinary()
 	if err != nil {
 		return nil, err
@@ -157,6 +348,10 @@ func toProtoUser(e *ent.User) (*User, error) {
 			Id: id,
 		}
 	}
+	for _, edg := range e.Edges.Friends {
+		friendsID := edg.ID
+		v.FriendIds = append(v.FriendIds, friendsID)
+	}
 	if edg := e.Edges.Group; edg != nil {
 		id := int64(edg.ID)
 		v.Group = &Group{
@@ -181,6 +376,176 @@ func toProtoUser(e *ent.User) (*User, error) {
 	return v, nil
 }
 
+// ToProtoUserWithEdges transforms the ent type to the pb type, converting its eager-loaded edges
+// into their messages down to the edge depth the package was generated with, see the edge_depth flag.
+// Edges beyond that depth, and edges of types without a service in the package, only hold their IDs.
+func ToProtoUserWithEdges(e *ent.User) (*User, error) {
+	return toProtoUserWithEdges(e, 1)
+}
+
+// toProtoUserWithEdges transforms the ent type to the pb type, converting its eager-loaded edges
+// into their messages down to depth levels.
+func toProtoUserWithEdges(e *ent.User, depth int) (*User, error) {
+	v, err := toProtoUser(e)
+	if err != nil {
+		return nil, err
+	}
+	if depth == 0 {
+		return v, nil
+	}
+	if edg := e.Edges.Attachment; edg != nil {
+		if v.Attachment, err = toProtoAttachmentWithEdges(edg, depth-1); err != nil {
+			return nil, err
+		}
+	}
+	if edg := e.Edges.Pet; edg != nil {
+		if v.Pet, err = toProtoPetWithEdges(edg, depth-1); err != nil {
+			return nil, err
+		}
+	}
+	for i, edg := range e.Edges.Received1 {
+		if v.Received_1[i], err = toProtoAttachmentWithEdges(edg, depth-1); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// ToEntUserWithEdges transforms the pb type to the ent type, the reverse of ToProtoUserWithEdges.
+// The edges of the message are set on the Edges of the entity down to the edge depth the package was
+// generated with. Edges beyond that depth only hold their IDs.
+func ToEntUserWithEdges(v *User) (*ent.User, error) {
+	return toEntUserWithEdges(v, 1)
+}
+
+// toEntUserWithEdges transforms the pb type to the ent type, converting the edges of the message
+// into their entities down to depth levels.
+func toEntUserWithEdges(v *User, depth int) (*ent.User, error) {
+	e, err := toEntUser(v)
+	if err != nil {
+		return nil, err
+	}
+	if depth == 0 {
+		return e, nil
+	}
+	if edg := v.GetAttachment(); edg != nil {
+		if e.Edges.Attachment, err = toEntAttachmentWithEdges(edg, depth-1); err != nil {
+			return nil, err
+		}
+	}
+	if edg := v.GetPet(); edg != nil {
+		if e.Edges.Pet, err = toEntPetWithEdges(edg, depth-1); err != nil {
+			return nil, err
+		}
+	}
+	for i, edg := range v.GetReceived_1() {
+		if e.Edges.Received1[i], err = toEntAttachmentWithEdges(edg, depth-1); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// toEntUser transforms the pb type to the ent type, setting the edges of the entity to entities
+// holding their IDs only.
+func toEntUser(v *User) (*ent.User, error) {
+	e := &ent.User{}
+	userAccountBalance := float64(v.GetAccountBalance())
+	e.AccountBalance = userAccountBalance
+	if v.GetBUser_1() != nil {
+		userBUser1 := int(v.GetBUser_1().GetValue())
+		e.BUser1 = userBUser1
+	}
+	userBanned := v.GetBanned()
+	e.Banned = userBanned
+	if v.GetBigInt() != nil {
+		userBigInt := schema.BigInt{}
+		if err := (&userBigInt).Scan(v.GetBigInt().GetValue()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		e.BigInt = userBigInt
+	}
+	userCreatedAt := runtime.ExtractTime(v.GetCreatedAt())
+	e.CreatedAt = userCreatedAt
+	var userCrmID uuid.UUID
+	if err := (&userCrmID).UnmarshalBinary(v.GetCrmId()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	e.CrmID = userCrmID
+	userCustomPb, err := runtime.ConvertInt[uint8](v.GetCustomPb())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	e.CustomPb = userCustomPb
+	userDeviceType := toEntUser_DeviceType(v.GetDeviceType())
+	e.DeviceType = userDeviceType
+	userExp := uint64(v.GetExp())
+	e.Exp = userExp
+	userExternalID := int(v.GetExternalId())
+	e.ExternalID = userExternalID
+	userHeightInCm := float32(v.GetHeightInCm())
+	e.HeightInCm = userHeightInCm
+	userID := uint32(v.GetId())
+	e.ID = userID
+	userJoined := runtime.ExtractTime(v.GetJoined())
+	e.Joined = userJoined
+	if v.GetLabels() != nil {
+		userLabels := v.GetLabels()
+		e.Labels = userLabels
+	}
+	userOmitPrefix := toEntUser_OmitPrefix(v.GetOmitPrefix())
+	e.OmitPrefix = userOmitPrefix
+	if v.GetOptBool() != nil {
+		userOptBool := v.GetOptBool().GetValue()
+		e.OptBool = userOptBool
+	}
+	if v.GetOptNum() != nil {
+		userOptNum := int(v.GetOptNum().GetValue())
+		e.OptNum = userOptNum
+	}
+	if v.GetOptStr() != nil {
+		userOptStr := v.GetOptStr().GetValue()
+		e.OptStr = userOptStr
+	}
+	userPoints := uint(v.GetPoints())
+	e.Points = userPoints
+	userStatus := toEntUser_Status(v.GetStatus())
+	e.Status = userStatus
+	if v.GetType() != nil {
+		userType := v.GetType().GetValue()
+		e.Type = userType
+	}
+	userUserName := v.GetUserName()
+	e.UserName = userUserName
+	if edg := v.GetAttachment(); edg != nil {
+		var attachmentID uuid.UUID
+		if err := (&attachmentID).UnmarshalBinary(edg.GetId()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		e.Edges.Attachment = &ent.Attachment{ID: attachmentID}
+	}
+	for _, item := range v.GetFriendIds() {
+		friendsID := uint32(item)
+		e.Edges.Friends = append(e.Edges.Friends, &ent.User{ID: friendsID})
+	}
+	if edg := v.GetGroup(); edg != nil {
+		groupID := int(edg.GetId())
+		e.Edges.Group = &ent.Group{ID: groupID}
+	}
+	if edg := v.GetPet(); edg != nil {
+		petID := int(edg.GetId())
+		e.Edges.Pet = &ent.Pet{ID: petID}
+	}
+	for _, edg := range v.GetReceived_1() {
+		var received1ID uuid.UUID
+		if err := (&received1ID).UnmarshalBinary(edg.GetId()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		e.Edges.Received1 = append(e.Edges.Received1, &ent.Attachment{ID: received1ID})
+	}
+	return e, nil
+}
+
 // toProtoUserList transforms a list of ent type to a list of pb type
 func toProtoUserList(e []*ent.User) ([]*User, error) {
 	var pbList []*User
@@ -194,47 +559,238 @@ func toProtoUserList(e []*ent.User) ([]*User, error) {
 	return pbList, nil
 }
 
+// userReadMaskColumns returns the columns of the fields selected by the read mask, including the ID
+func userReadMaskColumns(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	columns := []string{user.FieldID}
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "id":
+		case "account_balance":
+			columns = append(columns, user.FieldAccountBalance)
+		case "b_user_1":
+			columns = append(columns, user.FieldBUser1)
+		case "banned":
+			columns = append(columns, user.FieldBanned)
+		case "big_int":
+			columns = append(columns, user.FieldBigInt)
+		case "created_at":
+			columns = append(columns, user.FieldCreatedAt)
+		case "crm_id":
+			columns = append(columns, user.FieldCrmID)
+		case "custom_pb":
+			columns = append(columns, user.FieldCustomPb)
+		case "device_type":
+			columns = append(columns, user.FieldDeviceType)
+		case "exp":
+			columns = append(columns, user.FieldExp)
+		case "external_id":
+			columns = append(columns, user.FieldExternalID)
+		case "height_in_cm":
+			columns = append(columns, user.FieldHeightInCm)
+		case "joined":
+			columns = append(columns, user.FieldJoined)
+		case "labels":
+			columns = append(columns, user.FieldLabels)
+		case "omit_prefix":
+			columns = append(columns, user.FieldOmitPrefix)
+		case "opt_bool":
+			columns = append(columns, user.FieldOptBool)
+		case "opt_num":
+			columns = append(columns, user.FieldOptNum)
+		case "opt_str":
+			columns = append(columns, user.FieldOptStr)
+		case "points":
+			columns = append(columns, user.FieldPoints)
+		case "status":
+			columns = append(columns, user.FieldStatus)
+		case "type":
+			columns = append(columns, user.FieldType)
+		case "user_name":
+			columns = append(columns, user.FieldUserName)
+		case "attachment":
+			// The edges are loaded according to the view of the request.
+		case "friend_ids":
+			// The edges are loaded according to the view of the request.
+		case "group":
+			// The edges are loaded according to the view of the request.
+		case "pet":
+			// The edges are loaded according to the view of the request.
+		case "received_1":
+			// The edges are loaded according to the view of the request.
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: unknown read mask path %q", path)
+		}
+	}
+	return columns, nil
+}
+
+// userUpdateMaskPaths returns the set of the paths of the update mask, or nil if the mask is empty
+func userUpdateMaskPaths(mask *fieldmaskpb.FieldMask) (map[string]bool, error) {
+	if len(mask.GetPaths()) == 0 {
+		return nil, nil
+	}
+	paths := make(map[string]bool, len(mask.GetPaths()))
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "account_balance":
+		case "b_user_1":
+		case "banned":
+		case "big_int":
+		case "crm_id":
+		case "custom_pb":
+		case "device_type":
+		case "exp":
+		case "external_id":
+		case "height_in_cm":
+		case "labels":
+		case "omit_prefix":
+		case "opt_bool":
+		case "opt_num":
+		case "opt_str":
+		case "points":
+		case "status":
+		case "type":
+		case "user_name":
+		case "attachment":
+		case "friend_ids":
+		case "group":
+		case "pet":
+		case "received_1":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: unknown update mask path %q", path)
+		}
+		paths[path] = true
+	}
+	return paths, nil
+}
+
+// userValidationError returns an InvalidArgument status error for the ent validation error err,
+// holding the violation of the field of the request it failed on. prefix is the path of the User
+// message in the request, or empty if the request is the message itself.
+func userValidationError(err error, prefix string) error {
+	var verr *ent.ValidationError
+	if !errors.As(err, &verr) {
+		return status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	field := prefix + verr.Name
+	return runtime.FieldViolation(field, err)
+}
+
+// userConstraintField returns the path of the field of the request violating the database constraint
+// of err, or an empty string if it is unknown. prefix is the path of the User message in the request,
+// or empty if the request is the message itself.
+func userConstraintField(err error, prefix string) string {
+	switch runtime.ConstraintColumn(err) {
+	case "b_user_1":
+		return prefix + "b_user_1"
+	case "external_id":
+		return prefix + "external_id"
+	case "user_group":
+		return prefix + "group"
+	case "user_id":
+		return prefix + "id"
+	case "user_name":
+		return prefix + "user_name"
+	}
+	return ""
+}
+
 // Create implements UserServiceServer.Create
 func (svc *UserService) Create(ctx context.Context, req *CreateUserRequest) (*User, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.UserService/Create", "User")
+	if err := svc.config.Writable("UserService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.UserService", "Create", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveCreate(ctx, req)
+	if err == nil {
+		res = runtime.Redact(ctx, svc.config, res, userServiceRedactions)
+	}
+	return res, svc.config.RecordRequest("entpb.UserService", "Create", start, runtime.EndSpan(span, err))
+}
+
+// serveCreate serves the Create method within its span.
+func (svc *UserService) serveCreate(ctx context.Context, req *CreateUserRequest) (*User, error) {
 	user := req.GetUser()
-	m, err := svc.createBuilder(user)
+	m, err := svc.createBuilder(ctx, user)
 	if err != nil {
 		return nil, err
 	}
+	if err := svc.runBeforeCreateHooks(ctx, req, m); err != nil {
+		return nil, err
+	}
+	runtime.TraceQuery(ctx)
 	res, err := m.Save(ctx)
 	switch {
 	case err == nil:
+		runtime.TraceID(ctx, res.ID)
+		runtime.TraceConvert(ctx)
 		proto, err := toProtoUser(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		if err := svc.runAfterCreateHooks(ctx, req, proto); err != nil {
+			return nil, err
 		}
 		return proto, nil
-	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsValidationError(err):
+		return nil, userValidationError(err, "user.")
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, svc.config.ConstraintError(err, userConstraintField(err, "user."))
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Get implements UserServiceServer.Get
 func (svc *UserService) Get(ctx context.Context, req *GetUserRequest) (*User, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.UserService/Get", "User")
+	res, err := svc.serveGet(ctx, req)
+	if err == nil {
+		res = runtime.Redact(ctx, svc.config, res, userServiceRedactions)
+	}
+	return res, svc.config.RecordRequest("entpb.UserService", "Get", start, runtime.EndSpan(span, err))
+}
+
+// serveGet serves the Get method within its span.
+func (svc *UserService) serveGet(ctx context.Context, req *GetUserRequest) (*User, error) {
 	var (
 		err error
 		get *ent.User
 	)
 	id := uint32(req.GetId())
+	runtime.TraceID(ctx, id)
+	// Entities requested without their edges or a read mask are served from the cache of the service, if any.
+	cacheable := (req.GetView() == GetUserRequest_VIEW_UNSPECIFIED || req.GetView() == GetUserRequest_BASIC) && req.GetReadMask() == nil
+	if cacheable {
+		cached := &User{}
+		if svc.config.CachedEntity(ctx, "User", id, cached) {
+			return cached, nil
+		}
+	}
+	getQuery := svc.client.User.Query().
+		Where(user.ID(id))
+	if mask := req.GetReadMask(); mask != nil {
+		columns, err := userReadMaskColumns(mask)
+		if err != nil {
+			return nil, err
+		}
+		getQuery.Select(columns...)
+	}
+	runtime.TraceQuery(ctx)
 	switch req.GetView() {
 	case GetUserRequest_VIEW_UNSPECIFIED, GetUserRequest_BASIC:
-		get, err = svc.client.User.Get(ctx, id)
+		get, err = getQuery.Only(ctx)
 	case GetUserRequest_WITH_EDGE_IDS:
-		get, err = svc.client.User.Query().
-			Where(user.ID(id)).
+		get, err = getQuery.
 			WithAttachment(func(query *ent.AttachmentQuery) {
 				query.Select(attachment.FieldID)
 			}).
+			WithFriends(func(query *ent.UserQuery) {
+				query.Select(user.FieldID)
+			}).
 			WithGroup(func(query *ent.GroupQuery) {
 				query.Select(group.FieldID)
 			}).
@@ -250,251 +806,3821 @@ func (svc *UserService) Get(ctx context.Context, req *GetUserRequest) (*User, er
 	}
 	switch {
 	case err == nil:
-		return toProtoUser(get)
+		runtime.TraceConvert(ctx)
+		protoGet, err := toProtoUser(get)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		if cacheable {
+			svc.config.CacheEntity(ctx, "User", id, protoGet)
+		}
+		return protoGet, nil
 	case ent.IsNotFound(err):
-		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+		return nil, svc.config.MapError(err, codes.NotFound, "not found: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
-// Update implements UserServiceServer.Update
-func (svc *UserService) Update(ctx context.Context, req *UpdateUserRequest) (*User, error) {
-	user := req.GetUser()
-	userID := uint32(user.GetId())
-	m := svc.client.User.UpdateOneID(userID)
-	userAccountBalance := float64(user.GetAccountBalance())
-	m.SetAccountBalance(userAccountBalance)
-	if user.GetBUser_1() != nil {
-		userBUser1 := int(user.GetBUser_1().GetValue())
-		m.SetBUser1(userBUser1)
-	}
-	userBanned := user.GetBanned()
-	m.SetBanned(userBanned)
-	if user.GetBigInt() != nil {
-		userBigInt := schema.BigInt{}
-		if err := (&userBigInt).Scan(user.GetBigInt().GetValue()); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
-		}
-		m.SetBigInt(userBigInt)
-	}
-	var userCrmID uuid.UUID
-	if err := (&userCrmID).UnmarshalBinary(user.GetCrmId()); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
-	}
-	m.SetCrmID(userCrmID)
-	userCustomPb := uint8(user.GetCustomPb())
-	m.SetCustomPb(userCustomPb)
-	userDeviceType := toEntUser_DeviceType(user.GetDeviceType())
-	m.SetDeviceType(userDeviceType)
-	userExp := uint64(user.GetExp())
-	m.SetExp(userExp)
-	userExternalID := int(user.GetExternalId())
-	m.SetExternalID(userExternalID)
-	userHeightInCm := float32(user.GetHeightInCm())
-	m.SetHeightInCm(userHeightInCm)
-	if user.GetLabels() != nil {
-		userLabels := user.GetLabels()
-		m.SetLabels(userLabels)
-	}
-	userOmitPrefix := toEntUser_OmitPrefix(user.GetOmitPrefix())
-	m.SetOmitPrefix(userOmitPrefix)
-	if user.GetOptBool() != nil {
-		userOptBool := user.GetOptBool().GetValue()
-		m.SetOptBool(userOptBool)
-	}
-	if user.GetOptNum() != nil {
-		userOptNum := int(user.GetOptNum().GetValue())
-		m.SetOptNum(userOptNum)
-	}
-	if user.GetOptStr() != nil {
-		userOptStr := user.GetOptStr().GetValue()
-		m.SetOptStr(userOptStr)
-	}
-	userPoints := uint(user.GetPoints())
-	m.SetPoints(userPoints)
-	userStatus := toEntUser_Status(user.GetStatus())
-	m.SetStatus(userStatus)
-	if user.GetType() != nil {
-		userType := user.GetType().GetValue()
-		m.SetType(userType)
-	}
-	userUserName := user.GetUserName()
-	m.SetUserName(userUserName)
-	if user.GetAttachment() != nil {
-		var userAttachment uuid.UUID
-		if err := (&userAttachment).UnmarshalBinary(user.GetAttachment().GetId()); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
-		}
-		m.SetAttachmentID(userAttachment)
-	}
-	if user.GetGroup() != nil {
-		userGroup := int(user.GetGroup().GetId())
-		m.SetGroupID(userGroup)
-	}
-	if user.GetPet() != nil {
-		userPet := int(user.GetPet().GetId())
-		m.SetPetID(userPet)
-	}
-	for _, item := range user.GetReceived_1() {
-		var received1 uuid.UUID
-		if err := (&received1).UnmarshalBinary(item.GetId()); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
-		}
-		m.AddReceived1IDs(received1)
+// GetUserByUserName implements UserServiceServer.GetUserByUserName
+func (svc *UserService) GetUserByUserName(ctx context.Context, req *GetUserByUserNameRequest) (*User, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.UserService/GetUserByUserName", "User")
+	res, err := svc.serveGetUserByUserName(ctx, req)
+	if err == nil {
+		res = runtime.Redact(ctx, svc.config, res, userServiceRedactions)
 	}
+	return res, svc.config.RecordRequest("entpb.UserService", "GetUserByUserName", start, runtime.EndSpan(span, err))
+}
 
-	res, err := m.Save(ctx)
+// serveGetUserByUserName serves the GetUserByUserName method within its span.
+func (svc *UserService) serveGetUserByUserName(ctx context.Context, req *GetUserByUserNameRequest) (*User, error) {
+	get, err := svc.client.User.Query().
+		Where(user.UserNameEQ(req.GetUserName())).
+		Only(ctx)
 	switch {
 	case err == nil:
-		proto, err := toProtoUser(res)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
-		}
-		return proto, nil
-	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
-	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return toProtoUser(get)
+	case ent.IsNotFound(err):
+		return nil, svc.config.MapError(err, codes.NotFound, "not found: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
-// Delete implements UserServiceServer.Delete
-func (svc *UserService) Delete(ctx context.Context, req *DeleteUserRequest) (*emptypb.Empty, error) {
-	var err error
-	id := uint32(req.GetId())
-	err = svc.client.User.DeleteOneID(id).Exec(ctx)
+// GetUserByExternalID implements UserServiceServer.GetUserByExternalID
+func (svc *UserService) GetUserByExternalID(ctx context.Context, req *GetUserByExternalIDRequest) (*User, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.UserService/GetUserByExternalID", "User")
+	res, err := svc.serveGetUserByExternalID(ctx, req)
+	if err == nil {
+		res = runtime.Redact(ctx, svc.config, res, userServiceRedactions)
+	}
+	return res, svc.config.RecordRequest("entpb.UserService", "GetUserByExternalID", start, runtime.EndSpan(span, err))
+}
+
+// serveGetUserByExternalID serves the GetUserByExternalID method within its span.
+func (svc *UserService) serveGetUserByExternalID(ctx context.Context, req *GetUserByExternalIDRequest) (*User, error) {
+	get, err := svc.client.User.Query().
+		Where(user.ExternalIDEQ(int(req.GetExternalId()))).
+		Only(ctx)
 	switch {
 	case err == nil:
-		return &emptypb.Empty{}, nil
+		return toProtoUser(get)
 	case ent.IsNotFound(err):
-		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+		return nil, svc.config.MapError(err, codes.NotFound, "not found: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 	}
 
 }
 
-// List implements UserServiceServer.List
-func (svc *UserService) List(ctx context.Context, req *ListUserRequest) (*ListUserResponse, error) {
-	var (
-		err      error
-		entList  []*ent.User
-		pageSize int
-	)
+// GetUserByBUser1 implements UserServiceServer.GetUserByBUser1
+func (svc *UserService) GetUserByBUser1(ctx context.Context, req *GetUserByBUser1Request) (*User, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.UserService/GetUserByBUser1", "User")
+	res, err := svc.serveGetUserByBUser1(ctx, req)
+	if err == nil {
+		res = runtime.Redact(ctx, svc.config, res, userServiceRedactions)
+	}
+	return res, svc.config.RecordRequest("entpb.UserService", "GetUserByBUser1", start, runtime.EndSpan(span, err))
+}
+
+// serveGetUserByBUser1 serves the GetUserByBUser1 method within its span.
+func (svc *UserService) serveGetUserByBUser1(ctx context.Context, req *GetUserByBUser1Request) (*User, error) {
+	get, err := svc.client.User.Query().
+		Where(user.BUser1EQ(int(req.GetBUser_1()))).
+		Only(ctx)
+	switch {
+	case err == nil:
+		return toProtoUser(get)
+	case ent.IsNotFound(err):
+		return nil, svc.config.MapError(err, codes.NotFound, "not found: %s", err)
+	default:
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Exists implements UserServiceServer.Exists
+func (svc *UserService) Exists(ctx context.Context, req *ExistsUserRequest) (*ExistsUserResponse, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.UserService/Exists", "User")
+	res, err := svc.serveExists(ctx, req)
+	return res, svc.config.RecordRequest("entpb.UserService", "Exists", start, runtime.EndSpan(span, err))
+}
+
+// serveExists serves the Exists method within its span.
+func (svc *UserService) serveExists(ctx context.Context, req *ExistsUserRequest) (*ExistsUserResponse, error) {
+	query := svc.client.User.Query()
+	switch key := req.GetKey().(type) {
+	case *ExistsUserRequest_Id:
+		id := uint32(key.Id)
+		query = query.Where(user.ID(id))
+	case *ExistsUserRequest_BUser_1:
+		query = query.Where(user.BUser1EQ(int(key.BUser_1)))
+	case *ExistsUserRequest_ExternalId:
+		query = query.Where(user.ExternalIDEQ(int(key.ExternalId)))
+	case *ExistsUserRequest_UserName:
+		query = query.Where(user.UserNameEQ(key.UserName))
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: key must be set")
+	}
+	exists, err := query.Exist(ctx)
+	if err != nil {
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	return &ExistsUserResponse{
+		Exists: exists,
+	}, nil
+
+}
+
+// Update implements UserServiceServer.Update
+func (svc *UserService) Update(ctx context.Context, req *UpdateUserRequest) (*User, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.UserService/Update", "User")
+	if err := svc.config.Writable("UserService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.UserService", "Update", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveUpdate(ctx, req)
+	if err == nil {
+		res = runtime.Redact(ctx, svc.config, res, userServiceRedactions)
+	}
+	return res, svc.config.RecordRequest("entpb.UserService", "Update", start, runtime.EndSpan(span, err))
+}
+
+// serveUpdate serves the Update method within its span.
+func (svc *UserService) serveUpdate(ctx context.Context, req *UpdateUserRequest) (*User, error) {
+	user := req.GetUser()
+	m, err := svc.updateBuilder(ctx, svc.client, user, req.GetUpdateMask())
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.runBeforeUpdateHooks(ctx, req, m); err != nil {
+		return nil, err
+	}
+	runtime.TraceQuery(ctx)
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		runtime.TraceID(ctx, res.ID)
+		if err := svc.config.InvalidateCache(ctx, "User", res.ID); err != nil {
+			return nil, err
+		}
+		runtime.TraceConvert(ctx)
+		proto, err := toProtoUser(res)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		if err := svc.runAfterUpdateHooks(ctx, req, proto); err != nil {
+			return nil, err
+		}
+		return proto, nil
+	case ent.IsValidationError(err):
+		return nil, userValidationError(err, "user.")
+	case ent.IsConstraintError(err):
+		return nil, svc.config.ConstraintError(err, userConstraintField(err, "user."))
+	default:
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements UserServiceServer.Delete
+func (svc *UserService) Delete(ctx context.Context, req *DeleteUserRequest) (*emptypb.Empty, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.UserService/Delete", "User")
+	if err := svc.config.Writable("UserService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.UserService", "Delete", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveDelete(ctx, req)
+	return res, svc.config.RecordRequest("entpb.UserService", "Delete", start, runtime.EndSpan(span, err))
+}
+
+// serveDelete serves the Delete method within its span.
+func (svc *UserService) serveDelete(ctx context.Context, req *DeleteUserRequest) (*emptypb.Empty, error) {
+	var err error
+	id := uint32(req.GetId())
+	runtime.TraceID(ctx, id)
+	if err := svc.runBeforeDeleteHooks(ctx, req); err != nil {
+		return nil, err
+	}
+	runtime.TraceQuery(ctx)
+	err = svc.client.User.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		if err := svc.config.InvalidateCache(ctx, "User", id); err != nil {
+			return nil, err
+		}
+		if err := svc.runAfterDeleteHooks(ctx, req); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, svc.config.MapError(err, codes.NotFound, "not found: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, svc.config.ConstraintError(err, "")
+	default:
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// DeleteUsers implements UserServiceServer.DeleteUsers
+func (svc *UserService) DeleteUsers(ctx context.Context, req *DeleteUsersRequest) (*DeleteUsersResponse, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.UserService/DeleteUsers", "User")
+	if err := svc.config.Writable("UserService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.UserService", "DeleteUsers", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveDeleteUsers(ctx, req)
+	return res, svc.config.RecordRequest("entpb.UserService", "DeleteUsers", start, runtime.EndSpan(span, err))
+}
+
+// serveDeleteUsers serves the DeleteUsers method within its span.
+func (svc *UserService) serveDeleteUsers(ctx context.Context, req *DeleteUsersRequest) (*DeleteUsersResponse, error) {
+	filter := req.GetFilter()
+	// Reject requests without comparisons, as they would delete all entities.
+	if proto.Size(filter) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: filter must be set")
+	}
+	if filter.GetOptNum() != nil {
+		if err := runtime.CheckRedacted(ctx, svc.config, userServiceRedactions, "entpb.User.opt_num"); err != nil {
+			return nil, err
+		}
+	}
+	tx, err := svc.client.Tx(ctx)
+	if err != nil {
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	// The IDs of the entries are looked up in the transaction deleting them, so they are removed from the
+	// cache of the service.
+	idQuery := tx.User.Query()
+	if c := filter.AccountBalance; c != nil {
+		if c.Eq != nil {
+			idQuery = idQuery.Where(user.AccountBalanceEQ(c.Eq.GetValue()))
+		}
+		if c.Neq != nil {
+			idQuery = idQuery.Where(user.AccountBalanceNEQ(c.Neq.GetValue()))
+		}
+		if c.Gt != nil {
+			idQuery = idQuery.Where(user.AccountBalanceGT(c.Gt.GetValue()))
+		}
+		if c.Lt != nil {
+			idQuery = idQuery.Where(user.AccountBalanceLT(c.Lt.GetValue()))
+		}
+		if c.Gte != nil {
+			idQuery = idQuery.Where(user.AccountBalanceGTE(c.Gte.GetValue()))
+		}
+		if c.Lte != nil {
+			idQuery = idQuery.Where(user.AccountBalanceLTE(c.Lte.GetValue()))
+		}
+		if len(c.In) > 0 {
+			idQuery = idQuery.Where(user.AccountBalanceIn(c.In...))
+		}
+	}
+	if c := filter.BUser_1; c != nil {
+		if c.Eq != nil {
+			idQuery = idQuery.Where(user.BUser1EQ(int(c.Eq.GetValue())))
+		}
+		if c.Neq != nil {
+			idQuery = idQuery.Where(user.BUser1NEQ(int(c.Neq.GetValue())))
+		}
+		if c.Gt != nil {
+			idQuery = idQuery.Where(user.BUser1GT(int(c.Gt.GetValue())))
+		}
+		if c.Lt != nil {
+			idQuery = idQuery.Where(user.BUser1LT(int(c.Lt.GetValue())))
+		}
+		if c.Gte != nil {
+			idQuery = idQuery.Where(user.BUser1GTE(int(c.Gte.GetValue())))
+		}
+		if c.Lte != nil {
+			idQuery = idQuery.Where(user.BUser1LTE(int(c.Lte.GetValue())))
+		}
+		if len(c.In) > 0 {
+			vs := make([]int, len(c.In))
+			for i := range c.In {
+				vs[i] = int(c.In[i])
+			}
+			idQuery = idQuery.Where(user.BUser1In(vs...))
+		}
+	}
+	if c := filter.Banned; c != nil {
+		if c.Eq != nil {
+			idQuery = idQuery.Where(user.BannedEQ(c.Eq.GetValue()))
+		}
+		if c.Neq != nil {
+			idQuery = idQuery.Where(user.BannedNEQ(c.Neq.GetValue()))
+		}
+	}
+	if c := filter.CreatedAt; c != nil {
+		if c.Eq != nil {
+			idQuery = idQuery.Where(user.CreatedAtEQ(c.Eq.AsTime()))
+		}
+		if c.Neq != nil {
+			idQuery = idQuery.Where(user.CreatedAtNEQ(c.Neq.AsTime()))
+		}
+		if c.Gt != nil {
+			idQuery = idQuery.Where(user.CreatedAtGT(c.Gt.AsTime()))
+		}
+		if c.Lt != nil {
+			idQuery = idQuery.Where(user.CreatedAtLT(c.Lt.AsTime()))
+		}
+		if c.Gte != nil {
+			idQuery = idQuery.Where(user.CreatedAtGTE(c.Gte.AsTime()))
+		}
+		if c.Lte != nil {
+			idQuery = idQuery.Where(user.CreatedAtLTE(c.Lte.AsTime()))
+		}
+	}
+	if c := filter.Exp; c != nil {
+		if c.Eq != nil {
+			idQuery = idQuery.Where(user.ExpEQ(c.Eq.GetValue()))
+		}
+		if c.Neq != nil {
+			idQuery = idQuery.Where(user.ExpNEQ(c.Neq.GetValue()))
+		}
+		if c.Gt != nil {
+			idQuery = idQuery.Where(user.ExpGT(c.Gt.GetValue()))
+		}
+		if c.Lt != nil {
+			idQuery = idQuery.Where(user.ExpLT(c.Lt.GetValue()))
+		}
+		if c.Gte != nil {
+			idQuery = idQuery.Where(user.ExpGTE(c.Gte.GetValue()))
+		}
+		if c.Lte != nil {
+			idQuery = idQuery.Where(user.ExpLTE(c.Lte.GetValue()))
+		}
+		if len(c.In) > 0 {
+			idQuery = idQuery.Where(user.ExpIn(c.In...))
+		}
+	}
+	if c := filter.ExternalId; c != nil {
+		if c.Eq != nil {
+			idQuery = idQuery.Where(user.ExternalIDEQ(int(c.Eq.GetValue())))
+		}
+		if c.Neq != nil {
+			idQuery = idQuery.Where(user.ExternalIDNEQ(int(c.Neq.GetValue())))
+		}
+		if c.Gt != nil {
+			idQuery = idQuery.Where(user.ExternalIDGT(int(c.Gt.GetValue())))
+		}
+		if c.Lt != nil {
+			idQuery = idQuery.Where(user.ExternalIDLT(int(c.Lt.GetValue())))
+		}
+		if c.Gte != nil {
+			idQuery = idQuery.Where(user.ExternalIDGTE(int(c.Gte.GetValue())))
+		}
+		if c.Lte != nil {
+			idQuery = idQuery.Where(user.ExternalIDLTE(int(c.Lte.GetValue())))
+		}
+		if len(c.In) > 0 {
+			vs := make([]int, len(c.In))
+			for i := range c.In {
+				vs[i] = int(c.In[i])
+			}
+			idQuery = idQuery.Where(user.ExternalIDIn(vs...))
+		}
+	}
+	if c := filter.HeightInCm; c != nil {
+		if c.Eq != nil {
+			idQuery = idQuery.Where(user.HeightInCmEQ(c.Eq.GetValue()))
+		}
+		if c.Neq != nil {
+			idQuery = idQuery.Where(user.HeightInCmNEQ(c.Neq.GetValue()))
+		}
+		if c.Gt != nil {
+			idQuery = idQuery.Where(user.HeightInCmGT(c.Gt.GetValue()))
+		}
+		if c.Lt != nil {
+			idQuery = idQuery.Where(user.HeightInCmLT(c.Lt.GetValue()))
+		}
+		if c.Gte != nil {
+			idQuery = idQuery.Where(user.HeightInCmGTE(c.Gte.GetValue()))
+		}
+		if c.Lte != nil {
+			idQuery = idQuery.Where(user.HeightInCmLTE(c.Lte.GetValue()))
+		}
+		if len(c.In) > 0 {
+			idQuery = idQuery.Where(user.HeightInCmIn(c.In...))
+		}
+	}
+	if c := filter.Id; c != nil {
+		if c.Eq != nil {
+			idQuery = idQuery.Where(user.IDEQ(c.Eq.GetValue()))
+		}
+		if c.Neq != nil {
+			idQuery = idQuery.Where(user.IDNEQ(c.Neq.GetValue()))
+		}
+		if c.Gt != nil {
+			idQuery = idQuery.Where(user.IDGT(c.Gt.GetValue()))
+		}
+		if c.Lt != nil {
+			idQuery = idQuery.Where(user.IDLT(c.Lt.GetValue()))
+		}
+		if c.Gte != nil {
+			idQuery = idQuery.Where(user.IDGTE(c.Gte.GetValue()))
+		}
+		if c.Lte != nil {
+			idQuery = idQuery.Where(user.IDLTE(c.Lte.GetValue()))
+		}
+		if len(c.In) > 0 {
+			idQuery = idQuery.Where(user.IDIn(c.In...))
+		}
+	}
+	if c := filter.Joined; c != nil {
+		if c.Eq != nil {
+			idQuery = idQuery.Where(user.JoinedEQ(c.Eq.AsTime()))
+		}
+		if c.Neq != nil {
+			idQuery = idQuery.Where(user.JoinedNEQ(c.Neq.AsTime()))
+		}
+		if c.Gt != nil {
+			idQuery = idQuery.Where(user.JoinedGT(c.Gt.AsTime()))
+		}
+		if c.Lt != nil {
+			idQuery = idQuery.Where(user.JoinedLT(c.Lt.AsTime()))
+		}
+		if c.Gte != nil {
+			idQuery = idQuery.Where(user.JoinedGTE(c.Gte.AsTime()))
+		}
+		if c.Lte != nil {
+			idQuery = idQuery.Where(user.JoinedLTE(c.Lte.AsTime()))
+		}
+	}
+	if c := filter.OptBool; c != nil {
+		if c.Eq != nil {
+			idQuery = idQuery.Where(user.OptBoolEQ(c.Eq.GetValue()))
+		}
+		if c.Neq != nil {
+			idQuery = idQuery.Where(user.OptBoolNEQ(c.Neq.GetValue()))
+		}
+	}
+	if c := filter.OptNum; c != nil {
+		if c.Eq != nil {
+			idQuery = idQuery.Where(user.OptNumEQ(int(c.Eq.GetValue())))
+		}
+		if c.Neq != nil {
+			idQuery = idQuery.Where(user.OptNumNEQ(int(c.Neq.GetValue())))
+		}
+		if c.Gt != nil {
+			idQuery = idQuery.Where(user.OptNumGT(int(c.Gt.GetValue())))
+		}
+		if c.Lt != nil {
+			idQuery = idQuery.Where(user.OptNumLT(int(c.Lt.GetValue())))
+		}
+		if c.Gte != nil {
+			idQuery = idQuery.Where(user.OptNumGTE(int(c.Gte.GetValue())))
+		}
+		if c.Lte != nil {
+			idQuery = idQuery.Where(user.OptNumLTE(int(c.Lte.GetValue())))
+		}
+		if len(c.In) > 0 {
+			vs := make([]int, len(c.In))
+			for i := range c.In {
+				vs[i] = int(c.In[i])
+			}
+			idQuery = idQuery.Where(user.OptNumIn(vs...))
+		}
+	}
+	if c := filter.OptStr; c != nil {
+		if c.Eq != nil {
+			idQuery = idQuery.Where(user.OptStrEQ(c.Eq.GetValue()))
+		}
+		if c.Neq != nil {
+			idQuery = idQuery.Where(user.OptStrNEQ(c.Neq.GetValue()))
+		}
+		if c.Gt != nil {
+			idQuery = idQuery.Where(user.OptStrGT(c.Gt.GetValue()))
+		}
+		if c.Lt != nil {
+			idQuery = idQuery.Where(user.OptStrLT(c.Lt.GetValue()))
+		}
+		if c.Gte != nil {
+			idQuery = idQuery.Where(user.OptStrGTE(c.Gte.GetValue()))
+		}
+		if c.Lte != nil {
+			idQuery = idQuery.Where(user.OptStrLTE(c.Lte.GetValue()))
+		}
+		if len(c.In) > 0 {
+			idQuery = idQuery.Where(user.OptStrIn(c.In...))
+		}
+		if c.Contains != nil {
+			idQuery = idQuery.Where(user.OptStrContains(c.Contains.GetValue()))
+		}
+	}
+	if c := filter.Points; c != nil {
+		if c.Eq != nil {
+			idQuery = idQuery.Where(user.PointsEQ(uint(c.Eq.GetValue())))
+		}
+		if c.Neq != nil {
+			idQuery = idQuery.Where(user.PointsNEQ(uint(c.Neq.GetValue())))
+		}
+		if c.Gt != nil {
+			idQuery = idQuery.Where(user.PointsGT(uint(c.Gt.GetValue())))
+		}
+		if c.Lt != nil {
+			idQuery = idQuery.Where(user.PointsLT(uint(c.Lt.GetValue())))
+		}
+		if c.Gte != nil {
+			idQuery = idQuery.Where(user.PointsGTE(uint(c.Gte.GetValue())))
+		}
+		if c.Lte != nil {
+			idQuery = idQuery.Where(user.PointsLTE(uint(c.Lte.GetValue())))
+		}
+		if len(c.In) > 0 {
+			vs := make([]uint, len(c.In))
+			for i := range c.In {
+				vs[i] = uint(c.In[i])
+			}
+			idQuery = idQuery.Where(user.PointsIn(vs...))
+		}
+	}
+	if c := filter.Type; c != nil {
+		if c.Eq != nil {
+			idQuery = idQuery.Where(user.TypeEQ(c.Eq.GetValue()))
+		}
+		if c.Neq != nil {
+			idQuery = idQuery.Where(user.TypeNEQ(c.Neq.GetValue()))
+		}
+		if c.Gt != nil {
+			idQuery = idQuery.Where(user.TypeGT(c.Gt.GetValue()))
+		}
+		if c.Lt != nil {
+			idQuery = idQuery.Where(user.TypeLT(c.Lt.GetValue()))
+		}
+		if c.Gte != nil {
+			idQuery = idQuery.Where(user.TypeGTE(c.Gte.GetValue()))
+		}
+		if c.Lte != nil {
+			idQuery = idQuery.Where(user.TypeLTE(c.Lte.GetValue()))
+		}
+		if len(c.In) > 0 {
+			idQuery = idQuery.Where(user.TypeIn(c.In...))
+		}
+		if c.Contains != nil {
+			idQuery = idQuery.Where(user.TypeContains(c.Contains.GetValue()))
+		}
+	}
+	if c := filter.UserName; c != nil {
+		if c.Eq != nil {
+			idQuery = idQuery.Where(user.UserNameEQ(c.Eq.GetValue()))
+		}
+		if c.Neq != nil {
+			idQuery = idQuery.Where(user.UserNameNEQ(c.Neq.GetValue()))
+		}
+		if c.Gt != nil {
+			idQuery = idQuery.Where(user.UserNameGT(c.Gt.GetValue()))
+		}
+		if c.Lt != nil {
+			idQuery = idQuery.Where(user.UserNameLT(c.Lt.GetValue()))
+		}
+		if c.Gte != nil {
+			idQuery = idQuery.Where(user.UserNameGTE(c.Gte.GetValue()))
+		}
+		if c.Lte != nil {
+			idQuery = idQuery.Where(user.UserNameLTE(c.Lte.GetValue()))
+		}
+		if len(c.In) > 0 {
+			idQuery = idQuery.Where(user.UserNameIn(c.In...))
+		}
+		if c.Contains != nil {
+			idQuery = idQuery.Where(user.UserNameContains(c.Contains.GetValue()))
+		}
+	}
+	ids, err := idQuery.IDs(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	deleteReqs, err := toDeleteUserRequests(ids)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	for _, req := range deleteReqs {
+		if err := svc.runBeforeDeleteHooks(ctx, req); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+	deleteQuery := tx.User.Delete()
+	deleteQuery = deleteQuery.Where(user.IDIn(ids...))
+	deleted, err := deleteQuery.Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	for _, id := range ids {
+		if err := svc.config.InvalidateCache(ctx, "User", id); err != nil {
+			return nil, err
+		}
+	}
+	for _, req := range deleteReqs {
+		if err := svc.runAfterDeleteHooks(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	return &DeleteUsersResponse{
+		Deleted: int64(deleted),
+	}, nil
+
+}
+
+// List implements UserServiceServer.List
+func (svc *UserService) List(ctx context.Context, req *ListUserRequest) (*ListUserResponse, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.UserService/List", "User")
+	res, err := svc.serveList(ctx, req)
+	if err == nil {
+		res = runtime.Redact(ctx, svc.config, res, userServiceRedactions)
+	}
+	return res, svc.config.RecordRequest("entpb.UserService", "List", start, runtime.EndSpan(span, err))
+}
+
+// serveList serves the List method within its span.
+func (svc *UserService) serveList(ctx context.Context, req *ListUserRequest) (*ListUserResponse, error) {
+	var (
+		err      error
+		entList  []*ent.User
+		pageSize int
+		offset   int
+	)
+	defaultSize, maxSize := svc.config.PageSizes(entproto.MaxPageSize, entproto.MaxPageSize)
 	pageSize = int(req.GetPageSize())
 	switch {
 	case pageSize < 0:
 		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
-	case pageSize == 0 || pageSize > entproto.MaxPageSize:
-		pageSize = entproto.MaxPageSize
+	case pageSize == 0:
+		pageSize = defaultSize
+	case pageSize > maxSize:
+		pageSize = maxSize
+	}
+	svc.config.RecordPageSize("entpb.UserService", "List", pageSize)
+	cursor, err := svc.config.VerifyPageToken("entpb.UserService/List", req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	listQuery := svc.client.User.Query()
+	if filter := req.GetFilter(); filter != nil {
+		if c := filter.AccountBalance; c != nil {
+			if c.Eq != nil {
+				listQuery = listQuery.Where(user.AccountBalanceEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				listQuery = listQuery.Where(user.AccountBalanceNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				listQuery = listQuery.Where(user.AccountBalanceGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				listQuery = listQuery.Where(user.AccountBalanceLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				listQuery = listQuery.Where(user.AccountBalanceGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				listQuery = listQuery.Where(user.AccountBalanceLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				listQuery = listQuery.Where(user.AccountBalanceIn(c.In...))
+			}
+		}
+		if c := filter.BUser_1; c != nil {
+			if c.Eq != nil {
+				listQuery = listQuery.Where(user.BUser1EQ(int(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				listQuery = listQuery.Where(user.BUser1NEQ(int(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				listQuery = listQuery.Where(user.BUser1GT(int(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				listQuery = listQuery.Where(user.BUser1LT(int(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				listQuery = listQuery.Where(user.BUser1GTE(int(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				listQuery = listQuery.Where(user.BUser1LTE(int(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]int, len(c.In))
+				for i := range c.In {
+					vs[i] = int(c.In[i])
+				}
+				listQuery = listQuery.Where(user.BUser1In(vs...))
+			}
+		}
+		if c := filter.Banned; c != nil {
+			if c.Eq != nil {
+				listQuery = listQuery.Where(user.BannedEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				listQuery = listQuery.Where(user.BannedNEQ(c.Neq.GetValue()))
+			}
+		}
+		if c := filter.CreatedAt; c != nil {
+			if c.Eq != nil {
+				listQuery = listQuery.Where(user.CreatedAtEQ(c.Eq.AsTime()))
+			}
+			if c.Neq != nil {
+				listQuery = listQuery.Where(user.CreatedAtNEQ(c.Neq.AsTime()))
+			}
+			if c.Gt != nil {
+				listQuery = listQuery.Where(user.CreatedAtGT(c.Gt.AsTime()))
+			}
+			if c.Lt != nil {
+				listQuery = listQuery.Where(user.CreatedAtLT(c.Lt.AsTime()))
+			}
+			if c.Gte != nil {
+				listQuery = listQuery.Where(user.CreatedAtGTE(c.Gte.AsTime()))
+			}
+			if c.Lte != nil {
+				listQuery = listQuery.Where(user.CreatedAtLTE(c.Lte.AsTime()))
+			}
+		}
+		if c := filter.Exp; c != nil {
+			if c.Eq != nil {
+				listQuery = listQuery.Where(user.ExpEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				listQuery = listQuery.Where(user.ExpNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				listQuery = listQuery.Where(user.ExpGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				listQuery = listQuery.Where(user.ExpLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				listQuery = listQuery.Where(user.ExpGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				listQuery = listQuery.Where(user.ExpLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				listQuery = listQuery.Where(user.ExpIn(c.In...))
+			}
+		}
+		if c := filter.ExternalId; c != nil {
+			if c.Eq != nil {
+				listQuery = listQuery.Where(user.ExternalIDEQ(int(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				listQuery = listQuery.Where(user.ExternalIDNEQ(int(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				listQuery = listQuery.Where(user.ExternalIDGT(int(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				listQuery = listQuery.Where(user.ExternalIDLT(int(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				listQuery = listQuery.Where(user.ExternalIDGTE(int(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				listQuery = listQuery.Where(user.ExternalIDLTE(int(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]int, len(c.In))
+				for i := range c.In {
+					vs[i] = int(c.In[i])
+				}
+				listQuery = listQuery.Where(user.ExternalIDIn(vs...))
+			}
+		}
+		if c := filter.HeightInCm; c != nil {
+			if c.Eq != nil {
+				listQuery = listQuery.Where(user.HeightInCmEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				listQuery = listQuery.Where(user.HeightInCmNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				listQuery = listQuery.Where(user.HeightInCmGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				listQuery = listQuery.Where(user.HeightInCmLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				listQuery = listQuery.Where(user.HeightInCmGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				listQuery = listQuery.Where(user.HeightInCmLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				listQuery = listQuery.Where(user.HeightInCmIn(c.In...))
+			}
+		}
+		if c := filter.Id; c != nil {
+			if c.Eq != nil {
+				listQuery = listQuery.Where(user.IDEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				listQuery = listQuery.Where(user.IDNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				listQuery = listQuery.Where(user.IDGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				listQuery = listQuery.Where(user.IDLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				listQuery = listQuery.Where(user.IDGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				listQuery = listQuery.Where(user.IDLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				listQuery = listQuery.Where(user.IDIn(c.In...))
+			}
+		}
+		if c := filter.Joined; c != nil {
+			if c.Eq != nil {
+				listQuery = listQuery.Where(user.JoinedEQ(c.Eq.AsTime()))
+			}
+			if c.Neq != nil {
+				listQuery = listQuery.Where(user.JoinedNEQ(c.Neq.AsTime()))
+			}
+			if c.Gt != nil {
+				listQuery = listQuery.Where(user.JoinedGT(c.Gt.AsTime()))
+			}
+			if c.Lt != nil {
+				listQuery = listQuery.Where(user.JoinedLT(c.Lt.AsTime()))
+			}
+			if c.Gte != nil {
+				listQuery = listQuery.Where(user.JoinedGTE(c.Gte.AsTime()))
+			}
+			if c.Lte != nil {
+				listQuery = listQuery.Where(user.JoinedLTE(c.Lte.AsTime()))
+			}
+		}
+		if c := filter.OptBool; c != nil {
+			if c.Eq != nil {
+				listQuery = listQuery.Where(user.OptBoolEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				listQuery = listQuery.Where(user.OptBoolNEQ(c.Neq.GetValue()))
+			}
+		}
+		if c := filter.OptNum; c != nil {
+			if err := runtime.CheckRedacted(ctx, svc.config, userServiceRedactions, "entpb.User.opt_num"); err != nil {
+				return nil, err
+			}
+			if c.Eq != nil {
+				listQuery = listQuery.Where(user.OptNumEQ(int(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				listQuery = listQuery.Where(user.OptNumNEQ(int(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				listQuery = listQuery.Where(user.OptNumGT(int(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				listQuery = listQuery.Where(user.OptNumLT(int(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				listQuery = listQuery.Where(user.OptNumGTE(int(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				listQuery = listQuery.Where(user.OptNumLTE(int(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]int, len(c.In))
+				for i := range c.In {
+					vs[i] = int(c.In[i])
+				}
+				listQuery = listQuery.Where(user.OptNumIn(vs...))
+			}
+		}
+		if c := filter.OptStr; c != nil {
+			if c.Eq != nil {
+				listQuery = listQuery.Where(user.OptStrEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				listQuery = listQuery.Where(user.OptStrNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				listQuery = listQuery.Where(user.OptStrGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				listQuery = listQuery.Where(user.OptStrLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				listQuery = listQuery.Where(user.OptStrGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				listQuery = listQuery.Where(user.OptStrLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				listQuery = listQuery.Where(user.OptStrIn(c.In...))
+			}
+			if c.Contains != nil {
+				listQuery = listQuery.Where(user.OptStrContains(c.Contains.GetValue()))
+			}
+		}
+		if c := filter.Points; c != nil {
+			if c.Eq != nil {
+				listQuery = listQuery.Where(user.PointsEQ(uint(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				listQuery = listQuery.Where(user.PointsNEQ(uint(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				listQuery = listQuery.Where(user.PointsGT(uint(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				listQuery = listQuery.Where(user.PointsLT(uint(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				listQuery = listQuery.Where(user.PointsGTE(uint(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				listQuery = listQuery.Where(user.PointsLTE(uint(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]uint, len(c.In))
+				for i := range c.In {
+					vs[i] = uint(c.In[i])
+				}
+				listQuery = listQuery.Where(user.PointsIn(vs...))
+			}
+		}
+		if c := filter.Type; c != nil {
+			if c.Eq != nil {
+				listQuery = listQuery.Where(user.TypeEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				listQuery = listQuery.Where(user.TypeNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				listQuery = listQuery.Where(user.TypeGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				listQuery = listQuery.Where(user.TypeLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				listQuery = listQuery.Where(user.TypeGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				listQuery = listQuery.Where(user.TypeLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				listQuery = listQuery.Where(user.TypeIn(c.In...))
+			}
+			if c.Contains != nil {
+				listQuery = listQuery.Where(user.TypeContains(c.Contains.GetValue()))
+			}
+		}
+		if c := filter.UserName; c != nil {
+			if c.Eq != nil {
+				listQuery = listQuery.Where(user.UserNameEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				listQuery = listQuery.Where(user.UserNameNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				listQuery = listQuery.Where(user.UserNameGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				listQuery = listQuery.Where(user.UserNameLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				listQuery = listQuery.Where(user.UserNameGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				listQuery = listQuery.Where(user.UserNameLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				listQuery = listQuery.Where(user.UserNameIn(c.In...))
+			}
+			if c.Contains != nil {
+				listQuery = listQuery.Where(user.UserNameContains(c.Contains.GetValue()))
+			}
+		}
+	}
+	listQuery = listQuery.Limit(pageSize + 1)
+	if req.GetOrderBy() != "" {
+		terms, err := runtime.ParseOrderBy(req.GetOrderBy())
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.InvalidArgument, "order by is invalid: %s", err)
+		}
+		for _, t := range terms {
+			var field string
+			switch t.Field {
+			case "account_balance":
+				field = user.FieldAccountBalance
+			case "b_user_1":
+				field = user.FieldBUser1
+			case "banned":
+				field = user.FieldBanned
+			case "big_int":
+				field = user.FieldBigInt
+			case "created_at":
+				field = user.FieldCreatedAt
+			case "crm_id":
+				field = user.FieldCrmID
+			case "custom_pb":
+				field = user.FieldCustomPb
+			case "device_type":
+				field = user.FieldDeviceType
+			case "exp":
+				field = user.FieldExp
+			case "external_id":
+				field = user.FieldExternalID
+			case "height_in_cm":
+				field = user.FieldHeightInCm
+			case "id":
+				field = user.FieldID
+			case "joined":
+				field = user.FieldJoined
+			case "omit_prefix":
+				field = user.FieldOmitPrefix
+			case "opt_bool":
+				field = user.FieldOptBool
+			case "opt_num":
+				if err := runtime.CheckRedacted(ctx, svc.config, userServiceRedactions, "entpb.User.opt_num"); err != nil {
+					return nil, err
+				}
+				field = user.FieldOptNum
+			case "opt_str":
+				field = user.FieldOptStr
+			case "points":
+				field = user.FieldPoints
+			case "status":
+				field = user.FieldStatus
+			case "type":
+				field = user.FieldType
+			case "user_name":
+				field = user.FieldUserName
+			default:
+				return nil, status.Errorf(codes.InvalidArgument, "order by field %q is not sortable", t.Field)
+			}
+			if t.Desc {
+				listQuery = listQuery.Order(ent.Desc(field))
+			} else {
+				listQuery = listQuery.Order(ent.Asc(field))
+			}
+		}
+		// The ID breaks the ties between entities, making the pages stable.
+		// Ordered pages are tokenized by their offset.
+		listQuery = listQuery.Order(ent.Asc(user.FieldID))
+		if cursor != "" {
+			bytes, err := base64.StdEncoding.DecodeString(cursor)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, "page token is invalid")
+			}
+			offset, err = strconv.Atoi(string(bytes))
+			if err != nil || offset < 0 {
+				return nil, status.Error(codes.InvalidArgument, "page token is invalid")
+			}
+			listQuery = listQuery.Offset(offset)
+		}
+	} else {
+		// Pages are tokenized by the created_at and the ID of their first entity.
+		listQuery = listQuery.Order(ent.Desc(user.FieldCreatedAt), ent.Desc(user.FieldID))
+		if cursor != "" {
+			var (
+				value time.Time
+				id    uint32
+			)
+			if err := runtime.DecodeCursor(cursor, &value, &id); err != nil {
+				return nil, status.Error(codes.InvalidArgument, "page token is invalid")
+			}
+			listQuery = listQuery.
+				Where(user.Or(
+					user.CreatedAtLT(value),
+					user.And(user.CreatedAtEQ(value), user.IDLTE(id)),
+				))
+		}
+	}
+	if mask := req.GetReadMask(); mask != nil {
+		columns, err := userReadMaskColumns(mask)
+		if err != nil {
+			return nil, err
+		}
+		// The created_at is encoded in the page tokens.
+		columns = append(columns, user.FieldCreatedAt)
+		listQuery.Select(columns...)
+	}
+	runtime.TraceQuery(ctx)
+	switch req.GetView() {
+	case ListUserRequest_VIEW_UNSPECIFIED, ListUserRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListUserRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithAttachment(func(query *ent.AttachmentQuery) {
+				query.Select(attachment.FieldID)
+			}).
+			WithFriends(func(query *ent.UserQuery) {
+				query.Select(user.FieldID)
+			}).
+			WithGroup(func(query *ent.GroupQuery) {
+				query.Select(group.FieldID)
+			}).
+			WithPet(func(query *ent.PetQuery) {
+				query.Select(pet.FieldID)
+			}).
+			WithReceived1(func(query *ent.AttachmentQuery) {
+				query.Select(attachment.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		switch {
+		case len(entList) <= pageSize:
+		case req.GetOrderBy() != "":
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(strconv.Itoa(offset + pageSize)))
+			entList = entList[:len(entList)-1]
+		default:
+			last := entList[len(entList)-1]
+			nextPageToken, err = runtime.EncodeCursor(last.CreatedAt, last.ID)
+			if err != nil {
+				return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+			}
+			entList = entList[:len(entList)-1]
+		}
+		nextPageToken = svc.config.SignPageToken("entpb.UserService/List", nextPageToken)
+		runtime.TraceRows(ctx, len(entList))
+		runtime.TraceConvert(ctx)
+		protoList, err := toProtoUserList(entList)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		return &ListUserResponse{
+			UserList:      protoList,
+			NextPageToken: nextPageToken,
+		}, nil
+	default:
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// StreamUsers implements UserServiceServer.StreamUsers
+func (svc *UserService) StreamUsers(req *StreamUsersRequest, stream UserService_StreamUsersServer) error {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(stream.Context(), "entpb.UserService/StreamUsers", "User")
+	err := svc.serveStreamUsers(req, userServiceStreamUsersStream{UserService_StreamUsersServer: stream, ctx: ctx, config: svc.config})
+	return svc.config.RecordRequest("entpb.UserService", "StreamUsers", start, runtime.EndSpan(span, err))
+}
+
+// userServiceStreamUsersStream overrides the context of the stream of the StreamUsers method with the one holding its span.
+// It redacts the responses of the stream according to the roles of the caller.
+type userServiceStreamUsersStream struct {
+	UserService_StreamUsersServer
+	ctx    context.Context
+	config runtime.Config
+}
+
+// Context returns the context of the stream.
+func (s userServiceStreamUsersStream) Context() context.Context {
+	return s.ctx
+}
+
+// Send sends the response with the fields the caller may not read redacted, see entproto.RedactUnless.
+func (s userServiceStreamUsersStream) Send(res *User) error {
+	return s.UserService_StreamUsersServer.Send(runtime.Redact(s.ctx, s.config, res, userServiceRedactions))
+}
+
+// serveStreamUsers serves the StreamUsers method within its span.
+func (svc *UserService) serveStreamUsers(req *StreamUsersRequest, stream UserService_StreamUsersServer) error {
+	ctx := stream.Context()
+	batchSize := int(req.GetBatchSize())
+	switch {
+	case batchSize < 0:
+		return status.Errorf(codes.InvalidArgument, "batch size cannot be less than zero")
+	case batchSize == 0 || batchSize > entproto.MaxPageSize:
+		batchSize = entproto.MaxPageSize
+	}
+	query := svc.client.User.Query()
+	if filter := req.GetFilter(); filter != nil {
+		if c := filter.AccountBalance; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.AccountBalanceEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.AccountBalanceNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.AccountBalanceGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.AccountBalanceLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.AccountBalanceGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.AccountBalanceLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				query = query.Where(user.AccountBalanceIn(c.In...))
+			}
+		}
+		if c := filter.BUser_1; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.BUser1EQ(int(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.BUser1NEQ(int(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.BUser1GT(int(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.BUser1LT(int(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.BUser1GTE(int(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.BUser1LTE(int(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]int, len(c.In))
+				for i := range c.In {
+					vs[i] = int(c.In[i])
+				}
+				query = query.Where(user.BUser1In(vs...))
+			}
+		}
+		if c := filter.Banned; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.BannedEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.BannedNEQ(c.Neq.GetValue()))
+			}
+		}
+		if c := filter.CreatedAt; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.CreatedAtEQ(c.Eq.AsTime()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.CreatedAtNEQ(c.Neq.AsTime()))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.CreatedAtGT(c.Gt.AsTime()))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.CreatedAtLT(c.Lt.AsTime()))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.CreatedAtGTE(c.Gte.AsTime()))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.CreatedAtLTE(c.Lte.AsTime()))
+			}
+		}
+		if c := filter.Exp; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.ExpEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.ExpNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.ExpGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.ExpLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.ExpGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.ExpLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				query = query.Where(user.ExpIn(c.In...))
+			}
+		}
+		if c := filter.ExternalId; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.ExternalIDEQ(int(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.ExternalIDNEQ(int(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.ExternalIDGT(int(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.ExternalIDLT(int(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.ExternalIDGTE(int(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.ExternalIDLTE(int(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]int, len(c.In))
+				for i := range c.In {
+					vs[i] = int(c.In[i])
+				}
+				query = query.Where(user.ExternalIDIn(vs...))
+			}
+		}
+		if c := filter.HeightInCm; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.HeightInCmEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.HeightInCmNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.HeightInCmGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.HeightInCmLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.HeightInCmGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.HeightInCmLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				query = query.Where(user.HeightInCmIn(c.In...))
+			}
+		}
+		if c := filter.Id; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.IDEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.IDNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.IDGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.IDLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.IDGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.IDLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				query = query.Where(user.IDIn(c.In...))
+			}
+		}
+		if c := filter.Joined; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.JoinedEQ(c.Eq.AsTime()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.JoinedNEQ(c.Neq.AsTime()))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.JoinedGT(c.Gt.AsTime()))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.JoinedLT(c.Lt.AsTime()))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.JoinedGTE(c.Gte.AsTime()))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.JoinedLTE(c.Lte.AsTime()))
+			}
+		}
+		if c := filter.OptBool; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.OptBoolEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.OptBoolNEQ(c.Neq.GetValue()))
+			}
+		}
+		if c := filter.OptNum; c != nil {
+			if err := runtime.CheckRedacted(ctx, svc.config, userServiceRedactions, "entpb.User.opt_num"); err != nil {
+				return err
+			}
+			if c.Eq != nil {
+				query = query.Where(user.OptNumEQ(int(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.OptNumNEQ(int(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.OptNumGT(int(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.OptNumLT(int(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.OptNumGTE(int(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.OptNumLTE(int(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]int, len(c.In))
+				for i := range c.In {
+					vs[i] = int(c.In[i])
+				}
+				query = query.Where(user.OptNumIn(vs...))
+			}
+		}
+		if c := filter.OptStr; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.OptStrEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.OptStrNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.OptStrGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.OptStrLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.OptStrGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.OptStrLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				query = query.Where(user.OptStrIn(c.In...))
+			}
+			if c.Contains != nil {
+				query = query.Where(user.OptStrContains(c.Contains.GetValue()))
+			}
+		}
+		if c := filter.Points; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.PointsEQ(uint(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.PointsNEQ(uint(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.PointsGT(uint(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.PointsLT(uint(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.PointsGTE(uint(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.PointsLTE(uint(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]uint, len(c.In))
+				for i := range c.In {
+					vs[i] = uint(c.In[i])
+				}
+				query = query.Where(user.PointsIn(vs...))
+			}
+		}
+		if c := filter.Type; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.TypeEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.TypeNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.TypeGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.TypeLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.TypeGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.TypeLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				query = query.Where(user.TypeIn(c.In...))
+			}
+			if c.Contains != nil {
+				query = query.Where(user.TypeContains(c.Contains.GetValue()))
+			}
+		}
+		if c := filter.UserName; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.UserNameEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.UserNameNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.UserNameGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.UserNameLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.UserNameGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.UserNameLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				query = query.Where(user.UserNameIn(c.In...))
+			}
+			if c.Contains != nil {
+				query = query.Where(user.UserNameContains(c.Contains.GetValue()))
+			}
+		}
+	}
+	// Batches are iterated with a cursor on the ID of the last sent entity.
+	var last *ent.User
+	for {
+		batchQuery := query.Clone().
+			Order(ent.Asc(user.FieldID)).
+			Limit(batchSize)
+		if last != nil {
+			batchQuery = batchQuery.Where(user.IDGT(last.ID))
+		}
+		entList, err := batchQuery.All(ctx)
+		if err != nil {
+			return svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		for _, e := range entList {
+			protoEntity, err := toProtoUser(e)
+			if err != nil {
+				return svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+			}
+			if err := stream.Send(protoEntity); err != nil {
+				return err
+			}
+		}
+		if len(entList) < batchSize {
+			return nil
+		}
+		last = entList[len(entList)-1]
+	}
+
+}
+
+// Count implements UserServiceServer.Count
+func (svc *UserService) Count(ctx context.Context, req *CountUsersRequest) (*CountUsersResponse, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.UserService/Count", "User")
+	res, err := svc.serveCount(ctx, req)
+	return res, svc.config.RecordRequest("entpb.UserService", "Count", start, runtime.EndSpan(span, err))
+}
+
+// serveCount serves the Count method within its span.
+func (svc *UserService) serveCount(ctx context.Context, req *CountUsersRequest) (*CountUsersResponse, error) {
+	countQuery := svc.client.User.Query()
+	if filter := req.GetFilter(); filter != nil {
+		if c := filter.AccountBalance; c != nil {
+			if c.Eq != nil {
+				countQuery = countQuery.Where(user.AccountBalanceEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				countQuery = countQuery.Where(user.AccountBalanceNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				countQuery = countQuery.Where(user.AccountBalanceGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				countQuery = countQuery.Where(user.AccountBalanceLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				countQuery = countQuery.Where(user.AccountBalanceGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				countQuery = countQuery.Where(user.AccountBalanceLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				countQuery = countQuery.Where(user.AccountBalanceIn(c.In...))
+			}
+		}
+		if c := filter.BUser_1; c != nil {
+			if c.Eq != nil {
+				countQuery = countQuery.Where(user.BUser1EQ(int(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				countQuery = countQuery.Where(user.BUser1NEQ(int(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				countQuery = countQuery.Where(user.BUser1GT(int(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				countQuery = countQuery.Where(user.BUser1LT(int(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				countQuery = countQuery.Where(user.BUser1GTE(int(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				countQuery = countQuery.Where(user.BUser1LTE(int(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]int, len(c.In))
+				for i := range c.In {
+					vs[i] = int(c.In[i])
+				}
+				countQuery = countQuery.Where(user.BUser1In(vs...))
+			}
+		}
+		if c := filter.Banned; c != nil {
+			if c.Eq != nil {
+				countQuery = countQuery.Where(user.BannedEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				countQuery = countQuery.Where(user.BannedNEQ(c.Neq.GetValue()))
+			}
+		}
+		if c := filter.CreatedAt; c != nil {
+			if c.Eq != nil {
+				countQuery = countQuery.Where(user.CreatedAtEQ(c.Eq.AsTime()))
+			}
+			if c.Neq != nil {
+				countQuery = countQuery.Where(user.CreatedAtNEQ(c.Neq.AsTime()))
+			}
+			if c.Gt != nil {
+				countQuery = countQuery.Where(user.CreatedAtGT(c.Gt.AsTime()))
+			}
+			if c.Lt != nil {
+				countQuery = countQuery.Where(user.CreatedAtLT(c.Lt.AsTime()))
+			}
+			if c.Gte != nil {
+				countQuery = countQuery.Where(user.CreatedAtGTE(c.Gte.AsTime()))
+			}
+			if c.Lte != nil {
+				countQuery = countQuery.Where(user.CreatedAtLTE(c.Lte.AsTime()))
+			}
+		}
+		if c := filter.Exp; c != nil {
+			if c.Eq != nil {
+				countQuery = countQuery.Where(user.ExpEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				countQuery = countQuery.Where(user.ExpNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				countQuery = countQuery.Where(user.ExpGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				countQuery = countQuery.Where(user.ExpLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				countQuery = countQuery.Where(user.ExpGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				countQuery = countQuery.Where(user.ExpLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				countQuery = countQuery.Where(user.ExpIn(c.In...))
+			}
+		}
+		if c := filter.ExternalId; c != nil {
+			if c.Eq != nil {
+				countQuery = countQuery.Where(user.ExternalIDEQ(int(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				countQuery = countQuery.Where(user.ExternalIDNEQ(int(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				countQuery = countQuery.Where(user.ExternalIDGT(int(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				countQuery = countQuery.Where(user.ExternalIDLT(int(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				countQuery = countQuery.Where(user.ExternalIDGTE(int(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				countQuery = countQuery.Where(user.ExternalIDLTE(int(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]int, len(c.In))
+				for i := range c.In {
+					vs[i] = int(c.In[i])
+				}
+				countQuery = countQuery.Where(user.ExternalIDIn(vs...))
+			}
+		}
+		if c := filter.HeightInCm; c != nil {
+			if c.Eq != nil {
+				countQuery = countQuery.Where(user.HeightInCmEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				countQuery = countQuery.Where(user.HeightInCmNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				countQuery = countQuery.Where(user.HeightInCmGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				countQuery = countQuery.Where(user.HeightInCmLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				countQuery = countQuery.Where(user.HeightInCmGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				countQuery = countQuery.Where(user.HeightInCmLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				countQuery = countQuery.Where(user.HeightInCmIn(c.In...))
+			}
+		}
+		if c := filter.Id; c != nil {
+			if c.Eq != nil {
+				countQuery = countQuery.Where(user.IDEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				countQuery = countQuery.Where(user.IDNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				countQuery = countQuery.Where(user.IDGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				countQuery = countQuery.Where(user.IDLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				countQuery = countQuery.Where(user.IDGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				countQuery = countQuery.Where(user.IDLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				countQuery = countQuery.Where(user.IDIn(c.In...))
+			}
+		}
+		if c := filter.Joined; c != nil {
+			if c.Eq != nil {
+				countQuery = countQuery.Where(user.JoinedEQ(c.Eq.AsTime()))
+			}
+			if c.Neq != nil {
+				countQuery = countQuery.Where(user.JoinedNEQ(c.Neq.AsTime()))
+			}
+			if c.Gt != nil {
+				countQuery = countQuery.Where(user.JoinedGT(c.Gt.AsTime()))
+			}
+			if c.Lt != nil {
+				countQuery = countQuery.Where(user.JoinedLT(c.Lt.AsTime()))
+			}
+			if c.Gte != nil {
+				countQuery = countQuery.Where(user.JoinedGTE(c.Gte.AsTime()))
+			}
+			if c.Lte != nil {
+				countQuery = countQuery.Where(user.JoinedLTE(c.Lte.AsTime()))
+			}
+		}
+		if c := filter.OptBool; c != nil {
+			if c.Eq != nil {
+				countQuery = countQuery.Where(user.OptBoolEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				countQuery = countQuery.Where(user.OptBoolNEQ(c.Neq.GetValue()))
+			}
+		}
+		if c := filter.OptNum; c != nil {
+			if err := runtime.CheckRedacted(ctx, svc.config, userServiceRedactions, "entpb.User.opt_num"); err != nil {
+				return nil, err
+			}
+			if c.Eq != nil {
+				countQuery = countQuery.Where(user.OptNumEQ(int(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				countQuery = countQuery.Where(user.OptNumNEQ(int(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				countQuery = countQuery.Where(user.OptNumGT(int(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				countQuery = countQuery.Where(user.OptNumLT(int(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				countQuery = countQuery.Where(user.OptNumGTE(int(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				countQuery = countQuery.Where(user.OptNumLTE(int(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]int, len(c.In))
+				for i := range c.In {
+					vs[i] = int(c.In[i])
+				}
+				countQuery = countQuery.Where(user.OptNumIn(vs...))
+			}
+		}
+		if c := filter.OptStr; c != nil {
+			if c.Eq != nil {
+				countQuery = countQuery.Where(user.OptStrEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				countQuery = countQuery.Where(user.OptStrNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				countQuery = countQuery.Where(user.OptStrGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				countQuery = countQuery.Where(user.OptStrLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				countQuery = countQuery.Where(user.OptStrGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				countQuery = countQuery.Where(user.OptStrLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				countQuery = countQuery.Where(user.OptStrIn(c.In...))
+			}
+			if c.Contains != nil {
+				countQuery = countQuery.Where(user.OptStrContains(c.Contains.GetValue()))
+			}
+		}
+		if c := filter.Points; c != nil {
+			if c.Eq != nil {
+				countQuery = countQuery.Where(user.PointsEQ(uint(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				countQuery = countQuery.Where(user.PointsNEQ(uint(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				countQuery = countQuery.Where(user.PointsGT(uint(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				countQuery = countQuery.Where(user.PointsLT(uint(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				countQuery = countQuery.Where(user.PointsGTE(uint(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				countQuery = countQuery.Where(user.PointsLTE(uint(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]uint, len(c.In))
+				for i := range c.In {
+					vs[i] = uint(c.In[i])
+				}
+				countQuery = countQuery.Where(user.PointsIn(vs...))
+			}
+		}
+		if c := filter.Type; c != nil {
+			if c.Eq != nil {
+				countQuery = countQuery.Where(user.TypeEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				countQuery = countQuery.Where(user.TypeNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				countQuery = countQuery.Where(user.TypeGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				countQuery = countQuery.Where(user.TypeLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				countQuery = countQuery.Where(user.TypeGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				countQuery = countQuery.Where(user.TypeLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				countQuery = countQuery.Where(user.TypeIn(c.In...))
+			}
+			if c.Contains != nil {
+				countQuery = countQuery.Where(user.TypeContains(c.Contains.GetValue()))
+			}
+		}
+		if c := filter.UserName; c != nil {
+			if c.Eq != nil {
+				countQuery = countQuery.Where(user.UserNameEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				countQuery = countQuery.Where(user.UserNameNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				countQuery = countQuery.Where(user.UserNameGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				countQuery = countQuery.Where(user.UserNameLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				countQuery = countQuery.Where(user.UserNameGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				countQuery = countQuery.Where(user.UserNameLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				countQuery = countQuery.Where(user.UserNameIn(c.In...))
+			}
+			if c.Contains != nil {
+				countQuery = countQuery.Where(user.UserNameContains(c.Contains.GetValue()))
+			}
+		}
+	}
+	count, err := countQuery.Count(ctx)
+	if err != nil {
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	return &CountUsersResponse{
+		Count: int64(count),
+	}, nil
+
+}
+
+// AggregateUser implements UserServiceServer.AggregateUser
+func (svc *UserService) AggregateUser(ctx context.Context, req *AggregateUserRequest) (*AggregateUserResponse, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.UserService/AggregateUser", "User")
+	res, err := svc.serveAggregateUser(ctx, req)
+	return res, svc.config.RecordRequest("entpb.UserService", "AggregateUser", start, runtime.EndSpan(span, err))
+}
+
+// serveAggregateUser serves the AggregateUser method within its span.
+func (svc *UserService) serveAggregateUser(ctx context.Context, req *AggregateUserRequest) (*AggregateUserResponse, error) {
+	var field string
+	switch req.GetField() {
+	case "":
+	case "account_balance":
+		field = user.FieldAccountBalance
+	case "b_user_1":
+		field = user.FieldBUser1
+	case "exp":
+		field = user.FieldExp
+	case "external_id":
+		field = user.FieldExternalID
+	case "height_in_cm":
+		field = user.FieldHeightInCm
+	case "opt_num":
+		if err := runtime.CheckRedacted(ctx, svc.config, userServiceRedactions, "entpb.User.opt_num"); err != nil {
+			return nil, err
+		}
+		field = user.FieldOptNum
+	case "points":
+		field = user.FieldPoints
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "aggregate field %q is not numeric", req.GetField())
+	}
+	fns := []ent.AggregateFunc{ent.Count()}
+	if field != "" {
+		fns = append(fns, ent.Sum(field), ent.Mean(field), ent.Min(field), ent.Max(field))
+	}
+	query := svc.client.User.Query()
+	if filter := req.GetFilter(); filter != nil {
+		if c := filter.AccountBalance; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.AccountBalanceEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.AccountBalanceNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.AccountBalanceGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.AccountBalanceLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.AccountBalanceGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.AccountBalanceLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				query = query.Where(user.AccountBalanceIn(c.In...))
+			}
+		}
+		if c := filter.BUser_1; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.BUser1EQ(int(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.BUser1NEQ(int(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.BUser1GT(int(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.BUser1LT(int(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.BUser1GTE(int(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.BUser1LTE(int(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]int, len(c.In))
+				for i := range c.In {
+					vs[i] = int(c.In[i])
+				}
+				query = query.Where(user.BUser1In(vs...))
+			}
+		}
+		if c := filter.Banned; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.BannedEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.BannedNEQ(c.Neq.GetValue()))
+			}
+		}
+		if c := filter.CreatedAt; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.CreatedAtEQ(c.Eq.AsTime()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.CreatedAtNEQ(c.Neq.AsTime()))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.CreatedAtGT(c.Gt.AsTime()))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.CreatedAtLT(c.Lt.AsTime()))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.CreatedAtGTE(c.Gte.AsTime()))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.CreatedAtLTE(c.Lte.AsTime()))
+			}
+		}
+		if c := filter.Exp; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.ExpEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.ExpNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.ExpGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.ExpLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.ExpGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.ExpLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				query = query.Where(user.ExpIn(c.In...))
+			}
+		}
+		if c := filter.ExternalId; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.ExternalIDEQ(int(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.ExternalIDNEQ(int(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.ExternalIDGT(int(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.ExternalIDLT(int(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.ExternalIDGTE(int(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.ExternalIDLTE(int(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]int, len(c.In))
+				for i := range c.In {
+					vs[i] = int(c.In[i])
+				}
+				query = query.Where(user.ExternalIDIn(vs...))
+			}
+		}
+		if c := filter.HeightInCm; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.HeightInCmEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.HeightInCmNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.HeightInCmGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.HeightInCmLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.HeightInCmGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.HeightInCmLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				query = query.Where(user.HeightInCmIn(c.In...))
+			}
+		}
+		if c := filter.Id; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.IDEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.IDNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.IDGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.IDLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.IDGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.IDLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				query = query.Where(user.IDIn(c.In...))
+			}
+		}
+		if c := filter.Joined; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.JoinedEQ(c.Eq.AsTime()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.JoinedNEQ(c.Neq.AsTime()))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.JoinedGT(c.Gt.AsTime()))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.JoinedLT(c.Lt.AsTime()))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.JoinedGTE(c.Gte.AsTime()))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.JoinedLTE(c.Lte.AsTime()))
+			}
+		}
+		if c := filter.OptBool; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.OptBoolEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.OptBoolNEQ(c.Neq.GetValue()))
+			}
+		}
+		if c := filter.OptNum; c != nil {
+			if err := runtime.CheckRedacted(ctx, svc.config, userServiceRedactions, "entpb.User.opt_num"); err != nil {
+				return nil, err
+			}
+			if c.Eq != nil {
+				query = query.Where(user.OptNumEQ(int(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.OptNumNEQ(int(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.OptNumGT(int(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.OptNumLT(int(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.OptNumGTE(int(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.OptNumLTE(int(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]int, len(c.In))
+				for i := range c.In {
+					vs[i] = int(c.In[i])
+				}
+				query = query.Where(user.OptNumIn(vs...))
+			}
+		}
+		if c := filter.OptStr; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.OptStrEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.OptStrNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.OptStrGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.OptStrLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.OptStrGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.OptStrLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				query = query.Where(user.OptStrIn(c.In...))
+			}
+			if c.Contains != nil {
+				query = query.Where(user.OptStrContains(c.Contains.GetValue()))
+			}
+		}
+		if c := filter.Points; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.PointsEQ(uint(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.PointsNEQ(uint(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.PointsGT(uint(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.PointsLT(uint(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.PointsGTE(uint(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.PointsLTE(uint(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]uint, len(c.In))
+				for i := range c.In {
+					vs[i] = uint(c.In[i])
+				}
+				query = query.Where(user.PointsIn(vs...))
+			}
+		}
+		if c := filter.Type; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.TypeEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.TypeNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.TypeGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.TypeLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.TypeGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.TypeLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				query = query.Where(user.TypeIn(c.In...))
+			}
+			if c.Contains != nil {
+				query = query.Where(user.TypeContains(c.Contains.GetValue()))
+			}
+		}
+		if c := filter.UserName; c != nil {
+			if c.Eq != nil {
+				query = query.Where(user.UserNameEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				query = query.Where(user.UserNameNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				query = query.Where(user.UserNameGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				query = query.Where(user.UserNameLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				query = query.Where(user.UserNameGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				query = query.Where(user.UserNameLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				query = query.Where(user.UserNameIn(c.In...))
+			}
+			if c.Contains != nil {
+				query = query.Where(user.UserNameContains(c.Contains.GetValue()))
+			}
+		}
+	}
+	toProtoGroup := func(key string, a runtime.Aggregates) *AggregateUserResponse_Group {
+		g := &AggregateUserResponse_Group{Key: key, Count: int64(a.Count)}
+		if a.Sum != nil {
+			g.Sum = wrapperspb.Double(*a.Sum)
+		}
+		if a.Avg != nil {
+			g.Avg = wrapperspb.Double(*a.Avg)
+		}
+		if a.Min != nil {
+			g.Min = wrapperspb.Double(*a.Min)
+		}
+		if a.Max != nil {
+			g.Max = wrapperspb.Double(*a.Max)
+		}
+		return g
+	}
+	res := &AggregateUserResponse{}
+	switch req.GetGroupBy() {
+	case "":
+		var groups []runtime.Aggregates
+		if err := query.Aggregate(fns...).Scan(ctx, &groups); err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		for _, g := range groups {
+			res.Groups = append(res.Groups, toProtoGroup("", g))
+		}
+	case "b_user_1":
+		var groups []struct {
+			Key *int64 `json:"b_user_1"`
+			runtime.Aggregates
+		}
+		err := query.GroupBy(user.FieldBUser1).
+			Aggregate(fns...).
+			Scan(ctx, &groups)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		for _, g := range groups {
+			var key string
+			// NULL values are grouped with an empty key.
+			if g.Key != nil {
+				key = fmt.Sprint(*g.Key)
+			}
+			res.Groups = append(res.Groups, toProtoGroup(key, g.Aggregates))
+		}
+	case "banned":
+		var groups []struct {
+			Key *bool `json:"banned"`
+			runtime.Aggregates
+		}
+		err := query.GroupBy(user.FieldBanned).
+			Aggregate(fns...).
+			Scan(ctx, &groups)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		for _, g := range groups {
+			var key string
+			// NULL values are grouped with an empty key.
+			if g.Key != nil {
+				key = fmt.Sprint(*g.Key)
+			}
+			res.Groups = append(res.Groups, toProtoGroup(key, g.Aggregates))
+		}
+	case "device_type":
+		var groups []struct {
+			Key *string `json:"device_type"`
+			runtime.Aggregates
+		}
+		err := query.GroupBy(user.FieldDeviceType).
+			Aggregate(fns...).
+			Scan(ctx, &groups)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		for _, g := range groups {
+			var key string
+			// NULL values are grouped with an empty key.
+			if g.Key != nil {
+				key = fmt.Sprint(*g.Key)
+			}
+			res.Groups = append(res.Groups, toProtoGroup(key, g.Aggregates))
+		}
+	case "exp":
+		var groups []struct {
+			Key *int64 `json:"exp"`
+			runtime.Aggregates
+		}
+		err := query.GroupBy(user.FieldExp).
+			Aggregate(fns...).
+			Scan(ctx, &groups)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		for _, g := range groups {
+			var key string
+			// NULL values are grouped with an empty key.
+			if g.Key != nil {
+				key = fmt.Sprint(*g.Key)
+			}
+			res.Groups = append(res.Groups, toProtoGroup(key, g.Aggregates))
+		}
+	case "external_id":
+		var groups []struct {
+			Key *int64 `json:"external_id"`
+			runtime.Aggregates
+		}
+		err := query.GroupBy(user.FieldExternalID).
+			Aggregate(fns...).
+			Scan(ctx, &groups)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		for _, g := range groups {
+			var key string
+			// NULL values are grouped with an empty key.
+			if g.Key != nil {
+				key = fmt.Sprint(*g.Key)
+			}
+			res.Groups = append(res.Groups, toProtoGroup(key, g.Aggregates))
+		}
+	case "omit_prefix":
+		var groups []struct {
+			Key *string `json:"omit_prefix"`
+			runtime.Aggregates
+		}
+		err := query.GroupBy(user.FieldOmitPrefix).
+			Aggregate(fns...).
+			Scan(ctx, &groups)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		for _, g := range groups {
+			var key string
+			// NULL values are grouped with an empty key.
+			if g.Key != nil {
+				key = fmt.Sprint(*g.Key)
+			}
+			res.Groups = append(res.Groups, toProtoGroup(key, g.Aggregates))
+		}
+	case "opt_bool":
+		var groups []struct {
+			Key *bool `json:"opt_bool"`
+			runtime.Aggregates
+		}
+		err := query.GroupBy(user.FieldOptBool).
+			Aggregate(fns...).
+			Scan(ctx, &groups)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		for _, g := range groups {
+			var key string
+			// NULL values are grouped with an empty key.
+			if g.Key != nil {
+				key = fmt.Sprint(*g.Key)
+			}
+			res.Groups = append(res.Groups, toProtoGroup(key, g.Aggregates))
+		}
+	case "opt_num":
+		if err := runtime.CheckRedacted(ctx, svc.config, userServiceRedactions, "entpb.User.opt_num"); err != nil {
+			return nil, err
+		}
+		var groups []struct {
+			Key *int64 `json:"opt_num"`
+			runtime.Aggregates
+		}
+		err := query.GroupBy(user.FieldOptNum).
+			Aggregate(fns...).
+			Scan(ctx, &groups)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		for _, g := range groups {
+			var key string
+			// NULL values are grouped with an empty key.
+			if g.Key != nil {
+				key = fmt.Sprint(*g.Key)
+			}
+			res.Groups = append(res.Groups, toProtoGroup(key, g.Aggregates))
+		}
+	case "opt_str":
+		var groups []struct {
+			Key *string `json:"opt_str"`
+			runtime.Aggregates
+		}
+		err := query.GroupBy(user.FieldOptStr).
+			Aggregate(fns...).
+			Scan(ctx, &groups)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		for _, g := range groups {
+			var key string
+			// NULL values are grouped with an empty key.
+			if g.Key != nil {
+				key = fmt.Sprint(*g.Key)
+			}
+			res.Groups = append(res.Groups, toProtoGroup(key, g.Aggregates))
+		}
+	case "points":
+		var groups []struct {
+			Key *int64 `json:"points"`
+			runtime.Aggregates
+		}
+		err := query.GroupBy(user.FieldPoints).
+			Aggregate(fns...).
+			Scan(ctx, &groups)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		for _, g := range groups {
+			var key string
+			// NULL values are grouped with an empty key.
+			if g.Key != nil {
+				key = fmt.Sprint(*g.Key)
+			}
+			res.Groups = append(res.Groups, toProtoGroup(key, g.Aggregates))
+		}
+	case "status":
+		var groups []struct {
+			Key *string `json:"status"`
+			runtime.Aggregates
+		}
+		err := query.GroupBy(user.FieldStatus).
+			Aggregate(fns...).
+			Scan(ctx, &groups)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		for _, g := range groups {
+			var key string
+			// NULL values are grouped with an empty key.
+			if g.Key != nil {
+				key = fmt.Sprint(*g.Key)
+			}
+			res.Groups = append(res.Groups, toProtoGroup(key, g.Aggregates))
+		}
+	case "type":
+		var groups []struct {
+			Key *string `json:"type"`
+			runtime.Aggregates
+		}
+		err := query.GroupBy(user.FieldType).
+			Aggregate(fns...).
+			Scan(ctx, &groups)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		for _, g := range groups {
+			var key string
+			// NULL values are grouped with an empty key.
+			if g.Key != nil {
+				key = fmt.Sprint(*g.Key)
+			}
+			res.Groups = append(res.Groups, toProtoGroup(key, g.Aggregates))
+		}
+	case "user_name":
+		var groups []struct {
+			Key *string `json:"user_name"`
+			runtime.Aggregates
+		}
+		err := query.GroupBy(user.FieldUserName).
+			Aggregate(fns...).
+			Scan(ctx, &groups)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		for _, g := range groups {
+			var key string
+			// NULL values are grouped with an empty key.
+			if g.Key != nil {
+				key = fmt.Sprint(*g.Key)
+			}
+			res.Groups = append(res.Groups, toProtoGroup(key, g.Aggregates))
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "group by field %q is not groupable", req.GetGroupBy())
+	}
+	return res, nil
+
+}
+
+// BatchCreate implements UserServiceServer.BatchCreate
+func (svc *UserService) BatchCreate(ctx context.Context, req *BatchCreateUsersRequest) (*BatchCreateUsersResponse, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.UserService/BatchCreate", "User")
+	if err := svc.config.Writable("UserService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.UserService", "BatchCreate", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveBatchCreate(ctx, req)
+	if err == nil {
+		res = runtime.Redact(ctx, svc.config, res, userServiceRedactions)
+	}
+	return res, svc.config.RecordRequest("entpb.UserService", "BatchCreate", start, runtime.EndSpan(span, err))
+}
+
+// serveBatchCreate serves the BatchCreate method within its span.
+func (svc *UserService) serveBatchCreate(ctx context.Context, req *BatchCreateUsersRequest) (*BatchCreateUsersResponse, error) {
+	requests := req.GetRequests()
+	svc.config.RecordBatchSize("entpb.UserService", "BatchCreate", len(requests))
+	maxSize := svc.config.BatchSize(entproto.MaxBatchCreateSize)
+	if len(requests) > maxSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", maxSize)
+	}
+	bulk := make([]*ent.UserCreate, len(requests))
+	for i, req := range requests {
+		user := req.GetUser()
+		var err error
+		bulk[i], err = svc.createBuilder(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+		if err := svc.runBeforeCreateHooks(ctx, req, bulk[i]); err != nil {
+			return nil, err
+		}
+	}
+	runtime.TraceQuery(ctx)
+	res, err := svc.client.User.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		runtime.TraceRows(ctx, len(res))
+		runtime.TraceConvert(ctx)
+		protoList, err := toProtoUserList(res)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		for i, req := range requests {
+			if err := svc.runAfterCreateHooks(ctx, req, protoList[i]); err != nil {
+				return nil, err
+			}
+		}
+		return &BatchCreateUsersResponse{
+			Users: protoList,
+		}, nil
+	case ent.IsValidationError(err):
+		return nil, userValidationError(err, "user.")
+	case ent.IsConstraintError(err):
+		return nil, svc.config.ConstraintError(err, userConstraintField(err, "user."))
+	default:
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Upsert implements UserServiceServer.Upsert
+func (svc *UserService) Upsert(ctx context.Context, req *UpsertUserRequest) (*User, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.UserService/Upsert", "User")
+	if err := svc.config.Writable("UserService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.UserService", "Upsert", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveUpsert(ctx, req)
+	if err == nil {
+		res = runtime.Redact(ctx, svc.config, res, userServiceRedactions)
+	}
+	return res, svc.config.RecordRequest("entpb.UserService", "Upsert", start, runtime.EndSpan(span, err))
+}
+
+// serveUpsert serves the Upsert method within its span.
+func (svc *UserService) serveUpsert(ctx context.Context, req *UpsertUserRequest) (*User, error) {
+	m, err := svc.createBuilder(ctx, req.GetUser())
+	if err != nil {
+		return nil, err
+	}
+	// The Create hooks are run with the request creating the entity.
+	createReq := &CreateUserRequest{
+		User: req.GetUser(),
+	}
+	if err := svc.runBeforeCreateHooks(ctx, createReq, m); err != nil {
+		return nil, err
+	}
+	// The entity is loaded by its key after the upsert, as not all dialects report the ID of an updated row.
+	keyUserName, _ := m.Mutation().UserName()
+	err = m.
+		OnConflictColumns(user.FieldUserName).
+		UpdateNewValues().
+		Exec(ctx)
+	switch {
+	case err == nil:
+	case ent.IsValidationError(err):
+		return nil, userValidationError(err, "user.")
+	case ent.IsConstraintError(err):
+		return nil, svc.config.ConstraintError(err, userConstraintField(err, "user."))
+	default:
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	res, err := svc.client.User.Query().
+		Where(
+			user.UserNameEQ(keyUserName),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	if err := svc.config.InvalidateCache(ctx, "User", res.ID); err != nil {
+		return nil, err
+	}
+	protoEntity, err := toProtoUser(res)
+	if err != nil {
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	if err := svc.runAfterCreateHooks(ctx, createReq, protoEntity); err != nil {
+		return nil, err
+	}
+	return protoEntity, nil
+
+}
+
+// BatchGet implements UserServiceServer.BatchGet
+func (svc *UserService) BatchGet(ctx context.Context, req *BatchGetUsersRequest) (*BatchGetUsersResponse, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.UserService/BatchGet", "User")
+	res, err := svc.serveBatchGet(ctx, req)
+	if err == nil {
+		res = runtime.Redact(ctx, svc.config, res, userServiceRedactions)
+	}
+	return res, svc.config.RecordRequest("entpb.UserService", "BatchGet", start, runtime.EndSpan(span, err))
+}
+
+// serveBatchGet serves the BatchGet method within its span.
+func (svc *UserService) serveBatchGet(ctx context.Context, req *BatchGetUsersRequest) (*BatchGetUsersResponse, error) {
+	ids := req.GetIds()
+	svc.config.RecordBatchSize("entpb.UserService", "BatchGet", len(ids))
+	maxSize := svc.config.BatchSize(entproto.MaxBatchGetSize)
+	if len(ids) > maxSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", maxSize)
+	}
+	entIDs := make([]uint32, len(ids))
+	for i, item := range ids {
+		id := uint32(item)
+		entIDs[i] = id
+	}
+	query := svc.client.User.Query().
+		Where(user.IDIn(entIDs...))
+	switch req.GetView() {
+	case BatchGetUsersRequest_VIEW_UNSPECIFIED, BatchGetUsersRequest_BASIC:
+	case BatchGetUsersRequest_WITH_EDGE_IDS:
+		query.WithAttachment(func(query *ent.AttachmentQuery) {
+			query.Select(attachment.FieldID)
+		})
+		query.WithFriends(func(query *ent.UserQuery) {
+			query.Select(user.FieldID)
+		})
+		query.WithGroup(func(query *ent.GroupQuery) {
+			query.Select(group.FieldID)
+		})
+		query.WithPet(func(query *ent.PetQuery) {
+			query.Select(pet.FieldID)
+		})
+		query.WithReceived1(func(query *ent.AttachmentQuery) {
+			query.Select(attachment.FieldID)
+		})
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	runtime.TraceQuery(ctx)
+	entList, err := query.All(ctx)
+	if err != nil {
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	// Entities are returned in the order of the requested IDs, and the IDs that were not found are
+	// reported in the missing_ids field.
+	found := make(map[uint32]*ent.User, len(entList))
+	for _, e := range entList {
+		found[e.ID] = e
+	}
+	res := &BatchGetUsersResponse{}
+	entList = entList[:0]
+	for i, id := range entIDs {
+		e, ok := found[id]
+		if !ok {
+			res.MissingIds = append(res.MissingIds, ids[i])
+			continue
+		}
+		entList = append(entList, e)
+	}
+	runtime.TraceRows(ctx, len(entList))
+	runtime.TraceConvert(ctx)
+	res.Users, err = toProtoUserList(entList)
+	if err != nil {
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	return res, nil
+
+}
+
+// BatchUpdate implements UserServiceServer.BatchUpdate
+func (svc *UserService) BatchUpdate(ctx context.Context, req *BatchUpdateUsersRequest) (*BatchUpdateUsersResponse, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.UserService/BatchUpdate", "User")
+	if err := svc.config.Writable("UserService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.UserService", "BatchUpdate", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveBatchUpdate(ctx, req)
+	if err == nil {
+		res = runtime.Redact(ctx, svc.config, res, userServiceRedactions)
+	}
+	return res, svc.config.RecordRequest("entpb.UserService", "BatchUpdate", start, runtime.EndSpan(span, err))
+}
+
+// serveBatchUpdate serves the BatchUpdate method within its span.
+func (svc *UserService) serveBatchUpdate(ctx context.Context, req *BatchUpdateUsersRequest) (*BatchUpdateUsersResponse, error) {
+	requests := req.GetRequests()
+	svc.config.RecordBatchSize("entpb.UserService", "BatchUpdate", len(requests))
+	maxSize := svc.config.BatchSize(entproto.MaxBatchUpdateSize)
+	if len(requests) > maxSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", maxSize)
+	}
+	runtime.TraceQuery(ctx)
+	tx, err := svc.client.Tx(ctx)
+	if err != nil {
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	// Entities are updated in a single transaction, so a failing request rolls back the whole batch.
+	res := make([]*ent.User, len(requests))
+	for i, req := range requests {
+		user := req.GetUser()
+		m, err := svc.updateBuilder(ctx, tx.Client(), user, req.GetUpdateMask())
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		if err := svc.runBeforeUpdateHooks(ctx, req, m); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		if res[i], err = m.Save(ctx); err != nil {
+			_ = tx.Rollback()
+			switch {
+			case ent.IsNotFound(err):
+				return nil, svc.config.MapError(err, codes.NotFound, "not found: %s", err)
+			case ent.IsValidationError(err):
+				return nil, userValidationError(err, fmt.Sprintf("requests[%d].user.", i))
+			case ent.IsConstraintError(err):
+				return nil, svc.config.ConstraintError(err, userConstraintField(err, fmt.Sprintf("requests[%d].user.", i)))
+			default:
+				return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	for _, e := range res {
+		if err := svc.config.InvalidateCache(ctx, "User", e.ID); err != nil {
+			return nil, err
+		}
+	}
+	runtime.TraceRows(ctx, len(res))
+	runtime.TraceConvert(ctx)
+	protoList, err := toProtoUserList(res)
+	if err != nil {
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	for i, req := range requests {
+		if err := svc.runAfterUpdateHooks(ctx, req, protoList[i]); err != nil {
+			return nil, err
+		}
+	}
+	return &BatchUpdateUsersResponse{
+		Users: protoList,
+	}, nil
+
+}
+
+// BatchDelete implements UserServiceServer.BatchDelete
+func (svc *UserService) BatchDelete(ctx context.Context, req *BatchDeleteUsersRequest) (*emptypb.Empty, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.UserService/BatchDelete", "User")
+	if err := svc.config.Writable("UserService"); err != nil {
+		return nil, svc.config.RecordRequest("entpb.UserService", "BatchDelete", start, runtime.EndSpan(span, err))
+	}
+	res, err := svc.serveBatchDelete(ctx, req)
+	return res, svc.config.RecordRequest("entpb.UserService", "BatchDelete", start, runtime.EndSpan(span, err))
+}
+
+// serveBatchDelete serves the BatchDelete method within its span.
+func (svc *UserService) serveBatchDelete(ctx context.Context, req *BatchDeleteUsersRequest) (*emptypb.Empty, error) {
+	ids := req.GetIds()
+	svc.config.RecordBatchSize("entpb.UserService", "BatchDelete", len(ids))
+	maxSize := svc.config.BatchSize(entproto.MaxBatchDeleteSize)
+	if len(ids) > maxSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", maxSize)
+	}
+	// Reject requests without IDs or comparisons, as they would delete all entities.
+	if len(ids) == 0 && proto.Size(req.GetFilter()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: ids or filter must be set")
+	}
+	if req.GetFilter().GetOptNum() != nil {
+		if err := runtime.CheckRedacted(ctx, svc.config, userServiceRedactions, "entpb.User.opt_num"); err != nil {
+			return nil, err
+		}
+	}
+	entIDs := make([]uint32, len(ids))
+	for i, item := range ids {
+		id := uint32(item)
+		entIDs[i] = id
+	}
+	tx, err := svc.client.Tx(ctx)
+	if err != nil {
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	// The IDs of the entries are looked up in the transaction deleting them, so the ones matching the filter
+	// are removed from the cache of the service as well.
+	idQuery := tx.User.Query()
+	if len(entIDs) > 0 {
+		idQuery = idQuery.Where(user.IDIn(entIDs...))
+	}
+	if filter := req.GetFilter(); filter != nil {
+		if c := filter.AccountBalance; c != nil {
+			if c.Eq != nil {
+				idQuery = idQuery.Where(user.AccountBalanceEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				idQuery = idQuery.Where(user.AccountBalanceNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				idQuery = idQuery.Where(user.AccountBalanceGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				idQuery = idQuery.Where(user.AccountBalanceLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				idQuery = idQuery.Where(user.AccountBalanceGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				idQuery = idQuery.Where(user.AccountBalanceLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				idQuery = idQuery.Where(user.AccountBalanceIn(c.In...))
+			}
+		}
+		if c := filter.BUser_1; c != nil {
+			if c.Eq != nil {
+				idQuery = idQuery.Where(user.BUser1EQ(int(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				idQuery = idQuery.Where(user.BUser1NEQ(int(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				idQuery = idQuery.Where(user.BUser1GT(int(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				idQuery = idQuery.Where(user.BUser1LT(int(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				idQuery = idQuery.Where(user.BUser1GTE(int(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				idQuery = idQuery.Where(user.BUser1LTE(int(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]int, len(c.In))
+				for i := range c.In {
+					vs[i] = int(c.In[i])
+				}
+				idQuery = idQuery.Where(user.BUser1In(vs...))
+			}
+		}
+		if c := filter.Banned; c != nil {
+			if c.Eq != nil {
+				idQuery = idQuery.Where(user.BannedEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				idQuery = idQuery.Where(user.BannedNEQ(c.Neq.GetValue()))
+			}
+		}
+		if c := filter.CreatedAt; c != nil {
+			if c.Eq != nil {
+				idQuery = idQuery.Where(user.CreatedAtEQ(c.Eq.AsTime()))
+			}
+			if c.Neq != nil {
+				idQuery = idQuery.Where(user.CreatedAtNEQ(c.Neq.AsTime()))
+			}
+			if c.Gt != nil {
+				idQuery = idQuery.Where(user.CreatedAtGT(c.Gt.AsTime()))
+			}
+			if c.Lt != nil {
+				idQuery = idQuery.Where(user.CreatedAtLT(c.Lt.AsTime()))
+			}
+			if c.Gte != nil {
+				idQuery = idQuery.Where(user.CreatedAtGTE(c.Gte.AsTime()))
+			}
+			if c.Lte != nil {
+				idQuery = idQuery.Where(user.CreatedAtLTE(c.Lte.AsTime()))
+			}
+		}
+		if c := filter.Exp; c != nil {
+			if c.Eq != nil {
+				idQuery = idQuery.Where(user.ExpEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				idQuery = idQuery.Where(user.ExpNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				idQuery = idQuery.Where(user.ExpGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				idQuery = idQuery.Where(user.ExpLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				idQuery = idQuery.Where(user.ExpGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				idQuery = idQuery.Where(user.ExpLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				idQuery = idQuery.Where(user.ExpIn(c.In...))
+			}
+		}
+		if c := filter.ExternalId; c != nil {
+			if c.Eq != nil {
+				idQuery = idQuery.Where(user.ExternalIDEQ(int(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				idQuery = idQuery.Where(user.ExternalIDNEQ(int(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				idQuery = idQuery.Where(user.ExternalIDGT(int(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				idQuery = idQuery.Where(user.ExternalIDLT(int(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				idQuery = idQuery.Where(user.ExternalIDGTE(int(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				idQuery = idQuery.Where(user.ExternalIDLTE(int(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]int, len(c.In))
+				for i := range c.In {
+					vs[i] = int(c.In[i])
+				}
+				idQuery = idQuery.Where(user.ExternalIDIn(vs...))
+			}
+		}
+		if c := filter.HeightInCm; c != nil {
+			if c.Eq != nil {
+				idQuery = idQuery.Where(user.HeightInCmEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				idQuery = idQuery.Where(user.HeightInCmNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				idQuery = idQuery.Where(user.HeightInCmGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				idQuery = idQuery.Where(user.HeightInCmLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				idQuery = idQuery.Where(user.HeightInCmGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				idQuery = idQuery.Where(user.HeightInCmLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				idQuery = idQuery.Where(user.HeightInCmIn(c.In...))
+			}
+		}
+		if c := filter.Id; c != nil {
+			if c.Eq != nil {
+				idQuery = idQuery.Where(user.IDEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				idQuery = idQuery.Where(user.IDNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				idQuery = idQuery.Where(user.IDGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				idQuery = idQuery.Where(user.IDLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				idQuery = idQuery.Where(user.IDGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				idQuery = idQuery.Where(user.IDLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				idQuery = idQuery.Where(user.IDIn(c.In...))
+			}
+		}
+		if c := filter.Joined; c != nil {
+			if c.Eq != nil {
+				idQuery = idQuery.Where(user.JoinedEQ(c.Eq.AsTime()))
+			}
+			if c.Neq != nil {
+				idQuery = idQuery.Where(user.JoinedNEQ(c.Neq.AsTime()))
+			}
+			if c.Gt != nil {
+				idQuery = idQuery.Where(user.JoinedGT(c.Gt.AsTime()))
+			}
+			if c.Lt != nil {
+				idQuery = idQuery.Where(user.JoinedLT(c.Lt.AsTime()))
+			}
+			if c.Gte != nil {
+				idQuery = idQuery.Where(user.JoinedGTE(c.Gte.AsTime()))
+			}
+			if c.Lte != nil {
+				idQuery = idQuery.Where(user.JoinedLTE(c.Lte.AsTime()))
+			}
+		}
+		if c := filter.OptBool; c != nil {
+			if c.Eq != nil {
+				idQuery = idQuery.Where(user.OptBoolEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				idQuery = idQuery.Where(user.OptBoolNEQ(c.Neq.GetValue()))
+			}
+		}
+		if c := filter.OptNum; c != nil {
+			if c.Eq != nil {
+				idQuery = idQuery.Where(user.OptNumEQ(int(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				idQuery = idQuery.Where(user.OptNumNEQ(int(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				idQuery = idQuery.Where(user.OptNumGT(int(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				idQuery = idQuery.Where(user.OptNumLT(int(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				idQuery = idQuery.Where(user.OptNumGTE(int(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				idQuery = idQuery.Where(user.OptNumLTE(int(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]int, len(c.In))
+				for i := range c.In {
+					vs[i] = int(c.In[i])
+				}
+				idQuery = idQuery.Where(user.OptNumIn(vs...))
+			}
+		}
+		if c := filter.OptStr; c != nil {
+			if c.Eq != nil {
+				idQuery = idQuery.Where(user.OptStrEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				idQuery = idQuery.Where(user.OptStrNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				idQuery = idQuery.Where(user.OptStrGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				idQuery = idQuery.Where(user.OptStrLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				idQuery = idQuery.Where(user.OptStrGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				idQuery = idQuery.Where(user.OptStrLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				idQuery = idQuery.Where(user.OptStrIn(c.In...))
+			}
+			if c.Contains != nil {
+				idQuery = idQuery.Where(user.OptStrContains(c.Contains.GetValue()))
+			}
+		}
+		if c := filter.Points; c != nil {
+			if c.Eq != nil {
+				idQuery = idQuery.Where(user.PointsEQ(uint(c.Eq.GetValue())))
+			}
+			if c.Neq != nil {
+				idQuery = idQuery.Where(user.PointsNEQ(uint(c.Neq.GetValue())))
+			}
+			if c.Gt != nil {
+				idQuery = idQuery.Where(user.PointsGT(uint(c.Gt.GetValue())))
+			}
+			if c.Lt != nil {
+				idQuery = idQuery.Where(user.PointsLT(uint(c.Lt.GetValue())))
+			}
+			if c.Gte != nil {
+				idQuery = idQuery.Where(user.PointsGTE(uint(c.Gte.GetValue())))
+			}
+			if c.Lte != nil {
+				idQuery = idQuery.Where(user.PointsLTE(uint(c.Lte.GetValue())))
+			}
+			if len(c.In) > 0 {
+				vs := make([]uint, len(c.In))
+				for i := range c.In {
+					vs[i] = uint(c.In[i])
+				}
+				idQuery = idQuery.Where(user.PointsIn(vs...))
+			}
+		}
+		if c := filter.Type; c != nil {
+			if c.Eq != nil {
+				idQuery = idQuery.Where(user.TypeEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				idQuery = idQuery.Where(user.TypeNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				idQuery = idQuery.Where(user.TypeGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				idQuery = idQuery.Where(user.TypeLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				idQuery = idQuery.Where(user.TypeGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				idQuery = idQuery.Where(user.TypeLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				idQuery = idQuery.Where(user.TypeIn(c.In...))
+			}
+			if c.Contains != nil {
+				idQuery = idQuery.Where(user.TypeContains(c.Contains.GetValue()))
+			}
+		}
+		if c := filter.UserName; c != nil {
+			if c.Eq != nil {
+				idQuery = idQuery.Where(user.UserNameEQ(c.Eq.GetValue()))
+			}
+			if c.Neq != nil {
+				idQuery = idQuery.Where(user.UserNameNEQ(c.Neq.GetValue()))
+			}
+			if c.Gt != nil {
+				idQuery = idQuery.Where(user.UserNameGT(c.Gt.GetValue()))
+			}
+			if c.Lt != nil {
+				idQuery = idQuery.Where(user.UserNameLT(c.Lt.GetValue()))
+			}
+			if c.Gte != nil {
+				idQuery = idQuery.Where(user.UserNameGTE(c.Gte.GetValue()))
+			}
+			if c.Lte != nil {
+				idQuery = idQuery.Where(user.UserNameLTE(c.Lte.GetValue()))
+			}
+			if len(c.In) > 0 {
+				idQuery = idQuery.Where(user.UserNameIn(c.In...))
+			}
+			if c.Contains != nil {
+				idQuery = idQuery.Where(user.UserNameContains(c.Contains.GetValue()))
+			}
+		}
+	}
+	deletedIDs, err := idQuery.IDs(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	deleteReqs, err := toDeleteUserRequests(deletedIDs)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	for _, req := range deleteReqs {
+		if err := svc.runBeforeDeleteHooks(ctx, req); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+	deleteQuery := tx.User.Delete()
+	deleteQuery = deleteQuery.Where(user.IDIn(deletedIDs...))
+	runtime.TraceQuery(ctx)
+	n, err := deleteQuery.Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	for _, id := range deletedIDs {
+		if err := svc.config.InvalidateCache(ctx, "User", id); err != nil {
+			return nil, err
+		}
+	}
+	for _, req := range deleteReqs {
+		if err := svc.runAfterDeleteHooks(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	runtime.TraceRows(ctx, n)
+	return &emptypb.Empty{}, nil
+
+}
+
+// Stats implements UserServiceServer.Stats
+func (svc *UserService) Stats(ctx context.Context, req *StatsUserRequest) (*StatsUserResponse, error) {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(ctx, "entpb.UserService/Stats", "User")
+	res, err := svc.serveStats(ctx, req)
+	return res, svc.config.RecordRequest("entpb.UserService", "Stats", start, runtime.EndSpan(span, err))
+}
+
+// serveStats serves the Stats method within its span.
+func (svc *UserService) serveStats(ctx context.Context, req *StatsUserRequest) (*StatsUserResponse, error) {
+	count, err := svc.client.User.Query().Count(ctx)
+	if err != nil {
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+	res := &StatsUserResponse{
+		Count: int64(count),
 	}
-	listQuery := svc.client.User.Query().
-		Order(ent.Desc(user.FieldID)).
-		Limit(pageSize + 1)
-	if req.GetPageToken() != "" {
-		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+	{
+		var groups []struct {
+			Value *bool `json:"banned"`
+			Count int   `json:"count"`
+		}
+		err := svc.client.User.Query().
+			GroupBy(user.FieldBanned).
+			Aggregate(ent.Count()).
+			Scan(ctx, &groups)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		stats := &StatsUserResponse_FieldStats{Field: "banned"}
+		for _, g := range groups {
+			vc := &StatsUserResponse_ValueCount{Count: int64(g.Count)}
+			// NULL values are counted with an empty value.
+			if g.Value != nil {
+				vc.Value = fmt.Sprint(*g.Value)
+			}
+			stats.Values = append(stats.Values, vc)
+		}
+		res.Fields = append(res.Fields, stats)
+	}
+	{
+		var groups []struct {
+			Value *string `json:"device_type"`
+			Count int     `json:"count"`
 		}
-		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		err := svc.client.User.Query().
+			GroupBy(user.FieldDeviceType).
+			Aggregate(ent.Count()).
+			Scan(ctx, &groups)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 		}
-		pageToken := uint32(token)
-		listQuery = listQuery.
-			Where(user.IDLTE(pageToken))
+		stats := &StatsUserResponse_FieldStats{Field: "device_type"}
+		for _, g := range groups {
+			vc := &StatsUserResponse_ValueCount{Count: int64(g.Count)}
+			// NULL values are counted with an empty value.
+			if g.Value != nil {
+				vc.Value = fmt.Sprint(*g.Value)
+			}
+			stats.Values = append(stats.Values, vc)
+		}
+		res.Fields = append(res.Fields, stats)
 	}
-	switch req.GetView() {
-	case ListUserRequest_VIEW_UNSPECIFIED, ListUserRequest_BASIC:
-		entList, err = listQuery.All(ctx)
-	case ListUserRequest_WITH_EDGE_IDS:
-		entList, err = listQuery.
-			WithAttachment(func(query *ent.AttachmentQuery) {
-				query.Select(attachment.FieldID)
-			}).
-			WithGroup(func(query *ent.GroupQuery) {
-				query.Select(group.FieldID)
-			}).
-			WithPet(func(query *ent.PetQuery) {
-				query.Select(pet.FieldID)
-			}).
-			WithReceived1(func(query *ent.AttachmentQuery) {
-				query.Select(attachment.FieldID)
-			}).
-			All(ctx)
+	{
+		var groups []struct {
+			Value *string `json:"omit_prefix"`
+			Count int     `json:"count"`
+		}
+		err := svc.client.User.Query().
+			GroupBy(user.FieldOmitPrefix).
+			Aggregate(ent.Count()).
+			Scan(ctx, &groups)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		stats := &StatsUserResponse_FieldStats{Field: "omit_prefix"}
+		for _, g := range groups {
+			vc := &StatsUserResponse_ValueCount{Count: int64(g.Count)}
+			// NULL values are counted with an empty value.
+			if g.Value != nil {
+				vc.Value = fmt.Sprint(*g.Value)
+			}
+			stats.Values = append(stats.Values, vc)
+		}
+		res.Fields = append(res.Fields, stats)
+	}
+	{
+		var groups []struct {
+			Value *bool `json:"opt_bool"`
+			Count int   `json:"count"`
+		}
+		err := svc.client.User.Query().
+			GroupBy(user.FieldOptBool).
+			Aggregate(ent.Count()).
+			Scan(ctx, &groups)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		stats := &StatsUserResponse_FieldStats{Field: "opt_bool"}
+		for _, g := range groups {
+			vc := &StatsUserResponse_ValueCount{Count: int64(g.Count)}
+			// NULL values are counted with an empty value.
+			if g.Value != nil {
+				vc.Value = fmt.Sprint(*g.Value)
+			}
+			stats.Values = append(stats.Values, vc)
+		}
+		res.Fields = append(res.Fields, stats)
+	}
+	{
+		var groups []struct {
+			Value *string `json:"status"`
+			Count int     `json:"count"`
+		}
+		err := svc.client.User.Query().
+			GroupBy(user.FieldStatus).
+			Aggregate(ent.Count()).
+			Scan(ctx, &groups)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		stats := &StatsUserResponse_FieldStats{Field: "status"}
+		for _, g := range groups {
+			vc := &StatsUserResponse_ValueCount{Count: int64(g.Count)}
+			// NULL values are counted with an empty value.
+			if g.Value != nil {
+				vc.Value = fmt.Sprint(*g.Value)
+			}
+			stats.Values = append(stats.Values, vc)
+		}
+		res.Fields = append(res.Fields, stats)
+	}
+	return res, nil
+
+}
+
+// Export implements UserServiceServer.Export
+func (svc *UserService) Export(req *ExportUserRequest, stream UserService_ExportServer) error {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(stream.Context(), "entpb.UserService/Export", "User")
+	err := svc.serveExport(req, userServiceExportStream{UserService_ExportServer: stream, ctx: ctx})
+	return svc.config.RecordRequest("entpb.UserService", "Export", start, runtime.EndSpan(span, err))
+}
+
+// userServiceExportStream overrides the context of the stream of the Export method with the one holding its span.
+type userServiceExportStream struct {
+	UserService_ExportServer
+	ctx context.Context
+}
+
+// Context returns the context of the stream.
+func (s userServiceExportStream) Context() context.Context {
+	return s.ctx
+}
+
+// serveExport serves the Export method within its span.
+func (svc *UserService) serveExport(req *ExportUserRequest, stream UserService_ExportServer) error {
+	ctx := stream.Context()
+	format := runtime.RecordFormat(req.GetFormat())
+	if req.GetFormat() == ExportUserRequest_FORMAT_UNSPECIFIED {
+		format = runtime.FormatProtoDelimited
+	}
+	if !format.Valid() {
+		return status.Error(codes.InvalidArgument, "invalid argument: unknown format")
 	}
+	batchSize := int(req.GetBatchSize())
 	switch {
-	case err == nil:
-		var nextPageToken string
-		if len(entList) == pageSize+1 {
-			nextPageToken = base64.StdEncoding.EncodeToString(
-				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
-			entList = entList[:len(entList)-1]
+	case batchSize < 0:
+		return status.Errorf(codes.InvalidArgument, "batch size cannot be less than zero")
+	case batchSize == 0 || batchSize > entproto.MaxPageSize:
+		batchSize = entproto.MaxPageSize
+	}
+	query := svc.client.User.Query().
+		Order(ent.Asc(user.FieldID)).
+		Limit(batchSize)
+	if req.GetCursor() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.GetCursor())
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "cursor is invalid")
 		}
-		protoList, err := toProtoUserList(entList)
+		n, err := strconv.ParseUint(string(bytes), 10, 32)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return status.Errorf(codes.InvalidArgument, "cursor is invalid")
 		}
-		return &ListUserResponse{
-			UserList:      protoList,
-			NextPageToken: nextPageToken,
-		}, nil
-	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		cursor := uint32(n)
+		query = query.Where(user.IDGT(cursor))
+	}
+	for {
+		entList, err := query.All(ctx)
+		if err != nil {
+			return svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		if len(entList) == 0 {
+			return nil
+		}
+		var data []byte
+		for _, e := range entList {
+			protoEntity, err := toProtoUser(e)
+			if err != nil {
+				return svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+			}
+			// The records are packed in bytes, which the redaction of the responses does not reach.
+			protoEntity = runtime.Redact(ctx, svc.config, protoEntity, userServiceRedactions)
+			if data, err = runtime.AppendRecord(data, format, protoEntity); err != nil {
+				return svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+			}
+		}
+		last := entList[len(entList)-1].ID
+		err = stream.Send(&ExportUserResponse{
+			Data:   data,
+			Cursor: base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%v", last))),
+		})
+		if err != nil {
+			return err
+		}
+		if len(entList) < batchSize {
+			return nil
+		}
+		query = svc.client.User.Query().
+			Where(user.IDGT(last)).
+			Order(ent.Asc(user.FieldID)).
+			Limit(batchSize)
 	}
 
 }
 
-// BatchCreate implements UserServiceServer.BatchCreate
-func (svc *UserService) BatchCreate(ctx context.Context, req *BatchCreateUsersRequest) (*BatchCreateUsersResponse, error) {
-	requests := req.GetRequests()
-	if len(requests) > entproto.MaxBatchCreateSize {
-		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+// Import implements UserServiceServer.Import
+func (svc *UserService) Import(stream UserService_ImportServer) error {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(stream.Context(), "entpb.UserService/Import", "User")
+	if err := svc.config.Writable("UserService"); err != nil {
+		return svc.config.RecordRequest("entpb.UserService", "Import", start, runtime.EndSpan(span, err))
 	}
-	bulk := make([]*ent.UserCreate, len(requests))
-	for i, req := range requests {
-		user := req.GetUser()
-		var err error
-		bulk[i], err = svc.createBuilder(user)
+	err := svc.serveImport(userServiceImportStream{UserService_ImportServer: stream, ctx: ctx})
+	return svc.config.RecordRequest("entpb.UserService", "Import", start, runtime.EndSpan(span, err))
+}
+
+// userServiceImportStream overrides the context of the stream of the Import method with the one holding its span.
+type userServiceImportStream struct {
+	UserService_ImportServer
+	ctx context.Context
+}
+
+// Context returns the context of the stream.
+func (s userServiceImportStream) Context() context.Context {
+	return s.ctx
+}
+
+// serveImport serves the Import method within its span.
+func (svc *UserService) serveImport(stream UserService_ImportServer) error {
+	var (
+		ctx    = stream.Context()
+		reader *runtime.RecordReader
+		count  int64
+		cursor string
+	)
+	// importRecords creates an entity from each complete record buffered in reader.
+	importRecords := func() error {
+		for {
+			user := &User{}
+			ok, err := reader.Next(user)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid argument: record %d: %s", count+1, err)
+			}
+			if !ok {
+				return nil
+			}
+			res, err := svc.importRecord(ctx, user)
+			if err != nil {
+				// Entities are created one by one, so the client may resume the import after the last cursor.
+				s, _ := status.FromError(err)
+				return status.Errorf(s.Code(), "import stopped after %d records (cursor %q): %s", count, cursor, s.Message())
+			}
+			count++
+			cursor = base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%v", res.ID)))
+		}
+	}
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
 		if err != nil {
-			return nil, err
+			return err
+		}
+		if reader == nil {
+			format := runtime.RecordFormat(req.GetFormat())
+			if req.GetFormat() == ImportUserRequest_FORMAT_UNSPECIFIED {
+				format = runtime.FormatProtoDelimited
+			}
+			if !format.Valid() {
+				return status.Error(codes.InvalidArgument, "invalid argument: unknown format")
+			}
+			reader = &runtime.RecordReader{Format: format}
+		}
+		reader.Write(req.GetData())
+		if err := importRecords(); err != nil {
+			return err
 		}
 	}
-	res, err := svc.client.User.CreateBulk(bulk...).Save(ctx)
+	if reader != nil {
+		reader.Flush()
+		if err := importRecords(); err != nil {
+			return err
+		}
+	}
+	return stream.SendAndClose(&ImportUserResponse{
+		Count:  count,
+		Cursor: cursor,
+	})
+
+}
+
+// WatchUser implements UserServiceServer.WatchUser
+func (svc *UserService) WatchUser(req *WatchUserRequest, stream UserService_WatchUserServer) error {
+	start := time.Now()
+	ctx, span := svc.config.StartSpan(stream.Context(), "entpb.UserService/WatchUser", "User")
+	err := svc.serveWatchUser(req, userServiceWatchUserStream{UserService_WatchUserServer: stream, ctx: ctx, config: svc.config})
+	return svc.config.RecordRequest("entpb.UserService", "WatchUser", start, runtime.EndSpan(span, err))
+}
+
+// userServiceWatchUserStream overrides the context of the stream of the WatchUser method with the one holding its span.
+// It redacts the responses of the stream according to the roles of the caller.
+type userServiceWatchUserStream struct {
+	UserService_WatchUserServer
+	ctx    context.Context
+	config runtime.Config
+}
+
+// Context returns the context of the stream.
+func (s userServiceWatchUserStream) Context() context.Context {
+	return s.ctx
+}
+
+// Send sends the response with the fields the caller may not read redacted, see entproto.RedactUnless.
+func (s userServiceWatchUserStream) Send(res *UserEvent) error {
+	return s.UserService_WatchUserServer.Send(runtime.Redact(s.ctx, s.config, res, userServiceRedactions))
+}
+
+// serveWatchUser serves the WatchUser method within its span.
+func (svc *UserService) serveWatchUser(req *WatchUserRequest, stream UserService_WatchUserServer) error {
+	events, cancel := svc.feed.Subscribe()
+	defer cancel()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case e, ok := <-events:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "watcher fell behind the events")
+			}
+			if err := stream.Send(e.(*UserEvent)); err != nil {
+				return err
+			}
+		}
+	}
+
+}
+
+// importRecord creates an entity from a record of the Import method.
+func (svc *UserService) importRecord(ctx context.Context, user *User) (*ent.User, error) {
+	m, err := svc.createBuilder(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	userID := uint32(user.GetId())
+	m.SetID(userID)
+	// The Create hooks are run with the request creating the entity of the record.
+	req := &CreateUserRequest{User: user}
+	if err := svc.runBeforeCreateHooks(ctx, req, m); err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
 	switch {
 	case err == nil:
-		protoList, err := toProtoUserList(res)
+		if err := svc.config.InvalidateCache(ctx, "User", res.ID); err != nil {
+			return nil, err
+		}
+		protoEntity, err := toProtoUser(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
 		}
-		return &BatchCreateUsersResponse{
-			Users: protoList,
-		}, nil
-	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+		if err := svc.runAfterCreateHooks(ctx, req, protoEntity); err != nil {
+			return nil, err
+		}
+		return res, nil
+	case ent.IsValidationError(err):
+		return nil, userValidationError(err, "")
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, svc.config.ConstraintError(err, userConstraintField(err, ""))
+	default:
+		return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+	}
+}
+
+// userServiceWatchHook returns the hook publishing the mutations of the User entities of client to
+// feed, once they are committed. The events a mutation fails to build are logged and dropped, see runtime.DropEvents.
+func userServiceWatchHook(client *ent.Client, feed *runtime.Feed) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.UserFunc(func(ctx context.Context, m *ent.UserMutation) (ent.Value, error) {
+			if !feed.Watched() {
+				return next.Mutate(ctx, m)
+			}
+			op := m.Op()
+			var ids []uint32
+			if !op.Is(ent.OpCreate | ent.OpUpdateOne) {
+				// Bulk updates and deletes do not return the mutated entities, which are looked up by their IDs.
+				var err error
+				if ids, err = m.IDs(ctx); err != nil {
+					runtime.DropEvents("entpb.UserService", err)
+					return next.Mutate(ctx, m)
+				}
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			publish := func() {
+				events, err := userServiceEvents(ctx, client, op, ids, v)
+				if err != nil {
+					runtime.DropEvents("entpb.UserService", err)
+					return
+				}
+				for _, e := range events {
+					feed.Publish(e)
+				}
+			}
+			tx, err := m.Tx()
+			if err != nil {
+				// The mutation is not running in a transaction, it is committed already.
+				publish()
+				return v, nil
+			}
+			tx.OnCommit(func(commit ent.Committer) ent.Committer {
+				return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+					if err := commit.Commit(ctx, tx); err != nil {
+						return err
+					}
+					publish()
+					return nil
+				})
+			})
+			return v, nil
+		})
+	}
+}
+
+// userServiceEvents returns the events of the committed mutation of the User entities with op, returning v
+// and mutating the entities with the given ids for bulk updates and deletes.
+func userServiceEvents(ctx context.Context, client *ent.Client, op ent.Op, ids []uint32, v ent.Value) ([]*UserEvent, error) {
+	switch {
+	case op.Is(ent.OpCreate | ent.OpUpdateOne):
+		e, ok := v.(*ent.User)
+		if !ok {
+			return nil, nil
+		}
+		typ := UserEvent_UPDATED
+		if op.Is(ent.OpCreate) {
+			typ = UserEvent_CREATED
+		}
+		event, err := userServiceEvent(typ, e)
+		if err != nil {
+			return nil, err
+		}
+		return []*UserEvent{event}, nil
+	case op.Is(ent.OpUpdate):
+		entList, err := client.User.Query().
+			Where(user.IDIn(ids...)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		events := make([]*UserEvent, 0, len(entList))
+		for _, e := range entList {
+			event, err := userServiceEvent(UserEvent_UPDATED, e)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, event)
+		}
+		return events, nil
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		events := make([]*UserEvent, 0, len(ids))
+		for _, id := range ids {
+			pbID := id
+			events = append(events, &UserEvent{
+				Type: UserEvent_DELETED,
+				Id:   pbID,
+			})
+		}
+		return events, nil
 	}
+}
 
+// userServiceEvent returns an event of the given type for e.
+func userServiceEvent(typ UserEvent_Type, e *ent.User) (*UserEvent, error) {
+	protoEntity, err := toProtoUser(e)
+	if err != nil {
+		return nil, err
+	}
+	return &UserEvent{
+		Type: typ,
+		Id:   protoEntity.Id,
+		User: protoEntity,
+	}, nil
 }
 
-func (svc *UserService) createBuilder(user *User) (*ent.UserCreate, error) {
+func (svc *UserService) createBuilder(ctx context.Context, user *User) (*ent.UserCreate, error) {
 	m := svc.client.User.Create()
-	userAccountBalance := float64(user.GetAccountBalance())
-	m.SetAccountBalance(userAccountBalance)
+	if user.GetAccountBalance() != 0 {
+		userAccountBalance := float64(user.GetAccountBalance())
+		m.SetAccountBalance(userAccountBalance)
+	}
 	if user.GetBUser_1() != nil {
 		userBUser1 := int(user.GetBUser_1().GetValue())
 		m.SetBUser1(userBUser1)
 	}
-	userBanned := user.GetBanned()
-	m.SetBanned(userBanned)
+	if user.GetBanned() {
+		userBanned := user.GetBanned()
+		m.SetBanned(userBanned)
+	}
 	if user.GetBigInt() != nil {
 		userBigInt := schema.BigInt{}
 		if err := (&userBigInt).Scan(user.GetBigInt().GetValue()); err != nil {
@@ -507,24 +4633,37 @@ func (svc *UserService) createBuilder(user *User) (*ent.UserCreate, error) {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
 	}
 	m.SetCrmID(userCrmID)
-	userCustomPb := uint8(user.GetCustomPb())
+	userCustomPb, err := runtime.ConvertInt[uint8](user.GetCustomPb())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
 	m.SetCustomPb(userCustomPb)
-	userDeviceType := toEntUser_DeviceType(user.GetDeviceType())
-	m.SetDeviceType(userDeviceType)
+	if user.GetDeviceType() != 0 {
+		userDeviceType := toEntUser_DeviceType(user.GetDeviceType())
+		m.SetDeviceType(userDeviceType)
+	} else if svc.config.UnspecifiedEnum == runtime.UnspecifiedEnumReject {
+		return nil, runtime.UnspecifiedEnumError("user.device_type")
+	}
 	userExp := uint64(user.GetExp())
 	m.SetExp(userExp)
 	userExternalID := int(user.GetExternalId())
 	m.SetExternalID(userExternalID)
-	userHeightInCm := float32(user.GetHeightInCm())
-	m.SetHeightInCm(userHeightInCm)
+	if user.GetHeightInCm() != 0 {
+		userHeightInCm := float32(user.GetHeightInCm())
+		m.SetHeightInCm(userHeightInCm)
+	}
 	userJoined := runtime.ExtractTime(user.GetJoined())
 	m.SetJoined(userJoined)
 	if user.GetLabels() != nil {
 		userLabels := user.GetLabels()
 		m.SetLabels(userLabels)
 	}
-	userOmitPrefix := toEntUser_OmitPrefix(user.GetOmitPrefix())
-	m.SetOmitPrefix(userOmitPrefix)
+	if user.GetOmitPrefix() != 0 {
+		userOmitPrefix := toEntUser_OmitPrefix(user.GetOmitPrefix())
+		m.SetOmitPrefix(userOmitPrefix)
+	} else {
+		return nil, runtime.UnspecifiedEnumError("user.omit_prefix")
+	}
 	if user.GetOptBool() != nil {
 		userOptBool := user.GetOptBool().GetValue()
 		m.SetOptBool(userOptBool)
@@ -539,8 +4678,12 @@ func (svc *UserService) createBuilder(user *User) (*ent.UserCreate, error) {
 	}
 	userPoints := uint(user.GetPoints())
 	m.SetPoints(userPoints)
-	userStatus := toEntUser_Status(user.GetStatus())
-	m.SetStatus(userStatus)
+	if user.GetStatus() != 0 {
+		userStatus := toEntUser_Status(user.GetStatus())
+		m.SetStatus(userStatus)
+	} else {
+		return nil, runtime.UnspecifiedEnumError("user.status")
+	}
 	if user.GetType() != nil {
 		userType := user.GetType().GetValue()
 		m.SetType(userType)
@@ -554,6 +4697,10 @@ func (svc *UserService) createBuilder(user *User) (*ent.UserCreate, error) {
 		}
 		m.SetAttachmentID(userAttachment)
 	}
+	for _, item := range user.GetFriendIds() {
+		friends := uint32(item)
+		m.AddFriendIDs(friends)
+	}
 	if user.GetGroup() != nil {
 		userGroup := int(user.GetGroup().GetId())
 		m.SetGroupID(userGroup)
@@ -569,5 +4716,208 @@ func (svc *UserService) createBuilder(user *User) (*ent.UserCreate, error) {
 		}
 		m.AddReceived1IDs(received1)
 	}
+
+	return m, nil
+}
+
+func (svc *UserService) updateBuilder(ctx context.Context, client *ent.Client, user *User, mask *fieldmaskpb.FieldMask) (*ent.UserUpdateOne, error) {
+	userID := uint32(user.GetId())
+	paths, err := userUpdateMaskPaths(mask)
+	if err != nil {
+		return nil, err
+	}
+	// Immutable fields cannot be updated, reject requests setting them to a different value.
+	// They cannot be masked either, so the check only applies to requests without an update mask.
+	if paths == nil {
+		cur, err := client.User.Get(ctx, userID)
+		switch {
+		case ent.IsNotFound(err):
+			return nil, svc.config.MapError(err, codes.NotFound, "not found: %s", err)
+		case err != nil:
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		curProto, err := toProtoUser(cur)
+		if err != nil {
+			return nil, svc.config.MapError(err, codes.Internal, "internal error: %s", err)
+		}
+		if runtime.FieldChanged(user, curProto, "joined") {
+			return nil, status.Error(codes.FailedPrecondition, "failed precondition: field \"joined\" is immutable")
+		}
+	}
+	m := client.User.UpdateOneID(userID)
+	if paths == nil || paths["account_balance"] {
+		userAccountBalance := float64(user.GetAccountBalance())
+		m.SetAccountBalance(userAccountBalance)
+	}
+	if paths == nil || paths["b_user_1"] {
+		if user.GetBUser_1() != nil {
+			userBUser1 := int(user.GetBUser_1().GetValue())
+			m.SetBUser1(userBUser1)
+		} else if paths != nil {
+			m.ClearBUser1()
+		}
+	}
+	if paths == nil || paths["banned"] {
+		userBanned := user.GetBanned()
+		m.SetBanned(userBanned)
+	}
+	if paths == nil || paths["big_int"] {
+		if user.GetBigInt() != nil {
+			userBigInt := schema.BigInt{}
+			if err := (&userBigInt).Scan(user.GetBigInt().GetValue()); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			}
+			m.SetBigInt(userBigInt)
+		} else if paths != nil {
+			m.ClearBigInt()
+		}
+	}
+	if paths == nil || paths["crm_id"] {
+		var userCrmID uuid.UUID
+		if err := (&userCrmID).UnmarshalBinary(user.GetCrmId()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		m.SetCrmID(userCrmID)
+	}
+	if paths == nil || paths["custom_pb"] {
+		userCustomPb, err := runtime.ConvertInt[uint8](user.GetCustomPb())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		m.SetCustomPb(userCustomPb)
+	}
+	if paths == nil || paths["device_type"] {
+		// The unspecified device_type is the value mapped to its default.
+		if user.GetDeviceType() != 0 || svc.config.UnspecifiedEnum == runtime.UnspecifiedEnumDefault {
+			userDeviceType := toEntUser_DeviceType(user.GetDeviceType())
+			m.SetDeviceType(userDeviceType)
+		} else if svc.config.UnspecifiedEnum == runtime.UnspecifiedEnumReject {
+			return nil, runtime.UnspecifiedEnumError("user.device_type")
+		}
+	}
+	if paths == nil || paths["exp"] {
+		userExp := uint64(user.GetExp())
+		m.SetExp(userExp)
+	}
+	if paths == nil || paths["external_id"] {
+		userExternalID := int(user.GetExternalId())
+		m.SetExternalID(userExternalID)
+	}
+	if paths == nil || paths["height_in_cm"] {
+		userHeightInCm := float32(user.GetHeightInCm())
+		m.SetHeightInCm(userHeightInCm)
+	}
+	if paths == nil || paths["labels"] {
+		if user.GetLabels() != nil {
+			userLabels := user.GetLabels()
+			m.SetLabels(userLabels)
+		} else if paths != nil {
+			m.ClearLabels()
+		}
+	}
+	if paths == nil || paths["omit_prefix"] {
+		if user.GetOmitPrefix() != 0 {
+			userOmitPrefix := toEntUser_OmitPrefix(user.GetOmitPrefix())
+			m.SetOmitPrefix(userOmitPrefix)
+		} else if svc.config.UnspecifiedEnum != runtime.UnspecifiedEnumKeep {
+			return nil, runtime.UnspecifiedEnumError("user.omit_prefix")
+		}
+	}
+	if paths == nil || paths["opt_bool"] {
+		if user.GetOptBool() != nil {
+			userOptBool := user.GetOptBool().GetValue()
+			m.SetOptBool(userOptBool)
+		} else if paths != nil {
+			m.ClearOptBool()
+		}
+	}
+	if paths == nil || paths["opt_num"] {
+		if user.GetOptNum() != nil {
+			userOptNum := int(user.GetOptNum().GetValue())
+			m.SetOptNum(userOptNum)
+		} else if paths != nil {
+			m.ClearOptNum()
+		}
+	}
+	if paths == nil || paths["opt_str"] {
+		if user.GetOptStr() != nil {
+			userOptStr := user.GetOptStr().GetValue()
+			m.SetOptStr(userOptStr)
+		} else if paths != nil {
+			m.ClearOptStr()
+		}
+	}
+	if paths == nil || paths["points"] {
+		userPoints := uint(user.GetPoints())
+		m.SetPoints(userPoints)
+	}
+	if paths == nil || paths["status"] {
+		if user.GetStatus() != 0 {
+			userStatus := toEntUser_Status(user.GetStatus())
+			m.SetStatus(userStatus)
+		} else if svc.config.UnspecifiedEnum != runtime.UnspecifiedEnumKeep {
+			return nil, runtime.UnspecifiedEnumError("user.status")
+		}
+	}
+	if paths == nil || paths["type"] {
+		if user.GetType() != nil {
+			userType := user.GetType().GetValue()
+			m.SetType(userType)
+		} else if paths != nil {
+			m.ClearType()
+		}
+	}
+	if paths == nil || paths["user_name"] {
+		userUserName := user.GetUserName()
+		m.SetUserName(userUserName)
+	}
+	if paths == nil || paths["attachment"] {
+		if user.GetAttachment() != nil {
+			var userAttachment uuid.UUID
+			if err := (&userAttachment).UnmarshalBinary(user.GetAttachment().GetId()); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			}
+			m.SetAttachmentID(userAttachment)
+		} else if paths != nil {
+			m.ClearAttachment()
+		}
+	}
+	if paths == nil || paths["friend_ids"] {
+		if paths != nil {
+			m.ClearFriends()
+		}
+		for _, item := range user.GetFriendIds() {
+			friends := uint32(item)
+			m.AddFriendIDs(friends)
+		}
+	}
+	if paths == nil || paths["group"] {
+		if user.GetGroup() != nil {
+			userGroup := int(user.GetGroup().GetId())
+			m.SetGroupID(userGroup)
+		} else if paths != nil {
+			m.ClearGroup()
+		}
+	}
+	if paths == nil || paths["pet"] {
+		if user.GetPet() != nil {
+			userPet := int(user.GetPet().GetId())
+			m.SetPetID(userPet)
+		} else if paths != nil {
+			m.ClearPet()
+		}
+	}
+	if paths == nil || paths["received_1"] {
+		if paths != nil {
+			m.ClearReceived1()
+		}
+		for _, item := range user.GetReceived_1() {
+			var received1 uuid.UUID
+			if err := (&received1).UnmarshalBinary(item.GetId()); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			}
+			m.AddReceived1IDs(received1)
+		}
+	}
 	return m, nil
 }
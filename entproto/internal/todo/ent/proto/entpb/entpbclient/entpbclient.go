@@ -0,0 +1,2015 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpbclient
+
+import (
+	context "context"
+	common "entgo.io/contrib/entproto/internal/todo/ent/proto/common"
+	entpb "entgo.io/contrib/entproto/internal/todo/ent/proto/entpb"
+	grpc "google.golang.org/grpc"
+	proto "google.golang.org/protobuf/proto"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	time "time"
+)
+
+// Client is the client of the services of the entpb package, wrapping their gRPC clients with
+// ent-like builders.
+type Client struct {
+	// Attachment is the client of the AttachmentService.
+	Attachment *AttachmentClient
+	// Document is the client of the DocumentService.
+	Document *DocumentClient
+	// Label is the client of the LabelService.
+	Label *LabelClient
+	// MultiWordSchema is the client of the MultiWordSchemaService.
+	MultiWordSchema *MultiWordSchemaClient
+	// NilExample is the client of the NilExampleService.
+	NilExample *NilExampleClient
+	// PetRead is the client of the PetReadService.
+	PetRead *PetReadClient
+	// PetWrite is the client of the PetWriteService.
+	PetWrite *PetWriteClient
+	// Pony is the client of the PonyService.
+	Pony *PonyClient
+	// Project is the client of the ProjectService.
+	Project *ProjectClient
+	// Todo is the client of the TodoService.
+	Todo *TodoClient
+	// User is the client of the UserService.
+	User *UserClient
+}
+
+// NewClient returns a Client of the services served on the connection cc.
+func NewClient(cc grpc.ClientConnInterface) *Client {
+	return &Client{
+		Attachment:      &AttachmentClient{stub: entpb.NewAttachmentServiceClient(cc)},
+		Document:        &DocumentClient{stub: entpb.NewDocumentServiceClient(cc)},
+		Label:           &LabelClient{stub: entpb.NewLabelServiceClient(cc)},
+		MultiWordSchema: &MultiWordSchemaClient{stub: entpb.NewMultiWordSchemaServiceClient(cc)},
+		NilExample:      &NilExampleClient{stub: entpb.NewNilExampleServiceClient(cc)},
+		PetRead:         &PetReadClient{stub: entpb.NewPetReadServiceClient(cc)},
+		PetWrite:        &PetWriteClient{stub: entpb.NewPetWriteServiceClient(cc)},
+		Pony:            &PonyClient{stub: entpb.NewPonyServiceClient(cc)},
+		Project:         &ProjectClient{stub: entpb.NewProjectServiceClient(cc)},
+		Todo:            &TodoClient{stub: entpb.NewTodoServiceClient(cc)},
+		User:            &UserClient{stub: entpb.NewUserServiceClient(cc)},
+	}
+}
+
+// AttachmentClient is the client of the AttachmentService.
+type AttachmentClient struct {
+	stub entpb.AttachmentServiceClient
+}
+
+// Create returns a builder creating one Attachment with the Create method of the service.
+func (c *AttachmentClient) Create() *AttachmentCreate {
+	return &AttachmentCreate{stub: c.stub, msg: &entpb.Attachment{}}
+}
+
+// AttachmentCreate is the builder creating one Attachment.
+type AttachmentCreate struct {
+	stub entpb.AttachmentServiceClient
+	msg  *entpb.Attachment
+}
+
+// SetId sets the id field.
+func (c *AttachmentCreate) SetId(v []byte) *AttachmentCreate {
+	c.msg.Id = v
+	return c
+}
+
+// SetUser sets the user field.
+func (c *AttachmentCreate) SetUser(v *entpb.User) *AttachmentCreate {
+	c.msg.User = v
+	return c
+}
+
+// SetRecipients sets the recipients field.
+func (c *AttachmentCreate) SetRecipients(v []*entpb.User) *AttachmentCreate {
+	c.msg.Recipients = v
+	return c
+}
+
+// Save creates the Attachment and returns it.
+func (c *AttachmentCreate) Save(ctx context.Context) (*entpb.Attachment, error) {
+	return c.stub.Create(ctx, &entpb.CreateAttachmentRequest{Attachment: c.msg})
+}
+
+// UpdateOneID returns a builder updating the Attachment with the given id with the Update method of the
+// service. The fields left unset are cleared, as the requests of the service have no update mask.
+func (c *AttachmentClient) UpdateOneID(id []byte) *AttachmentUpdateOne {
+	return &AttachmentUpdateOne{stub: c.stub, msg: &entpb.Attachment{Id: id}}
+}
+
+// AttachmentUpdateOne is the builder updating one Attachment.
+type AttachmentUpdateOne struct {
+	stub entpb.AttachmentServiceClient
+	msg  *entpb.Attachment
+}
+
+// SetUser sets the user field.
+func (u *AttachmentUpdateOne) SetUser(v *entpb.User) *AttachmentUpdateOne {
+	u.msg.User = v
+	return u
+}
+
+// SetRecipients sets the recipients field.
+func (u *AttachmentUpdateOne) SetRecipients(v []*entpb.User) *AttachmentUpdateOne {
+	u.msg.Recipients = v
+	return u
+}
+
+// Save updates the Attachment and returns it.
+func (u *AttachmentUpdateOne) Save(ctx context.Context) (*entpb.Attachment, error) {
+	return u.stub.Update(ctx, &entpb.UpdateAttachmentRequest{
+		Attachment: u.msg,
+	})
+}
+
+// Get returns the Attachment with the given id.
+func (c *AttachmentClient) Get(ctx context.Context, id []byte) (*entpb.Attachment, error) {
+	return c.stub.Get(ctx, &entpb.GetAttachmentRequest{Id: id})
+}
+
+// DeleteOneID returns a builder deleting the Attachment with the given id with the Delete method of the
+// service.
+func (c *AttachmentClient) DeleteOneID(id []byte) *AttachmentDeleteOne {
+	return &AttachmentDeleteOne{stub: c.stub, req: &entpb.DeleteAttachmentRequest{Id: id}}
+}
+
+// AttachmentDeleteOne is the builder deleting one Attachment.
+type AttachmentDeleteOne struct {
+	stub entpb.AttachmentServiceClient
+	req  *entpb.DeleteAttachmentRequest
+}
+
+// Exec deletes the Attachment.
+func (d *AttachmentDeleteOne) Exec(ctx context.Context) error {
+	_, err := d.stub.Delete(ctx, d.req)
+	return err
+}
+
+// Query returns a builder querying the Attachment entities with the List method of the service.
+func (c *AttachmentClient) Query() *AttachmentQuery {
+	return &AttachmentQuery{stub: c.stub, req: &entpb.ListAttachmentRequest{}}
+}
+
+// AttachmentQuery is the builder querying Attachment entities.
+type AttachmentQuery struct {
+	stub entpb.AttachmentServiceClient
+	req  *entpb.ListAttachmentRequest
+}
+
+// OrderBy orders the entities by the given order_by expression, e.g. "points desc, user_name".
+func (q *AttachmentQuery) OrderBy(orderBy string) *AttachmentQuery {
+	q.req.OrderBy = orderBy
+	return q
+}
+
+// PageSize sets the maximum number of entities of the pages.
+func (q *AttachmentQuery) PageSize(n int32) *AttachmentQuery {
+	q.req.PageSize = n
+	return q
+}
+
+// Page returns the entities of the page starting at the given page token, empty for the first page, and the
+// token of the next page, empty for the last page.
+func (q *AttachmentQuery) Page(ctx context.Context, token string) ([]*entpb.Attachment, string, error) {
+	req := proto.Clone(q.req).(*entpb.ListAttachmentRequest)
+	req.PageToken = token
+	res, err := q.stub.List(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+	return res.GetAttachmentList(), res.GetNextPageToken(), nil
+}
+
+// All returns the entities of all the pages.
+func (q *AttachmentQuery) All(ctx context.Context) ([]*entpb.Attachment, error) {
+	var (
+		all   []*entpb.Attachment
+		token string
+	)
+	for {
+		list, next, err := q.Page(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list...)
+		if next == "" {
+			return all, nil
+		}
+		token = next
+	}
+}
+
+// DocumentClient is the client of the DocumentService.
+type DocumentClient struct {
+	stub entpb.DocumentServiceClient
+}
+
+// Create returns a builder creating one Document with the Create method of the service.
+func (c *DocumentClient) Create() *DocumentCreate {
+	return &DocumentCreate{stub: c.stub, msg: &entpb.Document{}}
+}
+
+// DocumentCreate is the builder creating one Document.
+type DocumentCreate struct {
+	stub entpb.DocumentServiceClient
+	msg  *entpb.Document
+}
+
+// SetId sets the id field.
+func (c *DocumentCreate) SetId(v int64) *DocumentCreate {
+	c.msg.Id = v
+	return c
+}
+
+// SetTenantId sets the tenant_id field.
+func (c *DocumentCreate) SetTenantId(v int64) *DocumentCreate {
+	c.msg.TenantId = v
+	return c
+}
+
+// SetTitle sets the title field.
+func (c *DocumentCreate) SetTitle(v string) *DocumentCreate {
+	c.msg.Title = v
+	return c
+}
+
+// SetRequestId sets the request_id field.
+func (c *DocumentCreate) SetRequestId(v string) *DocumentCreate {
+	c.msg.RequestId = wrapperspb.String(v)
+	return c
+}
+
+// SetParentId sets the parent_id field.
+func (c *DocumentCreate) SetParentId(v int64) *DocumentCreate {
+	c.msg.ParentId = v
+	return c
+}
+
+// Save creates the Document and returns it.
+func (c *DocumentCreate) Save(ctx context.Context) (*entpb.Document, error) {
+	return c.stub.Create(ctx, &entpb.CreateDocumentRequest{Document: c.msg})
+}
+
+// UpdateOneID returns a builder updating the Document with the given id with the Update method of the
+// service. The fields left unset are cleared, as the requests of the service have no update mask.
+func (c *DocumentClient) UpdateOneID(id int64) *DocumentUpdateOne {
+	return &DocumentUpdateOne{stub: c.stub, msg: &entpb.Document{Id: id}}
+}
+
+// DocumentUpdateOne is the builder updating one Document.
+type DocumentUpdateOne struct {
+	stub entpb.DocumentServiceClient
+	msg  *entpb.Document
+}
+
+// SetTenantId sets the tenant_id field.
+func (u *DocumentUpdateOne) SetTenantId(v int64) *DocumentUpdateOne {
+	u.msg.TenantId = v
+	return u
+}
+
+// SetTitle sets the title field.
+func (u *DocumentUpdateOne) SetTitle(v string) *DocumentUpdateOne {
+	u.msg.Title = v
+	return u
+}
+
+// SetRequestId sets the request_id field.
+func (u *DocumentUpdateOne) SetRequestId(v string) *DocumentUpdateOne {
+	u.msg.RequestId = wrapperspb.String(v)
+	return u
+}
+
+// SetParentId sets the parent_id field.
+func (u *DocumentUpdateOne) SetParentId(v int64) *DocumentUpdateOne {
+	u.msg.ParentId = v
+	return u
+}
+
+// Save updates the Document and returns it.
+func (u *DocumentUpdateOne) Save(ctx context.Context) (*entpb.Document, error) {
+	return u.stub.Update(ctx, &entpb.UpdateDocumentRequest{
+		Document: u.msg,
+	})
+}
+
+// Get returns the Document with the given id.
+func (c *DocumentClient) Get(ctx context.Context, id int64) (*entpb.Document, error) {
+	return c.stub.Get(ctx, &entpb.GetDocumentRequest{Id: id})
+}
+
+// DeleteOneID returns a builder deleting the Document with the given id with the Delete method of the
+// service.
+func (c *DocumentClient) DeleteOneID(id int64) *DocumentDeleteOne {
+	return &DocumentDeleteOne{stub: c.stub, req: &entpb.DeleteDocumentRequest{Id: id}}
+}
+
+// DocumentDeleteOne is the builder deleting one Document.
+type DocumentDeleteOne struct {
+	stub entpb.DocumentServiceClient
+	req  *entpb.DeleteDocumentRequest
+}
+
+// Exec deletes the Document.
+func (d *DocumentDeleteOne) Exec(ctx context.Context) error {
+	_, err := d.stub.Delete(ctx, d.req)
+	return err
+}
+
+// Query returns a builder querying the Document entities with the List method of the service.
+func (c *DocumentClient) Query() *DocumentQuery {
+	return &DocumentQuery{stub: c.stub, req: &entpb.ListDocumentRequest{}}
+}
+
+// DocumentQuery is the builder querying Document entities.
+type DocumentQuery struct {
+	stub entpb.DocumentServiceClient
+	req  *entpb.ListDocumentRequest
+}
+
+// WhereId filters the entities by their id field.
+func (q *DocumentQuery) WhereId(f *entpb.DocumentFilter_Int64Filter) *DocumentQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.DocumentFilter{}
+	}
+	q.req.Filter.Id = f
+	return q
+}
+
+// WhereTenantId filters the entities by their tenant_id field.
+func (q *DocumentQuery) WhereTenantId(f *entpb.DocumentFilter_Int64Filter) *DocumentQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.DocumentFilter{}
+	}
+	q.req.Filter.TenantId = f
+	return q
+}
+
+// WhereTitle filters the entities by their title field.
+func (q *DocumentQuery) WhereTitle(f *entpb.DocumentFilter_StringFilter) *DocumentQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.DocumentFilter{}
+	}
+	q.req.Filter.Title = f
+	return q
+}
+
+// WhereRequestId filters the entities by their request_id field.
+func (q *DocumentQuery) WhereRequestId(f *entpb.DocumentFilter_StringFilter) *DocumentQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.DocumentFilter{}
+	}
+	q.req.Filter.RequestId = f
+	return q
+}
+
+// OrderBy orders the entities by the given order_by expression, e.g. "points desc, user_name".
+func (q *DocumentQuery) OrderBy(orderBy string) *DocumentQuery {
+	q.req.OrderBy = orderBy
+	return q
+}
+
+// PageSize sets the maximum number of entities of the pages.
+func (q *DocumentQuery) PageSize(n int32) *DocumentQuery {
+	q.req.PageSize = n
+	return q
+}
+
+// Page returns the entities of the page starting at the given page token, empty for the first page, and the
+// token of the next page, empty for the last page.
+func (q *DocumentQuery) Page(ctx context.Context, token string) ([]*entpb.Document, string, error) {
+	req := proto.Clone(q.req).(*entpb.ListDocumentRequest)
+	req.PageToken = token
+	res, err := q.stub.List(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+	return res.GetDocumentList(), res.GetNextPageToken(), nil
+}
+
+// All returns the entities of all the pages.
+func (q *DocumentQuery) All(ctx context.Context) ([]*entpb.Document, error) {
+	var (
+		all   []*entpb.Document
+		token string
+	)
+	for {
+		list, next, err := q.Page(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list...)
+		if next == "" {
+			return all, nil
+		}
+		token = next
+	}
+}
+
+// LabelClient is the client of the LabelService.
+type LabelClient struct {
+	stub entpb.LabelServiceClient
+}
+
+// Create returns a builder creating one Label with the Create method of the service.
+func (c *LabelClient) Create() *LabelCreate {
+	return &LabelCreate{stub: c.stub, msg: &entpb.Label{}}
+}
+
+// LabelCreate is the builder creating one Label.
+type LabelCreate struct {
+	stub entpb.LabelServiceClient
+	msg  *entpb.Label
+}
+
+// SetId sets the id field.
+func (c *LabelCreate) SetId(v string) *LabelCreate {
+	c.msg.Id = v
+	return c
+}
+
+// SetColor sets the color field.
+func (c *LabelCreate) SetColor(v string) *LabelCreate {
+	c.msg.Color = v
+	return c
+}
+
+// Save creates the Label and returns it.
+func (c *LabelCreate) Save(ctx context.Context) (*entpb.Label, error) {
+	return c.stub.Create(ctx, &entpb.CreateLabelRequest{Label: c.msg})
+}
+
+// UpdateOneID returns a builder updating the Label with the given id with the Update method of the
+// service. The fields left unset are cleared, as the requests of the service have no update mask.
+func (c *LabelClient) UpdateOneID(id string) *LabelUpdateOne {
+	return &LabelUpdateOne{stub: c.stub, msg: &entpb.Label{Id: id}}
+}
+
+// LabelUpdateOne is the builder updating one Label.
+type LabelUpdateOne struct {
+	stub entpb.LabelServiceClient
+	msg  *entpb.Label
+}
+
+// SetColor sets the color field.
+func (u *LabelUpdateOne) SetColor(v string) *LabelUpdateOne {
+	u.msg.Color = v
+	return u
+}
+
+// Save updates the Label and returns it.
+func (u *LabelUpdateOne) Save(ctx context.Context) (*entpb.Label, error) {
+	return u.stub.Update(ctx, &entpb.UpdateLabelRequest{
+		Label: u.msg,
+	})
+}
+
+// Get returns the Label with the given id.
+func (c *LabelClient) Get(ctx context.Context, id string) (*entpb.Label, error) {
+	return c.stub.Get(ctx, &entpb.GetLabelRequest{Id: id})
+}
+
+// DeleteOneID returns a builder deleting the Label with the given id with the Delete method of the
+// service.
+func (c *LabelClient) DeleteOneID(id string) *LabelDeleteOne {
+	return &LabelDeleteOne{stub: c.stub, req: &entpb.DeleteLabelRequest{Id: id}}
+}
+
+// LabelDeleteOne is the builder deleting one Label.
+type LabelDeleteOne struct {
+	stub entpb.LabelServiceClient
+	req  *entpb.DeleteLabelRequest
+}
+
+// Exec deletes the Label.
+func (d *LabelDeleteOne) Exec(ctx context.Context) error {
+	_, err := d.stub.Delete(ctx, d.req)
+	return err
+}
+
+// Query returns a builder querying the Label entities with the List method of the service.
+func (c *LabelClient) Query() *LabelQuery {
+	return &LabelQuery{stub: c.stub, req: &entpb.ListLabelRequest{}}
+}
+
+// LabelQuery is the builder querying Label entities.
+type LabelQuery struct {
+	stub entpb.LabelServiceClient
+	req  *entpb.ListLabelRequest
+}
+
+// WhereId filters the entities by their id field.
+func (q *LabelQuery) WhereId(f *entpb.LabelFilter_StringFilter) *LabelQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.LabelFilter{}
+	}
+	q.req.Filter.Id = f
+	return q
+}
+
+// WhereColor filters the entities by their color field.
+func (q *LabelQuery) WhereColor(f *entpb.LabelFilter_StringFilter) *LabelQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.LabelFilter{}
+	}
+	q.req.Filter.Color = f
+	return q
+}
+
+// OrderBy orders the entities by the given order_by expression, e.g. "points desc, user_name".
+func (q *LabelQuery) OrderBy(orderBy string) *LabelQuery {
+	q.req.OrderBy = orderBy
+	return q
+}
+
+// PageSize sets the maximum number of entities of the pages.
+func (q *LabelQuery) PageSize(n int32) *LabelQuery {
+	q.req.PageSize = n
+	return q
+}
+
+// Page returns the entities of the page starting at the given page token, empty for the first page, and the
+// token of the next page, empty for the last page.
+func (q *LabelQuery) Page(ctx context.Context, token string) ([]*entpb.Label, string, error) {
+	req := proto.Clone(q.req).(*entpb.ListLabelRequest)
+	req.PageToken = token
+	res, err := q.stub.List(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+	return res.GetLabelList(), res.GetNextPageToken(), nil
+}
+
+// All returns the entities of all the pages.
+func (q *LabelQuery) All(ctx context.Context) ([]*entpb.Label, error) {
+	var (
+		all   []*entpb.Label
+		token string
+	)
+	for {
+		list, next, err := q.Page(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list...)
+		if next == "" {
+			return all, nil
+		}
+		token = next
+	}
+}
+
+// MultiWordSchemaClient is the client of the MultiWordSchemaService.
+type MultiWordSchemaClient struct {
+	stub entpb.MultiWordSchemaServiceClient
+}
+
+// Create returns a builder creating one MultiWordSchema with the Create method of the service.
+func (c *MultiWordSchemaClient) Create() *MultiWordSchemaCreate {
+	return &MultiWordSchemaCreate{stub: c.stub, msg: &entpb.MultiWordSchema{}}
+}
+
+// MultiWordSchemaCreate is the builder creating one MultiWordSchema.
+type MultiWordSchemaCreate struct {
+	stub entpb.MultiWordSchemaServiceClient
+	msg  *entpb.MultiWordSchema
+}
+
+// SetId sets the id field.
+func (c *MultiWordSchemaCreate) SetId(v int64) *MultiWordSchemaCreate {
+	c.msg.Id = v
+	return c
+}
+
+// SetUnit sets the unit field.
+func (c *MultiWordSchemaCreate) SetUnit(v entpb.MultiWordSchema_Unit) *MultiWordSchemaCreate {
+	c.msg.Unit = v
+	return c
+}
+
+// Save creates the MultiWordSchema and returns it.
+func (c *MultiWordSchemaCreate) Save(ctx context.Context) (*entpb.MultiWordSchema, error) {
+	return c.stub.Create(ctx, &entpb.CreateMultiWordSchemaRequest{MultiWordSchema: c.msg})
+}
+
+// UpdateOneID returns a builder updating the MultiWordSchema with the given id with the Update method of the
+// service. The fields left unset are cleared, as the requests of the service have no update mask.
+func (c *MultiWordSchemaClient) UpdateOneID(id int64) *MultiWordSchemaUpdateOne {
+	return &MultiWordSchemaUpdateOne{stub: c.stub, msg: &entpb.MultiWordSchema{Id: id}}
+}
+
+// MultiWordSchemaUpdateOne is the builder updating one MultiWordSchema.
+type MultiWordSchemaUpdateOne struct {
+	stub entpb.MultiWordSchemaServiceClient
+	msg  *entpb.MultiWordSchema
+}
+
+// SetUnit sets the unit field.
+func (u *MultiWordSchemaUpdateOne) SetUnit(v entpb.MultiWordSchema_Unit) *MultiWordSchemaUpdateOne {
+	u.msg.Unit = v
+	return u
+}
+
+// Save updates the MultiWordSchema and returns it.
+func (u *MultiWordSchemaUpdateOne) Save(ctx context.Context) (*entpb.MultiWordSchema, error) {
+	return u.stub.Update(ctx, &entpb.UpdateMultiWordSchemaRequest{
+		MultiWordSchema: u.msg,
+	})
+}
+
+// Get returns the MultiWordSchema with the given id.
+func (c *MultiWordSchemaClient) Get(ctx context.Context, id int64) (*entpb.MultiWordSchema, error) {
+	return c.stub.Get(ctx, &entpb.GetMultiWordSchemaRequest{Id: id})
+}
+
+// DeleteOneID returns a builder deleting the MultiWordSchema with the given id with the Delete method of the
+// service.
+func (c *MultiWordSchemaClient) DeleteOneID(id int64) *MultiWordSchemaDeleteOne {
+	return &MultiWordSchemaDeleteOne{stub: c.stub, req: &entpb.DeleteMultiWordSchemaRequest{Id: id}}
+}
+
+// MultiWordSchemaDeleteOne is the builder deleting one MultiWordSchema.
+type MultiWordSchemaDeleteOne struct {
+	stub entpb.MultiWordSchemaServiceClient
+	req  *entpb.DeleteMultiWordSchemaRequest
+}
+
+// Exec deletes the MultiWordSchema.
+func (d *MultiWordSchemaDeleteOne) Exec(ctx context.Context) error {
+	_, err := d.stub.Delete(ctx, d.req)
+	return err
+}
+
+// Query returns a builder querying the MultiWordSchema entities with the List method of the service.
+func (c *MultiWordSchemaClient) Query() *MultiWordSchemaQuery {
+	return &MultiWordSchemaQuery{stub: c.stub, req: &entpb.ListMultiWordSchemaRequest{}}
+}
+
+// MultiWordSchemaQuery is the builder querying MultiWordSchema entities.
+type MultiWordSchemaQuery struct {
+	stub entpb.MultiWordSchemaServiceClient
+	req  *entpb.ListMultiWordSchemaRequest
+}
+
+// WhereId filters the entities by their id field.
+func (q *MultiWordSchemaQuery) WhereId(f *entpb.MultiWordSchemaFilter_Int64Filter) *MultiWordSchemaQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.MultiWordSchemaFilter{}
+	}
+	q.req.Filter.Id = f
+	return q
+}
+
+// OrderBy orders the entities by the given order_by expression, e.g. "points desc, user_name".
+func (q *MultiWordSchemaQuery) OrderBy(orderBy string) *MultiWordSchemaQuery {
+	q.req.OrderBy = orderBy
+	return q
+}
+
+// PageSize sets the maximum number of entities of the pages.
+func (q *MultiWordSchemaQuery) PageSize(n int32) *MultiWordSchemaQuery {
+	q.req.PageSize = n
+	return q
+}
+
+// Page returns the entities of the page starting at the given page token, empty for the first page, and the
+// token of the next page, empty for the last page.
+func (q *MultiWordSchemaQuery) Page(ctx context.Context, token string) ([]*entpb.MultiWordSchema, string, error) {
+	req := proto.Clone(q.req).(*entpb.ListMultiWordSchemaRequest)
+	req.PageToken = token
+	res, err := q.stub.List(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+	return res.GetMultiWordSchemaList(), res.GetNextPageToken(), nil
+}
+
+// All returns the entities of all the pages.
+func (q *MultiWordSchemaQuery) All(ctx context.Context) ([]*entpb.MultiWordSchema, error) {
+	var (
+		all   []*entpb.MultiWordSchema
+		token string
+	)
+	for {
+		list, next, err := q.Page(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list...)
+		if next == "" {
+			return all, nil
+		}
+		token = next
+	}
+}
+
+// NilExampleClient is the client of the NilExampleService.
+type NilExampleClient struct {
+	stub entpb.NilExampleServiceClient
+}
+
+// Create returns a builder creating one NilExample with the Create method of the service.
+func (c *NilExampleClient) Create() *NilExampleCreate {
+	return &NilExampleCreate{stub: c.stub, msg: &entpb.NilExample{}}
+}
+
+// NilExampleCreate is the builder creating one NilExample.
+type NilExampleCreate struct {
+	stub entpb.NilExampleServiceClient
+	msg  *entpb.NilExample
+}
+
+// SetId sets the id field.
+func (c *NilExampleCreate) SetId(v int64) *NilExampleCreate {
+	c.msg.Id = v
+	return c
+}
+
+// SetStrNil sets the str_nil field.
+func (c *NilExampleCreate) SetStrNil(v string) *NilExampleCreate {
+	c.msg.StrNil = wrapperspb.String(v)
+	return c
+}
+
+// SetTimeNil sets the time_nil field.
+func (c *NilExampleCreate) SetTimeNil(v time.Time) *NilExampleCreate {
+	c.msg.TimeNil = timestamppb.New(v)
+	return c
+}
+
+// SetTimeStrNil sets the time_str_nil field.
+func (c *NilExampleCreate) SetTimeStrNil(v string) *NilExampleCreate {
+	c.msg.TimeStrNil = wrapperspb.String(v)
+	return c
+}
+
+// Save creates the NilExample and returns it.
+func (c *NilExampleCreate) Save(ctx context.Context) (*entpb.NilExample, error) {
+	return c.stub.Create(ctx, &entpb.CreateNilExampleRequest{NilExample: c.msg})
+}
+
+// UpdateOneID returns a builder updating the NilExample with the given id with the Update method of the
+// service. The fields left unset are cleared, as the requests of the service have no update mask.
+func (c *NilExampleClient) UpdateOneID(id int64) *NilExampleUpdateOne {
+	return &NilExampleUpdateOne{stub: c.stub, msg: &entpb.NilExample{Id: id}}
+}
+
+// NilExampleUpdateOne is the builder updating one NilExample.
+type NilExampleUpdateOne struct {
+	stub entpb.NilExampleServiceClient
+	msg  *entpb.NilExample
+}
+
+// SetStrNil sets the str_nil field.
+func (u *NilExampleUpdateOne) SetStrNil(v string) *NilExampleUpdateOne {
+	u.msg.StrNil = wrapperspb.String(v)
+	return u
+}
+
+// SetTimeNil sets the time_nil field.
+func (u *NilExampleUpdateOne) SetTimeNil(v time.Time) *NilExampleUpdateOne {
+	u.msg.TimeNil = timestamppb.New(v)
+	return u
+}
+
+// SetTimeStrNil sets the time_str_nil field.
+func (u *NilExampleUpdateOne) SetTimeStrNil(v string) *NilExampleUpdateOne {
+	u.msg.TimeStrNil = wrapperspb.String(v)
+	return u
+}
+
+// Save updates the NilExample and returns it.
+func (u *NilExampleUpdateOne) Save(ctx context.Context) (*entpb.NilExample, error) {
+	return u.stub.Update(ctx, &entpb.UpdateNilExampleRequest{
+		NilExample: u.msg,
+	})
+}
+
+// Get returns the NilExample with the given id.
+func (c *NilExampleClient) Get(ctx context.Context, id int64) (*entpb.NilExample, error) {
+	return c.stub.Get(ctx, &entpb.GetNilExampleRequest{Id: id})
+}
+
+// DeleteOneID returns a builder deleting the NilExample with the given id with the Delete method of the
+// service.
+func (c *NilExampleClient) DeleteOneID(id int64) *NilExampleDeleteOne {
+	return &NilExampleDeleteOne{stub: c.stub, req: &entpb.DeleteNilExampleRequest{Id: id}}
+}
+
+// NilExampleDeleteOne is the builder deleting one NilExample.
+type NilExampleDeleteOne struct {
+	stub entpb.NilExampleServiceClient
+	req  *entpb.DeleteNilExampleRequest
+}
+
+// Exec deletes the NilExample.
+func (d *NilExampleDeleteOne) Exec(ctx context.Context) error {
+	_, err := d.stub.Delete(ctx, d.req)
+	return err
+}
+
+// Query returns a builder querying the NilExample entities with the List method of the service.
+func (c *NilExampleClient) Query() *NilExampleQuery {
+	return &NilExampleQuery{stub: c.stub, req: &entpb.ListNilExampleRequest{}}
+}
+
+// NilExampleQuery is the builder querying NilExample entities.
+type NilExampleQuery struct {
+	stub entpb.NilExampleServiceClient
+	req  *entpb.ListNilExampleRequest
+}
+
+// WhereId filters the entities by their id field.
+func (q *NilExampleQuery) WhereId(f *entpb.NilExampleFilter_Int64Filter) *NilExampleQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.NilExampleFilter{}
+	}
+	q.req.Filter.Id = f
+	return q
+}
+
+// WhereStrNil filters the entities by their str_nil field.
+func (q *NilExampleQuery) WhereStrNil(f *entpb.NilExampleFilter_StringFilter) *NilExampleQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.NilExampleFilter{}
+	}
+	q.req.Filter.StrNil = f
+	return q
+}
+
+// WhereTimeNil filters the entities by their time_nil field.
+func (q *NilExampleQuery) WhereTimeNil(f *entpb.NilExampleFilter_TimestampFilter) *NilExampleQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.NilExampleFilter{}
+	}
+	q.req.Filter.TimeNil = f
+	return q
+}
+
+// OrderBy orders the entities by the given order_by expression, e.g. "points desc, user_name".
+func (q *NilExampleQuery) OrderBy(orderBy string) *NilExampleQuery {
+	q.req.OrderBy = orderBy
+	return q
+}
+
+// PageSize sets the maximum number of entities of the pages.
+func (q *NilExampleQuery) PageSize(n int32) *NilExampleQuery {
+	q.req.PageSize = n
+	return q
+}
+
+// Page returns the entities of the page starting at the given page token, empty for the first page, and the
+// token of the next page, empty for the last page.
+func (q *NilExampleQuery) Page(ctx context.Context, token string) ([]*entpb.NilExample, string, error) {
+	req := proto.Clone(q.req).(*entpb.ListNilExampleRequest)
+	req.PageToken = token
+	res, err := q.stub.List(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+	return res.GetNilExampleList(), res.GetNextPageToken(), nil
+}
+
+// All returns the entities of all the pages.
+func (q *NilExampleQuery) All(ctx context.Context) ([]*entpb.NilExample, error) {
+	var (
+		all   []*entpb.NilExample
+		token string
+	)
+	for {
+		list, next, err := q.Page(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list...)
+		if next == "" {
+			return all, nil
+		}
+		token = next
+	}
+}
+
+// PetReadClient is the client of the PetReadService.
+type PetReadClient struct {
+	stub entpb.PetReadServiceClient
+}
+
+// Get returns the Pet with the given id.
+func (c *PetReadClient) Get(ctx context.Context, id int64) (*entpb.Pet, error) {
+	return c.stub.Get(ctx, &entpb.GetPetRequest{Id: id})
+}
+
+// Query returns a builder querying the Pet entities with the List method of the service.
+func (c *PetReadClient) Query() *PetQuery {
+	return &PetQuery{stub: c.stub, req: &entpb.ListPetRequest{}}
+}
+
+// PetQuery is the builder querying Pet entities.
+type PetQuery struct {
+	stub entpb.PetReadServiceClient
+	req  *entpb.ListPetRequest
+}
+
+// WhereId filters the entities by their id field.
+func (q *PetQuery) WhereId(f *entpb.PetFilter_Int64Filter) *PetQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.PetFilter{}
+	}
+	q.req.Filter.Id = f
+	return q
+}
+
+// OrderBy orders the entities by the given order_by expression, e.g. "points desc, user_name".
+func (q *PetQuery) OrderBy(orderBy string) *PetQuery {
+	q.req.OrderBy = orderBy
+	return q
+}
+
+// PageSize sets the maximum number of entities of the pages.
+func (q *PetQuery) PageSize(n int32) *PetQuery {
+	q.req.PageSize = n
+	return q
+}
+
+// Page returns the entities of the page starting at the given page token, empty for the first page, and the
+// token of the next page, empty for the last page.
+func (q *PetQuery) Page(ctx context.Context, token string) ([]*entpb.Pet, string, error) {
+	req := proto.Clone(q.req).(*entpb.ListPetRequest)
+	req.PageToken = token
+	res, err := q.stub.List(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+	return res.GetPetList(), res.GetNextPageToken(), nil
+}
+
+// All returns the entities of all the pages.
+func (q *PetQuery) All(ctx context.Context) ([]*entpb.Pet, error) {
+	var (
+		all   []*entpb.Pet
+		token string
+	)
+	for {
+		list, next, err := q.Page(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list...)
+		if next == "" {
+			return all, nil
+		}
+		token = next
+	}
+}
+
+// PetWriteClient is the client of the PetWriteService.
+type PetWriteClient struct {
+	stub entpb.PetWriteServiceClient
+}
+
+// Create returns a builder creating one Pet with the Create method of the service.
+func (c *PetWriteClient) Create() *PetCreate {
+	return &PetCreate{stub: c.stub, msg: &entpb.Pet{}}
+}
+
+// PetCreate is the builder creating one Pet.
+type PetCreate struct {
+	stub entpb.PetWriteServiceClient
+	msg  *entpb.Pet
+}
+
+// SetId sets the id field.
+func (c *PetCreate) SetId(v int64) *PetCreate {
+	c.msg.Id = v
+	return c
+}
+
+// SetSize sets the size field.
+func (c *PetCreate) SetSize(v entpb.Size) *PetCreate {
+	c.msg.Size = v
+	return c
+}
+
+// SetOwner sets the owner field.
+func (c *PetCreate) SetOwner(v *entpb.User) *PetCreate {
+	c.msg.Owner = v
+	return c
+}
+
+// SetAttachment sets the attachment field.
+func (c *PetCreate) SetAttachment(v []*entpb.Attachment) *PetCreate {
+	c.msg.Attachment = v
+	return c
+}
+
+// Save creates the Pet and returns it.
+func (c *PetCreate) Save(ctx context.Context) (*entpb.Pet, error) {
+	return c.stub.Create(ctx, &entpb.CreatePetRequest{Pet: c.msg})
+}
+
+// UpdateOneID returns a builder updating the Pet with the given id with the Update method of the
+// service. The fields left unset are cleared, as the requests of the service have no update mask.
+func (c *PetWriteClient) UpdateOneID(id int64) *PetUpdateOne {
+	return &PetUpdateOne{stub: c.stub, msg: &entpb.Pet{Id: id}}
+}
+
+// PetUpdateOne is the builder updating one Pet.
+type PetUpdateOne struct {
+	stub entpb.PetWriteServiceClient
+	msg  *entpb.Pet
+}
+
+// SetSize sets the size field.
+func (u *PetUpdateOne) SetSize(v entpb.Size) *PetUpdateOne {
+	u.msg.Size = v
+	return u
+}
+
+// SetOwner sets the owner field.
+func (u *PetUpdateOne) SetOwner(v *entpb.User) *PetUpdateOne {
+	u.msg.Owner = v
+	return u
+}
+
+// SetAttachment sets the attachment field.
+func (u *PetUpdateOne) SetAttachment(v []*entpb.Attachment) *PetUpdateOne {
+	u.msg.Attachment = v
+	return u
+}
+
+// Save updates the Pet and returns it.
+func (u *PetUpdateOne) Save(ctx context.Context) (*entpb.Pet, error) {
+	return u.stub.Update(ctx, &entpb.UpdatePetRequest{
+		Pet: u.msg,
+	})
+}
+
+// DeleteOneID returns a builder deleting the Pet with the given id with the Delete method of the
+// service.
+func (c *PetWriteClient) DeleteOneID(id int64) *PetDeleteOne {
+	return &PetDeleteOne{stub: c.stub, req: &entpb.DeletePetRequest{Id: id}}
+}
+
+// PetDeleteOne is the builder deleting one Pet.
+type PetDeleteOne struct {
+	stub entpb.PetWriteServiceClient
+	req  *entpb.DeletePetRequest
+}
+
+// Exec deletes the Pet.
+func (d *PetDeleteOne) Exec(ctx context.Context) error {
+	_, err := d.stub.Delete(ctx, d.req)
+	return err
+}
+
+// PonyClient is the client of the PonyService.
+type PonyClient struct {
+	stub entpb.PonyServiceClient
+}
+
+// ProjectClient is the client of the ProjectService.
+type ProjectClient struct {
+	stub entpb.ProjectServiceClient
+}
+
+// Create returns a builder creating one Project with the Create method of the service.
+func (c *ProjectClient) Create() *ProjectCreate {
+	return &ProjectCreate{stub: c.stub, msg: &entpb.Project{}}
+}
+
+// ProjectCreate is the builder creating one Project.
+type ProjectCreate struct {
+	stub entpb.ProjectServiceClient
+	msg  *entpb.Project
+}
+
+// SetId sets the id field.
+func (c *ProjectCreate) SetId(v int64) *ProjectCreate {
+	c.msg.Id = v
+	return c
+}
+
+// SetName sets the name field.
+func (c *ProjectCreate) SetName(v string) *ProjectCreate {
+	c.msg.Name = v
+	return c
+}
+
+// SetDeletedAt sets the deleted_at field.
+func (c *ProjectCreate) SetDeletedAt(v time.Time) *ProjectCreate {
+	c.msg.DeletedAt = timestamppb.New(v)
+	return c
+}
+
+// SetPriority sets the priority field.
+func (c *ProjectCreate) SetPriority(v common.Priority) *ProjectCreate {
+	c.msg.Priority = v
+	return c
+}
+
+// SetVersion sets the version field.
+func (c *ProjectCreate) SetVersion(v int64) *ProjectCreate {
+	c.msg.Version = v
+	return c
+}
+
+// Save creates the Project and returns it.
+func (c *ProjectCreate) Save(ctx context.Context) (*entpb.Project, error) {
+	return c.stub.Create(ctx, &entpb.CreateProjectRequest{Project: c.msg})
+}
+
+// UpdateOneID returns a builder updating the Project with the given id with the Update method of the
+// service. The fields left unset are cleared, as the requests of the service have no update mask.
+func (c *ProjectClient) UpdateOneID(id int64) *ProjectUpdateOne {
+	return &ProjectUpdateOne{stub: c.stub, msg: &entpb.Project{Id: id}}
+}
+
+// ProjectUpdateOne is the builder updating one Project.
+type ProjectUpdateOne struct {
+	stub entpb.ProjectServiceClient
+	msg  *entpb.Project
+}
+
+// SetName sets the name field.
+func (u *ProjectUpdateOne) SetName(v string) *ProjectUpdateOne {
+	u.msg.Name = v
+	return u
+}
+
+// SetDeletedAt sets the deleted_at field.
+func (u *ProjectUpdateOne) SetDeletedAt(v time.Time) *ProjectUpdateOne {
+	u.msg.DeletedAt = timestamppb.New(v)
+	return u
+}
+
+// SetPriority sets the priority field.
+func (u *ProjectUpdateOne) SetPriority(v common.Priority) *ProjectUpdateOne {
+	u.msg.Priority = v
+	return u
+}
+
+// SetVersion sets the version field.
+func (u *ProjectUpdateOne) SetVersion(v int64) *ProjectUpdateOne {
+	u.msg.Version = v
+	return u
+}
+
+// Save updates the Project and returns it.
+func (u *ProjectUpdateOne) Save(ctx context.Context) (*entpb.Project, error) {
+	return u.stub.Update(ctx, &entpb.UpdateProjectRequest{
+		Project: u.msg,
+	})
+}
+
+// DeleteOneID returns a builder deleting the Project with the given id with the Delete method of the
+// service.
+func (c *ProjectClient) DeleteOneID(id int64) *ProjectDeleteOne {
+	return &ProjectDeleteOne{stub: c.stub, req: &entpb.DeleteProjectRequest{Id: id}}
+}
+
+// ProjectDeleteOne is the builder deleting one Project.
+type ProjectDeleteOne struct {
+	stub entpb.ProjectServiceClient
+	req  *entpb.DeleteProjectRequest
+}
+
+// Exec deletes the Project.
+func (d *ProjectDeleteOne) Exec(ctx context.Context) error {
+	_, err := d.stub.Delete(ctx, d.req)
+	return err
+}
+
+// Query returns a builder querying the Project entities with the List method of the service.
+func (c *ProjectClient) Query() *ProjectQuery {
+	return &ProjectQuery{stub: c.stub, req: &entpb.ListProjectRequest{}}
+}
+
+// ProjectQuery is the builder querying Project entities.
+type ProjectQuery struct {
+	stub entpb.ProjectServiceClient
+	req  *entpb.ListProjectRequest
+}
+
+// OrderBy orders the entities by the given order_by expression, e.g. "points desc, user_name".
+func (q *ProjectQuery) OrderBy(orderBy string) *ProjectQuery {
+	q.req.OrderBy = orderBy
+	return q
+}
+
+// PageSize sets the maximum number of entities of the pages.
+func (q *ProjectQuery) PageSize(n int32) *ProjectQuery {
+	q.req.PageSize = n
+	return q
+}
+
+// Page returns the entities of the page starting at the given page token, empty for the first page, and the
+// token of the next page, empty for the last page.
+func (q *ProjectQuery) Page(ctx context.Context, token string) ([]*entpb.Project, string, error) {
+	req := proto.Clone(q.req).(*entpb.ListProjectRequest)
+	req.PageToken = token
+	res, err := q.stub.List(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+	return res.GetProjectList(), res.GetNextPageToken(), nil
+}
+
+// All returns the entities of all the pages.
+func (q *ProjectQuery) All(ctx context.Context) ([]*entpb.Project, error) {
+	var (
+		all   []*entpb.Project
+		token string
+	)
+	for {
+		list, next, err := q.Page(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list...)
+		if next == "" {
+			return all, nil
+		}
+		token = next
+	}
+}
+
+// TodoClient is the client of the TodoService.
+type TodoClient struct {
+	stub entpb.TodoServiceClient
+}
+
+// Create returns a builder creating one Todo with the Create method of the service.
+func (c *TodoClient) Create() *TodoCreate {
+	return &TodoCreate{stub: c.stub, msg: &entpb.Todo{}}
+}
+
+// TodoCreate is the builder creating one Todo.
+type TodoCreate struct {
+	stub entpb.TodoServiceClient
+	msg  *entpb.Todo
+}
+
+// SetId sets the id field.
+func (c *TodoCreate) SetId(v int64) *TodoCreate {
+	c.msg.Id = v
+	return c
+}
+
+// SetTask sets the task field.
+func (c *TodoCreate) SetTask(v string) *TodoCreate {
+	c.msg.Task = v
+	return c
+}
+
+// SetStatus sets the status field.
+func (c *TodoCreate) SetStatus(v entpb.Todo_Status) *TodoCreate {
+	c.msg.Status = v
+	return c
+}
+
+// SetUser sets the user field.
+func (c *TodoCreate) SetUser(v *entpb.User) *TodoCreate {
+	c.msg.User = v
+	return c
+}
+
+// Save creates the Todo and returns it.
+func (c *TodoCreate) Save(ctx context.Context) (*entpb.Todo, error) {
+	return c.stub.Create(ctx, &entpb.CreateTodoRequest{Todo: c.msg})
+}
+
+// UpdateOneID returns a builder updating the Todo with the given id with the Update method of the
+// service. The fields left unset are cleared, as the requests of the service have no update mask.
+func (c *TodoClient) UpdateOneID(id int64) *TodoUpdateOne {
+	return &TodoUpdateOne{stub: c.stub, msg: &entpb.Todo{Id: id}}
+}
+
+// TodoUpdateOne is the builder updating one Todo.
+type TodoUpdateOne struct {
+	stub entpb.TodoServiceClient
+	msg  *entpb.Todo
+}
+
+// SetTask sets the task field.
+func (u *TodoUpdateOne) SetTask(v string) *TodoUpdateOne {
+	u.msg.Task = v
+	return u
+}
+
+// SetStatus sets the status field.
+func (u *TodoUpdateOne) SetStatus(v entpb.Todo_Status) *TodoUpdateOne {
+	u.msg.Status = v
+	return u
+}
+
+// SetUser sets the user field.
+func (u *TodoUpdateOne) SetUser(v *entpb.User) *TodoUpdateOne {
+	u.msg.User = v
+	return u
+}
+
+// Save updates the Todo and returns it.
+func (u *TodoUpdateOne) Save(ctx context.Context) (*entpb.Todo, error) {
+	return u.stub.Update(ctx, &entpb.UpdateTodoRequest{
+		Todo: u.msg,
+	})
+}
+
+// Get returns the Todo with the given id.
+func (c *TodoClient) Get(ctx context.Context, id int64) (*entpb.Todo, error) {
+	return c.stub.Get(ctx, &entpb.GetTodoRequest{Id: id})
+}
+
+// DeleteOneID returns a builder deleting the Todo with the given id with the Delete method of the
+// service.
+func (c *TodoClient) DeleteOneID(id int64) *TodoDeleteOne {
+	return &TodoDeleteOne{stub: c.stub, req: &entpb.DeleteTodoRequest{Id: id}}
+}
+
+// TodoDeleteOne is the builder deleting one Todo.
+type TodoDeleteOne struct {
+	stub entpb.TodoServiceClient
+	req  *entpb.DeleteTodoRequest
+}
+
+// Exec deletes the Todo.
+func (d *TodoDeleteOne) Exec(ctx context.Context) error {
+	_, err := d.stub.Delete(ctx, d.req)
+	return err
+}
+
+// Query returns a builder querying the Todo entities with the List method of the service.
+func (c *TodoClient) Query() *TodoQuery {
+	return &TodoQuery{stub: c.stub, req: &entpb.ListTodoRequest{}}
+}
+
+// TodoQuery is the builder querying Todo entities.
+type TodoQuery struct {
+	stub entpb.TodoServiceClient
+	req  *entpb.ListTodoRequest
+}
+
+// WhereId filters the entities by their id field.
+func (q *TodoQuery) WhereId(f *entpb.TodoFilter_Int64Filter) *TodoQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.TodoFilter{}
+	}
+	q.req.Filter.Id = f
+	return q
+}
+
+// WhereTask filters the entities by their task field.
+func (q *TodoQuery) WhereTask(f *entpb.TodoFilter_StringFilter) *TodoQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.TodoFilter{}
+	}
+	q.req.Filter.Task = f
+	return q
+}
+
+// OrderBy orders the entities by the given order_by expression, e.g. "points desc, user_name".
+func (q *TodoQuery) OrderBy(orderBy string) *TodoQuery {
+	q.req.OrderBy = orderBy
+	return q
+}
+
+// PageSize sets the maximum number of entities of the pages.
+func (q *TodoQuery) PageSize(n int32) *TodoQuery {
+	q.req.PageSize = n
+	return q
+}
+
+// Page returns the entities of the page starting at the given page token, empty for the first page, and the
+// token of the next page, empty for the last page.
+func (q *TodoQuery) Page(ctx context.Context, token string) ([]*entpb.Todo, string, error) {
+	req := proto.Clone(q.req).(*entpb.ListTodoRequest)
+	req.PageToken = token
+	res, err := q.stub.List(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+	return res.GetTodoList(), res.GetNextPageToken(), nil
+}
+
+// All returns the entities of all the pages.
+func (q *TodoQuery) All(ctx context.Context) ([]*entpb.Todo, error) {
+	var (
+		all   []*entpb.Todo
+		token string
+	)
+	for {
+		list, next, err := q.Page(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list...)
+		if next == "" {
+			return all, nil
+		}
+		token = next
+	}
+}
+
+// UserClient is the client of the UserService.
+type UserClient struct {
+	stub entpb.UserServiceClient
+}
+
+// Create returns a builder creating one User with the Create method of the service.
+func (c *UserClient) Create() *UserCreate {
+	return &UserCreate{stub: c.stub, msg: &entpb.User{}}
+}
+
+// UserCreate is the builder creating one User.
+type UserCreate struct {
+	stub entpb.UserServiceClient
+	msg  *entpb.User
+}
+
+// SetId sets the id field.
+func (c *UserCreate) SetId(v uint32) *UserCreate {
+	c.msg.Id = v
+	return c
+}
+
+// SetUserName sets the user_name field.
+func (c *UserCreate) SetUserName(v string) *UserCreate {
+	c.msg.UserName = v
+	return c
+}
+
+// SetJoined sets the joined field.
+func (c *UserCreate) SetJoined(v time.Time) *UserCreate {
+	c.msg.Joined = timestamppb.New(v)
+	return c
+}
+
+// SetPoints sets the points field.
+func (c *UserCreate) SetPoints(v uint32) *UserCreate {
+	c.msg.Points = v
+	return c
+}
+
+// SetExp sets the exp field.
+func (c *UserCreate) SetExp(v uint64) *UserCreate {
+	c.msg.Exp = v
+	return c
+}
+
+// SetStatus sets the status field.
+func (c *UserCreate) SetStatus(v entpb.User_Status) *UserCreate {
+	c.msg.Status = v
+	return c
+}
+
+// SetExternalId sets the external_id field.
+func (c *UserCreate) SetExternalId(v int64) *UserCreate {
+	c.msg.ExternalId = v
+	return c
+}
+
+// SetCrmId sets the crm_id field.
+func (c *UserCreate) SetCrmId(v []byte) *UserCreate {
+	c.msg.CrmId = v
+	return c
+}
+
+// SetBanned sets the banned field.
+func (c *UserCreate) SetBanned(v bool) *UserCreate {
+	c.msg.Banned = v
+	return c
+}
+
+// SetCustomPb sets the custom_pb field.
+func (c *UserCreate) SetCustomPb(v uint64) *UserCreate {
+	c.msg.CustomPb = v
+	return c
+}
+
+// SetOptNum sets the opt_num field.
+func (c *UserCreate) SetOptNum(v int64) *UserCreate {
+	c.msg.OptNum = wrapperspb.Int64(v)
+	return c
+}
+
+// SetOptStr sets the opt_str field.
+func (c *UserCreate) SetOptStr(v string) *UserCreate {
+	c.msg.OptStr = wrapperspb.String(v)
+	return c
+}
+
+// SetOptBool sets the opt_bool field.
+func (c *UserCreate) SetOptBool(v bool) *UserCreate {
+	c.msg.OptBool = wrapperspb.Bool(v)
+	return c
+}
+
+// SetBigInt sets the big_int field.
+func (c *UserCreate) SetBigInt(v string) *UserCreate {
+	c.msg.BigInt = wrapperspb.String(v)
+	return c
+}
+
+// SetBUser_1 sets the b_user_1 field.
+func (c *UserCreate) SetBUser_1(v int64) *UserCreate {
+	c.msg.BUser_1 = wrapperspb.Int64(v)
+	return c
+}
+
+// SetHeightInCm sets the height_in_cm field.
+func (c *UserCreate) SetHeightInCm(v float32) *UserCreate {
+	c.msg.HeightInCm = v
+	return c
+}
+
+// SetAccountBalance sets the account_balance field.
+func (c *UserCreate) SetAccountBalance(v float64) *UserCreate {
+	c.msg.AccountBalance = v
+	return c
+}
+
+// SetType sets the type field.
+func (c *UserCreate) SetType(v string) *UserCreate {
+	c.msg.Type = wrapperspb.String(v)
+	return c
+}
+
+// SetLabels sets the labels field.
+func (c *UserCreate) SetLabels(v []string) *UserCreate {
+	c.msg.Labels = v
+	return c
+}
+
+// SetDeviceType sets the device_type field.
+func (c *UserCreate) SetDeviceType(v entpb.User_DeviceType) *UserCreate {
+	c.msg.DeviceType = v
+	return c
+}
+
+// SetCreatedAt sets the created_at field.
+func (c *UserCreate) SetCreatedAt(v time.Time) *UserCreate {
+	c.msg.CreatedAt = timestamppb.New(v)
+	return c
+}
+
+// SetOmitPrefix sets the omit_prefix field.
+func (c *UserCreate) SetOmitPrefix(v entpb.User_OmitPrefix) *UserCreate {
+	c.msg.OmitPrefix = v
+	return c
+}
+
+// SetGroup sets the group field.
+func (c *UserCreate) SetGroup(v *entpb.Group) *UserCreate {
+	c.msg.Group = v
+	return c
+}
+
+// SetAttachment sets the attachment field.
+func (c *UserCreate) SetAttachment(v *entpb.Attachment) *UserCreate {
+	c.msg.Attachment = v
+	return c
+}
+
+// SetReceived_1 sets the received_1 field.
+func (c *UserCreate) SetReceived_1(v []*entpb.Attachment) *UserCreate {
+	c.msg.Received_1 = v
+	return c
+}
+
+// SetPet sets the pet field.
+func (c *UserCreate) SetPet(v *entpb.Pet) *UserCreate {
+	c.msg.Pet = v
+	return c
+}
+
+// SetFriendIds sets the friend_ids field.
+func (c *UserCreate) SetFriendIds(v []uint32) *UserCreate {
+	c.msg.FriendIds = v
+	return c
+}
+
+// Save creates the User and returns it.
+func (c *UserCreate) Save(ctx context.Context) (*entpb.User, error) {
+	return c.stub.Create(ctx, &entpb.CreateUserRequest{User: c.msg})
+}
+
+// UpdateOneID returns a builder updating the User with the given id with the Update method of the
+// service. Only the fields set by the builder are updated.
+func (c *UserClient) UpdateOneID(id uint32) *UserUpdateOne {
+	return &UserUpdateOne{stub: c.stub, msg: &entpb.User{Id: id}}
+}
+
+// UserUpdateOne is the builder updating one User.
+type UserUpdateOne struct {
+	stub entpb.UserServiceClient
+	msg  *entpb.User
+	// paths are the paths of the update mask, the fields set by the builder.
+	paths []string
+}
+
+// SetUserName sets the user_name field.
+func (u *UserUpdateOne) SetUserName(v string) *UserUpdateOne {
+	u.msg.UserName = v
+	u.paths = append(u.paths, "user_name")
+	return u
+}
+
+// SetJoined sets the joined field.
+func (u *UserUpdateOne) SetJoined(v time.Time) *UserUpdateOne {
+	u.msg.Joined = timestamppb.New(v)
+	u.paths = append(u.paths, "joined")
+	return u
+}
+
+// SetPoints sets the points field.
+func (u *UserUpdateOne) SetPoints(v uint32) *UserUpdateOne {
+	u.msg.Points = v
+	u.paths = append(u.paths, "points")
+	return u
+}
+
+// SetExp sets the exp field.
+func (u *UserUpdateOne) SetExp(v uint64) *UserUpdateOne {
+	u.msg.Exp = v
+	u.paths = append(u.paths, "exp")
+	return u
+}
+
+// SetStatus sets the status field.
+func (u *UserUpdateOne) SetStatus(v entpb.User_Status) *UserUpdateOne {
+	u.msg.Status = v
+	u.paths = append(u.paths, "status")
+	return u
+}
+
+// SetExternalId sets the external_id field.
+func (u *UserUpdateOne) SetExternalId(v int64) *UserUpdateOne {
+	u.msg.ExternalId = v
+	u.paths = append(u.paths, "external_id")
+	return u
+}
+
+// SetCrmId sets the crm_id field.
+func (u *UserUpdateOne) SetCrmId(v []byte) *UserUpdateOne {
+	u.msg.CrmId = v
+	u.paths = append(u.paths, "crm_id")
+	return u
+}
+
+// SetBanned sets the banned field.
+func (u *UserUpdateOne) SetBanned(v bool) *UserUpdateOne {
+	u.msg.Banned = v
+	u.paths = append(u.paths, "banned")
+	return u
+}
+
+// SetCustomPb sets the custom_pb field.
+func (u *UserUpdateOne) SetCustomPb(v uint64) *UserUpdateOne {
+	u.msg.CustomPb = v
+	u.paths = append(u.paths, "custom_pb")
+	return u
+}
+
+// SetOptNum sets the opt_num field.
+func (u *UserUpdateOne) SetOptNum(v int64) *UserUpdateOne {
+	u.msg.OptNum = wrapperspb.Int64(v)
+	u.paths = append(u.paths, "opt_num")
+	return u
+}
+
+// SetOptStr sets the opt_str field.
+func (u *UserUpdateOne) SetOptStr(v string) *UserUpdateOne {
+	u.msg.OptStr = wrapperspb.String(v)
+	u.paths = append(u.paths, "opt_str")
+	return u
+}
+
+// SetOptBool sets the opt_bool field.
+func (u *UserUpdateOne) SetOptBool(v bool) *UserUpdateOne {
+	u.msg.OptBool = wrapperspb.Bool(v)
+	u.paths = append(u.paths, "opt_bool")
+	return u
+}
+
+// SetBigInt sets the big_int field.
+func (u *UserUpdateOne) SetBigInt(v string) *UserUpdateOne {
+	u.msg.BigInt = wrapperspb.String(v)
+	u.paths = append(u.paths, "big_int")
+	return u
+}
+
+// SetBUser_1 sets the b_user_1 field.
+func (u *UserUpdateOne) SetBUser_1(v int64) *UserUpdateOne {
+	u.msg.BUser_1 = wrapperspb.Int64(v)
+	u.paths = append(u.paths, "b_user_1")
+	return u
+}
+
+// SetHeightInCm sets the height_in_cm field.
+func (u *UserUpdateOne) SetHeightInCm(v float32) *UserUpdateOne {
+	u.msg.HeightInCm = v
+	u.paths = append(u.paths, "height_in_cm")
+	return u
+}
+
+// SetAccountBalance sets the account_balance field.
+func (u *UserUpdateOne) SetAccountBalance(v float64) *UserUpdateOne {
+	u.msg.AccountBalance = v
+	u.paths = append(u.paths, "account_balance")
+	return u
+}
+
+// SetType sets the type field.
+func (u *UserUpdateOne) SetType(v string) *UserUpdateOne {
+	u.msg.Type = wrapperspb.String(v)
+	u.paths = append(u.paths, "type")
+	return u
+}
+
+// SetLabels sets the labels field.
+func (u *UserUpdateOne) SetLabels(v []string) *UserUpdateOne {
+	u.msg.Labels = v
+	u.paths = append(u.paths, "labels")
+	return u
+}
+
+// SetDeviceType sets the device_type field.
+func (u *UserUpdateOne) SetDeviceType(v entpb.User_DeviceType) *UserUpdateOne {
+	u.msg.DeviceType = v
+	u.paths = append(u.paths, "device_type")
+	return u
+}
+
+// SetCreatedAt sets the created_at field.
+func (u *UserUpdateOne) SetCreatedAt(v time.Time) *UserUpdateOne {
+	u.msg.CreatedAt = timestamppb.New(v)
+	u.paths = append(u.paths, "created_at")
+	return u
+}
+
+// SetOmitPrefix sets the omit_prefix field.
+func (u *UserUpdateOne) SetOmitPrefix(v entpb.User_OmitPrefix) *UserUpdateOne {
+	u.msg.OmitPrefix = v
+	u.paths = append(u.paths, "omit_prefix")
+	return u
+}
+
+// SetGroup sets the group field.
+func (u *UserUpdateOne) SetGroup(v *entpb.Group) *UserUpdateOne {
+	u.msg.Group = v
+	u.paths = append(u.paths, "group")
+	return u
+}
+
+// SetAttachment sets the attachment field.
+func (u *UserUpdateOne) SetAttachment(v *entpb.Attachment) *UserUpdateOne {
+	u.msg.Attachment = v
+	u.paths = append(u.paths, "attachment")
+	return u
+}
+
+// SetReceived_1 sets the received_1 field.
+func (u *UserUpdateOne) SetReceived_1(v []*entpb.Attachment) *UserUpdateOne {
+	u.msg.Received_1 = v
+	u.paths = append(u.paths, "received_1")
+	return u
+}
+
+// SetPet sets the pet field.
+func (u *UserUpdateOne) SetPet(v *entpb.Pet) *UserUpdateOne {
+	u.msg.Pet = v
+	u.paths = append(u.paths, "pet")
+	return u
+}
+
+// SetFriendIds sets the friend_ids field.
+func (u *UserUpdateOne) SetFriendIds(v []uint32) *UserUpdateOne {
+	u.msg.FriendIds = v
+	u.paths = append(u.paths, "friend_ids")
+	return u
+}
+
+// Save updates the User and returns it. As empty update masks update all the fields, at least one field must be set.
+func (u *UserUpdateOne) Save(ctx context.Context) (*entpb.User, error) {
+	return u.stub.Update(ctx, &entpb.UpdateUserRequest{
+		User:       u.msg,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: u.paths},
+	})
+}
+
+// Get returns the User with the given id.
+func (c *UserClient) Get(ctx context.Context, id uint32) (*entpb.User, error) {
+	return c.stub.Get(ctx, &entpb.GetUserRequest{Id: id})
+}
+
+// DeleteOneID returns a builder deleting the User with the given id with the Delete method of the
+// service.
+func (c *UserClient) DeleteOneID(id uint32) *UserDeleteOne {
+	return &UserDeleteOne{stub: c.stub, req: &entpb.DeleteUserRequest{Id: id}}
+}
+
+// UserDeleteOne is the builder deleting one User.
+type UserDeleteOne struct {
+	stub entpb.UserServiceClient
+	req  *entpb.DeleteUserRequest
+}
+
+// Exec deletes the User.
+func (d *UserDeleteOne) Exec(ctx context.Context) error {
+	_, err := d.stub.Delete(ctx, d.req)
+	return err
+}
+
+// Query returns a builder querying the User entities with the List method of the service.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{stub: c.stub, req: &entpb.ListUserRequest{}}
+}
+
+// UserQuery is the builder querying User entities.
+type UserQuery struct {
+	stub entpb.UserServiceClient
+	req  *entpb.ListUserRequest
+}
+
+// WhereId filters the entities by their id field.
+func (q *UserQuery) WhereId(f *entpb.UserFilter_UInt32Filter) *UserQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.UserFilter{}
+	}
+	q.req.Filter.Id = f
+	return q
+}
+
+// WhereUserName filters the entities by their user_name field.
+func (q *UserQuery) WhereUserName(f *entpb.UserFilter_StringFilter) *UserQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.UserFilter{}
+	}
+	q.req.Filter.UserName = f
+	return q
+}
+
+// WhereJoined filters the entities by their joined field.
+func (q *UserQuery) WhereJoined(f *entpb.UserFilter_TimestampFilter) *UserQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.UserFilter{}
+	}
+	q.req.Filter.Joined = f
+	return q
+}
+
+// WherePoints filters the entities by their points field.
+func (q *UserQuery) WherePoints(f *entpb.UserFilter_UInt32Filter) *UserQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.UserFilter{}
+	}
+	q.req.Filter.Points = f
+	return q
+}
+
+// WhereExp filters the entities by their exp field.
+func (q *UserQuery) WhereExp(f *entpb.UserFilter_UInt64Filter) *UserQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.UserFilter{}
+	}
+	q.req.Filter.Exp = f
+	return q
+}
+
+// WhereExternalId filters the entities by their external_id field.
+func (q *UserQuery) WhereExternalId(f *entpb.UserFilter_Int64Filter) *UserQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.UserFilter{}
+	}
+	q.req.Filter.ExternalId = f
+	return q
+}
+
+// WhereBanned filters the entities by their banned field.
+func (q *UserQuery) WhereBanned(f *entpb.UserFilter_BoolFilter) *UserQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.UserFilter{}
+	}
+	q.req.Filter.Banned = f
+	return q
+}
+
+// WhereOptNum filters the entities by their opt_num field.
+func (q *UserQuery) WhereOptNum(f *entpb.UserFilter_Int64Filter) *UserQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.UserFilter{}
+	}
+	q.req.Filter.OptNum = f
+	return q
+}
+
+// WhereOptStr filters the entities by their opt_str field.
+func (q *UserQuery) WhereOptStr(f *entpb.UserFilter_StringFilter) *UserQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.UserFilter{}
+	}
+	q.req.Filter.OptStr = f
+	return q
+}
+
+// WhereOptBool filters the entities by their opt_bool field.
+func (q *UserQuery) WhereOptBool(f *entpb.UserFilter_BoolFilter) *UserQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.UserFilter{}
+	}
+	q.req.Filter.OptBool = f
+	return q
+}
+
+// WhereBUser_1 filters the entities by their b_user_1 field.
+func (q *UserQuery) WhereBUser_1(f *entpb.UserFilter_Int64Filter) *UserQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.UserFilter{}
+	}
+	q.req.Filter.BUser_1 = f
+	return q
+}
+
+// WhereHeightInCm filters the entities by their height_in_cm field.
+func (q *UserQuery) WhereHeightInCm(f *entpb.UserFilter_FloatFilter) *UserQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.UserFilter{}
+	}
+	q.req.Filter.HeightInCm = f
+	return q
+}
+
+// WhereAccountBalance filters the entities by their account_balance field.
+func (q *UserQuery) WhereAccountBalance(f *entpb.UserFilter_DoubleFilter) *UserQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.UserFilter{}
+	}
+	q.req.Filter.AccountBalance = f
+	return q
+}
+
+// WhereType filters the entities by their type field.
+func (q *UserQuery) WhereType(f *entpb.UserFilter_StringFilter) *UserQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.UserFilter{}
+	}
+	q.req.Filter.Type = f
+	return q
+}
+
+// WhereCreatedAt filters the entities by their created_at field.
+func (q *UserQuery) WhereCreatedAt(f *entpb.UserFilter_TimestampFilter) *UserQuery {
+	if q.req.Filter == nil {
+		q.req.Filter = &entpb.UserFilter{}
+	}
+	q.req.Filter.CreatedAt = f
+	return q
+}
+
+// OrderBy orders the entities by the given order_by expression, e.g. "points desc, user_name".
+func (q *UserQuery) OrderBy(orderBy string) *UserQuery {
+	q.req.OrderBy = orderBy
+	return q
+}
+
+// PageSize sets the maximum number of entities of the pages.
+func (q *UserQuery) PageSize(n int32) *UserQuery {
+	q.req.PageSize = n
+	return q
+}
+
+// Page returns the entities of the page starting at the given page token, empty for the first page, and the
+// token of the next page, empty for the last page.
+func (q *UserQuery) Page(ctx context.Context, token string) ([]*entpb.User, string, error) {
+	req := proto.Clone(q.req).(*entpb.ListUserRequest)
+	req.PageToken = token
+	res, err := q.stub.List(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+	return res.GetUserList(), res.GetNextPageToken(), nil
+}
+
+// All returns the entities of all the pages.
+func (q *UserQuery) All(ctx context.Context) ([]*entpb.User, error) {
+	var (
+		all   []*entpb.User
+		token string
+	)
+	for {
+		list, next, err := q.Page(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list...)
+		if next == "" {
+			return all, nil
+		}
+		token = next
+	}
+}
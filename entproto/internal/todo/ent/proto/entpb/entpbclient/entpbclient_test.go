@@ -0,0 +1,107 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entpbclient
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
+	"entgo.io/contrib/entproto/internal/todo/ent/proto/entpb"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestClient_User(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	entpb.RegisterUserServiceServer(srv, entpb.NewUserService(client))
+	go srv.Serve(lis)
+	defer srv.Stop()
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer cc.Close()
+	c := NewClient(cc)
+	ctx := context.Background()
+
+	create := func(i int) *entpb.User {
+		crmID, err := uuid.New().MarshalBinary()
+		require.NoError(t, err)
+		u, err := c.User.Create().
+			SetUserName(fmt.Sprintf("User%d", i)).
+			SetExternalId(int64(i)).
+			SetJoined(time.Now()).
+			SetExp(1000).
+			SetPoints(uint32(i)).
+			SetStatus(entpb.User_STATUS_ACTIVE).
+			SetCrmId(crmID).
+			SetCustomPb(1).
+			SetOmitPrefix(entpb.User_BAR).
+			SetOptStr("optional").
+			Save(ctx)
+		require.NoError(t, err)
+		return u
+	}
+	for i := 0; i < 5; i++ {
+		create(i)
+	}
+	u := create(5)
+	require.Equal(t, "optional", u.GetOptStr().GetValue())
+
+	// Only the fields set by the update builder are updated.
+	updated, err := c.User.UpdateOneID(u.GetId()).SetPoints(100).Save(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, updated.GetPoints())
+	got, err := c.User.Get(ctx, u.GetId())
+	require.NoError(t, err)
+	require.Equal(t, "User5", got.GetUserName())
+	require.EqualValues(t, 100, got.GetPoints())
+
+	// Queries iterate over the pages of the List method.
+	list, next, err := c.User.Query().PageSize(2).Page(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.NotEmpty(t, next)
+	all, err := c.User.Query().
+		WhereUserName(&entpb.UserFilter_StringFilter{Neq: wrapperspb.String("User0")}).
+		OrderBy("user_name desc").
+		PageSize(2).
+		All(ctx)
+	require.NoError(t, err)
+	var names []string
+	for _, u := range all {
+		names = append(names, u.GetUserName())
+	}
+	require.Equal(t, []string{"User5", "User4", "User3", "User2", "User1"}, names)
+
+	require.NoError(t, c.User.DeleteOneID(u.GetId()).Exec(ctx))
+	_, err = c.User.Get(ctx, u.GetId())
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,1942 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpbfields
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Field numbers of the entpb.Attachment message.
+const (
+	Attachment_Id_FieldNumber         protoreflect.FieldNumber = 1
+	Attachment_User_FieldNumber       protoreflect.FieldNumber = 2
+	Attachment_Recipients_FieldNumber protoreflect.FieldNumber = 3
+)
+
+// Field names of the entpb.Attachment message, as used by the paths of field masks.
+const (
+	Attachment_Id_FieldName         protoreflect.Name = "id"
+	Attachment_User_FieldName       protoreflect.Name = "user"
+	Attachment_Recipients_FieldName protoreflect.Name = "recipients"
+)
+
+// Field numbers of the entpb.CreateAttachmentRequest message.
+const (
+	CreateAttachmentRequest_Attachment_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.CreateAttachmentRequest message, as used by the paths of field masks.
+const (
+	CreateAttachmentRequest_Attachment_FieldName protoreflect.Name = "attachment"
+)
+
+// Field numbers of the entpb.GetAttachmentRequest message.
+const (
+	GetAttachmentRequest_Id_FieldNumber   protoreflect.FieldNumber = 1
+	GetAttachmentRequest_View_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.GetAttachmentRequest message, as used by the paths of field masks.
+const (
+	GetAttachmentRequest_Id_FieldName   protoreflect.Name = "id"
+	GetAttachmentRequest_View_FieldName protoreflect.Name = "view"
+)
+
+// Field numbers of the entpb.ExistsAttachmentRequest message.
+const (
+	ExistsAttachmentRequest_Id_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.ExistsAttachmentRequest message, as used by the paths of field masks.
+const (
+	ExistsAttachmentRequest_Id_FieldName protoreflect.Name = "id"
+)
+
+// Field numbers of the entpb.ExistsAttachmentResponse message.
+const (
+	ExistsAttachmentResponse_Exists_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.ExistsAttachmentResponse message, as used by the paths of field masks.
+const (
+	ExistsAttachmentResponse_Exists_FieldName protoreflect.Name = "exists"
+)
+
+// Field numbers of the entpb.UpdateAttachmentRequest message.
+const (
+	UpdateAttachmentRequest_Attachment_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.UpdateAttachmentRequest message, as used by the paths of field masks.
+const (
+	UpdateAttachmentRequest_Attachment_FieldName protoreflect.Name = "attachment"
+)
+
+// Field numbers of the entpb.DeleteAttachmentRequest message.
+const (
+	DeleteAttachmentRequest_Id_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.DeleteAttachmentRequest message, as used by the paths of field masks.
+const (
+	DeleteAttachmentRequest_Id_FieldName protoreflect.Name = "id"
+)
+
+// Field numbers of the entpb.ListAttachmentRequest message.
+const (
+	ListAttachmentRequest_PageSize_FieldNumber  protoreflect.FieldNumber = 1
+	ListAttachmentRequest_PageToken_FieldNumber protoreflect.FieldNumber = 2
+	ListAttachmentRequest_View_FieldNumber      protoreflect.FieldNumber = 3
+	ListAttachmentRequest_OrderBy_FieldNumber   protoreflect.FieldNumber = 4
+)
+
+// Field names of the entpb.ListAttachmentRequest message, as used by the paths of field masks.
+const (
+	ListAttachmentRequest_PageSize_FieldName  protoreflect.Name = "page_size"
+	ListAttachmentRequest_PageToken_FieldName protoreflect.Name = "page_token"
+	ListAttachmentRequest_View_FieldName      protoreflect.Name = "view"
+	ListAttachmentRequest_OrderBy_FieldName   protoreflect.Name = "order_by"
+)
+
+// Field numbers of the entpb.ListAttachmentResponse message.
+const (
+	ListAttachmentResponse_AttachmentList_FieldNumber protoreflect.FieldNumber = 1
+	ListAttachmentResponse_NextPageToken_FieldNumber  protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.ListAttachmentResponse message, as used by the paths of field masks.
+const (
+	ListAttachmentResponse_AttachmentList_FieldName protoreflect.Name = "attachment_list"
+	ListAttachmentResponse_NextPageToken_FieldName  protoreflect.Name = "next_page_token"
+)
+
+// Field numbers of the entpb.CountAttachmentsResponse message.
+const (
+	CountAttachmentsResponse_Count_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.CountAttachmentsResponse message, as used by the paths of field masks.
+const (
+	CountAttachmentsResponse_Count_FieldName protoreflect.Name = "count"
+)
+
+// Field numbers of the entpb.BatchCreateAttachmentsRequest message.
+const (
+	BatchCreateAttachmentsRequest_Requests_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreateAttachmentsRequest message, as used by the paths of field masks.
+const (
+	BatchCreateAttachmentsRequest_Requests_FieldName protoreflect.Name = "requests"
+)
+
+// Field numbers of the entpb.BatchCreateAttachmentsResponse message.
+const (
+	BatchCreateAttachmentsResponse_Results_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreateAttachmentsResponse message, as used by the paths of field masks.
+const (
+	BatchCreateAttachmentsResponse_Results_FieldName protoreflect.Name = "results"
+)
+
+// Field numbers of the entpb.BatchCreateAttachmentResult message.
+const (
+	BatchCreateAttachmentResult_Attachment_FieldNumber protoreflect.FieldNumber = 1
+	BatchCreateAttachmentResult_Status_FieldNumber     protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.BatchCreateAttachmentResult message, as used by the paths of field masks.
+const (
+	BatchCreateAttachmentResult_Attachment_FieldName protoreflect.Name = "attachment"
+	BatchCreateAttachmentResult_Status_FieldName     protoreflect.Name = "status"
+)
+
+// Field numbers of the entpb.BatchDeleteAttachmentsRequest message.
+const (
+	BatchDeleteAttachmentsRequest_Ids_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchDeleteAttachmentsRequest message, as used by the paths of field masks.
+const (
+	BatchDeleteAttachmentsRequest_Ids_FieldName protoreflect.Name = "ids"
+)
+
+// Field numbers of the entpb.ListAttachmentRecipientsRequest message.
+const (
+	ListAttachmentRecipientsRequest_Id_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.ListAttachmentRecipientsRequest message, as used by the paths of field masks.
+const (
+	ListAttachmentRecipientsRequest_Id_FieldName protoreflect.Name = "id"
+)
+
+// Field numbers of the entpb.ListAttachmentRecipientsResponse message.
+const (
+	ListAttachmentRecipientsResponse_RecipientIds_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.ListAttachmentRecipientsResponse message, as used by the paths of field masks.
+const (
+	ListAttachmentRecipientsResponse_RecipientIds_FieldName protoreflect.Name = "recipient_ids"
+)
+
+// Field numbers of the entpb.AddAttachmentRecipientRequest message.
+const (
+	AddAttachmentRecipientRequest_Id_FieldNumber          protoreflect.FieldNumber = 1
+	AddAttachmentRecipientRequest_RecipientId_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.AddAttachmentRecipientRequest message, as used by the paths of field masks.
+const (
+	AddAttachmentRecipientRequest_Id_FieldName          protoreflect.Name = "id"
+	AddAttachmentRecipientRequest_RecipientId_FieldName protoreflect.Name = "recipient_id"
+)
+
+// Field numbers of the entpb.RemoveAttachmentRecipientRequest message.
+const (
+	RemoveAttachmentRecipientRequest_Id_FieldNumber          protoreflect.FieldNumber = 1
+	RemoveAttachmentRecipientRequest_RecipientId_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.RemoveAttachmentRecipientRequest message, as used by the paths of field masks.
+const (
+	RemoveAttachmentRecipientRequest_Id_FieldName          protoreflect.Name = "id"
+	RemoveAttachmentRecipientRequest_RecipientId_FieldName protoreflect.Name = "recipient_id"
+)
+
+// Field numbers of the entpb.Document message.
+const (
+	Document_Id_FieldNumber        protoreflect.FieldNumber = 1
+	Document_TenantId_FieldNumber  protoreflect.FieldNumber = 2
+	Document_Title_FieldNumber     protoreflect.FieldNumber = 3
+	Document_RequestId_FieldNumber protoreflect.FieldNumber = 4
+	Document_ParentId_FieldNumber  protoreflect.FieldNumber = 5
+)
+
+// Field names of the entpb.Document message, as used by the paths of field masks.
+const (
+	Document_Id_FieldName        protoreflect.Name = "id"
+	Document_TenantId_FieldName  protoreflect.Name = "tenant_id"
+	Document_Title_FieldName     protoreflect.Name = "title"
+	Document_RequestId_FieldName protoreflect.Name = "request_id"
+	Document_ParentId_FieldName  protoreflect.Name = "parent_id"
+)
+
+// Field numbers of the entpb.CreateDocumentRequest message.
+const (
+	CreateDocumentRequest_Document_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.CreateDocumentRequest message, as used by the paths of field masks.
+const (
+	CreateDocumentRequest_Document_FieldName protoreflect.Name = "document"
+)
+
+// Field numbers of the entpb.GetDocumentRequest message.
+const (
+	GetDocumentRequest_Id_FieldNumber   protoreflect.FieldNumber = 1
+	GetDocumentRequest_View_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.GetDocumentRequest message, as used by the paths of field masks.
+const (
+	GetDocumentRequest_Id_FieldName   protoreflect.Name = "id"
+	GetDocumentRequest_View_FieldName protoreflect.Name = "view"
+)
+
+// Field numbers of the entpb.UpdateDocumentRequest message.
+const (
+	UpdateDocumentRequest_Document_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.UpdateDocumentRequest message, as used by the paths of field masks.
+const (
+	UpdateDocumentRequest_Document_FieldName protoreflect.Name = "document"
+)
+
+// Field numbers of the entpb.DeleteDocumentRequest message.
+const (
+	DeleteDocumentRequest_Id_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.DeleteDocumentRequest message, as used by the paths of field masks.
+const (
+	DeleteDocumentRequest_Id_FieldName protoreflect.Name = "id"
+)
+
+// Field numbers of the entpb.DocumentFilter message.
+const (
+	DocumentFilter_Id_FieldNumber        protoreflect.FieldNumber = 1
+	DocumentFilter_TenantId_FieldNumber  protoreflect.FieldNumber = 2
+	DocumentFilter_Title_FieldNumber     protoreflect.FieldNumber = 3
+	DocumentFilter_RequestId_FieldNumber protoreflect.FieldNumber = 4
+)
+
+// Field names of the entpb.DocumentFilter message, as used by the paths of field masks.
+const (
+	DocumentFilter_Id_FieldName        protoreflect.Name = "id"
+	DocumentFilter_TenantId_FieldName  protoreflect.Name = "tenant_id"
+	DocumentFilter_Title_FieldName     protoreflect.Name = "title"
+	DocumentFilter_RequestId_FieldName protoreflect.Name = "request_id"
+)
+
+// Field numbers of the entpb.DeleteDocumentsRequest message.
+const (
+	DeleteDocumentsRequest_Filter_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.DeleteDocumentsRequest message, as used by the paths of field masks.
+const (
+	DeleteDocumentsRequest_Filter_FieldName protoreflect.Name = "filter"
+)
+
+// Field numbers of the entpb.DeleteDocumentsResponse message.
+const (
+	DeleteDocumentsResponse_Deleted_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.DeleteDocumentsResponse message, as used by the paths of field masks.
+const (
+	DeleteDocumentsResponse_Deleted_FieldName protoreflect.Name = "deleted"
+)
+
+// Field numbers of the entpb.ListDocumentRequest message.
+const (
+	ListDocumentRequest_PageSize_FieldNumber  protoreflect.FieldNumber = 1
+	ListDocumentRequest_PageToken_FieldNumber protoreflect.FieldNumber = 2
+	ListDocumentRequest_View_FieldNumber      protoreflect.FieldNumber = 3
+	ListDocumentRequest_OrderBy_FieldNumber   protoreflect.FieldNumber = 4
+	ListDocumentRequest_Filter_FieldNumber    protoreflect.FieldNumber = 5
+)
+
+// Field names of the entpb.ListDocumentRequest message, as used by the paths of field masks.
+const (
+	ListDocumentRequest_PageSize_FieldName  protoreflect.Name = "page_size"
+	ListDocumentRequest_PageToken_FieldName protoreflect.Name = "page_token"
+	ListDocumentRequest_View_FieldName      protoreflect.Name = "view"
+	ListDocumentRequest_OrderBy_FieldName   protoreflect.Name = "order_by"
+	ListDocumentRequest_Filter_FieldName    protoreflect.Name = "filter"
+)
+
+// Field numbers of the entpb.ListDocumentResponse message.
+const (
+	ListDocumentResponse_DocumentList_FieldNumber  protoreflect.FieldNumber = 1
+	ListDocumentResponse_NextPageToken_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.ListDocumentResponse message, as used by the paths of field masks.
+const (
+	ListDocumentResponse_DocumentList_FieldName  protoreflect.Name = "document_list"
+	ListDocumentResponse_NextPageToken_FieldName protoreflect.Name = "next_page_token"
+)
+
+// Field numbers of the entpb.CountDocumentsRequest message.
+const (
+	CountDocumentsRequest_Filter_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.CountDocumentsRequest message, as used by the paths of field masks.
+const (
+	CountDocumentsRequest_Filter_FieldName protoreflect.Name = "filter"
+)
+
+// Field numbers of the entpb.CountDocumentsResponse message.
+const (
+	CountDocumentsResponse_Count_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.CountDocumentsResponse message, as used by the paths of field masks.
+const (
+	CountDocumentsResponse_Count_FieldName protoreflect.Name = "count"
+)
+
+// Field numbers of the entpb.BatchCreateDocumentsRequest message.
+const (
+	BatchCreateDocumentsRequest_Requests_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreateDocumentsRequest message, as used by the paths of field masks.
+const (
+	BatchCreateDocumentsRequest_Requests_FieldName protoreflect.Name = "requests"
+)
+
+// Field numbers of the entpb.BatchCreateDocumentsResponse message.
+const (
+	BatchCreateDocumentsResponse_Documents_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreateDocumentsResponse message, as used by the paths of field masks.
+const (
+	BatchCreateDocumentsResponse_Documents_FieldName protoreflect.Name = "documents"
+)
+
+// Field numbers of the entpb.Group message.
+const (
+	Group_Id_FieldNumber    protoreflect.FieldNumber = 1
+	Group_Name_FieldNumber  protoreflect.FieldNumber = 2
+	Group_Users_FieldNumber protoreflect.FieldNumber = 3
+)
+
+// Field names of the entpb.Group message, as used by the paths of field masks.
+const (
+	Group_Id_FieldName    protoreflect.Name = "id"
+	Group_Name_FieldName  protoreflect.Name = "name"
+	Group_Users_FieldName protoreflect.Name = "users"
+)
+
+// Field numbers of the entpb.Label message.
+const (
+	Label_Id_FieldNumber    protoreflect.FieldNumber = 1
+	Label_Color_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.Label message, as used by the paths of field masks.
+const (
+	Label_Id_FieldName    protoreflect.Name = "id"
+	Label_Color_FieldName protoreflect.Name = "color"
+)
+
+// Field numbers of the entpb.CreateLabelRequest message.
+const (
+	CreateLabelRequest_Label_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.CreateLabelRequest message, as used by the paths of field masks.
+const (
+	CreateLabelRequest_Label_FieldName protoreflect.Name = "label"
+)
+
+// Field numbers of the entpb.GetLabelRequest message.
+const (
+	GetLabelRequest_Id_FieldNumber   protoreflect.FieldNumber = 1
+	GetLabelRequest_View_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.GetLabelRequest message, as used by the paths of field masks.
+const (
+	GetLabelRequest_Id_FieldName   protoreflect.Name = "id"
+	GetLabelRequest_View_FieldName protoreflect.Name = "view"
+)
+
+// Field numbers of the entpb.UpdateLabelRequest message.
+const (
+	UpdateLabelRequest_Label_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.UpdateLabelRequest message, as used by the paths of field masks.
+const (
+	UpdateLabelRequest_Label_FieldName protoreflect.Name = "label"
+)
+
+// Field numbers of the entpb.DeleteLabelRequest message.
+const (
+	DeleteLabelRequest_Id_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.DeleteLabelRequest message, as used by the paths of field masks.
+const (
+	DeleteLabelRequest_Id_FieldName protoreflect.Name = "id"
+)
+
+// Field numbers of the entpb.LabelFilter message.
+const (
+	LabelFilter_Id_FieldNumber    protoreflect.FieldNumber = 1
+	LabelFilter_Color_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.LabelFilter message, as used by the paths of field masks.
+const (
+	LabelFilter_Id_FieldName    protoreflect.Name = "id"
+	LabelFilter_Color_FieldName protoreflect.Name = "color"
+)
+
+// Field numbers of the entpb.ListLabelRequest message.
+const (
+	ListLabelRequest_PageSize_FieldNumber  protoreflect.FieldNumber = 1
+	ListLabelRequest_PageToken_FieldNumber protoreflect.FieldNumber = 2
+	ListLabelRequest_View_FieldNumber      protoreflect.FieldNumber = 3
+	ListLabelRequest_OrderBy_FieldNumber   protoreflect.FieldNumber = 4
+	ListLabelRequest_Filter_FieldNumber    protoreflect.FieldNumber = 5
+)
+
+// Field names of the entpb.ListLabelRequest message, as used by the paths of field masks.
+const (
+	ListLabelRequest_PageSize_FieldName  protoreflect.Name = "page_size"
+	ListLabelRequest_PageToken_FieldName protoreflect.Name = "page_token"
+	ListLabelRequest_View_FieldName      protoreflect.Name = "view"
+	ListLabelRequest_OrderBy_FieldName   protoreflect.Name = "order_by"
+	ListLabelRequest_Filter_FieldName    protoreflect.Name = "filter"
+)
+
+// Field numbers of the entpb.ListLabelResponse message.
+const (
+	ListLabelResponse_LabelList_FieldNumber     protoreflect.FieldNumber = 1
+	ListLabelResponse_NextPageToken_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.ListLabelResponse message, as used by the paths of field masks.
+const (
+	ListLabelResponse_LabelList_FieldName     protoreflect.Name = "label_list"
+	ListLabelResponse_NextPageToken_FieldName protoreflect.Name = "next_page_token"
+)
+
+// Field numbers of the entpb.BatchCreateLabelsRequest message.
+const (
+	BatchCreateLabelsRequest_Requests_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreateLabelsRequest message, as used by the paths of field masks.
+const (
+	BatchCreateLabelsRequest_Requests_FieldName protoreflect.Name = "requests"
+)
+
+// Field numbers of the entpb.BatchCreateLabelsResponse message.
+const (
+	BatchCreateLabelsResponse_Labels_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreateLabelsResponse message, as used by the paths of field masks.
+const (
+	BatchCreateLabelsResponse_Labels_FieldName protoreflect.Name = "labels"
+)
+
+// Field numbers of the entpb.ExportLabelRequest message.
+const (
+	ExportLabelRequest_Format_FieldNumber    protoreflect.FieldNumber = 1
+	ExportLabelRequest_Cursor_FieldNumber    protoreflect.FieldNumber = 2
+	ExportLabelRequest_BatchSize_FieldNumber protoreflect.FieldNumber = 3
+)
+
+// Field names of the entpb.ExportLabelRequest message, as used by the paths of field masks.
+const (
+	ExportLabelRequest_Format_FieldName    protoreflect.Name = "format"
+	ExportLabelRequest_Cursor_FieldName    protoreflect.Name = "cursor"
+	ExportLabelRequest_BatchSize_FieldName protoreflect.Name = "batch_size"
+)
+
+// Field numbers of the entpb.ExportLabelResponse message.
+const (
+	ExportLabelResponse_Data_FieldNumber   protoreflect.FieldNumber = 1
+	ExportLabelResponse_Cursor_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.ExportLabelResponse message, as used by the paths of field masks.
+const (
+	ExportLabelResponse_Data_FieldName   protoreflect.Name = "data"
+	ExportLabelResponse_Cursor_FieldName protoreflect.Name = "cursor"
+)
+
+// Field numbers of the entpb.MultiWordSchema message.
+const (
+	MultiWordSchema_Id_FieldNumber   protoreflect.FieldNumber = 1
+	MultiWordSchema_Unit_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.MultiWordSchema message, as used by the paths of field masks.
+const (
+	MultiWordSchema_Id_FieldName   protoreflect.Name = "id"
+	MultiWordSchema_Unit_FieldName protoreflect.Name = "unit"
+)
+
+// Field numbers of the entpb.CreateMultiWordSchemaRequest message.
+const (
+	CreateMultiWordSchemaRequest_MultiWordSchema_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.CreateMultiWordSchemaRequest message, as used by the paths of field masks.
+const (
+	CreateMultiWordSchemaRequest_MultiWordSchema_FieldName protoreflect.Name = "multi_word_schema"
+)
+
+// Field numbers of the entpb.GetMultiWordSchemaRequest message.
+const (
+	GetMultiWordSchemaRequest_Id_FieldNumber   protoreflect.FieldNumber = 1
+	GetMultiWordSchemaRequest_View_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.GetMultiWordSchemaRequest message, as used by the paths of field masks.
+const (
+	GetMultiWordSchemaRequest_Id_FieldName   protoreflect.Name = "id"
+	GetMultiWordSchemaRequest_View_FieldName protoreflect.Name = "view"
+)
+
+// Field numbers of the entpb.UpdateMultiWordSchemaRequest message.
+const (
+	UpdateMultiWordSchemaRequest_MultiWordSchema_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.UpdateMultiWordSchemaRequest message, as used by the paths of field masks.
+const (
+	UpdateMultiWordSchemaRequest_MultiWordSchema_FieldName protoreflect.Name = "multi_word_schema"
+)
+
+// Field numbers of the entpb.DeleteMultiWordSchemaRequest message.
+const (
+	DeleteMultiWordSchemaRequest_Id_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.DeleteMultiWordSchemaRequest message, as used by the paths of field masks.
+const (
+	DeleteMultiWordSchemaRequest_Id_FieldName protoreflect.Name = "id"
+)
+
+// Field numbers of the entpb.MultiWordSchemaFilter message.
+const (
+	MultiWordSchemaFilter_Id_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.MultiWordSchemaFilter message, as used by the paths of field masks.
+const (
+	MultiWordSchemaFilter_Id_FieldName protoreflect.Name = "id"
+)
+
+// Field numbers of the entpb.ListMultiWordSchemaRequest message.
+const (
+	ListMultiWordSchemaRequest_PageSize_FieldNumber  protoreflect.FieldNumber = 1
+	ListMultiWordSchemaRequest_PageToken_FieldNumber protoreflect.FieldNumber = 2
+	ListMultiWordSchemaRequest_View_FieldNumber      protoreflect.FieldNumber = 3
+	ListMultiWordSchemaRequest_OrderBy_FieldNumber   protoreflect.FieldNumber = 4
+	ListMultiWordSchemaRequest_Filter_FieldNumber    protoreflect.FieldNumber = 5
+)
+
+// Field names of the entpb.ListMultiWordSchemaRequest message, as used by the paths of field masks.
+const (
+	ListMultiWordSchemaRequest_PageSize_FieldName  protoreflect.Name = "page_size"
+	ListMultiWordSchemaRequest_PageToken_FieldName protoreflect.Name = "page_token"
+	ListMultiWordSchemaRequest_View_FieldName      protoreflect.Name = "view"
+	ListMultiWordSchemaRequest_OrderBy_FieldName   protoreflect.Name = "order_by"
+	ListMultiWordSchemaRequest_Filter_FieldName    protoreflect.Name = "filter"
+)
+
+// Field numbers of the entpb.ListMultiWordSchemaResponse message.
+const (
+	ListMultiWordSchemaResponse_MultiWordSchemaList_FieldNumber protoreflect.FieldNumber = 1
+	ListMultiWordSchemaResponse_NextPageToken_FieldNumber       protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.ListMultiWordSchemaResponse message, as used by the paths of field masks.
+const (
+	ListMultiWordSchemaResponse_MultiWordSchemaList_FieldName protoreflect.Name = "multi_word_schema_list"
+	ListMultiWordSchemaResponse_NextPageToken_FieldName       protoreflect.Name = "next_page_token"
+)
+
+// Field numbers of the entpb.BatchCreateMultiWordSchemasRequest message.
+const (
+	BatchCreateMultiWordSchemasRequest_Requests_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreateMultiWordSchemasRequest message, as used by the paths of field masks.
+const (
+	BatchCreateMultiWordSchemasRequest_Requests_FieldName protoreflect.Name = "requests"
+)
+
+// Field numbers of the entpb.BatchCreateMultiWordSchemasResponse message.
+const (
+	BatchCreateMultiWordSchemasResponse_MultiWordSchemas_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreateMultiWordSchemasResponse message, as used by the paths of field masks.
+const (
+	BatchCreateMultiWordSchemasResponse_MultiWordSchemas_FieldName protoreflect.Name = "multi_word_schemas"
+)
+
+// Field numbers of the entpb.NilExample message.
+const (
+	NilExample_Id_FieldNumber         protoreflect.FieldNumber = 1
+	NilExample_StrNil_FieldNumber     protoreflect.FieldNumber = 2
+	NilExample_TimeNil_FieldNumber    protoreflect.FieldNumber = 3
+	NilExample_TimeStrNil_FieldNumber protoreflect.FieldNumber = 4
+)
+
+// Field names of the entpb.NilExample message, as used by the paths of field masks.
+const (
+	NilExample_Id_FieldName         protoreflect.Name = "id"
+	NilExample_StrNil_FieldName     protoreflect.Name = "str_nil"
+	NilExample_TimeNil_FieldName    protoreflect.Name = "time_nil"
+	NilExample_TimeStrNil_FieldName protoreflect.Name = "time_str_nil"
+)
+
+// Field numbers of the entpb.CreateNilExampleRequest message.
+const (
+	CreateNilExampleRequest_NilExample_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.CreateNilExampleRequest message, as used by the paths of field masks.
+const (
+	CreateNilExampleRequest_NilExample_FieldName protoreflect.Name = "nil_example"
+)
+
+// Field numbers of the entpb.GetNilExampleRequest message.
+const (
+	GetNilExampleRequest_Id_FieldNumber   protoreflect.FieldNumber = 1
+	GetNilExampleRequest_View_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.GetNilExampleRequest message, as used by the paths of field masks.
+const (
+	GetNilExampleRequest_Id_FieldName   protoreflect.Name = "id"
+	GetNilExampleRequest_View_FieldName protoreflect.Name = "view"
+)
+
+// Field numbers of the entpb.UpdateNilExampleRequest message.
+const (
+	UpdateNilExampleRequest_NilExample_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.UpdateNilExampleRequest message, as used by the paths of field masks.
+const (
+	UpdateNilExampleRequest_NilExample_FieldName protoreflect.Name = "nil_example"
+)
+
+// Field numbers of the entpb.DeleteNilExampleRequest message.
+const (
+	DeleteNilExampleRequest_Id_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.DeleteNilExampleRequest message, as used by the paths of field masks.
+const (
+	DeleteNilExampleRequest_Id_FieldName protoreflect.Name = "id"
+)
+
+// Field numbers of the entpb.NilExampleFilter message.
+const (
+	NilExampleFilter_Id_FieldNumber      protoreflect.FieldNumber = 1
+	NilExampleFilter_StrNil_FieldNumber  protoreflect.FieldNumber = 2
+	NilExampleFilter_TimeNil_FieldNumber protoreflect.FieldNumber = 3
+)
+
+// Field names of the entpb.NilExampleFilter message, as used by the paths of field masks.
+const (
+	NilExampleFilter_Id_FieldName      protoreflect.Name = "id"
+	NilExampleFilter_StrNil_FieldName  protoreflect.Name = "str_nil"
+	NilExampleFilter_TimeNil_FieldName protoreflect.Name = "time_nil"
+)
+
+// Field numbers of the entpb.ListNilExampleRequest message.
+const (
+	ListNilExampleRequest_PageSize_FieldNumber  protoreflect.FieldNumber = 1
+	ListNilExampleRequest_PageToken_FieldNumber protoreflect.FieldNumber = 2
+	ListNilExampleRequest_View_FieldNumber      protoreflect.FieldNumber = 3
+	ListNilExampleRequest_OrderBy_FieldNumber   protoreflect.FieldNumber = 4
+	ListNilExampleRequest_Filter_FieldNumber    protoreflect.FieldNumber = 5
+)
+
+// Field names of the entpb.ListNilExampleRequest message, as used by the paths of field masks.
+const (
+	ListNilExampleRequest_PageSize_FieldName  protoreflect.Name = "page_size"
+	ListNilExampleRequest_PageToken_FieldName protoreflect.Name = "page_token"
+	ListNilExampleRequest_View_FieldName      protoreflect.Name = "view"
+	ListNilExampleRequest_OrderBy_FieldName   protoreflect.Name = "order_by"
+	ListNilExampleRequest_Filter_FieldName    protoreflect.Name = "filter"
+)
+
+// Field numbers of the entpb.ListNilExampleResponse message.
+const (
+	ListNilExampleResponse_NilExampleList_FieldNumber protoreflect.FieldNumber = 1
+	ListNilExampleResponse_NextPageToken_FieldNumber  protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.ListNilExampleResponse message, as used by the paths of field masks.
+const (
+	ListNilExampleResponse_NilExampleList_FieldName protoreflect.Name = "nil_example_list"
+	ListNilExampleResponse_NextPageToken_FieldName  protoreflect.Name = "next_page_token"
+)
+
+// Field numbers of the entpb.BatchCreateNilExamplesRequest message.
+const (
+	BatchCreateNilExamplesRequest_Requests_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreateNilExamplesRequest message, as used by the paths of field masks.
+const (
+	BatchCreateNilExamplesRequest_Requests_FieldName protoreflect.Name = "requests"
+)
+
+// Field numbers of the entpb.BatchCreateNilExamplesResponse message.
+const (
+	BatchCreateNilExamplesResponse_NilExamples_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreateNilExamplesResponse message, as used by the paths of field masks.
+const (
+	BatchCreateNilExamplesResponse_NilExamples_FieldName protoreflect.Name = "nil_examples"
+)
+
+// Field numbers of the entpb.Pet message.
+const (
+	Pet_Id_FieldNumber         protoreflect.FieldNumber = 1
+	Pet_Size_FieldNumber       protoreflect.FieldNumber = 4
+	Pet_Owner_FieldNumber      protoreflect.FieldNumber = 2
+	Pet_Attachment_FieldNumber protoreflect.FieldNumber = 3
+)
+
+// Field names of the entpb.Pet message, as used by the paths of field masks.
+const (
+	Pet_Id_FieldName         protoreflect.Name = "id"
+	Pet_Size_FieldName       protoreflect.Name = "size"
+	Pet_Owner_FieldName      protoreflect.Name = "owner"
+	Pet_Attachment_FieldName protoreflect.Name = "attachment"
+)
+
+// Field numbers of the entpb.CreatePetRequest message.
+const (
+	CreatePetRequest_Pet_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.CreatePetRequest message, as used by the paths of field masks.
+const (
+	CreatePetRequest_Pet_FieldName protoreflect.Name = "pet"
+)
+
+// Field numbers of the entpb.GetPetRequest message.
+const (
+	GetPetRequest_Id_FieldNumber   protoreflect.FieldNumber = 1
+	GetPetRequest_View_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.GetPetRequest message, as used by the paths of field masks.
+const (
+	GetPetRequest_Id_FieldName   protoreflect.Name = "id"
+	GetPetRequest_View_FieldName protoreflect.Name = "view"
+)
+
+// Field numbers of the entpb.UpdatePetRequest message.
+const (
+	UpdatePetRequest_Pet_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.UpdatePetRequest message, as used by the paths of field masks.
+const (
+	UpdatePetRequest_Pet_FieldName protoreflect.Name = "pet"
+)
+
+// Field numbers of the entpb.DeletePetRequest message.
+const (
+	DeletePetRequest_Id_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.DeletePetRequest message, as used by the paths of field masks.
+const (
+	DeletePetRequest_Id_FieldName protoreflect.Name = "id"
+)
+
+// Field numbers of the entpb.PetFilter message.
+const (
+	PetFilter_Id_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.PetFilter message, as used by the paths of field masks.
+const (
+	PetFilter_Id_FieldName protoreflect.Name = "id"
+)
+
+// Field numbers of the entpb.ListPetRequest message.
+const (
+	ListPetRequest_PageSize_FieldNumber  protoreflect.FieldNumber = 1
+	ListPetRequest_PageToken_FieldNumber protoreflect.FieldNumber = 2
+	ListPetRequest_View_FieldNumber      protoreflect.FieldNumber = 3
+	ListPetRequest_OrderBy_FieldNumber   protoreflect.FieldNumber = 4
+	ListPetRequest_Filter_FieldNumber    protoreflect.FieldNumber = 5
+)
+
+// Field names of the entpb.ListPetRequest message, as used by the paths of field masks.
+const (
+	ListPetRequest_PageSize_FieldName  protoreflect.Name = "page_size"
+	ListPetRequest_PageToken_FieldName protoreflect.Name = "page_token"
+	ListPetRequest_View_FieldName      protoreflect.Name = "view"
+	ListPetRequest_OrderBy_FieldName   protoreflect.Name = "order_by"
+	ListPetRequest_Filter_FieldName    protoreflect.Name = "filter"
+)
+
+// Field numbers of the entpb.ListPetResponse message.
+const (
+	ListPetResponse_PetList_FieldNumber       protoreflect.FieldNumber = 1
+	ListPetResponse_NextPageToken_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.ListPetResponse message, as used by the paths of field masks.
+const (
+	ListPetResponse_PetList_FieldName       protoreflect.Name = "pet_list"
+	ListPetResponse_NextPageToken_FieldName protoreflect.Name = "next_page_token"
+)
+
+// Field numbers of the entpb.BatchCreatePetsRequest message.
+const (
+	BatchCreatePetsRequest_Requests_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreatePetsRequest message, as used by the paths of field masks.
+const (
+	BatchCreatePetsRequest_Requests_FieldName protoreflect.Name = "requests"
+)
+
+// Field numbers of the entpb.BatchCreatePetsResponse message.
+const (
+	BatchCreatePetsResponse_Pets_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreatePetsResponse message, as used by the paths of field masks.
+const (
+	BatchCreatePetsResponse_Pets_FieldName protoreflect.Name = "pets"
+)
+
+// Field numbers of the entpb.Pony message.
+const (
+	Pony_Id_FieldNumber       protoreflect.FieldNumber = 1
+	Pony_Size_FieldNumber     protoreflect.FieldNumber = 4
+	Pony_Name_FieldNumber     protoreflect.FieldNumber = 2
+	Pony_Height_FieldNumber   protoreflect.FieldNumber = 3
+	Pony_Scores_FieldNumber   protoreflect.FieldNumber = 5
+	Pony_Rank_FieldNumber     protoreflect.FieldNumber = 7
+	Pony_Color_FieldNumber    protoreflect.FieldNumber = 8
+	Pony_Priority_FieldNumber protoreflect.FieldNumber = 6
+)
+
+// Field names of the entpb.Pony message, as used by the paths of field masks.
+const (
+	Pony_Id_FieldName       protoreflect.Name = "id"
+	Pony_Size_FieldName     protoreflect.Name = "size"
+	Pony_Name_FieldName     protoreflect.Name = "name"
+	Pony_Height_FieldName   protoreflect.Name = "height"
+	Pony_Scores_FieldName   protoreflect.Name = "scores"
+	Pony_Rank_FieldName     protoreflect.Name = "rank"
+	Pony_Color_FieldName    protoreflect.Name = "color"
+	Pony_Priority_FieldName protoreflect.Name = "priority"
+)
+
+// Field numbers of the entpb.CreatePonyRequest message.
+const (
+	CreatePonyRequest_Pony_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.CreatePonyRequest message, as used by the paths of field masks.
+const (
+	CreatePonyRequest_Pony_FieldName protoreflect.Name = "pony"
+)
+
+// Field numbers of the entpb.BatchCreatePoniesRequest message.
+const (
+	BatchCreatePoniesRequest_Requests_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreatePoniesRequest message, as used by the paths of field masks.
+const (
+	BatchCreatePoniesRequest_Requests_FieldName protoreflect.Name = "requests"
+)
+
+// Field numbers of the entpb.BatchCreatePoniesResponse message.
+const (
+	BatchCreatePoniesResponse_Ponies_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreatePoniesResponse message, as used by the paths of field masks.
+const (
+	BatchCreatePoniesResponse_Ponies_FieldName protoreflect.Name = "ponies"
+)
+
+// Field numbers of the entpb.Project message.
+const (
+	Project_Id_FieldNumber        protoreflect.FieldNumber = 1
+	Project_Name_FieldNumber      protoreflect.FieldNumber = 2
+	Project_DeletedAt_FieldNumber protoreflect.FieldNumber = 5
+	Project_Priority_FieldNumber  protoreflect.FieldNumber = 6
+	Project_Version_FieldNumber   protoreflect.FieldNumber = 7
+)
+
+// Field names of the entpb.Project message, as used by the paths of field masks.
+const (
+	Project_Id_FieldName        protoreflect.Name = "id"
+	Project_Name_FieldName      protoreflect.Name = "name"
+	Project_DeletedAt_FieldName protoreflect.Name = "deleted_at"
+	Project_Priority_FieldName  protoreflect.Name = "priority"
+	Project_Version_FieldName   protoreflect.Name = "version"
+)
+
+// Field numbers of the entpb.ProjectEdgeIds message.
+const (
+	ProjectEdgeIds_OwnerId_FieldNumber       protoreflect.FieldNumber = 3
+	ProjectEdgeIds_AttachmentIds_FieldNumber protoreflect.FieldNumber = 4
+)
+
+// Field names of the entpb.ProjectEdgeIds message, as used by the paths of field masks.
+const (
+	ProjectEdgeIds_OwnerId_FieldName       protoreflect.Name = "owner_id"
+	ProjectEdgeIds_AttachmentIds_FieldName protoreflect.Name = "attachment_ids"
+)
+
+// Field numbers of the entpb.CreateProjectRequest message.
+const (
+	CreateProjectRequest_Project_FieldNumber protoreflect.FieldNumber = 1
+	CreateProjectRequest_EdgeIds_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.CreateProjectRequest message, as used by the paths of field masks.
+const (
+	CreateProjectRequest_Project_FieldName protoreflect.Name = "project"
+	CreateProjectRequest_EdgeIds_FieldName protoreflect.Name = "edge_ids"
+)
+
+// Field numbers of the entpb.ProjectLookupRequest message.
+const (
+	ProjectLookupRequest_Id_FieldNumber             protoreflect.FieldNumber = 1
+	ProjectLookupRequest_View_FieldNumber           protoreflect.FieldNumber = 2
+	ProjectLookupRequest_IncludeDeleted_FieldNumber protoreflect.FieldNumber = 4
+)
+
+// Field names of the entpb.ProjectLookupRequest message, as used by the paths of field masks.
+const (
+	ProjectLookupRequest_Id_FieldName             protoreflect.Name = "id"
+	ProjectLookupRequest_View_FieldName           protoreflect.Name = "view"
+	ProjectLookupRequest_IncludeDeleted_FieldName protoreflect.Name = "include_deleted"
+)
+
+// Field numbers of the entpb.GetProjectResponse message.
+const (
+	GetProjectResponse_Project_FieldNumber protoreflect.FieldNumber = 1
+	GetProjectResponse_EdgeIds_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.GetProjectResponse message, as used by the paths of field masks.
+const (
+	GetProjectResponse_Project_FieldName protoreflect.Name = "project"
+	GetProjectResponse_EdgeIds_FieldName protoreflect.Name = "edge_ids"
+)
+
+// Field numbers of the entpb.GetProjectByNameRequest message.
+const (
+	GetProjectByNameRequest_Name_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.GetProjectByNameRequest message, as used by the paths of field masks.
+const (
+	GetProjectByNameRequest_Name_FieldName protoreflect.Name = "name"
+)
+
+// Field numbers of the entpb.ExistsProjectRequest message.
+const (
+	ExistsProjectRequest_Id_FieldNumber   protoreflect.FieldNumber = 1
+	ExistsProjectRequest_Name_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.ExistsProjectRequest message, as used by the paths of field masks.
+const (
+	ExistsProjectRequest_Id_FieldName   protoreflect.Name = "id"
+	ExistsProjectRequest_Name_FieldName protoreflect.Name = "name"
+)
+
+// Field numbers of the entpb.ExistsProjectResponse message.
+const (
+	ExistsProjectResponse_Exists_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.ExistsProjectResponse message, as used by the paths of field masks.
+const (
+	ExistsProjectResponse_Exists_FieldName protoreflect.Name = "exists"
+)
+
+// Field numbers of the entpb.UpdateProjectRequest message.
+const (
+	UpdateProjectRequest_Project_FieldNumber protoreflect.FieldNumber = 1
+	UpdateProjectRequest_EdgeIds_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.UpdateProjectRequest message, as used by the paths of field masks.
+const (
+	UpdateProjectRequest_Project_FieldName protoreflect.Name = "project"
+	UpdateProjectRequest_EdgeIds_FieldName protoreflect.Name = "edge_ids"
+)
+
+// Field numbers of the entpb.DeleteProjectRequest message.
+const (
+	DeleteProjectRequest_Id_FieldNumber      protoreflect.FieldNumber = 1
+	DeleteProjectRequest_Version_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.DeleteProjectRequest message, as used by the paths of field masks.
+const (
+	DeleteProjectRequest_Id_FieldName      protoreflect.Name = "id"
+	DeleteProjectRequest_Version_FieldName protoreflect.Name = "version"
+)
+
+// Field numbers of the entpb.ProjectFilter message.
+const (
+	ProjectFilter_Id_FieldNumber        protoreflect.FieldNumber = 1
+	ProjectFilter_Name_FieldNumber      protoreflect.FieldNumber = 2
+	ProjectFilter_DeletedAt_FieldNumber protoreflect.FieldNumber = 5
+	ProjectFilter_Version_FieldNumber   protoreflect.FieldNumber = 7
+)
+
+// Field names of the entpb.ProjectFilter message, as used by the paths of field masks.
+const (
+	ProjectFilter_Id_FieldName        protoreflect.Name = "id"
+	ProjectFilter_Name_FieldName      protoreflect.Name = "name"
+	ProjectFilter_DeletedAt_FieldName protoreflect.Name = "deleted_at"
+	ProjectFilter_Version_FieldName   protoreflect.Name = "version"
+)
+
+// Field numbers of the entpb.DeleteProjectsRequest message.
+const (
+	DeleteProjectsRequest_Filter_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.DeleteProjectsRequest message, as used by the paths of field masks.
+const (
+	DeleteProjectsRequest_Filter_FieldName protoreflect.Name = "filter"
+)
+
+// Field numbers of the entpb.DeleteProjectsResponse message.
+const (
+	DeleteProjectsResponse_Deleted_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.DeleteProjectsResponse message, as used by the paths of field masks.
+const (
+	DeleteProjectsResponse_Deleted_FieldName protoreflect.Name = "deleted"
+)
+
+// Field numbers of the entpb.RestoreProjectRequest message.
+const (
+	RestoreProjectRequest_Id_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.RestoreProjectRequest message, as used by the paths of field masks.
+const (
+	RestoreProjectRequest_Id_FieldName protoreflect.Name = "id"
+)
+
+// Field numbers of the entpb.ListProjectRequest message.
+const (
+	ListProjectRequest_PageSize_FieldNumber       protoreflect.FieldNumber = 1
+	ListProjectRequest_PageToken_FieldNumber      protoreflect.FieldNumber = 2
+	ListProjectRequest_View_FieldNumber           protoreflect.FieldNumber = 3
+	ListProjectRequest_OrderBy_FieldNumber        protoreflect.FieldNumber = 4
+	ListProjectRequest_Filter_FieldNumber         protoreflect.FieldNumber = 5
+	ListProjectRequest_IncludeDeleted_FieldNumber protoreflect.FieldNumber = 6
+)
+
+// Field names of the entpb.ListProjectRequest message, as used by the paths of field masks.
+const (
+	ListProjectRequest_PageSize_FieldName       protoreflect.Name = "page_size"
+	ListProjectRequest_PageToken_FieldName      protoreflect.Name = "page_token"
+	ListProjectRequest_View_FieldName           protoreflect.Name = "view"
+	ListProjectRequest_OrderBy_FieldName        protoreflect.Name = "order_by"
+	ListProjectRequest_Filter_FieldName         protoreflect.Name = "filter"
+	ListProjectRequest_IncludeDeleted_FieldName protoreflect.Name = "include_deleted"
+)
+
+// Field numbers of the entpb.ListProjectResponse message.
+const (
+	ListProjectResponse_ProjectList_FieldNumber   protoreflect.FieldNumber = 1
+	ListProjectResponse_NextPageToken_FieldNumber protoreflect.FieldNumber = 2
+	ListProjectResponse_EdgeIds_FieldNumber       protoreflect.FieldNumber = 3
+	ListProjectResponse_TotalSize_FieldNumber     protoreflect.FieldNumber = 4
+)
+
+// Field names of the entpb.ListProjectResponse message, as used by the paths of field masks.
+const (
+	ListProjectResponse_ProjectList_FieldName   protoreflect.Name = "project_list"
+	ListProjectResponse_NextPageToken_FieldName protoreflect.Name = "next_page_token"
+	ListProjectResponse_EdgeIds_FieldName       protoreflect.Name = "edge_ids"
+	ListProjectResponse_TotalSize_FieldName     protoreflect.Name = "total_size"
+)
+
+// Field numbers of the entpb.StreamProjectsRequest message.
+const (
+	StreamProjectsRequest_Filter_FieldNumber    protoreflect.FieldNumber = 1
+	StreamProjectsRequest_BatchSize_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.StreamProjectsRequest message, as used by the paths of field masks.
+const (
+	StreamProjectsRequest_Filter_FieldName    protoreflect.Name = "filter"
+	StreamProjectsRequest_BatchSize_FieldName protoreflect.Name = "batch_size"
+)
+
+// Field numbers of the entpb.CountProjectsRequest message.
+const (
+	CountProjectsRequest_Filter_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.CountProjectsRequest message, as used by the paths of field masks.
+const (
+	CountProjectsRequest_Filter_FieldName protoreflect.Name = "filter"
+)
+
+// Field numbers of the entpb.CountProjectsResponse message.
+const (
+	CountProjectsResponse_Count_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.CountProjectsResponse message, as used by the paths of field masks.
+const (
+	CountProjectsResponse_Count_FieldName protoreflect.Name = "count"
+)
+
+// Field numbers of the entpb.AggregateProjectRequest message.
+const (
+	AggregateProjectRequest_Field_FieldNumber   protoreflect.FieldNumber = 1
+	AggregateProjectRequest_GroupBy_FieldNumber protoreflect.FieldNumber = 2
+	AggregateProjectRequest_Filter_FieldNumber  protoreflect.FieldNumber = 3
+)
+
+// Field names of the entpb.AggregateProjectRequest message, as used by the paths of field masks.
+const (
+	AggregateProjectRequest_Field_FieldName   protoreflect.Name = "field"
+	AggregateProjectRequest_GroupBy_FieldName protoreflect.Name = "group_by"
+	AggregateProjectRequest_Filter_FieldName  protoreflect.Name = "filter"
+)
+
+// Field numbers of the entpb.AggregateProjectResponse message.
+const (
+	AggregateProjectResponse_Groups_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.AggregateProjectResponse message, as used by the paths of field masks.
+const (
+	AggregateProjectResponse_Groups_FieldName protoreflect.Name = "groups"
+)
+
+// Field numbers of the entpb.BatchCreateProjectsRequest message.
+const (
+	BatchCreateProjectsRequest_Requests_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreateProjectsRequest message, as used by the paths of field masks.
+const (
+	BatchCreateProjectsRequest_Requests_FieldName protoreflect.Name = "requests"
+)
+
+// Field numbers of the entpb.BatchCreateProjectsResponse message.
+const (
+	BatchCreateProjectsResponse_Projects_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreateProjectsResponse message, as used by the paths of field masks.
+const (
+	BatchCreateProjectsResponse_Projects_FieldName protoreflect.Name = "projects"
+)
+
+// Field numbers of the entpb.UpsertProjectRequest message.
+const (
+	UpsertProjectRequest_Project_FieldNumber protoreflect.FieldNumber = 1
+	UpsertProjectRequest_EdgeIds_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.UpsertProjectRequest message, as used by the paths of field masks.
+const (
+	UpsertProjectRequest_Project_FieldName protoreflect.Name = "project"
+	UpsertProjectRequest_EdgeIds_FieldName protoreflect.Name = "edge_ids"
+)
+
+// Field numbers of the entpb.BatchGetProjectsRequest message.
+const (
+	BatchGetProjectsRequest_Ids_FieldNumber  protoreflect.FieldNumber = 1
+	BatchGetProjectsRequest_View_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.BatchGetProjectsRequest message, as used by the paths of field masks.
+const (
+	BatchGetProjectsRequest_Ids_FieldName  protoreflect.Name = "ids"
+	BatchGetProjectsRequest_View_FieldName protoreflect.Name = "view"
+)
+
+// Field numbers of the entpb.ProjectBatch message.
+const (
+	ProjectBatch_Projects_FieldNumber   protoreflect.FieldNumber = 1
+	ProjectBatch_MissingIds_FieldNumber protoreflect.FieldNumber = 2
+	ProjectBatch_EdgeIds_FieldNumber    protoreflect.FieldNumber = 3
+)
+
+// Field names of the entpb.ProjectBatch message, as used by the paths of field masks.
+const (
+	ProjectBatch_Projects_FieldName   protoreflect.Name = "projects"
+	ProjectBatch_MissingIds_FieldName protoreflect.Name = "missing_ids"
+	ProjectBatch_EdgeIds_FieldName    protoreflect.Name = "edge_ids"
+)
+
+// Field numbers of the entpb.BatchDeleteProjectsRequest message.
+const (
+	BatchDeleteProjectsRequest_Ids_FieldNumber    protoreflect.FieldNumber = 1
+	BatchDeleteProjectsRequest_Filter_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.BatchDeleteProjectsRequest message, as used by the paths of field masks.
+const (
+	BatchDeleteProjectsRequest_Ids_FieldName    protoreflect.Name = "ids"
+	BatchDeleteProjectsRequest_Filter_FieldName protoreflect.Name = "filter"
+)
+
+// Field numbers of the entpb.StatsProjectResponse message.
+const (
+	StatsProjectResponse_Count_FieldNumber  protoreflect.FieldNumber = 1
+	StatsProjectResponse_Fields_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.StatsProjectResponse message, as used by the paths of field masks.
+const (
+	StatsProjectResponse_Count_FieldName  protoreflect.Name = "count"
+	StatsProjectResponse_Fields_FieldName protoreflect.Name = "fields"
+)
+
+// Field numbers of the entpb.ExportProjectRequest message.
+const (
+	ExportProjectRequest_Format_FieldNumber    protoreflect.FieldNumber = 1
+	ExportProjectRequest_Cursor_FieldNumber    protoreflect.FieldNumber = 2
+	ExportProjectRequest_BatchSize_FieldNumber protoreflect.FieldNumber = 3
+)
+
+// Field names of the entpb.ExportProjectRequest message, as used by the paths of field masks.
+const (
+	ExportProjectRequest_Format_FieldName    protoreflect.Name = "format"
+	ExportProjectRequest_Cursor_FieldName    protoreflect.Name = "cursor"
+	ExportProjectRequest_BatchSize_FieldName protoreflect.Name = "batch_size"
+)
+
+// Field numbers of the entpb.ExportProjectResponse message.
+const (
+	ExportProjectResponse_Data_FieldNumber   protoreflect.FieldNumber = 1
+	ExportProjectResponse_Cursor_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.ExportProjectResponse message, as used by the paths of field masks.
+const (
+	ExportProjectResponse_Data_FieldName   protoreflect.Name = "data"
+	ExportProjectResponse_Cursor_FieldName protoreflect.Name = "cursor"
+)
+
+// Field numbers of the entpb.ListProjectAttachmentsRequest message.
+const (
+	ListProjectAttachmentsRequest_Id_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.ListProjectAttachmentsRequest message, as used by the paths of field masks.
+const (
+	ListProjectAttachmentsRequest_Id_FieldName protoreflect.Name = "id"
+)
+
+// Field numbers of the entpb.ListProjectAttachmentsResponse message.
+const (
+	ListProjectAttachmentsResponse_AttachmentIds_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.ListProjectAttachmentsResponse message, as used by the paths of field masks.
+const (
+	ListProjectAttachmentsResponse_AttachmentIds_FieldName protoreflect.Name = "attachment_ids"
+)
+
+// Field numbers of the entpb.AddProjectAttachmentRequest message.
+const (
+	AddProjectAttachmentRequest_Id_FieldNumber           protoreflect.FieldNumber = 1
+	AddProjectAttachmentRequest_AttachmentId_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.AddProjectAttachmentRequest message, as used by the paths of field masks.
+const (
+	AddProjectAttachmentRequest_Id_FieldName           protoreflect.Name = "id"
+	AddProjectAttachmentRequest_AttachmentId_FieldName protoreflect.Name = "attachment_id"
+)
+
+// Field numbers of the entpb.RemoveProjectAttachmentRequest message.
+const (
+	RemoveProjectAttachmentRequest_Id_FieldNumber           protoreflect.FieldNumber = 1
+	RemoveProjectAttachmentRequest_AttachmentId_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.RemoveProjectAttachmentRequest message, as used by the paths of field masks.
+const (
+	RemoveProjectAttachmentRequest_Id_FieldName           protoreflect.Name = "id"
+	RemoveProjectAttachmentRequest_AttachmentId_FieldName protoreflect.Name = "attachment_id"
+)
+
+// Field numbers of the entpb.Todo message.
+const (
+	Todo_Id_FieldNumber     protoreflect.FieldNumber = 1
+	Todo_Task_FieldNumber   protoreflect.FieldNumber = 2
+	Todo_Status_FieldNumber protoreflect.FieldNumber = 3
+	Todo_User_FieldNumber   protoreflect.FieldNumber = 4
+)
+
+// Field names of the entpb.Todo message, as used by the paths of field masks.
+const (
+	Todo_Id_FieldName     protoreflect.Name = "id"
+	Todo_Task_FieldName   protoreflect.Name = "task"
+	Todo_Status_FieldName protoreflect.Name = "status"
+	Todo_User_FieldName   protoreflect.Name = "user"
+)
+
+// Field numbers of the entpb.CreateTodoRequest message.
+const (
+	CreateTodoRequest_Todo_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.CreateTodoRequest message, as used by the paths of field masks.
+const (
+	CreateTodoRequest_Todo_FieldName protoreflect.Name = "todo"
+)
+
+// Field numbers of the entpb.GetTodoRequest message.
+const (
+	GetTodoRequest_Id_FieldNumber   protoreflect.FieldNumber = 1
+	GetTodoRequest_View_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.GetTodoRequest message, as used by the paths of field masks.
+const (
+	GetTodoRequest_Id_FieldName   protoreflect.Name = "id"
+	GetTodoRequest_View_FieldName protoreflect.Name = "view"
+)
+
+// Field numbers of the entpb.UpdateTodoRequest message.
+const (
+	UpdateTodoRequest_Todo_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.UpdateTodoRequest message, as used by the paths of field masks.
+const (
+	UpdateTodoRequest_Todo_FieldName protoreflect.Name = "todo"
+)
+
+// Field numbers of the entpb.DeleteTodoRequest message.
+const (
+	DeleteTodoRequest_Id_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.DeleteTodoRequest message, as used by the paths of field masks.
+const (
+	DeleteTodoRequest_Id_FieldName protoreflect.Name = "id"
+)
+
+// Field numbers of the entpb.TodoFilter message.
+const (
+	TodoFilter_Id_FieldNumber   protoreflect.FieldNumber = 1
+	TodoFilter_Task_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.TodoFilter message, as used by the paths of field masks.
+const (
+	TodoFilter_Id_FieldName   protoreflect.Name = "id"
+	TodoFilter_Task_FieldName protoreflect.Name = "task"
+)
+
+// Field numbers of the entpb.ListTodoRequest message.
+const (
+	ListTodoRequest_PageSize_FieldNumber  protoreflect.FieldNumber = 1
+	ListTodoRequest_PageToken_FieldNumber protoreflect.FieldNumber = 2
+	ListTodoRequest_View_FieldNumber      protoreflect.FieldNumber = 3
+	ListTodoRequest_OrderBy_FieldNumber   protoreflect.FieldNumber = 4
+	ListTodoRequest_Filter_FieldNumber    protoreflect.FieldNumber = 5
+)
+
+// Field names of the entpb.ListTodoRequest message, as used by the paths of field masks.
+const (
+	ListTodoRequest_PageSize_FieldName  protoreflect.Name = "page_size"
+	ListTodoRequest_PageToken_FieldName protoreflect.Name = "page_token"
+	ListTodoRequest_View_FieldName      protoreflect.Name = "view"
+	ListTodoRequest_OrderBy_FieldName   protoreflect.Name = "order_by"
+	ListTodoRequest_Filter_FieldName    protoreflect.Name = "filter"
+)
+
+// Field numbers of the entpb.ListTodoResponse message.
+const (
+	ListTodoResponse_TodoList_FieldNumber      protoreflect.FieldNumber = 1
+	ListTodoResponse_NextPageToken_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.ListTodoResponse message, as used by the paths of field masks.
+const (
+	ListTodoResponse_TodoList_FieldName      protoreflect.Name = "todo_list"
+	ListTodoResponse_NextPageToken_FieldName protoreflect.Name = "next_page_token"
+)
+
+// Field numbers of the entpb.BatchCreateTodosRequest message.
+const (
+	BatchCreateTodosRequest_Requests_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreateTodosRequest message, as used by the paths of field masks.
+const (
+	BatchCreateTodosRequest_Requests_FieldName protoreflect.Name = "requests"
+)
+
+// Field numbers of the entpb.BatchCreateTodosResponse message.
+const (
+	BatchCreateTodosResponse_Todos_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreateTodosResponse message, as used by the paths of field masks.
+const (
+	BatchCreateTodosResponse_Todos_FieldName protoreflect.Name = "todos"
+)
+
+// Field numbers of the entpb.User message.
+const (
+	User_Id_FieldNumber             protoreflect.FieldNumber = 1
+	User_UserName_FieldNumber       protoreflect.FieldNumber = 2
+	User_Joined_FieldNumber         protoreflect.FieldNumber = 3
+	User_Points_FieldNumber         protoreflect.FieldNumber = 4
+	User_Exp_FieldNumber            protoreflect.FieldNumber = 5
+	User_Status_FieldNumber         protoreflect.FieldNumber = 6
+	User_ExternalId_FieldNumber     protoreflect.FieldNumber = 8
+	User_CrmId_FieldNumber          protoreflect.FieldNumber = 9
+	User_Banned_FieldNumber         protoreflect.FieldNumber = 10
+	User_CustomPb_FieldNumber       protoreflect.FieldNumber = 12
+	User_OptNum_FieldNumber         protoreflect.FieldNumber = 13
+	User_OptStr_FieldNumber         protoreflect.FieldNumber = 14
+	User_OptBool_FieldNumber        protoreflect.FieldNumber = 15
+	User_BigInt_FieldNumber         protoreflect.FieldNumber = 17
+	User_BUser_1_FieldNumber        protoreflect.FieldNumber = 18
+	User_HeightInCm_FieldNumber     protoreflect.FieldNumber = 19
+	User_AccountBalance_FieldNumber protoreflect.FieldNumber = 20
+	User_Type_FieldNumber           protoreflect.FieldNumber = 23
+	User_Labels_FieldNumber         protoreflect.FieldNumber = 24
+	User_DeviceType_FieldNumber     protoreflect.FieldNumber = 100
+	User_CreatedAt_FieldNumber      protoreflect.FieldNumber = 25
+	User_OmitPrefix_FieldNumber     protoreflect.FieldNumber = 103
+	User_Group_FieldNumber          protoreflect.FieldNumber = 7
+	User_Attachment_FieldNumber     protoreflect.FieldNumber = 11
+	User_Received_1_FieldNumber     protoreflect.FieldNumber = 16
+	User_Pet_FieldNumber            protoreflect.FieldNumber = 21
+	User_FriendIds_FieldNumber      protoreflect.FieldNumber = 26
+)
+
+// Field names of the entpb.User message, as used by the paths of field masks.
+const (
+	User_Id_FieldName             protoreflect.Name = "id"
+	User_UserName_FieldName       protoreflect.Name = "user_name"
+	User_Joined_FieldName         protoreflect.Name = "joined"
+	User_Points_FieldName         protoreflect.Name = "points"
+	User_Exp_FieldName            protoreflect.Name = "exp"
+	User_Status_FieldName         protoreflect.Name = "status"
+	User_ExternalId_FieldName     protoreflect.Name = "external_id"
+	User_CrmId_FieldName          protoreflect.Name = "crm_id"
+	User_Banned_FieldName         protoreflect.Name = "banned"
+	User_CustomPb_FieldName       protoreflect.Name = "custom_pb"
+	User_OptNum_FieldName         protoreflect.Name = "opt_num"
+	User_OptStr_FieldName         protoreflect.Name = "opt_str"
+	User_OptBool_FieldName        protoreflect.Name = "opt_bool"
+	User_BigInt_FieldName         protoreflect.Name = "big_int"
+	User_BUser_1_FieldName        protoreflect.Name = "b_user_1"
+	User_HeightInCm_FieldName     protoreflect.Name = "height_in_cm"
+	User_AccountBalance_FieldName protoreflect.Name = "account_balance"
+	User_Type_FieldName           protoreflect.Name = "type"
+	User_Labels_FieldName         protoreflect.Name = "labels"
+	User_DeviceType_FieldName     protoreflect.Name = "device_type"
+	User_CreatedAt_FieldName      protoreflect.Name = "created_at"
+	User_OmitPrefix_FieldName     protoreflect.Name = "omit_prefix"
+	User_Group_FieldName          protoreflect.Name = "group"
+	User_Attachment_FieldName     protoreflect.Name = "attachment"
+	User_Received_1_FieldName     protoreflect.Name = "received_1"
+	User_Pet_FieldName            protoreflect.Name = "pet"
+	User_FriendIds_FieldName      protoreflect.Name = "friend_ids"
+)
+
+// Field numbers of the entpb.CreateUserRequest message.
+const (
+	CreateUserRequest_User_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.CreateUserRequest message, as used by the paths of field masks.
+const (
+	CreateUserRequest_User_FieldName protoreflect.Name = "user"
+)
+
+// Field numbers of the entpb.GetUserRequest message.
+const (
+	GetUserRequest_Id_FieldNumber       protoreflect.FieldNumber = 1
+	GetUserRequest_View_FieldNumber     protoreflect.FieldNumber = 2
+	GetUserRequest_ReadMask_FieldNumber protoreflect.FieldNumber = 3
+)
+
+// Field names of the entpb.GetUserRequest message, as used by the paths of field masks.
+const (
+	GetUserRequest_Id_FieldName       protoreflect.Name = "id"
+	GetUserRequest_View_FieldName     protoreflect.Name = "view"
+	GetUserRequest_ReadMask_FieldName protoreflect.Name = "read_mask"
+)
+
+// Field numbers of the entpb.GetUserByUserNameRequest message.
+const (
+	GetUserByUserNameRequest_UserName_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.GetUserByUserNameRequest message, as used by the paths of field masks.
+const (
+	GetUserByUserNameRequest_UserName_FieldName protoreflect.Name = "user_name"
+)
+
+// Field numbers of the entpb.GetUserByExternalIDRequest message.
+const (
+	GetUserByExternalIDRequest_ExternalId_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.GetUserByExternalIDRequest message, as used by the paths of field masks.
+const (
+	GetUserByExternalIDRequest_ExternalId_FieldName protoreflect.Name = "external_id"
+)
+
+// Field numbers of the entpb.GetUserByBUser1Request message.
+const (
+	GetUserByBUser1Request_BUser_1_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.GetUserByBUser1Request message, as used by the paths of field masks.
+const (
+	GetUserByBUser1Request_BUser_1_FieldName protoreflect.Name = "b_user_1"
+)
+
+// Field numbers of the entpb.ExistsUserRequest message.
+const (
+	ExistsUserRequest_Id_FieldNumber         protoreflect.FieldNumber = 1
+	ExistsUserRequest_UserName_FieldNumber   protoreflect.FieldNumber = 2
+	ExistsUserRequest_ExternalId_FieldNumber protoreflect.FieldNumber = 8
+	ExistsUserRequest_BUser_1_FieldNumber    protoreflect.FieldNumber = 18
+)
+
+// Field names of the entpb.ExistsUserRequest message, as used by the paths of field masks.
+const (
+	ExistsUserRequest_Id_FieldName         protoreflect.Name = "id"
+	ExistsUserRequest_UserName_FieldName   protoreflect.Name = "user_name"
+	ExistsUserRequest_ExternalId_FieldName protoreflect.Name = "external_id"
+	ExistsUserRequest_BUser_1_FieldName    protoreflect.Name = "b_user_1"
+)
+
+// Field numbers of the entpb.ExistsUserResponse message.
+const (
+	ExistsUserResponse_Exists_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.ExistsUserResponse message, as used by the paths of field masks.
+const (
+	ExistsUserResponse_Exists_FieldName protoreflect.Name = "exists"
+)
+
+// Field numbers of the entpb.UpdateUserRequest message.
+const (
+	UpdateUserRequest_User_FieldNumber       protoreflect.FieldNumber = 1
+	UpdateUserRequest_UpdateMask_FieldNumber protoreflect.FieldNumber = 3
+)
+
+// Field names of the entpb.UpdateUserRequest message, as used by the paths of field masks.
+const (
+	UpdateUserRequest_User_FieldName       protoreflect.Name = "user"
+	UpdateUserRequest_UpdateMask_FieldName protoreflect.Name = "update_mask"
+)
+
+// Field numbers of the entpb.DeleteUserRequest message.
+const (
+	DeleteUserRequest_Id_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.DeleteUserRequest message, as used by the paths of field masks.
+const (
+	DeleteUserRequest_Id_FieldName protoreflect.Name = "id"
+)
+
+// Field numbers of the entpb.UserFilter message.
+const (
+	UserFilter_Id_FieldNumber             protoreflect.FieldNumber = 1
+	UserFilter_UserName_FieldNumber       protoreflect.FieldNumber = 2
+	UserFilter_Joined_FieldNumber         protoreflect.FieldNumber = 3
+	UserFilter_Points_FieldNumber         protoreflect.FieldNumber = 4
+	UserFilter_Exp_FieldNumber            protoreflect.FieldNumber = 5
+	UserFilter_ExternalId_FieldNumber     protoreflect.FieldNumber = 8
+	UserFilter_Banned_FieldNumber         protoreflect.FieldNumber = 10
+	UserFilter_OptNum_FieldNumber         protoreflect.FieldNumber = 13
+	UserFilter_OptStr_FieldNumber         protoreflect.FieldNumber = 14
+	UserFilter_OptBool_FieldNumber        protoreflect.FieldNumber = 15
+	UserFilter_BUser_1_FieldNumber        protoreflect.FieldNumber = 18
+	UserFilter_HeightInCm_FieldNumber     protoreflect.FieldNumber = 19
+	UserFilter_AccountBalance_FieldNumber protoreflect.FieldNumber = 20
+	UserFilter_Type_FieldNumber           protoreflect.FieldNumber = 23
+	UserFilter_CreatedAt_FieldNumber      protoreflect.FieldNumber = 25
+)
+
+// Field names of the entpb.UserFilter message, as used by the paths of field masks.
+const (
+	UserFilter_Id_FieldName             protoreflect.Name = "id"
+	UserFilter_UserName_FieldName       protoreflect.Name = "user_name"
+	UserFilter_Joined_FieldName         protoreflect.Name = "joined"
+	UserFilter_Points_FieldName         protoreflect.Name = "points"
+	UserFilter_Exp_FieldName            protoreflect.Name = "exp"
+	UserFilter_ExternalId_FieldName     protoreflect.Name = "external_id"
+	UserFilter_Banned_FieldName         protoreflect.Name = "banned"
+	UserFilter_OptNum_FieldName         protoreflect.Name = "opt_num"
+	UserFilter_OptStr_FieldName         protoreflect.Name = "opt_str"
+	UserFilter_OptBool_FieldName        protoreflect.Name = "opt_bool"
+	UserFilter_BUser_1_FieldName        protoreflect.Name = "b_user_1"
+	UserFilter_HeightInCm_FieldName     protoreflect.Name = "height_in_cm"
+	UserFilter_AccountBalance_FieldName protoreflect.Name = "account_balance"
+	UserFilter_Type_FieldName           protoreflect.Name = "type"
+	UserFilter_CreatedAt_FieldName      protoreflect.Name = "created_at"
+)
+
+// Field numbers of the entpb.DeleteUsersRequest message.
+const (
+	DeleteUsersRequest_Filter_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.DeleteUsersRequest message, as used by the paths of field masks.
+const (
+	DeleteUsersRequest_Filter_FieldName protoreflect.Name = "filter"
+)
+
+// Field numbers of the entpb.DeleteUsersResponse message.
+const (
+	DeleteUsersResponse_Deleted_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.DeleteUsersResponse message, as used by the paths of field masks.
+const (
+	DeleteUsersResponse_Deleted_FieldName protoreflect.Name = "deleted"
+)
+
+// Field numbers of the entpb.ListUserRequest message.
+const (
+	ListUserRequest_PageSize_FieldNumber  protoreflect.FieldNumber = 1
+	ListUserRequest_PageToken_FieldNumber protoreflect.FieldNumber = 2
+	ListUserRequest_View_FieldNumber      protoreflect.FieldNumber = 3
+	ListUserRequest_OrderBy_FieldNumber   protoreflect.FieldNumber = 4
+	ListUserRequest_Filter_FieldNumber    protoreflect.FieldNumber = 5
+	ListUserRequest_ReadMask_FieldNumber  protoreflect.FieldNumber = 7
+)
+
+// Field names of the entpb.ListUserRequest message, as used by the paths of field masks.
+const (
+	ListUserRequest_PageSize_FieldName  protoreflect.Name = "page_size"
+	ListUserRequest_PageToken_FieldName protoreflect.Name = "page_token"
+	ListUserRequest_View_FieldName      protoreflect.Name = "view"
+	ListUserRequest_OrderBy_FieldName   protoreflect.Name = "order_by"
+	ListUserRequest_Filter_FieldName    protoreflect.Name = "filter"
+	ListUserRequest_ReadMask_FieldName  protoreflect.Name = "read_mask"
+)
+
+// Field numbers of the entpb.ListUserResponse message.
+const (
+	ListUserResponse_UserList_FieldNumber      protoreflect.FieldNumber = 1
+	ListUserResponse_NextPageToken_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.ListUserResponse message, as used by the paths of field masks.
+const (
+	ListUserResponse_UserList_FieldName      protoreflect.Name = "user_list"
+	ListUserResponse_NextPageToken_FieldName protoreflect.Name = "next_page_token"
+)
+
+// Field numbers of the entpb.StreamUsersRequest message.
+const (
+	StreamUsersRequest_Filter_FieldNumber    protoreflect.FieldNumber = 1
+	StreamUsersRequest_BatchSize_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.StreamUsersRequest message, as used by the paths of field masks.
+const (
+	StreamUsersRequest_Filter_FieldName    protoreflect.Name = "filter"
+	StreamUsersRequest_BatchSize_FieldName protoreflect.Name = "batch_size"
+)
+
+// Field numbers of the entpb.CountUsersRequest message.
+const (
+	CountUsersRequest_Filter_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.CountUsersRequest message, as used by the paths of field masks.
+const (
+	CountUsersRequest_Filter_FieldName protoreflect.Name = "filter"
+)
+
+// Field numbers of the entpb.CountUsersResponse message.
+const (
+	CountUsersResponse_Count_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.CountUsersResponse message, as used by the paths of field masks.
+const (
+	CountUsersResponse_Count_FieldName protoreflect.Name = "count"
+)
+
+// Field numbers of the entpb.AggregateUserRequest message.
+const (
+	AggregateUserRequest_Field_FieldNumber   protoreflect.FieldNumber = 1
+	AggregateUserRequest_GroupBy_FieldNumber protoreflect.FieldNumber = 2
+	AggregateUserRequest_Filter_FieldNumber  protoreflect.FieldNumber = 3
+)
+
+// Field names of the entpb.AggregateUserRequest message, as used by the paths of field masks.
+const (
+	AggregateUserRequest_Field_FieldName   protoreflect.Name = "field"
+	AggregateUserRequest_GroupBy_FieldName protoreflect.Name = "group_by"
+	AggregateUserRequest_Filter_FieldName  protoreflect.Name = "filter"
+)
+
+// Field numbers of the entpb.AggregateUserResponse message.
+const (
+	AggregateUserResponse_Groups_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.AggregateUserResponse message, as used by the paths of field masks.
+const (
+	AggregateUserResponse_Groups_FieldName protoreflect.Name = "groups"
+)
+
+// Field numbers of the entpb.BatchCreateUsersRequest message.
+const (
+	BatchCreateUsersRequest_Requests_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreateUsersRequest message, as used by the paths of field masks.
+const (
+	BatchCreateUsersRequest_Requests_FieldName protoreflect.Name = "requests"
+)
+
+// Field numbers of the entpb.BatchCreateUsersResponse message.
+const (
+	BatchCreateUsersResponse_Users_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchCreateUsersResponse message, as used by the paths of field masks.
+const (
+	BatchCreateUsersResponse_Users_FieldName protoreflect.Name = "users"
+)
+
+// Field numbers of the entpb.UpsertUserRequest message.
+const (
+	UpsertUserRequest_User_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.UpsertUserRequest message, as used by the paths of field masks.
+const (
+	UpsertUserRequest_User_FieldName protoreflect.Name = "user"
+)
+
+// Field numbers of the entpb.BatchGetUsersRequest message.
+const (
+	BatchGetUsersRequest_Ids_FieldNumber  protoreflect.FieldNumber = 1
+	BatchGetUsersRequest_View_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.BatchGetUsersRequest message, as used by the paths of field masks.
+const (
+	BatchGetUsersRequest_Ids_FieldName  protoreflect.Name = "ids"
+	BatchGetUsersRequest_View_FieldName protoreflect.Name = "view"
+)
+
+// Field numbers of the entpb.BatchGetUsersResponse message.
+const (
+	BatchGetUsersResponse_Users_FieldNumber      protoreflect.FieldNumber = 1
+	BatchGetUsersResponse_MissingIds_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.BatchGetUsersResponse message, as used by the paths of field masks.
+const (
+	BatchGetUsersResponse_Users_FieldName      protoreflect.Name = "users"
+	BatchGetUsersResponse_MissingIds_FieldName protoreflect.Name = "missing_ids"
+)
+
+// Field numbers of the entpb.BatchUpdateUsersRequest message.
+const (
+	BatchUpdateUsersRequest_Requests_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchUpdateUsersRequest message, as used by the paths of field masks.
+const (
+	BatchUpdateUsersRequest_Requests_FieldName protoreflect.Name = "requests"
+)
+
+// Field numbers of the entpb.BatchUpdateUsersResponse message.
+const (
+	BatchUpdateUsersResponse_Users_FieldNumber protoreflect.FieldNumber = 1
+)
+
+// Field names of the entpb.BatchUpdateUsersResponse message, as used by the paths of field masks.
+const (
+	BatchUpdateUsersResponse_Users_FieldName protoreflect.Name = "users"
+)
+
+// Field numbers of the entpb.BatchDeleteUsersRequest message.
+const (
+	BatchDeleteUsersRequest_Ids_FieldNumber    protoreflect.FieldNumber = 1
+	BatchDeleteUsersRequest_Filter_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.BatchDeleteUsersRequest message, as used by the paths of field masks.
+const (
+	BatchDeleteUsersRequest_Ids_FieldName    protoreflect.Name = "ids"
+	BatchDeleteUsersRequest_Filter_FieldName protoreflect.Name = "filter"
+)
+
+// Field numbers of the entpb.StatsUserResponse message.
+const (
+	StatsUserResponse_Count_FieldNumber  protoreflect.FieldNumber = 1
+	StatsUserResponse_Fields_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.StatsUserResponse message, as used by the paths of field masks.
+const (
+	StatsUserResponse_Count_FieldName  protoreflect.Name = "count"
+	StatsUserResponse_Fields_FieldName protoreflect.Name = "fields"
+)
+
+// Field numbers of the entpb.ExportUserRequest message.
+const (
+	ExportUserRequest_Format_FieldNumber    protoreflect.FieldNumber = 1
+	ExportUserRequest_Cursor_FieldNumber    protoreflect.FieldNumber = 2
+	ExportUserRequest_BatchSize_FieldNumber protoreflect.FieldNumber = 3
+)
+
+// Field names of the entpb.ExportUserRequest message, as used by the paths of field masks.
+const (
+	ExportUserRequest_Format_FieldName    protoreflect.Name = "format"
+	ExportUserRequest_Cursor_FieldName    protoreflect.Name = "cursor"
+	ExportUserRequest_BatchSize_FieldName protoreflect.Name = "batch_size"
+)
+
+// Field numbers of the entpb.ExportUserResponse message.
+const (
+	ExportUserResponse_Data_FieldNumber   protoreflect.FieldNumber = 1
+	ExportUserResponse_Cursor_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.ExportUserResponse message, as used by the paths of field masks.
+const (
+	ExportUserResponse_Data_FieldName   protoreflect.Name = "data"
+	ExportUserResponse_Cursor_FieldName protoreflect.Name = "cursor"
+)
+
+// Field numbers of the entpb.ImportUserRequest message.
+const (
+	ImportUserRequest_Format_FieldNumber protoreflect.FieldNumber = 1
+	ImportUserRequest_Data_FieldNumber   protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.ImportUserRequest message, as used by the paths of field masks.
+const (
+	ImportUserRequest_Format_FieldName protoreflect.Name = "format"
+	ImportUserRequest_Data_FieldName   protoreflect.Name = "data"
+)
+
+// Field numbers of the entpb.ImportUserResponse message.
+const (
+	ImportUserResponse_Count_FieldNumber  protoreflect.FieldNumber = 1
+	ImportUserResponse_Cursor_FieldNumber protoreflect.FieldNumber = 2
+)
+
+// Field names of the entpb.ImportUserResponse message, as used by the paths of field masks.
+const (
+	ImportUserResponse_Count_FieldName  protoreflect.Name = "count"
+	ImportUserResponse_Cursor_FieldName protoreflect.Name = "cursor"
+)
+
+// Field numbers of the entpb.UserEvent message.
+const (
+	UserEvent_Type_FieldNumber protoreflect.FieldNumber = 1
+	UserEvent_Id_FieldNumber   protoreflect.FieldNumber = 2
+	UserEvent_User_FieldNumber protoreflect.FieldNumber = 3
+)
+
+// Field names of the entpb.UserEvent message, as used by the paths of field masks.
+const (
+	UserEvent_Type_FieldName protoreflect.Name = "type"
+	UserEvent_Id_FieldName   protoreflect.Name = "id"
+	UserEvent_User_FieldName protoreflect.Name = "user"
+)
@@ -0,0 +1,1239 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpbmock
+
+import (
+	context "context"
+	entpb "entgo.io/contrib/entproto/internal/todo/ent/proto/entpb"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	proto "google.golang.org/protobuf/proto"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	sync "sync"
+)
+
+// Call is a call of a method of a fake service.
+type Call struct {
+	// Method is the name of the method.
+	Method string
+	// Request is the request of the call, or nil for the client-streaming methods.
+	Request proto.Message
+}
+
+// recorder records the calls of the methods of a fake service.
+type recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// record records a call of the method.
+func (r *recorder) record(method string, req proto.Message) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Request: req})
+}
+
+// Calls returns the calls of the methods of the service, in the order they were made.
+func (r *recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// AttachmentService is a fake entpb.AttachmentServiceServer for testing the code depending on the service without a database.
+// Its methods call the functions of the matching <Method>Func fields, and fail with the Unimplemented code if
+// they are nil. The calls of the methods are recorded, see Calls.
+type AttachmentService struct {
+	// CreateFunc is called by the Create method.
+	CreateFunc func(context.Context, *entpb.CreateAttachmentRequest) (*entpb.Attachment, error)
+	// GetFunc is called by the Get method.
+	GetFunc func(context.Context, *entpb.GetAttachmentRequest) (*entpb.Attachment, error)
+	// ExistsFunc is called by the Exists method.
+	ExistsFunc func(context.Context, *entpb.ExistsAttachmentRequest) (*entpb.ExistsAttachmentResponse, error)
+	// UpdateFunc is called by the Update method.
+	UpdateFunc func(context.Context, *entpb.UpdateAttachmentRequest) (*entpb.Attachment, error)
+	// DeleteFunc is called by the Delete method.
+	DeleteFunc func(context.Context, *entpb.DeleteAttachmentRequest) (*emptypb.Empty, error)
+	// ListFunc is called by the List method.
+	ListFunc func(context.Context, *entpb.ListAttachmentRequest) (*entpb.ListAttachmentResponse, error)
+	// CountFunc is called by the Count method.
+	CountFunc func(context.Context, *entpb.CountAttachmentsRequest) (*entpb.CountAttachmentsResponse, error)
+	// BatchCreateFunc is called by the BatchCreate method.
+	BatchCreateFunc func(context.Context, *entpb.BatchCreateAttachmentsRequest) (*entpb.BatchCreateAttachmentsResponse, error)
+	// BatchDeleteFunc is called by the BatchDelete method.
+	BatchDeleteFunc func(context.Context, *entpb.BatchDeleteAttachmentsRequest) (*emptypb.Empty, error)
+	// ListAttachmentRecipientsFunc is called by the ListAttachmentRecipients method.
+	ListAttachmentRecipientsFunc func(context.Context, *entpb.ListAttachmentRecipientsRequest) (*entpb.ListAttachmentRecipientsResponse, error)
+	// AddAttachmentRecipientFunc is called by the AddAttachmentRecipient method.
+	AddAttachmentRecipientFunc func(context.Context, *entpb.AddAttachmentRecipientRequest) (*emptypb.Empty, error)
+	// RemoveAttachmentRecipientFunc is called by the RemoveAttachmentRecipient method.
+	RemoveAttachmentRecipientFunc func(context.Context, *entpb.RemoveAttachmentRecipientRequest) (*emptypb.Empty, error)
+	// UploadFunc is called by the Upload method.
+	UploadFunc func(entpb.AttachmentService_UploadServer) error
+	// SyncFunc is called by the Sync method.
+	SyncFunc func(entpb.AttachmentService_SyncServer) error
+	recorder
+	entpb.UnimplementedAttachmentServiceServer
+}
+
+var _ entpb.AttachmentServiceServer = (*AttachmentService)(nil)
+
+// Create implements entpb.AttachmentServiceServer.Create.
+func (s *AttachmentService) Create(ctx context.Context, req *entpb.CreateAttachmentRequest) (*entpb.Attachment, error) {
+	s.record("Create", req)
+	if s.CreateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Create not implemented")
+	}
+	return s.CreateFunc(ctx, req)
+}
+
+// Get implements entpb.AttachmentServiceServer.Get.
+func (s *AttachmentService) Get(ctx context.Context, req *entpb.GetAttachmentRequest) (*entpb.Attachment, error) {
+	s.record("Get", req)
+	if s.GetFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Get not implemented")
+	}
+	return s.GetFunc(ctx, req)
+}
+
+// Exists implements entpb.AttachmentServiceServer.Exists.
+func (s *AttachmentService) Exists(ctx context.Context, req *entpb.ExistsAttachmentRequest) (*entpb.ExistsAttachmentResponse, error) {
+	s.record("Exists", req)
+	if s.ExistsFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Exists not implemented")
+	}
+	return s.ExistsFunc(ctx, req)
+}
+
+// Update implements entpb.AttachmentServiceServer.Update.
+func (s *AttachmentService) Update(ctx context.Context, req *entpb.UpdateAttachmentRequest) (*entpb.Attachment, error) {
+	s.record("Update", req)
+	if s.UpdateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Update not implemented")
+	}
+	return s.UpdateFunc(ctx, req)
+}
+
+// Delete implements entpb.AttachmentServiceServer.Delete.
+func (s *AttachmentService) Delete(ctx context.Context, req *entpb.DeleteAttachmentRequest) (*emptypb.Empty, error) {
+	s.record("Delete", req)
+	if s.DeleteFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
+	}
+	return s.DeleteFunc(ctx, req)
+}
+
+// List implements entpb.AttachmentServiceServer.List.
+func (s *AttachmentService) List(ctx context.Context, req *entpb.ListAttachmentRequest) (*entpb.ListAttachmentResponse, error) {
+	s.record("List", req)
+	if s.ListFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method List not implemented")
+	}
+	return s.ListFunc(ctx, req)
+}
+
+// Count implements entpb.AttachmentServiceServer.Count.
+func (s *AttachmentService) Count(ctx context.Context, req *entpb.CountAttachmentsRequest) (*entpb.CountAttachmentsResponse, error) {
+	s.record("Count", req)
+	if s.CountFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Count not implemented")
+	}
+	return s.CountFunc(ctx, req)
+}
+
+// BatchCreate implements entpb.AttachmentServiceServer.BatchCreate.
+func (s *AttachmentService) BatchCreate(ctx context.Context, req *entpb.BatchCreateAttachmentsRequest) (*entpb.BatchCreateAttachmentsResponse, error) {
+	s.record("BatchCreate", req)
+	if s.BatchCreateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method BatchCreate not implemented")
+	}
+	return s.BatchCreateFunc(ctx, req)
+}
+
+// BatchDelete implements entpb.AttachmentServiceServer.BatchDelete.
+func (s *AttachmentService) BatchDelete(ctx context.Context, req *entpb.BatchDeleteAttachmentsRequest) (*emptypb.Empty, error) {
+	s.record("BatchDelete", req)
+	if s.BatchDeleteFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method BatchDelete not implemented")
+	}
+	return s.BatchDeleteFunc(ctx, req)
+}
+
+// ListAttachmentRecipients implements entpb.AttachmentServiceServer.ListAttachmentRecipients.
+func (s *AttachmentService) ListAttachmentRecipients(ctx context.Context, req *entpb.ListAttachmentRecipientsRequest) (*entpb.ListAttachmentRecipientsResponse, error) {
+	s.record("ListAttachmentRecipients", req)
+	if s.ListAttachmentRecipientsFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method ListAttachmentRecipients not implemented")
+	}
+	return s.ListAttachmentRecipientsFunc(ctx, req)
+}
+
+// AddAttachmentRecipient implements entpb.AttachmentServiceServer.AddAttachmentRecipient.
+func (s *AttachmentService) AddAttachmentRecipient(ctx context.Context, req *entpb.AddAttachmentRecipientRequest) (*emptypb.Empty, error) {
+	s.record("AddAttachmentRecipient", req)
+	if s.AddAttachmentRecipientFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method AddAttachmentRecipient not implemented")
+	}
+	return s.AddAttachmentRecipientFunc(ctx, req)
+}
+
+// RemoveAttachmentRecipient implements entpb.AttachmentServiceServer.RemoveAttachmentRecipient.
+func (s *AttachmentService) RemoveAttachmentRecipient(ctx context.Context, req *entpb.RemoveAttachmentRecipientRequest) (*emptypb.Empty, error) {
+	s.record("RemoveAttachmentRecipient", req)
+	if s.RemoveAttachmentRecipientFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method RemoveAttachmentRecipient not implemented")
+	}
+	return s.RemoveAttachmentRecipientFunc(ctx, req)
+}
+
+// Upload implements entpb.AttachmentServiceServer.Upload.
+func (s *AttachmentService) Upload(stream entpb.AttachmentService_UploadServer) error {
+	s.record("Upload", nil)
+	if s.UploadFunc == nil {
+		return status.Error(codes.Unimplemented, "method Upload not implemented")
+	}
+	return s.UploadFunc(stream)
+}
+
+// Sync implements entpb.AttachmentServiceServer.Sync.
+func (s *AttachmentService) Sync(stream entpb.AttachmentService_SyncServer) error {
+	s.record("Sync", nil)
+	if s.SyncFunc == nil {
+		return status.Error(codes.Unimplemented, "method Sync not implemented")
+	}
+	return s.SyncFunc(stream)
+}
+
+// DocumentService is a fake entpb.DocumentServiceServer for testing the code depending on the service without a database.
+// Its methods call the functions of the matching <Method>Func fields, and fail with the Unimplemented code if
+// they are nil. The calls of the methods are recorded, see Calls.
+type DocumentService struct {
+	// CreateFunc is called by the Create method.
+	CreateFunc func(context.Context, *entpb.CreateDocumentRequest) (*entpb.Document, error)
+	// GetFunc is called by the Get method.
+	GetFunc func(context.Context, *entpb.GetDocumentRequest) (*entpb.Document, error)
+	// UpdateFunc is called by the Update method.
+	UpdateFunc func(context.Context, *entpb.UpdateDocumentRequest) (*entpb.Document, error)
+	// DeleteFunc is called by the Delete method.
+	DeleteFunc func(context.Context, *entpb.DeleteDocumentRequest) (*entpb.Document, error)
+	// DeleteDocumentsFunc is called by the DeleteDocuments method.
+	DeleteDocumentsFunc func(context.Context, *entpb.DeleteDocumentsRequest) (*entpb.DeleteDocumentsResponse, error)
+	// ListFunc is called by the List method.
+	ListFunc func(context.Context, *entpb.ListDocumentRequest) (*entpb.ListDocumentResponse, error)
+	// CountFunc is called by the Count method.
+	CountFunc func(context.Context, *entpb.CountDocumentsRequest) (*entpb.CountDocumentsResponse, error)
+	// BatchCreateFunc is called by the BatchCreate method.
+	BatchCreateFunc func(context.Context, *entpb.BatchCreateDocumentsRequest) (*entpb.BatchCreateDocumentsResponse, error)
+	// ArchiveFunc is called by the Archive method.
+	ArchiveFunc func(context.Context, *entpb.Document) (*entpb.Document, error)
+	// TailFunc is called by the Tail method.
+	TailFunc func(*entpb.ListDocumentRequest, entpb.DocumentService_TailServer) error
+	recorder
+	entpb.UnimplementedDocumentServiceServer
+}
+
+var _ entpb.DocumentServiceServer = (*DocumentService)(nil)
+
+// Create implements entpb.DocumentServiceServer.Create.
+func (s *DocumentService) Create(ctx context.Context, req *entpb.CreateDocumentRequest) (*entpb.Document, error) {
+	s.record("Create", req)
+	if s.CreateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Create not implemented")
+	}
+	return s.CreateFunc(ctx, req)
+}
+
+// Get implements entpb.DocumentServiceServer.Get.
+func (s *DocumentService) Get(ctx context.Context, req *entpb.GetDocumentRequest) (*entpb.Document, error) {
+	s.record("Get", req)
+	if s.GetFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Get not implemented")
+	}
+	return s.GetFunc(ctx, req)
+}
+
+// Update implements entpb.DocumentServiceServer.Update.
+func (s *DocumentService) Update(ctx context.Context, req *entpb.UpdateDocumentRequest) (*entpb.Document, error) {
+	s.record("Update", req)
+	if s.UpdateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Update not implemented")
+	}
+	return s.UpdateFunc(ctx, req)
+}
+
+// Delete implements entpb.DocumentServiceServer.Delete.
+func (s *DocumentService) Delete(ctx context.Context, req *entpb.DeleteDocumentRequest) (*entpb.Document, error) {
+	s.record("Delete", req)
+	if s.DeleteFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
+	}
+	return s.DeleteFunc(ctx, req)
+}
+
+// DeleteDocuments implements entpb.DocumentServiceServer.DeleteDocuments.
+func (s *DocumentService) DeleteDocuments(ctx context.Context, req *entpb.DeleteDocumentsRequest) (*entpb.DeleteDocumentsResponse, error) {
+	s.record("DeleteDocuments", req)
+	if s.DeleteDocumentsFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method DeleteDocuments not implemented")
+	}
+	return s.DeleteDocumentsFunc(ctx, req)
+}
+
+// List implements entpb.DocumentServiceServer.List.
+func (s *DocumentService) List(ctx context.Context, req *entpb.ListDocumentRequest) (*entpb.ListDocumentResponse, error) {
+	s.record("List", req)
+	if s.ListFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method List not implemented")
+	}
+	return s.ListFunc(ctx, req)
+}
+
+// Count implements entpb.DocumentServiceServer.Count.
+func (s *DocumentService) Count(ctx context.Context, req *entpb.CountDocumentsRequest) (*entpb.CountDocumentsResponse, error) {
+	s.record("Count", req)
+	if s.CountFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Count not implemented")
+	}
+	return s.CountFunc(ctx, req)
+}
+
+// BatchCreate implements entpb.DocumentServiceServer.BatchCreate.
+func (s *DocumentService) BatchCreate(ctx context.Context, req *entpb.BatchCreateDocumentsRequest) (*entpb.BatchCreateDocumentsResponse, error) {
+	s.record("BatchCreate", req)
+	if s.BatchCreateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method BatchCreate not implemented")
+	}
+	return s.BatchCreateFunc(ctx, req)
+}
+
+// Archive implements entpb.DocumentServiceServer.Archive.
+func (s *DocumentService) Archive(ctx context.Context, req *entpb.Document) (*entpb.Document, error) {
+	s.record("Archive", req)
+	if s.ArchiveFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Archive not implemented")
+	}
+	return s.ArchiveFunc(ctx, req)
+}
+
+// Tail implements entpb.DocumentServiceServer.Tail.
+func (s *DocumentService) Tail(req *entpb.ListDocumentRequest, stream entpb.DocumentService_TailServer) error {
+	s.record("Tail", req)
+	if s.TailFunc == nil {
+		return status.Error(codes.Unimplemented, "method Tail not implemented")
+	}
+	return s.TailFunc(req, stream)
+}
+
+// LabelService is a fake entpb.LabelServiceServer for testing the code depending on the service without a database.
+// Its methods call the functions of the matching <Method>Func fields, and fail with the Unimplemented code if
+// they are nil. The calls of the methods are recorded, see Calls.
+type LabelService struct {
+	// CreateFunc is called by the Create method.
+	CreateFunc func(context.Context, *entpb.CreateLabelRequest) (*entpb.Label, error)
+	// GetFunc is called by the Get method.
+	GetFunc func(context.Context, *entpb.GetLabelRequest) (*entpb.Label, error)
+	// UpdateFunc is called by the Update method.
+	UpdateFunc func(context.Context, *entpb.UpdateLabelRequest) (*entpb.Label, error)
+	// DeleteFunc is called by the Delete method.
+	DeleteFunc func(context.Context, *entpb.DeleteLabelRequest) (*emptypb.Empty, error)
+	// ListFunc is called by the List method.
+	ListFunc func(context.Context, *entpb.ListLabelRequest) (*entpb.ListLabelResponse, error)
+	// BatchCreateFunc is called by the BatchCreate method.
+	BatchCreateFunc func(context.Context, *entpb.BatchCreateLabelsRequest) (*entpb.BatchCreateLabelsResponse, error)
+	// ExportFunc is called by the Export method.
+	ExportFunc func(*entpb.ExportLabelRequest, entpb.LabelService_ExportServer) error
+	recorder
+	entpb.UnimplementedLabelServiceServer
+}
+
+var _ entpb.LabelServiceServer = (*LabelService)(nil)
+
+// Create implements entpb.LabelServiceServer.Create.
+func (s *LabelService) Create(ctx context.Context, req *entpb.CreateLabelRequest) (*entpb.Label, error) {
+	s.record("Create", req)
+	if s.CreateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Create not implemented")
+	}
+	return s.CreateFunc(ctx, req)
+}
+
+// Get implements entpb.LabelServiceServer.Get.
+func (s *LabelService) Get(ctx context.Context, req *entpb.GetLabelRequest) (*entpb.Label, error) {
+	s.record("Get", req)
+	if s.GetFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Get not implemented")
+	}
+	return s.GetFunc(ctx, req)
+}
+
+// Update implements entpb.LabelServiceServer.Update.
+func (s *LabelService) Update(ctx context.Context, req *entpb.UpdateLabelRequest) (*entpb.Label, error) {
+	s.record("Update", req)
+	if s.UpdateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Update not implemented")
+	}
+	return s.UpdateFunc(ctx, req)
+}
+
+// Delete implements entpb.LabelServiceServer.Delete.
+func (s *LabelService) Delete(ctx context.Context, req *entpb.DeleteLabelRequest) (*emptypb.Empty, error) {
+	s.record("Delete", req)
+	if s.DeleteFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
+	}
+	return s.DeleteFunc(ctx, req)
+}
+
+// List implements entpb.LabelServiceServer.List.
+func (s *LabelService) List(ctx context.Context, req *entpb.ListLabelRequest) (*entpb.ListLabelResponse, error) {
+	s.record("List", req)
+	if s.ListFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method List not implemented")
+	}
+	return s.ListFunc(ctx, req)
+}
+
+// BatchCreate implements entpb.LabelServiceServer.BatchCreate.
+func (s *LabelService) BatchCreate(ctx context.Context, req *entpb.BatchCreateLabelsRequest) (*entpb.BatchCreateLabelsResponse, error) {
+	s.record("BatchCreate", req)
+	if s.BatchCreateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method BatchCreate not implemented")
+	}
+	return s.BatchCreateFunc(ctx, req)
+}
+
+// Export implements entpb.LabelServiceServer.Export.
+func (s *LabelService) Export(req *entpb.ExportLabelRequest, stream entpb.LabelService_ExportServer) error {
+	s.record("Export", req)
+	if s.ExportFunc == nil {
+		return status.Error(codes.Unimplemented, "method Export not implemented")
+	}
+	return s.ExportFunc(req, stream)
+}
+
+// MultiWordSchemaService is a fake entpb.MultiWordSchemaServiceServer for testing the code depending on the service without a database.
+// Its methods call the functions of the matching <Method>Func fields, and fail with the Unimplemented code if
+// they are nil. The calls of the methods are recorded, see Calls.
+type MultiWordSchemaService struct {
+	// CreateFunc is called by the Create method.
+	CreateFunc func(context.Context, *entpb.CreateMultiWordSchemaRequest) (*entpb.MultiWordSchema, error)
+	// GetFunc is called by the Get method.
+	GetFunc func(context.Context, *entpb.GetMultiWordSchemaRequest) (*entpb.MultiWordSchema, error)
+	// UpdateFunc is called by the Update method.
+	UpdateFunc func(context.Context, *entpb.UpdateMultiWordSchemaRequest) (*entpb.MultiWordSchema, error)
+	// DeleteFunc is called by the Delete method.
+	DeleteFunc func(context.Context, *entpb.DeleteMultiWordSchemaRequest) (*emptypb.Empty, error)
+	// ListFunc is called by the List method.
+	ListFunc func(context.Context, *entpb.ListMultiWordSchemaRequest) (*entpb.ListMultiWordSchemaResponse, error)
+	// BatchCreateFunc is called by the BatchCreate method.
+	BatchCreateFunc func(context.Context, *entpb.BatchCreateMultiWordSchemasRequest) (*entpb.BatchCreateMultiWordSchemasResponse, error)
+	recorder
+	entpb.UnimplementedMultiWordSchemaServiceServer
+}
+
+var _ entpb.MultiWordSchemaServiceServer = (*MultiWordSchemaService)(nil)
+
+// Create implements entpb.MultiWordSchemaServiceServer.Create.
+func (s *MultiWordSchemaService) Create(ctx context.Context, req *entpb.CreateMultiWordSchemaRequest) (*entpb.MultiWordSchema, error) {
+	s.record("Create", req)
+	if s.CreateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Create not implemented")
+	}
+	return s.CreateFunc(ctx, req)
+}
+
+// Get implements entpb.MultiWordSchemaServiceServer.Get.
+func (s *MultiWordSchemaService) Get(ctx context.Context, req *entpb.GetMultiWordSchemaRequest) (*entpb.MultiWordSchema, error) {
+	s.record("Get", req)
+	if s.GetFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Get not implemented")
+	}
+	return s.GetFunc(ctx, req)
+}
+
+// Update implements entpb.MultiWordSchemaServiceServer.Update.
+func (s *MultiWordSchemaService) Update(ctx context.Context, req *entpb.UpdateMultiWordSchemaRequest) (*entpb.MultiWordSchema, error) {
+	s.record("Update", req)
+	if s.UpdateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Update not implemented")
+	}
+	return s.UpdateFunc(ctx, req)
+}
+
+// Delete implements entpb.MultiWordSchemaServiceServer.Delete.
+func (s *MultiWordSchemaService) Delete(ctx context.Context, req *entpb.DeleteMultiWordSchemaRequest) (*emptypb.Empty, error) {
+	s.record("Delete", req)
+	if s.DeleteFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
+	}
+	return s.DeleteFunc(ctx, req)
+}
+
+// List implements entpb.MultiWordSchemaServiceServer.List.
+func (s *MultiWordSchemaService) List(ctx context.Context, req *entpb.ListMultiWordSchemaRequest) (*entpb.ListMultiWordSchemaResponse, error) {
+	s.record("List", req)
+	if s.ListFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method List not implemented")
+	}
+	return s.ListFunc(ctx, req)
+}
+
+// BatchCreate implements entpb.MultiWordSchemaServiceServer.BatchCreate.
+func (s *MultiWordSchemaService) BatchCreate(ctx context.Context, req *entpb.BatchCreateMultiWordSchemasRequest) (*entpb.BatchCreateMultiWordSchemasResponse, error) {
+	s.record("BatchCreate", req)
+	if s.BatchCreateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method BatchCreate not implemented")
+	}
+	return s.BatchCreateFunc(ctx, req)
+}
+
+// NilExampleService is a fake entpb.NilExampleServiceServer for testing the code depending on the service without a database.
+// Its methods call the functions of the matching <Method>Func fields, and fail with the Unimplemented code if
+// they are nil. The calls of the methods are recorded, see Calls.
+type NilExampleService struct {
+	// CreateFunc is called by the Create method.
+	CreateFunc func(context.Context, *entpb.CreateNilExampleRequest) (*entpb.NilExample, error)
+	// GetFunc is called by the Get method.
+	GetFunc func(context.Context, *entpb.GetNilExampleRequest) (*entpb.NilExample, error)
+	// UpdateFunc is called by the Update method.
+	UpdateFunc func(context.Context, *entpb.UpdateNilExampleRequest) (*entpb.NilExample, error)
+	// DeleteFunc is called by the Delete method.
+	DeleteFunc func(context.Context, *entpb.DeleteNilExampleRequest) (*emptypb.Empty, error)
+	// ListFunc is called by the List method.
+	ListFunc func(context.Context, *entpb.ListNilExampleRequest) (*entpb.ListNilExampleResponse, error)
+	// BatchCreateFunc is called by the BatchCreate method.
+	BatchCreateFunc func(context.Context, *entpb.BatchCreateNilExamplesRequest) (*entpb.BatchCreateNilExamplesResponse, error)
+	recorder
+	entpb.UnimplementedNilExampleServiceServer
+}
+
+var _ entpb.NilExampleServiceServer = (*NilExampleService)(nil)
+
+// Create implements entpb.NilExampleServiceServer.Create.
+func (s *NilExampleService) Create(ctx context.Context, req *entpb.CreateNilExampleRequest) (*entpb.NilExample, error) {
+	s.record("Create", req)
+	if s.CreateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Create not implemented")
+	}
+	return s.CreateFunc(ctx, req)
+}
+
+// Get implements entpb.NilExampleServiceServer.Get.
+func (s *NilExampleService) Get(ctx context.Context, req *entpb.GetNilExampleRequest) (*entpb.NilExample, error) {
+	s.record("Get", req)
+	if s.GetFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Get not implemented")
+	}
+	return s.GetFunc(ctx, req)
+}
+
+// Update implements entpb.NilExampleServiceServer.Update.
+func (s *NilExampleService) Update(ctx context.Context, req *entpb.UpdateNilExampleRequest) (*entpb.NilExample, error) {
+	s.record("Update", req)
+	if s.UpdateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Update not implemented")
+	}
+	return s.UpdateFunc(ctx, req)
+}
+
+// Delete implements entpb.NilExampleServiceServer.Delete.
+func (s *NilExampleService) Delete(ctx context.Context, req *entpb.DeleteNilExampleRequest) (*emptypb.Empty, error) {
+	s.record("Delete", req)
+	if s.DeleteFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
+	}
+	return s.DeleteFunc(ctx, req)
+}
+
+// List implements entpb.NilExampleServiceServer.List.
+func (s *NilExampleService) List(ctx context.Context, req *entpb.ListNilExampleRequest) (*entpb.ListNilExampleResponse, error) {
+	s.record("List", req)
+	if s.ListFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method List not implemented")
+	}
+	return s.ListFunc(ctx, req)
+}
+
+// BatchCreate implements entpb.NilExampleServiceServer.BatchCreate.
+func (s *NilExampleService) BatchCreate(ctx context.Context, req *entpb.BatchCreateNilExamplesRequest) (*entpb.BatchCreateNilExamplesResponse, error) {
+	s.record("BatchCreate", req)
+	if s.BatchCreateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method BatchCreate not implemented")
+	}
+	return s.BatchCreateFunc(ctx, req)
+}
+
+// PetReadService is a fake entpb.PetReadServiceServer for testing the code depending on the service without a database.
+// Its methods call the functions of the matching <Method>Func fields, and fail with the Unimplemented code if
+// they are nil. The calls of the methods are recorded, see Calls.
+type PetReadService struct {
+	// GetFunc is called by the Get method.
+	GetFunc func(context.Context, *entpb.GetPetRequest) (*entpb.Pet, error)
+	// ListFunc is called by the List method.
+	ListFunc func(context.Context, *entpb.ListPetRequest) (*entpb.ListPetResponse, error)
+	recorder
+	entpb.UnimplementedPetReadServiceServer
+}
+
+var _ entpb.PetReadServiceServer = (*PetReadService)(nil)
+
+// Get implements entpb.PetReadServiceServer.Get.
+func (s *PetReadService) Get(ctx context.Context, req *entpb.GetPetRequest) (*entpb.Pet, error) {
+	s.record("Get", req)
+	if s.GetFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Get not implemented")
+	}
+	return s.GetFunc(ctx, req)
+}
+
+// List implements entpb.PetReadServiceServer.List.
+func (s *PetReadService) List(ctx context.Context, req *entpb.ListPetRequest) (*entpb.ListPetResponse, error) {
+	s.record("List", req)
+	if s.ListFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method List not implemented")
+	}
+	return s.ListFunc(ctx, req)
+}
+
+// PetWriteService is a fake entpb.PetWriteServiceServer for testing the code depending on the service without a database.
+// Its methods call the functions of the matching <Method>Func fields, and fail with the Unimplemented code if
+// they are nil. The calls of the methods are recorded, see Calls.
+type PetWriteService struct {
+	// CreateFunc is called by the Create method.
+	CreateFunc func(context.Context, *entpb.CreatePetRequest) (*entpb.Pet, error)
+	// UpdateFunc is called by the Update method.
+	UpdateFunc func(context.Context, *entpb.UpdatePetRequest) (*entpb.Pet, error)
+	// DeleteFunc is called by the Delete method.
+	DeleteFunc func(context.Context, *entpb.DeletePetRequest) (*emptypb.Empty, error)
+	// BatchCreateFunc is called by the BatchCreate method.
+	BatchCreateFunc func(context.Context, *entpb.BatchCreatePetsRequest) (*entpb.BatchCreatePetsResponse, error)
+	recorder
+	entpb.UnimplementedPetWriteServiceServer
+}
+
+var _ entpb.PetWriteServiceServer = (*PetWriteService)(nil)
+
+// Create implements entpb.PetWriteServiceServer.Create.
+func (s *PetWriteService) Create(ctx context.Context, req *entpb.CreatePetRequest) (*entpb.Pet, error) {
+	s.record("Create", req)
+	if s.CreateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Create not implemented")
+	}
+	return s.CreateFunc(ctx, req)
+}
+
+// Update implements entpb.PetWriteServiceServer.Update.
+func (s *PetWriteService) Update(ctx context.Context, req *entpb.UpdatePetRequest) (*entpb.Pet, error) {
+	s.record("Update", req)
+	if s.UpdateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Update not implemented")
+	}
+	return s.UpdateFunc(ctx, req)
+}
+
+// Delete implements entpb.PetWriteServiceServer.Delete.
+func (s *PetWriteService) Delete(ctx context.Context, req *entpb.DeletePetRequest) (*emptypb.Empty, error) {
+	s.record("Delete", req)
+	if s.DeleteFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
+	}
+	return s.DeleteFunc(ctx, req)
+}
+
+// BatchCreate implements entpb.PetWriteServiceServer.BatchCreate.
+func (s *PetWriteService) BatchCreate(ctx context.Context, req *entpb.BatchCreatePetsRequest) (*entpb.BatchCreatePetsResponse, error) {
+	s.record("BatchCreate", req)
+	if s.BatchCreateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method BatchCreate not implemented")
+	}
+	return s.BatchCreateFunc(ctx, req)
+}
+
+// PonyService is a fake entpb.PonyServiceServer for testing the code depending on the service without a database.
+// Its methods call the functions of the matching <Method>Func fields, and fail with the Unimplemented code if
+// they are nil. The calls of the methods are recorded, see Calls.
+type PonyService struct {
+	// BatchCreateFunc is called by the BatchCreate method.
+	BatchCreateFunc func(context.Context, *entpb.BatchCreatePoniesRequest) (*entpb.BatchCreatePoniesResponse, error)
+	recorder
+	entpb.UnimplementedPonyServiceServer
+}
+
+var _ entpb.PonyServiceServer = (*PonyService)(nil)
+
+// BatchCreate implements entpb.PonyServiceServer.BatchCreate.
+func (s *PonyService) BatchCreate(ctx context.Context, req *entpb.BatchCreatePoniesRequest) (*entpb.BatchCreatePoniesResponse, error) {
+	s.record("BatchCreate", req)
+	if s.BatchCreateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method BatchCreate not implemented")
+	}
+	return s.BatchCreateFunc(ctx, req)
+}
+
+// ProjectService is a fake entpb.ProjectServiceServer for testing the code depending on the service without a database.
+// Its methods call the functions of the matching <Method>Func fields, and fail with the Unimplemented code if
+// they are nil. The calls of the methods are recorded, see Calls.
+type ProjectService struct {
+	// CreateFunc is called by the Create method.
+	CreateFunc func(context.Context, *entpb.CreateProjectRequest) (*entpb.Project, error)
+	// GetFunc is called by the Get method.
+	GetFunc func(context.Context, *entpb.ProjectLookupRequest) (*entpb.GetProjectResponse, error)
+	// GetProjectByNameFunc is called by the GetProjectByName method.
+	GetProjectByNameFunc func(context.Context, *entpb.GetProjectByNameRequest) (*entpb.Project, error)
+	// ExistsFunc is called by the Exists method.
+	ExistsFunc func(context.Context, *entpb.ExistsProjectRequest) (*entpb.ExistsProjectResponse, error)
+	// UpdateFunc is called by the Update method.
+	UpdateFunc func(context.Context, *entpb.UpdateProjectRequest) (*entpb.Project, error)
+	// DeleteFunc is called by the Delete method.
+	DeleteFunc func(context.Context, *entpb.DeleteProjectRequest) (*emptypb.Empty, error)
+	// DeleteProjectsFunc is called by the DeleteProjects method.
+	DeleteProjectsFunc func(context.Context, *entpb.DeleteProjectsRequest) (*entpb.DeleteProjectsResponse, error)
+	// RestoreProjectFunc is called by the RestoreProject method.
+	RestoreProjectFunc func(context.Context, *entpb.RestoreProjectRequest) (*entpb.Project, error)
+	// ListFunc is called by the List method.
+	ListFunc func(context.Context, *entpb.ListProjectRequest) (*entpb.ListProjectResponse, error)
+	// StreamProjectsFunc is called by the StreamProjects method.
+	StreamProjectsFunc func(*entpb.StreamProjectsRequest, entpb.ProjectService_StreamProjectsServer) error
+	// CountFunc is called by the Count method.
+	CountFunc func(context.Context, *entpb.CountProjectsRequest) (*entpb.CountProjectsResponse, error)
+	// AggregateProjectFunc is called by the AggregateProject method.
+	AggregateProjectFunc func(context.Context, *entpb.AggregateProjectRequest) (*entpb.AggregateProjectResponse, error)
+	// BatchCreateFunc is called by the BatchCreate method.
+	BatchCreateFunc func(context.Context, *entpb.BatchCreateProjectsRequest) (*entpb.BatchCreateProjectsResponse, error)
+	// UpsertFunc is called by the Upsert method.
+	UpsertFunc func(context.Context, *entpb.UpsertProjectRequest) (*entpb.Project, error)
+	// BatchGetFunc is called by the BatchGet method.
+	BatchGetFunc func(context.Context, *entpb.BatchGetProjectsRequest) (*entpb.ProjectBatch, error)
+	// BatchDeleteFunc is called by the BatchDelete method.
+	BatchDeleteFunc func(context.Context, *entpb.BatchDeleteProjectsRequest) (*emptypb.Empty, error)
+	// StatsFunc is called by the Stats method.
+	StatsFunc func(context.Context, *entpb.StatsProjectRequest) (*entpb.StatsProjectResponse, error)
+	// ExportFunc is called by the Export method.
+	ExportFunc func(*entpb.ExportProjectRequest, entpb.ProjectService_ExportServer) error
+	// ListProjectAttachmentsFunc is called by the ListProjectAttachments method.
+	ListProjectAttachmentsFunc func(context.Context, *entpb.ListProjectAttachmentsRequest) (*entpb.ListProjectAttachmentsResponse, error)
+	// AddProjectAttachmentFunc is called by the AddProjectAttachment method.
+	AddProjectAttachmentFunc func(context.Context, *entpb.AddProjectAttachmentRequest) (*emptypb.Empty, error)
+	// RemoveProjectAttachmentFunc is called by the RemoveProjectAttachment method.
+	RemoveProjectAttachmentFunc func(context.Context, *entpb.RemoveProjectAttachmentRequest) (*emptypb.Empty, error)
+	recorder
+	entpb.UnimplementedProjectServiceServer
+}
+
+var _ entpb.ProjectServiceServer = (*ProjectService)(nil)
+
+// Create implements entpb.ProjectServiceServer.Create.
+func (s *ProjectService) Create(ctx context.Context, req *entpb.CreateProjectRequest) (*entpb.Project, error) {
+	s.record("Create", req)
+	if s.CreateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Create not implemented")
+	}
+	return s.CreateFunc(ctx, req)
+}
+
+// Get implements entpb.ProjectServiceServer.Get.
+func (s *ProjectService) Get(ctx context.Context, req *entpb.ProjectLookupRequest) (*entpb.GetProjectResponse, error) {
+	s.record("Get", req)
+	if s.GetFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Get not implemented")
+	}
+	return s.GetFunc(ctx, req)
+}
+
+// GetProjectByName implements entpb.ProjectServiceServer.GetProjectByName.
+func (s *ProjectService) GetProjectByName(ctx context.Context, req *entpb.GetProjectByNameRequest) (*entpb.Project, error) {
+	s.record("GetProjectByName", req)
+	if s.GetProjectByNameFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method GetProjectByName not implemented")
+	}
+	return s.GetProjectByNameFunc(ctx, req)
+}
+
+// Exists implements entpb.ProjectServiceServer.Exists.
+func (s *ProjectService) Exists(ctx context.Context, req *entpb.ExistsProjectRequest) (*entpb.ExistsProjectResponse, error) {
+	s.record("Exists", req)
+	if s.ExistsFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Exists not implemented")
+	}
+	return s.ExistsFunc(ctx, req)
+}
+
+// Update implements entpb.ProjectServiceServer.Update.
+func (s *ProjectService) Update(ctx context.Context, req *entpb.UpdateProjectRequest) (*entpb.Project, error) {
+	s.record("Update", req)
+	if s.UpdateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Update not implemented")
+	}
+	return s.UpdateFunc(ctx, req)
+}
+
+// Delete implements entpb.ProjectServiceServer.Delete.
+func (s *ProjectService) Delete(ctx context.Context, req *entpb.DeleteProjectRequest) (*emptypb.Empty, error) {
+	s.record("Delete", req)
+	if s.DeleteFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
+	}
+	return s.DeleteFunc(ctx, req)
+}
+
+// DeleteProjects implements entpb.ProjectServiceServer.DeleteProjects.
+func (s *ProjectService) DeleteProjects(ctx context.Context, req *entpb.DeleteProjectsRequest) (*entpb.DeleteProjectsResponse, error) {
+	s.record("DeleteProjects", req)
+	if s.DeleteProjectsFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method DeleteProjects not implemented")
+	}
+	return s.DeleteProjectsFunc(ctx, req)
+}
+
+// RestoreProject implements entpb.ProjectServiceServer.RestoreProject.
+func (s *ProjectService) RestoreProject(ctx context.Context, req *entpb.RestoreProjectRequest) (*entpb.Project, error) {
+	s.record("RestoreProject", req)
+	if s.RestoreProjectFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method RestoreProject not implemented")
+	}
+	return s.RestoreProjectFunc(ctx, req)
+}
+
+// List implements entpb.ProjectServiceServer.List.
+func (s *ProjectService) List(ctx context.Context, req *entpb.ListProjectRequest) (*entpb.ListProjectResponse, error) {
+	s.record("List", req)
+	if s.ListFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method List not implemented")
+	}
+	return s.ListFunc(ctx, req)
+}
+
+// StreamProjects implements entpb.ProjectServiceServer.StreamProjects.
+func (s *ProjectService) StreamProjects(req *entpb.StreamProjectsRequest, stream entpb.ProjectService_StreamProjectsServer) error {
+	s.record("StreamProjects", req)
+	if s.StreamProjectsFunc == nil {
+		return status.Error(codes.Unimplemented, "method StreamProjects not implemented")
+	}
+	return s.StreamProjectsFunc(req, stream)
+}
+
+// Count implements entpb.ProjectServiceServer.Count.
+func (s *ProjectService) Count(ctx context.Context, req *entpb.CountProjectsRequest) (*entpb.CountProjectsResponse, error) {
+	s.record("Count", req)
+	if s.CountFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Count not implemented")
+	}
+	return s.CountFunc(ctx, req)
+}
+
+// AggregateProject implements entpb.ProjectServiceServer.AggregateProject.
+func (s *ProjectService) AggregateProject(ctx context.Context, req *entpb.AggregateProjectRequest) (*entpb.AggregateProjectResponse, error) {
+	s.record("AggregateProject", req)
+	if s.AggregateProjectFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method AggregateProject not implemented")
+	}
+	return s.AggregateProjectFunc(ctx, req)
+}
+
+// BatchCreate implements entpb.ProjectServiceServer.BatchCreate.
+func (s *ProjectService) BatchCreate(ctx context.Context, req *entpb.BatchCreateProjectsRequest) (*entpb.BatchCreateProjectsResponse, error) {
+	s.record("BatchCreate", req)
+	if s.BatchCreateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method BatchCreate not implemented")
+	}
+	return s.BatchCreateFunc(ctx, req)
+}
+
+// Upsert implements entpb.ProjectServiceServer.Upsert.
+func (s *ProjectService) Upsert(ctx context.Context, req *entpb.UpsertProjectRequest) (*entpb.Project, error) {
+	s.record("Upsert", req)
+	if s.UpsertFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Upsert not implemented")
+	}
+	return s.UpsertFunc(ctx, req)
+}
+
+// BatchGet implements entpb.ProjectServiceServer.BatchGet.
+func (s *ProjectService) BatchGet(ctx context.Context, req *entpb.BatchGetProjectsRequest) (*entpb.ProjectBatch, error) {
+	s.record("BatchGet", req)
+	if s.BatchGetFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method BatchGet not implemented")
+	}
+	return s.BatchGetFunc(ctx, req)
+}
+
+// BatchDelete implements entpb.ProjectServiceServer.BatchDelete.
+func (s *ProjectService) BatchDelete(ctx context.Context, req *entpb.BatchDeleteProjectsRequest) (*emptypb.Empty, error) {
+	s.record("BatchDelete", req)
+	if s.BatchDeleteFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method BatchDelete not implemented")
+	}
+	return s.BatchDeleteFunc(ctx, req)
+}
+
+// Stats implements entpb.ProjectServiceServer.Stats.
+func (s *ProjectService) Stats(ctx context.Context, req *entpb.StatsProjectRequest) (*entpb.StatsProjectResponse, error) {
+	s.record("Stats", req)
+	if s.StatsFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Stats not implemented")
+	}
+	return s.StatsFunc(ctx, req)
+}
+
+// Export implements entpb.ProjectServiceServer.Export.
+func (s *ProjectService) Export(req *entpb.ExportProjectRequest, stream entpb.ProjectService_ExportServer) error {
+	s.record("Export", req)
+	if s.ExportFunc == nil {
+		return status.Error(codes.Unimplemented, "method Export not implemented")
+	}
+	return s.ExportFunc(req, stream)
+}
+
+// ListProjectAttachments implements entpb.ProjectServiceServer.ListProjectAttachments.
+func (s *ProjectService) ListProjectAttachments(ctx context.Context, req *entpb.ListProjectAttachmentsRequest) (*entpb.ListProjectAttachmentsResponse, error) {
+	s.record("ListProjectAttachments", req)
+	if s.ListProjectAttachmentsFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method ListProjectAttachments not implemented")
+	}
+	return s.ListProjectAttachmentsFunc(ctx, req)
+}
+
+// AddProjectAttachment implements entpb.ProjectServiceServer.AddProjectAttachment.
+func (s *ProjectService) AddProjectAttachment(ctx context.Context, req *entpb.AddProjectAttachmentRequest) (*emptypb.Empty, error) {
+	s.record("AddProjectAttachment", req)
+	if s.AddProjectAttachmentFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method AddProjectAttachment not implemented")
+	}
+	return s.AddProjectAttachmentFunc(ctx, req)
+}
+
+// RemoveProjectAttachment implements entpb.ProjectServiceServer.RemoveProjectAttachment.
+func (s *ProjectService) RemoveProjectAttachment(ctx context.Context, req *entpb.RemoveProjectAttachmentRequest) (*emptypb.Empty, error) {
+	s.record("RemoveProjectAttachment", req)
+	if s.RemoveProjectAttachmentFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method RemoveProjectAttachment not implemented")
+	}
+	return s.RemoveProjectAttachmentFunc(ctx, req)
+}
+
+// TodoService is a fake entpb.TodoServiceServer for testing the code depending on the service without a database.
+// Its methods call the functions of the matching <Method>Func fields, and fail with the Unimplemented code if
+// they are nil. The calls of the methods are recorded, see Calls.
+type TodoService struct {
+	// CreateFunc is called by the Create method.
+	CreateFunc func(context.Context, *entpb.CreateTodoRequest) (*entpb.Todo, error)
+	// GetFunc is called by the Get method.
+	GetFunc func(context.Context, *entpb.GetTodoRequest) (*entpb.Todo, error)
+	// UpdateFunc is called by the Update method.
+	UpdateFunc func(context.Context, *entpb.UpdateTodoRequest) (*entpb.Todo, error)
+	// DeleteFunc is called by the Delete method.
+	DeleteFunc func(context.Context, *entpb.DeleteTodoRequest) (*emptypb.Empty, error)
+	// ListFunc is called by the List method.
+	ListFunc func(context.Context, *entpb.ListTodoRequest) (*entpb.ListTodoResponse, error)
+	// BatchCreateFunc is called by the BatchCreate method.
+	BatchCreateFunc func(context.Context, *entpb.BatchCreateTodosRequest) (*entpb.BatchCreateTodosResponse, error)
+	recorder
+	entpb.UnimplementedTodoServiceServer
+}
+
+var _ entpb.TodoServiceServer = (*TodoService)(nil)
+
+// Create implements entpb.TodoServiceServer.Create.
+func (s *TodoService) Create(ctx context.Context, req *entpb.CreateTodoRequest) (*entpb.Todo, error) {
+	s.record("Create", req)
+	if s.CreateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Create not implemented")
+	}
+	return s.CreateFunc(ctx, req)
+}
+
+// Get implements entpb.TodoServiceServer.Get.
+func (s *TodoService) Get(ctx context.Context, req *entpb.GetTodoRequest) (*entpb.Todo, error) {
+	s.record("Get", req)
+	if s.GetFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Get not implemented")
+	}
+	return s.GetFunc(ctx, req)
+}
+
+// Update implements entpb.TodoServiceServer.Update.
+func (s *TodoService) Update(ctx context.Context, req *entpb.UpdateTodoRequest) (*entpb.Todo, error) {
+	s.record("Update", req)
+	if s.UpdateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Update not implemented")
+	}
+	return s.UpdateFunc(ctx, req)
+}
+
+// Delete implements entpb.TodoServiceServer.Delete.
+func (s *TodoService) Delete(ctx context.Context, req *entpb.DeleteTodoRequest) (*emptypb.Empty, error) {
+	s.record("Delete", req)
+	if s.DeleteFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
+	}
+	return s.DeleteFunc(ctx, req)
+}
+
+// List implements entpb.TodoServiceServer.List.
+func (s *TodoService) List(ctx context.Context, req *entpb.ListTodoRequest) (*entpb.ListTodoResponse, error) {
+	s.record("List", req)
+	if s.ListFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method List not implemented")
+	}
+	return s.ListFunc(ctx, req)
+}
+
+// BatchCreate implements entpb.TodoServiceServer.BatchCreate.
+func (s *TodoService) BatchCreate(ctx context.Context, req *entpb.BatchCreateTodosRequest) (*entpb.BatchCreateTodosResponse, error) {
+	s.record("BatchCreate", req)
+	if s.BatchCreateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method BatchCreate not implemented")
+	}
+	return s.BatchCreateFunc(ctx, req)
+}
+
+// UserService is a fake entpb.UserServiceServer for testing the code depending on the service without a database.
+// Its methods call the functions of the matching <Method>Func fields, and fail with the Unimplemented code if
+// they are nil. The calls of the methods are recorded, see Calls.
+type UserService struct {
+	// CreateFunc is called by the Create method.
+	CreateFunc func(context.Context, *entpb.CreateUserRequest) (*entpb.User, error)
+	// GetFunc is called by the Get method.
+	GetFunc func(context.Context, *entpb.GetUserRequest) (*entpb.User, error)
+	// GetUserByUserNameFunc is called by the GetUserByUserName method.
+	GetUserByUserNameFunc func(context.Context, *entpb.GetUserByUserNameRequest) (*entpb.User, error)
+	// GetUserByExternalIDFunc is called by the GetUserByExternalID method.
+	GetUserByExternalIDFunc func(context.Context, *entpb.GetUserByExternalIDRequest) (*entpb.User, error)
+	// GetUserByBUser1Func is called by the GetUserByBUser1 method.
+	GetUserByBUser1Func func(context.Context, *entpb.GetUserByBUser1Request) (*entpb.User, error)
+	// ExistsFunc is called by the Exists method.
+	ExistsFunc func(context.Context, *entpb.ExistsUserRequest) (*entpb.ExistsUserResponse, error)
+	// UpdateFunc is called by the Update method.
+	UpdateFunc func(context.Context, *entpb.UpdateUserRequest) (*entpb.User, error)
+	// DeleteFunc is called by the Delete method.
+	DeleteFunc func(context.Context, *entpb.DeleteUserRequest) (*emptypb.Empty, error)
+	// DeleteUsersFunc is called by the DeleteUsers method.
+	DeleteUsersFunc func(context.Context, *entpb.DeleteUsersRequest) (*entpb.DeleteUsersResponse, error)
+	// ListFunc is called by the List method.
+	ListFunc func(context.Context, *entpb.ListUserRequest) (*entpb.ListUserResponse, error)
+	// StreamUsersFunc is called by the StreamUsers method.
+	StreamUsersFunc func(*entpb.StreamUsersRequest, entpb.UserService_StreamUsersServer) error
+	// CountFunc is called by the Count method.
+	CountFunc func(context.Context, *entpb.CountUsersRequest) (*entpb.CountUsersResponse, error)
+	// AggregateUserFunc is called by the AggregateUser method.
+	AggregateUserFunc func(context.Context, *entpb.AggregateUserRequest) (*entpb.AggregateUserResponse, error)
+	// BatchCreateFunc is called by the BatchCreate method.
+	BatchCreateFunc func(context.Context, *entpb.BatchCreateUsersRequest) (*entpb.BatchCreateUsersResponse, error)
+	// UpsertFunc is called by the Upsert method.
+	UpsertFunc func(context.Context, *entpb.UpsertUserRequest) (*entpb.User, error)
+	// BatchGetFunc is called by the BatchGet method.
+	BatchGetFunc func(context.Context, *entpb.BatchGetUsersRequest) (*entpb.BatchGetUsersResponse, error)
+	// BatchUpdateFunc is called by the BatchUpdate method.
+	BatchUpdateFunc func(context.Context, *entpb.BatchUpdateUsersRequest) (*entpb.BatchUpdateUsersResponse, error)
+	// BatchDeleteFunc is called by the BatchDelete method.
+	BatchDeleteFunc func(context.Context, *entpb.BatchDeleteUsersRequest) (*emptypb.Empty, error)
+	// StatsFunc is called by the Stats method.
+	StatsFunc func(context.Context, *entpb.StatsUserRequest) (*entpb.StatsUserResponse, error)
+	// ExportFunc is called by the Export method.
+	ExportFunc func(*entpb.ExportUserRequest, entpb.UserService_ExportServer) error
+	// ImportFunc is called by the Import method.
+	ImportFunc func(entpb.UserService_ImportServer) error
+	// WatchUserFunc is called by the WatchUser method.
+	WatchUserFunc func(*entpb.WatchUserRequest, entpb.UserService_WatchUserServer) error
+	recorder
+	entpb.UnimplementedUserServiceServer
+}
+
+var _ entpb.UserServiceServer = (*UserService)(nil)
+
+// Create implements entpb.UserServiceServer.Create.
+func (s *UserService) Create(ctx context.Context, req *entpb.CreateUserRequest) (*entpb.User, error) {
+	s.record("Create", req)
+	if s.CreateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Create not implemented")
+	}
+	return s.CreateFunc(ctx, req)
+}
+
+// Get implements entpb.UserServiceServer.Get.
+func (s *UserService) Get(ctx context.Context, req *entpb.GetUserRequest) (*entpb.User, error) {
+	s.record("Get", req)
+	if s.GetFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Get not implemented")
+	}
+	return s.GetFunc(ctx, req)
+}
+
+// GetUserByUserName implements entpb.UserServiceServer.GetUserByUserName.
+func (s *UserService) GetUserByUserName(ctx context.Context, req *entpb.GetUserByUserNameRequest) (*entpb.User, error) {
+	s.record("GetUserByUserName", req)
+	if s.GetUserByUserNameFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method GetUserByUserName not implemented")
+	}
+	return s.GetUserByUserNameFunc(ctx, req)
+}
+
+// GetUserByExternalID implements entpb.UserServiceServer.GetUserByExternalID.
+func (s *UserService) GetUserByExternalID(ctx context.Context, req *entpb.GetUserByExternalIDRequest) (*entpb.User, error) {
+	s.record("GetUserByExternalID", req)
+	if s.GetUserByExternalIDFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method GetUserByExternalID not implemented")
+	}
+	return s.GetUserByExternalIDFunc(ctx, req)
+}
+
+// GetUserByBUser1 implements entpb.UserServiceServer.GetUserByBUser1.
+func (s *UserService) GetUserByBUser1(ctx context.Context, req *entpb.GetUserByBUser1Request) (*entpb.User, error) {
+	s.record("GetUserByBUser1", req)
+	if s.GetUserByBUser1Func == nil {
+		return nil, status.Error(codes.Unimplemented, "method GetUserByBUser1 not implemented")
+	}
+	return s.GetUserByBUser1Func(ctx, req)
+}
+
+// Exists implements entpb.UserServiceServer.Exists.
+func (s *UserService) Exists(ctx context.Context, req *entpb.ExistsUserRequest) (*entpb.ExistsUserResponse, error) {
+	s.record("Exists", req)
+	if s.ExistsFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Exists not implemented")
+	}
+	return s.ExistsFunc(ctx, req)
+}
+
+// Update implements entpb.UserServiceServer.Update.
+func (s *UserService) Update(ctx context.Context, req *entpb.UpdateUserRequest) (*entpb.User, error) {
+	s.record("Update", req)
+	if s.UpdateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Update not implemented")
+	}
+	return s.UpdateFunc(ctx, req)
+}
+
+// Delete implements entpb.UserServiceServer.Delete.
+func (s *UserService) Delete(ctx context.Context, req *entpb.DeleteUserRequest) (*emptypb.Empty, error) {
+	s.record("Delete", req)
+	if s.DeleteFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
+	}
+	return s.DeleteFunc(ctx, req)
+}
+
+// DeleteUsers implements entpb.UserServiceServer.DeleteUsers.
+func (s *UserService) DeleteUsers(ctx context.Context, req *entpb.DeleteUsersRequest) (*entpb.DeleteUsersResponse, error) {
+	s.record("DeleteUsers", req)
+	if s.DeleteUsersFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method DeleteUsers not implemented")
+	}
+	return s.DeleteUsersFunc(ctx, req)
+}
+
+// List implements entpb.UserServiceServer.List.
+func (s *UserService) List(ctx context.Context, req *entpb.ListUserRequest) (*entpb.ListUserResponse, error) {
+	s.record("List", req)
+	if s.ListFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method List not implemented")
+	}
+	return s.ListFunc(ctx, req)
+}
+
+// StreamUsers implements entpb.UserServiceServer.StreamUsers.
+func (s *UserService) StreamUsers(req *entpb.StreamUsersRequest, stream entpb.UserService_StreamUsersServer) error {
+	s.record("StreamUsers", req)
+	if s.StreamUsersFunc == nil {
+		return status.Error(codes.Unimplemented, "method StreamUsers not implemented")
+	}
+	return s.StreamUsersFunc(req, stream)
+}
+
+// Count implements entpb.UserServiceServer.Count.
+func (s *UserService) Count(ctx context.Context, req *entpb.CountUsersRequest) (*entpb.CountUsersResponse, error) {
+	s.record("Count", req)
+	if s.CountFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Count not implemented")
+	}
+	return s.CountFunc(ctx, req)
+}
+
+// AggregateUser implements entpb.UserServiceServer.AggregateUser.
+func (s *UserService) AggregateUser(ctx context.Context, req *entpb.AggregateUserRequest) (*entpb.AggregateUserResponse, error) {
+	s.record("AggregateUser", req)
+	if s.AggregateUserFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method AggregateUser not implemented")
+	}
+	return s.AggregateUserFunc(ctx, req)
+}
+
+// BatchCreate implements entpb.UserServiceServer.BatchCreate.
+func (s *UserService) BatchCreate(ctx context.Context, req *entpb.BatchCreateUsersRequest) (*entpb.BatchCreateUsersResponse, error) {
+	s.record("BatchCreate", req)
+	if s.BatchCreateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method BatchCreate not implemented")
+	}
+	return s.BatchCreateFunc(ctx, req)
+}
+
+// Upsert implements entpb.UserServiceServer.Upsert.
+func (s *UserService) Upsert(ctx context.Context, req *entpb.UpsertUserRequest) (*entpb.User, error) {
+	s.record("Upsert", req)
+	if s.UpsertFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Upsert not implemented")
+	}
+	return s.UpsertFunc(ctx, req)
+}
+
+// BatchGet implements entpb.UserServiceServer.BatchGet.
+func (s *UserService) BatchGet(ctx context.Context, req *entpb.BatchGetUsersRequest) (*entpb.BatchGetUsersResponse, error) {
+	s.record("BatchGet", req)
+	if s.BatchGetFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method BatchGet not implemented")
+	}
+	return s.BatchGetFunc(ctx, req)
+}
+
+// BatchUpdate implements entpb.UserServiceServer.BatchUpdate.
+func (s *UserService) BatchUpdate(ctx context.Context, req *entpb.BatchUpdateUsersRequest) (*entpb.BatchUpdateUsersResponse, error) {
+	s.record("BatchUpdate", req)
+	if s.BatchUpdateFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method BatchUpdate not implemented")
+	}
+	return s.BatchUpdateFunc(ctx, req)
+}
+
+// BatchDelete implements entpb.UserServiceServer.BatchDelete.
+func (s *UserService) BatchDelete(ctx context.Context, req *entpb.BatchDeleteUsersRequest) (*emptypb.Empty, error) {
+	s.record("BatchDelete", req)
+	if s.BatchDeleteFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method BatchDelete not implemented")
+	}
+	return s.BatchDeleteFunc(ctx, req)
+}
+
+// Stats implements entpb.UserServiceServer.Stats.
+func (s *UserService) Stats(ctx context.Context, req *entpb.StatsUserRequest) (*entpb.StatsUserResponse, error) {
+	s.record("Stats", req)
+	if s.StatsFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "method Stats not implemented")
+	}
+	return s.StatsFunc(ctx, req)
+}
+
+// Export implements entpb.UserServiceServer.Export.
+func (s *UserService) Export(req *entpb.ExportUserRequest, stream entpb.UserService_ExportServer) error {
+	s.record("Export", req)
+	if s.ExportFunc == nil {
+		return status.Error(codes.Unimplemented, "method Export not implemented")
+	}
+	return s.ExportFunc(req, stream)
+}
+
+// Import implements entpb.UserServiceServer.Import.
+func (s *UserService) Import(stream entpb.UserService_ImportServer) error {
+	s.record("Import", nil)
+	if s.ImportFunc == nil {
+		return status.Error(codes.Unimplemented, "method Import not implemented")
+	}
+	return s.ImportFunc(stream)
+}
+
+// WatchUser implements entpb.UserServiceServer.WatchUser.
+func (s *UserService) WatchUser(req *entpb.WatchUserRequest, stream entpb.UserService_WatchUserServer) error {
+	s.record("WatchUser", req)
+	if s.WatchUserFunc == nil {
+		return status.Error(codes.Unimplemented, "method WatchUser not implemented")
+	}
+	return s.WatchUserFunc(req, stream)
+}
@@ -0,0 +1,51 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entpbmock
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/contrib/entproto/internal/todo/ent/proto/entpb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUserService(t *testing.T) {
+	fake := &UserService{
+		GetFunc: func(_ context.Context, req *entpb.GetUserRequest) (*entpb.User, error) {
+			if req.GetId() != 1 {
+				return nil, status.Error(codes.NotFound, "not found")
+			}
+			return &entpb.User{Id: 1, UserName: "a8m"}, nil
+		},
+	}
+	var svc entpb.UserServiceServer = fake
+	ctx := context.Background()
+
+	u, err := svc.Get(ctx, &entpb.GetUserRequest{Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, "a8m", u.GetUserName())
+	_, err = svc.Get(ctx, &entpb.GetUserRequest{Id: 2})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.Delete(ctx, &entpb.DeleteUserRequest{Id: 1})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	calls := fake.Calls()
+	require.Len(t, calls, 3)
+	require.Equal(t, []string{"Get", "Get", "Delete"}, []string{calls[0].Method, calls[1].Method, calls[2].Method})
+	require.EqualValues(t, 2, calls[1].Request.(*entpb.GetUserRequest).GetId())
+}
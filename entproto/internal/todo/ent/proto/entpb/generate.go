@@ -14,4 +14,4 @@
 
 package entpb
 
-//go:generate protoc -I=.. --go_out=.. --go-grpc_out=.. --go_opt=paths=source_relative --entgrpc_out=.. --entgrpc_opt=paths=source_relative,schema_path=../../schema --go-grpc_opt=paths=source_relative entpb/entpb.proto
+//go:generate protoc -I=.. --go_out=.. --go-grpc_out=.. --go_opt=paths=source_relative --entgrpc_out=.. --entgrpc_opt=paths=source_relative,schema_path=../../schema,field_numbers=true,metrics=true,client=true,mocks=true,tests=true --go-grpc_opt=paths=source_relative entpb/entpb.proto
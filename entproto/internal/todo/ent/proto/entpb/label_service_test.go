@@ -0,0 +1,83 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entpb
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestLabelService_StringID(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewLabelService(client)
+	ctx := context.Background()
+
+	// The ID of a label is set by its creator.
+	for _, id := range []string{"bug", "docs", "feature", "urgent"} {
+		created, err := svc.Create(ctx, &CreateLabelRequest{Label: &Label{Id: id, Color: "red"}})
+		require.NoError(t, err)
+		require.Equal(t, id, created.GetId())
+	}
+	get, err := svc.Get(ctx, &GetLabelRequest{Id: "docs"})
+	require.NoError(t, err)
+	require.Equal(t, "red", get.GetColor())
+	_, err = svc.Get(ctx, &GetLabelRequest{Id: "missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Empty and duplicate IDs are rejected.
+	_, err = svc.Create(ctx, &CreateLabelRequest{Label: &Label{Color: "red"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = svc.Create(ctx, &CreateLabelRequest{Label: &Label{Id: "bug"}})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// Pages are tokenized by the string IDs of their entries.
+	var ids []string
+	req := &ListLabelRequest{PageSize: 3}
+	for {
+		list, err := svc.List(ctx, req)
+		require.NoError(t, err)
+		for _, l := range list.GetLabelList() {
+			ids = append(ids, l.GetId())
+		}
+		if list.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = list.GetNextPageToken()
+	}
+	require.Equal(t, []string{"urgent", "feature", "docs", "bug"}, ids)
+	list, err := svc.List(ctx, &ListLabelRequest{PageToken: base64.StdEncoding.EncodeToString([]byte("docs"))})
+	require.NoError(t, err)
+	require.Len(t, list.GetLabelList(), 2)
+
+	// The IDs are filtered with the predicates of strings.
+	list, err = svc.List(ctx, &ListLabelRequest{Filter: &LabelFilter{
+		Id: &LabelFilter_StringFilter{Contains: wrapperspb.String("u")},
+	}})
+	require.NoError(t, err)
+	require.Len(t, list.GetLabelList(), 3)
+	list, err = svc.List(ctx, &ListLabelRequest{Filter: &LabelFilter{
+		Id: &LabelFilter_StringFilter{Gte: wrapperspb.String("e")},
+	}})
+	require.NoError(t, err)
+	require.Len(t, list.GetLabelList(), 2)
+}
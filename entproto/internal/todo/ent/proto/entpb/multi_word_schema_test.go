@@ -16,11 +16,20 @@ package entpb
 
 import (
 	"context"
+	"strconv"
 	"testing"
 
 	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
 	"entgo.io/contrib/entproto/internal/todo/ent/multiwordschema"
+	"entgo.io/contrib/entproto/runtime"
+	"entgo.io/contrib/entproto/runtime/metrics"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestMultiWordSchemaService_Get(t *testing.T) {
@@ -35,3 +44,186 @@ func TestMultiWordSchemaService_Get(t *testing.T) {
 	require.NoError(t, err)
 	require.EqualValues(t, MultiWordSchema_UNIT_FT, get.GetUnit())
 }
+
+func TestMultiWordSchemaService_ListPageSize(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewMultiWordSchemaService(client)
+	ctx := context.Background()
+	for i := 0; i < 5; i++ {
+		client.MultiWordSchema.Create().
+			SetUnit(multiwordschema.UnitM).
+			SaveX(ctx)
+	}
+
+	// The service is annotated with entproto.PageSize(2, 3).
+	resp, err := svc.List(ctx, &ListMultiWordSchemaRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetMultiWordSchemaList(), 2)
+	require.NotEmpty(t, resp.GetNextPageToken())
+
+	resp, err = svc.List(ctx, &ListMultiWordSchemaRequest{PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, resp.GetMultiWordSchemaList(), 3)
+	require.NotEmpty(t, resp.GetNextPageToken())
+}
+
+func TestMultiWordSchemaService_Limits(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewMultiWordSchemaService(client,
+		runtime.WithDefaultPageSize(1),
+		runtime.WithMaxPageSize(4),
+		runtime.WithMaxBatchSize(2),
+	)
+	ctx := context.Background()
+	for i := 0; i < 5; i++ {
+		client.MultiWordSchema.Create().
+			SetUnit(multiwordschema.UnitM).
+			SaveX(ctx)
+	}
+
+	// The options override the page sizes of the entproto.PageSize annotation.
+	resp, err := svc.List(ctx, &ListMultiWordSchemaRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetMultiWordSchemaList(), 1)
+	resp, err = svc.List(ctx, &ListMultiWordSchemaRequest{PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, resp.GetMultiWordSchemaList(), 4)
+
+	entry := &MultiWordSchema{Unit: MultiWordSchema_UNIT_M}
+	_, err = svc.BatchCreate(ctx, &BatchCreateMultiWordSchemasRequest{
+		Requests: []*CreateMultiWordSchemaRequest{{MultiWordSchema: entry}, {MultiWordSchema: entry}, {MultiWordSchema: entry}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = svc.BatchCreate(ctx, &BatchCreateMultiWordSchemasRequest{
+		Requests: []*CreateMultiWordSchemaRequest{{MultiWordSchema: entry}, {MultiWordSchema: entry}},
+	})
+	require.NoError(t, err)
+}
+
+func TestMultiWordSchemaService_Tracing(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	tp := &recordingTracerProvider{}
+	svc := NewMultiWordSchemaService(client, runtime.WithTracerProvider(tp))
+	ctx := context.Background()
+
+	created, err := svc.Create(ctx, &CreateMultiWordSchemaRequest{
+		MultiWordSchema: &MultiWordSchema{Unit: MultiWordSchema_UNIT_M},
+	})
+	require.NoError(t, err)
+	_, err = svc.List(ctx, &ListMultiWordSchemaRequest{})
+	require.NoError(t, err)
+	_, err = svc.Get(ctx, &GetMultiWordSchemaRequest{Id: created.GetId() + 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	require.Len(t, tp.spans, 3)
+	create, list, get := tp.spans[0], tp.spans[1], tp.spans[2]
+	require.Equal(t, "entpb.MultiWordSchemaService/Create", create.name)
+	require.Contains(t, create.attrs, runtime.EntityKey.String("MultiWordSchema"))
+	require.Contains(t, create.attrs, runtime.IDKey.String(strconv.Itoa(int(created.GetId()))))
+	require.Equal(t, []string{runtime.QueryEvent, runtime.ConvertEvent}, create.events)
+	require.Equal(t, otelcodes.Unset, create.code)
+	require.True(t, create.ended)
+
+	require.Equal(t, "entpb.MultiWordSchemaService/List", list.name)
+	require.Contains(t, list.attrs, runtime.RowsKey.Int(1))
+	require.Equal(t, []string{runtime.QueryEvent, runtime.ConvertEvent}, list.events)
+
+	require.Equal(t, "entpb.MultiWordSchemaService/Get", get.name)
+	require.Equal(t, []string{runtime.QueryEvent}, get.events)
+	require.Equal(t, otelcodes.Error, get.code)
+	require.True(t, get.ended)
+}
+
+func TestMultiWordSchemaService_Metrics(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	reg := prometheus.NewRegistry()
+	recorder, err := metrics.NewPrometheusRecorder(reg)
+	require.NoError(t, err)
+	svc := NewMultiWordSchemaService(client, runtime.WithRecorder(recorder))
+	ctx := context.Background()
+
+	entry := &MultiWordSchema{Unit: MultiWordSchema_UNIT_M}
+	_, err = svc.BatchCreate(ctx, &BatchCreateMultiWordSchemasRequest{
+		Requests: []*CreateMultiWordSchemaRequest{{MultiWordSchema: entry}, {MultiWordSchema: entry}},
+	})
+	require.NoError(t, err)
+	_, err = svc.List(ctx, &ListMultiWordSchemaRequest{PageSize: 2})
+	require.NoError(t, err)
+	_, err = svc.Get(ctx, &GetMultiWordSchemaRequest{Id: 1000})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	samples := make(map[string]float64)
+	for _, f := range families {
+		for _, m := range f.GetMetric() {
+			name := f.GetName()
+			for _, l := range m.GetLabel() {
+				name += "," + l.GetValue()
+			}
+			switch {
+			case m.GetCounter() != nil:
+				samples[name] = m.GetCounter().GetValue()
+			case m.GetHistogram() != nil:
+				samples[name] = m.GetHistogram().GetSampleSum()
+			}
+		}
+	}
+	require.Equal(t, 1.0, samples["entgrpc_requests_total,BatchCreate,entpb.MultiWordSchemaService"])
+	require.Equal(t, 1.0, samples["entgrpc_requests_total,Get,entpb.MultiWordSchemaService"])
+	require.Equal(t, 1.0, samples["entgrpc_errors_total,NotFound,Get,entpb.MultiWordSchemaService"])
+	require.NotContains(t, samples, "entgrpc_errors_total,OK,List,entpb.MultiWordSchemaService")
+	require.Equal(t, 2.0, samples["entgrpc_batch_size,BatchCreate,entpb.MultiWordSchemaService"])
+	require.Equal(t, 2.0, samples["entgrpc_page_size,List,entpb.MultiWordSchemaService"])
+	require.Contains(t, samples, "entgrpc_request_duration_seconds,List,entpb.MultiWordSchemaService")
+}
+
+// recordingTracerProvider records the spans started by its tracer.
+type recordingTracerProvider struct {
+	spans []*recordingSpan
+}
+
+func (tp *recordingTracerProvider) Tracer(string, ...trace.TracerOption) trace.Tracer {
+	return tp
+}
+
+func (tp *recordingTracerProvider) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	cfg := trace.NewSpanStartConfig(opts...)
+	span := &recordingSpan{
+		Span:  trace.SpanFromContext(context.Background()),
+		name:  name,
+		attrs: cfg.Attributes(),
+	}
+	tp.spans = append(tp.spans, span)
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+// recordingSpan records the attributes, events and status of a span.
+type recordingSpan struct {
+	trace.Span
+	name   string
+	attrs  []attribute.KeyValue
+	events []string
+	code   otelcodes.Code
+	ended  bool
+}
+
+func (s *recordingSpan) SetAttributes(kv ...attribute.KeyValue) {
+	s.attrs = append(s.attrs, kv...)
+}
+
+func (s *recordingSpan) AddEvent(name string, _ ...trace.EventOption) {
+	s.events = append(s.events, name)
+}
+
+func (s *recordingSpan) SetStatus(code otelcodes.Code, _ string) {
+	s.code = code
+}
+
+func (s *recordingSpan) End(...trace.SpanEndOption) {
+	s.ended = true
+}
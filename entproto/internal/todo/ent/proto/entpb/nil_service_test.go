@@ -21,6 +21,8 @@ import (
 
 	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -58,3 +60,33 @@ func TestNilExampleService_Create(t *testing.T) {
 	require.EqualValues(t, "str", *get.StrNil)
 	require.EqualValues(t, ts.Unix(), get.TimeNil.Unix())
 }
+
+func TestNilExampleService_RFC3339(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewNilExampleService(client)
+	ctx := context.Background()
+	ts := time.Date(2022, 11, 20, 10, 30, 0, 500, time.UTC)
+	c, err := svc.Create(ctx, &CreateNilExampleRequest{
+		NilExample: &NilExample{
+			TimeStrNil: wrapperspb.String("2022-11-20T12:30:00.0000005+02:00"),
+		},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, "2022-11-20T12:30:00.0000005+02:00", c.GetTimeStrNil().GetValue())
+	created := client.NilExample.GetX(ctx, int(c.Id))
+	require.True(t, ts.Equal(*created.TimeStrNil))
+
+	client.NilExample.UpdateOneID(created.ID).SetTimeStrNil(ts).ExecX(ctx)
+	get, err := svc.Get(ctx, &GetNilExampleRequest{Id: c.Id})
+	require.NoError(t, err)
+	require.EqualValues(t, "2022-11-20T10:30:00.0000005Z", get.GetTimeStrNil().GetValue())
+	require.Nil(t, get.GetTimeNil())
+
+	_, err = svc.Create(ctx, &CreateNilExampleRequest{
+		NilExample: &NilExample{
+			TimeStrNil: wrapperspb.String("20 November 2022"),
+		},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
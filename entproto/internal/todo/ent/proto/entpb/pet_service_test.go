@@ -0,0 +1,51 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entpb
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPetService_SplitReadWrite(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	read, write := NewPetReadService(client), NewPetWriteService(client)
+	ctx := context.Background()
+
+	var readMethods, writeMethods []string
+	for _, m := range PetReadService_ServiceDesc.Methods {
+		readMethods = append(readMethods, m.MethodName)
+	}
+	for _, m := range PetWriteService_ServiceDesc.Methods {
+		writeMethods = append(writeMethods, m.MethodName)
+	}
+	require.ElementsMatch(t, []string{"Get", "List"}, readMethods)
+	require.ElementsMatch(t, []string{"Create", "Update", "Delete", "BatchCreate"}, writeMethods)
+
+	created, err := write.Create(ctx, &CreatePetRequest{Pet: &Pet{Size: Size_SIZE_LARGE}})
+	require.NoError(t, err)
+
+	get, err := read.Get(ctx, &GetPetRequest{Id: created.GetId()})
+	require.NoError(t, err)
+	require.EqualValues(t, Size_SIZE_LARGE, get.GetSize())
+
+	list, err := read.List(ctx, &ListPetRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.GetPetList(), 1)
+}
@@ -18,10 +18,13 @@ import (
 	"context"
 	"entgo.io/contrib/entproto"
 	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
+	entprotoopts "entgo.io/contrib/entproto/options/entproto"
 	"fmt"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	"testing"
 )
 
@@ -36,7 +39,9 @@ func TestPonyService_BatchCreate(t *testing.T) {
 	for i := 0; i < (entproto.MaxBatchCreateSize*2)+5; i++ {
 		request := &CreatePonyRequest{
 			Pony: &Pony{
-				Name: fmt.Sprintf("Pony%d", i),
+				Name:   fmt.Sprintf("Pony%d", i),
+				Height: fmt.Sprintf("1%d.%d", i%10, i%4),
+				Size:   Size(i % 3),
 			},
 		}
 		requests = append(requests, request)
@@ -52,6 +57,8 @@ func TestPonyService_BatchCreate(t *testing.T) {
 	// Check unique values of returned entities
 	for i, entry := range resp.Ponies {
 		require.EqualValues(t, fmt.Sprintf("Pony%d", i), entry.Name)
+		require.EqualValues(t, fmt.Sprintf("1%d.%d", i%10, i%4), entry.Height)
+		require.EqualValues(t, Size(i%3), entry.Size)
 	}
 
 	// Invalid batch size
@@ -62,4 +69,75 @@ func TestPonyService_BatchCreate(t *testing.T) {
 	respStatus, ok := status.FromError(err)
 	require.True(t, ok, "expected a gRPC status error")
 	require.EqualValues(t, respStatus.Code(), codes.InvalidArgument)
+
+	// Invalid height
+	resp, err = svc.BatchCreate(ctx, &BatchCreatePoniesRequest{
+		Requests: []*CreatePonyRequest{{Pony: &Pony{Name: "Shorty", Height: "14.5"}}},
+	})
+	require.Nil(t, resp)
+	respStatus, ok = status.FromError(err)
+	require.True(t, ok, "expected a gRPC status error")
+	require.EqualValues(t, codes.InvalidArgument, respStatus.Code())
+	require.Contains(t, respStatus.Message(), "inches must be between 0 and 3")
+}
+
+func TestPonyService_PackedScores(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewPonyService(client)
+	ctx := context.Background()
+
+	scores := (&Pony{}).ProtoReflect().Descriptor().Fields().ByName("scores")
+	require.True(t, scores.IsPacked())
+
+	resp, err := svc.BatchCreate(ctx, &BatchCreatePoniesRequest{
+		Requests: []*CreatePonyRequest{{Pony: &Pony{Name: "Scorer", Height: "14.2", Scores: []int64{9, 10, 8}}}},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Ponies, 1)
+	require.Equal(t, []int64{9, 10, 8}, resp.Ponies[0].Scores)
+	require.Equal(t, []int64{9, 10, 8}, client.Pony.GetX(ctx, int(resp.Ponies[0].Id)).Scores)
+}
+
+func TestPonyService_RangeCheckedRank(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewPonyService(client)
+	ctx := context.Background()
+
+	resp, err := svc.BatchCreate(ctx, &BatchCreatePoniesRequest{
+		Requests: []*CreatePonyRequest{{Pony: &Pony{Name: "Ranked", Height: "14.2", Rank: wrapperspb.Int32(-128)}}},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, -128, client.Pony.GetX(ctx, int(resp.Ponies[0].Id)).Rank)
+
+	// Values out of the range of int8 are rejected rather than truncated.
+	for _, rank := range []int32{128, -129, 1 << 20} {
+		_, err = svc.BatchCreate(ctx, &BatchCreatePoniesRequest{
+			Requests: []*CreatePonyRequest{{Pony: &Pony{Name: "Overflow", Height: "14.2", Rank: wrapperspb.Int32(rank)}}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+	require.Equal(t, 1, client.Pony.Query().CountX(ctx))
+}
+
+func TestPonyService_DefaultValues(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewPonyService(client)
+	ctx := context.Background()
+
+	color := File_entpb_entpb_proto.Messages().ByName("Pony").Fields().ByName("color")
+	require.Equal(t, "bay", proto.GetExtension(color.Options(), entprotoopts.E_Default))
+
+	// Unset fields are left to their ent defaults rather than set to their proto zero values.
+	resp, err := svc.BatchCreate(ctx, &BatchCreatePoniesRequest{
+		Requests: []*CreatePonyRequest{
+			{Pony: &Pony{Name: "Default", Height: "14.2"}},
+			{Pony: &Pony{Name: "Gray", Height: "14.2", Color: "gray"}},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "bay", client.Pony.GetX(ctx, int(resp.Ponies[0].Id)).Color)
+	require.Equal(t, "gray", client.Pony.GetX(ctx, int(resp.Ponies[1].Id)).Color)
 }
@@ -0,0 +1,722 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entpb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entproto/internal/todo/ent"
+	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
+	"entgo.io/contrib/entproto/internal/todo/ent/project"
+	"entgo.io/contrib/entproto/internal/todo/ent/proto/common"
+	"entgo.io/contrib/entproto/internal/todo/ent/user"
+	"entgo.io/contrib/entproto/runtime"
+	"entgo.io/ent/privacy"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestProjectService_EdgeIds(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewProjectService(client)
+	ctx := context.Background()
+
+	owner := client.User.Create().
+		SetUserName("owner").
+		SetJoined(time.Now()).
+		SetPoints(10).
+		SetExp(1000).
+		SetStatus("pending").
+		SetExternalID(1).
+		SetCrmID(uuid.New()).
+		SetCustomPb(1).
+		SetLabels(nil).
+		SetOmitPrefix(user.OmitPrefixFoo).
+		SaveX(ctx)
+	attachment := client.Attachment.Create().SaveX(ctx)
+	attachmentID, err := attachment.ID.MarshalBinary()
+	require.NoError(t, err)
+
+	created, err := svc.Create(ctx, &CreateProjectRequest{
+		Project: &Project{Name: "entproto"},
+		EdgeIds: &ProjectEdgeIds{
+			OwnerId:       owner.ID,
+			AttachmentIds: [][]byte{attachmentID},
+		},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, "entproto", created.GetName())
+
+	get, err := svc.Get(ctx, &ProjectLookupRequest{Id: created.GetId()})
+	require.NoError(t, err)
+	require.EqualValues(t, "entproto", get.GetProject().GetName())
+	require.Nil(t, get.GetEdgeIds())
+
+	get, err = svc.Get(ctx, &ProjectLookupRequest{
+		Id:   created.GetId(),
+		View: ProjectLookupRequest_WITH_EDGE_IDS,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, owner.ID, get.GetEdgeIds().GetOwnerId())
+	require.EqualValues(t, [][]byte{attachmentID}, get.GetEdgeIds().GetAttachmentIds())
+
+	list, err := svc.List(ctx, &ListProjectRequest{View: ListProjectRequest_WITH_EDGE_IDS})
+	require.NoError(t, err)
+	require.Len(t, list.GetProjectList(), 1)
+	require.Len(t, list.GetEdgeIds(), 1)
+	require.EqualValues(t, owner.ID, list.GetEdgeIds()[0].GetOwnerId())
+
+	batch, err := svc.BatchGet(ctx, &BatchGetProjectsRequest{
+		Ids:  []int64{created.GetId()},
+		View: BatchGetProjectsRequest_WITH_EDGE_IDS,
+	})
+	require.NoError(t, err)
+	require.Len(t, batch.GetProjects(), 1)
+	require.Len(t, batch.GetEdgeIds(), 1)
+	require.EqualValues(t, [][]byte{attachmentID}, batch.GetEdgeIds()[0].GetAttachmentIds())
+
+	// Updating the project without edge IDs leaves its edges untouched.
+	_, err = svc.Update(ctx, &UpdateProjectRequest{
+		Project: &Project{Id: created.GetId(), Name: "entgrpc"},
+	})
+	require.NoError(t, err)
+	proj := client.Project.Query().WithOwner().WithAttachments().OnlyX(ctx)
+	require.EqualValues(t, "entgrpc", proj.Name)
+	require.EqualValues(t, owner.ID, proj.Edges.Owner.ID)
+	require.Len(t, proj.Edges.Attachments, 1)
+}
+
+func TestProjectService_SoftDelete(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewProjectService(client)
+	ctx := context.Background()
+
+	client.Project.Create().SetName("active").SaveX(ctx)
+	deleted := client.Project.Create().SetName("deleted").SetDeletedAt(time.Now()).SaveX(ctx)
+
+	list, err := svc.List(ctx, &ListProjectRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.GetProjectList(), 1)
+	require.EqualValues(t, "active", list.GetProjectList()[0].GetName())
+
+	list, err = svc.List(ctx, &ListProjectRequest{IncludeDeleted: true})
+	require.NoError(t, err)
+	require.Len(t, list.GetProjectList(), 2)
+
+	restored, err := svc.RestoreProject(ctx, &RestoreProjectRequest{Id: int64(deleted.ID)})
+	require.NoError(t, err)
+	require.EqualValues(t, "deleted", restored.GetName())
+	require.True(t, client.Project.GetX(ctx, deleted.ID).DeletedAt.IsZero())
+
+	list, err = svc.List(ctx, &ListProjectRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.GetProjectList(), 2)
+
+	_, err = svc.RestoreProject(ctx, &RestoreProjectRequest{Id: 1000})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Delete marks the entries as deleted, leaving them out of Get unless they are included.
+	_, err = svc.Delete(ctx, &DeleteProjectRequest{Id: int64(deleted.ID)})
+	require.NoError(t, err)
+	require.False(t, client.Project.GetX(ctx, deleted.ID).DeletedAt.IsZero())
+	_, err = svc.Get(ctx, &ProjectLookupRequest{Id: int64(deleted.ID)})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	get, err := svc.Get(ctx, &ProjectLookupRequest{Id: int64(deleted.ID), IncludeDeleted: true})
+	require.NoError(t, err)
+	require.NotNil(t, get.GetProject().GetDeletedAt())
+	_, err = svc.Delete(ctx, &DeleteProjectRequest{Id: int64(deleted.ID), Version: 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.RestoreProject(ctx, &RestoreProjectRequest{Id: int64(deleted.ID)})
+	require.NoError(t, err)
+	_, err = svc.Get(ctx, &ProjectLookupRequest{Id: int64(deleted.ID)})
+	require.NoError(t, err)
+}
+
+func TestProjectService_SoftDeleteWrites(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewProjectService(client)
+	ctx := context.Background()
+
+	active := client.Project.Create().SetName("active").SaveX(ctx)
+	deletedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	deleted := client.Project.Create().SetName("deleted").SetDeletedAt(deletedAt).SaveX(ctx)
+
+	// BatchDelete marks the entries as deleted, leaving the ones already marked as deleted unchanged.
+	_, err := svc.BatchDelete(ctx, &BatchDeleteProjectsRequest{Ids: []int64{int64(active.ID), int64(deleted.ID)}})
+	require.NoError(t, err)
+	require.Equal(t, 2, client.Project.Query().CountX(ctx))
+	got := client.Project.GetX(ctx, active.ID)
+	require.False(t, got.DeletedAt.IsZero())
+	require.Equal(t, 1, got.Version)
+	got = client.Project.GetX(ctx, deleted.ID)
+	require.True(t, deletedAt.Equal(got.DeletedAt))
+	require.Zero(t, got.Version)
+
+	// Update and Upsert do not find the entries marked as deleted.
+	_, err = svc.Update(ctx, &UpdateProjectRequest{Project: &Project{Id: int64(deleted.ID), Name: "updated"}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.Upsert(ctx, &UpsertProjectRequest{Project: &Project{Name: "deleted", Priority: common.Priority_PRIORITY_HIGH}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	got = client.Project.GetX(ctx, deleted.ID)
+	require.Equal(t, "deleted", got.Name)
+	require.Equal(t, project.PriorityLow, got.Priority)
+	require.Equal(t, 2, client.Project.Query().CountX(ctx))
+
+	_, err = svc.RestoreProject(ctx, &RestoreProjectRequest{Id: int64(deleted.ID)})
+	require.NoError(t, err)
+	upserted, err := svc.Upsert(ctx, &UpsertProjectRequest{Project: &Project{Name: "deleted", Priority: common.Priority_PRIORITY_HIGH}})
+	require.NoError(t, err)
+	require.EqualValues(t, deleted.ID, upserted.GetId())
+	require.Equal(t, common.Priority_PRIORITY_HIGH, upserted.GetPriority())
+}
+
+type streamProjectsStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	projects []*Project
+}
+
+func (s *streamProjectsStream) Context() context.Context { return s.ctx }
+
+func (s *streamProjectsStream) Send(p *Project) error {
+	s.projects = append(s.projects, p)
+	return nil
+}
+
+type exportProjectsStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*ExportProjectResponse
+}
+
+func (s *exportProjectsStream) Context() context.Context { return s.ctx }
+
+func (s *exportProjectsStream) Send(r *ExportProjectResponse) error {
+	s.responses = append(s.responses, r)
+	return nil
+}
+
+func TestProjectService_SoftDeleteReads(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewProjectService(client)
+	ctx := context.Background()
+
+	active := client.Project.Create().SetName("active").SaveX(ctx)
+	attachment := client.Attachment.Create().SaveX(ctx)
+	deleted := client.Project.Create().SetName("deleted").SetDeletedAt(time.Now()).AddAttachments(attachment).SaveX(ctx)
+	attachmentID, err := attachment.ID.MarshalBinary()
+	require.NoError(t, err)
+
+	// The read methods leave out the entries marked as deleted.
+	batch, err := svc.BatchGet(ctx, &BatchGetProjectsRequest{Ids: []int64{int64(active.ID), int64(deleted.ID)}})
+	require.NoError(t, err)
+	require.Len(t, batch.GetProjects(), 1)
+	require.Equal(t, []int64{int64(deleted.ID)}, batch.GetMissingIds())
+	_, err = svc.GetProjectByName(ctx, &GetProjectByNameRequest{Name: "deleted"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	exists, err := svc.Exists(ctx, &ExistsProjectRequest{Key: &ExistsProjectRequest_Id{Id: int64(deleted.ID)}})
+	require.NoError(t, err)
+	require.False(t, exists.GetExists())
+	count, err := svc.Count(ctx, &CountProjectsRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, count.GetCount())
+	stream := &streamProjectsStream{ctx: ctx}
+	require.NoError(t, svc.StreamProjects(&StreamProjectsRequest{}, stream))
+	require.Len(t, stream.projects, 1)
+	export := &exportProjectsStream{ctx: ctx}
+	require.NoError(t, svc.Export(&ExportProjectRequest{}, export))
+	require.Len(t, export.responses, 1)
+	r := &runtime.RecordReader{Format: runtime.FormatProtoDelimited}
+	r.Write(export.responses[0].GetData())
+	r.Flush()
+	var exported []*Project
+	for {
+		p := &Project{}
+		ok, err := r.Next(p)
+		require.NoError(t, err)
+		if !ok {
+			break
+		}
+		exported = append(exported, p)
+	}
+	require.Len(t, exported, 1)
+	require.EqualValues(t, active.ID, exported[0].GetId())
+	agg, err := svc.AggregateProject(ctx, &AggregateProjectRequest{Field: "version"})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, agg.GetGroups()[0].GetCount())
+	stats, err := svc.Stats(ctx, &StatsProjectRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, stats.GetCount())
+	require.EqualValues(t, 1, stats.GetFields()[0].GetValues()[0].GetCount())
+
+	// The edges of the entries marked as deleted are neither listed nor changed.
+	_, err = svc.ListProjectAttachments(ctx, &ListProjectAttachmentsRequest{Id: int64(deleted.ID)})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.RemoveProjectAttachment(ctx, &RemoveProjectAttachmentRequest{Id: int64(deleted.ID), AttachmentId: attachmentID})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.AddProjectAttachment(ctx, &AddProjectAttachmentRequest{Id: int64(deleted.ID), AttachmentId: attachmentID})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Equal(t, 1, deleted.QueryAttachments().CountX(ctx))
+
+	// DeleteProjects marks the entries as deleted, leaving the ones already marked as deleted unchanged.
+	res, err := svc.DeleteProjects(ctx, &DeleteProjectsRequest{Filter: &ProjectFilter{Name: &ProjectFilter_StringFilter{In: []string{"active", "deleted"}}}})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, res.GetDeleted())
+	require.Equal(t, 2, client.Project.Query().CountX(ctx))
+	got := client.Project.GetX(ctx, active.ID)
+	require.False(t, got.DeletedAt.IsZero())
+	require.Equal(t, 1, got.Version)
+	require.Zero(t, client.Project.GetX(ctx, deleted.ID).Version)
+}
+
+func TestProjectService_OmitFrom(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewProjectService(client)
+	ctx := context.Background()
+
+	// deleted_at is omitted from the Create and Update requests, and is only set by the server.
+	deletedAt := timestamppb.New(time.Now())
+	created, err := svc.Create(ctx, &CreateProjectRequest{
+		Project: &Project{Name: "entproto", DeletedAt: deletedAt},
+	})
+	require.NoError(t, err)
+	require.True(t, client.Project.GetX(ctx, int(created.GetId())).DeletedAt.IsZero())
+
+	_, err = svc.Update(ctx, &UpdateProjectRequest{
+		Project: &Project{Id: created.GetId(), Name: "entgrpc", DeletedAt: deletedAt},
+	})
+	require.NoError(t, err)
+	updated := client.Project.GetX(ctx, int(created.GetId()))
+	require.EqualValues(t, "entgrpc", updated.Name)
+	require.True(t, updated.DeletedAt.IsZero())
+
+	// The field is still returned by the read methods.
+	client.Project.UpdateOneID(updated.ID).SetDeletedAt(time.Now()).ExecX(ctx)
+	get, err := svc.Get(ctx, &ProjectLookupRequest{Id: created.GetId(), IncludeDeleted: true})
+	require.NoError(t, err)
+	require.NotNil(t, get.GetProject().GetDeletedAt())
+}
+
+func TestProjectService_EnumRef(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	// Projects and ponies share the common.Priority enum, declared in its own package.
+	created, err := NewProjectService(client).Create(ctx, &CreateProjectRequest{
+		Project: &Project{Name: "entproto", Priority: common.Priority_PRIORITY_HIGH},
+	})
+	require.NoError(t, err)
+	require.Equal(t, common.Priority_PRIORITY_HIGH, created.GetPriority())
+	require.Equal(t, project.PriorityHigh, client.Project.GetX(ctx, int(created.GetId())).Priority)
+
+	ponies, err := NewPonyService(client).BatchCreate(ctx, &BatchCreatePoniesRequest{
+		Requests: []*CreatePonyRequest{{Pony: &Pony{Name: "Priority", Height: "14.2", Priority: created.GetPriority()}}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, created.GetPriority(), ponies.GetPonies()[0].GetPriority())
+
+	get, err := NewProjectService(client).Get(ctx, &ProjectLookupRequest{Id: created.GetId()})
+	require.NoError(t, err)
+	require.Equal(t, common.Priority_PRIORITY_HIGH, get.GetProject().GetPriority())
+}
+
+func TestProjectService_Edges(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewProjectService(client)
+	ctx := context.Background()
+
+	project := client.Project.Create().SetName("project").SaveX(ctx)
+	attachment := client.Attachment.Create().SaveX(ctx)
+	attachmentID, err := attachment.ID.MarshalBinary()
+	require.NoError(t, err)
+
+	_, err = svc.AddProjectAttachment(ctx, &AddProjectAttachmentRequest{Id: int64(project.ID), AttachmentId: attachmentID})
+	require.NoError(t, err)
+	list, err := svc.ListProjectAttachments(ctx, &ListProjectAttachmentsRequest{Id: int64(project.ID)})
+	require.NoError(t, err)
+	require.Len(t, list.GetAttachmentIds(), 1)
+	require.EqualValues(t, attachmentID, list.GetAttachmentIds()[0])
+
+	_, err = svc.RemoveProjectAttachment(ctx, &RemoveProjectAttachmentRequest{Id: int64(project.ID), AttachmentId: attachmentID})
+	require.NoError(t, err)
+	list, err = svc.ListProjectAttachments(ctx, &ListProjectAttachmentsRequest{Id: int64(project.ID)})
+	require.NoError(t, err)
+	require.Empty(t, list.GetAttachmentIds())
+
+	_, err = svc.ListProjectAttachments(ctx, &ListProjectAttachmentsRequest{Id: 1000})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.AddProjectAttachment(ctx, &AddProjectAttachmentRequest{Id: int64(project.ID), AttachmentId: []byte("invalid")})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestProjectService_TotalSize(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewProjectService(client)
+	ctx := context.Background()
+
+	client.Project.Create().SetName("p0").SaveX(ctx)
+	client.Project.Create().SetName("p1").SaveX(ctx)
+	client.Project.Create().SetName("deleted").SetDeletedAt(time.Now()).SaveX(ctx)
+
+	// The total size counts the entries of all pages matching the request.
+	list, err := svc.List(ctx, &ListProjectRequest{PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, list.GetProjectList(), 1)
+	require.EqualValues(t, 2, list.GetTotalSize())
+	list, err = svc.List(ctx, &ListProjectRequest{PageSize: 1, PageToken: list.GetNextPageToken()})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, list.GetTotalSize())
+
+	// The schema caps the total size to 3.
+	client.Project.Create().SetName("p2").SaveX(ctx)
+	list, err = svc.List(ctx, &ListProjectRequest{IncludeDeleted: true})
+	require.NoError(t, err)
+	require.Len(t, list.GetProjectList(), 4)
+	require.EqualValues(t, 3, list.GetTotalSize())
+}
+
+func TestProjectService_FilterExpression(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewProjectService(client)
+	ctx := context.Background()
+
+	client.Project.Create().SetName("alpha").SetPriority(project.PriorityHigh).SetVersion(1).SaveX(ctx)
+	client.Project.Create().SetName("beta").SetPriority(project.PriorityLow).SetVersion(2).SaveX(ctx)
+	client.Project.Create().SetName("gamma").SetPriority(project.PriorityHigh).SetVersion(3).SaveX(ctx)
+	client.Project.Create().SetName("delta").SetDeletedAt(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)).SaveX(ctx)
+
+	for filter, names := range map[string][]string{
+		"":                                      {"alpha", "beta", "gamma"},
+		`priority = "high"`:                     {"alpha", "gamma"},
+		`priority = high AND version > 1`:       {"gamma"},
+		`priority = high version >= 1`:          {"alpha", "gamma"},
+		`name = alpha OR name = "beta"`:         {"alpha", "beta"},
+		`NOT name = alpha`:                      {"beta", "gamma"},
+		`-(name = alpha OR name = beta)`:        {"gamma"},
+		`name : "ta"`:                           {"beta"},
+		`version != 2 AND (name:al OR name:am)`: {"alpha", "gamma"},
+		`deleted_at > "2021-12-31T00:00:00Z"`:   {},
+		`version <= -1`:                         {},
+		`name = 'gamma' AND priority != 'low'`:  {"gamma"},
+	} {
+		list, err := svc.List(ctx, &ListProjectRequest{Filter: filter})
+		require.NoError(t, err, filter)
+		got := make([]string, 0, len(list.GetProjectList()))
+		for _, p := range list.GetProjectList() {
+			got = append(got, p.GetName())
+		}
+		require.ElementsMatch(t, names, got, filter)
+	}
+
+	// Deleted entries are still included on demand.
+	list, err := svc.List(ctx, &ListProjectRequest{Filter: `deleted_at > "2021-12-31T00:00:00Z"`, IncludeDeleted: true})
+	require.NoError(t, err)
+	require.Len(t, list.GetProjectList(), 1)
+	require.Equal(t, "delta", list.GetProjectList()[0].GetName())
+
+	for _, filter := range []string{
+		`unknown = 1`,
+		`name`,
+		`name =`,
+		`version = one`,
+		`version : 1`,
+		`name = "alpha`,
+		`(name = alpha`,
+		`name = alpha AND`,
+		`name = alpha)`,
+		`deleted_at > 2022`,
+		strings.Repeat("(", 40) + "name = alpha" + strings.Repeat(")", 40),
+	} {
+		_, err := svc.List(ctx, &ListProjectRequest{Filter: filter})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), filter)
+	}
+}
+
+func TestProjectService_Etag(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewProjectService(client)
+	ctx := context.Background()
+
+	created, err := svc.Create(ctx, &CreateProjectRequest{Project: &Project{Name: "versioned"}})
+	require.NoError(t, err)
+	require.Zero(t, created.GetVersion())
+
+	// Updates of the current version increment it.
+	updated, err := svc.Update(ctx, &UpdateProjectRequest{Project: &Project{Id: created.GetId(), Name: "renamed"}})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, updated.GetVersion())
+
+	// Stale versions are rejected and leave the entry untouched.
+	_, err = svc.Update(ctx, &UpdateProjectRequest{Project: &Project{Id: created.GetId(), Name: "stale"}})
+	require.Equal(t, codes.Aborted, status.Code(err))
+	require.EqualValues(t, "renamed", client.Project.GetX(ctx, int(created.GetId())).Name)
+	_, err = svc.Update(ctx, &UpdateProjectRequest{Project: &Project{Id: 1000, Name: "missing"}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = svc.Delete(ctx, &DeleteProjectRequest{Id: created.GetId(), Version: 0})
+	require.Equal(t, codes.Aborted, status.Code(err))
+	_, err = svc.Delete(ctx, &DeleteProjectRequest{Id: 1000})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.Delete(ctx, &DeleteProjectRequest{Id: created.GetId(), Version: updated.GetVersion()})
+	require.NoError(t, err)
+	deleted := client.Project.GetX(ctx, int(created.GetId()))
+	require.False(t, deleted.DeletedAt.IsZero())
+	require.Equal(t, 2, deleted.Version)
+}
+
+func TestProjectService_Hooks(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	var calls []string
+	svc := NewProjectService(client, WithProjectServiceHooks(ProjectServiceHooks{
+		BeforeCreate: func(_ context.Context, req *CreateProjectRequest, m *ent.ProjectCreate) error {
+			calls = append(calls, "BeforeCreate")
+			if req.GetProject().GetName() == "" {
+				return status.Error(codes.InvalidArgument, "name is required")
+			}
+			m.SetPriority(project.PriorityHigh)
+			return nil
+		},
+		AfterCreate: func(_ context.Context, _ *CreateProjectRequest, res *Project) error {
+			calls = append(calls, "AfterCreate")
+			return nil
+		},
+	}))
+	ctx := context.Background()
+	svc.Use(ProjectServiceHooks{
+		BeforeDelete: func(context.Context, *DeleteProjectRequest) error {
+			calls = append(calls, "BeforeDelete")
+			return status.Error(codes.PermissionDenied, "projects cannot be deleted")
+		},
+	})
+
+	// Before hooks can reject requests and alter the mutations.
+	_, err := svc.Create(ctx, &CreateProjectRequest{Project: &Project{}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	created, err := svc.Create(ctx, &CreateProjectRequest{Project: &Project{Name: "hooked"}})
+	require.NoError(t, err)
+	require.EqualValues(t, common.Priority_PRIORITY_HIGH, created.GetPriority())
+
+	_, err = svc.Delete(ctx, &DeleteProjectRequest{Id: created.GetId()})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.True(t, client.Project.Query().Where(project.ID(int(created.GetId()))).ExistX(ctx))
+	require.Equal(t, []string{"BeforeCreate", "BeforeCreate", "AfterCreate", "BeforeDelete"}, calls)
+}
+
+func TestProjectService_BatchHooks(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	var calls []string
+	svc := NewProjectService(client, WithProjectServiceHooks(ProjectServiceHooks{
+		BeforeCreate: func(_ context.Context, req *CreateProjectRequest, m *ent.ProjectCreate) error {
+			if req.GetProject().GetName() == "" {
+				return status.Error(codes.InvalidArgument, "name is required")
+			}
+			m.SetPriority(project.PriorityHigh)
+			return nil
+		},
+		AfterCreate: func(_ context.Context, _ *CreateProjectRequest, res *Project) error {
+			calls = append(calls, "AfterCreate "+res.GetName())
+			return nil
+		},
+		BeforeDelete: func(_ context.Context, req *DeleteProjectRequest) error {
+			if name := client.Project.GetX(context.Background(), int(req.GetId())).Name; name == "kept" {
+				return status.Error(codes.PermissionDenied, "kept projects cannot be deleted")
+			}
+			return nil
+		},
+		AfterDelete: func(_ context.Context, req *DeleteProjectRequest) error {
+			calls = append(calls, fmt.Sprintf("AfterDelete %d", req.GetId()))
+			return nil
+		},
+	}))
+	ctx := context.Background()
+
+	// The hooks are run for each entry of the batch, and a failing one fails the whole batch.
+	_, err := svc.BatchCreate(ctx, &BatchCreateProjectsRequest{Requests: []*CreateProjectRequest{
+		{Project: &Project{Name: "first"}},
+		{Project: &Project{}},
+	}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Zero(t, client.Project.Query().CountX(ctx))
+	created, err := svc.BatchCreate(ctx, &BatchCreateProjectsRequest{Requests: []*CreateProjectRequest{
+		{Project: &Project{Name: "first"}},
+		{Project: &Project{Name: "kept"}},
+	}})
+	require.NoError(t, err)
+	for _, p := range created.GetProjects() {
+		require.EqualValues(t, common.Priority_PRIORITY_HIGH, p.GetPriority())
+	}
+	require.Equal(t, []string{"AfterCreate first", "AfterCreate kept"}, calls)
+
+	// Upsert runs the Create hooks with the request holding the entity.
+	upserted, err := svc.Upsert(ctx, &UpsertProjectRequest{Project: &Project{Name: "upserted"}})
+	require.NoError(t, err)
+	require.EqualValues(t, common.Priority_PRIORITY_HIGH, upserted.GetPriority())
+	require.Equal(t, "AfterCreate upserted", calls[len(calls)-1])
+
+	// The Delete hooks are run for each entry deleted by its ID or by the filter, and a failing one rolls back
+	// the deletion of the others.
+	calls = nil
+	first, kept := created.GetProjects()[0], created.GetProjects()[1]
+	_, err = svc.BatchDelete(ctx, &BatchDeleteProjectsRequest{Ids: []int64{first.GetId(), kept.GetId()}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Equal(t, 3, client.Project.Query().Where(project.DeletedAtIsNil()).CountX(ctx))
+	require.Empty(t, calls)
+	_, err = svc.BatchDelete(ctx, &BatchDeleteProjectsRequest{Ids: []int64{first.GetId()}})
+	require.NoError(t, err)
+	require.Equal(t, []string{fmt.Sprintf("AfterDelete %d", first.GetId())}, calls)
+
+	calls = nil
+	res, err := svc.DeleteProjects(ctx, &DeleteProjectsRequest{Filter: &ProjectFilter{Name: &ProjectFilter_StringFilter{Eq: wrapperspb.String("upserted")}}})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, res.GetDeleted())
+	require.Equal(t, []string{fmt.Sprintf("AfterDelete %d", upserted.GetId())}, calls)
+	_, err = svc.DeleteProjects(ctx, &DeleteProjectsRequest{Filter: &ProjectFilter{Name: &ProjectFilter_StringFilter{Contains: wrapperspb.String("e")}}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.True(t, client.Project.Query().Where(project.ID(int(kept.GetId())), project.DeletedAtIsNil()).ExistX(ctx))
+}
+
+func TestProjectService_ReadOnly(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewProjectService(client, runtime.WithReadOnly())
+	ctx := context.Background()
+	created := client.Project.Create().SetName("read-only").SaveX(ctx)
+
+	_, err := svc.Create(ctx, &CreateProjectRequest{Project: &Project{Name: "created"}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = svc.Delete(ctx, &DeleteProjectRequest{Id: int64(created.ID)})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	list, err := svc.List(ctx, &ListProjectRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.GetProjectList(), 1)
+}
+
+type viewerKey struct{}
+
+func TestProjectService_ViewerContext(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewProjectService(client, runtime.WithViewer(func(ctx context.Context, md metadata.MD) (context.Context, error) {
+		v := md.Get("x-viewer")
+		if len(v) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "missing viewer")
+		}
+		return context.WithValue(ctx, viewerKey{}, v[0]), nil
+	}))
+	// Only admins may mutate projects.
+	client.Project.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if v, _ := ctx.Value(viewerKey{}).(string); v != "admin" {
+				return nil, fmt.Errorf("viewer %q cannot mutate projects: %w", v, privacy.Deny)
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	req := &CreateProjectRequest{Project: &Project{Name: "viewed"}}
+
+	_, err := svc.Create(context.Background(), req)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-viewer", "guest"))
+	_, err = svc.Create(ctx, req)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-viewer", "admin"))
+	created, err := svc.Create(ctx, req)
+	require.NoError(t, err)
+	require.EqualValues(t, "viewed", created.GetName())
+}
+
+func TestProjectService_FieldViolations(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewProjectService(client)
+	ctx := context.Background()
+	long := strings.Repeat("x", 65)
+
+	violation := func(err error) *errdetails.BadRequest_FieldViolation {
+		s := status.Convert(err)
+		require.Equal(t, codes.InvalidArgument, s.Code())
+		require.Len(t, s.Details(), 1)
+		details, ok := s.Details()[0].(*errdetails.BadRequest)
+		require.True(t, ok)
+		require.Len(t, details.GetFieldViolations(), 1)
+		return details.GetFieldViolations()[0]
+	}
+	_, err := svc.Create(ctx, &CreateProjectRequest{Project: &Project{Name: long}})
+	v := violation(err)
+	require.Equal(t, "project.name", v.GetField())
+	require.Contains(t, v.GetDescription(), `validator failed for field "Project.name"`)
+
+	created, err := svc.Create(ctx, &CreateProjectRequest{Project: &Project{Name: "valid"}})
+	require.NoError(t, err)
+	created.Name = long
+	_, err = svc.Update(ctx, &UpdateProjectRequest{Project: created})
+	require.Equal(t, "project.name", violation(err).GetField())
+
+	_, err = svc.BatchCreate(ctx, &BatchCreateProjectsRequest{
+		Requests: []*CreateProjectRequest{{Project: &Project{Name: "valid"}}, {Project: &Project{Name: long}}},
+	})
+	require.Equal(t, "project.name", violation(err).GetField())
+}
+
+func TestProjectService_ErrorMapper(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	errQuota := errors.New("project quota exceeded")
+	svc := NewProjectService(client, runtime.WithErrorMapper(func(err error) error {
+		if errors.Is(err, errQuota) {
+			return status.Error(codes.ResourceExhausted, err.Error())
+		}
+		return nil
+	}))
+	client.Project.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if name, _ := m.(*ent.ProjectMutation).Name(); name == "over-quota" {
+				return nil, fmt.Errorf("creating project: %w", errQuota)
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	ctx := context.Background()
+
+	_, err := svc.Create(ctx, &CreateProjectRequest{Project: &Project{Name: "over-quota"}})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	// Errors the mapper does not map fall back to the default mapping.
+	_, err = svc.Get(ctx, &ProjectLookupRequest{Id: 1000})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
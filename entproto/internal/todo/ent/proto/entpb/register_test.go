@@ -0,0 +1,63 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entpb
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
+	"entgo.io/contrib/entproto/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestRegisterAll(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	hs := RegisterAll(srv, client, nil, &documentExtraMethods{}, runtime.WithReadOnly())
+	go srv.Serve(lis)
+	defer srv.Stop()
+	for _, name := range []string{UserService_ServiceDesc.ServiceName, PonyService_ServiceDesc.ServiceName, "grpc.health.v1.Health", "grpc.reflection.v1alpha.ServerReflection"} {
+		require.Contains(t, srv.GetServiceInfo(), name)
+	}
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer cc.Close()
+	ctx := context.Background()
+
+	res, err := grpc_health_v1.NewHealthClient(cc).Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: UserService_ServiceDesc.ServiceName})
+	require.NoError(t, err)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, res.GetStatus())
+	hs.SetServingStatus(UserService_ServiceDesc.ServiceName, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	res, err = grpc_health_v1.NewHealthClient(cc).Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: UserService_ServiceDesc.ServiceName})
+	require.NoError(t, err)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, res.GetStatus())
+
+	// The options are passed to the services.
+	_, err = NewUserServiceClient(cc).Delete(ctx, &DeleteUserRequest{Id: 1})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,60 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entpb
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
+	"entgo.io/contrib/entproto/internal/todo/ent/todo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTodoService_ReadOnly(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewTodoService(client)
+	ctx := context.Background()
+	entry := client.Todo.Create().
+		SetTask("read").
+		SetStatus(todo.StatusInProgress).
+		SaveX(ctx)
+
+	// The methods reading the entries are served.
+	get, err := svc.Get(ctx, &GetTodoRequest{Id: int64(entry.ID)})
+	require.NoError(t, err)
+	require.EqualValues(t, "read", get.GetTask())
+	require.EqualValues(t, Todo_STATUS_IN_PROGRESS, get.GetStatus())
+	list, err := svc.List(ctx, &ListTodoRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.GetTodoList(), 1)
+
+	// The service is annotated with entproto.ReadOnly, and rejects the methods mutating them.
+	_, err = svc.Create(ctx, &CreateTodoRequest{Todo: &Todo{Task: "write"}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = svc.Update(ctx, &UpdateTodoRequest{Todo: &Todo{Id: int64(entry.ID), Task: "write"}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = svc.Delete(ctx, &DeleteTodoRequest{Id: int64(entry.ID)})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = svc.BatchCreate(ctx, &BatchCreateTodosRequest{
+		Requests: []*CreateTodoRequest{{Todo: &Todo{Task: "write"}}},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Equal(t, "read", client.Todo.GetX(ctx, entry.ID).Task)
+	require.Equal(t, 1, client.Todo.Query().CountX(ctx))
+}
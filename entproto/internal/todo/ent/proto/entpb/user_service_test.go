@@ -17,21 +17,31 @@ package entpb
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 	"testing"
 	"time"
 
 	"entgo.io/contrib/entproto"
 	"entgo.io/contrib/entproto/internal/todo/ent/user"
+	"entgo.io/contrib/entproto/runtime"
 
 	"entgo.io/contrib/entproto/internal/todo/ent"
 	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
+	"entgo.io/contrib/entproto/internal/todo/ent/proto/entpb/entpbfields"
+	"entgo.io/contrib/entproto/internal/todo/ent/schema"
 	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestUserService_Create(t *testing.T) {
@@ -63,6 +73,7 @@ func TestUserService_Create(t *testing.T) {
 		AccountBalance: 2000.50,
 		Labels:         []string{"member", "production"},
 		OmitPrefix:     User_BAR,
+		CreatedAt:      timestamppb.New(time.Unix(0, 0)),
 	}
 	created, err := svc.Create(ctx, &CreateUserRequest{
 		User: inputUser,
@@ -80,6 +91,9 @@ func TestUserService_Create(t *testing.T) {
 	require.EqualValues(t, inputUser.HeightInCm, fromDB.HeightInCm)
 	require.EqualValues(t, inputUser.AccountBalance, fromDB.AccountBalance)
 	require.EqualValues(t, inputUser.Labels, fromDB.Labels)
+	// created_at is skipped in Create, and is set by the schema default.
+	require.NotEqual(t, inputUser.CreatedAt.AsTime().Unix(), fromDB.CreatedAt.Unix())
+	require.EqualValues(t, fromDB.CreatedAt.Unix(), created.CreatedAt.AsTime().Unix())
 
 	// preexisting user
 	_, err = svc.Create(ctx, &CreateUserRequest{
@@ -130,6 +144,74 @@ func TestUserService_Get(t *testing.T) {
 	require.EqualValues(t, respStatus.Code(), codes.NotFound)
 }
 
+func TestUserService_EnumConversion(t *testing.T) {
+	require.EqualValues(t, User_STATUS_ACTIVE, ToProtoUserStatus(user.StatusActive))
+	require.EqualValues(t, User_STATUS_UNSPECIFIED, ToProtoUserStatus("unknown"))
+	require.EqualValues(t, user.StatusPending, ToEntUserStatus(User_STATUS_PENDING))
+	require.EqualValues(t, "", ToEntUserStatus(User_STATUS_UNSPECIFIED))
+	for _, st := range []user.Status{user.StatusPending, user.StatusActive} {
+		require.EqualValues(t, st, ToEntUserStatus(ToProtoUserStatus(st)))
+	}
+	require.EqualValues(t, User_BAR, ToProtoUserOmitPrefix(user.OmitPrefixBar))
+	require.EqualValues(t, user.OmitPrefixBar, ToEntUserOmitPrefix(User_BAR))
+}
+
+func TestUserService_ToProtoWithEdges(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	group := client.Group.Create().SetName("managers").SaveX(ctx)
+	attachment := client.Attachment.Create().SaveX(ctx)
+	pet := client.Pet.Create().SetSize("large").AddAttachment(attachment).SaveX(ctx)
+	created := client.User.Create().
+		SetUserName("rotemtam").
+		SetJoined(time.Now()).
+		SetPoints(10).
+		SetExp(1000).
+		SetStatus("pending").
+		SetExternalID(1).
+		SetCrmID(uuid.New()).
+		SetCustomPb(1).
+		SetHeightInCm(170.18).
+		SetAccountBalance(2000.50).
+		SetOmitPrefix(user.OmitPrefixBar).
+		SetBigInt(schema.NewBigInt(42)).
+		SetGroup(group).
+		SetPet(pet).
+		SaveX(ctx)
+	loaded := client.User.Query().
+		Where(user.ID(created.ID)).
+		WithGroup().
+		WithPet(func(q *ent.PetQuery) {
+			q.WithAttachment()
+		}).
+		OnlyX(ctx)
+
+	pb, err := ToProtoUserWithEdges(loaded)
+	require.NoError(t, err)
+	require.EqualValues(t, created.UserName, pb.GetUserName())
+	// Group has no service in the package, its edge only holds the ID.
+	require.EqualValues(t, group.ID, pb.GetGroup().GetId())
+	require.Empty(t, pb.GetGroup().GetName())
+	require.EqualValues(t, pet.ID, pb.GetPet().GetId())
+	require.EqualValues(t, Size_SIZE_LARGE, pb.GetPet().GetSize())
+	// Edges beyond the edge depth only hold their IDs.
+	require.Len(t, pb.GetPet().GetAttachment(), 1)
+	attachmentID, err := attachment.ID.MarshalBinary()
+	require.NoError(t, err)
+	require.EqualValues(t, attachmentID, pb.GetPet().GetAttachment()[0].GetId())
+
+	e, err := ToEntUserWithEdges(pb)
+	require.NoError(t, err)
+	require.EqualValues(t, created.ID, e.ID)
+	require.EqualValues(t, created.UserName, e.UserName)
+	require.EqualValues(t, group.ID, e.Edges.Group.ID)
+	require.EqualValues(t, pet.ID, e.Edges.Pet.ID)
+	require.EqualValues(t, pet.Size, e.Edges.Pet.Size)
+	require.Len(t, e.Edges.Pet.Edges.Attachment, 1)
+	require.EqualValues(t, attachment.ID, e.Edges.Pet.Edges.Attachment[0].ID)
+}
+
 func TestUserService_Delete(t *testing.T) {
 	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
 	defer client.Close()
@@ -193,7 +275,7 @@ func TestUserService_Update(t *testing.T) {
 	inputUser := &User{
 		Id:         created.ID,
 		UserName:   "rotemtam",
-		Joined:     timestamppb.Now(),
+		Joined:     timestamppb.New(created.Joined),
 		Exp:        999,
 		Points:     999,
 		ExternalId: 1,
@@ -218,6 +300,297 @@ func TestUserService_Update(t *testing.T) {
 	afterUpd := client.User.GetX(ctx, created.ID)
 	require.EqualValues(t, inputUser.Exp, afterUpd.Exp)
 	require.EqualValues(t, user.OmitPrefixFoo, afterUpd.OmitPrefix)
+
+	// Leaving an immutable field unset keeps its value.
+	inputUser.Joined = nil
+	inputUser.Exp = 1000
+	inputUser.Attachment = nil
+	_, err = svc.Update(ctx, &UpdateUserRequest{
+		User: inputUser,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1000, client.User.GetX(ctx, created.ID).Exp)
+
+	// Changing an immutable field is rejected.
+	inputUser.Joined = timestamppb.New(created.Joined.Add(time.Hour))
+	inputUser.Exp = 1001
+	_, err = svc.Update(ctx, &UpdateUserRequest{
+		User: inputUser,
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	afterUpd = client.User.GetX(ctx, created.ID)
+	require.EqualValues(t, 1000, afterUpd.Exp)
+	require.Equal(t, created.Joined.Unix(), afterUpd.Joined.Unix())
+}
+
+func TestUserService_UnspecifiedEnum(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	created := client.User.Create().
+		SetUserName("rotemtam").
+		SetJoined(time.Now()).
+		SetPoints(10).
+		SetExp(1000).
+		SetStatus(user.StatusActive).
+		SetDeviceType(user.DeviceTypeSPEEDY300).
+		SetExternalID(1).
+		SetCrmID(uuid.New()).
+		SetCustomPb(1).
+		SetOmitPrefix(user.OmitPrefixFoo).
+		SaveX(ctx)
+	crmID, err := created.CrmID.MarshalBinary()
+	require.NoError(t, err)
+	update := func(svc *UserService, status User_Status, deviceType User_DeviceType) error {
+		_, err := svc.Update(ctx, &UpdateUserRequest{User: &User{
+			Id:         created.ID,
+			UserName:   "rotemtam",
+			Exp:        1000,
+			Points:     10,
+			ExternalId: 1,
+			CrmId:      crmID,
+			CustomPb:   1,
+			OmitPrefix: User_FOO,
+			Status:     status,
+			DeviceType: deviceType,
+		}})
+		return err
+	}
+	violation := func(err error) string {
+		s, _ := status.FromError(err)
+		require.Equal(t, codes.InvalidArgument, s.Code())
+		require.Len(t, s.Details(), 1)
+		return s.Details()[0].(*errdetails.BadRequest).GetFieldViolations()[0].GetField()
+	}
+
+	// By default, unspecified enums are set to their default value, and rejected if they have none.
+	svc := NewUserService(client)
+	require.Equal(t, "user.status", violation(update(svc, User_STATUS_UNSPECIFIED, User_DEVICE_TYPE_SPEEDY300)))
+	_, err = svc.Create(ctx, &CreateUserRequest{User: &User{UserName: "a8m", CrmId: crmID, OmitPrefix: User_FOO}})
+	require.Equal(t, "user.status", violation(err))
+	require.NoError(t, update(svc, User_STATUS_ACTIVE, User_DEVICE_TYPE_GLOWY9000))
+	require.Equal(t, user.DeviceTypeGLOWY9000, client.User.GetX(ctx, created.ID).DeviceType)
+
+	// Unspecified enums are left unchanged by updates.
+	client.User.UpdateOneID(created.ID).SetDeviceType(user.DeviceTypeSPEEDY300).ExecX(ctx)
+	svc = NewUserService(client, runtime.WithUnspecifiedEnum(runtime.UnspecifiedEnumKeep))
+	require.NoError(t, update(svc, User_STATUS_UNSPECIFIED, User_DEVICE_TYPE_GLOWY9000))
+	got := client.User.GetX(ctx, created.ID)
+	require.Equal(t, user.StatusActive, got.Status)
+	require.Equal(t, user.DeviceTypeSPEEDY300, got.DeviceType)
+
+	// Unspecified enums are rejected, even if they have a default value.
+	svc = NewUserService(client, runtime.WithUnspecifiedEnum(runtime.UnspecifiedEnumReject))
+	require.Equal(t, "user.device_type", violation(update(svc, User_STATUS_ACTIVE, User_DEVICE_TYPE_GLOWY9000)))
+	_, err = svc.Create(ctx, &CreateUserRequest{
+		User: &User{UserName: "a8m", CrmId: crmID, Status: User_STATUS_ACTIVE, OmitPrefix: User_FOO},
+	})
+	require.Equal(t, "user.device_type", violation(err))
+	require.Equal(t, user.DeviceTypeSPEEDY300, client.User.GetX(ctx, created.ID).DeviceType)
+}
+
+func TestUserService_Cache(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	created := client.User.Create().
+		SetUserName("rotemtam").
+		SetJoined(time.Now()).
+		SetPoints(10).
+		SetExp(1000).
+		SetStatus(user.StatusActive).
+		SetExternalID(1).
+		SetCrmID(uuid.New()).
+		SetCustomPb(1).
+		SetOmitPrefix(user.OmitPrefixFoo).
+		SetBigInt(schema.NewBigInt(1)).
+		SaveX(ctx)
+	crmID, err := created.CrmID.MarshalBinary()
+	require.NoError(t, err)
+	svc := NewUserService(client, runtime.WithCache(runtime.NewMemoryCache(), time.Minute))
+	get, err := svc.Get(ctx, &GetUserRequest{Id: created.ID})
+	require.NoError(t, err)
+	require.EqualValues(t, 10, get.Points)
+
+	// Entities mutated outside of the service are served from the cache until they expire.
+	client.User.UpdateOneID(created.ID).SetPoints(20).ExecX(ctx)
+	get, err = svc.Get(ctx, &GetUserRequest{Id: created.ID})
+	require.NoError(t, err)
+	require.EqualValues(t, 10, get.Points)
+
+	// Requests with read masks bypass the cache.
+	get, err = svc.Get(ctx, &GetUserRequest{Id: created.ID, ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"points"}}})
+	require.NoError(t, err)
+	require.EqualValues(t, 20, get.Points)
+
+	// Updates invalidate the cached entities.
+	_, err = svc.Update(ctx, &UpdateUserRequest{User: &User{
+		Id:         created.ID,
+		UserName:   "rotemtam",
+		Exp:        1000,
+		Points:     30,
+		ExternalId: 1,
+		CrmId:      crmID,
+		CustomPb:   1,
+		OmitPrefix: User_FOO,
+		Status:     User_STATUS_ACTIVE,
+	}})
+	require.NoError(t, err)
+	get, err = svc.Get(ctx, &GetUserRequest{Id: created.ID})
+	require.NoError(t, err)
+	require.EqualValues(t, 30, get.Points)
+
+	// Imports invalidate the cached entities.
+	client.User.DeleteOneID(created.ID).ExecX(ctx)
+	get.Points = 40
+	record, err := protojson.Marshal(get)
+	require.NoError(t, err)
+	imp := &importStream{ctx: ctx, requests: []*ImportUserRequest{{
+		Format: ImportUserRequest_NDJSON,
+		Data:   append(record, '\n'),
+	}}}
+	require.NoError(t, svc.Import(imp))
+	require.EqualValues(t, 1, imp.response.GetCount())
+	get, err = svc.Get(ctx, &GetUserRequest{Id: created.ID})
+	require.NoError(t, err)
+	require.EqualValues(t, 40, get.Points)
+
+	// Deletes invalidate the cached entities.
+	_, err = svc.Delete(ctx, &DeleteUserRequest{Id: created.ID})
+	require.NoError(t, err)
+	_, err = svc.Get(ctx, &GetUserRequest{Id: created.ID})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Deletes by a filter invalidate the cached entities matching it.
+	var ids []uint32
+	for i := 2; i < 4; i++ {
+		u := client.User.Create().
+			SetUserName(fmt.Sprintf("User%d", i)).
+			SetJoined(time.Now()).
+			SetPoints(10).
+			SetExp(1000).
+			SetStatus(user.StatusActive).
+			SetExternalID(i).
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SetOmitPrefix(user.OmitPrefixFoo).
+			SaveX(ctx)
+		_, err = svc.Get(ctx, &GetUserRequest{Id: u.ID})
+		require.NoError(t, err)
+		ids = append(ids, u.ID)
+	}
+	deleted, err := svc.DeleteUsers(ctx, &DeleteUsersRequest{Filter: &UserFilter{ExternalId: &UserFilter_Int64Filter{Eq: wrapperspb.Int64(2)}}})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, deleted.GetDeleted())
+	_, err = svc.Get(ctx, &GetUserRequest{Id: ids[0]})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.BatchDelete(ctx, &BatchDeleteUsersRequest{Filter: &UserFilter{ExternalId: &UserFilter_Int64Filter{Eq: wrapperspb.Int64(3)}}})
+	require.NoError(t, err)
+	_, err = svc.Get(ctx, &GetUserRequest{Id: ids[1]})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestUserService_Redact(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	created := client.User.Create().
+		SetUserName("rotemtam").
+		SetJoined(time.Now()).
+		SetPoints(10).
+		SetExp(1000).
+		SetStatus(user.StatusActive).
+		SetExternalID(1).
+		SetCrmID(uuid.New()).
+		SetCustomPb(1).
+		SetOptNum(42).
+		SetOmitPrefix(user.OmitPrefixFoo).
+		SaveX(ctx)
+	svc := NewUserService(client,
+		runtime.WithCache(runtime.NewMemoryCache(), time.Minute),
+		runtime.WithRoles(func(_ context.Context, md metadata.MD) []string {
+			return md.Get("x-role")
+		}),
+	)
+	admin := metadata.NewIncomingContext(ctx, metadata.Pairs("x-role", "admin"))
+
+	// Callers without the role are served the entities without the redacted fields.
+	get, err := svc.Get(ctx, &GetUserRequest{Id: created.ID})
+	require.NoError(t, err)
+	require.Nil(t, get.OptNum)
+	require.EqualValues(t, 10, get.Points)
+
+	// Entities served from the cache are redacted per caller.
+	get, err = svc.Get(admin, &GetUserRequest{Id: created.ID})
+	require.NoError(t, err)
+	require.EqualValues(t, 42, get.OptNum.GetValue())
+	get, err = svc.Get(ctx, &GetUserRequest{Id: created.ID})
+	require.NoError(t, err)
+	require.Nil(t, get.OptNum)
+
+	list, err := svc.List(ctx, &ListUserRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.UserList, 1)
+	require.Nil(t, list.UserList[0].OptNum)
+	list, err = svc.List(admin, &ListUserRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 42, list.UserList[0].OptNum.GetValue())
+
+	stream := &streamUsersStream{ctx: ctx}
+	require.NoError(t, svc.StreamUsers(&StreamUsersRequest{}, stream))
+	require.Len(t, stream.users, 1)
+	require.Nil(t, stream.users[0].OptNum)
+	stream = &streamUsersStream{ctx: admin}
+	require.NoError(t, svc.StreamUsers(&StreamUsersRequest{}, stream))
+	require.EqualValues(t, 42, stream.users[0].OptNum.GetValue())
+
+	// The exported records are redacted as well.
+	for _, c := range []struct {
+		ctx  context.Context
+		want bool
+	}{{ctx, false}, {admin, true}} {
+		export := &exportStream{ctx: c.ctx}
+		require.NoError(t, svc.Export(&ExportUserRequest{}, export))
+		require.Len(t, export.responses, 1)
+		r := &runtime.RecordReader{Format: runtime.FormatProtoDelimited}
+		r.Write(export.responses[0].GetData())
+		r.Flush()
+		record := &User{}
+		ok, err := r.Next(record)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.EqualValues(t, 10, record.Points)
+		require.Equal(t, c.want, record.OptNum != nil)
+	}
+
+	// Callers without the role cannot filter, sort or aggregate on the redacted fields, which would disclose them.
+	filter := &UserFilter{OptNum: &UserFilter_Int64Filter{Eq: wrapperspb.Int64(42)}}
+	_, err = svc.List(ctx, &ListUserRequest{Filter: filter})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = svc.List(ctx, &ListUserRequest{OrderBy: "points, opt_num desc"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = svc.Count(ctx, &CountUsersRequest{Filter: filter})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = svc.StreamUsers(&StreamUsersRequest{Filter: filter}, &streamUsersStream{ctx: ctx})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = svc.AggregateUser(ctx, &AggregateUserRequest{Field: "opt_num"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = svc.AggregateUser(ctx, &AggregateUserRequest{GroupBy: "opt_num"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	list, err = svc.List(ctx, &ListUserRequest{OrderBy: "points"})
+	require.NoError(t, err)
+	require.Len(t, list.UserList, 1)
+	list, err = svc.List(admin, &ListUserRequest{Filter: filter, OrderBy: "opt_num desc"})
+	require.NoError(t, err)
+	require.Len(t, list.UserList, 1)
+	count, err := svc.Count(admin, &CountUsersRequest{Filter: filter})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, count.Count)
+
+	// Services without a roles function redact the fields for all the callers.
+	get, err = NewUserService(client).Get(admin, &GetUserRequest{Id: created.ID})
+	require.NoError(t, err)
+	require.Nil(t, get.OptNum)
 }
 
 func TestUserService_List(t *testing.T) {
@@ -299,52 +672,1155 @@ func TestUserService_List(t *testing.T) {
 	require.EqualValues(t, respStatus.Code(), codes.InvalidArgument)
 }
 
-func TestUserService_BatchCreate(t *testing.T) {
+func TestUserService_ListPaginationKey(t *testing.T) {
 	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
 	defer client.Close()
 	svc := NewUserService(client)
 	ctx := context.Background()
 
-	// Create requests
-	var requests []*CreateUserRequest
-	for i := 0; i < (entproto.MaxBatchCreateSize*2)+5; i++ {
-		crmid, _ := uuid.New().MarshalBinary()
-		request := &CreateUserRequest{
-			User: &User{
-				UserName:   fmt.Sprintf("User%d", i),
-				ExternalId: int64(i),
-				Joined:     timestamppb.Now(),
-				Exp:        1000,
-				Points:     10,
-				CrmId:      crmid,
-				CustomPb:   1,
-				Labels:     nil,
-				Status:     User_STATUS_ACTIVE,
-				OmitPrefix: User_BAR,
-			},
+	// The service is paged by the created_at field, and the ID of entities with equal values.
+	base := time.Now().UTC()
+	create := func(i, minutes int) {
+		client.User.Create().
+			SetUserName(fmt.Sprintf("User%d", i)).
+			SetExternalID(i).
+			SetJoined(time.Now()).
+			SetExp(1000).
+			SetPoints(10).
+			SetStatus("pending").
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SetOmitPrefix(user.OmitPrefixBar).
+			SetCreatedAt(base.Add(time.Duration(minutes) * time.Minute)).
+			SaveX(ctx)
+	}
+	for i, minutes := range []int{3, 1, 4, 1, 5} {
+		create(i, minutes)
+	}
+	names := func(list []*User) []string {
+		var out []string
+		for _, u := range list {
+			out = append(out, u.UserName)
 		}
-		requests = append(requests, request)
+		return out
 	}
+	resp, err := svc.List(ctx, &ListUserRequest{PageSize: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"User4", "User2"}, names(resp.UserList))
 
-	// Valid request
-	resp, err := svc.BatchCreate(ctx, &BatchCreateUsersRequest{
-		Requests: requests[:entproto.MaxBatchCreateSize],
-	})
+	// Entities created after the first page do not shift the next pages.
+	create(5, 6)
+	resp, err = svc.List(ctx, &ListUserRequest{PageSize: 2, PageToken: resp.NextPageToken})
 	require.NoError(t, err)
-	// Check number of entities returned. Should be max batch create size
-	require.EqualValues(t, entproto.MaxBatchCreateSize, len(resp.Users))
-	// Check unique values of returned entities
-	for i, entry := range resp.Users {
-		require.EqualValues(t, fmt.Sprintf("User%d", i), entry.UserName)
-		require.EqualValues(t, i, entry.ExternalId)
+	require.Equal(t, []string{"User0", "User3"}, names(resp.UserList))
+	resp, err = svc.List(ctx, &ListUserRequest{PageSize: 2, PageToken: resp.NextPageToken})
+	require.NoError(t, err)
+	require.Equal(t, []string{"User1"}, names(resp.UserList))
+	require.Empty(t, resp.NextPageToken)
+
+	_, err = svc.List(ctx, &ListUserRequest{PageToken: "INVALID PAGE TOKEN"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUserService_PageTokenKey(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client, runtime.WithPageTokenKey([]byte("secret")))
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		client.User.Create().
+			SetUserName(fmt.Sprintf("User%d", i)).
+			SetExternalID(i).
+			SetJoined(time.Now()).
+			SetExp(1000).
+			SetPoints(10).
+			SetStatus("pending").
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SetOmitPrefix(user.OmitPrefixBar).
+			SaveX(ctx)
 	}
+	for _, orderBy := range []string{"", "user_name"} {
+		resp, err := svc.List(ctx, &ListUserRequest{PageSize: 2, OrderBy: orderBy})
+		require.NoError(t, err)
+		token := resp.NextPageToken
+		resp, err = svc.List(ctx, &ListUserRequest{PageSize: 2, OrderBy: orderBy, PageToken: token})
+		require.NoError(t, err)
+		require.Len(t, resp.UserList, 1)
 
-	// Invalid batch size
-	resp, err = svc.BatchCreate(ctx, &BatchCreateUsersRequest{
-		Requests: requests,
+		// Tampered tokens, unsigned ones and the ones signed with another key are rejected.
+		unsigned, err := NewUserService(client).List(ctx, &ListUserRequest{PageSize: 2, OrderBy: orderBy})
+		require.NoError(t, err)
+		other, err := NewUserService(client, runtime.WithPageTokenKey([]byte("other"))).List(ctx, &ListUserRequest{PageSize: 2, OrderBy: orderBy})
+		require.NoError(t, err)
+		for _, invalid := range []string{"x" + token, token[:len(token)-1], unsigned.NextPageToken, other.NextPageToken} {
+			_, err = svc.List(ctx, &ListUserRequest{PageSize: 2, OrderBy: orderBy, PageToken: invalid})
+			require.Equal(t, codes.InvalidArgument, status.Code(err), invalid)
+		}
+	}
+}
+
+func TestUserService_ReadMask(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		client.User.Create().
+			SetUserName(fmt.Sprintf("User%d", i)).
+			SetExternalID(i).
+			SetJoined(time.Now()).
+			SetExp(1000).
+			SetPoints(10).
+			SetStatus("pending").
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SetOmitPrefix(user.OmitPrefixBar).
+			SaveX(ctx)
+	}
+	created := client.User.Query().Order(ent.Asc(user.FieldID)).FirstX(ctx)
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"user_name", "pet"}}
+
+	get, err := svc.Get(ctx, &GetUserRequest{Id: created.ID, ReadMask: mask})
+	require.NoError(t, err)
+	require.EqualValues(t, created.ID, get.GetId())
+	require.EqualValues(t, created.UserName, get.GetUserName())
+	require.Zero(t, get.GetExternalId())
+	require.Zero(t, get.GetPoints())
+
+	get, err = svc.Get(ctx, &GetUserRequest{Id: created.ID})
+	require.NoError(t, err)
+	require.EqualValues(t, created.ExternalID, get.GetExternalId())
+
+	// Masked pages are still tokenized by the pagination key.
+	list, err := svc.List(ctx, &ListUserRequest{PageSize: 2, ReadMask: mask})
+	require.NoError(t, err)
+	require.Len(t, list.GetUserList(), 2)
+	for _, u := range list.GetUserList() {
+		require.NotEmpty(t, u.GetUserName())
+		require.Zero(t, u.GetPoints())
+	}
+	list, err = svc.List(ctx, &ListUserRequest{PageSize: 2, PageToken: list.GetNextPageToken(), ReadMask: mask})
+	require.NoError(t, err)
+	require.Len(t, list.GetUserList(), 1)
+
+	_, err = svc.Get(ctx, &GetUserRequest{Id: created.ID, ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"unknown"}}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = svc.List(ctx, &ListUserRequest{ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"unknown"}}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUserService_UpdateMask(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+
+	created := client.User.Create().
+		SetUserName("masked").
+		SetExternalID(1).
+		SetJoined(time.Now()).
+		SetExp(1000).
+		SetPoints(10).
+		SetStatus("pending").
+		SetCrmID(uuid.New()).
+		SetCustomPb(1).
+		SetOptStr("optional").
+		SetOmitPrefix(user.OmitPrefixBar).
+		SaveX(ctx)
+
+	// Only the masked fields are updated, and the unset optional ones are cleared.
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"user_name", "opt_str"}}
+	updated, err := svc.Update(ctx, &UpdateUserRequest{
+		User:       &User{Id: created.ID, UserName: "renamed", Points: 99},
+		UpdateMask: mask,
 	})
-	require.Nil(t, resp)
-	respStatus, ok := status.FromError(err)
-	require.True(t, ok, "expected a gRPC status error")
-	require.EqualValues(t, respStatus.Code(), codes.InvalidArgument)
+	require.NoError(t, err)
+	require.EqualValues(t, "renamed", updated.GetUserName())
+	require.Empty(t, updated.GetOptStr().GetValue())
+	afterUpd := client.User.GetX(ctx, created.ID)
+	require.EqualValues(t, "renamed", afterUpd.UserName)
+	require.EqualValues(t, 10, afterUpd.Points)
+	require.EqualValues(t, 1, afterUpd.ExternalID)
+	require.EqualValues(t, created.CrmID, afterUpd.CrmID)
+	require.Empty(t, afterUpd.OptStr)
+
+	_, err = svc.BatchUpdate(ctx, &BatchUpdateUsersRequest{
+		Requests: []*UpdateUserRequest{
+			{
+				User:       &User{Id: created.ID, Points: 20},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"points"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	afterUpd = client.User.GetX(ctx, created.ID)
+	require.EqualValues(t, 20, afterUpd.Points)
+	require.EqualValues(t, "renamed", afterUpd.UserName)
+
+	for _, path := range []string{"unknown", "id", "joined", "created_at"} {
+		_, err = svc.Update(ctx, &UpdateUserRequest{
+			User:       &User{Id: created.ID},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{path}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), path)
+	}
+}
+
+func TestUserService_IDOnlyEdge(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+	var friends []uint32
+	for i := 0; i < 2; i++ {
+		friend := client.User.Create().
+			SetUserName(fmt.Sprintf("friend%d", i)).
+			SetExternalID(i + 1).
+			SetJoined(time.Now()).
+			SetExp(1000).
+			SetPoints(10).
+			SetStatus("pending").
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SetOmitPrefix(user.OmitPrefixBar).
+			SaveX(ctx)
+		friends = append(friends, friend.ID)
+	}
+	crmID, err := uuid.New().MarshalBinary()
+	require.NoError(t, err)
+	created, err := svc.Create(ctx, &CreateUserRequest{
+		User: &User{
+			UserName:   "rotemtam",
+			Joined:     timestamppb.Now(),
+			Exp:        100,
+			Points:     1000,
+			Status:     User_STATUS_ACTIVE,
+			ExternalId: 100,
+			CrmId:      crmID,
+			OmitPrefix: User_BAR,
+			FriendIds:  friends,
+		},
+	})
+	require.NoError(t, err)
+
+	get, err := svc.Get(ctx, &GetUserRequest{Id: created.GetId(), View: GetUserRequest_WITH_EDGE_IDS})
+	require.NoError(t, err)
+	require.ElementsMatch(t, friends, get.GetFriendIds())
+
+	// The friends edge is bidirectional, and lists the created user as a friend of its friends.
+	get, err = svc.Get(ctx, &GetUserRequest{Id: friends[0], View: GetUserRequest_WITH_EDGE_IDS})
+	require.NoError(t, err)
+	require.Equal(t, []uint32{created.GetId()}, get.GetFriendIds())
+
+	_, err = svc.Create(ctx, &CreateUserRequest{
+		User: &User{
+			UserName:   "a8m",
+			Joined:     timestamppb.Now(),
+			Status:     User_STATUS_ACTIVE,
+			ExternalId: 101,
+			CrmId:      crmID,
+			OmitPrefix: User_BAR,
+			FriendIds:  []uint32{1000},
+		},
+	})
+	require.Error(t, err)
+}
+
+func TestUser_FieldNumbers(t *testing.T) {
+	fields := (&User{}).ProtoReflect().Descriptor().Fields()
+	userName := fields.ByNumber(entpbfields.User_UserName_FieldNumber)
+	require.NotNil(t, userName)
+	require.Equal(t, entpbfields.User_UserName_FieldName, userName.Name())
+	require.Equal(t, entpbfields.User_FriendIds_FieldNumber, fields.ByName(entpbfields.User_FriendIds_FieldName).Number())
+
+	mask, err := fieldmaskpb.New(&User{}, string(entpbfields.User_UserName_FieldName), string(entpbfields.User_Joined_FieldName))
+	require.NoError(t, err)
+	require.Equal(t, []string{"user_name", "joined"}, mask.GetPaths())
+}
+
+func TestUserService_ListOrderBy(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+
+	// Points are repeated, so entities with the same points are ordered by ID.
+	for i := 0; i < 5; i++ {
+		client.User.Create().
+			SetUserName(fmt.Sprintf("User%d", i)).
+			SetExternalID(i).
+			SetJoined(time.Now()).
+			SetExp(1000).
+			SetPoints(uint(i % 2)).
+			SetStatus("pending").
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SetOmitPrefix(user.OmitPrefixBar).
+			SaveX(ctx)
+	}
+	var names []string
+	req := &ListUserRequest{PageSize: 2, OrderBy: "points desc, user_name"}
+	for {
+		resp, err := svc.List(ctx, req)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(resp.UserList), 2)
+		for _, u := range resp.UserList {
+			names = append(names, u.UserName)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	require.Equal(t, []string{"User1", "User3", "User0", "User2", "User4"}, names)
+
+	// Ascending by default.
+	resp, err := svc.List(ctx, &ListUserRequest{OrderBy: "external_id"})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, resp.UserList[0].ExternalId)
+	require.EqualValues(t, 4, resp.UserList[4].ExternalId)
+
+	for _, orderBy := range []string{"labels", "unknown", "points sideways", "points,", "points, points desc"} {
+		resp, err = svc.List(ctx, &ListUserRequest{OrderBy: orderBy})
+		require.Nil(t, resp)
+		require.Equal(t, codes.InvalidArgument, status.Code(err), orderBy)
+	}
+	// Ordered pages reject tokens of other pages.
+	resp, err = svc.List(ctx, &ListUserRequest{OrderBy: "points", PageToken: "INVALID PAGE TOKEN"})
+	require.Nil(t, resp)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUserService_ListFilter(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+
+	joined := time.Unix(1000, 0)
+	for i := 0; i < 5; i++ {
+		client.User.Create().
+			SetUserName(fmt.Sprintf("User%d", i)).
+			SetExternalID(i).
+			SetJoined(joined.Add(time.Duration(i) * time.Hour)).
+			SetExp(1000).
+			SetPoints(uint(i)).
+			SetBanned(i%2 == 0).
+			SetStatus("pending").
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SetOmitPrefix(user.OmitPrefixBar).
+			SaveX(ctx)
+	}
+	names := func(f *UserFilter) []string {
+		resp, err := svc.List(ctx, &ListUserRequest{OrderBy: "external_id", Filter: f})
+		require.NoError(t, err)
+		var names []string
+		for _, u := range resp.UserList {
+			names = append(names, u.UserName)
+		}
+		return names
+	}
+	require.Len(t, names(nil), 5)
+	require.Equal(t, []string{"User2"}, names(&UserFilter{
+		UserName: &UserFilter_StringFilter{Eq: wrapperspb.String("User2")},
+	}))
+	require.Equal(t, []string{"User1", "User3"}, names(&UserFilter{
+		Banned: &UserFilter_BoolFilter{Eq: wrapperspb.Bool(false)},
+	}))
+	require.Equal(t, []string{"User2", "User3"}, names(&UserFilter{
+		Points:     &UserFilter_UInt32Filter{Gt: wrapperspb.UInt32(1)},
+		ExternalId: &UserFilter_Int64Filter{Lt: wrapperspb.Int64(4)},
+	}))
+	require.Equal(t, []string{"User1", "User2", "User3"}, names(&UserFilter{
+		Points: &UserFilter_UInt32Filter{Gte: wrapperspb.UInt32(1), Lte: wrapperspb.UInt32(3)},
+	}))
+	require.Equal(t, []string{"User1", "User2"}, names(&UserFilter{
+		Joined: &UserFilter_TimestampFilter{Gte: timestamppb.New(joined.Add(time.Hour)), Lte: timestamppb.New(joined.Add(2 * time.Hour))},
+	}))
+	require.Equal(t, []string{"User0", "User4"}, names(&UserFilter{
+		ExternalId: &UserFilter_Int64Filter{In: []int64{0, 4, 10}},
+	}))
+	require.Equal(t, []string{"User0", "User1"}, names(&UserFilter{
+		Joined: &UserFilter_TimestampFilter{Lt: timestamppb.New(joined.Add(90 * time.Minute))},
+	}))
+	require.Equal(t, []string{"User0", "User1", "User2", "User4"}, names(&UserFilter{
+		UserName: &UserFilter_StringFilter{Contains: wrapperspb.String("User"), Neq: wrapperspb.String("User3")},
+	}))
+	require.Empty(t, names(&UserFilter{
+		UserName: &UserFilter_StringFilter{Eq: wrapperspb.String("User1")},
+		Banned:   &UserFilter_BoolFilter{Eq: wrapperspb.Bool(true)},
+	}))
+}
+
+func TestUserService_BatchCreate(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+
+	// Create requests
+	var requests []*CreateUserRequest
+	for i := 0; i < (entproto.MaxBatchCreateSize*2)+5; i++ {
+		crmid, _ := uuid.New().MarshalBinary()
+		request := &CreateUserRequest{
+			User: &User{
+				UserName:   fmt.Sprintf("User%d", i),
+				ExternalId: int64(i),
+				Joined:     timestamppb.Now(),
+				Exp:        1000,
+				Points:     10,
+				CrmId:      crmid,
+				CustomPb:   1,
+				Labels:     nil,
+				Status:     User_STATUS_ACTIVE,
+				OmitPrefix: User_BAR,
+			},
+		}
+		requests = append(requests, request)
+	}
+
+	// Valid request
+	resp, err := svc.BatchCreate(ctx, &BatchCreateUsersRequest{
+		Requests: requests[:entproto.MaxBatchCreateSize],
+	})
+	require.NoError(t, err)
+	// Check number of entities returned. Should be max batch create size
+	require.EqualValues(t, entproto.MaxBatchCreateSize, len(resp.Users))
+	// Check unique values of returned entities
+	for i, entry := range resp.Users {
+		require.EqualValues(t, fmt.Sprintf("User%d", i), entry.UserName)
+		require.EqualValues(t, i, entry.ExternalId)
+	}
+
+	// Invalid batch size
+	resp, err = svc.BatchCreate(ctx, &BatchCreateUsersRequest{
+		Requests: requests,
+	})
+	require.Nil(t, resp)
+	respStatus, ok := status.FromError(err)
+	require.True(t, ok, "expected a gRPC status error")
+	require.EqualValues(t, respStatus.Code(), codes.InvalidArgument)
+}
+
+func TestUserService_Exists(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+
+	created := client.User.Create().
+		SetUserName("rotemtam").
+		SetExternalID(1).
+		SetJoined(time.Now()).
+		SetExp(1000).
+		SetPoints(10).
+		SetStatus("pending").
+		SetCrmID(uuid.New()).
+		SetCustomPb(1).
+		SetOmitPrefix(user.OmitPrefixBar).
+		SaveX(ctx)
+	for _, tt := range []struct {
+		req    *ExistsUserRequest
+		exists bool
+	}{
+		{&ExistsUserRequest{Key: &ExistsUserRequest_Id{Id: created.ID}}, true},
+		{&ExistsUserRequest{Key: &ExistsUserRequest_Id{Id: created.ID + 1}}, false},
+		{&ExistsUserRequest{Key: &ExistsUserRequest_UserName{UserName: "rotemtam"}}, true},
+		{&ExistsUserRequest{Key: &ExistsUserRequest_UserName{UserName: "a8m"}}, false},
+		{&ExistsUserRequest{Key: &ExistsUserRequest_ExternalId{ExternalId: 1}}, true},
+	} {
+		resp, err := svc.Exists(ctx, tt.req)
+		require.NoError(t, err)
+		require.Equal(t, tt.exists, resp.Exists, tt.req.String())
+	}
+	_, err := svc.Exists(ctx, &ExistsUserRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUserService_GetByUnique(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+
+	created := client.User.Create().
+		SetUserName("rotemtam").
+		SetExternalID(1).
+		SetJoined(time.Now()).
+		SetExp(1000).
+		SetPoints(10).
+		SetStatus("pending").
+		SetCrmID(uuid.New()).
+		SetCustomPb(1).
+		SetOmitPrefix(user.OmitPrefixBar).
+		SaveX(ctx)
+
+	got, err := svc.GetUserByUserName(ctx, &GetUserByUserNameRequest{UserName: "rotemtam"})
+	require.NoError(t, err)
+	require.EqualValues(t, created.ID, got.Id)
+	require.EqualValues(t, "rotemtam", got.UserName)
+
+	got, err = svc.GetUserByExternalID(ctx, &GetUserByExternalIDRequest{ExternalId: 1})
+	require.NoError(t, err)
+	require.EqualValues(t, created.ID, got.Id)
+
+	_, err = svc.GetUserByUserName(ctx, &GetUserByUserNameRequest{UserName: "a8m"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestUserService_Count(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		client.User.Create().
+			SetUserName(fmt.Sprintf("User%d", i)).
+			SetExternalID(i).
+			SetJoined(time.Now()).
+			SetExp(1000).
+			SetPoints(uint(i)).
+			SetStatus("pending").
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SetOmitPrefix(user.OmitPrefixBar).
+			SaveX(ctx)
+	}
+	resp, err := svc.Count(ctx, &CountUsersRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 5, resp.Count)
+	resp, err = svc.Count(ctx, &CountUsersRequest{
+		Filter: &UserFilter{Points: &UserFilter_UInt32Filter{Gt: wrapperspb.UInt32(2)}},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, resp.Count)
+}
+
+func TestUserService_Upsert(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+
+	crmID, err := uuid.New().MarshalBinary()
+	require.NoError(t, err)
+	joined := time.Unix(1000, 0)
+	input := &User{
+		UserName:   "rotemtam",
+		ExternalId: 1,
+		Joined:     timestamppb.New(joined),
+		Exp:        1000,
+		Points:     10,
+		Status:     User_STATUS_ACTIVE,
+		CrmId:      crmID,
+		CustomPb:   1,
+		OmitPrefix: User_BAR,
+	}
+	created, err := svc.Upsert(ctx, &UpsertUserRequest{User: input})
+	require.NoError(t, err)
+	require.EqualValues(t, 1000, created.Exp)
+
+	// Upserting an entity with the same key updates it, except for its immutable fields.
+	input.Exp = 2000
+	input.Joined = timestamppb.New(joined.Add(time.Hour))
+	updated, err := svc.Upsert(ctx, &UpsertUserRequest{User: input})
+	require.NoError(t, err)
+	require.Equal(t, created.Id, updated.Id)
+	require.EqualValues(t, 2000, updated.Exp)
+	require.Equal(t, joined.Unix(), updated.Joined.AsTime().Unix())
+	require.Equal(t, 1, client.User.Query().CountX(ctx))
+
+	// Conflicts on other unique fields are reported.
+	other := client.User.Create().
+		SetUserName("a8m").
+		SetExternalID(2).
+		SetJoined(time.Now()).
+		SetExp(1000).
+		SetPoints(10).
+		SetStatus("pending").
+		SetCrmID(uuid.New()).
+		SetCustomPb(1).
+		SetOmitPrefix(user.OmitPrefixBar).
+		SaveX(ctx)
+	input.ExternalId = int64(other.ExternalID)
+	_, err = svc.Upsert(ctx, &UpsertUserRequest{User: input})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	details := status.Convert(err).Details()
+	require.Len(t, details, 1)
+	info, ok := details[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	require.Equal(t, runtime.ReasonUniqueConstraint, info.GetReason())
+	require.Equal(t, "user.external_id", info.GetMetadata()["field"])
+}
+
+func TestUserService_BatchGet(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+
+	var ids []uint32
+	for i := 0; i < 3; i++ {
+		u := client.User.Create().
+			SetUserName(fmt.Sprintf("User%d", i)).
+			SetExternalID(i).
+			SetJoined(time.Now()).
+			SetExp(1000).
+			SetPoints(10).
+			SetStatus("pending").
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SetOmitPrefix(user.OmitPrefixBar).
+			SaveX(ctx)
+		ids = append(ids, u.ID)
+	}
+
+	// Entities are returned in the order of the request.
+	resp, err := svc.BatchGet(ctx, &BatchGetUsersRequest{Ids: []uint32{ids[2], 1000, ids[0]}})
+	require.NoError(t, err)
+	require.Len(t, resp.Users, 2)
+	require.Equal(t, "User2", resp.Users[0].UserName)
+	require.Equal(t, "User0", resp.Users[1].UserName)
+	require.Equal(t, []uint32{1000}, resp.MissingIds)
+
+	resp, err = svc.BatchGet(ctx, &BatchGetUsersRequest{Ids: ids, View: BatchGetUsersRequest_WITH_EDGE_IDS})
+	require.NoError(t, err)
+	require.Len(t, resp.Users, 3)
+	require.Empty(t, resp.MissingIds)
+
+	resp, err = svc.BatchGet(ctx, &BatchGetUsersRequest{Ids: ids, View: BatchGetUsersRequest_View(42)})
+	require.Nil(t, resp)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	resp, err = svc.BatchGet(ctx, &BatchGetUsersRequest{
+		Ids: make([]uint32, entproto.MaxBatchGetSize+1),
+	})
+	require.Nil(t, resp)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUserService_BatchUpdate(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+
+	var requests []*UpdateUserRequest
+	for i := 0; i < 3; i++ {
+		created := client.User.Create().
+			SetUserName(fmt.Sprintf("User%d", i)).
+			SetExternalID(i).
+			SetJoined(time.Now()).
+			SetExp(1000).
+			SetPoints(10).
+			SetStatus("pending").
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SetOmitPrefix(user.OmitPrefixBar).
+			SaveX(ctx)
+		crmID, err := created.CrmID.MarshalBinary()
+		require.NoError(t, err)
+		requests = append(requests, &UpdateUserRequest{
+			User: &User{
+				Id:         created.ID,
+				UserName:   created.UserName,
+				ExternalId: int64(i),
+				Joined:     timestamppb.New(created.Joined),
+				Exp:        uint64(2000 + i),
+				Points:     10,
+				Status:     User_STATUS_ACTIVE,
+				CrmId:      crmID,
+				CustomPb:   1,
+				OmitPrefix: User_BAR,
+			},
+		})
+	}
+
+	resp, err := svc.BatchUpdate(ctx, &BatchUpdateUsersRequest{Requests: requests})
+	require.NoError(t, err)
+	require.Len(t, resp.Users, 3)
+	for i, u := range resp.Users {
+		require.EqualValues(t, 2000+i, u.Exp)
+		require.EqualValues(t, 2000+i, client.User.GetX(ctx, u.Id).Exp)
+	}
+
+	// A failing request rolls back the whole batch.
+	for _, r := range requests {
+		r.User.Exp = 3000
+	}
+	requests[2].User.Joined = timestamppb.New(requests[2].User.Joined.AsTime().Add(time.Hour))
+	resp, err = svc.BatchUpdate(ctx, &BatchUpdateUsersRequest{Requests: requests})
+	require.Nil(t, resp)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	for i, r := range requests {
+		require.EqualValues(t, 2000+i, client.User.GetX(ctx, r.User.Id).Exp)
+	}
+
+	// Invalid batch size
+	resp, err = svc.BatchUpdate(ctx, &BatchUpdateUsersRequest{
+		Requests: make([]*UpdateUserRequest, entproto.MaxBatchUpdateSize+1),
+	})
+	require.Nil(t, resp)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUserService_BatchDelete(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+
+	var ids []uint32
+	for i := 0; i < 5; i++ {
+		u := client.User.Create().
+			SetUserName(fmt.Sprintf("User%d", i)).
+			SetExternalID(i).
+			SetJoined(time.Now()).
+			SetExp(1000).
+			SetPoints(uint(i)).
+			SetStatus("pending").
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SetOmitPrefix(user.OmitPrefixBar).
+			SaveX(ctx)
+		ids = append(ids, u.ID)
+	}
+
+	_, err := svc.BatchDelete(ctx, &BatchDeleteUsersRequest{Ids: ids[:2]})
+	require.NoError(t, err)
+	require.Equal(t, 3, client.User.Query().CountX(ctx))
+
+	// IDs and filter are combined.
+	_, err = svc.BatchDelete(ctx, &BatchDeleteUsersRequest{
+		Ids:    ids[2:4],
+		Filter: &UserFilter{Points: &UserFilter_UInt32Filter{Eq: wrapperspb.UInt32(3)}},
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uint32{ids[2], ids[4]}, client.User.Query().IDsX(ctx))
+
+	_, err = svc.BatchDelete(ctx, &BatchDeleteUsersRequest{
+		Filter: &UserFilter{UserName: &UserFilter_StringFilter{Contains: wrapperspb.String("User")}},
+	})
+	require.NoError(t, err)
+	require.Zero(t, client.User.Query().CountX(ctx))
+
+	// Requests must hold IDs or a filter.
+	for _, req := range []*BatchDeleteUsersRequest{{}, {Filter: &UserFilter{}}} {
+		_, err = svc.BatchDelete(ctx, req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+	_, err = svc.BatchDelete(ctx, &BatchDeleteUsersRequest{
+		Ids: make([]uint32, entproto.MaxBatchDeleteSize+1),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUserService_Stats(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+
+	for i, status := range []user.Status{"pending", "active", "active"} {
+		_ = client.User.Create().
+			SetUserName(fmt.Sprintf("User%d", i)).
+			SetExternalID(i).
+			SetJoined(time.Now()).
+			SetExp(1000).
+			SetPoints(10).
+			SetStatus(status).
+			SetBanned(i == 0).
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SetLabels(nil).
+			SetOmitPrefix(user.OmitPrefixBar).
+			SaveX(ctx)
+	}
+	resp, err := svc.Stats(ctx, &StatsUserRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 3, resp.GetCount())
+	counts := make(map[string]map[string]int64)
+	for _, fs := range resp.GetFields() {
+		counts[fs.GetField()] = make(map[string]int64)
+		for _, vc := range fs.GetValues() {
+			counts[fs.GetField()][vc.GetValue()] = vc.GetCount()
+		}
+	}
+	require.Equal(t, map[string]int64{"pending": 1, "active": 2}, counts["status"])
+	require.Equal(t, map[string]int64{"true": 1, "false": 2}, counts["banned"])
+	require.Equal(t, map[string]int64{"": 3}, counts["opt_bool"])
+	require.Equal(t, map[string]int64{"bar": 3}, counts["omit_prefix"])
+}
+
+type exportStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*ExportUserResponse
+}
+
+func (s *exportStream) Context() context.Context { return s.ctx }
+
+func (s *exportStream) Send(resp *ExportUserResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+type importStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*ImportUserRequest
+	response *ImportUserResponse
+}
+
+func (s *importStream) Context() context.Context { return s.ctx }
+
+func (s *importStream) Recv() (*ImportUserRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *importStream) SendAndClose(resp *ImportUserResponse) error {
+	s.response = resp
+	return nil
+}
+
+func TestUserService_ExportImport(t *testing.T) {
+	for _, format := range []ExportUserRequest_Format{ExportUserRequest_PROTO_DELIMITED, ExportUserRequest_NDJSON} {
+		t.Run(format.String(), func(t *testing.T) {
+			ctx := context.Background()
+			src := enttest.Open(t, "sqlite3", "file:export?mode=memory&_fk=1")
+			defer src.Close()
+			for i := 0; i < 5; i++ {
+				_ = src.User.Create().
+					SetID(uint32(i + 1)).
+					SetUserName(fmt.Sprintf("User%d", i)).
+					SetExternalID(i).
+					SetJoined(time.Now()).
+					SetExp(1000).
+					SetPoints(10).
+					SetStatus("pending").
+					SetCrmID(uuid.New()).
+					SetCustomPb(1).
+					SetLabels(nil).
+					SetOmitPrefix(user.OmitPrefixBar).
+					SetBigInt(schema.NewBigInt(int64(i))).
+					SetBUser1(i).
+					SaveX(ctx)
+			}
+			export := &exportStream{ctx: ctx}
+			err := NewUserService(src).Export(&ExportUserRequest{Format: format, BatchSize: 2}, export)
+			require.NoError(t, err)
+			require.Len(t, export.responses, 3)
+
+			// Resume the export after the first batch.
+			resumed := &exportStream{ctx: ctx}
+			err = NewUserService(src).Export(&ExportUserRequest{
+				Format:    format,
+				BatchSize: 2,
+				Cursor:    export.responses[0].GetCursor(),
+			}, resumed)
+			require.NoError(t, err)
+			require.Len(t, resumed.responses, 2)
+			require.Equal(t, export.responses[2].GetCursor(), resumed.responses[1].GetCursor())
+
+			// Import the records in chunks that do not match the record boundaries.
+			var data []byte
+			for _, resp := range export.responses {
+				data = append(data, resp.GetData()...)
+			}
+			imp := &importStream{ctx: ctx}
+			records := data
+			for len(data) > 0 {
+				n := 7
+				if n > len(data) {
+					n = len(data)
+				}
+				imp.requests = append(imp.requests, &ImportUserRequest{
+					Format: ImportUserRequest_Format(format),
+					Data:   data[:n],
+				})
+				data = data[n:]
+			}
+			dst := enttest.Open(t, "sqlite3", "file:import?mode=memory&_fk=1")
+			defer dst.Close()
+			err = NewUserService(dst).Import(imp)
+			require.NoError(t, err)
+			require.EqualValues(t, 5, imp.response.GetCount())
+			require.Equal(t, export.responses[2].GetCursor(), imp.response.GetCursor())
+			imported := dst.User.Query().Order(ent.Asc(user.FieldID)).AllX(ctx)
+			require.Len(t, imported, 5)
+			for i, u := range imported {
+				require.EqualValues(t, i+1, u.ID)
+				require.Equal(t, fmt.Sprintf("User%d", i), u.UserName)
+			}
+
+			// Importing the same records again stops at the first one.
+			err = NewUserService(dst).Import(&importStream{ctx: ctx, requests: []*ImportUserRequest{
+				{Format: ImportUserRequest_Format(format), Data: records},
+			}})
+			require.Equal(t, codes.AlreadyExists, status.Code(err))
+			require.Contains(t, err.Error(), `import stopped after 0 records (cursor "")`)
+		})
+	}
+}
+
+type streamUsersStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	users []*User
+}
+
+func (s *streamUsersStream) Context() context.Context { return s.ctx }
+
+func (s *streamUsersStream) Send(u *User) error {
+	s.users = append(s.users, u)
+	return nil
+}
+
+func TestUserService_StreamUsers(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		client.User.Create().
+			SetUserName(fmt.Sprintf("User%d", i)).
+			SetExternalID(i).
+			SetJoined(time.Now()).
+			SetExp(1000).
+			SetPoints(uint(i)).
+			SetStatus("pending").
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SetOmitPrefix(user.OmitPrefixBar).
+			SaveX(ctx)
+	}
+	stream := &streamUsersStream{ctx: ctx}
+	err := svc.StreamUsers(&StreamUsersRequest{BatchSize: 2}, stream)
+	require.NoError(t, err)
+	require.Len(t, stream.users, 5)
+	for i, u := range stream.users {
+		require.Equal(t, fmt.Sprintf("User%d", i), u.UserName)
+	}
+
+	stream = &streamUsersStream{ctx: ctx}
+	err = svc.StreamUsers(&StreamUsersRequest{
+		Filter:    &UserFilter{Points: &UserFilter_UInt32Filter{Gt: wrapperspb.UInt32(1)}},
+		BatchSize: 2,
+	}, stream)
+	require.NoError(t, err)
+	require.Len(t, stream.users, 3)
+	require.Equal(t, "User2", stream.users[0].UserName)
+
+	err = svc.StreamUsers(&StreamUsersRequest{BatchSize: -1}, &streamUsersStream{ctx: ctx})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+type watchUserStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *UserEvent
+}
+
+func (s *watchUserStream) Context() context.Context { return s.ctx }
+
+func (s *watchUserStream) Send(e *UserEvent) error {
+	s.events <- e
+	return nil
+}
+
+func TestUserService_WatchUser(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	stream := &watchUserStream{ctx: ctx, events: make(chan *UserEvent, 10)}
+	done := make(chan error, 1)
+	go func() {
+		done <- svc.WatchUser(&WatchUserRequest{}, stream)
+	}()
+	require.Eventually(t, svc.feed.Watched, time.Second, time.Millisecond)
+
+	created := client.User.Create().
+		SetUserName("rotemtam").
+		SetExternalID(1).
+		SetJoined(time.Now()).
+		SetExp(1000).
+		SetPoints(10).
+		SetStatus("pending").
+		SetCrmID(uuid.New()).
+		SetCustomPb(1).
+		SetOmitPrefix(user.OmitPrefixBar).
+		SaveX(ctx)
+	e := <-stream.events
+	require.Equal(t, UserEvent_CREATED, e.Type)
+	require.Equal(t, created.ID, e.Id)
+	require.Equal(t, "rotemtam", e.User.UserName)
+
+	client.User.UpdateOne(created).SetPoints(11).ExecX(ctx)
+	e = <-stream.events
+	require.Equal(t, UserEvent_UPDATED, e.Type)
+	require.EqualValues(t, 11, e.User.Points)
+
+	client.User.Update().Where(user.ID(created.ID)).SetPoints(12).ExecX(ctx)
+	e = <-stream.events
+	require.Equal(t, UserEvent_UPDATED, e.Type)
+	require.EqualValues(t, 12, e.User.Points)
+
+	client.User.DeleteOne(created).ExecX(ctx)
+	e = <-stream.events
+	require.Equal(t, UserEvent_DELETED, e.Type)
+	require.Equal(t, created.ID, e.Id)
+	require.Nil(t, e.User)
+
+	cancel()
+	require.NoError(t, <-done)
+	require.False(t, svc.feed.Watched())
+}
+
+func TestUserService_WatchUserTx(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	// The services of a client share its feed and hook.
+	require.Same(t, svc.feed, NewUserService(client).feed)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	stream := &watchUserStream{ctx: ctx, events: make(chan *UserEvent, 10)}
+	done := make(chan error, 1)
+	go func() {
+		done <- svc.WatchUser(&WatchUserRequest{}, stream)
+	}()
+	require.Eventually(t, svc.feed.Watched, time.Second, time.Millisecond)
+
+	create := func(tx *ent.Tx, name string) *ent.User {
+		return tx.User.Create().
+			SetUserName(name).
+			SetExternalID(1).
+			SetJoined(time.Now()).
+			SetExp(1000).
+			SetPoints(10).
+			SetStatus("pending").
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SetOmitPrefix(user.OmitPrefixBar).
+			SaveX(ctx)
+	}
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	create(tx, "rolledback")
+	require.Empty(t, stream.events)
+	require.NoError(t, tx.Rollback())
+
+	tx, err = client.Tx(ctx)
+	require.NoError(t, err)
+	created := create(tx, "committed")
+	require.Empty(t, stream.events)
+	require.NoError(t, tx.Commit())
+	e := <-stream.events
+	require.Equal(t, UserEvent_CREATED, e.Type)
+	require.Equal(t, created.ID, e.Id)
+	require.Equal(t, "committed", e.User.UserName)
+	require.Empty(t, stream.events)
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestUserService_AggregateUser(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+
+	for i, st := range []string{"pending", "active", "active"} {
+		client.User.Create().
+			SetUserName(fmt.Sprintf("User%d", i)).
+			SetExternalID(i).
+			SetJoined(time.Now()).
+			SetExp(1000).
+			SetPoints(uint(i + 1)).
+			SetStatus(user.Status(st)).
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SetOmitPrefix(user.OmitPrefixBar).
+			SaveX(ctx)
+	}
+	resp, err := svc.AggregateUser(ctx, &AggregateUserRequest{Field: "points"})
+	require.NoError(t, err)
+	require.Len(t, resp.Groups, 1)
+	g := resp.Groups[0]
+	require.EqualValues(t, 3, g.Count)
+	require.EqualValues(t, 6, g.Sum.GetValue())
+	require.EqualValues(t, 2, g.Avg.GetValue())
+	require.EqualValues(t, 1, g.Min.GetValue())
+	require.EqualValues(t, 3, g.Max.GetValue())
+
+	resp, err = svc.AggregateUser(ctx, &AggregateUserRequest{
+		Field:   "points",
+		GroupBy: "status",
+		Filter:  &UserFilter{Points: &UserFilter_UInt32Filter{Gt: wrapperspb.UInt32(1)}},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Groups, 1)
+	require.Equal(t, "active", resp.Groups[0].Key)
+	require.EqualValues(t, 2, resp.Groups[0].Count)
+	require.EqualValues(t, 5, resp.Groups[0].Sum.GetValue())
+
+	resp, err = svc.AggregateUser(ctx, &AggregateUserRequest{GroupBy: "status"})
+	require.NoError(t, err)
+	counts := make(map[string]int64)
+	for _, g := range resp.Groups {
+		require.Nil(t, g.Sum)
+		counts[g.Key] = g.Count
+	}
+	require.Equal(t, map[string]int64{"pending": 1, "active": 2}, counts)
+
+	_, err = svc.AggregateUser(ctx, &AggregateUserRequest{Field: "user_name"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = svc.AggregateUser(ctx, &AggregateUserRequest{GroupBy: "account_balance"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUserService_DeleteUsers(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		client.User.Create().
+			SetUserName(fmt.Sprintf("User%d", i)).
+			SetExternalID(i).
+			SetJoined(time.Now()).
+			SetExp(1000).
+			SetPoints(uint(i)).
+			SetStatus("pending").
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SetOmitPrefix(user.OmitPrefixBar).
+			SaveX(ctx)
+	}
+	resp, err := svc.DeleteUsers(ctx, &DeleteUsersRequest{
+		Filter: &UserFilter{Points: &UserFilter_UInt32Filter{Gt: wrapperspb.UInt32(2)}},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, resp.Deleted)
+	require.Equal(t, 3, client.User.Query().CountX(ctx))
+
+	// Requests without comparisons are rejected.
+	_, err = svc.DeleteUsers(ctx, &DeleteUsersRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = svc.DeleteUsers(ctx, &DeleteUsersRequest{Filter: &UserFilter{}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, 3, client.User.Query().CountX(ctx))
 }
@@ -0,0 +1,202 @@
+// Code generated by entproto (entgo.io/contrib (devel)) from schema 830b4fd7dfe10a4d207535c61c07c2c632abf9d1dba0d9b450b1e8b270bbc3f3, DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.19.4
+// source: public/public.proto
+
+package public
+
+import (
+	common "entgo.io/contrib/entproto/internal/todo/ent/proto/common"
+	entpb "entgo.io/contrib/entproto/internal/todo/ent/proto/entpb"
+	_ "entgo.io/contrib/entproto/options/entproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Pony struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Defaults to "medium" when unset on creation.
+	Size entpb.Size `protobuf:"varint,4,opt,name=size,proto3,enum=entpb.Size" json:"size,omitempty"`
+	Name string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Defaults to "bay" when unset on creation.
+	Color string `protobuf:"bytes,8,opt,name=color,proto3" json:"color,omitempty"`
+	// Defaults to "low" when unset on creation.
+	Priority common.Priority `protobuf:"varint,6,opt,name=priority,proto3,enum=common.Priority" json:"priority,omitempty"`
+}
+
+func (x *Pony) Reset() {
+	*x = Pony{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_public_public_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pony) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pony) ProtoMessage() {}
+
+func (x *Pony) ProtoReflect() protoreflect.Message {
+	mi := &file_public_public_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pony.ProtoReflect.Descriptor instead.
+func (*Pony) Descriptor() ([]byte, []int) {
+	return file_public_public_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Pony) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Pony) GetSize() entpb.Size {
+	if x != nil {
+		return x.Size
+	}
+	return entpb.Size(0)
+}
+
+func (x *Pony) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pony) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Pony) GetPriority() common.Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return common.Priority(0)
+}
+
+var File_public_public_proto protoreflect.FileDescriptor
+
+var file_public_public_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x1a, 0x13, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x11, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2f, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x65, 0x6e, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xad, 0x01,
+	0x0a, 0x04, 0x50, 0x6f, 0x6e, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x7a,
+	0x65, 0x42, 0x0a, 0x92, 0xa7, 0x49, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0x92, 0xa7, 0x49, 0x03, 0x62, 0x61, 0x79, 0x52,
+	0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x07, 0x92, 0xa7, 0x49, 0x03,
+	0x6c, 0x6f, 0x77, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x3a, 0x5a,
+	0x38, 0x65, 0x6e, 0x74, 0x67, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69,
+	0x62, 0x2f, 0x65, 0x6e, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x6f, 0x64, 0x6f, 0x2f, 0x65, 0x6e, 0x74, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_public_public_proto_rawDescOnce sync.Once
+	file_public_public_proto_rawDescData = file_public_public_proto_rawDesc
+)
+
+func file_public_public_proto_rawDescGZIP() []byte {
+	file_public_public_proto_rawDescOnce.Do(func() {
+		file_public_public_proto_rawDescData = protoimpl.X.CompressGZIP(file_public_public_proto_rawDescData)
+	})
+	return file_public_public_proto_rawDescData
+}
+
+var file_public_public_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_public_public_proto_goTypes = []interface{}{
+	(*Pony)(nil),         // 0: public.Pony
+	(entpb.Size)(0),      // 1: entpb.Size
+	(common.Priority)(0), // 2: common.Priority
+}
+var file_public_public_proto_depIdxs = []int32{
+	1, // 0: public.Pony.size:type_name -> entpb.Size
+	2, // 1: public.Pony.priority:type_name -> common.Priority
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_public_public_proto_init() }
+func file_public_public_proto_init() {
+	if File_public_public_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_public_public_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pony); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_public_public_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_public_public_proto_goTypes,
+		DependencyIndexes: file_public_public_proto_depIdxs,
+		MessageInfos:      file_public_public_proto_msgTypes,
+	}.Build()
+	File_public_public_proto = out.File
+	file_public_public_proto_rawDesc = nil
+	file_public_public_proto_goTypes = nil
+	file_public_public_proto_depIdxs = nil
+}
@@ -3,7 +3,12 @@
 package ent
 
 import (
+	"time"
+
 	"entgo.io/contrib/entproto/internal/todo/ent/attachment"
+	"entgo.io/contrib/entproto/internal/todo/ent/label"
+	"entgo.io/contrib/entproto/internal/todo/ent/pony"
+	"entgo.io/contrib/entproto/internal/todo/ent/project"
 	"entgo.io/contrib/entproto/internal/todo/ent/schema"
 	"entgo.io/contrib/entproto/internal/todo/ent/user"
 	"github.com/google/uuid"
@@ -19,8 +24,46 @@ func init() {
 	attachmentDescID := attachmentFields[0].Descriptor()
 	// attachment.DefaultID holds the default value on creation for the id field.
 	attachment.DefaultID = attachmentDescID.Default.(func() uuid.UUID)
+	labelFields := schema.Label{}.Fields()
+	_ = labelFields
+	// labelDescColor is the schema descriptor for color field.
+	labelDescColor := labelFields[1].Descriptor()
+	// label.DefaultColor holds the default value on creation for the color field.
+	label.DefaultColor = labelDescColor.Default.(string)
+	// labelDescID is the schema descriptor for id field.
+	labelDescID := labelFields[0].Descriptor()
+	// label.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	label.IDValidator = labelDescID.Validators[0].(func(string) error)
 	multiwordschemaFields := schema.MultiWordSchema{}.Fields()
 	_ = multiwordschemaFields
+	petMixin := schema.Pet{}.Mixin()
+	petMixinFields0 := petMixin[0].Fields()
+	_ = petMixinFields0
+	petFields := schema.Pet{}.Fields()
+	_ = petFields
+	ponyMixin := schema.Pony{}.Mixin()
+	ponyMixinFields0 := ponyMixin[0].Fields()
+	_ = ponyMixinFields0
+	ponyFields := schema.Pony{}.Fields()
+	_ = ponyFields
+	// ponyDescHeight is the schema descriptor for height field.
+	ponyDescHeight := ponyFields[1].Descriptor()
+	// pony.DefaultHeight holds the default value on creation for the height field.
+	pony.DefaultHeight = ponyDescHeight.Default.(schema.Height)
+	// ponyDescColor is the schema descriptor for color field.
+	ponyDescColor := ponyFields[4].Descriptor()
+	// pony.DefaultColor holds the default value on creation for the color field.
+	pony.DefaultColor = ponyDescColor.Default.(string)
+	projectFields := schema.Project{}.Fields()
+	_ = projectFields
+	// projectDescName is the schema descriptor for name field.
+	projectDescName := projectFields[0].Descriptor()
+	// project.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	project.NameValidator = projectDescName.Validators[0].(func(string) error)
+	// projectDescVersion is the schema descriptor for version field.
+	projectDescVersion := projectFields[3].Descriptor()
+	// project.DefaultVersion holds the default value on creation for the version field.
+	project.DefaultVersion = projectDescVersion.Default.(int)
 	todoFields := schema.Todo{}.Fields()
 	_ = todoFields
 	userFields := schema.User{}.Fields()
@@ -37,4 +80,8 @@ func init() {
 	userDescAccountBalance := userFields[16].Descriptor()
 	// user.DefaultAccountBalance holds the default value on creation for the account_balance field.
 	user.DefaultAccountBalance = userDescAccountBalance.Default.(float64)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[21].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 }
@@ -49,6 +49,14 @@ func (Attachment) Edges() []ent.Edge {
 func (Attachment) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entproto.Message(),
-		entproto.Service(),
+		entproto.Service(
+			entproto.Methods(entproto.MethodAll|entproto.MethodExists|entproto.MethodCount|entproto.MethodBatchDelete|entproto.MethodEdges),
+			entproto.PartialBatchCreate(),
+			entproto.ExtraMethod("Upload", "Attachment", "google.protobuf.Empty",
+				entproto.ExtraMethodClientStream(),
+				entproto.ExtraMethodComment("Upload stores the attachments streamed by the client."),
+			),
+			entproto.ExtraMethod("Sync", "Attachment", "Attachment", entproto.ExtraMethodBidiStream()),
+		),
 	}
 }
@@ -0,0 +1,76 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+type Document struct {
+	ent.Schema
+}
+
+// Fields of the Document.
+func (Document) Fields() []ent.Field {
+	return []ent.Field{
+		// TenantID scopes the documents to the tenant of the requests, see the Tenant option of the service.
+		field.Int("tenant_id").
+			Immutable().
+			Annotations(entproto.Field(2)),
+		field.String("title").
+			Annotations(entproto.Field(3)),
+		// RequestID holds the idempotency key of the request creating the document, see the IdempotencyKey option of
+		// the service.
+		field.String("request_id").
+			Optional().
+			Unique().
+			Immutable().
+			Annotations(entproto.Field(4)),
+	}
+}
+
+// Edges of the Document.
+func (Document) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Parent is checked against the tenant of the requests, as documents are scoped to a tenant.
+		edge.To("parent", Document.Type).
+			Unique().
+			Annotations(
+				entproto.Field(5,
+					entproto.IDOnly(),
+				),
+			),
+	}
+}
+
+func (Document) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(
+			entproto.Methods(entproto.MethodAll|entproto.MethodCount|entproto.MethodDeleteWhere),
+			entproto.Tenant("tenant_id"),
+			entproto.IdempotencyKey("request_id"),
+			entproto.DeleteReturnsEntity(),
+			entproto.ExtraMethod("Archive", "Document", "Document",
+				entproto.ExtraMethodComment("Archive moves the document to the archive of its tenant."),
+			),
+			entproto.ExtraMethod("Tail", "ListDocumentRequest", "Document", entproto.ExtraMethodServerStream()),
+		),
+	}
+}
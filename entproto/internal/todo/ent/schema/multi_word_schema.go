@@ -50,6 +50,8 @@ func (MultiWordSchema) Edges() []ent.Edge {
 func (MultiWordSchema) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entproto.Message(),
-		entproto.Service(),
+		entproto.Service(
+			entproto.PageSize(2, 3),
+		),
 	}
 }
@@ -39,6 +39,14 @@ func (NilExample) Fields() []ent.Field {
 			Annotations(
 				entproto.Field(3),
 			),
+		field.Time("time_str_nil").
+			Optional().
+			Nillable().
+			Annotations(
+				entproto.Field(4,
+					entproto.RFC3339(),
+				),
+			),
 	}
 }
 
@@ -25,6 +25,13 @@ type Pet struct {
 	ent.Schema
 }
 
+// Mixin of the Pet.
+func (Pet) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SizeMixin{},
+	}
+}
+
 // Fields of the Pet.
 func (Pet) Fields() []ent.Field {
 	return nil
@@ -45,6 +52,12 @@ func (Pet) Edges() []ent.Edge {
 func (Pet) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entproto.Message(),
-		entproto.Service(),
+		entproto.Service(
+			entproto.SplitReadWrite(),
+			entproto.Comment("Pets are served by separate read and write services."),
+			entproto.WithMethodOptions(entproto.MethodCreate,
+				entproto.MethodComment("Create creates a pet, along with the edges to its owner and attachment."),
+			),
+		),
 	}
 }
@@ -15,8 +15,13 @@
 package schema
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
 	"entgo.io/contrib/entproto"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -27,17 +32,105 @@ type Pony struct {
 	ent.Schema
 }
 
+// Mixin of the Pony.
+func (Pony) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SizeMixin{},
+	}
+}
+
 // Fields of the Pet.
 func (Pony) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").
 			Annotations(entproto.Field(2)),
+		// Height is mapped to a string in the generated protos by the type
+		// mapping recorded in proto/entproto.types.json.
+		field.Other("height", Height{}).
+			SchemaType(map[string]string{
+				dialect.SQLite:   "varchar",
+				dialect.MySQL:    "varchar(16)",
+				dialect.Postgres: "varchar",
+			}).
+			Default(Height{Hands: 14}).
+			Annotations(entproto.Field(3)),
+		field.JSON("scores", []int64{}).
+			Optional().
+			Annotations(
+				entproto.Field(5,
+					entproto.Packed(),
+				),
+			),
+		field.Int8("rank").
+			Optional().
+			Annotations(entproto.Field(7)),
+		field.String("color").
+			Default("bay").
+			Annotations(entproto.Field(8)),
+		field.Enum("priority").
+			Values("low", "high").
+			Default("low").
+			Annotations(
+				entproto.Field(6),
+				entproto.Enum(map[string]int32{
+					"low":  0,
+					"high": 1,
+				}, entproto.EnumRef("common.Priority")),
+			),
 	}
 }
 
 func (Pony) Annotations() []schema.Annotation {
 	return []schema.Annotation{
-		entproto.Message(),
+		entproto.Message(
+			// The public package exposes the ponies without their height, scores and rank.
+			entproto.ExportTo("public", "name", "size", "color", "priority"),
+		),
 		entproto.Service(entproto.Methods(entproto.MethodBatchCreate)),
 	}
 }
+
+// Height is the height of a pony, measured in hands and inches.
+type Height struct {
+	Hands  int
+	Inches int
+}
+
+// ParseHeight parses a height in the "<hands>.<inches>" notation.
+func ParseHeight(s string) (Height, error) {
+	var h Height
+	if _, err := fmt.Sscanf(s, "%d.%d", &h.Hands, &h.Inches); err != nil {
+		return Height{}, fmt.Errorf("invalid height %q: %w", s, err)
+	}
+	if h.Inches < 0 || h.Inches > 3 {
+		return Height{}, fmt.Errorf("invalid height %q: inches must be between 0 and 3", s)
+	}
+	return h, nil
+}
+
+// String implements the fmt.Stringer interface.
+func (h Height) String() string {
+	return fmt.Sprintf("%d.%d", h.Hands, h.Inches)
+}
+
+// Scan implements the sql.Scanner interface.
+func (h *Height) Scan(src interface{}) error {
+	var s sql.NullString
+	if err := s.Scan(src); err != nil {
+		return err
+	}
+	if !s.Valid {
+		return nil
+	}
+	v, err := ParseHeight(s.String)
+	if err != nil {
+		return err
+	}
+	*h = v
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (h Height) Value() (driver.Value, error) {
+	return h.String(), nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+type Project struct {
+	ent.Schema
+}
+
+// Fields of the Project.
+func (Project) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(64).
+			Unique().
+			Annotations(entproto.Field(2)),
+		field.Time("deleted_at").
+			Optional().
+			Annotations(
+				entproto.Field(5,
+					entproto.OmitFrom(entproto.MethodCreate|entproto.MethodUpdate),
+				),
+			),
+		field.Enum("priority").
+			Values("low", "high").
+			Default("low").
+			Annotations(
+				entproto.Field(6),
+				entproto.Enum(map[string]int32{
+					"low":  0,
+					"high": 1,
+				}, entproto.EnumRef("common.Priority")),
+			),
+		// Version is incremented by every update, see the Etag option of the service.
+		field.Int("version").
+			Default(0).
+			Annotations(entproto.Field(7)),
+	}
+}
+
+// Edges of the Project.
+func (Project) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("owner", User.Type).
+			Unique().
+			Annotations(entproto.Field(3)),
+		edge.To("attachments", Attachment.Type).
+			Annotations(entproto.Field(4)),
+	}
+}
+
+func (Project) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(entproto.EdgeIDsMessage()),
+		entproto.Service(
+			entproto.Methods(entproto.MethodAll|entproto.MethodGetByUnique|entproto.MethodExists|entproto.MethodDeleteWhere|entproto.MethodStreamList|entproto.MethodCount|entproto.MethodAggregate|entproto.MethodBatchGet|entproto.MethodBatchDelete|entproto.MethodUpsert|entproto.MethodStats|entproto.MethodExport|entproto.MethodRestore|entproto.MethodEdges),
+			entproto.SoftDelete("deleted_at"),
+			entproto.Etag("version"),
+			entproto.ViewerContext(),
+			entproto.TotalSize(3),
+			entproto.FilterExpression(),
+			entproto.MessageNames(entproto.MethodGet, "ProjectLookupRequest", ""),
+			entproto.MessageNames(entproto.MethodBatchGet, "", "ProjectBatch"),
+		),
+	}
+}
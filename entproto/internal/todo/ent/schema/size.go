@@ -0,0 +1,63 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Size is the size of an animal. It is shared by the schemas using SizeMixin,
+// and generated as a single shared enum in the protos.
+type Size string
+
+// Size values.
+const (
+	SizeMedium Size = "medium"
+	SizeSmall  Size = "small"
+	SizeLarge  Size = "large"
+)
+
+// Values implements the field.EnumValues interface.
+func (Size) Values() []string {
+	return []string{string(SizeMedium), string(SizeSmall), string(SizeLarge)}
+}
+
+// SizeMixin adds the size field to schemas.
+type SizeMixin struct {
+	mixin.Schema
+}
+
+// Fields of the SizeMixin.
+func (SizeMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("size").
+			GoType(Size("")).
+			Default(string(SizeMedium)).
+			Annotations(
+				entproto.Field(4),
+				entproto.Enum(
+					map[string]int32{
+						"medium": 0,
+						"small":  1,
+						"large":  2,
+					},
+					entproto.SharedEnum("Size"),
+				),
+			),
+	}
+}
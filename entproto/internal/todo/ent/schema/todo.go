@@ -30,6 +30,10 @@ type Todo struct {
 func (Todo) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entproto.Message(),
+		entproto.Service(
+			entproto.Methods(entproto.MethodAll),
+			entproto.ReadOnly(),
+		),
 	}
 }
 
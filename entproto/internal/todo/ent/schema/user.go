@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"time"
 
 	"entgo.io/contrib/entproto"
 	"entgo.io/ent"
@@ -38,7 +39,18 @@ type User struct {
 func (User) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entproto.Message(),
-		entproto.Service(),
+		entproto.Service(
+			entproto.Methods(
+				entproto.MethodAll|entproto.MethodGetByUnique|entproto.MethodExists|entproto.MethodDeleteWhere|
+					entproto.MethodCount|entproto.MethodAggregate|entproto.MethodUpsert|entproto.MethodBatchGet|
+					entproto.MethodBatchUpdate|entproto.MethodBatchDelete|entproto.MethodStats|entproto.MethodStreamList|
+					entproto.MethodExport|entproto.MethodImport|entproto.MethodWatch,
+			),
+			entproto.UpsertKey("user_name"),
+			entproto.PaginationKey("created_at"),
+			entproto.ReadMask(),
+			entproto.UpdateMask(),
+		),
 	}
 }
 
@@ -81,7 +93,11 @@ func (User) Fields() []ent.Field {
 			),
 		field.Int("opt_num").
 			Optional().
-			Annotations(entproto.Field(13)),
+			Annotations(
+				entproto.Field(13,
+					entproto.RedactUnless("admin"),
+				),
+			),
 		field.String("opt_str").
 			Optional().
 			Annotations(entproto.Field(14)),
@@ -129,6 +145,12 @@ func (User) Fields() []ent.Field {
 					"SPEEDY300": 1,
 				}),
 			),
+		field.Time("created_at").
+			Default(time.Now).
+			Annotations(
+				entproto.Field(25),
+				entproto.Skip(entproto.MethodCreate|entproto.MethodUpdate),
+			),
 		field.Enum("omit_prefix").
 			Values("foo", "bar").
 			Annotations(
@@ -162,6 +184,12 @@ func (User) Edges() []ent.Edge {
 		edge.To("pet", Pet.Type).
 			Unique().
 			Annotations(entproto.Field(21)),
+		edge.To("friends", User.Type).
+			Annotations(
+				entproto.Field(26,
+					entproto.IDOnly(),
+				),
+			),
 		edge.To("skip_edge", SkipEdgeExample.Type).
 			Unique().
 			Annotations(entproto.Skip()),
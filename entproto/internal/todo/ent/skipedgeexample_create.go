@@ -4,10 +4,12 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"entgo.io/contrib/entproto/internal/todo/ent/skipedgeexample"
 	"entgo.io/contrib/entproto/internal/todo/ent/user"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -17,6 +19,7 @@ type SkipEdgeExampleCreate struct {
 	config
 	mutation *SkipEdgeExampleMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserID sets the "user" edge to the User entity by ID.
@@ -141,6 +144,7 @@ func (seec *SkipEdgeExampleCreate) createSpec() (*SkipEdgeExample, *sqlgraph.Cre
 			},
 		}
 	)
+	_spec.OnConflict = seec.conflict
 	if nodes := seec.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -164,10 +168,127 @@ func (seec *SkipEdgeExampleCreate) createSpec() (*SkipEdgeExample, *sqlgraph.Cre
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SkipEdgeExample.Create().
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (seec *SkipEdgeExampleCreate) OnConflict(opts ...sql.ConflictOption) *SkipEdgeExampleUpsertOne {
+	seec.conflict = opts
+	return &SkipEdgeExampleUpsertOne{
+		create: seec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SkipEdgeExample.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (seec *SkipEdgeExampleCreate) OnConflictColumns(columns ...string) *SkipEdgeExampleUpsertOne {
+	seec.conflict = append(seec.conflict, sql.ConflictColumns(columns...))
+	return &SkipEdgeExampleUpsertOne{
+		create: seec,
+	}
+}
+
+type (
+	// SkipEdgeExampleUpsertOne is the builder for "upsert"-ing
+	//  one SkipEdgeExample node.
+	SkipEdgeExampleUpsertOne struct {
+		create *SkipEdgeExampleCreate
+	}
+
+	// SkipEdgeExampleUpsert is the "OnConflict" setter.
+	SkipEdgeExampleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SkipEdgeExample.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SkipEdgeExampleUpsertOne) UpdateNewValues() *SkipEdgeExampleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SkipEdgeExample.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SkipEdgeExampleUpsertOne) Ignore() *SkipEdgeExampleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SkipEdgeExampleUpsertOne) DoNothing() *SkipEdgeExampleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SkipEdgeExampleCreate.OnConflict
+// documentation for more info.
+func (u *SkipEdgeExampleUpsertOne) Update(set func(*SkipEdgeExampleUpsert)) *SkipEdgeExampleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SkipEdgeExampleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *SkipEdgeExampleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SkipEdgeExampleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SkipEdgeExampleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SkipEdgeExampleUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SkipEdgeExampleUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // SkipEdgeExampleCreateBulk is the builder for creating many SkipEdgeExample entities in bulk.
 type SkipEdgeExampleCreateBulk struct {
 	config
 	builders []*SkipEdgeExampleCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the SkipEdgeExample entities in the database.
@@ -193,6 +314,7 @@ func (seecb *SkipEdgeExampleCreateBulk) Save(ctx context.Context) ([]*SkipEdgeEx
 					_, err = mutators[i+1].Mutate(root, seecb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = seecb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, seecb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -246,3 +368,99 @@ func (seecb *SkipEdgeExampleCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SkipEdgeExample.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (seecb *SkipEdgeExampleCreateBulk) OnConflict(opts ...sql.ConflictOption) *SkipEdgeExampleUpsertBulk {
+	seecb.conflict = opts
+	return &SkipEdgeExampleUpsertBulk{
+		create: seecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SkipEdgeExample.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (seecb *SkipEdgeExampleCreateBulk) OnConflictColumns(columns ...string) *SkipEdgeExampleUpsertBulk {
+	seecb.conflict = append(seecb.conflict, sql.ConflictColumns(columns...))
+	return &SkipEdgeExampleUpsertBulk{
+		create: seecb,
+	}
+}
+
+// SkipEdgeExampleUpsertBulk is the builder for "upsert"-ing
+// a bulk of SkipEdgeExample nodes.
+type SkipEdgeExampleUpsertBulk struct {
+	create *SkipEdgeExampleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SkipEdgeExample.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SkipEdgeExampleUpsertBulk) UpdateNewValues() *SkipEdgeExampleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SkipEdgeExample.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SkipEdgeExampleUpsertBulk) Ignore() *SkipEdgeExampleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SkipEdgeExampleUpsertBulk) DoNothing() *SkipEdgeExampleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SkipEdgeExampleCreateBulk.OnConflict
+// documentation for more info.
+func (u *SkipEdgeExampleUpsertBulk) Update(set func(*SkipEdgeExampleUpsert)) *SkipEdgeExampleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SkipEdgeExampleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *SkipEdgeExampleUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SkipEdgeExampleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SkipEdgeExampleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SkipEdgeExampleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -14,8 +14,12 @@ type Tx struct {
 	config
 	// Attachment is the client for interacting with the Attachment builders.
 	Attachment *AttachmentClient
+	// Document is the client for interacting with the Document builders.
+	Document *DocumentClient
 	// Group is the client for interacting with the Group builders.
 	Group *GroupClient
+	// Label is the client for interacting with the Label builders.
+	Label *LabelClient
 	// MultiWordSchema is the client for interacting with the MultiWordSchema builders.
 	MultiWordSchema *MultiWordSchemaClient
 	// NilExample is the client for interacting with the NilExample builders.
@@ -24,6 +28,8 @@ type Tx struct {
 	Pet *PetClient
 	// Pony is the client for interacting with the Pony builders.
 	Pony *PonyClient
+	// Project is the client for interacting with the Project builders.
+	Project *ProjectClient
 	// SkipEdgeExample is the client for interacting with the SkipEdgeExample builders.
 	SkipEdgeExample *SkipEdgeExampleClient
 	// Todo is the client for interacting with the Todo builders.
@@ -162,11 +168,14 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Attachment = NewAttachmentClient(tx.config)
+	tx.Document = NewDocumentClient(tx.config)
 	tx.Group = NewGroupClient(tx.config)
+	tx.Label = NewLabelClient(tx.config)
 	tx.MultiWordSchema = NewMultiWordSchemaClient(tx.config)
 	tx.NilExample = NewNilExampleClient(tx.config)
 	tx.Pet = NewPetClient(tx.config)
 	tx.Pony = NewPonyClient(tx.config)
+	tx.Project = NewProjectClient(tx.config)
 	tx.SkipEdgeExample = NewSkipEdgeExampleClient(tx.config)
 	tx.Todo = NewTodoClient(tx.config)
 	tx.User = NewUserClient(tx.config)
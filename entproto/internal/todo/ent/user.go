@@ -63,6 +63,8 @@ type User struct {
 	Labels []string `json:"labels,omitempty"`
 	// DeviceType holds the value of the "device_type" field.
 	DeviceType user.DeviceType `json:"device_type,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
 	// OmitPrefix holds the value of the "omit_prefix" field.
 	OmitPrefix user.OmitPrefix `json:"omit_prefix,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -81,11 +83,13 @@ type UserEdges struct {
 	Received1 []*Attachment `json:"received_1,omitempty"`
 	// Pet holds the value of the pet edge.
 	Pet *Pet `json:"pet,omitempty"`
+	// Friends holds the value of the friends edge.
+	Friends []*User `json:"friends,omitempty"`
 	// SkipEdge holds the value of the skip_edge edge.
 	SkipEdge *SkipEdgeExample `json:"skip_edge,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [6]bool
 }
 
 // GroupOrErr returns the Group value or an error if the edge
@@ -136,10 +140,19 @@ func (e UserEdges) PetOrErr() (*Pet, error) {
 	return nil, &NotLoadedError{edge: "pet"}
 }
 
+// FriendsOrErr returns the Friends value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FriendsOrErr() ([]*User, error) {
+	if e.loadedTypes[4] {
+		return e.Friends, nil
+	}
+	return nil, &NotLoadedError{edge: "friends"}
+}
+
 // SkipEdgeOrErr returns the SkipEdge value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e UserEdges) SkipEdgeOrErr() (*SkipEdgeExample, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		if e.SkipEdge == nil {
 			// Edge was loaded but was not found.
 			return nil, &NotFoundError{label: skipedgeexample.Label}
@@ -166,7 +179,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case user.FieldUserName, user.FieldStatus, user.FieldOptStr, user.FieldUnnecessary, user.FieldType, user.FieldDeviceType, user.FieldOmitPrefix:
 			values[i] = new(sql.NullString)
-		case user.FieldJoined:
+		case user.FieldJoined, user.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case user.FieldCrmID:
 			values[i] = new(uuid.UUID)
@@ -315,6 +328,12 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.DeviceType = user.DeviceType(value.String)
 			}
+		case user.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				u.CreatedAt = value.Time
+			}
 		case user.FieldOmitPrefix:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field omit_prefix", values[i])
@@ -353,6 +372,11 @@ func (u *User) QueryPet() *PetQuery {
 	return (&UserClient{config: u.config}).QueryPet(u)
 }
 
+// QueryFriends queries the "friends" edge of the User entity.
+func (u *User) QueryFriends() *UserQuery {
+	return (&UserClient{config: u.config}).QueryFriends(u)
+}
+
 // QuerySkipEdge queries the "skip_edge" edge of the User entity.
 func (u *User) QuerySkipEdge() *SkipEdgeExampleQuery {
 	return (&UserClient{config: u.config}).QuerySkipEdge(u)
@@ -441,6 +465,9 @@ func (u *User) String() string {
 	builder.WriteString("device_type=")
 	builder.WriteString(fmt.Sprintf("%v", u.DeviceType))
 	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("omit_prefix=")
 	builder.WriteString(fmt.Sprintf("%v", u.OmitPrefix))
 	builder.WriteByte(')')
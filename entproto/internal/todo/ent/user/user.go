@@ -4,6 +4,7 @@ package user
 
 import (
 	"fmt"
+	"time"
 )
 
 const (
@@ -51,6 +52,8 @@ const (
 	FieldLabels = "labels"
 	// FieldDeviceType holds the string denoting the device_type field in the database.
 	FieldDeviceType = "device_type"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
 	// FieldOmitPrefix holds the string denoting the omit_prefix field in the database.
 	FieldOmitPrefix = "omit_prefix"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
@@ -61,6 +64,8 @@ const (
 	EdgeReceived1 = "received_1"
 	// EdgePet holds the string denoting the pet edge name in mutations.
 	EdgePet = "pet"
+	// EdgeFriends holds the string denoting the friends edge name in mutations.
+	EdgeFriends = "friends"
 	// EdgeSkipEdge holds the string denoting the skip_edge edge name in mutations.
 	EdgeSkipEdge = "skip_edge"
 	// GroupFieldID holds the string denoting the ID field of the Group.
@@ -99,6 +104,8 @@ const (
 	PetInverseTable = "pets"
 	// PetColumn is the table column denoting the pet relation/edge.
 	PetColumn = "user_pet"
+	// FriendsTable is the table that holds the friends relation/edge. The primary key declared below.
+	FriendsTable = "user_friends"
 	// SkipEdgeTable is the table that holds the skip_edge relation/edge.
 	SkipEdgeTable = "skip_edge_examples"
 	// SkipEdgeInverseTable is the table name for the SkipEdgeExample entity.
@@ -131,6 +138,7 @@ var Columns = []string{
 	FieldType,
 	FieldLabels,
 	FieldDeviceType,
+	FieldCreatedAt,
 	FieldOmitPrefix,
 }
 
@@ -144,6 +152,9 @@ var (
 	// Received1PrimaryKey and Received1Column2 are the table columns denoting the
 	// primary key for the received_1 relation (M2M).
 	Received1PrimaryKey = []string{"attachment_id", "user_id"}
+	// FriendsPrimaryKey and FriendsColumn2 are the table columns denoting the
+	// primary key for the friends relation (M2M).
+	FriendsPrimaryKey = []string{"user_id", "friend_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -168,6 +179,8 @@ var (
 	DefaultHeightInCm float32
 	// DefaultAccountBalance holds the default value on creation for the "account_balance" field.
 	DefaultAccountBalance float64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
 )
 
 // Status defines the type for the "status" enum field.
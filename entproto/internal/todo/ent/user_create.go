@@ -14,6 +14,7 @@ import (
 	"entgo.io/contrib/entproto/internal/todo/ent/schema"
 	"entgo.io/contrib/entproto/internal/todo/ent/skipedgeexample"
 	"entgo.io/contrib/entproto/internal/todo/ent/user"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -24,6 +25,7 @@ type UserCreate struct {
 	config
 	mutation *UserMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserName sets the "user_name" field.
@@ -234,6 +236,20 @@ func (uc *UserCreate) SetNillableDeviceType(ut *user.DeviceType) *UserCreate {
 	return uc
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (uc *UserCreate) SetCreatedAt(t time.Time) *UserCreate {
+	uc.mutation.SetCreatedAt(t)
+	return uc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableCreatedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetCreatedAt(*t)
+	}
+	return uc
+}
+
 // SetOmitPrefix sets the "omit_prefix" field.
 func (uc *UserCreate) SetOmitPrefix(up user.OmitPrefix) *UserCreate {
 	uc.mutation.SetOmitPrefix(up)
@@ -318,6 +334,21 @@ func (uc *UserCreate) SetPet(p *Pet) *UserCreate {
 	return uc.SetPetID(p.ID)
 }
 
+// AddFriendIDs adds the "friends" edge to the User entity by IDs.
+func (uc *UserCreate) AddFriendIDs(ids ...uint32) *UserCreate {
+	uc.mutation.AddFriendIDs(ids...)
+	return uc
+}
+
+// AddFriends adds the "friends" edges to the User entity.
+func (uc *UserCreate) AddFriends(u ...*User) *UserCreate {
+	ids := make([]uint32, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddFriendIDs(ids...)
+}
+
 // SetSkipEdgeID sets the "skip_edge" edge to the SkipEdgeExample entity by ID.
 func (uc *UserCreate) SetSkipEdgeID(id int) *UserCreate {
 	uc.mutation.SetSkipEdgeID(id)
@@ -430,6 +461,10 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultDeviceType
 		uc.mutation.SetDeviceType(v)
 	}
+	if _, ok := uc.mutation.CreatedAt(); !ok {
+		v := user.DefaultCreatedAt()
+		uc.mutation.SetCreatedAt(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -480,6 +515,9 @@ func (uc *UserCreate) check() error {
 			return &ValidationError{Name: "device_type", err: fmt.Errorf(`ent: validator failed for field "User.device_type": %w`, err)}
 		}
 	}
+	if _, ok := uc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
+	}
 	if _, ok := uc.mutation.OmitPrefix(); !ok {
 		return &ValidationError{Name: "omit_prefix", err: errors.New(`ent: missing required field "User.omit_prefix"`)}
 	}
@@ -517,6 +555,7 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 			},
 		}
 	)
+	_spec.OnConflict = uc.conflict
 	if id, ok := uc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -601,6 +640,10 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldDeviceType, field.TypeEnum, value)
 		_node.DeviceType = value
 	}
+	if value, ok := uc.mutation.CreatedAt(); ok {
+		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
 	if value, ok := uc.mutation.OmitPrefix(); ok {
 		_spec.SetField(user.FieldOmitPrefix, field.TypeEnum, value)
 		_node.OmitPrefix = value
@@ -682,6 +725,25 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.FriendsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.FriendsTable,
+			Columns: user.FriendsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUint32,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := uc.mutation.SkipEdgeIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -704,10 +766,898 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.User.Create().
+//		SetUserName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserUpsert) {
+//			SetUserName(v+v).
+//		}).
+//		Exec(ctx)
+func (uc *UserCreate) OnConflict(opts ...sql.ConflictOption) *UserUpsertOne {
+	uc.conflict = opts
+	return &UserUpsertOne{
+		create: uc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (uc *UserCreate) OnConflictColumns(columns ...string) *UserUpsertOne {
+	uc.conflict = append(uc.conflict, sql.ConflictColumns(columns...))
+	return &UserUpsertOne{
+		create: uc,
+	}
+}
+
+type (
+	// UserUpsertOne is the builder for "upsert"-ing
+	//  one User node.
+	UserUpsertOne struct {
+		create *UserCreate
+	}
+
+	// UserUpsert is the "OnConflict" setter.
+	UserUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserName sets the "user_name" field.
+func (u *UserUpsert) SetUserName(v string) *UserUpsert {
+	u.Set(user.FieldUserName, v)
+	return u
+}
+
+// UpdateUserName sets the "user_name" field to the value that was provided on create.
+func (u *UserUpsert) UpdateUserName() *UserUpsert {
+	u.SetExcluded(user.FieldUserName)
+	return u
+}
+
+// SetPoints sets the "points" field.
+func (u *UserUpsert) SetPoints(v uint) *UserUpsert {
+	u.Set(user.FieldPoints, v)
+	return u
+}
+
+// UpdatePoints sets the "points" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePoints() *UserUpsert {
+	u.SetExcluded(user.FieldPoints)
+	return u
+}
+
+// AddPoints adds v to the "points" field.
+func (u *UserUpsert) AddPoints(v uint) *UserUpsert {
+	u.Add(user.FieldPoints, v)
+	return u
+}
+
+// SetExp sets the "exp" field.
+func (u *UserUpsert) SetExp(v uint64) *UserUpsert {
+	u.Set(user.FieldExp, v)
+	return u
+}
+
+// UpdateExp sets the "exp" field to the value that was provided on create.
+func (u *UserUpsert) UpdateExp() *UserUpsert {
+	u.SetExcluded(user.FieldExp)
+	return u
+}
+
+// AddExp adds v to the "exp" field.
+func (u *UserUpsert) AddExp(v uint64) *UserUpsert {
+	u.Add(user.FieldExp, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *UserUpsert) SetStatus(v user.Status) *UserUpsert {
+	u.Set(user.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *UserUpsert) UpdateStatus() *UserUpsert {
+	u.SetExcluded(user.FieldStatus)
+	return u
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *UserUpsert) SetExternalID(v int) *UserUpsert {
+	u.Set(user.FieldExternalID, v)
+	return u
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *UserUpsert) UpdateExternalID() *UserUpsert {
+	u.SetExcluded(user.FieldExternalID)
+	return u
+}
+
+// AddExternalID adds v to the "external_id" field.
+func (u *UserUpsert) AddExternalID(v int) *UserUpsert {
+	u.Add(user.FieldExternalID, v)
+	return u
+}
+
+// SetCrmID sets the "crm_id" field.
+func (u *UserUpsert) SetCrmID(v uuid.UUID) *UserUpsert {
+	u.Set(user.FieldCrmID, v)
+	return u
+}
+
+// UpdateCrmID sets the "crm_id" field to the value that was provided on create.
+func (u *UserUpsert) UpdateCrmID() *UserUpsert {
+	u.SetExcluded(user.FieldCrmID)
+	return u
+}
+
+// SetBanned sets the "banned" field.
+func (u *UserUpsert) SetBanned(v bool) *UserUpsert {
+	u.Set(user.FieldBanned, v)
+	return u
+}
+
+// UpdateBanned sets the "banned" field to the value that was provided on create.
+func (u *UserUpsert) UpdateBanned() *UserUpsert {
+	u.SetExcluded(user.FieldBanned)
+	return u
+}
+
+// SetCustomPb sets the "custom_pb" field.
+func (u *UserUpsert) SetCustomPb(v uint8) *UserUpsert {
+	u.Set(user.FieldCustomPb, v)
+	return u
+}
+
+// UpdateCustomPb sets the "custom_pb" field to the value that was provided on create.
+func (u *UserUpsert) UpdateCustomPb() *UserUpsert {
+	u.SetExcluded(user.FieldCustomPb)
+	return u
+}
+
+// AddCustomPb adds v to the "custom_pb" field.
+func (u *UserUpsert) AddCustomPb(v uint8) *UserUpsert {
+	u.Add(user.FieldCustomPb, v)
+	return u
+}
+
+// SetOptNum sets the "opt_num" field.
+func (u *UserUpsert) SetOptNum(v int) *UserUpsert {
+	u.Set(user.FieldOptNum, v)
+	return u
+}
+
+// UpdateOptNum sets the "opt_num" field to the value that was provided on create.
+func (u *UserUpsert) UpdateOptNum() *UserUpsert {
+	u.SetExcluded(user.FieldOptNum)
+	return u
+}
+
+// AddOptNum adds v to the "opt_num" field.
+func (u *UserUpsert) AddOptNum(v int) *UserUpsert {
+	u.Add(user.FieldOptNum, v)
+	return u
+}
+
+// ClearOptNum clears the value of the "opt_num" field.
+func (u *UserUpsert) ClearOptNum() *UserUpsert {
+	u.SetNull(user.FieldOptNum)
+	return u
+}
+
+// SetOptStr sets the "opt_str" field.
+func (u *UserUpsert) SetOptStr(v string) *UserUpsert {
+	u.Set(user.FieldOptStr, v)
+	return u
+}
+
+// UpdateOptStr sets the "opt_str" field to the value that was provided on create.
+func (u *UserUpsert) UpdateOptStr() *UserUpsert {
+	u.SetExcluded(user.FieldOptStr)
+	return u
+}
+
+// ClearOptStr clears the value of the "opt_str" field.
+func (u *UserUpsert) ClearOptStr() *UserUpsert {
+	u.SetNull(user.FieldOptStr)
+	return u
+}
+
+// SetOptBool sets the "opt_bool" field.
+func (u *UserUpsert) SetOptBool(v bool) *UserUpsert {
+	u.Set(user.FieldOptBool, v)
+	return u
+}
+
+// UpdateOptBool sets the "opt_bool" field to the value that was provided on create.
+func (u *UserUpsert) UpdateOptBool() *UserUpsert {
+	u.SetExcluded(user.FieldOptBool)
+	return u
+}
+
+// ClearOptBool clears the value of the "opt_bool" field.
+func (u *UserUpsert) ClearOptBool() *UserUpsert {
+	u.SetNull(user.FieldOptBool)
+	return u
+}
+
+// SetBigInt sets the "big_int" field.
+func (u *UserUpsert) SetBigInt(v schema.BigInt) *UserUpsert {
+	u.Set(user.FieldBigInt, v)
+	return u
+}
+
+// UpdateBigInt sets the "big_int" field to the value that was provided on create.
+func (u *UserUpsert) UpdateBigInt() *UserUpsert {
+	u.SetExcluded(user.FieldBigInt)
+	return u
+}
+
+// ClearBigInt clears the value of the "big_int" field.
+func (u *UserUpsert) ClearBigInt() *UserUpsert {
+	u.SetNull(user.FieldBigInt)
+	return u
+}
+
+// SetBUser1 sets the "b_user_1" field.
+func (u *UserUpsert) SetBUser1(v int) *UserUpsert {
+	u.Set(user.FieldBUser1, v)
+	return u
+}
+
+// UpdateBUser1 sets the "b_user_1" field to the value that was provided on create.
+func (u *UserUpsert) UpdateBUser1() *UserUpsert {
+	u.SetExcluded(user.FieldBUser1)
+	return u
+}
+
+// AddBUser1 adds v to the "b_user_1" field.
+func (u *UserUpsert) AddBUser1(v int) *UserUpsert {
+	u.Add(user.FieldBUser1, v)
+	return u
+}
+
+// ClearBUser1 clears the value of the "b_user_1" field.
+func (u *UserUpsert) ClearBUser1() *UserUpsert {
+	u.SetNull(user.FieldBUser1)
+	return u
+}
+
+// SetHeightInCm sets the "height_in_cm" field.
+func (u *UserUpsert) SetHeightInCm(v float32) *UserUpsert {
+	u.Set(user.FieldHeightInCm, v)
+	return u
+}
+
+// UpdateHeightInCm sets the "height_in_cm" field to the value that was provided on create.
+func (u *UserUpsert) UpdateHeightInCm() *UserUpsert {
+	u.SetExcluded(user.FieldHeightInCm)
+	return u
+}
+
+// AddHeightInCm adds v to the "height_in_cm" field.
+func (u *UserUpsert) AddHeightInCm(v float32) *UserUpsert {
+	u.Add(user.FieldHeightInCm, v)
+	return u
+}
+
+// SetAccountBalance sets the "account_balance" field.
+func (u *UserUpsert) SetAccountBalance(v float64) *UserUpsert {
+	u.Set(user.FieldAccountBalance, v)
+	return u
+}
+
+// UpdateAccountBalance sets the "account_balance" field to the value that was provided on create.
+func (u *UserUpsert) UpdateAccountBalance() *UserUpsert {
+	u.SetExcluded(user.FieldAccountBalance)
+	return u
+}
+
+// AddAccountBalance adds v to the "account_balance" field.
+func (u *UserUpsert) AddAccountBalance(v float64) *UserUpsert {
+	u.Add(user.FieldAccountBalance, v)
+	return u
+}
+
+// SetUnnecessary sets the "unnecessary" field.
+func (u *UserUpsert) SetUnnecessary(v string) *UserUpsert {
+	u.Set(user.FieldUnnecessary, v)
+	return u
+}
+
+// UpdateUnnecessary sets the "unnecessary" field to the value that was provided on create.
+func (u *UserUpsert) UpdateUnnecessary() *UserUpsert {
+	u.SetExcluded(user.FieldUnnecessary)
+	return u
+}
+
+// ClearUnnecessary clears the value of the "unnecessary" field.
+func (u *UserUpsert) ClearUnnecessary() *UserUpsert {
+	u.SetNull(user.FieldUnnecessary)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *UserUpsert) SetType(v string) *UserUpsert {
+	u.Set(user.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *UserUpsert) UpdateType() *UserUpsert {
+	u.SetExcluded(user.FieldType)
+	return u
+}
+
+// ClearType clears the value of the "type" field.
+func (u *UserUpsert) ClearType() *UserUpsert {
+	u.SetNull(user.FieldType)
+	return u
+}
+
+// SetLabels sets the "labels" field.
+func (u *UserUpsert) SetLabels(v []string) *UserUpsert {
+	u.Set(user.FieldLabels, v)
+	return u
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *UserUpsert) UpdateLabels() *UserUpsert {
+	u.SetExcluded(user.FieldLabels)
+	return u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *UserUpsert) ClearLabels() *UserUpsert {
+	u.SetNull(user.FieldLabels)
+	return u
+}
+
+// SetDeviceType sets the "device_type" field.
+func (u *UserUpsert) SetDeviceType(v user.DeviceType) *UserUpsert {
+	u.Set(user.FieldDeviceType, v)
+	return u
+}
+
+// UpdateDeviceType sets the "device_type" field to the value that was provided on create.
+func (u *UserUpsert) UpdateDeviceType() *UserUpsert {
+	u.SetExcluded(user.FieldDeviceType)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *UserUpsert) SetCreatedAt(v time.Time) *UserUpsert {
+	u.Set(user.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *UserUpsert) UpdateCreatedAt() *UserUpsert {
+	u.SetExcluded(user.FieldCreatedAt)
+	return u
+}
+
+// SetOmitPrefix sets the "omit_prefix" field.
+func (u *UserUpsert) SetOmitPrefix(v user.OmitPrefix) *UserUpsert {
+	u.Set(user.FieldOmitPrefix, v)
+	return u
+}
+
+// UpdateOmitPrefix sets the "omit_prefix" field to the value that was provided on create.
+func (u *UserUpsert) UpdateOmitPrefix() *UserUpsert {
+	u.SetExcluded(user.FieldOmitPrefix)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(user.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserUpsertOne) UpdateNewValues() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(user.FieldID)
+		}
+		if _, exists := u.create.mutation.Joined(); exists {
+			s.SetIgnore(user.FieldJoined)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserUpsertOne) Ignore() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserUpsertOne) DoNothing() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserCreate.OnConflict
+// documentation for more info.
+func (u *UserUpsertOne) Update(set func(*UserUpsert)) *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserName sets the "user_name" field.
+func (u *UserUpsertOne) SetUserName(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUserName(v)
+	})
+}
+
+// UpdateUserName sets the "user_name" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateUserName() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateUserName()
+	})
+}
+
+// SetPoints sets the "points" field.
+func (u *UserUpsertOne) SetPoints(v uint) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPoints(v)
+	})
+}
+
+// AddPoints adds v to the "points" field.
+func (u *UserUpsertOne) AddPoints(v uint) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddPoints(v)
+	})
+}
+
+// UpdatePoints sets the "points" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePoints() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePoints()
+	})
+}
+
+// SetExp sets the "exp" field.
+func (u *UserUpsertOne) SetExp(v uint64) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetExp(v)
+	})
+}
+
+// AddExp adds v to the "exp" field.
+func (u *UserUpsertOne) AddExp(v uint64) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddExp(v)
+	})
+}
+
+// UpdateExp sets the "exp" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateExp() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateExp()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *UserUpsertOne) SetStatus(v user.Status) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateStatus() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *UserUpsertOne) SetExternalID(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetExternalID(v)
+	})
+}
+
+// AddExternalID adds v to the "external_id" field.
+func (u *UserUpsertOne) AddExternalID(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddExternalID(v)
+	})
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateExternalID() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateExternalID()
+	})
+}
+
+// SetCrmID sets the "crm_id" field.
+func (u *UserUpsertOne) SetCrmID(v uuid.UUID) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetCrmID(v)
+	})
+}
+
+// UpdateCrmID sets the "crm_id" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateCrmID() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateCrmID()
+	})
+}
+
+// SetBanned sets the "banned" field.
+func (u *UserUpsertOne) SetBanned(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBanned(v)
+	})
+}
+
+// UpdateBanned sets the "banned" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateBanned() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBanned()
+	})
+}
+
+// SetCustomPb sets the "custom_pb" field.
+func (u *UserUpsertOne) SetCustomPb(v uint8) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetCustomPb(v)
+	})
+}
+
+// AddCustomPb adds v to the "custom_pb" field.
+func (u *UserUpsertOne) AddCustomPb(v uint8) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddCustomPb(v)
+	})
+}
+
+// UpdateCustomPb sets the "custom_pb" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateCustomPb() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateCustomPb()
+	})
+}
+
+// SetOptNum sets the "opt_num" field.
+func (u *UserUpsertOne) SetOptNum(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetOptNum(v)
+	})
+}
+
+// AddOptNum adds v to the "opt_num" field.
+func (u *UserUpsertOne) AddOptNum(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddOptNum(v)
+	})
+}
+
+// UpdateOptNum sets the "opt_num" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateOptNum() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateOptNum()
+	})
+}
+
+// ClearOptNum clears the value of the "opt_num" field.
+func (u *UserUpsertOne) ClearOptNum() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearOptNum()
+	})
+}
+
+// SetOptStr sets the "opt_str" field.
+func (u *UserUpsertOne) SetOptStr(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetOptStr(v)
+	})
+}
+
+// UpdateOptStr sets the "opt_str" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateOptStr() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateOptStr()
+	})
+}
+
+// ClearOptStr clears the value of the "opt_str" field.
+func (u *UserUpsertOne) ClearOptStr() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearOptStr()
+	})
+}
+
+// SetOptBool sets the "opt_bool" field.
+func (u *UserUpsertOne) SetOptBool(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetOptBool(v)
+	})
+}
+
+// UpdateOptBool sets the "opt_bool" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateOptBool() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateOptBool()
+	})
+}
+
+// ClearOptBool clears the value of the "opt_bool" field.
+func (u *UserUpsertOne) ClearOptBool() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearOptBool()
+	})
+}
+
+// SetBigInt sets the "big_int" field.
+func (u *UserUpsertOne) SetBigInt(v schema.BigInt) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBigInt(v)
+	})
+}
+
+// UpdateBigInt sets the "big_int" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateBigInt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBigInt()
+	})
+}
+
+// ClearBigInt clears the value of the "big_int" field.
+func (u *UserUpsertOne) ClearBigInt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBigInt()
+	})
+}
+
+// SetBUser1 sets the "b_user_1" field.
+func (u *UserUpsertOne) SetBUser1(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBUser1(v)
+	})
+}
+
+// AddBUser1 adds v to the "b_user_1" field.
+func (u *UserUpsertOne) AddBUser1(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddBUser1(v)
+	})
+}
+
+// UpdateBUser1 sets the "b_user_1" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateBUser1() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBUser1()
+	})
+}
+
+// ClearBUser1 clears the value of the "b_user_1" field.
+func (u *UserUpsertOne) ClearBUser1() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBUser1()
+	})
+}
+
+// SetHeightInCm sets the "height_in_cm" field.
+func (u *UserUpsertOne) SetHeightInCm(v float32) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetHeightInCm(v)
+	})
+}
+
+// AddHeightInCm adds v to the "height_in_cm" field.
+func (u *UserUpsertOne) AddHeightInCm(v float32) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddHeightInCm(v)
+	})
+}
+
+// UpdateHeightInCm sets the "height_in_cm" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateHeightInCm() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateHeightInCm()
+	})
+}
+
+// SetAccountBalance sets the "account_balance" field.
+func (u *UserUpsertOne) SetAccountBalance(v float64) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAccountBalance(v)
+	})
+}
+
+// AddAccountBalance adds v to the "account_balance" field.
+func (u *UserUpsertOne) AddAccountBalance(v float64) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddAccountBalance(v)
+	})
+}
+
+// UpdateAccountBalance sets the "account_balance" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateAccountBalance() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAccountBalance()
+	})
+}
+
+// SetUnnecessary sets the "unnecessary" field.
+func (u *UserUpsertOne) SetUnnecessary(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUnnecessary(v)
+	})
+}
+
+// UpdateUnnecessary sets the "unnecessary" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateUnnecessary() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateUnnecessary()
+	})
+}
+
+// ClearUnnecessary clears the value of the "unnecessary" field.
+func (u *UserUpsertOne) ClearUnnecessary() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearUnnecessary()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *UserUpsertOne) SetType(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateType() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateType()
+	})
+}
+
+// ClearType clears the value of the "type" field.
+func (u *UserUpsertOne) ClearType() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearType()
+	})
+}
+
+// SetLabels sets the "labels" field.
+func (u *UserUpsertOne) SetLabels(v []string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetLabels(v)
+	})
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateLabels() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateLabels()
+	})
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *UserUpsertOne) ClearLabels() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearLabels()
+	})
+}
+
+// SetDeviceType sets the "device_type" field.
+func (u *UserUpsertOne) SetDeviceType(v user.DeviceType) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetDeviceType(v)
+	})
+}
+
+// UpdateDeviceType sets the "device_type" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateDeviceType() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateDeviceType()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *UserUpsertOne) SetCreatedAt(v time.Time) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateCreatedAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetOmitPrefix sets the "omit_prefix" field.
+func (u *UserUpsertOne) SetOmitPrefix(v user.OmitPrefix) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetOmitPrefix(v)
+	})
+}
+
+// UpdateOmitPrefix sets the "omit_prefix" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateOmitPrefix() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateOmitPrefix()
+	})
+}
+
+// Exec executes the query.
+func (u *UserUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // UserCreateBulk is the builder for creating many User entities in bulk.
 type UserCreateBulk struct {
 	config
 	builders []*UserCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the User entities in the database.
@@ -734,6 +1684,7 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 					_, err = mutators[i+1].Mutate(root, ucb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ucb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ucb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -787,3 +1738,523 @@ func (ucb *UserCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.User.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserUpsert) {
+//			SetUserName(v+v).
+//		}).
+//		Exec(ctx)
+func (ucb *UserCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserUpsertBulk {
+	ucb.conflict = opts
+	return &UserUpsertBulk{
+		create: ucb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ucb *UserCreateBulk) OnConflictColumns(columns ...string) *UserUpsertBulk {
+	ucb.conflict = append(ucb.conflict, sql.ConflictColumns(columns...))
+	return &UserUpsertBulk{
+		create: ucb,
+	}
+}
+
+// UserUpsertBulk is the builder for "upsert"-ing
+// a bulk of User nodes.
+type UserUpsertBulk struct {
+	create *UserCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(user.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserUpsertBulk) UpdateNewValues() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(user.FieldID)
+			}
+			if _, exists := b.mutation.Joined(); exists {
+				s.SetIgnore(user.FieldJoined)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserUpsertBulk) Ignore() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserUpsertBulk) DoNothing() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserUpsertBulk) Update(set func(*UserUpsert)) *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserName sets the "user_name" field.
+func (u *UserUpsertBulk) SetUserName(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUserName(v)
+	})
+}
+
+// UpdateUserName sets the "user_name" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateUserName() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateUserName()
+	})
+}
+
+// SetPoints sets the "points" field.
+func (u *UserUpsertBulk) SetPoints(v uint) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPoints(v)
+	})
+}
+
+// AddPoints adds v to the "points" field.
+func (u *UserUpsertBulk) AddPoints(v uint) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddPoints(v)
+	})
+}
+
+// UpdatePoints sets the "points" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePoints() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePoints()
+	})
+}
+
+// SetExp sets the "exp" field.
+func (u *UserUpsertBulk) SetExp(v uint64) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetExp(v)
+	})
+}
+
+// AddExp adds v to the "exp" field.
+func (u *UserUpsertBulk) AddExp(v uint64) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddExp(v)
+	})
+}
+
+// UpdateExp sets the "exp" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateExp() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateExp()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *UserUpsertBulk) SetStatus(v user.Status) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateStatus() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *UserUpsertBulk) SetExternalID(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetExternalID(v)
+	})
+}
+
+// AddExternalID adds v to the "external_id" field.
+func (u *UserUpsertBulk) AddExternalID(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddExternalID(v)
+	})
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateExternalID() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateExternalID()
+	})
+}
+
+// SetCrmID sets the "crm_id" field.
+func (u *UserUpsertBulk) SetCrmID(v uuid.UUID) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetCrmID(v)
+	})
+}
+
+// UpdateCrmID sets the "crm_id" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateCrmID() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateCrmID()
+	})
+}
+
+// SetBanned sets the "banned" field.
+func (u *UserUpsertBulk) SetBanned(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBanned(v)
+	})
+}
+
+// UpdateBanned sets the "banned" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateBanned() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBanned()
+	})
+}
+
+// SetCustomPb sets the "custom_pb" field.
+func (u *UserUpsertBulk) SetCustomPb(v uint8) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetCustomPb(v)
+	})
+}
+
+// AddCustomPb adds v to the "custom_pb" field.
+func (u *UserUpsertBulk) AddCustomPb(v uint8) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddCustomPb(v)
+	})
+}
+
+// UpdateCustomPb sets the "custom_pb" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateCustomPb() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateCustomPb()
+	})
+}
+
+// SetOptNum sets the "opt_num" field.
+func (u *UserUpsertBulk) SetOptNum(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetOptNum(v)
+	})
+}
+
+// AddOptNum adds v to the "opt_num" field.
+func (u *UserUpsertBulk) AddOptNum(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddOptNum(v)
+	})
+}
+
+// UpdateOptNum sets the "opt_num" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateOptNum() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateOptNum()
+	})
+}
+
+// ClearOptNum clears the value of the "opt_num" field.
+func (u *UserUpsertBulk) ClearOptNum() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearOptNum()
+	})
+}
+
+// SetOptStr sets the "opt_str" field.
+func (u *UserUpsertBulk) SetOptStr(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetOptStr(v)
+	})
+}
+
+// UpdateOptStr sets the "opt_str" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateOptStr() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateOptStr()
+	})
+}
+
+// ClearOptStr clears the value of the "opt_str" field.
+func (u *UserUpsertBulk) ClearOptStr() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearOptStr()
+	})
+}
+
+// SetOptBool sets the "opt_bool" field.
+func (u *UserUpsertBulk) SetOptBool(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetOptBool(v)
+	})
+}
+
+// UpdateOptBool sets the "opt_bool" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateOptBool() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateOptBool()
+	})
+}
+
+// ClearOptBool clears the value of the "opt_bool" field.
+func (u *UserUpsertBulk) ClearOptBool() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearOptBool()
+	})
+}
+
+// SetBigInt sets the "big_int" field.
+func (u *UserUpsertBulk) SetBigInt(v schema.BigInt) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBigInt(v)
+	})
+}
+
+// UpdateBigInt sets the "big_int" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateBigInt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBigInt()
+	})
+}
+
+// ClearBigInt clears the value of the "big_int" field.
+func (u *UserUpsertBulk) ClearBigInt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBigInt()
+	})
+}
+
+// SetBUser1 sets the "b_user_1" field.
+func (u *UserUpsertBulk) SetBUser1(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBUser1(v)
+	})
+}
+
+// AddBUser1 adds v to the "b_user_1" field.
+func (u *UserUpsertBulk) AddBUser1(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddBUser1(v)
+	})
+}
+
+// UpdateBUser1 sets the "b_user_1" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateBUser1() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBUser1()
+	})
+}
+
+// ClearBUser1 clears the value of the "b_user_1" field.
+func (u *UserUpsertBulk) ClearBUser1() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBUser1()
+	})
+}
+
+// SetHeightInCm sets the "height_in_cm" field.
+func (u *UserUpsertBulk) SetHeightInCm(v float32) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetHeightInCm(v)
+	})
+}
+
+// AddHeightInCm adds v to the "height_in_cm" field.
+func (u *UserUpsertBulk) AddHeightInCm(v float32) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddHeightInCm(v)
+	})
+}
+
+// UpdateHeightInCm sets the "height_in_cm" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateHeightInCm() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateHeightInCm()
+	})
+}
+
+// SetAccountBalance sets the "account_balance" field.
+func (u *UserUpsertBulk) SetAccountBalance(v float64) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAccountBalance(v)
+	})
+}
+
+// AddAccountBalance adds v to the "account_balance" field.
+func (u *UserUpsertBulk) AddAccountBalance(v float64) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddAccountBalance(v)
+	})
+}
+
+// UpdateAccountBalance sets the "account_balance" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateAccountBalance() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAccountBalance()
+	})
+}
+
+// SetUnnecessary sets the "unnecessary" field.
+func (u *UserUpsertBulk) SetUnnecessary(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUnnecessary(v)
+	})
+}
+
+// UpdateUnnecessary sets the "unnecessary" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateUnnecessary() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateUnnecessary()
+	})
+}
+
+// ClearUnnecessary clears the value of the "unnecessary" field.
+func (u *UserUpsertBulk) ClearUnnecessary() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearUnnecessary()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *UserUpsertBulk) SetType(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateType() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateType()
+	})
+}
+
+// ClearType clears the value of the "type" field.
+func (u *UserUpsertBulk) ClearType() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearType()
+	})
+}
+
+// SetLabels sets the "labels" field.
+func (u *UserUpsertBulk) SetLabels(v []string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetLabels(v)
+	})
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateLabels() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateLabels()
+	})
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *UserUpsertBulk) ClearLabels() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearLabels()
+	})
+}
+
+// SetDeviceType sets the "device_type" field.
+func (u *UserUpsertBulk) SetDeviceType(v user.DeviceType) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetDeviceType(v)
+	})
+}
+
+// UpdateDeviceType sets the "device_type" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateDeviceType() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateDeviceType()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *UserUpsertBulk) SetCreatedAt(v time.Time) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateCreatedAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetOmitPrefix sets the "omit_prefix" field.
+func (u *UserUpsertBulk) SetOmitPrefix(v user.OmitPrefix) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetOmitPrefix(v)
+	})
+}
+
+// UpdateOmitPrefix sets the "omit_prefix" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateOmitPrefix() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateOmitPrefix()
+	})
+}
+
+// Exec executes the query.
+func (u *UserUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
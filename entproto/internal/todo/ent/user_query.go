@@ -33,6 +33,7 @@ type UserQuery struct {
 	withAttachment *AttachmentQuery
 	withReceived1  *AttachmentQuery
 	withPet        *PetQuery
+	withFriends    *UserQuery
 	withSkipEdge   *SkipEdgeExampleQuery
 	withFKs        bool
 	// intermediate query (i.e. traversal path).
@@ -159,6 +160,28 @@ func (uq *UserQuery) QueryPet() *PetQuery {
 	return query
 }
 
+// QueryFriends chains the current query on the "friends" edge.
+func (uq *UserQuery) QueryFriends() *UserQuery {
+	query := &UserQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, user.FriendsTable, user.FriendsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QuerySkipEdge chains the current query on the "skip_edge" edge.
 func (uq *UserQuery) QuerySkipEdge() *SkipEdgeExampleQuery {
 	query := &SkipEdgeExampleQuery{config: uq.config}
@@ -366,6 +389,7 @@ func (uq *UserQuery) Clone() *UserQuery {
 		withAttachment: uq.withAttachment.Clone(),
 		withReceived1:  uq.withReceived1.Clone(),
 		withPet:        uq.withPet.Clone(),
+		withFriends:    uq.withFriends.Clone(),
 		withSkipEdge:   uq.withSkipEdge.Clone(),
 		// clone intermediate query.
 		sql:    uq.sql.Clone(),
@@ -418,6 +442,17 @@ func (uq *UserQuery) WithPet(opts ...func(*PetQuery)) *UserQuery {
 	return uq
 }
 
+// WithFriends tells the query-builder to eager-load the nodes that are connected to
+// the "friends" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithFriends(opts ...func(*UserQuery)) *UserQuery {
+	query := &UserQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withFriends = query
+	return uq
+}
+
 // WithSkipEdge tells the query-builder to eager-load the nodes that are connected to
 // the "skip_edge" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithSkipEdge(opts ...func(*SkipEdgeExampleQuery)) *UserQuery {
@@ -503,11 +538,12 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		nodes       = []*User{}
 		withFKs     = uq.withFKs
 		_spec       = uq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [6]bool{
 			uq.withGroup != nil,
 			uq.withAttachment != nil,
 			uq.withReceived1 != nil,
 			uq.withPet != nil,
+			uq.withFriends != nil,
 			uq.withSkipEdge != nil,
 		}
 	)
@@ -560,6 +596,13 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withFriends; query != nil {
+		if err := uq.loadFriends(ctx, query, nodes,
+			func(n *User) { n.Edges.Friends = []*User{} },
+			func(n *User, e *User) { n.Edges.Friends = append(n.Edges.Friends, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := uq.withSkipEdge; query != nil {
 		if err := uq.loadSkipEdge(ctx, query, nodes, nil,
 			func(n *User, e *SkipEdgeExample) { n.Edges.SkipEdge = e }); err != nil {
@@ -712,6 +755,64 @@ func (uq *UserQuery) loadPet(ctx context.Context, query *PetQuery, nodes []*User
 	}
 	return nil
 }
+func (uq *UserQuery) loadFriends(ctx context.Context, query *UserQuery, nodes []*User, init func(*User), assign func(*User, *User)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uint32]*User)
+	nids := make(map[uint32]map[*User]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(user.FriendsTable)
+		s.Join(joinT).On(s.C(user.FieldID), joinT.C(user.FriendsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(user.FriendsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(user.FriendsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	neighbors, err := query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+		assign := spec.Assign
+		values := spec.ScanValues
+		spec.ScanValues = func(columns []string) ([]any, error) {
+			values, err := values(columns[1:])
+			if err != nil {
+				return nil, err
+			}
+			return append([]any{new(sql.NullInt64)}, values...), nil
+		}
+		spec.Assign = func(columns []string, values []any) error {
+			outValue := uint32(values[0].(*sql.NullInt64).Int64)
+			inValue := uint32(values[1].(*sql.NullInt64).Int64)
+			if nids[inValue] == nil {
+				nids[inValue] = map[*User]struct{}{byID[outValue]: {}}
+				return assign(columns[1:], values[1:])
+			}
+			nids[inValue][byID[outValue]] = struct{}{}
+			return nil
+		}
+	})
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "friends" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 func (uq *UserQuery) loadSkipEdge(ctx context.Context, query *SkipEdgeExampleQuery, nodes []*User, init func(*User), assign func(*User, *SkipEdgeExample)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uint32]*User)
@@ -24,6 +24,7 @@ import (
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 func TestGenerate(t *testing.T) {
@@ -36,9 +37,86 @@ func TestGenerate(t *testing.T) {
 	require.NoError(t, err)
 
 	err = entproto.Generate(graph)
+	require.EqualError(t, err, `entproto: failed parsing some schemas: unsupported field type "TypeOther"`)
+
+	height := entproto.TypeConverter{
+		ToProto:  `{{ . }}.String()`,
+		ToEnt:    `{{ qualify "entgo.io/contrib/entproto/internal/todo/ent/schema" "ParseHeight" }}({{ . }})`,
+		ToEntErr: true,
+	}
+	err = entproto.Generate(graph, entproto.WithTypeMapping(
+		"entgo.io/contrib/entproto/internal/todo/ent/schema.Height",
+		descriptorpb.FieldDescriptorProto_TYPE_STRING,
+		height,
+	))
 	require.NoError(t, err)
 
 	bytes, err := os.ReadFile(filepath.Join(tgt, "proto", "entpb", "entpb.proto"))
 	require.NoError(t, err)
 	require.True(t, strings.Contains(string(bytes), "// Code generated by entproto. DO NOT EDIT."))
+
+	// The registered mappings are recorded next to the .proto files, and loaded from there on.
+	expected, err := os.ReadFile(filepath.Join("ent", "proto", entproto.TypeMappingsFileName))
+	require.NoError(t, err)
+	actual, err := os.ReadFile(filepath.Join(tgt, "proto", entproto.TypeMappingsFileName))
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected), string(actual))
+	require.NoError(t, entproto.Generate(graph))
+}
+
+func TestCheckProtos(t *testing.T) {
+	graph, err := entc.LoadGraph("./ent/schema", &gen.Config{Target: "./ent"})
+	require.NoError(t, err)
+	stale, err := entproto.CheckProtos(graph)
+	require.NoError(t, err)
+	require.Empty(t, stale)
+
+	// Copy entpb.proto with the hash of another schema, and leave out common.proto and public.proto.
+	tgt, err := os.MkdirTemp(os.TempDir(), "entproto-test-*")
+	require.NoError(t, err)
+	defer os.RemoveAll(tgt)
+	b, err := os.ReadFile(filepath.Join("ent", "proto", "entpb", "entpb.proto"))
+	require.NoError(t, err)
+	lines := strings.SplitN(string(b), "\n", 2)
+	header := lines[0][:strings.LastIndex(lines[0], "schema ")] + "schema 0123abcd, DO NOT EDIT."
+	require.NoError(t, os.Mkdir(filepath.Join(tgt, "entpb"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(tgt, "entpb", "entpb.proto"), []byte(header+"\n"+lines[1]), 0644))
+
+	adapter, err := entproto.LoadAdapter(graph)
+	require.NoError(t, err)
+	stale, err = adapter.Stale(tgt)
+	require.NoError(t, err)
+	require.Len(t, stale, 3)
+	require.Equal(t, "common/common.proto", stale[0].Path)
+	require.Equal(t, "file is missing", stale[0].Reason)
+	require.Equal(t, "entpb/entpb.proto", stale[1].Path)
+	require.Contains(t, stale[1].Reason, "generated from schema 0123abcd")
+	require.Equal(t, "public/public.proto", stale[2].Path)
+}
+
+func TestExportTo(t *testing.T) {
+	graph, err := entc.LoadGraph("./ent/schema", &gen.Config{Target: "./ent"})
+	require.NoError(t, err)
+	adapter, err := entproto.LoadAdapter(graph)
+	require.NoError(t, err)
+
+	// The exported message holds the ID and the exported fields only, and is not served.
+	fd, ok := adapter.AllFileDescriptors()["public/public.proto"]
+	require.True(t, ok)
+	require.Empty(t, fd.GetServices())
+	msg := fd.FindMessage("public.Pony")
+	require.NotNil(t, msg)
+	var names []string
+	for _, f := range msg.GetFields() {
+		names = append(names, f.GetName())
+	}
+	require.ElementsMatch(t, []string{"id", "name", "size", "color", "priority"}, names)
+	require.Equal(t, "entpb.Size", msg.FindFieldByName("size").GetEnumType().GetFullyQualifiedName())
+	require.Equal(t, "common.Priority", msg.FindFieldByName("priority").GetEnumType().GetFullyQualifiedName())
+
+	// The message of the schema in its own package is left unchanged.
+	full, err := adapter.GetMessageDescriptor("Pony")
+	require.NoError(t, err)
+	require.NotNil(t, full.FindFieldByName("height"))
+	require.Equal(t, "entpb.Pony", full.GetFullyQualifiedName())
 }
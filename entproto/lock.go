@@ -0,0 +1,156 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// LockFileName is the name of the file recording the field numbers assigned to schemas
+// annotated with entproto.AutoFieldNumbers. It is written next to the generated .proto files.
+const LockFileName = "entproto.lock.json"
+
+// The field numbers reserved by the protobuf implementation, which are never assigned.
+const (
+	reservedFieldNumbersStart = 19000
+	reservedFieldNumbersEnd   = 19999
+)
+
+// lockFile records the field numbers assigned in auto-numbering mode. Entries are never removed,
+// so the numbers of deleted fields are not reused. To carry a number over a field rename, rename
+// its key in the lock file before regenerating.
+type lockFile struct {
+	Messages map[string]map[string]int32 `json:"messages"`
+	dirty    bool
+}
+
+func lockFilePath(graph *gen.Graph) string {
+	return filepath.Join(graph.Config.Target, "proto", LockFileName)
+}
+
+func loadLockFile(path string) (*lockFile, error) {
+	lf := &lockFile{Messages: make(map[string]map[string]int32)}
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return lf, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("entproto: failed reading lock file: %w", err)
+	}
+	if err := json.Unmarshal(b, lf); err != nil {
+		return nil, fmt.Errorf("entproto: failed decoding lock file %q: %w", path, err)
+	}
+	if lf.Messages == nil {
+		lf.Messages = make(map[string]map[string]int32)
+	}
+	return lf, nil
+}
+
+func (lf *lockFile) write(path string) error {
+	b, err := json.MarshalIndent(lf, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(b, '\n'), 0600)
+}
+
+// assignFieldNumbers annotates all fields and edges of genType that lack an entproto.Field annotation
+// with a field number. Numbers recorded in the lock file are reused, and new numbers are allocated
+// after the highest number ever used by the message, in schema declaration order, skipping the range
+// reserved by protobuf. Explicit numbers locked to other fields are rejected.
+func (lf *lockFile) assignFieldNumbers(genType *gen.Type) error {
+	locked, ok := lf.Messages[genType.Name]
+	if !ok {
+		locked = make(map[string]int32)
+		lf.Messages[genType.Name] = locked
+	}
+	if _, ok := genType.ID.Annotations[FieldAnnotation]; !ok {
+		setFieldAnnotation(&genType.ID.Annotations, IDFieldNumber)
+	}
+	next := int32(IDFieldNumber)
+	for _, n := range locked {
+		if n > next {
+			next = n
+		}
+	}
+	type numbered struct {
+		name   string
+		annots *gen.Annotations
+	}
+	var all []numbered
+	for _, f := range genType.Fields {
+		all = append(all, numbered{name: f.Name, annots: &f.Annotations})
+	}
+	for _, e := range genType.Edges {
+		all = append(all, numbered{name: e.Name, annots: &e.Annotations})
+	}
+	for _, n := range all {
+		if _, ok := (*n.annots)[FieldAnnotation]; !ok {
+			continue
+		}
+		pbf, err := decodeFieldAnnotation(n.name, (*n.annots)[FieldAnnotation])
+		if err != nil {
+			return err
+		}
+		for name, num := range locked {
+			if name != n.name && num == int32(pbf.Number) {
+				return fmt.Errorf("entproto: field number %d of %q on schema %q is locked to %q, remove or renumber its entry in %s",
+					num, n.name, genType.Name, name, LockFileName)
+			}
+		}
+		if num := int32(pbf.Number); num > next {
+			next = num
+		}
+		if locked[n.name] != int32(pbf.Number) {
+			locked[n.name] = int32(pbf.Number)
+			lf.dirty = true
+		}
+	}
+	for _, n := range all {
+		if isSkipped(*n.annots) {
+			continue
+		}
+		if _, ok := (*n.annots)[FieldAnnotation]; ok {
+			continue
+		}
+		num, ok := locked[n.name]
+		if !ok {
+			next++
+			if next >= reservedFieldNumbersStart && next <= reservedFieldNumbersEnd {
+				next = reservedFieldNumbersEnd + 1
+			}
+			num = next
+			locked[n.name] = num
+			lf.dirty = true
+		}
+		setFieldAnnotation(n.annots, int(num))
+	}
+	return nil
+}
+
+func setFieldAnnotation(annots *gen.Annotations, num int) {
+	if *annots == nil {
+		*annots = make(gen.Annotations)
+	}
+	(*annots)[FieldAnnotation] = Field(num)
+}
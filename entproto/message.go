@@ -55,9 +55,34 @@ func PackageName(pkg string) MessageOption {
 	}
 }
 
+// AutoFieldNumbers assigns protobuf field numbers to all fields and edges of the schema that are
+// not annotated with entproto.Field. Assigned numbers are recorded in entproto.lock.json next to the
+// generated .proto files, so they stay stable across regenerations.
+func AutoFieldNumbers() MessageOption {
+	return func(msg *message) {
+		msg.AutoFieldNumbers = true
+	}
+}
+
+// EdgeIDsMessage generates the IDs of the schema's edges in a separate <Schema>EdgeIds message, instead
+// of adding the edges to the entity message. The Get and List methods of the service return the edge IDs
+// alongside the entity when the WITH_EDGE_IDS view is requested, and the Create and Update requests accept
+// them. This keeps the entity message stable for clients that never load edges.
+func EdgeIDsMessage() MessageOption {
+	return func(msg *message) {
+		msg.EdgeIDsMessage = true
+	}
+}
+
 type message struct {
-	Generate bool
-	Package  string
+	Generate         bool
+	Package          string
+	AutoFieldNumbers bool
+	EdgeIDsMessage   bool
+	// Exports holds the proto packages the message is exported into, see ExportTo.
+	Exports []export
+	// OpenAPISchema is the protoc-gen-openapiv2 schema of the message, see OpenAPISchema.
+	OpenAPISchema *openAPIOption
 }
 
 func (m message) Name() string {
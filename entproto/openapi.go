@@ -0,0 +1,87 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// openAPIOptionsPath is the path of the file defining the protoc-gen-openapiv2 options.
+const openAPIOptionsPath = "protoc-gen-openapiv2/options/annotations.proto"
+
+// OpenAPIOperation sets the openapiv2_operation option of protoc-gen-openapiv2 on the method, such as its summary,
+// tags and response examples, which are copied into the OpenAPI document generated for the gRPC gateway. The
+// generated file imports protoc-gen-openapiv2/options/annotations.proto, which must be available to protoc, for
+// example from the grpc-gateway repository. For example:
+//
+//	entproto.Service(
+//		entproto.WithMethodOptions(entproto.MethodGet,
+//			entproto.OpenAPIOperation(&options.Operation{
+//				Summary: "Get a user",
+//				Tags:    []string{"users"},
+//			}),
+//		),
+//	)
+func OpenAPIOperation(op *options.Operation) MethodOption {
+	return func(o *methodOptions) {
+		o.OpenAPIOperation = newOpenAPIOption(op)
+	}
+}
+
+// OpenAPISchema sets the openapiv2_schema option of protoc-gen-openapiv2 on the message of the schema, such as its
+// title, description and example. For example:
+//
+//	entproto.Message(
+//		entproto.OpenAPISchema(&options.Schema{
+//			JsonSchema: &options.JSONSchema{Title: "User"},
+//			Example:    `{"user_name": "a8m"}`,
+//		}),
+//	)
+func OpenAPISchema(s *options.Schema) MessageOption {
+	return func(msg *message) {
+		msg.OpenAPISchema = newOpenAPIOption(s)
+	}
+}
+
+// openAPIOption holds an option in the annotations, which are encoded in JSON by ent, along with the error of its
+// encoding, reported when the option is set on its descriptor.
+type openAPIOption struct {
+	JSON  string
+	Error string
+}
+
+func newOpenAPIOption(m proto.Message) *openAPIOption {
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return &openAPIOption{Error: err.Error()}
+	}
+	return &openAPIOption{JSON: string(b)}
+}
+
+// decode decodes the option into m.
+func (o *openAPIOption) decode(m proto.Message) error {
+	name := m.ProtoReflect().Descriptor().FullName()
+	if o.Error != "" {
+		return fmt.Errorf("entproto: invalid %s option: %s", name, o.Error)
+	}
+	if err := protojson.Unmarshal([]byte(o.JSON), m); err != nil {
+		return fmt.Errorf("entproto: invalid %s option: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Packed sets the packed option of a repeated numeric field, such as a field of a JSON slice of numbers
+// or an edge of an edge IDs message holding integer IDs, encoding its elements in a single length-delimited
+// record. proto3 packs these fields by default, and the option makes the encoding explicit for the
+// consumers of the generated .proto files. Fields of other types are rejected.
+// Example:
+//	field.JSON("scores", []int64{}).
+//		Annotations(
+//			entproto.Field(2,
+//				entproto.Packed(),
+//			),
+//		)
+func Packed() FieldOption {
+	return func(p *pbfield) {
+		p.Packed = true
+	}
+}
+
+// packableTypes are the protobuf types of the repeated fields that may use the packed encoding.
+var packableTypes = map[descriptorpb.FieldDescriptorProto_Type]bool{
+	descriptorpb.FieldDescriptorProto_TYPE_BOOL:     true,
+	descriptorpb.FieldDescriptorProto_TYPE_ENUM:     true,
+	descriptorpb.FieldDescriptorProto_TYPE_INT32:    true,
+	descriptorpb.FieldDescriptorProto_TYPE_INT64:    true,
+	descriptorpb.FieldDescriptorProto_TYPE_UINT32:   true,
+	descriptorpb.FieldDescriptorProto_TYPE_UINT64:   true,
+	descriptorpb.FieldDescriptorProto_TYPE_SINT32:   true,
+	descriptorpb.FieldDescriptorProto_TYPE_SINT64:   true,
+	descriptorpb.FieldDescriptorProto_TYPE_FIXED32:  true,
+	descriptorpb.FieldDescriptorProto_TYPE_FIXED64:  true,
+	descriptorpb.FieldDescriptorProto_TYPE_SFIXED32: true,
+	descriptorpb.FieldDescriptorProto_TYPE_SFIXED64: true,
+	descriptorpb.FieldDescriptorProto_TYPE_FLOAT:    true,
+	descriptorpb.FieldDescriptorProto_TYPE_DOUBLE:   true,
+}
+
+// setPacked sets the packed option of the field descriptor fd of the field or edge name, annotated with
+// entproto.Packed. It fails if the field is not a repeated numeric field.
+func setPacked(name string, fd *descriptorpb.FieldDescriptorProto) error {
+	if fd.GetLabel() != descriptorpb.FieldDescriptorProto_LABEL_REPEATED || !packableTypes[fd.GetType()] {
+		return fmt.Errorf("entproto: entproto.Packed of %q requires a repeated numeric field", name)
+	}
+	if fd.Options == nil {
+		fd.Options = &descriptorpb.FieldOptions{}
+	}
+	fd.Options.Packed = proto.Bool(true)
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// PageSize sets the page sizes of the List method of the service. Requests without a page size return
+// def entries, and requests for more than max entries return at most max. For example:
+//
+//	entproto.Service(
+//		entproto.PageSize(20, 100),
+//	)
+//
+// If no page size is set, both default to MaxPageSize.
+func PageSize(def, max int) ServiceOption {
+	return func(s *service) {
+		s.DefaultPageSize = def
+		s.MaxPageSize = max
+	}
+}
+
+// PageSizes returns the default and maximum page sizes of the List method of the schema. See PageSize.
+func PageSizes(t *gen.Type) (def, max int, err error) {
+	svc, err := extractServiceAnnotation(t)
+	if err != nil {
+		return 0, 0, err
+	}
+	if svc.DefaultPageSize == 0 && svc.MaxPageSize == 0 {
+		return MaxPageSize, MaxPageSize, nil
+	}
+	if svc.DefaultPageSize <= 0 || svc.DefaultPageSize > svc.MaxPageSize {
+		return 0, 0, fmt.Errorf("entproto: page sizes of schema %q must satisfy 0 < default (%d) <= max (%d)",
+			t.Name, svc.DefaultPageSize, svc.MaxPageSize)
+	}
+	return svc.DefaultPageSize, svc.MaxPageSize, nil
+}
+
+// pageSizeComment returns the comment of the page_size field of List requests.
+func pageSizeComment(def, max int) string {
+	return fmt.Sprintf(" The maximum number of entries to return. Defaults to %d if unset, and larger\n"+
+		" values are capped to %d.\n", def, max)
+}
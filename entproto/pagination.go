@@ -0,0 +1,63 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// PaginationKey sets the field paging the List requests of the service that have no order_by expression.
+// Their entities are ordered by the field and their ID, both descending, and the page tokens encode the
+// values of the first entity of the next page. For example, the following annotation pages the entities
+// from the most recently created:
+//
+//	entproto.Service(
+//		entproto.PaginationKey("created_at"),
+//	)
+//
+// Unlike the ID, the field does not need to be unique. If no key is set, the entities are paged by ID.
+func PaginationKey(field string) ServiceOption {
+	return func(s *service) {
+		s.PaginationKey = field
+	}
+}
+
+// PaginationKeyField returns the field paging the List requests of the schema, or nil if they are paged by ID.
+// See PaginationKey.
+func PaginationKeyField(t *gen.Type) (*gen.Field, error) {
+	svc, err := extractServiceAnnotation(t)
+	if err != nil {
+		return nil, err
+	}
+	if svc.PaginationKey == "" {
+		return nil, nil
+	}
+	for _, f := range t.Fields {
+		if f.Name != svc.PaginationKey {
+			continue
+		}
+		switch {
+		case f.Optional || f.Nillable:
+			return nil, fmt.Errorf("entproto: pagination key field %q of schema %q cannot be optional", f.Name, t.Name)
+		case f.HasGoType() || f.Sensitive() ||
+			!(f.IsTime() || f.IsString() || f.Type.Type.Integer() || f.Type.Type.Float()):
+			return nil, fmt.Errorf("entproto: pagination key field %q of schema %q must be a time, string or numeric field", f.Name, t.Name)
+		}
+		return f, nil
+	}
+	return nil, fmt.Errorf("entproto: pagination key field %q not found in schema %q", svc.PaginationKey, t.Name)
+}
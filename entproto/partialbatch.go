@@ -0,0 +1,79 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
+	_ "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// statusPath is the path of the file declaring the google.rpc.Status message.
+const statusPath = "google/rpc/status.proto"
+
+// PartialBatchCreate makes the BatchCreate method of the service create the entries of the request one by one,
+// rather than rejecting the whole batch when one of them is invalid. The response holds a result per entry of
+// the request, in the same order, carrying either the created entity or the google.rpc.Status of its failure.
+// For example:
+//
+//	entproto.Service(
+//		entproto.Methods(entproto.MethodAll),
+//		entproto.PartialBatchCreate(),
+//	)
+//
+// The generated file imports google/rpc/status.proto, which must be available to protoc.
+func PartialBatchCreate() ServiceOption {
+	return func(s *service) {
+		s.PartialBatchCreate = true
+	}
+}
+
+// HasPartialBatchCreate reports whether the BatchCreate method of the service of the schema creates the entries
+// of the request one by one. See PartialBatchCreate.
+func HasPartialBatchCreate(t *gen.Type) (bool, error) {
+	svc, err := extractServiceAnnotation(t)
+	if err != nil {
+		return false, err
+	}
+	return svc.PartialBatchCreate, nil
+}
+
+// toBatchCreateResultDescriptor returns the descriptor of the BatchCreate<T>Result message of genType, holding
+// the created entity or the status of the failure of an entry of a partial BatchCreate request.
+func toBatchCreateResultDescriptor(genType *gen.Type) *descriptorpb.DescriptorProto {
+	messageType := descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	return &descriptorpb.DescriptorProto{
+		Name:      strptr(fmt.Sprintf("BatchCreate%sResult", genType.Name)),
+		OneofDecl: []*descriptorpb.OneofDescriptorProto{{Name: strptr("result")}},
+		Field: []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:       strptr(snake(genType.Name)),
+				Number:     int32ptr(1),
+				Type:       &messageType,
+				TypeName:   &genType.Name,
+				OneofIndex: int32ptr(0),
+			},
+			{
+				Name:       strptr("status"),
+				Number:     int32ptr(2),
+				Type:       &messageType,
+				TypeName:   strptr("google.rpc.Status"),
+				OneofIndex: int32ptr(0),
+			},
+		},
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"entgo.io/ent/entc/gen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// ReadMask adds a google.protobuf.FieldMask read_mask field to the Get and List requests of the service. The
+// paths of the mask are the names of the fields of the entity message, and the generated service selects only
+// their columns, leaving the other fields of the returned messages unset. The ID is always returned, and the
+// edges are still loaded according to the view of the request. For example:
+//
+//	entproto.Service(
+//		entproto.ReadMask(),
+//	)
+//
+// Requests without a read mask return all the fields, and unknown paths are rejected with the InvalidArgument code.
+func ReadMask() ServiceOption {
+	return func(s *service) {
+		s.ReadMask = true
+	}
+}
+
+// HasReadMask reports whether the Get and List requests of the service of the schema hold a read mask.
+// See ReadMask.
+func HasReadMask(t *gen.Type) (bool, error) {
+	svc, err := extractServiceAnnotation(t)
+	if err != nil {
+		return false, err
+	}
+	return svc.ReadMask, nil
+}
+
+// readMaskField returns the descriptor of the read_mask field of a request, with the given number.
+func readMaskField(number int32) *descriptorpb.FieldDescriptorProto {
+	messageType := descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	return &descriptorpb.FieldDescriptorProto{
+		Name:     strptr("read_mask"),
+		Number:   int32ptr(number),
+		Type:     &messageType,
+		TypeName: strptr("google.protobuf.FieldMask"),
+	}
+}
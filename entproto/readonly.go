@@ -0,0 +1,46 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"entgo.io/ent/entc/gen"
+)
+
+// ReadOnly generates a service serving only the methods reading the entries of the schema, such as Get, List and
+// BatchGet. The methods mutating them, such as Create, Update and Delete, are still declared by the generated
+// .proto file, so that read-only and read-write deployments share the same contract, but they reject all requests
+// with the PermissionDenied code. For example, to serve the entries from a read replica:
+//
+//	entproto.Service(
+//		entproto.Methods(entproto.MethodAll),
+//		entproto.ReadOnly(),
+//	)
+//
+// The generated service does not build the mutations of the schema, and has no lifecycle hooks. Unlike the
+// runtime.WithReadOnly option, it cannot be made writable when constructed.
+func ReadOnly() ServiceOption {
+	return func(s *service) {
+		s.ReadOnly = true
+	}
+}
+
+// HasReadOnly reports whether the service of the schema rejects the methods mutating its entries. See ReadOnly.
+func HasReadOnly(t *gen.Type) (bool, error) {
+	svc, err := extractServiceAnnotation(t)
+	if err != nil {
+		return false, err
+	}
+	return svc.ReadOnly, nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// RedactUnless clears the field from the responses of the generated service, unless the caller of the request
+// holds the given role. The roles of the callers are extracted by the function passed to the constructor of the
+// service with runtime.WithRoles, and callers hold no role without it. It allows a single service to serve both
+// privileged and end-user callers. The field is still read from the requests, see OmitFrom.
+// Example:
+//
+//	field.String("ssn").
+//		Annotations(
+//			entproto.Field(7,
+//				entproto.RedactUnless("admin"),
+//			),
+//		)
+func RedactUnless(role string) FieldOption {
+	return func(p *pbfield) {
+		p.RedactUnless = role
+	}
+}
+
+// RedactedRole returns the role the callers must hold to read the field in the responses of the service, or
+// "" if the field is not redacted. See RedactUnless.
+func (d *FieldMappingDescriptor) RedactedRole() (string, error) {
+	var (
+		name   string
+		annots gen.Annotations
+	)
+	switch {
+	case d.IsEdgeField && d.EntEdge != nil:
+		name, annots = d.EntEdge.Name, d.EntEdge.Annotations
+	case d.EntField != nil:
+		name, annots = d.EntField.Name, d.EntField.Annotations
+	}
+	annot, ok := annots[FieldAnnotation]
+	if !ok {
+		return "", nil
+	}
+	f, err := decodeFieldAnnotation(name, annot)
+	if err != nil {
+		return "", err
+	}
+	if f.RedactUnless != "" && d.IsIDField {
+		return "", fmt.Errorf("entproto: entproto.RedactUnless is not supported on the ID field %q", name)
+	}
+	return f.RedactUnless, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// RFC3339TimeConverter converts time.Time values to RFC 3339 strings with nanoseconds, and parses
+// RFC 3339 strings back, with or without fractional seconds.
+var RFC3339TimeConverter = TypeConverter{
+	ToProto:  `{{ . }}.Format({{ qualify "time" "RFC3339Nano" }})`,
+	ToEnt:    `{{ qualify "time" "Parse" }}({{ qualify "time" "RFC3339Nano" }}, {{ . }})`,
+	ToEntErr: true,
+}
+
+// rfc3339Mapping is the type mapping of the time fields annotated with RFC3339.
+var rfc3339Mapping = &TypeMapping{
+	GoType:        "time.Time",
+	ProtoType:     descriptorpb.FieldDescriptorProto_TYPE_STRING,
+	TypeConverter: RFC3339TimeConverter,
+}
+
+// WithRFC3339Times maps all the time fields of the graph to RFC 3339 strings instead of
+// google.protobuf.Timestamp messages, for consumers that prefer textual timestamps. It registers
+// RFC3339TimeConverter as the type mapping of time.Time, see WithTypeMapping.
+func WithRFC3339Times() GenerateOption {
+	return WithTypeMapping("time.Time", descriptorpb.FieldDescriptorProto_TYPE_STRING, RFC3339TimeConverter)
+}
+
+// RFC3339 maps the time field to an RFC 3339 string instead of a google.protobuf.Timestamp message.
+// Optional fields are mapped to google.protobuf.StringValue. Invalid strings in requests are rejected
+// with an InvalidArgument status.
+// Example:
+//	field.Time("updated_at").
+//		Annotations(
+//			entproto.Field(4,
+//				entproto.RFC3339(),
+//			),
+//		)
+func RFC3339() FieldOption {
+	return func(p *pbfield) {
+		p.RFC3339 = true
+	}
+}
+
+// isRFC3339 reports whether the field is annotated with RFC3339. It fails if the field is not a time field.
+func isRFC3339(f *gen.Field) (bool, error) {
+	if _, ok := f.Annotations[FieldAnnotation]; !ok {
+		return false, nil
+	}
+	fann, err := extractFieldAnnotation(f)
+	if err != nil || !fann.RFC3339 {
+		return false, err
+	}
+	if !f.IsTime() || f.HasGoType() {
+		return false, fmt.Errorf("entproto: entproto.RFC3339 of field %q requires a time.Time field", f.Name)
+	}
+	return true, nil
+}
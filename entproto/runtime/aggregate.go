@@ -0,0 +1,25 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+// Aggregates holds the results of the aggregation functions of a group scanned by the generated Aggregate
+// methods. Sum, Avg, Min and Max are nil if no field is aggregated, or if all of its values in the group are NULL.
+type Aggregates struct {
+	Count int      `json:"count"`
+	Sum   *float64 `json:"sum"`
+	Avg   *float64 `json:"avg"`
+	Min   *float64 `json:"min"`
+	Max   *float64 `json:"max"`
+}
@@ -0,0 +1,138 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Cache stores the entities served by the Get methods of the services, keyed by the name of their type and their
+// ID. Values are the marshaled protobuf messages of the entities.
+type Cache interface {
+	// Get returns the value cached for the entity, and reports whether it was found.
+	Get(ctx context.Context, entity, id string) ([]byte, bool, error)
+	// Set caches the value of the entity for the given duration, or without expiration if it is not positive.
+	Set(ctx context.Context, entity, id string, value []byte, ttl time.Duration) error
+	// Invalidate removes the value cached for the entity, if any.
+	Invalidate(ctx context.Context, entity, id string) error
+}
+
+// WithCache sets the cache of the entities served by the Get methods, kept for the given duration. The methods
+// of the service mutating or deleting entities invalidate them, while the entities mutated outside of the service
+// are only refreshed once they expire. It is not used by the services scoped to a tenant or to the viewer of the
+// requests.
+func WithCache(cache Cache, ttl time.Duration) ServiceOption {
+	return func(c *Config) {
+		c.Cache = cache
+		c.CacheTTL = ttl
+	}
+}
+
+// CachedEntity unmarshals into m the entity cached for the given ID, and reports whether it was found. Failures
+// of the cache are treated as misses, so the entity is loaded from the database.
+func (c Config) CachedEntity(ctx context.Context, entity string, id any, m proto.Message) bool {
+	if c.Cache == nil {
+		return false
+	}
+	b, ok, err := c.Cache.Get(ctx, entity, fmt.Sprint(id))
+	if err != nil || !ok {
+		return false
+	}
+	return proto.Unmarshal(b, m) == nil
+}
+
+// CacheEntity caches the entity with the given ID. Failures of the cache are ignored, as the entity is loaded
+// from the database on the next miss.
+func (c Config) CacheEntity(ctx context.Context, entity string, id any, m proto.Message) {
+	if c.Cache == nil {
+		return
+	}
+	if b, err := proto.Marshal(m); err == nil {
+		_ = c.Cache.Set(ctx, entity, fmt.Sprint(id), b, c.CacheTTL)
+	}
+}
+
+// InvalidateCache removes the entities with the given IDs from the cache. Its errors are Internal status errors,
+// failing the requests that mutated the entities rather than serving stale entities.
+func (c Config) InvalidateCache(ctx context.Context, entity string, ids ...any) error {
+	if c.Cache == nil {
+		return nil
+	}
+	for _, id := range ids {
+		if err := c.Cache.Invalidate(ctx, entity, fmt.Sprint(id)); err != nil {
+			return status.Errorf(codes.Internal, "internal error: invalidating the cached %s: %s", entity, err)
+		}
+	}
+	return nil
+}
+
+// MemoryCache is a Cache holding the entities in memory, suited to services running in a single process.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryEntry
+}
+
+type memoryEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache returns an empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]memoryEntry)}
+}
+
+// Get implements Cache.
+func (c *MemoryCache) Get(_ context.Context, entity, id string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	k := entity + "/" + id
+	e, ok := c.entries[k]
+	if !ok {
+		return nil, false, nil
+	}
+	if !e.expires.IsZero() && !time.Now().Before(e.expires) {
+		delete(c.entries, k)
+		return nil, false, nil
+	}
+	return e.value, true, nil
+}
+
+// Set implements Cache.
+func (c *MemoryCache) Set(_ context.Context, entity, id string, value []byte, ttl time.Duration) error {
+	e := memoryEntry{value: value}
+	if ttl > 0 {
+		e.expires = time.Now().Add(ttl)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[entity+"/"+id] = e
+	return nil
+}
+
+// Invalidate implements Cache.
+func (c *MemoryCache) Invalidate(_ context.Context, entity, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, entity+"/"+id)
+	return nil
+}
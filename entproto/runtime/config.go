@@ -0,0 +1,193 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"entgo.io/ent/privacy"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Config holds the configuration of a generated service, set by the options passed to its constructor. Unset
+// limits fall back to the ones the service was generated with.
+type Config struct {
+	DefaultPageSize int
+	MaxPageSize     int
+	MaxBatchSize    int
+	// BatchCreateParallelism is the number of entries of the partial BatchCreate requests created concurrently,
+	// see WithBatchCreateParallelism.
+	BatchCreateParallelism int
+	// Viewer adds the viewer of a request to its context, see WithViewer.
+	Viewer ViewerFunc
+	// TracerProvider provides the tracer of the methods of the service, see WithTracerProvider.
+	TracerProvider trace.TracerProvider
+	// Recorder records the metrics of the methods of the service, see WithRecorder.
+	Recorder Recorder
+	// ErrorMapper maps the errors of the ent operations of the service, see WithErrorMapper.
+	ErrorMapper ErrorMapper
+	// PageTokenKey signs the page tokens of the List methods of the service, see WithPageTokenKey.
+	PageTokenKey []byte
+	// ReadOnly rejects the requests of the methods mutating the entities, see WithReadOnly.
+	ReadOnly bool
+	// Tenant extracts the tenant of a request, see WithTenant.
+	Tenant TenantFunc
+	// UnspecifiedEnum is the handling of the enum fields left unspecified by the requests, see
+	// WithUnspecifiedEnum.
+	UnspecifiedEnum UnspecifiedEnum
+	// Cache stores the entities served by the Get methods of the service for CacheTTL, see WithCache.
+	Cache    Cache
+	CacheTTL time.Duration
+	// Roles extracts the roles of the callers, deciding the redacted fields of the responses, see WithRoles.
+	Roles RolesFunc
+}
+
+// ServiceOption configures a generated service.
+type ServiceOption func(*Config)
+
+// ApplyConfig sets the option on the configuration c. It makes the ServiceOptions valid options of the
+// constructors of all the generated services.
+func (o ServiceOption) ApplyConfig(c *Config) {
+	o(c)
+}
+
+// ViewerFunc returns the context of a request holding its viewer, extracted from the incoming gRPC metadata of
+// the request. Its errors fail the request, and should be gRPC status errors such as Unauthenticated ones.
+type ViewerFunc func(ctx context.Context, md metadata.MD) (context.Context, error)
+
+// WithDefaultPageSize sets the page size of the List requests that do not set one.
+func WithDefaultPageSize(n int) ServiceOption {
+	return func(c *Config) {
+		c.DefaultPageSize = n
+	}
+}
+
+// WithMaxPageSize sets the maximum page size of the List requests. Larger page sizes are capped to it.
+func WithMaxPageSize(n int) ServiceOption {
+	return func(c *Config) {
+		c.MaxPageSize = n
+	}
+}
+
+// WithMaxBatchSize sets the maximum number of entries of the BatchCreate, BatchUpdate, BatchDelete and BatchGet
+// requests. Larger requests are rejected.
+func WithMaxBatchSize(n int) ServiceOption {
+	return func(c *Config) {
+		c.MaxBatchSize = n
+	}
+}
+
+// WithBatchCreateParallelism sets the number of entries of the BatchCreate requests of the services annotated with
+// entproto.PartialBatchCreate created concurrently, to cut the latency of large batches against remote databases.
+// The results of the responses keep the order of the entries. The entries are created one at a time by default.
+func WithBatchCreateParallelism(n int) ServiceOption {
+	return func(c *Config) {
+		c.BatchCreateParallelism = n
+	}
+}
+
+// WithViewer sets the function adding the viewer of the requests to their context, usually consumed by the
+// privacy policies of the ent schemas. It is only used by the services annotated with entproto.ViewerContext.
+func WithViewer(f ViewerFunc) ServiceOption {
+	return func(c *Config) {
+		c.Viewer = f
+	}
+}
+
+// WithReadOnly rejects the requests of the methods mutating the entities, such as Create, Update or Import, with
+// the PermissionDenied code like the services generated with entproto.ReadOnly, e.g. to serve the entities from a read replica or during a maintenance.
+func WithReadOnly() ServiceOption {
+	return func(c *Config) {
+		c.ReadOnly = true
+	}
+}
+
+// NewConfig returns the configuration set by the given options.
+func NewConfig(opts ...ServiceOption) Config {
+	var c Config
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// PageSizes returns the default and maximum page sizes of the List requests, falling back to def and max. The
+// default page size is capped to the maximum one.
+func (c Config) PageSizes(def, max int) (int, int) {
+	if c.MaxPageSize > 0 {
+		max = c.MaxPageSize
+	}
+	if c.DefaultPageSize > 0 {
+		def = c.DefaultPageSize
+	}
+	if def > max {
+		def = max
+	}
+	return def, max
+}
+
+// BatchSize returns the maximum number of entries of the batch requests, falling back to max.
+func (c Config) BatchSize(max int) int {
+	if c.MaxBatchSize > 0 {
+		return c.MaxBatchSize
+	}
+	return max
+}
+
+// Parallelism returns the number of entries of the partial BatchCreate requests created concurrently, at least 1.
+func (c Config) Parallelism() int {
+	if c.BatchCreateParallelism > 1 {
+		return c.BatchCreateParallelism
+	}
+	return 1
+}
+
+// Writable returns the ReadOnlyError of the named service if it is read-only, and nil otherwise.
+func (c Config) Writable(service string) error {
+	if c.ReadOnly {
+		return ReadOnlyError(service)
+	}
+	return nil
+}
+
+// ReadOnlyError returns the PermissionDenied status error rejecting the requests of the methods mutating the
+// entities of the named read-only service, see WithReadOnly and entproto.ReadOnly.
+func ReadOnlyError(service string) error {
+	return status.Errorf(codes.PermissionDenied, "%s is read-only", service)
+}
+
+// ViewerContext returns the context of a request holding its viewer, or ctx if no viewer function is set.
+func (c Config) ViewerContext(ctx context.Context) (context.Context, error) {
+	if c.Viewer == nil {
+		return ctx, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	return c.Viewer(ctx, md)
+}
+
+// PrivacyError returns a PermissionDenied status error if err was caused by an ent privacy rule denying the
+// request, and err otherwise. The errors of the ent operations of the generated services are mapped when they
+// are converted to status errors, see Config.MapError, and it maps the ones returned as is, e.g. by the hooks.
+func PrivacyError(err error) error {
+	if errors.Is(err, privacy.Deny) {
+		return status.Errorf(codes.PermissionDenied, "permission denied: %s", err)
+	}
+	return err
+}
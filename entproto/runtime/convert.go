@@ -0,0 +1,33 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import "fmt"
+
+// Integer is the set of the integer types of ent fields and protobuf values.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// ConvertInt converts the protobuf value v to the integer type T of an ent field, such as int8 or uint16,
+// whose values are a subset of those of the protobuf type. It fails if v is out of the range of T, instead
+// of silently truncating it.
+func ConvertInt[T, V Integer](v V) (T, error) {
+	t := T(v)
+	if V(t) != v || (t < 0) != (v < 0) {
+		return t, fmt.Errorf("value %d is out of the range of %T", v, t)
+	}
+	return t, nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// EncodeCursor returns the page token of a keyset cursor, made of the value of the pagination key of an entity
+// and its ID.
+func EncodeCursor(value, id any) (string, error) {
+	data, err := json.Marshal([]any{value, id})
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// DecodeCursor decodes a page token returned by EncodeCursor into the values pointed to by value and id.
+func DecodeCursor(token string, value, id any) error {
+	data, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return err
+	}
+	var parts []json.RawMessage
+	if err := json.Unmarshal(data, &parts); err != nil {
+		return err
+	}
+	if len(parts) != 2 {
+		return errors.New("entproto: cursor must hold a value and an id")
+	}
+	if err := json.Unmarshal(parts[0], value); err != nil {
+		return err
+	}
+	return json.Unmarshal(parts[1], id)
+}
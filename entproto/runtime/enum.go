@@ -0,0 +1,52 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import "errors"
+
+// UnspecifiedEnum is the handling of the enum fields of the Create and Update requests set to the zero value of
+// their proto enum, which proto3 does not tell apart from an unset field. It is either the UNSPECIFIED value
+// generated for the fields without a default value or, for the other ones, the value mapped to their default.
+// The fields annotated with entproto.MapZeroValue are not affected.
+type UnspecifiedEnum int
+
+const (
+	// UnspecifiedEnumDefault applies the default value of the fields, and rejects the requests with the
+	// InvalidArgument code for the fields without one. It is the handling of the services without the
+	// WithUnspecifiedEnum option.
+	UnspecifiedEnumDefault UnspecifiedEnum = iota
+	// UnspecifiedEnumReject rejects the requests with the InvalidArgument code, requiring the clients to set the
+	// fields explicitly. Note that the value mapped to the default of a field cannot be set then.
+	UnspecifiedEnumReject
+	// UnspecifiedEnumKeep leaves the fields unchanged by Update requests, and applies their default value in Create
+	// requests as UnspecifiedEnumDefault does.
+	UnspecifiedEnumKeep
+)
+
+// WithUnspecifiedEnum sets the handling of the enum fields left unspecified by the Create and Update requests.
+func WithUnspecifiedEnum(h UnspecifiedEnum) ServiceOption {
+	return func(c *Config) {
+		c.UnspecifiedEnum = h
+	}
+}
+
+// errUnspecifiedEnum is the violation of the enum fields rejected as unspecified.
+var errUnspecifiedEnum = errors.New("enum value is unspecified")
+
+// UnspecifiedEnumError returns the InvalidArgument status error rejecting a request that leaves the enum field
+// unspecified, holding the violation of the field. The field is named after its path in the request.
+func UnspecifiedEnumError(field string) error {
+	return FieldViolation(field, errUnspecifiedEnum)
+}
@@ -0,0 +1,137 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"errors"
+	"regexp"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/privacy"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorMapper maps the errors of the ent operations of a generated service, such as the errors of the ent hooks
+// or domain errors, to gRPC status errors. It returns nil for the errors it does not map, which are mapped to the
+// codes the service defaults to, e.g. NotFound for the ent not found errors and Internal for unknown errors.
+type ErrorMapper func(error) error
+
+// WithErrorMapper sets the function mapping the errors of the ent operations of the service to status errors,
+// consulted before the default mapping of the service.
+func WithErrorMapper(f ErrorMapper) ServiceOption {
+	return func(c *Config) {
+		c.ErrorMapper = f
+	}
+}
+
+// MapError returns the status error the ErrorMapper of the service maps err to or, if it does not map it, a status
+// error with the given code and message. Errors of ent privacy rules are mapped to PermissionDenied, see mapError.
+func (c Config) MapError(err error, code codes.Code, format string, args ...any) error {
+	if mapped := c.mapError(err); mapped != nil {
+		return mapped
+	}
+	return status.Errorf(code, format, args...)
+}
+
+// mapError returns the status error the ErrorMapper of the service maps err to, or nil if it does not map it.
+// Errors of ent privacy rules denying the request, which the ErrorMapper does not map, are PermissionDenied
+// status errors.
+func (c Config) mapError(err error) error {
+	if c.ErrorMapper != nil {
+		if mapped := c.ErrorMapper(err); mapped != nil {
+			return mapped
+		}
+	}
+	if errors.Is(err, privacy.Deny) {
+		return status.Errorf(codes.PermissionDenied, "permission denied: %s", err)
+	}
+	return nil
+}
+
+// FieldViolation returns an InvalidArgument status error for the validation error err of the given field of a
+// request, holding a google.rpc.BadRequest detail with the violation of the field, so that clients can map the
+// error to the field. The field is named after its path in the request, e.g. "user.user_name".
+func FieldViolation(field string, err error) error {
+	s := status.Newf(codes.InvalidArgument, "invalid argument: %s", err)
+	ds, derr := s.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: err.Error()},
+		},
+	})
+	if derr != nil {
+		return s.Err()
+	}
+	return ds.Err()
+}
+
+// ErrorDomain is the domain of the google.rpc.ErrorInfo details of the errors of the generated services.
+const ErrorDomain = "entgo.io"
+
+// The reasons of the google.rpc.ErrorInfo details of the constraint errors, see Config.ConstraintError.
+const (
+	ReasonUniqueConstraint     = "UNIQUE_CONSTRAINT"
+	ReasonForeignKeyConstraint = "FOREIGN_KEY_CONSTRAINT"
+	ReasonCheckConstraint      = "CHECK_CONSTRAINT"
+)
+
+// ConstraintError returns the status error the ErrorMapper of the service maps the database constraint error err
+// to or, if it does not map it, a status error classified by the kind of the violated constraint: AlreadyExists
+// for unique constraints, FailedPrecondition for foreign keys and InvalidArgument for the others, such as check
+// constraints. The error holds a google.rpc.ErrorInfo detail with the reason of the violation and, if not empty,
+// the field of the request violating the constraint in its "field" metadata.
+func (c Config) ConstraintError(err error, field string) error {
+	if mapped := c.mapError(err); mapped != nil {
+		return mapped
+	}
+	var s *status.Status
+	info := &errdetails.ErrorInfo{Domain: ErrorDomain}
+	switch {
+	case sqlgraph.IsUniqueConstraintError(err):
+		s, info.Reason = status.Newf(codes.AlreadyExists, "already exists: %s", err), ReasonUniqueConstraint
+	case sqlgraph.IsForeignKeyConstraintError(err):
+		s, info.Reason = status.Newf(codes.FailedPrecondition, "failed precondition: %s", err), ReasonForeignKeyConstraint
+	default:
+		s, info.Reason = status.Newf(codes.InvalidArgument, "invalid argument: %s", err), ReasonCheckConstraint
+	}
+	if field != "" {
+		info.Metadata = map[string]string{"field": field}
+	}
+	ds, derr := s.WithDetails(info)
+	if derr != nil {
+		return s.Err()
+	}
+	return ds.Err()
+}
+
+// constraintColumns match the column of the constraint violated by an error of the database.
+var constraintColumns = []*regexp.Regexp{
+	regexp.MustCompile(`UNIQUE constraint failed: \w+\.(\w+)`),              // SQLite
+	regexp.MustCompile("Duplicate entry '.*' for key '(?:\\w+\\.)?(\\w+)'"), // MySQL
+	regexp.MustCompile("FOREIGN KEY \\(`(\\w+)`\\)"),                        // MySQL
+}
+
+// ConstraintColumn returns the column of the constraint violated by the database constraint error err, or an
+// empty string if the database does not report it. The columns of composite constraints are reported by their
+// first column.
+func ConstraintColumn(err error) string {
+	for _, re := range constraintColumns {
+		if m := re.FindStringSubmatch(err.Error()); m != nil {
+			return m[1]
+		}
+	}
+	return ""
+}
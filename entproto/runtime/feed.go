@@ -0,0 +1,106 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"log"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// WatchBufferSize is the number of events buffered for each subscriber of a Feed. Subscribers falling
+// further behind are dropped.
+const WatchBufferSize = 256
+
+// Feed fans out the events published by the mutation hooks of the generated Watch methods to their
+// subscribers. The zero value is ready to use.
+type Feed struct {
+	mu   sync.Mutex
+	subs map[chan proto.Message]struct{}
+}
+
+// Subscribe registers a new subscriber to the feed. The returned channel receives the published events
+// until cancel is called, and is closed if the subscriber does not keep up with them.
+func (f *Feed) Subscribe() (events <-chan proto.Message, cancel func()) {
+	ch := make(chan proto.Message, WatchBufferSize)
+	f.mu.Lock()
+	if f.subs == nil {
+		f.subs = make(map[chan proto.Message]struct{})
+	}
+	f.subs[ch] = struct{}{}
+	f.mu.Unlock()
+	return ch, func() {
+		f.mu.Lock()
+		delete(f.subs, ch)
+		f.mu.Unlock()
+	}
+}
+
+// Watched reports whether the feed has subscribers. Hooks use it to skip building events no one receives.
+func (f *Feed) Watched() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.subs) > 0
+}
+
+// Publish sends e to all subscribers of the feed without blocking. Subscribers whose buffer is full are
+// dropped, and their channel is closed.
+func (f *Feed) Publish(e proto.Message) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for ch := range f.subs {
+		select {
+		case ch <- e:
+		default:
+			delete(f.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// feedKey identifies the feed of the events of a service of a client, see FeedOf.
+type feedKey struct {
+	client  any
+	service string
+}
+
+var (
+	feedsMu sync.Mutex
+	feeds   = make(map[feedKey]*Feed)
+)
+
+// FeedOf returns the feed of the events of the named service of the client, shared by all the instances of the
+// service created with the client. The first call for a client and a service calls register with the new feed,
+// which registers the hook publishing the events of the client to it, so the client runs a single hook however
+// many instances of the service it serves.
+func FeedOf(client any, service string, register func(*Feed)) *Feed {
+	feedsMu.Lock()
+	defer feedsMu.Unlock()
+	k := feedKey{client: client, service: service}
+	if f, ok := feeds[k]; ok {
+		return f
+	}
+	f := &Feed{}
+	feeds[k] = f
+	register(f)
+	return f
+}
+
+// DropEvents logs the error preventing the events of a committed mutation of the named service from being
+// published. The events are dropped, as the mutation they report has succeeded.
+func DropEvents(service string, err error) {
+	log.Printf("entproto: dropping the events of %s: %v", service, err)
+}
@@ -0,0 +1,38 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldChanged reports whether the named field is set in req to a value that differs from its value in cur.
+// A field holding its zero value in req is considered unset. Both messages must be of the same type.
+func FieldChanged(req, cur proto.Message, name string) bool {
+	r, c := req.ProtoReflect(), cur.ProtoReflect()
+	fd := r.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if fd == nil || !r.Has(fd) {
+		return false
+	}
+	// Compare messages holding only the named field, so that lists, maps and
+	// nested messages are compared by value.
+	a, b := r.Type().New(), c.Type().New()
+	a.Set(fd, r.Get(fd))
+	if c.Has(fd) {
+		b.Set(fd, c.Get(fd))
+	}
+	return !proto.Equal(a.Interface(), b.Interface())
+}
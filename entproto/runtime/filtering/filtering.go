@@ -0,0 +1,372 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package filtering parses AIP-160 filter expressions, such as `status = "active" AND points > 10`, into ent
+// predicates. The List methods generated by protoc-gen-entgrpc for the schemas annotated with
+// entproto.FilterExpression parse the filter field of their requests with it.
+//
+// The supported subset of the grammar is made of restrictions comparing a field to a value, combined with AND,
+// OR, NOT and parentheses. As in AIP-160, OR binds tighter than AND, and restrictions separated by whitespace
+// only are combined with AND:
+//
+//	status = "active" AND (points > 10 OR banned = false)
+//	NOT user_name : "bot" created_at >= "2022-01-01T00:00:00Z"
+//
+// The comparators are =, !=, <, <=, >, >= and the : (has) comparator, which matches the strings containing the
+// value. Values are quoted strings or bare literals, and timestamps are written in RFC 3339 format. Traversals,
+// functions and global restrictions, such as a value without a field, are not supported.
+package filtering
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Type is the type of the values of a field.
+type Type uint8
+
+// The types of the values of the fields.
+const (
+	String Type = iota + 1
+	Int
+	Uint
+	Float
+	Bool
+	Time
+)
+
+// Field describes a field that can be referenced by filter expressions.
+type Field struct {
+	// Column is the name of the column of the field.
+	Column string
+	// Type is the type of the values of the field.
+	Type Type
+}
+
+// Fields maps the names of the fields referenced by filter expressions, usually the names of the fields of the
+// protobuf message, to their description.
+type Fields map[string]Field
+
+// maxDepth is the maximum nesting depth of the parentheses and NOT operators of an expression.
+const maxDepth = 32
+
+// Parse parses the filter expression s into a predicate on the columns of the given fields, for example
+// predicate.User(p). It returns a nil predicate if the expression is empty.
+func Parse(s string, fields Fields) (func(*sql.Selector), error) {
+	tokens, err := lex(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	p := &parser{tokens: tokens, fields: fields}
+	cond, err := p.expression(0)
+	if err != nil {
+		return nil, err
+	}
+	if t, ok := p.peek(); ok {
+		return nil, fmt.Errorf("entproto: invalid filter: unexpected %q", t.text)
+	}
+	return func(s *sql.Selector) {
+		s.Where(cond(s))
+	}, nil
+}
+
+type (
+	// token is a lexical token of a filter expression.
+	token struct {
+		kind tokenKind
+		text string
+	}
+	tokenKind uint8
+	// cond builds the predicate of a (sub) expression on the columns of the selector.
+	cond func(*sql.Selector) *sql.Predicate
+)
+
+const (
+	// tokenText is a bare word or literal, such as a field name, a keyword or a number.
+	tokenText tokenKind = iota + 1
+	// tokenString is a quoted string, whose text is unquoted.
+	tokenString
+	tokenComparator
+	tokenMinus
+	tokenLParen
+	tokenRParen
+)
+
+// lex splits the filter expression s into its tokens.
+func lex(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case unicode.IsSpace(rune(c)):
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")"})
+			i++
+		case c == '"' || c == '\'':
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(s) && s[j] != c; j++ {
+				if s[j] == '\\' && j+1 < len(s) {
+					j++
+				}
+				b.WriteByte(s[j])
+			}
+			if j == len(s) {
+				return nil, fmt.Errorf("entproto: invalid filter: unterminated string %s", s[i:])
+			}
+			tokens = append(tokens, token{kind: tokenString, text: b.String()})
+			i = j + 1
+		case strings.IndexByte("=!<>:", c) >= 0:
+			op := string(c)
+			if i+1 < len(s) && s[i+1] == '=' && c != '=' && c != ':' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, fmt.Errorf("entproto: invalid filter: unexpected %q", op)
+			}
+			tokens = append(tokens, token{kind: tokenComparator, text: op})
+			i += len(op)
+		case c == '-' && (i+1 == len(s) || !isNumeric(s[i+1])):
+			tokens = append(tokens, token{kind: tokenMinus, text: "-"})
+			i++
+		default:
+			j := i + 1
+			for j < len(s) && !unicode.IsSpace(rune(s[j])) && strings.IndexByte("()\"'=!<>:", s[j]) < 0 {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenText, text: s[i:j]})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+func isNumeric(c byte) bool {
+	return c >= '0' && c <= '9' || c == '.'
+}
+
+// parser is a recursive descent parser of the tokens of a filter expression.
+type parser struct {
+	tokens []token
+	pos    int
+	fields Fields
+}
+
+func (p *parser) peek() (token, bool) {
+	if p.pos == len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *parser) next() (token, bool) {
+	t, ok := p.peek()
+	if ok {
+		p.pos++
+	}
+	return t, ok
+}
+
+// keyword reports whether the next token is the given keyword, and consumes it if so.
+func (p *parser) keyword(k string) bool {
+	if t, ok := p.peek(); ok && t.kind == tokenText && t.text == k {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// expression parses a sequence of sequences joined with AND.
+func (p *parser) expression(depth int) (cond, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("entproto: invalid filter: expression is nested more than %d levels deep", maxDepth)
+	}
+	var conds []cond
+	for {
+		c, err := p.sequence(depth)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, c)
+		if !p.keyword("AND") {
+			return and(conds), nil
+		}
+	}
+}
+
+// sequence parses factors separated by whitespace only, which are joined with AND.
+func (p *parser) sequence(depth int) (cond, error) {
+	var conds []cond
+	for {
+		c, err := p.factor(depth)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, c)
+		t, ok := p.peek()
+		if !ok || t.kind == tokenRParen || t.kind == tokenText && t.text == "AND" {
+			return and(conds), nil
+		}
+	}
+}
+
+// factor parses terms joined with OR.
+func (p *parser) factor(depth int) (cond, error) {
+	var conds []cond
+	for {
+		c, err := p.term(depth)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, c)
+		if !p.keyword("OR") {
+			break
+		}
+	}
+	if len(conds) == 1 {
+		return conds[0], nil
+	}
+	return func(s *sql.Selector) *sql.Predicate {
+		ps := make([]*sql.Predicate, len(conds))
+		for i, c := range conds {
+			ps[i] = c(s)
+		}
+		return sql.Or(ps...)
+	}, nil
+}
+
+// term parses a simple expression, optionally negated with NOT or -.
+func (p *parser) term(depth int) (cond, error) {
+	if t, ok := p.peek(); ok && (t.kind == tokenMinus || t.kind == tokenText && t.text == "NOT") {
+		p.pos++
+		if depth+1 > maxDepth {
+			return nil, fmt.Errorf("entproto: invalid filter: expression is nested more than %d levels deep", maxDepth)
+		}
+		c, err := p.term(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return func(s *sql.Selector) *sql.Predicate {
+			return sql.Not(c(s))
+		}, nil
+	}
+	t, ok := p.next()
+	switch {
+	case !ok:
+		return nil, fmt.Errorf("entproto: invalid filter: unexpected end of expression")
+	case t.kind == tokenLParen:
+		c, err := p.expression(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if t, ok := p.next(); !ok || t.kind != tokenRParen {
+			return nil, fmt.Errorf("entproto: invalid filter: missing closing parenthesis")
+		}
+		return c, nil
+	case t.kind == tokenText && !isKeyword(t.text):
+		return p.restriction(t.text)
+	default:
+		return nil, fmt.Errorf("entproto: invalid filter: unexpected %q", t.text)
+	}
+}
+
+// restriction parses the comparator and the value of a restriction on the field name.
+func (p *parser) restriction(name string) (cond, error) {
+	f, ok := p.fields[name]
+	if !ok {
+		return nil, fmt.Errorf("entproto: invalid filter: unknown field %q", name)
+	}
+	op, ok := p.next()
+	if !ok || op.kind != tokenComparator {
+		return nil, fmt.Errorf("entproto: invalid filter: field %q must be followed by a comparator", name)
+	}
+	arg, ok := p.next()
+	if !ok || arg.kind != tokenText && arg.kind != tokenString || arg.kind == tokenText && isKeyword(arg.text) {
+		return nil, fmt.Errorf("entproto: invalid filter: missing value of field %q", name)
+	}
+	v, err := f.value(arg.text)
+	if err != nil {
+		return nil, fmt.Errorf("entproto: invalid filter: invalid value %q of field %q: %w", arg.text, name, err)
+	}
+	var pred func(string, any) *sql.Predicate
+	switch op.text {
+	case "=":
+		pred = sql.EQ
+	case "!=":
+		pred = sql.NEQ
+	case "<", "<=", ">", ">=":
+		if f.Type == Bool {
+			return nil, fmt.Errorf("entproto: invalid filter: field %q does not support the %s comparator", name, op.text)
+		}
+		pred = map[string]func(string, any) *sql.Predicate{"<": sql.LT, "<=": sql.LTE, ">": sql.GT, ">=": sql.GTE}[op.text]
+	case ":":
+		if f.Type != String {
+			return nil, fmt.Errorf("entproto: invalid filter: field %q does not support the : comparator", name)
+		}
+		return func(s *sql.Selector) *sql.Predicate {
+			return sql.Contains(s.C(f.Column), arg.text)
+		}, nil
+	}
+	return func(s *sql.Selector) *sql.Predicate {
+		return pred(s.C(f.Column), v)
+	}, nil
+}
+
+// value parses the value of a restriction on the field.
+func (f Field) value(s string) (any, error) {
+	switch f.Type {
+	case Int:
+		return strconv.ParseInt(s, 10, 64)
+	case Uint:
+		return strconv.ParseUint(s, 10, 64)
+	case Float:
+		return strconv.ParseFloat(s, 64)
+	case Bool:
+		return strconv.ParseBool(s)
+	case Time:
+		return time.Parse(time.RFC3339Nano, s)
+	default:
+		return s, nil
+	}
+}
+
+func isKeyword(s string) bool {
+	return s == "AND" || s == "OR" || s == "NOT"
+}
+
+// and joins the conditions with AND.
+func and(conds []cond) cond {
+	if len(conds) == 1 {
+		return conds[0]
+	}
+	return func(s *sql.Selector) *sql.Predicate {
+		ps := make([]*sql.Predicate, len(conds))
+		for i, c := range conds {
+			ps[i] = c(s)
+		}
+		return sql.And(ps...)
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtering
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/require"
+)
+
+var fields = Fields{
+	"name":       {Column: "name", Type: String},
+	"points":     {Column: "points", Type: Int},
+	"exp":        {Column: "exp", Type: Uint},
+	"score":      {Column: "score", Type: Float},
+	"banned":     {Column: "banned", Type: Bool},
+	"created_at": {Column: "created_at", Type: Time},
+}
+
+// where returns the WHERE clause and the arguments of the query filtered by the expression.
+func where(t *testing.T, expr string) (string, []any) {
+	p, err := Parse(expr, fields)
+	require.NoError(t, err)
+	s := sql.Dialect(dialect.SQLite).Select("*").From(sql.Table("users"))
+	if p != nil {
+		p(s)
+	}
+	query, args := s.Query()
+	_, clause, _ := strings.Cut(query, " WHERE ")
+	return clause, args
+}
+
+func TestParse(t *testing.T) {
+	created := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		expr  string
+		where string
+		args  []any
+	}{
+		{"", "", nil},
+		{"   ", "", nil},
+		// Comparators.
+		{`name = "a8m"`, "`users`.`name` = ?", []any{"a8m"}},
+		{`name != "a8m"`, "`users`.`name` <> ?", []any{"a8m"}},
+		{"points < 10", "`users`.`points` < ?", []any{int64(10)}},
+		{"points <= 10", "`users`.`points` <= ?", []any{int64(10)}},
+		{"points > -10", "`users`.`points` > ?", []any{int64(-10)}},
+		{"points >= 10", "`users`.`points` >= ?", []any{int64(10)}},
+		{`name : "8"`, "`users`.`name` LIKE ?", []any{"%8%"}},
+		{"exp = 10", "`users`.`exp` = ?", []any{uint64(10)}},
+		{"score > 1.5", "`users`.`score` > ?", []any{1.5}},
+		{"banned = true", "`users`.`banned`", nil},
+		{"banned != true", "NOT `users`.`banned`", nil},
+		{`created_at >= "2022-01-01T00:00:00Z"`, "`users`.`created_at` >= ?", []any{created}},
+		// OR binds tighter than AND, and whitespace joins with AND.
+		{
+			"points > 1 AND points < 5 OR name = x",
+			"`users`.`points` > ? AND (`users`.`points` < ? OR `users`.`name` = ?)",
+			[]any{int64(1), int64(5), "x"},
+		},
+		{
+			"points > 1 OR points < 5 AND name = x",
+			"(`users`.`points` > ? OR `users`.`points` < ?) AND `users`.`name` = ?",
+			[]any{int64(1), int64(5), "x"},
+		},
+		{
+			"points > 1 points < 5 OR name = x",
+			"`users`.`points` > ? AND (`users`.`points` < ? OR `users`.`name` = ?)",
+			[]any{int64(1), int64(5), "x"},
+		},
+		{
+			"(points > 1 AND points < 5) OR name = x",
+			"(`users`.`points` > ? AND `users`.`points` < ?) OR `users`.`name` = ?",
+			[]any{int64(1), int64(5), "x"},
+		},
+		// NOT and - negate the following term only.
+		{"NOT name = x", "NOT (`users`.`name` = ?)", []any{"x"}},
+		{"-name = x", "NOT (`users`.`name` = ?)", []any{"x"}},
+		{"- name = x", "NOT (`users`.`name` = ?)", []any{"x"}},
+		{"NOT NOT name = x", "NOT (NOT (`users`.`name` = ?))", []any{"x"}},
+		{
+			"NOT name = x OR points = 1",
+			"(NOT (`users`.`name` = ?)) OR `users`.`points` = ?",
+			[]any{"x", int64(1)},
+		},
+		{
+			"-(name = x OR points = 1)",
+			"NOT (`users`.`name` = ? OR `users`.`points` = ?)",
+			[]any{"x", int64(1)},
+		},
+		// Strings are quoted with double or single quotes, and escaped with backslashes.
+		{`name = 'a8m'`, "`users`.`name` = ?", []any{"a8m"}},
+		{`name = a8m`, "`users`.`name` = ?", []any{"a8m"}},
+		{`name = "a \"quoted\" name"`, "`users`.`name` = ?", []any{`a "quoted" name`}},
+		{`name = 'it\'s'`, "`users`.`name` = ?", []any{"it's"}},
+		{`name = "back\\slash"`, "`users`.`name` = ?", []any{`back\slash`}},
+		{`name = "AND OR NOT (x)"`, "`users`.`name` = ?", []any{"AND OR NOT (x)"}},
+		{`name = ""`, "`users`.`name` = ?", []any{""}},
+		{`points = "10"`, "`users`.`points` = ?", []any{int64(10)}},
+	} {
+		t.Run(tt.expr, func(t *testing.T) {
+			where, args := where(t, tt.expr)
+			require.Equal(t, tt.where, where)
+			require.Equal(t, tt.args, args)
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	for _, tt := range []struct {
+		expr string
+		err  string
+	}{
+		// Syntax errors.
+		{`name = "a8m`, "unterminated string"},
+		{"name", `field "name" must be followed by a comparator`},
+		{"name =", `missing value of field "name"`},
+		{"name = AND", `missing value of field "name"`},
+		{"name ! a8m", `unexpected "!"`},
+		{"(name = a8m", "missing closing parenthesis"},
+		{"name = a8m)", `unexpected ")"`},
+		{"name = a8m AND", "unexpected end of expression"},
+		{"name = a8m OR", "unexpected end of expression"},
+		{"NOT", "unexpected end of expression"},
+		{"AND name = a8m", `unexpected "AND"`},
+		{`"a8m"`, `unexpected "a8m"`},
+		// Unknown fields.
+		{"email = a8m", `unknown field "email"`},
+		{"name = a8m OR email = a8m", `unknown field "email"`},
+		// Type mismatches.
+		{"points = ten", `invalid value "ten" of field "points"`},
+		{"points = 1.5", `invalid value "1.5" of field "points"`},
+		{"exp = -1", `invalid value "-1" of field "exp"`},
+		{"score = high", `invalid value "high" of field "score"`},
+		{"banned = yes", `invalid value "yes" of field "banned"`},
+		{"banned > false", `field "banned" does not support the > comparator`},
+		{`created_at = "2022-01-01"`, `invalid value "2022-01-01" of field "created_at"`},
+		{"points : 1", `field "points" does not support the : comparator`},
+		{"banned : true", `field "banned" does not support the : comparator`},
+	} {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := Parse(tt.expr, fields)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestParse_MaxDepth(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		expr func(depth int) string
+	}{
+		{"parentheses", func(depth int) string {
+			return strings.Repeat("(", depth) + "banned = true" + strings.Repeat(")", depth)
+		}},
+		{"NOT", func(depth int) string {
+			return strings.Repeat("NOT ", depth) + "banned = true"
+		}},
+		{"minus", func(depth int) string {
+			return strings.Repeat("-", depth) + "banned = true"
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.expr(maxDepth), fields)
+			require.NoError(t, err)
+			_, err = Parse(tt.expr(maxDepth+1), fields)
+			require.EqualError(t, err, "entproto: invalid filter: expression is nested more than 32 levels deep")
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// IdempotencyKeyHeader is the metadata key holding the idempotency key of the Create requests of the services
+// annotated with entproto.IdempotencyKey.
+const IdempotencyKeyHeader = "x-idempotency-key"
+
+// IdempotencyKey returns the idempotency key of a request, read from its incoming IdempotencyKeyHeader metadata,
+// or an empty string if it is not set.
+func IdempotencyKey(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get(IdempotencyKeyHeader); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
@@ -0,0 +1,63 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Recorder records the metrics of the methods of the services generated with the metrics=true option of
+// protoc-gen-entgrpc. Services are named after their full gRPC name, e.g. "entpb.UserService", and methods
+// after their gRPC name, e.g. "Get". See the metrics package for a Prometheus implementation.
+type Recorder interface {
+	// RecordRequest records a request served by the method in d, with the given status code.
+	RecordRequest(service, method string, code codes.Code, d time.Duration)
+	// RecordBatchSize records the number of entries of a request of a batch method.
+	RecordBatchSize(service, method string, n int)
+	// RecordPageSize records the page size a request of a List method is served with.
+	RecordPageSize(service, method string, n int)
+}
+
+// WithRecorder sets the recorder of the metrics of the methods of the service.
+func WithRecorder(r Recorder) ServiceOption {
+	return func(c *Config) {
+		c.Recorder = r
+	}
+}
+
+// RecordRequest records a request of the method served since start, with the status code of err. It returns err.
+func (c Config) RecordRequest(service, method string, start time.Time, err error) error {
+	if c.Recorder != nil {
+		c.Recorder.RecordRequest(service, method, status.Code(err), time.Since(start))
+	}
+	return err
+}
+
+// RecordBatchSize records the number of entries of a request of a batch method.
+func (c Config) RecordBatchSize(service, method string, n int) {
+	if c.Recorder != nil {
+		c.Recorder.RecordBatchSize(service, method, n)
+	}
+}
+
+// RecordPageSize records the page size a request of a List method is served with.
+func (c Config) RecordPageSize(service, method string, n int) {
+	if c.Recorder != nil {
+		c.Recorder.RecordPageSize(service, method, n)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics provides implementations of the runtime.Recorder interface, recording the metrics of the
+// services generated by protoc-gen-entgrpc.
+package metrics
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+// PrometheusRecorder is a runtime.Recorder exporting the following Prometheus metrics, labeled by the service
+// and method of the requests:
+//
+//	entgrpc_requests_total            counter of the served requests
+//	entgrpc_errors_total              counter of the failed requests, also labeled by their status code
+//	entgrpc_request_duration_seconds  histogram of the latencies of the requests
+//	entgrpc_batch_size                histogram of the number of entries of the batch requests
+//	entgrpc_page_size                 histogram of the page sizes of the List requests
+type PrometheusRecorder struct {
+	requests   *prometheus.CounterVec
+	errors     *prometheus.CounterVec
+	durations  *prometheus.HistogramVec
+	batchSizes *prometheus.HistogramVec
+	pageSizes  *prometheus.HistogramVec
+}
+
+var _ runtime.Recorder = (*PrometheusRecorder)(nil)
+
+// NewPrometheusRecorder returns a PrometheusRecorder whose metrics are registered with reg, usually
+// prometheus.DefaultRegisterer.
+func NewPrometheusRecorder(reg prometheus.Registerer) (*PrometheusRecorder, error) {
+	labels := []string{"service", "method"}
+	sizes := prometheus.ExponentialBuckets(1, 2, 11)
+	r := &PrometheusRecorder{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "entgrpc_requests_total",
+			Help: "Number of requests served by the generated services.",
+		}, labels),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "entgrpc_errors_total",
+			Help: "Number of requests failed by the generated services, by status code.",
+		}, append(labels, "code")),
+		durations: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "entgrpc_request_duration_seconds",
+			Help:    "Latency of the requests served by the generated services.",
+			Buckets: prometheus.DefBuckets,
+		}, labels),
+		batchSizes: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "entgrpc_batch_size",
+			Help:    "Number of entries of the batch requests.",
+			Buckets: sizes,
+		}, labels),
+		pageSizes: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "entgrpc_page_size",
+			Help:    "Page size of the List requests.",
+			Buckets: sizes,
+		}, labels),
+	}
+	for _, c := range []prometheus.Collector{r.requests, r.errors, r.durations, r.batchSizes, r.pageSizes} {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// RecordRequest implements runtime.Recorder.
+func (r *PrometheusRecorder) RecordRequest(service, method string, code codes.Code, d time.Duration) {
+	r.requests.WithLabelValues(service, method).Inc()
+	if code != codes.OK {
+		r.errors.WithLabelValues(service, method, code.String()).Inc()
+	}
+	r.durations.WithLabelValues(service, method).Observe(d.Seconds())
+}
+
+// RecordBatchSize implements runtime.Recorder.
+func (r *PrometheusRecorder) RecordBatchSize(service, method string, n int) {
+	r.batchSizes.WithLabelValues(service, method).Observe(float64(n))
+}
+
+// RecordPageSize implements runtime.Recorder.
+func (r *PrometheusRecorder) RecordPageSize(service, method string, n int) {
+	r.pageSizes.WithLabelValues(service, method).Observe(float64(n))
+}
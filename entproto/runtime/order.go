@@ -0,0 +1,60 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OrderTerm is a single term of an order_by expression.
+type OrderTerm struct {
+	// Field is the name of the protobuf field to order by.
+	Field string
+	// Desc reports whether the results are ordered in descending order.
+	Desc bool
+}
+
+// ParseOrderBy parses an AIP-132 style order_by expression, a comma separated list of field names, each
+// optionally followed by "asc" or "desc". For example: "priority desc, name". Fields are ordered ascending
+// by default and must not be repeated.
+func ParseOrderBy(s string) ([]OrderTerm, error) {
+	var (
+		terms []OrderTerm
+		seen  = make(map[string]bool)
+	)
+	for _, t := range strings.Split(s, ",") {
+		parts := strings.Fields(t)
+		if len(parts) == 0 || len(parts) > 2 {
+			return nil, fmt.Errorf("entproto: invalid order_by term %q", strings.TrimSpace(t))
+		}
+		term := OrderTerm{Field: parts[0]}
+		if len(parts) == 2 {
+			switch strings.ToLower(parts[1]) {
+			case "asc":
+			case "desc":
+				term.Desc = true
+			default:
+				return nil, fmt.Errorf("entproto: invalid order_by direction %q of field %q", parts[1], term.Field)
+			}
+		}
+		if seen[term.Field] {
+			return nil, fmt.Errorf("entproto: order_by field %q is repeated", term.Field)
+		}
+		seen[term.Field] = true
+		terms = append(terms, term)
+	}
+	return terms, nil
+}
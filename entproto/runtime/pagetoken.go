@@ -0,0 +1,69 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WithPageTokenKey sets the key signing the page tokens of the List requests with HMAC-SHA256. The tokens are
+// bound to the method returning them, and the requests with tampered tokens, or tokens of another method, are
+// rejected with the InvalidArgument code. Changing the key invalidates the tokens returned before.
+func WithPageTokenKey(key []byte) ServiceOption {
+	return func(c *Config) {
+		c.PageTokenKey = key
+	}
+}
+
+// SignPageToken returns the page token returned by the method, signed with the page token key of the config if
+// set, and token otherwise.
+func (c Config) SignPageToken(method, token string) string {
+	if len(c.PageTokenKey) == 0 || token == "" {
+		return token
+	}
+	return token + "." + base64.RawURLEncoding.EncodeToString(c.pageTokenMAC(method, token))
+}
+
+// VerifyPageToken returns the page token sent to the method, stripped of its signature. It fails with the
+// InvalidArgument code if the config has a page token key and the token was not signed with it for the method.
+func (c Config) VerifyPageToken(method, token string) (string, error) {
+	if len(c.PageTokenKey) == 0 || token == "" {
+		return token, nil
+	}
+	i := strings.LastIndexByte(token, '.')
+	if i < 0 {
+		return "", status.Error(codes.InvalidArgument, "page token is invalid")
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(token[i+1:])
+	if err != nil || !hmac.Equal(mac, c.pageTokenMAC(method, token[:i])) {
+		return "", status.Error(codes.InvalidArgument, "page token is invalid")
+	}
+	return token[:i], nil
+}
+
+// pageTokenMAC returns the HMAC of the page token of the method.
+func (c Config) pageTokenMAC(method, token string) []byte {
+	h := hmac.New(sha256.New, c.PageTokenKey)
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write([]byte(token))
+	return h.Sum(nil)
+}
@@ -0,0 +1,138 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// RecordFormat is the encoding of the records streamed by the generated Export and Import methods.
+// Its values match the values of the Format enum of the generated requests.
+type RecordFormat int32
+
+const (
+	// FormatProtoDelimited encodes each record as a protobuf message prefixed with its varint-encoded size.
+	FormatProtoDelimited RecordFormat = iota + 1
+	// FormatNDJSON encodes each record as a protojson object followed by a newline.
+	FormatNDJSON
+)
+
+// Valid reports whether f is a known record format.
+func (f RecordFormat) Valid() bool {
+	return f == FormatProtoDelimited || f == FormatNDJSON
+}
+
+// AppendRecord appends the encoding of m in format f to b.
+func AppendRecord(b []byte, f RecordFormat, m proto.Message) ([]byte, error) {
+	switch f {
+	case FormatProtoDelimited:
+		data, err := proto.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+		b = protowire.AppendVarint(b, uint64(len(data)))
+		return append(b, data...), nil
+	case FormatNDJSON:
+		data, err := protojson.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, data...)
+		return append(b, '\n'), nil
+	default:
+		return nil, fmt.Errorf("entproto: unknown record format %d", f)
+	}
+}
+
+// RecordReader decodes records written to it in chunks. A record may span several chunks.
+type RecordReader struct {
+	Format RecordFormat
+	buf    []byte
+	eof    bool
+}
+
+// Write appends a chunk of data to the reader.
+func (r *RecordReader) Write(p []byte) {
+	r.buf = append(r.buf, p...)
+}
+
+// Flush marks the end of the data. It makes a trailing NDJSON record that does not end with a newline
+// available to Next, which returns io.ErrUnexpectedEOF for any other incomplete record.
+func (r *RecordReader) Flush() {
+	r.eof = true
+}
+
+// Next decodes the next complete record into m. It reports false if no complete record is buffered.
+func (r *RecordReader) Next(m proto.Message) (bool, error) {
+	switch r.Format {
+	case FormatProtoDelimited:
+		return r.nextDelimited(m)
+	case FormatNDJSON:
+		return r.nextJSON(m)
+	default:
+		return false, fmt.Errorf("entproto: unknown record format %d", r.Format)
+	}
+}
+
+func (r *RecordReader) nextDelimited(m proto.Message) (bool, error) {
+	if len(r.buf) == 0 {
+		return false, nil
+	}
+	size, n := protowire.ConsumeVarint(r.buf)
+	if n < 0 {
+		if err := protowire.ParseError(n); !errors.Is(err, io.ErrUnexpectedEOF) || r.eof {
+			return false, err
+		}
+		return false, nil
+	}
+	if uint64(len(r.buf)-n) < size {
+		if r.eof {
+			return false, io.ErrUnexpectedEOF
+		}
+		return false, nil
+	}
+	if err := proto.Unmarshal(r.buf[n:n+int(size)], m); err != nil {
+		return false, err
+	}
+	r.buf = r.buf[n+int(size):]
+	return true, nil
+}
+
+func (r *RecordReader) nextJSON(m proto.Message) (bool, error) {
+	r.buf = bytes.TrimLeft(r.buf, " \t\r\n")
+	if len(r.buf) == 0 {
+		return false, nil
+	}
+	line := r.buf
+	switch i := bytes.IndexByte(r.buf, '\n'); {
+	case i >= 0:
+		line, r.buf = r.buf[:i], r.buf[i+1:]
+	case r.eof:
+		r.buf = nil
+	default:
+		return false, nil
+	}
+	if err := protojson.Unmarshal(line, m); err != nil {
+		return false, err
+	}
+	return true, nil
+}
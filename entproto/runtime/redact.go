@@ -0,0 +1,107 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Redactions maps the full names of the fields redacted from the responses of a service, such as
+// "entpb.User.ssn", to the role the callers must hold to read them. See entproto.RedactUnless.
+type Redactions map[protoreflect.FullName]string
+
+// RolesFunc returns the roles of the caller of a request, extracted from the incoming gRPC metadata of the
+// request or from its context, which holds the viewer of the request if the service has a viewer context.
+type RolesFunc func(ctx context.Context, md metadata.MD) []string
+
+// WithRoles sets the function extracting the roles of the callers, which decide the fields annotated with
+// entproto.RedactUnless the responses hold. Callers hold no role without it.
+func WithRoles(f RolesFunc) ServiceOption {
+	return func(c *Config) {
+		c.Roles = f
+	}
+}
+
+// Redact returns the response m with the fields whose role the caller does not hold cleared. m is returned as
+// is if the caller holds all the roles of the redactions, and a redacted copy of it otherwise, leaving m, which
+// may be shared with other requests, untouched.
+func Redact[M proto.Message](ctx context.Context, c Config, m M, redactions Redactions) M {
+	if len(redactions) == 0 || !m.ProtoReflect().IsValid() {
+		return m
+	}
+	held := c.heldRoles(ctx)
+	denied := make(Redactions)
+	for name, role := range redactions {
+		if !held[role] {
+			denied[name] = role
+		}
+	}
+	if len(denied) == 0 {
+		return m
+	}
+	m = proto.Clone(m).(M)
+	redact(m.ProtoReflect(), denied)
+	return m
+}
+
+// CheckRedacted returns a PermissionDenied status error if the caller of the request does not hold the role of
+// the named field redacted from the responses, such as a field compared by the filter of a request or sorted by
+// its order_by, as the results of the request would disclose its values. The roles are checked as by Redact.
+func CheckRedacted(ctx context.Context, c Config, redactions Redactions, name protoreflect.FullName) error {
+	if role, ok := redactions[name]; ok && !c.heldRoles(ctx)[role] {
+		return status.Errorf(codes.PermissionDenied, "permission denied: field %q is redacted", name.Name())
+	}
+	return nil
+}
+
+// heldRoles returns the roles held by the caller of the request, see WithRoles.
+func (c Config) heldRoles(ctx context.Context) map[string]bool {
+	held := make(map[string]bool)
+	if c.Roles != nil {
+		md, _ := metadata.FromIncomingContext(ctx)
+		for _, r := range c.Roles(ctx, md) {
+			held[r] = true
+		}
+	}
+	return held
+}
+
+// redact clears the fields of m and of its nested messages that are denied to the caller.
+func redact(m protoreflect.Message, denied Redactions) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch _, ok := denied[fd.FullName()]; {
+		case ok:
+			m.Clear(fd)
+		case fd.IsList() && fd.Message() != nil:
+			for l, i := v.List(), 0; i < l.Len(); i++ {
+				redact(l.Get(i).Message(), denied)
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				redact(v.Message(), denied)
+				return true
+			})
+		case fd.Message() != nil:
+			redact(v.Message(), denied)
+		}
+		return true
+	})
+}
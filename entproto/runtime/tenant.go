@@ -0,0 +1,56 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TenantFunc returns the tenant of a request, extracted from the incoming gRPC metadata of the request. Its
+// errors fail the request, and should be gRPC status errors such as Unauthenticated ones.
+type TenantFunc func(ctx context.Context, md metadata.MD) (string, error)
+
+// WithTenant sets the function extracting the tenant of the requests. It is only used by the services annotated
+// with entproto.Tenant, which reject all the requests without it.
+func WithTenant(f TenantFunc) ServiceOption {
+	return func(c *Config) {
+		c.Tenant = f
+	}
+}
+
+// TenantHeader returns a TenantFunc reading the tenant of the requests from the given metadata key. Requests
+// without it are rejected with the Unauthenticated code.
+func TenantHeader(key string) TenantFunc {
+	return func(_ context.Context, md metadata.MD) (string, error) {
+		if v := md.Get(key); len(v) > 0 && v[0] != "" {
+			return v[0], nil
+		}
+		return "", status.Errorf(codes.Unauthenticated, "missing tenant: metadata %q is not set", key)
+	}
+}
+
+// TenantID returns the tenant of a request, extracted by the tenant function of the config. Services scoped to
+// a tenant without a tenant function fail with the Internal code, rather than serving all the tenants.
+func (c Config) TenantID(ctx context.Context) (string, error) {
+	if c.Tenant == nil {
+		return "", status.Error(codes.Internal, "internal error: no tenant function, see runtime.WithTenant")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	return c.Tenant(ctx, md)
+}
@@ -0,0 +1,100 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/status"
+)
+
+// TracerName is the name of the tracer creating the spans of the generated services.
+const TracerName = "entgo.io/contrib/entproto"
+
+// The attributes set on the spans of the generated services.
+const (
+	// EntityKey is the ent type served by the method.
+	EntityKey = attribute.Key("ent.entity")
+	// IDKey is the ID of the entity read or written by the method.
+	IDKey = attribute.Key("ent.id")
+	// RowsKey is the number of entities read or written by the method.
+	RowsKey = attribute.Key("ent.rows")
+)
+
+// The events added to the spans of the generated services, marking the start of the phases of a method.
+const (
+	// QueryEvent marks the start of the database operations of the method.
+	QueryEvent = "ent.query"
+	// ConvertEvent marks the start of the conversion of the ent entities to their proto messages.
+	ConvertEvent = "ent.convert"
+)
+
+// WithTracerProvider makes the service trace its methods with a tracer of the given provider. Each method is
+// traced by a span named after its full gRPC name, e.g. "entpb.UserService/Get", holding the ent type served by
+// the method and, depending on the method, the ID of the entity or the number of entities it reads or writes.
+// The QueryEvent and ConvertEvent events of the span mark the phases of the method.
+func WithTracerProvider(tp trace.TracerProvider) ServiceOption {
+	return func(c *Config) {
+		c.TracerProvider = tp
+	}
+}
+
+// StartSpan starts the span of the method of a generated service serving the given ent type. The span is not
+// recorded if no tracer provider is set.
+func (c Config) StartSpan(ctx context.Context, method, entity string) (context.Context, trace.Span) {
+	tp := c.TracerProvider
+	if tp == nil {
+		tp = trace.NewNoopTracerProvider()
+	}
+	return tp.Tracer(TracerName).Start(ctx, method,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(EntityKey.String(entity)),
+	)
+}
+
+// EndSpan ends the span of a method, recording the error the method failed with, if any. It returns err.
+func EndSpan(span trace.Span, err error) error {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, status.Convert(err).Message())
+	}
+	span.End()
+	return err
+}
+
+// TraceID sets the ID of the entity read or written by a method on its span.
+func TraceID(ctx context.Context, id any) {
+	trace.SpanFromContext(ctx).SetAttributes(IDKey.String(fmt.Sprint(id)))
+}
+
+// TraceRows sets the number of entities read or written by a method on its span.
+func TraceRows(ctx context.Context, n int) {
+	trace.SpanFromContext(ctx).SetAttributes(RowsKey.Int(n))
+}
+
+// TraceQuery marks the start of the database operations of a method on its span.
+func TraceQuery(ctx context.Context) {
+	trace.SpanFromContext(ctx).AddEvent(QueryEvent)
+}
+
+// TraceConvert marks the start of the conversion of the ent entities of a method to their proto messages on
+// its span.
+func TraceConvert(ctx context.Context) {
+	trace.SpanFromContext(ctx).AddEvent(ConvertEvent)
+}
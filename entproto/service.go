@@ -17,10 +17,14 @@ package entproto
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/schema"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	"github.com/mitchellh/mapstructure"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/descriptorpb"
 	_ "google.golang.org/protobuf/types/known/emptypb"
 )
@@ -33,6 +37,15 @@ const (
 	// MaxBatchCreateSize is the maximum number of entries that can be created by a single BatchCreate call. Requests
 	// exceeding this batch size will return an error.
 	MaxBatchCreateSize = 1000
+	// MaxBatchUpdateSize is the maximum number of entries that can be updated by a single BatchUpdate call. Requests
+	// exceeding this batch size will return an error.
+	MaxBatchUpdateSize = 1000
+	// MaxBatchDeleteSize is the maximum number of IDs that can be deleted by a single BatchDelete call. Requests
+	// exceeding this batch size will return an error.
+	MaxBatchDeleteSize = 1000
+	// MaxBatchGetSize is the maximum number of IDs that can be requested by a single BatchGet call. Requests
+	// exceeding this batch size will return an error.
+	MaxBatchGetSize = 1000
 	// MethodCreate generates a Create gRPC service method for the entproto.Service.
 	MethodCreate Method = 1 << iota
 	// MethodGet generates a Get gRPC service method for the entproto.Service.
@@ -45,12 +58,95 @@ const (
 	MethodList
 	// MethodBatchCreate generates a Batch Create gRPC service method for the entproto.Service.
 	MethodBatchCreate
+	// MethodStats generates a Stats gRPC service method for the entproto.Service, returning the number of rows and
+	// the distribution of the values of the enum and bool fields of the schema. It is intended for admin tooling and
+	// is not included in MethodAll.
+	MethodStats
+	// MethodExport generates a server-streaming Export gRPC service method for the entproto.Service, streaming all
+	// rows of the schema as length-delimited protobuf or NDJSON records. It is not included in MethodAll.
+	MethodExport
+	// MethodImport generates a client-streaming Import gRPC service method for the entproto.Service, creating rows
+	// from the records produced by the Export method. It is not included in MethodAll.
+	MethodImport
+	// MethodBatchUpdate generates a Batch Update gRPC service method for the entproto.Service, updating all entries
+	// of the request in a single transaction. It is not included in MethodAll.
+	MethodBatchUpdate
+	// MethodBatchDelete generates a Batch Delete gRPC service method for the entproto.Service, deleting the entries
+	// matching a list of IDs or a filter. It is not included in MethodAll.
+	MethodBatchDelete
+	// MethodBatchGet generates a Batch Get gRPC service method for the entproto.Service, returning the entries
+	// matching a list of IDs along with the IDs that were not found. It is not included in MethodAll.
+	MethodBatchGet
+	// MethodUpsert generates an Upsert gRPC service method for the entproto.Service, creating an entry or updating
+	// the one with the same key. See UpsertKey. It requires the sql/upsert feature of ent and is not included in
+	// MethodAll.
+	MethodUpsert
+	// MethodCount generates a Count gRPC service method for the entproto.Service, returning the number of entries
+	// matching a filter. It is not included in MethodAll.
+	MethodCount
+	// MethodExists generates an Exists gRPC service method for the entproto.Service, reporting whether an entry
+	// exists given its ID or the value of one of its unique fields. It is not included in MethodAll.
+	MethodExists
+	// MethodGetByUnique generates a Get<T>By<Field> gRPC service method for the entproto.Service per unique field
+	// of the schema with a scalar protobuf type, e.g. GetUserByUserName. It is not included in MethodAll.
+	MethodGetByUnique
+	// MethodStreamList generates a server-streaming Stream<T>s gRPC service method for the entproto.Service,
+	// sending all entries matching a filter ordered by their IDs. It is not included in MethodAll.
+	MethodStreamList
+	// MethodWatch generates a server-streaming Watch<T> gRPC service method for the entproto.Service, sending an
+	// event for every entry created, updated or deleted through the ent client of the service. The events are
+	// produced by a mutation hook registered by the generated service. It is not included in MethodAll.
+	MethodWatch
+	// MethodAggregate generates an Aggregate<T> gRPC service method for the entproto.Service, returning the count,
+	// sum, average, minimum and maximum of a numeric field, optionally grouped by the values of another field.
+	// It is not included in MethodAll.
+	MethodAggregate
+	// MethodDeleteWhere generates a Delete<T>s gRPC service method for the entproto.Service, deleting all entries
+	// matching a filter and returning their number. It is not included in MethodAll.
+	MethodDeleteWhere
+	// MethodRestore generates a Restore<T> gRPC service method for the entproto.Service, restoring a soft-deleted
+	// entry given its ID. It requires a SoftDelete field and is not included in MethodAll.
+	MethodRestore
+	// MethodEdges generates List<T><Edge>, Add<T><Edge> and Remove<T><Edge> gRPC service methods for the
+	// entproto.Service per non-unique edge of the schema, e.g. ListUserPets, AddUserPet and RemoveUserPet,
+	// managing the IDs of the edge without updating the entity. It is not included in MethodAll.
+	MethodEdges
 	// MethodAll generates all service methods for the entproto.Service. This is the same behavior as not including entproto.Methods.
 	MethodAll = MethodCreate | MethodGet | MethodUpdate | MethodDelete | MethodList | MethodBatchCreate
 )
 
 var (
 	errNoServiceDef = errors.New("entproto: annotation entproto.Service missing")
+	// allMethods lists the service methods in the order they are generated.
+	allMethods = []Method{MethodCreate, MethodGet, MethodGetByUnique, MethodExists, MethodUpdate, MethodDelete, MethodDeleteWhere, MethodRestore, MethodList, MethodStreamList, MethodCount, MethodAggregate, MethodBatchCreate, MethodUpsert, MethodBatchGet, MethodBatchUpdate, MethodBatchDelete, MethodStats, MethodExport, MethodImport, MethodWatch, MethodEdges}
+	// readMethods are the methods served by the read service of the schemas annotated with SplitReadWrite.
+	readMethods = MethodGet | MethodGetByUnique | MethodExists | MethodList | MethodStreamList | MethodCount |
+		MethodAggregate | MethodBatchGet | MethodStats | MethodExport | MethodWatch
+	// methodNames maps the service methods to the names of their generated RPCs.
+	methodNames = map[Method]string{
+		MethodCreate:      "Create",
+		MethodGet:         "Get",
+		MethodUpdate:      "Update",
+		MethodDelete:      "Delete",
+		MethodList:        "List",
+		MethodBatchCreate: "BatchCreate",
+		MethodBatchUpdate: "BatchUpdate",
+		MethodBatchDelete: "BatchDelete",
+		MethodBatchGet:    "BatchGet",
+		MethodUpsert:      "Upsert",
+		MethodCount:       "Count",
+		MethodExists:      "Exists",
+		MethodGetByUnique: "GetByUnique",
+		MethodStreamList:  "StreamList",
+		MethodWatch:       "Watch",
+		MethodAggregate:   "Aggregate",
+		MethodDeleteWhere: "DeleteWhere",
+		MethodRestore:     "Restore",
+		MethodEdges:       "Edges",
+		MethodStats:       "Stats",
+		MethodExport:      "Export",
+		MethodImport:      "Import",
+	}
 )
 
 type Method uint
@@ -58,6 +154,24 @@ type Method uint
 // Is reports whether method m matches given method n.
 func (m Method) Is(n Method) bool { return m&n != 0 }
 
+// SplitReadWrite generates two services for the schema instead of one: <T>ReadService, serving the methods
+// reading the entries of the schema (such as Get, List and BatchGet), and <T>WriteService, serving the methods
+// mutating them (such as Create, Update and Delete) along with the extra methods of the service. Separate services
+// let deployments apply different authorization, load-balancing and rollout policies to reads and writes.
+func SplitReadWrite() ServiceOption {
+	return func(s *service) {
+		s.SplitReadWrite = true
+	}
+}
+
+// Comment sets the leading comment of the generated service, documenting it in the generated docs and client
+// stubs. Schemas annotated with SplitReadWrite set it on both services. Multi-line comments are supported.
+func Comment(comment string) ServiceOption {
+	return func(s *service) {
+		s.Comment = comment
+	}
+}
+
 // Methods specifies the gRPC service methods to generate for the entproto.Service.
 func Methods(methods Method) ServiceOption {
 	return func(s *service) {
@@ -65,15 +179,191 @@ func Methods(methods Method) ServiceOption {
 	}
 }
 
+// WithMethodOptions sets proto options on the generated service methods matching m. For example, the following
+// annotation marks the Get and List methods as free of side effects, so clients may safely retry them, and adds a
+// flattened "id" signature to the Get method:
+//
+//	entproto.Service(
+//		entproto.WithMethodOptions(entproto.MethodGet|entproto.MethodList,
+//			entproto.IdempotencyLevel(descriptorpb.MethodOptions_NO_SIDE_EFFECTS),
+//		),
+//		entproto.WithMethodOptions(entproto.MethodGet, entproto.MethodSignature("id")),
+//	)
+func WithMethodOptions(m Method, opts ...MethodOption) ServiceOption {
+	return func(s *service) {
+		for _, method := range allMethods {
+			if !m.Is(method) {
+				continue
+			}
+			name := methodNames[method]
+			if s.MethodOptions == nil {
+				s.MethodOptions = make(map[string]*methodOptions)
+			}
+			if s.MethodOptions[name] == nil {
+				s.MethodOptions[name] = &methodOptions{}
+			}
+			for _, apply := range opts {
+				apply(s.MethodOptions[name])
+			}
+		}
+	}
+}
+
+// MethodOption configures the proto options of a generated service method.
+type MethodOption func(*methodOptions)
+
+// MethodSignature adds a google.api.method_signature option to the method, listing the request fields client
+// generators should expose as the arguments of a flattened helper method. The option may be added more than
+// once to define several signatures.
+func MethodSignature(fields ...string) MethodOption {
+	return func(o *methodOptions) {
+		o.Signatures = append(o.Signatures, strings.Join(fields, ","))
+	}
+}
+
+// IdempotencyLevel sets the idempotency_level option of the method.
+func IdempotencyLevel(level descriptorpb.MethodOptions_IdempotencyLevel) MethodOption {
+	return func(o *methodOptions) {
+		o.IdempotencyLevel = level
+	}
+}
+
+// MethodComment sets the leading comment of the method in the generated .proto file, documenting it in the
+// generated docs and client stubs. Multi-line comments are supported. For example:
+//
+//	entproto.Service(
+//		entproto.WithMethodOptions(entproto.MethodDelete,
+//			entproto.MethodComment("Delete removes the user and its pets."),
+//		),
+//	)
+func MethodComment(comment string) MethodOption {
+	return func(o *methodOptions) {
+		o.Comment = comment
+	}
+}
+
+type methodOptions struct {
+	Signatures       []string
+	IdempotencyLevel descriptorpb.MethodOptions_IdempotencyLevel
+	// Comment is the leading comment of the method, see MethodComment.
+	Comment string
+	// OpenAPIOperation is the protoc-gen-openapiv2 operation of the method, see OpenAPIOperation.
+	OpenAPIOperation *openAPIOption
+}
+
+// descriptor returns the proto options of the method, or nil if no option is set.
+func (o *methodOptions) descriptor() (*descriptorpb.MethodOptions, error) {
+	if o == nil || (len(o.Signatures) == 0 && o.IdempotencyLevel == descriptorpb.MethodOptions_IDEMPOTENCY_UNKNOWN && o.OpenAPIOperation == nil) {
+		return nil, nil
+	}
+	opts := &descriptorpb.MethodOptions{}
+	if o.IdempotencyLevel != descriptorpb.MethodOptions_IDEMPOTENCY_UNKNOWN {
+		opts.IdempotencyLevel = o.IdempotencyLevel.Enum()
+	}
+	if len(o.Signatures) > 0 {
+		proto.SetExtension(opts, annotations.E_MethodSignature, o.Signatures)
+	}
+	if o.OpenAPIOperation != nil {
+		op := &options.Operation{}
+		if err := o.OpenAPIOperation.decode(op); err != nil {
+			return nil, err
+		}
+		proto.SetExtension(opts, options.E_Openapiv2Operation, op)
+	}
+	return opts, nil
+}
+
+// MessageNames overrides the names of the request and response messages generated for the service method m,
+// for example, to match an existing API contract when retrofitting entproto onto it. An empty name keeps the
+// generated one. For example, the following renames the request of the Get method to UserLookupRequest:
+//
+//	entproto.Service(
+//		entproto.MessageNames(entproto.MethodGet, "UserLookupRequest", ""),
+//	)
+//
+// The response can only be renamed for methods responding with a message of their own, and not with the
+// entity itself.
+func MessageNames(m Method, request, response string) ServiceOption {
+	return func(s *service) {
+		for _, method := range allMethods {
+			if !m.Is(method) {
+				continue
+			}
+			if s.MessageNames == nil {
+				s.MessageNames = make(map[string]*messageNames)
+			}
+			s.MessageNames[methodNames[method]] = &messageNames{
+				Request:  request,
+				Response: response,
+			}
+		}
+	}
+}
+
+type messageNames struct {
+	Request  string
+	Response string
+}
+
 type service struct {
-	Generate bool
-	Methods  Method
+	Generate      bool
+	Methods       Method
+	MethodOptions map[string]*methodOptions
+	// MessageNames holds the overridden message names of the methods, see MessageNames.
+	MessageNames map[string]*messageNames
+	UpsertKey    []string
+	// DefaultPageSize and MaxPageSize are the page sizes of the List method, see PageSize.
+	DefaultPageSize int
+	MaxPageSize     int
+	// PaginationKey is the field paging the List method, see PaginationKey.
+	PaginationKey string
+	// ExtraMethods holds the custom methods of the service, see ExtraMethod.
+	ExtraMethods []*extraMethod
+	// SoftDelete is the field marking the entries as deleted, see SoftDelete.
+	SoftDelete string
+	// Etag is the field versioning the entries, see Etag.
+	Etag string
+	// SplitReadWrite reports whether the read and write methods are served by separate services.
+	SplitReadWrite bool
+	// ReadMask reports whether the Get and List requests select the returned fields, see ReadMask.
+	ReadMask bool
+	// UpdateMask reports whether the Update requests select the updated fields, see UpdateMask.
+	UpdateMask bool
+	// ViewerContext reports whether the methods add the viewer of the requests to their context, see
+	// ViewerContext.
+	ViewerContext bool
+	// Tenant is the field holding the tenant of the entries, see Tenant.
+	Tenant string
+	// IdempotencyKey is the field storing the idempotency keys of the Create requests, see IdempotencyKey.
+	IdempotencyKey string
+	// TotalSize reports whether the List responses hold the total number of entries, capped to MaxTotalSize
+	// if set. See TotalSize.
+	TotalSize    bool
+	MaxTotalSize int
+	// PartialBatchCreate reports whether the BatchCreate method creates the entries one by one, see
+	// PartialBatchCreate.
+	PartialBatchCreate bool
+	// DeleteReturnsEntity reports whether the Delete method returns the deleted entity, see DeleteReturnsEntity.
+	DeleteReturnsEntity bool
+	// FilterExpression reports whether the filter of the List method is an AIP-160 filter expression, see
+	// FilterExpression.
+	FilterExpression bool
+	// ReadOnly reports whether the generated service rejects the methods mutating the entries, see ReadOnly.
+	ReadOnly bool
+	// Comment is the leading comment of the services, see Comment.
+	Comment string
 }
 
 func (service) Name() string {
 	return ServiceAnnotation
 }
 
+// Merge implements schema.Merger. Like entproto.Message, the last declared annotation wins, so an
+// entproto.Service annotation on a schema overrides the one declared on its mixins.
+func (service) Merge(other schema.Annotation) schema.Annotation {
+	return other
+}
+
 // ServiceOption configures the entproto.Service annotation.
 type ServiceOption func(svc *service)
 
@@ -92,29 +382,159 @@ func Service(opts ...ServiceOption) schema.Annotation {
 	return s
 }
 
-func (a *Adapter) createServiceResources(genType *gen.Type, methods Method) (serviceResources, error) {
+func (a *Adapter) createServiceResources(genType *gen.Type, svcAnnot *service) (serviceResources, error) {
 	name := genType.Name
 	serviceFqn := fmt.Sprintf("%sService", name)
 
+	svc := &descriptorpb.ServiceDescriptorProto{
+		Name: &serviceFqn,
+	}
 	out := serviceResources{
-		svc: &descriptorpb.ServiceDescriptorProto{
-			Name: &serviceFqn,
-		},
+		svcs:     []*descriptorpb.ServiceDescriptorProto{svc},
+		comments: make(map[string]string),
+	}
+	// Schemas annotated with SplitReadWrite serve the read methods from a service of their own, and the
+	// write methods, along with the extra methods, from the other.
+	readSvc := svc
+	if svcAnnot.SplitReadWrite {
+		readSvc = &descriptorpb.ServiceDescriptorProto{
+			Name: strptr(fmt.Sprintf("%sReadService", name)),
+		}
+		svc.Name = strptr(fmt.Sprintf("%sWriteService", name))
+		out.svcs = []*descriptorpb.ServiceDescriptorProto{readSvc, svc}
+	}
+
+	if _, err := TenantField(genType); err != nil {
+		return serviceResources{}, err
+	}
+	if _, err := IdempotencyKeyField(genType); err != nil {
+		return serviceResources{}, err
+	}
+	if svcAnnot.Comment != "" {
+		for _, d := range out.svcs {
+			out.comments[d.GetName()] = protoComment(svcAnnot.Comment)
+		}
 	}
 
-	for _, m := range []Method{MethodCreate, MethodGet, MethodUpdate, MethodDelete, MethodList, MethodBatchCreate} {
-		if !methods.Is(m) {
+	var renamed []string
+	for _, m := range allMethods {
+		if !svcAnnot.Methods.Is(m) {
 			continue
 		}
 
-		resources, err := a.genMethodProtos(genType, m)
+		var methods []methodResources
+		switch m {
+		case MethodGetByUnique:
+			// MethodGetByUnique generates a method per unique field of the schema.
+			all, err := a.genGetByUniqueProtos(genType)
+			if err != nil {
+				return serviceResources{}, err
+			}
+			methods = all
+		case MethodEdges:
+			// MethodEdges generates three methods per non-unique edge of the schema.
+			all, err := a.genEdgeProtos(genType)
+			if err != nil {
+				return serviceResources{}, err
+			}
+			methods = all
+		default:
+			resources, err := a.genMethodProtos(genType, m)
+			if err != nil {
+				return serviceResources{}, err
+			}
+			methods = append(methods, resources)
+		}
+		if names := svcAnnot.MessageNames[methodNames[m]]; names != nil {
+			if len(methods) != 1 {
+				return serviceResources{}, fmt.Errorf("entproto: cannot rename the messages of method %q of schema %q, "+
+					"generating %d service methods", methodNames[m], genType.Name, len(methods))
+			}
+			if err := methods[0].rename(names); err != nil {
+				return serviceResources{}, fmt.Errorf("entproto: schema %q: %w", genType.Name, err)
+			}
+			renamed = append(renamed, names.Request, names.Response)
+		}
+		for _, resources := range methods {
+			target := svc
+			// The List<T><Edge> methods of MethodEdges only read the edges.
+			if readMethods.Is(m) || m == MethodEdges && strings.HasPrefix(resources.methodDescriptor.GetName(), "List") {
+				target = readSvc
+			}
+			if opts := svcAnnot.MethodOptions[methodNames[m]]; opts != nil {
+				methodOpts, err := opts.descriptor()
+				if err != nil {
+					return serviceResources{}, fmt.Errorf("entproto: schema %q: %w", genType.Name, err)
+				}
+				resources.methodDescriptor.Options = methodOpts
+				if len(opts.Signatures) > 0 {
+					out.deps = append(out.deps, "google/api/client.proto")
+				}
+				if opts.OpenAPIOperation != nil {
+					out.deps = append(out.deps, openAPIOptionsPath)
+				}
+				if opts.Comment != "" {
+					out.comments[target.GetName()+"."+resources.methodDescriptor.GetName()] = protoComment(opts.Comment)
+				}
+			}
+			out.deps = append(out.deps, resources.deps...)
+			target.Method = append(target.Method, resources.methodDescriptor)
+			out.svcMessages = append(out.svcMessages, resources.messages...)
+			for name, c := range resources.comments {
+				out.comments[name] = c
+			}
+		}
+	}
+	svcMessages := make(map[string]bool, len(out.svcMessages))
+	for _, msg := range out.svcMessages {
+		svcMessages[msg.GetName()] = true
+	}
+	for _, m := range svcAnnot.ExtraMethods {
+		md, deps, err := a.extraMethodDescriptor(genType, m, svcMessages)
 		if err != nil {
-			return serviceResources{}, err
+			return serviceResources{}, fmt.Errorf("entproto: schema %q: %w", genType.Name, err)
+		}
+		for _, existing := range append(readSvc.Method, svc.Method...) {
+			if existing.GetName() == md.GetName() {
+				return serviceResources{}, fmt.Errorf("entproto: extra method %q of schema %q collides with "+
+					"another method of the service", md.GetName(), genType.Name)
+			}
+		}
+		svc.Method = append(svc.Method, md)
+		if m.Comment != "" {
+			out.comments[svc.GetName()+"."+m.Name] = protoComment(m.Comment)
+		}
+		out.deps = append(out.deps, deps...)
+		a.imports = append(a.imports, m.Imports...)
+	}
+	// Renamed messages must not collide with the other messages of the service, which would be
+	// silently merged by dedupeServiceMessages.
+	for _, name := range renamed {
+		if name == "" {
+			continue
+		}
+		var count int
+		for _, msg := range out.svcMessages {
+			if msg.GetName() == name {
+				count++
+			}
+		}
+		if count > 1 {
+			return serviceResources{}, fmt.Errorf("entproto: renamed message %q of schema %q collides with "+
+				"another message of the service", name, genType.Name)
 		}
-		out.svc.Method = append(out.svc.Method, resources.methodDescriptor)
-		out.svcMessages = append(out.svcMessages, resources.messages...)
 	}
 	out.svcMessages = dedupeServiceMessages(out.svcMessages)
+	if svcAnnot.SplitReadWrite {
+		// Leave out the split services without methods.
+		svcs := out.svcs[:0]
+		for _, svc := range out.svcs {
+			if len(svc.Method) > 0 {
+				svcs = append(svcs, svc)
+			}
+		}
+		out.svcs = svcs
+	}
 
 	return out, nil
 }
@@ -123,7 +543,7 @@ var plural = gen.Funcs["plural"].(func(string) string)
 
 func (a *Adapter) genMethodProtos(genType *gen.Type, m Method) (methodResources, error) {
 	input := &descriptorpb.DescriptorProto{}
-	idField, err := toProtoFieldDescriptor(genType.ID)
+	idField, err := a.toProtoFieldDescriptor(genType.ID)
 	if err != nil {
 		return methodResources{}, err
 	}
@@ -143,9 +563,55 @@ func (a *Adapter) genMethodProtos(genType *gen.Type, m Method) (methodResources,
 		Type:     &protoMessageFieldType,
 		TypeName: strptr(genType.Name),
 	}
+	msgAnnot, err := extractMessageAnnotation(genType)
+	if err != nil {
+		return methodResources{}, err
+	}
+	var edgeIDsField *descriptorpb.FieldDescriptorProto
+	if msgAnnot.EdgeIDsMessage {
+		edgeIDsField = &descriptorpb.FieldDescriptorProto{
+			Name:     strptr("edge_ids"),
+			Number:   int32ptr(2),
+			Type:     &protoMessageFieldType,
+			TypeName: strptr(edgeIDsMessageName(genType)),
+		}
+	}
+	// createRequest is shared by the Create, BatchCreate and Upsert methods, and updateRequest by the Update
+	// and BatchUpdate methods.
+	createRequest := func() *descriptorpb.DescriptorProto {
+		req := &descriptorpb.DescriptorProto{
+			Name:  strptr(fmt.Sprintf("Create%sRequest", genType.Name)),
+			Field: []*descriptorpb.FieldDescriptorProto{singleMessageField},
+		}
+		if edgeIDsField != nil {
+			req.Field = append(req.Field, edgeIDsField)
+		}
+		return req
+	}
+	updateMask, err := HasUpdateMask(genType)
+	if err != nil {
+		return methodResources{}, err
+	}
+	updateRequest := func() *descriptorpb.DescriptorProto {
+		req := &descriptorpb.DescriptorProto{
+			Name:  strptr(fmt.Sprintf("Update%sRequest", genType.Name)),
+			Field: []*descriptorpb.FieldDescriptorProto{singleMessageField},
+		}
+		if edgeIDsField != nil {
+			req.Field = append(req.Field, edgeIDsField)
+		}
+		if updateMask {
+			req.Field = append(req.Field, updateMaskField(3))
+		}
+		return req
+	}
 	var (
-		outputName, methodName string
-		messages               []*descriptorpb.DescriptorProto
+		outputName, methodName           string
+		messages                         []*descriptorpb.DescriptorProto
+		deps                             []string
+		serverStreaming, clientStreaming bool
+		// comments holds the leading comments of the fields of the messages, keyed by "<message>.<field>".
+		comments map[string]string
 	)
 	switch m {
 	case MethodGet:
@@ -160,40 +626,98 @@ func (a *Adapter) genMethodProtos(genType *gen.Type, m Method) (methodResources,
 				TypeName: strptr("View"),
 			},
 		}
-		input.EnumType = append(input.EnumType, &descriptorpb.EnumDescriptorProto{
-			Name: strptr("View"),
-			Value: []*descriptorpb.EnumValueDescriptorProto{
-				{Number: int32ptr(0), Name: strptr("VIEW_UNSPECIFIED")},
-				{Number: int32ptr(1), Name: strptr("BASIC")},
-				{Number: int32ptr(2), Name: strptr("WITH_EDGE_IDS")},
-			},
-		})
+		input.EnumType = append(input.EnumType, viewEnum())
+		readMask, err := HasReadMask(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+		if readMask {
+			input.Field = append(input.Field, readMaskField(3))
+			deps = append(deps, wktsPaths["google.protobuf.FieldMask"])
+		}
+		softDelete, err := SoftDeleteField(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+		if softDelete != nil {
+			boolFieldType := descriptorpb.FieldDescriptorProto_TYPE_BOOL
+			input.Field = append(input.Field, &descriptorpb.FieldDescriptorProto{
+				Name:   strptr("include_deleted"),
+				Number: int32ptr(4),
+				Type:   &boolFieldType,
+			})
+			comments = map[string]string{
+				input.GetName() + ".include_deleted": fmt.Sprintf(" Includes the entry if its %s is set.\n", softDelete.Name),
+			}
+		}
 		outputName = genType.Name
 		messages = append(messages, input)
+		if edgeIDsField != nil {
+			outputName = fmt.Sprintf("Get%sResponse", genType.Name)
+			output := &descriptorpb.DescriptorProto{
+				Name:  &outputName,
+				Field: []*descriptorpb.FieldDescriptorProto{singleMessageField, edgeIDsField},
+			}
+			messages = append(messages, output)
+		}
 	case MethodCreate:
 		methodName = "Create"
-		input.Name = strptr(fmt.Sprintf("Create%sRequest", genType.Name))
-		input.Field = []*descriptorpb.FieldDescriptorProto{singleMessageField}
+		input = createRequest()
 		outputName = genType.Name
 		messages = append(messages, input)
 	case MethodUpdate:
 		methodName = "Update"
-		input.Name = strptr(fmt.Sprintf("Update%sRequest", genType.Name))
-		input.Field = []*descriptorpb.FieldDescriptorProto{singleMessageField}
+		input = updateRequest()
 		outputName = genType.Name
 		messages = append(messages, input)
+		if updateMask {
+			deps = append(deps, wktsPaths["google.protobuf.FieldMask"])
+		}
 	case MethodDelete:
 		methodName = "Delete"
 		input.Name = strptr(fmt.Sprintf("Delete%sRequest", genType.Name))
 		input.Field = []*descriptorpb.FieldDescriptorProto{idField}
+		etag, err := EtagField(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+		if etag != nil {
+			fd, err := a.toProtoFieldDescriptor(etag)
+			if err != nil {
+				return methodResources{}, err
+			}
+			// The options of the field, such as its default value, only apply to the entity message.
+			fd.Number, fd.Options = int32ptr(2), nil
+			input.Field = append(input.Field, fd)
+			comments = map[string]string{
+				input.GetName() + "." + fd.GetName(): " The stored version of the entry, the request is aborted if it does not match.\n",
+			}
+		}
 		outputName = "google.protobuf.Empty"
+		returnsEntity, err := HasDeleteReturnsEntity(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+		if returnsEntity {
+			outputName = genType.Name
+		}
 		messages = append(messages, input)
 	case MethodList:
-		if !(genType.ID.Type.Type.Integer() || genType.ID.IsUUID() || genType.ID.IsString()) {
-			return methodResources{}, fmt.Errorf("entproto: list method does not support schema %q id type %q",
-				genType.Name, genType.ID.Type.String())
+		if err := verifyCursorID(genType, "list"); err != nil {
+			return methodResources{}, err
 		}
 
+		def, max, err := PageSizes(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+		if _, err := PaginationKeyField(genType); err != nil {
+			return methodResources{}, err
+		}
+		softDelete, err := SoftDeleteField(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
 		methodName = "List"
 		int32FieldType := descriptorpb.FieldDescriptorProto_TYPE_INT32
 		stringFieldType := descriptorpb.FieldDescriptorProto_TYPE_STRING
@@ -215,15 +739,16 @@ func (a *Adapter) genMethodProtos(genType *gen.Type, m Method) (methodResources,
 				Type:     &protoEnumFieldType,
 				TypeName: strptr("View"),
 			},
-		}
-		input.EnumType = append(input.EnumType, &descriptorpb.EnumDescriptorProto{
-			Name: strptr("View"),
-			Value: []*descriptorpb.EnumValueDescriptorProto{
-				{Number: int32ptr(0), Name: strptr("VIEW_UNSPECIFIED")},
-				{Number: int32ptr(1), Name: strptr("BASIC")},
-				{Number: int32ptr(2), Name: strptr("WITH_EDGE_IDS")},
+			{
+				Name:   strptr("order_by"),
+				Number: int32ptr(4),
+				Type:   &stringFieldType,
 			},
-		})
+		}
+		input.EnumType = append(input.EnumType, viewEnum())
+		comments = map[string]string{
+			input.GetName() + ".page_size": pageSizeComment(def, max),
+		}
 		outputName = fmt.Sprintf("List%sResponse", genType.Name)
 		output := &descriptorpb.DescriptorProto{
 			Name: &outputName,
@@ -242,13 +767,74 @@ func (a *Adapter) genMethodProtos(genType *gen.Type, m Method) (methodResources,
 				},
 			},
 		}
+		filterExpr, err := HasFilterExpression(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+		filter, filterDeps, err := a.toFilterMessageDescriptor(genType)
+		switch {
+		case err != nil:
+			return methodResources{}, err
+		case filterExpr:
+			input.Field = append(input.Field, &descriptorpb.FieldDescriptorProto{
+				Name:   strptr("filter"),
+				Number: int32ptr(5),
+				Type:   &stringFieldType,
+			})
+			comments[input.GetName()+".filter"] = " An AIP-160 filter expression, e.g. `name = \"foo\" AND id > 10`.\n"
+		case filter != nil:
+			input.Field = append(input.Field, &descriptorpb.FieldDescriptorProto{
+				Name:     strptr("filter"),
+				Number:   int32ptr(5),
+				Type:     &protoMessageFieldType,
+				TypeName: filter.Name,
+			})
+			messages = append(messages, filter)
+			deps = append(deps, filterDeps...)
+		}
+		if softDelete != nil {
+			boolFieldType := descriptorpb.FieldDescriptorProto_TYPE_BOOL
+			input.Field = append(input.Field, &descriptorpb.FieldDescriptorProto{
+				Name:   strptr("include_deleted"),
+				Number: int32ptr(6),
+				Type:   &boolFieldType,
+			})
+			comments[input.GetName()+".include_deleted"] = fmt.Sprintf(" Includes the entries whose %s is set.\n", softDelete.Name)
+		}
+		readMask, err := HasReadMask(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+		if readMask {
+			input.Field = append(input.Field, readMaskField(7))
+			deps = append(deps, wktsPaths["google.protobuf.FieldMask"])
+		}
+		if edgeIDsField != nil {
+			// The edge IDs are aligned by index with the entities of the list.
+			output.Field = append(output.Field, &descriptorpb.FieldDescriptorProto{
+				Name:     strptr("edge_ids"),
+				Number:   int32ptr(3),
+				Label:    &repeatedFieldLabel,
+				Type:     &protoMessageFieldType,
+				TypeName: edgeIDsField.TypeName,
+			})
+		}
+		totalSize, maxTotalSize, err := ListTotalSize(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+		if totalSize {
+			output.Field = append(output.Field, &descriptorpb.FieldDescriptorProto{
+				Name:   strptr("total_size"),
+				Number: int32ptr(4),
+				Type:   &int32FieldType,
+			})
+			comments[outputName+".total_size"] = totalSizeComment(maxTotalSize)
+		}
 		messages = append(messages, input, output)
 	case MethodBatchCreate:
 		methodName = "BatchCreate"
-		createRequest := &descriptorpb.DescriptorProto{}
-		createRequest.Name = strptr(fmt.Sprintf("Create%sRequest", genType.Name))
-		createRequest.Field = []*descriptorpb.FieldDescriptorProto{singleMessageField}
-		messages = append(messages, createRequest)
+		messages = append(messages, createRequest())
 
 		pluralEntityName := plural(genType.Name)
 		input.Name = strptr(fmt.Sprintf("BatchCreate%sRequest", pluralEntityName))
@@ -268,27 +854,632 @@ func (a *Adapter) genMethodProtos(genType *gen.Type, m Method) (methodResources,
 			Field: []*descriptorpb.FieldDescriptorProto{repeatedMessageField},
 		}
 		messages = append(messages, input, output)
+		partial, err := HasPartialBatchCreate(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+		if partial {
+			result := toBatchCreateResultDescriptor(genType)
+			output.Field = []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     strptr("results"),
+					Number:   int32ptr(1),
+					Label:    &repeatedFieldLabel,
+					Type:     &protoMessageFieldType,
+					TypeName: result.Name,
+				},
+			}
+			comments = map[string]string{
+				outputName + ".results": " The results of the requests, in the same order.\n",
+			}
+			messages = append(messages, result)
+			deps = append(deps, statusPath)
+			a.imports = append(a.imports, statusPath)
+		}
+	case MethodBatchUpdate:
+		methodName = "BatchUpdate"
+		messages = append(messages, updateRequest())
+		if updateMask {
+			deps = append(deps, wktsPaths["google.protobuf.FieldMask"])
+		}
+
+		pluralEntityName := plural(genType.Name)
+		input.Name = strptr(fmt.Sprintf("BatchUpdate%sRequest", pluralEntityName))
+		input.Field = []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:     strptr("requests"),
+				Number:   int32ptr(1),
+				Label:    &repeatedFieldLabel,
+				Type:     &protoMessageFieldType,
+				TypeName: strptr(fmt.Sprintf("Update%sRequest", genType.Name)),
+			},
+		}
+
+		outputName = fmt.Sprintf("BatchUpdate%sResponse", pluralEntityName)
+		output := &descriptorpb.DescriptorProto{
+			Name:  &outputName,
+			Field: []*descriptorpb.FieldDescriptorProto{repeatedMessageField},
+		}
+		messages = append(messages, input, output)
+	case MethodExists:
+		methodName = "Exists"
+		boolFieldType := descriptorpb.FieldDescriptorProto_TYPE_BOOL
+		input, err = a.toExistsRequestDescriptor(genType, idField)
+		if err != nil {
+			return methodResources{}, err
+		}
+		outputName = fmt.Sprintf("Exists%sResponse", genType.Name)
+		output := &descriptorpb.DescriptorProto{
+			Name: &outputName,
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:   strptr("exists"),
+					Number: int32ptr(1),
+					Type:   &boolFieldType,
+				},
+			},
+		}
+		messages = append(messages, input, output)
+	case MethodCount:
+		methodName = "Count"
+		int64FieldType := descriptorpb.FieldDescriptorProto_TYPE_INT64
+		pluralEntityName := plural(genType.Name)
+		input.Name = strptr(fmt.Sprintf("Count%sRequest", pluralEntityName))
+		filter, filterDeps, err := a.toFilterMessageDescriptor(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+		if filter != nil {
+			input.Field = append(input.Field, &descriptorpb.FieldDescriptorProto{
+				Name:     strptr("filter"),
+				Number:   int32ptr(1),
+				Type:     &protoMessageFieldType,
+				TypeName: filter.Name,
+			})
+			messages = append(messages, filter)
+			deps = append(deps, filterDeps...)
+		}
+		outputName = fmt.Sprintf("Count%sResponse", pluralEntityName)
+		output := &descriptorpb.DescriptorProto{
+			Name: &outputName,
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:   strptr("count"),
+					Number: int32ptr(1),
+					Type:   &int64FieldType,
+				},
+			},
+		}
+		messages = append(messages, input, output)
+	case MethodUpsert:
+		if _, err := UpsertKeyFields(genType); err != nil {
+			return methodResources{}, err
+		}
+		methodName = "Upsert"
+		input = createRequest()
+		input.Name = strptr(fmt.Sprintf("Upsert%sRequest", genType.Name))
+		outputName = genType.Name
+		messages = append(messages, input)
+	case MethodBatchGet:
+		methodName = "BatchGet"
+		pluralEntityName := plural(genType.Name)
+		idsField := &descriptorpb.FieldDescriptorProto{
+			Name:     strptr("ids"),
+			Number:   int32ptr(1),
+			Label:    &repeatedFieldLabel,
+			Type:     idField.Type,
+			TypeName: idField.TypeName,
+		}
+		input.Name = strptr(fmt.Sprintf("BatchGet%sRequest", pluralEntityName))
+		input.Field = []*descriptorpb.FieldDescriptorProto{
+			idsField,
+			{
+				Name:     strptr("view"),
+				Number:   int32ptr(2),
+				Type:     &protoEnumFieldType,
+				TypeName: strptr("View"),
+			},
+		}
+		input.EnumType = append(input.EnumType, viewEnum())
+		outputName = fmt.Sprintf("BatchGet%sResponse", pluralEntityName)
+		output := &descriptorpb.DescriptorProto{
+			Name: &outputName,
+			Field: []*descriptorpb.FieldDescriptorProto{
+				repeatedMessageField,
+				{
+					Name:     strptr("missing_ids"),
+					Number:   int32ptr(2),
+					Label:    &repeatedFieldLabel,
+					Type:     idField.Type,
+					TypeName: idField.TypeName,
+				},
+			},
+		}
+		if edgeIDsField != nil {
+			// The edge IDs are aligned by index with the returned entities.
+			output.Field = append(output.Field, &descriptorpb.FieldDescriptorProto{
+				Name:     strptr("edge_ids"),
+				Number:   int32ptr(3),
+				Label:    &repeatedFieldLabel,
+				Type:     &protoMessageFieldType,
+				TypeName: edgeIDsField.TypeName,
+			})
+		}
+		messages = append(messages, input, output)
+	case MethodBatchDelete:
+		methodName = "BatchDelete"
+		input.Name = strptr(fmt.Sprintf("BatchDelete%sRequest", plural(genType.Name)))
+		input.Field = []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:     strptr("ids"),
+				Number:   int32ptr(1),
+				Label:    &repeatedFieldLabel,
+				Type:     idField.Type,
+				TypeName: idField.TypeName,
+			},
+		}
+		filter, filterDeps, err := a.toFilterMessageDescriptor(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+		if filter != nil {
+			input.Field = append(input.Field, &descriptorpb.FieldDescriptorProto{
+				Name:     strptr("filter"),
+				Number:   int32ptr(2),
+				Type:     &protoMessageFieldType,
+				TypeName: filter.Name,
+			})
+			messages = append(messages, filter)
+			deps = append(deps, filterDeps...)
+		}
+		outputName = "google.protobuf.Empty"
+		messages = append(messages, input)
+	case MethodStreamList:
+		if err := verifyCursorID(genType, "stream list"); err != nil {
+			return methodResources{}, err
+		}
+		pluralEntityName := plural(genType.Name)
+		methodName = fmt.Sprintf("Stream%s", pluralEntityName)
+		serverStreaming = true
+		int32FieldType := descriptorpb.FieldDescriptorProto_TYPE_INT32
+		input.Name = strptr(fmt.Sprintf("Stream%sRequest", pluralEntityName))
+		filter, filterDeps, err := a.toFilterMessageDescriptor(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+		if filter != nil {
+			input.Field = append(input.Field, &descriptorpb.FieldDescriptorProto{
+				Name:     strptr("filter"),
+				Number:   int32ptr(1),
+				Type:     &protoMessageFieldType,
+				TypeName: filter.Name,
+			})
+			messages = append(messages, filter)
+			deps = append(deps, filterDeps...)
+		}
+		input.Field = append(input.Field, &descriptorpb.FieldDescriptorProto{
+			Name:   strptr("batch_size"),
+			Number: int32ptr(2),
+			Type:   &int32FieldType,
+		})
+		outputName = genType.Name
+		messages = append(messages, input)
+	case MethodWatch:
+		methodName = fmt.Sprintf("Watch%s", genType.Name)
+		serverStreaming = true
+		input.Name = strptr(fmt.Sprintf("Watch%sRequest", genType.Name))
+		outputName = fmt.Sprintf("%sEvent", genType.Name)
+		output := &descriptorpb.DescriptorProto{
+			Name: &outputName,
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     strptr("type"),
+					Number:   int32ptr(1),
+					Type:     &protoEnumFieldType,
+					TypeName: strptr("Type"),
+				},
+				{
+					Name:     idField.Name,
+					Number:   int32ptr(2),
+					JsonName: idField.JsonName,
+					Type:     idField.Type,
+					TypeName: idField.TypeName,
+				},
+				{
+					// The entity is not set in DELETED events.
+					Name:     strptr(snake(genType.Name)),
+					Number:   int32ptr(3),
+					Type:     &protoMessageFieldType,
+					TypeName: &genType.Name,
+				},
+			},
+			EnumType: []*descriptorpb.EnumDescriptorProto{
+				{
+					Name: strptr("Type"),
+					Value: []*descriptorpb.EnumValueDescriptorProto{
+						{Number: int32ptr(0), Name: strptr("TYPE_UNSPECIFIED")},
+						{Number: int32ptr(1), Name: strptr("CREATED")},
+						{Number: int32ptr(2), Name: strptr("UPDATED")},
+						{Number: int32ptr(3), Name: strptr("DELETED")},
+					},
+				},
+			},
+		}
+		messages = append(messages, input, output)
+	case MethodAggregate:
+		methodName = fmt.Sprintf("Aggregate%s", genType.Name)
+		int64FieldType := descriptorpb.FieldDescriptorProto_TYPE_INT64
+		stringFieldType := descriptorpb.FieldDescriptorProto_TYPE_STRING
+		input.Name = strptr(fmt.Sprintf("Aggregate%sRequest", genType.Name))
+		input.Field = []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:   strptr("field"),
+				Number: int32ptr(1),
+				Type:   &stringFieldType,
+			},
+			{
+				Name:   strptr("group_by"),
+				Number: int32ptr(2),
+				Type:   &stringFieldType,
+			},
+		}
+		filter, filterDeps, err := a.toFilterMessageDescriptor(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+		if filter != nil {
+			input.Field = append(input.Field, &descriptorpb.FieldDescriptorProto{
+				Name:     strptr("filter"),
+				Number:   int32ptr(3),
+				Type:     &protoMessageFieldType,
+				TypeName: filter.Name,
+			})
+			messages = append(messages, filter)
+			deps = append(deps, filterDeps...)
+		}
+		outputName = fmt.Sprintf("Aggregate%sResponse", genType.Name)
+		group := &descriptorpb.DescriptorProto{
+			Name: strptr("Group"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:   strptr("key"),
+					Number: int32ptr(1),
+					Type:   &stringFieldType,
+				},
+				{
+					Name:   strptr("count"),
+					Number: int32ptr(2),
+					Type:   &int64FieldType,
+				},
+			},
+		}
+		for i, name := range []string{"sum", "avg", "min", "max"} {
+			group.Field = append(group.Field, &descriptorpb.FieldDescriptorProto{
+				Name:     strptr(name),
+				Number:   int32ptr(int32(i + 3)),
+				Type:     &protoMessageFieldType,
+				TypeName: strptr("google.protobuf.DoubleValue"),
+			})
+		}
+		deps = append(deps, wktsPaths["google.protobuf.DoubleValue"])
+		output := &descriptorpb.DescriptorProto{
+			Name: &outputName,
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     strptr("groups"),
+					Number:   int32ptr(1),
+					Label:    &repeatedFieldLabel,
+					Type:     &protoMessageFieldType,
+					TypeName: strptr("Group"),
+				},
+			},
+			NestedType: []*descriptorpb.DescriptorProto{group},
+		}
+		messages = append(messages, input, output)
+	case MethodRestore:
+		softDelete, err := SoftDeleteField(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+		if softDelete == nil {
+			return methodResources{}, fmt.Errorf("entproto: Restore method of schema %q requires a soft-delete field, see entproto.SoftDelete", genType.Name)
+		}
+		methodName = fmt.Sprintf("Restore%s", genType.Name)
+		input.Name = strptr(fmt.Sprintf("Restore%sRequest", genType.Name))
+		input.Field = []*descriptorpb.FieldDescriptorProto{idField}
+		outputName = genType.Name
+		messages = append(messages, input)
+	case MethodDeleteWhere:
+		int64FieldType := descriptorpb.FieldDescriptorProto_TYPE_INT64
+		pluralEntityName := plural(genType.Name)
+		methodName = fmt.Sprintf("Delete%s", pluralEntityName)
+		filter, filterDeps, err := a.toFilterMessageDescriptor(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+		if filter == nil {
+			return methodResources{}, fmt.Errorf("entproto: DeleteWhere method of schema %q requires a filterable field", genType.Name)
+		}
+		input.Name = strptr(fmt.Sprintf("Delete%sRequest", pluralEntityName))
+		input.Field = []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:     strptr("filter"),
+				Number:   int32ptr(1),
+				Type:     &protoMessageFieldType,
+				TypeName: filter.Name,
+			},
+		}
+		outputName = fmt.Sprintf("Delete%sResponse", pluralEntityName)
+		output := &descriptorpb.DescriptorProto{
+			Name: &outputName,
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:   strptr("deleted"),
+					Number: int32ptr(1),
+					Type:   &int64FieldType,
+				},
+			},
+		}
+		messages = append(messages, filter, input, output)
+		deps = append(deps, filterDeps...)
+	case MethodStats:
+		methodName = "Stats"
+		int64FieldType := descriptorpb.FieldDescriptorProto_TYPE_INT64
+		stringFieldType := descriptorpb.FieldDescriptorProto_TYPE_STRING
+		input.Name = strptr(fmt.Sprintf("Stats%sRequest", genType.Name))
+		outputName = fmt.Sprintf("Stats%sResponse", genType.Name)
+		output := &descriptorpb.DescriptorProto{
+			Name: &outputName,
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:   strptr("count"),
+					Number: int32ptr(1),
+					Type:   &int64FieldType,
+				},
+				{
+					Name:     strptr("fields"),
+					Number:   int32ptr(2),
+					Label:    &repeatedFieldLabel,
+					Type:     &protoMessageFieldType,
+					TypeName: strptr("FieldStats"),
+				},
+			},
+			NestedType: []*descriptorpb.DescriptorProto{
+				{
+					Name: strptr("FieldStats"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{
+							Name:   strptr("field"),
+							Number: int32ptr(1),
+							Type:   &stringFieldType,
+						},
+						{
+							Name:     strptr("values"),
+							Number:   int32ptr(2),
+							Label:    &repeatedFieldLabel,
+							Type:     &protoMessageFieldType,
+							TypeName: strptr("ValueCount"),
+						},
+					},
+				},
+				{
+					Name: strptr("ValueCount"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{
+							Name:   strptr("value"),
+							Number: int32ptr(1),
+							Type:   &stringFieldType,
+						},
+						{
+							Name:   strptr("count"),
+							Number: int32ptr(2),
+							Type:   &int64FieldType,
+						},
+					},
+				},
+			},
+		}
+		messages = append(messages, input, output)
+	case MethodExport:
+		if err := verifyCursorID(genType, "export"); err != nil {
+			return methodResources{}, err
+		}
+		methodName = "Export"
+		serverStreaming = true
+		int32FieldType := descriptorpb.FieldDescriptorProto_TYPE_INT32
+		stringFieldType := descriptorpb.FieldDescriptorProto_TYPE_STRING
+		bytesFieldType := descriptorpb.FieldDescriptorProto_TYPE_BYTES
+		input.Name = strptr(fmt.Sprintf("Export%sRequest", genType.Name))
+		input.Field = []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:     strptr("format"),
+				Number:   int32ptr(1),
+				Type:     &protoEnumFieldType,
+				TypeName: strptr("Format"),
+			},
+			{
+				Name:   strptr("cursor"),
+				Number: int32ptr(2),
+				Type:   &stringFieldType,
+			},
+			{
+				Name:   strptr("batch_size"),
+				Number: int32ptr(3),
+				Type:   &int32FieldType,
+			},
+		}
+		input.EnumType = append(input.EnumType, recordFormatEnum())
+		outputName = fmt.Sprintf("Export%sResponse", genType.Name)
+		output := &descriptorpb.DescriptorProto{
+			Name: &outputName,
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:   strptr("data"),
+					Number: int32ptr(1),
+					Type:   &bytesFieldType,
+				},
+				{
+					Name:   strptr("cursor"),
+					Number: int32ptr(2),
+					Type:   &stringFieldType,
+				},
+			},
+		}
+		messages = append(messages, input, output)
+	case MethodImport:
+		if err := verifyCursorID(genType, "import"); err != nil {
+			return methodResources{}, err
+		}
+		methodName = "Import"
+		clientStreaming = true
+		int64FieldType := descriptorpb.FieldDescriptorProto_TYPE_INT64
+		stringFieldType := descriptorpb.FieldDescriptorProto_TYPE_STRING
+		bytesFieldType := descriptorpb.FieldDescriptorProto_TYPE_BYTES
+		input.Name = strptr(fmt.Sprintf("Import%sRequest", genType.Name))
+		input.Field = []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:     strptr("format"),
+				Number:   int32ptr(1),
+				Type:     &protoEnumFieldType,
+				TypeName: strptr("Format"),
+			},
+			{
+				Name:   strptr("data"),
+				Number: int32ptr(2),
+				Type:   &bytesFieldType,
+			},
+		}
+		input.EnumType = append(input.EnumType, recordFormatEnum())
+		outputName = fmt.Sprintf("Import%sResponse", genType.Name)
+		output := &descriptorpb.DescriptorProto{
+			Name: &outputName,
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:   strptr("count"),
+					Number: int32ptr(1),
+					Type:   &int64FieldType,
+				},
+				{
+					Name:   strptr("cursor"),
+					Number: int32ptr(2),
+					Type:   &stringFieldType,
+				},
+			},
+		}
+		messages = append(messages, input, output)
 	default:
 		return methodResources{}, fmt.Errorf("unknown method %q", m)
 	}
 	return methodResources{
 		methodDescriptor: &descriptorpb.MethodDescriptorProto{
-			Name:       &methodName,
-			InputType:  input.Name,
-			OutputType: &outputName,
+			Name:            &methodName,
+			InputType:       input.Name,
+			OutputType:      &outputName,
+			ServerStreaming: optionalBool(serverStreaming),
+			ClientStreaming: optionalBool(clientStreaming),
 		},
 		messages: messages,
+		deps:     deps,
+		comments: comments,
 	}, nil
 }
 
+// verifyCursorID verifies that the ID of genType can be encoded in the page tokens and cursors of the named method.
+func verifyCursorID(genType *gen.Type, method string) error {
+	if !(genType.ID.Type.Type.Integer() || genType.ID.IsUUID() || genType.ID.IsString()) {
+		return fmt.Errorf("entproto: %s method does not support schema %q id type %q",
+			method, genType.Name, genType.ID.Type.String())
+	}
+	return nil
+}
+
+// viewEnum returns the descriptor of the View enum of the Get, List and BatchGet requests.
+func viewEnum() *descriptorpb.EnumDescriptorProto {
+	return &descriptorpb.EnumDescriptorProto{
+		Name: strptr("View"),
+		Value: []*descriptorpb.EnumValueDescriptorProto{
+			{Number: int32ptr(0), Name: strptr("VIEW_UNSPECIFIED")},
+			{Number: int32ptr(1), Name: strptr("BASIC")},
+			{Number: int32ptr(2), Name: strptr("WITH_EDGE_IDS")},
+		},
+	}
+}
+
+// recordFormatEnum returns the descriptor of the Format enum of the Export and Import requests. Its values match
+// the values of runtime.RecordFormat.
+func recordFormatEnum() *descriptorpb.EnumDescriptorProto {
+	return &descriptorpb.EnumDescriptorProto{
+		Name: strptr("Format"),
+		Value: []*descriptorpb.EnumValueDescriptorProto{
+			{Number: int32ptr(0), Name: strptr("FORMAT_UNSPECIFIED")},
+			{Number: int32ptr(1), Name: strptr("PROTO_DELIMITED")},
+			{Number: int32ptr(2), Name: strptr("NDJSON")},
+		},
+	}
+}
+
+// optionalBool returns a pointer to b, or nil if b is false.
+func optionalBool(b bool) *bool {
+	if !b {
+		return nil
+	}
+	return &b
+}
+
 type methodResources struct {
 	methodDescriptor *descriptorpb.MethodDescriptorProto
 	messages         []*descriptorpb.DescriptorProto
+	// deps holds the paths of the files defining the types used by the messages.
+	deps []string
+	// comments holds the leading comments of the fields of the messages, keyed by "<message>.<field>".
+	comments map[string]string
+}
+
+// rename renames the request and response messages of the method to the non-empty names of n.
+func (r methodResources) rename(n *messageNames) error {
+	if n.Request != "" {
+		if err := r.renameMessage(r.methodDescriptor.GetInputType(), n.Request); err != nil {
+			return err
+		}
+		r.methodDescriptor.InputType = strptr(n.Request)
+	}
+	if n.Response != "" {
+		if err := r.renameMessage(r.methodDescriptor.GetOutputType(), n.Response); err != nil {
+			return err
+		}
+		r.methodDescriptor.OutputType = strptr(n.Response)
+	}
+	return nil
+}
+
+// renameMessage renames the message of the method named old, along with the comments of its fields.
+func (r methodResources) renameMessage(old, name string) error {
+	for _, msg := range r.messages {
+		if msg.GetName() != old {
+			continue
+		}
+		msg.Name = strptr(name)
+		for key, c := range r.comments {
+			if field := strings.TrimPrefix(key, old+"."); field != key {
+				delete(r.comments, key)
+				r.comments[name+"."+field] = c
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("message %q of method %q is not generated by entproto and cannot be renamed",
+		old, r.methodDescriptor.GetName())
 }
 
 type serviceResources struct {
-	svc         *descriptorpb.ServiceDescriptorProto
+	// svcs holds the services of the schema, a single one unless it is annotated with SplitReadWrite.
+	svcs        []*descriptorpb.ServiceDescriptorProto
 	svcMessages []*descriptorpb.DescriptorProto
+	// deps holds the paths of the files defining the options and types used by the service methods.
+	deps []string
+	// comments holds the leading comments of the fields of the messages, of the services and of their
+	// methods, keyed by "<message>.<field>", "<service>" and "<service>.<method>".
+	comments map[string]string
 }
 
 func extractServiceAnnotation(sch *gen.Type) (*service, error) {
@@ -15,18 +15,94 @@
 package entproto
 
 import (
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/schema"
+	"github.com/mitchellh/mapstructure"
 )
 
 const SkipAnnotation = "ProtoSkip"
 
-type skipped struct{}
+type skipped struct {
+	Methods Method
+}
 
 // Skip annotates an ent.Schema to specify that this field will be skipped during .proto generation.
-func Skip() schema.Annotation {
-	return skipped{}
+//
+// Skip optionally receives a mask of the service methods that should ignore the field. In that case,
+// the field is still generated in the message and returned by all methods, but its value is not read
+// from the requests of the masked methods. This is useful for server-computed fields, such as
+// "created_at", that should be output-only:
+//
+//	field.Time("created_at").
+//		Default(time.Now).
+//		Annotations(
+//			entproto.Field(2),
+//			entproto.Skip(entproto.MethodCreate|entproto.MethodUpdate),
+//		)
+//
+// Only MethodCreate and MethodUpdate are accepted. Since BatchCreate, Upsert and BatchUpdate build entities
+// the same way as Create and Update, MethodCreate applies to BatchCreate and Upsert, and MethodUpdate to
+// BatchUpdate.
+func Skip(methods ...Method) schema.Annotation {
+	var s skipped
+	for _, m := range methods {
+		s.Methods |= m
+	}
+	return s
 }
 
 func (f skipped) Name() string {
 	return SkipAnnotation
 }
+
+// Merge implements schema.Merger. Method masks of multiple entproto.Skip annotations are combined,
+// and an entproto.Skip without methods skips the field entirely.
+func (f skipped) Merge(other schema.Annotation) schema.Annotation {
+	o, ok := other.(skipped)
+	if !ok {
+		return other
+	}
+	if f.Methods == 0 || o.Methods == 0 {
+		return skipped{}
+	}
+	return skipped{Methods: f.Methods | o.Methods}
+}
+
+// skipMethods returns the methods that should ignore the field with the given annotations, and reports
+// whether the field is skipped entirely. The methods combine the masks of entproto.Skip and entproto.OmitFrom.
+func skipMethods(name string, annots gen.Annotations) (Method, bool, error) {
+	var omitted Method
+	if annot, ok := annots[FieldAnnotation]; ok {
+		f, err := decodeFieldAnnotation(name, annot)
+		if err != nil {
+			return 0, false, err
+		}
+		if f.OmitFrom&^(MethodCreate|MethodUpdate) != 0 {
+			return 0, false, fmt.Errorf("entproto: entproto.OmitFrom of %q only accepts MethodCreate and MethodUpdate", name)
+		}
+		omitted = f.OmitFrom
+	}
+	annot, ok := annots[SkipAnnotation]
+	if !ok {
+		return omitted, false, nil
+	}
+	var s skipped
+	if err := mapstructure.Decode(annot, &s); err != nil {
+		return 0, false, fmt.Errorf("entproto: failed decoding skip annotation of %q: %w", name, err)
+	}
+	if s.Methods == 0 {
+		return 0, true, nil
+	}
+	if s.Methods&^(MethodCreate|MethodUpdate) != 0 {
+		return 0, false, fmt.Errorf("entproto: entproto.Skip of %q only accepts MethodCreate and MethodUpdate", name)
+	}
+	return s.Methods | omitted, false, nil
+}
+
+// isSkipped reports whether the field with the given annotations is excluded from the generated message.
+func isSkipped(annots gen.Annotations) bool {
+	_, skip, _ := skipMethods("", annots)
+	return skip
+}
@@ -0,0 +1,58 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// SoftDelete sets the optional time field marking the entries of the schema as deleted, usually added to the
+// schema by a soft-delete mixin. The delete methods set the field rather than removing the entries, the Get and
+// List methods leave out the entries whose field is set unless their include_deleted flag is set, the other
+// methods do not find them, and MethodRestore generates a Restore<T> method clearing the field. For example:
+//
+//	entproto.Service(
+//		entproto.Methods(entproto.MethodAll|entproto.MethodRestore),
+//		entproto.SoftDelete("deleted_at"),
+//	)
+func SoftDelete(field string) ServiceOption {
+	return func(s *service) {
+		s.SoftDelete = field
+	}
+}
+
+// SoftDeleteField returns the field marking the entries of the schema as deleted, or nil if the schema is not
+// soft-deleted. See SoftDelete.
+func SoftDeleteField(t *gen.Type) (*gen.Field, error) {
+	svc, err := extractServiceAnnotation(t)
+	if err != nil {
+		return nil, err
+	}
+	if svc.SoftDelete == "" {
+		return nil, nil
+	}
+	for _, f := range t.Fields {
+		if f.Name != svc.SoftDelete {
+			continue
+		}
+		if !f.Optional || f.Immutable || !f.IsTime() {
+			return nil, fmt.Errorf("entproto: soft-delete field %q of schema %q must be an optional and mutable time field", f.Name, t.Name)
+		}
+		return f, nil
+	}
+	return nil, fmt.Errorf("entproto: soft-delete field %q not found in schema %q", svc.SoftDelete, t.Name)
+}
@@ -0,0 +1,97 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"entgo.io/contrib/genmanifest"
+	"entgo.io/ent/entc/gen"
+)
+
+// StaleFile describes a .proto file that needs to be generated again.
+type StaleFile struct {
+	// Path is the path of the file, relative to the proto directory.
+	Path string
+	// Reason explains why the file is stale.
+	Reason string
+}
+
+// Stale compares the .proto files stored in dir with the files generated by the adapter, and returns the files
+// that are missing, or that were generated from another revision of the schema according to the schema hash
+// stamped in their header. The files must be generated with WithManifest to carry the hash. Unlike the
+// manifest, the check relies on the .proto files only, and detects files edited or restored independently.
+func (a *Adapter) Stale(dir string) ([]*StaleFile, error) {
+	hash, err := genmanifest.SchemaHash(a.graph)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(a.descriptors))
+	for name := range a.descriptors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var stale []*StaleFile
+	for _, name := range names {
+		fileHash, ok, err := protoFileHash(filepath.Join(dir, filepath.FromSlash(name)))
+		switch {
+		case os.IsNotExist(err):
+			stale = append(stale, &StaleFile{Path: name, Reason: "file is missing"})
+		case err != nil:
+			return nil, err
+		case !ok:
+			stale = append(stale, &StaleFile{Path: name, Reason: "file has no schema hash, generate it with entproto.WithManifest"})
+		case fileHash != hash:
+			stale = append(stale, &StaleFile{Path: name, Reason: fmt.Sprintf("generated from schema %s, current schema is %s", fileHash, hash)})
+		}
+	}
+	return stale, nil
+}
+
+// CheckProtos loads the adapter of the graph and returns the stale .proto files of the proto directory of the
+// target directory of the graph, where Generate writes them. See Adapter.Stale for more details.
+func CheckProtos(g *gen.Graph) ([]*StaleFile, error) {
+	a, err := LoadAdapter(g)
+	if err != nil {
+		return nil, err
+	}
+	return a.Stale(filepath.Join(g.Config.Target, "proto"))
+}
+
+// protoFileHash returns the schema hash stamped in the header of the .proto file at path, and reports
+// whether the file has one. The header is one of the leading comments of the file.
+func protoFileHash(path string) (string, bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", false, err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "//") {
+			break
+		}
+		if generator, _, hash, ok := genmanifest.ParseHeader(strings.TrimPrefix(line, "//")); ok && generator == "entproto" {
+			return hash, true, nil
+		}
+	}
+	return "", false, scanner.Err()
+}
@@ -0,0 +1,125 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// Tenant scopes the service of the schema to the tenant of the requests, stored in the given field. The tenant
+// is extracted from the incoming gRPC metadata by the function passed to the constructor of the service with
+// runtime.WithTenant, and the methods of the service only read, update and delete the entries of the tenant,
+// while the created entries are set its tenant, whatever the value of the request. For example:
+//
+//	entproto.Service(
+//		entproto.Tenant("org_id"),
+//	)
+//
+// The field must be a required and immutable string, integer or UUID field. The Upsert method requires the
+// tenant field to be part of its key, and the Watch method is not supported. The edges set by the requests to
+// schemas also scoped to a tenant only link the entries of the tenant, see TenantEdges.
+func Tenant(field string) ServiceOption {
+	return func(s *service) {
+		s.Tenant = field
+	}
+}
+
+// TenantField returns the field holding the tenant of the entries of the schema, or nil if the service of the
+// schema is not scoped to a tenant. See Tenant.
+func TenantField(t *gen.Type) (*gen.Field, error) {
+	svc, err := extractServiceAnnotation(t)
+	if err != nil {
+		return nil, err
+	}
+	if svc.Tenant == "" {
+		return nil, nil
+	}
+	var tenant *gen.Field
+	for _, f := range t.Fields {
+		if f.Name == svc.Tenant {
+			tenant = f
+			break
+		}
+	}
+	switch {
+	case tenant == nil:
+		return nil, fmt.Errorf("entproto: tenant field %q not found in schema %q", svc.Tenant, t.Name)
+	case tenant.Optional || tenant.Nillable || !tenant.Immutable || !isTenantType(tenant):
+		return nil, fmt.Errorf("entproto: tenant field %q of schema %q must be a required and immutable string, integer or UUID field", tenant.Name, t.Name)
+	case svc.Methods.Is(MethodWatch):
+		return nil, fmt.Errorf("entproto: Watch method of schema %q cannot be scoped to the tenant of the requests", t.Name)
+	case svc.Methods.Is(MethodUpsert):
+		key, err := UpsertKeyFields(t)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range key {
+			if f == tenant {
+				return tenant, nil
+			}
+		}
+		return nil, fmt.Errorf("entproto: upsert key of schema %q must hold its tenant field %q", t.Name, tenant.Name)
+	}
+	return tenant, nil
+}
+
+// TenantEdge is an edge of a schema scoped to a tenant, pointing to a schema whose service is also scoped to a
+// tenant, stored in Field.
+type TenantEdge struct {
+	Edge  *gen.Edge
+	Field *gen.Field
+}
+
+// TenantEdges returns the edges of the schema pointing to schemas whose services are also scoped to a tenant, or
+// nil if the service of the schema is not scoped to a tenant. The IDs of those edges set by the requests are
+// checked against the tenant of the request. The tenant fields of both schemas must have the same type.
+func TenantEdges(t *gen.Type) ([]*TenantEdge, error) {
+	tenant, err := TenantField(t)
+	if err != nil || tenant == nil {
+		return nil, err
+	}
+	var edges []*TenantEdge
+	for _, e := range t.Edges {
+		f, err := TenantField(e.Type)
+		if errors.Is(err, errNoServiceDef) || err == nil && f == nil {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if f.Type.String() != tenant.Type.String() {
+			return nil, fmt.Errorf("entproto: tenant field %q of schema %q must have the type of the tenant field %q of schema %q, pointed to by edge %q",
+				f.Name, e.Type.Name, tenant.Name, t.Name, e.Name)
+		}
+		edges = append(edges, &TenantEdge{Edge: e, Field: f})
+	}
+	return edges, nil
+}
+
+// isTenantType reports whether the type of the field can hold a tenant, parsed from the incoming metadata of
+// the requests.
+func isTenantType(f *gen.Field) bool {
+	switch {
+	case f.IsUUID():
+		return f.Type.RType != nil && f.Type.RType.PkgPath == "github.com/google/uuid"
+	case f.HasGoType():
+		return false
+	default:
+		return f.IsString() || f.Type.Type.Integer()
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// TotalSize adds an int32 total_size field to the List responses of the service, holding the number of entries
+// matching the filter of the request regardless of the page. Counting stops at max entries, as exact totals of
+// large tables are expensive, and a max of 0 counts all the entries. For example:
+//
+//	entproto.Service(
+//		entproto.TotalSize(1000),
+//	)
+func TotalSize(max int) ServiceOption {
+	return func(s *service) {
+		s.TotalSize = true
+		s.MaxTotalSize = max
+	}
+}
+
+// ListTotalSize reports whether the List responses of the schema hold the total number of entries, and returns
+// the number at which counting stops, or 0 if it is not capped. See TotalSize.
+func ListTotalSize(t *gen.Type) (ok bool, max int, err error) {
+	svc, err := extractServiceAnnotation(t)
+	if err != nil {
+		return false, 0, err
+	}
+	if !svc.TotalSize {
+		return false, 0, nil
+	}
+	if svc.MaxTotalSize < 0 {
+		return false, 0, fmt.Errorf("entproto: maximum total size of schema %q cannot be negative", t.Name)
+	}
+	return true, svc.MaxTotalSize, nil
+}
+
+// totalSizeComment returns the comment of the total_size field of List responses.
+func totalSizeComment(max int) string {
+	if max == 0 {
+		return " The number of entries matching the request across all pages.\n"
+	}
+	return fmt.Sprintf(" The number of entries matching the request across all pages, capped to %d.\n", max)
+}
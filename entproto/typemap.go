@@ -0,0 +1,228 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// TypeMappingsFileName is the name of the file recording the type mappings registered with
+// Adapter.RegisterTypeMapping. It is written next to the generated .proto files, and read by
+// protoc-gen-entgrpc to generate the conversions of the mapped fields.
+const TypeMappingsFileName = "entproto.types.json"
+
+type (
+	// TypeConverter holds the templates of the Go expressions converting a value between its ent
+	// and protobuf representations. The templates are executed with the Go expression of the value
+	// to convert as dot, and may use the "qualify" function to refer to an identifier of another
+	// package. For example:
+	//
+	//	entproto.TypeConverter{
+	//		ToProto: `{{ . }}.String()`,
+	//		ToEnt:   `{{ qualify "net" "ParseIP" }}({{ . }})`,
+	//	}
+	//
+	TypeConverter struct {
+		// ToProto converts the ent value to its protobuf representation.
+		ToProto string `json:"to_proto"`
+		// ToProtoErr reports whether ToProto evaluates to a value and an error.
+		ToProtoErr bool `json:"to_proto_err,omitempty"`
+		// ToEnt converts the protobuf value to its ent representation.
+		ToEnt string `json:"to_ent"`
+		// ToEntErr reports whether ToEnt evaluates to a value and an error. Errors are
+		// returned to the client with an InvalidArgument status.
+		ToEntErr bool `json:"to_ent_err,omitempty"`
+	}
+
+	// TypeMapping describes how the fields of a Go type are represented in the generated protobuf
+	// messages and services.
+	TypeMapping struct {
+		// GoType is the fully-qualified name of the Go type, e.g. "net.IP" or "*example.com/pkg.Money".
+		GoType string
+		// ProtoType is the scalar protobuf type the fields are mapped to.
+		ProtoType descriptorpb.FieldDescriptorProto_Type
+		TypeConverter
+	}
+
+	// GenerateOption configures the Adapter used by Generate.
+	GenerateOption func(*Adapter) error
+)
+
+// WithTypeMapping registers a type mapping with the Adapter used by Generate. See Adapter.RegisterTypeMapping.
+func WithTypeMapping(goType string, protoType descriptorpb.FieldDescriptorProto_Type, conv TypeConverter) GenerateOption {
+	return func(a *Adapter) error {
+		return a.types.register(goType, protoType, conv)
+	}
+}
+
+// RegisterTypeMapping teaches the Adapter to represent fields of the Go type goType, such as field.Other
+// fields or fields with a custom GoType, as the scalar protobuf type protoType, and parses the graph again.
+// goType is the fully-qualified name of the type, e.g. "net.IP" or "*example.com/pkg.Money". Mappings take
+// precedence over the builtin mapping of the field type, and optional fields are mapped to the wrapper type
+// of protoType.
+//
+// Registered mappings are recorded by Generate in TypeMappingsFileName, and loaded by LoadAdapter from there.
+// Entries are never removed from the file. To drop a mapping, delete its entry before regenerating.
+func (a *Adapter) RegisterTypeMapping(goType string, protoType descriptorpb.FieldDescriptorProto_Type, conv TypeConverter) error {
+	if err := a.types.register(goType, protoType, conv); err != nil {
+		return err
+	}
+	a.descriptors = make(map[string]*desc.FileDescriptor)
+	a.schemaProtoFiles = make(map[string]string)
+	a.errors = make(map[string]error)
+	return a.parse()
+}
+
+// TypeMapping returns the type mapping registered for the Go type of f, or nil if there is none. Time
+// fields annotated with RFC3339 are mapped by RFC3339TimeConverter regardless of the registered mappings.
+func (a *Adapter) TypeMapping(f *gen.Field) *TypeMapping {
+	if f == nil || f.Type == nil {
+		return nil
+	}
+	if ok, _ := isRFC3339(f); ok {
+		return rfc3339Mapping
+	}
+	return a.types.Types[goTypeName(f.Type)]
+}
+
+// Template returns the parsed ToProto and ToEnt templates of the converter. funcs must define the
+// "qualify" function used by the templates.
+func (c TypeConverter) Template(funcs template.FuncMap) (toProto, toEnt *template.Template, err error) {
+	if toProto, err = template.New("to_proto").Funcs(funcs).Parse(c.ToProto); err != nil {
+		return nil, nil, fmt.Errorf("entproto: failed parsing ToProto template: %w", err)
+	}
+	if toEnt, err = template.New("to_ent").Funcs(funcs).Parse(c.ToEnt); err != nil {
+		return nil, nil, fmt.Errorf("entproto: failed parsing ToEnt template: %w", err)
+	}
+	return toProto, toEnt, nil
+}
+
+// typesFile records the type mappings registered with the Adapter.
+type typesFile struct {
+	Types map[string]*TypeMapping
+	dirty bool
+}
+
+type typesFileMapping struct {
+	ProtoType string `json:"proto_type"`
+	TypeConverter
+}
+
+func typesFilePath(graph *gen.Graph) string {
+	return filepath.Join(graph.Config.Target, "proto", TypeMappingsFileName)
+}
+
+func loadTypesFile(path string) (*typesFile, error) {
+	tf := &typesFile{Types: make(map[string]*TypeMapping)}
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return tf, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("entproto: failed reading type mappings file: %w", err)
+	}
+	var raw struct {
+		Types map[string]typesFileMapping `json:"types"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, fmt.Errorf("entproto: failed decoding type mappings file %q: %w", path, err)
+	}
+	for goType, m := range raw.Types {
+		pbType, ok := descriptorpb.FieldDescriptorProto_Type_value[m.ProtoType]
+		if !ok {
+			return nil, fmt.Errorf("entproto: unknown proto type %q of Go type %q in %q", m.ProtoType, goType, path)
+		}
+		tf.Types[goType] = &TypeMapping{
+			GoType:        goType,
+			ProtoType:     descriptorpb.FieldDescriptorProto_Type(pbType),
+			TypeConverter: m.TypeConverter,
+		}
+	}
+	return tf, nil
+}
+
+func (tf *typesFile) write(path string) error {
+	raw := struct {
+		Types map[string]typesFileMapping `json:"types"`
+	}{Types: make(map[string]typesFileMapping, len(tf.Types))}
+	for goType, m := range tf.Types {
+		raw.Types[goType] = typesFileMapping{ProtoType: m.ProtoType.String(), TypeConverter: m.TypeConverter}
+	}
+	b, err := json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(b, '\n'), 0600)
+}
+
+func (tf *typesFile) register(goType string, protoType descriptorpb.FieldDescriptorProto_Type, conv TypeConverter) error {
+	if goType == "" {
+		return errors.New("entproto: type mapping requires a Go type")
+	}
+	if _, ok := scalarWrappers[protoType]; !ok {
+		return fmt.Errorf("entproto: Go type %q cannot be mapped to non-scalar proto type %s", goType, protoType)
+	}
+	if conv.ToProto == "" || conv.ToEnt == "" {
+		return fmt.Errorf("entproto: type mapping of Go type %q requires ToProto and ToEnt converters", goType)
+	}
+	noop := template.FuncMap{"qualify": func(string, string) string { return "" }}
+	if _, _, err := conv.Template(noop); err != nil {
+		return fmt.Errorf("entproto: invalid converter of Go type %q: %w", goType, err)
+	}
+	m := &TypeMapping{GoType: goType, ProtoType: protoType, TypeConverter: conv}
+	if cur, ok := tf.Types[goType]; !ok || *cur != *m {
+		tf.Types[goType] = m
+		tf.dirty = true
+	}
+	return nil
+}
+
+// scalarWrappers maps the scalar protobuf types to their well-known wrapper types.
+var scalarWrappers = map[descriptorpb.FieldDescriptorProto_Type]string{
+	descriptorpb.FieldDescriptorProto_TYPE_BOOL:   "google.protobuf.BoolValue",
+	descriptorpb.FieldDescriptorProto_TYPE_STRING: "google.protobuf.StringValue",
+	descriptorpb.FieldDescriptorProto_TYPE_BYTES:  "google.protobuf.BytesValue",
+	descriptorpb.FieldDescriptorProto_TYPE_INT32:  "google.protobuf.Int32Value",
+	descriptorpb.FieldDescriptorProto_TYPE_INT64:  "google.protobuf.Int64Value",
+	descriptorpb.FieldDescriptorProto_TYPE_UINT32: "google.protobuf.UInt32Value",
+	descriptorpb.FieldDescriptorProto_TYPE_UINT64: "google.protobuf.UInt64Value",
+	descriptorpb.FieldDescriptorProto_TYPE_FLOAT:  "google.protobuf.FloatValue",
+	descriptorpb.FieldDescriptorProto_TYPE_DOUBLE: "google.protobuf.DoubleValue",
+}
+
+// goTypeName returns the fully-qualified name of the Go type t, e.g. "*example.com/pkg.Money".
+func goTypeName(t *field.TypeInfo) string {
+	ident := t.String()
+	i := strings.LastIndexByte(ident, '.')
+	if t.PkgPath == "" || i == -1 {
+		return ident
+	}
+	j := strings.LastIndexAny(ident[:i], "*]") + 1
+	return ident[:j] + t.PkgPath + ident[i:]
+}
@@ -27,6 +27,11 @@ var typeMap = map[field.Type]typeConfig{
 	field.TypeUUID:  {pbType: descriptorpb.FieldDescriptorProto_TYPE_BYTES, optionalType: "google.protobuf.BytesValue"},
 	field.TypeBytes: {pbType: descriptorpb.FieldDescriptorProto_TYPE_BYTES, optionalType: "google.protobuf.BytesValue"},
 	field.TypeEnum: {pbType: descriptorpb.FieldDescriptorProto_TYPE_ENUM, namer: func(fld *gen.Field) string {
+		if e, err := extractEnumAnnotation(fld); err == nil && e.Shared != "" {
+			return e.Shared
+		} else if err == nil && e.Ref != "" {
+			return e.Ref
+		}
 		return pascal(fld.Name)
 	}},
 	field.TypeString:  {pbType: descriptorpb.FieldDescriptorProto_TYPE_STRING, optionalType: "google.protobuf.StringValue"},
@@ -0,0 +1,58 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"entgo.io/ent/entc/gen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// UpdateMask adds a google.protobuf.FieldMask update_mask field to the Update requests of the service, also used
+// by the BatchUpdate method. The paths of the mask are the names of the fields and edges of the entity message,
+// and the generated service sets only the masked fields, leaving the other columns untouched. For example:
+//
+//	entproto.Service(
+//		entproto.UpdateMask(),
+//	)
+//
+// Masked optional fields and unique edges that are unset in the request are cleared, and masked non-unique edges
+// are replaced. Requests without an update mask update all the fields, and unknown, immutable or skipped paths
+// are rejected with the InvalidArgument code.
+func UpdateMask() ServiceOption {
+	return func(s *service) {
+		s.UpdateMask = true
+	}
+}
+
+// HasUpdateMask reports whether the Update requests of the service of the schema hold an update mask.
+// See UpdateMask.
+func HasUpdateMask(t *gen.Type) (bool, error) {
+	svc, err := extractServiceAnnotation(t)
+	if err != nil {
+		return false, err
+	}
+	return svc.UpdateMask, nil
+}
+
+// updateMaskField returns the descriptor of the update_mask field of a request, with the given number.
+func updateMaskField(number int32) *descriptorpb.FieldDescriptorProto {
+	messageType := descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	return &descriptorpb.FieldDescriptorProto{
+		Name:     strptr("update_mask"),
+		Number:   int32ptr(number),
+		Type:     &messageType,
+		TypeName: strptr("google.protobuf.FieldMask"),
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"fmt"
+	"sort"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// UpsertKey sets the fields identifying the entities of the Upsert method, used as the conflict target of
+// its INSERT statements. A single field must be unique, while several fields must match a unique index
+// of the schema. For example:
+//
+//	entproto.Service(
+//		entproto.Methods(entproto.MethodAll|entproto.MethodUpsert),
+//		entproto.UpsertKey("user_name"),
+//	)
+//
+// If no key is set, the Upsert method is keyed by the single required unique field of the schema.
+func UpsertKey(fields ...string) ServiceOption {
+	return func(s *service) {
+		s.UpsertKey = fields
+	}
+}
+
+// UpsertKeyFields returns the fields identifying the entities of the Upsert method of the schema. See UpsertKey.
+func UpsertKeyFields(t *gen.Type) ([]*gen.Field, error) {
+	svc, err := extractServiceAnnotation(t)
+	if err != nil {
+		return nil, err
+	}
+	if len(svc.UpsertKey) == 0 {
+		var key []*gen.Field
+		for _, f := range t.Fields {
+			if f.Unique && !f.Optional && !f.Nillable {
+				key = append(key, f)
+			}
+		}
+		if len(key) != 1 {
+			return nil, fmt.Errorf("entproto: Upsert of schema %q requires a single required unique field, "+
+				"use entproto.UpsertKey to select the fields identifying its entities", t.Name)
+		}
+		return key, nil
+	}
+	var (
+		key     []*gen.Field
+		columns []string
+	)
+	for _, name := range svc.UpsertKey {
+		var fld *gen.Field
+		for _, f := range t.Fields {
+			if f.Name == name {
+				fld = f
+				break
+			}
+		}
+		switch {
+		case fld == nil:
+			return nil, fmt.Errorf("entproto: upsert key field %q not found in schema %q", name, t.Name)
+		case fld.Optional || fld.Nillable:
+			return nil, fmt.Errorf("entproto: upsert key field %q of schema %q cannot be optional", name, t.Name)
+		}
+		key = append(key, fld)
+		columns = append(columns, fld.StorageKey())
+	}
+	if len(key) == 1 && key[0].Unique {
+		return key, nil
+	}
+	sort.Strings(columns)
+	for _, idx := range t.Indexes {
+		if !idx.Unique || len(idx.Columns) != len(columns) {
+			continue
+		}
+		idxColumns := append([]string(nil), idx.Columns...)
+		sort.Strings(idxColumns)
+		if equalStrings(idxColumns, columns) {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("entproto: upsert key %q of schema %q does not match a unique field or index", svc.UpsertKey, t.Name)
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
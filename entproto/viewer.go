@@ -0,0 +1,45 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"entgo.io/ent/entc/gen"
+)
+
+// ViewerContext makes the methods of the service add the viewer of the requests to their context before any ent
+// operation, so that the privacy policies of the schemas govern the generated service. The viewer is extracted
+// from the incoming gRPC metadata by the function passed to the constructor of the service with
+// runtime.WithViewer. For example:
+//
+//	entproto.Service(
+//		entproto.ViewerContext(),
+//	)
+//
+// Requests denied by a privacy rule are rejected with the PermissionDenied code.
+func ViewerContext() ServiceOption {
+	return func(s *service) {
+		s.ViewerContext = true
+	}
+}
+
+// HasViewerContext reports whether the methods of the service of the schema add the viewer of the requests to
+// their context. See ViewerContext.
+func HasViewerContext(t *gen.Type) (bool, error) {
+	svc, err := extractServiceAnnotation(t)
+	if err != nil {
+		return false, err
+	}
+	return svc.ViewerContext, nil
+}
@@ -0,0 +1,260 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package genmanifest records what the code generators of this repository (entproto, entgql and entoas)
+// generated from which revision of an ent schema, and allows tools to detect stale generated code.
+//
+// Generators record an Entry in a manifest file stored in the target directory of the ent codegen,
+// and stamp the artifacts supporting comments with a Header. A CI check can then load the graph
+// and report the generators that need to run again:
+//
+//	g, err := entc.LoadGraph("./ent/schema", &gen.Config{})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	stale, err := genmanifest.Check(g)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, s := range stale {
+//		log.Printf("%s is stale: %s", s.Entry.Generator, s.Reason)
+//	}
+package genmanifest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime/debug"
+	"sort"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+)
+
+const (
+	// FileName is the name of the manifest file, stored in the target directory of the ent codegen.
+	FileName = "contrib.manifest.json"
+	// ModulePath is the path of the module providing the generators.
+	ModulePath = "entgo.io/contrib"
+)
+
+type (
+	// Manifest describes the artifacts generated from an ent schema.
+	Manifest struct {
+		// Entries holds an entry per generator, sorted by generator name.
+		Entries []*Entry `json:"entries"`
+	}
+
+	// Entry describes the artifacts generated by a generator.
+	Entry struct {
+		// Generator is the name of the generator, e.g. "entproto".
+		Generator string `json:"generator"`
+		// Version is the version of the module providing the generator.
+		Version string `json:"version"`
+		// SchemaHash is the hash of the schema the artifacts were generated from. See SchemaHash.
+		SchemaHash string `json:"schema_hash"`
+		// Files holds the paths of the generated artifacts, relative to the manifest directory.
+		Files []string `json:"files,omitempty"`
+	}
+
+	// StaleEntry describes an entry whose artifacts need to be generated again.
+	StaleEntry struct {
+		Entry *Entry
+		// Reason explains why the entry is stale.
+		Reason string
+	}
+)
+
+// Version returns the version of the entgo.io/contrib module linked into the running binary,
+// or "(devel)" if it cannot be determined, e.g. when running from the module itself.
+func Version() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "(devel)"
+	}
+	if info.Main.Path == ModulePath && info.Main.Version != "" {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path != ModulePath {
+			continue
+		}
+		if dep.Replace != nil {
+			dep = dep.Replace
+		}
+		if dep.Version != "" {
+			return dep.Version
+		}
+	}
+	return "(devel)"
+}
+
+// SchemaHash returns the hex encoded SHA-256 hash of the schemas of the graph. It changes
+// whenever a field, an edge, an index or an annotation of any schema changes.
+func SchemaHash(g *gen.Graph) (string, error) {
+	b, err := json.Marshal(g.Schemas)
+	if err != nil {
+		return "", fmt.Errorf("genmanifest: encoding schemas: %w", err)
+	}
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:]), nil
+}
+
+// Header returns the text stamped at the top of the artifacts generated from the given schema hash, without
+// the comment markers of the artifact language.
+func Header(generator, version, hash string) string {
+	return fmt.Sprintf("Code generated by %s (%s %s) from schema %s, DO NOT EDIT.", generator, ModulePath, version, hash)
+}
+
+// headerRegexp matches the headers returned by Header.
+var headerRegexp = regexp.MustCompile(`^Code generated by (\S+) \(` + regexp.QuoteMeta(ModulePath) + ` (\S+)\) from schema ([0-9a-f]+), DO NOT EDIT\.$`)
+
+// ParseHeader parses a header returned by Header, without the comment markers of the artifact language, and
+// returns its generator, version and schema hash. It reports false if s is not such a header.
+func ParseHeader(s string) (generator, version, hash string, ok bool) {
+	m := headerRegexp.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return "", "", "", false
+	}
+	return m[1], m[2], m[3], true
+}
+
+// NewEntry returns the entry of the given generator for the artifacts generated from g. Paths of files
+// are made relative to the target directory of g.
+func NewEntry(g *gen.Graph, generator string, files ...string) (*Entry, error) {
+	hash, err := SchemaHash(g)
+	if err != nil {
+		return nil, err
+	}
+	e := &Entry{Generator: generator, Version: Version(), SchemaHash: hash}
+	dir, err := filepath.Abs(g.Config.Target)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		if f, err = filepath.Abs(f); err != nil {
+			return nil, err
+		}
+		if f, err = filepath.Rel(dir, f); err != nil {
+			return nil, err
+		}
+		e.Files = append(e.Files, filepath.ToSlash(f))
+	}
+	sort.Strings(e.Files)
+	return e, nil
+}
+
+// Load reads the manifest stored in dir. A missing manifest is returned empty.
+func Load(dir string) (*Manifest, error) {
+	m := &Manifest{}
+	b, err := os.ReadFile(filepath.Join(dir, FileName))
+	if os.IsNotExist(err) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("genmanifest: reading manifest: %w", err)
+	}
+	if err := json.Unmarshal(b, m); err != nil {
+		return nil, fmt.Errorf("genmanifest: decoding manifest: %w", err)
+	}
+	return m, nil
+}
+
+// Write stores the manifest in dir.
+func (m *Manifest) Write(dir string) error {
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, FileName), append(b, '\n'), 0644)
+}
+
+// Entry returns the entry of the given generator, or nil if there is none.
+func (m *Manifest) Entry(generator string) *Entry {
+	for _, e := range m.Entries {
+		if e.Generator == generator {
+			return e
+		}
+	}
+	return nil
+}
+
+// Set adds the entry to the manifest, replacing the entry of the same generator, if any.
+func (m *Manifest) Set(e *Entry) {
+	for i := range m.Entries {
+		if m.Entries[i].Generator == e.Generator {
+			m.Entries[i] = e
+			return
+		}
+	}
+	m.Entries = append(m.Entries, e)
+	sort.Slice(m.Entries, func(i, j int) bool {
+		return m.Entries[i].Generator < m.Entries[j].Generator
+	})
+}
+
+// Record sets the entry of the given generator in the manifest stored in the target directory of g.
+func Record(g *gen.Graph, generator string, files ...string) error {
+	e, err := NewEntry(g, generator, files...)
+	if err != nil {
+		return err
+	}
+	m, err := Load(g.Config.Target)
+	if err != nil {
+		return err
+	}
+	m.Set(e)
+	return m.Write(g.Config.Target)
+}
+
+// Stale returns the entries of the manifest stored in dir that were generated from another revision of the
+// schemas of g, by another version of the generators, or whose files are missing.
+func (m *Manifest) Stale(dir string, g *gen.Graph) ([]*StaleEntry, error) {
+	hash, err := SchemaHash(g)
+	if err != nil {
+		return nil, err
+	}
+	version := Version()
+	var stale []*StaleEntry
+	for _, e := range m.Entries {
+		switch {
+		case e.SchemaHash != hash:
+			stale = append(stale, &StaleEntry{Entry: e, Reason: "schema changed since generation"})
+		case e.Version != version:
+			stale = append(stale, &StaleEntry{Entry: e, Reason: fmt.Sprintf("generated by version %s, current version is %s", e.Version, version)})
+		default:
+			for _, f := range e.Files {
+				if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(f))); os.IsNotExist(err) {
+					stale = append(stale, &StaleEntry{Entry: e, Reason: fmt.Sprintf("file %q is missing", f)})
+					break
+				}
+			}
+		}
+	}
+	return stale, nil
+}
+
+// Check loads the manifest stored in the target directory of g and returns its stale entries.
+func Check(g *gen.Graph) ([]*StaleEntry, error) {
+	m, err := Load(g.Config.Target)
+	if err != nil {
+		return nil, err
+	}
+	return m.Stale(g.Config.Target, g)
+}
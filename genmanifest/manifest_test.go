@@ -0,0 +1,130 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genmanifest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/load"
+	"entgo.io/ent/schema/field"
+	"github.com/stretchr/testify/require"
+)
+
+func graph(t *testing.T, target string, fields ...*field.Descriptor) *gen.Graph {
+	s := &load.Schema{Name: "User"}
+	for _, d := range fields {
+		f, err := load.NewField(d)
+		require.NoError(t, err)
+		s.Fields = append(s.Fields, f)
+	}
+	g, err := gen.NewGraph(&gen.Config{Package: "example.com/ent", Target: target}, s)
+	require.NoError(t, err)
+	return g
+}
+
+func TestSchemaHash(t *testing.T) {
+	dir := t.TempDir()
+	h1, err := SchemaHash(graph(t, dir, field.String("name").Descriptor()))
+	require.NoError(t, err)
+	h2, err := SchemaHash(graph(t, dir, field.String("name").Descriptor()))
+	require.NoError(t, err)
+	require.Equal(t, h1, h2)
+	h3, err := SchemaHash(graph(t, dir, field.String("name").Optional().Descriptor()))
+	require.NoError(t, err)
+	require.NotEqual(t, h1, h3)
+	require.Len(t, h1, 64)
+}
+
+func TestRecord(t *testing.T) {
+	dir := t.TempDir()
+	g := graph(t, dir, field.String("name").Descriptor())
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "proto"), 0755))
+	protoFile := filepath.Join(dir, "proto", "entpb.proto")
+	require.NoError(t, os.WriteFile(protoFile, nil, 0644))
+	require.NoError(t, Record(g, "entproto", protoFile))
+	require.NoError(t, Record(g, "entgql"))
+
+	m, err := Load(dir)
+	require.NoError(t, err)
+	require.Len(t, m.Entries, 2)
+	require.Equal(t, "entgql", m.Entries[0].Generator)
+	e := m.Entry("entproto")
+	require.NotNil(t, e)
+	require.Equal(t, []string{"proto/entpb.proto"}, e.Files)
+	require.Equal(t, Version(), e.Version)
+	stale, err := Check(g)
+	require.NoError(t, err)
+	require.Empty(t, stale)
+
+	// Recording an entry again replaces it.
+	require.NoError(t, Record(g, "entproto", protoFile))
+	m, err = Load(dir)
+	require.NoError(t, err)
+	require.Len(t, m.Entries, 2)
+
+	// A missing file makes its entry stale.
+	require.NoError(t, os.Remove(protoFile))
+	stale, err = Check(g)
+	require.NoError(t, err)
+	require.Len(t, stale, 1)
+	require.Equal(t, "entproto", stale[0].Entry.Generator)
+	require.Equal(t, `file "proto/entpb.proto" is missing`, stale[0].Reason)
+
+	// Changing the schema makes all entries stale.
+	stale, err = Check(graph(t, dir, field.Int("age").Descriptor()))
+	require.NoError(t, err)
+	require.Len(t, stale, 2)
+	for _, s := range stale {
+		require.Equal(t, "schema changed since generation", s.Reason)
+	}
+
+	// Entries generated by another version are stale.
+	m.Entries[0].Version = "v0.0.1"
+	stale, err = m.Stale(dir, g)
+	require.NoError(t, err)
+	require.Len(t, stale, 2)
+	require.Equal(t, "generated by version v0.0.1, current version is "+Version(), stale[0].Reason)
+}
+
+func TestLoad(t *testing.T) {
+	m, err := Load(t.TempDir())
+	require.NoError(t, err)
+	require.Empty(t, m.Entries)
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, FileName), []byte("{"), 0644))
+	_, err = Load(dir)
+	require.Error(t, err)
+}
+
+func TestHeader(t *testing.T) {
+	require.Equal(t,
+		"Code generated by entproto (entgo.io/contrib v0.3.0) from schema abc, DO NOT EDIT.",
+		Header("entproto", "v0.3.0", "abc"),
+	)
+}
+
+func TestParseHeader(t *testing.T) {
+	generator, version, hash, ok := ParseHeader(" " + Header("entproto", "(devel)", "abc123"))
+	require.True(t, ok)
+	require.Equal(t, "entproto", generator)
+	require.Equal(t, "(devel)", version)
+	require.Equal(t, "abc123", hash)
+
+	_, _, _, ok = ParseHeader("Code generated by entproto. DO NOT EDIT.")
+	require.False(t, ok)
+}
@@ -31,6 +31,9 @@ import (
 // Field converts a *field.Descriptor back into an *ast.CallExpr of the ent field package that can be used
 // to construct it.
 func Field(desc *field.Descriptor) (*ast.CallExpr, error) {
+	if k, ok := matchFieldKind(desc); ok {
+		return k.Print(desc)
+	}
 	switch t := desc.Info.Type; {
 	case t.Numeric(), t == field.TypeString, t == field.TypeBool, t == field.TypeTime, t == field.TypeBytes:
 		return fromSimpleType(desc)
@@ -73,7 +76,11 @@ func (c *Context) AppendField(typeName string, desc *field.Descriptor) error {
 	if err != nil {
 		return err
 	}
-	return c.appendReturnItem(kindField, typeName, newField)
+	if err := c.appendReturnItem(kindField, typeName, newField); err != nil {
+		return err
+	}
+	c.addFieldKindImport(typeName, desc)
+	return nil
 }
 
 // RemoveField removes a field from the returned values of the Fields method of type typeName.
@@ -261,13 +268,16 @@ func extractFieldName(fd *ast.CallExpr) (string, error) {
 		return extractFieldName(inner)
 	}
 	if final, ok := sel.X.(*ast.Ident); ok && final.Name != "field" {
+		if name, ok, err := customFieldName(final.Name, fd); ok {
+			return name, err
+		}
 		return "", fmt.Errorf(`schemast: expected field AST to be of form field.<Type>("name")`)
 	}
 	if len(fd.Args) == 0 {
 		return "", fmt.Errorf("schemast: expected field constructor to have at least name arg")
 	}
 	name, ok := fd.Args[0].(*ast.BasicLit)
-	if !ok && name.Kind == token.STRING {
+	if !ok || name.Kind != token.STRING {
 		return "", fmt.Errorf("schemast: expected field name to be a string literal")
 	}
 	return strconv.Unquote(name.Value)
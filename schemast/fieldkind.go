@@ -0,0 +1,141 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"path"
+	"sync"
+
+	"entgo.io/ent/schema/field"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// FieldKind describes how schemast prints and parses fields that are constructed by a package other
+// than ent's field package, for example a company package wrapping common field definitions:
+//
+//	schemast.RegisterFieldKind(schemast.FieldKind{
+//		Package: "money",
+//		Import:  "github.com/acme/schema/money",
+//		Match: func(desc *field.Descriptor) bool {
+//			return desc.Info.Ident == "money.Amount"
+//		},
+//		Print: func(desc *field.Descriptor) (*ast.CallExpr, error) {
+//			return schemast.FieldCall("money", "Amount", desc.Name), nil
+//		},
+//	})
+type FieldKind struct {
+	// Package is the identifier used to reference the package in schema files.
+	Package string
+	// Import is the import path of the package. If set, it is added to the files
+	// fields of this kind are appended to.
+	Import string
+	// Match reports whether a *field.Descriptor should be printed by this kind.
+	Match func(*field.Descriptor) bool
+	// Print converts a matched *field.Descriptor into the call expression constructing it, including
+	// the builder methods applied to it (e.g. Optional).
+	Print func(*field.Descriptor) (*ast.CallExpr, error)
+	// Name extracts the field name from the constructor call of a field of this kind, for example
+	// money.Amount("price"). If nil, the name is read from the first argument of the constructor.
+	Name func(*ast.CallExpr) (string, error)
+}
+
+var fieldKinds struct {
+	sync.RWMutex
+	kinds []FieldKind
+}
+
+// RegisterFieldKind registers a FieldKind used by Field, AppendField and RemoveField. Kinds
+// are matched in registration order and take precedence over the builtin ent field types, so
+// they can also be used to print fields of a builtin type (for example, a field.Strings wrapper)
+// with a custom constructor.
+func RegisterFieldKind(k FieldKind) error {
+	if k.Package == "" {
+		return errors.New("schemast: FieldKind.Package is required")
+	}
+	if k.Match == nil || k.Print == nil {
+		return fmt.Errorf("schemast: FieldKind %q must define both Match and Print", k.Package)
+	}
+	fieldKinds.Lock()
+	defer fieldKinds.Unlock()
+	fieldKinds.kinds = append(fieldKinds.kinds, k)
+	return nil
+}
+
+// FieldCall returns the AST of a call to the constructor fn of package pkg with a field name
+// as its first argument, for example: money.Amount("price").
+func FieldCall(pkg, fn, name string, args ...ast.Expr) *ast.CallExpr {
+	return fnCall(selectorLit(pkg, fn), append([]ast.Expr{strLit(name)}, args...)...)
+}
+
+// matchFieldKind returns the registered FieldKind matching desc.
+func matchFieldKind(desc *field.Descriptor) (FieldKind, bool) {
+	fieldKinds.RLock()
+	defer fieldKinds.RUnlock()
+	for _, k := range fieldKinds.kinds {
+		if k.Match(desc) {
+			return k, true
+		}
+	}
+	return FieldKind{}, false
+}
+
+// lookupFieldKind returns the registered FieldKind of package pkg.
+func lookupFieldKind(pkg string) (FieldKind, bool) {
+	fieldKinds.RLock()
+	defer fieldKinds.RUnlock()
+	for _, k := range fieldKinds.kinds {
+		if k.Package == pkg {
+			return k, true
+		}
+	}
+	return FieldKind{}, false
+}
+
+// addFieldKindImport adds the import of the kind printing desc to the file declaring typeName.
+func (c *Context) addFieldKindImport(typeName string, desc *field.Descriptor) {
+	k, ok := matchFieldKind(desc)
+	if !ok || k.Import == "" {
+		return
+	}
+	file, _, ok := c.lookupTypeDecl(typeName)
+	if !ok {
+		return
+	}
+	if path.Base(k.Import) == k.Package {
+		astutil.AddImport(c.SchemaPackage.Fset, file, k.Import)
+	} else {
+		astutil.AddNamedImport(c.SchemaPackage.Fset, file, k.Package, k.Import)
+	}
+}
+
+// customFieldName extracts the field name from the constructor call of a registered FieldKind of package pkg.
+func customFieldName(pkg string, call *ast.CallExpr) (string, bool, error) {
+	k, ok := lookupFieldKind(pkg)
+	if !ok {
+		return "", false, nil
+	}
+	if k.Name != nil {
+		name, err := k.Name(call)
+		return name, true, err
+	}
+	name, ok := stringArg(call, 0)
+	if !ok {
+		return "", true, fmt.Errorf("schemast: expected %s field name to be a string literal", pkg)
+	}
+	return name, true, nil
+}
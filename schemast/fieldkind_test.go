@@ -0,0 +1,66 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"go/ast"
+	"testing"
+
+	"entgo.io/ent/schema/field"
+	"github.com/stretchr/testify/require"
+)
+
+type amount string
+
+func TestFieldKind(t *testing.T) {
+	kinds := fieldKinds.kinds
+	t.Cleanup(func() {
+		fieldKinds.kinds = kinds
+	})
+	require.Error(t, RegisterFieldKind(FieldKind{Package: "money"}))
+	err := RegisterFieldKind(FieldKind{
+		Package: "money",
+		Import:  "example.com/schema/money",
+		Match: func(desc *field.Descriptor) bool {
+			return desc.Info.Ident == "schemast.amount"
+		},
+		Print: func(desc *field.Descriptor) (*ast.CallExpr, error) {
+			builder := &builderCall{curr: FieldCall("money", "Amount", desc.Name)}
+			if desc.Optional {
+				builder.method("Optional")
+			}
+			return builder.curr, nil
+		},
+	})
+	require.NoError(t, err)
+
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	price := field.String("price").GoType(amount("")).Optional().Descriptor()
+	require.NoError(t, tt.ctx.AppendField("Message", price))
+	require.NoError(t, tt.ctx.AppendField("Message", field.String("title").Descriptor()))
+	require.NoError(t, tt.ctx.Validate())
+	require.NoError(t, tt.print())
+	contents := tt.contents("message.go")
+	require.Contains(t, contents, `money.Amount("price").Optional()`)
+	require.Contains(t, contents, `"example.com/schema/money"`)
+
+	require.NoError(t, tt.ctx.AppendField("Message", price))
+	require.Error(t, tt.ctx.Validate())
+	require.NoError(t, tt.ctx.RemoveField("Message", "price"))
+	require.NoError(t, tt.ctx.RemoveField("Message", "price"))
+	require.NoError(t, tt.ctx.Validate())
+	require.NoError(t, tt.ctx.RemoveField("Message", "title"))
+}
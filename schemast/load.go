@@ -17,6 +17,7 @@ package schemast
 import (
 	"fmt"
 	"go/ast"
+	"sort"
 
 	"golang.org/x/tools/go/packages"
 )
@@ -121,8 +122,13 @@ func Load(path string) (*Context, error) {
 func (c *Context) syntax() []*ast.File {
 	var out []*ast.File
 	out = append(out, c.SchemaPackage.Syntax...)
-	for _, f := range c.newTypes {
-		out = append(out, f)
+	names := make([]string, 0, len(c.newTypes))
+	for name := range c.newTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		out = append(out, c.newTypes[name])
 	}
 	return out
 }
@@ -0,0 +1,416 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Diagnostic describes a problem found in a schema type by Validate.
+type Diagnostic struct {
+	// Pos is the position of the offending declaration. Nodes added by mutations have no position
+	// of their own and are reported at the position of the closest enclosing declaration.
+	Pos token.Position
+	// Type is the name of the schema type the problem was found in.
+	Type    string
+	Message string
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s: %s: %s", d.Pos, d.Type, d.Message)
+}
+
+// ValidationError is returned by Validate when the schema package contains problems that
+// would be rejected by ent's code generation.
+type ValidationError struct {
+	Diagnostics []Diagnostic
+}
+
+func (e *ValidationError) Error() string {
+	lines := make([]string, 0, len(e.Diagnostics))
+	for _, d := range e.Diagnostics {
+		lines = append(lines, d.String())
+	}
+	return "schemast: invalid schema:\n\t" + strings.Join(lines, "\n\t")
+}
+
+// Validate checks the schema types of the Context, including changes made by mutations, for problems
+// that ent's code generation would reject later, such as duplicate fields or edges, edges that
+// reference unknown types or edges, and invalid entproto annotations. Validate does not modify the
+// Context and is meant to be called before Print, so tools can fail fast. If problems are found,
+// a *ValidationError listing all of them is returned.
+//
+// Validate only inspects declarations that are written as literals (for example field.String("name")),
+// declarations that are computed at runtime are skipped.
+func (c *Context) Validate() error {
+	v := &validator{ctx: c, edges: make(map[string]map[string]*edgeDecl)}
+	types := c.schemaTypes()
+	for _, typeName := range types {
+		v.edges[typeName] = v.collectEdges(typeName)
+	}
+	for _, typeName := range types {
+		v.validateType(typeName)
+	}
+	if len(v.diags) > 0 {
+		return &ValidationError{Diagnostics: v.diags}
+	}
+	return nil
+}
+
+type (
+	validator struct {
+		ctx   *Context
+		diags []Diagnostic
+		// edges holds the edges declared by each schema type.
+		edges map[string]map[string]*edgeDecl
+	}
+	edgeDecl struct {
+		name    string
+		target  string
+		inverse bool
+		ref     string
+		node    ast.Node
+		chain   []*ast.CallExpr
+		// order is the index of the edge in the Edges method.
+		order int
+	}
+)
+
+// schemaTypes returns the sorted names of all types in the Context that embed ent.Schema.
+func (c *Context) schemaTypes() []string {
+	var names []string
+	for _, file := range c.syntax() {
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				if st, ok := ts.Type.(*ast.StructType); ok && embedsSchema(st) {
+					names = append(names, ts.Name.Name)
+				}
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func embedsSchema(st *ast.StructType) bool {
+	for _, f := range st.Fields.List {
+		if sel, ok := f.Type.(*ast.SelectorExpr); ok && len(f.Names) == 0 {
+			if x, ok := sel.X.(*ast.Ident); ok && x.Name == "ent" && sel.Sel.Name == "Schema" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (v *validator) report(typeName string, node ast.Node, format string, args ...interface{}) {
+	v.diags = append(v.diags, Diagnostic{
+		Pos:     v.position(typeName, node),
+		Type:    typeName,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// position returns the position of node, falling back to the position of the type declaration.
+func (v *validator) position(typeName string, node ast.Node) token.Position {
+	fset := v.ctx.SchemaPackage.Fset
+	if node != nil && node.Pos().IsValid() {
+		return fset.Position(node.Pos())
+	}
+	if _, decl, ok := v.ctx.lookupTypeDecl(typeName); ok && decl.Pos().IsValid() {
+		return fset.Position(decl.Pos())
+	}
+	return token.Position{}
+}
+
+// returnedItems returns the elements of the slice literal returned by the method of typeName, and
+// the node to report problems on when the elements themselves have no position.
+func (v *validator) returnedItems(typeName, method string) ([]ast.Expr, ast.Node) {
+	fd, ok := v.ctx.lookupMethod(typeName, method)
+	if !ok || fd.Body == nil || len(fd.Body.List) != 1 {
+		return nil, nil
+	}
+	ret, ok := fd.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return nil, nil
+	}
+	lit, ok := ret.Results[0].(*ast.CompositeLit)
+	if !ok {
+		return nil, nil
+	}
+	return lit.Elts, fd
+}
+
+func (v *validator) collectEdges(typeName string) map[string]*edgeDecl {
+	edges := make(map[string]*edgeDecl)
+	items, fd := v.returnedItems(typeName, "Edges")
+	for _, item := range items {
+		chain, pkg := builderChain(item)
+		if pkg != "edge" {
+			continue
+		}
+		name, ok := stringArg(chain[0], 0)
+		if !ok {
+			continue
+		}
+		e := &edgeDecl{
+			name:    name,
+			inverse: callName(chain[0]) == "From",
+			node:    nodeOr(item, fd),
+			chain:   chain,
+			order:   len(edges),
+		}
+		if len(chain[0].Args) > 1 {
+			if sel, ok := chain[0].Args[1].(*ast.SelectorExpr); ok && sel.Sel.Name == "Type" {
+				if x, ok := sel.X.(*ast.Ident); ok {
+					e.target = x.Name
+				}
+			}
+		}
+		for _, call := range chain[1:] {
+			switch callName(call) {
+			case "Ref":
+				e.ref, _ = stringArg(call, 0)
+			case "From":
+				// An assoc edge with its inverse declared on the same builder (O2M/M2M to the same type).
+				if back, ok := stringArg(call, 0); ok && !e.inverse {
+					if _, exists := edges[back]; !exists {
+						edges[back] = &edgeDecl{name: back, target: typeName, inverse: true, ref: name, node: e.node, order: len(edges) + 1}
+					}
+				}
+			}
+		}
+		if prev, exists := edges[name]; exists {
+			v.report(typeName, e.node, "duplicate edge %q, previously declared at %s", name, v.position(typeName, prev.node))
+			continue
+		}
+		edges[name] = e
+	}
+	return edges
+}
+
+func (v *validator) validateType(typeName string) {
+	fields := make(map[string]ast.Node)
+	numbers := make(map[int]string)
+	items, fd := v.returnedItems(typeName, "Fields")
+	for _, item := range items {
+		chain, pkg := builderChain(item)
+		if chain == nil {
+			continue
+		}
+		name, ok := stringArg(chain[0], 0)
+		if pkg != "field" {
+			var err error
+			if name, ok, err = customFieldName(pkg, chain[0]); err != nil {
+				ok = false
+			}
+		}
+		if !ok {
+			continue
+		}
+		node := nodeOr(item, fd)
+		if prev, exists := fields[name]; exists {
+			v.report(typeName, node, "duplicate field %q, previously declared at %s", name, v.position(typeName, prev))
+			continue
+		}
+		fields[name] = node
+		v.validateAnnotations(typeName, "field", name, node, chain, numbers)
+	}
+	edges := v.edges[typeName]
+	names := make([]string, 0, len(edges))
+	for name := range edges {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return edges[names[i]].order < edges[names[j]].order
+	})
+	for _, name := range names {
+		e := edges[name]
+		if _, exists := fields[name]; exists {
+			v.report(typeName, e.node, "edge %q conflicts with field %q", name, name)
+		}
+		switch {
+		case e.target == "":
+		case !v.ctx.HasType(e.target):
+			v.report(typeName, e.node, "edge %q references unknown type %q", name, e.target)
+		case e.inverse && e.ref == "":
+			v.report(typeName, e.node, "inverse edge %q is missing a reference to an edge of type %q, use Ref to set it", name, e.target)
+		case e.inverse:
+			targetEdges, ok := v.edges[e.target]
+			if !ok {
+				break
+			}
+			if ref, ok := targetEdges[e.ref]; !ok || ref.inverse {
+				v.report(typeName, e.node, "inverse edge %q references unknown edge %q of type %q", name, e.ref, e.target)
+			} else if ref.target != "" && ref.target != typeName {
+				v.report(typeName, e.node, "inverse edge %q references edge %q of type %q, which points to type %q", name, e.ref, e.target, ref.target)
+			}
+		}
+		v.validateAnnotations(typeName, "edge", name, e.node, e.chain, numbers)
+	}
+}
+
+// validateAnnotations checks the entproto annotations passed to the Annotations method of a field or
+// edge builder. numbers records the proto field numbers used so far by the type.
+func (v *validator) validateAnnotations(typeName, kind, name string, node ast.Node, chain []*ast.CallExpr, numbers map[int]string) {
+	if len(chain) == 0 {
+		return
+	}
+	for _, call := range chain[1:] {
+		if callName(call) != "Annotations" {
+			continue
+		}
+		for _, arg := range call.Args {
+			annot, pkg := builderChain(arg)
+			if pkg != "entproto" {
+				continue
+			}
+			at := nodeOr(arg, node)
+			switch callName(annot[0]) {
+			case "Field":
+				num, ok := intArg(annot[0], 0)
+				if !ok {
+					continue
+				}
+				desc := fmt.Sprintf("%s %q", kind, name)
+				switch {
+				case num < 1 || num > 536870911:
+					v.report(typeName, at, "%s has out of range entproto field number %d", desc, num)
+				case num >= 19000 && num <= 19999:
+					v.report(typeName, at, "%s uses entproto field number %d, reserved by the protobuf implementation", desc, num)
+				case numbers[num] != "":
+					v.report(typeName, at, "%s uses entproto field number %d, already used by %s", desc, num, numbers[num])
+				default:
+					numbers[num] = desc
+				}
+			case "Enum":
+				v.validateEnumOptions(typeName, name, at, annot[0])
+			}
+		}
+	}
+}
+
+func (v *validator) validateEnumOptions(typeName, name string, node ast.Node, call *ast.CallExpr) {
+	if len(call.Args) == 0 {
+		v.report(typeName, node, "entproto.Enum annotation of field %q is missing its options", name)
+		return
+	}
+	lit, ok := call.Args[0].(*ast.CompositeLit)
+	if !ok {
+		return
+	}
+	seen := make(map[int]string)
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		opt, ok := stringValue(kv.Key)
+		if !ok {
+			continue
+		}
+		num, ok := intValue(kv.Value)
+		if !ok {
+			continue
+		}
+		if prev, exists := seen[num]; exists {
+			v.report(typeName, nodeOr(kv, node), "entproto.Enum annotation of field %q maps both %q and %q to %d", name, prev, opt, num)
+			continue
+		}
+		seen[num] = opt
+	}
+}
+
+// builderChain unwinds a builder expression such as field.String("name").Optional() into its calls,
+// starting with the constructor call, and returns the name of the package the constructor belongs to.
+// It returns a nil chain if expr is not a builder expression.
+func builderChain(expr ast.Expr) ([]*ast.CallExpr, string) {
+	var chain []*ast.CallExpr
+	for {
+		call, ok := expr.(*ast.CallExpr)
+		if !ok {
+			return nil, ""
+		}
+		chain = append([]*ast.CallExpr{call}, chain...)
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return nil, ""
+		}
+		if x, ok := sel.X.(*ast.Ident); ok {
+			return chain, x.Name
+		}
+		expr = sel.X
+	}
+}
+
+func callName(call *ast.CallExpr) string {
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		return sel.Sel.Name
+	}
+	return ""
+}
+
+func stringArg(call *ast.CallExpr, i int) (string, bool) {
+	if len(call.Args) <= i {
+		return "", false
+	}
+	return stringValue(call.Args[i])
+}
+
+func stringValue(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
+
+func intArg(call *ast.CallExpr, i int) (int, bool) {
+	if len(call.Args) <= i {
+		return 0, false
+	}
+	return intValue(call.Args[i])
+}
+
+func intValue(expr ast.Expr) (int, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(lit.Value, 0, 64)
+	return int(n), err == nil
+}
+
+// nodeOr returns node if it has a position, or fallback otherwise.
+func nodeOr(node ast.Node, fallback ast.Node) ast.Node {
+	if node.Pos().IsValid() || fallback == nil {
+		return node
+	}
+	return fallback
+}
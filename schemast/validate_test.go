@@ -0,0 +1,100 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.Validate())
+
+	err = Mutate(tt.ctx,
+		&UpsertSchema{
+			Name: "User",
+			Fields: []ent.Field{
+				field.String("name").Annotations(entproto.Field(2)),
+			},
+			Edges: []ent.Edge{
+				WithType(edge.From("administered", placeholder.Type).Ref("admin"), "Team"),
+			},
+		},
+		&UpsertSchema{
+			Name: "Team",
+			Edges: []ent.Edge{
+				WithType(edge.To("admin", placeholder.Type), "User"),
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.Validate())
+}
+
+func TestValidateDiagnostics(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+
+	err = Mutate(tt.ctx,
+		&UpsertSchema{
+			Name: "User",
+			Fields: []ent.Field{
+				field.String("name").Annotations(entproto.Field(2)),
+				field.String("name"),
+				field.Int("age").Annotations(entproto.Field(2)),
+				field.Int("score").Annotations(entproto.Field(19001)),
+				field.Enum("status").Values("on", "off").Annotations(
+					entproto.Field(3),
+					entproto.Enum(map[string]int32{"on": 1, "off": 1}),
+				),
+			},
+			Edges: []ent.Edge{
+				WithType(edge.To("age", placeholder.Type), "Message"),
+				WithType(edge.To("pets", placeholder.Type), "Pet"),
+				WithType(edge.From("messages", placeholder.Type), "Message"),
+				WithType(edge.From("owner", placeholder.Type).Ref("users"), "Message"),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	err = tt.ctx.Validate()
+	var verr *ValidationError
+	require.True(t, errors.As(err, &verr))
+	var msgs []string
+	for _, d := range verr.Diagnostics {
+		require.Equal(t, "User", d.Type)
+		require.Equal(t, "user.go", filepath.Base(d.Pos.Filename))
+		msgs = append(msgs, d.Message)
+	}
+	require.Len(t, msgs, 8)
+	require.Contains(t, msgs[0], `duplicate field "name"`)
+	require.Equal(t, `field "age" uses entproto field number 2, already used by field "name"`, msgs[1])
+	require.Equal(t, `field "score" uses entproto field number 19001, reserved by the protobuf implementation`, msgs[2])
+	require.Regexp(t, `entproto.Enum annotation of field "status" maps both "(on|off)" and "(on|off)" to 1`, msgs[3])
+	require.Equal(t, `edge "age" conflicts with field "age"`, msgs[4])
+	require.Equal(t, `edge "pets" references unknown type "Pet"`, msgs[5])
+	require.Equal(t, `inverse edge "messages" is missing a reference to an edge of type "Message", use Ref to set it`, msgs[6])
+	require.Equal(t, `inverse edge "owner" references unknown edge "users" of type "Message"`, msgs[7])
+}